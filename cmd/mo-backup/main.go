@@ -0,0 +1,230 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+)
+
+const (
+	defaultUsername = "root"
+	defaultPassword = "111"
+	defaultHost     = "127.0.0.1"
+	defaultPort     = 6001
+	timeout         = 10 * time.Second
+)
+
+// mo-backup backups a running cluster by the BACKUP statement, or restores a
+// backup into the file service of a stopped DN.
+//
+//	mo-backup backup -dir /backup
+//	mo-backup backup -backend S3 -endpoint http://127.0.0.1:9000 -bucket backup -prefix mo
+//	mo-backup restore -src backup.toml -dst shared.toml [-ts 1678000000000000000-0]
+//	mo-backup restore -src backup.toml -dst shared.toml -archive archive.toml -dir /tmp/pitr -target-ts 1678000000000000000-0
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(1)
+	}
+	start := time.Now()
+	ctx := context.Background()
+	var err error
+	switch os.Args[1] {
+	case "backup":
+		err = backup(ctx, os.Args[2:])
+	case "restore":
+		err = restore(ctx, os.Args[2:])
+	default:
+		usage()
+		os.Exit(1)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "mobackup error: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Fprintf(os.Stdout, "MOBACKUP SUCCESS, COST %v\n", time.Since(start))
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: %s backup|restore [flags]\n", os.Args[0])
+}
+
+func backup(ctx context.Context, args []string) (err error) {
+	var (
+		username, password, host string
+		port                     int
+		dir, backend             string
+		endpoint, bucket, prefix string
+	)
+	flags := flag.NewFlagSet("backup", flag.ExitOnError)
+	flags.StringVar(&username, "u", defaultUsername, "username")
+	flags.StringVar(&password, "p", defaultPassword, "password")
+	flags.StringVar(&host, "h", defaultHost, "hostname")
+	flags.IntVar(&port, "P", defaultPort, "portNumber")
+	flags.StringVar(&backend, "backend", "DISK", "backend of the backup target, [DISK|S3|MINIO]")
+	flags.StringVar(&dir, "dir", "", "directory of DN to backup to, for DISK backend")
+	flags.StringVar(&endpoint, "endpoint", "", "endpoint of the S3 compatible storage")
+	flags.StringVar(&bucket, "bucket", "", "bucket to backup to, for S3 and MINIO backend")
+	flags.StringVar(&prefix, "prefix", "", "key prefix of the backup in the bucket")
+	if err = flags.Parse(args); err != nil {
+		return
+	}
+
+	var stmt string
+	switch strings.ToUpper(backend) {
+	case "DISK":
+		if dir == "" {
+			return moerr.NewInvalidInput(ctx, "dir must be specified")
+		}
+		stmt = fmt.Sprintf("backup to '%s'", dir)
+	case "S3", "MINIO":
+		if bucket == "" {
+			return moerr.NewInvalidInput(ctx, "bucket must be specified")
+		}
+		provider := "aws"
+		if strings.ToUpper(backend) == "MINIO" {
+			provider = "minio"
+		}
+		stmt = fmt.Sprintf("backup to s3option {'endpoint'='%s', 'bucket'='%s', 'filepath'='%s', 'provider'='%s'}",
+			endpoint, bucket, prefix, provider)
+	default:
+		return moerr.NewNotSupported(ctx, "backend %s", backend)
+	}
+
+	dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/", username, password, host, port)
+	conn, err := sql.Open("mysql", dsn)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	err = conn.PingContext(ctx)
+	cancel()
+	if err != nil {
+		return
+	}
+	rows, err := conn.Query(stmt)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var result string
+		if err = rows.Scan(&result); err != nil {
+			return
+		}
+		fmt.Fprintln(os.Stdout, result)
+	}
+	return rows.Err()
+}
+
+func restore(ctx context.Context, args []string) (err error) {
+	var (
+		src, dst, ts string
+		archive, dir string
+		targetTS     string
+		targetLSN    uint64
+	)
+	flags := flag.NewFlagSet("restore", flag.ExitOnError)
+	flags.StringVar(&src, "src", "", "toml file of the file service config of the backup")
+	flags.StringVar(&dst, "dst", "", "toml file of the file service config of DN to restore to")
+	flags.StringVar(&ts, "ts", "", "end timestamp of the backup to restore, default the latest one")
+	flags.StringVar(&archive, "archive", "", "toml file of the file service config of the WAL archive, for point-in-time recovery")
+	flags.StringVar(&dir, "dir", "", "local directory to replay the archived WAL in, for point-in-time recovery")
+	flags.StringVar(&targetTS, "target-ts", "", "max commit timestamp of the archived txns replayed")
+	flags.Uint64Var(&targetLSN, "target-lsn", 0, "max LSN of the archived txns replayed")
+	if err = flags.Parse(args); err != nil {
+		return
+	}
+	if src == "" || dst == "" {
+		return moerr.NewInvalidInput(ctx, "src and dst must be specified")
+	}
+	if archive != "" && dir == "" {
+		return moerr.NewInvalidInput(ctx, "dir must be specified for point-in-time recovery")
+	}
+	srcFS, err := newFileService(src)
+	if err != nil {
+		return
+	}
+	dstFS, err := newFileService(dst)
+	if err != nil {
+		return
+	}
+	var end types.TS
+	if ts != "" {
+		end = types.StringToTS(ts)
+	}
+	result, err := db.Restore(ctx, srcFS, dstFS, end)
+	if err != nil {
+		return
+	}
+	fmt.Fprintln(os.Stdout, result.String())
+	if archive == "" {
+		return
+	}
+
+	// replays the archived WAL on top of the backup, and checkpoints all of them
+	// into dst, so DN needs nothing but dst to start.
+	archiveFS, err := newFileService(archive)
+	if err != nil {
+		return
+	}
+	cfg := &options.RecoveryCfg{
+		Archive:   archiveFS,
+		TargetLSN: targetLSN,
+	}
+	if targetTS != "" {
+		cfg.TargetTS = types.StringToTS(targetTS)
+	}
+	tae, err := db.Open(dir, &options.Options{
+		Fs:          dstFS,
+		LogStoreT:   options.LogstoreBatchStore,
+		RecoveryCfg: cfg,
+	})
+	if err != nil {
+		return
+	}
+	defer func() {
+		if closeErr := tae.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+	return tae.CheckpointAll(ctx)
+}
+
+func newFileService(file string) (fileservice.FileService, error) {
+	var cfg fileservice.Config
+	if _, err := toml.DecodeFile(file, &cfg); err != nil {
+		return nil, err
+	}
+	if cfg.Name == "" {
+		cfg.Name = db.BackupFileServiceName
+	}
+	return fileservice.NewFileService(cfg, nil)
+}
@@ -86,6 +86,15 @@ func ParseEntryList(es []*api.Entry) (any, []*api.Entry, error) {
 		if e.EntryType == api.Entry_Delete {
 			return genDropOrTruncateTables(GenRows(bat)), es[1:], nil
 		} else if e.EntryType == api.Entry_Update {
+			if bat.Attrs[MO_TABLES_UPDATE_PARTITION] == SystemRelAttr_Partition {
+				return genUpdatePartition(GenRows(bat)), es[1:], nil
+			}
+			if bat.Attrs[MO_TABLES_UPDATE_MERGE] == MergePolicyAttr {
+				return genUpdateMergePolicy(GenRows(bat)), es[1:], nil
+			}
+			if bat.Attrs[MO_TABLES_UPDATE_NAME] == NewRelNameAttr {
+				return genUpdateTableName(GenRows(bat)), es[1:], nil
+			}
 			return genUpdateConstraint(GenRows(bat)), es[1:], nil
 		}
 		cmds := genCreateTables(GenRows(bat))
@@ -206,6 +215,42 @@ func genUpdateConstraint(rows [][]any) []UpdateConstraint {
 	return cmds
 }
 
+func genUpdatePartition(rows [][]any) []UpdatePartition {
+	cmds := make([]UpdatePartition, len(rows))
+	for i, row := range rows {
+		cmds[i].TableId = row[MO_TABLES_REL_ID_IDX].(uint64)
+		cmds[i].DatabaseId = row[MO_TABLES_RELDATABASE_ID_IDX].(uint64)
+		cmds[i].TableName = string(row[MO_TABLES_REL_NAME_IDX].([]byte))
+		cmds[i].DatabaseName = string(row[MO_TABLES_RELDATABASE_IDX].([]byte))
+		cmds[i].Partition = string(row[MO_TABLES_UPDATE_PARTITION].([]byte))
+	}
+	return cmds
+}
+
+func genUpdateMergePolicy(rows [][]any) []UpdateMergePolicy {
+	cmds := make([]UpdateMergePolicy, len(rows))
+	for i, row := range rows {
+		cmds[i].TableId = row[MO_TABLES_REL_ID_IDX].(uint64)
+		cmds[i].DatabaseId = row[MO_TABLES_RELDATABASE_ID_IDX].(uint64)
+		cmds[i].TableName = string(row[MO_TABLES_REL_NAME_IDX].([]byte))
+		cmds[i].DatabaseName = string(row[MO_TABLES_RELDATABASE_IDX].([]byte))
+		cmds[i].MergePolicy = string(row[MO_TABLES_UPDATE_MERGE].([]byte))
+	}
+	return cmds
+}
+
+func genUpdateTableName(rows [][]any) []UpdateTableName {
+	cmds := make([]UpdateTableName, len(rows))
+	for i, row := range rows {
+		cmds[i].TableId = row[MO_TABLES_REL_ID_IDX].(uint64)
+		cmds[i].DatabaseId = row[MO_TABLES_RELDATABASE_ID_IDX].(uint64)
+		cmds[i].TableName = string(row[MO_TABLES_REL_NAME_IDX].([]byte))
+		cmds[i].DatabaseName = string(row[MO_TABLES_RELDATABASE_IDX].([]byte))
+		cmds[i].NewName = string(row[MO_TABLES_UPDATE_NAME].([]byte))
+	}
+	return cmds
+}
+
 func genDropOrTruncateTables(rows [][]any) []DropOrTruncateTable {
 	cmds := make([]DropOrTruncateTable, len(rows))
 	for i, row := range rows {
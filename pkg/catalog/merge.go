@@ -0,0 +1,156 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const (
+	MergePolicySizeTiered = "size_tiered"
+	MergePolicyTimeWindow = "time_window"
+)
+
+// table properties tuning the merge (compaction) of a table in DN
+const (
+	PropMergePolicy    = "merge_policy"
+	PropMergeMinBlocks = "merge_min_blocks"
+	PropMergeMaxRows   = "merge_max_rows"
+	PropMergeWait      = "merge_wait"
+	PropMergeWindow    = "merge_window"
+	// PropMergeTimeColumn is the DATE, DATETIME or TIMESTAMP column by which
+	// the time window policy groups the blocks
+	PropMergeTimeColumn = "merge_time_column"
+)
+
+// MergePolicyAttr is the attribute of the mo_tables update entry carrying the
+// merge properties. It is not a column of mo_tables, DN keeps it in the schema only.
+const MergePolicyAttr = "merge_policy"
+
+// MergeProperties is the per-table override of the merge policy in DN.
+// Zero values mean the default of the policy.
+type MergeProperties struct {
+	Policy    string
+	MinBlocks int
+	MaxRows   int
+	Wait      time.Duration
+	Window    time.Duration
+	// TimeColumn is kept in lower case
+	TimeColumn string
+}
+
+func IsMergeProperty(key string) bool {
+	switch strings.ToLower(key) {
+	case PropMergePolicy, PropMergeMinBlocks, PropMergeMaxRows, PropMergeWait, PropMergeWindow, PropMergeTimeColumn:
+		return true
+	}
+	return false
+}
+
+// Set parses the value of the merge property key. An empty value resets it to default.
+func (p *MergeProperties) Set(ctx context.Context, key, value string) error {
+	key = strings.ToLower(key)
+	value = strings.TrimSpace(value)
+	switch key {
+	case PropMergePolicy:
+		value = strings.ToLower(value)
+		if value != "" && value != MergePolicySizeTiered && value != MergePolicyTimeWindow {
+			return moerr.NewInvalidInput(ctx, "unknown merge policy '%s'", value)
+		}
+		p.Policy = value
+		return nil
+	case PropMergeMinBlocks, PropMergeMaxRows:
+		n := 0
+		if value != "" {
+			v, err := strconv.ParseUint(value, 10, 31)
+			if err != nil {
+				return moerr.NewInvalidInput(ctx, "invalid value '%s' of %s", value, key)
+			}
+			n = int(v)
+		}
+		if key == PropMergeMinBlocks {
+			p.MinBlocks = n
+		} else {
+			p.MaxRows = n
+		}
+		return nil
+	case PropMergeWait, PropMergeWindow:
+		var d time.Duration
+		if value != "" {
+			v, err := time.ParseDuration(value)
+			if err != nil || v < 0 {
+				return moerr.NewInvalidInput(ctx, "invalid value '%s' of %s", value, key)
+			}
+			d = v
+		}
+		if key == PropMergeWait {
+			p.Wait = d
+		} else {
+			p.Window = d
+		}
+		return nil
+	case PropMergeTimeColumn:
+		p.TimeColumn = strings.ToLower(value)
+		return nil
+	}
+	return moerr.NewInvalidInput(ctx, "unknown merge property '%s'", key)
+}
+
+// Encode returns the form kept in the schema, an empty string if all properties are default.
+func (p *MergeProperties) Encode() string {
+	var items []string
+	if p.Policy != "" {
+		items = append(items, fmt.Sprintf("%s=%s", PropMergePolicy, p.Policy))
+	}
+	if p.MinBlocks != 0 {
+		items = append(items, fmt.Sprintf("%s=%d", PropMergeMinBlocks, p.MinBlocks))
+	}
+	if p.MaxRows != 0 {
+		items = append(items, fmt.Sprintf("%s=%d", PropMergeMaxRows, p.MaxRows))
+	}
+	if p.Wait != 0 {
+		items = append(items, fmt.Sprintf("%s=%s", PropMergeWait, p.Wait))
+	}
+	if p.Window != 0 {
+		items = append(items, fmt.Sprintf("%s=%s", PropMergeWindow, p.Window))
+	}
+	if p.TimeColumn != "" {
+		items = append(items, fmt.Sprintf("%s=%s", PropMergeTimeColumn, p.TimeColumn))
+	}
+	return strings.Join(items, ";")
+}
+
+func DecodeMergeProperties(s string) (*MergeProperties, error) {
+	p := new(MergeProperties)
+	if s == "" {
+		return p, nil
+	}
+	for _, item := range strings.Split(s, ";") {
+		kv := strings.SplitN(item, "=", 2)
+		if len(kv) != 2 {
+			return nil, moerr.NewInvalidInputNoCtx("invalid merge properties '%s'", s)
+		}
+		if err := p.Set(context.Background(), kv[0], kv[1]); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeProperties(t *testing.T) {
+	ctx := context.Background()
+	var p MergeProperties
+	require.Equal(t, "", p.Encode())
+
+	require.NoError(t, p.Set(ctx, "MERGE_POLICY", "Time_Window"))
+	require.NoError(t, p.Set(ctx, PropMergeWindow, "30m"))
+	require.NoError(t, p.Set(ctx, PropMergeMinBlocks, "4"))
+	require.NoError(t, p.Set(ctx, PropMergeMaxRows, "100000"))
+	require.NoError(t, p.Set(ctx, PropMergeWait, "10s"))
+	require.NoError(t, p.Set(ctx, PropMergeTimeColumn, "Created_At"))
+	require.Equal(t, MergeProperties{
+		Policy:     MergePolicyTimeWindow,
+		MinBlocks:  4,
+		MaxRows:    100000,
+		Wait:       10 * time.Second,
+		Window:     30 * time.Minute,
+		TimeColumn: "created_at",
+	}, p)
+
+	decoded, err := DecodeMergeProperties(p.Encode())
+	require.NoError(t, err)
+	require.Equal(t, p, *decoded)
+
+	// empty value resets to default
+	require.NoError(t, p.Set(ctx, PropMergeWait, ""))
+	require.NoError(t, p.Set(ctx, PropMergeMinBlocks, ""))
+	require.NoError(t, p.Set(ctx, PropMergeTimeColumn, ""))
+	require.Equal(t, "merge_policy=time_window;merge_max_rows=100000;merge_window=30m0s", p.Encode())
+
+	require.Error(t, p.Set(ctx, PropMergePolicy, "leveled"))
+	require.Error(t, p.Set(ctx, PropMergeMinBlocks, "-1"))
+	require.Error(t, p.Set(ctx, PropMergeMaxRows, "many"))
+	require.Error(t, p.Set(ctx, PropMergeWindow, "-1h"))
+	require.Error(t, p.Set(ctx, "merge_level", "1"))
+
+	require.True(t, IsMergeProperty("Merge_Window"))
+	require.False(t, IsMergeProperty("comment"))
+
+	_, err = DecodeMergeProperties("merge_policy")
+	require.Error(t, err)
+}
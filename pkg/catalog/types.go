@@ -27,9 +27,12 @@ import (
 )
 
 const (
-	Row_ID               = "__mo_rowid"
-	PrefixPriColName     = "__mo_cpkey_"
-	PrefixCBColName      = "__mo_cbkey_"
+	Row_ID           = "__mo_rowid"
+	PrefixPriColName = "__mo_cpkey_"
+	PrefixCBColName  = "__mo_cbkey_"
+	// PrefixZOrderColName names the hidden sort key of a table clustered by
+	// the Z-order of its columns, it has the same length as PrefixCBColName
+	PrefixZOrderColName  = "__mo_zokey_"
 	PrefixIndexTableName = "__mo_index_"
 	// Compound primary key column name, which is a hidden column
 	CPrimaryKeyColName = "__mo_cpkey_col"
@@ -177,11 +180,18 @@ const (
 	MO_COLUMNS_ID  = 3
 )
 
-// index use to update constraint
+// index use to update constraint or partition
 const (
 	MO_TABLES_UPDATE_CONSTRAINT = 4
+	MO_TABLES_UPDATE_PARTITION  = 4
+	MO_TABLES_UPDATE_MERGE      = 4
+	MO_TABLES_UPDATE_NAME       = 4
 )
 
+// NewRelNameAttr is the attribute of the mo_tables update entry carrying the
+// new name of a renamed table
+const NewRelNameAttr = "new_relname"
+
 // column's index in catalog table
 const (
 	MO_DATABASE_DAT_ID_IDX           = 0
@@ -356,6 +366,30 @@ type UpdateConstraint struct {
 	Constraint   []byte
 }
 
+type UpdatePartition struct {
+	DatabaseId   uint64
+	TableId      uint64
+	TableName    string
+	DatabaseName string
+	Partition    string
+}
+
+type UpdateMergePolicy struct {
+	DatabaseId   uint64
+	TableId      uint64
+	TableName    string
+	DatabaseName string
+	MergePolicy  string
+}
+
+type UpdateTableName struct {
+	DatabaseId   uint64
+	TableId      uint64
+	TableName    string
+	DatabaseName string
+	NewName      string
+}
+
 type DropOrTruncateTable struct {
 	IsDrop       bool // true for Drop and false for Truncate
 	Id           uint64
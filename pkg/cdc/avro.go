@@ -0,0 +1,239 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"math"
+	"strconv"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// The changes are encoded in the Avro object container file format by hand,
+// with the schema below. The column values are in a union of the primitive
+// types, and the row images are maps from the column names to the values.
+const avroValue = `["null","boolean","long","double","string"]`
+
+const avroSchema = `{"type":"record","name":"Change","namespace":"matrixone.cdc","fields":[` +
+	`{"name":"database","type":"string"},` +
+	`{"name":"table","type":"string"},` +
+	`{"name":"ts","type":"string"},` +
+	`{"name":"op","type":{"type":"enum","name":"Op","symbols":["insert","update","delete"]}},` +
+	`{"name":"pk","type":["null",{"type":"map","values":` + avroValue + `}]},` +
+	`{"name":"before","type":["null",{"type":"map","values":` + avroValue + `}]},` +
+	`{"name":"after","type":["null",{"type":"map","values":` + avroValue + `}]}]}`
+
+const avroSyncSize = 16
+
+// indexes of the value union
+const (
+	avroNull = iota
+	avroBoolean
+	avroLong
+	avroDouble
+	avroString
+)
+
+var avroMagic = []byte{'O', 'b', 'j', 1}
+
+type avroEncoder struct {
+	buf     bytes.Buffer
+	scratch [binary.MaxVarintLen64]byte
+}
+
+func (e *avroEncoder) writeLong(v int64) {
+	n := binary.PutVarint(e.scratch[:], v)
+	e.buf.Write(e.scratch[:n])
+}
+
+func (e *avroEncoder) writeBytes(v []byte) {
+	e.writeLong(int64(len(v)))
+	e.buf.Write(v)
+}
+
+func (e *avroEncoder) writeString(v string) {
+	e.writeLong(int64(len(v)))
+	e.buf.WriteString(v)
+}
+
+func (e *avroEncoder) writeValue(v any) {
+	switch v := v.(type) {
+	case nil:
+		e.writeLong(avroNull)
+	case bool:
+		e.writeLong(avroBoolean)
+		if v {
+			e.buf.WriteByte(1)
+		} else {
+			e.buf.WriteByte(0)
+		}
+	case int64:
+		e.writeLong(avroLong)
+		e.writeLong(v)
+	case uint64:
+		if v > math.MaxInt64 {
+			e.writeLong(avroString)
+			e.writeString(strconv.FormatUint(v, 10))
+			return
+		}
+		e.writeLong(avroLong)
+		e.writeLong(int64(v))
+	case float64:
+		e.writeLong(avroDouble)
+		binary.LittleEndian.PutUint64(e.scratch[:8], math.Float64bits(v))
+		e.buf.Write(e.scratch[:8])
+	case string:
+		e.writeLong(avroString)
+		e.writeString(v)
+	default:
+		panic(moerr.NewInternalErrorNoCtx("unexpected avro value %T", v))
+	}
+}
+
+func (e *avroEncoder) writeRow(row Row) {
+	if row == nil {
+		e.writeLong(0)
+		return
+	}
+	e.writeLong(1)
+	if len(row) > 0 {
+		e.writeLong(int64(len(row)))
+		for _, col := range row {
+			e.writeString(col.Name)
+			e.writeValue(col.Value)
+		}
+	}
+	e.writeLong(0)
+}
+
+func (e *avroEncoder) writeChange(c *Change) {
+	e.writeString(c.Database)
+	e.writeString(c.Table)
+	e.writeString(c.TS.ToString())
+	switch c.Op {
+	case OpInsert:
+		e.writeLong(0)
+	case OpUpdate:
+		e.writeLong(1)
+	default:
+		e.writeLong(2)
+	}
+	e.writeRow(c.PK)
+	e.writeRow(c.Before)
+	e.writeRow(c.After)
+}
+
+// encodeAvroChanges encodes the changes as an Avro record each
+func encodeAvroChanges(changes []*Change) []byte {
+	var e avroEncoder
+	for _, c := range changes {
+		e.writeChange(c)
+	}
+	return e.buf.Bytes()
+}
+
+// avroWriter writes the changes in the Avro object container file format, a
+// data block per Write.
+type avroWriter struct {
+	w    io.Writer
+	sync []byte
+}
+
+// newAvroWriter writes the file header to w
+func newAvroWriter(w io.Writer) (*avroWriter, error) {
+	sync := make([]byte, avroSyncSize)
+	if _, err := rand.Read(sync); err != nil {
+		return nil, err
+	}
+	var e avroEncoder
+	e.buf.Write(avroMagic)
+	e.writeLong(2)
+	e.writeString("avro.schema")
+	e.writeString(avroSchema)
+	e.writeString("avro.codec")
+	e.writeString("null")
+	e.writeLong(0)
+	e.buf.Write(sync)
+	if _, err := w.Write(e.buf.Bytes()); err != nil {
+		return nil, err
+	}
+	return &avroWriter{w: w, sync: sync}, nil
+}
+
+// openAvroWriter appends to the file written by an avroWriter before, whose
+// header is read from r.
+func openAvroWriter(r io.Reader, w io.Writer) (*avroWriter, error) {
+	br := bufio.NewReader(r)
+	magic := make([]byte, len(avroMagic))
+	if _, err := io.ReadFull(br, magic); err != nil || !bytes.Equal(magic, avroMagic) {
+		return nil, moerr.NewInvalidInputNoCtx("not an avro file")
+	}
+	for {
+		n, err := binary.ReadVarint(br)
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			break
+		}
+		if n < 0 {
+			// the block size follows a negative count
+			if _, err = binary.ReadVarint(br); err != nil {
+				return nil, err
+			}
+			n = -n
+		}
+		for i := int64(0); i < n*2; i++ {
+			if err = skipAvroBytes(br); err != nil {
+				return nil, err
+			}
+		}
+	}
+	sync := make([]byte, avroSyncSize)
+	if _, err := io.ReadFull(br, sync); err != nil {
+		return nil, err
+	}
+	return &avroWriter{w: w, sync: sync}, nil
+}
+
+func skipAvroBytes(br *bufio.Reader) error {
+	n, err := binary.ReadVarint(br)
+	if err != nil {
+		return err
+	}
+	if n < 0 {
+		return moerr.NewInvalidInputNoCtx("invalid avro bytes length %d", n)
+	}
+	_, err = br.Discard(int(n))
+	return err
+}
+
+func (w *avroWriter) Write(changes []*Change) error {
+	if len(changes) == 0 {
+		return nil
+	}
+	data := encodeAvroChanges(changes)
+	var e avroEncoder
+	e.writeLong(int64(len(changes)))
+	e.writeBytes(data)
+	e.buf.Write(w.sync)
+	_, err := w.w.Write(e.buf.Bytes())
+	return err
+}
@@ -0,0 +1,83 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// fileSink appends the changes to a local file, a JSON object per line, or
+// an Avro data block per Send.
+type fileSink struct {
+	f    *os.File
+	avro *avroWriter
+}
+
+func NewFileSink(ctx context.Context, path string, format string) (Sink, error) {
+	if path == "" {
+		return nil, moerr.NewInvalidInput(ctx, "file sink path is empty")
+	}
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	sink := &fileSink{f: f}
+	if format == FormatAvro {
+		stat, err := f.Stat()
+		if err == nil {
+			if stat.Size() == 0 {
+				sink.avro, err = newAvroWriter(f)
+			} else {
+				sink.avro, err = openAvroWriter(f, f)
+			}
+		}
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+	}
+	return sink, nil
+}
+
+func (s *fileSink) Send(_ context.Context, changes []*Change) error {
+	if s.avro != nil {
+		if err := s.avro.Write(changes); err != nil {
+			return err
+		}
+		return s.f.Sync()
+	}
+	w := bufio.NewWriter(s.f)
+	for _, c := range changes {
+		data, err := json.Marshal(c)
+		if err != nil {
+			return err
+		}
+		w.Write(data)
+		w.WriteByte('\n')
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	return s.f.Sync()
+}
+
+func (s *fileSink) Close() error {
+	return s.f.Close()
+}
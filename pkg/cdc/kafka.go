@@ -0,0 +1,397 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"net"
+	"strconv"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// A minimal client side of the Kafka protocol, just enough to look up the
+// leaders of the partitions of a topic by Metadata v1 requests, and produce to
+// them by Produce v3 requests carrying a v2 record batch.
+// See https://kafka.apache.org/protocol.html.
+
+const (
+	kafkaProduceKey      = 0
+	kafkaProduceVersion  = 3
+	kafkaMetadataKey     = 3
+	kafkaMetadataVersion = 1
+	// the offset of the attributes in a record batch, where the CRC starts
+	kafkaBatchCRCStart = 21
+)
+
+// the error codes of Kafka, which can be retried after refreshing the metadata
+const (
+	kafkaUnknownTopicOrPartition      = 3
+	kafkaLeaderNotAvailable           = 5
+	kafkaNotLeaderOrFollower          = 6
+	kafkaRequestTimedOut              = 7
+	kafkaBrokerNotAvailable           = 8
+	kafkaReplicaNotAvailable          = 9
+	kafkaNetworkException             = 13
+	kafkaNotEnoughReplicas            = 19
+	kafkaNotEnoughReplicasAfterAppend = 20
+)
+
+func kafkaRetriable(code int16) bool {
+	switch code {
+	case kafkaUnknownTopicOrPartition, kafkaLeaderNotAvailable, kafkaNotLeaderOrFollower,
+		kafkaRequestTimedOut, kafkaBrokerNotAvailable, kafkaReplicaNotAvailable,
+		kafkaNetworkException, kafkaNotEnoughReplicas, kafkaNotEnoughReplicasAfterAppend:
+		return true
+	}
+	return false
+}
+
+var castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+type kafkaRecord struct {
+	Key   []byte
+	Value []byte
+}
+
+type kafkaEncoder struct {
+	buf []byte
+}
+
+func (e *kafkaEncoder) int8(v int8) {
+	e.buf = append(e.buf, byte(v))
+}
+
+func (e *kafkaEncoder) int16(v int16) {
+	e.buf = binary.BigEndian.AppendUint16(e.buf, uint16(v))
+}
+
+func (e *kafkaEncoder) int32(v int32) {
+	e.buf = binary.BigEndian.AppendUint32(e.buf, uint32(v))
+}
+
+func (e *kafkaEncoder) int64(v int64) {
+	e.buf = binary.BigEndian.AppendUint64(e.buf, uint64(v))
+}
+
+func (e *kafkaEncoder) varint(v int64) {
+	e.buf = binary.AppendVarint(e.buf, v)
+}
+
+func (e *kafkaEncoder) string(v string) {
+	e.int16(int16(len(v)))
+	e.buf = append(e.buf, v...)
+}
+
+func (e *kafkaEncoder) nullableString(v *string) {
+	if v == nil {
+		e.int16(-1)
+		return
+	}
+	e.string(*v)
+}
+
+func (e *kafkaEncoder) varbytes(v []byte) {
+	if v == nil {
+		e.varint(-1)
+		return
+	}
+	e.varint(int64(len(v)))
+	e.buf = append(e.buf, v...)
+}
+
+// encodeRecordBatch encodes the records as a v2 record batch, all of which
+// have the timestamp ts in milliseconds.
+func encodeRecordBatch(records []kafkaRecord, ts int64) []byte {
+	e := &kafkaEncoder{}
+	e.int64(0)  // base offset
+	e.int32(0)  // batch length, filled later
+	e.int32(-1) // partition leader epoch
+	e.int8(2)   // magic
+	e.int32(0)  // crc, filled later
+	e.int16(0)  // attributes, no compression
+	e.int32(int32(len(records) - 1))
+	e.int64(ts) // first timestamp
+	e.int64(ts) // max timestamp
+	e.int64(-1) // producer id
+	e.int16(-1) // producer epoch
+	e.int32(-1) // base sequence
+	e.int32(int32(len(records)))
+	record := &kafkaEncoder{}
+	for i, r := range records {
+		record.buf = record.buf[:0]
+		record.int8(0)   // attributes
+		record.varint(0) // timestamp delta
+		record.varint(int64(i))
+		record.varbytes(r.Key)
+		record.varbytes(r.Value)
+		record.varint(0) // headers
+		e.varint(int64(len(record.buf)))
+		e.buf = append(e.buf, record.buf...)
+	}
+	binary.BigEndian.PutUint32(e.buf[8:], uint32(len(e.buf)-12))
+	binary.BigEndian.PutUint32(e.buf[17:], crc32.Checksum(e.buf[kafkaBatchCRCStart:], castagnoli))
+	return e.buf
+}
+
+// encodeProduceRequest encodes a size delimited Produce request writing the
+// record batch to a partition.
+func encodeProduceRequest(
+	correlationID int32,
+	clientID string,
+	acks int16,
+	timeoutMs int32,
+	topic string,
+	partition int32,
+	batch []byte) []byte {
+	e := &kafkaEncoder{}
+	e.int32(0) // size, filled later
+	e.int16(kafkaProduceKey)
+	e.int16(kafkaProduceVersion)
+	e.int32(correlationID)
+	e.nullableString(&clientID)
+	e.nullableString(nil) // transactional id
+	e.int16(acks)
+	e.int32(timeoutMs)
+	e.int32(1) // topics
+	e.string(topic)
+	e.int32(1) // partitions
+	e.int32(partition)
+	e.int32(int32(len(batch)))
+	e.buf = append(e.buf, batch...)
+	binary.BigEndian.PutUint32(e.buf, uint32(len(e.buf)-4))
+	return e.buf
+}
+
+// encodeMetadataRequest encodes a size delimited Metadata request of a topic
+func encodeMetadataRequest(correlationID int32, clientID string, topic string) []byte {
+	e := &kafkaEncoder{}
+	e.int32(0) // size, filled later
+	e.int16(kafkaMetadataKey)
+	e.int16(kafkaMetadataVersion)
+	e.int32(correlationID)
+	e.nullableString(&clientID)
+	e.int32(1) // topics
+	e.string(topic)
+	binary.BigEndian.PutUint32(e.buf, uint32(len(e.buf)-4))
+	return e.buf
+}
+
+// kafkaMetadata is the metadata of a topic
+type kafkaMetadata struct {
+	// code is the error code of the topic
+	code int16
+	// leaders are the addresses of the leaders of the partitions, indexed by the
+	// partition id. It is empty if the partition has no leader for now.
+	leaders []string
+}
+
+// decodeMetadataResponse decodes the metadata of the topic requested
+func decodeMetadataResponse(msg []byte, correlationID int32) (*kafkaMetadata, error) {
+	d := &kafkaDecoder{buf: msg}
+	if id := d.int32(); d.err == nil && id != correlationID {
+		return nil, moerr.NewInternalErrorNoCtx("unexpected kafka correlation id %d, want %d", id, correlationID)
+	}
+	brokers := make(map[int32]string)
+	for i, n := int32(0), d.int32(); i < n && d.err == nil; i++ {
+		id := d.int32()
+		host := d.string()
+		port := d.int32()
+		d.string() // rack
+		brokers[id] = net.JoinHostPort(host, strconv.Itoa(int(port)))
+	}
+	d.int32() // controller id
+	meta := &kafkaMetadata{}
+	for i, n := int32(0), d.int32(); i < n && d.err == nil; i++ {
+		meta.code = d.int16()
+		d.string() // name
+		d.int8()   // is internal
+		partitions := d.int32()
+		if d.err == nil && (partitions < 0 || int(partitions) > len(d.buf)) {
+			return nil, moerr.NewInternalErrorNoCtx("malformed kafka message")
+		}
+		meta.leaders = make([]string, partitions)
+		for j := int32(0); j < partitions && d.err == nil; j++ {
+			d.int16() // error code
+			id := d.int32()
+			leader := d.int32()
+			for k, replicas := int32(0), d.int32(); k < replicas && d.err == nil; k++ {
+				d.int32()
+			}
+			for k, isr := int32(0), d.int32(); k < isr && d.err == nil; k++ {
+				d.int32()
+			}
+			if id >= 0 && id < partitions {
+				meta.leaders[id] = brokers[leader]
+			}
+		}
+	}
+	return meta, d.err
+}
+
+// kafkaPartition returns the partition of the key like the default partitioner
+// of the Kafka clients, so the consumers see the same partitions of the keys.
+func kafkaPartition(key []byte, partitions int) int32 {
+	return int32((murmur2(key) & 0x7fffffff) % uint32(partitions))
+}
+
+// murmur2 is the 32 bits murmur2 hash used by the Kafka clients
+func murmur2(data []byte) uint32 {
+	const (
+		seed = 0x9747b28c
+		m    = 0x5bd1e995
+		r    = 24
+	)
+	n := len(data)
+	h := uint32(seed) ^ uint32(n)
+	for i := 0; i+4 <= n; i += 4 {
+		k := binary.LittleEndian.Uint32(data[i:])
+		k *= m
+		k ^= k >> r
+		k *= m
+		h *= m
+		h ^= k
+	}
+	tail := data[n&^3:]
+	switch len(tail) {
+	case 3:
+		h ^= uint32(tail[2]) << 16
+		fallthrough
+	case 2:
+		h ^= uint32(tail[1]) << 8
+		fallthrough
+	case 1:
+		h ^= uint32(tail[0])
+		h *= m
+	}
+	h ^= h >> 13
+	h *= m
+	h ^= h >> 15
+	return h
+}
+
+type kafkaDecoder struct {
+	buf []byte
+	err error
+}
+
+func (d *kafkaDecoder) next(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if n < 0 || len(d.buf) < n {
+		d.err = moerr.NewInternalErrorNoCtx("malformed kafka message")
+		return nil
+	}
+	v := d.buf[:n]
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *kafkaDecoder) int8() int8 {
+	if v := d.next(1); v != nil {
+		return int8(v[0])
+	}
+	return 0
+}
+
+func (d *kafkaDecoder) int16() int16 {
+	if v := d.next(2); v != nil {
+		return int16(binary.BigEndian.Uint16(v))
+	}
+	return 0
+}
+
+func (d *kafkaDecoder) int32() int32 {
+	if v := d.next(4); v != nil {
+		return int32(binary.BigEndian.Uint32(v))
+	}
+	return 0
+}
+
+func (d *kafkaDecoder) int64() int64 {
+	if v := d.next(8); v != nil {
+		return int64(binary.BigEndian.Uint64(v))
+	}
+	return 0
+}
+
+func (d *kafkaDecoder) varint() int64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(d.buf)
+	if n <= 0 {
+		d.err = moerr.NewInternalErrorNoCtx("malformed kafka message")
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *kafkaDecoder) string() string {
+	n := d.int16()
+	if n < 0 {
+		return ""
+	}
+	return string(d.next(int(n)))
+}
+
+func (d *kafkaDecoder) varbytes() []byte {
+	n := d.varint()
+	if n < 0 {
+		return nil
+	}
+	return d.next(int(n))
+}
+
+// readKafkaMessage reads a size delimited message
+func readKafkaMessage(r io.Reader) ([]byte, error) {
+	var size [4]byte
+	if _, err := io.ReadFull(r, size[:]); err != nil {
+		return nil, err
+	}
+	msg := make([]byte, binary.BigEndian.Uint32(size[:]))
+	if _, err := io.ReadFull(r, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// decodeProduceResponse returns the error code and the base offset of the
+// partition produced to.
+func decodeProduceResponse(msg []byte, correlationID int32) (int16, int64, error) {
+	d := &kafkaDecoder{buf: msg}
+	if id := d.int32(); d.err == nil && id != correlationID {
+		return 0, 0, moerr.NewInternalErrorNoCtx("unexpected kafka correlation id %d, want %d", id, correlationID)
+	}
+	var (
+		code   int16
+		offset int64
+	)
+	for i, topics := int32(0), d.int32(); i < topics; i++ {
+		d.string()
+		for j, partitions := int32(0), d.int32(); j < partitions; j++ {
+			d.int32()
+			code = d.int16()
+			offset = d.int64()
+			d.int64() // log append time
+		}
+	}
+	d.int32() // throttle time
+	return code, offset, d.err
+}
@@ -0,0 +1,286 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+)
+
+const (
+	kafkaClientID       = "matrixone-cdc"
+	kafkaAcksAll        = -1
+	kafkaDialTimeout    = 10 * time.Second
+	kafkaProduceTimeout = 30 * time.Second
+	kafkaMaxRetries     = 5
+	kafkaRetryBackoff   = 500 * time.Millisecond
+)
+
+// kafkaSink produces the changes to a Kafka topic, a record per change. The key
+// of a record is the primary key in JSON, and the value is the change in JSON or
+// an Avro record. The records are produced to the partition of the key like the
+// Kafka clients do, or the partition in the URI. The changes of the tables
+// without primary key are keyed by the table name to keep their order.
+//
+// The leaders of the partitions are looked up from the brokers in the URI, and
+// looked up again on the errors of the leadership or the connections, then the
+// records not produced yet are retried. The changes are delivered at least once,
+// a failed Send is retried by the changefeed from its last checkpoint.
+type kafkaSink struct {
+	brokers []string
+	topic   string
+	// partition is the partition to produce to, -1 for the partitions of the keys
+	partition int32
+	format    string
+
+	// leaders of the partitions by the last metadata, nil if it is to be refreshed
+	leaders []string
+	conns   map[string]*kafkaConn
+}
+
+type kafkaConn struct {
+	conn          net.Conn
+	correlationID int32
+}
+
+func NewKafkaSink(ctx context.Context, u *url.URL, format string) (Sink, error) {
+	topic := strings.Trim(u.Path, "/")
+	if u.Host == "" || topic == "" {
+		return nil, moerr.NewInvalidInput(ctx, "kafka sink needs the brokers and the topic")
+	}
+	partition := int64(-1)
+	if p := u.Query().Get("partition"); p != "" {
+		var err error
+		if partition, err = strconv.ParseInt(p, 10, 32); err != nil || partition < 0 {
+			return nil, moerr.NewInvalidInput(ctx, "invalid kafka partition %s", p)
+		}
+	}
+	return &kafkaSink{
+		brokers:   strings.Split(u.Host, ","),
+		topic:     topic,
+		partition: int32(partition),
+		format:    format,
+		conns:     make(map[string]*kafkaConn),
+	}, nil
+}
+
+func (s *kafkaSink) Send(ctx context.Context, changes []*Change) (err error) {
+	if len(changes) == 0 {
+		return nil
+	}
+	records := make([]kafkaRecord, 0, len(changes))
+	for _, c := range changes {
+		var r kafkaRecord
+		if c.PK != nil {
+			if r.Key, err = json.Marshal(c.PK); err != nil {
+				return err
+			}
+		}
+		if s.format == FormatAvro {
+			r.Value = encodeAvroChanges([]*Change{c})
+		} else if r.Value, err = json.Marshal(c); err != nil {
+			return err
+		}
+		records = append(records, r)
+	}
+	ts := changes[len(changes)-1].TS.Physical() / int64(time.Millisecond)
+
+	var pending map[int32][]kafkaRecord
+	for retry := 0; ; retry++ {
+		if retry > 0 {
+			logutil.Warnf("[Kafka] retry producing to %s for the %d time: %v", s.topic, retry, err)
+			s.leaders = nil
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(kafkaRetryBackoff * time.Duration(retry)):
+			}
+		}
+		var retriable bool
+		if s.leaders == nil {
+			retriable, err = s.refreshMetadata(ctx)
+		}
+		if err == nil {
+			if pending == nil {
+				if pending, err = s.partitionRecords(ctx, changes, records); err != nil {
+					return err
+				}
+			}
+			retriable, err = s.produce(ctx, pending, ts)
+		}
+		if err == nil || !retriable || retry == kafkaMaxRetries {
+			return err
+		}
+	}
+}
+
+// partitionRecords groups the records by the partitions
+func (s *kafkaSink) partitionRecords(
+	ctx context.Context,
+	changes []*Change,
+	records []kafkaRecord) (map[int32][]kafkaRecord, error) {
+	partitions := make(map[int32][]kafkaRecord)
+	if s.partition >= 0 {
+		if int(s.partition) >= len(s.leaders) {
+			return nil, moerr.NewInvalidInput(ctx, "kafka topic %s has no partition %d", s.topic, s.partition)
+		}
+		partitions[s.partition] = records
+		return partitions, nil
+	}
+	for i, r := range records {
+		key := r.Key
+		if key == nil {
+			key = []byte(changes[i].Database + "." + changes[i].Table)
+		}
+		p := kafkaPartition(key, len(s.leaders))
+		partitions[p] = append(partitions[p], r)
+	}
+	return partitions, nil
+}
+
+// produce produces the records to the leaders of their partitions, the
+// partitions produced are removed from pending. It returns whether the error
+// can be retried.
+func (s *kafkaSink) produce(ctx context.Context, pending map[int32][]kafkaRecord, ts int64) (bool, error) {
+	partitions := make([]int32, 0, len(pending))
+	for p := range pending {
+		partitions = append(partitions, p)
+	}
+	sort.Slice(partitions, func(i, j int) bool { return partitions[i] < partitions[j] })
+	for _, p := range partitions {
+		leader := s.leaders[p]
+		if leader == "" {
+			return true, moerr.NewInternalError(ctx, "kafka partition %s-%d has no leader", s.topic, p)
+		}
+		batch := encodeRecordBatch(pending[p], ts)
+		var correlationID int32
+		resp, err := s.roundTrip(ctx, leader, func(id int32) []byte {
+			correlationID = id
+			return encodeProduceRequest(id, kafkaClientID, kafkaAcksAll,
+				int32(kafkaProduceTimeout/time.Millisecond), s.topic, p, batch)
+		})
+		if err != nil {
+			return true, err
+		}
+		code, _, err := decodeProduceResponse(resp, correlationID)
+		if err != nil {
+			s.closeConn(leader)
+			return true, err
+		}
+		if code != 0 {
+			return kafkaRetriable(code), moerr.NewInternalError(ctx, "kafka produce to %s-%d error code %d", s.topic, p, code)
+		}
+		delete(pending, p)
+	}
+	return false, nil
+}
+
+// refreshMetadata looks up the leaders of the partitions from the brokers in
+// the URI, or the leaders known. It returns whether the error can be retried.
+func (s *kafkaSink) refreshMetadata(ctx context.Context) (bool, error) {
+	addrs := append([]string{}, s.brokers...)
+	for _, leader := range s.leaders {
+		if leader != "" {
+			addrs = append(addrs, leader)
+		}
+	}
+	var err error
+	for _, addr := range addrs {
+		var (
+			resp          []byte
+			correlationID int32
+			meta          *kafkaMetadata
+		)
+		if resp, err = s.roundTrip(ctx, addr, func(id int32) []byte {
+			correlationID = id
+			return encodeMetadataRequest(id, kafkaClientID, s.topic)
+		}); err != nil {
+			continue
+		}
+		if meta, err = decodeMetadataResponse(resp, correlationID); err != nil {
+			s.closeConn(addr)
+			continue
+		}
+		if meta.code != 0 {
+			return kafkaRetriable(meta.code), moerr.NewInternalError(ctx, "kafka metadata of %s error code %d", s.topic, meta.code)
+		}
+		if len(meta.leaders) == 0 {
+			return true, moerr.NewInternalError(ctx, "kafka topic %s has no partition", s.topic)
+		}
+		s.leaders = meta.leaders
+		return false, nil
+	}
+	return true, err
+}
+
+// roundTrip sends the request built with the next correlation id to the broker,
+// and reads the response. The connection is closed on any error, for it is out
+// of sync.
+func (s *kafkaSink) roundTrip(ctx context.Context, addr string, build func(int32) []byte) (resp []byte, err error) {
+	c, ok := s.conns[addr]
+	if !ok {
+		dialer := net.Dialer{Timeout: kafkaDialTimeout}
+		conn, err := dialer.DialContext(ctx, "tcp", addr)
+		if err != nil {
+			return nil, err
+		}
+		c = &kafkaConn{conn: conn}
+		s.conns[addr] = c
+	}
+	defer func() {
+		if err != nil {
+			s.closeConn(addr)
+		}
+	}()
+	deadline := time.Now().Add(kafkaProduceTimeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	if err = c.conn.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+	c.correlationID++
+	if _, err = c.conn.Write(build(c.correlationID)); err != nil {
+		return nil, err
+	}
+	return readKafkaMessage(c.conn)
+}
+
+func (s *kafkaSink) closeConn(addr string) {
+	if c, ok := s.conns[addr]; ok {
+		c.conn.Close()
+		delete(s.conns, addr)
+	}
+}
+
+func (s *kafkaSink) Close() error {
+	var err error
+	for addr, c := range s.conns {
+		if e := c.conn.Close(); e != nil && err == nil {
+			err = e
+		}
+		delete(s.conns, addr)
+	}
+	return err
+}
@@ -0,0 +1,100 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+// MViewSpec is the definition of an incrementally maintained materialized
+// view, which is a filter, a projection or an aggregation on a table. The
+// result of the view is kept in a state table, a row of which is a group of
+// the aggregation, or a row of the table projected. The state table is kept
+// fresh by applying the changes of the table captured by a changefeed.
+//
+// The columns of the state table are MViewKeyCol, the primary key which is
+// the JSON array of the values of Keys, MViewTSCol, the commit timestamp of
+// the last change applied to the row, MViewCountCol, the number of the rows
+// of the table in the group, and Columns. The row of MViewWatermarkKey is not
+// a group but the watermark of the state table, see MViewWatermarkKey.
+type MViewSpec struct {
+	// Base is the table of the view, in the form of database.table
+	Base string `json:"base"`
+	// State is the state table, in the form of database.table
+	State string `json:"state"`
+	// Filter is nil if the view has no WHERE clause
+	Filter *MViewExpr `json:"filter,omitempty"`
+	// Aggregate is true if the view has GROUP BY or aggregate functions,
+	// Keys are the GROUP BY columns then. Otherwise, Keys are the primary
+	// key columns of the table.
+	Aggregate bool          `json:"aggregate"`
+	Keys      []string      `json:"keys"`
+	Columns   []MViewColumn `json:"columns"`
+}
+
+const (
+	MViewKeyCol   = "__mo_mv_key"
+	MViewTSCol    = "__mo_mv_ts"
+	MViewCountCol = "__mo_mv_count"
+)
+
+// MViewWatermarkKey is the key of the row of the state table whose MViewTSCol
+// is the commit timestamp of the last change of the table applied, even if the
+// change is filtered out by the view. The state table has all the changes of
+// the table committed at or before the watermark. The key never collides with
+// the key of a group, which is a JSON array, and the view skips the row.
+const MViewWatermarkKey = ""
+
+// MViewFunc is how a column of the state table is computed from the rows of
+// the table in a group
+type MViewFunc string
+
+const (
+	// MViewValue is the value of the column Arg, which is a GROUP BY column
+	// or a column projected
+	MViewValue MViewFunc = ""
+	// MViewCount is the number of the values of Arg that are not null
+	MViewCount MViewFunc = "count"
+	MViewSum   MViewFunc = "sum"
+	MViewMin   MViewFunc = "min"
+	MViewMax   MViewFunc = "max"
+)
+
+type MViewColumn struct {
+	// Name is the name of the column in the state table
+	Name string    `json:"name"`
+	Func MViewFunc `json:"func,omitempty"`
+	Arg  string    `json:"arg"`
+}
+
+// MViewExpr is a filter on the rows of the table. It is a comparison between
+// the column Col and the literal Value, a NULL test on Col, or a logical
+// operation on Args.
+type MViewExpr struct {
+	Op    string       `json:"op"`
+	Col   string       `json:"col,omitempty"`
+	Value string       `json:"value,omitempty"`
+	Args  []*MViewExpr `json:"args,omitempty"`
+}
+
+const (
+	MViewOpAnd       = "and"
+	MViewOpOr        = "or"
+	MViewOpNot       = "not"
+	MViewOpIsNull    = "is null"
+	MViewOpIsNotNull = "is not null"
+	MViewOpEQ        = "="
+	MViewOpNE        = "<>"
+	MViewOpLT        = "<"
+	MViewOpLE        = "<="
+	MViewOpGT        = ">"
+	MViewOpGE        = ">="
+)
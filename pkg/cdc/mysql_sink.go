@@ -0,0 +1,173 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// execer is the part of *sql.Conn used by the MySQL sink
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	Close() error
+}
+
+// mysqlSink applies the changes to a MySQL compatible downstream, a
+// transaction per Send. The rows are upserted by REPLACE and deleted by the
+// primary key, so applying the same changes again is harmless. The changes of
+// the tables without primary key are applied by all the columns of the before
+// image, one row a time.
+type mysqlSink struct {
+	db *sql.DB
+	// database overrides the database of the changes if not empty
+	database string
+	conn     func(ctx context.Context) (execer, error)
+}
+
+func NewMySQLSink(ctx context.Context, u *url.URL) (Sink, error) {
+	cfg := mysql.NewConfig()
+	cfg.Net = "tcp"
+	cfg.Addr = u.Host
+	if u.User != nil {
+		cfg.User = u.User.Username()
+		cfg.Passwd, _ = u.User.Password()
+	}
+	db, err := sql.Open("mysql", cfg.FormatDSN())
+	if err != nil {
+		return nil, err
+	}
+	if err = db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, err
+	}
+	s := &mysqlSink{
+		db:       db,
+		database: strings.Trim(u.Path, "/"),
+	}
+	s.conn = func(ctx context.Context) (execer, error) {
+		return s.db.Conn(ctx)
+	}
+	return s, nil
+}
+
+func (s *mysqlSink) Send(ctx context.Context, changes []*Change) (err error) {
+	if len(changes) == 0 {
+		return nil
+	}
+	conn, err := s.conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if _, err = conn.ExecContext(ctx, "BEGIN"); err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			conn.ExecContext(ctx, "ROLLBACK")
+		}
+	}()
+	for _, c := range changes {
+		table := s.tableName(c)
+		if c.Op != OpInsert && (c.Op == OpDelete || c.PK == nil) {
+			stmt, args := deleteStmt(table, c)
+			if err = exec(ctx, conn, stmt, args); err != nil {
+				return err
+			}
+		}
+		if c.Op != OpDelete {
+			stmt, args := upsertStmt(table, c)
+			if err = exec(ctx, conn, stmt, args); err != nil {
+				return err
+			}
+		}
+	}
+	_, err = conn.ExecContext(ctx, "COMMIT")
+	return err
+}
+
+func exec(ctx context.Context, conn execer, stmt string, args []any) error {
+	if _, err := conn.ExecContext(ctx, stmt, args...); err != nil {
+		return moerr.NewInternalError(ctx, "cdc mysql sink: %s: %v", stmt, err)
+	}
+	return nil
+}
+
+func (s *mysqlSink) tableName(c *Change) string {
+	database := c.Database
+	if s.database != "" {
+		database = s.database
+	}
+	return quoteIdent(database) + "." + quoteIdent(c.Table)
+}
+
+func (s *mysqlSink) Close() error {
+	return s.db.Close()
+}
+
+func quoteIdent(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+// upsertStmt returns the statement to write the after image. The rows of the
+// tables without primary key are inserted, because there is nothing for
+// REPLACE to match.
+func upsertStmt(table string, c *Change) (string, []any) {
+	var (
+		buf  strings.Builder
+		args = make([]any, 0, len(c.After))
+	)
+	if c.PK != nil {
+		buf.WriteString("REPLACE INTO ")
+	} else {
+		buf.WriteString("INSERT INTO ")
+	}
+	buf.WriteString(table)
+	buf.WriteString(" (")
+	for i, col := range c.After {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(quoteIdent(col.Name))
+		args = append(args, col.Value)
+	}
+	buf.WriteString(") VALUES (")
+	buf.WriteString(strings.TrimSuffix(strings.Repeat("?, ", len(c.After)), ", "))
+	buf.WriteString(")")
+	return buf.String(), args
+}
+
+// deleteStmt returns the statement to delete the before image, by the primary
+// key if any.
+func deleteStmt(table string, c *Change) (string, []any) {
+	cols, limit := c.PK, ""
+	if cols == nil {
+		cols, limit = c.Before, " LIMIT 1"
+	}
+	conds := make([]string, 0, len(cols))
+	args := make([]any, 0, len(cols))
+	for _, col := range cols {
+		conds = append(conds, fmt.Sprintf("%s <=> ?", quoteIdent(col.Name)))
+		args = append(args, col.Value)
+	}
+	return fmt.Sprintf("DELETE FROM %s WHERE %s%s", table, strings.Join(conds, " AND "), limit), args
+}
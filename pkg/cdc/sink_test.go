@@ -0,0 +1,490 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/binary"
+	"encoding/json"
+	"hash/crc32"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testChanges() []*Change {
+	return []*Change{
+		{
+			Database: "db", Table: "t", TS: types.BuildTS(1000, 1), Op: OpInsert,
+			PK:    Row{{"a", int64(1)}},
+			After: Row{{"a", int64(1)}, {"b", "x"}},
+		},
+		{
+			Database: "db", Table: "t", TS: types.BuildTS(2000, 0), Op: OpUpdate,
+			PK:     Row{{"a", int64(1)}},
+			Before: Row{{"a", int64(1)}, {"b", "x"}},
+			After:  Row{{"a", int64(1)}, {"b", nil}},
+		},
+		{
+			Database: "db", Table: "t", TS: types.BuildTS(3000, 0), Op: OpDelete,
+			PK:     Row{{"a", int64(1)}},
+			Before: Row{{"a", int64(1)}, {"b", nil}},
+		},
+	}
+}
+
+func TestChangeJSON(t *testing.T) {
+	data, err := json.Marshal(testChanges()[1])
+	require.NoError(t, err)
+	assert.Equal(t,
+		`{"database":"db","table":"t","ts":"2000-0","op":"update","pk":{"a":1},"before":{"a":1,"b":"x"},"after":{"a":1,"b":null}}`,
+		string(data))
+}
+
+func TestFileSinkJSON(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "changes.json")
+	changes := testChanges()
+	for i := range changes {
+		sink, err := NewSink(ctx, "file://"+path)
+		require.NoError(t, err)
+		require.NoError(t, sink.Send(ctx, changes[i:i+1]))
+		require.NoError(t, sink.Close())
+	}
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Equal(t, 3, len(lines))
+	var c map[string]any
+	require.NoError(t, json.Unmarshal([]byte(lines[2]), &c))
+	assert.Equal(t, "delete", c["op"])
+	assert.Nil(t, c["after"])
+}
+
+// readAvroFile decodes the file written by the file sink in the Avro format
+func readAvroFile(t *testing.T, data []byte) (schema string, records []map[string]any) {
+	r := bufio.NewReader(bytes.NewReader(data))
+	w, err := openAvroWriter(r, nil)
+	require.NoError(t, err)
+	schemaStart := bytes.Index(data, []byte(`{"type"`))
+	schemaEnd := bytes.Index(data, []byte("avro.codec")) - 1
+	schema = string(data[schemaStart:schemaEnd])
+
+	readLong := func() int64 {
+		v, err := binary.ReadVarint(r)
+		require.NoError(t, err)
+		return v
+	}
+	readString := func() string {
+		b := make([]byte, readLong())
+		_, err := io.ReadFull(r, b)
+		require.NoError(t, err)
+		return string(b)
+	}
+	readRow := func() map[string]any {
+		if readLong() == 0 {
+			return nil
+		}
+		row := map[string]any{}
+		for n := readLong(); n != 0; n = readLong() {
+			for i := int64(0); i < n; i++ {
+				name := readString()
+				switch readLong() {
+				case avroNull:
+					row[name] = nil
+				case avroLong:
+					row[name] = readLong()
+				case avroString:
+					row[name] = readString()
+				default:
+					t.Fatal("unexpected type")
+				}
+			}
+		}
+		return row
+	}
+	for {
+		count, err := binary.ReadVarint(r)
+		if err != nil {
+			break
+		}
+		readLong() // size
+		for i := int64(0); i < count; i++ {
+			records = append(records, map[string]any{
+				"database": readString(),
+				"table":    readString(),
+				"ts":       readString(),
+				"op":       readLong(),
+				"pk":       readRow(),
+				"before":   readRow(),
+				"after":    readRow(),
+			})
+		}
+		sync := make([]byte, avroSyncSize)
+		_, err = io.ReadFull(r, sync)
+		require.NoError(t, err)
+		require.Equal(t, w.sync, sync)
+	}
+	return
+}
+
+func TestFileSinkAvro(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "changes.avro")
+	changes := testChanges()
+	sink, err := NewSink(ctx, "file://"+path+"?format=avro")
+	require.NoError(t, err)
+	require.NoError(t, sink.Send(ctx, changes[:2]))
+	require.NoError(t, sink.Close())
+	// appends to the existing file with the same sync marker
+	sink, err = NewSink(ctx, "file://"+path+"?format=avro")
+	require.NoError(t, err)
+	require.NoError(t, sink.Send(ctx, changes[2:]))
+	require.NoError(t, sink.Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	schema, records := readAvroFile(t, data)
+	assert.Equal(t, avroSchema, schema)
+	assert.True(t, json.Valid([]byte(schema)))
+	require.Equal(t, 3, len(records))
+	assert.Equal(t, "1000-1", records[0]["ts"])
+	assert.Equal(t, int64(1), records[1]["op"])
+	assert.Equal(t, map[string]any{"a": int64(1), "b": "x"}, records[1]["before"])
+	assert.Equal(t, map[string]any{"a": int64(1), "b": nil}, records[1]["after"])
+	assert.Nil(t, records[2]["after"])
+}
+
+type fakeConn struct {
+	stmts []string
+	args  [][]any
+	fail  string
+}
+
+func (c *fakeConn) ExecContext(_ context.Context, query string, args ...any) (sql.Result, error) {
+	c.stmts = append(c.stmts, query)
+	c.args = append(c.args, args)
+	if c.fail != "" && strings.HasPrefix(query, c.fail) {
+		return nil, sql.ErrConnDone
+	}
+	return nil, nil
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func TestMySQLSink(t *testing.T) {
+	ctx := context.Background()
+	conn := &fakeConn{}
+	sink := &mysqlSink{
+		database: "downstream",
+		conn: func(context.Context) (execer, error) {
+			return conn, nil
+		},
+	}
+	require.NoError(t, sink.Send(ctx, testChanges()))
+	assert.Equal(t, []string{
+		"BEGIN",
+		"REPLACE INTO `downstream`.`t` (`a`, `b`) VALUES (?, ?)",
+		"REPLACE INTO `downstream`.`t` (`a`, `b`) VALUES (?, ?)",
+		"DELETE FROM `downstream`.`t` WHERE `a` <=> ?",
+		"COMMIT",
+	}, conn.stmts)
+	assert.Equal(t, []any{int64(1), nil}, conn.args[2])
+
+	// the tables without primary key
+	changes := testChanges()
+	for _, c := range changes {
+		c.PK = nil
+	}
+	conn.stmts = nil
+	sink.database = ""
+	require.NoError(t, sink.Send(ctx, changes[1:]))
+	assert.Equal(t, []string{
+		"BEGIN",
+		"DELETE FROM `db`.`t` WHERE `a` <=> ? AND `b` <=> ? LIMIT 1",
+		"INSERT INTO `db`.`t` (`a`, `b`) VALUES (?, ?)",
+		"DELETE FROM `db`.`t` WHERE `a` <=> ? AND `b` <=> ? LIMIT 1",
+		"COMMIT",
+	}, conn.stmts)
+
+	conn.stmts = nil
+	conn.fail = "REPLACE"
+	require.Error(t, sink.Send(ctx, testChanges()))
+	assert.Equal(t, "ROLLBACK", conn.stmts[len(conn.stmts)-1])
+}
+
+type kafkaProduced struct {
+	topic     string
+	partition int32
+	records   []kafkaRecord
+}
+
+// kafkaStandIn serves the Metadata and Produce requests like a Kafka broker,
+// which is the leader of all the partitions of the topics.
+type kafkaStandIn struct {
+	t          *testing.T
+	l          net.Listener
+	partitions int32
+	produced   chan kafkaProduced
+	// notLeader is the number of the Produce requests to fail with
+	// kafkaNotLeaderOrFollower
+	notLeader atomic.Int32
+	metadata  atomic.Int32
+}
+
+func (k *kafkaStandIn) run() {
+	for {
+		conn, err := k.l.Accept()
+		if err != nil {
+			return
+		}
+		go k.serve(conn)
+	}
+}
+
+func (k *kafkaStandIn) serve(conn net.Conn) {
+	t := k.t
+	defer conn.Close()
+	var offset int64
+	for {
+		msg, err := readKafkaMessage(conn)
+		if err != nil {
+			return
+		}
+		d := &kafkaDecoder{buf: msg}
+		key := d.int16()
+		version := d.int16()
+		correlationID := d.int32()
+		assert.Equal(t, kafkaClientID, d.string())
+		e := &kafkaEncoder{}
+		e.int32(0)
+		e.int32(correlationID)
+		if key == kafkaMetadataKey {
+			assert.Equal(t, int16(kafkaMetadataVersion), version)
+			assert.Equal(t, int32(1), d.int32())
+			topic := d.string()
+			require.NoError(t, d.err)
+			k.metadata.Add(1)
+			host, port, err := net.SplitHostPort(k.l.Addr().String())
+			require.NoError(t, err)
+			p, err := strconv.Atoi(port)
+			require.NoError(t, err)
+			e.int32(1)
+			e.int32(1)
+			e.string(host)
+			e.int32(int32(p))
+			e.nullableString(nil)
+			e.int32(1)
+			e.int32(1)
+			e.int16(0)
+			e.string(topic)
+			e.int8(0)
+			e.int32(k.partitions)
+			for i := int32(0); i < k.partitions; i++ {
+				e.int16(0)
+				e.int32(i)
+				e.int32(1)
+				e.int32(1)
+				e.int32(1)
+				e.int32(1)
+				e.int32(1)
+			}
+			binary.BigEndian.PutUint32(e.buf, uint32(len(e.buf)-4))
+			_, err = conn.Write(e.buf)
+			require.NoError(t, err)
+			continue
+		}
+
+		assert.Equal(t, int16(kafkaProduceKey), key)
+		assert.Equal(t, int16(kafkaProduceVersion), version)
+		assert.Equal(t, int16(-1), d.int16())
+		assert.Equal(t, int16(kafkaAcksAll), d.int16())
+		d.int32()
+		assert.Equal(t, int32(1), d.int32())
+		p := kafkaProduced{topic: d.string()}
+		assert.Equal(t, int32(1), d.int32())
+		p.partition = d.int32()
+		batch := d.next(int(d.int32()))
+		require.NoError(t, d.err)
+
+		d = &kafkaDecoder{buf: batch}
+		d.int64()
+		assert.Equal(t, int(d.int32()), len(batch)-12)
+		d.int32()
+		assert.Equal(t, int8(2), d.int8())
+		assert.Equal(t, crc32.Checksum(batch[kafkaBatchCRCStart:], castagnoli), uint32(d.int32()))
+		d.int16()
+		d.int32()
+		d.int64()
+		d.int64()
+		d.int64()
+		d.int16()
+		d.int32()
+		for i, n := int32(0), d.int32(); i < n; i++ {
+			d.varint()
+			d.int8()
+			d.varint()
+			assert.Equal(t, int64(i), d.varint())
+			r := kafkaRecord{Key: d.varbytes(), Value: d.varbytes()}
+			assert.Equal(t, int64(0), d.varint())
+			p.records = append(p.records, r)
+		}
+		require.NoError(t, d.err)
+		code := int16(0)
+		if k.notLeader.Add(-1) >= 0 {
+			code = kafkaNotLeaderOrFollower
+		} else {
+			k.produced <- p
+		}
+
+		e.int32(1)
+		e.string(p.topic)
+		e.int32(1)
+		e.int32(p.partition)
+		e.int16(code)
+		e.int64(offset)
+		e.int64(-1)
+		e.int32(0)
+		binary.BigEndian.PutUint32(e.buf, uint32(len(e.buf)-4))
+		_, err = conn.Write(e.buf)
+		require.NoError(t, err)
+		if code == 0 {
+			offset += int64(len(p.records))
+		}
+	}
+}
+
+func newKafkaStandIn(t *testing.T, partitions int32) *kafkaStandIn {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	k := &kafkaStandIn{
+		t:          t,
+		l:          l,
+		partitions: partitions,
+		produced:   make(chan kafkaProduced, 16),
+	}
+	go k.run()
+	return k
+}
+
+func TestKafkaSink(t *testing.T) {
+	ctx := context.Background()
+	k := newKafkaStandIn(t, 3)
+	defer k.l.Close()
+
+	// the first broker is down, the leaders are looked up from the second one
+	down, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	require.NoError(t, down.Close())
+	sink, err := NewSink(ctx, "kafka://"+down.Addr().String()+","+k.l.Addr().String()+"/changes?partition=2")
+	require.NoError(t, err)
+	defer sink.Close()
+	changes := testChanges()
+	require.NoError(t, sink.Send(ctx, changes[:2]))
+	require.NoError(t, sink.Send(ctx, changes[2:]))
+
+	p := <-k.produced
+	assert.Equal(t, "changes", p.topic)
+	assert.Equal(t, int32(2), p.partition)
+	require.Equal(t, 2, len(p.records))
+	assert.Equal(t, `{"a":1}`, string(p.records[0].Key))
+	value, err := json.Marshal(changes[1])
+	require.NoError(t, err)
+	assert.Equal(t, value, p.records[1].Value)
+	p = <-k.produced
+	require.Equal(t, 1, len(p.records))
+	assert.Equal(t, int32(1), k.metadata.Load())
+
+	// the partition is not in the topic
+	sink, err = NewSink(ctx, "kafka://"+k.l.Addr().String()+"/changes?partition=3")
+	require.NoError(t, err)
+	defer sink.Close()
+	require.Error(t, sink.Send(ctx, changes))
+}
+
+func TestKafkaSinkPartitions(t *testing.T) {
+	ctx := context.Background()
+	k := newKafkaStandIn(t, 2)
+	defer k.l.Close()
+	sink, err := NewSink(ctx, "kafka://"+k.l.Addr().String()+"/changes")
+	require.NoError(t, err)
+	defer sink.Close()
+
+	// the leader changes, the records are produced after the metadata is refreshed
+	k.notLeader.Store(1)
+	changes := append(testChanges(), &Change{
+		Database: "db", Table: "t", TS: types.BuildTS(4000, 0), Op: OpInsert,
+		After: Row{{"b", "y"}},
+	})
+	require.NoError(t, sink.Send(ctx, changes))
+	assert.Equal(t, int32(2), k.metadata.Load())
+
+	produced := 0
+	for produced < len(changes) {
+		p := <-k.produced
+		for _, r := range p.records {
+			key := r.Key
+			if key == nil {
+				key = []byte("db.t")
+			}
+			assert.Equal(t, kafkaPartition(key, 2), p.partition)
+		}
+		produced += len(p.records)
+	}
+	assert.Equal(t, len(changes), produced)
+}
+
+func TestMurmur2(t *testing.T) {
+	// the hashes by the Kafka clients
+	for key, hash := range map[string]int32{
+		"21":                         -973932308,
+		"foobar":                     -790332482,
+		"a-little-bit-long-string":   -985981536,
+		"a-little-bit-longer-string": -1486304829,
+		"lkjh234lh9fiuh90y23oiuhsafujhadof229phr9h19h89h8": -58897971,
+		"abc": 479470107,
+	} {
+		assert.Equal(t, hash, int32(murmur2([]byte(key))), key)
+	}
+}
+
+func TestNewSink(t *testing.T) {
+	ctx := context.Background()
+	_, err := NewSink(ctx, "hdfs://a/b")
+	assert.Error(t, err)
+	_, err = NewSink(ctx, "file:///tmp/x?format=csv")
+	assert.Error(t, err)
+	_, err = NewSink(ctx, "kafka://127.0.0.1:9092")
+	assert.Error(t, err)
+	u, err := url.Parse("kafka://127.0.0.1:9092/topic?partition=x")
+	require.NoError(t, err)
+	_, err = NewKafkaSink(ctx, u, FormatJSON)
+	assert.Error(t, err)
+}
@@ -0,0 +1,114 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// Op is the operation type of a change
+type Op string
+
+const (
+	OpInsert Op = "insert"
+	OpUpdate Op = "update"
+	OpDelete Op = "delete"
+)
+
+// Column is a column value of a row image. Value is one of nil, bool, int64,
+// uint64, float64 and string.
+type Column struct {
+	Name  string
+	Value any
+}
+
+// Row is a row image, the columns are in the order of the table definition.
+type Row []Column
+
+// Get returns the value of the column named name
+func (r Row) Get(name string) (any, bool) {
+	for _, col := range r {
+		if col.Name == name {
+			return col.Value, true
+		}
+	}
+	return nil, false
+}
+
+// MarshalJSON encodes the row as a JSON object keeping the column order
+func (r Row) MarshalJSON() ([]byte, error) {
+	if r == nil {
+		return []byte("null"), nil
+	}
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, col := range r {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, err := json.Marshal(col.Name)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		value, err := json.Marshal(col.Value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// Change is a row change of a table. Before is nil for an insert, and After is
+// nil for a delete. PK is the primary key of the row, or nil if the table has
+// no primary key.
+type Change struct {
+	Database string
+	Table    string
+	// TS is the commit timestamp of the change
+	TS     types.TS
+	Op     Op
+	PK     Row
+	Before Row
+	After  Row
+}
+
+type jsonChange struct {
+	Database string `json:"database"`
+	Table    string `json:"table"`
+	TS       string `json:"ts"`
+	Op       Op     `json:"op"`
+	PK       Row    `json:"pk"`
+	Before   Row    `json:"before"`
+	After    Row    `json:"after"`
+}
+
+func (c *Change) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&jsonChange{
+		Database: c.Database,
+		Table:    c.Table,
+		TS:       c.TS.ToString(),
+		Op:       c.Op,
+		PK:       c.PK,
+		Before:   c.Before,
+		After:    c.After,
+	})
+}
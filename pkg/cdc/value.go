@@ -0,0 +1,79 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// FormatValue converts the value of a column of type typ to one of the types
+// of Column.Value. The temporal and decimal values are formatted as strings
+// in the same way as the query results.
+func FormatValue(typ types.Type, v any) any {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case bool:
+		return v
+	case int8:
+		return int64(v)
+	case int16:
+		return int64(v)
+	case int32:
+		return int64(v)
+	case int64:
+		return v
+	case uint8:
+		return uint64(v)
+	case uint16:
+		return uint64(v)
+	case uint32:
+		return uint64(v)
+	case uint64:
+		return v
+	case float32:
+		return float64(v)
+	case float64:
+		return v
+	case []byte:
+		if typ.Oid == types.T_json {
+			return types.DecodeJson(v).String()
+		}
+		return string(v)
+	case string:
+		return v
+	case types.Decimal64:
+		return v.Format(typ.Scale)
+	case types.Decimal128:
+		return v.Format(typ.Scale)
+	case types.Decimal256:
+		return v.Format(typ.Scale)
+	case types.Date:
+		return v.String()
+	case types.Time:
+		return v.String2(typ.Scale)
+	case types.Datetime:
+		return v.String2(typ.Scale)
+	case types.Timestamp:
+		return v.String2(time.UTC, typ.Scale)
+	case types.Uuid:
+		return v.ToString()
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatValue(t *testing.T) {
+	assert.Nil(t, FormatValue(types.T_int32.ToType(), nil))
+	assert.Equal(t, int64(-1), FormatValue(types.T_int8.ToType(), int8(-1)))
+	assert.Equal(t, uint64(1), FormatValue(types.T_uint16.ToType(), uint16(1)))
+	assert.Equal(t, float64(0.5), FormatValue(types.T_float32.ToType(), float32(0.5)))
+	assert.Equal(t, "abc", FormatValue(types.T_varchar.ToType(), []byte("abc")))
+
+	date, err := types.ParseDateCast("2023-03-01")
+	assert.NoError(t, err)
+	assert.Equal(t, "2023-03-01", FormatValue(types.T_date.ToType(), date))
+
+	typ := types.New(types.T_decimal64, 10, 2)
+	dec, err := types.ParseDecimal64("12.30", 10, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, "12.30", FormatValue(typ, dec))
+}
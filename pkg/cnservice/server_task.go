@@ -167,6 +167,7 @@ func (s *service) waitSystemInitCompleted(ctx context.Context) {
 				s.logger.Debug("waiting all init task completed",
 					zap.Int("completed", len(tasks)))
 				if len(tasks) > 0 {
+					s.upgradeTenantTables()
 					if err := file.WriteFile(s.metadataFS,
 						"./system_init_completed",
 						[]byte("OK")); err != nil {
@@ -183,6 +184,23 @@ func (s *service) waitSystemInitCompleted(ctx context.Context) {
 	}
 }
 
+// upgradeTenantTables creates the catalog tables which the accounts created by
+// the former versions miss.
+func (s *service) upgradeTenantTables() {
+	if s.mo == nil {
+		return
+	}
+	pu := config.NewParameterUnit(&s.cfg.Frontend, nil, nil, nil)
+	pu.StorageEngine = s.storeEngine
+	pu.TxnClient = s._txnClient
+	pu.FileService = s.fileService
+	pu.LockService = s.lockService
+	ctx := context.WithValue(context.Background(), config.ParameterUnitKey, pu)
+	if err := frontend.UpgradeTenantTables(ctx, s.mo.GetRoutineManager().GetAutoIncrCacheManager()); err != nil {
+		s.logger.Error("upgrade the tables of the accounts failed", zap.Error(err))
+	}
+}
+
 func (s *service) stopTask() error {
 	defer logutil.LogClose(s.logger, "cnservice/task")()
 
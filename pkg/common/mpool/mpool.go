@@ -312,6 +312,9 @@ type MPool struct {
 	pools   [NumFixedPool]fixedPool
 	details *mpoolDetails
 
+	// parent is the pool a child pool allocates from, see NewChild.
+	parent *MPool
+
 	// To remove: this thing is highly unlikely to be of any good use.
 	sels *sync.Pool
 }
@@ -404,6 +407,51 @@ func NewMPool(tag string, cap int64, flag int) (*MPool, error) {
 	return &mp, nil
 }
 
+// NewChild returns a pool which allocates from mp, and counts the bytes
+// allocated and freed through it in its own stats, so its high water mark is
+// the peak of the memory held by a part of the work using mp, like an operator
+// of a query. The memory is owned by mp, so the child is neither registered
+// nor deleted, and the memory it allocated can be freed through any pool.
+func (mp *MPool) NewChild(tag string) *MPool {
+	if mp.parent != nil {
+		mp = mp.parent
+	}
+	return &MPool{
+		id:      mp.id,
+		tag:     tag,
+		cap:     mp.cap,
+		noFixed: mp.noFixed,
+		noLock:  mp.noLock,
+		parent:  mp,
+		sels:    mp.sels,
+	}
+}
+
+// Parent returns the pool the child pool allocates from, or nil if mp is not a child.
+func (mp *MPool) Parent() *MPool {
+	return mp.parent
+}
+
+// recordChildAlloc is like RecordAlloc, but the bytes of a child pool are
+// freed by the other pools too, so the current bytes may be negative.
+func (s *MPoolStats) recordChildAlloc(sz int64) {
+	s.NumAlloc.Add(1)
+	s.NumAllocBytes.Add(sz)
+	curr := s.NumCurrBytes.Add(sz)
+	for {
+		hwm := s.HighWaterMark.Load()
+		if curr <= hwm || s.HighWaterMark.CompareAndSwap(hwm, curr) {
+			return
+		}
+	}
+}
+
+func (s *MPoolStats) recordChildFree(sz int64) {
+	s.NumFree.Add(1)
+	s.NumFreeBytes.Add(sz)
+	s.NumCurrBytes.Add(-sz)
+}
+
 func MustNew(tag string) *MPool {
 	mp, err := NewMPool(tag, 0, 0)
 	if err != nil {
@@ -504,6 +552,14 @@ func (mp *MPool) Alloc(sz int) ([]byte, error) {
 		return nil, nil
 	}
 
+	if mp.parent != nil {
+		bs, err := mp.parent.Alloc(sz)
+		if err == nil {
+			mp.stats.recordChildAlloc(int64(sz))
+		}
+		return bs, err
+	}
+
 	// if global undercap
 	gcurr := globalStats.RecordAlloc("global", int64(sz))
 	if gcurr > GlobalCap() {
@@ -557,6 +613,14 @@ func (mp *MPool) Free(bs []byte) {
 		panic(moerr.NewInternalErrorNoCtx("mp header corruption"))
 	}
 
+	if mp.parent != nil {
+		// the size must be read before the parent reuses the memory
+		sz := pHdr.allocSz
+		mp.parent.Free(bs)
+		mp.stats.recordChildFree(int64(sz))
+		return
+	}
+
 	if pHdr.poolId == mp.id {
 		if pHdr.allocSz == -1 {
 			// double free.
@@ -677,6 +741,13 @@ func (mp *MPool) Grow2(old []byte, old2 []byte, sz int) ([]byte, error) {
 }
 
 func (mp *MPool) Increase(nb int64) error {
+	if mp.parent != nil {
+		err := mp.parent.Increase(nb)
+		if err == nil {
+			mp.stats.recordChildAlloc(nb)
+		}
+		return err
+	}
 	gcurr := globalStats.RecordAlloc("global", nb)
 	if gcurr > GlobalCap() {
 		globalStats.RecordFree(mp.tag, nb)
@@ -693,6 +764,11 @@ func (mp *MPool) Increase(nb int64) error {
 }
 
 func (mp *MPool) Decrease(nb int64) {
+	if mp.parent != nil {
+		mp.parent.Decrease(nb)
+		mp.stats.recordChildFree(nb)
+		return
+	}
 	mp.stats.RecordFree(mp.tag, nb)
 	globalStats.RecordFree("global", nb)
 }
@@ -58,6 +58,38 @@ func TestMPool(t *testing.T) {
 	require.True(t, nalloc0-nfree0 == m.Stats().NumAlloc.Load()-m.Stats().NumFree.Load(), "free")
 }
 
+func TestChildMPool(t *testing.T) {
+	m, err := NewMPool("test-mpool-child", 0, 0)
+	require.NoError(t, err)
+	defer DeleteMPool(m)
+	c1 := m.NewChild("child1")
+	c2 := c1.NewChild("child2")
+	require.True(t, c2.Parent() == m)
+
+	a, err := c1.Alloc(100)
+	require.NoError(t, err)
+	b, err := c1.Alloc(2000)
+	require.NoError(t, err)
+	c1.Free(a)
+	c, err := c2.Alloc(500)
+	require.NoError(t, err)
+	require.Equal(t, int64(2500), m.Stats().HighWaterMark.Load())
+	require.Equal(t, int64(2100), c1.Stats().HighWaterMark.Load())
+	require.Equal(t, int64(500), c2.Stats().HighWaterMark.Load())
+
+	// the memory of a child can be freed through the others
+	m.Free(b)
+	c2.Free(c)
+	require.Equal(t, int64(0), m.CurrNB())
+	require.Equal(t, int64(2000), c1.CurrNB())
+	require.Equal(t, int64(0), c2.CurrNB())
+
+	require.NoError(t, c2.Increase(300))
+	require.Equal(t, int64(300), m.CurrNB())
+	c2.Decrease(300)
+	require.Equal(t, int64(0), m.CurrNB())
+}
+
 func TestReportMemUsage(t *testing.T) {
 	// Just test a mid sized
 	m, err := NewMPool("testjson", 0, 0)
@@ -142,6 +142,12 @@ var (
 	// defaultMergedExtension default: tae. Support val in [csv, tae]
 	defaultMergedExtension = "tae"
 
+	// defaultAuditLogMaxSize default: 128 MB
+	defaultAuditLogMaxSize = 128
+
+	// defaultSlowQueryLogMaxSize default: 128 MB
+	defaultSlowQueryLogMaxSize = 128
+
 	// defaultOBShowStatsInterval default: 1min
 	defaultOBShowStatsInterval = time.Minute
 
@@ -294,6 +300,9 @@ type FrontendParameters struct {
 	// default 100 (MB)
 	QueryResultMaxsize uint64 `toml:"queryResultMaxsize"`
 
+	// default 64 (MB)
+	QueryResultCacheSize uint64 `toml:"queryResultCacheSize"`
+
 	AutoIncrCacheSize uint64 `toml:"autoIncrCacheSize"`
 
 	LowerCaseTableNames string `toml:"lowerCaseTableNames"`
@@ -309,6 +318,15 @@ type FrontendParameters struct {
 	// ProxyEnabled indicates that proxy module is enabled and something extra
 	// is needed, such as update the salt.
 	ProxyEnabled bool `toml:"proxy-enabled"`
+
+	// SlowQueryLogFile default is empty. If set, the slow queries are also written into the local file in JSON.
+	SlowQueryLogFile string `toml:"slowQueryLogFile"`
+
+	// SlowQueryLogMaxSize default: 128 (MB). The slow query log file is rotated when it reaches the size.
+	SlowQueryLogMaxSize int `toml:"slowQueryLogMaxSize"`
+
+	// SlowQueryLogMaxBackups default is 0, which retains all the rotated slow query log files.
+	SlowQueryLogMaxBackups int `toml:"slowQueryLogMaxBackups"`
 }
 
 func (fp *FrontendParameters) SetDefaultValues() {
@@ -424,6 +442,10 @@ func (fp *FrontendParameters) SetDefaultValues() {
 		fp.QueryResultMaxsize = 100
 	}
 
+	if fp.QueryResultCacheSize == 0 {
+		fp.QueryResultCacheSize = 64
+	}
+
 	if fp.AutoIncrCacheSize == 0 {
 		fp.AutoIncrCacheSize = 3000
 	}
@@ -443,6 +465,10 @@ func (fp *FrontendParameters) SetDefaultValues() {
 	if fp.CleanKillQueueInterval == 0 {
 		fp.CleanKillQueueInterval = defaultCleanKillQueueInterval
 	}
+
+	if fp.SlowQueryLogMaxSize <= 0 {
+		fp.SlowQueryLogMaxSize = defaultSlowQueryLogMaxSize
+	}
 }
 
 func (fp *FrontendParameters) SetMaxMessageSize(size uint64) {
@@ -571,6 +597,18 @@ type ObservabilityParameters struct {
 	// MergedExtension default: tae. Support val in [csv, tae]
 	MergedExtension string `toml:"mergedExtension"`
 
+	// EnableAudit default is false. With true, the logins, logouts, DDL, DCL and DML statements are recorded into system.mo_audit.
+	EnableAudit bool `toml:"enableAudit"`
+
+	// AuditLogFile default is empty. If set, the audit records are also written into the local file in JSON.
+	AuditLogFile string `toml:"auditLogFile"`
+
+	// AuditLogMaxSize default: 128 (MB). The audit log file is rotated when it reaches the size.
+	AuditLogMaxSize int `toml:"auditLogMaxSize"`
+
+	// AuditLogMaxBackups default is 0, which retains all the rotated audit log files.
+	AuditLogMaxBackups int `toml:"auditLogMaxBackups"`
+
 	OBCollectorConfig
 }
 
@@ -626,6 +664,10 @@ func (op *ObservabilityParameters) SetDefaultValues(version string) {
 	if op.MergedExtension == "" {
 		op.MergedExtension = defaultMergedExtension
 	}
+
+	if op.AuditLogMaxSize <= 0 {
+		op.AuditLogMaxSize = defaultAuditLogMaxSize
+	}
 }
 
 type OBCollectorConfig struct {
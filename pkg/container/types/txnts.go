@@ -24,6 +24,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/txn/clock"
 )
@@ -136,6 +137,24 @@ func StringToTS(s string) (ts TS) {
 	return BuildTS(pTime, uint32(lTime))
 }
 
+// ParseTS parses the timestamp in the form of physical-logical like StringToTS,
+// but returns an error instead of panicking on a bad one.
+func ParseTS(s string) (ts TS, err error) {
+	physical, logical, ok := strings.Cut(s, "-")
+	if !ok {
+		return ts, moerr.NewInvalidInputNoCtx("format of ts must be physical-logical: %s", s)
+	}
+	pTime, err := strconv.ParseInt(physical, 10, 64)
+	if err != nil {
+		return ts, moerr.NewInvalidInputNoCtx("format of ts must be physical-logical, physical is not an integer: %s", s)
+	}
+	lTime, err := strconv.ParseUint(logical, 10, 32)
+	if err != nil {
+		return ts, moerr.NewInvalidInputNoCtx("format of ts must be physical-logical, logical is not an uint32: %s", s)
+	}
+	return BuildTS(pTime, uint32(lTime)), nil
+}
+
 // XXX
 // XXX The following code does not belong to types. TAE folks please fix.
 
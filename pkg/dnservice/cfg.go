@@ -128,6 +128,13 @@ type Config struct {
 			Backend StorageType `toml:"backend"`
 			// LogBackend the backend used to store logs
 			LogBackend string `toml:"log-backend"`
+			// WalArchive the name of the fileservice the WAL entries are archived into
+			// for point-in-time recovery, no archiving if empty.
+			WalArchive string `toml:"wal-archive"`
+			// WalArchiveFlushInterval the interval of flushing the WAL entries archived,
+			// the entries not flushed are lost if DN crashes, so it is the recovery point
+			// objective of the point-in-time recovery. Default is 5s.
+			WalArchiveFlushInterval toml.Duration `toml:"wal-archive-flush-interval"`
 		}
 	}
 
@@ -150,6 +150,18 @@ func (s *store) newTAEStorage(shard metadata.DNShard, factory logservice.ClientF
 		return nil, err
 	}
 
+	var walArchiveCfg *options.WalArchiveCfg
+	if s.cfg.Txn.Storage.WalArchive != "" {
+		archiveFS, err := fileservice.Get[fileservice.FileService](s.fileService, s.cfg.Txn.Storage.WalArchive)
+		if err != nil {
+			return nil, err
+		}
+		walArchiveCfg = &options.WalArchiveCfg{
+			Fs:            archiveFS,
+			FlushInterval: s.cfg.Txn.Storage.WalArchiveFlushInterval.Duration,
+		}
+	}
+
 	return taestorage.NewTAEStorage(
 		s.cfg.Txn.Storage.dataDir,
 		shard,
@@ -159,5 +171,6 @@ func (s *store) newTAEStorage(shard metadata.DNShard, factory logservice.ClientF
 		ckpcfg,
 		logtailServerAddr,
 		logtailServerCfg,
-		options.LogstoreType(s.cfg.Txn.Storage.LogBackend))
+		options.LogstoreType(s.cfg.Txn.Storage.LogBackend),
+		walArchiveCfg)
 }
@@ -0,0 +1,206 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const (
+	deleteAccountVariableFormat = `delete from mo_catalog.mo_account_variables where variable_name = "%s";`
+
+	insertAccountVariableFormat = `insert into mo_catalog.mo_account_variables(
+				variable_name,
+				variable_value) values ("%s","%s");`
+
+	getAccountVariablesFormat = `select variable_name,variable_value from mo_catalog.mo_account_variables;`
+)
+
+// accountVariables are the global variables that are set for every account.
+// SET GLOBAL of them saves the value into the mo_account_variables of the account
+// instead of changing the value of the whole CN.
+var accountVariables = map[string]int8{
+	"slow_query_log":           0,
+	"long_query_time":          0,
+	"slow_query_log_retention": 0,
+}
+
+func isAccountVariable(name string) bool {
+	_, ok := accountVariables[strings.ToLower(name)]
+	return ok
+}
+
+func getSqlForDeleteAccountVariable(name string) string {
+	return fmt.Sprintf(deleteAccountVariableFormat, name)
+}
+
+func getSqlForInsertAccountVariable(name, value string) string {
+	return fmt.Sprintf(insertAccountVariableFormat, name, value)
+}
+
+func getSqlForAccountVariables() string {
+	return getAccountVariablesFormat
+}
+
+// accountVariableString converts the value of the variable into the text saved in the mo_account_variables.
+// The text can be converted back by the type of the variable.
+func accountVariableString(def SystemVariable, value interface{}) string {
+	switch t := def.GetType().(type) {
+	case SystemVariableBoolType:
+		if t.IsTrue(value) {
+			return "on"
+		}
+		return "off"
+	case SystemVariableDoubleType:
+		if f, ok := value.(float64); ok {
+			return strconv.FormatFloat(f, 'f', -1, 64)
+		}
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// convertAccountVariable converts the text saved by accountVariableString into the value of the variable
+func convertAccountVariable(def SystemVariable, value string) (interface{}, error) {
+	if _, ok := def.GetType().(SystemVariableIntType); ok {
+		x, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		return def.GetType().Convert(x)
+	}
+	return def.GetType().Convert(value)
+}
+
+// fillAccountVariables converts the result of the sql getSqlForAccountVariables into the values of the variables.
+// The unknown variables and the invalid values are skipped.
+func fillAccountVariables(ctx context.Context, erArray []ExecResult) (map[string]interface{}, error) {
+	if !execResultArrayHasData(erArray) {
+		return nil, nil
+	}
+	vars := make(map[string]interface{}, erArray[0].GetRowCount())
+	for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+		name, err := erArray[0].GetString(ctx, i, 0)
+		if err != nil {
+			return nil, err
+		}
+		value, err := erArray[0].GetString(ctx, i, 1)
+		if err != nil {
+			return nil, err
+		}
+		def, ok := gSysVarsDefs[name]
+		if !ok || !isAccountVariable(name) {
+			continue
+		}
+		cv, err := convertAccountVariable(def, value)
+		if err != nil {
+			continue
+		}
+		vars[name] = cv
+	}
+	return vars, nil
+}
+
+// loadAccountVariables loads the global variables of the account during the authentication.
+// The session values of the variables start with the ones of the account.
+func (ses *Session) loadAccountVariables(tenantCtx context.Context) error {
+	rsset, err := executeSQLInBackgroundSession(
+		tenantCtx,
+		ses,
+		ses.GetMemPool(),
+		ses.GetParameterUnit(),
+		getSqlForAccountVariables())
+	if err != nil {
+		return err
+	}
+	vars, err := fillAccountVariables(tenantCtx, rsset)
+	if err != nil {
+		return err
+	}
+	for name, value := range vars {
+		ses.SetSysVar(name, value)
+	}
+	ses.setAccountVars(vars)
+	return nil
+}
+
+// setAccountVariable saves the global value of the variable for the account of the session
+func setAccountVariable(ctx context.Context, ses *Session, name string, value interface{}) error {
+	name = strings.ToLower(name)
+	def, ok := gSysVarsDefs[name]
+	if !ok {
+		return moerr.NewInternalError(ctx, errorSystemVariableDoesNotExist())
+	}
+	cv, err := def.GetType().Convert(value)
+	if err != nil {
+		return err
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	err = bh.Exec(ctx, "begin;")
+	if err != nil {
+		goto handleFailed
+	}
+	err = bh.Exec(ctx, getSqlForDeleteAccountVariable(name))
+	if err != nil {
+		goto handleFailed
+	}
+	err = bh.Exec(ctx, getSqlForInsertAccountVariable(name, accountVariableString(def, cv)))
+	if err != nil {
+		goto handleFailed
+	}
+	err = bh.Exec(ctx, "commit;")
+	if err != nil {
+		goto handleFailed
+	}
+	ses.setAccountVar(name, cv)
+	return err
+
+handleFailed:
+	//ROLLBACK the transaction
+	rbErr := bh.Exec(ctx, "rollback;")
+	if rbErr != nil {
+		return rbErr
+	}
+	return err
+}
+
+func (ses *Session) getAccountVar(name string) (interface{}, bool) {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	val, ok := ses.accountVars[name]
+	return val, ok
+}
+
+func (ses *Session) setAccountVar(name string, value interface{}) {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	if ses.accountVars == nil {
+		ses.accountVars = make(map[string]interface{})
+	}
+	ses.accountVars[name] = value
+}
+
+func (ses *Session) setAccountVars(vars map[string]interface{}) {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	ses.accountVars = vars
+}
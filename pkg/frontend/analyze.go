@@ -0,0 +1,541 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+)
+
+const (
+	getColumnStatsFormat = `select column_name, stats from mo_catalog.mo_column_stats where table_id = %d;`
+
+	deleteColumnStatsFormat = `delete from mo_catalog.mo_column_stats where table_id = %d and column_name in (%s);`
+
+	insertColumnStatsFormat = `insert into mo_catalog.mo_column_stats(
+				table_id,
+				column_name,
+				database_name,
+				table_name,
+				row_count,
+				null_count,
+				ndv,
+				stats,
+				analyzed_time) values %s;`
+
+	columnStatsValueFormat = `(%d,"%s","%s","%s",%d,%d,%d,'%s',"%s")`
+)
+
+const (
+	// the rows sampled by ANALYZE TABLE to build the histograms
+	analyzeSampleRows = 100000
+	// the sample is the rows whose hash of the rowid falls into a part of the range
+	analyzeSampleRange = 1000000
+
+	// the table is analyzed in background when the rows modified since it was
+	// analyzed exceed autoAnalyzeMinRows + autoAnalyzeRatio * the analyzed rows
+	autoAnalyzeMinRows = 1000
+	autoAnalyzeRatio   = 0.2
+	autoAnalyzeTimeout = time.Hour
+
+	// the statistics loaded from mo_column_stats are loaded again after the ttl,
+	// so that those collected by the other CNs are used
+	columnStatsTTL = time.Minute
+)
+
+func getSqlForGetColumnStats(tableId uint64) string {
+	return fmt.Sprintf(getColumnStatsFormat, tableId)
+}
+
+func getSqlForDeleteColumnStats(tableId uint64, cols []string) string {
+	quoted := make([]string, len(cols))
+	for i, col := range cols {
+		quoted[i] = `"` + col + `"`
+	}
+	return fmt.Sprintf(deleteColumnStatsFormat, tableId, strings.Join(quoted, ","))
+}
+
+func getSqlForInsertColumnStats(dbName string, tableDef *plan.TableDef, cols []string, stats []*plan2.ColumnStats, analyzedTime string) (string, error) {
+	values := make([]string, len(cols))
+	for i, cs := range stats {
+		data, err := json.Marshal(cs)
+		if err != nil {
+			return "", err
+		}
+		values[i] = fmt.Sprintf(columnStatsValueFormat, tableDef.TblId, cols[i], dbName, tableDef.Name,
+			uint64(cs.RowCount), uint64(cs.NullCount), uint64(cs.Ndv), escapeSingleQuoteString(string(data)), analyzedTime)
+	}
+	return fmt.Sprintf(insertColumnStatsFormat, strings.Join(values, ",")), nil
+}
+
+// getSqlForAnalyzeCounts returns the sql counting the rows of the table, the
+// non-null values and the ndv of the columns
+func getSqlForAnalyzeCounts(dbName, tableName string, cols []string) string {
+	var sb strings.Builder
+	sb.WriteString("select count(*)")
+	for _, col := range cols {
+		fmt.Fprintf(&sb, ", count(`%s`), approx_count_distinct(`%s`)", col, col)
+	}
+	fmt.Fprintf(&sb, " from `%s`.`%s`", dbName, tableName)
+	return sb.String()
+}
+
+// getSqlForAnalyzeSample returns the sql sampling about analyzeSampleRows rows
+// of the table
+func getSqlForAnalyzeSample(dbName, tableName string, cols []string, rowCount float64) string {
+	quoted := make([]string, len(cols))
+	for i, col := range cols {
+		quoted[i] = "`" + col + "`"
+	}
+	sql := fmt.Sprintf("select %s from `%s`.`%s`", strings.Join(quoted, ", "), dbName, tableName)
+	if rowCount > analyzeSampleRows {
+		sql += fmt.Sprintf(" where abs(hash_value(%s) %% %d) < %d", catalog.Row_ID, analyzeSampleRange,
+			int64(analyzeSampleRange*analyzeSampleRows/rowCount)+1)
+	}
+	return sql
+}
+
+// analyzedColumnsOf returns the columns to be analyzed, which are all the
+// supported columns if names is empty
+func analyzedColumnsOf(ctx context.Context, tableDef *plan.TableDef, names tree.IdentifierList) ([]*plan.ColDef, error) {
+	var cols []*plan.ColDef
+	if len(names) == 0 {
+		for _, col := range tableDef.Cols {
+			if !col.Hidden && plan2.IsStatsType(types.T(col.Typ.Id)) {
+				cols = append(cols, col)
+			}
+		}
+		return cols, nil
+	}
+	for _, name := range names {
+		var found *plan.ColDef
+		for _, col := range tableDef.Cols {
+			if !col.Hidden && strings.EqualFold(col.Name, string(name)) {
+				found = col
+				break
+			}
+		}
+		if found == nil {
+			return nil, moerr.NewInvalidInput(ctx, "column '%s' does not exist in the table %s", name, tableDef.Name)
+		}
+		if !plan2.IsStatsType(types.T(found.Typ.Id)) {
+			return nil, moerr.NewNotSupported(ctx, "analyze the column '%s' of type %s", name, types.T(found.Typ.Id))
+		}
+		cols = append(cols, found)
+	}
+	return cols, nil
+}
+
+// analyzeExec runs the statements collecting the statistics, and keeps their
+// results in batches.
+type analyzeExec interface {
+	Exec(context.Context, string) error
+	ClearExecResultBatches()
+	GetExecResultBatches() []*batch.Batch
+}
+
+// analyzeTable collects the statistics of the columns and saves them into
+// mo_catalog.mo_column_stats. The ndv is estimated by HyperLogLog on the whole
+// table, and the TopN and the histograms are built on a sample.
+func analyzeTable(ctx context.Context, bh analyzeExec, dbName string, tableDef *plan.TableDef, cols []*plan.ColDef) error {
+	if len(cols) == 0 {
+		return nil
+	}
+	names := make([]string, len(cols))
+	for i, col := range cols {
+		names[i] = col.Name
+	}
+
+	bh.ClearExecResultBatches()
+	err := bh.Exec(ctx, getSqlForAnalyzeCounts(dbName, tableDef.Name, names))
+	if err != nil {
+		return err
+	}
+	rs := bh.GetExecResultBatches()
+	if len(rs) == 0 || rs[0].Length() == 0 {
+		return moerr.NewInternalError(ctx, "count the rows of the table %s.%s failed", dbName, tableDef.Name)
+	}
+	counts := rs[0]
+	rowCount, _ := plan2.StatsValueOf(counts.Vecs[0], 0)
+
+	bh.ClearExecResultBatches()
+	err = bh.Exec(ctx, getSqlForAnalyzeSample(dbName, tableDef.Name, names, rowCount))
+	if err != nil {
+		return err
+	}
+	samples := make([][]float64, len(cols))
+	for _, bat := range bh.GetExecResultBatches() {
+		for i := range cols {
+			for row := 0; row < bat.Length(); row++ {
+				if v, ok := plan2.StatsValueOf(bat.Vecs[i], row); ok {
+					samples[i] = append(samples[i], v)
+				}
+			}
+		}
+	}
+
+	stats := make([]*plan2.ColumnStats, len(cols))
+	for i, col := range cols {
+		nonNull, _ := plan2.StatsValueOf(counts.Vecs[2*i+1], 0)
+		ndv, _ := plan2.StatsValueOf(counts.Vecs[2*i+2], 0)
+		stats[i] = plan2.BuildColumnStats(types.T(col.Typ.Id), samples[i], rowCount, rowCount-nonNull, ndv, plan2.TopNValues, plan2.HistogramBuckets)
+	}
+	insertSql, err := getSqlForInsertColumnStats(dbName, tableDef, names, stats, types.CurrentTimestamp().String2(time.UTC, 0))
+	if err != nil {
+		return err
+	}
+
+	err = bh.Exec(ctx, "begin;")
+	if err != nil {
+		goto handleFailed
+	}
+	err = bh.Exec(ctx, getSqlForDeleteColumnStats(tableDef.TblId, names))
+	if err != nil {
+		goto handleFailed
+	}
+	err = bh.Exec(ctx, insertSql)
+	if err != nil {
+		goto handleFailed
+	}
+	err = bh.Exec(ctx, "commit;")
+	if err != nil {
+		goto handleFailed
+	}
+	analyzedTables.analyzed(tableDef.TblId, rowCount)
+	return nil
+handleFailed:
+	//ROLLBACK the transaction
+	rbErr := bh.Exec(ctx, "rollback;")
+	if rbErr != nil {
+		return rbErr
+	}
+	return err
+}
+
+// doAnalyzeTable accomplishes the ANALYZE TABLE statement. It returns the
+// database of the table.
+func doAnalyzeTable(ctx context.Context, ses *Session, stmt *tree.AnalyzeStmt) (string, error) {
+	dbName := string(stmt.Table.SchemaName)
+	if len(dbName) == 0 {
+		if ses.DatabaseNameIsEmpty() {
+			return "", moerr.NewNoDB(ctx)
+		}
+		dbName = ses.GetDatabaseName()
+	}
+	tableName := string(stmt.Table.ObjectName)
+	if isBannedDatabase(dbName) {
+		return "", moerr.NewNotSupported(ctx, "analyze the table in the database %s", dbName)
+	}
+
+	// the user must be able to read the table
+	sql := fmt.Sprintf("select count(*) from `%s`.`%s`", dbName, tableName)
+	v, err := ses.GetGlobalVar("lower_case_table_names")
+	if err != nil {
+		return "", err
+	}
+	selectStmt, err := parsers.ParseOne(ctx, dialect.MYSQL, sql, v.(int64))
+	if err != nil {
+		return "", err
+	}
+	if _, err = buildPlan(ctx, ses, ses.GetTxnCompileCtx(), selectStmt); err != nil {
+		return "", err
+	}
+
+	_, tableDef := ses.GetTxnCompileCtx().Resolve(dbName, tableName)
+	if tableDef == nil {
+		return "", moerr.NewNoSuchTable(ctx, dbName, tableName)
+	}
+	if tableDef.TableType != catalog.SystemOrdinaryRel && tableDef.TableType != catalog.SystemClusterRel {
+		return "", moerr.NewNotSupported(ctx, "analyze the table %s.%s, which is not an ordinary table", dbName, tableName)
+	}
+	cols, err := analyzedColumnsOf(ctx, tableDef, stmt.Cols)
+	if err != nil {
+		return "", err
+	}
+
+	bh := ses.GetBackgroundHandlerWithBatchFetcher(ctx)
+	defer bh.Close()
+	return dbName, analyzeTable(ctx, bh, dbName, tableDef, cols)
+}
+
+// getColumnStats returns the statistics of the table in mo_column_stats, which
+// are cached for columnStatsTTL
+func getColumnStats(ctx context.Context, ses *Session, dbName string, tableId uint64) map[string]*plan2.ColumnStats {
+	if ses.IsBackgroundSession() || isBannedDatabase(dbName) {
+		return nil
+	}
+	if stats, ok := analyzedTables.get(tableId); ok {
+		return stats
+	}
+	stats, err := loadColumnStats(ctx, ses, tableId)
+	if err != nil {
+		logErrorf(ses.GetDebugString(), "load the column statistics of the table %d failed. error:%v", tableId, err)
+	}
+	// the failure is cached too, the statistics are not necessary
+	analyzedTables.set(tableId, stats)
+	return stats
+}
+
+func loadColumnStats(ctx context.Context, ses *Session, tableId uint64) (map[string]*plan2.ColumnStats, error) {
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	bh.ClearExecResultSet()
+	err := bh.Exec(ctx, getSqlForGetColumnStats(tableId))
+	if err != nil {
+		return nil, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil || !execResultArrayHasData(erArray) {
+		return nil, err
+	}
+	stats := make(map[string]*plan2.ColumnStats, erArray[0].GetRowCount())
+	for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+		name, err := erArray[0].GetString(ctx, i, 0)
+		if err != nil {
+			return nil, err
+		}
+		data, err := erArray[0].GetString(ctx, i, 1)
+		if err != nil {
+			return nil, err
+		}
+		cs := &plan2.ColumnStats{}
+		if err = json.Unmarshal([]byte(data), cs); err != nil {
+			return nil, err
+		}
+		stats[name] = cs
+	}
+	return stats, nil
+}
+
+// modifiedTable is the rows of a table modified by the txn of a session.
+type modifiedTable struct {
+	dbName string
+	rows   float64
+	// tableDef is resolved when the rows may make the table analyzed, the
+	// txn resolving it is gone when the rows are counted at commit.
+	tableDef *plan.TableDef
+}
+
+// recordModifiedRows records the rows modified by the plan in the txn, they
+// are counted when the txn commits.
+func recordModifiedRows(ses *Session, p *plan.Plan, rows uint64) {
+	if rows == 0 || p.GetQuery() == nil {
+		return
+	}
+	modified := make(map[uint64]*plan.ObjectRef)
+	for _, node := range p.GetQuery().Nodes {
+		if node.InsertCtx != nil && node.InsertCtx.AddAffectedRows && node.InsertCtx.Ref != nil {
+			modified[node.InsertCtx.TableDef.GetTblId()] = node.InsertCtx.Ref
+		}
+		if node.DeleteCtx != nil && node.DeleteCtx.AddAffectedRows && node.DeleteCtx.Ref != nil {
+			modified[uint64(node.DeleteCtx.Ref.Obj)] = node.DeleteCtx.Ref
+		}
+	}
+	if len(modified) == 0 {
+		return
+	}
+	val, err := ses.GetSessionVar("auto_analyze")
+	if err != nil {
+		return
+	}
+	autoAnalyze, _ := val.(int8)
+	for tableId, ref := range modified {
+		if tableId == 0 || isBannedDatabase(ref.SchemaName) {
+			continue
+		}
+		if ses.modifiedTables == nil {
+			ses.modifiedTables = make(map[uint64]*modifiedTable)
+		}
+		t, ok := ses.modifiedTables[tableId]
+		if !ok {
+			t = &modifiedTable{dbName: ref.SchemaName}
+			ses.modifiedTables[tableId] = t
+		}
+		t.rows += float64(rows)
+		if t.tableDef == nil && autoAnalyze > 0 && analyzedTables.needAnalyze(tableId, t.rows) {
+			_, t.tableDef = ses.GetTxnCompileCtx().Resolve(ref.SchemaName, ref.ObjName)
+		}
+	}
+}
+
+// countModifiedRows counts the rows modified by the txn of the session if it
+// is committed, and analyzes the tables modified too much in background.
+func countModifiedRows(ses *Session, committed bool) {
+	tables := ses.modifiedTables
+	ses.modifiedTables = nil
+	if !committed {
+		return
+	}
+	for tableId, t := range tables {
+		if !analyzedTables.modify(tableId, t.rows) {
+			continue
+		}
+		if t.tableDef == nil {
+			analyzedTables.analyzeFailed(tableId)
+			continue
+		}
+		startAutoAnalyze(ses, t.dbName, t.tableDef)
+	}
+}
+
+// startAutoAnalyze analyzes all the columns of the table in background
+func startAutoAnalyze(ses *Session, dbName string, tableDef *plan.TableDef) {
+	tableId := tableDef.TblId
+	tenant := ses.GetTenantInfo()
+	if tenant == nil {
+		analyzedTables.analyzeFailed(tableId)
+		return
+	}
+	cols, _ := analyzedColumnsOf(ses.GetRequestContext(), tableDef, nil)
+	mp, err := mpool.NewMPool("auto_analyze", 0, mpool.NoFixed)
+	if err != nil {
+		analyzedTables.analyzeFailed(tableId)
+		return
+	}
+
+	ctx := context.WithValue(context.Background(), defines.TenantIDKey{}, tenant.GetTenantID())
+	ctx = context.WithValue(ctx, defines.UserIDKey{}, tenant.GetUserID())
+	ctx = context.WithValue(ctx, defines.RoleIDKey{}, tenant.GetDefaultRoleID())
+	ctx, cancel := context.WithTimeout(ctx, autoAnalyzeTimeout)
+	bh := &BackgroundHandler{
+		mce: NewMysqlCmdExecutor(),
+		ses: NewBackgroundSession(ctx, ses, mp, ses.GetParameterUnit(), GSysVariables),
+	}
+	bh.ses.SetOutputCallback(batchFetcher)
+	debugStr := ses.GetDebugString()
+
+	go func() {
+		defer func() {
+			bh.Close()
+			mpool.DeleteMPool(mp)
+			cancel()
+		}()
+		if err := analyzeTable(ctx, bh, dbName, tableDef, cols); err != nil {
+			analyzedTables.analyzeFailed(tableId)
+			logErrorf(debugStr, "auto analyze the table %s.%s failed. error:%v", dbName, tableDef.Name, err)
+		}
+	}()
+}
+
+// analyzedTables caches the statistics of the tables in mo_column_stats, and
+// counts the rows modified since they were analyzed
+var analyzedTables = &analyzedTableMap{
+	tables: make(map[uint64]*analyzedTable),
+}
+
+type analyzedTableMap struct {
+	sync.Mutex
+	tables map[uint64]*analyzedTable
+}
+
+type analyzedTable struct {
+	stats    map[string]*plan2.ColumnStats
+	loadTime time.Time
+	// the rows when the table was analyzed
+	rowCount  float64
+	modified  float64
+	analyzing bool
+}
+
+func (m *analyzedTableMap) getLocked(tableId uint64) *analyzedTable {
+	t, ok := m.tables[tableId]
+	if !ok {
+		t = &analyzedTable{}
+		m.tables[tableId] = t
+	}
+	return t
+}
+
+// get returns the statistics loaded in columnStatsTTL
+func (m *analyzedTableMap) get(tableId uint64) (map[string]*plan2.ColumnStats, bool) {
+	m.Lock()
+	defer m.Unlock()
+	t, ok := m.tables[tableId]
+	if !ok || t.loadTime.IsZero() || time.Since(t.loadTime) > columnStatsTTL {
+		return nil, false
+	}
+	return t.stats, true
+}
+
+// set caches the statistics loaded from mo_column_stats
+func (m *analyzedTableMap) set(tableId uint64, stats map[string]*plan2.ColumnStats) {
+	m.Lock()
+	defer m.Unlock()
+	t := m.getLocked(tableId)
+	t.stats = stats
+	t.loadTime = time.Now()
+	for _, cs := range stats {
+		t.rowCount = cs.RowCount
+		break
+	}
+}
+
+// analyzed resets the modified rows, and makes the statistics loaded again
+func (m *analyzedTableMap) analyzed(tableId uint64, rowCount float64) {
+	m.Lock()
+	defer m.Unlock()
+	t := m.getLocked(tableId)
+	t.stats = nil
+	t.loadTime = time.Time{}
+	t.rowCount = rowCount
+	t.modified = 0
+	t.analyzing = false
+}
+
+func (m *analyzedTableMap) analyzeFailed(tableId uint64) {
+	m.Lock()
+	defer m.Unlock()
+	m.getLocked(tableId).analyzing = false
+}
+
+// needAnalyze tells whether the table needs to be analyzed again if rows
+// more are modified.
+func (m *analyzedTableMap) needAnalyze(tableId uint64, rows float64) bool {
+	m.Lock()
+	defer m.Unlock()
+	t := m.getLocked(tableId)
+	return !t.analyzing && t.modified+rows >= autoAnalyzeMinRows+autoAnalyzeRatio*t.rowCount
+}
+
+// modify adds the modified rows of the table, and returns true if the table
+// needs to be analyzed again. It is not analyzed twice at the same time.
+func (m *analyzedTableMap) modify(tableId uint64, rows float64) bool {
+	m.Lock()
+	defer m.Unlock()
+	t := m.getLocked(tableId)
+	t.modified += rows
+	if t.analyzing || t.modified < autoAnalyzeMinRows+autoAnalyzeRatio*t.rowCount {
+		return false
+	}
+	t.analyzing = true
+	return true
+}
@@ -0,0 +1,133 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyzeSql(t *testing.T) {
+	require.Equal(t,
+		"select count(*), count(`a`), approx_count_distinct(`a`), count(`b`), approx_count_distinct(`b`) from `db`.`t`",
+		getSqlForAnalyzeCounts("db", "t", []string{"a", "b"}))
+	require.Equal(t, "select `a`, `b` from `db`.`t`", getSqlForAnalyzeSample("db", "t", []string{"a", "b"}, 1000))
+	require.Equal(t, "select `a` from `db`.`t` where abs(hash_value(__mo_rowid) % 1000000) < 100001",
+		getSqlForAnalyzeSample("db", "t", []string{"a"}, 1e6))
+	require.Equal(t, `delete from mo_catalog.mo_column_stats where table_id = 5 and column_name in ("a","b");`,
+		getSqlForDeleteColumnStats(5, []string{"a", "b"}))
+
+	tableDef := &plan.TableDef{TblId: 5, Name: "t"}
+	cs := plan2.BuildColumnStats(types.T_int64, []float64{1, 1, 1, 2, 3}, 10, 2, 3, plan2.TopNValues, plan2.HistogramBuckets)
+	sql, err := getSqlForInsertColumnStats("db", tableDef, []string{"a"}, []*plan2.ColumnStats{cs}, "2023-01-01 00:00:00")
+	require.NoError(t, err)
+	data, err := json.Marshal(cs)
+	require.NoError(t, err)
+	require.Contains(t, sql, `(5,"a","db","t",10,2,3,'`+string(data)+`',"2023-01-01 00:00:00")`)
+}
+
+func TestAnalyzedColumnsOf(t *testing.T) {
+	ctx := context.TODO()
+	tableDef := &plan.TableDef{
+		Name: "t",
+		Cols: []*plan.ColDef{
+			{Name: "a", Typ: &plan.Type{Id: int32(types.T_int32)}},
+			{Name: "b", Typ: &plan.Type{Id: int32(types.T_varchar)}},
+			{Name: "c", Typ: &plan.Type{Id: int32(types.T_json)}},
+			{Name: "__mo_rowid", Typ: &plan.Type{Id: int32(types.T_Rowid)}, Hidden: true},
+		},
+	}
+	cols, err := analyzedColumnsOf(ctx, tableDef, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(cols))
+	require.Equal(t, "a", cols[0].Name)
+	require.Equal(t, "b", cols[1].Name)
+
+	cols, err = analyzedColumnsOf(ctx, tableDef, tree.IdentifierList{"B"})
+	require.NoError(t, err)
+	require.Equal(t, "b", cols[0].Name)
+
+	_, err = analyzedColumnsOf(ctx, tableDef, tree.IdentifierList{"d"})
+	require.Error(t, err)
+	_, err = analyzedColumnsOf(ctx, tableDef, tree.IdentifierList{"c"})
+	require.Error(t, err)
+}
+
+func TestAnalyzedTableMap(t *testing.T) {
+	m := &analyzedTableMap{tables: make(map[uint64]*analyzedTable)}
+
+	_, ok := m.get(1)
+	require.False(t, ok)
+	m.set(1, map[string]*plan2.ColumnStats{"a": {RowCount: 10000}})
+	stats, ok := m.get(1)
+	require.True(t, ok)
+	require.Equal(t, 1, len(stats))
+
+	// 1000 + 0.2 * 10000 rows must be modified
+	require.False(t, m.modify(1, 2000))
+	require.True(t, m.modify(1, 1000))
+	// it is being analyzed
+	require.False(t, m.modify(1, 5000))
+	m.analyzeFailed(1)
+	require.True(t, m.modify(1, 1))
+
+	m.analyzed(1, 20000)
+	_, ok = m.get(1)
+	require.False(t, ok)
+	require.False(t, m.modify(1, 4000))
+	require.True(t, m.modify(1, 1000))
+}
+
+func TestCountModifiedRows(t *testing.T) {
+	const tableId = 1 << 40
+	defer func() {
+		analyzedTables.Lock()
+		delete(analyzedTables.tables, tableId)
+		analyzedTables.Unlock()
+	}()
+	modified := func() float64 {
+		analyzedTables.Lock()
+		defer analyzedTables.Unlock()
+		return analyzedTables.getLocked(tableId).modified
+	}
+
+	// the rows of a txn rolled back are not counted
+	ses := &Session{}
+	ses.modifiedTables = map[uint64]*modifiedTable{tableId: {dbName: "db", rows: 10}}
+	countModifiedRows(ses, false)
+	require.Nil(t, ses.modifiedTables)
+	require.Equal(t, float64(0), modified())
+
+	ses.modifiedTables = map[uint64]*modifiedTable{tableId: {dbName: "db", rows: 10}}
+	countModifiedRows(ses, true)
+	require.Nil(t, ses.modifiedTables)
+	require.Equal(t, float64(10), modified())
+	require.False(t, analyzedTables.needAnalyze(tableId, 10))
+	require.True(t, analyzedTables.needAnalyze(tableId, autoAnalyzeMinRows))
+
+	// the table is not analyzed without its definition
+	ses.modifiedTables = map[uint64]*modifiedTable{tableId: {dbName: "db", rows: autoAnalyzeMinRows}}
+	countModifiedRows(ses, true)
+	analyzedTables.Lock()
+	require.False(t, analyzedTables.getLocked(tableId).analyzing)
+	analyzedTables.Unlock()
+}
@@ -0,0 +1,436 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/util/trace/impl/motrace"
+)
+
+const (
+	checkAuditFilterFormat = `select filter_name from mo_catalog.mo_audit_filters where filter_name = "%s";`
+
+	insertAuditFilterFormat = `insert into mo_catalog.mo_audit_filters(
+				filter_name,
+				event_classes,
+				users,
+				creator,
+				created_time) values ("%s","%s","%s",%d,"%s");`
+
+	deleteAuditFilterFormat = `delete from mo_catalog.mo_audit_filters where filter_name = "%s";`
+
+	getAuditFiltersFormat = `select filter_name,event_classes,users from mo_catalog.mo_audit_filters order by filter_name;`
+)
+
+// auditClasses are the events that the audit filter can choose
+var auditClasses = map[string]int8{
+	motrace.AuditClassConnection: 0,
+	motrace.AuditClassDDL:        0,
+	motrace.AuditClassDCL:        0,
+	motrace.AuditClassDML:        0,
+}
+
+func getSqlForCheckAuditFilter(ctx context.Context, filterName string) (string, error) {
+	err := inputNameIsInvalid(ctx, filterName)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(checkAuditFilterFormat, filterName), nil
+}
+
+func getSqlForInsertAuditFilter(filterName string, events, users []string, creator int64, createdTime string) string {
+	return fmt.Sprintf(insertAuditFilterFormat, filterName, strings.Join(events, ","), strings.Join(users, ","), creator, createdTime)
+}
+
+func getSqlForDeleteAuditFilter(filterName string) string {
+	return fmt.Sprintf(deleteAuditFilterFormat, filterName)
+}
+
+func getSqlForAuditFilters() string {
+	return getAuditFiltersFormat
+}
+
+// auditFilter is one row of the mo_audit_filters
+type auditFilter struct {
+	name   string
+	events []string
+	// empty users means all users
+	users []string
+}
+
+func (f *auditFilter) match(class, user string) bool {
+	found := false
+	for _, event := range f.events {
+		if event == class {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return false
+	}
+	if len(f.users) == 0 {
+		return true
+	}
+	for _, u := range f.users {
+		if u == user {
+			return true
+		}
+	}
+	return false
+}
+
+// auditFilterMatched checks the event should be audited or not.
+// All events are audited if the account does not have any filter.
+func auditFilterMatched(filters []*auditFilter, class, user string) bool {
+	if len(filters) == 0 {
+		return true
+	}
+	for _, f := range filters {
+		if f.match(class, user) {
+			return true
+		}
+	}
+	return false
+}
+
+func splitAuditFilterList(s string) []string {
+	if len(s) == 0 {
+		return nil
+	}
+	return strings.Split(s, ",")
+}
+
+// fillAuditFilters converts the result of the sql getSqlForAuditFilters into the filters
+func fillAuditFilters(ctx context.Context, erArray []ExecResult) ([]*auditFilter, error) {
+	if !execResultArrayHasData(erArray) {
+		return nil, nil
+	}
+	filters := make([]*auditFilter, 0, erArray[0].GetRowCount())
+	for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+		name, err := erArray[0].GetString(ctx, i, 0)
+		if err != nil {
+			return nil, err
+		}
+		events, err := erArray[0].GetString(ctx, i, 1)
+		if err != nil {
+			return nil, err
+		}
+		users, err := erArray[0].GetString(ctx, i, 2)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, &auditFilter{
+			name:   name,
+			events: splitAuditFilterList(events),
+			users:  splitAuditFilterList(users),
+		})
+	}
+	return filters, nil
+}
+
+func (ses *Session) getAuditFilters() []*auditFilter {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	return ses.auditFilters
+}
+
+func (ses *Session) setAuditFilters(filters []*auditFilter) {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	ses.auditFilters = filters
+}
+
+// loadAuditFilters loads the audit filters of the account during the authentication
+func (ses *Session) loadAuditFilters(tenantCtx context.Context) error {
+	rsset, err := executeSQLInBackgroundSession(
+		tenantCtx,
+		ses,
+		ses.GetMemPool(),
+		ses.GetParameterUnit(),
+		getSqlForAuditFilters())
+	if err != nil {
+		return err
+	}
+	filters, err := fillAuditFilters(tenantCtx, rsset)
+	if err != nil {
+		return err
+	}
+	ses.setAuditFilters(filters)
+	return nil
+}
+
+// refreshAuditFilters reloads the audit filters after they have been changed in the session
+func refreshAuditFilters(ctx context.Context, ses *Session, bh BackgroundExec) error {
+	bh.ClearExecResultSet()
+	err := bh.Exec(ctx, getSqlForAuditFilters())
+	if err != nil {
+		return err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return err
+	}
+	filters, err := fillAuditFilters(ctx, erArray)
+	if err != nil {
+		return err
+	}
+	ses.setAuditFilters(filters)
+	return nil
+}
+
+// auditFilterExists checks the filter has been in the account or not
+func auditFilterExists(ctx context.Context, bh BackgroundExec, filterName string) (bool, error) {
+	sql, err := getSqlForCheckAuditFilter(ctx, filterName)
+	if err != nil {
+		return false, err
+	}
+	bh.ClearExecResultSet()
+	err = bh.Exec(ctx, sql)
+	if err != nil {
+		return false, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return false, err
+	}
+	return execResultArrayHasData(erArray), nil
+}
+
+// doCreateAuditFilter accomplishes the CreateAuditFilter statement
+func doCreateAuditFilter(ctx context.Context, ses *Session, caf *tree.CreateAuditFilter) error {
+	var (
+		err    error
+		exists bool
+		sql    string
+		events []string
+		users  []string
+	)
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	tenantInfo := ses.GetTenantInfo()
+	if !tenantInfo.IsAdminRole() {
+		return moerr.NewInternalError(ctx, "only admin can create audit filter")
+	}
+
+	for _, event := range caf.Events {
+		class := strings.ToLower(string(event))
+		if _, ok := auditClasses[class]; !ok {
+			return moerr.NewInternalError(ctx, "invalid audit event %s, it should be one of connection, ddl, dcl and dml", event)
+		}
+		events = append(events, class)
+	}
+	for _, user := range caf.Users {
+		err = inputNameIsInvalid(ctx, string(user))
+		if err != nil {
+			return err
+		}
+		users = append(users, string(user))
+	}
+
+	err = bh.Exec(ctx, "begin;")
+	if err != nil {
+		goto handleFailed
+	}
+
+	exists, err = auditFilterExists(ctx, bh, string(caf.Name))
+	if err != nil {
+		goto handleFailed
+	}
+	if exists {
+		if !caf.IfNotExists {
+			err = moerr.NewInternalError(ctx, "the audit filter %s has already existed", caf.Name)
+			goto handleFailed
+		}
+	} else {
+		sql = getSqlForInsertAuditFilter(string(caf.Name), events, users,
+			int64(tenantInfo.GetUserID()), types.CurrentTimestamp().String2(time.UTC, 0))
+		err = bh.Exec(ctx, sql)
+		if err != nil {
+			goto handleFailed
+		}
+	}
+
+	err = refreshAuditFilters(ctx, ses, bh)
+	if err != nil {
+		goto handleFailed
+	}
+
+	err = bh.Exec(ctx, "commit;")
+	if err != nil {
+		goto handleFailed
+	}
+	return err
+handleFailed:
+	//ROLLBACK the transaction
+	rbErr := bh.Exec(ctx, "rollback;")
+	if rbErr != nil {
+		return rbErr
+	}
+	return err
+}
+
+// doDropAuditFilter accomplishes the DropAuditFilter statement
+func doDropAuditFilter(ctx context.Context, ses *Session, daf *tree.DropAuditFilter) error {
+	var (
+		err    error
+		exists bool
+	)
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	if !ses.GetTenantInfo().IsAdminRole() {
+		return moerr.NewInternalError(ctx, "only admin can drop audit filter")
+	}
+
+	err = bh.Exec(ctx, "begin;")
+	if err != nil {
+		goto handleFailed
+	}
+
+	exists, err = auditFilterExists(ctx, bh, string(daf.Name))
+	if err != nil {
+		goto handleFailed
+	}
+	if !exists {
+		if !daf.IfExists {
+			err = moerr.NewInternalError(ctx, "there is no audit filter %s", daf.Name)
+			goto handleFailed
+		}
+	} else {
+		err = bh.Exec(ctx, getSqlForDeleteAuditFilter(string(daf.Name)))
+		if err != nil {
+			goto handleFailed
+		}
+	}
+
+	err = refreshAuditFilters(ctx, ses, bh)
+	if err != nil {
+		goto handleFailed
+	}
+
+	err = bh.Exec(ctx, "commit;")
+	if err != nil {
+		goto handleFailed
+	}
+	return err
+handleFailed:
+	//ROLLBACK the transaction
+	rbErr := bh.Exec(ctx, "rollback;")
+	if rbErr != nil {
+		return rbErr
+	}
+	return err
+}
+
+// getAuditClassOfStatement returns the class of the statement.
+// It is empty if the statement is not audited.
+func getAuditClassOfStatement(stmt tree.Statement) string {
+	switch stmt.(type) {
+	case *tree.SetRole, *tree.SetDefaultRole, *tree.SetPassword:
+		return motrace.AuditClassDCL
+	}
+	switch stmt.GetQueryType() {
+	case tree.QueryTypeDDL:
+		return motrace.AuditClassDDL
+	case tree.QueryTypeDCL:
+		return motrace.AuditClassDCL
+	case tree.QueryTypeDML:
+		return motrace.AuditClassDML
+	}
+	return ""
+}
+
+// newAuditRecordOfSession fills the audit record with the information of the session
+func newAuditRecordOfSession(ses *Session, class, event string) *motrace.AuditRecord {
+	r := motrace.NewAuditRecord(class, event)
+	copy(r.SessionID[:], ses.GetUUID())
+	if tenant := ses.GetTenantInfo(); tenant != nil {
+		r.Account = tenant.GetTenant()
+		r.User = tenant.GetUser()
+		r.Role = tenant.GetDefaultRole()
+	}
+	ses.mu.Lock()
+	proto := ses.protocol
+	ses.mu.Unlock()
+	if mp, ok := proto.(MysqlProtocol); ok {
+		r.Host = mp.Peer()
+		r.Database = mp.GetDatabaseName()
+	}
+	return r
+}
+
+func reportAudit(ctx context.Context, ses *Session, r *motrace.AuditRecord) {
+	if err := motrace.ReportAudit(ctx, r); err != nil {
+		logErrorf(ses.GetDebugString(), "report the audit record failed. error:%v", err)
+	}
+}
+
+// auditStatement records the ddl, dcl and dml statements that the user inputs
+func auditStatement(ctx context.Context, ses *Session, stmt tree.Statement, err error) {
+	if !motrace.IsAuditEnable() || stmt == nil || ses.IsBackgroundSession() || !ses.GetFromRealUser() {
+		return
+	}
+	tenant := ses.GetTenantInfo()
+	if tenant == nil {
+		return
+	}
+	class := getAuditClassOfStatement(stmt)
+	if len(class) == 0 || !auditFilterMatched(ses.getAuditFilters(), class, tenant.GetUser()) {
+		return
+	}
+	r := newAuditRecordOfSession(ses, class, stmt.GetStatementType())
+	//the formatted statement does not have the passwords and the secret keys
+	r.Statement = tree.String(stmt, dialect.MYSQL)
+	r.Error = err
+	reportAudit(ctx, ses, r)
+}
+
+// auditLogin records the login. The failed logins are always recorded.
+func auditLogin(ctx context.Context, ses *Session, userInput string, err error) {
+	if !motrace.IsAuditEnable() || ses == nil {
+		return
+	}
+	r := newAuditRecordOfSession(ses, motrace.AuditClassConnection, motrace.AuditEventLogin)
+	if len(r.User) == 0 {
+		r.User = userInput
+	}
+	if err == nil && !auditFilterMatched(ses.getAuditFilters(), motrace.AuditClassConnection, r.User) {
+		return
+	}
+	r.Error = err
+	reportAudit(ctx, ses, r)
+}
+
+// auditLogout records the logout of the user who has logged in
+func auditLogout(ctx context.Context, ses *Session) {
+	if !motrace.IsAuditEnable() || ses.GetTenantInfo() == nil {
+		return
+	}
+	r := newAuditRecordOfSession(ses, motrace.AuditClassConnection, motrace.AuditEventLogout)
+	if !auditFilterMatched(ses.getAuditFilters(), motrace.AuditClassConnection, r.User) {
+		return
+	}
+	reportAudit(ctx, ses, r)
+}
@@ -0,0 +1,197 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/util/trace/impl/motrace"
+	"github.com/prashantv/gostub"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func newMrsForAuditFilters(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+
+	col1 := &MysqlColumn{}
+	col1.SetName("filter_name")
+	col1.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+
+	col2 := &MysqlColumn{}
+	col2.SetName("event_classes")
+	col2.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+
+	col3 := &MysqlColumn{}
+	col3.SetName("users")
+	col3.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+
+	mrs.AddColumn(col1)
+	mrs.AddColumn(col2)
+	mrs.AddColumn(col3)
+
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+
+	return mrs
+}
+
+func Test_auditFilterMatched(t *testing.T) {
+	convey.Convey("match the audit filters", t, func() {
+		convey.So(auditFilterMatched(nil, motrace.AuditClassDML, "u1"), convey.ShouldBeTrue)
+
+		filters := []*auditFilter{
+			{name: "f1", events: []string{motrace.AuditClassDDL, motrace.AuditClassDCL}},
+			{name: "f2", events: []string{motrace.AuditClassDML}, users: []string{"u1"}},
+		}
+		convey.So(auditFilterMatched(filters, motrace.AuditClassDDL, "u2"), convey.ShouldBeTrue)
+		convey.So(auditFilterMatched(filters, motrace.AuditClassDML, "u1"), convey.ShouldBeTrue)
+		convey.So(auditFilterMatched(filters, motrace.AuditClassDML, "u2"), convey.ShouldBeFalse)
+		convey.So(auditFilterMatched(filters, motrace.AuditClassConnection, "u1"), convey.ShouldBeFalse)
+	})
+}
+
+func Test_getAuditClassOfStatement(t *testing.T) {
+	convey.Convey("the class of the statement", t, func() {
+		kases := []struct {
+			sql   string
+			class string
+		}{
+			{"create table t(a int)", motrace.AuditClassDDL},
+			{"drop database db", motrace.AuditClassDDL},
+			{"grant select on table t to r1", motrace.AuditClassDCL},
+			{"create user u1 identified by '123'", motrace.AuditClassDCL},
+			{"set role r1", motrace.AuditClassDCL},
+			{"insert into t values (1)", motrace.AuditClassDML},
+			{"delete from t", motrace.AuditClassDML},
+			{"select * from t", ""},
+			{"begin", ""},
+		}
+		for _, kase := range kases {
+			stmt, err := parsers.ParseOne(context.TODO(), dialect.MYSQL, kase.sql, 1)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(getAuditClassOfStatement(stmt), convey.ShouldEqual, kase.class)
+		}
+	})
+}
+
+func Test_doCreateAuditFilter(t *testing.T) {
+	convey.Convey("create audit filter", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+		defer bhStub.Reset()
+
+		stmt, err := parsers.ParseOne(context.TODO(), dialect.MYSQL, "create audit filter f1 events DDL, dml for user u1, u2", 1)
+		convey.So(err, convey.ShouldBeNil)
+		caf := stmt.(*tree.CreateAuditFilter)
+
+		priv := determinePrivilegeSetOfStatement(caf)
+		ses := newSes(priv, ctrl)
+		ctx := ses.GetRequestContext()
+
+		bh.sql2result["begin;"] = nil
+		bh.sql2result["commit;"] = nil
+		bh.sql2result["rollback;"] = nil
+
+		sql, _ := getSqlForCheckAuditFilter(ctx, "f1")
+		bh.sql2result[sql] = newMrsForAuditFilters([][]interface{}{})
+		bh.sql2result[getSqlForAuditFilters()] = newMrsForAuditFilters([][]interface{}{
+			{"f1", "ddl,dml", "u1,u2"},
+		})
+
+		err = doCreateAuditFilter(ctx, ses, caf)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(bh.currentSql, convey.ShouldEqual, "commit;")
+		filters := ses.getAuditFilters()
+		convey.So(len(filters), convey.ShouldEqual, 1)
+		convey.So(filters[0].events, convey.ShouldResemble, []string{"ddl", "dml"})
+		convey.So(filters[0].users, convey.ShouldResemble, []string{"u1", "u2"})
+
+		//the filter has existed
+		bh.sql2result[sql] = newMrsForAuditFilters([][]interface{}{{"f1", "ddl,dml", "u1,u2"}})
+		err = doCreateAuditFilter(ctx, ses, caf)
+		convey.So(err, convey.ShouldNotBeNil)
+		caf.IfNotExists = true
+		err = doCreateAuditFilter(ctx, ses, caf)
+		convey.So(err, convey.ShouldBeNil)
+
+		//invalid event
+		caf.Events = append(caf.Events, tree.Identifier("dql"))
+		err = doCreateAuditFilter(ctx, ses, caf)
+		convey.So(err, convey.ShouldNotBeNil)
+
+		//only the admin can create the audit filter
+		ses.GetTenantInfo().DefaultRole = "r1"
+		err = doCreateAuditFilter(ctx, ses, caf)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+
+	convey.Convey("insert the audit filter", t, func() {
+		sql := getSqlForInsertAuditFilter("f1", []string{"ddl", "dml"}, nil, 1, "2023-01-01 00:00:00")
+		stmts, err := parsers.Parse(context.TODO(), dialect.MYSQL, sql, 1)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(stmts), convey.ShouldEqual, 1)
+	})
+}
+
+func Test_doDropAuditFilter(t *testing.T) {
+	convey.Convey("drop audit filter", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+		defer bhStub.Reset()
+
+		daf := &tree.DropAuditFilter{Name: tree.Identifier("f1")}
+		priv := determinePrivilegeSetOfStatement(daf)
+		ses := newSes(priv, ctrl)
+		ctx := ses.GetRequestContext()
+		ses.setAuditFilters([]*auditFilter{{name: "f1", events: []string{"ddl"}}})
+
+		bh.sql2result["begin;"] = nil
+		bh.sql2result["commit;"] = nil
+		bh.sql2result["rollback;"] = nil
+
+		sql, _ := getSqlForCheckAuditFilter(ctx, "f1")
+		bh.sql2result[sql] = newMrsForAuditFilters([][]interface{}{{"f1", "ddl", ""}})
+		bh.sql2result[getSqlForDeleteAuditFilter("f1")] = nil
+		bh.sql2result[getSqlForAuditFilters()] = newMrsForAuditFilters([][]interface{}{})
+
+		err := doDropAuditFilter(ctx, ses, daf)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(ses.getAuditFilters()), convey.ShouldEqual, 0)
+
+		bh.sql2result[sql] = newMrsForAuditFilters([][]interface{}{})
+		err = doDropAuditFilter(ctx, ses, daf)
+		convey.So(err, convey.ShouldNotBeNil)
+		daf.IfExists = true
+		err = doDropAuditFilter(ctx, ses, daf)
+		convey.So(err, convey.ShouldBeNil)
+	})
+}
@@ -732,12 +732,21 @@ var (
 		"mo_user_grant":               0,
 		"mo_role_grant":               0,
 		"mo_role_privs":               0,
+		"mo_role_column_privs":        0,
+		"mo_row_policies":             0,
+		"mo_audit_filters":            0,
+		"mo_mviews":                   0,
+		"mo_column_stats":             0,
+		"mo_account_variables":        0,
+		"mo_slow_query_log":           0,
 		"mo_user_defined_function":    0,
 		"mo_stored_procedure":         0,
 		"mo_mysql_compatibility_mode": 0,
 		catalog.AutoIncrTableName:     0,
 		"mo_indexes":                  0,
 		"mo_pubs":                     0,
+		"mo_user_password_policy":     0,
+		"mo_user_password_history":    0,
 	}
 	createAutoTableSql = fmt.Sprintf("create table `%s`(name varchar(770) primary key, offset bigint unsigned, step bigint unsigned);", catalog.AutoIncrTableName)
 	// mo_indexes is a data dictionary table, must be created first when creating tenants, and last when deleting tenants
@@ -820,6 +829,91 @@ var (
 				with_grant_option bool,
 				primary key(role_id, obj_type, obj_id, privilege_id, privilege_level)
 			);`,
+		`create table mo_role_column_privs(
+				role_id int signed,
+				role_name  varchar(100),
+				obj_id bigint unsigned,
+				column_name varchar(256),
+				privilege_id int,
+				privilege_name varchar(100),
+				operation_user_id int unsigned,
+				granted_time timestamp,
+				with_grant_option bool,
+				primary key(role_id, obj_id, column_name, privilege_id)
+			);`,
+		`create table mo_row_policies(
+				policy_id int auto_increment,
+				policy_name varchar(100),
+				table_id bigint unsigned,
+				database_name varchar(5000),
+				table_name varchar(5000),
+				command varchar(16),
+				role_name varchar(300),
+				predicate text,
+				creator int unsigned,
+				owner int unsigned,
+				created_time timestamp,
+				primary key(policy_id)
+			);`,
+		`create table mo_audit_filters(
+				filter_name varchar(64),
+				event_classes varchar(256),
+				users text,
+				creator int unsigned,
+				created_time timestamp,
+				primary key(filter_name)
+			);`,
+		`create table mo_mviews(
+				mview_id int auto_increment,
+				database_name varchar(5000),
+				view_name varchar(5000),
+				base_table_id bigint unsigned,
+				state_table varchar(5000),
+				definition text,
+				spec text,
+				creator int unsigned,
+				owner int unsigned,
+				created_time timestamp,
+				primary key(mview_id)
+			);`,
+		`create table mo_column_stats(
+				table_id bigint unsigned,
+				column_name varchar(256),
+				database_name varchar(5000),
+				table_name varchar(5000),
+				row_count bigint unsigned,
+				null_count bigint unsigned,
+				ndv bigint unsigned,
+				stats text,
+				analyzed_time timestamp,
+				primary key(table_id, column_name)
+			);`,
+		`create table mo_account_variables(
+				variable_name varchar(64),
+				variable_value varchar(1024),
+				primary key(variable_name)
+			);`,
+		`create table mo_slow_query_log(
+				statement_id varchar(36),
+				session_id varchar(36),
+				user_name varchar(300),
+				host varchar(100),
+				database_name varchar(5000),
+				start_time timestamp,
+				query_time double,
+				lock_time double,
+				rows_sent bigint,
+				rows_examined bigint,
+				bytes_scan bigint,
+				status varchar(32),
+				error text,
+				statement text,
+				normalized_statement text,
+				digest varchar(64),
+				exec_plan text,
+				stats text,
+				primary key(statement_id)
+			);`,
 		`create table mo_user_defined_function(
 				function_id int auto_increment,
 				name     varchar(100),
@@ -877,6 +971,25 @@ var (
 				database_collation varchar(64),
 				primary key(proc_id)
 			);`,
+		`create table mo_user_password_policy(
+				user_id int signed primary key,
+				password_lifetime int signed,
+				password_last_changed bigint,
+				password_expired bool,
+				password_history int signed,
+				password_reuse_interval int signed,
+				failed_login_attempts int signed,
+				password_lock_time int signed,
+				failed_login_count int signed,
+				locked_time bigint
+			);`,
+		`create table mo_user_password_history(
+				history_id int auto_increment,
+				user_id int signed,
+				authentication_string varchar(100),
+				password_time bigint,
+				primary key(history_id)
+			);`,
 	}
 
 	//drop tables for the tenant
@@ -886,9 +999,18 @@ var (
 		`drop table if exists mo_catalog.mo_user_grant;`,
 		`drop table if exists mo_catalog.mo_role_grant;`,
 		`drop table if exists mo_catalog.mo_role_privs;`,
+		`drop table if exists mo_catalog.mo_role_column_privs;`,
+		`drop table if exists mo_catalog.mo_row_policies;`,
+		`drop table if exists mo_catalog.mo_audit_filters;`,
+		`drop table if exists mo_catalog.mo_mviews;`,
+		`drop table if exists mo_catalog.mo_column_stats;`,
+		`drop table if exists mo_catalog.mo_account_variables;`,
+		`drop table if exists mo_catalog.mo_slow_query_log;`,
 		`drop table if exists mo_catalog.mo_user_defined_function;`,
 		`drop table if exists mo_catalog.mo_stored_procedure;`,
 		`drop table if exists mo_catalog.mo_mysql_compatibility_mode;`,
+		`drop table if exists mo_catalog.mo_user_password_policy;`,
+		`drop table if exists mo_catalog.mo_user_password_history;`,
 	}
 	dropMoPubsSql     = `drop table if exists mo_catalog.mo_pubs;`
 	deleteMoPubsSql   = `delete from mo_catalog.mo_pubs;`
@@ -1017,7 +1139,7 @@ const (
 
 	deleteAccountFromMoAccountFormat = `delete from mo_catalog.mo_account where account_name = "%s";`
 
-	getPasswordOfUserFormat = `select user_id,authentication_string,default_role from mo_catalog.mo_user where user_name = "%s";`
+	getPasswordOfUserFormat = `select user_id,authentication_string,default_role,status from mo_catalog.mo_user where user_name = "%s";`
 
 	updatePasswordOfUserFormat = `update mo_catalog.mo_user set authentication_string = "%s" where user_name = "%s";`
 
@@ -1078,6 +1200,31 @@ const (
        									    and privilege_id = %d 
        									    and privilege_level = "%s";`
 
+	checkRoleHasColumnPrivilegeFormat = `select role_id,with_grant_option from mo_catalog.mo_role_column_privs where role_id = %d and obj_id = %d and column_name = "%s" and privilege_id = %d;`
+
+	updateRoleColumnPrivsFormat = `update mo_catalog.mo_role_column_privs set operation_user_id = %d, granted_time = "%s", with_grant_option = %v where role_id = %d and obj_id = %d and column_name = "%s" and privilege_id = %d;`
+
+	insertRoleColumnPrivsFormat = `insert into mo_catalog.mo_role_column_privs(role_id,role_name,obj_id,column_name,privilege_id,privilege_name,operation_user_id,granted_time,with_grant_option)
+								values (%d,"%s",%d,"%s",%d,"%s",%d,"%s",%v);`
+
+	deleteRoleColumnPrivsFormat = `delete from mo_catalog.mo_role_column_privs
+       									where role_id = %d
+       									    and obj_id = %d
+       									    and column_name = "%s"
+       									    and privilege_id = %d;`
+
+	//the columns of the table that the privilege has been granted to the role
+	getColumnsOfRoleHasColumnPrivilegeFormat = `select rcp.column_name
+				from mo_catalog.mo_database d, mo_catalog.mo_tables t, mo_catalog.mo_role_column_privs rcp
+				where d.dat_id = t.reldatabase_id
+					and rcp.obj_id = t.rel_id
+					and rcp.role_id = %d
+					and rcp.privilege_id = %d
+					and d.datname = "%s"
+					and t.relname = "%s";`
+
+	checkColumnOfTableFormat = `select attname from mo_catalog.mo_columns where att_relname_id = %d and attname = "%s" and att_is_hidden = 0;`
+
 	checkDatabaseFormat = `select dat_id from mo_catalog.mo_database where datname = "%s";`
 
 	checkDatabaseTableFormat = `select t.rel_id from mo_catalog.mo_database d, mo_catalog.mo_tables t
@@ -1244,11 +1391,17 @@ const (
 
 	deleteRoleFromMoRolePrivsFormat = `delete from mo_catalog.mo_role_privs where role_id = %d;`
 
+	deleteRoleFromMoRoleColumnPrivsFormat = `delete from mo_catalog.mo_role_column_privs where role_id = %d;`
+
 	//delete user from mo_user,mo_user_grant
 	deleteUserFromMoUserFormat = `delete from mo_catalog.mo_user where user_id = %d;`
 
 	deleteUserFromMoUserGrantFormat = `delete from mo_catalog.mo_user_grant where user_id = %d;`
 
+	deleteUserFromMoUserPasswordPolicyFormat = `delete from mo_catalog.mo_user_password_policy where user_id = %d;`
+
+	deleteUserFromMoUserPasswordHistoryFormat = `delete from mo_catalog.mo_user_password_history where user_id = %d;`
+
 	// delete user defined function from mo_user_defined_function
 	deleteUserDefinedFunctionFormat = `delete from mo_catalog.mo_user_defined_function where function_id = %d;`
 
@@ -1526,6 +1679,38 @@ func getSqlForDeleteRolePrivs(roleId int64, objType string, objId, privilegeId i
 	return fmt.Sprintf(deleteRolePrivsFormat, roleId, objType, objId, privilegeId, privilegeLevel)
 }
 
+func getSqlForCheckRoleHasColumnPrivilege(roleId, objId int64, columnName string, privilegeId int64) string {
+	return fmt.Sprintf(checkRoleHasColumnPrivilegeFormat, roleId, objId, columnName, privilegeId)
+}
+
+func getSqlForUpdateRoleColumnPrivs(userId int64, timestamp string, withGrantOption bool, roleId, objId int64, columnName string, privilegeId int64) string {
+	return fmt.Sprintf(updateRoleColumnPrivsFormat, userId, timestamp, withGrantOption, roleId, objId, columnName, privilegeId)
+}
+
+func getSqlForInsertRoleColumnPrivs(roleId int64, roleName string, objId int64, columnName string, privilegeId int64, privilegeName string, operationUserId int64, grantedTime string, withGrantOption bool) string {
+	return fmt.Sprintf(insertRoleColumnPrivsFormat, roleId, roleName, objId, columnName, privilegeId, privilegeName, operationUserId, grantedTime, withGrantOption)
+}
+
+func getSqlForDeleteRoleColumnPrivs(roleId, objId int64, columnName string, privilegeId int64) string {
+	return fmt.Sprintf(deleteRoleColumnPrivsFormat, roleId, objId, columnName, privilegeId)
+}
+
+func getSqlForColumnsOfRoleHasColumnPrivilege(ctx context.Context, roleId int64, privId PrivilegeType, dbName, tableName string) (string, error) {
+	err := inputNameIsInvalid(ctx, dbName, tableName)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(getColumnsOfRoleHasColumnPrivilegeFormat, roleId, privId, dbName, tableName), nil
+}
+
+func getSqlForCheckColumnOfTable(ctx context.Context, tableId int64, columnName string) (string, error) {
+	err := inputNameIsInvalid(ctx, columnName)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(checkColumnOfTableFormat, tableId, columnName), nil
+}
+
 func getSqlForCheckWithGrantOptionForTableStarStar(roleId int64, privId PrivilegeType) string {
 	return fmt.Sprintf(checkWithGrantOptionForTableStarStar, objectTypeTable, roleId, privId, privilegeLevelStarStar)
 }
@@ -1680,6 +1865,7 @@ func getSqlForDeleteRole(roleId int64) []string {
 		fmt.Sprintf(deleteRoleFromMoUserGrantFormat, roleId),
 		fmt.Sprintf(deleteRoleFromMoRoleGrantFormat, roleId, roleId),
 		fmt.Sprintf(deleteRoleFromMoRolePrivsFormat, roleId),
+		fmt.Sprintf(deleteRoleFromMoRoleColumnPrivsFormat, roleId),
 	}
 }
 
@@ -1691,6 +1877,8 @@ func getSqlForDeleteUser(userId int64) []string {
 	return []string{
 		fmt.Sprintf(deleteUserFromMoUserFormat, userId),
 		fmt.Sprintf(deleteUserFromMoUserGrantFormat, userId),
+		fmt.Sprintf(deleteUserFromMoUserPasswordPolicyFormat, userId),
+		fmt.Sprintf(deleteUserFromMoUserPasswordHistoryFormat, userId),
 	}
 }
 
@@ -1802,6 +1990,9 @@ type privilegeItem struct {
 	tableName             string
 	isClusterTable        bool
 	clusterTableOperation clusterTableOperationType
+	//the columns that the statement operates on.
+	//it is used to check the column level privilege.
+	columns []string
 }
 
 // compoundEntry is the entry has multi privilege items
@@ -2340,6 +2531,12 @@ func doAlterUser(ctx context.Context, ses *Session, au *tree.AlterUser) error {
 	var password string
 	var erArray []ExecResult
 	var encryption string
+	var isAdmin bool
+	var policy *passwordPolicy
+	var status string
+	var now int64
+	var plugin string
+	var authString string
 	account := ses.GetTenantInfo()
 	currentUser := account.User
 
@@ -2347,9 +2544,6 @@ func doAlterUser(ctx context.Context, ses *Session, au *tree.AlterUser) error {
 	if au.Role != nil {
 		return moerr.NewInternalError(ctx, "not support alter role")
 	}
-	if au.MiscOpt != nil {
-		return moerr.NewInternalError(ctx, "not support password or lock operation")
-	}
 	if au.CommentOrAttribute.Exist {
 		return moerr.NewInternalError(ctx, "not support alter comment or attribute")
 	}
@@ -2368,25 +2562,27 @@ func doAlterUser(ctx context.Context, ses *Session, au *tree.AlterUser) error {
 	user = au.Users[0]
 	userName = user.Username
 	hostName = user.Hostname
-	password = user.AuthOption.Str
-	if len(password) == 0 {
-		err = moerr.NewInternalError(ctx, "password is empty string")
-		goto handleFailed
-	}
 	//put it into the single transaction
 	err = bh.Exec(ctx, "begin")
 	if err != nil {
 		goto handleFailed
 	}
 
-	if user.AuthOption == nil {
+	if user.AuthOption == nil && au.MiscOpt == nil {
 		err = moerr.NewInternalError(ctx, "Operation ALTER USER failed for '%s'@'%s', alter Auth is nil", userName, hostName)
 		goto handleFailed
 	}
 
-	if user.AuthOption.Typ != tree.AccountIdentifiedByPassword {
-		err = moerr.NewInternalError(ctx, "Operation ALTER USER failed for '%s'@'%s', only support alter Auth by identified by", userName, hostName)
-		goto handleFailed
+	if user.AuthOption != nil {
+		if user.AuthOption.Typ != tree.AccountIdentifiedByPassword {
+			err = moerr.NewInternalError(ctx, "Operation ALTER USER failed for '%s'@'%s', only support alter Auth by identified by", userName, hostName)
+			goto handleFailed
+		}
+		password = user.AuthOption.Str
+		if len(password) == 0 {
+			err = moerr.NewInternalError(ctx, "password is empty string")
+			goto handleFailed
+		}
 	}
 
 	//check the user exists or not
@@ -2411,6 +2607,22 @@ func doAlterUser(ctx context.Context, ses *Session, au *tree.AlterUser) error {
 		}
 	}
 
+	//a plain IDENTIFIED BY keeps the plugin the user authenticates with
+	if user.AuthOption != nil {
+		plugin = user.AuthOption.AuthPlugin
+		if len(plugin) == 0 {
+			erArray, err = getResultSet(ctx, bh)
+			if err != nil {
+				goto handleFailed
+			}
+			authString, err = erArray[0].GetString(ctx, 0, 1)
+			if err != nil {
+				goto handleFailed
+			}
+			plugin = getAuthPluginOfAuthString(authString)
+		}
+	}
+
 	//if the user is admin user with the role moadmin or accountadmin,
 	//the user can be altered
 	//otherwise only general user can alter itself
@@ -2434,23 +2646,42 @@ func doAlterUser(ctx context.Context, ses *Session, au *tree.AlterUser) error {
 		goto handleFailed
 	}
 
-	//encryption the password
-	encryption = HashPassWord(password)
+	isAdmin = execResultArrayHasData(erArray)
+	if !isAdmin {
+		if currentUser != userName {
+			err = moerr.NewInternalError(ctx, "Operation ALTER USER failed for '%s'@'%s', don't have the privilege to alter", userName, hostName)
+			goto handleFailed
+		}
+		//the general user can only change its own password
+		if au.MiscOpt != nil {
+			err = moerr.NewInternalError(ctx, "Operation ALTER USER failed for '%s'@'%s', don't have the privilege to alter the password or lock option", userName, hostName)
+			goto handleFailed
+		}
+	}
 
-	if execResultArrayHasData(erArray) {
-		sql, err = getSqlForUpdatePasswordOfUser(ctx, encryption, userName)
+	policy, err = getPasswordPolicyOfUser(ctx, bh, vr.id)
+	if err != nil {
+		goto handleFailed
+	}
+	now = time.Now().Unix()
+
+	if user.AuthOption != nil {
+		err = validatePasswordComplexity(ctx, ses, userName, password)
 		if err != nil {
 			goto handleFailed
 		}
-		err = bh.Exec(ctx, sql)
+
+		err = checkPasswordHistory(ctx, ses, bh, policy, userName, password, now)
 		if err != nil {
 			goto handleFailed
 		}
-	} else {
-		if currentUser != userName {
-			err = moerr.NewInternalError(ctx, "Operation ALTER USER failed for '%s'@'%s', don't have the privilege to alter", userName, hostName)
+
+		//encryption the password
+		encryption, err = HashPassWordWithPlugin(ctx, plugin, password)
+		if err != nil {
 			goto handleFailed
 		}
+
 		sql, err = getSqlForUpdatePasswordOfUser(ctx, encryption, userName)
 		if err != nil {
 			goto handleFailed
@@ -2459,6 +2690,31 @@ func doAlterUser(ctx context.Context, ses *Session, au *tree.AlterUser) error {
 		if err != nil {
 			goto handleFailed
 		}
+
+		err = bh.Exec(ctx, getSqlForInsertPasswordHistory(vr.id, encryption, now))
+		if err != nil {
+			goto handleFailed
+		}
+		policy.lastChanged = now
+		policy.expired = false
+	}
+
+	if au.MiscOpt != nil {
+		status, err = policy.applyOption(ctx, au.MiscOpt)
+		if err != nil {
+			goto handleFailed
+		}
+		if len(status) != 0 {
+			err = bh.Exec(ctx, getSqlForUpdateStatusOfUser(status, vr.id))
+			if err != nil {
+				goto handleFailed
+			}
+		}
+	}
+
+	err = bh.Exec(ctx, getSqlForSavePasswordPolicy(policy))
+	if err != nil {
+		goto handleFailed
 	}
 
 	err = bh.Exec(ctx, "commit;")
@@ -2466,6 +2722,10 @@ func doAlterUser(ctx context.Context, ses *Session, au *tree.AlterUser) error {
 		goto handleFailed
 	}
 
+	//the session leaves the sandbox mode after the password has been changed
+	if user.AuthOption != nil && currentUser == userName {
+		ses.setPasswordExpired(false)
+	}
 	return err
 
 handleFailed:
@@ -3965,6 +4225,12 @@ func doRevokePrivilege(ctx context.Context, ses *Session, rp *tree.RevokePrivile
 		if err != nil {
 			goto handleFailed
 		}
+		if len(priv.ColumnList) != 0 {
+			err = checkColumnPrivilege(ctx, privType, *rp.Level)
+			if err != nil {
+				goto handleFailed
+			}
+		}
 		checkedPrivilegeTypes[i] = privType
 	}
 
@@ -3975,7 +4241,21 @@ func doRevokePrivilege(ctx context.Context, ses *Session, rp *tree.RevokePrivile
 	}
 
 	//step 3: delete the granted privilege
-	for _, privType = range checkedPrivilegeTypes {
+	for i := range checkedPrivilegeTypes {
+		privType = checkedPrivilegeTypes[i]
+		//the column level privilege
+		if len(rp.Privileges[i].ColumnList) != 0 {
+			err = revokeColumnPrivilege(ctx, bh, verifiedRoles, privType, objId, rp.Privileges[i].ColumnList)
+			if err != nil {
+				goto handleFailed
+			}
+			//the plans cached before checked the old privileges of the columns
+			err = bumpCatalogVersionOfTableById(ctx, ses, uint64(objId))
+			if err != nil {
+				goto handleFailed
+			}
+			continue
+		}
 		for _, role := range verifiedRoles {
 			if role == nil {
 				continue
@@ -4176,6 +4456,123 @@ func matchPrivilegeTypeWithObjectType(ctx context.Context, privType PrivilegeTyp
 	return err
 }
 
+// isColumnPrivilegeType checks the privilege can be granted on the columns or not
+func isColumnPrivilegeType(privType PrivilegeType) bool {
+	switch privType {
+	case PrivilegeTypeSelect, PrivilegeTypeInsert, PrivilegeTypeUpdate:
+		return true
+	}
+	return false
+}
+
+// checkColumnPrivilege checks the privilege type and the privilege level of the column level privilege
+func checkColumnPrivilege(ctx context.Context, privType PrivilegeType, pl tree.PrivilegeLevel) error {
+	if !isColumnPrivilegeType(privType) {
+		return moerr.NewInternalError(ctx, `the privilege "%s" can not be granted on the columns`, privType)
+	}
+	switch pl.Level {
+	case tree.PRIVILEGE_LEVEL_TYPE_DATABASE_TABLE, tree.PRIVILEGE_LEVEL_TYPE_TABLE:
+		return nil
+	}
+	return moerr.NewInternalError(ctx, `the column level privilege "%s" can only be granted on a table`, privType)
+}
+
+// normalizeColumnsOfPrivilege converts the column names into the lower case and checks the columns exist in the table
+func normalizeColumnsOfPrivilege(ctx context.Context, bh BackgroundExec, tableId int64, columns []*tree.UnresolvedName) ([]string, error) {
+	var erArray []ExecResult
+	names := make([]string, 0, len(columns))
+	dedup := make(map[string]int8)
+	for _, column := range columns {
+		_, _, name := column.GetNames()
+		name = strings.ToLower(name)
+		if _, ok := dedup[name]; ok {
+			continue
+		}
+		dedup[name] = 1
+		sql, err := getSqlForCheckColumnOfTable(ctx, tableId, name)
+		if err != nil {
+			return nil, err
+		}
+		bh.ClearExecResultSet()
+		err = bh.Exec(ctx, sql)
+		if err != nil {
+			return nil, err
+		}
+		erArray, err = getResultSet(ctx, bh)
+		if err != nil {
+			return nil, err
+		}
+		if !execResultArrayHasData(erArray) {
+			return nil, moerr.NewInternalError(ctx, `there is no column "%s" in the table`, name)
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// grantColumnPrivilege grants the privilege on the columns of the table to the roles
+func grantColumnPrivilege(ctx context.Context, bh BackgroundExec, account *TenantInfo, roles []*verifiedRole,
+	privType PrivilegeType, tableId int64, columns []*tree.UnresolvedName, withGrantOption bool) error {
+	var erArray []ExecResult
+	names, err := normalizeColumnsOfPrivilege(ctx, bh, tableId, columns)
+	if err != nil {
+		return err
+	}
+	for _, role := range roles {
+		for _, name := range names {
+			//check exists
+			sql := getSqlForCheckRoleHasColumnPrivilege(role.id, tableId, name, int64(privType))
+			bh.ClearExecResultSet()
+			err = bh.Exec(ctx, sql)
+			if err != nil {
+				return err
+			}
+			erArray, err = getResultSet(ctx, bh)
+			if err != nil {
+				return err
+			}
+
+			if execResultArrayHasData(erArray) { //update the record
+				sql = getSqlForUpdateRoleColumnPrivs(int64(account.GetUserID()),
+					types.CurrentTimestamp().String2(time.UTC, 0),
+					withGrantOption, role.id, tableId, name, int64(privType))
+			} else { //insert new record
+				sql = getSqlForInsertRoleColumnPrivs(role.id, role.name, tableId, name,
+					int64(privType), privType.String(), int64(account.GetUserID()),
+					types.CurrentTimestamp().String2(time.UTC, 0), withGrantOption)
+			}
+			bh.ClearExecResultSet()
+			err = bh.Exec(ctx, sql)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// revokeColumnPrivilege revokes the privilege on the columns of the table from the roles
+func revokeColumnPrivilege(ctx context.Context, bh BackgroundExec, roles []*verifiedRole,
+	privType PrivilegeType, tableId int64, columns []*tree.UnresolvedName) error {
+	names, err := normalizeColumnsOfPrivilege(ctx, bh, tableId, columns)
+	if err != nil {
+		return err
+	}
+	for _, role := range roles {
+		if role == nil {
+			continue
+		}
+		for _, name := range names {
+			bh.ClearExecResultSet()
+			err = bh.Exec(ctx, getSqlForDeleteRoleColumnPrivs(role.id, tableId, name, int64(privType)))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // doGrantPrivilege accomplishes the GrantPrivilege statement
 func doGrantPrivilege(ctx context.Context, ses *Session, gp *tree.GrantPrivilege) error {
 	var err error
@@ -4267,6 +4664,12 @@ func doGrantPrivilege(ctx context.Context, ses *Session, gp *tree.GrantPrivilege
 		if err != nil {
 			goto handleFailed
 		}
+		if len(priv.ColumnList) != 0 {
+			err = checkColumnPrivilege(ctx, privType, *gp.Level)
+			if err != nil {
+				goto handleFailed
+			}
+		}
 		checkedPrivilegeTypes[i] = privType
 	}
 
@@ -4281,7 +4684,21 @@ func doGrantPrivilege(ctx context.Context, ses *Session, gp *tree.GrantPrivilege
 	//step 5: check exists
 	//step 6: update or insert
 
-	for _, privType = range checkedPrivilegeTypes {
+	for i := range checkedPrivilegeTypes {
+		privType = checkedPrivilegeTypes[i]
+		//the column level privilege
+		if len(gp.Privileges[i].ColumnList) != 0 {
+			err = grantColumnPrivilege(ctx, bh, account, verifiedRoles, privType, objId, gp.Privileges[i].ColumnList, gp.GrantOption)
+			if err != nil {
+				goto handleFailed
+			}
+			//the plans cached before checked the old privileges of the columns
+			err = bumpCatalogVersionOfTableById(ctx, ses, uint64(objId))
+			if err != nil {
+				goto handleFailed
+			}
+			continue
+		}
 		for _, role := range verifiedRoles {
 			sql := getSqlForCheckRoleHasPrivilege(role.id, objType, objId, int64(privType))
 			//check exists
@@ -4911,11 +5328,35 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 
 	case *tree.AlterTable:
 		objType = objectTypeDatabase
-		typs = append(typs, PrivilegeTypeAlterTable, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
 		writeDatabaseAndTableDirectly = true
 		if st.Table != nil {
 			dbName = string(st.Table.SchemaName)
 		}
+		if exchange := getExchangePartitionOption(st); exchange != nil {
+			//exchanging a partition moves the rows of both tables.
+			//it needs the privilege to alter the table and the privileges
+			//to insert and to delete on both tables.
+			typs = append(typs, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
+			exchangeDbName := string(exchange.ExchangeTable.SchemaName)
+			if len(exchangeDbName) == 0 {
+				exchangeDbName = dbName
+			}
+			me := &compoundEntry{
+				items: []privilegeItem{
+					{privilegeTyp: PrivilegeTypeAlterTable, dbName: dbName},
+					{privilegeTyp: PrivilegeTypeInsert, dbName: dbName, tableName: string(st.Table.ObjectName)},
+					{privilegeTyp: PrivilegeTypeDelete, dbName: dbName, tableName: string(st.Table.ObjectName)},
+					{privilegeTyp: PrivilegeTypeInsert, dbName: exchangeDbName, tableName: string(exchange.ExchangeTable.ObjectName)},
+					{privilegeTyp: PrivilegeTypeDelete, dbName: exchangeDbName, tableName: string(exchange.ExchangeTable.ObjectName)},
+				},
+			}
+			extraEntries = append(extraEntries, privilegeEntry{
+				privilegeEntryTyp: privilegeEntryTypeCompound,
+				compound:          me,
+			})
+		} else {
+			typs = append(typs, PrivilegeTypeAlterTable, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
+		}
 	case *tree.CreateProcedure:
 		objType = objectTypeDatabase
 		typs = append(typs, PrivilegeTypeCreateView, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
@@ -4938,6 +5379,21 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 		if len(st.Names) != 0 {
 			dbName = string(st.Names[0].SchemaName)
 		}
+	case *tree.CreateMaterializedView:
+		objType = objectTypeDatabase
+		typs = append(typs, PrivilegeTypeCreateView, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
+		writeDatabaseAndTableDirectly = true
+		dbName = string(st.Name.SchemaName)
+	case *tree.RefreshMaterializedView:
+		objType = objectTypeDatabase
+		typs = append(typs, PrivilegeTypeCreateView, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
+		writeDatabaseAndTableDirectly = true
+		dbName = string(st.Name.SchemaName)
+	case *tree.DropMaterializedView:
+		objType = objectTypeDatabase
+		typs = append(typs, PrivilegeTypeDropView, PrivilegeTypeDropObject, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
+		writeDatabaseAndTableDirectly = true
+		dbName = string(st.Name.SchemaName)
 	case *tree.DropSequence:
 		objType = objectTypeDatabase
 		typs = append(typs, PrivilegeTypeDropObject, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
@@ -4992,7 +5448,8 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 		*tree.ShowBackendServers:
 		objType = objectTypeNone
 		kind = privilegeKindNone
-	case *tree.ShowAccounts:
+	case *tree.ShowAccounts, *tree.Backup, *tree.Restore,
+		*tree.CreateChangefeed, *tree.DropChangefeed, *tree.ShowChangefeeds:
 		objType = objectTypeNone
 		kind = privilegeKindSpecial
 		special = specialTagAdmin
@@ -5030,13 +5487,18 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 	case *tree.MoDump:
 		objType = objectTypeTable
 		typs = append(typs, PrivilegeTypeSelect, PrivilegeTypeTableAll, PrivilegeTypeTableOwnership)
+	case *tree.AnalyzeStmt:
+		objType = objectTypeTable
+		typs = append(typs, PrivilegeTypeSelect, PrivilegeTypeTableAll, PrivilegeTypeTableOwnership)
 	case *tree.Kill:
 		objType = objectTypeNone
 		kind = privilegeKindNone
 	case *tree.LockTableStmt, *tree.UnLockTableStmt:
 		objType = objectTypeNone
 		kind = privilegeKindNone
-	case *tree.CreatePublication, *tree.DropPublication, *tree.AlterPublication:
+	case *tree.CreatePublication, *tree.DropPublication, *tree.AlterPublication,
+		*tree.CreatePolicy, *tree.DropPolicy,
+		*tree.CreateAuditFilter, *tree.DropAuditFilter:
 		typs = append(typs, PrivilegeTypeAccountAll)
 		objType = objectTypeDatabase
 		kind = privilegeKindNone
@@ -5067,6 +5529,7 @@ type privilegeTips struct {
 	tableName             string
 	isClusterTable        bool
 	clusterTableOperation clusterTableOperationType
+	columns               []string
 }
 
 type privilegeTipsArray []privilegeTips
@@ -5136,6 +5599,12 @@ func extractPrivilegeTipsFromPlan(p *plan2.Plan) privilegeTipsArray {
 						clusterTableOperation = clusterTableSelect
 					}
 
+					//the columns of the table after the column pruning
+					var columns []string
+					if scanTyp == PrivilegeTypeSelect {
+						columns = getVisibleColumnsOfTableDef(node.TableDef)
+					}
+
 					//do not check the privilege of the index table
 					if !isIndexTable(node.ObjRef.GetObjName()) {
 						appendPt(privilegeTips{
@@ -5144,12 +5613,28 @@ func extractPrivilegeTipsFromPlan(p *plan2.Plan) privilegeTipsArray {
 							tableName:             node.ObjRef.GetObjName(),
 							isClusterTable:        clusterTable,
 							clusterTableOperation: clusterTableOperation,
+							columns:               columns,
 						})
+						//the columns read by the update or the delete need the select privilege
+						if scanTyp != PrivilegeTypeSelect && len(node.ReadCols) != 0 {
+							appendPt(privilegeTips{
+								typ:                   PrivilegeTypeSelect,
+								databaseName:          node.ObjRef.GetSchemaName(),
+								tableName:             node.ObjRef.GetObjName(),
+								isClusterTable:        clusterTable,
+								clusterTableOperation: clusterTableSelect,
+								columns:               node.ReadCols,
+							})
+						}
 					}
 				}
 			} else if node.NodeType == plan.Node_INSERT {
 				if node.InsertCtx != nil && node.InsertCtx.Ref != nil {
 					objRef := node.InsertCtx.Ref
+					var columns []string
+					if t == PrivilegeTypeInsert {
+						columns = getVisibleColumnsOfTableDef(node.InsertCtx.TableDef)
+					}
 					//do not check the privilege of the index table
 					if !isIndexTable(node.ObjRef.GetObjName()) {
 						appendPt(privilegeTips{
@@ -5158,6 +5643,7 @@ func extractPrivilegeTipsFromPlan(p *plan2.Plan) privilegeTipsArray {
 							tableName:             objRef.GetObjName(),
 							isClusterTable:        node.InsertCtx.IsClusterTable,
 							clusterTableOperation: clusterTableModify,
+							columns:               columns,
 						})
 					}
 				}
@@ -5201,6 +5687,111 @@ func extractPrivilegeTipsFromPlan(p *plan2.Plan) privilegeTipsArray {
 	return pts
 }
 
+// getExchangePartitionOption returns the EXCHANGE PARTITION option of the alter table
+func getExchangePartitionOption(st *tree.AlterTable) *tree.AlterOptionPartition {
+	if st.Table == nil {
+		return nil
+	}
+	for _, option := range st.Options {
+		if opt, ok := option.(*tree.AlterOptionPartition); ok &&
+			opt.Typ == tree.AlterPartitionExchange && opt.ExchangeTable != nil {
+			return opt
+		}
+	}
+	return nil
+}
+
+// getVisibleColumnsOfTableDef gets the names of the columns except the hidden columns
+func getVisibleColumnsOfTableDef(tableDef *plan.TableDef) []string {
+	if tableDef == nil {
+		return nil
+	}
+	columns := make([]string, 0, len(tableDef.Cols))
+	for _, col := range tableDef.Cols {
+		if col.Hidden {
+			continue
+		}
+		columns = append(columns, strings.ToLower(col.Name))
+	}
+	return columns
+}
+
+// getTableNamesOfTableExpr collects the alias and the name of the tables in the table expr
+func getTableNamesOfTableExpr(expr tree.TableExpr, alias2Table map[string]string) {
+	switch te := expr.(type) {
+	case *tree.AliasedTableExpr:
+		if tn, ok := te.Expr.(*tree.TableName); ok {
+			name := string(tn.ObjectName)
+			alias2Table[name] = name
+			if len(te.As.Alias) != 0 {
+				alias2Table[string(te.As.Alias)] = name
+			}
+		} else {
+			getTableNamesOfTableExpr(te.Expr, alias2Table)
+		}
+	case *tree.TableName:
+		alias2Table[string(te.ObjectName)] = string(te.ObjectName)
+	case *tree.JoinTableExpr:
+		getTableNamesOfTableExpr(te.Left, alias2Table)
+		getTableNamesOfTableExpr(te.Right, alias2Table)
+	case *tree.ParenTableExpr:
+		getTableNamesOfTableExpr(te.Expr, alias2Table)
+	}
+}
+
+// fillColumnsOfPrivilegeTips completes the columns of the privilege tips
+// that can not be decided by the plan.
+// insert: the columns in the column list.
+// update: the columns in the set clause.
+func fillColumnsOfPrivilegeTips(arr privilegeTipsArray, stmt tree.Statement) privilegeTipsArray {
+	switch st := stmt.(type) {
+	case *tree.Insert:
+		if len(st.Columns) == 0 {
+			return arr
+		}
+		columns := make([]string, 0, len(st.Columns))
+		for _, col := range st.Columns {
+			columns = append(columns, strings.ToLower(string(col)))
+		}
+		for i := range arr {
+			if arr[i].typ == PrivilegeTypeInsert {
+				arr[i].columns = columns
+			}
+		}
+	case *tree.Update:
+		alias2Table := make(map[string]string)
+		for _, te := range st.Tables {
+			getTableNamesOfTableExpr(te, alias2Table)
+		}
+		tables := make(map[string]int8)
+		for _, tblName := range alias2Table {
+			tables[tblName] = 1
+		}
+		columnsOfTable := make(map[string][]string)
+		for _, expr := range st.Exprs {
+			for _, name := range expr.Names {
+				_, tblName, colName := name.GetNames()
+				if name, ok := alias2Table[tblName]; ok {
+					tblName = name
+				}
+				columnsOfTable[tblName] = append(columnsOfTable[tblName], strings.ToLower(colName))
+			}
+		}
+		for i := range arr {
+			if arr[i].typ != PrivilegeTypeUpdate {
+				continue
+			}
+			columns := columnsOfTable[arr[i].tableName]
+			//the column without the table name belongs to the only table in the update
+			if len(tables) == 1 {
+				columns = append(columns, columnsOfTable[""]...)
+			}
+			arr[i].columns = columns
+		}
+	}
+	return arr
+}
+
 // convertPrivilegeTipsToPrivilege constructs the privilege entries from the privilege tips from the plan
 func convertPrivilegeTipsToPrivilege(priv *privilege, arr privilegeTipsArray) {
 	//rewirte the privilege entries based on privilege tips
@@ -5228,6 +5819,7 @@ func convertPrivilegeTipsToPrivilege(priv *privilege, arr privilegeTipsArray) {
 			tableName:             tips.tableName,
 			isClusterTable:        tips.isClusterTable,
 			clusterTableOperation: tips.clusterTableOperation,
+			columns:               tips.columns,
 		})
 
 		dedup[pair{tips.databaseName, tips.tableName}] = 1
@@ -5393,6 +5985,47 @@ func verifyPrivilegeEntryInMultiPrivilegeLevels(
 	return false, nil
 }
 
+// verifyColumnPrivilegeOfRole checks the privilege on all the columns
+// in the privilege item has been granted to the role.
+func verifyColumnPrivilegeOfRole(ctx context.Context, bh BackgroundExec, ses *Session, roleId int64, mi privilegeItem) (bool, error) {
+	var erArray []ExecResult
+	var column string
+	dbName := mi.dbName
+	if len(dbName) == 0 {
+		dbName = ses.GetDatabaseName()
+	}
+	sql, err := getSqlForColumnsOfRoleHasColumnPrivilege(ctx, roleId, mi.privilegeTyp, dbName, mi.tableName)
+	if err != nil {
+		return false, err
+	}
+	bh.ClearExecResultSet()
+	err = bh.Exec(ctx, sql)
+	if err != nil {
+		return false, err
+	}
+	erArray, err = getResultSet(ctx, bh)
+	if err != nil {
+		return false, err
+	}
+	if !execResultArrayHasData(erArray) {
+		return false, nil
+	}
+	granted := make(map[string]int8)
+	for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+		column, err = erArray[0].GetString(ctx, i, 0)
+		if err != nil {
+			return false, err
+		}
+		granted[column] = 1
+	}
+	for _, column = range mi.columns {
+		if _, ok := granted[column]; !ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 // determineRoleSetHasPrivilegeSet decides the role set has at least one privilege of the privilege set.
 // The algorithm 2.
 func determineRoleSetHasPrivilegeSet(ctx context.Context, bh BackgroundExec, ses *Session, roleIds *btree.Set[int64], priv *privilege) (bool, error) {
@@ -5483,6 +6116,13 @@ func determineRoleSetHasPrivilegeSet(ctx context.Context, bh BackgroundExec, ses
 								if err != nil {
 									return false, err
 								}
+								//the privilege on the columns the statement operates on
+								if !yes && len(mi.columns) != 0 && isColumnPrivilegeType(mi.privilegeTyp) {
+									yes, err = verifyColumnPrivilegeOfRole(ctx, bh, ses, roleId, mi)
+									if err != nil {
+										return false, err
+									}
+								}
 							}
 						}
 						if !yes {
@@ -5966,6 +6606,7 @@ func authenticateUserCanExecuteStatementWithObjectTypeDatabaseAndTable(ctx conte
 		if len(arr) == 0 {
 			return true, nil
 		}
+		arr = fillColumnsOfPrivilegeTips(arr, stmt)
 		convertPrivilegeTipsToPrivilege(priv, arr)
 		ok, err := determineUserHasPrivilegeSet(ctx, ses, priv, stmt)
 		if err != nil {
@@ -6328,6 +6969,12 @@ func authenticateUserCanExecuteStatementWithObjectTypeNone(ctx context.Context,
 			return checkRevokePrivilege()
 		case *tree.ShowAccounts:
 			return checkShowAccountsPrivilege()
+		case *tree.Backup, *tree.Restore:
+			//only the moAdmin can backup the cluster and restore the backups.
+			return tenant.IsMoAdminRole(), nil
+		case *tree.CreateChangefeed, *tree.DropChangefeed, *tree.ShowChangefeeds:
+			//only the moAdmin can manage the changefeeds of the cluster.
+			return tenant.IsMoAdminRole(), nil
 		}
 	}
 
@@ -7067,7 +7714,6 @@ func InitUser(ctx context.Context, ses *Session, tenant *TenantInfo, cu *tree.Cr
 		}
 	}
 
-	//TODO: get password_option or lock_option. there is no field in mo_user to store it.
 	status = userStatusUnlock
 	if cu.MiscOpt != nil {
 		if _, ok := cu.MiscOpt.(*tree.UserMiscOptionAccountLock); ok {
@@ -7146,8 +7792,17 @@ func InitUser(ctx context.Context, ses *Session, tenant *TenantInfo, cu *tree.Cr
 			goto handleFailed
 		}
 
+		err = validatePasswordComplexity(ctx, ses, user.Username, password)
+		if err != nil {
+			goto handleFailed
+		}
+
 		//encryption the password
-		encryption := HashPassWord(password)
+		var encryption string
+		encryption, err = HashPassWordWithPlugin(ctx, user.AuthOption.AuthPlugin, password)
+		if err != nil {
+			goto handleFailed
+		}
 
 		//TODO: get comment or attribute. there is no field in mo_user to store it.
 		host = user.Hostname
@@ -7203,6 +7858,24 @@ func InitUser(ctx context.Context, ses *Session, tenant *TenantInfo, cu *tree.Cr
 				goto handleFailed
 			}
 		}
+
+		//the password policy and the password history of the user
+		policy := newDefaultPasswordPolicy(newUserId)
+		policy.lastChanged = time.Now().Unix()
+		if cu.MiscOpt != nil {
+			_, err = policy.applyOption(ctx, cu.MiscOpt)
+			if err != nil {
+				goto handleFailed
+			}
+		}
+		err = bh.Exec(ctx, getSqlForSavePasswordPolicy(policy))
+		if err != nil {
+			goto handleFailed
+		}
+		err = bh.Exec(ctx, getSqlForInsertPasswordHistory(newUserId, encryption, policy.lastChanged))
+		if err != nil {
+			goto handleFailed
+		}
 	}
 
 	err = bh.Exec(ctx, "commit;")
@@ -32,6 +32,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/prashantv/gostub"
@@ -3411,6 +3413,43 @@ func Test_determineDropTable(t *testing.T) {
 	})
 }
 
+func Test_determineExchangePartition(t *testing.T) {
+	convey.Convey("exchange partition needs the privileges on both tables", t, func() {
+		stmt, err := parsers.ParseOne(context.TODO(), dialect.MYSQL, "alter table db1.t1 exchange partition p0 with table t2", 1)
+		convey.So(err, convey.ShouldBeNil)
+		priv := determinePrivilegeSetOfStatement(stmt)
+		convey.So(priv.objectType(), convey.ShouldEqual, objectTypeDatabase)
+		convey.So(len(priv.entries), convey.ShouldEqual, 3)
+		convey.So(priv.entries[0].privilegeId, convey.ShouldEqual, PrivilegeTypeDatabaseAll)
+		convey.So(priv.entries[1].privilegeId, convey.ShouldEqual, PrivilegeTypeDatabaseOwnership)
+		convey.So(priv.entries[2].privilegeEntryTyp, convey.ShouldEqual, privilegeEntryTypeCompound)
+
+		items := priv.entries[2].compound.items
+		convey.So(len(items), convey.ShouldEqual, 5)
+		convey.So(items[0].privilegeTyp, convey.ShouldEqual, PrivilegeTypeAlterTable)
+		convey.So(items[0].dbName, convey.ShouldEqual, "db1")
+		for i, want := range []struct {
+			typ   PrivilegeType
+			table string
+		}{
+			{PrivilegeTypeInsert, "t1"},
+			{PrivilegeTypeDelete, "t1"},
+			{PrivilegeTypeInsert, "t2"},
+			{PrivilegeTypeDelete, "t2"},
+		} {
+			convey.So(items[i+1].privilegeTyp, convey.ShouldEqual, want.typ)
+			convey.So(items[i+1].dbName, convey.ShouldEqual, "db1")
+			convey.So(items[i+1].tableName, convey.ShouldEqual, want.table)
+		}
+
+		stmt, err = parsers.ParseOne(context.TODO(), dialect.MYSQL, "alter table t1 truncate partition p0", 1)
+		convey.So(err, convey.ShouldBeNil)
+		priv = determinePrivilegeSetOfStatement(stmt)
+		convey.So(len(priv.entries), convey.ShouldEqual, 3)
+		convey.So(priv.entries[0].privilegeId, convey.ShouldEqual, PrivilegeTypeAlterTable)
+	})
+}
+
 func Test_determineDML(t *testing.T) {
 	type arg struct {
 		stmt tree.Statement
@@ -5182,6 +5221,181 @@ func Test_doGrantPrivilege(t *testing.T) {
 	})
 }
 
+func Test_doGrantColumnPrivilege(t *testing.T) {
+	convey.Convey("grant table columns, role succ", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+		defer bhStub.Reset()
+
+		stmt := &tree.GrantPrivilege{
+			Privileges: []*tree.Privilege{
+				{Type: tree.PRIVILEGE_TYPE_STATIC_SELECT, ColumnList: []*tree.UnresolvedName{
+					tree.SetUnresolvedName("a"), tree.SetUnresolvedName("B"),
+				}},
+				{Type: tree.PRIVILEGE_TYPE_STATIC_INSERT},
+			},
+			ObjType: tree.OBJECT_TYPE_TABLE,
+			Level: &tree.PrivilegeLevel{
+				Level:   tree.PRIVILEGE_LEVEL_TYPE_DATABASE_TABLE,
+				DbName:  "d",
+				TabName: "t",
+			},
+			Roles: []*tree.Role{
+				{UserName: "r1"},
+			},
+		}
+		priv := determinePrivilegeSetOfStatement(stmt)
+		ses := newSes(priv, ctrl)
+
+		bh.sql2result["begin;"] = nil
+		bh.sql2result["commit;"] = nil
+		bh.sql2result["rollback;"] = nil
+
+		sql, _ := getSqlForRoleIdOfRole(context.TODO(), "r1")
+		bh.sql2result[sql] = newMrsForRoleIdOfRole([][]interface{}{{1}})
+
+		sql, _ = getSqlForCheckDatabaseTable(context.TODO(), "d", "t")
+		bh.sql2result[sql] = newMrsForCheckDatabaseTable([][]interface{}{{10}})
+
+		for _, column := range []string{"a", "b"} {
+			sql, _ = getSqlForCheckColumnOfTable(context.TODO(), 10, column)
+			bh.sql2result[sql] = newMrsForColumnOfTable([][]interface{}{{column}})
+			sql = getSqlForCheckRoleHasColumnPrivilege(1, 10, column, int64(PrivilegeTypeSelect))
+			bh.sql2result[sql] = newMrsForCheckRoleHasPrivilege([][]interface{}{})
+		}
+		sql = getSqlForCheckRoleHasPrivilege(1, objectTypeTable, 10, int64(PrivilegeTypeInsert))
+		bh.sql2result[sql] = newMrsForCheckRoleHasPrivilege([][]interface{}{})
+
+		var bumped []uint64
+		bumpStub := gostub.Stub(&bumpCatalogVersionOfTableById, func(_ context.Context, _ *Session, tableId uint64) error {
+			bumped = append(bumped, tableId)
+			return nil
+		})
+		defer bumpStub.Reset()
+
+		err := doGrantPrivilege(ses.GetRequestContext(), ses, stmt)
+		convey.So(err, convey.ShouldBeNil)
+		//the plans cached with the old privileges of the columns are dropped
+		convey.So(bumped, convey.ShouldResemble, []uint64{10})
+
+		//the column does not exist
+		sql, _ = getSqlForCheckColumnOfTable(context.TODO(), 10, "b")
+		bh.sql2result[sql] = newMrsForColumnOfTable([][]interface{}{})
+		err = doGrantPrivilege(ses.GetRequestContext(), ses, stmt)
+		convey.So(err, convey.ShouldNotBeNil)
+
+		//the privilege can not be granted on the columns
+		stmt.Privileges[0].Type = tree.PRIVILEGE_TYPE_STATIC_DELETE
+		err = doGrantPrivilege(ses.GetRequestContext(), ses, stmt)
+		convey.So(err, convey.ShouldNotBeNil)
+
+		//the column level privilege on the database.*
+		stmt.Privileges[0].Type = tree.PRIVILEGE_TYPE_STATIC_SELECT
+		stmt.Level = &tree.PrivilegeLevel{
+			Level:  tree.PRIVILEGE_LEVEL_TYPE_DATABASE_STAR,
+			DbName: "d",
+		}
+		err = doGrantPrivilege(ses.GetRequestContext(), ses, stmt)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+func Test_verifyColumnPrivilegeOfRole(t *testing.T) {
+	convey.Convey("verify column privilege", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		ses := newSes(nil, ctrl)
+		ses.SetDatabaseName("d")
+
+		sql, _ := getSqlForColumnsOfRoleHasColumnPrivilege(context.TODO(), 1, PrivilegeTypeSelect, "d", "t")
+		bh.sql2result[sql] = newMrsForColumnOfTable([][]interface{}{{"a"}, {"b"}})
+
+		mi := privilegeItem{privilegeTyp: PrivilegeTypeSelect, tableName: "t", columns: []string{"a", "b"}}
+		ok, err := verifyColumnPrivilegeOfRole(context.TODO(), bh, ses, 1, mi)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ok, convey.ShouldBeTrue)
+
+		mi.columns = []string{"a", "c"}
+		ok, err = verifyColumnPrivilegeOfRole(context.TODO(), bh, ses, 1, mi)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ok, convey.ShouldBeFalse)
+
+		sql, _ = getSqlForColumnsOfRoleHasColumnPrivilege(context.TODO(), 1, PrivilegeTypeUpdate, "d", "t")
+		bh.sql2result[sql] = newMrsForColumnOfTable([][]interface{}{})
+		mi = privilegeItem{privilegeTyp: PrivilegeTypeUpdate, dbName: "d", tableName: "t", columns: []string{"a"}}
+		ok, err = verifyColumnPrivilegeOfRole(context.TODO(), bh, ses, 1, mi)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ok, convey.ShouldBeFalse)
+	})
+}
+
+func Test_fillColumnsOfPrivilegeTips(t *testing.T) {
+	convey.Convey("fill the columns of the privilege tips", t, func() {
+		stmt, err := parsers.ParseOne(context.TODO(), dialect.MYSQL, "insert into t(A, b) values (1, 2)", 1)
+		convey.So(err, convey.ShouldBeNil)
+		arr := privilegeTipsArray{
+			{typ: PrivilegeTypeInsert, tableName: "t", columns: []string{"a", "b", "c"}},
+		}
+		fillColumnsOfPrivilegeTips(arr, stmt)
+		convey.So(arr[0].columns, convey.ShouldResemble, []string{"a", "b"})
+
+		stmt, err = parsers.ParseOne(context.TODO(), dialect.MYSQL, "update t as x set x.a = 1, b = 2 where c = 3", 1)
+		convey.So(err, convey.ShouldBeNil)
+		arr = privilegeTipsArray{
+			{typ: PrivilegeTypeUpdate, tableName: "t"},
+		}
+		fillColumnsOfPrivilegeTips(arr, stmt)
+		convey.So(arr[0].columns, convey.ShouldResemble, []string{"a", "b"})
+
+		stmt, err = parsers.ParseOne(context.TODO(), dialect.MYSQL, "update t1 join t2 on t1.a = t2.a set t1.b = 1, t2.c = 2", 1)
+		convey.So(err, convey.ShouldBeNil)
+		arr = privilegeTipsArray{
+			{typ: PrivilegeTypeUpdate, tableName: "t1"},
+			{typ: PrivilegeTypeUpdate, tableName: "t2"},
+		}
+		fillColumnsOfPrivilegeTips(arr, stmt)
+		convey.So(arr[0].columns, convey.ShouldResemble, []string{"b"})
+		convey.So(arr[1].columns, convey.ShouldResemble, []string{"c"})
+
+		//the columns read by the update need the select privilege on the columns read from the plan
+		p := &plan2.Plan{
+			Plan: &plan2.Plan_Query{
+				Query: &plan2.Query{
+					StmtType: plan.Query_UPDATE,
+					Nodes: []*plan2.Node{
+						{NodeType: plan.Node_TABLE_SCAN, ObjRef: &plan2.ObjectRef{SchemaName: "db", ObjName: "t"}, ReadCols: []string{"secret_col"}},
+						{NodeType: plan.Node_TABLE_SCAN, ObjRef: &plan2.ObjectRef{SchemaName: "db", ObjName: "u"}, ReadCols: []string{"x"}},
+						{NodeType: plan.Node_TABLE_SCAN, ObjRef: &plan2.ObjectRef{SchemaName: "db", ObjName: "s"}},
+					},
+				},
+			},
+		}
+		stmt, err = parsers.ParseOne(context.TODO(), dialect.MYSQL, "update t set a = 1 where exists (select 1 from u where u.x = secret_col)", 1)
+		convey.So(err, convey.ShouldBeNil)
+		arr = fillColumnsOfPrivilegeTips(extractPrivilegeTipsFromPlan(p), stmt)
+		convey.So(len(arr), convey.ShouldEqual, 5)
+		convey.So(arr[0].typ, convey.ShouldEqual, PrivilegeTypeUpdate)
+		convey.So(arr[0].columns, convey.ShouldResemble, []string{"a"})
+		convey.So(arr[1].typ, convey.ShouldEqual, PrivilegeTypeSelect)
+		convey.So(arr[1].tableName, convey.ShouldEqual, "t")
+		convey.So(arr[1].columns, convey.ShouldResemble, []string{"secret_col"})
+		convey.So(arr[3].typ, convey.ShouldEqual, PrivilegeTypeSelect)
+		convey.So(arr[3].tableName, convey.ShouldEqual, "u")
+		convey.So(arr[3].columns, convey.ShouldResemble, []string{"x"})
+		convey.So(arr[4].typ, convey.ShouldEqual, PrivilegeTypeUpdate)
+		convey.So(arr[4].tableName, convey.ShouldEqual, "s")
+	})
+}
+
 func Test_doRevokePrivilege(t *testing.T) {
 	convey.Convey("revoke account, role succ", t, func() {
 		ctrl := gomock.NewController(t)
@@ -5871,6 +6085,8 @@ func Test_doAlterUser(t *testing.T) {
 			})
 			bh.sql2result[sql] = mrs
 
+			bh.sql2result[getSqlForPasswordPolicyOfUser(int64(i))] = newMrsForPasswordPolicyOfUser([][]interface{}{})
+
 			sql, _ = getSqlForCheckUserHasRole(context.TODO(), "root", moAdminRoleID)
 			mrs = newMrsForSqlForCheckUserHasRole([][]interface{}{
 				{0, 0},
@@ -5887,6 +6103,60 @@ func Test_doAlterUser(t *testing.T) {
 		convey.So(err, convey.ShouldBeNil)
 	})
 
+	convey.Convey("alter user keeps the authentication plugin", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &recordingBackgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+		defer bhStub.Reset()
+
+		stmt := &tree.AlterUser{
+			Users: []*tree.User{
+				{Username: "u1", Hostname: "%", AuthOption: &tree.AccountIdentified{Typ: tree.AccountIdentifiedByPassword, Str: "123456"}},
+			},
+		}
+		priv := determinePrivilegeSetOfStatement(stmt)
+		ses := newSes(priv, ctrl)
+
+		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
+		pu.SV.SetDefaultValues()
+		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+		aicm := &defines.AutoIncrCacheManager{}
+		rm, _ := NewRoutineManager(ctx, pu, aicm)
+		ses.rm = rm
+
+		bh.sql2result["begin;"] = nil
+		bh.sql2result["commit;"] = nil
+		bh.sql2result["rollback;"] = nil
+
+		authString, err := HashPassWordWithCachingSha2("111")
+		convey.So(err, convey.ShouldBeNil)
+		sql, _ := getSqlForPasswordOfUser(context.TODO(), "u1")
+		bh.sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{
+			{0, authString, 0},
+		})
+		bh.sql2result[getSqlForPasswordPolicyOfUser(0)] = newMrsForPasswordPolicyOfUser([][]interface{}{})
+		sql, _ = getSqlForCheckUserHasRole(context.TODO(), "root", moAdminRoleID)
+		bh.sql2result[sql] = newMrsForSqlForCheckUserHasRole([][]interface{}{
+			{0, 0},
+		})
+
+		err = doAlterUser(ses.GetRequestContext(), ses, stmt)
+		convey.So(err, convey.ShouldBeNil)
+
+		updated := false
+		for _, sql := range bh.sqls {
+			if strings.HasPrefix(sql, "update mo_catalog.mo_user set authentication_string") {
+				convey.So(sql, convey.ShouldContainSubstring, cachingSha2AuthStringPrefix)
+				updated = true
+			}
+		}
+		convey.So(updated, convey.ShouldBeTrue)
+	})
+
 	convey.Convey("alter user fail for alter multi user", t, func() {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -5926,6 +6196,8 @@ func Test_doAlterUser(t *testing.T) {
 			})
 			bh.sql2result[sql] = mrs
 
+			bh.sql2result[getSqlForPasswordPolicyOfUser(int64(i))] = newMrsForPasswordPolicyOfUser([][]interface{}{})
+
 			sql, _ = getSqlForCheckUserHasRole(context.TODO(), user.Username, moAdminRoleID)
 			mrs = newMrsForSqlForCheckUserHasRole([][]interface{}{})
 			bh.sql2result[sql] = mrs
@@ -5977,6 +6249,8 @@ func Test_doAlterUser(t *testing.T) {
 			})
 			bh.sql2result[sql] = mrs
 
+			bh.sql2result[getSqlForPasswordPolicyOfUser(int64(i))] = newMrsForPasswordPolicyOfUser([][]interface{}{})
+
 			sql, _ = getSqlForCheckUserHasRole(context.TODO(), user.Username, moAdminRoleID)
 			mrs = newMrsForSqlForCheckUserHasRole([][]interface{}{})
 			bh.sql2result[sql] = mrs
@@ -6884,6 +7158,17 @@ func (bt *backgroundExecTest) ClearExecResultSet() {
 
 var _ BackgroundExec = &backgroundExecTest{}
 
+// recordingBackgroundExecTest records the sqls it executes
+type recordingBackgroundExecTest struct {
+	backgroundExecTest
+	sqls []string
+}
+
+func (bt *recordingBackgroundExecTest) Exec(ctx context.Context, s string) error {
+	bt.sqls = append(bt.sqls, s)
+	return bt.backgroundExecTest.Exec(ctx, s)
+}
+
 func newMrsForSqlForShowDatabases(rows [][]interface{}) *MysqlResultSet {
 	mrs := &MysqlResultSet{}
 
@@ -7077,6 +7362,25 @@ func newMrsForPasswordOfUser(rows [][]interface{}) *MysqlResultSet {
 	return mrs
 }
 
+func newMrsForPasswordPolicyOfUser(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+
+	for _, name := range []string{"password_lifetime", "password_last_changed", "password_expired",
+		"password_history", "password_reuse_interval", "failed_login_attempts", "password_lock_time",
+		"failed_login_count", "locked_time"} {
+		col := &MysqlColumn{}
+		col.SetName(name)
+		col.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+		mrs.AddColumn(col)
+	}
+
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+
+	return mrs
+}
+
 func newMrsForCheckUserGrant(rows [][]interface{}) *MysqlResultSet {
 	mrs := &MysqlResultSet{}
 
@@ -7135,6 +7439,22 @@ func newMrsForCheckDatabase(rows [][]interface{}) *MysqlResultSet {
 	return mrs
 }
 
+func newMrsForColumnOfTable(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+
+	col1 := &MysqlColumn{}
+	col1.SetName("column_name")
+	col1.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+
+	mrs.AddColumn(col1)
+
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+
+	return mrs
+}
+
 func newMrsForCheckDatabaseTable(rows [][]interface{}) *MysqlResultSet {
 	mrs := &MysqlResultSet{}
 
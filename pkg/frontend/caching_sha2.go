@@ -0,0 +1,446 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+/*
+The caching_sha2_password authentication.
+
+The authentication_string in the mo_user is the same as the mysql 8.0:
+
+	$A$005$<salt><digest>
+
+	005 : the iteration count of the sha256 crypt divided by 1000
+	salt : 20 bytes
+	digest : 43 bytes. the sha256 crypt of the password with the salt.
+
+The scramble of the client is:
+
+	XOR(SHA256(password), SHA256(SHA256(SHA256(password)), nonce))
+
+The server can not check the scramble with the authentication_string directly.
+It keeps the SHA256(SHA256(password)) in the cache after the full authentication
+succeeded. Then the following logins of the user check the scramble with
+the cache (fast authentication).
+
+The full authentication gets the plaintext password from the client:
+ 1. in the TLS connection, the client sends the password directly.
+ 2. in the plain connection, the client asks for the public key of the server,
+    then sends the XOR(password, nonce) encrypted with the RSA public key.
+*/
+
+const (
+	cachingSha2AuthStringPrefix = "$A$"
+	cachingSha2Iterations       = 5000
+	cachingSha2SaltLength       = 20
+	cachingSha2DigestLength     = 43
+	cachingSha2AuthStringLength = len(cachingSha2AuthStringPrefix) + 4 + cachingSha2SaltLength + cachingSha2DigestLength
+
+	// the status in the AuthMoreData packet
+	cachingSha2RequestPublicKey          byte = 2
+	cachingSha2FastAuthSuccess           byte = 3
+	cachingSha2PerformFullAuthentication byte = 4
+
+	// the header of the AuthMoreData packet
+	authMoreDataHeader byte = 1
+
+	cachingSha2RSAKeyBits = 2048
+)
+
+const cryptB64 = "./0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// saltCharacters are the characters of the salt in the authentication_string.
+// The quote, the backslash and the '$' are excluded.
+const saltCharacters = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// sha256Crypt computes the sha256 crypt (Ulrich Drepper) of the key with the salt.
+// It returns the 43 bytes digest encoded with the crypt base64.
+func sha256Crypt(key, salt []byte, rounds int) []byte {
+	keyLen := len(key)
+	saltLen := len(salt)
+
+	b := sha256.New()
+	b.Write(key)
+	b.Write(salt)
+	b.Write(key)
+	digestB := b.Sum(nil)
+
+	a := sha256.New()
+	a.Write(key)
+	a.Write(salt)
+	i := keyLen
+	for ; i > sha256.Size; i -= sha256.Size {
+		a.Write(digestB)
+	}
+	a.Write(digestB[:i])
+	for i = keyLen; i > 0; i >>= 1 {
+		if i&1 != 0 {
+			a.Write(digestB)
+		} else {
+			a.Write(key)
+		}
+	}
+	digestA := a.Sum(nil)
+
+	dp := sha256.New()
+	for i = 0; i < keyLen; i++ {
+		dp.Write(key)
+	}
+	p := repeatToLength(dp.Sum(nil), keyLen)
+
+	ds := sha256.New()
+	for i = 0; i < 16+int(digestA[0]); i++ {
+		ds.Write(salt)
+	}
+	s := repeatToLength(ds.Sum(nil), saltLen)
+
+	c := sha256.New()
+	digestC := digestA
+	for i = 0; i < rounds; i++ {
+		c.Reset()
+		if i&1 != 0 {
+			c.Write(p)
+		} else {
+			c.Write(digestC)
+		}
+		if i%3 != 0 {
+			c.Write(s)
+		}
+		if i%7 != 0 {
+			c.Write(p)
+		}
+		if i&1 != 0 {
+			c.Write(digestC)
+		} else {
+			c.Write(p)
+		}
+		digestC = c.Sum(nil)
+	}
+
+	out := make([]byte, 0, cachingSha2DigestLength)
+	b64From24Bit := func(b2, b1, b0 byte, n int) {
+		w := uint32(b2)<<16 | uint32(b1)<<8 | uint32(b0)
+		for ; n > 0; n-- {
+			out = append(out, cryptB64[w&0x3f])
+			w >>= 6
+		}
+	}
+	for j := 0; j < 10; j++ {
+		b64From24Bit(digestC[(j*21)%30], digestC[(j*21+10)%30], digestC[(j*21+20)%30], 4)
+	}
+	b64From24Bit(0, digestC[31], digestC[30], 3)
+	return out
+}
+
+func repeatToLength(digest []byte, length int) []byte {
+	ret := make([]byte, 0, length)
+	for len(ret)+len(digest) <= length {
+		ret = append(ret, digest...)
+	}
+	return append(ret, digest[:length-len(ret)]...)
+}
+
+// generateSalt generates the salt of the authentication_string.
+func generateSalt(length int) ([]byte, error) {
+	salt := make([]byte, length)
+	max := big.NewInt(int64(len(saltCharacters)))
+	for i := range salt {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return nil, err
+		}
+		salt[i] = saltCharacters[n.Int64()]
+	}
+	return salt, nil
+}
+
+// HashPassWordWithCachingSha2 is used to hash password for the caching_sha2_password
+// $A$005$<salt><sha256 crypt(password, salt)>
+func HashPassWordWithCachingSha2(pwd string) (string, error) {
+	if len(pwd) == 0 {
+		return "", nil
+	}
+	salt, err := generateSalt(cachingSha2SaltLength)
+	if err != nil {
+		return "", err
+	}
+	return makeCachingSha2AuthString([]byte(pwd), salt), nil
+}
+
+func makeCachingSha2AuthString(pwd, salt []byte) string {
+	return fmt.Sprintf("%s%03X$%s%s", cachingSha2AuthStringPrefix, cachingSha2Iterations/1000, salt,
+		sha256Crypt(pwd, salt, cachingSha2Iterations))
+}
+
+// checkCachingSha2AuthString checks the plaintext password with the authentication_string.
+func checkCachingSha2AuthString(authString string, pwd []byte) bool {
+	if !isCachingSha2AuthString(authString) || len(authString) != cachingSha2AuthStringLength {
+		return false
+	}
+	var iterations int
+	if _, err := fmt.Sscanf(authString[len(cachingSha2AuthStringPrefix):len(cachingSha2AuthStringPrefix)+3], "%03X", &iterations); err != nil {
+		return false
+	}
+	pos := len(cachingSha2AuthStringPrefix) + 4
+	salt := []byte(authString[pos : pos+cachingSha2SaltLength])
+	digest := sha256Crypt(pwd, salt, iterations*1000)
+	return bytes.Equal(digest, []byte(authString[pos+cachingSha2SaltLength:]))
+}
+
+func isCachingSha2AuthString(authString string) bool {
+	return strings.HasPrefix(authString, cachingSha2AuthStringPrefix)
+}
+
+// getAuthPluginOfAuthString returns the authentication plugin
+// that generated the authentication_string.
+func getAuthPluginOfAuthString(authString string) string {
+	if isCachingSha2AuthString(authString) {
+		return AuthCachingSha2Password
+	}
+	return AuthNativePassword
+}
+
+// HashPassWordWithPlugin hashes the password for the authentication plugin.
+// The empty plugin means the mysql_native_password.
+func HashPassWordWithPlugin(ctx context.Context, plugin, pwd string) (string, error) {
+	switch strings.ToLower(plugin) {
+	case "", AuthNativePassword:
+		return HashPassWord(pwd), nil
+	case AuthCachingSha2Password:
+		return HashPassWordWithCachingSha2(pwd)
+	default:
+		return "", moerr.NewInternalError(ctx, "the authentication plugin %s is not supported", plugin)
+	}
+}
+
+// scrambleCachingSha2 computes the scramble like the client does.
+// XOR(SHA256(password), SHA256(SHA256(SHA256(password)), nonce))
+func scrambleCachingSha2(pwd, nonce []byte) []byte {
+	if len(pwd) == 0 {
+		return nil
+	}
+	stage1 := sha256.Sum256(pwd)
+	stage2 := sha256.Sum256(stage1[:])
+	h := sha256.New()
+	h.Write(stage2[:])
+	h.Write(nonce)
+	scramble := h.Sum(nil)
+	for i := range scramble {
+		scramble[i] ^= stage1[i]
+	}
+	return scramble
+}
+
+// checkCachingSha2Scramble checks the scramble from the client with the cached
+// SHA256(SHA256(password)).
+// stage1 = XOR(scramble, SHA256(stage2, nonce))
+// check SHA256(stage1) == stage2
+func checkCachingSha2Scramble(stage2, nonce, scramble []byte) bool {
+	if len(scramble) != sha256.Size || len(stage2) != sha256.Size {
+		return false
+	}
+	h := sha256.New()
+	h.Write(stage2)
+	h.Write(nonce)
+	stage1 := h.Sum(nil)
+	for i := range stage1 {
+		stage1[i] ^= scramble[i]
+	}
+	got := sha256.Sum256(stage1)
+	return bytes.Equal(got[:], stage2)
+}
+
+// xorWithNonce does XOR(data, nonce) with the nonce repeated.
+func xorWithNonce(data, nonce []byte) []byte {
+	ret := make([]byte, len(data))
+	for i := range data {
+		ret[i] = data[i] ^ nonce[i%len(nonce)]
+	}
+	return ret
+}
+
+type cachingSha2Entry struct {
+	authString string
+	stage2     []byte
+}
+
+// cachingSha2Cache keeps the SHA256(SHA256(password)) of the users
+// that passed the full authentication.
+type cachingSha2Cache struct {
+	sync.Mutex
+	entries map[string]cachingSha2Entry
+}
+
+func newCachingSha2Cache() *cachingSha2Cache {
+	return &cachingSha2Cache{entries: make(map[string]cachingSha2Entry)}
+}
+
+// get returns the cached SHA256(SHA256(password)) of the user.
+// The entry is stale when the authentication_string has been changed.
+func (c *cachingSha2Cache) get(key, authString string) ([]byte, bool) {
+	c.Lock()
+	defer c.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if e.authString != authString {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return e.stage2, true
+}
+
+func (c *cachingSha2Cache) set(key, authString string, pwd []byte) {
+	stage1 := sha256.Sum256(pwd)
+	stage2 := sha256.Sum256(stage1[:])
+	c.Lock()
+	defer c.Unlock()
+	c.entries[key] = cachingSha2Entry{authString: authString, stage2: stage2[:]}
+}
+
+func getCachingSha2CacheKey(tenant, user string) string {
+	return fmt.Sprintf("%s:%s", strings.ToLower(tenant), user)
+}
+
+var globalCachingSha2Cache = newCachingSha2Cache()
+
+// cachingSha2RSAKeys is the RSA key pair for the full authentication
+// in the plain connection. It is generated at the first use.
+type cachingSha2RSAKeys struct {
+	once       sync.Once
+	err        error
+	privateKey *rsa.PrivateKey
+	publicPem  []byte
+}
+
+var globalCachingSha2RSAKeys = &cachingSha2RSAKeys{}
+
+func (keys *cachingSha2RSAKeys) init() error {
+	keys.once.Do(func() {
+		keys.privateKey, keys.err = rsa.GenerateKey(rand.Reader, cachingSha2RSAKeyBits)
+		if keys.err != nil {
+			return
+		}
+		var der []byte
+		der, keys.err = x509.MarshalPKIXPublicKey(&keys.privateKey.PublicKey)
+		if keys.err != nil {
+			return
+		}
+		keys.publicPem = pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	})
+	return keys.err
+}
+
+// getPublicKey returns the public key in the PEM format.
+func (keys *cachingSha2RSAKeys) getPublicKey() ([]byte, error) {
+	if err := keys.init(); err != nil {
+		return nil, err
+	}
+	return keys.publicPem, nil
+}
+
+// decryptPassword decrypts the password encrypted by the client.
+// The client does RSA_OAEP(XOR(password + '\0', nonce)).
+func (keys *cachingSha2RSAKeys) decryptPassword(data, nonce []byte) ([]byte, error) {
+	if err := keys.init(); err != nil {
+		return nil, err
+	}
+	plain, err := rsa.DecryptOAEP(sha1.New(), rand.Reader, keys.privateKey, data, nil)
+	if err != nil {
+		return nil, err
+	}
+	return trimPasswordNUL(xorWithNonce(plain, nonce)), nil
+}
+
+func trimPasswordNUL(pwd []byte) []byte {
+	if n := bytes.IndexByte(pwd, 0); n >= 0 {
+		return pwd[:n]
+	}
+	return pwd
+}
+
+// authenticateCachingSha2 authenticates the user with the caching_sha2_password.
+func (mp *MysqlProtocolImpl) authenticateCachingSha2(ctx context.Context, key, authString string, authResponse []byte) error {
+	var err error
+	var data, pwd []byte
+	nonce := mp.GetSalt()
+
+	//the client sends the empty auth response for the empty password
+	if len(authResponse) == 0 {
+		if len(authString) == 0 {
+			return nil
+		}
+		return moerr.NewInternalError(ctx, "check password failed")
+	}
+
+	//fast authentication
+	if stage2, ok := globalCachingSha2Cache.get(key, authString); ok {
+		if !checkCachingSha2Scramble(stage2, nonce, authResponse) {
+			return moerr.NewInternalError(ctx, "check password failed")
+		}
+		return mp.writePackets([]byte{authMoreDataHeader, cachingSha2FastAuthSuccess})
+	}
+
+	//full authentication
+	if err = mp.writePackets([]byte{authMoreDataHeader, cachingSha2PerformFullAuthentication}); err != nil {
+		return err
+	}
+	if data, err = mp.readAuthPacket(ctx); err != nil {
+		return err
+	}
+
+	if mp.IsTlsEstablished() {
+		pwd = trimPasswordNUL(data)
+	} else {
+		if len(data) == 1 && data[0] == cachingSha2RequestPublicKey {
+			var publicKey []byte
+			if publicKey, err = globalCachingSha2RSAKeys.getPublicKey(); err != nil {
+				return err
+			}
+			if err = mp.writePackets(append([]byte{authMoreDataHeader}, publicKey...)); err != nil {
+				return err
+			}
+			if data, err = mp.readAuthPacket(ctx); err != nil {
+				return err
+			}
+		}
+		if pwd, err = globalCachingSha2RSAKeys.decryptPassword(data, nonce); err != nil {
+			return moerr.NewInternalError(ctx, "decrypt password failed. error:%v", err)
+		}
+	}
+
+	if !checkCachingSha2AuthString(authString, pwd) {
+		return moerr.NewInternalError(ctx, "check password failed")
+	}
+	globalCachingSha2Cache.set(key, authString, pwd)
+	return nil
+}
@@ -0,0 +1,183 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/fagongzi/goetty/v2"
+	"github.com/fagongzi/goetty/v2/buf"
+	"github.com/golang/mock/gomock"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func Test_sha256Crypt(t *testing.T) {
+	convey.Convey("sha256 crypt", t, func() {
+		//the test vector from the specification of the sha256 crypt
+		digest := sha256Crypt([]byte("Hello world!"), []byte("saltstring"), 5000)
+		convey.So(string(digest), convey.ShouldEqual, "5B8vYYiY.CVt1RlTTf8KbXBH3hsxY/GNooZaBBGWEc5")
+
+		digest = sha256Crypt([]byte("Hello world!"), []byte("saltstringsaltst"), 10000)
+		convey.So(string(digest), convey.ShouldEqual, "3xv.VbSHBb41AL9AvLeujZkZRBAwqFMz2.opqey6IcA")
+	})
+}
+
+func Test_cachingSha2AuthString(t *testing.T) {
+	convey.Convey("caching sha2 authentication_string", t, func() {
+		ctx := context.TODO()
+		authString, err := HashPassWordWithPlugin(ctx, AuthCachingSha2Password, "111")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(authString), convey.ShouldEqual, cachingSha2AuthStringLength)
+		convey.So(getAuthPluginOfAuthString(authString), convey.ShouldEqual, AuthCachingSha2Password)
+		convey.So(checkCachingSha2AuthString(authString, []byte("111")), convey.ShouldBeTrue)
+		convey.So(checkCachingSha2AuthString(authString, []byte("112")), convey.ShouldBeFalse)
+
+		//the salt is different every time
+		authString2, err := HashPassWordWithPlugin(ctx, AuthCachingSha2Password, "111")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(authString2, convey.ShouldNotEqual, authString)
+
+		authString, err = HashPassWordWithPlugin(ctx, "", "111")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(authString, convey.ShouldEqual, HashPassWord("111"))
+		convey.So(getAuthPluginOfAuthString(authString), convey.ShouldEqual, AuthNativePassword)
+
+		_, err = HashPassWordWithPlugin(ctx, "sha256_password", "111")
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+func Test_cachingSha2Scramble(t *testing.T) {
+	convey.Convey("caching sha2 scramble", t, func() {
+		nonce := []byte("01234567890123456789")
+		cache := newCachingSha2Cache()
+		_, ok := cache.get("sys:u1", "a")
+		convey.So(ok, convey.ShouldBeFalse)
+
+		cache.set("sys:u1", "a", []byte("111"))
+		stage2, ok := cache.get("sys:u1", "a")
+		convey.So(ok, convey.ShouldBeTrue)
+		convey.So(checkCachingSha2Scramble(stage2, nonce, scrambleCachingSha2([]byte("111"), nonce)), convey.ShouldBeTrue)
+		convey.So(checkCachingSha2Scramble(stage2, nonce, scrambleCachingSha2([]byte("112"), nonce)), convey.ShouldBeFalse)
+
+		//the password has been changed
+		_, ok = cache.get("sys:u1", "b")
+		convey.So(ok, convey.ShouldBeFalse)
+		_, ok = cache.get("sys:u1", "a")
+		convey.So(ok, convey.ShouldBeFalse)
+	})
+}
+
+func encryptPasswordForTest(publicPem, pwd, nonce []byte) ([]byte, error) {
+	block, _ := pem.Decode(publicPem)
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	plain := xorWithNonce(append(pwd, 0), nonce)
+	return rsa.EncryptOAEP(sha1.New(), rand.Reader, pub.(*rsa.PublicKey), plain, nil)
+}
+
+func Test_authenticateCachingSha2(t *testing.T) {
+	ctx := context.TODO()
+	convey.Convey("caching sha2 full and fast authentication", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+
+		var written [][]byte
+		var toRead []*Packet
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().Write(gomock.Any(), gomock.Any()).DoAndReturn(func(msg interface{}, opts goetty.WriteOptions) error {
+			packet := msg.([]byte)
+			written = append(written, append([]byte{}, packet[HeaderLengthOfTheProtocol:]...))
+			return nil
+		}).AnyTimes()
+		ioses.EXPECT().Read(gomock.Any()).DoAndReturn(func(opts goetty.ReadOptions) (interface{}, error) {
+			pkt := toRead[0]
+			toRead = toRead[1:]
+			return pkt, nil
+		}).AnyTimes()
+		ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+		ioses.EXPECT().Ref().AnyTimes()
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		convey.So(err, convey.ShouldBeNil)
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+		pwd := []byte("111")
+		authString, err := HashPassWordWithCachingSha2(string(pwd))
+		convey.So(err, convey.ShouldBeNil)
+		key := getCachingSha2CacheKey("sys", "user_for_caching_sha2")
+		nonce := proto.GetSalt()
+		scramble := scrambleCachingSha2(pwd, nonce)
+
+		publicKey, err := globalCachingSha2RSAKeys.getPublicKey()
+		convey.So(err, convey.ShouldBeNil)
+
+		//wrong password in the full authentication
+		wrong, err := encryptPasswordForTest(publicKey, []byte("112"), nonce)
+		convey.So(err, convey.ShouldBeNil)
+		toRead = []*Packet{{Payload: wrong}}
+		err = proto.authenticateCachingSha2(ctx, key, authString, scramble)
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(written[0], convey.ShouldResemble, []byte{authMoreDataHeader, cachingSha2PerformFullAuthentication})
+
+		//full authentication with the public key
+		written = nil
+		encrypted, err := encryptPasswordForTest(publicKey, pwd, nonce)
+		convey.So(err, convey.ShouldBeNil)
+		toRead = []*Packet{{Payload: []byte{cachingSha2RequestPublicKey}}, {Payload: encrypted}}
+		err = proto.authenticateCachingSha2(ctx, key, authString, scramble)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(written), convey.ShouldEqual, 2)
+		convey.So(written[0], convey.ShouldResemble, []byte{authMoreDataHeader, cachingSha2PerformFullAuthentication})
+		convey.So(written[1], convey.ShouldResemble, append([]byte{authMoreDataHeader}, publicKey...))
+
+		//fast authentication
+		written = nil
+		err = proto.authenticateCachingSha2(ctx, key, authString, scramble)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(written), convey.ShouldEqual, 1)
+		convey.So(written[0], convey.ShouldResemble, []byte{authMoreDataHeader, cachingSha2FastAuthSuccess})
+
+		err = proto.authenticateCachingSha2(ctx, key, authString, scrambleCachingSha2([]byte("112"), nonce))
+		convey.So(err, convey.ShouldNotBeNil)
+
+		//empty password
+		written = nil
+		err = proto.authenticateCachingSha2(ctx, key, "", nil)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(written), convey.ShouldEqual, 0)
+
+		err = proto.authenticateCachingSha2(ctx, key, authString, nil)
+		convey.So(err, convey.ShouldNotBeNil)
+
+		//full authentication in the TLS connection
+		written = nil
+		proto.SetTlsEstablished()
+		key = getCachingSha2CacheKey("sys", "user_for_caching_sha2_tls")
+		toRead = []*Packet{{Payload: append(pwd, 0)}}
+		err = proto.authenticateCachingSha2(ctx, key, authString, scramble)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(written), convey.ShouldEqual, 1)
+	})
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/util"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
@@ -271,6 +272,16 @@ func (tcc *TxnCompilerContext) Resolve(dbName string, tableName string) (*plan2.
 	return tcc.getTableDef(ctx, table, dbName, tableName, sub)
 }
 
+func (tcc *TxnCompilerContext) ResolvePolicy(obj *plan.ObjectRef, tableDef *plan.TableDef, command string) (tree.Expr, error) {
+	ses := tcc.GetSession()
+	return resolveRowPolicy(ses.GetRequestContext(), ses, obj, tableDef, command)
+}
+
+func (tcc *TxnCompilerContext) ResolveMaterializedViews(obj *plan.ObjectRef, tableDef *plan.TableDef) ([]*plan2.MaterializedView, error) {
+	ses := tcc.GetSession()
+	return resolveMaterializedViews(ses.GetRequestContext(), ses, obj, tableDef)
+}
+
 func (tcc *TxnCompilerContext) ResolveUdf(name string, args []*plan.Expr) (string, error) {
 	var expectInvalidArgErr bool
 	var expectedInvalidArgLengthErr bool
@@ -394,6 +405,8 @@ func (tcc *TxnCompilerContext) getTableDef(ctx context.Context, table engine.Rel
 	var primarykey *plan2.PrimaryKeyDef
 	var indexes []*plan2.IndexDef
 	var refChildTbls []uint64
+	var ttl *plan2.TTLDef
+	var bloomFilter *plan2.BloomFilterDef
 	var subscriptionName string
 	var pubAccountId int32 = -1
 	if sub != nil {
@@ -463,6 +476,10 @@ func (tcc *TxnCompilerContext) getTableDef(ctx context.Context, table engine.Rel
 					refChildTbls = k.Tables
 				case *engine.PrimaryKeyDef:
 					primarykey = k.Pkey
+				case *engine.TTLDef:
+					ttl = k.Ttl
+				case *engine.BloomFilterDef:
+					bloomFilter = k.BloomFilter
 				}
 			}
 		} else if commnetDef, ok := def.(*engine.CommentDef); ok {
@@ -523,6 +540,8 @@ func (tcc *TxnCompilerContext) getTableDef(ctx context.Context, table engine.Rel
 		RefChildTbls: refChildTbls,
 		ClusterBy:    clusterByDef,
 		Indexes:      indexes,
+		Ttl:          ttl,
+		BloomFilter:  bloomFilter,
 	}
 	return obj, tableDef
 }
@@ -667,7 +686,12 @@ func (tcc *TxnCompilerContext) Stats(obj *plan2.ObjectRef, e *plan2.Expr) (stats
 		cols, _ := table.TableColumns(ctx)
 		fixColumnName(cols, e)
 	}
-	stats, _ = table.Stats(ctx, e, tcc.GetSession().statsCache.GetStatsInfoMap(table.GetTableID(ctx)))
+	ses := tcc.GetSession()
+	tableId := table.GetTableID(ctx)
+	statsInfoMap := ses.statsCache.GetStatsInfoMap(tableId)
+	// the statistics collected by ANALYZE TABLE
+	statsInfoMap.SetColumnStats(getColumnStats(ctx, ses, dbName, tableId))
+	stats, _ = table.Stats(ctx, e, statsInfoMap)
 	return stats
 }
 
@@ -25,6 +25,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/compile"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
@@ -90,6 +91,8 @@ type TxnComputationWrapper struct {
 	proc    *process.Process
 	ses     *Session
 	compile *compile.Compile
+	// collects the result for the query result cache
+	collector *resultCollector
 
 	uuid uuid.UUID
 }
@@ -180,6 +183,10 @@ func (cwft *TxnComputationWrapper) GetClock() clock.Clock {
 }
 
 func (cwft *TxnComputationWrapper) GetAffectedRows() uint64 {
+	if cwft.compile == nil {
+		// the result is from the query result cache
+		return 0
+	}
 	return cwft.compile.GetAffectedRows()
 }
 
@@ -193,8 +200,12 @@ func (cwft *TxnComputationWrapper) Compile(requestCtx context.Context, u interfa
 		cwft.ses.GetTxnHandler().AttachTempStorageToTxnCtx()
 	}
 	cacheHit := cwft.plan != nil
+	cacheKey := ""
 	if !cacheHit {
-		cwft.plan, err = buildPlan(requestCtx, cwft.ses, cwft.ses.GetTxnCompileCtx(), cwft.stmt)
+		cwft.plan, err = buildParameterisedPlan(requestCtx, cwft.ses, cwft.stmt)
+		if err == nil && cwft.plan == nil {
+			cwft.plan, err = buildPlan(requestCtx, cwft.ses, cwft.ses.GetTxnCompileCtx(), cwft.stmt)
+		}
 	} else if cwft.ses != nil && cwft.ses.GetTenantInfo() != nil {
 		cwft.ses.accountId = getAccountId(requestCtx)
 		err = authenticateCanExecuteStatementAndPlan(requestCtx, cwft.ses, cwft.stmt, cwft.plan)
@@ -228,14 +239,7 @@ func (cwft *TxnComputationWrapper) Compile(requestCtx context.Context, u interfa
 		if len(executePlan.Args) != len(preparePlan.ParamTypes) {
 			return nil, moerr.NewInvalidInput(requestCtx, "Incorrect arguments to EXECUTE")
 		}
-		newPlan := plan2.DeepCopyPlan(preparePlan.Plan)
-
-		// replace ? and @var with their values
-		resetParamRule := plan2.NewResetParamRefRule(requestCtx, executePlan.Args)
-		resetVarRule := plan2.NewResetVarRefRule(cwft.ses.GetTxnCompileCtx(), cwft.ses.GetTxnCompileCtx().GetProcess())
-		constantFoldRule := plan2.NewConstantFoldRule(cwft.ses.GetTxnCompileCtx())
-		vp := plan2.NewVisitPlan(newPlan, []plan2.VisitPlanRule{resetParamRule, resetVarRule, constantFoldRule})
-		err = vp.Visit(requestCtx)
+		newPlan, err := bindPreparePlan(requestCtx, cwft.ses, preparePlan, executePlan.Args)
 		if err != nil {
 			return nil, err
 		}
@@ -257,7 +261,13 @@ func (cwft *TxnComputationWrapper) Compile(requestCtx context.Context, u interfa
 		if err != nil {
 			return nil, err
 		}
+		if resultCacheEnabled(cwft.ses, cwft.stmt) && isResultCacheablePlan(preparePlan.Plan) {
+			cacheKey = getResultCacheKey(cwft.ses, cwft.stmt, executePlan.Args, newPlan)
+		}
 	} else {
+		if resultCacheEnabled(cwft.ses, cwft.stmt) && isResultCacheablePlan(cwft.plan) {
+			cacheKey = getResultCacheKey(cwft.ses, cwft.stmt, nil, cwft.plan)
+		}
 		var vp *plan2.VisitPlan
 		if cacheHit {
 			vp = plan2.NewVisitPlan(cwft.plan, []plan2.VisitPlanRule{plan2.NewResetVarRefRule(cwft.ses.GetTxnCompileCtx(), cwft.ses.GetTxnCompileCtx().GetProcess()), plan2.NewRecomputeRealTimeRelatedFuncRule(cwft.ses.GetTxnCompileCtx().GetProcess())})
@@ -284,6 +294,29 @@ func (cwft *TxnComputationWrapper) Compile(requestCtx context.Context, u interfa
 			return nil, err
 		}
 	}
+	if cacheKey != "" {
+		if tables, ok := getTablesCommitTS(cwft.ses, cwft.plan); ok {
+			if cr := globalResultCache.get(cacheKey, tables); cr != nil {
+				incResultCacheCounter(cwft.ses, true)
+				return &cachedResultRunner{ses: cwft.ses, fill: fill, batches: cr.batches}, nil
+			}
+			incResultCacheCounter(cwft.ses, false)
+			collector := &resultCollector{
+				key:      cacheKey,
+				tables:   tables,
+				capacity: resultCacheCapacity(cwft.ses),
+			}
+			output := fill
+			fill = func(obj interface{}, bat *batch.Batch) error {
+				if err := collector.collect(bat); err != nil {
+					return err
+				}
+				return output(obj, bat)
+			}
+			cwft.collector = collector
+		}
+	}
+
 	addr := ""
 	if len(cwft.ses.GetParameterUnit().ClusterNodes) > 0 {
 		addr = cwft.ses.GetParameterUnit().ClusterNodes[0].Addr
@@ -345,9 +378,29 @@ func (cwft *TxnComputationWrapper) Compile(requestCtx context.Context, u interfa
 
 		cwft.ses.EnableInitTempEngine()
 	}
+	if cwft.collector != nil {
+		return cwft, err
+	}
 	return cwft.compile, err
 }
 
+// bindPreparePlan returns a copy of the plan of the prepared statement with
+// the params and the variables replaced with their values
+func bindPreparePlan(requestCtx context.Context, ses *Session, preparePlan *plan.Prepare, args []*plan.Expr) (*plan2.Plan, error) {
+	newPlan := plan2.DeepCopyPlan(preparePlan.Plan)
+
+	// replace ? and @var with their values
+	resetParamRule := plan2.NewResetParamRefRule(requestCtx, args)
+	resetVarRule := plan2.NewResetVarRefRule(ses.GetTxnCompileCtx(), ses.GetTxnCompileCtx().GetProcess())
+	constantFoldRule := plan2.NewConstantFoldRule(ses.GetTxnCompileCtx())
+	partitionPruneRule := plan2.NewPartitionPruneRule(ses.GetTxnCompileCtx())
+	vp := plan2.NewVisitPlan(newPlan, []plan2.VisitPlanRule{resetParamRule, resetVarRule, constantFoldRule, partitionPruneRule})
+	if err := vp.Visit(requestCtx); err != nil {
+		return nil, err
+	}
+	return newPlan, nil
+}
+
 func (cwft *TxnComputationWrapper) RecordExecPlan(ctx context.Context) error {
 	if stm := motrace.StatementFromContext(ctx); stm != nil {
 		stm.SetExecPlan(cwft.plan, SerializeExecPlan)
@@ -365,6 +418,14 @@ func (cwft *TxnComputationWrapper) Run(ts uint64) error {
 		logDebugf(cwft.ses.GetDebugString(), "compile.Run end")
 	}()
 	err := cwft.compile.Run(ts)
+	if cwft.collector != nil {
+		if err != nil {
+			cwft.collector.free()
+		} else {
+			cwft.collector.finish()
+		}
+		cwft.collector = nil
+	}
 	return err
 }
 
@@ -0,0 +1,419 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/cdc"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function/builtin/ctl"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	getMViewFormat = `select state_table from mo_catalog.mo_mviews where database_name = "%s" and view_name = "%s";`
+
+	insertMViewFormat = `insert into mo_catalog.mo_mviews(
+				database_name,
+				view_name,
+				base_table_id,
+				state_table,
+				definition,
+				spec,
+				creator,
+				owner,
+				created_time) values ("%s","%s",%d,"%s",'%s','%s',%d,%d,"%s");`
+
+	deleteMViewFormat = `delete from mo_catalog.mo_mviews where database_name = "%s" and view_name = "%s";`
+
+	getMViewsFormat = `select base_table_id, database_name, view_name, state_table, definition from mo_catalog.mo_mviews order by mview_id;`
+
+	getMViewWatermarkFormat = "select `%s` from `%s`.`%s` where `%s` = '%s';"
+)
+
+func getSqlForGetMView(ctx context.Context, dbName, viewName string) (string, error) {
+	err := inputNameIsInvalid(ctx, dbName, viewName)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(getMViewFormat, dbName, viewName), nil
+}
+
+func getSqlForInsertMView(def *plan2.MaterializedViewDef, spec string, creator, owner int64, createdTime string) string {
+	return fmt.Sprintf(insertMViewFormat, def.Database, def.Name, def.BaseTableId, def.StateTable,
+		escapeSingleQuoteString(def.Definition), escapeSingleQuoteString(spec), creator, owner, createdTime)
+}
+
+func getSqlForDeleteMView(dbName, viewName string) string {
+	return fmt.Sprintf(deleteMViewFormat, dbName, viewName)
+}
+
+func getSqlForMViews() string {
+	return getMViewsFormat
+}
+
+func getSqlForMViewWatermark(dbName, stateTable string) string {
+	return fmt.Sprintf(getMViewWatermarkFormat, cdc.MViewTSCol, dbName, stateTable, cdc.MViewKeyCol, cdc.MViewWatermarkKey)
+}
+
+// getMViewName returns the database and the name of the materialized view
+func getMViewName(ctx context.Context, ses *Session, name *tree.TableName) (string, string, error) {
+	dbName := string(name.SchemaName)
+	if len(dbName) == 0 {
+		if ses.DatabaseNameIsEmpty() {
+			return "", "", moerr.NewNoDB(ctx)
+		}
+		dbName = ses.GetDatabaseName()
+	}
+	return dbName, string(name.ObjectName), nil
+}
+
+// getMViewStateTable returns the state table of the materialized view, it is
+// empty if the view does not exist
+func getMViewStateTable(ctx context.Context, bh BackgroundExec, dbName, viewName string) (string, error) {
+	sql, err := getSqlForGetMView(ctx, dbName, viewName)
+	if err != nil {
+		return "", err
+	}
+	bh.ClearExecResultSet()
+	err = bh.Exec(ctx, sql)
+	if err != nil {
+		return "", err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil || !execResultArrayHasData(erArray) {
+		return "", err
+	}
+	return erArray[0].GetString(ctx, 0, 0)
+}
+
+// dropMViewObjects drops the metadata, the view and the state table of the
+// materialized view. The drop of the view bumps the version of the catalog
+// after the metadata is deleted, which drops the views cached on every CN.
+func dropMViewObjects(ctx context.Context, bh BackgroundExec, dbName, viewName, stateTable string) error {
+	for _, sql := range []string{
+		getSqlForDeleteMView(dbName, viewName),
+		fmt.Sprintf("drop view if exists `%s`.`%s`;", dbName, viewName),
+		fmt.Sprintf("drop table if exists `%s`.`%s`;", dbName, stateTable),
+	} {
+		if err := bh.Exec(ctx, sql); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// doCreateMaterializedView creates the state table and the view of the
+// materialized view, and asks DN to maintain the state table. The state table
+// is committed before DN builds it. The view is created after the metadata, so
+// that the version of the catalog it bumps drops the views cached on every CN.
+func doCreateMaterializedView(ctx context.Context, ses *Session, proc *process.Process, cmv *tree.CreateMaterializedView) error {
+	def, err := plan2.BuildMaterializedView(ses.GetTxnCompileCtx(), cmv)
+	if err != nil {
+		return err
+	}
+	if isBannedDatabase(def.Database) {
+		return moerr.NewInternalError(ctx, "can not create the materialized view in the database %s", def.Database)
+	}
+	spec, err := json.Marshal(def.Spec)
+	if err != nil {
+		return err
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	stateTable, err := getMViewStateTable(ctx, bh, def.Database, def.Name)
+	if err != nil {
+		return err
+	}
+	if len(stateTable) > 0 {
+		if cmv.IfNotExists {
+			return nil
+		}
+		return moerr.NewTableAlreadyExists(ctx, def.Name)
+	}
+
+	tenantInfo := ses.GetTenantInfo()
+	for _, sql := range []string{
+		def.CreateStateSql,
+		getSqlForInsertMView(def, string(spec), int64(tenantInfo.GetUserID()), int64(tenantInfo.GetDefaultRoleID()),
+			types.CurrentTimestamp().String2(time.UTC, 0)),
+		def.CreateViewSql,
+	} {
+		if err = bh.Exec(ctx, sql); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = ctl.MView(proc, db.MView{
+			Op:    db.MViewCreate,
+			State: def.Spec.State,
+			Spec:  string(spec),
+		})
+	}
+	if err != nil {
+		if dropErr := dropMViewObjects(ctx, bh, def.Database, def.Name, def.StateTable); dropErr != nil {
+			logErrorf(ses.GetDebugString(), "drop the materialized view %s.%s failed. error:%v", def.Database, def.Name, dropErr)
+		}
+	}
+	globalMViewCache.invalidate(tenantInfo.GetTenantID())
+	return err
+}
+
+// doDropMaterializedView stops DN maintaining the materialized view, and drops
+// the view and its state table
+func doDropMaterializedView(ctx context.Context, ses *Session, proc *process.Process, dmv *tree.DropMaterializedView) error {
+	dbName, viewName, err := getMViewName(ctx, ses, dmv.Name)
+	if err != nil {
+		return err
+	}
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	stateTable, err := getMViewStateTable(ctx, bh, dbName, viewName)
+	if err != nil {
+		return err
+	}
+	if len(stateTable) == 0 {
+		if dmv.IfExists {
+			return nil
+		}
+		return moerr.NewNoSuchTable(ctx, dbName, viewName)
+	}
+	err = ctl.MView(proc, db.MView{
+		Op:       db.MViewDrop,
+		State:    dbName + "." + stateTable,
+		IfExists: true,
+	})
+	if err != nil {
+		return err
+	}
+	err = dropMViewObjects(ctx, bh, dbName, viewName, stateTable)
+	globalMViewCache.invalidate(ses.GetTenantInfo().GetTenantID())
+	return err
+}
+
+// doRefreshMaterializedView asks DN to build the state table of the
+// materialized view again
+func doRefreshMaterializedView(ctx context.Context, ses *Session, proc *process.Process, rmv *tree.RefreshMaterializedView) error {
+	dbName, viewName, err := getMViewName(ctx, ses, rmv.Name)
+	if err != nil {
+		return err
+	}
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	stateTable, err := getMViewStateTable(ctx, bh, dbName, viewName)
+	if err != nil {
+		return err
+	}
+	if len(stateTable) == 0 {
+		return moerr.NewNoSuchTable(ctx, dbName, viewName)
+	}
+	return ctl.MView(proc, db.MView{
+		Op:    db.MViewRefresh,
+		State: dbName + "." + stateTable,
+	})
+}
+
+// cachedMView is a materialized view cached by globalMViewCache
+type cachedMView struct {
+	view       *plan2.MaterializedView
+	stateTable string
+
+	mu sync.Mutex
+	// watermark is the highest watermark of the state table read, which only
+	// moves forward
+	watermark types.TS
+}
+
+// globalMViewCache caches the materialized views of the tables of the accounts
+// for the sessions of the CN, so that the query on a table does not query the
+// mo_mviews. The views of an account are loaded at once and are only valid for
+// one version of the catalog, which the create and the drop of a view bump.
+var globalMViewCache = newAccountCache[map[uint64][]*cachedMView]()
+
+// loadMViews reads the materialized views of the account
+func loadMViews(ctx context.Context, bh BackgroundExec) (map[uint64][]*cachedMView, error) {
+	bh.ClearExecResultSet()
+	err := bh.Exec(ctx, getSqlForMViews())
+	if err != nil {
+		return nil, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+	tables := make(map[uint64][]*cachedMView)
+	if !execResultArrayHasData(erArray) {
+		return tables, nil
+	}
+	for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+		tableId, err := erArray[0].GetUint64(ctx, i, 0)
+		if err != nil {
+			return nil, err
+		}
+		mv := &cachedMView{view: &plan2.MaterializedView{}}
+		if mv.view.Database, err = erArray[0].GetString(ctx, i, 1); err != nil {
+			return nil, err
+		}
+		if mv.view.Name, err = erArray[0].GetString(ctx, i, 2); err != nil {
+			return nil, err
+		}
+		if mv.stateTable, err = erArray[0].GetString(ctx, i, 3); err != nil {
+			return nil, err
+		}
+		if mv.view.Definition, err = erArray[0].GetString(ctx, i, 4); err != nil {
+			return nil, err
+		}
+		tables[tableId] = append(tables[tableId], mv)
+	}
+	return tables, nil
+}
+
+// getMViewWatermark returns the watermark of the state table of the view,
+// which is read only if the cached one is older than ts
+func getMViewWatermark(ctx context.Context, bh BackgroundExec, mv *cachedMView, ts types.TS) (types.TS, error) {
+	mv.mu.Lock()
+	watermark := mv.watermark
+	mv.mu.Unlock()
+	if !watermark.Less(ts) {
+		return watermark, nil
+	}
+
+	bh.ClearExecResultSet()
+	err := bh.Exec(ctx, getSqlForMViewWatermark(mv.view.Database, mv.stateTable))
+	if err != nil {
+		return watermark, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil || !execResultArrayHasData(erArray) {
+		return watermark, err
+	}
+	value, err := erArray[0].GetString(ctx, 0, 0)
+	if err != nil {
+		return watermark, err
+	}
+	read, err := types.ParseTS(value)
+	if err != nil {
+		return watermark, err
+	}
+
+	mv.mu.Lock()
+	defer mv.mu.Unlock()
+	if mv.watermark.Less(read) {
+		mv.watermark = read
+	}
+	return mv.watermark, nil
+}
+
+// mviewIsFresh returns whether the state table has all the changes of the table
+// visible at the snapshot and no more. base and state are the max commit ts of
+// the table and the state table, and watermark is the commit ts of the last
+// change of the table applied to the state table.
+func mviewIsFresh(snapshot, base, state, watermark types.TS) bool {
+	return !base.Greater(snapshot) && !state.Greater(snapshot) && !watermark.Less(base)
+}
+
+// txnHasWrites returns whether the txn has written something, which the
+// materialized views do not have
+func txnHasWrites(ses *Session) bool {
+	txnOp := ses.GetTxnHandler().GetTxnOperator()
+	if txnOp == nil {
+		return false
+	}
+	ws, ok := txnOp.GetWorkspace().(interface{ ReadOnly() bool })
+	return ok && !ws.ReadOnly()
+}
+
+// resolveMaterializedViews returns the materialized views on the table, which
+// the query on the table may be rewritten to read from. The views are skipped
+// unless they are as fresh as the table the txn reads, and there are none if
+// the txn has written something.
+func resolveMaterializedViews(ctx context.Context, ses *Session, obj *plan.ObjectRef, tableDef *plan.TableDef) ([]*plan2.MaterializedView, error) {
+	var (
+		err    error
+		tables map[uint64][]*cachedMView
+		views  []*plan2.MaterializedView
+		ok     bool
+	)
+	tenantInfo := ses.GetTenantInfo()
+	// the views of the publishing account are not visible
+	if tenantInfo == nil || ses.IsBackgroundSession() || isBannedDatabase(obj.GetSchemaName()) || obj.GetPubAccountId() != -1 {
+		return nil, nil
+	}
+	txnOp := ses.GetTxnHandler().GetTxnOperator()
+	if txnOp == nil || txnHasWrites(ses) {
+		return nil, nil
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	accountId := tenantInfo.GetTenantID()
+	version, _ := ses.GetStorage().CatalogVersion()
+	if tables, ok = globalMViewCache.get(accountId, version); !ok {
+		if tables, err = loadMViews(ctx, bh); err != nil {
+			return nil, err
+		}
+		globalMViewCache.put(accountId, version, tables)
+	}
+	cached := tables[tableDef.GetTblId()]
+	if len(cached) == 0 {
+		return nil, nil
+	}
+
+	snapshot := types.TimestampToTS(txnOp.Txn().SnapshotTS)
+	tcc := ses.GetTxnCompileCtx()
+	relCtx, rel, err := tcc.getRelation(obj.GetSchemaName(), tableDef.GetName(), nil)
+	if err != nil {
+		return nil, err
+	}
+	base, err := rel.MaxCommitTS(relCtx)
+	if err != nil || base.Greater(snapshot) {
+		return nil, err
+	}
+	for _, mv := range cached {
+		watermark, err := getMViewWatermark(ctx, bh, mv, base)
+		if err != nil {
+			return nil, err
+		}
+		// the state table is checked after the watermark is read, so that
+		// the watermark is not newer than the state table the txn reads
+		relCtx, rel, err = tcc.getRelation(mv.view.Database, mv.stateTable, nil)
+		if err != nil {
+			return nil, err
+		}
+		state, err := rel.MaxCommitTS(relCtx)
+		if err != nil {
+			return nil, err
+		}
+		if mviewIsFresh(snapshot, base, state, watermark) {
+			views = append(views, mv.view)
+		}
+	}
+	return views, nil
+}
@@ -0,0 +1,191 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/cdc"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/prashantv/gostub"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func newMrsForMViews(names []string, rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+	for _, name := range names {
+		col := &MysqlColumn{}
+		col.SetName(name)
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		mrs.AddColumn(col)
+	}
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+	return mrs
+}
+
+func Test_resolveMaterializedViews(t *testing.T) {
+	convey.Convey("resolve the materialized views of the table", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+		defer bhStub.Reset()
+
+		globalMViewCache = newAccountCache[map[uint64][]*cachedMView]()
+		defer func() {
+			globalMViewCache = newAccountCache[map[uint64][]*cachedMView]()
+		}()
+
+		ses := newSes(nil, ctrl)
+		ctx := ses.GetRequestContext()
+
+		bh.sql2result[getSqlForMViews()] = newMrsForMViews(
+			[]string{"base_table_id", "database_name", "view_name", "state_table", "definition"},
+			[][]interface{}{
+				{uint64(10), "d", "mv1", "__mo_mv_mv1", "select count(*) as `c` from `d`.`t`"},
+				{uint64(10), "d", "mv2", "__mo_mv_mv2", "select `a` as `a` from `d`.`t`"},
+				{uint64(11), "d", "mv3", "__mo_mv_mv3", "select `b` as `b` from `d`.`u`"},
+			})
+		tables, err := loadMViews(ctx, bh)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(tables), convey.ShouldEqual, 2)
+		convey.So(len(tables[10]), convey.ShouldEqual, 2)
+		mv := tables[10][0]
+		convey.So(mv.view.Database, convey.ShouldEqual, "d")
+		convey.So(mv.view.Name, convey.ShouldEqual, "mv1")
+		convey.So(mv.stateTable, convey.ShouldEqual, "__mo_mv_mv1")
+		convey.So(mv.view.Definition, convey.ShouldEqual, "select count(*) as `c` from `d`.`t`")
+
+		//the watermark is read only if the cached one is older
+		bh.sql2result[getSqlForMViewWatermark("d", "__mo_mv_mv1")] = newMrsForMViews(
+			[]string{cdc.MViewTSCol}, [][]interface{}{{types.BuildTS(100, 1).ToString()}})
+		watermark, err := getMViewWatermark(ctx, bh, mv, types.BuildTS(50, 0))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(watermark, convey.ShouldEqual, types.BuildTS(100, 1))
+		bh.sql2result[getSqlForMViewWatermark("d", "__mo_mv_mv1")] = newMrsForMViews(
+			[]string{cdc.MViewTSCol}, [][]interface{}{{types.BuildTS(200, 0).ToString()}})
+		watermark, err = getMViewWatermark(ctx, bh, mv, types.BuildTS(100, 0))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(watermark, convey.ShouldEqual, types.BuildTS(100, 1))
+		watermark, err = getMViewWatermark(ctx, bh, mv, types.BuildTS(150, 0))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(watermark, convey.ShouldEqual, types.BuildTS(200, 0))
+		//the state table is not built yet
+		bh.sql2result[getSqlForMViewWatermark("d", "__mo_mv_mv2")] = newMrsForMViews([]string{cdc.MViewTSCol}, nil)
+		watermark, err = getMViewWatermark(ctx, bh, tables[10][1], types.BuildTS(150, 0))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(watermark.IsEmpty(), convey.ShouldBeTrue)
+
+		//no view without a txn, on the system tables or on the tables published
+		obj := &plan.ObjectRef{SchemaName: "d", ObjName: "t", PubAccountId: -1}
+		tableDef := &plan.TableDef{TblId: 10, Name: "t"}
+		views, err := resolveMaterializedViews(ctx, ses, obj, tableDef)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(views, convey.ShouldBeEmpty)
+		obj.PubAccountId = 1
+		views, err = resolveMaterializedViews(ctx, ses, obj, tableDef)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(views, convey.ShouldBeEmpty)
+		obj.PubAccountId = -1
+		obj.SchemaName = "mo_catalog"
+		views, err = resolveMaterializedViews(ctx, ses, obj, tableDef)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(views, convey.ShouldBeEmpty)
+	})
+}
+
+type mviewTestWorkspace struct {
+	readOnly bool
+}
+
+func (ws *mviewTestWorkspace) ReadOnly() bool {
+	return ws.readOnly
+}
+
+func Test_txnHasWrites(t *testing.T) {
+	convey.Convey("the materialized views are not used by the txn having writes", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
+		convey.So(txnHasWrites(ses), convey.ShouldBeFalse)
+
+		ws := &mviewTestWorkspace{readOnly: true}
+		txnOperator := mock_frontend.NewMockTxnOperator(ctrl)
+		txnOperator.EXPECT().GetWorkspace().Return(ws).AnyTimes()
+		ses.GetTxnHandler().txnOperator = txnOperator
+		convey.So(txnHasWrites(ses), convey.ShouldBeFalse)
+		ws.readOnly = false
+		convey.So(txnHasWrites(ses), convey.ShouldBeTrue)
+	})
+}
+
+func Test_mviewIsFresh(t *testing.T) {
+	convey.Convey("the materialized view is fresh", t, func() {
+		snapshot := types.BuildTS(100, 0)
+		convey.So(mviewIsFresh(snapshot, types.BuildTS(50, 0), types.BuildTS(60, 0), types.BuildTS(50, 0)), convey.ShouldBeTrue)
+		convey.So(mviewIsFresh(snapshot, types.BuildTS(50, 0), types.BuildTS(60, 0), types.BuildTS(70, 0)), convey.ShouldBeTrue)
+		//the state table misses the changes of the table
+		convey.So(mviewIsFresh(snapshot, types.BuildTS(50, 0), types.BuildTS(60, 0), types.BuildTS(40, 0)), convey.ShouldBeFalse)
+		//the table or the state table is changed after the snapshot
+		convey.So(mviewIsFresh(snapshot, types.BuildTS(110, 0), types.BuildTS(120, 0), types.BuildTS(110, 0)), convey.ShouldBeFalse)
+		convey.So(mviewIsFresh(snapshot, types.BuildTS(50, 0), types.BuildTS(120, 0), types.BuildTS(110, 0)), convey.ShouldBeFalse)
+	})
+}
+
+func Test_doDropMaterializedView(t *testing.T) {
+	convey.Convey("drop or refresh the materialized view not existing", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+		defer bhStub.Reset()
+
+		name := tree.NewTableName(tree.Identifier("mv1"), tree.ObjectNamePrefix{SchemaName: tree.Identifier("d"), ExplicitSchema: true})
+		stmt := &tree.DropMaterializedView{Name: name}
+		priv := determinePrivilegeSetOfStatement(stmt)
+		ses := newSes(priv, ctrl)
+		ctx := ses.GetRequestContext()
+
+		sql, err := getSqlForGetMView(ctx, "d", "mv1")
+		convey.So(err, convey.ShouldBeNil)
+		bh.sql2result[sql] = newMrsForMViews([]string{"state_table"}, nil)
+
+		err = doDropMaterializedView(ctx, ses, nil, stmt)
+		convey.So(err, convey.ShouldNotBeNil)
+		stmt.IfExists = true
+		err = doDropMaterializedView(ctx, ses, nil, stmt)
+		convey.So(err, convey.ShouldBeNil)
+
+		err = doRefreshMaterializedView(ctx, ses, nil, &tree.RefreshMaterializedView{Name: name})
+		convey.So(err, convey.ShouldNotBeNil)
+
+		_, err = getSqlForGetMView(ctx, "d", "mv1\"")
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
@@ -46,10 +46,12 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan/explain"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function/builtin/ctl"
 	"github.com/matrixorigin/matrixone/pkg/util/metric"
 	"github.com/matrixorigin/matrixone/pkg/util/trace"
 	"github.com/matrixorigin/matrixone/pkg/util/trace/impl/motrace"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"golang.org/x/sync/errgroup"
 )
@@ -197,6 +199,11 @@ func (mce *MysqlCmdExecutor) GetRoutineManager() *RoutineManager {
 }
 
 var RecordStatement = func(ctx context.Context, ses *Session, proc *process.Process, cw ComputationWrapper, envBegin time.Time, envStmt, sqlType string, useEnv bool) context.Context {
+	requestAt := envBegin
+	if !useEnv {
+		requestAt = time.Now()
+	}
+	ses.setSlowQueryStatement(cw, requestAt)
 	if !motrace.GetTracerProvider().IsEnable() {
 		return ctx
 	}
@@ -217,10 +224,6 @@ var RecordStatement = func(ctx context.Context, ses *Session, proc *process.Proc
 	}
 	var sesID uuid.UUID
 	copy(sesID[:], ses.GetUUID())
-	requestAt := envBegin
-	if !useEnv {
-		requestAt = time.Now()
-	}
 	var stmID uuid.UUID
 	var statement tree.Statement = nil
 	var text string
@@ -614,7 +617,11 @@ func doSetVar(ctx context.Context, ses *Session, sv *tree.SetVar) error {
 	setVarFunc := func(system, global bool, name string, value interface{}) error {
 		if system {
 			if global {
-				err = ses.SetGlobalVar(name, value)
+				if isAccountVariable(name) {
+					err = setAccountVariable(ctx, ses, name, value)
+				} else {
+					err = ses.SetGlobalVar(name, value)
+				}
 				if err != nil {
 					return err
 				}
@@ -720,7 +727,7 @@ func doShowErrors(ses *Session) error {
 
 	for i := info.length() - 1; i >= 0; i-- {
 		row := make([]interface{}, 3)
-		row[0] = "Error"
+		row[0] = info.levels[i]
 		row[1] = info.codes[i]
 		row[2] = info.msgs[i]
 		mrs.AddRow(row)
@@ -904,25 +911,30 @@ func constructVarBatch(ses *Session, rows [][]interface{}) (*batch.Batch, error)
 	return bat, nil
 }
 
-func (mce *MysqlCmdExecutor) handleAnalyzeStmt(requestCtx context.Context, stmt *tree.AnalyzeStmt) error {
-	// rewrite analyzeStmt to `select approx_count_distinct(col), .. from tbl`
-	// IMO, this approach is simple and future-proof
-	// Although this rewriting processing could have been handled in rewrite module,
-	// `handleAnalyzeStmt` can be easily managed by cron jobs in the future
-	ctx := tree.NewFmtCtx(dialect.MYSQL)
-	ctx.WriteString("select ")
-	for i, ident := range stmt.Cols {
-		if i > 0 {
-			ctx.WriteByte(',')
-		}
-		ctx.WriteString("approx_count_distinct(")
-		ctx.WriteString(string(ident))
-		ctx.WriteByte(')')
+// handleAnalyzeStmt collects the statistics of the columns into mo_catalog.mo_column_stats
+func (mce *MysqlCmdExecutor) handleAnalyzeStmt(requestCtx context.Context, stmt *tree.AnalyzeStmt, cwIndex, cwsLen int) error {
+	ses := mce.GetSession()
+	proto := ses.GetMysqlProtocol()
+	dbName, err := doAnalyzeTable(requestCtx, ses, stmt)
+	if err != nil {
+		return err
+	}
+
+	mrs := ses.GetMysqlResultSet()
+	for _, name := range []string{"Table", "Op", "Msg_type", "Msg_text"} {
+		col := new(MysqlColumn)
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		col.SetName(name)
+		mrs.AddColumn(col)
+	}
+	mrs.AddRow([]interface{}{dbName + "." + string(stmt.Table.ObjectName), "analyze", "status", "OK"})
+
+	mer := NewMysqlExecutionResult(0, 0, 0, 0, mrs)
+	resp := SetNewResponse(ResultResponse, 0, int(COM_QUERY), mer, cwIndex, cwsLen)
+	if err := proto.SendResponse(requestCtx, resp); err != nil {
+		return moerr.NewInternalError(requestCtx, "routine send response failed, error: %v ", err)
 	}
-	ctx.WriteString(" from ")
-	stmt.Table.Format(ctx)
-	sql := ctx.String()
-	return mce.GetDoQueryFunc()(requestCtx, sql)
+	return nil
 }
 
 // Note: for pass the compile quickly. We will remove the comments in the future.
@@ -1001,7 +1013,9 @@ func (mce *MysqlCmdExecutor) handleExplainStmt(requestCtx context.Context, stmt
 }
 
 func doPrepareStmt(ctx context.Context, ses *Session, st *tree.PrepareStmt) (*PrepareStmt, error) {
-	preparePlan, err := buildPlan(ctx, ses, ses.GetTxnCompileCtx(), st)
+	preparePlan, err := buildPreparePlan(ctx, ses, string(st.Name), st.Stmt, func() (*plan2.Plan, error) {
+		return buildPlan(ctx, ses, ses.GetTxnCompileCtx(), st)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -1031,7 +1045,15 @@ func doPrepareString(ctx context.Context, ses *Session, st *tree.PrepareString)
 		return nil, err
 	}
 
-	preparePlan, err := buildPlan(ses.GetRequestContext(), ses, ses.GetTxnCompileCtx(), st)
+	build := func() (*plan2.Plan, error) {
+		return buildPlan(ses.GetRequestContext(), ses, ses.GetTxnCompileCtx(), st)
+	}
+	var preparePlan *plan2.Plan
+	if len(stmts) == 1 {
+		preparePlan, err = buildPreparePlan(ses.GetRequestContext(), ses, string(st.Name), stmts[0], build)
+	} else {
+		preparePlan, err = build()
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -1086,6 +1108,22 @@ func (mce *MysqlCmdExecutor) handleDropPublication(ctx context.Context, dp *tree
 	return doDropPublication(ctx, mce.GetSession(), dp)
 }
 
+func (mce *MysqlCmdExecutor) handleCreatePolicy(ctx context.Context, cp *tree.CreatePolicy) error {
+	return doCreatePolicy(ctx, mce.GetSession(), cp)
+}
+
+func (mce *MysqlCmdExecutor) handleDropPolicy(ctx context.Context, dp *tree.DropPolicy) error {
+	return doDropPolicy(ctx, mce.GetSession(), dp)
+}
+
+func (mce *MysqlCmdExecutor) handleCreateAuditFilter(ctx context.Context, caf *tree.CreateAuditFilter) error {
+	return doCreateAuditFilter(ctx, mce.GetSession(), caf)
+}
+
+func (mce *MysqlCmdExecutor) handleDropAuditFilter(ctx context.Context, daf *tree.DropAuditFilter) error {
+	return doDropAuditFilter(ctx, mce.GetSession(), daf)
+}
+
 // handleCreateAccount creates a new user-level tenant in the context of the tenant SYS
 // which has been initialized.
 func (mce *MysqlCmdExecutor) handleCreateAccount(ctx context.Context, ca *tree.CreateAccount) error {
@@ -1367,6 +1405,188 @@ func (mce *MysqlCmdExecutor) handleShowBackendServers(ctx context.Context, cwInd
 	return err
 }
 
+// buildBackupRequest builds the request of DN to backup to the target of the statement.
+// The S3 credentials are taken from the environment of DN.
+func buildBackupRequest(ctx context.Context, st *tree.Backup) (db.Backup, error) {
+	return buildBackupTarget(ctx, &st.BackupTarget)
+}
+
+// buildBackupTarget builds the file service config of DN for a backup target
+func buildBackupTarget(ctx context.Context, target *tree.BackupTarget) (db.Backup, error) {
+	if len(target.Option) == 0 {
+		if target.Dir == "" {
+			return db.Backup{}, moerr.NewInvalidInput(ctx, "empty backup dir")
+		}
+		return db.Backup{Backend: "DISK", Dir: target.Dir}, nil
+	}
+	req := db.Backup{Backend: "S3"}
+	for i := 0; i < len(target.Option); i += 2 {
+		switch strings.ToLower(target.Option[i]) {
+		case "endpoint":
+			req.Endpoint = target.Option[i+1]
+		case "bucket":
+			req.Bucket = target.Option[i+1]
+		case "filepath":
+			req.KeyPrefix = target.Option[i+1]
+		case "provider":
+			switch strings.ToLower(target.Option[i+1]) {
+			case "minio":
+				req.Backend = "MINIO"
+			case "aws", "s3":
+			default:
+				return db.Backup{}, moerr.NewBadConfig(ctx, "the provider '%s' is not supported", target.Option[i+1])
+			}
+		default:
+			return db.Backup{}, moerr.NewBadConfig(ctx, "the keyword '%s' is not support", strings.ToLower(target.Option[i]))
+		}
+	}
+	if req.Bucket == "" {
+		return db.Backup{}, moerr.NewInvalidInput(ctx, "empty backup bucket")
+	}
+	return req, nil
+}
+
+// buildRestoreRequest builds the request of DN to restore the backup of the statement
+func buildRestoreRequest(ctx context.Context, st *tree.Restore) (db.RestoreReq, error) {
+	src, err := buildBackupTarget(ctx, &st.From)
+	if err != nil {
+		return db.RestoreReq{}, err
+	}
+	dst, err := buildBackupTarget(ctx, &st.To)
+	if err != nil {
+		return db.RestoreReq{}, err
+	}
+	if src == dst {
+		return db.RestoreReq{}, moerr.NewInvalidInput(ctx, "restore to the backup itself")
+	}
+	return db.RestoreReq{Src: src, Dst: dst, Ts: st.Ts}, nil
+}
+
+// handleBackup asks DN to backup the cluster, and returns the summary of the backup
+func (mce *MysqlCmdExecutor) handleBackup(ctx context.Context, st *tree.Backup, proc *process.Process, cwIndex, cwsLen int) error {
+	req, err := buildBackupRequest(ctx, st)
+	if err != nil {
+		return err
+	}
+	messages, err := ctl.Backup(proc, req)
+	if err != nil {
+		return err
+	}
+	return mce.sendBackupMessages(ctx, "Backup", messages, cwIndex, cwsLen)
+}
+
+// handleRestore asks DN to restore a backup into another target, and returns the
+// summary of the restored backup
+func (mce *MysqlCmdExecutor) handleRestore(ctx context.Context, st *tree.Restore, proc *process.Process, cwIndex, cwsLen int) error {
+	req, err := buildRestoreRequest(ctx, st)
+	if err != nil {
+		return err
+	}
+	messages, err := ctl.Restore(proc, req)
+	if err != nil {
+		return err
+	}
+	return mce.sendBackupMessages(ctx, "Restore", messages, cwIndex, cwsLen)
+}
+
+func (mce *MysqlCmdExecutor) sendBackupMessages(ctx context.Context, name string, messages []string, cwIndex, cwsLen int) error {
+	ses := mce.GetSession()
+	proto := ses.GetMysqlProtocol()
+	col := new(MysqlColumn)
+	col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	col.SetName(name)
+	mrs := ses.GetMysqlResultSet()
+	mrs.AddColumn(col)
+	for _, message := range messages {
+		mrs.AddRow([]interface{}{message})
+	}
+
+	mer := NewMysqlExecutionResult(0, 0, 0, 0, mrs)
+	resp := SetNewResponse(ResultResponse, 0, int(COM_QUERY), mer, cwIndex, cwsLen)
+	if err := proto.SendResponse(ctx, resp); err != nil {
+		return moerr.NewInternalError(ctx, "routine send response failed, error: %v ", err)
+	}
+	return nil
+}
+
+// buildChangefeedRequest builds the request of DN for the changefeed statements.
+// The tables without database are in the current database.
+func buildChangefeedRequest(ctx context.Context, stmt tree.Statement, dbName string) (db.Changefeed, error) {
+	switch st := stmt.(type) {
+	case *tree.CreateChangefeed:
+		req := db.Changefeed{
+			Op:          db.ChangefeedCreate,
+			Name:        string(st.Name),
+			Sink:        st.Sink,
+			Cursor:      st.Cursor,
+			IfNotExists: st.IfNotExists,
+		}
+		for _, table := range st.Tables {
+			schema := string(table.SchemaName)
+			if schema == "" {
+				schema = dbName
+			}
+			if schema == "" {
+				return db.Changefeed{}, moerr.NewNoDB(ctx)
+			}
+			req.Tables = append(req.Tables, schema+"."+string(table.ObjectName))
+		}
+		return req, nil
+	case *tree.DropChangefeed:
+		return db.Changefeed{
+			Op:       db.ChangefeedDrop,
+			Name:     string(st.Name),
+			IfExists: st.IfExists,
+		}, nil
+	default:
+		return db.Changefeed{Op: db.ChangefeedShow}, nil
+	}
+}
+
+// handleChangefeed asks DN to create or drop a changefeed
+func (mce *MysqlCmdExecutor) handleChangefeed(ctx context.Context, stmt tree.Statement, proc *process.Process) error {
+	req, err := buildChangefeedRequest(ctx, stmt, mce.GetSession().GetDatabaseName())
+	if err != nil {
+		return err
+	}
+	_, err = ctl.Changefeed(proc, req)
+	return err
+}
+
+// handleShowChangefeeds returns the changefeeds of all the DNs
+func (mce *MysqlCmdExecutor) handleShowChangefeeds(ctx context.Context, proc *process.Process, cwIndex, cwsLen int) error {
+	ses := mce.GetSession()
+	proto := ses.GetMysqlProtocol()
+	messages, err := ctl.Changefeed(proc, db.Changefeed{Op: db.ChangefeedShow})
+	if err != nil {
+		return err
+	}
+
+	mrs := ses.GetMysqlResultSet()
+	for _, name := range []string{"Name", "Tables", "Sink", "Checkpoint", "Error"} {
+		col := new(MysqlColumn)
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		col.SetName(name)
+		mrs.AddColumn(col)
+	}
+	for _, message := range messages {
+		var feeds []db.ChangefeedStatus
+		if err = json.Unmarshal([]byte(message), &feeds); err != nil {
+			return err
+		}
+		for _, feed := range feeds {
+			mrs.AddRow([]interface{}{feed.Name, strings.Join(feed.Tables, ", "), feed.Sink, feed.Checkpoint, feed.Error})
+		}
+	}
+
+	mer := NewMysqlExecutionResult(0, 0, 0, 0, mrs)
+	resp := SetNewResponse(ResultResponse, 0, int(COM_QUERY), mer, cwIndex, cwsLen)
+	if err := proto.SendResponse(ctx, resp); err != nil {
+		return moerr.NewInternalError(ctx, "routine send response failed, error: %v ", err)
+	}
+	return nil
+}
+
 func GetExplainColumns(ctx context.Context, explainColName string) ([]interface{}, error) {
 	cols := []*plan2.ColDef{
 		{Typ: &plan2.Type{Id: int32(types.T_varchar)}, Name: explainColName},
@@ -1411,7 +1631,7 @@ func getExplainOption(requestCtx context.Context, options []tree.OptionElem) (*e
 				if strings.EqualFold(v.Value, "TEXT") {
 					es.Format = explain.EXPLAIN_FORMAT_TEXT
 				} else if strings.EqualFold(v.Value, "JSON") {
-					return nil, moerr.NewNotSupported(requestCtx, "Unsupport explain format '%s'", v.Value)
+					es.Format = explain.EXPLAIN_FORMAT_JSON
 				} else if strings.EqualFold(v.Value, "DOT") {
 					return nil, moerr.NewNotSupported(requestCtx, "Unsupport explain format '%s'", v.Value)
 				} else {
@@ -1495,6 +1715,11 @@ func buildPlan(requestCtx context.Context, ses *Session, ctx plan2.CompilerConte
 		ret, err = plan2.BuildPlan(ctx, stmt)
 	}
 	if ret != nil {
+		if ses != nil {
+			for _, warning := range ret.GetQuery().GetWarnings() {
+				ses.GetErrInfo().pushWarning(moerr.ER_UNKNOWN_ERROR, warning)
+			}
+		}
 		if ses != nil && ses.GetTenantInfo() != nil {
 			err = authenticateCanExecuteStatementAndPlan(requestCtx, ses, stmt, ret)
 			if err != nil {
@@ -1839,6 +2064,38 @@ func getStmtExecutor(ses *Session, proc *process.Process, base *baseStmtExecutor
 			},
 			dp: st,
 		})
+	case *tree.CreatePolicy:
+		base.ComputationWrapper = InitNullComputationWrapper(ses, st, proc)
+		ret = (&CreatePolicyExecutor{
+			statusStmtExecutor: &statusStmtExecutor{
+				base,
+			},
+			cp: st,
+		})
+	case *tree.DropPolicy:
+		base.ComputationWrapper = InitNullComputationWrapper(ses, st, proc)
+		ret = (&DropPolicyExecutor{
+			statusStmtExecutor: &statusStmtExecutor{
+				base,
+			},
+			dp: st,
+		})
+	case *tree.CreateAuditFilter:
+		base.ComputationWrapper = InitNullComputationWrapper(ses, st, proc)
+		ret = (&CreateAuditFilterExecutor{
+			statusStmtExecutor: &statusStmtExecutor{
+				base,
+			},
+			caf: st,
+		})
+	case *tree.DropAuditFilter:
+		base.ComputationWrapper = InitNullComputationWrapper(ses, st, proc)
+		ret = (&DropAuditFilterExecutor{
+			statusStmtExecutor: &statusStmtExecutor{
+				base,
+			},
+			daf: st,
+		})
 	case *tree.CreateAccount:
 		base.ComputationWrapper = InitNullComputationWrapper(ses, st, proc)
 		ret = (&CreateAccountExecutor{
@@ -2113,6 +2370,10 @@ func authenticateUserCanExecuteStatement(requestCtx context.Context, ses *Sessio
 	}
 	var havePrivilege bool
 	var err error
+	err = checkStatementWithExpiredPassword(requestCtx, ses, stmt)
+	if err != nil {
+		return err
+	}
 	if ses.GetTenantInfo() != nil {
 		ses.SetPrivilege(determinePrivilegeSetOfStatement(stmt))
 		havePrivilege, err = authenticateUserCanExecuteStatementWithObjectTypeAccountAndDatabase(requestCtx, ses, stmt)
@@ -2574,7 +2835,7 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 			}
 		case *tree.AnalyzeStmt:
 			selfHandle = true
-			if err = mce.handleAnalyzeStmt(requestCtx, st); err != nil {
+			if err = mce.handleAnalyzeStmt(requestCtx, st, i, len(cws)); err != nil {
 				goto handleFailed
 			}
 		case *tree.ExplainStmt:
@@ -2607,6 +2868,26 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 			if err = mce.handleDropPublication(requestCtx, st); err != nil {
 				goto handleFailed
 			}
+		case *tree.CreatePolicy:
+			selfHandle = true
+			if err = mce.handleCreatePolicy(requestCtx, st); err != nil {
+				goto handleFailed
+			}
+		case *tree.DropPolicy:
+			selfHandle = true
+			if err = mce.handleDropPolicy(requestCtx, st); err != nil {
+				goto handleFailed
+			}
+		case *tree.CreateAuditFilter:
+			selfHandle = true
+			if err = mce.handleCreateAuditFilter(requestCtx, st); err != nil {
+				goto handleFailed
+			}
+		case *tree.DropAuditFilter:
+			selfHandle = true
+			if err = mce.handleDropAuditFilter(requestCtx, st); err != nil {
+				goto handleFailed
+			}
 		case *tree.ShowPublications:
 			selfHandle = true
 			if err = mce.handleShowPublications(requestCtx, st, i, len(cws)); err != nil {
@@ -2753,6 +3034,41 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 			if err = mce.handleShowBackendServers(requestCtx, i, len(cws)); err != nil {
 				goto handleFailed
 			}
+		case *tree.Backup:
+			selfHandle = true
+			if err = mce.handleBackup(requestCtx, st, proc, i, len(cws)); err != nil {
+				goto handleFailed
+			}
+		case *tree.Restore:
+			selfHandle = true
+			if err = mce.handleRestore(requestCtx, st, proc, i, len(cws)); err != nil {
+				goto handleFailed
+			}
+		case *tree.CreateChangefeed, *tree.DropChangefeed:
+			selfHandle = true
+			if err = mce.handleChangefeed(requestCtx, st, proc); err != nil {
+				goto handleFailed
+			}
+		case *tree.ShowChangefeeds:
+			selfHandle = true
+			if err = mce.handleShowChangefeeds(requestCtx, proc, i, len(cws)); err != nil {
+				goto handleFailed
+			}
+		case *tree.CreateMaterializedView:
+			selfHandle = true
+			if err = doCreateMaterializedView(requestCtx, ses, proc, st); err != nil {
+				goto handleFailed
+			}
+		case *tree.DropMaterializedView:
+			selfHandle = true
+			if err = doDropMaterializedView(requestCtx, ses, proc, st); err != nil {
+				goto handleFailed
+			}
+		case *tree.RefreshMaterializedView:
+			selfHandle = true
+			if err = doRefreshMaterializedView(requestCtx, ses, proc, st); err != nil {
+				goto handleFailed
+			}
 		}
 
 		if selfHandle {
@@ -2962,6 +3278,9 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 			logInfof(ses.GetDebugString(), "time of Exec.Run : %s", time.Since(runBegin).String())
 
 			rspLen = cw.GetAffectedRows()
+			if cwft, ok := cw.(*TxnComputationWrapper); ok && cwft.plan != nil {
+				recordModifiedRows(ses, cwft.plan, rspLen)
+			}
 			echoTime := time.Now()
 
 			logInfof(ses.GetDebugString(), "time of SendResponse %s", time.Since(echoTime).String())
@@ -3074,6 +3393,10 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 			*tree.CreateView, *tree.DropView, *tree.AlterView, *tree.AlterTable, *tree.Load, *tree.MoDump,
 			*tree.CreateSequence, *tree.DropSequence,
 			*tree.CreateAccount, *tree.DropAccount, *tree.AlterAccount, *tree.AlterDataBaseConfig, *tree.CreatePublication, *tree.AlterPublication, *tree.DropPublication,
+			*tree.CreatePolicy, *tree.DropPolicy,
+			*tree.CreateAuditFilter, *tree.DropAuditFilter,
+			*tree.CreateChangefeed, *tree.DropChangefeed,
+			*tree.CreateMaterializedView, *tree.DropMaterializedView, *tree.RefreshMaterializedView,
 			*tree.CreateFunction, *tree.DropFunction,
 			*tree.CreateProcedure, *tree.DropProcedure, *tree.CallStmt,
 			*tree.CreateUser, *tree.DropUser, *tree.AlterUser,
@@ -45,6 +45,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/util/trace/impl/motrace"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"github.com/prashantv/gostub"
 	"github.com/smartystreets/goconvey/convey"
@@ -1280,3 +1281,79 @@ func TestMysqlCmdExecutor_HandleShowBackendServers(t *testing.T) {
 		require.Equal(t, "addr1", row[1])
 	})
 }
+
+func Test_buildBackupRequest(t *testing.T) {
+	ctx := context.TODO()
+	req, err := buildBackupRequest(ctx, &tree.Backup{BackupTarget: tree.BackupTarget{Dir: "/backup"}})
+	require.NoError(t, err)
+	require.Equal(t, "DISK", req.Backend)
+	require.Equal(t, "/backup", req.Dir)
+
+	_, err = buildBackupRequest(ctx, &tree.Backup{})
+	require.Error(t, err)
+
+	req, err = buildBackupRequest(ctx, &tree.Backup{BackupTarget: tree.BackupTarget{Option: []string{
+		"endpoint", "http://127.0.0.1:9000", "bucket", "b", "filepath", "mo", "provider", "minio"}}})
+	require.NoError(t, err)
+	require.Equal(t, "MINIO", req.Backend)
+	require.Equal(t, "b", req.Bucket)
+	require.Equal(t, "mo", req.KeyPrefix)
+
+	_, err = buildBackupRequest(ctx, &tree.Backup{BackupTarget: tree.BackupTarget{Option: []string{"endpoint", "e"}}})
+	require.Error(t, err)
+	_, err = buildBackupRequest(ctx, &tree.Backup{BackupTarget: tree.BackupTarget{Option: []string{"bucket", "b", "region", "r"}}})
+	require.Error(t, err)
+}
+
+func Test_buildRestoreRequest(t *testing.T) {
+	ctx := context.TODO()
+	req, err := buildRestoreRequest(ctx, &tree.Restore{
+		From: tree.BackupTarget{Option: []string{"bucket", "b", "filepath", "backup"}},
+		To:   tree.BackupTarget{Dir: "/restore"},
+		Ts:   "1-1",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "S3", req.Src.Backend)
+	require.Equal(t, "backup", req.Src.KeyPrefix)
+	require.Equal(t, "DISK", req.Dst.Backend)
+	require.Equal(t, "/restore", req.Dst.Dir)
+	require.Equal(t, "1-1", req.Ts)
+
+	_, err = buildRestoreRequest(ctx, &tree.Restore{
+		From: tree.BackupTarget{Dir: "/backup"},
+		To:   tree.BackupTarget{},
+	})
+	require.Error(t, err)
+	_, err = buildRestoreRequest(ctx, &tree.Restore{
+		From: tree.BackupTarget{Dir: "/backup"},
+		To:   tree.BackupTarget{Dir: "/backup"},
+	})
+	require.Error(t, err)
+}
+
+func Test_buildChangefeedRequest(t *testing.T) {
+	ctx := context.TODO()
+	stmt := &tree.CreateChangefeed{
+		Name: "cf",
+		Tables: tree.TableNames{
+			tree.NewTableName("t1", tree.ObjectNamePrefix{}),
+			tree.NewTableName("t2", tree.ObjectNamePrefix{SchemaName: "db2", ExplicitSchema: true}),
+		},
+		Sink:   "file:///tmp/cf",
+		Cursor: "100-0",
+	}
+	req, err := buildChangefeedRequest(ctx, stmt, "db1")
+	require.NoError(t, err)
+	require.Equal(t, db.ChangefeedCreate, req.Op)
+	require.Equal(t, []string{"db1.t1", "db2.t2"}, req.Tables)
+	require.Equal(t, "file:///tmp/cf", req.Sink)
+	require.Equal(t, "100-0", req.Cursor)
+
+	_, err = buildChangefeedRequest(ctx, stmt, "")
+	require.Error(t, err)
+
+	req, err = buildChangefeedRequest(ctx, &tree.DropChangefeed{Name: "cf", IfExists: true}, "")
+	require.NoError(t, err)
+	require.Equal(t, db.ChangefeedDrop, req.Op)
+	require.True(t, req.IfExists)
+}
@@ -142,6 +142,8 @@ const (
 
 	AuthNativePassword string = "mysql_native_password"
 
+	AuthCachingSha2Password string = "caching_sha2_password"
+
 	//the length of the mysql protocol header
 	HeaderLengthOfTheProtocol int = 4
 	HeaderOffset              int = 0
@@ -287,6 +289,9 @@ type MysqlProtocolImpl struct {
 	// indicated by the plugin name field.
 	authResponse []byte
 
+	// the authentication method that generated the authResponse
+	authPluginName string
+
 	//the default database for the client
 	database string
 
@@ -1073,24 +1078,53 @@ func (mp *MysqlProtocolImpl) checkPassword(pwd, salt, auth []byte) bool {
 // the server authenticate that the client can connect and use the database
 func (mp *MysqlProtocolImpl) authenticateUser(ctx context.Context, authResponse []byte) error {
 	var psw []byte
+	var authString string
 	var err error
 	var tenant *TenantInfo
 
 	ses := mp.GetSession()
 	if !mp.GetSkipCheckUser() {
 		logDebugf(mp.getDebugStringUnsafe(), "authenticate user 1")
-		psw, err = ses.AuthenticateUser(mp.GetUserName())
+		authString, err = ses.AuthenticateUser(mp.GetUserName())
 		if err != nil {
 			return err
 		}
 		logDebugf(mp.getDebugStringUnsafe(), "authenticate user 2")
 
+		//switch to the authentication method of the user
+		plugin := getAuthPluginOfAuthString(authString)
+		if plugin != mp.authPluginName {
+			if mp.capability&CLIENT_PLUGIN_AUTH == 0 {
+				return moerr.NewInternalError(ctx, "the client does not support the authentication method %s", plugin)
+			}
+			authResponse, err = mp.negotiateAuthenticationMethod(ctx, plugin)
+			if err != nil {
+				return moerr.NewInternalError(ctx, "negotiate authentication method failed. error:%v", err)
+			}
+			mp.authPluginName = plugin
+		}
+
 		//TO Check password
-		if mp.checkPassword(psw, mp.GetSalt(), authResponse) {
-			logInfof(mp.getDebugStringUnsafe(), "check password succeeded")
-		} else {
-			return moerr.NewInternalError(ctx, "check password failed")
+		switch plugin {
+		case AuthCachingSha2Password:
+			tenant = ses.GetTenantInfo()
+			key := getCachingSha2CacheKey(tenant.GetTenant(), tenant.GetUser())
+			err = mp.authenticateCachingSha2(ctx, key, authString, authResponse)
+		default:
+			psw, err = GetPassWord(authString)
+			if err != nil {
+				return err
+			}
+			if !mp.checkPassword(psw, mp.GetSalt(), authResponse) {
+				err = moerr.NewInternalError(ctx, "check password failed")
+			}
 		}
+
+		//count the failed logins and check the password expiration
+		if err = ses.checkLoginResult(err); err != nil {
+			return err
+		}
+		logInfof(mp.getDebugStringUnsafe(), "check password succeeded")
 	} else {
 		logDebugf(mp.getDebugStringUnsafe(), "skip authenticate user")
 		//Get tenant info
@@ -1136,6 +1170,7 @@ func (mp *MysqlProtocolImpl) HandleHandshake(ctx context.Context, payload []byte
 		}
 
 		mp.authResponse = resp41.authResponse
+		mp.authPluginName = resp41.clientPluginName
 		mp.capability = mp.capability & resp41.capabilities
 
 		if nameAndCharset, ok3 := collationID2CharsetAndName[int(resp41.collationID)]; !ok3 {
@@ -1163,6 +1198,7 @@ func (mp *MysqlProtocolImpl) HandleHandshake(ctx context.Context, payload []byte
 		}
 
 		mp.authResponse = resp320.authResponse
+		mp.authPluginName = AuthNativePassword
 		mp.capability = mp.capability & resp320.capabilities
 		mp.collationID = int(Utf8mb4CollationID)
 		mp.collationName = "utf8mb4_general_ci"
@@ -1178,7 +1214,9 @@ func (mp *MysqlProtocolImpl) HandleHandshake(ctx context.Context, payload []byte
 func (mp *MysqlProtocolImpl) Authenticate(ctx context.Context) error {
 	logDebugf(mp.getDebugStringUnsafe(), "authenticate user")
 	mp.incDebugCount(0)
-	if err := mp.authenticateUser(ctx, mp.authResponse); err != nil {
+	err := mp.authenticateUser(ctx, mp.authResponse)
+	auditLogin(ctx, mp.GetSession(), mp.GetUserName(), err)
+	if err != nil {
 		logutil.Errorf("authenticate user failed.error:%v", err)
 		fail := moerr.MysqlErrorMsgRefer[moerr.ER_ACCESS_DENIED_ERROR]
 		tipsFormat := "Access denied for user %s. %s"
@@ -1193,7 +1231,7 @@ func (mp *MysqlProtocolImpl) Authenticate(ctx context.Context) error {
 
 	mp.incDebugCount(2)
 	logInfof(mp.getDebugStringUnsafe(), "handle handshake end")
-	err := mp.sendOKPacket(0, 0, 0, 0, "")
+	err = mp.sendOKPacket(0, 0, 0, 0, "")
 	mp.incDebugCount(3)
 	logInfof(mp.getDebugStringUnsafe(), "handle handshake response ok")
 	if err != nil {
@@ -1364,13 +1402,16 @@ func (mp *MysqlProtocolImpl) analyseHandshakeResponse41(ctx context.Context, dat
 		}
 
 		//to switch authenticate method
-		if info.clientPluginName != AuthNativePassword {
+		//the caching_sha2_password is switched after the method of the user is known.
+		if info.clientPluginName != AuthNativePassword && info.clientPluginName != AuthCachingSha2Password {
 			var err error
-			if info.authResponse, err = mp.negotiateAuthenticationMethod(ctx); err != nil {
+			if info.authResponse, err = mp.negotiateAuthenticationMethod(ctx, AuthNativePassword); err != nil {
 				return false, info, moerr.NewInternalError(ctx, "negotiate authentication method failed. error:%v", err)
 			}
 			info.clientPluginName = AuthNativePassword
 		}
+	} else {
+		info.clientPluginName = AuthNativePassword
 	}
 
 	// client connection attributes
@@ -1537,14 +1578,18 @@ func (mp *MysqlProtocolImpl) makeAuthSwitchRequestPayload(authMethodName string)
 // the server can send AuthSwitchRequest to ask client to use designated authentication method,
 // if both server and client support CLIENT_PLUGIN_AUTH capability.
 // return data authenticated with new method
-func (mp *MysqlProtocolImpl) negotiateAuthenticationMethod(ctx context.Context) ([]byte, error) {
+func (mp *MysqlProtocolImpl) negotiateAuthenticationMethod(ctx context.Context, authMethodName string) ([]byte, error) {
 	var err error
-	aswPkt := mp.makeAuthSwitchRequestPayload(AuthNativePassword)
+	aswPkt := mp.makeAuthSwitchRequestPayload(authMethodName)
 	err = mp.writePackets(aswPkt)
 	if err != nil {
 		return nil, err
 	}
+	return mp.readAuthPacket(ctx)
+}
 
+// readAuthPacket reads the packet from the client in the authentication phase.
+func (mp *MysqlProtocolImpl) readAuthPacket(ctx context.Context) ([]byte, error) {
 	read, err := mp.tcpConn.Read(goetty.ReadOptions{})
 	if err != nil {
 		return nil, err
@@ -0,0 +1,553 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"unicode"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+/*
+The password policy of the user is in the table mo_user_password_policy.
+
+	password_lifetime : the days that the password can be used.
+		-1 : use the default_password_lifetime. 0 : never expire.
+	password_last_changed : the unix time when the password was changed.
+	password_expired : the password has been expired manually.
+	password_history : the number of the recent passwords that can not be reused.
+		-1 : use the password_history.
+	password_reuse_interval : the days that the old password can not be reused.
+		-1 : use the password_reuse_interval.
+	failed_login_attempts : the number of the consecutive failed logins before
+		the user is locked. 0 : do not lock the user.
+	password_lock_time : the days that the user is locked after the failed logins.
+		-1 : locked until the ALTER USER ... UNLOCK.
+	failed_login_count : the number of the consecutive failed logins.
+	locked_time : the unix time when the user was locked. 0 : not locked.
+
+The user that does not have the policy entry uses the default policy,
+and the age of the password is not tracked until the password is changed.
+
+The passwords of the user are recorded in the table mo_user_password_history.
+*/
+
+const (
+	// the value of the policy denotes the global system variable is used
+	passwordPolicyDefault int64 = -1
+	// the user is locked until the ALTER USER ... UNLOCK
+	passwordLockTimeUnbounded int64 = -1
+
+	secondsPerDay int64 = 24 * 60 * 60
+)
+
+const (
+	getPasswordPolicyOfUserFormat = `select password_lifetime,password_last_changed,password_expired,password_history,password_reuse_interval,failed_login_attempts,password_lock_time,failed_login_count,locked_time from mo_catalog.mo_user_password_policy where user_id = %d;`
+
+	insertPasswordPolicyFormat = `insert into mo_catalog.mo_user_password_policy(
+				user_id,
+				password_lifetime,
+				password_last_changed,
+				password_expired,
+				password_history,
+				password_reuse_interval,
+				failed_login_attempts,
+				password_lock_time,
+				failed_login_count,
+				locked_time
+			) values (%d,%d,%d,%v,%d,%d,%d,%d,%d,%d);`
+
+	updatePasswordPolicyFormat = `update mo_catalog.mo_user_password_policy set password_lifetime = %d,password_last_changed = %d,password_expired = %v,password_history = %d,password_reuse_interval = %d,failed_login_attempts = %d,password_lock_time = %d,failed_login_count = %d,locked_time = %d where user_id = %d;`
+
+	resetFailedLoginOfUserFormat = `update mo_catalog.mo_user_password_policy set failed_login_count = 0,locked_time = 0 where user_id = %d;`
+
+	// the lock is reset only if it is still the expired one, so the failed logins counted by the others are kept.
+	resetExpiredLockOfUserFormat = `update mo_catalog.mo_user_password_policy set failed_login_count = 0,locked_time = 0 where user_id = %d and locked_time = %d;`
+
+	increaseFailedLoginOfUserFormat = `update mo_catalog.mo_user_password_policy set failed_login_count = failed_login_count + 1 where user_id = %d;`
+
+	// the user is locked by the stored count, which counts the concurrent failed logins too.
+	lockUserOnFailedLoginFormat = `update mo_catalog.mo_user_password_policy set locked_time = %d where user_id = %d and locked_time = 0 and failed_login_count >= failed_login_attempts;`
+
+	getPasswordHistoryOfUserFormat = `select authentication_string,password_time from mo_catalog.mo_user_password_history where user_id = %d order by history_id desc;`
+
+	insertPasswordHistoryFormat = `insert into mo_catalog.mo_user_password_history(
+				user_id,
+				authentication_string,
+				password_time
+			) values (%d,"%s",%d);`
+
+	updateStatusOfUserFormat = `update mo_catalog.mo_user set status = "%s" where user_id = %d;`
+)
+
+// passwordPolicy denotes the entry of the user in the mo_user_password_policy
+type passwordPolicy struct {
+	userID              int64
+	lifetime            int64
+	lastChanged         int64
+	expired             bool
+	history             int64
+	reuseInterval       int64
+	failedLoginAttempts int64
+	lockTime            int64
+	failedLoginCount    int64
+	lockedTime          int64
+
+	//the user has the entry in the mo_user_password_policy
+	exists bool
+	//the user was locked temporarily and the lock time has passed
+	lockExpired bool
+}
+
+func newDefaultPasswordPolicy(userID int64) *passwordPolicy {
+	return &passwordPolicy{
+		userID:        userID,
+		lifetime:      passwordPolicyDefault,
+		history:       passwordPolicyDefault,
+		reuseInterval: passwordPolicyDefault,
+	}
+}
+
+func getSqlForPasswordPolicyOfUser(userID int64) string {
+	return fmt.Sprintf(getPasswordPolicyOfUserFormat, userID)
+}
+
+func getSqlForSavePasswordPolicy(policy *passwordPolicy) string {
+	if policy.exists {
+		return fmt.Sprintf(updatePasswordPolicyFormat, policy.lifetime, policy.lastChanged, policy.expired,
+			policy.history, policy.reuseInterval, policy.failedLoginAttempts, policy.lockTime,
+			policy.failedLoginCount, policy.lockedTime, policy.userID)
+	}
+	return fmt.Sprintf(insertPasswordPolicyFormat, policy.userID, policy.lifetime, policy.lastChanged, policy.expired,
+		policy.history, policy.reuseInterval, policy.failedLoginAttempts, policy.lockTime,
+		policy.failedLoginCount, policy.lockedTime)
+}
+
+func getSqlForResetFailedLogin(userID int64) string {
+	return fmt.Sprintf(resetFailedLoginOfUserFormat, userID)
+}
+
+// getSqlsForLoginFailed returns the sqls counting the failed login in the table.
+// It must be called before onLoginFailed which forgets the expired lock.
+func getSqlsForLoginFailed(policy *passwordPolicy, now int64) []string {
+	var sqls []string
+	if policy.lockExpired {
+		sqls = append(sqls, fmt.Sprintf(resetExpiredLockOfUserFormat, policy.userID, policy.lockedTime))
+	}
+	return append(sqls,
+		fmt.Sprintf(increaseFailedLoginOfUserFormat, policy.userID),
+		fmt.Sprintf(lockUserOnFailedLoginFormat, now, policy.userID))
+}
+
+func getSqlForPasswordHistoryOfUser(userID int64) string {
+	return fmt.Sprintf(getPasswordHistoryOfUserFormat, userID)
+}
+
+func getSqlForInsertPasswordHistory(userID int64, authString string, now int64) string {
+	return fmt.Sprintf(insertPasswordHistoryFormat, userID, authString, now)
+}
+
+func getSqlForUpdateStatusOfUser(status string, userID int64) string {
+	return fmt.Sprintf(updateStatusOfUserFormat, status, userID)
+}
+
+// fillPasswordPolicy fills the policy with the result of the getPasswordPolicyOfUserFormat
+func fillPasswordPolicy(ctx context.Context, policy *passwordPolicy, rsset []ExecResult) error {
+	var err error
+	if !execResultArrayHasData(rsset) {
+		return nil
+	}
+	values := []*int64{&policy.lifetime, &policy.lastChanged, nil, &policy.history, &policy.reuseInterval,
+		&policy.failedLoginAttempts, &policy.lockTime, &policy.failedLoginCount, &policy.lockedTime}
+	for i, v := range values {
+		if v == nil {
+			var expired int64
+			expired, err = rsset[0].GetInt64(ctx, 0, uint64(i))
+			if err != nil {
+				return err
+			}
+			policy.expired = expired != 0
+			continue
+		}
+		*v, err = rsset[0].GetInt64(ctx, 0, uint64(i))
+		if err != nil {
+			return err
+		}
+	}
+	policy.exists = true
+	return nil
+}
+
+// getPasswordPolicyOfUser reads the password policy of the user in the transaction
+func getPasswordPolicyOfUser(ctx context.Context, bh BackgroundExec, userID int64) (*passwordPolicy, error) {
+	policy := newDefaultPasswordPolicy(userID)
+	bh.ClearExecResultSet()
+	err := bh.Exec(ctx, getSqlForPasswordPolicyOfUser(userID))
+	if err != nil {
+		return nil, err
+	}
+	rsset, err := getResultSet(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+	if err = fillPasswordPolicy(ctx, policy, rsset); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// isLockTracked denotes the failed logins of the user are counted or not
+func (policy *passwordPolicy) isLockTracked() bool {
+	return policy.failedLoginAttempts > 0 && policy.lockTime != 0
+}
+
+// checkLocked checks the user is locked temporarily due to the failed logins.
+func (policy *passwordPolicy) checkLocked(ctx context.Context, now int64) error {
+	if !policy.isLockTracked() || policy.lockedTime == 0 {
+		return nil
+	}
+	if policy.lockTime == passwordLockTimeUnbounded {
+		return moerr.NewInternalError(ctx, "Account is blocked due to %d consecutive failed logins.", policy.failedLoginAttempts)
+	}
+	remaining := policy.lockedTime + policy.lockTime*secondsPerDay - now
+	if remaining > 0 {
+		return moerr.NewInternalError(ctx, "Account is blocked for %d day(s) (%d day(s) remaining) due to %d consecutive failed logins.",
+			policy.lockTime, (remaining+secondsPerDay-1)/secondsPerDay, policy.failedLoginAttempts)
+	}
+	policy.lockExpired = true
+	return nil
+}
+
+// onLoginFailed counts the failed login. It returns true if the policy has been changed.
+func (policy *passwordPolicy) onLoginFailed(now int64) bool {
+	if !policy.isLockTracked() {
+		return false
+	}
+	if policy.lockExpired {
+		policy.failedLoginCount = 0
+		policy.lockedTime = 0
+		policy.lockExpired = false
+	}
+	policy.failedLoginCount++
+	if policy.failedLoginCount >= policy.failedLoginAttempts {
+		policy.lockedTime = now
+	}
+	return true
+}
+
+// onLoginSucceeded resets the failed logins. It returns true if the policy has been changed.
+func (policy *passwordPolicy) onLoginSucceeded() bool {
+	if policy.failedLoginCount == 0 && policy.lockedTime == 0 {
+		return false
+	}
+	policy.failedLoginCount = 0
+	policy.lockedTime = 0
+	policy.lockExpired = false
+	return true
+}
+
+// isPasswordExpired checks the password of the user has been expired or not.
+func (policy *passwordPolicy) isPasswordExpired(defaultLifetime, now int64) bool {
+	if policy.expired {
+		return true
+	}
+	lifetime := policy.lifetime
+	if lifetime == passwordPolicyDefault {
+		lifetime = defaultLifetime
+	}
+	if lifetime <= 0 || policy.lastChanged <= 0 {
+		return false
+	}
+	return now-policy.lastChanged >= lifetime*secondsPerDay
+}
+
+// applyOption changes the policy with the option in the CREATE USER or ALTER USER.
+// It returns the new status of the user if the option is LOCK or UNLOCK.
+func (policy *passwordPolicy) applyOption(ctx context.Context, opt tree.UserMiscOption) (string, error) {
+	switch o := opt.(type) {
+	case *tree.UserMiscOptionAccountLock:
+		return userStatusLock, nil
+	case *tree.UserMiscOptionAccountUnlock:
+		policy.failedLoginCount = 0
+		policy.lockedTime = 0
+		return userStatusUnlock, nil
+	case *tree.UserMiscOptionPasswordExpireNone:
+		policy.expired = true
+	case *tree.UserMiscOptionPasswordExpireDefault:
+		policy.lifetime = passwordPolicyDefault
+	case *tree.UserMiscOptionPasswordExpireNever:
+		policy.lifetime = 0
+	case *tree.UserMiscOptionPasswordExpireInterval:
+		if o.Value <= 0 {
+			return "", moerr.NewInternalError(ctx, "the interval of the password expiration should be greater than 0")
+		}
+		policy.lifetime = o.Value
+	case *tree.UserMiscOptionPasswordHistoryDefault:
+		policy.history = passwordPolicyDefault
+	case *tree.UserMiscOptionPasswordHistoryCount:
+		policy.history = o.Value
+	case *tree.UserMiscOptionPasswordReuseIntervalDefault:
+		policy.reuseInterval = passwordPolicyDefault
+	case *tree.UserMiscOptionPasswordReuseIntervalCount:
+		policy.reuseInterval = o.Value
+	case *tree.UserMiscOptionFailedLoginAttempts:
+		policy.failedLoginAttempts = o.Value
+		policy.failedLoginCount = 0
+		policy.lockedTime = 0
+	case *tree.UserMiscOptionPasswordLockTimeCount:
+		policy.lockTime = o.Value
+		policy.failedLoginCount = 0
+		policy.lockedTime = 0
+	case *tree.UserMiscOptionPasswordLockTimeUnbounded:
+		policy.lockTime = passwordLockTimeUnbounded
+		policy.failedLoginCount = 0
+		policy.lockedTime = 0
+	default:
+		return "", moerr.NewInternalError(ctx, "not support the option %s", tree.String(opt, dialect.MYSQL))
+	}
+	return "", nil
+}
+
+// getGlobalIntVar gets the int64 value of the global system variable.
+// It returns zero if the variable does not exist.
+func getGlobalIntVar(ses *Session, name string) int64 {
+	if ses == nil || ses.GetGlobalSysVars() == nil {
+		return 0
+	}
+	val, err := ses.GetGlobalVar(name)
+	if err != nil {
+		return 0
+	}
+	switch v := val.(type) {
+	case int64:
+		return v
+	case int8:
+		return int64(v)
+	case uint64:
+		return int64(v)
+	case bool:
+		if v {
+			return 1
+		}
+	}
+	return 0
+}
+
+// validatePasswordComplexity checks the new password with the validate_password_* variables
+func validatePasswordComplexity(ctx context.Context, ses *Session, userName, password string) error {
+	if getGlobalIntVar(ses, "validate_password") == 0 {
+		return nil
+	}
+	var lower, upper, number, special int64
+	for _, c := range password {
+		switch {
+		case unicode.IsLower(c):
+			lower++
+		case unicode.IsUpper(c):
+			upper++
+		case unicode.IsDigit(c):
+			number++
+		default:
+			special++
+		}
+	}
+	if length := getGlobalIntVar(ses, "validate_password_length"); int64(len([]rune(password))) < length {
+		return moerr.NewInternalError(ctx, "Your password does not satisfy the current policy requirements. The length of the password should be at least %d", length)
+	}
+	if mixed := getGlobalIntVar(ses, "validate_password_mixed_case_count"); lower < mixed || upper < mixed {
+		return moerr.NewInternalError(ctx, "Your password does not satisfy the current policy requirements. The password should have at least %d lowercase and %d uppercase characters", mixed, mixed)
+	}
+	if cnt := getGlobalIntVar(ses, "validate_password_number_count"); number < cnt {
+		return moerr.NewInternalError(ctx, "Your password does not satisfy the current policy requirements. The password should have at least %d numeric characters", cnt)
+	}
+	if cnt := getGlobalIntVar(ses, "validate_password_special_char_count"); special < cnt {
+		return moerr.NewInternalError(ctx, "Your password does not satisfy the current policy requirements. The password should have at least %d nonalphanumeric characters", cnt)
+	}
+	if password == userName {
+		return moerr.NewInternalError(ctx, "Your password does not satisfy the current policy requirements. The password should not be the user name")
+	}
+	return nil
+}
+
+// checkPasswordWithAuthString checks the plaintext password with the authentication_string
+func checkPasswordWithAuthString(authString, password string) bool {
+	if isCachingSha2AuthString(authString) {
+		return checkCachingSha2AuthString(authString, []byte(password))
+	}
+	return HashPassWord(password) == authString
+}
+
+// checkPasswordHistory checks the new password is not in the recent passwords of the user
+func checkPasswordHistory(ctx context.Context, ses *Session, bh BackgroundExec, policy *passwordPolicy, userName, password string, now int64) error {
+	history := policy.history
+	if history == passwordPolicyDefault {
+		history = getGlobalIntVar(ses, "password_history")
+	}
+	reuseInterval := policy.reuseInterval
+	if reuseInterval == passwordPolicyDefault {
+		reuseInterval = getGlobalIntVar(ses, "password_reuse_interval")
+	}
+	if history <= 0 && reuseInterval <= 0 {
+		return nil
+	}
+
+	bh.ClearExecResultSet()
+	err := bh.Exec(ctx, getSqlForPasswordHistoryOfUser(policy.userID))
+	if err != nil {
+		return err
+	}
+	rsset, err := getResultSet(ctx, bh)
+	if err != nil {
+		return err
+	}
+	if !execResultArrayHasData(rsset) {
+		return nil
+	}
+	for i := uint64(0); i < rsset[0].GetRowCount(); i++ {
+		authString, err := rsset[0].GetString(ctx, i, 0)
+		if err != nil {
+			return err
+		}
+		passwordTime, err := rsset[0].GetInt64(ctx, i, 1)
+		if err != nil {
+			return err
+		}
+		inHistory := int64(i) < history
+		inReuseInterval := reuseInterval > 0 && now-passwordTime < reuseInterval*secondsPerDay
+		if !inHistory && !inReuseInterval {
+			continue
+		}
+		if checkPasswordWithAuthString(authString, password) {
+			return moerr.NewInternalError(ctx, "Cannot use these credentials for '%s' because they contradict the password history policy", userName)
+		}
+	}
+	return nil
+}
+
+// getTenantCtxOfSession returns the context with the account, the user and the role of the session.
+func getTenantCtxOfSession(ses *Session) context.Context {
+	tenant := ses.GetTenantInfo()
+	ctx := context.WithValue(ses.GetRequestContext(), defines.TenantIDKey{}, tenant.GetTenantID())
+	ctx = context.WithValue(ctx, defines.UserIDKey{}, tenant.GetUserID())
+	ctx = context.WithValue(ctx, defines.RoleIDKey{}, tenant.GetDefaultRoleID())
+	return ctx
+}
+
+// checkLoginResult updates the failed logins of the user and checks the password expiration
+// after the password has been checked.
+func (ses *Session) checkLoginResult(loginErr error) error {
+	policy := ses.getPasswordPolicy()
+	if policy == nil {
+		return loginErr
+	}
+
+	now := time.Now().Unix()
+	tenantCtx := getTenantCtxOfSession(ses)
+	var sqls []string
+	if loginErr != nil {
+		if policy.isLockTracked() {
+			sqls = getSqlsForLoginFailed(policy, now)
+			policy.onLoginFailed(now)
+		}
+	} else if policy.onLoginSucceeded() {
+		sqls = []string{getSqlForResetFailedLogin(policy.userID)}
+	}
+	if len(sqls) != 0 {
+		if err := updateFailedLoginOfUser(tenantCtx, ses, sqls); err != nil {
+			logErrorf(ses.GetDebugString(), "update the failed logins of the user failed. error:%v", err)
+		}
+	}
+	if loginErr != nil {
+		return loginErr
+	}
+
+	//like the sandbox mode of mysql, the user with the expired password can log in
+	//but can do nothing except changing the password.
+	if policy.isPasswordExpired(getGlobalIntVar(ses, "default_password_lifetime"), now) {
+		ses.setPasswordExpired(true)
+	}
+	return nil
+}
+
+// updateFailedLoginOfUser executes the sqls updating the failed logins in a transaction.
+func updateFailedLoginOfUser(ctx context.Context, ses *Session, sqls []string) error {
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+	err := bh.Exec(ctx, "begin;")
+	if err != nil {
+		return err
+	}
+	for _, sql := range sqls {
+		if err = bh.Exec(ctx, sql); err != nil {
+			goto handleFailed
+		}
+	}
+	err = bh.Exec(ctx, "commit;")
+	if err != nil {
+		goto handleFailed
+	}
+	return nil
+
+handleFailed:
+	//ROLLBACK the transaction
+	rbErr := bh.Exec(ctx, "rollback;")
+	if rbErr != nil {
+		return rbErr
+	}
+	return err
+}
+
+func (ses *Session) isPasswordExpired() bool {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	return ses.passwordExpired
+}
+
+func (ses *Session) setPasswordExpired(expired bool) {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	ses.passwordExpired = expired
+}
+
+// checkStatementWithExpiredPassword checks the statement can be executed by
+// the session whose password has expired.
+func checkStatementWithExpiredPassword(ctx context.Context, ses *Session, stmt tree.Statement) error {
+	if !ses.isPasswordExpired() {
+		return nil
+	}
+	switch stmt.(type) {
+	case *tree.AlterUser, *tree.SetPassword, *tree.SetVar:
+		return nil
+	}
+	return moerr.NewInternalError(ctx, "You must reset your password using ALTER USER statement before executing this statement.")
+}
+
+func (ses *Session) getPasswordPolicy() *passwordPolicy {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	return ses.passwordPolicy
+}
+
+func (ses *Session) setPasswordPolicy(policy *passwordPolicy) {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	ses.passwordPolicy = policy
+}
@@ -0,0 +1,170 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func Test_passwordPolicyFailedLogin(t *testing.T) {
+	ctx := context.TODO()
+	convey.Convey("lock the user after the failed logins", t, func() {
+		policy := newDefaultPasswordPolicy(1)
+		now := int64(1000000)
+		//the failed logins are not counted by default
+		convey.So(policy.onLoginFailed(now), convey.ShouldBeFalse)
+
+		_, err := policy.applyOption(ctx, &tree.UserMiscOptionFailedLoginAttempts{Value: 2})
+		convey.So(err, convey.ShouldBeNil)
+		_, err = policy.applyOption(ctx, &tree.UserMiscOptionPasswordLockTimeCount{Value: 1})
+		convey.So(err, convey.ShouldBeNil)
+
+		convey.So(policy.onLoginFailed(now), convey.ShouldBeTrue)
+		convey.So(policy.checkLocked(ctx, now), convey.ShouldBeNil)
+		convey.So(policy.onLoginFailed(now), convey.ShouldBeTrue)
+		convey.So(policy.lockedTime, convey.ShouldEqual, now)
+		convey.So(policy.checkLocked(ctx, now+100), convey.ShouldNotBeNil)
+
+		//the lock time has passed
+		convey.So(policy.checkLocked(ctx, now+secondsPerDay), convey.ShouldBeNil)
+		convey.So(policy.onLoginFailed(now+secondsPerDay), convey.ShouldBeTrue)
+		convey.So(policy.failedLoginCount, convey.ShouldEqual, 1)
+		convey.So(policy.lockedTime, convey.ShouldEqual, 0)
+
+		convey.So(policy.onLoginSucceeded(), convey.ShouldBeTrue)
+		convey.So(policy.onLoginSucceeded(), convey.ShouldBeFalse)
+
+		//locked until unlock
+		_, err = policy.applyOption(ctx, &tree.UserMiscOptionPasswordLockTimeUnbounded{})
+		convey.So(err, convey.ShouldBeNil)
+		policy.onLoginFailed(now)
+		policy.onLoginFailed(now)
+		convey.So(policy.checkLocked(ctx, now+100*secondsPerDay), convey.ShouldNotBeNil)
+
+		status, err := policy.applyOption(ctx, &tree.UserMiscOptionAccountUnlock{})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(status, convey.ShouldEqual, userStatusUnlock)
+		convey.So(policy.checkLocked(ctx, now), convey.ShouldBeNil)
+	})
+}
+
+func Test_getSqlsForLoginFailed(t *testing.T) {
+	ctx := context.TODO()
+	convey.Convey("the failed logins are counted by the stored value", t, func() {
+		policy := newDefaultPasswordPolicy(1)
+		now := int64(1000000)
+		_, err := policy.applyOption(ctx, &tree.UserMiscOptionFailedLoginAttempts{Value: 2})
+		convey.So(err, convey.ShouldBeNil)
+		_, err = policy.applyOption(ctx, &tree.UserMiscOptionPasswordLockTimeCount{Value: 1})
+		convey.So(err, convey.ShouldBeNil)
+
+		sqls := getSqlsForLoginFailed(policy, now)
+		convey.So(sqls, convey.ShouldResemble, []string{
+			"update mo_catalog.mo_user_password_policy set failed_login_count = failed_login_count + 1 where user_id = 1;",
+			"update mo_catalog.mo_user_password_policy set locked_time = 1000000 where user_id = 1 and locked_time = 0 and failed_login_count >= failed_login_attempts;",
+		})
+
+		//the expired lock is reset only if no one has reset it
+		policy.onLoginFailed(now)
+		policy.onLoginFailed(now)
+		convey.So(policy.checkLocked(ctx, now+secondsPerDay), convey.ShouldBeNil)
+		sqls = getSqlsForLoginFailed(policy, now+secondsPerDay)
+		convey.So(sqls, convey.ShouldHaveLength, 3)
+		convey.So(sqls[0], convey.ShouldEqual,
+			"update mo_catalog.mo_user_password_policy set failed_login_count = 0,locked_time = 0 where user_id = 1 and locked_time = 1000000;")
+	})
+}
+
+func Test_passwordPolicyExpiration(t *testing.T) {
+	ctx := context.TODO()
+	convey.Convey("password expiration", t, func() {
+		policy := newDefaultPasswordPolicy(1)
+		now := int64(1000000)
+		//the age of the password is not tracked
+		convey.So(policy.isPasswordExpired(1, now), convey.ShouldBeFalse)
+
+		policy.lastChanged = now
+		convey.So(policy.isPasswordExpired(0, now+10*secondsPerDay), convey.ShouldBeFalse)
+		convey.So(policy.isPasswordExpired(1, now+10*secondsPerDay), convey.ShouldBeTrue)
+
+		_, err := policy.applyOption(ctx, &tree.UserMiscOptionPasswordExpireNever{})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(policy.isPasswordExpired(1, now+10*secondsPerDay), convey.ShouldBeFalse)
+
+		_, err = policy.applyOption(ctx, &tree.UserMiscOptionPasswordExpireInterval{Value: 20})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(policy.isPasswordExpired(1, now+10*secondsPerDay), convey.ShouldBeFalse)
+		convey.So(policy.isPasswordExpired(1, now+20*secondsPerDay), convey.ShouldBeTrue)
+
+		_, err = policy.applyOption(ctx, &tree.UserMiscOptionPasswordExpireNone{})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(policy.isPasswordExpired(0, now), convey.ShouldBeTrue)
+
+		_, err = policy.applyOption(ctx, &tree.UserMiscOptionPasswordRequireCurrentNone{})
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+func Test_validatePasswordComplexity(t *testing.T) {
+	ctx := context.TODO()
+	convey.Convey("password complexity", t, func() {
+		var gSys GlobalSystemVariables
+		InitGlobalSystemVariables(&gSys)
+		ses := &Session{gSysVars: &gSys}
+
+		//disabled by default
+		convey.So(validatePasswordComplexity(ctx, ses, "u1", "111"), convey.ShouldBeNil)
+
+		convey.So(gSys.SetGlobalSysVar(ctx, "validate_password", int64(1)), convey.ShouldBeNil)
+		convey.So(validatePasswordComplexity(ctx, ses, "u1", "Ab1!"), convey.ShouldNotBeNil)
+		convey.So(validatePasswordComplexity(ctx, ses, "u1", "abcdef1!"), convey.ShouldNotBeNil)
+		convey.So(validatePasswordComplexity(ctx, ses, "u1", "Abcdefg!"), convey.ShouldNotBeNil)
+		convey.So(validatePasswordComplexity(ctx, ses, "u1", "Abcdefg1"), convey.ShouldNotBeNil)
+		convey.So(validatePasswordComplexity(ctx, ses, "u1", "Abcdef1!"), convey.ShouldBeNil)
+		convey.So(validatePasswordComplexity(ctx, ses, "Abcdef1!", "Abcdef1!"), convey.ShouldNotBeNil)
+	})
+}
+
+func Test_checkPasswordWithAuthString(t *testing.T) {
+	convey.Convey("check the password with the authentication_string", t, func() {
+		convey.So(checkPasswordWithAuthString(HashPassWord("111"), "111"), convey.ShouldBeTrue)
+		convey.So(checkPasswordWithAuthString(HashPassWord("111"), "112"), convey.ShouldBeFalse)
+
+		authString, err := HashPassWordWithCachingSha2("111")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(checkPasswordWithAuthString(authString, "111"), convey.ShouldBeTrue)
+		convey.So(checkPasswordWithAuthString(authString, "112"), convey.ShouldBeFalse)
+	})
+}
+
+func Test_checkStatementWithExpiredPassword(t *testing.T) {
+	ctx := context.TODO()
+	convey.Convey("the session with the expired password can only change the password", t, func() {
+		ses := &Session{}
+		convey.So(checkStatementWithExpiredPassword(ctx, ses, &tree.Select{}), convey.ShouldBeNil)
+
+		ses.setPasswordExpired(true)
+		convey.So(checkStatementWithExpiredPassword(ctx, ses, &tree.Select{}), convey.ShouldNotBeNil)
+		convey.So(checkStatementWithExpiredPassword(ctx, ses, &tree.AlterUser{}), convey.ShouldBeNil)
+		convey.So(checkStatementWithExpiredPassword(ctx, ses, &tree.SetVar{}), convey.ShouldBeNil)
+
+		ses.setPasswordExpired(false)
+		convey.So(checkStatementWithExpiredPassword(ctx, ses, &tree.Select{}), convey.ShouldBeNil)
+	})
+}
@@ -0,0 +1,399 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"container/list"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/matrixorigin/matrixone/pkg/util/metric"
+)
+
+// a result can take 1/resultCacheEntryRatio of the cache at most, so that
+// a big result does not evict all the others
+const resultCacheEntryRatio = 8
+
+// globalResultCache is the query result cache shared by the sessions of the CN
+var globalResultCache = newResultCache()
+
+type cachedResult struct {
+	key string
+	// the max commit ts of the tables read by the query when it was executed
+	tables  map[uint64]types.TS
+	batches []*batch.Batch
+	size    int64
+}
+
+// resultCache uses LRU to cache the results of the queries, bounded by the
+// total size of the batches
+type resultCache struct {
+	sync.Mutex
+	size      int64
+	lruList   *list.List
+	cachePool map[string]*list.Element
+	mp        *mpool.MPool
+}
+
+func newResultCache() *resultCache {
+	return &resultCache{
+		lruList:   list.New(),
+		cachePool: make(map[string]*list.Element),
+		mp:        mpool.MustNewZeroNoFixed(),
+	}
+}
+
+// get returns the cached result of the key. The result is removed if any
+// table it read has been changed since.
+func (rc *resultCache) get(key string, tables map[uint64]types.TS) *cachedResult {
+	rc.Lock()
+	defer rc.Unlock()
+	element, ok := rc.cachePool[key]
+	if !ok {
+		return nil
+	}
+	cr := element.Value.(*cachedResult)
+	if !sameCommitTS(cr.tables, tables) {
+		rc.removeLocked(element)
+		return nil
+	}
+	rc.lruList.MoveToFront(element)
+	return cr
+}
+
+// put caches the result, and evicts the least recently used ones to keep the
+// size of the cache under capacity
+func (rc *resultCache) put(cr *cachedResult, capacity int64) {
+	rc.Lock()
+	defer rc.Unlock()
+	if element, ok := rc.cachePool[cr.key]; ok {
+		rc.removeLocked(element)
+	}
+	if cr.size > capacity/resultCacheEntryRatio {
+		rc.freeBatches(cr)
+		return
+	}
+	rc.cachePool[cr.key] = rc.lruList.PushFront(cr)
+	rc.size += cr.size
+	for rc.size > capacity {
+		rc.removeLocked(rc.lruList.Back())
+	}
+}
+
+func (rc *resultCache) removeLocked(element *list.Element) {
+	cr := rc.lruList.Remove(element).(*cachedResult)
+	delete(rc.cachePool, cr.key)
+	rc.size -= cr.size
+	rc.freeBatches(cr)
+}
+
+// freeBatches returns the memory to the pool. The memory of the pool without
+// fixed pools is not reused, so the sessions replaying the batches are safe.
+func (rc *resultCache) freeBatches(cr *cachedResult) {
+	for _, bat := range cr.batches {
+		bat.Clean(rc.mp)
+	}
+	cr.batches = nil
+}
+
+func (rc *resultCache) clean() {
+	rc.Lock()
+	defer rc.Unlock()
+	for rc.lruList.Len() > 0 {
+		rc.removeLocked(rc.lruList.Back())
+	}
+}
+
+func sameCommitTS(a, b map[uint64]types.TS) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for id, ts := range a {
+		if ts2, ok := b[id]; !ok || !ts.Equal(ts2) {
+			return false
+		}
+	}
+	return true
+}
+
+// resultCollector copies the batches sent to the client for the result cache
+type resultCollector struct {
+	key      string
+	tables   map[uint64]types.TS
+	capacity int64
+	batches  []*batch.Batch
+	size     int64
+	// the result is too big to be cached
+	overflow bool
+}
+
+func (rc *resultCollector) collect(bat *batch.Batch) error {
+	if rc.overflow {
+		return nil
+	}
+	rc.size += int64(bat.Size())
+	if rc.size > rc.capacity/resultCacheEntryRatio {
+		rc.overflow = true
+		rc.free()
+		return nil
+	}
+	bat2 := batch.NewWithSize(len(bat.Vecs))
+	for i, vec := range bat.Vecs {
+		tmp, err := vec.Dup(globalResultCache.mp)
+		if err != nil {
+			bat2.Clean(globalResultCache.mp)
+			return err
+		}
+		bat2.Vecs[i] = tmp
+	}
+	bat2.InitZsOne(bat.Length())
+	rc.batches = append(rc.batches, bat2)
+	return nil
+}
+
+func (rc *resultCollector) free() {
+	for _, bat := range rc.batches {
+		bat.Clean(globalResultCache.mp)
+	}
+	rc.batches = nil
+}
+
+// finish caches the result after the query succeeded
+func (rc *resultCollector) finish() {
+	if rc.overflow {
+		return
+	}
+	globalResultCache.put(&cachedResult{
+		key:     rc.key,
+		tables:  rc.tables,
+		batches: rc.batches,
+		size:    rc.size,
+	}, rc.capacity)
+	rc.batches = nil
+}
+
+// cachedResultRunner sends the cached result instead of running the query
+type cachedResultRunner struct {
+	ses     *Session
+	fill    func(interface{}, *batch.Batch) error
+	batches []*batch.Batch
+}
+
+func (r *cachedResultRunner) Run(_ uint64) error {
+	for _, bat := range r.batches {
+		if err := r.fill(r.ses, bat); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resultCacheEnabled checks whether the result of the statement can be cached
+func resultCacheEnabled(ses *Session, stmt tree.Statement) bool {
+	st, ok := stmt.(*tree.Select)
+	if !ok || st.Ep != nil {
+		return false
+	}
+	if ses.IsBackgroundSession() || ses.GetTenantInfo() == nil || ses.InMultiStmtTransactionMode() {
+		return false
+	}
+	val, err := ses.GetSessionVar("query_result_cache")
+	if err != nil {
+		return false
+	}
+	v, _ := val.(int8)
+	return v > 0
+}
+
+// resultCacheCapacity returns the size of the result cache in bytes
+func resultCacheCapacity(ses *Session) int64 {
+	val, err := ses.GetGlobalVar("query_result_cache_size")
+	if err != nil {
+		return 0
+	}
+	switch v := val.(type) {
+	case uint64:
+		return int64(v) << 20
+	case float64:
+		return int64(v) << 20
+	}
+	return 0
+}
+
+// getResultCacheKey returns the key of the result in the cache. The results
+// of the same statement are shared by the users of the account in the same
+// roles, for the privileges depend on the roles. The version of the catalog
+// is in the key, so the results cached before the policies or the privileges
+// of the tables changed are not used any more.
+func getResultCacheKey(ses *Session, stmt tree.Statement, args []*plan.Expr, p *plan.Plan) string {
+	var sb strings.Builder
+	tenant := ses.GetTenantInfo()
+	version, _ := ses.GetStorage().CatalogVersion()
+	fmt.Fprintf(&sb, "%d/%d/%t/%d/%s/", tenant.GetTenantID(), tenant.GetDefaultRoleID(),
+		tenant.GetUseSecondaryRole(), version, ses.GetDatabaseName())
+	sb.WriteString(fingerprintOf(stmt))
+	for _, arg := range args {
+		sb.WriteString("/")
+		sb.WriteString(arg.String())
+	}
+	for _, col := range plan2.GetResultColumnsFromPlan(p) {
+		fmt.Fprintf(&sb, "/%s:%d", col.Name, col.Typ.GetId())
+	}
+	return sb.String()
+}
+
+// fingerprintOf returns the text of the statement used as the key of the
+// caches, the statements differing in their literals have different texts.
+func fingerprintOf(stmt tree.Statement) string {
+	ctx := tree.NewFmtCtx(dialect.MYSQL, tree.WithFingerprint())
+	stmt.Format(ctx)
+	return ctx.String()
+}
+
+// isResultCacheablePlan checks the query reads the ordinary tables only, and
+// its result is decided by the data of the tables. The plans depending on the
+// session, like the ones filtered by the row level security policies, are not
+// cacheable.
+func isResultCacheablePlan(p *plan.Plan) bool {
+	qry := p.GetQuery()
+	if qry == nil || qry.StmtType != plan.Query_SELECT {
+		return false
+	}
+	hasTable := false
+	for _, node := range qry.Nodes {
+		if node.NotCacheable {
+			return false
+		}
+		switch node.NodeType {
+		case plan.Node_TABLE_SCAN:
+			tableDef := node.TableDef
+			if tableDef == nil || node.ObjRef == nil || node.ObjRef.PubAccountId != -1 ||
+				isBannedDatabase(node.ObjRef.SchemaName) || tableDef.Partition != nil ||
+				(tableDef.TableType != catalog.SystemOrdinaryRel && tableDef.TableType != catalog.SystemClusterRel) {
+				return false
+			}
+			hasTable = true
+		case plan.Node_VALUE_SCAN, plan.Node_MATERIAL_SCAN, plan.Node_PROJECT,
+			plan.Node_MATERIAL, plan.Node_RECURSIVE_CTE, plan.Node_SINK, plan.Node_SINK_SCAN,
+			plan.Node_AGG, plan.Node_DISTINCT, plan.Node_FILTER, plan.Node_JOIN, plan.Node_SORT,
+			plan.Node_UNION, plan.Node_UNION_ALL, plan.Node_UNIQUE, plan.Node_WINDOW,
+			plan.Node_INTERSECT, plan.Node_INTERSECT_ALL, plan.Node_MINUS, plan.Node_MINUS_ALL:
+		default:
+			return false
+		}
+		if !isDeterministicExprs(node.ProjectList, node.FilterList, node.OnList,
+			node.GroupBy, node.GroupingSet, node.AggList, node.TblFuncExprList) ||
+			!isDeterministicExprs([]*plan.Expr{node.Limit, node.Offset}) {
+			return false
+		}
+		for _, orderBy := range node.OrderBy {
+			if !isDeterministicExpr(orderBy.Expr) {
+				return false
+			}
+		}
+		if node.RowsetData != nil {
+			for _, col := range node.RowsetData.Cols {
+				if !isDeterministicExprs(col.Data) {
+					return false
+				}
+			}
+		}
+	}
+	return hasTable
+}
+
+func isDeterministicExprs(exprLists ...[]*plan.Expr) bool {
+	for _, exprs := range exprLists {
+		for _, expr := range exprs {
+			if !isDeterministicExpr(expr) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// isDeterministicExpr checks the expr does not depend on the time, the
+// session or the variables
+func isDeterministicExpr(expr *plan.Expr) bool {
+	if expr == nil {
+		return true
+	}
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_V:
+		return false
+	case *plan.Expr_C:
+		// the real time related functions folded into constants
+		return e.C.Src == nil
+	case *plan.Expr_F:
+		fn, ok := function.GetFunctionByIDWithoutError(e.F.Func.GetObj())
+		if !ok || fn.Volatile || fn.RealTimeRelated {
+			return false
+		}
+		return isDeterministicExprs(e.F.Args)
+	case *plan.Expr_List:
+		return isDeterministicExprs(e.List.List)
+	}
+	return true
+}
+
+// getTablesCommitTS returns the max commit ts of the tables read by the query.
+// It returns false if the result of the query can not be cached.
+func getTablesCommitTS(ses *Session, p *plan.Plan) (map[uint64]types.TS, bool) {
+	txnOp := ses.GetTxnHandler().GetTxnOperator()
+	if txnOp == nil {
+		return nil, false
+	}
+	snapshotTS := types.TimestampToTS(txnOp.Txn().SnapshotTS)
+	tables := make(map[uint64]types.TS)
+	for _, node := range p.GetQuery().Nodes {
+		if node.NodeType != plan.Node_TABLE_SCAN {
+			continue
+		}
+		tableId := node.TableDef.TblId
+		if _, ok := tables[tableId]; ok {
+			continue
+		}
+		ctx, rel, err := ses.GetTxnCompileCtx().getRelation(node.ObjRef.SchemaName, node.TableDef.Name, nil)
+		if err != nil || rel.GetTableID(ctx) != tableId {
+			return nil, false
+		}
+		ts, err := rel.MaxCommitTS(ctx)
+		// the data committed after the snapshot is invisible to the query
+		if err != nil || ts.Greater(snapshotTS) {
+			return nil, false
+		}
+		tables[tableId] = ts
+	}
+	return tables, true
+}
+
+func incResultCacheCounter(ses *Session, hit bool) {
+	if hit {
+		metric.QueryResultCacheCounter(ses.GetTenantInfo().GetTenant(), metric.QueryResultCacheHit).Inc()
+	} else {
+		metric.QueryResultCacheCounter(ses.GetTenantInfo().GetTenant(), metric.QueryResultCacheMiss).Inc()
+	}
+}
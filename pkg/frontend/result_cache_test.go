@@ -0,0 +1,204 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/stretchr/testify/require"
+)
+
+func newBatchForResultCache(t *testing.T, rc *resultCache, n int) *batch.Batch {
+	bat := batch.NewWithSize(1)
+	bat.Vecs[0] = vector.NewVec(types.T_int64.ToType())
+	for i := 0; i < n; i++ {
+		require.NoError(t, vector.AppendFixed(bat.Vecs[0], int64(i), false, rc.mp))
+	}
+	bat.InitZsOne(n)
+	return bat
+}
+
+func TestResultCache(t *testing.T) {
+	rc := newResultCache()
+	tables := map[uint64]types.TS{1: types.BuildTS(10, 0)}
+
+	bat := newBatchForResultCache(t, rc, 100)
+	size := int64(bat.Size())
+	capacity := resultCacheEntryRatio * size
+	rc.put(&cachedResult{key: "0", tables: tables, batches: []*batch.Batch{bat}, size: size}, capacity)
+	cr := rc.get("0", tables)
+	require.NotNil(t, cr)
+	require.Equal(t, 100, cr.batches[0].Length())
+
+	// the table has been changed
+	require.Nil(t, rc.get("0", map[uint64]types.TS{1: types.BuildTS(11, 0)}))
+	require.Nil(t, rc.get("0", tables))
+	require.Equal(t, int64(0), rc.size)
+
+	// the least recently used one is evicted
+	for i := 0; i <= resultCacheEntryRatio; i++ {
+		bat := newBatchForResultCache(t, rc, 100)
+		rc.put(&cachedResult{key: strconv.Itoa(i), tables: tables, batches: []*batch.Batch{bat}, size: size}, capacity)
+		if i == 1 {
+			require.NotNil(t, rc.get("0", tables))
+		}
+	}
+	require.Equal(t, capacity, rc.size)
+	require.NotNil(t, rc.get("0", tables))
+	require.Nil(t, rc.get("1", tables))
+	require.NotNil(t, rc.get("2", tables))
+
+	// too big to be cached
+	bat = newBatchForResultCache(t, rc, 100)
+	rc.put(&cachedResult{key: "a", tables: tables, batches: []*batch.Batch{bat}, size: size}, size)
+	require.Nil(t, rc.get("a", tables))
+
+	rc.clean()
+	require.Equal(t, 0, rc.lruList.Len())
+	require.Equal(t, int64(0), rc.size)
+}
+
+func TestResultCollector(t *testing.T) {
+	bat := newBatchForResultCache(t, globalResultCache, 10)
+	defer bat.Clean(globalResultCache.mp)
+
+	collector := &resultCollector{capacity: 3 * resultCacheEntryRatio * int64(bat.Size())}
+	for i := 0; i < 3; i++ {
+		require.NoError(t, collector.collect(bat))
+	}
+	require.False(t, collector.overflow)
+	require.Equal(t, 3, len(collector.batches))
+	require.Equal(t, int64(9), vector.GetFixedAt[int64](collector.batches[2].Vecs[0], 9))
+
+	require.NoError(t, collector.collect(bat))
+	require.True(t, collector.overflow)
+	require.Nil(t, collector.batches)
+}
+
+func TestIsResultCacheablePlan(t *testing.T) {
+	ctx := context.TODO()
+	col := &plan.Expr{
+		Typ:  &plan.Type{Id: int32(types.T_int64)},
+		Expr: &plan.Expr_Col{Col: &plan.ColRef{RelPos: 0, ColPos: 0}},
+	}
+	nowId, _, _, err := function.GetFunctionByName(ctx, "now", nil)
+	require.NoError(t, err)
+	absId, _, _, err := function.GetFunctionByName(ctx, "abs", []types.Type{types.T_int64.ToType()})
+	require.NoError(t, err)
+	callOf := func(id int64, args ...*plan.Expr) *plan.Expr {
+		return &plan.Expr{
+			Typ:  &plan.Type{Id: int32(types.T_int64)},
+			Expr: &plan.Expr_F{F: &plan.Function{Func: &plan.ObjectRef{Obj: id}, Args: args}},
+		}
+	}
+	planOf := func(schema string, tableType string, projects ...*plan.Expr) *plan.Plan {
+		scan := &plan.Node{
+			NodeType: plan.Node_TABLE_SCAN,
+			ObjRef:   &plan.ObjectRef{SchemaName: schema, ObjName: "t", PubAccountId: -1},
+			TableDef: &plan.TableDef{TblId: 1, Name: "t", TableType: tableType},
+		}
+		project := &plan.Node{
+			NodeType:    plan.Node_PROJECT,
+			Children:    []int32{0},
+			ProjectList: projects,
+		}
+		return &plan.Plan{Plan: &plan.Plan_Query{Query: &plan.Query{
+			StmtType: plan.Query_SELECT,
+			Nodes:    []*plan.Node{scan, project},
+			Steps:    []int32{1},
+		}}}
+	}
+
+	require.True(t, isResultCacheablePlan(planOf("db", catalog.SystemOrdinaryRel, col)))
+	require.True(t, isResultCacheablePlan(planOf("db", catalog.SystemOrdinaryRel, callOf(absId, col))))
+	require.False(t, isResultCacheablePlan(planOf("db", catalog.SystemOrdinaryRel, callOf(nowId))))
+	require.False(t, isResultCacheablePlan(planOf("db", catalog.SystemExternalRel, col)))
+	require.False(t, isResultCacheablePlan(planOf("mo_catalog", catalog.SystemOrdinaryRel, col)))
+
+	//the scan filtered by the row level security policies
+	filtered := planOf("db", catalog.SystemOrdinaryRel, col)
+	filtered.GetQuery().Nodes[0].NotCacheable = true
+	require.False(t, isResultCacheablePlan(filtered))
+
+	variable := &plan.Expr{
+		Typ:  &plan.Type{Id: int32(types.T_int64)},
+		Expr: &plan.Expr_V{V: &plan.VarRef{Name: "a"}},
+	}
+	require.False(t, isResultCacheablePlan(planOf("db", catalog.SystemOrdinaryRel, variable)))
+
+	folded := &plan.Expr{
+		Typ:  &plan.Type{Id: int32(types.T_int64)},
+		Expr: &plan.Expr_C{C: &plan.Const{Src: callOf(nowId)}},
+	}
+	require.False(t, isResultCacheablePlan(planOf("db", catalog.SystemOrdinaryRel, folded)))
+
+	values := &plan.Plan{Plan: &plan.Plan_Query{Query: &plan.Query{
+		StmtType: plan.Query_SELECT,
+		Nodes:    []*plan.Node{{NodeType: plan.Node_VALUE_SCAN}},
+	}}}
+	require.False(t, isResultCacheablePlan(values))
+}
+
+func TestFingerprintOf(t *testing.T) {
+	fingerprint := func(sql string) string {
+		stmts, err := mysql.Parse(context.TODO(), sql, 1)
+		require.NoError(t, err)
+		return fingerprintOf(stmts[0])
+	}
+	require.Equal(t, fingerprint("select a from t where b = 'x'"), fingerprint("SELECT a FROM t WHERE b = 'x'"))
+	require.NotEqual(t, fingerprint("select a from t where b = 'x'"), fingerprint("select a from t where b = x"))
+	require.NotEqual(t, fingerprint("select a from t where b = x'ab'"), fingerprint("select a from t where b = ab"))
+	require.NotEqual(t, fingerprint("select a from t where b = 'it''s'"), fingerprint("select a from t where b = 'it'"))
+}
+
+func TestGetResultCacheKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ses := newSes(nil, ctrl)
+	eng := mock_frontend.NewMockEngine(ctrl)
+	version := uint64(1)
+	eng.EXPECT().CatalogVersion().DoAndReturn(func() (uint64, timestamp.Timestamp) {
+		return version, timestamp.Timestamp{}
+	}).AnyTimes()
+	ses.storage = eng
+
+	stmts, err := mysql.Parse(context.TODO(), "select a from t", 1)
+	require.NoError(t, err)
+	p := &plan.Plan{Plan: &plan.Plan_Query{Query: &plan.Query{}}}
+	key := getResultCacheKey(ses, stmts[0], nil, p)
+	require.Equal(t, key, getResultCacheKey(ses, stmts[0], nil, p))
+
+	//the secondary roles are in use
+	ses.GetTenantInfo().SetUseSecondaryRole(true)
+	key2 := getResultCacheKey(ses, stmts[0], nil, p)
+	require.NotEqual(t, key, key2)
+
+	//the policies or the privileges changed
+	version++
+	require.NotEqual(t, key2, getResultCacheKey(ses, stmts[0], nil, p))
+}
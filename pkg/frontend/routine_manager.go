@@ -263,6 +263,7 @@ func (rm *RoutineManager) Closed(rs goetty.IOSession) {
 				}
 				metric.ConnectionCounter(accountName).Dec()
 				rm.accountRoutine.deleteRoutine(int64(account.GetTenantID()), rt)
+				auditLogout(ses.GetRequestContext(), ses)
 			})
 			logDebugf(ses.GetDebugString(), "the io session was closed.")
 		}
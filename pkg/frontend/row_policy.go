@@ -0,0 +1,678 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"go/constant"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/tidwall/btree"
+)
+
+const (
+	checkRowPolicyFormat = `select policy_id from mo_catalog.mo_row_policies where policy_name = "%s" and table_id = %d;`
+
+	insertRowPolicyFormat = `insert into mo_catalog.mo_row_policies(
+				policy_name,
+				table_id,
+				database_name,
+				table_name,
+				command,
+				role_name,
+				predicate,
+				creator,
+				owner,
+				created_time) values ("%s",%d,"%s","%s","%s","%s",'%s',%d,%d,"%s");`
+
+	deleteRowPolicyFormat = `delete from mo_catalog.mo_row_policies where policy_name = "%s" and table_id = %d;`
+
+	getAllRowPoliciesFormat = `select table_id,role_name,command,predicate from mo_catalog.mo_row_policies order by policy_id;`
+
+	// the predicate is checked by running it against the table
+	checkRowPolicyPredicateFormat = "select 1 from `%s`.`%s` where %s limit 0;"
+)
+
+func getSqlForCheckRowPolicy(ctx context.Context, policyName string, tableId int64) (string, error) {
+	err := inputNameIsInvalid(ctx, policyName)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(checkRowPolicyFormat, policyName, tableId), nil
+}
+
+func getSqlForInsertRowPolicy(policyName string, tableId int64, dbName, tableName, command, roleName, predicate string, creator, owner int64, createdTime string) string {
+	return fmt.Sprintf(insertRowPolicyFormat, policyName, tableId, dbName, tableName, command, roleName, escapeSingleQuoteString(predicate), creator, owner, createdTime)
+}
+
+func getSqlForDeleteRowPolicy(policyName string, tableId int64) string {
+	return fmt.Sprintf(deleteRowPolicyFormat, policyName, tableId)
+}
+
+// escapeSingleQuoteString makes the string can be put into the single-quoted string literal
+func escapeSingleQuoteString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return strings.ReplaceAll(s, `'`, `\'`)
+}
+
+// getTableIdForPolicy returns the database name and the id of the table that the policy is on
+func getTableIdForPolicy(ctx context.Context, ses *Session, bh BackgroundExec, table *tree.TableName) (string, int64, error) {
+	dbName := string(table.SchemaName)
+	if len(dbName) == 0 {
+		if ses.DatabaseNameIsEmpty() {
+			return "", 0, moerr.NewNoDB(ctx)
+		}
+		dbName = ses.GetDatabaseName()
+	}
+	tableName := string(table.ObjectName)
+	if isBannedDatabase(dbName) {
+		return "", 0, moerr.NewInternalError(ctx, "can not create or drop the policy on the table %s.%s", dbName, tableName)
+	}
+
+	sql, err := getSqlForCheckDatabaseTable(ctx, dbName, tableName)
+	if err != nil {
+		return "", 0, err
+	}
+	bh.ClearExecResultSet()
+	err = bh.Exec(ctx, sql)
+	if err != nil {
+		return "", 0, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return "", 0, err
+	}
+	if !execResultArrayHasData(erArray) {
+		return "", 0, moerr.NewNoSuchTable(ctx, dbName, tableName)
+	}
+	tableId, err := erArray[0].GetInt64(ctx, 0, 0)
+	if err != nil {
+		return "", 0, err
+	}
+	return dbName, tableId, nil
+}
+
+// rowPolicyExists checks the policy has been on the table or not
+func rowPolicyExists(ctx context.Context, bh BackgroundExec, policyName string, tableId int64) (bool, error) {
+	sql, err := getSqlForCheckRowPolicy(ctx, policyName, tableId)
+	if err != nil {
+		return false, err
+	}
+	bh.ClearExecResultSet()
+	err = bh.Exec(ctx, sql)
+	if err != nil {
+		return false, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return false, err
+	}
+	return execResultArrayHasData(erArray), nil
+}
+
+// doCreatePolicy accomplishes the CreatePolicy statement
+func doCreatePolicy(ctx context.Context, ses *Session, cp *tree.CreatePolicy) error {
+	var (
+		err       error
+		sql       string
+		erArray   []ExecResult
+		dbName    string
+		tableId   int64
+		exists    bool
+		predicate string
+	)
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	tenantInfo := ses.GetTenantInfo()
+	if !tenantInfo.IsAdminRole() {
+		return moerr.NewInternalError(ctx, "only admin can create policy")
+	}
+
+	fmtctx := tree.NewFmtCtx(dialect.MYSQL, tree.WithQuoteString(true))
+	cp.Using.Format(fmtctx)
+	predicate = fmtctx.String()
+
+	err = bh.Exec(ctx, "begin;")
+	if err != nil {
+		goto handleFailed
+	}
+
+	dbName, tableId, err = getTableIdForPolicy(ctx, ses, bh, cp.Table)
+	if err != nil {
+		goto handleFailed
+	}
+
+	exists, err = rowPolicyExists(ctx, bh, string(cp.Name), tableId)
+	if err != nil {
+		goto handleFailed
+	}
+	if exists {
+		if !cp.IfNotExists {
+			err = moerr.NewInternalError(ctx, "the policy %s on the table %s.%s has already existed", cp.Name, dbName, cp.Table.ObjectName)
+			goto handleFailed
+		}
+		err = bh.Exec(ctx, "commit;")
+		if err != nil {
+			goto handleFailed
+		}
+		return err
+	}
+
+	for _, role := range cp.Roles {
+		if isPublicRole(role.UserName) {
+			continue
+		}
+		sql, err = getSqlForRoleIdOfRole(ctx, role.UserName)
+		if err != nil {
+			goto handleFailed
+		}
+		bh.ClearExecResultSet()
+		err = bh.Exec(ctx, sql)
+		if err != nil {
+			goto handleFailed
+		}
+		erArray, err = getResultSet(ctx, bh)
+		if err != nil {
+			goto handleFailed
+		}
+		if !execResultArrayHasData(erArray) {
+			err = moerr.NewInternalError(ctx, "there is no role %s", role.UserName)
+			goto handleFailed
+		}
+	}
+
+	//the predicate must be valid on the table
+	bh.ClearExecResultSet()
+	err = bh.Exec(ctx, fmt.Sprintf(checkRowPolicyPredicateFormat, dbName, cp.Table.ObjectName, predicate))
+	if err != nil {
+		goto handleFailed
+	}
+
+	for _, role := range cp.Roles {
+		roleName := role.UserName
+		if isPublicRole(roleName) {
+			roleName = publicRoleName
+		}
+		sql = getSqlForInsertRowPolicy(string(cp.Name), tableId, dbName, string(cp.Table.ObjectName), cp.Command, roleName, predicate,
+			int64(tenantInfo.GetUserID()), int64(tenantInfo.GetDefaultRoleID()), types.CurrentTimestamp().String2(time.UTC, 0))
+		err = bh.Exec(ctx, sql)
+		if err != nil {
+			goto handleFailed
+		}
+	}
+
+	err = bumpCatalogVersionOfTable(ctx, ses, dbName, string(cp.Table.ObjectName))
+	if err != nil {
+		goto handleFailed
+	}
+
+	err = bh.Exec(ctx, "commit;")
+	if err != nil {
+		goto handleFailed
+	}
+	globalRowPolicyCache.invalidate(tenantInfo.GetTenantID())
+	return err
+handleFailed:
+	//ROLLBACK the transaction
+	rbErr := bh.Exec(ctx, "rollback;")
+	if rbErr != nil {
+		return rbErr
+	}
+	return err
+}
+
+// doDropPolicy accomplishes the DropPolicy statement
+func doDropPolicy(ctx context.Context, ses *Session, dp *tree.DropPolicy) error {
+	var (
+		err     error
+		dbName  string
+		tableId int64
+		exists  bool
+	)
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	if !ses.GetTenantInfo().IsAdminRole() {
+		return moerr.NewInternalError(ctx, "only admin can drop policy")
+	}
+
+	err = bh.Exec(ctx, "begin;")
+	if err != nil {
+		goto handleFailed
+	}
+
+	dbName, tableId, err = getTableIdForPolicy(ctx, ses, bh, dp.Table)
+	if err != nil {
+		goto handleFailed
+	}
+
+	exists, err = rowPolicyExists(ctx, bh, string(dp.Name), tableId)
+	if err != nil {
+		goto handleFailed
+	}
+	if !exists {
+		if !dp.IfExists {
+			err = moerr.NewInternalError(ctx, "there is no policy %s on the table %s.%s", dp.Name, dbName, dp.Table.ObjectName)
+			goto handleFailed
+		}
+	} else {
+		err = bh.Exec(ctx, getSqlForDeleteRowPolicy(string(dp.Name), tableId))
+		if err != nil {
+			goto handleFailed
+		}
+		err = bumpCatalogVersionOfTable(ctx, ses, dbName, string(dp.Table.ObjectName))
+		if err != nil {
+			goto handleFailed
+		}
+	}
+
+	err = bh.Exec(ctx, "commit;")
+	if err != nil {
+		goto handleFailed
+	}
+	globalRowPolicyCache.invalidate(ses.GetTenantInfo().GetTenantID())
+	return err
+handleFailed:
+	//ROLLBACK the transaction
+	rbErr := bh.Exec(ctx, "rollback;")
+	if rbErr != nil {
+		return rbErr
+	}
+	return err
+}
+
+// rowPolicy is one row of the mo_row_policies
+type rowPolicy struct {
+	roleName  string
+	command   string
+	predicate string
+	// expr is the parsed predicate. It is shared by the statements and
+	// must not be changed by the binder.
+	expr tree.Expr
+}
+
+// globalRowPolicyCache caches the row level security policies of the accounts
+// for the sessions of the CN, so that the scan of a table does not query the
+// mo_row_policies. The policies of an account are loaded at once and are only
+// valid for one version of the catalog. The policy DDL bumps the version on
+// every CN and drops the policies of the account on the CN running it.
+var globalRowPolicyCache = newAccountCache[map[uint64][]rowPolicy]()
+
+// accountCache caches the data of the accounts loaded from the tables of the
+// mo_catalog, which is only valid for one version of the catalog
+type accountCache[T any] struct {
+	sync.Mutex
+	version  uint64
+	accounts map[uint32]T
+}
+
+func newAccountCache[T any]() *accountCache[T] {
+	return &accountCache[T]{
+		accounts: make(map[uint32]T),
+	}
+}
+
+// get returns the data of the account loaded with the version of the catalog
+func (ac *accountCache[T]) get(accountId uint32, version uint64) (T, bool) {
+	ac.Lock()
+	defer ac.Unlock()
+	ac.checkVersion(version)
+	if version != ac.version {
+		var zero T
+		return zero, false
+	}
+	data, ok := ac.accounts[accountId]
+	return data, ok
+}
+
+// put caches the data of the account loaded with the version of the catalog
+func (ac *accountCache[T]) put(accountId uint32, version uint64, data T) {
+	ac.Lock()
+	defer ac.Unlock()
+	ac.checkVersion(version)
+	if version != ac.version {
+		return
+	}
+	ac.accounts[accountId] = data
+}
+
+// invalidate drops the data of the account
+func (ac *accountCache[T]) invalidate(accountId uint32) {
+	ac.Lock()
+	defer ac.Unlock()
+	delete(ac.accounts, accountId)
+}
+
+// checkVersion drops the data of all accounts if the catalog has changed
+func (ac *accountCache[T]) checkVersion(version uint64) {
+	if version > ac.version {
+		ac.version = version
+		ac.accounts = make(map[uint32]T)
+	}
+}
+
+// bumpCatalogVersionOfTable rewrites the constraint of the table unchanged in
+// the txn of the session. The change of the mo_tables reaches every CN through
+// the logtail and bumps the version of its catalog, which drops the policies
+// and the plans cached for the old version.
+var bumpCatalogVersionOfTable = func(ctx context.Context, ses *Session, dbName, tableName string) error {
+	txnHandler := ses.GetTxnHandler()
+	txn, err := txnHandler.GetTxn()
+	if err != nil {
+		return err
+	}
+	txnCtx := txnHandler.GetTxnCtx()
+	db, err := ses.GetStorage().Database(txnCtx, dbName, txn)
+	if err != nil {
+		return err
+	}
+	rel, err := db.Relation(txnCtx, tableName)
+	if err != nil {
+		return err
+	}
+	return rewriteConstraint(txnCtx, rel)
+}
+
+// bumpCatalogVersionOfTableById is bumpCatalogVersionOfTable for the table with the id
+var bumpCatalogVersionOfTableById = func(ctx context.Context, ses *Session, tableId uint64) error {
+	txnHandler := ses.GetTxnHandler()
+	txn, err := txnHandler.GetTxn()
+	if err != nil {
+		return err
+	}
+	txnCtx := txnHandler.GetTxnCtx()
+	_, _, rel, err := ses.GetStorage().GetRelationById(txnCtx, txn, tableId)
+	if err != nil {
+		return err
+	}
+	return rewriteConstraint(txnCtx, rel)
+}
+
+func rewriteConstraint(ctx context.Context, rel engine.Relation) error {
+	defs, err := rel.TableDefs(ctx)
+	if err != nil {
+		return err
+	}
+	ct := &engine.ConstraintDef{}
+	for _, def := range defs {
+		if c, ok := def.(*engine.ConstraintDef); ok {
+			ct = c
+			break
+		}
+	}
+	return rel.UpdateConstraint(ctx, ct)
+}
+
+// parseRowPolicyPredicate parses the predicate of the policy
+func parseRowPolicyPredicate(ctx context.Context, predicate string) (tree.Expr, error) {
+	stmts, err := mysql.Parse(ctx, "select "+predicate, 1)
+	if err != nil {
+		return nil, err
+	}
+	return stmts[0].(*tree.Select).Select.(*tree.SelectClause).Exprs[0].Expr, nil
+}
+
+// loadRowPolicies reads the policies of all tables of the account in the context.
+// The account created before the mo_row_policies was added does not have any policy.
+func loadRowPolicies(ctx context.Context, bh BackgroundExec) (map[uint64][]rowPolicy, error) {
+	var (
+		err     error
+		erArray []ExecResult
+		tableId uint64
+	)
+	tables := make(map[uint64][]rowPolicy)
+
+	bh.ClearExecResultSet()
+	err = bh.Exec(ctx, getAllRowPoliciesFormat)
+	if err != nil {
+		if moerr.IsMoErrCode(err, moerr.ErrNoSuchTable) {
+			return tables, nil
+		}
+		return nil, err
+	}
+	erArray, err = getResultSet(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+	if !execResultArrayHasData(erArray) {
+		return tables, nil
+	}
+	for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+		policy := rowPolicy{}
+		tableId, err = erArray[0].GetUint64(ctx, i, 0)
+		if err != nil {
+			return nil, err
+		}
+		policy.roleName, err = erArray[0].GetString(ctx, i, 1)
+		if err != nil {
+			return nil, err
+		}
+		policy.command, err = erArray[0].GetString(ctx, i, 2)
+		if err != nil {
+			return nil, err
+		}
+		policy.predicate, err = erArray[0].GetString(ctx, i, 3)
+		if err != nil {
+			return nil, err
+		}
+		policy.expr, err = parseRowPolicyPredicate(ctx, policy.predicate)
+		if err != nil {
+			return nil, err
+		}
+		tables[tableId] = append(tables[tableId], policy)
+	}
+	return tables, nil
+}
+
+// unrestrictedRowPolicy is the predicate for the roles not restricted by the policies
+func unrestrictedRowPolicy() tree.Expr {
+	return tree.NewNumValWithType(constant.MakeBool(true), "true", false, tree.P_bool)
+}
+
+// combineRowPolicies returns the predicate of the policies for the roles and the command.
+// It is nil if there is not any policy on the table. If the table has policies
+// but none of them applies, the roles can not see any row.
+func combineRowPolicies(policies []rowPolicy, roleNames map[string]int8, command string) tree.Expr {
+	if len(policies) == 0 {
+		return nil
+	}
+	var predicate tree.Expr
+	for _, policy := range policies {
+		if policy.command != tree.PolicyCommandAll && policy.command != command {
+			continue
+		}
+		if _, ok := roleNames[strings.ToLower(policy.roleName)]; !ok && policy.roleName != publicRoleName {
+			continue
+		}
+		if predicate == nil {
+			predicate = tree.NewParenExpr(policy.expr)
+		} else {
+			predicate = tree.NewOrExpr(predicate, tree.NewParenExpr(policy.expr))
+		}
+	}
+	if predicate == nil {
+		return tree.NewNumValWithType(constant.MakeBool(false), "false", false, tree.P_bool)
+	}
+	return predicate
+}
+
+// activeRoleSet is the names of the roles in use by the session, including
+// the secondary roles and the roles inherited from them.
+type activeRoleSet struct {
+	roleId       uint32
+	useSecondary bool
+	names        map[string]int8
+}
+
+// getActiveRoleNames returns the names of the roles the session is using.
+// They are cached in the session until the role or the privileges change.
+func getActiveRoleNames(ctx context.Context, ses *Session, bh BackgroundExec) (map[string]int8, error) {
+	var (
+		err      error
+		erArray  []ExecResult
+		roleId   int64
+		roleName string
+	)
+	tenantInfo := ses.GetTenantInfo()
+	if roles := ses.getActiveRoles(); roles != nil &&
+		roles.roleId == tenantInfo.GetDefaultRoleID() &&
+		roles.useSecondary == tenantInfo.GetUseSecondaryRole() {
+		return roles.names, nil
+	}
+
+	roleSet := &btree.Set[int64]{}
+	roleSet.Insert(int64(tenantInfo.GetDefaultRoleID()))
+	err = loadAllSecondaryRoles(ctx, bh, tenantInfo, roleSet)
+	if err != nil {
+		return nil, err
+	}
+	//the roles granted to the roles in use
+	toVisit := roleSet.Keys()
+	for len(toVisit) != 0 {
+		roleId, toVisit = toVisit[0], toVisit[1:]
+		bh.ClearExecResultSet()
+		err = bh.Exec(ctx, getSqlForInheritedRoleIdOfRoleId(roleId))
+		if err != nil {
+			return nil, err
+		}
+		erArray, err = getResultSet(ctx, bh)
+		if err != nil {
+			return nil, err
+		}
+		if !execResultArrayHasData(erArray) {
+			continue
+		}
+		for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+			var grantedId int64
+			grantedId, err = erArray[0].GetInt64(ctx, i, 0)
+			if err != nil {
+				return nil, err
+			}
+			if !roleSet.Contains(grantedId) {
+				roleSet.Insert(grantedId)
+				toVisit = append(toVisit, grantedId)
+			}
+		}
+	}
+
+	names := make(map[string]int8, roleSet.Len())
+	names[strings.ToLower(tenantInfo.GetDefaultRole())] = 1
+	for _, id := range roleSet.Keys() {
+		if id == int64(tenantInfo.GetDefaultRoleID()) {
+			continue
+		}
+		bh.ClearExecResultSet()
+		err = bh.Exec(ctx, getSqlForRoleNameOfRoleId(id))
+		if err != nil {
+			return nil, err
+		}
+		erArray, err = getResultSet(ctx, bh)
+		if err != nil {
+			return nil, err
+		}
+		if !execResultArrayHasData(erArray) {
+			continue
+		}
+		roleName, err = erArray[0].GetString(ctx, 0, 0)
+		if err != nil {
+			return nil, err
+		}
+		names[strings.ToLower(roleName)] = 1
+	}
+
+	ses.setActiveRoles(&activeRoleSet{
+		roleId:       tenantInfo.GetDefaultRoleID(),
+		useSecondary: tenantInfo.GetUseSecondaryRole(),
+		names:        names,
+	})
+	return names, nil
+}
+
+// hasAdminRole checks the admin role is in use by the session
+func hasAdminRole(tenantInfo *TenantInfo, roleNames map[string]int8) bool {
+	for name := range roleNames {
+		if tenantInfo.IsNameOfAdminRoles(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveRowPolicy returns the predicate of the row level security policies on the table.
+// The policies of the published table are defined in the account of the publisher, whose
+// roles are not those of the subscriber, so only the policies on the public role apply.
+func resolveRowPolicy(ctx context.Context, ses *Session, obj *plan.ObjectRef, tableDef *plan.TableDef, command string) (tree.Expr, error) {
+	var (
+		err       error
+		tables    map[uint64][]rowPolicy
+		roleNames map[string]int8
+		ok        bool
+	)
+	tenantInfo := ses.GetTenantInfo()
+	if tenantInfo == nil || ses.IsBackgroundSession() || isBannedDatabase(obj.GetSchemaName()) {
+		return nil, nil
+	}
+
+	accountId := tenantInfo.GetTenantID()
+	if obj.GetPubAccountId() != -1 {
+		accountId = uint32(obj.GetPubAccountId())
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	version, _ := ses.GetStorage().CatalogVersion()
+	if tables, ok = globalRowPolicyCache.get(accountId, version); !ok {
+		tables, err = loadRowPolicies(context.WithValue(ctx, defines.TenantIDKey{}, accountId), bh)
+		if err != nil {
+			return nil, err
+		}
+		globalRowPolicyCache.put(accountId, version, tables)
+	}
+	policies := tables[tableDef.GetTblId()]
+	if len(policies) == 0 {
+		return nil, nil
+	}
+
+	if obj.GetPubAccountId() != -1 {
+		return combineRowPolicies(policies, nil, command), nil
+	}
+
+	//the admin is not restricted by the policies of its own account. The
+	//predicate is still returned, so the plan is not shared with other roles.
+	if tenantInfo.IsAdminRole() {
+		return unrestrictedRowPolicy(), nil
+	}
+	roleNames, err = getActiveRoleNames(ctx, ses, bh)
+	if err != nil {
+		return nil, err
+	}
+	if hasAdminRole(tenantInfo, roleNames) {
+		return unrestrictedRowPolicy(), nil
+	}
+	return combineRowPolicies(policies, roleNames, command), nil
+}
@@ -0,0 +1,357 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/prashantv/gostub"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func newMrsForAllRowPolicies(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+
+	col0 := &MysqlColumn{}
+	col0.SetName("table_id")
+	col0.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+
+	col1 := &MysqlColumn{}
+	col1.SetName("role_name")
+	col1.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+
+	col2 := &MysqlColumn{}
+	col2.SetName("command")
+	col2.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+
+	col3 := &MysqlColumn{}
+	col3.SetName("predicate")
+	col3.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+
+	mrs.AddColumn(col0)
+	mrs.AddColumn(col1)
+	mrs.AddColumn(col2)
+	mrs.AddColumn(col3)
+
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+
+	return mrs
+}
+
+func newMrsForRoleNameOfRoleId(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+
+	col1 := &MysqlColumn{}
+	col1.SetName("role_name")
+	col1.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+
+	mrs.AddColumn(col1)
+
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+
+	return mrs
+}
+
+func newRowPolicy(roleName, command, predicate string) rowPolicy {
+	expr, err := parseRowPolicyPredicate(context.TODO(), predicate)
+	convey.So(err, convey.ShouldBeNil)
+	return rowPolicy{roleName: roleName, command: command, predicate: predicate, expr: expr}
+}
+
+func formatRowPolicyPredicate(expr tree.Expr) string {
+	if expr == nil {
+		return ""
+	}
+	return tree.String(expr, dialect.MYSQL)
+}
+
+func Test_combineRowPolicies(t *testing.T) {
+	convey.Convey("combine the row policies", t, func() {
+		r1 := map[string]int8{"r1": 1}
+		r2 := map[string]int8{"r2": 1}
+		convey.So(combineRowPolicies(nil, r1, tree.PolicyCommandSelect), convey.ShouldBeNil)
+
+		policies := []rowPolicy{
+			newRowPolicy("r1", tree.PolicyCommandSelect, "a > 1"),
+			newRowPolicy("r2", tree.PolicyCommandAll, "a > 2"),
+			newRowPolicy(publicRoleName, tree.PolicyCommandDelete, "a > 3"),
+		}
+		convey.So(formatRowPolicyPredicate(combineRowPolicies(policies, r1, tree.PolicyCommandSelect)), convey.ShouldEqual, "(a > 1)")
+		convey.So(formatRowPolicyPredicate(combineRowPolicies(policies, r1, tree.PolicyCommandDelete)), convey.ShouldEqual, "(a > 3)")
+		convey.So(formatRowPolicyPredicate(combineRowPolicies(policies, r2, tree.PolicyCommandDelete)), convey.ShouldEqual, "(a > 2) or (a > 3)")
+		convey.So(formatRowPolicyPredicate(combineRowPolicies(policies, r1, tree.PolicyCommandUpdate)), convey.ShouldEqual, "false")
+
+		//the policies of all the roles in use apply
+		convey.So(formatRowPolicyPredicate(combineRowPolicies(policies, map[string]int8{"r1": 1, "r2": 1}, tree.PolicyCommandSelect)), convey.ShouldEqual, "(a > 1) or (a > 2)")
+	})
+}
+
+func Test_doCreatePolicy(t *testing.T) {
+	convey.Convey("create policy", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+		defer bhStub.Reset()
+
+		bumped := 0
+		bumpStub := gostub.Stub(&bumpCatalogVersionOfTable, func(context.Context, *Session, string, string) error {
+			bumped++
+			return nil
+		})
+		defer bumpStub.Reset()
+
+		stmts, err := parsers.Parse(context.TODO(), dialect.MYSQL, "create policy p1 on d.t for select to r1, public using (a > 10 and b = 'it''s')", 1)
+		convey.So(err, convey.ShouldBeNil)
+		stmt := stmts[0].(*tree.CreatePolicy)
+
+		priv := determinePrivilegeSetOfStatement(stmt)
+		ses := newSes(priv, ctrl)
+		ctx := ses.GetRequestContext()
+
+		bh.sql2result["begin;"] = nil
+		bh.sql2result["commit;"] = nil
+		bh.sql2result["rollback;"] = nil
+
+		sql, _ := getSqlForCheckDatabaseTable(ctx, "d", "t")
+		bh.sql2result[sql] = newMrsForCheckDatabaseTable([][]interface{}{{10}})
+		sql, _ = getSqlForCheckRowPolicy(ctx, "p1", 10)
+		bh.sql2result[sql] = newMrsForRoleIdOfRole([][]interface{}{})
+		sql, _ = getSqlForRoleIdOfRole(ctx, "r1")
+		bh.sql2result[sql] = newMrsForRoleIdOfRole([][]interface{}{{2}})
+
+		err = doCreatePolicy(ctx, ses, stmt)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(bh.currentSql, convey.ShouldEqual, "commit;")
+		convey.So(bumped, convey.ShouldEqual, 1)
+
+		//the role does not exist
+		sql, _ = getSqlForRoleIdOfRole(ctx, "r1")
+		bh.sql2result[sql] = newMrsForRoleIdOfRole([][]interface{}{})
+		err = doCreatePolicy(ctx, ses, stmt)
+		convey.So(err, convey.ShouldNotBeNil)
+
+		//the policy has existed
+		sql, _ = getSqlForCheckRowPolicy(ctx, "p1", 10)
+		bh.sql2result[sql] = newMrsForRoleIdOfRole([][]interface{}{{1}})
+		err = doCreatePolicy(ctx, ses, stmt)
+		convey.So(err, convey.ShouldNotBeNil)
+		stmt.IfNotExists = true
+		err = doCreatePolicy(ctx, ses, stmt)
+		convey.So(err, convey.ShouldBeNil)
+
+		//the table does not exist
+		sql, _ = getSqlForCheckDatabaseTable(ctx, "d", "t")
+		bh.sql2result[sql] = newMrsForCheckDatabaseTable([][]interface{}{})
+		err = doCreatePolicy(ctx, ses, stmt)
+		convey.So(err, convey.ShouldNotBeNil)
+
+		//only the admin can create the policy
+		ses.GetTenantInfo().DefaultRole = "r1"
+		err = doCreatePolicy(ctx, ses, stmt)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+
+	convey.Convey("insert the predicate of the policy", t, func() {
+		sql := getSqlForInsertRowPolicy("p1", 10, "d", "t", "select", "r1", `b = "it's"`, 1, 2, "2023-01-01 00:00:00")
+		stmts, err := parsers.Parse(context.TODO(), dialect.MYSQL, sql, 1)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(stmts), convey.ShouldEqual, 1)
+	})
+}
+
+func Test_doDropPolicy(t *testing.T) {
+	convey.Convey("drop policy", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+		defer bhStub.Reset()
+
+		bumped := 0
+		bumpStub := gostub.Stub(&bumpCatalogVersionOfTable, func(context.Context, *Session, string, string) error {
+			bumped++
+			return nil
+		})
+		defer bumpStub.Reset()
+
+		stmt := &tree.DropPolicy{
+			Name:  tree.Identifier("p1"),
+			Table: tree.NewTableName(tree.Identifier("t"), tree.ObjectNamePrefix{SchemaName: tree.Identifier("d"), ExplicitSchema: true}),
+		}
+		priv := determinePrivilegeSetOfStatement(stmt)
+		ses := newSes(priv, ctrl)
+		ctx := ses.GetRequestContext()
+
+		bh.sql2result["begin;"] = nil
+		bh.sql2result["commit;"] = nil
+		bh.sql2result["rollback;"] = nil
+
+		sql, _ := getSqlForCheckDatabaseTable(ctx, "d", "t")
+		bh.sql2result[sql] = newMrsForCheckDatabaseTable([][]interface{}{{10}})
+		sql, _ = getSqlForCheckRowPolicy(ctx, "p1", 10)
+		bh.sql2result[sql] = newMrsForRoleIdOfRole([][]interface{}{{1}})
+		bh.sql2result[getSqlForDeleteRowPolicy("p1", 10)] = nil
+
+		err := doDropPolicy(ctx, ses, stmt)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(bumped, convey.ShouldEqual, 1)
+
+		sql, _ = getSqlForCheckRowPolicy(ctx, "p1", 10)
+		bh.sql2result[sql] = newMrsForRoleIdOfRole([][]interface{}{})
+		err = doDropPolicy(ctx, ses, stmt)
+		convey.So(err, convey.ShouldNotBeNil)
+		stmt.IfExists = true
+		err = doDropPolicy(ctx, ses, stmt)
+		convey.So(err, convey.ShouldBeNil)
+	})
+}
+
+func Test_rowPolicyCache(t *testing.T) {
+	convey.Convey("cache the row policies of the accounts", t, func() {
+		pc := newAccountCache[map[uint64][]rowPolicy]()
+		tables := map[uint64][]rowPolicy{10: {newRowPolicy("r1", tree.PolicyCommandAll, "a > 1")}}
+
+		_, ok := pc.get(1, 1)
+		convey.So(ok, convey.ShouldBeFalse)
+		pc.put(1, 1, tables)
+		cached, ok := pc.get(1, 1)
+		convey.So(ok, convey.ShouldBeTrue)
+		convey.So(len(cached[10]), convey.ShouldEqual, 1)
+
+		//the policies loaded with the old catalog are not cached
+		pc.put(2, 0, tables)
+		_, ok = pc.get(2, 1)
+		convey.So(ok, convey.ShouldBeFalse)
+
+		pc.invalidate(1)
+		_, ok = pc.get(1, 1)
+		convey.So(ok, convey.ShouldBeFalse)
+
+		//the catalog changes
+		pc.put(1, 1, tables)
+		_, ok = pc.get(1, 2)
+		convey.So(ok, convey.ShouldBeFalse)
+	})
+}
+
+func Test_resolveRowPolicy(t *testing.T) {
+	convey.Convey("resolve the row policies of the table", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &recordingBackgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+		defer bhStub.Reset()
+
+		globalRowPolicyCache = newAccountCache[map[uint64][]rowPolicy]()
+		defer func() {
+			globalRowPolicyCache = newAccountCache[map[uint64][]rowPolicy]()
+		}()
+
+		ses := newSes(nil, ctrl)
+		ctx := ses.GetRequestContext()
+		eng := mock_frontend.NewMockEngine(ctrl)
+		eng.EXPECT().CatalogVersion().Return(uint64(1), timestamp.Timestamp{}).AnyTimes()
+		ses.storage = eng
+
+		bh.sql2result[getAllRowPoliciesFormat] = newMrsForAllRowPolicies([][]interface{}{
+			{10, "r1", "select", "a > 1"},
+			{10, "public", "all", "b < 2"},
+			{10, "r2", "all", "c = 3"},
+		})
+
+		obj := &plan.ObjectRef{SchemaName: "d", ObjName: "t", PubAccountId: -1}
+		tableDef := &plan.TableDef{TblId: 10, Name: "t"}
+
+		//the admin is not restricted, but the plan depends on the role
+		predicate, err := resolveRowPolicy(ctx, ses, obj, tableDef, tree.PolicyCommandSelect)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(formatRowPolicyPredicate(predicate), convey.ShouldEqual, "true")
+
+		ses.GetTenantInfo().DefaultRole = "r1"
+		ses.GetTenantInfo().DefaultRoleID = 3
+		bh.sql2result[getSqlForInheritedRoleIdOfRoleId(3)] = newMrsForInheritedRoleIdOfRoleId([][]interface{}{})
+		predicate, err = resolveRowPolicy(ctx, ses, obj, tableDef, tree.PolicyCommandSelect)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(formatRowPolicyPredicate(predicate), convey.ShouldEqual, "(a > 1) or (b < 2)")
+
+		predicate, err = resolveRowPolicy(ctx, ses, obj, tableDef, tree.PolicyCommandDelete)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(formatRowPolicyPredicate(predicate), convey.ShouldEqual, "(b < 2)")
+
+		//the policies are loaded once
+		loads := 0
+		for _, sql := range bh.sqls {
+			if sql == getAllRowPoliciesFormat {
+				loads++
+			}
+		}
+		convey.So(loads, convey.ShouldEqual, 1)
+
+		//the table without policies
+		predicate, err = resolveRowPolicy(ctx, ses, obj, &plan.TableDef{TblId: 11, Name: "t1"}, tree.PolicyCommandSelect)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(predicate, convey.ShouldBeNil)
+
+		//the secondary roles and the roles granted to them are in use
+		ses.GetTenantInfo().SetUseSecondaryRole(true)
+		bh.sql2result[getSqlForRoleIdOfUserId(int(rootID))] = newMrsForRoleIdOfUserId([][]interface{}{{3, false}, {4, false}})
+		bh.sql2result[getSqlForInheritedRoleIdOfRoleId(4)] = newMrsForInheritedRoleIdOfRoleId([][]interface{}{{5, false}})
+		bh.sql2result[getSqlForInheritedRoleIdOfRoleId(5)] = newMrsForInheritedRoleIdOfRoleId([][]interface{}{})
+		bh.sql2result[getSqlForRoleNameOfRoleId(4)] = newMrsForRoleNameOfRoleId([][]interface{}{{"r4"}})
+		bh.sql2result[getSqlForRoleNameOfRoleId(5)] = newMrsForRoleNameOfRoleId([][]interface{}{{"r2"}})
+		predicate, err = resolveRowPolicy(ctx, ses, obj, tableDef, tree.PolicyCommandSelect)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(formatRowPolicyPredicate(predicate), convey.ShouldEqual, "(a > 1) or (b < 2) or (c = 3)")
+
+		//the roles of the subscriber are not those of the publisher, even with the same names
+		bh.sql2result[getAllRowPoliciesFormat] = newMrsForAllRowPolicies([][]interface{}{
+			{20, "r1", "select", "a > 1"},
+			{20, "public", "all", "b < 2"},
+		})
+		pubObj := &plan.ObjectRef{SchemaName: "d", ObjName: "t", PubAccountId: 100}
+		predicate, err = resolveRowPolicy(ctx, ses, pubObj, &plan.TableDef{TblId: 20, Name: "t"}, tree.PolicyCommandSelect)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(formatRowPolicyPredicate(predicate), convey.ShouldEqual, "(b < 2)")
+
+		//the system tables do not have policies
+		obj.SchemaName = "mo_catalog"
+		predicate, err = resolveRowPolicy(ctx, ses, obj, tableDef, tree.PolicyCommandSelect)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(predicate, convey.ShouldBeNil)
+	})
+}
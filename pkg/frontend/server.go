@@ -109,6 +109,7 @@ func initVarByConfig(pu *config.ParameterUnit) {
 	}
 	GSysVariables.sysVars["query_result_maxsize"] = pu.SV.QueryResultMaxsize
 	GSysVariables.sysVars["query_result_timeout"] = pu.SV.QueryResultTimeout
+	GSysVariables.sysVars["query_result_cache_size"] = pu.SV.QueryResultCacheSize
 	v, _ := strconv.ParseInt(pu.SV.LowerCaseTableNames, 10, 64)
 	GSysVariables.sysVars["lower_case_table_names"] = v
 }
@@ -124,6 +124,22 @@ type Session struct {
 
 	priv *privilege
 
+	//the password policy of the user loaded during the authentication
+	passwordPolicy *passwordPolicy
+
+	//the password of the user has expired. The session can only change the password.
+	passwordExpired bool
+
+	//the audit filters of the account loaded during the authentication
+	auditFilters []*auditFilter
+
+	//the account level values of the global variables loaded during the authentication
+	accountVars map[string]interface{}
+
+	//the start time and the computation of the running statement for the slow query log
+	slowQueryStart time.Time
+	slowQueryCw    ComputationWrapper
+
 	errInfo *errInfo
 
 	//fromRealUser distinguish the sql that the user inputs from the one
@@ -132,6 +148,9 @@ type Session struct {
 
 	cache *privilegeCache
 
+	// activeRoles caches the roles in use for the row level security policies
+	activeRoles *activeRoleSet
+
 	debugStr string
 
 	mu sync.Mutex
@@ -194,6 +213,10 @@ type Session struct {
 	// least the commit of the last transaction log of the previous transaction arrives.
 	lastCommitTS timestamp.Timestamp
 	upstream     *Session
+
+	// the rows modified by the txn, they are counted for auto analyze when
+	// the txn commits.
+	modifiedTables map[uint64]*modifiedTable
 }
 
 func (ses *Session) setRoutineManager(rm *RoutineManager) {
@@ -286,16 +309,29 @@ func (ses *Session) pushQueryId(uuid string) {
 }
 
 type errInfo struct {
+	levels []string
 	codes  []uint16
 	msgs   []string
 	maxCnt int
 }
 
 func (e *errInfo) push(code uint16, msg string) {
+	e.pushWithLevel("Error", code, msg)
+}
+
+// pushWarning keeps a warning of the statement, like the optimizer hints
+// ignored by the planner.
+func (e *errInfo) pushWarning(code uint16, msg string) {
+	e.pushWithLevel("Warning", code, msg)
+}
+
+func (e *errInfo) pushWithLevel(level string, code uint16, msg string) {
 	if e.maxCnt > 0 && len(e.codes) > e.maxCnt {
+		e.levels = e.levels[1:]
 		e.codes = e.codes[1:]
 		e.msgs = e.msgs[1:]
 	}
+	e.levels = append(e.levels, level)
 	e.codes = append(e.codes, code)
 	e.msgs = append(e.msgs, msg)
 }
@@ -329,6 +365,7 @@ func NewSession(proto Protocol, mp *mpool.MPool, pu *config.ParameterUnit, gSysV
 		outputCallback: getDataFromPipeline,
 		timeZone:       time.Local,
 		errInfo: &errInfo{
+			levels: make([]string, 0, MoDefaultErrorCount),
 			codes:  make([]uint16, 0, MoDefaultErrorCount),
 			msgs:   make([]string, 0, MoDefaultErrorCount),
 			maxCnt: MoDefaultErrorCount,
@@ -606,6 +643,19 @@ func (ses *Session) InvalidatePrivilegeCache() {
 	ses.mu.Lock()
 	defer ses.mu.Unlock()
 	ses.cache.invalidate()
+	ses.activeRoles = nil
+}
+
+func (ses *Session) getActiveRoles() *activeRoleSet {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	return ses.activeRoles
+}
+
+func (ses *Session) setActiveRoles(roles *activeRoleSet) {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	ses.activeRoles = roles
 }
 
 // GetBackgroundExec generates a background executor
@@ -955,6 +1005,9 @@ func (ses *Session) GetGlobalVar(name string) (interface{}, error) {
 			//empty
 			return nil, moerr.NewInternalError(ses.GetRequestContext(), errorSystemVariableSessionEmpty())
 		}
+		if accountVal, ok := ses.getAccountVar(def.GetName()); ok {
+			return accountVal, nil
+		}
 		return val, nil
 	}
 	return nil, moerr.NewInternalError(ses.GetRequestContext(), errorSystemVariableDoesNotExist())
@@ -1122,7 +1175,7 @@ func (ses *Session) skipAuthForSpecialUser() bool {
 }
 
 // AuthenticateUser verifies the password of the user.
-func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
+func (ses *Session) AuthenticateUser(userInput string) (string, error) {
 	var defaultRoleID int64
 	var defaultRole string
 	var tenant *TenantInfo
@@ -1130,7 +1183,7 @@ func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
 	var rsset []ExecResult
 	var tenantID int64
 	var userID int64
-	var pwd, accountStatus string
+	var pwd, accountStatus, userStatus string
 	var accountVersion uint64
 	var pwdBytes []byte
 	var isSpecial bool
@@ -1139,7 +1192,7 @@ func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
 	//Get tenant info
 	tenant, err = GetTenantInfo(ses.GetRequestContext(), userInput)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
 	ses.SetTenantInfo(tenant)
@@ -1151,7 +1204,7 @@ func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
 	isSpecial, pwdBytes, specialAccount = isSpecialUser(tenant.GetUser())
 	if isSpecial && specialAccount.IsMoAdminRole() {
 		ses.SetTenantInfo(specialAccount)
-		return GetPassWord(HashPassWordWithByte(pwdBytes))
+		return HashPassWordWithByte(pwdBytes), nil
 	}
 
 	ses.SetTenantInfo(tenant)
@@ -1162,7 +1215,7 @@ func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
 	sysTenantCtx = context.WithValue(sysTenantCtx, defines.RoleIDKey{}, uint32(moAdminRoleID))
 	sqlForCheckTenant, err := getSqlForCheckTenant(sysTenantCtx, tenant.GetTenant())
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 	pu := ses.GetParameterUnit()
 	mp := ses.GetMemPool()
@@ -1174,32 +1227,32 @@ func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
 		pu,
 		sqlForCheckTenant)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 	if !execResultArrayHasData(rsset) {
-		return nil, moerr.NewInternalError(sysTenantCtx, "there is no tenant %s", tenant.GetTenant())
+		return "", moerr.NewInternalError(sysTenantCtx, "there is no tenant %s", tenant.GetTenant())
 	}
 
 	//account id
 	tenantID, err = rsset[0].GetInt64(sysTenantCtx, 0, 0)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
 	//account status
 	accountStatus, err = rsset[0].GetString(sysTenantCtx, 0, 2)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
 	//account version
 	accountVersion, err = rsset[0].GetUint64(sysTenantCtx, 0, 3)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
 	if strings.ToLower(accountStatus) == tree.AccountStatusSuspend.String() {
-		return nil, moerr.NewInternalError(sysTenantCtx, "Account %s is suspended", tenant.GetTenant())
+		return "", moerr.NewInternalError(sysTenantCtx, "Account %s is suspended", tenant.GetTenant())
 	}
 
 	tenant.SetTenantID(uint32(tenantID))
@@ -1212,7 +1265,7 @@ func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
 	//Get the password of the user in an independent session
 	sqlForPasswordOfUser, err := getSqlForPasswordOfUser(tenantCtx, tenant.GetUser())
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 	rsset, err = executeSQLInBackgroundSession(
 		tenantCtx,
@@ -1221,32 +1274,82 @@ func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
 		pu,
 		sqlForPasswordOfUser)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 	if !execResultArrayHasData(rsset) {
-		return nil, moerr.NewInternalError(tenantCtx, "there is no user %s", tenant.GetUser())
+		return "", moerr.NewInternalError(tenantCtx, "there is no user %s", tenant.GetUser())
 	}
 
 	userID, err = rsset[0].GetInt64(tenantCtx, 0, 0)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
 	pwd, err = rsset[0].GetString(tenantCtx, 0, 1)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
 	//the default_role in the mo_user table.
 	//the default_role is always valid. public or other valid role.
 	defaultRoleID, err = rsset[0].GetInt64(tenantCtx, 0, 2)
 	if err != nil {
-		return nil, err
+		return "", err
+	}
+
+	userStatus, err = rsset[0].GetString(tenantCtx, 0, 3)
+	if err != nil {
+		return "", err
+	}
+
+	if strings.ToLower(userStatus) == userStatusLock {
+		return "", moerr.NewInternalError(tenantCtx, "Access denied for user %s. Account is locked.", tenant.GetUser())
 	}
 
 	tenant.SetUserID(uint32(userID))
 	tenant.SetDefaultRoleID(uint32(defaultRoleID))
 
+	//step3.1 : check the user is locked due to the failed logins or not
+	logDebugf(sessionInfo, "check password policy of user %s.", tenant)
+	rsset, err = executeSQLInBackgroundSession(
+		tenantCtx,
+		ses,
+		mp,
+		pu,
+		getSqlForPasswordPolicyOfUser(userID))
+	if err != nil {
+		//the account created by the former versions has no password policy
+		//before it is upgraded
+		if !moerr.IsMoErrCode(err, moerr.ErrNoSuchTable) {
+			return "", err
+		}
+		rsset = nil
+	}
+	policy := newDefaultPasswordPolicy(userID)
+	err = fillPasswordPolicy(tenantCtx, policy, rsset)
+	if err != nil {
+		return "", err
+	}
+	err = policy.checkLocked(tenantCtx, time.Now().Unix())
+	if err != nil {
+		return "", err
+	}
+	ses.setPasswordPolicy(policy)
+
+	//step3.2 : load the audit filters of the account
+	if motrace.IsAuditEnable() {
+		err = ses.loadAuditFilters(tenantCtx)
+		if err != nil {
+			logErrorf(sessionInfo, "load the audit filters failed. error:%v", err)
+		}
+	}
+
+	//step3.3 : load the global variables of the account
+	err = ses.loadAccountVariables(tenantCtx)
+	if err != nil {
+		logErrorf(sessionInfo, "load the account variables failed. error:%v", err)
+	}
+
 	/*
 		login case 1: tenant:user
 		1.get the default_role of the user in mo_user
@@ -1263,7 +1366,7 @@ func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
 		//step4 : check role exists or not
 		sqlForCheckRoleExists, err := getSqlForRoleIdOfRole(tenantCtx, tenant.GetDefaultRole())
 		if err != nil {
-			return nil, err
+			return "", err
 		}
 		rsset, err = executeSQLInBackgroundSession(
 			tenantCtx,
@@ -1272,18 +1375,18 @@ func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
 			pu,
 			sqlForCheckRoleExists)
 		if err != nil {
-			return nil, err
+			return "", err
 		}
 
 		if !execResultArrayHasData(rsset) {
-			return nil, moerr.NewInternalError(tenantCtx, "there is no role %s", tenant.GetDefaultRole())
+			return "", moerr.NewInternalError(tenantCtx, "there is no role %s", tenant.GetDefaultRole())
 		}
 
 		logDebugf(sessionInfo, "check granted role of user %s.", tenant)
 		//step4.2 : check the role has been granted to the user or not
 		sqlForRoleOfUser, err := getSqlForRoleOfUser(tenantCtx, userID, tenant.GetDefaultRole())
 		if err != nil {
-			return nil, err
+			return "", err
 		}
 		rsset, err = executeSQLInBackgroundSession(
 			tenantCtx,
@@ -1292,16 +1395,16 @@ func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
 			pu,
 			sqlForRoleOfUser)
 		if err != nil {
-			return nil, err
+			return "", err
 		}
 		if !execResultArrayHasData(rsset) {
-			return nil, moerr.NewInternalError(tenantCtx, "the role %s has not been granted to the user %s",
+			return "", moerr.NewInternalError(tenantCtx, "the role %s has not been granted to the user %s",
 				tenant.GetDefaultRole(), tenant.GetUser())
 		}
 
 		defaultRoleID, err = rsset[0].GetInt64(tenantCtx, 0, 0)
 		if err != nil {
-			return nil, err
+			return "", err
 		}
 		tenant.SetDefaultRoleID(uint32(defaultRoleID))
 	} else {
@@ -1315,15 +1418,15 @@ func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
 			pu,
 			sql)
 		if err != nil {
-			return nil, err
+			return "", err
 		}
 		if !execResultArrayHasData(rsset) {
-			return nil, moerr.NewInternalError(tenantCtx, "get the default role of the user %s failed", tenant.GetUser())
+			return "", moerr.NewInternalError(tenantCtx, "get the default role of the user %s failed", tenant.GetUser())
 		}
 
 		defaultRole, err = rsset[0].GetString(tenantCtx, 0, 0)
 		if err != nil {
-			return nil, err
+			return "", err
 		}
 		tenant.SetDefaultRole(defaultRole)
 	}
@@ -1331,7 +1434,7 @@ func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
 	ses.getRoutineManager().accountRoutine.recordRountine(tenantID, ses.getRoutin(), accountVersion)
 	logInfo(sessionInfo, tenant.String())
 
-	return GetPassWord(pwd)
+	return pwd, nil
 }
 
 func (ses *Session) GetPrivilege() *privilege {
@@ -627,6 +627,8 @@ func TestSession_TxnCompilerContext(t *testing.T) {
 		pkd := tcc.GetPrimaryKeyDef("abc", "t1")
 		convey.So(len(pkd), convey.ShouldBeZeroValue)
 
+		// the table is not analyzed
+		analyzedTables.set(10, nil)
 		stats := tcc.Stats(&plan2.ObjectRef{SchemaName: "abc", ObjName: "t1"}, &plan2.Expr{})
 		convey.So(stats, convey.ShouldBeNil)
 	})
@@ -0,0 +1,360 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+)
+
+// globalPlanCache is the cache of the parameterised plans shared by the
+// sessions of the CN. It is used by the prepared statements and by the
+// queries parameterised automatically, so that short-lived connections
+// running the same statements do not build their plans again and again.
+var globalPlanCache = newSharedPlanCache()
+
+type sharedPlanKey struct {
+	accountId uint32
+	database  string
+	sqlMode   string
+	// sql is the normalised text of the statement with the params
+	sql string
+}
+
+type sharedPlan struct {
+	key     sharedPlanKey
+	prepare *plan.Prepare
+}
+
+// sharedPlanCache uses LRU to cache the parameterised plans. The plans are
+// only valid for one version of the catalog, all of them are dropped once
+// a DDL changes the catalog.
+type sharedPlanCache struct {
+	sync.Mutex
+	version   uint64
+	lruList   *list.List
+	cachePool map[sharedPlanKey]*list.Element
+}
+
+func newSharedPlanCache() *sharedPlanCache {
+	return &sharedPlanCache{
+		lruList:   list.New(),
+		cachePool: make(map[sharedPlanKey]*list.Element),
+	}
+}
+
+// get returns a copy of the plan cached for the key with the version of
+// the catalog
+func (pc *sharedPlanCache) get(key sharedPlanKey, version uint64) *plan.Prepare {
+	pc.Lock()
+	defer pc.Unlock()
+	pc.checkVersion(version)
+	if version != pc.version {
+		return nil
+	}
+	element, ok := pc.cachePool[key]
+	if !ok {
+		return nil
+	}
+	pc.lruList.MoveToFront(element)
+	return copyPrepare(element.Value.(*sharedPlan).prepare)
+}
+
+// put caches the plan built with the version of the catalog
+func (pc *sharedPlanCache) put(key sharedPlanKey, version uint64, prepare *plan.Prepare, capacity int) {
+	pc.Lock()
+	defer pc.Unlock()
+	pc.checkVersion(version)
+	if version != pc.version || capacity <= 0 {
+		return
+	}
+	if element, ok := pc.cachePool[key]; ok {
+		element.Value.(*sharedPlan).prepare = prepare
+		pc.lruList.MoveToFront(element)
+		return
+	}
+	pc.cachePool[key] = pc.lruList.PushFront(&sharedPlan{key: key, prepare: prepare})
+	for pc.lruList.Len() > capacity {
+		toRemove := pc.lruList.Back()
+		pc.lruList.Remove(toRemove)
+		delete(pc.cachePool, toRemove.Value.(*sharedPlan).key)
+	}
+}
+
+// checkVersion drops all the plans if the catalog has changed
+func (pc *sharedPlanCache) checkVersion(version uint64) {
+	if version > pc.version {
+		pc.version = version
+		pc.lruList.Init()
+		pc.cachePool = make(map[sharedPlanKey]*list.Element)
+	}
+}
+
+func copyPrepare(prepare *plan.Prepare) *plan.Prepare {
+	return &plan.Prepare{
+		Name:       prepare.Name,
+		Schemas:    prepare.Schemas,
+		Plan:       plan2.DeepCopyPlan(prepare.Plan),
+		ParamTypes: prepare.ParamTypes,
+	}
+}
+
+func sharedPlanCacheEnabled(ses *Session) bool {
+	// the plans built in a txn may see the uncommitted DDL of the txn, and
+	// the temporary tables are only visible to their sessions
+	if ses.IsBackgroundSession() || ses.GetTenantInfo() == nil ||
+		ses.InMultiStmtTransactionMode() || ses.IfInitedTempEngine() {
+		return false
+	}
+	val, err := ses.GetSessionVar("shared_plan_cache")
+	if err != nil {
+		return false
+	}
+	v, _ := val.(int8)
+	return v > 0
+}
+
+// sharedPlanCacheCapacity returns the number of the plans the shared plan
+// cache can hold
+func sharedPlanCacheCapacity(ses *Session) int {
+	val, err := ses.GetGlobalVar("shared_plan_cache_size")
+	if err != nil {
+		return 0
+	}
+	switch v := val.(type) {
+	case uint64:
+		return int(v)
+	case float64:
+		return int(v)
+	}
+	return 0
+}
+
+// getSharedPlanKey returns the key of the statement in the shared plan cache
+// and the current version of the catalog. It returns false if the plan of
+// the statement can not be shared.
+func getSharedPlanKey(ctx context.Context, ses *Session, stmt tree.Statement) (sharedPlanKey, uint64, bool) {
+	switch stmt.(type) {
+	case *tree.Select, *tree.ParenSelect, *tree.Insert, *tree.Update, *tree.Delete:
+	default:
+		return sharedPlanKey{}, 0, false
+	}
+	if !sharedPlanCacheEnabled(ses) {
+		return sharedPlanKey{}, 0, false
+	}
+	version, _ := ses.GetStorage().CatalogVersion()
+	if version == 0 {
+		return sharedPlanKey{}, 0, false
+	}
+	// the binding of the query depends on the sql mode
+	sqlMode, err := ses.GetSessionVar("sql_mode")
+	if err != nil {
+		return sharedPlanKey{}, 0, false
+	}
+	return sharedPlanKey{
+		accountId: getAccountId(ctx),
+		database:  ses.GetDatabaseName(),
+		sqlMode:   fmt.Sprint(sqlMode),
+		sql:       fingerprintOf(stmt),
+	}, version, true
+}
+
+// putSharedPlan caches the prepare plan built with the version of the catalog
+func putSharedPlan(ses *Session, key sharedPlanKey, version uint64, prepare *plan.Prepare) {
+	if prepare == nil || prepare.Plan.GetQuery() == nil || !checkNodeCanCache(prepare.Plan) {
+		return
+	}
+	// the plan is built with an old catalog if the catalog changed while
+	// building, or the snapshot of the txn is older than the last change
+	current, ts := ses.GetStorage().CatalogVersion()
+	txnOp := ses.GetTxnHandler().GetTxnOperator()
+	if current != version || txnOp == nil || txnOp.Txn().SnapshotTS.Less(ts) {
+		return
+	}
+	globalPlanCache.put(key, version, copyPrepare(prepare), sharedPlanCacheCapacity(ses))
+}
+
+func newPreparePlan(prepare *plan.Prepare) *plan2.Plan {
+	return &plan2.Plan{
+		Plan: &plan.Plan_Dcl{
+			Dcl: &plan.DataControl{
+				DclType: plan.DataControl_PREPARE,
+				Control: &plan.DataControl_Prepare{
+					Prepare: prepare,
+				},
+			},
+		},
+	}
+}
+
+// buildPreparePlan returns the prepare plan of the statement from the shared
+// plan cache, or builds it with build and caches it.
+func buildPreparePlan(ctx context.Context, ses *Session, name string, stmt tree.Statement, build func() (*plan2.Plan, error)) (*plan2.Plan, error) {
+	key, version, ok := getSharedPlanKey(ctx, ses, stmt)
+	if ok {
+		if prepare := globalPlanCache.get(key, version); prepare != nil {
+			prepare.Name = name
+			return newPreparePlan(prepare), nil
+		}
+	}
+	preparePlan, err := build()
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		putSharedPlan(ses, key, version, preparePlan.GetDcl().GetPrepare())
+	}
+	return preparePlan, nil
+}
+
+func autoParameterizeEnabled(ses *Session, stmt tree.Statement) bool {
+	st, ok := stmt.(*tree.Select)
+	if !ok || st.Ep != nil {
+		return false
+	}
+	val, err := ses.GetSessionVar("auto_parameterize")
+	if err != nil {
+		return false
+	}
+	v, _ := val.(int8)
+	return v > 0
+}
+
+// buildParameterisedPlan builds the plan of the query from the parameterised
+// plan in the shared plan cache, so that the queries differing only in the
+// literals compared with the columns share the same plan. It returns nil if
+// the query can not be parameterised.
+func buildParameterisedPlan(ctx context.Context, ses *Session, stmt tree.Statement) (*plan2.Plan, error) {
+	if !autoParameterizeEnabled(ses, stmt) {
+		return nil, nil
+	}
+	args, restore := parameterize(stmt.(*tree.Select))
+	key, version, ok := getSharedPlanKey(ctx, ses, stmt)
+	if !ok {
+		restore()
+		return nil, nil
+	}
+	prepare := globalPlanCache.get(key, version)
+	if prepare == nil {
+		preparePlan, err := buildPlan(ctx, ses, ses.GetTxnCompileCtx(), &tree.PrepareStmt{Stmt: stmt})
+		restore()
+		// the query is built as it is, and reports the error if any
+		if err != nil {
+			return nil, nil
+		}
+		prepare = preparePlan.GetDcl().GetPrepare()
+		putSharedPlan(ses, key, version, prepare)
+	} else {
+		restore()
+	}
+	if len(args) != len(prepare.ParamTypes) {
+		return nil, nil
+	}
+
+	values, err := plan2.BuildExecuteArgs(ses.GetTxnCompileCtx(), args)
+	if err != nil {
+		return nil, err
+	}
+	newPlan, err := bindPreparePlan(ctx, ses, prepare, values)
+	if err != nil {
+		return nil, err
+	}
+	if ses.GetTenantInfo() != nil {
+		err = authenticateCanExecuteStatementAndPlan(ctx, ses, stmt, newPlan)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return newPlan, nil
+}
+
+// parameterize replaces the literals compared with the columns in the where
+// clause of the query with params. It returns the literals in the order of
+// the params, and a function to put the literals back.
+func parameterize(stmt *tree.Select) ([]tree.Expr, func()) {
+	var args []tree.Expr
+	var restores []func()
+	param := func(expr tree.Expr, set func(tree.Expr)) {
+		if !isParameterizableLiteral(expr) {
+			return
+		}
+		args = append(args, expr)
+		set(tree.NewParamExpr(len(args)))
+		restores = append(restores, func() { set(expr) })
+	}
+
+	var walk func(tree.Expr)
+	walk = func(expr tree.Expr) {
+		switch e := expr.(type) {
+		case *tree.AndExpr:
+			walk(e.Left)
+			walk(e.Right)
+		case *tree.OrExpr:
+			walk(e.Left)
+			walk(e.Right)
+		case *tree.NotExpr:
+			walk(e.Expr)
+		case *tree.ParenExpr:
+			walk(e.Expr)
+		case *tree.ComparisonExpr:
+			switch e.Op {
+			case tree.EQUAL, tree.NOT_EQUAL, tree.LESS_THAN, tree.LESS_THAN_EQUAL,
+				tree.GREAT_THAN, tree.GREAT_THAN_EQUAL:
+			default:
+				return
+			}
+			if _, ok := e.Left.(*tree.UnresolvedName); ok {
+				param(e.Right, func(arg tree.Expr) { e.Right = arg })
+			} else if _, ok := e.Right.(*tree.UnresolvedName); ok {
+				param(e.Left, func(arg tree.Expr) { e.Left = arg })
+			}
+		case *tree.RangeCond:
+			if _, ok := e.Left.(*tree.UnresolvedName); ok {
+				param(e.From, func(arg tree.Expr) { e.From = arg })
+				param(e.To, func(arg tree.Expr) { e.To = arg })
+			}
+		}
+	}
+
+	if clause, ok := stmt.Select.(*tree.SelectClause); ok && clause.Where != nil {
+		walk(clause.Where.Expr)
+	}
+	return args, func() {
+		for i := len(restores) - 1; i >= 0; i-- {
+			restores[i]()
+		}
+		restores = nil
+	}
+}
+
+// isParameterizableLiteral returns true for the numbers and the strings, the
+// nulls and the bools are kept in the query since they change its plan.
+func isParameterizableLiteral(expr tree.Expr) bool {
+	val, ok := expr.(*tree.NumVal)
+	if !ok {
+		return false
+	}
+	switch val.ValType {
+	case tree.P_int64, tree.P_uint64, tree.P_float64, tree.P_decimal, tree.P_char:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,110 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/stretchr/testify/require"
+)
+
+func newPrepareForPlanCache(name string) *plan.Prepare {
+	return &plan.Prepare{
+		Name: name,
+		Plan: &plan.Plan{
+			Plan: &plan.Plan_Query{
+				Query: &plan.Query{
+					Nodes: []*plan.Node{{NodeType: plan.Node_TABLE_SCAN}},
+				},
+			},
+		},
+		ParamTypes: []int32{1},
+	}
+}
+
+func TestSharedPlanCache(t *testing.T) {
+	pc := newSharedPlanCache()
+	key := sharedPlanKey{accountId: 1, database: "db", sql: "select a from t where a = ?"}
+	pc.put(key, 1, newPrepareForPlanCache("s1"), 2)
+
+	prepare := pc.get(key, 1)
+	require.NotNil(t, prepare)
+	require.Equal(t, []int32{1}, prepare.ParamTypes)
+	// the plan is copied for each statement
+	prepare.Plan.GetQuery().Nodes[0].NodeType = plan.Node_FILTER
+	require.Equal(t, plan.Node_TABLE_SCAN, pc.get(key, 1).Plan.GetQuery().Nodes[0].NodeType)
+
+	// another account has its own plans
+	require.Nil(t, pc.get(sharedPlanKey{accountId: 2, database: "db", sql: key.sql}, 1))
+
+	// the least recently used one is evicted
+	key2, key3 := key, key
+	key2.sql, key3.sql = "select b from t where a = ?", "select c from t where a = ?"
+	pc.put(key2, 1, newPrepareForPlanCache("s2"), 2)
+	require.NotNil(t, pc.get(key, 1))
+	pc.put(key3, 1, newPrepareForPlanCache("s3"), 2)
+	require.Equal(t, 2, pc.lruList.Len())
+	require.NotNil(t, pc.get(key, 1))
+	require.Nil(t, pc.get(key2, 1))
+	require.NotNil(t, pc.get(key3, 1))
+
+	// a plan built with an old catalog is not cached
+	pc.put(key2, 0, newPrepareForPlanCache("s2"), 2)
+	require.Nil(t, pc.get(key2, 1))
+
+	// the plans are dropped once the catalog changes
+	require.Nil(t, pc.get(key, 2))
+	require.Equal(t, 0, pc.lruList.Len())
+	require.Nil(t, pc.get(key3, 1))
+}
+
+func TestParameterize(t *testing.T) {
+	kases := []struct {
+		sql           string
+		parameterised string
+		args          []string
+	}{
+		{"select a from t where a = 1 and b > 'x'",
+			"select a from t where a = ? and b > ?", []string{"1", "x"}},
+		{"select a from t where 2 <= a or not (b <> 3.5)",
+			"select a from t where ? <= a or not (b != ?)", []string{"2", "3.5"}},
+		{"select a from t where a between 1 and 10 limit 5",
+			"select a from t where a between ? and ? limit 5", []string{"1", "10"}},
+		// the literals not compared with the columns are kept
+		{"select a + 1 from t where a in (1, 2) and b = null and c = true and a + 1 = 2",
+			"select a + 1 from t where a in (1, 2) and b = null and c = true and a + 1 = 2", nil},
+		{"select a from t where b = (select max(b) from t where c = 1)",
+			"select a from t where b = (select max(b) from t where c = 1)", nil},
+	}
+	for _, kase := range kases {
+		stmts, err := mysql.Parse(context.TODO(), kase.sql, 1)
+		require.NoError(t, err)
+		stmt := stmts[0].(*tree.Select)
+		origin := tree.String(stmt, dialect.MYSQL)
+		args, restore := parameterize(stmt)
+		require.Equal(t, kase.parameterised, tree.String(stmt, dialect.MYSQL), kase.sql)
+		require.Equal(t, len(kase.args), len(args), kase.sql)
+		for i, arg := range args {
+			require.Equal(t, kase.args[i], tree.String(arg, dialect.MYSQL), kase.sql)
+		}
+		restore()
+		require.Equal(t, origin, tree.String(stmt, dialect.MYSQL), kase.sql)
+	}
+}
@@ -0,0 +1,449 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+const (
+	insertSlowQueryPrefix = `insert into mo_catalog.mo_slow_query_log(
+				statement_id,
+				session_id,
+				user_name,
+				host,
+				database_name,
+				start_time,
+				query_time,
+				lock_time,
+				rows_sent,
+				rows_examined,
+				bytes_scan,
+				status,
+				error,
+				statement,
+				normalized_statement,
+				digest,
+				exec_plan,
+				stats) values `
+
+	slowQueryValuesFormat = `('%s','%s','%s','%s','%s','%s',%f,%f,%d,%d,%d,'%s','%s','%s','%s','%s','%s','%s')`
+
+	deleteExpiredSlowQueryFormat = `delete from mo_catalog.mo_slow_query_log where start_time < '%s';`
+
+	slowQueryLogTimeout = time.Minute
+
+	// slowQueryQueueSize is the number of the slow queries of the CN waiting
+	// to be saved. The slow queries are dropped when the queue is full.
+	slowQueryQueueSize = 4096
+	// slowQueryBatchSize is the max number of the slow queries inserted at once
+	slowQueryBatchSize = 256
+	// slowQueryFlushInterval is the max time a slow query waits in the queue
+	slowQueryFlushInterval = time.Second
+	// slowQueryPurgeInterval is the interval of deleting the expired slow queries of an account
+	slowQueryPurgeInterval = time.Hour
+)
+
+// slowQueryRecord is a statement that runs longer than the long_query_time of the session
+type slowQueryRecord struct {
+	StatementID string    `json:"statement_id"`
+	SessionID   string    `json:"session_id"`
+	Account     string    `json:"account"`
+	User        string    `json:"user"`
+	Host        string    `json:"host"`
+	Database    string    `json:"database"`
+	StartTime   time.Time `json:"start_time"`
+	// QueryTime and LockTime are in seconds
+	QueryTime           float64         `json:"query_time"`
+	LockTime            float64         `json:"lock_time"`
+	RowsSent            int64           `json:"rows_sent"`
+	RowsExamined        int64           `json:"rows_examined"`
+	BytesScan           int64           `json:"bytes_scan"`
+	Status              string          `json:"status"`
+	Error               string          `json:"error,omitempty"`
+	Statement           string          `json:"statement"`
+	NormalizedStatement string          `json:"normalized_statement"`
+	Digest              string          `json:"digest"`
+	ExecPlan            json.RawMessage `json:"exec_plan,omitempty"`
+	Stats               json.RawMessage `json:"stats,omitempty"`
+
+	//the account, user and role saving the record
+	accountId uint32
+	userId    uint32
+	roleId    uint32
+	//retention is the days the slow queries are kept in the mo_slow_query_log of the account
+	retention int64
+}
+
+func getSqlForInsertSlowQuery(records ...*slowQueryRecord) string {
+	sql := strings.Builder{}
+	sql.WriteString(insertSlowQueryPrefix)
+	for i, r := range records {
+		if i > 0 {
+			sql.WriteString(",")
+		}
+		sql.WriteString(getSqlForSlowQueryValues(r))
+	}
+	sql.WriteString(";")
+	return sql.String()
+}
+
+func getSqlForSlowQueryValues(r *slowQueryRecord) string {
+	return fmt.Sprintf(slowQueryValuesFormat,
+		r.StatementID,
+		r.SessionID,
+		escapeSingleQuoteString(r.User),
+		escapeSingleQuoteString(r.Host),
+		escapeSingleQuoteString(r.Database),
+		r.StartTime.UTC().Format("2006-01-02 15:04:05"),
+		r.QueryTime,
+		r.LockTime,
+		r.RowsSent,
+		r.RowsExamined,
+		r.BytesScan,
+		r.Status,
+		escapeSingleQuoteString(r.Error),
+		escapeSingleQuoteString(r.Statement),
+		escapeSingleQuoteString(r.NormalizedStatement),
+		r.Digest,
+		escapeSingleQuoteString(string(r.ExecPlan)),
+		escapeSingleQuoteString(string(r.Stats)))
+}
+
+func getSqlForDeleteExpiredSlowQuery(before time.Time) string {
+	return fmt.Sprintf(deleteExpiredSlowQueryFormat, before.UTC().Format("2006-01-02 15:04:05"))
+}
+
+// normalizeStatement replaces the literals of the statement with '?' and
+// returns the text with its digest. The statements differing only in their
+// literals have the same digest.
+func normalizeStatement(stmt tree.Statement) (string, string) {
+	fmtCtx := tree.NewFmtCtx(dialect.MYSQL, tree.WithNormalize())
+	stmt.Format(fmtCtx)
+	text := fmtCtx.String()
+	sum := sha256.Sum256([]byte(text))
+	return text, hex.EncodeToString(sum[:])
+}
+
+// isSlowQuery checks the statement runs longer than the long_query_time with the slow_query_log on
+func isSlowQuery(ses *Session, queryTime time.Duration) bool {
+	val, err := ses.GetSessionVar("slow_query_log")
+	if err != nil || !(SystemVariableBoolType{}).IsTrue(val) {
+		return false
+	}
+	val, err = ses.GetSessionVar("long_query_time")
+	if err != nil {
+		return false
+	}
+	longQueryTime, ok := val.(float64)
+	return ok && queryTime.Seconds() > longQueryTime
+}
+
+// execPlanHolder is the computation that has the plan of the statement
+type execPlanHolder interface {
+	getExecPlan() *plan2.Plan
+}
+
+func (cwft *TxnComputationWrapper) getExecPlan() *plan2.Plan {
+	return cwft.plan
+}
+
+func (bse *baseStmtExecutor) getExecPlan() *plan2.Plan {
+	if h, ok := bse.ComputationWrapper.(execPlanHolder); ok {
+		return h.getExecPlan()
+	}
+	return nil
+}
+
+// lockWaitTimeOfPlan sums the time the nodes of the plan waiting for the locks
+func lockWaitTimeOfPlan(p *plan2.Plan) time.Duration {
+	var total int64
+	if p == nil || p.GetQuery() == nil {
+		return 0
+	}
+	for _, node := range p.GetQuery().Nodes {
+		if node.AnalyzeInfo != nil {
+			total += node.AnalyzeInfo.LockWaitTime
+		}
+	}
+	return time.Duration(total)
+}
+
+func (ses *Session) setSlowQueryStatement(cw ComputationWrapper, start time.Time) {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	ses.slowQueryCw = cw
+	ses.slowQueryStart = start
+}
+
+func (ses *Session) getSlowQueryStatement() (ComputationWrapper, time.Time) {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	return ses.slowQueryCw, ses.slowQueryStart
+}
+
+// recordSlowQuery saves the statement the user inputs into the mo_slow_query_log of the account
+// and the slow query log file when it runs longer than the long_query_time
+func recordSlowQuery(ctx context.Context, ses *Session, stmt tree.Statement, status statementStatus, err error) {
+	if stmt == nil || ses.IsBackgroundSession() || !ses.GetFromRealUser() {
+		return
+	}
+	cw, start := ses.getSlowQueryStatement()
+	if start.IsZero() {
+		return
+	}
+	ses.setSlowQueryStatement(nil, time.Time{})
+	queryTime := time.Since(start)
+	if !isSlowQuery(ses, queryTime) {
+		return
+	}
+	tenant := ses.GetTenantInfo()
+	if tenant == nil {
+		return
+	}
+
+	r := newSlowQueryRecord(ctx, ses, stmt, cw, start, queryTime, status, err)
+	writeSlowQueryLogFile(ses, r)
+	saveSlowQuery(ses, r)
+}
+
+func newSlowQueryRecord(ctx context.Context, ses *Session, stmt tree.Statement, cw ComputationWrapper,
+	start time.Time, queryTime time.Duration, status statementStatus, err error) *slowQueryRecord {
+	stmtID := uuid.New()
+	if cw != nil && len(cw.GetUUID()) == len(stmtID) {
+		copy(stmtID[:], cw.GetUUID())
+	}
+	r := &slowQueryRecord{
+		StatementID: stmtID.String(),
+		SessionID:   ses.GetUUIDString(),
+		Database:    ses.GetDatabaseName(),
+		StartTime:   start,
+		QueryTime:   queryTime.Seconds(),
+		RowsSent:    ses.sentRows.Load(),
+		Status:      status.String(),
+		//the formatted statement does not have the passwords and the secret keys
+		Statement: tree.String(stmt, dialect.MYSQL),
+	}
+	if tenant := ses.GetTenantInfo(); tenant != nil {
+		r.Account = tenant.GetTenant()
+		r.User = tenant.GetUser()
+		r.accountId = tenant.GetTenantID()
+		r.userId = tenant.GetUserID()
+		r.roleId = tenant.GetDefaultRoleID()
+	}
+	if val, err := ses.GetGlobalVar("slow_query_log_retention"); err == nil {
+		r.retention, _ = val.(int64)
+	}
+	ses.mu.Lock()
+	proto := ses.protocol
+	ses.mu.Unlock()
+	if mp, ok := proto.(MysqlProtocol); ok {
+		r.Host = mp.Peer()
+	}
+	if err != nil {
+		r.Error = err.Error()
+	}
+	r.NormalizedStatement, r.Digest = normalizeStatement(stmt)
+
+	if h, ok := cw.(execPlanHolder); ok {
+		if p := h.getExecPlan(); p != nil && p.GetQuery() != nil {
+			planJson, statsJson, stats := serializePlanToJson(ctx, p, stmtID)
+			r.ExecPlan = trimJson(planJson)
+			r.Stats = trimJson(statsJson)
+			r.RowsExamined, r.BytesScan = stats.RowsRead, stats.BytesScan
+			r.LockTime = lockWaitTimeOfPlan(p).Seconds()
+		}
+	}
+	return r
+}
+
+// trimJson drops the new line the json encoder appends
+func trimJson(b []byte) json.RawMessage {
+	if len(b) > 0 && b[len(b)-1] == '\n' {
+		b = b[:len(b)-1]
+	}
+	if len(b) == 0 || !json.Valid(b) {
+		return nil
+	}
+	return b
+}
+
+// slowQueryWriter saves the slow queries of all the sessions on the CN into
+// the mo_slow_query_log of their accounts. The slow queries are queued and
+// inserted in batches by a single goroutine.
+type slowQueryWriter struct {
+	once    sync.Once
+	records chan *slowQueryRecord
+	//purged is the last time the expired slow queries of the account were deleted
+	purged map[uint32]time.Time
+}
+
+var globalSlowQueryWriter = newSlowQueryWriter(slowQueryQueueSize)
+
+func newSlowQueryWriter(size int) *slowQueryWriter {
+	return &slowQueryWriter{
+		records: make(chan *slowQueryRecord, size),
+		purged:  make(map[uint32]time.Time),
+	}
+}
+
+// saveSlowQuery queues the record to be inserted into the mo_slow_query_log of the account.
+// The record is only in the slow query log file if the queue is full.
+func saveSlowQuery(ses *Session, r *slowQueryRecord) {
+	w := globalSlowQueryWriter
+	pu, aicm := ses.GetParameterUnit(), ses.GetAutoIncrCacheManager()
+	w.once.Do(func() {
+		go w.run(pu, aicm)
+	})
+	select {
+	case w.records <- r:
+	default:
+		logErrorf(ses.GetDebugString(), "the queue of the slow queries is full, drop the slow query %s", r.StatementID)
+	}
+}
+
+func (w *slowQueryWriter) run(pu *config.ParameterUnit, aicm *defines.AutoIncrCacheManager) {
+	ticker := time.NewTicker(slowQueryFlushInterval)
+	defer ticker.Stop()
+	records := make([]*slowQueryRecord, 0, slowQueryBatchSize)
+	for {
+		select {
+		case r := <-w.records:
+			records = append(records, r)
+			if len(records) < slowQueryBatchSize {
+				continue
+			}
+		case <-ticker.C:
+		}
+		if len(records) > 0 {
+			w.flush(pu, aicm, records)
+			records = records[:0]
+		}
+	}
+}
+
+// flush inserts the records into the mo_slow_query_log of their accounts
+func (w *slowQueryWriter) flush(pu *config.ParameterUnit, aicm *defines.AutoIncrCacheManager, records []*slowQueryRecord) {
+	accounts := make([]uint32, 0, 1)
+	recordsOfAccount := make(map[uint32][]*slowQueryRecord)
+	for _, r := range records {
+		if _, ok := recordsOfAccount[r.accountId]; !ok {
+			accounts = append(accounts, r.accountId)
+		}
+		recordsOfAccount[r.accountId] = append(recordsOfAccount[r.accountId], r)
+	}
+	for _, accountId := range accounts {
+		if err := w.flushAccount(pu, aicm, recordsOfAccount[accountId]); err != nil {
+			logutil.Errorf("save %d slow queries of the account %d failed. error:%v",
+				len(recordsOfAccount[accountId]), accountId, err)
+		}
+	}
+}
+
+// flushAccount inserts the records of an account, and deletes the slow queries
+// older than the retention of the account.
+func (w *slowQueryWriter) flushAccount(pu *config.ParameterUnit, aicm *defines.AutoIncrCacheManager, records []*slowQueryRecord) error {
+	last := records[len(records)-1]
+	mp, err := mpool.NewMPool("slow_query_log", 0, mpool.NoFixed)
+	if err != nil {
+		return err
+	}
+	defer mpool.DeleteMPool(mp)
+
+	ctx := context.WithValue(context.Background(), defines.TenantIDKey{}, last.accountId)
+	ctx = context.WithValue(ctx, defines.UserIDKey{}, last.userId)
+	ctx = context.WithValue(ctx, defines.RoleIDKey{}, last.roleId)
+	ctx, cancel := context.WithTimeout(ctx, slowQueryLogTimeout)
+	defer cancel()
+	upstream := &Session{connectCtx: ctx, autoIncrCacheManager: aicm}
+	bh := NewBackgroundHandler(ctx, upstream, mp, pu)
+	defer bh.Close()
+
+	if err = bh.Exec(ctx, getSqlForInsertSlowQuery(records...)); err != nil {
+		return err
+	}
+	now := time.Now()
+	if last.retention <= 0 || now.Sub(w.purged[last.accountId]) < slowQueryPurgeInterval {
+		return nil
+	}
+	if err = bh.Exec(ctx, getSqlForDeleteExpiredSlowQuery(now.AddDate(0, 0, -int(last.retention)))); err != nil {
+		return err
+	}
+	w.purged[last.accountId] = now
+	return nil
+}
+
+// slowQueryLogFile is the rotating local file of the slow queries on the CN
+var slowQueryLogFile struct {
+	sync.Mutex
+	filename string
+	writer   io.Writer
+}
+
+func getSlowQueryLogWriter(sv *config.FrontendParameters) io.Writer {
+	if sv == nil || sv.SlowQueryLogFile == "" {
+		return nil
+	}
+	slowQueryLogFile.Lock()
+	defer slowQueryLogFile.Unlock()
+	if slowQueryLogFile.writer == nil || slowQueryLogFile.filename != sv.SlowQueryLogFile {
+		slowQueryLogFile.filename = sv.SlowQueryLogFile
+		slowQueryLogFile.writer = &lumberjack.Logger{
+			Filename:   sv.SlowQueryLogFile,
+			MaxSize:    sv.SlowQueryLogMaxSize,
+			MaxBackups: sv.SlowQueryLogMaxBackups,
+			LocalTime:  true,
+		}
+	}
+	return slowQueryLogFile.writer
+}
+
+// writeSlowQueryLogFile writes the record into the slow query log file in JSON
+func writeSlowQueryLogFile(ses *Session, r *slowQueryRecord) {
+	pu := ses.GetParameterUnit()
+	if pu == nil {
+		return
+	}
+	w := getSlowQueryLogWriter(pu.SV)
+	if w == nil {
+		return
+	}
+	line, err := json.Marshal(r)
+	if err != nil {
+		logErrorf(ses.GetDebugString(), "marshal the slow query failed. error:%v", err)
+		return
+	}
+	if _, err = w.Write(append(line, '\n')); err != nil {
+		logErrorf(ses.GetDebugString(), "write the slow query log file failed. error:%v", err)
+	}
+}
@@ -0,0 +1,281 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/prashantv/gostub"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func newMrsForAccountVariables(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+
+	col1 := &MysqlColumn{}
+	col1.SetName("variable_name")
+	col1.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+
+	col2 := &MysqlColumn{}
+	col2.SetName("variable_value")
+	col2.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+
+	mrs.AddColumn(col1)
+	mrs.AddColumn(col2)
+
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+
+	return mrs
+}
+
+func Test_normalizeStatement(t *testing.T) {
+	convey.Convey("normalize statement", t, func() {
+		stmt1, err := parsers.ParseOne(context.TODO(), dialect.MYSQL, "select a from t where b = 1 and c = 'x' and d is null limit 10", 1)
+		convey.So(err, convey.ShouldBeNil)
+		stmt2, err := parsers.ParseOne(context.TODO(), dialect.MYSQL, "select a from t where b = 2 and c = 'yy' and d is null limit 5", 1)
+		convey.So(err, convey.ShouldBeNil)
+		stmt3, err := parsers.ParseOne(context.TODO(), dialect.MYSQL, "select a from t where b = 2 and e = 'yy' and d is null limit 5", 1)
+		convey.So(err, convey.ShouldBeNil)
+
+		text1, digest1 := normalizeStatement(stmt1)
+		text2, digest2 := normalizeStatement(stmt2)
+		_, digest3 := normalizeStatement(stmt3)
+		convey.So(text1, convey.ShouldEqual, "select a from t where b = ? and c = ? and d is null limit ?")
+		convey.So(text2, convey.ShouldEqual, text1)
+		convey.So(digest2, convey.ShouldEqual, digest1)
+		convey.So(len(digest1), convey.ShouldEqual, 64)
+		convey.So(digest3, convey.ShouldNotEqual, digest1)
+	})
+}
+
+func Test_isSlowQuery(t *testing.T) {
+	convey.Convey("slow query threshold", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
+		ses.sysVars = GSysVariables.CopySysVarsToSession()
+		//the slow query log is off by default
+		convey.So(isSlowQuery(ses, time.Hour), convey.ShouldBeFalse)
+
+		convey.So(ses.SetSessionVar("slow_query_log", "on"), convey.ShouldBeNil)
+		convey.So(ses.SetSessionVar("long_query_time", "0.5"), convey.ShouldBeNil)
+		convey.So(isSlowQuery(ses, time.Second), convey.ShouldBeTrue)
+		convey.So(isSlowQuery(ses, 100*time.Millisecond), convey.ShouldBeFalse)
+
+		convey.So(ses.SetSessionVar("long_query_time", int64(2)), convey.ShouldBeNil)
+		convey.So(isSlowQuery(ses, time.Second), convey.ShouldBeFalse)
+
+		convey.So(ses.SetSessionVar("long_query_time", int64(-1)), convey.ShouldNotBeNil)
+
+		//set long_query_time = 0.5
+		decimal := plan2.MakePlan2Decimal64ExprWithType(types.Decimal64(5), &plan.Type{Id: int32(types.T_decimal64), Scale: 1})
+		convey.So(ses.SetSessionVar("long_query_time", decimal), convey.ShouldBeNil)
+		convey.So(isSlowQuery(ses, 600*time.Millisecond), convey.ShouldBeTrue)
+		convey.So(isSlowQuery(ses, 400*time.Millisecond), convey.ShouldBeFalse)
+	})
+}
+
+func Test_setAccountVariable(t *testing.T) {
+	convey.Convey("set global variable of the account", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+		defer bhStub.Reset()
+
+		ses := newSes(nil, ctrl)
+		ctx := ses.GetRequestContext()
+
+		bh.sql2result["begin;"] = nil
+		bh.sql2result["commit;"] = nil
+		bh.sql2result["rollback;"] = nil
+		bh.sql2result[getSqlForDeleteAccountVariable("long_query_time")] = nil
+		bh.sql2result[getSqlForInsertAccountVariable("long_query_time", "0.25")] = nil
+
+		err := setAccountVariable(ctx, ses, "LONG_QUERY_TIME", "0.25")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(bh.currentSql, convey.ShouldEqual, "commit;")
+		val, err := ses.GetGlobalVar("long_query_time")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(val, convey.ShouldEqual, 0.25)
+
+		//the value of the whole CN does not change
+		_, gVal, _ := ses.GetGlobalSysVars().GetGlobalSysVar("long_query_time")
+		convey.So(gVal, convey.ShouldEqual, float64(10))
+
+		err = setAccountVariable(ctx, ses, "long_query_time", "abc")
+		convey.So(err, convey.ShouldNotBeNil)
+
+		sql := getSqlForInsertAccountVariable("slow_query_log", "on")
+		_, err = parsers.Parse(context.TODO(), dialect.MYSQL, sql, 1)
+		convey.So(err, convey.ShouldBeNil)
+	})
+
+	convey.Convey("load global variables of the account", t, func() {
+		ctx := context.TODO()
+		vars, err := fillAccountVariables(ctx, []ExecResult{newMrsForAccountVariables([][]interface{}{
+			{"slow_query_log", "on"},
+			{"long_query_time", "1.5"},
+			{"slow_query_log_retention", "7"},
+			{"unknown_variable", "1"},
+			{"long_query_time_invalid", "x"},
+		})})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(vars), convey.ShouldEqual, 3)
+		convey.So(vars["slow_query_log"], convey.ShouldEqual, int8(1))
+		convey.So(vars["long_query_time"], convey.ShouldEqual, 1.5)
+		convey.So(vars["slow_query_log_retention"], convey.ShouldEqual, int64(7))
+	})
+}
+
+func Test_slowQueryRecord(t *testing.T) {
+	r := &slowQueryRecord{
+		StatementID:         "a4e3a2f1-0000-0000-0000-000000000000",
+		SessionID:           "b4e3a2f1-0000-0000-0000-000000000000",
+		Account:             "acc1",
+		User:                "u1",
+		Host:                "127.0.0.1:6001",
+		Database:            "db1",
+		StartTime:           time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC),
+		QueryTime:           12.5,
+		LockTime:            0.5,
+		RowsSent:            10,
+		RowsExamined:        100,
+		Status:              success.String(),
+		Statement:           "select * from t where a = 'it''s'",
+		NormalizedStatement: "select * from t where a = ?",
+		Digest:              "d1",
+		ExecPlan:            json.RawMessage(`{"steps":[{"a":"it's \"x\""}]}`),
+	}
+
+	convey.Convey("insert the slow query", t, func() {
+		sql := getSqlForInsertSlowQuery(r)
+		stmts, err := parsers.Parse(context.TODO(), dialect.MYSQL, sql, 1)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(stmts), convey.ShouldEqual, 1)
+		convey.So(sql, convey.ShouldContainSubstring, "'2023-05-01 10:00:00'")
+	})
+
+	convey.Convey("write the slow query log file", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
+		filename := filepath.Join(t.TempDir(), "slow.log")
+		ses.GetParameterUnit().SV.SlowQueryLogFile = filename
+		writeSlowQueryLogFile(ses, r)
+		writeSlowQueryLogFile(ses, r)
+
+		data, err := os.ReadFile(filename)
+		convey.So(err, convey.ShouldBeNil)
+		lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+		convey.So(len(lines), convey.ShouldEqual, 2)
+		got := &slowQueryRecord{}
+		convey.So(json.Unmarshal([]byte(lines[0]), got), convey.ShouldBeNil)
+		convey.So(got.Digest, convey.ShouldEqual, "d1")
+		convey.So(got.QueryTime, convey.ShouldEqual, 12.5)
+		convey.So(string(got.ExecPlan), convey.ShouldEqual, string(r.ExecPlan))
+	})
+}
+
+func Test_slowQueryWriter(t *testing.T) {
+	convey.Convey("save the slow queries in batches", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var accounts []uint32
+		var sqls []string
+		bhStub := gostub.Stub(&NewBackgroundHandler, func(ctx context.Context, _ *Session, _ *mpool.MPool, _ *config.ParameterUnit) BackgroundExec {
+			accounts = append(accounts, ctx.Value(defines.TenantIDKey{}).(uint32))
+			bh := &backgroundExecTest{}
+			bh.init()
+			return &sqlsRecorder{bh, &sqls}
+		})
+		defer bhStub.Reset()
+
+		newRecord := func(accountId uint32, retention int64) *slowQueryRecord {
+			return &slowQueryRecord{
+				StatementID: "a4e3a2f1-0000-0000-0000-000000000000",
+				StartTime:   time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC),
+				Statement:   "select 1",
+				accountId:   accountId,
+				retention:   retention,
+			}
+		}
+		w := newSlowQueryWriter(2)
+		w.flush(nil, nil, []*slowQueryRecord{newRecord(1, 30), newRecord(2, 0), newRecord(1, 30)})
+		convey.So(accounts, convey.ShouldResemble, []uint32{1, 2})
+		convey.So(len(sqls), convey.ShouldEqual, 3)
+		convey.So(strings.Count(sqls[0], "a4e3a2f1"), convey.ShouldEqual, 2)
+		stmts, err := parsers.Parse(context.TODO(), dialect.MYSQL, sqls[0], 1)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(stmts), convey.ShouldEqual, 1)
+		//the expired slow queries of the account 1 are deleted
+		convey.So(sqls[1], convey.ShouldStartWith, "delete from mo_catalog.mo_slow_query_log")
+		_, err = parsers.Parse(context.TODO(), dialect.MYSQL, sqls[1], 1)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(strings.Count(sqls[2], "a4e3a2f1"), convey.ShouldEqual, 1)
+
+		//the expired slow queries are deleted once in the interval
+		sqls = nil
+		w.flush(nil, nil, []*slowQueryRecord{newRecord(1, 30)})
+		convey.So(len(sqls), convey.ShouldEqual, 1)
+
+		//the slow queries are dropped when the queue is full
+		ses := newSes(nil, ctrl)
+		old := globalSlowQueryWriter
+		defer func() {
+			globalSlowQueryWriter = old
+		}()
+		globalSlowQueryWriter = w
+		w.once.Do(func() {})
+		for i := 0; i < 3; i++ {
+			saveSlowQuery(ses, newRecord(1, 30))
+		}
+		convey.So(len(w.records), convey.ShouldEqual, 2)
+	})
+}
+
+// sqlsRecorder records the sqls of all the background executors
+type sqlsRecorder struct {
+	*backgroundExecTest
+	sqls *[]string
+}
+
+func (r *sqlsRecorder) Exec(ctx context.Context, sql string) error {
+	*r.sqls = append(*r.sqls, sql)
+	return nil
+}
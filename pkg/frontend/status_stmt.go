@@ -276,6 +276,42 @@ func (dpe *DropPublicationExecutor) ExecuteImpl(ctx context.Context, ses *Sessio
 	return doDropPublication(ctx, ses, dpe.dp)
 }
 
+type CreatePolicyExecutor struct {
+	*statusStmtExecutor
+	cp *tree.CreatePolicy
+}
+
+func (cpe *CreatePolicyExecutor) ExecuteImpl(ctx context.Context, ses *Session) error {
+	return doCreatePolicy(ctx, ses, cpe.cp)
+}
+
+type DropPolicyExecutor struct {
+	*statusStmtExecutor
+	dp *tree.DropPolicy
+}
+
+func (dpe *DropPolicyExecutor) ExecuteImpl(ctx context.Context, ses *Session) error {
+	return doDropPolicy(ctx, ses, dpe.dp)
+}
+
+type CreateAuditFilterExecutor struct {
+	*statusStmtExecutor
+	caf *tree.CreateAuditFilter
+}
+
+func (cafe *CreateAuditFilterExecutor) ExecuteImpl(ctx context.Context, ses *Session) error {
+	return doCreateAuditFilter(ctx, ses, cafe.caf)
+}
+
+type DropAuditFilterExecutor struct {
+	*statusStmtExecutor
+	daf *tree.DropAuditFilter
+}
+
+func (dafe *DropAuditFilterExecutor) ExecuteImpl(ctx context.Context, ses *Session) error {
+	return doDropAuditFilter(ctx, ses, dafe.daf)
+}
+
 type AlterPublicationExecutor struct {
 	*statusStmtExecutor
 	ap *tree.AlterPublication
@@ -194,6 +194,21 @@ func (mr *MockRelationMockRecorder) AddTableDef(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTableDef", reflect.TypeOf((*MockRelation)(nil).AddTableDef), arg0, arg1)
 }
 
+// ClusteringDepth mocks base method.
+func (m *MockRelation) ClusteringDepth(ctx context.Context) (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClusteringDepth", ctx)
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClusteringDepth indicates an expected call of ClusteringDepth.
+func (mr *MockRelationMockRecorder) ClusteringDepth(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusteringDepth", reflect.TypeOf((*MockRelation)(nil).ClusteringDepth), ctx)
+}
+
 // DelTableDef mocks base method.
 func (m *MockRelation) DelTableDef(arg0 context.Context, arg1 engine.TableDef) error {
 	m.ctrl.T.Helper()
@@ -280,6 +295,21 @@ func (mr *MockRelationMockRecorder) GetTableID(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTableID", reflect.TypeOf((*MockRelation)(nil).GetTableID), arg0)
 }
 
+// MaxCommitTS mocks base method.
+func (m *MockRelation) MaxCommitTS(ctx context.Context) (types.TS, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MaxCommitTS", ctx)
+	ret0, _ := ret[0].(types.TS)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MaxCommitTS indicates an expected call of MaxCommitTS.
+func (mr *MockRelationMockRecorder) MaxCommitTS(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MaxCommitTS", reflect.TypeOf((*MockRelation)(nil).MaxCommitTS), ctx)
+}
+
 // MaxAndMinValues mocks base method.
 func (m *MockRelation) MaxAndMinValues(ctx context.Context) ([][2]any, []uint8, error) {
 	m.ctrl.T.Helper()
@@ -326,6 +356,20 @@ func (mr *MockRelationMockRecorder) Ranges(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ranges", reflect.TypeOf((*MockRelation)(nil).Ranges), arg0, arg1)
 }
 
+// Rename mocks base method.
+func (m *MockRelation) Rename(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rename", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rename indicates an expected call of Rename.
+func (mr *MockRelationMockRecorder) Rename(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rename", reflect.TypeOf((*MockRelation)(nil).Rename), arg0, arg1)
+}
+
 // Rows mocks base method.
 func (m *MockRelation) Rows(ctx context.Context) (int64, error) {
 	m.ctrl.T.Helper()
@@ -429,6 +473,34 @@ func (mr *MockRelationMockRecorder) UpdateConstraint(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConstraint", reflect.TypeOf((*MockRelation)(nil).UpdateConstraint), arg0, arg1)
 }
 
+// UpdateMergePolicy mocks base method.
+func (m *MockRelation) UpdateMergePolicy(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMergePolicy", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMergePolicy indicates an expected call of UpdateMergePolicy.
+func (mr *MockRelationMockRecorder) UpdateMergePolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMergePolicy", reflect.TypeOf((*MockRelation)(nil).UpdateMergePolicy), arg0, arg1)
+}
+
+// UpdatePartition mocks base method.
+func (m *MockRelation) UpdatePartition(arg0 context.Context, arg1 *engine.PartitionDef) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePartition", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePartition indicates an expected call of UpdatePartition.
+func (mr *MockRelationMockRecorder) UpdatePartition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePartition", reflect.TypeOf((*MockRelation)(nil).UpdatePartition), arg0, arg1)
+}
+
 // Write mocks base method.
 func (m *MockRelation) Write(arg0 context.Context, arg1 *batch.Batch) error {
 	m.ctrl.T.Helper()
@@ -671,6 +743,21 @@ func (mr *MockEngineMockRecorder) AllocateIDByKey(ctx, key interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllocateIDByKey", reflect.TypeOf((*MockEngine)(nil).AllocateIDByKey), ctx, key)
 }
 
+// CatalogVersion mocks base method.
+func (m *MockEngine) CatalogVersion() (uint64, timestamp.Timestamp) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CatalogVersion")
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(timestamp.Timestamp)
+	return ret0, ret1
+}
+
+// CatalogVersion indicates an expected call of CatalogVersion.
+func (mr *MockEngineMockRecorder) CatalogVersion() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CatalogVersion", reflect.TypeOf((*MockEngine)(nil).CatalogVersion))
+}
+
 // Commit mocks base method.
 func (m *MockEngine) Commit(ctx context.Context, op client.TxnOperator) error {
 	m.ctrl.T.Helper()
@@ -252,6 +252,9 @@ func (th *TxnHandler) CommitTxn() error {
 			incTransactionErrorsCounter(tenant, metric.SQLTypeCommit)
 		}
 	}()
+	defer func() {
+		countModifiedRows(ses, err == nil)
+	}()
 	txnOp := th.GetTxnOperator()
 	if txnOp == nil {
 		th.SetTxnOperatorInvalid()
@@ -312,6 +315,7 @@ func (th *TxnHandler) RollbackTxn() error {
 	)
 	defer cancel()
 	var err, err2 error
+	defer countModifiedRows(ses, false)
 	defer func() {
 		// metric count
 		tenant := ses.GetTenantName(nil)
@@ -0,0 +1,119 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+)
+
+// upgradeTables are the tables in the database mo_catalog of every account
+// which were added after the first release. The accounts created before a
+// table was added miss it, so it is created in them when the cn starts.
+var upgradeTables = []string{
+	"mo_user_password_policy",
+	"mo_user_password_history",
+	"mo_role_column_privs",
+	"mo_row_policies",
+	"mo_mviews",
+	"mo_column_stats",
+	"mo_account_variables",
+	"mo_slow_query_log",
+}
+
+const getAllAccountIdsSql = `select account_id from mo_catalog.mo_account;`
+
+// getSqlForCreateTableIfNotExists returns the sql creating the predefined
+// table in the database mo_catalog if it does not exist.
+func getSqlForCreateTableIfNotExists(ctx context.Context, table string) (string, error) {
+	prefix := fmt.Sprintf("create table %s(", table)
+	for _, sql := range createSqls {
+		if strings.HasPrefix(sql, prefix) {
+			return fmt.Sprintf("create table if not exists mo_catalog.%s(", table) + sql[len(prefix):], nil
+		}
+	}
+	return "", moerr.NewInternalError(ctx, "there is no predefined table %s", table)
+}
+
+// UpgradeTenantTables creates the upgradeTables missed by the accounts.
+func UpgradeTenantTables(ctx context.Context, aicm *defines.AutoIncrCacheManager) error {
+	pu := config.GetParameterUnit(ctx)
+	sysCtx := context.WithValue(ctx, defines.TenantIDKey{}, uint32(sysAccountID))
+	sysCtx = context.WithValue(sysCtx, defines.UserIDKey{}, uint32(rootID))
+	sysCtx = context.WithValue(sysCtx, defines.RoleIDKey{}, uint32(moAdminRoleID))
+
+	mp, err := mpool.NewMPool("upgrade_tenant_tables", 0, mpool.NoFixed)
+	if err != nil {
+		return err
+	}
+	defer mpool.DeleteMPool(mp)
+	upstream := &Session{connectCtx: sysCtx, autoIncrCacheManager: aicm}
+	bh := NewBackgroundHandler(sysCtx, upstream, mp, pu)
+	defer bh.Close()
+
+	return upgradeTenantTables(sysCtx, bh)
+}
+
+func upgradeTenantTables(ctx context.Context, bh BackgroundExec) error {
+	bh.ClearExecResultSet()
+	err := bh.Exec(ctx, getAllAccountIdsSql)
+	if err != nil {
+		return err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return err
+	}
+	accountIds := []int64{sysAccountID}
+	if execResultArrayHasData(erArray) {
+		for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+			id, err := erArray[0].GetInt64(ctx, i, 0)
+			if err != nil {
+				return err
+			}
+			if id != sysAccountID {
+				accountIds = append(accountIds, id)
+			}
+		}
+	}
+
+	sqls := make([]string, len(upgradeTables))
+	for i, table := range upgradeTables {
+		if sqls[i], err = getSqlForCreateTableIfNotExists(ctx, table); err != nil {
+			return err
+		}
+	}
+	for _, id := range accountIds {
+		tenantCtx := ctx
+		if id != sysAccountID {
+			//the same as the tables created with the account
+			tenantCtx = context.WithValue(tenantCtx, defines.TenantIDKey{}, uint32(id))
+			tenantCtx = context.WithValue(tenantCtx, defines.UserIDKey{}, uint32(dumpID+1))
+			tenantCtx = context.WithValue(tenantCtx, defines.RoleIDKey{}, uint32(accountAdminRoleID))
+		}
+		for _, sql := range sqls {
+			if err = bh.Exec(tenantCtx, sql); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
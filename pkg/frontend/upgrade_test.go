@@ -0,0 +1,64 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func Test_upgradeTenantTables(t *testing.T) {
+	ctx := context.TODO()
+	convey.Convey("create the missed tables in every account", t, func() {
+		bh := &recordingBackgroundExecTest{}
+		bh.init()
+
+		mrs := &MysqlResultSet{}
+		col := &MysqlColumn{}
+		col.SetName("account_id")
+		col.SetColumnType(defines.MYSQL_TYPE_LONG)
+		mrs.AddColumn(col)
+		mrs.AddRow([]interface{}{0})
+		mrs.AddRow([]interface{}{1})
+		bh.sql2result[getAllAccountIdsSql] = mrs
+
+		err := upgradeTenantTables(ctx, bh)
+		convey.So(err, convey.ShouldBeNil)
+
+		creates := 0
+		for _, sql := range bh.sqls {
+			if strings.HasPrefix(sql, "create table if not exists mo_catalog.") {
+				creates++
+			}
+		}
+		convey.So(creates, convey.ShouldEqual, 2*len(upgradeTables))
+	})
+
+	convey.Convey("every upgraded table is predefined", t, func() {
+		for _, table := range upgradeTables {
+			_, ok := predefinedTables[table]
+			convey.So(ok, convey.ShouldBeTrue)
+			sql, err := getSqlForCreateTableIfNotExists(ctx, table)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(sql, convey.ShouldStartWith, "create table if not exists mo_catalog."+table+"(")
+		}
+		_, err := getSqlForCreateTableIfNotExists(ctx, "mo_unknown")
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
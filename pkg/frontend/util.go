@@ -381,6 +381,8 @@ func logStatementStatus(ctx context.Context, ses *Session, stmt tree.Statement,
 	} else {
 		stmtStr = stm.Statement
 	}
+	auditStatement(ctx, ses, stmt, err)
+	recordSlowQuery(ctx, ses, stmt, status, err)
 	logStatementStringStatus(ctx, ses, stmtStr, status, err)
 }
 
@@ -27,6 +27,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 )
 
 var (
@@ -387,6 +388,13 @@ type SystemVariableDoubleType struct {
 	maximum float64
 }
 
+func InitSystemVariableDoubleType(minimum, maximum float64) SystemVariableDoubleType {
+	return SystemVariableDoubleType{
+		minimum: minimum,
+		maximum: maximum,
+	}
+}
+
 func (svdt SystemVariableDoubleType) String() string {
 	return "DOUBLE"
 }
@@ -424,6 +432,23 @@ func (svdt SystemVariableDoubleType) Convert(value interface{}) (interface{}, er
 		return cv1(float64(v))
 	case float64:
 		return cv1(v)
+	case string:
+		x, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil, errorConvertToDoubleFailed
+		}
+		return cv1(x)
+	case *plan.Expr:
+		// decimal literals like 0.5 arrive as constant plan expressions
+		if c := v.GetC(); c != nil && v.Typ != nil {
+			switch d := c.Value.(type) {
+			case *plan.Const_Decimal64Val:
+				return cv1(types.Decimal64ToFloat64(types.Decimal64(d.Decimal64Val.A), v.Typ.Scale))
+			case *plan.Const_Decimal128Val:
+				x := types.Decimal128{B0_63: uint64(d.Decimal128Val.A), B64_127: uint64(d.Decimal128Val.B)}
+				return cv1(types.Decimal128ToFloat64(x, v.Typ.Scale))
+			}
+		}
 	}
 	return nil, errorConvertToDoubleFailed
 }
@@ -1226,6 +1251,22 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemVariableUintType("sql_select_limit", 0, 18446744073709551615),
 		Default:           uint64(18446744073709551615),
 	},
+	"auto_analyze": {
+		Name:              "auto_analyze",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableBoolType("auto_analyze"),
+		Default:           int64(1),
+	},
+	"materialized_view_rewrite": {
+		Name:              "materialized_view_rewrite",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableBoolType("materialized_view_rewrite"),
+		Default:           int64(0),
+	},
 	"save_query_result": {
 		Name:              "save_query_result",
 		Scope:             ScopeBoth,
@@ -1234,6 +1275,30 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemVariableBoolType("save_query_result"),
 		Default:           int64(0),
 	},
+	"slow_query_log": {
+		Name:              "slow_query_log",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableBoolType("slow_query_log"),
+		Default:           int64(0),
+	},
+	"long_query_time": {
+		Name:              "long_query_time",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableDoubleType(0, 31536000),
+		Default:           float64(10),
+	},
+	"slow_query_log_retention": {
+		Name:              "slow_query_log_retention",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableIntType("slow_query_log_retention", 0, 36500, false),
+		Default:           int64(30),
+	},
 	"query_result_timeout": {
 		Name:              "query_result_timeout",
 		Scope:             ScopeBoth,
@@ -1250,6 +1315,123 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemVariableUintType("query_result_maxsize", 0, 18446744073709551615),
 		Default:           uint64(100),
 	},
+	//cache the results of the queries in the memory of the CN
+	"query_result_cache": {
+		Name:              "query_result_cache",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableBoolType("query_result_cache"),
+		Default:           int64(0),
+	},
+	//the memory (MB) of the query result cache of the CN
+	"query_result_cache_size": {
+		Name:              "query_result_cache_size",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableUintType("query_result_cache_size", 0, 18446744073709551615),
+		Default:           uint64(64),
+	},
+	//share the plans of the prepared statements among the sessions of the CN
+	"shared_plan_cache": {
+		Name:              "shared_plan_cache",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableBoolType("shared_plan_cache"),
+		Default:           int64(1),
+	},
+	//the number of the plans in the shared plan cache of the CN
+	"shared_plan_cache_size": {
+		Name:              "shared_plan_cache_size",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableUintType("shared_plan_cache_size", 0, 18446744073709551615),
+		Default:           uint64(1024),
+	},
+	//replace the literals in the queries with params to share their plans
+	"auto_parameterize": {
+		Name:              "auto_parameterize",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableBoolType("auto_parameterize"),
+		Default:           int64(0),
+	},
+	//the number of days that the password of the user can be used. 0 means the password never expires.
+	"default_password_lifetime": {
+		Name:              "default_password_lifetime",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableIntType("default_password_lifetime", 0, 65535, false),
+		Default:           int64(0),
+	},
+	//the number of the recent passwords that can not be reused.
+	"password_history": {
+		Name:              "password_history",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableIntType("password_history", 0, 4294967295, false),
+		Default:           int64(0),
+	},
+	//the number of days that the old password can not be reused.
+	"password_reuse_interval": {
+		Name:              "password_reuse_interval",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableIntType("password_reuse_interval", 0, 4294967295, false),
+		Default:           int64(0),
+	},
+	//whether the new password is checked with the validate_password_* variables.
+	"validate_password": {
+		Name:              "validate_password",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableBoolType("validate_password"),
+		Default:           int64(0),
+	},
+	//the minimum number of characters of the password.
+	"validate_password_length": {
+		Name:              "validate_password_length",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableIntType("validate_password_length", 0, 2147483647, false),
+		Default:           int64(8),
+	},
+	//the minimum number of lowercase and uppercase characters of the password.
+	"validate_password_mixed_case_count": {
+		Name:              "validate_password_mixed_case_count",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableIntType("validate_password_mixed_case_count", 0, 2147483647, false),
+		Default:           int64(1),
+	},
+	//the minimum number of numeric characters of the password.
+	"validate_password_number_count": {
+		Name:              "validate_password_number_count",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableIntType("validate_password_number_count", 0, 2147483647, false),
+		Default:           int64(1),
+	},
+	//the minimum number of nonalphanumeric characters of the password.
+	"validate_password_special_char_count": {
+		Name:              "validate_password_special_char_count",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableIntType("validate_password_special_char_count", 0, 2147483647, false),
+		Default:           int64(1),
+	},
 	//whether DN does primary key uniqueness check against transaction's workspace or not.
 	"mo_pk_check_by_dn": {
 		Name:              "mo_pk_check_by_dn",
@@ -38,6 +38,7 @@ const (
 	IOET_ObjectMeta_V1  = 1
 	IOET_ColumnData_V1  = 1
 	IOET_BloomFilter_V1 = 1
+	IOET_BloomFilter_V2 = 2
 	IOET_ZoneMap_V1     = 1
 
 	IOET_ObjectMeta_CurrVer  = IOET_ObjectMeta_V1
@@ -50,6 +51,7 @@ func init() {
 	RegisterIOEnrtyCodec(IOEntryHeader{IOET_ObjMeta, IOET_ObjectMeta_V1}, nil, nil)
 	RegisterIOEnrtyCodec(IOEntryHeader{IOET_ColData, IOET_ColumnData_V1}, EncodeColumnDataV1, DecodeColumnDataV1)
 	RegisterIOEnrtyCodec(IOEntryHeader{IOET_BF, IOET_BloomFilter_V1}, nil, DecodeBloomFilterV1)
+	RegisterIOEnrtyCodec(IOEntryHeader{IOET_BF, IOET_BloomFilter_V2}, nil, DecodeBloomFilterV2)
 	RegisterIOEnrtyCodec(IOEntryHeader{IOET_ZM, IOET_ZoneMap_V1}, nil, nil)
 }
 
@@ -83,3 +85,24 @@ func DecodeBloomFilterV1(buf []byte) (ioe any, err error) {
 	}
 	return indexes, nil
 }
+
+// DecodeBloomFilterV2 decodes the bloom filters of the blocks built on several
+// columns, every filter of a block is an index.ColumnFilters.
+func DecodeBloomFilterV2(buf []byte) (ioe any, err error) {
+	indexes := make([]StaticFilter, 0)
+	bf := BloomFilter(buf)
+	count := bf.BlockCount()
+	for i := uint32(0); i < count; i++ {
+		buf := bf.GetBloomFilter(i)
+		if len(buf) == 0 {
+			indexes = append(indexes, nil)
+			continue
+		}
+		filters, err := index.DecodeColumnFilters(buf)
+		if err != nil {
+			return nil, err
+		}
+		indexes = append(indexes, filters)
+	}
+	return indexes, nil
+}
@@ -39,6 +39,7 @@ type objectWriterV1 struct {
 	lastId      uint32
 	name        ObjectName
 	compressBuf []byte
+	bfVersion   uint16
 }
 
 type blockData struct {
@@ -112,6 +113,15 @@ func (w *objectWriterV1) WriteBF(blkIdx, colIdx int, buf []byte) (err error) {
 	return
 }
 
+// WriteColumnsBF sets the bloom filters of the block on several columns, which
+// are encoded by index.ColumnFilters. Once it is called, the bloom filter area
+// of the object is written in V2, so it must be used for all the blocks.
+func (w *objectWriterV1) WriteColumnsBF(blkIdx int, buf []byte) (err error) {
+	w.blocks[blkIdx].bloomFilter = buf
+	w.bfVersion = IOET_BloomFilter_V2
+	return
+}
+
 func (w *objectWriterV1) WriteObjectMeta(ctx context.Context, totalrow uint32, metas []ColumnMeta) {
 	w.totalRow = totalrow
 	w.colmeta = metas
@@ -173,6 +183,9 @@ func (w *objectWriterV1) prepareBlockMeta(offset uint32) uint32 {
 func (w *objectWriterV1) prepareBloomFilter(blockCount uint32, offset uint32) ([]byte, Extent, error) {
 	buf := new(bytes.Buffer)
 	h := IOEntryHeader{IOET_BF, IOET_BloomFilter_CurrVer}
+	if w.bfVersion != 0 {
+		h.Version = w.bfVersion
+	}
 	buf.Write(EncodeIOEntryHeader(&h))
 	bloomFilterStart := uint32(0)
 	bloomFilterIndex := BuildBlockIndex(blockCount)
@@ -28,6 +28,28 @@ func NewUpdateConstraintReq(did, tid uint64, cstr string) *AlterTableReq {
 	}
 }
 
+func NewUpdatePartitionReq(did, tid uint64, partition string) *AlterTableReq {
+	return &AlterTableReq{
+		DbId:    did,
+		TableId: tid,
+		Kind:    AlterKind_UpdatePartition,
+		Operation: &AlterTableReq_UpdatePartition{
+			&AlterTablePartition{Partition: partition},
+		},
+	}
+}
+
+func NewUpdateMergePolicyReq(did, tid uint64, mergePolicy string) *AlterTableReq {
+	return &AlterTableReq{
+		DbId:    did,
+		TableId: tid,
+		Kind:    AlterKind_UpdateMergePolicy,
+		Operation: &AlterTableReq_UpdateMerge{
+			&AlterTableMergePolicy{MergePolicy: mergePolicy},
+		},
+	}
+}
+
 func NewUpdateCommentReq(did, tid uint64, comment string) *AlterTableReq {
 	return &AlterTableReq{
 		TableId: did,
@@ -41,8 +63,8 @@ func NewUpdateCommentReq(did, tid uint64, comment string) *AlterTableReq {
 
 func NewRenameTableReq(did, tid uint64, old, new string) *AlterTableReq {
 	return &AlterTableReq{
-		TableId: did,
-		DbId:    tid,
+		TableId: tid,
+		DbId:    did,
 		Kind:    AlterKind_RenameTable,
 		Operation: &AlterTableReq_RenameTable{
 			&AlterTableRenameTable{OldName: old, NewName: new},
@@ -60,12 +60,14 @@ func (OpCode) EnumDescriptor() ([]byte, []int) {
 type AlterKind int32
 
 const (
-	AlterKind_Invalid          AlterKind = 0
-	AlterKind_AddColumn        AlterKind = 1
-	AlterKind_DropColumn       AlterKind = 2
-	AlterKind_RenameTable      AlterKind = 3
-	AlterKind_UpdateComment    AlterKind = 4
-	AlterKind_UpdateConstraint AlterKind = 5
+	AlterKind_Invalid           AlterKind = 0
+	AlterKind_AddColumn         AlterKind = 1
+	AlterKind_DropColumn        AlterKind = 2
+	AlterKind_RenameTable       AlterKind = 3
+	AlterKind_UpdateComment     AlterKind = 4
+	AlterKind_UpdateConstraint  AlterKind = 5
+	AlterKind_UpdatePartition   AlterKind = 6
+	AlterKind_UpdateMergePolicy AlterKind = 7
 )
 
 var AlterKind_name = map[int32]string{
@@ -75,15 +77,19 @@ var AlterKind_name = map[int32]string{
 	3: "RenameTable",
 	4: "UpdateComment",
 	5: "UpdateConstraint",
+	6: "UpdatePartition",
+	7: "UpdateMergePolicy",
 }
 
 var AlterKind_value = map[string]int32{
-	"Invalid":          0,
-	"AddColumn":        1,
-	"DropColumn":       2,
-	"RenameTable":      3,
-	"UpdateComment":    4,
-	"UpdateConstraint": 5,
+	"Invalid":           0,
+	"AddColumn":         1,
+	"DropColumn":        2,
+	"RenameTable":       3,
+	"UpdateComment":     4,
+	"UpdateConstraint":  5,
+	"UpdatePartition":   6,
+	"UpdateMergePolicy": 7,
 }
 
 func (x AlterKind) String() string {
@@ -912,6 +918,100 @@ func (m *AlterTableComment) GetComment() string {
 	return ""
 }
 
+type AlterTablePartition struct {
+	Partition            string   `protobuf:"bytes,1,opt,name=partition,proto3" json:"partition,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterTablePartition) Reset()         { *m = AlterTablePartition{} }
+func (m *AlterTablePartition) String() string { return proto.CompactTextString(m) }
+func (*AlterTablePartition) ProtoMessage()    {}
+func (*AlterTablePartition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{12}
+}
+func (m *AlterTablePartition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTablePartition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTablePartition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTablePartition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTablePartition.Merge(m, src)
+}
+func (m *AlterTablePartition) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTablePartition) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTablePartition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTablePartition proto.InternalMessageInfo
+
+func (m *AlterTablePartition) GetPartition() string {
+	if m != nil {
+		return m.Partition
+	}
+	return ""
+}
+
+type AlterTableMergePolicy struct {
+	MergePolicy          string   `protobuf:"bytes,1,opt,name=merge_policy,json=mergePolicy,proto3" json:"merge_policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterTableMergePolicy) Reset()         { *m = AlterTableMergePolicy{} }
+func (m *AlterTableMergePolicy) String() string { return proto.CompactTextString(m) }
+func (*AlterTableMergePolicy) ProtoMessage()    {}
+func (*AlterTableMergePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{13}
+}
+func (m *AlterTableMergePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableMergePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableMergePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableMergePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableMergePolicy.Merge(m, src)
+}
+func (m *AlterTableMergePolicy) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableMergePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableMergePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableMergePolicy proto.InternalMessageInfo
+
+func (m *AlterTableMergePolicy) GetMergePolicy() string {
+	if m != nil {
+		return m.MergePolicy
+	}
+	return ""
+}
+
 type AlterTableRenameTable struct {
 	OldName              string   `protobuf:"bytes,1,opt,name=old_name,json=oldName,proto3" json:"old_name,omitempty"`
 	NewName              string   `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
@@ -924,7 +1024,7 @@ func (m *AlterTableRenameTable) Reset()         { *m = AlterTableRenameTable{} }
 func (m *AlterTableRenameTable) String() string { return proto.CompactTextString(m) }
 func (*AlterTableRenameTable) ProtoMessage()    {}
 func (*AlterTableRenameTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{12}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{14}
 }
 func (m *AlterTableRenameTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -979,7 +1079,7 @@ func (m *AlterTableAddColumn) Reset()         { *m = AlterTableAddColumn{} }
 func (m *AlterTableAddColumn) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAddColumn) ProtoMessage()    {}
 func (*AlterTableAddColumn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{13}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{15}
 }
 func (m *AlterTableAddColumn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1034,7 +1134,7 @@ func (m *AlterTableDropColumn) Reset()         { *m = AlterTableDropColumn{} }
 func (m *AlterTableDropColumn) String() string { return proto.CompactTextString(m) }
 func (*AlterTableDropColumn) ProtoMessage()    {}
 func (*AlterTableDropColumn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{14}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{16}
 }
 func (m *AlterTableDropColumn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1087,6 +1187,8 @@ type AlterTableReq struct {
 	//	*AlterTableReq_RenameTable
 	//	*AlterTableReq_UpdateComment
 	//	*AlterTableReq_UpdateCstr
+	//	*AlterTableReq_UpdatePartition
+	//	*AlterTableReq_UpdateMerge
 	Operation            isAlterTableReq_Operation `protobuf_oneof:"operation"`
 	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
 	XXX_unrecognized     []byte                    `json:"-"`
@@ -1097,7 +1199,7 @@ func (m *AlterTableReq) Reset()         { *m = AlterTableReq{} }
 func (m *AlterTableReq) String() string { return proto.CompactTextString(m) }
 func (*AlterTableReq) ProtoMessage()    {}
 func (*AlterTableReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{15}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{17}
 }
 func (m *AlterTableReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1147,12 +1249,20 @@ type AlterTableReq_UpdateComment struct {
 type AlterTableReq_UpdateCstr struct {
 	UpdateCstr *AlterTableConstraint `protobuf:"bytes,8,opt,name=update_cstr,json=updateCstr,proto3,oneof" json:"update_cstr,omitempty"`
 }
+type AlterTableReq_UpdatePartition struct {
+	UpdatePartition *AlterTablePartition `protobuf:"bytes,9,opt,name=update_partition,json=updatePartition,proto3,oneof" json:"update_partition,omitempty"`
+}
+type AlterTableReq_UpdateMerge struct {
+	UpdateMerge *AlterTableMergePolicy `protobuf:"bytes,10,opt,name=update_merge,json=updateMerge,proto3,oneof" json:"update_merge,omitempty"`
+}
 
-func (*AlterTableReq_AddColumn) isAlterTableReq_Operation()     {}
-func (*AlterTableReq_DropColumn) isAlterTableReq_Operation()    {}
-func (*AlterTableReq_RenameTable) isAlterTableReq_Operation()   {}
-func (*AlterTableReq_UpdateComment) isAlterTableReq_Operation() {}
-func (*AlterTableReq_UpdateCstr) isAlterTableReq_Operation()    {}
+func (*AlterTableReq_AddColumn) isAlterTableReq_Operation()       {}
+func (*AlterTableReq_DropColumn) isAlterTableReq_Operation()      {}
+func (*AlterTableReq_RenameTable) isAlterTableReq_Operation()     {}
+func (*AlterTableReq_UpdateComment) isAlterTableReq_Operation()   {}
+func (*AlterTableReq_UpdateCstr) isAlterTableReq_Operation()      {}
+func (*AlterTableReq_UpdatePartition) isAlterTableReq_Operation() {}
+func (*AlterTableReq_UpdateMerge) isAlterTableReq_Operation()     {}
 
 func (m *AlterTableReq) GetOperation() isAlterTableReq_Operation {
 	if m != nil {
@@ -1217,6 +1327,20 @@ func (m *AlterTableReq) GetUpdateCstr() *AlterTableConstraint {
 	return nil
 }
 
+func (m *AlterTableReq) GetUpdatePartition() *AlterTablePartition {
+	if x, ok := m.GetOperation().(*AlterTableReq_UpdatePartition); ok {
+		return x.UpdatePartition
+	}
+	return nil
+}
+
+func (m *AlterTableReq) GetUpdateMerge() *AlterTableMergePolicy {
+	if x, ok := m.GetOperation().(*AlterTableReq_UpdateMerge); ok {
+		return x.UpdateMerge
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*AlterTableReq) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1225,6 +1349,8 @@ func (*AlterTableReq) XXX_OneofWrappers() []interface{} {
 		(*AlterTableReq_RenameTable)(nil),
 		(*AlterTableReq_UpdateComment)(nil),
 		(*AlterTableReq_UpdateCstr)(nil),
+		(*AlterTableReq_UpdatePartition)(nil),
+		(*AlterTableReq_UpdateMerge)(nil),
 	}
 }
 
@@ -1240,7 +1366,7 @@ func (m *Int64Map) Reset()         { *m = Int64Map{} }
 func (m *Int64Map) String() string { return proto.CompactTextString(m) }
 func (*Int64Map) ProtoMessage()    {}
 func (*Int64Map) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{16}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{18}
 }
 func (m *Int64Map) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1292,6 +1418,8 @@ func init() {
 	proto.RegisterType((*MetadataCkp)(nil), "api.MetadataCkp")
 	proto.RegisterType((*AlterTableConstraint)(nil), "api.AlterTableConstraint")
 	proto.RegisterType((*AlterTableComment)(nil), "api.AlterTableComment")
+	proto.RegisterType((*AlterTablePartition)(nil), "api.AlterTablePartition")
+	proto.RegisterType((*AlterTableMergePolicy)(nil), "api.AlterTableMergePolicy")
 	proto.RegisterType((*AlterTableRenameTable)(nil), "api.AlterTableRenameTable")
 	proto.RegisterType((*AlterTableAddColumn)(nil), "api.AlterTableAddColumn")
 	proto.RegisterType((*AlterTableDropColumn)(nil), "api.AlterTableDropColumn")
@@ -1303,86 +1431,92 @@ func init() {
 func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
 
 var fileDescriptor_00212fb1f9d3bf1c = []byte{
-	// 1253 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x5f, 0x6f, 0x1b, 0x45,
-	0x10, 0xf7, 0xf9, 0xbf, 0xe7, 0x6c, 0xe7, 0xb2, 0x0d, 0xc8, 0x0d, 0x90, 0x9a, 0x2b, 0x02, 0x53,
-	0x68, 0x22, 0xa5, 0x15, 0x2a, 0xa8, 0x6a, 0xd5, 0x38, 0x88, 0x58, 0x34, 0x4d, 0x75, 0xa4, 0xad,
-	0x54, 0x21, 0x9d, 0xd6, 0xb7, 0x5b, 0x67, 0xe5, 0xbb, 0xbd, 0xcd, 0xdd, 0x3a, 0x8d, 0xdf, 0xe1,
-	0x0b, 0xf0, 0x09, 0x78, 0x45, 0x7c, 0x11, 0x5e, 0x90, 0xf8, 0x08, 0xa8, 0xbc, 0x00, 0x9f, 0x02,
-	0xed, 0xdc, 0x9d, 0xed, 0x04, 0xe8, 0x6b, 0x5f, 0xac, 0x99, 0xdf, 0xcc, 0xac, 0xe7, 0xcf, 0xef,
-	0x76, 0x16, 0x5a, 0x54, 0x89, 0x6d, 0x95, 0xc4, 0x3a, 0x26, 0x15, 0xaa, 0xc4, 0xe6, 0xcd, 0x89,
-	0xd0, 0x27, 0xb3, 0xf1, 0x76, 0x10, 0x47, 0x3b, 0x93, 0x78, 0x12, 0xef, 0xa0, 0x6d, 0x3c, 0x7b,
-	0x81, 0x1a, 0x2a, 0x28, 0x65, 0x31, 0x9b, 0x6b, 0x5a, 0x44, 0x3c, 0xd5, 0x34, 0x52, 0x39, 0x00,
-	0x2a, 0xa4, 0x32, 0x93, 0xdd, 0x9f, 0x2d, 0xa8, 0x3f, 0xe5, 0x81, 0x8e, 0x13, 0x42, 0xa0, 0xca,
-	0xa8, 0xa6, 0x3d, 0xab, 0x6f, 0x0d, 0xda, 0x1e, 0xca, 0x64, 0x0b, 0xaa, 0x7a, 0xae, 0x78, 0xaf,
-	0xdc, 0xb7, 0x06, 0xf6, 0x2e, 0x6c, 0x63, 0xe4, 0xf1, 0x5c, 0x71, 0x0f, 0x71, 0xb2, 0x09, 0x4d,
-	0x39, 0x0b, 0x43, 0x3a, 0x0e, 0x79, 0xaf, 0xd2, 0xb7, 0x06, 0x4d, 0x6f, 0xa1, 0x13, 0x07, 0x2a,
-	0x32, 0x55, 0xbd, 0x2a, 0x1e, 0x67, 0x44, 0x72, 0x15, 0x9a, 0x22, 0xf5, 0x83, 0x58, 0xa6, 0xba,
-	0x57, 0x43, 0xef, 0x86, 0x48, 0x87, 0x46, 0x35, 0xce, 0x21, 0x97, 0xbd, 0x7a, 0xdf, 0x1a, 0x74,
-	0x3c, 0x23, 0x9a, 0x74, 0x68, 0xc2, 0x69, 0xaf, 0x91, 0xa5, 0x63, 0x64, 0xf7, 0x1e, 0xd4, 0xf6,
-	0xa8, 0x0e, 0x4e, 0xc8, 0x06, 0xd4, 0xa8, 0xd6, 0x49, 0xda, 0xb3, 0xfa, 0x95, 0x41, 0xcb, 0xcb,
-	0x14, 0x72, 0x0d, 0xaa, 0x67, 0x3c, 0x48, 0x7b, 0xe5, 0x7e, 0x65, 0x60, 0xef, 0xda, 0xdb, 0xa6,
-	0x6f, 0x59, 0x71, 0x1e, 0x1a, 0xdc, 0xa7, 0xd0, 0x38, 0x36, 0xb9, 0x8d, 0xf6, 0xc9, 0x15, 0xa8,
-	0xb1, 0xb1, 0x2f, 0x18, 0x96, 0x5b, 0xf5, 0xaa, 0x6c, 0x3c, 0x62, 0x06, 0xd4, 0x08, 0x96, 0x33,
-	0x50, 0x1b, 0xf0, 0x7d, 0x68, 0x2b, 0x9a, 0x68, 0xa1, 0x45, 0x2c, 0x8d, 0xad, 0x82, 0x36, 0x7b,
-	0x81, 0x8d, 0x98, 0xfb, 0x83, 0x05, 0xdd, 0x6f, 0xe6, 0x32, 0x78, 0x18, 0x4f, 0x8e, 0xa9, 0x08,
-	0x3d, 0x7e, 0x4a, 0x6e, 0x42, 0x23, 0x90, 0xfe, 0x09, 0x3d, 0xe3, 0xf8, 0x0f, 0xf6, 0xee, 0xc6,
-	0xf6, 0x72, 0x0e, 0xc7, 0x85, 0xe4, 0xd5, 0x03, 0x79, 0x40, 0xcf, 0x78, 0xee, 0xfe, 0x92, 0x4a,
-	0x9d, 0xf7, 0xfa, 0x7f, 0xdd, 0x9f, 0x51, 0xa9, 0x89, 0x0b, 0x35, 0xbd, 0x68, 0xba, 0xbd, 0xdb,
-	0xc6, 0x52, 0xf3, 0xd2, 0xbc, 0xcc, 0xe4, 0x7e, 0x0b, 0x6b, 0x17, 0x72, 0x4a, 0x95, 0x29, 0x25,
-	0x98, 0x2a, 0x3f, 0x8c, 0x03, 0x6a, 0x32, 0xc7, 0xcc, 0x5a, 0x9e, 0x1d, 0x4c, 0xd5, 0xc3, 0x1c,
-	0x22, 0x1f, 0x42, 0x33, 0x88, 0xa3, 0x88, 0x4a, 0x56, 0xf4, 0x11, 0xf0, 0xf0, 0x2f, 0xa5, 0x4e,
-	0xe6, 0xde, 0xc2, 0xe6, 0xde, 0x83, 0xf5, 0xc7, 0x09, 0x37, 0xaa, 0xd0, 0xcf, 0x12, 0xa1, 0xf9,
-	0x30, 0x62, 0xe4, 0x63, 0x00, 0x6e, 0xfc, 0xfc, 0x50, 0xa4, 0x1a, 0x67, 0x73, 0x31, 0xbc, 0x85,
-	0xd6, 0x87, 0x22, 0xd5, 0xee, 0xaf, 0x65, 0xa8, 0x21, 0x48, 0x6e, 0x15, 0x41, 0xc8, 0x34, 0x93,
-	0x52, 0x77, 0x77, 0x63, 0x19, 0x94, 0xfd, 0x22, 0xe7, 0xb2, 0x70, 0x23, 0x1a, 0x2a, 0x61, 0x95,
-	0xcb, 0x61, 0x35, 0x50, 0x1f, 0x31, 0x72, 0x0d, 0x6c, 0xc3, 0xdd, 0x31, 0x4d, 0xf9, 0x72, 0x5c,
-	0x50, 0x40, 0x23, 0x46, 0xde, 0x03, 0xc8, 0x62, 0x25, 0x8d, 0x38, 0xf2, 0xb3, 0xe5, 0xb5, 0x10,
-	0x79, 0x44, 0x23, 0x4e, 0xae, 0x43, 0x67, 0x11, 0x8f, 0x1e, 0x35, 0xf4, 0x68, 0x17, 0x20, 0x3a,
-	0xbd, 0x03, 0xad, 0x17, 0xa2, 0x38, 0xa2, 0x8e, 0x0e, 0x4d, 0x03, 0xa0, 0xf1, 0x5d, 0xa8, 0x8c,
-	0xa9, 0x46, 0xe6, 0x16, 0xf5, 0x23, 0x6d, 0x3d, 0x03, 0x93, 0xeb, 0xd0, 0x55, 0x53, 0x3f, 0x38,
-	0xe1, 0xc1, 0xd4, 0x1f, 0xcf, 0x7d, 0x26, 0x7b, 0xcd, 0xbe, 0x35, 0xa8, 0x79, 0xb6, 0x9a, 0x0e,
-	0x0d, 0xb8, 0x37, 0xdf, 0x97, 0xee, 0x0e, 0xb4, 0x16, 0x75, 0x13, 0x80, 0xfa, 0x48, 0xa6, 0x3c,
-	0xd1, 0x4e, 0xc9, 0xc8, 0xfb, 0x3c, 0xe4, 0x9a, 0x3b, 0x96, 0x91, 0x9f, 0x28, 0x46, 0x35, 0x77,
-	0xca, 0xee, 0x77, 0x16, 0x00, 0x86, 0xab, 0x58, 0x48, 0x4d, 0x3e, 0x81, 0x7a, 0x24, 0xa4, 0xaf,
-	0xd3, 0xd7, 0xb2, 0xaf, 0x16, 0x09, 0x79, 0x9c, 0xa2, 0x33, 0x3d, 0x37, 0xce, 0xe5, 0xd7, 0x3a,
-	0xd3, 0xf3, 0xe3, 0xb4, 0x28, 0xae, 0xf2, 0x9f, 0xc5, 0x65, 0x69, 0x50, 0x4d, 0xc3, 0x78, 0x32,
-	0x9c, 0xaa, 0x37, 0x96, 0xc6, 0xf7, 0x16, 0xd8, 0x87, 0x5c, 0x53, 0x33, 0xb3, 0x37, 0x99, 0xc7,
-	0x1d, 0xd8, 0x78, 0x10, 0x6a, 0x9e, 0xe0, 0xa7, 0x89, 0x37, 0x5d, 0x42, 0xcd, 0x78, 0xfa, 0x60,
-	0x07, 0x0b, 0x2d, 0xcd, 0xaf, 0xdc, 0x55, 0xc8, 0xbd, 0x09, 0xeb, 0xab, 0x91, 0x51, 0xc4, 0xa5,
-	0x26, 0x3d, 0x68, 0x04, 0x99, 0x98, 0x7f, 0xba, 0x85, 0xea, 0x1e, 0xc2, 0x5b, 0x4b, 0x77, 0x8f,
-	0x1b, 0x5a, 0xa2, 0x68, 0x3e, 0x94, 0x38, 0x64, 0x19, 0x4f, 0xf3, 0x98, 0x38, 0x64, 0x48, 0xd3,
-	0xab, 0xd0, 0x94, 0xfc, 0x65, 0x66, 0x2a, 0x67, 0x26, 0xc9, 0x5f, 0x1a, 0x93, 0xcb, 0xe0, 0xca,
-	0xf2, 0xb8, 0x07, 0x8c, 0x0d, 0xe3, 0x70, 0x16, 0x49, 0xf2, 0x01, 0xd4, 0x03, 0x94, 0xf2, 0x36,
-	0xb6, 0xb3, 0x85, 0x30, 0x8c, 0xc3, 0x7d, 0xfe, 0xc2, 0xcb, 0x6d, 0xe4, 0x23, 0x58, 0x13, 0x48,
-	0x57, 0x5f, 0xc5, 0x29, 0x5e, 0x91, 0x78, 0x7c, 0xcd, 0xeb, 0x66, 0xf0, 0xe3, 0x1c, 0x75, 0x9f,
-	0xaf, 0x76, 0x67, 0x3f, 0x89, 0x55, 0xfe, 0x37, 0xd7, 0xc0, 0x0e, 0xe3, 0x89, 0x08, 0x68, 0xe8,
-	0x0b, 0x76, 0x8e, 0xff, 0xd5, 0xf1, 0x20, 0x87, 0x46, 0xec, 0xdc, 0xdc, 0x63, 0x29, 0x3f, 0x9d,
-	0x71, 0x19, 0x70, 0x5f, 0xce, 0x22, 0x3c, 0xbe, 0xe3, 0xd9, 0x05, 0xf6, 0x68, 0x16, 0xb9, 0x3f,
-	0x55, 0xa0, 0xb3, 0xda, 0x91, 0xd3, 0x0b, 0x57, 0x86, 0x75, 0xf1, 0xca, 0x58, 0x2c, 0x83, 0xf2,
-	0xca, 0x32, 0x70, 0xa1, 0x3a, 0x15, 0x32, 0xbb, 0x40, 0xba, 0xbb, 0x5d, 0x1c, 0x2d, 0x9e, 0xf8,
-	0xb5, 0x90, 0xcc, 0x43, 0x1b, 0xf9, 0x1c, 0x80, 0x32, 0xe6, 0xe7, 0x4d, 0xa9, 0x62, 0x53, 0x7a,
-	0x4b, 0xcf, 0x8b, 0xed, 0x3b, 0x28, 0x79, 0x2d, 0xba, 0xe8, 0xe5, 0x5d, 0xb0, 0x59, 0x12, 0xab,
-	0x22, 0xb6, 0x86, 0xb1, 0x57, 0x2f, 0xc5, 0x2e, 0x9b, 0x72, 0x50, 0xf2, 0x80, 0x2d, 0x5b, 0x74,
-	0x1f, 0xda, 0x09, 0x4e, 0xd9, 0xcf, 0xf6, 0x40, 0x1d, 0xc3, 0x37, 0x2f, 0x85, 0xaf, 0x10, 0xe1,
-	0xa0, 0xe4, 0xd9, 0xc9, 0x0a, 0x2f, 0xee, 0x43, 0x77, 0x86, 0x77, 0x87, 0x5f, 0x30, 0x2a, 0xbb,
-	0xae, 0xde, 0xbe, 0x74, 0x44, 0x4e, 0xbd, 0x83, 0x92, 0xd7, 0xc9, 0xfc, 0x0b, 0x2e, 0xde, 0x05,
-	0xbb, 0x38, 0x20, 0xd5, 0x09, 0xde, 0x61, 0xff, 0xce, 0x7f, 0x49, 0x79, 0x93, 0x7f, 0x7e, 0x40,
-	0xaa, 0x93, 0x3d, 0x1b, 0x5a, 0xb1, 0xe2, 0x09, 0xee, 0x1c, 0x97, 0x41, 0x73, 0x24, 0xf5, 0x67,
-	0xb7, 0x0f, 0xa9, 0x22, 0x2e, 0x58, 0x51, 0xbe, 0x39, 0xb2, 0x25, 0x50, 0x58, 0xb6, 0x0f, 0xb3,
-	0x1d, 0x62, 0x45, 0x9b, 0xb7, 0xa1, 0x9e, 0x29, 0xe6, 0xd9, 0x30, 0xe5, 0x73, 0x1c, 0x67, 0xc5,
-	0x33, 0xa2, 0x79, 0x19, 0x9c, 0xd1, 0x70, 0x96, 0x31, 0xba, 0xe2, 0x65, 0xca, 0x17, 0xe5, 0x3b,
-	0xd6, 0x8d, 0x7d, 0xa8, 0x1f, 0xa9, 0x61, 0xcc, 0x38, 0x69, 0x40, 0xe5, 0x51, 0xac, 0x9c, 0x12,
-	0x59, 0x87, 0xf6, 0x91, 0xfa, 0x8a, 0xeb, 0x7c, 0x47, 0x3a, 0x7f, 0x36, 0x48, 0x1b, 0x1a, 0x47,
-	0x0a, 0x17, 0x9a, 0xf3, 0x57, 0x83, 0x38, 0x60, 0x1f, 0xa9, 0xc7, 0x09, 0x16, 0x2d, 0xb4, 0xf3,
-	0x77, 0xe3, 0xc6, 0x29, 0xb4, 0x16, 0x24, 0x20, 0x36, 0x34, 0x46, 0xf2, 0x8c, 0x86, 0x82, 0x39,
-	0x25, 0xd2, 0x81, 0xd6, 0x62, 0xd4, 0x8e, 0x45, 0xba, 0x00, 0xcb, 0xe9, 0x39, 0x65, 0xb2, 0x06,
-	0xf6, 0xca, 0x38, 0x9c, 0x0a, 0x59, 0x87, 0xce, 0x93, 0xd5, 0x8e, 0x3a, 0x55, 0xb2, 0x01, 0x4e,
-	0x01, 0x15, 0x7d, 0x73, 0x6a, 0x7b, 0xf7, 0x7e, 0x79, 0xb5, 0x65, 0xfd, 0xf6, 0x6a, 0xcb, 0xfa,
-	0xfd, 0xd5, 0x56, 0xe9, 0xc7, 0x3f, 0xb6, 0xac, 0xe7, 0x9f, 0xae, 0xbc, 0x00, 0x23, 0xaa, 0x13,
-	0x71, 0x1e, 0x27, 0x62, 0x22, 0x64, 0xa1, 0x48, 0xbe, 0xa3, 0xa6, 0x93, 0x1d, 0x35, 0xde, 0xa1,
-	0x4a, 0x8c, 0xeb, 0xf8, 0xd4, 0xbb, 0xf5, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x3e, 0xf3, 0xdd,
-	0x05, 0x48, 0x0a, 0x00, 0x00,
+	// 1349 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6e, 0xdb, 0x46,
+	0x13, 0x17, 0xf5, 0x5f, 0x43, 0x49, 0xa6, 0x37, 0xce, 0x07, 0xc6, 0x5f, 0x3e, 0x47, 0x1f, 0x53,
+	0xb4, 0x6e, 0xda, 0xd8, 0x80, 0x13, 0x14, 0x69, 0x50, 0x24, 0x88, 0xed, 0xa0, 0x16, 0x1a, 0xc7,
+	0x06, 0x6b, 0x27, 0x40, 0x50, 0x80, 0x58, 0x91, 0x1b, 0x79, 0x21, 0x72, 0xb9, 0x21, 0x57, 0x8e,
+	0x7d, 0x6f, 0x5f, 0xa0, 0xf7, 0x02, 0xbd, 0xf7, 0xd4, 0xb7, 0xe8, 0xa5, 0x40, 0x1f, 0xa1, 0x48,
+	0x2f, 0x6d, 0x9f, 0xa2, 0xd8, 0x59, 0x52, 0x92, 0x53, 0x23, 0xd7, 0x5c, 0x84, 0x99, 0xdf, 0xcc,
+	0x6f, 0x35, 0x3b, 0xff, 0x96, 0xd0, 0xa1, 0x92, 0x6f, 0xc8, 0x2c, 0x55, 0x29, 0xa9, 0x51, 0xc9,
+	0x57, 0x6f, 0x8f, 0xb9, 0x3a, 0x99, 0x8e, 0x36, 0xc2, 0x34, 0xd9, 0x1c, 0xa7, 0xe3, 0x74, 0x13,
+	0x6d, 0xa3, 0xe9, 0x4b, 0xd4, 0x50, 0x41, 0xc9, 0x70, 0x56, 0x97, 0x14, 0x4f, 0x58, 0xae, 0x68,
+	0x22, 0x0b, 0x00, 0x64, 0x4c, 0x85, 0x91, 0xbd, 0x9f, 0x2c, 0x68, 0x3e, 0x63, 0xa1, 0x4a, 0x33,
+	0x42, 0xa0, 0x1e, 0x51, 0x45, 0x5d, 0x6b, 0x60, 0xad, 0x77, 0x7d, 0x94, 0xc9, 0x1a, 0xd4, 0xd5,
+	0xb9, 0x64, 0x6e, 0x75, 0x60, 0xad, 0xdb, 0x5b, 0xb0, 0x81, 0xcc, 0xa3, 0x73, 0xc9, 0x7c, 0xc4,
+	0xc9, 0x2a, 0xb4, 0xc5, 0x34, 0x8e, 0xe9, 0x28, 0x66, 0x6e, 0x6d, 0x60, 0xad, 0xb7, 0xfd, 0x99,
+	0x4e, 0x1c, 0xa8, 0x89, 0x5c, 0xba, 0x75, 0x3c, 0x4e, 0x8b, 0xe4, 0x1a, 0xb4, 0x79, 0x1e, 0x84,
+	0xa9, 0xc8, 0x95, 0xdb, 0x40, 0xef, 0x16, 0xcf, 0x77, 0xb4, 0xaa, 0x9d, 0x63, 0x26, 0xdc, 0xe6,
+	0xc0, 0x5a, 0xef, 0xf9, 0x5a, 0xd4, 0xe1, 0xd0, 0x8c, 0x51, 0xb7, 0x65, 0xc2, 0xd1, 0xb2, 0xf7,
+	0x00, 0x1a, 0xdb, 0x54, 0x85, 0x27, 0x64, 0x05, 0x1a, 0x54, 0xa9, 0x2c, 0x77, 0xad, 0x41, 0x6d,
+	0xbd, 0xe3, 0x1b, 0x85, 0xdc, 0x80, 0xfa, 0x29, 0x0b, 0x73, 0xb7, 0x3a, 0xa8, 0xad, 0xdb, 0x5b,
+	0xf6, 0x86, 0xce, 0x9b, 0xb9, 0x9c, 0x8f, 0x06, 0xef, 0x19, 0xb4, 0x8e, 0x74, 0x6c, 0xc3, 0x5d,
+	0x72, 0x05, 0x1a, 0xd1, 0x28, 0xe0, 0x11, 0x5e, 0xb7, 0xee, 0xd7, 0xa3, 0xd1, 0x30, 0xd2, 0xa0,
+	0x42, 0xb0, 0x6a, 0x40, 0xa5, 0xc1, 0xff, 0x43, 0x57, 0xd2, 0x4c, 0x71, 0xc5, 0x53, 0xa1, 0x6d,
+	0x35, 0xb4, 0xd9, 0x33, 0x6c, 0x18, 0x79, 0xdf, 0x5b, 0xd0, 0xff, 0xfa, 0x5c, 0x84, 0x4f, 0xd2,
+	0xf1, 0x11, 0xe5, 0xb1, 0xcf, 0x5e, 0x91, 0xdb, 0xd0, 0x0a, 0x45, 0x70, 0x42, 0x4f, 0x19, 0xfe,
+	0x83, 0xbd, 0xb5, 0xb2, 0x31, 0xaf, 0xc3, 0x51, 0x29, 0xf9, 0xcd, 0x50, 0xec, 0xd1, 0x53, 0x56,
+	0xb8, 0xbf, 0xa6, 0x42, 0xb9, 0xd5, 0x77, 0xbb, 0x3f, 0xa7, 0x42, 0x11, 0x0f, 0x1a, 0x6a, 0x96,
+	0x74, 0x7b, 0xab, 0x8b, 0x57, 0x2d, 0xae, 0xe6, 0x1b, 0x93, 0xf7, 0x0d, 0x2c, 0x5d, 0x88, 0x29,
+	0x97, 0xfa, 0x2a, 0xe1, 0x44, 0x06, 0x71, 0x1a, 0x52, 0x1d, 0x39, 0x46, 0xd6, 0xf1, 0xed, 0x70,
+	0x22, 0x9f, 0x14, 0x10, 0xf9, 0x10, 0xda, 0x61, 0x9a, 0x24, 0x54, 0x44, 0x65, 0x1e, 0x01, 0x0f,
+	0x7f, 0x2c, 0x54, 0x76, 0xee, 0xcf, 0x6c, 0xde, 0x03, 0x58, 0x3e, 0xcc, 0x98, 0x56, 0xb9, 0x7a,
+	0x9e, 0x71, 0xc5, 0x76, 0x92, 0x88, 0x7c, 0x0c, 0xc0, 0xb4, 0x5f, 0x10, 0xf3, 0x5c, 0xb9, 0xd6,
+	0xbf, 0xe8, 0x1d, 0xb4, 0x3e, 0xe1, 0xb9, 0xf2, 0x7e, 0xad, 0x42, 0x03, 0x41, 0x72, 0xa7, 0x24,
+	0x61, 0xa7, 0xe9, 0x90, 0xfa, 0x5b, 0x2b, 0x73, 0x92, 0xf9, 0xc5, 0x9e, 0xeb, 0xb0, 0x52, 0xd4,
+	0xad, 0x84, 0xb7, 0x9c, 0x17, 0xab, 0x85, 0xfa, 0x30, 0x22, 0x37, 0xc0, 0xd6, 0xbd, 0x3b, 0xa2,
+	0x39, 0x9b, 0x97, 0x0b, 0x4a, 0x68, 0x18, 0x91, 0xff, 0x01, 0x18, 0xae, 0xa0, 0x09, 0xc3, 0xfe,
+	0xec, 0xf8, 0x1d, 0x44, 0x9e, 0xd2, 0x84, 0x91, 0x9b, 0xd0, 0x9b, 0xf1, 0xd1, 0xa3, 0x81, 0x1e,
+	0xdd, 0x12, 0x44, 0xa7, 0xff, 0x42, 0xe7, 0x25, 0x2f, 0x8f, 0x68, 0xa2, 0x43, 0x5b, 0x03, 0x68,
+	0xbc, 0x0e, 0xb5, 0x11, 0x55, 0xd8, 0xb9, 0xe5, 0xfd, 0xb1, 0x6d, 0x7d, 0x0d, 0x93, 0x9b, 0xd0,
+	0x97, 0x93, 0x20, 0x3c, 0x61, 0xe1, 0x24, 0x18, 0x9d, 0x07, 0x91, 0x70, 0xdb, 0x03, 0x6b, 0xbd,
+	0xe1, 0xdb, 0x72, 0xb2, 0xa3, 0xc1, 0xed, 0xf3, 0x5d, 0xe1, 0x6d, 0x42, 0x67, 0x76, 0x6f, 0x02,
+	0xd0, 0x1c, 0x8a, 0x9c, 0x65, 0xca, 0xa9, 0x68, 0x79, 0x97, 0xc5, 0x4c, 0x31, 0xc7, 0xd2, 0xf2,
+	0xb1, 0x8c, 0xa8, 0x62, 0x4e, 0xd5, 0xfb, 0xd6, 0x02, 0x40, 0xba, 0x4c, 0xb9, 0x50, 0xe4, 0x13,
+	0x68, 0x26, 0x5c, 0x04, 0x2a, 0x7f, 0x67, 0xf7, 0x35, 0x12, 0x2e, 0x8e, 0x72, 0x74, 0xa6, 0x67,
+	0xda, 0xb9, 0xfa, 0x4e, 0x67, 0x7a, 0x76, 0x94, 0x97, 0x97, 0xab, 0x5d, 0x7a, 0x39, 0x13, 0x06,
+	0x55, 0x34, 0x4e, 0xc7, 0x3b, 0x13, 0xf9, 0xde, 0xc2, 0xf8, 0xce, 0x02, 0x7b, 0x9f, 0x29, 0xaa,
+	0x6b, 0xf6, 0x3e, 0xe3, 0xb8, 0x07, 0x2b, 0x8f, 0x62, 0xc5, 0x32, 0x1c, 0x4d, 0xdc, 0x74, 0x19,
+	0xd5, 0xe5, 0x19, 0x80, 0x1d, 0xce, 0xb4, 0xbc, 0x58, 0xb9, 0x8b, 0x90, 0x77, 0x1b, 0x96, 0x17,
+	0x99, 0x49, 0xc2, 0x84, 0x22, 0x2e, 0xb4, 0x42, 0x23, 0x16, 0xa3, 0x5b, 0xaa, 0xde, 0x1d, 0xb8,
+	0x32, 0x77, 0x3f, 0x2c, 0x57, 0x13, 0xb9, 0x0e, 0x9d, 0xd9, 0x9e, 0x2a, 0x28, 0x73, 0xc0, 0xbb,
+	0x0f, 0x57, 0xe7, 0xa4, 0x7d, 0x96, 0x8d, 0xd9, 0x61, 0x1a, 0xf3, 0xf0, 0x5c, 0xef, 0x89, 0x44,
+	0xab, 0x81, 0x44, 0xbd, 0xdc, 0x13, 0xc9, 0xdc, 0xc5, 0xdb, 0x5f, 0xe4, 0xfa, 0x4c, 0xcf, 0x01,
+	0x8a, 0x7a, 0x32, 0xd3, 0x38, 0x32, 0x83, 0x51, 0x04, 0x99, 0xc6, 0x11, 0xce, 0xc5, 0x35, 0x68,
+	0x0b, 0xf6, 0xda, 0x98, 0xaa, 0xc6, 0x24, 0xd8, 0x6b, 0x6d, 0xf2, 0xa2, 0xc5, 0xf8, 0x1f, 0x45,
+	0xd1, 0x4e, 0x1a, 0x4f, 0x13, 0x41, 0x3e, 0x80, 0x66, 0x88, 0x52, 0x51, 0xb7, 0xae, 0x79, 0x81,
+	0x76, 0xd2, 0x78, 0x97, 0xbd, 0xf4, 0x0b, 0x1b, 0xf9, 0x08, 0x96, 0x38, 0xce, 0x47, 0x20, 0xd3,
+	0xdc, 0xdc, 0xb5, 0x8a, 0x23, 0xd5, 0x37, 0xf0, 0x61, 0x81, 0x7a, 0x2f, 0x16, 0xcb, 0xb1, 0x9b,
+	0xa5, 0xb2, 0xf8, 0x9b, 0x1b, 0x60, 0xc7, 0xe9, 0x98, 0x87, 0x34, 0x0e, 0x78, 0x74, 0x86, 0xff,
+	0xd5, 0xf3, 0xa1, 0x80, 0x86, 0xd1, 0x99, 0x4e, 0x48, 0xce, 0x5e, 0x4d, 0x99, 0x08, 0x59, 0x20,
+	0xa6, 0x09, 0x1e, 0xdf, 0xf3, 0xed, 0x12, 0x7b, 0x3a, 0x4d, 0xbc, 0x9f, 0xeb, 0xd0, 0x5b, 0xcc,
+	0xc8, 0xab, 0x0b, 0x3b, 0xca, 0xba, 0xb8, 0xa3, 0x66, 0xaf, 0x4f, 0x75, 0xe1, 0xf5, 0xf1, 0xa0,
+	0x3e, 0xe1, 0xc2, 0x6c, 0xac, 0xfe, 0x56, 0x1f, 0x7b, 0x09, 0x4f, 0xfc, 0x8a, 0x8b, 0xc8, 0x47,
+	0x1b, 0xf9, 0x1c, 0x80, 0x46, 0x51, 0x50, 0x24, 0xa5, 0x8e, 0x49, 0x71, 0xe7, 0x9e, 0x17, 0xd3,
+	0xb7, 0x57, 0xf1, 0x3b, 0xb4, 0x54, 0xc8, 0x17, 0x60, 0x47, 0x59, 0x2a, 0x4b, 0x6e, 0x03, 0xb9,
+	0xd7, 0xde, 0xe2, 0xce, 0x93, 0xb2, 0x57, 0xf1, 0x21, 0x9a, 0x69, 0xe4, 0x21, 0x74, 0x33, 0xac,
+	0x72, 0x60, 0x1e, 0x9e, 0x26, 0xd2, 0x57, 0xdf, 0xa2, 0x2f, 0x34, 0xc2, 0x5e, 0xc5, 0xb7, 0xb3,
+	0xb9, 0x4a, 0x1e, 0x42, 0x7f, 0x8a, 0xcb, 0x2a, 0x28, 0x5b, 0xd8, 0xec, 0xc7, 0xff, 0xbc, 0x75,
+	0x44, 0xd1, 0xeb, 0x7b, 0x15, 0xbf, 0x67, 0xfc, 0x0b, 0x40, 0xc7, 0x5f, 0x1e, 0x90, 0xab, 0xcc,
+	0x6d, 0x5f, 0x1a, 0xff, 0x7c, 0xc6, 0x74, 0xfc, 0xc5, 0x01, 0xb9, 0xca, 0xc8, 0x63, 0x70, 0x0a,
+	0xf6, 0x7c, 0x20, 0x3a, 0x97, 0xa6, 0x6f, 0x36, 0x3d, 0x7b, 0x15, 0x7f, 0xc9, 0x70, 0x66, 0x90,
+	0x4e, 0x43, 0x71, 0x0c, 0x0e, 0x83, 0x0b, 0x97, 0xa6, 0x61, 0x61, 0x96, 0x74, 0x1a, 0x0c, 0x03,
+	0xc1, 0x6d, 0x1b, 0x3a, 0xa9, 0x64, 0x19, 0x3e, 0xb6, 0x5e, 0x04, 0xed, 0xa1, 0x50, 0x9f, 0xdd,
+	0xdd, 0xa7, 0x92, 0x78, 0x60, 0x25, 0xc5, 0x93, 0x69, 0x5e, 0xbf, 0xd2, 0xb2, 0xb1, 0x6f, 0x1e,
+	0x4f, 0x2b, 0x59, 0xbd, 0x0b, 0x4d, 0xa3, 0xe8, 0xef, 0xa5, 0x09, 0x33, 0x83, 0x59, 0xf3, 0xb5,
+	0xa8, 0x3f, 0x89, 0x4e, 0x69, 0x3c, 0x35, 0x93, 0x55, 0xf3, 0x8d, 0x72, 0xbf, 0x7a, 0xcf, 0xba,
+	0xb5, 0x0b, 0xcd, 0x03, 0xb9, 0x93, 0x46, 0x8c, 0xb4, 0xa0, 0xf6, 0x34, 0x95, 0x4e, 0x85, 0x2c,
+	0x43, 0xf7, 0x40, 0x7e, 0xc9, 0x54, 0xf1, 0x71, 0xe0, 0xfc, 0xd9, 0x22, 0x5d, 0x68, 0x1d, 0x48,
+	0x7c, 0xc9, 0x9d, 0xbf, 0x5a, 0xc4, 0x01, 0xfb, 0x40, 0x1e, 0x66, 0x98, 0x7c, 0xae, 0x9c, 0xbf,
+	0x5b, 0xb7, 0x7e, 0xb0, 0xa0, 0x33, 0xeb, 0x46, 0x62, 0x43, 0x6b, 0x28, 0x4e, 0x69, 0xcc, 0x23,
+	0xa7, 0x42, 0x7a, 0xd0, 0x99, 0xf5, 0x9c, 0x63, 0x91, 0x3e, 0xc0, 0xbc, 0x8d, 0x9c, 0x2a, 0x59,
+	0x02, 0x7b, 0xa1, 0x2f, 0x9c, 0x1a, 0x59, 0x86, 0xde, 0xf1, 0x62, 0x69, 0x9d, 0x3a, 0x59, 0x01,
+	0xa7, 0x84, 0xca, 0x02, 0x3a, 0x0d, 0x72, 0x05, 0x96, 0x8e, 0x2f, 0x16, 0xc0, 0x69, 0x92, 0xab,
+	0xb0, 0x7c, 0x3c, 0x4f, 0xa8, 0xc9, 0xb2, 0xd3, 0xda, 0x7e, 0xf0, 0xcb, 0x9b, 0x35, 0xeb, 0xb7,
+	0x37, 0x6b, 0xd6, 0xef, 0x6f, 0xd6, 0x2a, 0x3f, 0xfe, 0xb1, 0x66, 0xbd, 0xf8, 0x74, 0xe1, 0x3b,
+	0x39, 0xa1, 0x2a, 0xe3, 0x67, 0x69, 0xc6, 0xc7, 0x5c, 0x94, 0x8a, 0x60, 0x9b, 0x72, 0x32, 0xde,
+	0x94, 0xa3, 0x4d, 0x2a, 0xf9, 0xa8, 0x89, 0x1f, 0xc4, 0x77, 0xfe, 0x19, 0x00, 0x57, 0x43, 0x97,
+	0xe8, 0x6e, 0x0b, 0x00, 0x00,
 }
 
 func (m *Vector) Marshal() (dAtA []byte, err error) {
@@ -2051,6 +2185,74 @@ func (m *AlterTableComment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AlterTablePartition) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTablePartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTablePartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Partition) > 0 {
+		i -= len(m.Partition)
+		copy(dAtA[i:], m.Partition)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Partition)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AlterTableMergePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTableMergePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableMergePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.MergePolicy) > 0 {
+		i -= len(m.MergePolicy)
+		copy(dAtA[i:], m.MergePolicy)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.MergePolicy)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AlterTableRenameTable) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -2329,6 +2531,48 @@ func (m *AlterTableReq_UpdateCstr) MarshalToSizedBuffer(dAtA []byte) (int, error
 	}
 	return len(dAtA) - i, nil
 }
+func (m *AlterTableReq_UpdatePartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableReq_UpdatePartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UpdatePartition != nil {
+		{
+			size, err := m.UpdatePartition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AlterTableReq_UpdateMerge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableReq_UpdateMerge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UpdateMerge != nil {
+		{
+			size, err := m.UpdateMerge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	return len(dAtA) - i, nil
+}
 func (m *Int64Map) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -2672,6 +2916,38 @@ func (m *AlterTableComment) ProtoSize() (n int) {
 	return n
 }
 
+func (m *AlterTablePartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Partition)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterTableMergePolicy) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MergePolicy)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *AlterTableRenameTable) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -2813,6 +3089,30 @@ func (m *AlterTableReq_UpdateCstr) ProtoSize() (n int) {
 	}
 	return n
 }
+func (m *AlterTableReq_UpdatePartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpdatePartition != nil {
+		l = m.UpdatePartition.ProtoSize()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+func (m *AlterTableReq_UpdateMerge) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpdateMerge != nil {
+		l = m.UpdateMerge.ProtoSize()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
 func (m *Int64Map) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -4577,6 +4877,172 @@ func (m *AlterTableComment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AlterTablePartition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTablePartition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTablePartition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Partition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTableMergePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableMergePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableMergePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergePolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MergePolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *AlterTableRenameTable) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5148,6 +5614,76 @@ func (m *AlterTableReq) Unmarshal(dAtA []byte) error {
 			}
 			m.Operation = &AlterTableReq_UpdateCstr{v}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatePartition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTablePartition{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Operation = &AlterTableReq_UpdatePartition{v}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMerge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTableMergePolicy{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Operation = &AlterTableReq_UpdateMerge{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -54,6 +54,17 @@ const (
 	CmdMethod_SyncCommit CmdMethod = 9
 	// GetCommit get latest commit timestamp of cn.
 	CmdMethod_GetCommit CmdMethod = 10
+	// Merge is to force merge the blocks of the table.
+	// parameter should be "DbName.TableName"
+	CmdMethod_Merge CmdMethod = 11
+	// Backup is to backup the data of DN to a fileservice target.
+	CmdMethod_Backup CmdMethod = 12
+	// Changefeed is to create, drop or show the changefeeds of DN.
+	CmdMethod_Changefeed CmdMethod = 13
+	// MView is to create, drop or refresh the maintenance of a materialized view.
+	CmdMethod_MView CmdMethod = 14
+	// Restore is to restore a backup from a fileservice target to another one.
+	CmdMethod_Restore CmdMethod = 15
 )
 
 var CmdMethod_name = map[int32]string{
@@ -68,6 +79,11 @@ var CmdMethod_name = map[int32]string{
 	8:  "Label",
 	9:  "SyncCommit",
 	10: "GetCommit",
+	11: "Merge",
+	12: "Backup",
+	13: "Changefeed",
+	14: "MView",
+	15: "Restore",
 }
 
 var CmdMethod_value = map[string]int32{
@@ -82,6 +98,11 @@ var CmdMethod_value = map[string]int32{
 	"Label":       8,
 	"SyncCommit":  9,
 	"GetCommit":   10,
+	"Merge":       11,
+	"Backup":      12,
+	"Changefeed":  13,
+	"MView":       14,
+	"Restore":     15,
 }
 
 func (x CmdMethod) String() string {
@@ -628,45 +649,47 @@ func init() {
 func init() { proto.RegisterFile("ctl.proto", fileDescriptor_0646114e50303026) }
 
 var fileDescriptor_0646114e50303026 = []byte{
-	// 595 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x41, 0x6f, 0xd3, 0x3e,
-	0x18, 0xc6, 0xe7, 0x2d, 0x5d, 0x97, 0xb7, 0xff, 0x65, 0x9e, 0x35, 0xfd, 0xa9, 0x26, 0x54, 0xa6,
-	0x1c, 0xd0, 0x84, 0xb6, 0x16, 0x8d, 0x1b, 0x02, 0x24, 0x96, 0xb0, 0xa9, 0xd2, 0x36, 0xa1, 0x64,
-	0x08, 0xb1, 0x5b, 0x9a, 0x9a, 0x24, 0x6a, 0x12, 0x07, 0xdb, 0x41, 0xec, 0xdb, 0x70, 0xe6, 0x93,
-	0xec, 0xc6, 0x3e, 0x01, 0x82, 0x5e, 0xf8, 0x1a, 0x28, 0x4e, 0xda, 0x84, 0xf6, 0x00, 0x48, 0xbb,
-	0xf9, 0x7d, 0xfc, 0xbc, 0x6f, 0x9e, 0x9f, 0xad, 0x18, 0x74, 0x5f, 0xc6, 0xfd, 0x8c, 0x33, 0xc9,
-	0xc8, 0x9a, 0x2f, 0xe3, 0xdd, 0xc3, 0x20, 0x92, 0x61, 0x3e, 0xea, 0xfb, 0x2c, 0x19, 0x04, 0x2c,
-	0x60, 0x03, 0xb5, 0x37, 0xca, 0xdf, 0xab, 0x4a, 0x15, 0x6a, 0x55, 0xf6, 0xec, 0x6e, 0xc9, 0x28,
-	0xa1, 0x42, 0x7a, 0x49, 0x56, 0x0a, 0xe6, 0x21, 0x6c, 0xda, 0x17, 0xaf, 0xa3, 0x34, 0x70, 0xe8,
-	0x87, 0x9c, 0x0a, 0x49, 0xee, 0x83, 0x9e, 0x79, 0xdc, 0x4b, 0xa8, 0xa4, 0xbc, 0x8b, 0xf6, 0xd0,
-	0xbe, 0xee, 0xd4, 0x82, 0xf9, 0x05, 0x81, 0x31, 0xf3, 0x8b, 0x8c, 0xa5, 0x82, 0x92, 0x2e, 0xb4,
-	0x85, 0x64, 0x9c, 0x0e, 0xed, 0xca, 0x3e, 0x2b, 0xc9, 0x43, 0x30, 0x04, 0xe5, 0x1f, 0x23, 0x9f,
-	0xbe, 0x1c, 0x8f, 0x39, 0x15, 0xa2, 0xbb, 0xaa, 0x0c, 0x0b, 0xaa, 0x9a, 0x10, 0x7a, 0x7c, 0x3c,
-	0xb4, 0xbb, 0x6b, 0x7b, 0x68, 0x5f, 0x73, 0x66, 0x65, 0x11, 0x86, 0xd3, 0x2c, 0x8e, 0x7c, 0x6f,
-	0x68, 0x77, 0x35, 0xb5, 0x57, 0x0b, 0xa4, 0x07, 0x10, 0xb3, 0xc0, 0xad, 0x5a, 0x5b, 0x6a, 0xbb,
-	0xa1, 0x98, 0x8f, 0x01, 0xdb, 0x17, 0xae, 0xe4, 0xcd, 0xb4, 0x6a, 0xa2, 0xcc, 0x79, 0xea, 0xca,
-	0x39, 0xde, 0x5c, 0x30, 0xbf, 0x22, 0x68, 0x37, 0x0e, 0xa2, 0x5a, 0x56, 0x64, 0x9a, 0x53, 0x0b,
-	0xe4, 0x00, 0x74, 0xeb, 0xdc, 0x3e, 0xa7, 0x32, 0x64, 0x63, 0x85, 0x65, 0x1c, 0x19, 0xfd, 0xe2,
-	0x6e, 0xac, 0x64, 0x5c, 0xaa, 0x4e, 0x6d, 0x20, 0xcf, 0x00, 0xdc, 0x6b, 0x3f, 0xb5, 0x58, 0x92,
-	0x44, 0x52, 0x41, 0x76, 0x8e, 0xfe, 0x57, 0x76, 0xf7, 0x3a, 0xf5, 0x4b, 0xb9, 0x9a, 0x7d, 0xac,
-	0xdd, 0x7c, 0x7b, 0xb0, 0xe2, 0x34, 0xfc, 0xe4, 0x29, 0xe8, 0xa7, 0x54, 0x56, 0xcd, 0xda, 0x5f,
-	0x34, 0xd7, 0x76, 0xf3, 0x27, 0x82, 0x8d, 0x26, 0xfc, 0x9d, 0x21, 0xed, 0x40, 0xeb, 0x15, 0xe7,
-	0x8c, 0x2b, 0x9a, 0xff, 0x9c, 0xb2, 0x20, 0xcf, 0x7f, 0x03, 0x2d, 0xb3, 0xde, 0x5b, 0xca, 0x5a,
-	0xc6, 0xf9, 0x13, 0x69, 0xab, 0x41, 0x3a, 0x57, 0x17, 0x9a, 0x1b, 0xa4, 0x6f, 0x61, 0x7b, 0xe9,
-	0x3c, 0xc8, 0x31, 0x18, 0x67, 0x9e, 0xa4, 0xa2, 0x32, 0x5d, 0xba, 0x0a, 0xbb, 0x73, 0xb4, 0xd3,
-	0xaf, 0x7f, 0x84, 0xcb, 0xd9, 0xaa, 0x9a, 0xb9, 0xd0, 0x61, 0x5e, 0x01, 0x59, 0x0e, 0x4f, 0x6c,
-	0xd8, 0xb2, 0x72, 0xce, 0x69, 0xfa, 0x2f, 0xa3, 0x17, 0x5b, 0x4c, 0x02, 0xb8, 0x81, 0xa6, 0x32,
-	0x9b, 0xef, 0x60, 0x7b, 0x09, 0xf7, 0x6e, 0x3e, 0xf7, 0xe8, 0x33, 0x02, 0x7d, 0x7e, 0x9b, 0x64,
-	0x03, 0xb4, 0xe2, 0x4f, 0xc6, 0x2b, 0x44, 0x87, 0xd6, 0x49, 0x9c, 0x8b, 0x10, 0xa3, 0x42, 0xbc,
-	0xf4, 0xc4, 0x04, 0xaf, 0x12, 0x03, 0xc0, 0x0a, 0xa9, 0x3f, 0xc9, 0x58, 0x94, 0x4a, 0xbc, 0x46,
-	0xb6, 0xa0, 0xf3, 0x46, 0x50, 0x37, 0xf5, 0x32, 0x11, 0x32, 0x89, 0xb5, 0x42, 0x38, 0xa5, 0x72,
-	0x2e, 0xb4, 0x48, 0x07, 0xda, 0x27, 0x8c, 0xfb, 0xf4, 0xd4, 0xc2, 0xeb, 0x45, 0x31, 0x4c, 0x45,
-	0x46, 0x7d, 0x89, 0xdb, 0xc5, 0x07, 0xce, 0xbc, 0x11, 0x8d, 0xf1, 0x46, 0x31, 0xb6, 0x3e, 0x4e,
-	0xac, 0x93, 0xcd, 0xc6, 0x9d, 0x63, 0x38, 0x7e, 0x71, 0xfb, 0xa3, 0x87, 0x6e, 0xa6, 0x3d, 0x74,
-	0x3b, 0xed, 0xa1, 0xef, 0xd3, 0x1e, 0xba, 0x3a, 0x68, 0x3c, 0x71, 0x89, 0x27, 0x79, 0xf4, 0x89,
-	0xf1, 0x28, 0x88, 0xd2, 0x59, 0x91, 0xd2, 0x41, 0x36, 0x09, 0x06, 0xd9, 0x68, 0xe0, 0xcb, 0x78,
-	0xb4, 0xae, 0xde, 0xb5, 0x27, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x91, 0x8e, 0xeb, 0x7e, 0x29,
-	0x05, 0x00, 0x00,
+	// 631 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4d, 0x4f, 0xdb, 0x4a,
+	0x14, 0xc5, 0x90, 0x10, 0x7c, 0x03, 0xce, 0x30, 0x42, 0xef, 0x45, 0xe8, 0x29, 0x0f, 0x79, 0xf1,
+	0x84, 0x9e, 0x20, 0xa9, 0xe8, 0xae, 0x6a, 0x2b, 0x35, 0x71, 0x41, 0x91, 0x00, 0x55, 0x36, 0x6d,
+	0x55, 0x76, 0x8e, 0x73, 0x71, 0xac, 0xd8, 0x1e, 0x77, 0x66, 0xdc, 0x96, 0xbf, 0xd4, 0x5f, 0xc2,
+	0xae, 0xfc, 0x82, 0x7e, 0xb0, 0xe9, 0xdf, 0xa8, 0x3c, 0x76, 0x62, 0x37, 0x59, 0xb4, 0x95, 0xd8,
+	0xcd, 0x3d, 0x73, 0xce, 0x9d, 0x73, 0x66, 0x34, 0x17, 0x74, 0x4f, 0x86, 0xdd, 0x84, 0x33, 0xc9,
+	0xe8, 0x9a, 0x27, 0xc3, 0xdd, 0x43, 0x3f, 0x90, 0x93, 0x74, 0xd4, 0xf5, 0x58, 0xd4, 0xf3, 0x99,
+	0xcf, 0x7a, 0x6a, 0x6f, 0x94, 0x5e, 0xa9, 0x4a, 0x15, 0x6a, 0x95, 0x6b, 0x76, 0x5b, 0x32, 0x88,
+	0x50, 0x48, 0x37, 0x4a, 0x72, 0xc0, 0x3c, 0x84, 0x2d, 0xeb, 0xfc, 0x45, 0x10, 0xfb, 0x36, 0xbe,
+	0x4d, 0x51, 0x48, 0xfa, 0x0f, 0xe8, 0x89, 0xcb, 0xdd, 0x08, 0x25, 0xf2, 0xb6, 0xb6, 0xa7, 0xed,
+	0xeb, 0x76, 0x09, 0x98, 0x1f, 0x35, 0x30, 0x66, 0x7c, 0x91, 0xb0, 0x58, 0x20, 0x6d, 0x43, 0x43,
+	0x48, 0xc6, 0x71, 0x68, 0x15, 0xf4, 0x59, 0x49, 0xff, 0x03, 0x43, 0x20, 0x7f, 0x17, 0x78, 0xf8,
+	0x6c, 0x3c, 0xe6, 0x28, 0x44, 0x7b, 0x55, 0x11, 0x16, 0x50, 0xd5, 0x61, 0xe2, 0xf2, 0xf1, 0xd0,
+	0x6a, 0xaf, 0xed, 0x69, 0xfb, 0x35, 0x7b, 0x56, 0x66, 0x66, 0x38, 0x26, 0x61, 0xe0, 0xb9, 0x43,
+	0xab, 0x5d, 0x53, 0x7b, 0x25, 0x40, 0x3b, 0x00, 0x21, 0xf3, 0x9d, 0x42, 0x5a, 0x57, 0xdb, 0x15,
+	0xc4, 0x7c, 0x00, 0xc4, 0x3a, 0x77, 0x24, 0xaf, 0xba, 0x55, 0x1d, 0x65, 0xca, 0x63, 0x47, 0xce,
+	0xe3, 0xcd, 0x01, 0xf3, 0x93, 0x06, 0x8d, 0xca, 0x45, 0x14, 0xcb, 0x22, 0x59, 0xcd, 0x2e, 0x01,
+	0x7a, 0x00, 0xfa, 0xe0, 0xcc, 0x3a, 0x43, 0x39, 0x61, 0x63, 0x15, 0xcb, 0x38, 0x32, 0xba, 0xd9,
+	0xdb, 0x0c, 0xa2, 0x71, 0x8e, 0xda, 0x25, 0x81, 0x3e, 0x06, 0x70, 0xae, 0xbd, 0x78, 0xc0, 0xa2,
+	0x28, 0x90, 0x2a, 0x64, 0xf3, 0xe8, 0x2f, 0x45, 0x77, 0xae, 0x63, 0x2f, 0x87, 0x8b, 0xde, 0xfd,
+	0xda, 0xcd, 0xe7, 0x7f, 0x57, 0xec, 0x0a, 0x9f, 0x3e, 0x02, 0xfd, 0x04, 0x65, 0x21, 0xae, 0xfd,
+	0x86, 0xb8, 0xa4, 0x9b, 0xdf, 0x35, 0xd8, 0xa8, 0x86, 0xbf, 0xb7, 0x48, 0x3b, 0x50, 0x7f, 0xce,
+	0x39, 0xe3, 0x2a, 0xcd, 0xa6, 0x9d, 0x17, 0xf4, 0xc9, 0x4f, 0x41, 0x73, 0xaf, 0x7f, 0x2f, 0x79,
+	0xcd, 0xed, 0xfc, 0x2a, 0x69, 0xbd, 0x92, 0x74, 0x8e, 0x2e, 0x88, 0x2b, 0x49, 0x5f, 0xc3, 0xf6,
+	0xd2, 0x7d, 0xd0, 0x3e, 0x18, 0xa7, 0xae, 0x44, 0x51, 0x90, 0x2e, 0x1c, 0x15, 0xbb, 0x79, 0xb4,
+	0xd3, 0x2d, 0x3f, 0xc2, 0xc5, 0x6c, 0x55, 0xf4, 0x5c, 0x50, 0x98, 0x97, 0x40, 0x97, 0xcd, 0x53,
+	0x0b, 0x5a, 0x83, 0x94, 0x73, 0x8c, 0xff, 0xa4, 0xf5, 0xa2, 0xc4, 0xa4, 0x40, 0x2a, 0xd1, 0x94,
+	0x67, 0xf3, 0x0d, 0x6c, 0x2f, 0xc5, 0xbd, 0x9f, 0xe3, 0xfe, 0xff, 0xa2, 0x81, 0x3e, 0x7f, 0x4d,
+	0xba, 0x01, 0xb5, 0xec, 0x27, 0x93, 0x15, 0xaa, 0x43, 0xfd, 0x38, 0x4c, 0xc5, 0x84, 0x68, 0x19,
+	0x78, 0xe1, 0x8a, 0x29, 0x59, 0xa5, 0x06, 0xc0, 0x60, 0x82, 0xde, 0x34, 0x61, 0x41, 0x2c, 0xc9,
+	0x1a, 0x6d, 0x41, 0xf3, 0xa5, 0x40, 0x27, 0x76, 0x13, 0x31, 0x61, 0x92, 0xd4, 0x32, 0xe0, 0x04,
+	0xe5, 0x1c, 0xa8, 0xd3, 0x26, 0x34, 0x8e, 0x19, 0xf7, 0xf0, 0x64, 0x40, 0xd6, 0xb3, 0x62, 0x18,
+	0x8b, 0x04, 0x3d, 0x49, 0x1a, 0xd9, 0x01, 0xa7, 0xee, 0x08, 0x43, 0xb2, 0x91, 0xb5, 0x2d, 0xaf,
+	0x93, 0xe8, 0x74, 0xab, 0xf2, 0xe6, 0x04, 0x32, 0xe6, 0x19, 0x72, 0x1f, 0x49, 0x93, 0x02, 0xac,
+	0xf7, 0x5d, 0x6f, 0x9a, 0x26, 0x64, 0x33, 0x37, 0xe3, 0xc6, 0x3e, 0x5e, 0x21, 0x8e, 0xc9, 0x96,
+	0xa2, 0xbd, 0x0a, 0xf0, 0x3d, 0x31, 0xb2, 0x83, 0x6c, 0x54, 0x33, 0x87, 0xb4, 0xfa, 0x4f, 0x6f,
+	0xbf, 0x75, 0xb4, 0x9b, 0xbb, 0x8e, 0x76, 0x7b, 0xd7, 0xd1, 0xbe, 0xde, 0x75, 0xb4, 0xcb, 0x83,
+	0xca, 0x84, 0x8c, 0x5c, 0xc9, 0x83, 0x0f, 0x8c, 0x07, 0x7e, 0x10, 0xcf, 0x8a, 0x18, 0x7b, 0xc9,
+	0xd4, 0xef, 0x25, 0xa3, 0x9e, 0x27, 0xc3, 0xd1, 0xba, 0x1a, 0x8b, 0x0f, 0x7f, 0x0c, 0x00, 0x77,
+	0x39, 0x89, 0x67, 0x68, 0x05, 0x00, 0x00,
 }
 
 func (m *DNPingRequest) Marshal() (dAtA []byte, err error) {
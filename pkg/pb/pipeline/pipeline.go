@@ -32,6 +32,10 @@ const (
 	WaitingNext
 	Last
 	MessageEnd
+
+	// For cmd. The runtime filter for a running pipeline, it follows the
+	// status types to keep the values above unchanged.
+	RuntimeFilterMessage
 )
 
 func (m *Message) Size() int {
@@ -111,6 +115,10 @@ func (m *Message) IsPipelineMessage() bool {
 	return m.GetCmd() == PipelineMessage
 }
 
+func (m *Message) IsRuntimeFilterMessage() bool {
+	return m.GetCmd() == RuntimeFilterMessage
+}
+
 func (m *Message) IsEndMessage() bool {
 	return m.Sid == MessageEnd
 }
@@ -54,7 +54,7 @@ func (x Pipeline_PipelineType) String() string {
 }
 
 func (Pipeline_PipelineType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{36, 0}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{38, 0}
 }
 
 type Message struct {
@@ -184,6 +184,103 @@ func (m *Message) GetSequence() uint64 {
 	return 0
 }
 
+// RuntimeFilter is a runtime filter sent by a hash build to the table scans
+// running on a remote CN, see process.RuntimeFilterMessage.
+type RuntimeFilter struct {
+	Tag                  int32    `protobuf:"varint,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Typ                  int32    `protobuf:"varint,2,opt,name=typ,proto3" json:"typ,omitempty"`
+	Oid                  int32    `protobuf:"varint,3,opt,name=oid,proto3" json:"oid,omitempty"`
+	Min                  []byte   `protobuf:"bytes,4,opt,name=min,proto3" json:"min,omitempty"`
+	Max                  []byte   `protobuf:"bytes,5,opt,name=max,proto3" json:"max,omitempty"`
+	Keys                 [][]byte `protobuf:"bytes,6,rep,name=keys,proto3" json:"keys,omitempty"`
+	Bloom                []byte   `protobuf:"bytes,7,opt,name=bloom,proto3" json:"bloom,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RuntimeFilter) Reset()         { *m = RuntimeFilter{} }
+func (m *RuntimeFilter) String() string { return proto.CompactTextString(m) }
+func (*RuntimeFilter) ProtoMessage()    {}
+func (*RuntimeFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ac67a7adf3df9c7, []int{1}
+}
+func (m *RuntimeFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RuntimeFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RuntimeFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RuntimeFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuntimeFilter.Merge(m, src)
+}
+func (m *RuntimeFilter) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *RuntimeFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuntimeFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RuntimeFilter proto.InternalMessageInfo
+
+func (m *RuntimeFilter) GetTag() int32 {
+	if m != nil {
+		return m.Tag
+	}
+	return 0
+}
+
+func (m *RuntimeFilter) GetTyp() int32 {
+	if m != nil {
+		return m.Typ
+	}
+	return 0
+}
+
+func (m *RuntimeFilter) GetOid() int32 {
+	if m != nil {
+		return m.Oid
+	}
+	return 0
+}
+
+func (m *RuntimeFilter) GetMin() []byte {
+	if m != nil {
+		return m.Min
+	}
+	return nil
+}
+
+func (m *RuntimeFilter) GetMax() []byte {
+	if m != nil {
+		return m.Max
+	}
+	return nil
+}
+
+func (m *RuntimeFilter) GetKeys() [][]byte {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *RuntimeFilter) GetBloom() []byte {
+	if m != nil {
+		return m.Bloom
+	}
+	return nil
+}
+
 type Connector struct {
 	PipelineId           int32    `protobuf:"varint,1,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`
 	ConnectorIndex       int32    `protobuf:"varint,2,opt,name=connector_index,json=connectorIndex,proto3" json:"connector_index,omitempty"`
@@ -196,7 +293,7 @@ func (m *Connector) Reset()         { *m = Connector{} }
 func (m *Connector) String() string { return proto.CompactTextString(m) }
 func (*Connector) ProtoMessage()    {}
 func (*Connector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{1}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{2}
 }
 func (m *Connector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -243,6 +340,9 @@ type Dispatch struct {
 	FuncId               int32        `protobuf:"varint,1,opt,name=func_id,json=funcId,proto3" json:"func_id,omitempty"`
 	LocalConnector       []*Connector `protobuf:"bytes,2,rep,name=local_connector,json=localConnector,proto3" json:"local_connector,omitempty"`
 	RemoteConnector      []*WrapNode  `protobuf:"bytes,3,rep,name=remote_connector,json=remoteConnector,proto3" json:"remote_connector,omitempty"`
+	ShuffleExprs         []*plan.Expr `protobuf:"bytes,4,rep,name=shuffle_exprs,json=shuffleExprs,proto3" json:"shuffle_exprs,omitempty"`
+	ShuffleLocalIdx      []int32      `protobuf:"varint,5,rep,packed,name=shuffle_local_idx,json=shuffleLocalIdx,proto3" json:"shuffle_local_idx,omitempty"`
+	ShuffleRemoteIdx     []int32      `protobuf:"varint,6,rep,packed,name=shuffle_remote_idx,json=shuffleRemoteIdx,proto3" json:"shuffle_remote_idx,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -252,7 +352,7 @@ func (m *Dispatch) Reset()         { *m = Dispatch{} }
 func (m *Dispatch) String() string { return proto.CompactTextString(m) }
 func (*Dispatch) ProtoMessage()    {}
 func (*Dispatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{2}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{3}
 }
 func (m *Dispatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -302,6 +402,27 @@ func (m *Dispatch) GetRemoteConnector() []*WrapNode {
 	return nil
 }
 
+func (m *Dispatch) GetShuffleExprs() []*plan.Expr {
+	if m != nil {
+		return m.ShuffleExprs
+	}
+	return nil
+}
+
+func (m *Dispatch) GetShuffleLocalIdx() []int32 {
+	if m != nil {
+		return m.ShuffleLocalIdx
+	}
+	return nil
+}
+
+func (m *Dispatch) GetShuffleRemoteIdx() []int32 {
+	if m != nil {
+		return m.ShuffleRemoteIdx
+	}
+	return nil
+}
+
 type MultiArguemnt struct {
 	Dist                 bool         `protobuf:"varint,1,opt,name=Dist,proto3" json:"Dist,omitempty"`
 	GroupExpr            []*plan.Expr `protobuf:"bytes,2,rep,name=GroupExpr,proto3" json:"GroupExpr,omitempty"`
@@ -317,7 +438,7 @@ func (m *MultiArguemnt) Reset()         { *m = MultiArguemnt{} }
 func (m *MultiArguemnt) String() string { return proto.CompactTextString(m) }
 func (*MultiArguemnt) ProtoMessage()    {}
 func (*MultiArguemnt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{3}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{4}
 }
 func (m *MultiArguemnt) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -394,7 +515,7 @@ func (m *Aggregate) Reset()         { *m = Aggregate{} }
 func (m *Aggregate) String() string { return proto.CompactTextString(m) }
 func (*Aggregate) ProtoMessage()    {}
 func (*Aggregate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{4}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{5}
 }
 func (m *Aggregate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -461,7 +582,7 @@ func (m *Group) Reset()         { *m = Group{} }
 func (m *Group) String() string { return proto.CompactTextString(m) }
 func (*Group) ProtoMessage()    {}
 func (*Group) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{5}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{6}
 }
 func (m *Group) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -558,7 +679,7 @@ func (m *Insert) Reset()         { *m = Insert{} }
 func (m *Insert) String() string { return proto.CompactTextString(m) }
 func (*Insert) ProtoMessage()    {}
 func (*Insert) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{6}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{7}
 }
 func (m *Insert) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -654,7 +775,7 @@ func (m *Array) Reset()         { *m = Array{} }
 func (m *Array) String() string { return proto.CompactTextString(m) }
 func (*Array) ProtoMessage()    {}
 func (*Array) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{7}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{8}
 }
 func (m *Array) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -701,7 +822,7 @@ func (m *Map) Reset()         { *m = Map{} }
 func (m *Map) String() string { return proto.CompactTextString(m) }
 func (*Map) ProtoMessage()    {}
 func (*Map) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{8}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{9}
 }
 func (m *Map) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -760,7 +881,7 @@ func (m *Deletion) Reset()         { *m = Deletion{} }
 func (m *Deletion) String() string { return proto.CompactTextString(m) }
 func (*Deletion) ProtoMessage()    {}
 func (*Deletion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{9}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{10}
 }
 func (m *Deletion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -896,7 +1017,7 @@ func (m *PreInsert) Reset()         { *m = PreInsert{} }
 func (m *PreInsert) String() string { return proto.CompactTextString(m) }
 func (*PreInsert) ProtoMessage()    {}
 func (*PreInsert) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{10}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{11}
 }
 func (m *PreInsert) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -978,7 +1099,7 @@ func (m *PreInsertUnique) Reset()         { *m = PreInsertUnique{} }
 func (m *PreInsertUnique) String() string { return proto.CompactTextString(m) }
 func (*PreInsertUnique) ProtoMessage()    {}
 func (*PreInsertUnique) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{11}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{12}
 }
 func (m *PreInsertUnique) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1027,7 +1148,7 @@ func (m *OnDuplicateKey) Reset()         { *m = OnDuplicateKey{} }
 func (m *OnDuplicateKey) String() string { return proto.CompactTextString(m) }
 func (*OnDuplicateKey) ProtoMessage()    {}
 func (*OnDuplicateKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{12}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{13}
 }
 func (m *OnDuplicateKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1078,24 +1199,25 @@ func (m *OnDuplicateKey) GetOnDuplicateExpr() map[string]*plan.Expr {
 }
 
 type Join struct {
-	Ibucket              uint64       `protobuf:"varint,1,opt,name=ibucket,proto3" json:"ibucket,omitempty"`
-	Nbucket              uint64       `protobuf:"varint,2,opt,name=nbucket,proto3" json:"nbucket,omitempty"`
-	RelList              []int32      `protobuf:"varint,3,rep,packed,name=rel_list,json=relList,proto3" json:"rel_list,omitempty"`
-	ColList              []int32      `protobuf:"varint,4,rep,packed,name=col_list,json=colList,proto3" json:"col_list,omitempty"`
-	Expr                 *plan.Expr   `protobuf:"bytes,5,opt,name=expr,proto3" json:"expr,omitempty"`
-	Types                []*plan.Type `protobuf:"bytes,6,rep,name=types,proto3" json:"types,omitempty"`
-	LeftCond             []*plan.Expr `protobuf:"bytes,7,rep,name=left_cond,json=leftCond,proto3" json:"left_cond,omitempty"`
-	RightCond            []*plan.Expr `protobuf:"bytes,8,rep,name=right_cond,json=rightCond,proto3" json:"right_cond,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Ibucket                uint64                    `protobuf:"varint,1,opt,name=ibucket,proto3" json:"ibucket,omitempty"`
+	Nbucket                uint64                    `protobuf:"varint,2,opt,name=nbucket,proto3" json:"nbucket,omitempty"`
+	RelList                []int32                   `protobuf:"varint,3,rep,packed,name=rel_list,json=relList,proto3" json:"rel_list,omitempty"`
+	ColList                []int32                   `protobuf:"varint,4,rep,packed,name=col_list,json=colList,proto3" json:"col_list,omitempty"`
+	Expr                   *plan.Expr                `protobuf:"bytes,5,opt,name=expr,proto3" json:"expr,omitempty"`
+	Types                  []*plan.Type              `protobuf:"bytes,6,rep,name=types,proto3" json:"types,omitempty"`
+	LeftCond               []*plan.Expr              `protobuf:"bytes,7,rep,name=left_cond,json=leftCond,proto3" json:"left_cond,omitempty"`
+	RightCond              []*plan.Expr              `protobuf:"bytes,8,rep,name=right_cond,json=rightCond,proto3" json:"right_cond,omitempty"`
+	RuntimeFilterBuildList []*plan.RuntimeFilterSpec `protobuf:"bytes,9,rep,name=runtime_filter_build_list,json=runtimeFilterBuildList,proto3" json:"runtime_filter_build_list,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}                  `json:"-"`
+	XXX_unrecognized       []byte                    `json:"-"`
+	XXX_sizecache          int32                     `json:"-"`
 }
 
 func (m *Join) Reset()         { *m = Join{} }
 func (m *Join) String() string { return proto.CompactTextString(m) }
 func (*Join) ProtoMessage()    {}
 func (*Join) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{13}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{14}
 }
 func (m *Join) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1180,6 +1302,100 @@ func (m *Join) GetRightCond() []*plan.Expr {
 	return nil
 }
 
+func (m *Join) GetRuntimeFilterBuildList() []*plan.RuntimeFilterSpec {
+	if m != nil {
+		return m.RuntimeFilterBuildList
+	}
+	return nil
+}
+
+type MergeJoin struct {
+	JoinType             int32        `protobuf:"varint,1,opt,name=join_type,json=joinType,proto3" json:"join_type,omitempty"`
+	RelList              []int32      `protobuf:"varint,2,rep,packed,name=rel_list,json=relList,proto3" json:"rel_list,omitempty"`
+	ColList              []int32      `protobuf:"varint,3,rep,packed,name=col_list,json=colList,proto3" json:"col_list,omitempty"`
+	Types                []*plan.Type `protobuf:"bytes,4,rep,name=types,proto3" json:"types,omitempty"`
+	LeftCond             []*plan.Expr `protobuf:"bytes,5,rep,name=left_cond,json=leftCond,proto3" json:"left_cond,omitempty"`
+	RightCond            []*plan.Expr `protobuf:"bytes,6,rep,name=right_cond,json=rightCond,proto3" json:"right_cond,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *MergeJoin) Reset()         { *m = MergeJoin{} }
+func (m *MergeJoin) String() string { return proto.CompactTextString(m) }
+func (*MergeJoin) ProtoMessage()    {}
+func (*MergeJoin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ac67a7adf3df9c7, []int{15}
+}
+func (m *MergeJoin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MergeJoin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MergeJoin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MergeJoin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeJoin.Merge(m, src)
+}
+func (m *MergeJoin) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *MergeJoin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeJoin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MergeJoin proto.InternalMessageInfo
+
+func (m *MergeJoin) GetJoinType() int32 {
+	if m != nil {
+		return m.JoinType
+	}
+	return 0
+}
+
+func (m *MergeJoin) GetRelList() []int32 {
+	if m != nil {
+		return m.RelList
+	}
+	return nil
+}
+
+func (m *MergeJoin) GetColList() []int32 {
+	if m != nil {
+		return m.ColList
+	}
+	return nil
+}
+
+func (m *MergeJoin) GetTypes() []*plan.Type {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+func (m *MergeJoin) GetLeftCond() []*plan.Expr {
+	if m != nil {
+		return m.LeftCond
+	}
+	return nil
+}
+
+func (m *MergeJoin) GetRightCond() []*plan.Expr {
+	if m != nil {
+		return m.RightCond
+	}
+	return nil
+}
+
 type AntiJoin struct {
 	Ibucket              uint64       `protobuf:"varint,1,opt,name=ibucket,proto3" json:"ibucket,omitempty"`
 	Nbucket              uint64       `protobuf:"varint,2,opt,name=nbucket,proto3" json:"nbucket,omitempty"`
@@ -1197,7 +1413,7 @@ func (m *AntiJoin) Reset()         { *m = AntiJoin{} }
 func (m *AntiJoin) String() string { return proto.CompactTextString(m) }
 func (*AntiJoin) ProtoMessage()    {}
 func (*AntiJoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{14}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{16}
 }
 func (m *AntiJoin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1293,7 +1509,7 @@ func (m *InnerJoin) Reset()         { *m = InnerJoin{} }
 func (m *InnerJoin) String() string { return proto.CompactTextString(m) }
 func (*InnerJoin) ProtoMessage()    {}
 func (*InnerJoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{15}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{17}
 }
 func (m *InnerJoin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1396,7 +1612,7 @@ func (m *LeftJoin) Reset()         { *m = LeftJoin{} }
 func (m *LeftJoin) String() string { return proto.CompactTextString(m) }
 func (*LeftJoin) ProtoMessage()    {}
 func (*LeftJoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{16}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{18}
 }
 func (m *LeftJoin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1500,7 +1716,7 @@ func (m *RightJoin) Reset()         { *m = RightJoin{} }
 func (m *RightJoin) String() string { return proto.CompactTextString(m) }
 func (*RightJoin) ProtoMessage()    {}
 func (*RightJoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{17}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{19}
 }
 func (m *RightJoin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1609,7 +1825,7 @@ func (m *RightSemiJoin) Reset()         { *m = RightSemiJoin{} }
 func (m *RightSemiJoin) String() string { return proto.CompactTextString(m) }
 func (*RightSemiJoin) ProtoMessage()    {}
 func (*RightSemiJoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{18}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{20}
 }
 func (m *RightSemiJoin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1704,7 +1920,7 @@ func (m *RightAntiJoin) Reset()         { *m = RightAntiJoin{} }
 func (m *RightAntiJoin) String() string { return proto.CompactTextString(m) }
 func (*RightAntiJoin) ProtoMessage()    {}
 func (*RightAntiJoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{19}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{21}
 }
 func (m *RightAntiJoin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1783,23 +1999,24 @@ func (m *RightAntiJoin) GetRightCond() []*plan.Expr {
 }
 
 type SemiJoin struct {
-	Ibucket              uint64       `protobuf:"varint,1,opt,name=ibucket,proto3" json:"ibucket,omitempty"`
-	Nbucket              uint64       `protobuf:"varint,2,opt,name=nbucket,proto3" json:"nbucket,omitempty"`
-	Result               []int32      `protobuf:"varint,3,rep,packed,name=result,proto3" json:"result,omitempty"`
-	Expr                 *plan.Expr   `protobuf:"bytes,4,opt,name=expr,proto3" json:"expr,omitempty"`
-	Types                []*plan.Type `protobuf:"bytes,5,rep,name=types,proto3" json:"types,omitempty"`
-	LeftCond             []*plan.Expr `protobuf:"bytes,6,rep,name=left_cond,json=leftCond,proto3" json:"left_cond,omitempty"`
-	RightCond            []*plan.Expr `protobuf:"bytes,7,rep,name=right_cond,json=rightCond,proto3" json:"right_cond,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Ibucket                uint64                    `protobuf:"varint,1,opt,name=ibucket,proto3" json:"ibucket,omitempty"`
+	Nbucket                uint64                    `protobuf:"varint,2,opt,name=nbucket,proto3" json:"nbucket,omitempty"`
+	Result                 []int32                   `protobuf:"varint,3,rep,packed,name=result,proto3" json:"result,omitempty"`
+	Expr                   *plan.Expr                `protobuf:"bytes,4,opt,name=expr,proto3" json:"expr,omitempty"`
+	Types                  []*plan.Type              `protobuf:"bytes,5,rep,name=types,proto3" json:"types,omitempty"`
+	LeftCond               []*plan.Expr              `protobuf:"bytes,6,rep,name=left_cond,json=leftCond,proto3" json:"left_cond,omitempty"`
+	RightCond              []*plan.Expr              `protobuf:"bytes,7,rep,name=right_cond,json=rightCond,proto3" json:"right_cond,omitempty"`
+	RuntimeFilterBuildList []*plan.RuntimeFilterSpec `protobuf:"bytes,8,rep,name=runtime_filter_build_list,json=runtimeFilterBuildList,proto3" json:"runtime_filter_build_list,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}                  `json:"-"`
+	XXX_unrecognized       []byte                    `json:"-"`
+	XXX_sizecache          int32                     `json:"-"`
 }
 
 func (m *SemiJoin) Reset()         { *m = SemiJoin{} }
 func (m *SemiJoin) String() string { return proto.CompactTextString(m) }
 func (*SemiJoin) ProtoMessage()    {}
 func (*SemiJoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{20}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{22}
 }
 func (m *SemiJoin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1877,6 +2094,13 @@ func (m *SemiJoin) GetRightCond() []*plan.Expr {
 	return nil
 }
 
+func (m *SemiJoin) GetRuntimeFilterBuildList() []*plan.RuntimeFilterSpec {
+	if m != nil {
+		return m.RuntimeFilterBuildList
+	}
+	return nil
+}
+
 type SingleJoin struct {
 	Ibucket              uint64       `protobuf:"varint,1,opt,name=ibucket,proto3" json:"ibucket,omitempty"`
 	Nbucket              uint64       `protobuf:"varint,2,opt,name=nbucket,proto3" json:"nbucket,omitempty"`
@@ -1895,7 +2119,7 @@ func (m *SingleJoin) Reset()         { *m = SingleJoin{} }
 func (m *SingleJoin) String() string { return proto.CompactTextString(m) }
 func (*SingleJoin) ProtoMessage()    {}
 func (*SingleJoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{21}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{23}
 }
 func (m *SingleJoin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1998,7 +2222,7 @@ func (m *MarkJoin) Reset()         { *m = MarkJoin{} }
 func (m *MarkJoin) String() string { return proto.CompactTextString(m) }
 func (*MarkJoin) ProtoMessage()    {}
 func (*MarkJoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{22}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{24}
 }
 func (m *MarkJoin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2096,7 +2320,7 @@ func (m *Product) Reset()         { *m = Product{} }
 func (m *Product) String() string { return proto.CompactTextString(m) }
 func (*Product) ProtoMessage()    {}
 func (*Product) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{23}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{25}
 }
 func (m *Product) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2161,7 +2385,7 @@ func (m *TableFunction) Reset()         { *m = TableFunction{} }
 func (m *TableFunction) String() string { return proto.CompactTextString(m) }
 func (*TableFunction) ProtoMessage()    {}
 func (*TableFunction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{24}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{26}
 }
 func (m *TableFunction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2226,22 +2450,23 @@ func (m *TableFunction) GetName() string {
 }
 
 type HashBuild struct {
-	NeedExpr             bool         `protobuf:"varint,1,opt,name=need_expr,json=needExpr,proto3" json:"need_expr,omitempty"`
-	NeedHash             bool         `protobuf:"varint,2,opt,name=need_hash,json=needHash,proto3" json:"need_hash,omitempty"`
-	Ibucket              uint64       `protobuf:"varint,3,opt,name=ibucket,proto3" json:"ibucket,omitempty"`
-	Nbucket              uint64       `protobuf:"varint,4,opt,name=nbucket,proto3" json:"nbucket,omitempty"`
-	Types                []*plan.Type `protobuf:"bytes,5,rep,name=types,proto3" json:"types,omitempty"`
-	Conds                []*plan.Expr `protobuf:"bytes,6,rep,name=conds,proto3" json:"conds,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	NeedExpr               bool                      `protobuf:"varint,1,opt,name=need_expr,json=needExpr,proto3" json:"need_expr,omitempty"`
+	NeedHash               bool                      `protobuf:"varint,2,opt,name=need_hash,json=needHash,proto3" json:"need_hash,omitempty"`
+	Ibucket                uint64                    `protobuf:"varint,3,opt,name=ibucket,proto3" json:"ibucket,omitempty"`
+	Nbucket                uint64                    `protobuf:"varint,4,opt,name=nbucket,proto3" json:"nbucket,omitempty"`
+	Types                  []*plan.Type              `protobuf:"bytes,5,rep,name=types,proto3" json:"types,omitempty"`
+	Conds                  []*plan.Expr              `protobuf:"bytes,6,rep,name=conds,proto3" json:"conds,omitempty"`
+	RuntimeFilterBuildList []*plan.RuntimeFilterSpec `protobuf:"bytes,7,rep,name=runtime_filter_build_list,json=runtimeFilterBuildList,proto3" json:"runtime_filter_build_list,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}                  `json:"-"`
+	XXX_unrecognized       []byte                    `json:"-"`
+	XXX_sizecache          int32                     `json:"-"`
 }
 
 func (m *HashBuild) Reset()         { *m = HashBuild{} }
 func (m *HashBuild) String() string { return proto.CompactTextString(m) }
 func (*HashBuild) ProtoMessage()    {}
 func (*HashBuild) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{25}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{27}
 }
 func (m *HashBuild) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2312,6 +2537,13 @@ func (m *HashBuild) GetConds() []*plan.Expr {
 	return nil
 }
 
+func (m *HashBuild) GetRuntimeFilterBuildList() []*plan.RuntimeFilterSpec {
+	if m != nil {
+		return m.RuntimeFilterBuildList
+	}
+	return nil
+}
+
 type ExternalName2ColIndex struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Index                int32    `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
@@ -2324,7 +2556,7 @@ func (m *ExternalName2ColIndex) Reset()         { *m = ExternalName2ColIndex{} }
 func (m *ExternalName2ColIndex) String() string { return proto.CompactTextString(m) }
 func (*ExternalName2ColIndex) ProtoMessage()    {}
 func (*ExternalName2ColIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{26}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{28}
 }
 func (m *ExternalName2ColIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2378,7 +2610,7 @@ func (m *FileOffset) Reset()         { *m = FileOffset{} }
 func (m *FileOffset) String() string { return proto.CompactTextString(m) }
 func (*FileOffset) ProtoMessage()    {}
 func (*FileOffset) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{27}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{29}
 }
 func (m *FileOffset) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2433,7 +2665,7 @@ func (m *ExternalScan) Reset()         { *m = ExternalScan{} }
 func (m *ExternalScan) String() string { return proto.CompactTextString(m) }
 func (*ExternalScan) ProtoMessage()    {}
 func (*ExternalScan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{28}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{30}
 }
 func (m *ExternalScan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2561,6 +2793,7 @@ type Instruction struct {
 	RightSemiJoin        *RightSemiJoin `protobuf:"bytes,29,opt,name=right_semi_join,json=rightSemiJoin,proto3" json:"right_semi_join,omitempty"`
 	RightAntiJoin        *RightAntiJoin `protobuf:"bytes,30,opt,name=right_anti_join,json=rightAntiJoin,proto3" json:"right_anti_join,omitempty"`
 	Delete               *Deletion      `protobuf:"bytes,31,opt,name=delete,proto3" json:"delete,omitempty"`
+	MergeJoin            *MergeJoin     `protobuf:"bytes,32,opt,name=merge_join,json=mergeJoin,proto3" json:"merge_join,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -2570,7 +2803,7 @@ func (m *Instruction) Reset()         { *m = Instruction{} }
 func (m *Instruction) String() string { return proto.CompactTextString(m) }
 func (*Instruction) ProtoMessage()    {}
 func (*Instruction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{29}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{31}
 }
 func (m *Instruction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2816,6 +3049,13 @@ func (m *Instruction) GetDelete() *Deletion {
 	return nil
 }
 
+func (m *Instruction) GetMergeJoin() *MergeJoin {
+	if m != nil {
+		return m.MergeJoin
+	}
+	return nil
+}
+
 type AnalysisList struct {
 	List                 []*plan.AnalyzeInfo `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
@@ -2827,7 +3067,7 @@ func (m *AnalysisList) Reset()         { *m = AnalysisList{} }
 func (m *AnalysisList) String() string { return proto.CompactTextString(m) }
 func (*AnalysisList) ProtoMessage()    {}
 func (*AnalysisList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{30}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{32}
 }
 func (m *AnalysisList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2864,25 +3104,26 @@ func (m *AnalysisList) GetList() []*plan.AnalyzeInfo {
 }
 
 type Source struct {
-	SchemaName           string               `protobuf:"bytes,1,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
-	TableName            string               `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
-	ColList              []string             `protobuf:"bytes,3,rep,name=col_list,json=colList,proto3" json:"col_list,omitempty"`
-	Block                string               `protobuf:"bytes,4,opt,name=block,proto3" json:"block,omitempty"`
-	PushdownId           uint64               `protobuf:"varint,5,opt,name=pushdown_id,json=pushdownId,proto3" json:"pushdown_id,omitempty"`
-	PushdownAddr         string               `protobuf:"bytes,6,opt,name=pushdown_addr,json=pushdownAddr,proto3" json:"pushdown_addr,omitempty"`
-	Expr                 *plan.Expr           `protobuf:"bytes,7,opt,name=expr,proto3" json:"expr,omitempty"`
-	TableDef             *plan.TableDef       `protobuf:"bytes,8,opt,name=tableDef,proto3" json:"tableDef,omitempty"`
-	Timestamp            *timestamp.Timestamp `protobuf:"bytes,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	SchemaName             string                    `protobuf:"bytes,1,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
+	TableName              string                    `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	ColList                []string                  `protobuf:"bytes,3,rep,name=col_list,json=colList,proto3" json:"col_list,omitempty"`
+	Block                  string                    `protobuf:"bytes,4,opt,name=block,proto3" json:"block,omitempty"`
+	PushdownId             uint64                    `protobuf:"varint,5,opt,name=pushdown_id,json=pushdownId,proto3" json:"pushdown_id,omitempty"`
+	PushdownAddr           string                    `protobuf:"bytes,6,opt,name=pushdown_addr,json=pushdownAddr,proto3" json:"pushdown_addr,omitempty"`
+	Expr                   *plan.Expr                `protobuf:"bytes,7,opt,name=expr,proto3" json:"expr,omitempty"`
+	TableDef               *plan.TableDef            `protobuf:"bytes,8,opt,name=tableDef,proto3" json:"tableDef,omitempty"`
+	Timestamp              *timestamp.Timestamp      `protobuf:"bytes,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	RuntimeFilterProbeList []*plan.RuntimeFilterSpec `protobuf:"bytes,10,rep,name=runtime_filter_probe_list,json=runtimeFilterProbeList,proto3" json:"runtime_filter_probe_list,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}                  `json:"-"`
+	XXX_unrecognized       []byte                    `json:"-"`
+	XXX_sizecache          int32                     `json:"-"`
 }
 
 func (m *Source) Reset()         { *m = Source{} }
 func (m *Source) String() string { return proto.CompactTextString(m) }
 func (*Source) ProtoMessage()    {}
 func (*Source) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{31}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{33}
 }
 func (m *Source) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2974,6 +3215,13 @@ func (m *Source) GetTimestamp() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *Source) GetRuntimeFilterProbeList() []*plan.RuntimeFilterSpec {
+	if m != nil {
+		return m.RuntimeFilterProbeList
+	}
+	return nil
+}
+
 type NodeInfo struct {
 	Mcpu                 int32    `protobuf:"varint,1,opt,name=mcpu,proto3" json:"mcpu,omitempty"`
 	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -2988,7 +3236,7 @@ func (m *NodeInfo) Reset()         { *m = NodeInfo{} }
 func (m *NodeInfo) String() string { return proto.CompactTextString(m) }
 func (*NodeInfo) ProtoMessage()    {}
 func (*NodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{32}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{34}
 }
 func (m *NodeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3060,7 +3308,7 @@ func (m *ProcessLimitation) Reset()         { *m = ProcessLimitation{} }
 func (m *ProcessLimitation) String() string { return proto.CompactTextString(m) }
 func (*ProcessLimitation) ProtoMessage()    {}
 func (*ProcessLimitation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{33}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{35}
 }
 func (m *ProcessLimitation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3140,7 +3388,7 @@ func (m *ProcessInfo) Reset()         { *m = ProcessInfo{} }
 func (m *ProcessInfo) String() string { return proto.CompactTextString(m) }
 func (*ProcessInfo) ProtoMessage()    {}
 func (*ProcessInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{34}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{36}
 }
 func (m *ProcessInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3229,7 +3477,7 @@ func (m *SessionInfo) Reset()         { *m = SessionInfo{} }
 func (m *SessionInfo) String() string { return proto.CompactTextString(m) }
 func (*SessionInfo) ProtoMessage()    {}
 func (*SessionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{35}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{37}
 }
 func (m *SessionInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3337,7 +3585,7 @@ func (m *Pipeline) Reset()         { *m = Pipeline{} }
 func (m *Pipeline) String() string { return proto.CompactTextString(m) }
 func (*Pipeline) ProtoMessage()    {}
 func (*Pipeline) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{36}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{38}
 }
 func (m *Pipeline) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3469,7 +3717,7 @@ func (m *WrapNode) Reset()         { *m = WrapNode{} }
 func (m *WrapNode) String() string { return proto.CompactTextString(m) }
 func (*WrapNode) ProtoMessage()    {}
 func (*WrapNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{37}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{39}
 }
 func (m *WrapNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3525,7 +3773,7 @@ func (m *UuidToRegIdx) Reset()         { *m = UuidToRegIdx{} }
 func (m *UuidToRegIdx) String() string { return proto.CompactTextString(m) }
 func (*UuidToRegIdx) ProtoMessage()    {}
 func (*UuidToRegIdx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{38}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{40}
 }
 func (m *UuidToRegIdx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3578,6 +3826,7 @@ func (m *UuidToRegIdx) GetFromAddr() string {
 func init() {
 	proto.RegisterEnum("pipeline.Pipeline_PipelineType", Pipeline_PipelineType_name, Pipeline_PipelineType_value)
 	proto.RegisterType((*Message)(nil), "pipeline.Message")
+	proto.RegisterType((*RuntimeFilter)(nil), "pipeline.RuntimeFilter")
 	proto.RegisterType((*Connector)(nil), "pipeline.Connector")
 	proto.RegisterType((*Dispatch)(nil), "pipeline.Dispatch")
 	proto.RegisterType((*MultiArguemnt)(nil), "pipeline.MultiArguemnt")
@@ -3594,6 +3843,7 @@ func init() {
 	proto.RegisterType((*OnDuplicateKey)(nil), "pipeline.OnDuplicateKey")
 	proto.RegisterMapType((map[string]*plan.Expr)(nil), "pipeline.OnDuplicateKey.OnDuplicateExprEntry")
 	proto.RegisterType((*Join)(nil), "pipeline.Join")
+	proto.RegisterType((*MergeJoin)(nil), "pipeline.MergeJoin")
 	proto.RegisterType((*AntiJoin)(nil), "pipeline.AntiJoin")
 	proto.RegisterType((*InnerJoin)(nil), "pipeline.InnerJoin")
 	proto.RegisterType((*LeftJoin)(nil), "pipeline.LeftJoin")
@@ -3624,207 +3874,220 @@ func init() {
 func init() { proto.RegisterFile("pipeline.proto", fileDescriptor_7ac67a7adf3df9c7) }
 
 var fileDescriptor_7ac67a7adf3df9c7 = []byte{
-	// 3185 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3a, 0xcb, 0x8e, 0x1c, 0x47,
-	0x72, 0xea, 0x77, 0x75, 0x74, 0xcf, 0xab, 0x44, 0x8a, 0xc5, 0xf7, 0xa8, 0x64, 0x5a, 0xa3, 0x07,
-	0x87, 0xd0, 0x18, 0x84, 0x05, 0xcb, 0x32, 0x3d, 0x9c, 0xa1, 0xe4, 0xb6, 0xf8, 0x72, 0xce, 0x10,
-	0x86, 0x05, 0x03, 0x85, 0x9c, 0xaa, 0xec, 0xee, 0xd2, 0x54, 0x57, 0x16, 0xb3, 0xaa, 0xc8, 0x19,
-	0xde, 0xed, 0x83, 0xad, 0x8b, 0xed, 0x1f, 0xd0, 0xd5, 0x07, 0x9f, 0x7c, 0x36, 0x6c, 0xdf, 0xf6,
-	0xb8, 0xf7, 0xbd, 0x2c, 0xb4, 0xd7, 0xdd, 0xdb, 0x1e, 0x85, 0xc5, 0x22, 0x22, 0xb3, 0x1e, 0xdd,
-	0x33, 0x23, 0x72, 0x17, 0x8b, 0xe5, 0x02, 0xab, 0x5b, 0xc6, 0x23, 0xb3, 0x32, 0x1e, 0x19, 0x11,
-	0x19, 0x59, 0xb0, 0x9c, 0x84, 0x89, 0x88, 0xc2, 0x58, 0x6c, 0x26, 0x4a, 0x66, 0xd2, 0xb6, 0x0a,
-	0xf8, 0xd2, 0xcd, 0x49, 0x98, 0x4d, 0xf3, 0x83, 0x4d, 0x5f, 0xce, 0x6e, 0x4d, 0xe4, 0x44, 0xde,
-	0x22, 0x86, 0x83, 0x7c, 0x4c, 0x10, 0x01, 0x34, 0xd2, 0x13, 0x2f, 0x41, 0x12, 0xf1, 0xd8, 0x8c,
-	0x57, 0xb2, 0x70, 0x26, 0xd2, 0x8c, 0xcf, 0x12, 0x8d, 0x70, 0xbf, 0x6e, 0x42, 0xef, 0x81, 0x48,
-	0x53, 0x3e, 0x11, 0xf6, 0x2a, 0xb4, 0xd2, 0x30, 0x70, 0x1a, 0xeb, 0x8d, 0x8d, 0x36, 0xc3, 0x21,
-	0x62, 0xfc, 0x59, 0xe0, 0x34, 0x35, 0xc6, 0x9f, 0x11, 0x46, 0x28, 0xe5, 0xb4, 0xd6, 0x1b, 0x1b,
-	0x43, 0x86, 0x43, 0xdb, 0x86, 0x76, 0xc0, 0x33, 0xee, 0xb4, 0x09, 0x45, 0x63, 0xfb, 0x4f, 0x60,
-	0x39, 0x51, 0xd2, 0xf7, 0xc2, 0x78, 0x2c, 0x3d, 0xa2, 0x76, 0x88, 0x3a, 0x44, 0xec, 0x28, 0x1e,
-	0xcb, 0x5d, 0xe4, 0x72, 0xa0, 0xc7, 0x63, 0x1e, 0x1d, 0xa7, 0xc2, 0xe9, 0x12, 0xb9, 0x00, 0xed,
-	0x65, 0x68, 0x86, 0x81, 0xd3, 0xa3, 0xcf, 0x36, 0xc3, 0x00, 0xbf, 0x91, 0xe7, 0x61, 0xe0, 0x58,
-	0xfa, 0x1b, 0x38, 0xb6, 0x2f, 0x43, 0xff, 0x80, 0x67, 0xfe, 0xd4, 0xf3, 0xe3, 0xcc, 0xe9, 0x13,
-	0xab, 0x45, 0x88, 0x9d, 0x38, 0xb3, 0x2f, 0x81, 0xe5, 0x4f, 0x85, 0x7f, 0x98, 0xe6, 0x33, 0x07,
-	0xd6, 0x1b, 0x1b, 0x4b, 0xac, 0x84, 0x91, 0x96, 0x8a, 0xa7, 0xb9, 0x88, 0x7d, 0xe1, 0x0c, 0xf4,
-	0xbc, 0x02, 0x76, 0x9f, 0x40, 0x7f, 0x47, 0xc6, 0xb1, 0xf0, 0x33, 0xa9, 0xec, 0xeb, 0x30, 0x28,
-	0x74, 0xee, 0x19, 0xbd, 0x74, 0x18, 0x14, 0xa8, 0x51, 0x60, 0xbf, 0x0b, 0x2b, 0x7e, 0xc1, 0xed,
-	0x85, 0x71, 0x20, 0x8e, 0x48, 0x55, 0x1d, 0xb6, 0x5c, 0xa2, 0x47, 0x88, 0x75, 0xbf, 0x69, 0x80,
-	0xb5, 0x1b, 0xa6, 0x09, 0x6e, 0xcf, 0xbe, 0x00, 0xbd, 0x71, 0x1e, 0xfb, 0xd5, 0x92, 0x5d, 0x04,
-	0x47, 0x81, 0xfd, 0x97, 0xb0, 0x12, 0x49, 0x9f, 0x47, 0x5e, 0x39, 0xdb, 0x69, 0xae, 0xb7, 0x36,
-	0x06, 0x5b, 0x6f, 0x6e, 0x96, 0xbe, 0x50, 0xee, 0x8e, 0x2d, 0x13, 0x6f, 0xb5, 0xdb, 0x4f, 0x61,
-	0x55, 0x89, 0x99, 0xcc, 0x44, 0x6d, 0x7a, 0x8b, 0xa6, 0xdb, 0xd5, 0xf4, 0xbf, 0x57, 0x3c, 0x79,
-	0x28, 0x03, 0xc1, 0x56, 0x34, 0x6f, 0x39, 0xdd, 0xfd, 0xef, 0x06, 0x2c, 0x3d, 0xc8, 0xa3, 0x2c,
-	0xdc, 0x56, 0x93, 0x5c, 0xcc, 0xe2, 0x0c, 0x95, 0xbe, 0x1b, 0xa6, 0x19, 0x6d, 0xd2, 0x62, 0x34,
-	0xb6, 0x37, 0xa0, 0xff, 0xb9, 0x92, 0x79, 0x72, 0xef, 0x28, 0x29, 0x36, 0x07, 0x9b, 0xe4, 0x5f,
-	0x88, 0x61, 0x15, 0xd1, 0xfe, 0x10, 0x06, 0x8f, 0x54, 0x20, 0xd4, 0xdd, 0x63, 0xe2, 0x6d, 0x9d,
-	0xe0, 0xad, 0x93, 0xed, 0x2b, 0xd0, 0xdf, 0x13, 0x09, 0x57, 0x1c, 0x77, 0x8d, 0x9e, 0xd4, 0x67,
-	0x15, 0x02, 0x1d, 0x85, 0x98, 0x47, 0x01, 0xf9, 0x51, 0x87, 0x15, 0xa0, 0xfb, 0x08, 0xfa, 0xdb,
-	0x93, 0x89, 0x12, 0x13, 0x9e, 0x91, 0xd7, 0xc8, 0xc4, 0xe8, 0xb4, 0x29, 0x13, 0xf2, 0x4c, 0x14,
-	0xa0, 0xa9, 0x05, 0xc0, 0xb1, 0x7d, 0x0d, 0xda, 0x42, 0xef, 0xa7, 0xb1, 0xb0, 0x1f, 0xc2, 0xbb,
-	0xdf, 0x35, 0xa0, 0x43, 0x42, 0xa0, 0x7f, 0xc5, 0x42, 0x04, 0x9e, 0x78, 0xc6, 0x23, 0xa3, 0x03,
-	0x0b, 0x11, 0xf7, 0x9e, 0xf1, 0x08, 0x77, 0x14, 0x1e, 0xe4, 0xfe, 0xa1, 0xc8, 0xcc, 0xe1, 0x28,
-	0x40, 0xa4, 0xc4, 0x86, 0xd2, 0xd2, 0x14, 0x03, 0xda, 0xeb, 0xd0, 0xc1, 0x4f, 0xa4, 0x4e, 0xfb,
-	0x84, 0x2e, 0x34, 0x01, 0x39, 0xb2, 0xe3, 0x44, 0xa4, 0x4e, 0xa7, 0xce, 0xb1, 0x7f, 0x9c, 0x08,
-	0xa6, 0x09, 0xf6, 0xbb, 0xd0, 0xe6, 0x93, 0x49, 0xea, 0x74, 0x17, 0xfd, 0xa2, 0xd4, 0x02, 0x23,
-	0x06, 0xfb, 0x36, 0xf4, 0xb5, 0x35, 0x91, 0xbb, 0x47, 0xdc, 0x17, 0x2a, 0xee, 0x39, 0x43, 0xb3,
-	0x8a, 0xd3, 0xfd, 0xb7, 0x26, 0x74, 0x47, 0x71, 0x2a, 0x14, 0x1d, 0x21, 0x3e, 0x1e, 0x0b, 0x3f,
-	0x13, 0x45, 0x48, 0x28, 0x61, 0xa4, 0x8d, 0x52, 0x46, 0x1e, 0x64, 0xb4, 0x5b, 0xc2, 0xf6, 0xfb,
-	0xb0, 0xc6, 0x83, 0xc0, 0x2b, 0x78, 0x3d, 0x25, 0x9f, 0xa7, 0xa4, 0x0a, 0x8b, 0xad, 0xf0, 0x20,
-	0xd8, 0x36, 0x78, 0x26, 0x9f, 0xa7, 0xf6, 0xdb, 0xd0, 0x52, 0x62, 0x4c, 0x06, 0x1f, 0x6c, 0xad,
-	0x68, 0x71, 0x1f, 0x1d, 0x7c, 0x25, 0xfc, 0x8c, 0x89, 0x31, 0x43, 0x9a, 0x7d, 0x0e, 0x3a, 0x3c,
-	0xcb, 0x94, 0xd6, 0x49, 0x9f, 0x69, 0xc0, 0xde, 0x84, 0x37, 0x13, 0xae, 0xb2, 0x30, 0x0b, 0x65,
-	0xec, 0x65, 0xfc, 0x20, 0xc2, 0x13, 0xaa, 0xd5, 0xd2, 0x66, 0x6b, 0x25, 0x69, 0x1f, 0x29, 0xa3,
-	0x20, 0xb5, 0xdf, 0x81, 0xa5, 0x8a, 0x3f, 0x0c, 0x8e, 0x28, 0xb6, 0x74, 0xd8, 0xb0, 0x44, 0x8e,
-	0x82, 0x23, 0xfb, 0x3c, 0x74, 0xc3, 0xd4, 0x13, 0xb1, 0x8e, 0x33, 0x16, 0xeb, 0x84, 0xe9, 0xbd,
-	0x38, 0x70, 0xaf, 0x42, 0x67, 0x5b, 0x29, 0x7e, 0x4c, 0x5b, 0xc1, 0x81, 0xd3, 0x58, 0x6f, 0x6d,
-	0x74, 0x98, 0x06, 0x5c, 0x1f, 0x5a, 0x0f, 0x78, 0x62, 0xdf, 0x80, 0xe6, 0x2c, 0x21, 0xca, 0x60,
-	0xeb, 0x7c, 0x4d, 0xd3, 0x3c, 0xd9, 0x7c, 0x90, 0xdc, 0x8b, 0x33, 0x75, 0xcc, 0x9a, 0xb3, 0xe4,
-	0xd2, 0x6d, 0xe8, 0x19, 0x10, 0x43, 0xe9, 0xa1, 0x38, 0x26, 0xdd, 0xf6, 0x19, 0x0e, 0xf1, 0x03,
-	0xcf, 0x78, 0x94, 0x0b, 0x13, 0x45, 0x34, 0xf0, 0x17, 0xcd, 0x8f, 0x1b, 0xee, 0x7f, 0xb6, 0xc1,
-	0xda, 0x15, 0x91, 0xc0, 0xad, 0xa2, 0x9f, 0xef, 0xa7, 0xc6, 0x26, 0xcd, 0xfd, 0xd4, 0x76, 0x61,
-	0x58, 0xd7, 0xaa, 0xf1, 0xc8, 0x39, 0x1c, 0xf2, 0x68, 0xfb, 0xd0, 0x2a, 0xc2, 0x18, 0x64, 0x0e,
-	0x87, 0xae, 0x3b, 0xba, 0xab, 0x5d, 0xb7, 0x4d, 0x31, 0xb3, 0x00, 0x91, 0xf2, 0xd0, 0x50, 0x3a,
-	0x9a, 0x62, 0x40, 0xfb, 0x0a, 0x80, 0x92, 0xcf, 0xbd, 0x30, 0x20, 0xad, 0x76, 0x69, 0xdf, 0x96,
-	0x92, 0xcf, 0x47, 0x01, 0x6a, 0xf4, 0x0c, 0x33, 0xf5, 0xce, 0x32, 0xd3, 0x9f, 0x83, 0x53, 0x33,
-	0x13, 0x86, 0x4e, 0x2f, 0x8c, 0x3d, 0x8a, 0xea, 0x64, 0x93, 0x0e, 0x3b, 0x5f, 0x59, 0x0c, 0xc9,
-	0xa3, 0xf8, 0x2e, 0xc5, 0x54, 0xe3, 0x48, 0xfd, 0xef, 0x71, 0xa4, 0x53, 0xfd, 0x12, 0x4e, 0xf7,
-	0xcb, 0xbb, 0x00, 0x7b, 0x62, 0x32, 0x13, 0x71, 0xf6, 0x80, 0x27, 0xce, 0x80, 0x8c, 0xea, 0x56,
-	0x46, 0x2d, 0x2c, 0xb1, 0x59, 0x31, 0x69, 0x0b, 0xd7, 0x66, 0xd9, 0x6f, 0xc3, 0xd0, 0xe7, 0xb1,
-	0x97, 0xa9, 0x3c, 0xf6, 0x79, 0x26, 0x9c, 0x21, 0x7d, 0x6a, 0xe0, 0xf3, 0x78, 0xdf, 0xa0, 0x6a,
-	0x0e, 0xb7, 0x54, 0x73, 0xb8, 0x4b, 0x9f, 0xc2, 0xca, 0xc2, 0xc2, 0xbf, 0x91, 0xaf, 0xfc, 0x5f,
-	0x03, 0xfa, 0x8f, 0x95, 0x30, 0xc7, 0xf8, 0x3a, 0x0c, 0x52, 0x7f, 0x2a, 0x66, 0xdc, 0x8b, 0xf9,
-	0x4c, 0x98, 0x15, 0x40, 0xa3, 0x1e, 0xf2, 0x99, 0xb0, 0x3f, 0x80, 0xbe, 0xb6, 0x4c, 0x20, 0xc6,
-	0xb4, 0xd8, 0x60, 0x6b, 0xd9, 0x04, 0x1e, 0x44, 0xef, 0x8a, 0x31, 0xb3, 0x32, 0x33, 0xc2, 0x7d,
-	0xa0, 0x9d, 0x5b, 0x74, 0x00, 0x70, 0x58, 0x9d, 0xcf, 0x76, 0xfd, 0x7c, 0xae, 0xc3, 0x70, 0xca,
-	0x53, 0x8f, 0xe7, 0x99, 0xf4, 0x7c, 0x19, 0x91, 0xd7, 0x58, 0x0c, 0xa6, 0x3c, 0xdd, 0xce, 0x33,
-	0xb9, 0x23, 0x23, 0x0c, 0xaf, 0x61, 0xea, 0xe5, 0x49, 0x80, 0xba, 0xe9, 0xea, 0x18, 0x12, 0xa6,
-	0x4f, 0x08, 0x76, 0x19, 0xac, 0x94, 0x12, 0x3c, 0x89, 0xc3, 0xa7, 0xb9, 0xb0, 0xef, 0xc0, 0x5a,
-	0xa2, 0x84, 0x17, 0x12, 0xce, 0xcb, 0x0f, 0x3d, 0x3f, 0x3b, 0x22, 0x69, 0x06, 0x5b, 0xe7, 0xf4,
-	0x76, 0xab, 0x19, 0x87, 0x3b, 0xd9, 0x11, 0x5b, 0x4e, 0xe6, 0x60, 0xf7, 0xdf, 0x9b, 0xb0, 0xfc,
-	0x28, 0xde, 0xcd, 0x93, 0x28, 0x44, 0xe5, 0x7f, 0x21, 0x8e, 0xe7, 0x45, 0x6f, 0xbc, 0x44, 0xf4,
-	0x0d, 0x58, 0x95, 0xb1, 0x17, 0x14, 0xf3, 0xc9, 0xdf, 0x9b, 0xa4, 0x87, 0x65, 0x59, 0x2d, 0x8b,
-	0x5e, 0xff, 0x0f, 0xb0, 0x36, 0xc7, 0x29, 0xaa, 0x04, 0x78, 0xb3, 0x72, 0xa2, 0xf9, 0xbd, 0xd4,
-	0x41, 0x4c, 0x09, 0xda, 0x9f, 0x56, 0xe4, 0x3c, 0xf6, 0xd2, 0x43, 0x38, 0x77, 0x1a, 0xe3, 0x29,
-	0xfe, 0xb1, 0x5e, 0xf7, 0x8f, 0x85, 0x6c, 0x53, 0xf9, 0xca, 0x3f, 0x37, 0xa1, 0xfd, 0xb7, 0x32,
-	0x8c, 0xeb, 0x09, 0xad, 0x71, 0x66, 0x42, 0x6b, 0xce, 0x27, 0xb4, 0x8b, 0x60, 0x29, 0x11, 0x79,
-	0x11, 0xe6, 0x58, 0xed, 0x11, 0x3d, 0x25, 0xa2, 0xfb, 0x98, 0x66, 0x2f, 0x82, 0xe5, 0x4b, 0x43,
-	0x6a, 0x6b, 0x92, 0x2f, 0x35, 0xa9, 0xc8, 0xc0, 0x9d, 0xd3, 0x33, 0x70, 0x95, 0x04, 0xbb, 0x67,
-	0x27, 0xc1, 0x7e, 0x24, 0xc6, 0x19, 0xd6, 0x39, 0x81, 0xc9, 0x6d, 0xf5, 0x65, 0x2c, 0x24, 0xee,
-	0xc8, 0x38, 0xb0, 0xdf, 0x03, 0x50, 0xe1, 0x64, 0x6a, 0x38, 0xad, 0x93, 0xe5, 0x0a, 0x51, 0x91,
-	0xd5, 0xfd, 0x79, 0x03, 0xac, 0xed, 0x38, 0x0b, 0x7f, 0x6b, 0x65, 0xbc, 0x05, 0x5d, 0x25, 0xd2,
-	0x3c, 0x2a, 0x54, 0x61, 0xa0, 0x52, 0xdc, 0xf6, 0xcb, 0xc4, 0xed, 0xbc, 0x92, 0xb8, 0xdd, 0x57,
-	0x16, 0xb7, 0xf7, 0x7d, 0xe2, 0xfe, 0x6b, 0x13, 0xfa, 0xa3, 0x38, 0x16, 0xea, 0x07, 0xe3, 0xc7,
-	0x81, 0xfb, 0x2f, 0x4d, 0xb0, 0xee, 0x8b, 0x71, 0xf6, 0x83, 0x32, 0xe2, 0xc0, 0xfd, 0xff, 0x26,
-	0xf4, 0x19, 0x42, 0x7f, 0x60, 0xda, 0x78, 0x0f, 0x80, 0x64, 0x3d, 0x4b, 0x25, 0xa4, 0x89, 0x7d,
-	0x52, 0xcb, 0x07, 0x30, 0xd0, 0xd2, 0x6a, 0xde, 0xde, 0x09, 0x5e, 0xad, 0x8c, 0xfd, 0x93, 0x3a,
-	0xb4, 0x5e, 0x59, 0x87, 0xfd, 0xef, 0xd3, 0xe1, 0x77, 0x0d, 0x58, 0x22, 0x1d, 0xee, 0x89, 0xd9,
-	0xef, 0x3f, 0xa4, 0x2c, 0x88, 0xdf, 0x79, 0x75, 0xf1, 0x7f, 0x47, 0xd1, 0xa5, 0x14, 0xff, 0xb5,
-	0x44, 0xd4, 0xd7, 0x2e, 0x3e, 0xe6, 0x92, 0xd7, 0x62, 0xf8, 0xd7, 0x93, 0x4b, 0xbe, 0x6e, 0x02,
-	0xec, 0x85, 0xf1, 0x24, 0x12, 0x3f, 0xc4, 0xcf, 0x38, 0xc0, 0x2b, 0xb4, 0xf5, 0x80, 0xab, 0xc3,
-	0x3f, 0x0e, 0xeb, 0xdb, 0xef, 0x40, 0x4f, 0xc6, 0xda, 0x3c, 0x27, 0xd5, 0xd2, 0x95, 0x31, 0x5a,
-	0xca, 0xe5, 0xd0, 0x7b, 0xac, 0x64, 0x90, 0xfb, 0xf3, 0xa6, 0x6e, 0x9c, 0x6d, 0xea, 0xe6, 0xbc,
-	0xa9, 0x4b, 0xd9, 0x5a, 0x67, 0xc8, 0xe6, 0xfe, 0x47, 0x03, 0x96, 0xa8, 0x6a, 0xff, 0x2c, 0x8f,
-	0x7d, 0xba, 0x26, 0x97, 0x37, 0x93, 0xc6, 0xfc, 0xcd, 0xa4, 0xad, 0x44, 0x96, 0x9a, 0xe6, 0xd5,
-	0x50, 0x2f, 0xb4, 0x23, 0x23, 0x2c, 0xf6, 0x89, 0x82, 0x7a, 0xe6, 0x6a, 0x92, 0x9e, 0xd2, 0xb2,
-	0x22, 0x3c, 0xda, 0x27, 0xe1, 0x8a, 0xcf, 0x52, 0xd3, 0xf2, 0x34, 0x90, 0x6d, 0x43, 0x9b, 0xae,
-	0x58, 0x1d, 0x2a, 0xc2, 0x69, 0xec, 0xfe, 0x4f, 0x03, 0xfa, 0x7f, 0xc3, 0xd3, 0xe9, 0xdd, 0x3c,
-	0x8c, 0x82, 0xaa, 0xa5, 0x84, 0x66, 0xac, 0xb7, 0x94, 0xd0, 0x7c, 0x05, 0x71, 0xca, 0xd3, 0x69,
-	0xd1, 0x54, 0x41, 0x04, 0x4e, 0xaf, 0xfb, 0x51, 0xeb, 0x4c, 0x3f, 0x6a, 0x9f, 0xe8, 0x37, 0xbd,
-	0xc4, 0x1f, 0xd6, 0xa1, 0x83, 0x06, 0x4e, 0x4f, 0xf1, 0x05, 0x4d, 0x70, 0xb7, 0xe1, 0xfc, 0xbd,
-	0xa3, 0x4c, 0xa8, 0x98, 0x47, 0x78, 0x59, 0xdc, 0xda, 0x91, 0x11, 0xdd, 0xbb, 0x4b, 0x61, 0x1b,
-	0x95, 0xb0, 0xa8, 0xf0, 0x7a, 0x13, 0x54, 0x03, 0xee, 0x0d, 0x18, 0x8c, 0xc3, 0x48, 0x78, 0x72,
-	0x3c, 0x4e, 0xb5, 0x77, 0xeb, 0x11, 0x99, 0xa5, 0xc5, 0x0c, 0xe4, 0xfe, 0xaa, 0x09, 0xc3, 0xe2,
-	0x53, 0x7b, 0x3e, 0x3f, 0xcb, 0x7c, 0x97, 0xa1, 0x4f, 0xab, 0xa5, 0xe1, 0x0b, 0x41, 0x36, 0x6c,
-	0x31, 0x0b, 0x11, 0x7b, 0xe1, 0x0b, 0x61, 0x6f, 0xc3, 0x5a, 0xed, 0x53, 0x5e, 0x26, 0x33, 0x1e,
-	0x19, 0x33, 0xd6, 0x5a, 0x32, 0x35, 0x16, 0xb6, 0x82, 0xc0, 0x23, 0x1a, 0xef, 0x23, 0x37, 0xba,
-	0x87, 0x2f, 0xa3, 0xa2, 0x47, 0xb7, 0xe0, 0x1e, 0x48, 0xb1, 0x3f, 0x87, 0x15, 0x94, 0x76, 0x0b,
-	0xef, 0xb5, 0xa6, 0xe9, 0xab, 0x15, 0x7c, 0xbd, 0xfa, 0xc4, 0xa9, 0x3a, 0x63, 0x4b, 0xf1, 0x9c,
-	0x0a, 0xaf, 0x02, 0xf8, 0x4a, 0xe0, 0x05, 0x31, 0x7d, 0x1a, 0xd1, 0x15, 0xb8, 0xcf, 0xfa, 0x1a,
-	0xb3, 0xf7, 0x34, 0x2a, 0x25, 0xa5, 0xe3, 0xd0, 0x23, 0x1d, 0x90, 0xa4, 0x74, 0x1e, 0x6e, 0xc2,
-	0x40, 0xaa, 0x70, 0x12, 0xc6, 0x1e, 0xed, 0xd6, 0x3a, 0x65, 0xb7, 0xa0, 0x19, 0x76, 0x70, 0xcf,
-	0x2e, 0x74, 0xc7, 0x61, 0x94, 0x09, 0x65, 0x3a, 0x24, 0x73, 0x67, 0x54, 0x53, 0xdc, 0x7f, 0x1a,
-	0xc0, 0x60, 0x14, 0xa7, 0x99, 0xca, 0xfd, 0xa2, 0xcb, 0x34, 0xd7, 0x4d, 0x35, 0x57, 0x7f, 0x6d,
-	0x5b, 0xba, 0xfa, 0xff, 0x29, 0xb4, 0x79, 0x9c, 0x85, 0xa6, 0x97, 0x5a, 0xeb, 0x32, 0x17, 0x69,
-	0x9f, 0x11, 0xdd, 0xbe, 0x09, 0x3d, 0xd3, 0x92, 0x36, 0xb1, 0xeb, 0xd4, 0x7e, 0x76, 0xc1, 0x63,
-	0x6f, 0x82, 0x15, 0x98, 0x5e, 0xb9, 0x09, 0xed, 0xb5, 0xa5, 0x8b, 0x2e, 0x3a, 0x2b, 0x79, 0xec,
-	0xb7, 0xa1, 0xc5, 0x27, 0x13, 0x52, 0x20, 0xf5, 0x7e, 0x0a, 0x56, 0x6a, 0xe3, 0x32, 0xa4, 0xd9,
-	0x5b, 0x00, 0x21, 0xde, 0x76, 0xbc, 0xaf, 0x64, 0x18, 0x53, 0xef, 0x6f, 0x6e, 0x13, 0xe5, 0x4d,
-	0x88, 0xf5, 0xc3, 0xf2, 0x52, 0x74, 0xcb, 0x04, 0x4b, 0x9a, 0x62, 0x2d, 0xee, 0xa3, 0xb8, 0x2e,
-	0xe8, 0xa0, 0x59, 0x4c, 0x48, 0xc5, 0x2c, 0xd4, 0x13, 0xfa, 0x8b, 0x13, 0x8a, 0x82, 0x80, 0x59,
-	0x69, 0x51, 0x1a, 0xdc, 0x86, 0x41, 0x4a, 0x79, 0x53, 0x4f, 0x81, 0xa2, 0x99, 0x51, 0x4e, 0x29,
-	0x93, 0x2a, 0x83, 0xb4, 0x4a, 0xb0, 0xb7, 0xa0, 0x3f, 0xe3, 0xea, 0x50, 0x4f, 0x1a, 0x2c, 0x7e,
-	0xa7, 0x48, 0x3d, 0xcc, 0x9a, 0x15, 0x49, 0xc8, 0x85, 0x36, 0xf1, 0x0e, 0x8b, 0x0e, 0x47, 0xc1,
-	0xab, 0x6d, 0x84, 0x34, 0xfb, 0x03, 0xe8, 0x25, 0x3a, 0x42, 0x53, 0x2f, 0x6a, 0xb0, 0xb5, 0x56,
-	0xb1, 0x99, 0xd0, 0xcd, 0x0a, 0x0e, 0xfb, 0xaf, 0x60, 0x59, 0xf7, 0x4d, 0xc6, 0x26, 0xd6, 0x3a,
-	0xcb, 0x34, 0xa7, 0xd6, 0x61, 0x9e, 0x0b, 0xc5, 0x6c, 0x29, 0x9b, 0x8b, 0xcc, 0x5b, 0x00, 0x18,
-	0xe5, 0xbc, 0x03, 0x8c, 0x8a, 0xce, 0xca, 0xa2, 0x39, 0xca, 0x80, 0xc9, 0xfa, 0xd3, 0x32, 0x76,
-	0x7e, 0x02, 0x4b, 0xc2, 0x9c, 0x2a, 0x2f, 0xf5, 0x79, 0xec, 0xac, 0xd2, 0xb4, 0xb7, 0x4e, 0x1e,
-	0x3a, 0x8c, 0x1e, 0x6c, 0x28, 0xea, 0xb1, 0x64, 0x03, 0xba, 0xba, 0x71, 0xe4, 0xac, 0xd1, 0xac,
-	0xd5, 0xba, 0xed, 0x11, 0xcf, 0x0c, 0xdd, 0xbe, 0xbb, 0xd0, 0xe5, 0x39, 0x14, 0xc7, 0x8e, 0x4d,
-	0x73, 0x9c, 0xb3, 0x5a, 0x37, 0x73, 0xfd, 0x9f, 0x2f, 0xc4, 0x31, 0x8a, 0x57, 0xb5, 0xaa, 0x9c,
-	0x37, 0x17, 0xc5, 0x2b, 0xfb, 0x54, 0xac, 0x5f, 0xb6, 0xa8, 0xec, 0x7b, 0xf3, 0xed, 0x2d, 0xea,
-	0x79, 0x39, 0xe7, 0x68, 0xea, 0xc5, 0x53, 0xa6, 0xea, 0xa6, 0x18, 0x5b, 0x49, 0x16, 0xba, 0x64,
-	0x1f, 0x82, 0x25, 0x55, 0x20, 0x94, 0x77, 0x70, 0xec, 0x9c, 0xa7, 0xa0, 0xb0, 0x66, 0x9a, 0xa1,
-	0xfa, 0xb1, 0x65, 0x2f, 0x11, 0x3e, 0xeb, 0x49, 0x0d, 0xd8, 0x37, 0x61, 0x98, 0x28, 0xf9, 0x95,
-	0xf0, 0x33, 0x1d, 0x65, 0xde, 0x3a, 0xf9, 0x48, 0x63, 0xe8, 0x14, 0x74, 0xaa, 0x28, 0x72, 0xe1,
-	0xac, 0x28, 0x82, 0x51, 0x3b, 0x0a, 0x67, 0x61, 0xe6, 0x38, 0x94, 0x8c, 0x34, 0x50, 0x0b, 0xfa,
-	0x17, 0x09, 0x6d, 0x20, 0x4a, 0x6b, 0xe9, 0x67, 0xa1, 0x4a, 0x33, 0xe7, 0x12, 0x65, 0xbc, 0x02,
-	0xc4, 0x19, 0x61, 0x7a, 0x9f, 0xa7, 0x99, 0x73, 0x99, 0x08, 0x06, 0x42, 0xdd, 0xea, 0xca, 0x84,
-	0x3c, 0xfa, 0xca, 0xa2, 0x6e, 0xcb, 0x8b, 0xab, 0x29, 0x51, 0xc8, 0xff, 0xef, 0xc0, 0x8a, 0x9e,
-	0x53, 0x1d, 0xcf, 0xab, 0x8b, 0xfe, 0x3a, 0x77, 0x5b, 0x63, 0x4b, 0x6a, 0xee, 0xf2, 0x56, 0x2e,
-	0x80, 0xe1, 0x4c, 0x2f, 0x70, 0xed, 0xd4, 0x05, 0xca, 0xc0, 0xa7, 0x17, 0x28, 0xaf, 0x3f, 0xef,
-	0x43, 0x37, 0xd0, 0x7d, 0xf7, 0xeb, 0x27, 0x02, 0x9a, 0xe9, 0x25, 0x33, 0xc3, 0xe1, 0xde, 0x86,
-	0xe1, 0x36, 0x3d, 0x83, 0x86, 0x29, 0x69, 0xfd, 0x06, 0xb4, 0xcb, 0x62, 0xa9, 0x34, 0x27, 0x71,
-	0xbc, 0x10, 0xa3, 0x78, 0x2c, 0x19, 0x91, 0xdd, 0xff, 0x6d, 0x42, 0x77, 0x4f, 0xe6, 0xca, 0x17,
-	0x2f, 0x6f, 0xf9, 0x5e, 0x05, 0xd0, 0xe7, 0x97, 0xe8, 0x4d, 0x9d, 0x79, 0x08, 0x43, 0xe4, 0x7a,
-	0x1d, 0xd6, 0xa2, 0xc4, 0x53, 0xd6, 0x61, 0xe7, 0xa0, 0x73, 0x10, 0x49, 0xff, 0xd0, 0xbc, 0xd1,
-	0x69, 0x80, 0x1e, 0x4a, 0xf3, 0x74, 0x1a, 0xc8, 0xe7, 0xb1, 0x17, 0xea, 0x37, 0xba, 0x36, 0x83,
-	0x02, 0x35, 0x0a, 0xe8, 0xf9, 0xa5, 0x60, 0xe0, 0x41, 0xa0, 0x4c, 0xb6, 0x1b, 0x16, 0xc8, 0xed,
-	0x20, 0x50, 0x65, 0x7d, 0xdb, 0x3b, 0xa3, 0xbe, 0x7d, 0x1f, 0xca, 0x66, 0xac, 0x89, 0xc7, 0x67,
-	0x37, 0x6b, 0xb7, 0xa0, 0x5f, 0xbe, 0x74, 0x9b, 0x58, 0x7c, 0x6e, 0xb3, 0x7a, 0xfb, 0xde, 0x2f,
-	0x46, 0xac, 0x62, 0x73, 0xff, 0x11, 0xac, 0x87, 0x32, 0x20, 0x9d, 0x62, 0x79, 0x33, 0xf3, 0x93,
-	0xdc, 0xa4, 0x3f, 0x1a, 0x9b, 0x47, 0x69, 0xad, 0x2d, 0xf3, 0x28, 0x4d, 0xb2, 0xb4, 0x74, 0x09,
-	0x84, 0x63, 0x74, 0xe8, 0x84, 0x1f, 0x47, 0x92, 0x07, 0xa6, 0x1f, 0x5e, 0x80, 0xee, 0x7f, 0x35,
-	0x60, 0xed, 0xb1, 0x92, 0xbe, 0x48, 0xd3, 0xfb, 0x78, 0x26, 0x38, 0x45, 0x42, 0x1b, 0xda, 0x54,
-	0xc9, 0xe0, 0x77, 0x5a, 0x8c, 0xc6, 0x68, 0x1d, 0xfd, 0xb0, 0xad, 0x8a, 0xc7, 0x9c, 0x16, 0xd3,
-	0x4f, 0xdd, 0xf4, 0x36, 0x51, 0x92, 0x69, 0x62, 0xab, 0x46, 0xa6, 0x1a, 0xe8, 0x06, 0x2c, 0x57,
-	0x4f, 0x28, 0xb4, 0x42, 0x9b, 0x58, 0xaa, 0xf7, 0x2f, 0x5a, 0xe5, 0x3a, 0x0c, 0x94, 0xe0, 0x18,
-	0x29, 0x68, 0x99, 0x0e, 0xf1, 0x80, 0x46, 0xe1, 0x3a, 0xee, 0x2f, 0x1a, 0x30, 0x30, 0xfb, 0x25,
-	0x8d, 0x68, 0xe9, 0x1b, 0xa5, 0xf4, 0x37, 0xa1, 0x15, 0x85, 0x33, 0xd3, 0x5d, 0xbe, 0x3c, 0x97,
-	0x2c, 0xe6, 0x65, 0x64, 0xc8, 0x87, 0xd5, 0x4c, 0x1e, 0x87, 0x47, 0x1e, 0xaa, 0xdb, 0x6c, 0xda,
-	0x42, 0x04, 0x5a, 0x82, 0x5e, 0xe4, 0x63, 0x9e, 0xa4, 0x53, 0x99, 0x19, 0xc7, 0x2a, 0x61, 0xfb,
-	0x63, 0x18, 0xa6, 0x22, 0x4d, 0xf5, 0x83, 0xd0, 0x58, 0x9a, 0x8a, 0xe0, 0x7c, 0x3d, 0xb1, 0x12,
-	0x95, 0x8e, 0xc2, 0x20, 0xad, 0x00, 0xfb, 0x43, 0xb0, 0xb9, 0x39, 0x48, 0x5e, 0x2c, 0x03, 0x53,
-	0x49, 0x75, 0xe9, 0x62, 0xb1, 0x5a, 0x50, 0xd0, 0xe2, 0x74, 0x45, 0xf9, 0x49, 0x03, 0x06, 0xb5,
-	0xa5, 0xe8, 0x97, 0x83, 0x54, 0xa8, 0xa2, 0xc0, 0xc5, 0x31, 0xe2, 0xa6, 0xd2, 0x3c, 0x28, 0xf7,
-	0x19, 0x8d, 0x11, 0xa7, 0x64, 0x24, 0x0a, 0x2f, 0xc0, 0x31, 0xba, 0xbb, 0x29, 0x66, 0xf4, 0x73,
-	0xa3, 0xa9, 0xcc, 0x87, 0x15, 0x72, 0x44, 0x6f, 0xa8, 0x01, 0xcf, 0xf8, 0x01, 0x4f, 0x8b, 0x2b,
-	0x43, 0x09, 0xa3, 0x1b, 0x3d, 0x13, 0x0a, 0xf7, 0x62, 0x4e, 0x4a, 0x01, 0xa2, 0x1e, 0x51, 0x85,
-	0xde, 0x0b, 0x19, 0x0b, 0x3a, 0x29, 0x43, 0x66, 0x21, 0xe2, 0x4b, 0x19, 0xd3, 0x34, 0xee, 0xfb,
-	0x32, 0x8f, 0x33, 0x3a, 0x20, 0x7d, 0x56, 0x80, 0xee, 0x2f, 0xdb, 0x60, 0x3d, 0x36, 0x1a, 0xb3,
-	0x77, 0x61, 0xa9, 0xfc, 0xaf, 0x01, 0x2f, 0x02, 0x24, 0xe3, 0x72, 0xbd, 0x7e, 0x7d, 0xbc, 0x38,
-	0xa0, 0x5b, 0xc3, 0x30, 0xa9, 0x41, 0x8b, 0x7f, 0x47, 0x34, 0x4f, 0xfc, 0x1d, 0x71, 0x05, 0x5a,
-	0x4f, 0xd5, 0xf1, 0xfc, 0x4b, 0xfb, 0xe3, 0x88, 0xc7, 0x0c, 0xd1, 0xf6, 0x47, 0x30, 0x40, 0x71,
-	0xbd, 0x94, 0x62, 0x96, 0x29, 0x0c, 0x6b, 0x79, 0x59, 0xc7, 0x32, 0x06, 0xc8, 0x64, 0xe2, 0xda,
-	0x26, 0x58, 0xfe, 0x34, 0x8c, 0x02, 0x25, 0x62, 0x53, 0x72, 0xdb, 0x27, 0xb7, 0xcc, 0x4a, 0x1e,
-	0xfb, 0xaf, 0x61, 0x35, 0xac, 0x0a, 0xda, 0xca, 0xfc, 0x73, 0xee, 0x53, 0x2b, 0x79, 0xd9, 0x4a,
-	0x8d, 0x9d, 0xc2, 0x5d, 0xf5, 0x40, 0xd7, 0xab, 0x3d, 0xd0, 0xd9, 0x17, 0x30, 0x6d, 0x55, 0x85,
-	0x21, 0x65, 0x27, 0x8a, 0xf3, 0x9a, 0x40, 0xc7, 0xbf, 0x5f, 0xa6, 0x2d, 0xc9, 0x03, 0x2c, 0x95,
-	0xd1, 0x05, 0x4d, 0x8d, 0x57, 0xdb, 0x76, 0x11, 0x71, 0x18, 0xd1, 0xe9, 0xc7, 0x99, 0x3c, 0x9d,
-	0x7a, 0x3a, 0x94, 0xa2, 0xbf, 0x0f, 0xcc, 0x43, 0x75, 0x9e, 0x4e, 0x77, 0x31, 0x98, 0xa2, 0x6f,
-	0xde, 0x80, 0xe5, 0x42, 0x48, 0x4f, 0x9b, 0x7b, 0x48, 0x5c, 0x4b, 0x05, 0x76, 0x07, 0x91, 0xf6,
-	0x1d, 0x58, 0xcd, 0xf3, 0x30, 0x48, 0xbd, 0x4c, 0x7a, 0x4a, 0x4c, 0xe8, 0xc5, 0x6a, 0x89, 0xe4,
-	0xaf, 0x55, 0x4d, 0x4f, 0xf2, 0x30, 0xd8, 0x97, 0x4c, 0x4c, 0x46, 0xc1, 0x11, 0x5b, 0x22, 0xfe,
-	0x02, 0x74, 0xef, 0xc0, 0xb0, 0xee, 0x00, 0x76, 0x1f, 0x3a, 0x0f, 0x84, 0x9a, 0x88, 0xd5, 0x37,
-	0x6c, 0x80, 0xee, 0x43, 0xa9, 0x66, 0x3c, 0x5a, 0x6d, 0xe0, 0x58, 0xbf, 0x23, 0xaf, 0x36, 0xed,
-	0x21, 0x58, 0x8f, 0xb9, 0xe2, 0x51, 0x24, 0xa2, 0xd5, 0x96, 0xfb, 0x09, 0x58, 0xc5, 0x1f, 0x27,
-	0x74, 0xbf, 0xc5, 0x53, 0x48, 0x31, 0x53, 0x9f, 0x2a, 0x0b, 0x11, 0x14, 0xfb, 0x8b, 0x1f, 0x7c,
-	0x9a, 0xd5, 0x0f, 0x3e, 0xee, 0xdf, 0xc1, 0xb0, 0xbe, 0xb9, 0xe2, 0x02, 0xd2, 0xa8, 0x2e, 0x20,
-	0xa7, 0xcc, 0xa2, 0x6b, 0x93, 0x92, 0x33, 0xaf, 0x16, 0x9a, 0x2d, 0x44, 0xe0, 0x67, 0xee, 0xee,
-	0xfc, 0xe8, 0xdb, 0x6b, 0x8d, 0x1f, 0x7f, 0x7b, 0xad, 0xf1, 0xd3, 0x6f, 0xaf, 0xbd, 0xf1, 0xcd,
-	0xcf, 0xae, 0x35, 0xbe, 0xfc, 0xa8, 0xf6, 0x2f, 0xd5, 0x8c, 0x67, 0x2a, 0x3c, 0xd2, 0xd7, 0xa6,
-	0x02, 0x88, 0xc5, 0xad, 0xe4, 0x70, 0x72, 0x2b, 0x39, 0xb8, 0x55, 0x68, 0xec, 0xa0, 0x4b, 0x7f,
-	0x4e, 0xfd, 0xd9, 0xaf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x31, 0xbb, 0x82, 0xa1, 0x25, 0x00,
-	0x00,
+	// 3403 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3a, 0x4d, 0x8f, 0x1c, 0x49,
+	0x56, 0x5b, 0xdf, 0x99, 0xaf, 0xaa, 0xbb, 0xba, 0x63, 0xec, 0x99, 0xb4, 0x3d, 0x63, 0xf7, 0xd6,
+	0x62, 0xd6, 0x3b, 0x33, 0x6e, 0x6b, 0x1b, 0x8d, 0x58, 0xb1, 0x2c, 0x43, 0xbb, 0xed, 0x59, 0x0a,
+	0xfc, 0xd1, 0x44, 0xf7, 0x08, 0xb1, 0x42, 0x4a, 0x45, 0x67, 0x46, 0x55, 0xe5, 0x76, 0x56, 0x46,
+	0x3a, 0x32, 0x73, 0xdc, 0x3d, 0x3f, 0x01, 0xf6, 0x02, 0xfb, 0x07, 0xb8, 0x22, 0xc4, 0x89, 0x13,
+	0xb7, 0xe5, 0xc6, 0x91, 0x03, 0x27, 0xb8, 0xa0, 0xe1, 0x0a, 0x37, 0x8e, 0x2b, 0x84, 0xde, 0x8b,
+	0xc8, 0x8f, 0xaa, 0xee, 0xb6, 0xbd, 0x23, 0x84, 0x91, 0x98, 0xdb, 0xfb, 0x8a, 0xcc, 0x78, 0x1f,
+	0xf1, 0xe2, 0xc5, 0x8b, 0x80, 0xcd, 0x34, 0x4a, 0x65, 0x1c, 0x25, 0x72, 0x37, 0xd5, 0x2a, 0x57,
+	0xcc, 0x29, 0xf1, 0x9b, 0xf7, 0xe7, 0x51, 0xbe, 0x28, 0x4e, 0x76, 0x03, 0xb5, 0x7c, 0x30, 0x57,
+	0x73, 0xf5, 0x80, 0x04, 0x4e, 0x8a, 0x19, 0x61, 0x84, 0x10, 0x64, 0x06, 0xde, 0x84, 0x34, 0x16,
+	0x89, 0x85, 0xc7, 0x79, 0xb4, 0x94, 0x59, 0x2e, 0x96, 0xa9, 0x21, 0x4c, 0x7e, 0xd6, 0x86, 0xc1,
+	0x53, 0x99, 0x65, 0x62, 0x2e, 0xd9, 0x16, 0x74, 0xb2, 0x28, 0xf4, 0x5a, 0x3b, 0xad, 0x7b, 0x5d,
+	0x8e, 0x20, 0x52, 0x82, 0x65, 0xe8, 0xb5, 0x0d, 0x25, 0x58, 0x12, 0x45, 0x6a, 0xed, 0x75, 0x76,
+	0x5a, 0xf7, 0x46, 0x1c, 0x41, 0xc6, 0xa0, 0x1b, 0x8a, 0x5c, 0x78, 0x5d, 0x22, 0x11, 0xcc, 0x7e,
+	0x0d, 0x36, 0x53, 0xad, 0x02, 0x3f, 0x4a, 0x66, 0xca, 0x27, 0x6e, 0x8f, 0xb8, 0x23, 0xa4, 0x4e,
+	0x93, 0x99, 0x7a, 0x84, 0x52, 0x1e, 0x0c, 0x44, 0x22, 0xe2, 0xf3, 0x4c, 0x7a, 0x7d, 0x62, 0x97,
+	0x28, 0xdb, 0x84, 0x76, 0x14, 0x7a, 0x03, 0xfa, 0x6d, 0x3b, 0x0a, 0xf1, 0x1f, 0x45, 0x11, 0x85,
+	0x9e, 0x63, 0xfe, 0x81, 0x30, 0xbb, 0x05, 0xee, 0x89, 0xc8, 0x83, 0x85, 0x1f, 0x24, 0xb9, 0xe7,
+	0x92, 0xa8, 0x43, 0x84, 0x83, 0x24, 0x67, 0x37, 0xc1, 0x09, 0x16, 0x32, 0x38, 0xcd, 0x8a, 0xa5,
+	0x07, 0x3b, 0xad, 0x7b, 0x1b, 0xbc, 0xc2, 0x91, 0x97, 0xc9, 0x17, 0x85, 0x4c, 0x02, 0xe9, 0x0d,
+	0xcd, 0xb8, 0x12, 0x9f, 0xfc, 0xbc, 0x05, 0x1b, 0xbc, 0x48, 0xd0, 0x4a, 0x9f, 0x45, 0x71, 0x2e,
+	0x35, 0x2a, 0x9c, 0x8b, 0x39, 0x19, 0xa5, 0xc7, 0x11, 0x24, 0xca, 0x79, 0xea, 0xb5, 0x2d, 0xe5,
+	0x3c, 0x45, 0x8a, 0x8a, 0x42, 0x32, 0x4a, 0x8f, 0x23, 0x88, 0x94, 0x65, 0x94, 0x58, 0x9b, 0x20,
+	0x48, 0x14, 0x71, 0x66, 0xed, 0x80, 0x20, 0x2a, 0x75, 0x2a, 0xcf, 0x33, 0xaf, 0xbf, 0xd3, 0x41,
+	0xa5, 0x10, 0x66, 0xd7, 0xa0, 0x77, 0x12, 0x2b, 0xb5, 0x24, 0xdd, 0x47, 0xdc, 0x20, 0x93, 0xcf,
+	0xc1, 0x3d, 0x50, 0x49, 0x22, 0x83, 0x5c, 0x69, 0x76, 0x07, 0x86, 0x65, 0x24, 0xf8, 0xd6, 0x5b,
+	0x3d, 0x0e, 0x25, 0x69, 0x1a, 0xb2, 0xef, 0xc2, 0x38, 0x28, 0xa5, 0xfd, 0x28, 0x09, 0xe5, 0x99,
+	0x9d, 0xeb, 0x66, 0x45, 0x9e, 0x22, 0x75, 0xf2, 0xd7, 0x6d, 0x70, 0x1e, 0x45, 0x59, 0x8a, 0x46,
+	0x63, 0xef, 0xc1, 0x60, 0x56, 0x24, 0x41, 0xfd, 0xc9, 0x3e, 0xa2, 0xd3, 0x90, 0xfd, 0x36, 0x8c,
+	0x63, 0x15, 0x88, 0xd8, 0xaf, 0x46, 0x7b, 0xed, 0x9d, 0xce, 0xbd, 0xe1, 0xde, 0x3b, 0xbb, 0x55,
+	0x84, 0x56, 0xb3, 0xe3, 0x9b, 0x24, 0x5b, 0xcf, 0xf6, 0x47, 0xb0, 0xa5, 0xe5, 0x52, 0xe5, 0xb2,
+	0x31, 0xbc, 0x43, 0xc3, 0x59, 0x3d, 0xfc, 0x8f, 0xb4, 0x48, 0x9f, 0xa9, 0x50, 0xf2, 0xb1, 0x91,
+	0xad, 0x87, 0x3f, 0x80, 0x8d, 0x6c, 0x51, 0xcc, 0x66, 0xb1, 0xf4, 0xe5, 0x59, 0xaa, 0x33, 0xaf,
+	0x4b, 0x63, 0x61, 0x97, 0x62, 0xfa, 0xf1, 0x59, 0xaa, 0xf9, 0xc8, 0x0a, 0x20, 0x92, 0xb1, 0x0f,
+	0x61, 0xbb, 0x1c, 0x60, 0x66, 0x1d, 0x85, 0x68, 0xf4, 0xce, 0xbd, 0x1e, 0x1f, 0x5b, 0xc6, 0x13,
+	0xa4, 0x4f, 0xc3, 0x33, 0xf6, 0x31, 0xb0, 0x52, 0xd6, 0xce, 0x11, 0x85, 0xfb, 0x24, 0xbc, 0x65,
+	0x39, 0x9c, 0x18, 0xd3, 0xf0, 0x6c, 0xf2, 0xb7, 0x2d, 0xd8, 0x78, 0x5a, 0xc4, 0x79, 0xb4, 0xaf,
+	0xe7, 0x85, 0x5c, 0x26, 0x39, 0x3a, 0xf0, 0x51, 0x94, 0xe5, 0x64, 0x2f, 0x87, 0x13, 0xcc, 0xee,
+	0x81, 0xfb, 0x63, 0xad, 0x8a, 0x14, 0x67, 0xe3, 0xb5, 0x2f, 0x4c, 0xb6, 0x66, 0xb2, 0x8f, 0x61,
+	0xf8, 0x5c, 0x87, 0x52, 0x3f, 0x3c, 0x27, 0xd9, 0xce, 0x05, 0xd9, 0x26, 0x9b, 0xbd, 0x0f, 0xee,
+	0x91, 0x4c, 0x85, 0x16, 0x68, 0x40, 0x0c, 0x2b, 0x97, 0xd7, 0x04, 0x5c, 0x49, 0x24, 0x3c, 0x0d,
+	0x29, 0xc0, 0x7a, 0xbc, 0x44, 0x27, 0xcf, 0xc1, 0xdd, 0x9f, 0xcf, 0xb5, 0x9c, 0x8b, 0x9c, 0x96,
+	0x95, 0x4a, 0xad, 0x7b, 0xdb, 0x2a, 0xa5, 0xa5, 0x8b, 0x0a, 0xb4, 0x8d, 0x02, 0x08, 0xb3, 0xdb,
+	0xd0, 0x95, 0x66, 0x3e, 0xad, 0xb5, 0xf9, 0x10, 0x7d, 0xf2, 0xcb, 0x16, 0xf4, 0x48, 0x09, 0x5c,
+	0x80, 0x89, 0x94, 0xa1, 0x2f, 0xbf, 0x10, 0xb1, 0xb5, 0x81, 0x83, 0x84, 0xc7, 0x5f, 0x88, 0x18,
+	0x67, 0x14, 0x9d, 0x14, 0xc1, 0xa9, 0xcc, 0x6d, 0xf6, 0x28, 0x51, 0xe4, 0x24, 0x96, 0xd3, 0x31,
+	0x1c, 0x8b, 0xb2, 0x1d, 0xe8, 0x5d, 0xe5, 0x64, 0xc3, 0x40, 0x89, 0xfc, 0x3c, 0x95, 0x99, 0xd7,
+	0x6b, 0x4a, 0x1c, 0x9f, 0xa7, 0x92, 0x1b, 0x06, 0xfb, 0x2e, 0x74, 0xc5, 0x7c, 0x6e, 0x16, 0xd5,
+	0x4a, 0x88, 0x56, 0x56, 0xe0, 0x24, 0xc0, 0x3e, 0x01, 0xd7, 0x78, 0x13, 0xa5, 0x07, 0x24, 0xfd,
+	0x5e, 0x2d, 0xbd, 0xe2, 0x68, 0x5e, 0x4b, 0x4e, 0xfe, 0xbc, 0x0d, 0xfd, 0x69, 0x92, 0x49, 0x4d,
+	0x39, 0x46, 0xcc, 0x66, 0x32, 0xc8, 0x65, 0x99, 0x33, 0x2b, 0x1c, 0x79, 0xd3, 0xcc, 0xc4, 0x8e,
+	0xb5, 0x6e, 0x85, 0x63, 0x88, 0x8a, 0x30, 0xf4, 0x4b, 0x59, 0x5f, 0xab, 0x97, 0x19, 0x99, 0xc2,
+	0xe1, 0x63, 0x11, 0x86, 0xfb, 0x96, 0xce, 0xd5, 0xcb, 0x8c, 0x7d, 0x1b, 0x3a, 0x5a, 0xce, 0xc8,
+	0xe1, 0xc3, 0xbd, 0xb1, 0x51, 0xf7, 0xf9, 0xc9, 0x4f, 0x65, 0x90, 0x73, 0x39, 0xe3, 0xc8, 0xc3,
+	0x94, 0x21, 0xf2, 0x5c, 0x1b, 0x9b, 0xb8, 0xdc, 0x20, 0x6c, 0x17, 0xde, 0x49, 0x85, 0xce, 0xa3,
+	0x3c, 0x52, 0x89, 0x9f, 0x8b, 0x93, 0x18, 0x83, 0xdb, 0x98, 0xa5, 0xcb, 0xb7, 0x2b, 0xd6, 0x31,
+	0x72, 0xa6, 0x61, 0xc6, 0xbe, 0x03, 0x1b, 0xb5, 0x3c, 0x2e, 0x83, 0x01, 0x45, 0xc9, 0xa8, 0x22,
+	0xe2, 0x82, 0xb9, 0x0e, 0xfd, 0x28, 0xf3, 0x65, 0x62, 0x12, 0xb1, 0xc3, 0x7b, 0x51, 0xf6, 0x38,
+	0x09, 0x27, 0x1f, 0x40, 0x6f, 0x5f, 0x6b, 0x71, 0x4e, 0x53, 0x41, 0xc0, 0x6b, 0xd1, 0x1a, 0x32,
+	0xc8, 0x24, 0x80, 0xce, 0x53, 0x91, 0xb2, 0xbb, 0xd0, 0x5e, 0xa6, 0xc4, 0x19, 0xee, 0x5d, 0x6f,
+	0x58, 0x5a, 0xa4, 0xbb, 0x4f, 0xd3, 0xc7, 0x49, 0xae, 0xcf, 0x79, 0x7b, 0x99, 0xde, 0xfc, 0x04,
+	0x06, 0x16, 0xc5, 0x94, 0x79, 0x2a, 0xcf, 0xc9, 0xb6, 0x2e, 0x47, 0x10, 0x7f, 0xf0, 0x85, 0x88,
+	0x0b, 0x69, 0x13, 0x9a, 0x41, 0x7e, 0xab, 0xfd, 0x83, 0xd6, 0xe4, 0xaf, 0xba, 0xe0, 0x3c, 0x92,
+	0xb1, 0xc4, 0xa9, 0x62, 0x9c, 0x1f, 0x67, 0xd6, 0x27, 0xed, 0xe3, 0x8c, 0x4d, 0x60, 0xd4, 0xb4,
+	0xaa, 0x8d, 0xc8, 0x15, 0x1a, 0xca, 0x18, 0xff, 0xd0, 0x57, 0xa4, 0x75, 0xc8, 0x0a, 0x0d, 0x43,
+	0x77, 0xfa, 0xd0, 0x84, 0x6e, 0x97, 0x36, 0x95, 0x12, 0x45, 0xce, 0x33, 0xcb, 0xe9, 0x19, 0x8e,
+	0x45, 0xd9, 0xfb, 0x00, 0x5a, 0xbd, 0xf4, 0xa3, 0xd0, 0x26, 0x17, 0x9c, 0xb7, 0xa3, 0xd5, 0xcb,
+	0x69, 0x88, 0x16, 0xbd, 0xc2, 0x4d, 0x83, 0xab, 0xdc, 0xf4, 0x9b, 0xe0, 0xd5, 0xf2, 0x94, 0xdb,
+	0xfd, 0x28, 0xf1, 0x69, 0xdb, 0x23, 0x9f, 0xf4, 0xf8, 0xf5, 0xda, 0x63, 0xc8, 0x9e, 0x26, 0x0f,
+	0x91, 0x59, 0x06, 0x92, 0xfb, 0x8a, 0x40, 0xba, 0x34, 0x2e, 0xe1, 0xf2, 0xb8, 0x7c, 0x08, 0x70,
+	0x24, 0xe7, 0x4b, 0x99, 0xe4, 0x4f, 0x45, 0xea, 0x0d, 0xc9, 0xa9, 0x93, 0xda, 0xa9, 0xa5, 0x27,
+	0x76, 0x6b, 0x21, 0xe3, 0xe1, 0xc6, 0x28, 0xf6, 0x6d, 0x18, 0x05, 0x22, 0xf1, 0x73, 0x5d, 0x24,
+	0x81, 0xc8, 0xa5, 0x37, 0xa2, 0x5f, 0x0d, 0x03, 0x91, 0x1c, 0x5b, 0x52, 0x23, 0xe0, 0x36, 0x1a,
+	0x01, 0x77, 0xf3, 0x47, 0x30, 0x5e, 0xfb, 0xf0, 0xaf, 0x14, 0x2b, 0xbf, 0x68, 0x81, 0x7b, 0xa8,
+	0xa5, 0x5d, 0xc6, 0x77, 0x60, 0x98, 0x05, 0x0b, 0xb9, 0x14, 0x7e, 0x22, 0x96, 0xd2, 0x7e, 0x01,
+	0x0c, 0xe9, 0x99, 0x58, 0x4a, 0xf6, 0x11, 0xb8, 0xc6, 0x33, 0xa1, 0x9c, 0xd1, 0xc7, 0x86, 0x7b,
+	0x9b, 0x36, 0xf1, 0x20, 0xf9, 0x91, 0x9c, 0x71, 0x27, 0xb7, 0x10, 0xce, 0x03, 0xfd, 0xdc, 0xa1,
+	0x05, 0x80, 0x60, 0xbd, 0x3e, 0xbb, 0xcd, 0xf5, 0xb9, 0x03, 0xa3, 0x85, 0xc8, 0x7c, 0x51, 0xe4,
+	0xca, 0x0f, 0x54, 0x4c, 0x51, 0xe3, 0x70, 0x58, 0x88, 0x6c, 0xbf, 0xc8, 0xd5, 0x81, 0x8a, 0x31,
+	0xbd, 0x46, 0x99, 0x5f, 0xa4, 0x21, 0xda, 0xa6, 0x6f, 0x72, 0x48, 0x94, 0x7d, 0x4e, 0xf8, 0x84,
+	0xc3, 0xb8, 0xd2, 0xe0, 0xf3, 0x24, 0x7a, 0x51, 0x48, 0xf6, 0x29, 0x6c, 0xa7, 0x5a, 0xfa, 0x11,
+	0xd1, 0xfc, 0xe2, 0xd4, 0x0f, 0xf2, 0x33, 0xd2, 0x66, 0xb8, 0x77, 0xcd, 0x4c, 0xb7, 0x1e, 0x71,
+	0x7a, 0x90, 0x9f, 0xf1, 0xcd, 0x74, 0x05, 0x9f, 0xfc, 0x45, 0x1b, 0x36, 0x9f, 0x27, 0x8f, 0x8a,
+	0x34, 0x8e, 0xd0, 0xf8, 0x7f, 0x20, 0xcf, 0x57, 0x55, 0x6f, 0xbd, 0x46, 0xf5, 0x7b, 0xb0, 0xa5,
+	0x12, 0x3f, 0x2c, 0xc7, 0x53, 0xbc, 0xb7, 0xc9, 0x0e, 0x9b, 0xaa, 0xfe, 0x2c, 0x46, 0xfd, 0x1f,
+	0xc3, 0xf6, 0x8a, 0xa4, 0xac, 0x37, 0xc0, 0xfb, 0x75, 0x10, 0xad, 0xce, 0xa5, 0x89, 0xe2, 0x96,
+	0x60, 0xe2, 0x69, 0xac, 0x56, 0xa9, 0x37, 0x9f, 0xc1, 0xb5, 0xcb, 0x04, 0x2f, 0x89, 0x8f, 0x9d,
+	0x66, 0x7c, 0xac, 0xed, 0x36, 0x75, 0xac, 0xfc, 0x73, 0x1b, 0xba, 0xbf, 0xaf, 0xa2, 0xa4, 0xb9,
+	0xa1, 0xb5, 0xae, 0xdc, 0xd0, 0xda, 0xab, 0x1b, 0xda, 0x0d, 0x70, 0xb4, 0x8c, 0xfd, 0x18, 0xf7,
+	0x58, 0x13, 0x11, 0x03, 0x2d, 0xe3, 0x27, 0xb8, 0xcd, 0xde, 0x00, 0x27, 0x50, 0x96, 0xd5, 0x35,
+	0xac, 0x40, 0xc5, 0x4f, 0x9a, 0x3b, 0x70, 0xef, 0xf2, 0x1d, 0xb8, 0xde, 0x04, 0xfb, 0x57, 0x6f,
+	0x82, 0x6e, 0x2c, 0x67, 0x39, 0x96, 0x5c, 0xa1, 0x37, 0x68, 0x4a, 0xd1, 0x67, 0x1c, 0x64, 0x1e,
+	0xa8, 0x24, 0x64, 0xdf, 0x03, 0xd0, 0xd1, 0x7c, 0x61, 0x25, 0x9d, 0x8b, 0xe5, 0x0a, 0x71, 0x49,
+	0x94, 0xc3, 0x0d, 0x6d, 0x0a, 0x63, 0x7f, 0x46, 0x95, 0xb1, 0x7f, 0x52, 0x44, 0x71, 0x68, 0x34,
+	0x70, 0xcb, 0xfd, 0x13, 0x47, 0xae, 0xd4, 0xcf, 0x47, 0xa9, 0x0c, 0xf8, 0xbb, 0xba, 0x49, 0x7a,
+	0x88, 0xe3, 0x50, 0xd3, 0xc9, 0x3f, 0xb5, 0xc0, 0x7d, 0x2a, 0xf5, 0x5c, 0x92, 0x85, 0x6f, 0x81,
+	0xfb, 0x53, 0x15, 0x25, 0x3e, 0xea, 0x60, 0x8b, 0x14, 0x07, 0x09, 0xa8, 0xd9, 0x8a, 0x29, 0xdb,
+	0x57, 0x9b, 0xb2, 0xb3, 0x6a, 0xca, 0xca, 0x54, 0xdd, 0x37, 0x32, 0x55, 0xef, 0x8d, 0x4d, 0xd5,
+	0x7f, 0x85, 0xa9, 0x26, 0xff, 0xde, 0x02, 0x67, 0x3f, 0xc9, 0xa3, 0xaf, 0x1d, 0x37, 0xef, 0x42,
+	0x5f, 0xcb, 0xac, 0x88, 0x4b, 0x7d, 0x2c, 0x56, 0x45, 0x46, 0xf7, 0x75, 0x91, 0xd1, 0x7b, 0x23,
+	0x75, 0xfb, 0x6f, 0xac, 0xee, 0xe0, 0x55, 0xea, 0xfe, 0x59, 0x1b, 0xdc, 0x69, 0x92, 0x48, 0xfd,
+	0xcd, 0x3a, 0x49, 0xc2, 0xc9, 0x9f, 0xb6, 0xc1, 0x79, 0x22, 0x67, 0xf9, 0x37, 0xc6, 0x48, 0xc2,
+	0xc9, 0xdf, 0xb7, 0xc1, 0xe5, 0x88, 0xfd, 0x1f, 0xb3, 0xc6, 0xf7, 0x00, 0x48, 0xd7, 0xab, 0x4c,
+	0x42, 0x96, 0x38, 0x26, 0xb3, 0x7c, 0x04, 0x43, 0xa3, 0xad, 0x91, 0x1d, 0x5c, 0x90, 0x35, 0xc6,
+	0x38, 0xbe, 0x68, 0x43, 0xe7, 0x8d, 0x6d, 0xe8, 0xbe, 0xca, 0x86, 0xbf, 0xc4, 0x96, 0x04, 0x62,
+	0x47, 0x72, 0xf9, 0xbf, 0x9f, 0x52, 0xd6, 0xd4, 0xef, 0xbd, 0xb9, 0xfa, 0xff, 0x43, 0xd9, 0xa5,
+	0x52, 0xff, 0xad, 0x64, 0xd4, 0xb7, 0xae, 0xfe, 0x2f, 0xda, 0xe0, 0xbc, 0x15, 0xc7, 0xbf, 0x95,
+	0xbd, 0xe4, 0xd5, 0x55, 0x86, 0xf3, 0xf5, 0xaa, 0x8c, 0x9f, 0xb5, 0x01, 0x8e, 0xa2, 0x64, 0x1e,
+	0xcb, 0x6f, 0x72, 0x72, 0x12, 0x62, 0x07, 0xc3, 0x79, 0x2a, 0xf4, 0xe9, 0xff, 0x93, 0x88, 0xfa,
+	0x0e, 0x0c, 0x54, 0xd2, 0x8c, 0x9f, 0xa6, 0x5c, 0x5f, 0x25, 0x14, 0x22, 0x02, 0x06, 0x87, 0x5a,
+	0x85, 0x45, 0xb0, 0xea, 0xea, 0xd6, 0xd5, 0xae, 0x6e, 0x5f, 0x51, 0x68, 0x76, 0xae, 0xd0, 0x8d,
+	0x3a, 0xcb, 0x74, 0x68, 0xfa, 0xac, 0x48, 0x02, 0xea, 0x52, 0x54, 0x07, 0xc3, 0xd6, 0xea, 0xc1,
+	0xb0, 0xab, 0x65, 0x9e, 0xd9, 0xde, 0xe1, 0xc8, 0x7c, 0xe8, 0x40, 0xc5, 0x78, 0xd6, 0x22, 0x0e,
+	0xda, 0x59, 0xe8, 0x79, 0x76, 0x49, 0xc7, 0x90, 0xe8, 0xe8, 0x1f, 0xec, 0x0b, 0x2e, 0x33, 0xdb,
+	0x7e, 0xb6, 0x18, 0x76, 0xfb, 0xe8, 0x84, 0xdb, 0xa3, 0x33, 0x10, 0xc1, 0x18, 0x0c, 0xee, 0xef,
+	0x89, 0x6c, 0x41, 0xab, 0xa5, 0xee, 0xe8, 0xa1, 0x1b, 0x9b, 0x1d, 0x3d, 0x74, 0x5f, 0xc9, 0x5c,
+	0x88, 0x6c, 0x51, 0xf6, 0xb4, 0x90, 0x80, 0xc3, 0x9b, 0x71, 0xd4, 0xb9, 0x32, 0x8e, 0xba, 0x17,
+	0xda, 0x7d, 0xaf, 0x89, 0x87, 0x1d, 0xe8, 0xa1, 0x83, 0xb3, 0x4b, 0x62, 0xc1, 0x30, 0x5e, 0x9d,
+	0x2f, 0x06, 0x5f, 0x2f, 0x5f, 0xec, 0xc3, 0xf5, 0xc7, 0x67, 0xb9, 0xd4, 0x89, 0x88, 0xf1, 0xfc,
+	0xbf, 0x77, 0xa0, 0x62, 0x6a, 0xa5, 0x54, 0x06, 0x6c, 0xd5, 0x06, 0x44, 0x27, 0x36, 0x5b, 0xec,
+	0x06, 0x99, 0xdc, 0x85, 0xe1, 0x2c, 0x8a, 0xa5, 0xaf, 0x66, 0xb3, 0xcc, 0xac, 0x18, 0x03, 0x91,
+	0xab, 0x3b, 0xdc, 0x62, 0x93, 0xff, 0x6a, 0xc3, 0xa8, 0xfc, 0xd5, 0x51, 0x20, 0xae, 0x0a, 0x89,
+	0x5b, 0xe0, 0xd2, 0xd7, 0xb2, 0xe8, 0x4b, 0x49, 0x71, 0xd1, 0xe1, 0x0e, 0x12, 0x8e, 0xa2, 0x2f,
+	0x25, 0xdb, 0x87, 0xed, 0xc6, 0xaf, 0xfc, 0x5c, 0xe5, 0x22, 0xf6, 0x3a, 0xeb, 0x5d, 0xb6, 0x86,
+	0x08, 0x1f, 0x23, 0xf2, 0x9c, 0xe0, 0x63, 0x94, 0xc6, 0x90, 0x0b, 0x54, 0x5c, 0x1e, 0x92, 0xd6,
+	0x42, 0x0e, 0x39, 0xec, 0xc7, 0x30, 0x46, 0x6d, 0xf7, 0xb0, 0x55, 0x61, 0xaf, 0x14, 0x8c, 0xd3,
+	0xee, 0xd4, 0xbf, 0xb8, 0xd4, 0x66, 0x7c, 0x23, 0x69, 0xa2, 0xec, 0x03, 0x80, 0x40, 0x4b, 0x3c,
+	0xf3, 0x67, 0x2f, 0x62, 0xea, 0x6a, 0xb8, 0xdc, 0x35, 0x94, 0xa3, 0x17, 0x71, 0xa5, 0x69, 0xe5,
+	0x3e, 0xd7, 0x68, 0x4a, 0x6b, 0xec, 0x3e, 0x0c, 0x95, 0x8e, 0xe6, 0x51, 0xe2, 0xd3, 0x6c, 0x9d,
+	0x4b, 0x66, 0x0b, 0x46, 0xe0, 0x00, 0xe7, 0x3c, 0x81, 0xbe, 0x09, 0x09, 0xdb, 0xf4, 0x5a, 0x59,
+	0xf7, 0x86, 0x33, 0xf9, 0xbb, 0x21, 0x0c, 0xa7, 0x49, 0x96, 0xeb, 0x22, 0x28, 0x1b, 0x87, 0x2b,
+	0x0d, 0x72, 0xdb, 0xcd, 0xb1, 0x57, 0x3d, 0xd8, 0xcd, 0xf9, 0x75, 0xe8, 0x8a, 0x24, 0x8f, 0x6c,
+	0x7b, 0xbc, 0x71, 0x87, 0x51, 0x96, 0x27, 0x9c, 0xf8, 0xec, 0x3e, 0x0c, 0xec, 0x85, 0x87, 0xcd,
+	0x87, 0x97, 0xde, 0x96, 0x94, 0x32, 0x6c, 0x17, 0x9c, 0xd0, 0xde, 0xc4, 0x78, 0xbd, 0xf5, 0x4f,
+	0x97, 0x77, 0x34, 0xbc, 0x92, 0xc1, 0x76, 0x9e, 0x98, 0xcf, 0xbd, 0x7e, 0xd9, 0xce, 0x2b, 0x45,
+	0xa9, 0x33, 0xcf, 0x91, 0xc7, 0xf6, 0x00, 0x22, 0x3c, 0x95, 0xf9, 0x78, 0x86, 0xf6, 0x06, 0xeb,
+	0x93, 0xa8, 0x4e, 0x6c, 0xdc, 0x8d, 0x4a, 0x90, 0x3d, 0xb0, 0x09, 0x98, 0x86, 0x38, 0xeb, 0xf3,
+	0x28, 0x8f, 0x35, 0x26, 0x11, 0x97, 0x03, 0x32, 0xb9, 0x8c, 0xcc, 0x00, 0x77, 0x7d, 0x40, 0x59,
+	0xb8, 0xe0, 0x05, 0x9b, 0x81, 0xd8, 0x27, 0x30, 0xcc, 0x68, 0x2f, 0x36, 0x43, 0xa0, 0xec, 0x4f,
+	0x55, 0x43, 0xaa, 0x8d, 0x9a, 0x43, 0x56, 0xc1, 0xf8, 0x9f, 0xa5, 0xd0, 0xa7, 0x66, 0xd0, 0x70,
+	0xfd, 0x3f, 0xe5, 0x76, 0xc6, 0x9d, 0xa5, 0x85, 0xd8, 0x04, 0xba, 0x24, 0x3b, 0x2a, 0x9b, 0x56,
+	0xa5, 0xac, 0xf1, 0x11, 0xf2, 0xd8, 0x47, 0x30, 0x48, 0x4d, 0xd6, 0xa7, 0xf6, 0xe2, 0x70, 0x6f,
+	0xbb, 0x16, 0xb3, 0xdb, 0x01, 0x2f, 0x25, 0xd8, 0xef, 0xc0, 0xa6, 0x69, 0x85, 0xcd, 0x6c, 0xfe,
+	0xf6, 0x36, 0x77, 0x5a, 0xab, 0x97, 0x06, 0x2b, 0xe9, 0x9d, 0x6f, 0xe4, 0x4d, 0x14, 0xdd, 0x81,
+	0x99, 0xd3, 0xe4, 0x27, 0x6f, 0xbc, 0xee, 0x8e, 0x2a, 0x09, 0x73, 0x77, 0x51, 0x82, 0xec, 0x87,
+	0xb0, 0x21, 0xed, 0xaa, 0xf2, 0xb3, 0x40, 0x24, 0xde, 0x16, 0x0d, 0x7b, 0xf7, 0xe2, 0xa2, 0xc3,
+	0xec, 0xc1, 0x47, 0xb2, 0x81, 0xb1, 0x7b, 0xd0, 0x37, 0xbd, 0x40, 0x6f, 0x9b, 0x46, 0x6d, 0x35,
+	0x7d, 0x8f, 0x74, 0x6e, 0xf9, 0xec, 0xe1, 0x5a, 0xe3, 0x0e, 0x1b, 0x65, 0x8c, 0xc6, 0x78, 0x57,
+	0x75, 0xe3, 0x56, 0x5a, 0x7a, 0xd8, 0x29, 0xdc, 0x03, 0xa8, 0xbb, 0x8f, 0xde, 0x3b, 0xeb, 0xea,
+	0x55, 0xad, 0x47, 0xee, 0x56, 0x5d, 0x47, 0xf6, 0x78, 0xb5, 0x63, 0x49, 0x6d, 0x4c, 0xef, 0x1a,
+	0x0d, 0xbd, 0x71, 0xc9, 0x50, 0xd3, 0xe7, 0xe4, 0xe3, 0x74, 0x95, 0xc0, 0x3e, 0x06, 0x47, 0xe1,
+	0x6d, 0x97, 0x7f, 0x72, 0xee, 0x5d, 0xa7, 0xa4, 0xb0, 0x6d, 0xfb, 0xdb, 0xe6, 0xfe, 0x8c, 0x92,
+	0xfd, 0x40, 0x19, 0x84, 0xdd, 0x07, 0xbc, 0x84, 0xc6, 0xc6, 0xb7, 0xc9, 0x32, 0xef, 0x5e, 0xbc,
+	0x77, 0xb3, 0x7c, 0x4a, 0x3a, 0x75, 0x16, 0x79, 0xef, 0xaa, 0x2c, 0x82, 0x59, 0x3b, 0x8e, 0x96,
+	0x51, 0xee, 0x79, 0xb4, 0xc1, 0x19, 0xa4, 0x91, 0xf4, 0x6f, 0x10, 0xd9, 0x62, 0xb4, 0x55, 0x66,
+	0x9f, 0x45, 0x3a, 0xcb, 0xbd, 0x9b, 0xb4, 0x8b, 0x96, 0x28, 0x8e, 0x88, 0xb2, 0x27, 0x22, 0xcb,
+	0xbd, 0x5b, 0xc4, 0xb0, 0x18, 0xda, 0xd6, 0x54, 0x3b, 0x14, 0xd1, 0xef, 0xaf, 0xdb, 0xb6, 0x3a,
+	0x60, 0xdb, 0xb2, 0x07, 0x41, 0xf6, 0x29, 0x8c, 0xcd, 0x98, 0x7a, 0x79, 0x7e, 0xb0, 0x1e, 0xaf,
+	0x2b, 0xa7, 0x4a, 0xbe, 0xa1, 0x9b, 0x68, 0xfd, 0x01, 0x4c, 0x67, 0xe6, 0x03, 0xb7, 0x2f, 0xfd,
+	0x40, 0x95, 0xf8, 0x36, 0x74, 0x13, 0x65, 0x1f, 0x42, 0x3f, 0x34, 0x57, 0x29, 0x77, 0x2e, 0x24,
+	0x34, 0x7b, 0x3d, 0xc0, 0xad, 0x04, 0x6a, 0xb8, 0xc4, 0x3e, 0xa0, 0xf9, 0xcf, 0xce, 0xba, 0x86,
+	0x55, 0x8f, 0x90, 0xbb, 0xcb, 0x12, 0x9c, 0x7c, 0x02, 0xa3, 0x7d, 0x7a, 0x2e, 0x10, 0x65, 0xe4,
+	0xa9, 0xbb, 0xd0, 0xad, 0x8a, 0xb6, 0x2a, 0x04, 0x48, 0xe2, 0x4b, 0x89, 0x4f, 0x0e, 0x38, 0xb1,
+	0x27, 0x3f, 0xef, 0x40, 0xff, 0x48, 0x15, 0x3a, 0x90, 0xaf, 0xef, 0xfc, 0x7f, 0x00, 0x60, 0xd6,
+	0x3c, 0xf1, 0xdb, 0x66, 0xb7, 0x22, 0x0a, 0xb1, 0xd7, 0x1b, 0x8f, 0x6e, 0x5d, 0x0f, 0x9a, 0x7b,
+	0xfc, 0xe0, 0xd4, 0x5e, 0xd5, 0x1a, 0x04, 0x7f, 0x98, 0x16, 0xd9, 0x22, 0x54, 0x2f, 0xf1, 0x8e,
+	0x8d, 0x12, 0x7d, 0x97, 0x43, 0x49, 0x9a, 0x86, 0x74, 0x0b, 0x57, 0x0a, 0x88, 0x30, 0xd4, 0x76,
+	0x87, 0x1c, 0x95, 0xc4, 0xfd, 0x30, 0xd4, 0x55, 0x9d, 0x3d, 0xb8, 0xa2, 0xce, 0xfe, 0x10, 0xaa,
+	0x9e, 0xbc, 0xe7, 0xbc, 0xa6, 0x67, 0xbf, 0x07, 0x6e, 0xf5, 0x22, 0xc4, 0xe6, 0xef, 0x6b, 0xbb,
+	0x15, 0x65, 0xf7, 0xb8, 0x84, 0x78, 0x2d, 0x76, 0x49, 0xcd, 0x95, 0x6a, 0x75, 0x62, 0x37, 0x6d,
+	0xf8, 0x55, 0x6a, 0xae, 0x43, 0x1c, 0x47, 0x35, 0xd7, 0x9f, 0x80, 0x83, 0x0f, 0x00, 0xd0, 0x4f,
+	0x58, 0x66, 0x2d, 0x83, 0xb4, 0xb0, 0xdb, 0x30, 0xc1, 0xf6, 0x41, 0x88, 0xf1, 0x80, 0x7d, 0x10,
+	0x42, 0xf6, 0xe9, 0x10, 0x85, 0x60, 0x5c, 0x58, 0xa9, 0x38, 0x8f, 0x95, 0x08, 0xed, 0x55, 0x4b,
+	0x89, 0x4e, 0xfe, 0xa6, 0x05, 0xdb, 0x87, 0x5a, 0x05, 0x32, 0xcb, 0x9e, 0xe0, 0xda, 0x14, 0x94,
+	0x91, 0x19, 0x74, 0xa9, 0xa2, 0xc2, 0xff, 0x74, 0x38, 0xc1, 0xe8, 0x71, 0xf3, 0xa8, 0x44, 0x97,
+	0xf7, 0x84, 0x1d, 0x6e, 0x9e, 0x99, 0xd0, 0xb5, 0x57, 0xc5, 0xa6, 0x81, 0x9d, 0x06, 0x9b, 0x6a,
+	0xb1, 0xbb, 0xb0, 0x59, 0xdf, 0xce, 0xd1, 0x17, 0xba, 0x24, 0x52, 0x5f, 0xad, 0xd2, 0x57, 0xee,
+	0xc0, 0x50, 0x4b, 0x81, 0x19, 0x8b, 0x3e, 0xd3, 0x23, 0x19, 0x30, 0x24, 0xfc, 0xce, 0xe4, 0x3f,
+	0x5a, 0x30, 0xb4, 0xf3, 0x25, 0x8b, 0x18, 0xed, 0x5b, 0x95, 0xf6, 0xf7, 0xa1, 0x13, 0x47, 0x4b,
+	0x7b, 0x71, 0x71, 0x6b, 0x65, 0xd3, 0x5a, 0xd5, 0x91, 0xa3, 0x1c, 0x56, 0x55, 0x45, 0x12, 0x9d,
+	0xf9, 0x68, 0x78, 0x3b, 0x69, 0x07, 0x09, 0xe8, 0x5d, 0x7a, 0x0d, 0x93, 0x88, 0x34, 0x5b, 0xa8,
+	0xdc, 0x06, 0x6b, 0x85, 0xb3, 0x1f, 0xc0, 0x28, 0x93, 0x59, 0x66, 0xee, 0x1a, 0x67, 0xca, 0x56,
+	0x26, 0xd7, 0x9b, 0x1b, 0x3c, 0x71, 0x69, 0x79, 0x0d, 0xb3, 0x1a, 0xc1, 0xa7, 0x15, 0xc2, 0x2e,
+	0x4e, 0x3f, 0x51, 0xa1, 0x0d, 0x0e, 0xfb, 0xb4, 0xa2, 0xe4, 0xa0, 0xc7, 0xc9, 0xfb, 0xff, 0xd2,
+	0x82, 0x61, 0xe3, 0x53, 0xf4, 0xdc, 0x27, 0x93, 0xba, 0x2c, 0xb4, 0x11, 0x46, 0xda, 0x42, 0xd9,
+	0xb7, 0x0a, 0x2e, 0x27, 0x18, 0x69, 0x5a, 0xc5, 0xb2, 0x8c, 0x02, 0x84, 0x71, 0x09, 0xd9, 0xa2,
+	0xca, 0xdc, 0x64, 0xdb, 0x53, 0xc7, 0xa8, 0x26, 0x4e, 0xe9, 0x7a, 0x1e, 0x5f, 0x25, 0x9d, 0x88,
+	0xac, 0x3c, 0x0e, 0x55, 0x38, 0x86, 0xd1, 0x17, 0x52, 0xe3, 0x5c, 0xec, 0xea, 0x2b, 0x51, 0xb4,
+	0x23, 0x45, 0xfd, 0x97, 0x2a, 0x91, 0xf6, 0x81, 0x8e, 0x83, 0x84, 0x9f, 0xa8, 0x84, 0x86, 0x89,
+	0x20, 0x50, 0x45, 0x92, 0xd3, 0xa2, 0x73, 0x79, 0x89, 0x4e, 0xfe, 0xb3, 0x0b, 0xce, 0xa1, 0xb5,
+	0x18, 0x7b, 0x04, 0x1b, 0xd5, 0xeb, 0x9d, 0xea, 0xa2, 0x63, 0xb3, 0x59, 0x47, 0x1f, 0xae, 0x03,
+	0x74, 0x22, 0x1a, 0xa5, 0x0d, 0x6c, 0xfd, 0x0d, 0x50, 0xfb, 0xc2, 0x1b, 0xa0, 0xf7, 0xa1, 0xf3,
+	0x42, 0x9f, 0xaf, 0x3e, 0xe2, 0x38, 0x8c, 0x45, 0xc2, 0x91, 0xcc, 0xbe, 0x0f, 0x43, 0x54, 0xd7,
+	0xcf, 0x28, 0x0f, 0x7a, 0xdd, 0xf5, 0xfa, 0xc0, 0xe4, 0x47, 0x0e, 0x28, 0x64, 0x60, 0x2c, 0x50,
+	0x83, 0x45, 0x14, 0x87, 0x5a, 0x26, 0xb6, 0xf4, 0x67, 0x17, 0xa7, 0xcc, 0x2b, 0x19, 0xf6, 0xbb,
+	0xb0, 0x15, 0xd5, 0x85, 0x75, 0xed, 0xfe, 0x95, 0xf0, 0x69, 0x94, 0xde, 0x7c, 0xdc, 0x10, 0xa7,
+	0x14, 0x5a, 0xdf, 0xfd, 0x0e, 0x1a, 0x77, 0xbf, 0xf8, 0x4e, 0x29, 0xca, 0xea, 0x02, 0x95, 0x76,
+	0x49, 0xda, 0x6f, 0x0c, 0x83, 0x96, 0xbf, 0x5b, 0x6d, 0x9f, 0x4a, 0x84, 0x58, 0xb2, 0x63, 0x08,
+	0xda, 0x5a, 0xb3, 0x31, 0xed, 0x32, 0xe3, 0x70, 0xe2, 0xd3, 0xa3, 0xb5, 0x22, 0x5b, 0xf8, 0x26,
+	0x3d, 0x63, 0xbc, 0x0f, 0xed, 0x1b, 0x88, 0x22, 0x5b, 0x3c, 0x52, 0x2f, 0x4d, 0x6c, 0xde, 0x85,
+	0xcd, 0x52, 0x49, 0xdf, 0xb8, 0x7b, 0x44, 0x52, 0x1b, 0x25, 0xf5, 0x00, 0x89, 0xec, 0x53, 0xd8,
+	0xc2, 0x57, 0x6a, 0x99, 0x9f, 0x2b, 0x5f, 0xcb, 0x39, 0x5d, 0x86, 0x6e, 0xec, 0x74, 0x56, 0xab,
+	0xb7, 0xcf, 0x8b, 0x28, 0x3c, 0x56, 0x5c, 0xce, 0xa7, 0xe1, 0x19, 0xdf, 0x20, 0xf9, 0x12, 0x9d,
+	0x7c, 0x0a, 0xa3, 0x66, 0x00, 0x30, 0x17, 0x7a, 0xb4, 0x0d, 0x6e, 0x7d, 0x8b, 0x01, 0xf4, 0x9f,
+	0x29, 0xbd, 0x14, 0xf1, 0x56, 0x0b, 0x61, 0xf3, 0x44, 0x61, 0xab, 0xcd, 0x46, 0xe0, 0x1c, 0x0a,
+	0x2d, 0xe2, 0x58, 0xc6, 0x5b, 0x9d, 0xc9, 0x0f, 0xc1, 0x29, 0xdf, 0x55, 0xd1, 0xd9, 0x1d, 0x57,
+	0x21, 0xe5, 0x4c, 0xb3, 0xaa, 0x1c, 0x24, 0xd0, 0x7e, 0x52, 0x3e, 0xae, 0x6b, 0xd7, 0x8f, 0xeb,
+	0x26, 0x7f, 0x08, 0xa3, 0xe6, 0xe4, 0xca, 0x83, 0x50, 0xab, 0x3e, 0x08, 0x5d, 0x32, 0x8a, 0x8e,
+	0x6f, 0x5a, 0x2d, 0xfd, 0x46, 0x6a, 0x76, 0x90, 0x80, 0xbf, 0x79, 0x78, 0xf0, 0x0f, 0x5f, 0xdd,
+	0x6e, 0xfd, 0xe3, 0x57, 0xb7, 0x5b, 0xff, 0xfa, 0xd5, 0xed, 0x6f, 0xfd, 0xe5, 0xbf, 0xdd, 0x6e,
+	0xfd, 0xe4, 0xfb, 0x8d, 0x77, 0x8c, 0x4b, 0x91, 0xeb, 0xe8, 0xcc, 0x1c, 0xdf, 0x4a, 0x24, 0x91,
+	0x0f, 0xd2, 0xd3, 0xf9, 0x83, 0xf4, 0xe4, 0x41, 0x69, 0xb1, 0x93, 0x3e, 0xbd, 0x5a, 0xfc, 0x8d,
+	0xff, 0x1e, 0x00, 0xc8, 0xd9, 0xba, 0x32, 0x1d, 0x29, 0x00, 0x00,
 }
 
 func (m *Message) Marshal() (dAtA []byte, err error) {
@@ -3919,6 +4182,78 @@ func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RuntimeFilter) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RuntimeFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RuntimeFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Bloom) > 0 {
+		i -= len(m.Bloom)
+		copy(dAtA[i:], m.Bloom)
+		i = encodeVarintPipeline(dAtA, i, uint64(len(m.Bloom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintPipeline(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Max) > 0 {
+		i -= len(m.Max)
+		copy(dAtA[i:], m.Max)
+		i = encodeVarintPipeline(dAtA, i, uint64(len(m.Max)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Min) > 0 {
+		i -= len(m.Min)
+		copy(dAtA[i:], m.Min)
+		i = encodeVarintPipeline(dAtA, i, uint64(len(m.Min)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Oid != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.Oid))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Typ != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.Typ))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Tag != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.Tag))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Connector) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -3980,6 +4315,58 @@ func (m *Dispatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ShuffleRemoteIdx) > 0 {
+		dAtA2 := make([]byte, len(m.ShuffleRemoteIdx)*10)
+		var j1 int
+		for _, num1 := range m.ShuffleRemoteIdx {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintPipeline(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ShuffleLocalIdx) > 0 {
+		dAtA4 := make([]byte, len(m.ShuffleLocalIdx)*10)
+		var j3 int
+		for _, num1 := range m.ShuffleLocalIdx {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintPipeline(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ShuffleExprs) > 0 {
+		for iNdEx := len(m.ShuffleExprs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ShuffleExprs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPipeline(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.RemoteConnector) > 0 {
 		for iNdEx := len(m.RemoteConnector) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -4290,20 +4677,20 @@ func (m *Insert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x38
 	}
 	if len(m.PartitionTableIds) > 0 {
-		dAtA3 := make([]byte, len(m.PartitionTableIds)*10)
-		var j2 int
+		dAtA7 := make([]byte, len(m.PartitionTableIds)*10)
+		var j6 int
 		for _, num := range m.PartitionTableIds {
 			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j2++
+				j6++
 			}
-			dAtA3[j2] = uint8(num)
-			j2++
+			dAtA7[j6] = uint8(num)
+			j6++
 		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintPipeline(dAtA, i, uint64(j2))
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintPipeline(dAtA, i, uint64(j6))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -4381,21 +4768,21 @@ func (m *Array) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Array) > 0 {
-		dAtA6 := make([]byte, len(m.Array)*10)
-		var j5 int
+		dAtA10 := make([]byte, len(m.Array)*10)
+		var j9 int
 		for _, num1 := range m.Array {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j5++
+				j9++
 			}
-			dAtA6[j5] = uint8(num)
-			j5++
+			dAtA10[j9] = uint8(num)
+			j9++
 		}
-		i -= j5
-		copy(dAtA[i:], dAtA6[:j5])
-		i = encodeVarintPipeline(dAtA, i, uint64(j5))
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintPipeline(dAtA, i, uint64(j9))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -4535,20 +4922,20 @@ func (m *Deletion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x40
 	}
 	if len(m.PartitionTableIds) > 0 {
-		dAtA9 := make([]byte, len(m.PartitionTableIds)*10)
-		var j8 int
+		dAtA13 := make([]byte, len(m.PartitionTableIds)*10)
+		var j12 int
 		for _, num := range m.PartitionTableIds {
 			for num >= 1<<7 {
-				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j8++
+				j12++
 			}
-			dAtA9[j8] = uint8(num)
-			j8++
+			dAtA13[j12] = uint8(num)
+			j12++
 		}
-		i -= j8
-		copy(dAtA[i:], dAtA9[:j8])
-		i = encodeVarintPipeline(dAtA, i, uint64(j8))
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintPipeline(dAtA, i, uint64(j12))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -4644,21 +5031,21 @@ func (m *PreInsert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Idx) > 0 {
-		dAtA11 := make([]byte, len(m.Idx)*10)
-		var j10 int
+		dAtA15 := make([]byte, len(m.Idx)*10)
+		var j14 int
 		for _, num1 := range m.Idx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j10++
+				j14++
 			}
-			dAtA11[j10] = uint8(num)
-			j10++
+			dAtA15[j14] = uint8(num)
+			j14++
 		}
-		i -= j10
-		copy(dAtA[i:], dAtA11[:j10])
-		i = encodeVarintPipeline(dAtA, i, uint64(j10))
+		i -= j14
+		copy(dAtA[i:], dAtA15[:j14])
+		i = encodeVarintPipeline(dAtA, i, uint64(j14))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -4774,21 +5161,21 @@ func (m *OnDuplicateKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.OnDuplicateIdx) > 0 {
-		dAtA16 := make([]byte, len(m.OnDuplicateIdx)*10)
-		var j15 int
+		dAtA20 := make([]byte, len(m.OnDuplicateIdx)*10)
+		var j19 int
 		for _, num1 := range m.OnDuplicateIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j15++
+				j19++
 			}
-			dAtA16[j15] = uint8(num)
-			j15++
+			dAtA20[j19] = uint8(num)
+			j19++
 		}
-		i -= j15
-		copy(dAtA[i:], dAtA16[:j15])
-		i = encodeVarintPipeline(dAtA, i, uint64(j15))
+		i -= j19
+		copy(dAtA[i:], dAtA20[:j19])
+		i = encodeVarintPipeline(dAtA, i, uint64(j19))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -4831,6 +5218,20 @@ func (m *Join) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.RuntimeFilterBuildList) > 0 {
+		for iNdEx := len(m.RuntimeFilterBuildList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RuntimeFilterBuildList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPipeline(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.RightCond) > 0 {
 		for iNdEx := len(m.RightCond) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -4886,40 +5287,40 @@ func (m *Join) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 	}
 	if len(m.ColList) > 0 {
-		dAtA20 := make([]byte, len(m.ColList)*10)
-		var j19 int
+		dAtA24 := make([]byte, len(m.ColList)*10)
+		var j23 int
 		for _, num1 := range m.ColList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA24[j23] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j19++
+				j23++
 			}
-			dAtA20[j19] = uint8(num)
-			j19++
+			dAtA24[j23] = uint8(num)
+			j23++
 		}
-		i -= j19
-		copy(dAtA[i:], dAtA20[:j19])
-		i = encodeVarintPipeline(dAtA, i, uint64(j19))
+		i -= j23
+		copy(dAtA[i:], dAtA24[:j23])
+		i = encodeVarintPipeline(dAtA, i, uint64(j23))
 		i--
 		dAtA[i] = 0x22
 	}
 	if len(m.RelList) > 0 {
-		dAtA22 := make([]byte, len(m.RelList)*10)
-		var j21 int
+		dAtA26 := make([]byte, len(m.RelList)*10)
+		var j25 int
 		for _, num1 := range m.RelList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA22[j21] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA26[j25] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j21++
+				j25++
 			}
-			dAtA22[j21] = uint8(num)
-			j21++
+			dAtA26[j25] = uint8(num)
+			j25++
 		}
-		i -= j21
-		copy(dAtA[i:], dAtA22[:j21])
-		i = encodeVarintPipeline(dAtA, i, uint64(j21))
+		i -= j25
+		copy(dAtA[i:], dAtA26[:j25])
+		i = encodeVarintPipeline(dAtA, i, uint64(j25))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -4936,7 +5337,7 @@ func (m *Join) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *AntiJoin) Marshal() (dAtA []byte, err error) {
+func (m *MergeJoin) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4946,12 +5347,12 @@ func (m *AntiJoin) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AntiJoin) MarshalTo(dAtA []byte) (int, error) {
+func (m *MergeJoin) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AntiJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MergeJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4971,7 +5372,7 @@ func (m *AntiJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPipeline(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x32
 		}
 	}
 	if len(m.LeftCond) > 0 {
@@ -4985,7 +5386,7 @@ func (m *AntiJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPipeline(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x32
+			dAtA[i] = 0x2a
 		}
 	}
 	if len(m.Types) > 0 {
@@ -4999,54 +5400,56 @@ func (m *AntiJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPipeline(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x22
 		}
 	}
-	if m.Expr != nil {
-		{
-			size, err := m.Expr.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.ColList) > 0 {
+		dAtA28 := make([]byte, len(m.ColList)*10)
+		var j27 int
+		for _, num1 := range m.ColList {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA28[j27] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j27++
 			}
-			i -= size
-			i = encodeVarintPipeline(dAtA, i, uint64(size))
+			dAtA28[j27] = uint8(num)
+			j27++
 		}
+		i -= j27
+		copy(dAtA[i:], dAtA28[:j27])
+		i = encodeVarintPipeline(dAtA, i, uint64(j27))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x1a
 	}
-	if len(m.Result) > 0 {
-		dAtA25 := make([]byte, len(m.Result)*10)
-		var j24 int
-		for _, num1 := range m.Result {
+	if len(m.RelList) > 0 {
+		dAtA30 := make([]byte, len(m.RelList)*10)
+		var j29 int
+		for _, num1 := range m.RelList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA25[j24] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA30[j29] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j24++
+				j29++
 			}
-			dAtA25[j24] = uint8(num)
-			j24++
+			dAtA30[j29] = uint8(num)
+			j29++
 		}
-		i -= j24
-		copy(dAtA[i:], dAtA25[:j24])
-		i = encodeVarintPipeline(dAtA, i, uint64(j24))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Nbucket != 0 {
-		i = encodeVarintPipeline(dAtA, i, uint64(m.Nbucket))
+		i -= j29
+		copy(dAtA[i:], dAtA30[:j29])
+		i = encodeVarintPipeline(dAtA, i, uint64(j29))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.Ibucket != 0 {
-		i = encodeVarintPipeline(dAtA, i, uint64(m.Ibucket))
+	if m.JoinType != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.JoinType))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *InnerJoin) Marshal() (dAtA []byte, err error) {
+func (m *AntiJoin) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5056,12 +5459,12 @@ func (m *InnerJoin) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InnerJoin) MarshalTo(dAtA []byte) (int, error) {
+func (m *AntiJoin) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InnerJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AntiJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5081,7 +5484,7 @@ func (m *InnerJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPipeline(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x42
+			dAtA[i] = 0x3a
 		}
 	}
 	if len(m.LeftCond) > 0 {
@@ -5095,7 +5498,7 @@ func (m *InnerJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPipeline(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x32
 		}
 	}
 	if len(m.Types) > 0 {
@@ -5109,7 +5512,7 @@ func (m *InnerJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPipeline(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x32
+			dAtA[i] = 0x2a
 		}
 	}
 	if m.Expr != nil {
@@ -5122,43 +5525,24 @@ func (m *InnerJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPipeline(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ColList) > 0 {
-		dAtA28 := make([]byte, len(m.ColList)*10)
-		var j27 int
-		for _, num1 := range m.ColList {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA28[j27] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j27++
-			}
-			dAtA28[j27] = uint8(num)
-			j27++
-		}
-		i -= j27
-		copy(dAtA[i:], dAtA28[:j27])
-		i = encodeVarintPipeline(dAtA, i, uint64(j27))
-		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.RelList) > 0 {
-		dAtA30 := make([]byte, len(m.RelList)*10)
-		var j29 int
-		for _, num1 := range m.RelList {
+	if len(m.Result) > 0 {
+		dAtA33 := make([]byte, len(m.Result)*10)
+		var j32 int
+		for _, num1 := range m.Result {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA30[j29] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA33[j32] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j29++
+				j32++
 			}
-			dAtA30[j29] = uint8(num)
-			j29++
+			dAtA33[j32] = uint8(num)
+			j32++
 		}
-		i -= j29
-		copy(dAtA[i:], dAtA30[:j29])
-		i = encodeVarintPipeline(dAtA, i, uint64(j29))
+		i -= j32
+		copy(dAtA[i:], dAtA33[:j32])
+		i = encodeVarintPipeline(dAtA, i, uint64(j32))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -5175,7 +5559,7 @@ func (m *InnerJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *LeftJoin) Marshal() (dAtA []byte, err error) {
+func (m *InnerJoin) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5185,12 +5569,12 @@ func (m *LeftJoin) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LeftJoin) MarshalTo(dAtA []byte) (int, error) {
+func (m *InnerJoin) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LeftJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InnerJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5254,40 +5638,40 @@ func (m *LeftJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 	}
 	if len(m.ColList) > 0 {
-		dAtA33 := make([]byte, len(m.ColList)*10)
-		var j32 int
+		dAtA36 := make([]byte, len(m.ColList)*10)
+		var j35 int
 		for _, num1 := range m.ColList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA33[j32] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA36[j35] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j32++
+				j35++
 			}
-			dAtA33[j32] = uint8(num)
-			j32++
+			dAtA36[j35] = uint8(num)
+			j35++
 		}
-		i -= j32
-		copy(dAtA[i:], dAtA33[:j32])
-		i = encodeVarintPipeline(dAtA, i, uint64(j32))
+		i -= j35
+		copy(dAtA[i:], dAtA36[:j35])
+		i = encodeVarintPipeline(dAtA, i, uint64(j35))
 		i--
 		dAtA[i] = 0x22
 	}
 	if len(m.RelList) > 0 {
-		dAtA35 := make([]byte, len(m.RelList)*10)
-		var j34 int
+		dAtA38 := make([]byte, len(m.RelList)*10)
+		var j37 int
 		for _, num1 := range m.RelList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA35[j34] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA38[j37] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j34++
+				j37++
 			}
-			dAtA35[j34] = uint8(num)
-			j34++
+			dAtA38[j37] = uint8(num)
+			j37++
 		}
-		i -= j34
-		copy(dAtA[i:], dAtA35[:j34])
-		i = encodeVarintPipeline(dAtA, i, uint64(j34))
+		i -= j37
+		copy(dAtA[i:], dAtA38[:j37])
+		i = encodeVarintPipeline(dAtA, i, uint64(j37))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -5304,7 +5688,7 @@ func (m *LeftJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RightJoin) Marshal() (dAtA []byte, err error) {
+func (m *LeftJoin) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5314,12 +5698,12 @@ func (m *RightJoin) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RightJoin) MarshalTo(dAtA []byte) (int, error) {
+func (m *LeftJoin) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RightJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LeftJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5339,7 +5723,7 @@ func (m *RightJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPipeline(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4a
+			dAtA[i] = 0x42
 		}
 	}
 	if len(m.LeftCond) > 0 {
@@ -5353,13 +5737,13 @@ func (m *RightJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPipeline(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x42
+			dAtA[i] = 0x3a
 		}
 	}
-	if len(m.RightTypes) > 0 {
-		for iNdEx := len(m.RightTypes) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Types) > 0 {
+		for iNdEx := len(m.Types) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.RightTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Types[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5367,13 +5751,142 @@ func (m *RightJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPipeline(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x32
 		}
 	}
-	if len(m.LeftTypes) > 0 {
-		for iNdEx := len(m.LeftTypes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LeftTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+	if m.Expr != nil {
+		{
+			size, err := m.Expr.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPipeline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ColList) > 0 {
+		dAtA41 := make([]byte, len(m.ColList)*10)
+		var j40 int
+		for _, num1 := range m.ColList {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA41[j40] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j40++
+			}
+			dAtA41[j40] = uint8(num)
+			j40++
+		}
+		i -= j40
+		copy(dAtA[i:], dAtA41[:j40])
+		i = encodeVarintPipeline(dAtA, i, uint64(j40))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RelList) > 0 {
+		dAtA43 := make([]byte, len(m.RelList)*10)
+		var j42 int
+		for _, num1 := range m.RelList {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA43[j42] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j42++
+			}
+			dAtA43[j42] = uint8(num)
+			j42++
+		}
+		i -= j42
+		copy(dAtA[i:], dAtA43[:j42])
+		i = encodeVarintPipeline(dAtA, i, uint64(j42))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Nbucket != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.Nbucket))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Ibucket != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.Ibucket))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RightJoin) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RightJoin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RightJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RightCond) > 0 {
+		for iNdEx := len(m.RightCond) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RightCond[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPipeline(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.LeftCond) > 0 {
+		for iNdEx := len(m.LeftCond) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LeftCond[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPipeline(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.RightTypes) > 0 {
+		for iNdEx := len(m.RightTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RightTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPipeline(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.LeftTypes) > 0 {
+		for iNdEx := len(m.LeftTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LeftTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5397,40 +5910,40 @@ func (m *RightJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 	}
 	if len(m.ColList) > 0 {
-		dAtA38 := make([]byte, len(m.ColList)*10)
-		var j37 int
+		dAtA46 := make([]byte, len(m.ColList)*10)
+		var j45 int
 		for _, num1 := range m.ColList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA38[j37] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA46[j45] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j37++
+				j45++
 			}
-			dAtA38[j37] = uint8(num)
-			j37++
+			dAtA46[j45] = uint8(num)
+			j45++
 		}
-		i -= j37
-		copy(dAtA[i:], dAtA38[:j37])
-		i = encodeVarintPipeline(dAtA, i, uint64(j37))
+		i -= j45
+		copy(dAtA[i:], dAtA46[:j45])
+		i = encodeVarintPipeline(dAtA, i, uint64(j45))
 		i--
 		dAtA[i] = 0x22
 	}
 	if len(m.RelList) > 0 {
-		dAtA40 := make([]byte, len(m.RelList)*10)
-		var j39 int
+		dAtA48 := make([]byte, len(m.RelList)*10)
+		var j47 int
 		for _, num1 := range m.RelList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA40[j39] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA48[j47] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j39++
+				j47++
 			}
-			dAtA40[j39] = uint8(num)
-			j39++
+			dAtA48[j47] = uint8(num)
+			j47++
 		}
-		i -= j39
-		copy(dAtA[i:], dAtA40[:j39])
-		i = encodeVarintPipeline(dAtA, i, uint64(j39))
+		i -= j47
+		copy(dAtA[i:], dAtA48[:j47])
+		i = encodeVarintPipeline(dAtA, i, uint64(j47))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -5526,21 +6039,21 @@ func (m *RightSemiJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if len(m.Result) > 0 {
-		dAtA43 := make([]byte, len(m.Result)*10)
-		var j42 int
+		dAtA51 := make([]byte, len(m.Result)*10)
+		var j50 int
 		for _, num1 := range m.Result {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA43[j42] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA51[j50] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j42++
+				j50++
 			}
-			dAtA43[j42] = uint8(num)
-			j42++
+			dAtA51[j50] = uint8(num)
+			j50++
 		}
-		i -= j42
-		copy(dAtA[i:], dAtA43[:j42])
-		i = encodeVarintPipeline(dAtA, i, uint64(j42))
+		i -= j50
+		copy(dAtA[i:], dAtA51[:j50])
+		i = encodeVarintPipeline(dAtA, i, uint64(j50))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -5636,21 +6149,21 @@ func (m *RightAntiJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if len(m.Result) > 0 {
-		dAtA46 := make([]byte, len(m.Result)*10)
-		var j45 int
+		dAtA54 := make([]byte, len(m.Result)*10)
+		var j53 int
 		for _, num1 := range m.Result {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA46[j45] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA54[j53] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j45++
+				j53++
 			}
-			dAtA46[j45] = uint8(num)
-			j45++
+			dAtA54[j53] = uint8(num)
+			j53++
 		}
-		i -= j45
-		copy(dAtA[i:], dAtA46[:j45])
-		i = encodeVarintPipeline(dAtA, i, uint64(j45))
+		i -= j53
+		copy(dAtA[i:], dAtA54[:j53])
+		i = encodeVarintPipeline(dAtA, i, uint64(j53))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -5691,6 +6204,20 @@ func (m *SemiJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.RuntimeFilterBuildList) > 0 {
+		for iNdEx := len(m.RuntimeFilterBuildList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RuntimeFilterBuildList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPipeline(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.RightCond) > 0 {
 		for iNdEx := len(m.RightCond) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -5746,21 +6273,21 @@ func (m *SemiJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if len(m.Result) > 0 {
-		dAtA49 := make([]byte, len(m.Result)*10)
-		var j48 int
+		dAtA57 := make([]byte, len(m.Result)*10)
+		var j56 int
 		for _, num1 := range m.Result {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA49[j48] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA57[j56] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j48++
+				j56++
 			}
-			dAtA49[j48] = uint8(num)
-			j48++
+			dAtA57[j56] = uint8(num)
+			j56++
 		}
-		i -= j48
-		copy(dAtA[i:], dAtA49[:j48])
-		i = encodeVarintPipeline(dAtA, i, uint64(j48))
+		i -= j56
+		copy(dAtA[i:], dAtA57[:j56])
+		i = encodeVarintPipeline(dAtA, i, uint64(j56))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -5856,40 +6383,40 @@ func (m *SingleJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 	}
 	if len(m.ColList) > 0 {
-		dAtA52 := make([]byte, len(m.ColList)*10)
-		var j51 int
+		dAtA60 := make([]byte, len(m.ColList)*10)
+		var j59 int
 		for _, num1 := range m.ColList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA52[j51] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA60[j59] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j51++
+				j59++
 			}
-			dAtA52[j51] = uint8(num)
-			j51++
+			dAtA60[j59] = uint8(num)
+			j59++
 		}
-		i -= j51
-		copy(dAtA[i:], dAtA52[:j51])
-		i = encodeVarintPipeline(dAtA, i, uint64(j51))
+		i -= j59
+		copy(dAtA[i:], dAtA60[:j59])
+		i = encodeVarintPipeline(dAtA, i, uint64(j59))
 		i--
 		dAtA[i] = 0x22
 	}
 	if len(m.RelList) > 0 {
-		dAtA54 := make([]byte, len(m.RelList)*10)
-		var j53 int
+		dAtA62 := make([]byte, len(m.RelList)*10)
+		var j61 int
 		for _, num1 := range m.RelList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA54[j53] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA62[j61] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j53++
+				j61++
 			}
-			dAtA54[j53] = uint8(num)
-			j53++
+			dAtA62[j61] = uint8(num)
+			j61++
 		}
-		i -= j53
-		copy(dAtA[i:], dAtA54[:j53])
-		i = encodeVarintPipeline(dAtA, i, uint64(j53))
+		i -= j61
+		copy(dAtA[i:], dAtA62[:j61])
+		i = encodeVarintPipeline(dAtA, i, uint64(j61))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -5999,21 +6526,21 @@ func (m *MarkJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if len(m.Result) > 0 {
-		dAtA57 := make([]byte, len(m.Result)*10)
-		var j56 int
+		dAtA65 := make([]byte, len(m.Result)*10)
+		var j64 int
 		for _, num1 := range m.Result {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA57[j56] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA65[j64] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j56++
+				j64++
 			}
-			dAtA57[j56] = uint8(num)
-			j56++
+			dAtA65[j64] = uint8(num)
+			j64++
 		}
-		i -= j56
-		copy(dAtA[i:], dAtA57[:j56])
-		i = encodeVarintPipeline(dAtA, i, uint64(j56))
+		i -= j64
+		copy(dAtA[i:], dAtA65[:j64])
+		i = encodeVarintPipeline(dAtA, i, uint64(j64))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -6069,40 +6596,40 @@ func (m *Product) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.ColList) > 0 {
-		dAtA59 := make([]byte, len(m.ColList)*10)
-		var j58 int
+		dAtA67 := make([]byte, len(m.ColList)*10)
+		var j66 int
 		for _, num1 := range m.ColList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA59[j58] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA67[j66] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j58++
+				j66++
 			}
-			dAtA59[j58] = uint8(num)
-			j58++
+			dAtA67[j66] = uint8(num)
+			j66++
 		}
-		i -= j58
-		copy(dAtA[i:], dAtA59[:j58])
-		i = encodeVarintPipeline(dAtA, i, uint64(j58))
+		i -= j66
+		copy(dAtA[i:], dAtA67[:j66])
+		i = encodeVarintPipeline(dAtA, i, uint64(j66))
 		i--
 		dAtA[i] = 0x12
 	}
 	if len(m.RelList) > 0 {
-		dAtA61 := make([]byte, len(m.RelList)*10)
-		var j60 int
+		dAtA69 := make([]byte, len(m.RelList)*10)
+		var j68 int
 		for _, num1 := range m.RelList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA61[j60] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA69[j68] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j60++
+				j68++
 			}
-			dAtA61[j60] = uint8(num)
-			j60++
+			dAtA69[j68] = uint8(num)
+			j68++
 		}
-		i -= j60
-		copy(dAtA[i:], dAtA61[:j60])
-		i = encodeVarintPipeline(dAtA, i, uint64(j60))
+		i -= j68
+		copy(dAtA[i:], dAtA69[:j68])
+		i = encodeVarintPipeline(dAtA, i, uint64(j68))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -6211,6 +6738,20 @@ func (m *HashBuild) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.RuntimeFilterBuildList) > 0 {
+		for iNdEx := len(m.RuntimeFilterBuildList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RuntimeFilterBuildList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPipeline(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.Conds) > 0 {
 		for iNdEx := len(m.Conds) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -6336,21 +6877,21 @@ func (m *FileOffset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Offset) > 0 {
-		dAtA63 := make([]byte, len(m.Offset)*10)
-		var j62 int
+		dAtA71 := make([]byte, len(m.Offset)*10)
+		var j70 int
 		for _, num1 := range m.Offset {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA63[j62] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA71[j70] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j62++
+				j70++
 			}
-			dAtA63[j62] = uint8(num)
-			j62++
+			dAtA71[j70] = uint8(num)
+			j70++
 		}
-		i -= j62
-		copy(dAtA[i:], dAtA63[:j62])
-		i = encodeVarintPipeline(dAtA, i, uint64(j62))
+		i -= j70
+		copy(dAtA[i:], dAtA71[:j70])
+		i = encodeVarintPipeline(dAtA, i, uint64(j70))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -6466,21 +7007,21 @@ func (m *ExternalScan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.FileSize) > 0 {
-		dAtA66 := make([]byte, len(m.FileSize)*10)
-		var j65 int
+		dAtA74 := make([]byte, len(m.FileSize)*10)
+		var j73 int
 		for _, num1 := range m.FileSize {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA66[j65] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA74[j73] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j65++
+				j73++
 			}
-			dAtA66[j65] = uint8(num)
-			j65++
+			dAtA74[j73] = uint8(num)
+			j73++
 		}
-		i -= j65
-		copy(dAtA[i:], dAtA66[:j65])
-		i = encodeVarintPipeline(dAtA, i, uint64(j65))
+		i -= j73
+		copy(dAtA[i:], dAtA74[:j73])
+		i = encodeVarintPipeline(dAtA, i, uint64(j73))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -6520,6 +7061,20 @@ func (m *Instruction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.MergeJoin != nil {
+		{
+			size, err := m.MergeJoin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPipeline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
+	}
 	if m.Delete != nil {
 		{
 			size, err := m.Delete.MarshalToSizedBuffer(dAtA[:i])
@@ -6964,6 +7519,20 @@ func (m *Source) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.RuntimeFilterProbeList) > 0 {
+		for iNdEx := len(m.RuntimeFilterProbeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RuntimeFilterProbeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPipeline(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if m.Timestamp != nil {
 		{
 			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
@@ -7177,21 +7746,21 @@ func (m *ProcessInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.AnalysisNodeList) > 0 {
-		dAtA94 := make([]byte, len(m.AnalysisNodeList)*10)
-		var j93 int
+		dAtA103 := make([]byte, len(m.AnalysisNodeList)*10)
+		var j102 int
 		for _, num1 := range m.AnalysisNodeList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA94[j93] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA103[j102] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j93++
+				j102++
 			}
-			dAtA94[j93] = uint8(num)
-			j93++
+			dAtA103[j102] = uint8(num)
+			j102++
 		}
-		i -= j93
-		copy(dAtA[i:], dAtA94[:j93])
-		i = encodeVarintPipeline(dAtA, i, uint64(j93))
+		i -= j102
+		copy(dAtA[i:], dAtA103[:j102])
+		i = encodeVarintPipeline(dAtA, i, uint64(j102))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -7625,17 +8194,38 @@ func (m *Message) ProtoSize() (n int) {
 	return n
 }
 
-func (m *Connector) ProtoSize() (n int) {
+func (m *RuntimeFilter) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.PipelineId != 0 {
-		n += 1 + sovPipeline(uint64(m.PipelineId))
+	if m.Tag != 0 {
+		n += 1 + sovPipeline(uint64(m.Tag))
 	}
-	if m.ConnectorIndex != 0 {
-		n += 1 + sovPipeline(uint64(m.ConnectorIndex))
+	if m.Typ != 0 {
+		n += 1 + sovPipeline(uint64(m.Typ))
+	}
+	if m.Oid != 0 {
+		n += 1 + sovPipeline(uint64(m.Oid))
+	}
+	l = len(m.Min)
+	if l > 0 {
+		n += 1 + l + sovPipeline(uint64(l))
+	}
+	l = len(m.Max)
+	if l > 0 {
+		n += 1 + l + sovPipeline(uint64(l))
+	}
+	if len(m.Keys) > 0 {
+		for _, b := range m.Keys {
+			l = len(b)
+			n += 1 + l + sovPipeline(uint64(l))
+		}
+	}
+	l = len(m.Bloom)
+	if l > 0 {
+		n += 1 + l + sovPipeline(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -7643,14 +8233,32 @@ func (m *Connector) ProtoSize() (n int) {
 	return n
 }
 
-func (m *Dispatch) ProtoSize() (n int) {
+func (m *Connector) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.FuncId != 0 {
-		n += 1 + sovPipeline(uint64(m.FuncId))
+	if m.PipelineId != 0 {
+		n += 1 + sovPipeline(uint64(m.PipelineId))
+	}
+	if m.ConnectorIndex != 0 {
+		n += 1 + sovPipeline(uint64(m.ConnectorIndex))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Dispatch) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FuncId != 0 {
+		n += 1 + sovPipeline(uint64(m.FuncId))
 	}
 	if len(m.LocalConnector) > 0 {
 		for _, e := range m.LocalConnector {
@@ -7664,6 +8272,26 @@ func (m *Dispatch) ProtoSize() (n int) {
 			n += 1 + l + sovPipeline(uint64(l))
 		}
 	}
+	if len(m.ShuffleExprs) > 0 {
+		for _, e := range m.ShuffleExprs {
+			l = e.ProtoSize()
+			n += 1 + l + sovPipeline(uint64(l))
+		}
+	}
+	if len(m.ShuffleLocalIdx) > 0 {
+		l = 0
+		for _, e := range m.ShuffleLocalIdx {
+			l += sovPipeline(uint64(e))
+		}
+		n += 1 + sovPipeline(uint64(l)) + l
+	}
+	if len(m.ShuffleRemoteIdx) > 0 {
+		l = 0
+		for _, e := range m.ShuffleRemoteIdx {
+			l += sovPipeline(uint64(e))
+		}
+		n += 1 + sovPipeline(uint64(l)) + l
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -8054,6 +8682,59 @@ func (m *Join) ProtoSize() (n int) {
 			n += 1 + l + sovPipeline(uint64(l))
 		}
 	}
+	if len(m.RuntimeFilterBuildList) > 0 {
+		for _, e := range m.RuntimeFilterBuildList {
+			l = e.ProtoSize()
+			n += 1 + l + sovPipeline(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MergeJoin) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JoinType != 0 {
+		n += 1 + sovPipeline(uint64(m.JoinType))
+	}
+	if len(m.RelList) > 0 {
+		l = 0
+		for _, e := range m.RelList {
+			l += sovPipeline(uint64(e))
+		}
+		n += 1 + sovPipeline(uint64(l)) + l
+	}
+	if len(m.ColList) > 0 {
+		l = 0
+		for _, e := range m.ColList {
+			l += sovPipeline(uint64(e))
+		}
+		n += 1 + sovPipeline(uint64(l)) + l
+	}
+	if len(m.Types) > 0 {
+		for _, e := range m.Types {
+			l = e.ProtoSize()
+			n += 1 + l + sovPipeline(uint64(l))
+		}
+	}
+	if len(m.LeftCond) > 0 {
+		for _, e := range m.LeftCond {
+			l = e.ProtoSize()
+			n += 1 + l + sovPipeline(uint64(l))
+		}
+	}
+	if len(m.RightCond) > 0 {
+		for _, e := range m.RightCond {
+			l = e.ProtoSize()
+			n += 1 + l + sovPipeline(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -8410,6 +9091,12 @@ func (m *SemiJoin) ProtoSize() (n int) {
 			n += 1 + l + sovPipeline(uint64(l))
 		}
 	}
+	if len(m.RuntimeFilterBuildList) > 0 {
+		for _, e := range m.RuntimeFilterBuildList {
+			l = e.ProtoSize()
+			n += 1 + l + sovPipeline(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -8623,6 +9310,12 @@ func (m *HashBuild) ProtoSize() (n int) {
 			n += 1 + l + sovPipeline(uint64(l))
 		}
 	}
+	if len(m.RuntimeFilterBuildList) > 0 {
+		for _, e := range m.RuntimeFilterBuildList {
+			l = e.ProtoSize()
+			n += 1 + l + sovPipeline(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -8858,6 +9551,10 @@ func (m *Instruction) ProtoSize() (n int) {
 		l = m.Delete.ProtoSize()
 		n += 2 + l + sovPipeline(uint64(l))
 	}
+	if m.MergeJoin != nil {
+		l = m.MergeJoin.ProtoSize()
+		n += 2 + l + sovPipeline(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -8925,6 +9622,12 @@ func (m *Source) ProtoSize() (n int) {
 		l = m.Timestamp.ProtoSize()
 		n += 1 + l + sovPipeline(uint64(l))
 	}
+	if len(m.RuntimeFilterProbeList) > 0 {
+		for _, e := range m.RuntimeFilterProbeList {
+			l = e.ProtoSize()
+			n += 1 + l + sovPipeline(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -9515,7 +10218,7 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Connector) Unmarshal(dAtA []byte) error {
+func (m *RuntimeFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9538,17 +10241,17 @@ func (m *Connector) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Connector: wiretype end group for non-group")
+			return fmt.Errorf("proto: RuntimeFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Connector: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RuntimeFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
 			}
-			m.PipelineId = 0
+			m.Tag = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPipeline
@@ -9558,16 +10261,16 @@ func (m *Connector) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PipelineId |= int32(b&0x7F) << shift
+				m.Tag |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectorIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Typ", wireType)
 			}
-			m.ConnectorIndex = 0
+			m.Typ = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPipeline
@@ -9577,67 +10280,69 @@ func (m *Connector) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ConnectorIndex |= int32(b&0x7F) << shift
+				m.Typ |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPipeline(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oid", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPipeline
+			m.Oid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Oid |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Dispatch) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPipeline
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if byteLen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Min = append(m.Min[:0], dAtA[iNdEx:postIndex]...)
+			if m.Min == nil {
+				m.Min = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Dispatch: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Dispatch: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FuncId", wireType)
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
 			}
-			m.FuncId = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPipeline
@@ -9647,16 +10352,31 @@ func (m *Dispatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FuncId |= int32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if byteLen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Max = append(m.Max[:0], dAtA[iNdEx:postIndex]...)
+			if m.Max == nil {
+				m.Max = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocalConnector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPipeline
@@ -9666,31 +10386,29 @@ func (m *Dispatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPipeline
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPipeline
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LocalConnector = append(m.LocalConnector, &Connector{})
-			if err := m.LocalConnector[len(m.LocalConnector)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Keys = append(m.Keys, make([]byte, postIndex-iNdEx))
+			copy(m.Keys[len(m.Keys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteConnector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bloom", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPipeline
@@ -9700,24 +10418,24 @@ func (m *Dispatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPipeline
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPipeline
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoteConnector = append(m.RemoteConnector, &WrapNode{})
-			if err := m.RemoteConnector[len(m.RemoteConnector)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Bloom = append(m.Bloom[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bloom == nil {
+				m.Bloom = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -9742,7 +10460,7 @@ func (m *Dispatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiArguemnt) Unmarshal(dAtA []byte) error {
+func (m *Connector) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9765,17 +10483,17 @@ func (m *MultiArguemnt) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultiArguemnt: wiretype end group for non-group")
+			return fmt.Errorf("proto: Connector: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiArguemnt: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Connector: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineId", wireType)
 			}
-			var v int
+			m.PipelineId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPipeline
@@ -9785,17 +10503,16 @@ func (m *MultiArguemnt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.PipelineId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Dist = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupExpr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectorIndex", wireType)
 			}
-			var msglen int
+			m.ConnectorIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPipeline
@@ -9805,107 +10522,7 @@ func (m *MultiArguemnt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPipeline
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPipeline
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GroupExpr = append(m.GroupExpr, &plan.Expr{})
-			if err := m.GroupExpr[len(m.GroupExpr)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderByExpr", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPipeline
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPipeline
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPipeline
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OrderByExpr = append(m.OrderByExpr, &plan.Expr{})
-			if err := m.OrderByExpr[len(m.OrderByExpr)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Separator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPipeline
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPipeline
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPipeline
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Separator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
-			}
-			m.OrderId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPipeline
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OrderId |= int32(b&0x7F) << shift
+				m.ConnectorIndex |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9932,7 +10549,7 @@ func (m *MultiArguemnt) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Aggregate) Unmarshal(dAtA []byte) error {
+func (m *Dispatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9955,17 +10572,17 @@ func (m *Aggregate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Aggregate: wiretype end group for non-group")
+			return fmt.Errorf("proto: Dispatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Aggregate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Dispatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FuncId", wireType)
 			}
-			m.Op = 0
+			m.FuncId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPipeline
@@ -9975,34 +10592,14 @@ func (m *Aggregate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Op |= int32(b&0x7F) << shift
+				m.FuncId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dist", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPipeline
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Dist = bool(v != 0)
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalConnector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10029,69 +10626,16 @@ func (m *Aggregate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Expr == nil {
-				m.Expr = &plan.Expr{}
-			}
-			if err := m.Expr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LocalConnector = append(m.LocalConnector, &Connector{})
+			if err := m.LocalConnector[len(m.LocalConnector)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPipeline(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPipeline
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Group) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPipeline
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Group: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Group: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NeedEval", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteConnector", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPipeline
@@ -10101,53 +10645,29 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.NeedEval = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ibucket", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
 			}
-			m.Ibucket = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPipeline
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Ibucket |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nbucket", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Nbucket = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPipeline
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nbucket |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.RemoteConnector = append(m.RemoteConnector, &WrapNode{})
+			if err := m.RemoteConnector[len(m.RemoteConnector)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exprs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShuffleExprs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10174,113 +10694,163 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Exprs = append(m.Exprs, &plan.Expr{})
-			if err := m.Exprs[len(m.Exprs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ShuffleExprs = append(m.ShuffleExprs, &plan.Expr{})
+			if err := m.ShuffleExprs[len(m.ShuffleExprs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPipeline
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPipeline
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.ShuffleLocalIdx = append(m.ShuffleLocalIdx, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPipeline
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPipeline
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPipeline
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.ShuffleLocalIdx) == 0 {
+					m.ShuffleLocalIdx = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPipeline
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ShuffleLocalIdx = append(m.ShuffleLocalIdx, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShuffleLocalIdx", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPipeline
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPipeline
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Types = append(m.Types, &plan.Type{})
-			if err := m.Types[len(m.Types)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Aggs", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPipeline
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPipeline
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.ShuffleRemoteIdx = append(m.ShuffleRemoteIdx, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPipeline
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthPipeline
 				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPipeline
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPipeline
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Aggs = append(m.Aggs, &Aggregate{})
-			if err := m.Aggs[len(m.Aggs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultiAggs", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPipeline
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPipeline
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.ShuffleRemoteIdx) == 0 {
+					m.ShuffleRemoteIdx = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPipeline
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ShuffleRemoteIdx = append(m.ShuffleRemoteIdx, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShuffleRemoteIdx", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPipeline
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPipeline
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MultiAggs = append(m.MultiAggs, &MultiArguemnt{})
-			if err := m.MultiAggs[len(m.MultiAggs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -10303,7 +10873,7 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Insert) Unmarshal(dAtA []byte) error {
+func (m *MultiArguemnt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10326,17 +10896,17 @@ func (m *Insert) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Insert: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiArguemnt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Insert: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiArguemnt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Affected", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dist", wireType)
 			}
-			m.Affected = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPipeline
@@ -10346,16 +10916,17 @@ func (m *Insert) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Affected |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Dist = bool(v != 0)
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsRemote", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupExpr", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPipeline
@@ -10365,17 +10936,31 @@ func (m *Insert) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsRemote = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupExpr = append(m.GroupExpr, &plan.Expr{})
+			if err := m.GroupExpr[len(m.GroupExpr)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddAffectedRows", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderByExpr", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPipeline
@@ -10385,27 +10970,7 @@ func (m *Insert) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AddAffectedRows = bool(v != 0)
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPipeline
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10420,16 +10985,14 @@ func (m *Insert) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Ref == nil {
-				m.Ref = &plan.ObjectRef{}
-			}
-			if err := m.Ref.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OrderByExpr = append(m.OrderByExpr, &plan.Expr{})
+			if err := m.OrderByExpr[len(m.OrderByExpr)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attrs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Separator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10457,89 +11020,83 @@ func (m *Insert) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attrs = append(m.Attrs, string(dAtA[iNdEx:postIndex]))
+			m.Separator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPipeline
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.PartitionTableIds = append(m.PartitionTableIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPipeline
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPipeline
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPipeline
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.PartitionTableIds) == 0 {
-					m.PartitionTableIds = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPipeline
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.PartitionTableIds = append(m.PartitionTableIds, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionTableIds", wireType)
 			}
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPipeline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Aggregate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPipeline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Aggregate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Aggregate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionIdx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
 			}
-			m.PartitionIdx = 0
+			m.Op = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPipeline
@@ -10549,14 +11106,14 @@ func (m *Insert) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PartitionIdx |= int32(b&0x7F) << shift
+				m.Op |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsEnd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dist", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -10573,7 +11130,43 @@ func (m *Insert) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IsEnd = bool(v != 0)
+			m.Dist = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expr", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expr == nil {
+				m.Expr = &plan.Expr{}
+			}
+			if err := m.Expr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -10596,7 +11189,7 @@ func (m *Insert) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Array) Unmarshal(dAtA []byte) error {
+func (m *Group) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10619,41 +11212,579 @@ func (m *Array) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Array: wiretype end group for non-group")
+			return fmt.Errorf("proto: Group: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Array: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Group: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPipeline
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NeedEval", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
 				}
-				m.Array = append(m.Array, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPipeline
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NeedEval = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ibucket", wireType)
+			}
+			m.Ibucket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ibucket |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nbucket", wireType)
+			}
+			m.Nbucket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nbucket |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exprs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exprs = append(m.Exprs, &plan.Expr{})
+			if err := m.Exprs[len(m.Exprs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Types = append(m.Types, &plan.Type{})
+			if err := m.Types[len(m.Types)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aggs = append(m.Aggs, &Aggregate{})
+			if err := m.Aggs[len(m.Aggs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiAggs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultiAggs = append(m.MultiAggs, &MultiArguemnt{})
+			if err := m.MultiAggs[len(m.MultiAggs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPipeline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Insert) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPipeline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Insert: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Insert: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Affected", wireType)
+			}
+			m.Affected = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Affected |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsRemote", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsRemote = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddAffectedRows", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AddAffectedRows = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ref == nil {
+				m.Ref = &plan.ObjectRef{}
+			}
+			if err := m.Ref.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attrs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attrs = append(m.Attrs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPipeline
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PartitionTableIds = append(m.PartitionTableIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPipeline
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPipeline
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPipeline
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PartitionTableIds) == 0 {
+					m.PartitionTableIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPipeline
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PartitionTableIds = append(m.PartitionTableIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionTableIds", wireType)
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionIdx", wireType)
+			}
+			m.PartitionIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartitionIdx |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsEnd", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsEnd = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPipeline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Array) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPipeline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Array: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Array: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPipeline
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Array = append(m.Array, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPipeline
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
 					packedLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
@@ -11594,68 +12725,267 @@ func (m *PreInsert) Unmarshal(dAtA []byte) error {
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsUpdate = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPipeline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PreInsertUnique) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPipeline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PreInsertUnique: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PreInsertUnique: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreInsertUkCtx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreInsertUkCtx == nil {
+				m.PreInsertUkCtx = &plan.PreInsertUkCtx{}
+			}
+			if err := m.PreInsertUkCtx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPipeline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OnDuplicateKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPipeline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OnDuplicateKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OnDuplicateKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableDef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TableDef == nil {
+				m.TableDef = &plan.TableDef{}
+			}
+			if err := m.TableDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPipeline
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.OnDuplicateIdx = append(m.OnDuplicateIdx, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPipeline
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPipeline
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPipeline
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.OnDuplicateIdx) == 0 {
+					m.OnDuplicateIdx = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPipeline
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.OnDuplicateIdx = append(m.OnDuplicateIdx, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnDuplicateIdx", wireType)
 			}
-			m.IsUpdate = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPipeline(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPipeline
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PreInsertUnique) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPipeline
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PreInsertUnique: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PreInsertUnique: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreInsertUkCtx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OnDuplicateExpr", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11682,12 +13012,105 @@ func (m *PreInsertUnique) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PreInsertUkCtx == nil {
-				m.PreInsertUkCtx = &plan.PreInsertUkCtx{}
+			if m.OnDuplicateExpr == nil {
+				m.OnDuplicateExpr = make(map[string]*plan.Expr)
 			}
-			if err := m.PreInsertUkCtx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue *plan.Expr
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPipeline
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPipeline
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPipeline
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPipeline
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPipeline
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthPipeline
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthPipeline
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &plan.Expr{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPipeline(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPipeline
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.OnDuplicateExpr[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11711,7 +13134,7 @@ func (m *PreInsertUnique) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OnDuplicateKey) Unmarshal(dAtA []byte) error {
+func (m *Join) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11734,49 +13157,127 @@ func (m *OnDuplicateKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OnDuplicateKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: Join: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OnDuplicateKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Join: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableDef", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ibucket", wireType)
 			}
-			var msglen int
+			m.Ibucket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ibucket |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nbucket", wireType)
+			}
+			m.Nbucket = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPipeline
 				}
-				if iNdEx >= l {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nbucket |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPipeline
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RelList = append(m.RelList, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPipeline
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPipeline
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPipeline
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.RelList) == 0 {
+					m.RelList = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPipeline
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RelList = append(m.RelList, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelList", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPipeline
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPipeline
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TableDef == nil {
-				m.TableDef = &plan.TableDef{}
-			}
-			if err := m.TableDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType == 0 {
 				var v int32
 				for shift := uint(0); ; shift += 7 {
@@ -11793,7 +13294,7 @@ func (m *OnDuplicateKey) Unmarshal(dAtA []byte) error {
 						break
 					}
 				}
-				m.OnDuplicateIdx = append(m.OnDuplicateIdx, v)
+				m.ColList = append(m.ColList, v)
 			} else if wireType == 2 {
 				var packedLen int
 				for shift := uint(0); ; shift += 7 {
@@ -11828,8 +13329,8 @@ func (m *OnDuplicateKey) Unmarshal(dAtA []byte) error {
 					}
 				}
 				elementCount = count
-				if elementCount != 0 && len(m.OnDuplicateIdx) == 0 {
-					m.OnDuplicateIdx = make([]int32, 0, elementCount)
+				if elementCount != 0 && len(m.ColList) == 0 {
+					m.ColList = make([]int32, 0, elementCount)
 				}
 				for iNdEx < postIndex {
 					var v int32
@@ -11847,14 +13348,14 @@ func (m *OnDuplicateKey) Unmarshal(dAtA []byte) error {
 							break
 						}
 					}
-					m.OnDuplicateIdx = append(m.OnDuplicateIdx, v)
+					m.ColList = append(m.ColList, v)
 				}
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnDuplicateIdx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ColList", wireType)
 			}
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnDuplicateExpr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expr", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11881,105 +13382,148 @@ func (m *OnDuplicateKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OnDuplicateExpr == nil {
-				m.OnDuplicateExpr = make(map[string]*plan.Expr)
+			if m.Expr == nil {
+				m.Expr = &plan.Expr{}
 			}
-			var mapkey string
-			var mapvalue *plan.Expr
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPipeline
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if err := m.Expr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPipeline
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthPipeline
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthPipeline
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPipeline
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthPipeline
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthPipeline
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &plan.Expr{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPipeline(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthPipeline
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Types = append(m.Types, &plan.Type{})
+			if err := m.Types[len(m.Types)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeftCond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeftCond = append(m.LeftCond, &plan.Expr{})
+			if err := m.LeftCond[len(m.LeftCond)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RightCond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.OnDuplicateExpr[mapkey] = mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RightCond = append(m.RightCond, &plan.Expr{})
+			if err := m.RightCond[len(m.RightCond)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeFilterBuildList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeFilterBuildList = append(m.RuntimeFilterBuildList, &plan.RuntimeFilterSpec{})
+			if err := m.RuntimeFilterBuildList[len(m.RuntimeFilterBuildList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12003,7 +13547,7 @@ func (m *OnDuplicateKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Join) Unmarshal(dAtA []byte) error {
+func (m *MergeJoin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12026,17 +13570,17 @@ func (m *Join) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Join: wiretype end group for non-group")
+			return fmt.Errorf("proto: MergeJoin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Join: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MergeJoin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ibucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinType", wireType)
 			}
-			m.Ibucket = 0
+			m.JoinType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPipeline
@@ -12046,31 +13590,12 @@ func (m *Join) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Ibucket |= uint64(b&0x7F) << shift
+				m.JoinType |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nbucket", wireType)
-			}
-			m.Nbucket = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPipeline
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nbucket |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType == 0 {
 				var v int32
 				for shift := uint(0); ; shift += 7 {
@@ -12146,7 +13671,7 @@ func (m *Join) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RelList", wireType)
 			}
-		case 4:
+		case 3:
 			if wireType == 0 {
 				var v int32
 				for shift := uint(0); ; shift += 7 {
@@ -12222,43 +13747,7 @@ func (m *Join) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field ColList", wireType)
 			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expr", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPipeline
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPipeline
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPipeline
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Expr == nil {
-				m.Expr = &plan.Expr{}
-			}
-			if err := m.Expr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
 			}
@@ -12292,7 +13781,7 @@ func (m *Join) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field LeftCond", wireType)
 			}
@@ -12326,7 +13815,7 @@ func (m *Join) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RightCond", wireType)
 			}
@@ -14743,6 +16232,40 @@ func (m *SemiJoin) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeFilterBuildList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeFilterBuildList = append(m.RuntimeFilterBuildList, &plan.RuntimeFilterSpec{})
+			if err := m.RuntimeFilterBuildList[len(m.RuntimeFilterBuildList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -16110,6 +17633,40 @@ func (m *HashBuild) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeFilterBuildList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeFilterBuildList = append(m.RuntimeFilterBuildList, &plan.RuntimeFilterSpec{})
+			if err := m.RuntimeFilterBuildList[len(m.RuntimeFilterBuildList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -17797,6 +19354,42 @@ func (m *Instruction) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeJoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MergeJoin == nil {
+				m.MergeJoin = &MergeJoin{}
+			}
+			if err := m.MergeJoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -18220,6 +19813,40 @@ func (m *Source) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeFilterProbeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeFilterProbeList = append(m.RuntimeFilterProbeList, &plan.RuntimeFilterSpec{})
+			if err := m.RuntimeFilterProbeList[len(m.RuntimeFilterProbeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
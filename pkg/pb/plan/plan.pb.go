@@ -323,7 +323,7 @@ func (x OrderBySpec_OrderByFlag) String() string {
 }
 
 func (OrderBySpec_OrderByFlag) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{40, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{42, 0}
 }
 
 type Node_NodeType int32
@@ -464,7 +464,7 @@ func (x Node_NodeType) String() string {
 }
 
 func (Node_NodeType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{46, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{48, 0}
 }
 
 type Node_JoinType int32
@@ -510,7 +510,7 @@ func (x Node_JoinType) String() string {
 }
 
 func (Node_JoinType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{46, 1}
+	return fileDescriptor_2d655ab2f7683c23, []int{48, 1}
 }
 
 type Node_AggMode int32
@@ -538,7 +538,66 @@ func (x Node_AggMode) String() string {
 }
 
 func (Node_AggMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{46, 2}
+	return fileDescriptor_2d655ab2f7683c23, []int{48, 2}
+}
+
+type Node_JoinMethod int32
+
+const (
+	Node_AUTO_JOIN_METHOD Node_JoinMethod = 0
+	Node_HASH_JOIN        Node_JoinMethod = 1
+	Node_NESTED_LOOP_JOIN Node_JoinMethod = 2
+	Node_MERGE_JOIN       Node_JoinMethod = 3
+)
+
+var Node_JoinMethod_name = map[int32]string{
+	0: "AUTO_JOIN_METHOD",
+	1: "HASH_JOIN",
+	2: "NESTED_LOOP_JOIN",
+	3: "MERGE_JOIN",
+}
+
+var Node_JoinMethod_value = map[string]int32{
+	"AUTO_JOIN_METHOD": 0,
+	"HASH_JOIN":        1,
+	"NESTED_LOOP_JOIN": 2,
+	"MERGE_JOIN":       3,
+}
+
+func (x Node_JoinMethod) String() string {
+	return proto.EnumName(Node_JoinMethod_name, int32(x))
+}
+
+func (Node_JoinMethod) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{48, 3}
+}
+
+type Node_JoinDistribution int32
+
+const (
+	Node_AUTO_JOIN_DISTRIBUTION Node_JoinDistribution = 0
+	Node_BROADCAST_JOIN         Node_JoinDistribution = 1
+	Node_SHUFFLE_JOIN           Node_JoinDistribution = 2
+)
+
+var Node_JoinDistribution_name = map[int32]string{
+	0: "AUTO_JOIN_DISTRIBUTION",
+	1: "BROADCAST_JOIN",
+	2: "SHUFFLE_JOIN",
+}
+
+var Node_JoinDistribution_value = map[string]int32{
+	"AUTO_JOIN_DISTRIBUTION": 0,
+	"BROADCAST_JOIN":         1,
+	"SHUFFLE_JOIN":           2,
+}
+
+func (x Node_JoinDistribution) String() string {
+	return proto.EnumName(Node_JoinDistribution_name, int32(x))
+}
+
+func (Node_JoinDistribution) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{48, 4}
 }
 
 type Query_StatementType int32
@@ -575,7 +634,7 @@ func (x Query_StatementType) String() string {
 }
 
 func (Query_StatementType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{53, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{56, 0}
 }
 
 type TransationControl_TclType int32
@@ -603,7 +662,7 @@ func (x TransationControl_TclType) String() string {
 }
 
 func (TransationControl_TclType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{54, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{57, 0}
 }
 
 type TransationBegin_TransationMode int32
@@ -631,7 +690,7 @@ func (x TransationBegin_TransationMode) String() string {
 }
 
 func (TransationBegin_TransationMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{55, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{58, 0}
 }
 
 type DataControl_DclType int32
@@ -671,7 +730,7 @@ func (x DataControl_DclType) String() string {
 }
 
 func (DataControl_DclType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{60, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{63, 0}
 }
 
 type DataDefinition_DdlType int32
@@ -789,7 +848,7 @@ func (x DataDefinition_DdlType) String() string {
 }
 
 func (DataDefinition_DdlType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{61, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{64, 0}
 }
 
 type AlterTableDrop_Typ int32
@@ -823,7 +882,41 @@ func (x AlterTableDrop_Typ) String() string {
 }
 
 func (AlterTableDrop_Typ) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{68, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{71, 0}
+}
+
+type AlterTablePartition_Typ int32
+
+const (
+	AlterTablePartition_ADD        AlterTablePartition_Typ = 0
+	AlterTablePartition_DROP       AlterTablePartition_Typ = 1
+	AlterTablePartition_TRUNCATE   AlterTablePartition_Typ = 2
+	AlterTablePartition_EXCHANGE   AlterTablePartition_Typ = 3
+	AlterTablePartition_REORGANIZE AlterTablePartition_Typ = 4
+)
+
+var AlterTablePartition_Typ_name = map[int32]string{
+	0: "ADD",
+	1: "DROP",
+	2: "TRUNCATE",
+	3: "EXCHANGE",
+	4: "REORGANIZE",
+}
+
+var AlterTablePartition_Typ_value = map[string]int32{
+	"ADD":        0,
+	"DROP":       1,
+	"TRUNCATE":   2,
+	"EXCHANGE":   3,
+	"REORGANIZE": 4,
+}
+
+func (x AlterTablePartition_Typ) String() string {
+	return proto.EnumName(AlterTablePartition_Typ_name, int32(x))
+}
+
+func (AlterTablePartition_Typ) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{76, 0}
 }
 
 type Type struct {
@@ -918,7 +1011,6 @@ func (m *Type) GetTable() string {
 type Const struct {
 	Isnull bool `protobuf:"varint,1,opt,name=isnull,proto3" json:"isnull,omitempty"`
 	// Types that are valid to be assigned to Value:
-	//
 	//	*Const_I8Val
 	//	*Const_I16Val
 	//	*Const_I32Val
@@ -2051,7 +2143,6 @@ func (m *Function) GetArgs() []*Expr {
 type Expr struct {
 	Typ *Type `protobuf:"bytes,1,opt,name=typ,proto3" json:"typ,omitempty"`
 	// Types that are valid to be assigned to Expr:
-	//
 	//	*Expr_C
 	//	*Expr_P
 	//	*Expr_V
@@ -3677,6 +3768,8 @@ type TableDef struct {
 	Name2ColIndex        map[string]int32    `protobuf:"bytes,26,rep,name=name2col_index,json=name2colIndex,proto3" json:"name2col_index,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 	IsLocked             bool                `protobuf:"varint,27,opt,name=isLocked,proto3" json:"isLocked,omitempty"`
 	TableLockType        TableLockType       `protobuf:"varint,28,opt,name=tableLockType,proto3,enum=plan.TableLockType" json:"tableLockType,omitempty"`
+	Ttl                  *TTLDef             `protobuf:"bytes,29,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	BloomFilter          *BloomFilterDef     `protobuf:"bytes,30,opt,name=bloomFilter,proto3" json:"bloomFilter,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -3855,10 +3948,23 @@ func (m *TableDef) GetTableLockType() TableLockType {
 	return TableLockType_TableLockNone
 }
 
+func (m *TableDef) GetTtl() *TTLDef {
+	if m != nil {
+		return m.Ttl
+	}
+	return nil
+}
+
+func (m *TableDef) GetBloomFilter() *BloomFilterDef {
+	if m != nil {
+		return m.BloomFilter
+	}
+	return nil
+}
+
 // XXX: Deprecated and to be removed soon.
 type TableDef_DefType struct {
 	// Types that are valid to be assigned to Def:
-	//
 	//	*TableDef_DefType_Properties
 	Def                  isTableDef_DefType_Def `protobuf_oneof:"def"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
@@ -3932,6 +4038,113 @@ func (*TableDef_DefType) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// TTLDef expires the rows whose value of the column plus the interval is
+// earlier than the current time.
+type TTLDef struct {
+	Col string `protobuf:"bytes,1,opt,name=col,proto3" json:"col,omitempty"`
+	// interval in seconds
+	Interval             int64    `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TTLDef) Reset()         { *m = TTLDef{} }
+func (m *TTLDef) String() string { return proto.CompactTextString(m) }
+func (*TTLDef) ProtoMessage()    {}
+func (*TTLDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{36}
+}
+func (m *TTLDef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TTLDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TTLDef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TTLDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TTLDef.Merge(m, src)
+}
+func (m *TTLDef) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *TTLDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_TTLDef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TTLDef proto.InternalMessageInfo
+
+func (m *TTLDef) GetCol() string {
+	if m != nil {
+		return m.Col
+	}
+	return ""
+}
+
+func (m *TTLDef) GetInterval() int64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+// BloomFilterDef declares the columns other than the primary key with a bloom
+// filter built for every block.
+type BloomFilterDef struct {
+	Cols                 []string `protobuf:"bytes,1,rep,name=cols,proto3" json:"cols,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BloomFilterDef) Reset()         { *m = BloomFilterDef{} }
+func (m *BloomFilterDef) String() string { return proto.CompactTextString(m) }
+func (*BloomFilterDef) ProtoMessage()    {}
+func (*BloomFilterDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{37}
+}
+func (m *BloomFilterDef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BloomFilterDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BloomFilterDef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BloomFilterDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BloomFilterDef.Merge(m, src)
+}
+func (m *BloomFilterDef) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *BloomFilterDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_BloomFilterDef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BloomFilterDef proto.InternalMessageInfo
+
+func (m *BloomFilterDef) GetCols() []string {
+	if m != nil {
+		return m.Cols
+	}
+	return nil
+}
+
 type TableFunction struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Param                []byte   `protobuf:"bytes,2,opt,name=param,proto3" json:"param,omitempty"`
@@ -3944,7 +4157,7 @@ func (m *TableFunction) Reset()         { *m = TableFunction{} }
 func (m *TableFunction) String() string { return proto.CompactTextString(m) }
 func (*TableFunction) ProtoMessage()    {}
 func (*TableFunction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{36}
+	return fileDescriptor_2d655ab2f7683c23, []int{38}
 }
 func (m *TableFunction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3988,24 +4201,24 @@ func (m *TableFunction) GetParam() []byte {
 }
 
 type Stats struct {
-	// for scan, number of blocks to read from S3
-	// for other nodes, it's meaningless
+	//for scan, number of blocks to read from S3
+	//for other nodes, it's meaningless
 	BlockNum int32 `protobuf:"varint,1,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
-	// for scan, cost of reading from S3, basically the read lines
-	// for other nodes, it means the estimated cost of current node
+	//for scan, cost of reading from S3, basically the read lines
+	//for other nodes, it means the estimated cost of current node
 	Cost float64 `protobuf:"fixed64,2,opt,name=cost,proto3" json:"cost,omitempty"`
-	// number of output lines
+	//number of output lines
 	Outcnt float64 `protobuf:"fixed64,3,opt,name=outcnt,proto3" json:"outcnt,omitempty"`
 	// average size of one row, currently not used
 	Rowsize float64 `protobuf:"fixed64,4,opt,name=rowsize,proto3" json:"rowsize,omitempty"`
 	// hashmap size for nodes which build a hashmap
-	// for other nodes, it's meaningless
+	//for other nodes, it's meaningless
 	HashmapSize float64 `protobuf:"fixed64,5,opt,name=hashmap_size,json=hashmapSize,proto3" json:"hashmap_size,omitempty"`
-	// for scan, this means total count of all table, before filtering
-	// for other nodes, this is meanlingless
+	//for scan, this means total count of all table, before filtering
+	//for other nodes, this is meanlingless
 	TableCnt float64 `protobuf:"fixed64,6,opt,name=table_cnt,json=tableCnt,proto3" json:"table_cnt,omitempty"`
-	// for scan, selectivity means outcnt divide total count
-	// for other node, currently be 0. will change in the future
+	//for scan, selectivity means outcnt divide total count
+	//for other node, currently be 0. will change in the future
 	Selectivity          float64  `protobuf:"fixed64,7,opt,name=selectivity,proto3" json:"selectivity,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -4016,7 +4229,7 @@ func (m *Stats) Reset()         { *m = Stats{} }
 func (m *Stats) String() string { return proto.CompactTextString(m) }
 func (*Stats) ProtoMessage()    {}
 func (*Stats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{37}
+	return fileDescriptor_2d655ab2f7683c23, []int{39}
 }
 func (m *Stats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4105,7 +4318,7 @@ func (m *ColData) Reset()         { *m = ColData{} }
 func (m *ColData) String() string { return proto.CompactTextString(m) }
 func (*ColData) ProtoMessage()    {}
 func (*ColData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{38}
+	return fileDescriptor_2d655ab2f7683c23, []int{40}
 }
 func (m *ColData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4152,7 +4365,7 @@ func (m *RowsetData) Reset()         { *m = RowsetData{} }
 func (m *RowsetData) String() string { return proto.CompactTextString(m) }
 func (*RowsetData) ProtoMessage()    {}
 func (*RowsetData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{39}
+	return fileDescriptor_2d655ab2f7683c23, []int{41}
 }
 func (m *RowsetData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4201,7 +4414,7 @@ func (m *OrderBySpec) Reset()         { *m = OrderBySpec{} }
 func (m *OrderBySpec) String() string { return proto.CompactTextString(m) }
 func (*OrderBySpec) ProtoMessage()    {}
 func (*OrderBySpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{40}
+	return fileDescriptor_2d655ab2f7683c23, []int{42}
 }
 func (m *OrderBySpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4265,7 +4478,7 @@ func (m *WindowSpec) Reset()         { *m = WindowSpec{} }
 func (m *WindowSpec) String() string { return proto.CompactTextString(m) }
 func (*WindowSpec) ProtoMessage()    {}
 func (*WindowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{41}
+	return fileDescriptor_2d655ab2f7683c23, []int{43}
 }
 func (m *WindowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4335,7 +4548,7 @@ func (m *OnDuplicateKeyCtx) Reset()         { *m = OnDuplicateKeyCtx{} }
 func (m *OnDuplicateKeyCtx) String() string { return proto.CompactTextString(m) }
 func (*OnDuplicateKeyCtx) ProtoMessage()    {}
 func (*OnDuplicateKeyCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{42}
+	return fileDescriptor_2d655ab2f7683c23, []int{44}
 }
 func (m *OnDuplicateKeyCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4403,7 +4616,7 @@ func (m *InsertCtx) Reset()         { *m = InsertCtx{} }
 func (m *InsertCtx) String() string { return proto.CompactTextString(m) }
 func (*InsertCtx) ProtoMessage()    {}
 func (*InsertCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{43}
+	return fileDescriptor_2d655ab2f7683c23, []int{45}
 }
 func (m *InsertCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4508,7 +4721,7 @@ func (m *UpdateCtx) Reset()         { *m = UpdateCtx{} }
 func (m *UpdateCtx) String() string { return proto.CompactTextString(m) }
 func (*UpdateCtx) ProtoMessage()    {}
 func (*UpdateCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{44}
+	return fileDescriptor_2d655ab2f7683c23, []int{46}
 }
 func (m *UpdateCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4671,6 +4884,15 @@ type AnalyzeInfo struct {
 	NetworkIO            int64    `protobuf:"varint,12,opt,name=networkIO,proto3" json:"networkIO,omitempty"`
 	ScanTime             int64    `protobuf:"varint,13,opt,name=scanTime,proto3" json:"scanTime,omitempty"`
 	InsertTime           int64    `protobuf:"varint,14,opt,name=insertTime,proto3" json:"insertTime,omitempty"`
+	MemoryPeak           int64    `protobuf:"varint,15,opt,name=memory_peak,json=memoryPeak,proto3" json:"memory_peak,omitempty"`
+	TotalBlocks          int64    `protobuf:"varint,16,opt,name=total_blocks,json=totalBlocks,proto3" json:"total_blocks,omitempty"`
+	PrunedBlocks         int64    `protobuf:"varint,17,opt,name=pruned_blocks,json=prunedBlocks,proto3" json:"pruned_blocks,omitempty"`
+	S3ReadCount          int64    `protobuf:"varint,18,opt,name=s3_read_count,json=s3ReadCount,proto3" json:"s3_read_count,omitempty"`
+	CacheReadCount       int64    `protobuf:"varint,19,opt,name=cache_read_count,json=cacheReadCount,proto3" json:"cache_read_count,omitempty"`
+	CacheHitCount        int64    `protobuf:"varint,20,opt,name=cache_hit_count,json=cacheHitCount,proto3" json:"cache_hit_count,omitempty"`
+	CnAddrs              []string `protobuf:"bytes,21,rep,name=cn_addrs,json=cnAddrs,proto3" json:"cn_addrs,omitempty"`
+	LockWaitTime         int64    `protobuf:"varint,22,opt,name=lock_wait_time,json=lockWaitTime,proto3" json:"lock_wait_time,omitempty"`
+	CpuTime              int64    `protobuf:"varint,23,opt,name=cpu_time,json=cpuTime,proto3" json:"cpu_time,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -4680,7 +4902,7 @@ func (m *AnalyzeInfo) Reset()         { *m = AnalyzeInfo{} }
 func (m *AnalyzeInfo) String() string { return proto.CompactTextString(m) }
 func (*AnalyzeInfo) ProtoMessage()    {}
 func (*AnalyzeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{45}
+	return fileDescriptor_2d655ab2f7683c23, []int{47}
 }
 func (m *AnalyzeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4807,6 +5029,69 @@ func (m *AnalyzeInfo) GetInsertTime() int64 {
 	return 0
 }
 
+func (m *AnalyzeInfo) GetMemoryPeak() int64 {
+	if m != nil {
+		return m.MemoryPeak
+	}
+	return 0
+}
+
+func (m *AnalyzeInfo) GetTotalBlocks() int64 {
+	if m != nil {
+		return m.TotalBlocks
+	}
+	return 0
+}
+
+func (m *AnalyzeInfo) GetPrunedBlocks() int64 {
+	if m != nil {
+		return m.PrunedBlocks
+	}
+	return 0
+}
+
+func (m *AnalyzeInfo) GetS3ReadCount() int64 {
+	if m != nil {
+		return m.S3ReadCount
+	}
+	return 0
+}
+
+func (m *AnalyzeInfo) GetCacheReadCount() int64 {
+	if m != nil {
+		return m.CacheReadCount
+	}
+	return 0
+}
+
+func (m *AnalyzeInfo) GetCacheHitCount() int64 {
+	if m != nil {
+		return m.CacheHitCount
+	}
+	return 0
+}
+
+func (m *AnalyzeInfo) GetCnAddrs() []string {
+	if m != nil {
+		return m.CnAddrs
+	}
+	return nil
+}
+
+func (m *AnalyzeInfo) GetLockWaitTime() int64 {
+	if m != nil {
+		return m.LockWaitTime
+	}
+	return 0
+}
+
+func (m *AnalyzeInfo) GetCpuTime() int64 {
+	if m != nil {
+		return m.CpuTime
+	}
+	return 0
+}
+
 type Node struct {
 	NodeType Node_NodeType `protobuf:"varint,1,opt,name=node_type,json=nodeType,proto3,enum=plan.Node_NodeType" json:"node_type,omitempty"`
 	NodeId   int32         `protobuf:"varint,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
@@ -4852,18 +5137,32 @@ type Node struct {
 	PreDeleteCtx *PreDeleteCtx `protobuf:"bytes,32,opt,name=pre_delete_ctx,json=preDeleteCtx,proto3" json:"pre_delete_ctx,omitempty"`
 	PreInsertCtx *PreInsertCtx `protobuf:"bytes,33,opt,name=pre_insert_ctx,json=preInsertCtx,proto3" json:"pre_insert_ctx,omitempty"`
 	// build unique key batch before insert into hidden table which keep the unique key
-	PreInsertUkCtx       *PreInsertUkCtx    `protobuf:"bytes,34,opt,name=pre_insert_uk_ctx,json=preInsertUkCtx,proto3" json:"pre_insert_uk_ctx,omitempty"`
-	OnDuplicateKey       *OnDuplicateKeyCtx `protobuf:"bytes,35,opt,name=on_duplicate_key,json=onDuplicateKey,proto3" json:"on_duplicate_key,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	PreInsertUkCtx *PreInsertUkCtx    `protobuf:"bytes,34,opt,name=pre_insert_uk_ctx,json=preInsertUkCtx,proto3" json:"pre_insert_uk_ctx,omitempty"`
+	OnDuplicateKey *OnDuplicateKeyCtx `protobuf:"bytes,35,opt,name=on_duplicate_key,json=onDuplicateKey,proto3" json:"on_duplicate_key,omitempty"`
+	// runtime filters built from the build side of a hash join, and the ones
+	// applied to a table scan on the probe side, matched by tag.
+	RuntimeFilterBuildList []*RuntimeFilterSpec `protobuf:"bytes,36,rep,name=runtime_filter_build_list,json=runtimeFilterBuildList,proto3" json:"runtime_filter_build_list,omitempty"`
+	RuntimeFilterProbeList []*RuntimeFilterSpec `protobuf:"bytes,37,rep,name=runtime_filter_probe_list,json=runtimeFilterProbeList,proto3" json:"runtime_filter_probe_list,omitempty"`
+	// the join method and distribution forced by optimizer hints
+	JoinMethod       Node_JoinMethod       `protobuf:"varint,38,opt,name=join_method,json=joinMethod,proto3,enum=plan.Node_JoinMethod" json:"join_method,omitempty"`
+	JoinDistribution Node_JoinDistribution `protobuf:"varint,39,opt,name=join_distribution,json=joinDistribution,proto3,enum=plan.Node_JoinDistribution" json:"join_distribution,omitempty"`
+	// for an APPLY join, how the rows the right child produces for each row of
+	// the left child are joined with it: INNER, LEFT, SINGLE or MARK
+	ApplyType Node_JoinType `protobuf:"varint,40,opt,name=apply_type,json=applyType,proto3,enum=plan.Node_JoinType" json:"apply_type,omitempty"`
+	// for a TABLE_SCAN of an UPDATE or a DELETE, the visible columns read by
+	// the statement, which need the select privilege. The columns only passed
+	// through to be deleted or rewritten are not read.
+	ReadCols             []string `protobuf:"bytes,41,rep,name=read_cols,json=readCols,proto3" json:"read_cols,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Node) Reset()         { *m = Node{} }
 func (m *Node) String() string { return proto.CompactTextString(m) }
 func (*Node) ProtoMessage()    {}
 func (*Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{46}
+	return fileDescriptor_2d655ab2f7683c23, []int{48}
 }
 func (m *Node) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5137,6 +5436,114 @@ func (m *Node) GetOnDuplicateKey() *OnDuplicateKeyCtx {
 	return nil
 }
 
+func (m *Node) GetRuntimeFilterBuildList() []*RuntimeFilterSpec {
+	if m != nil {
+		return m.RuntimeFilterBuildList
+	}
+	return nil
+}
+
+func (m *Node) GetRuntimeFilterProbeList() []*RuntimeFilterSpec {
+	if m != nil {
+		return m.RuntimeFilterProbeList
+	}
+	return nil
+}
+
+func (m *Node) GetJoinMethod() Node_JoinMethod {
+	if m != nil {
+		return m.JoinMethod
+	}
+	return Node_AUTO_JOIN_METHOD
+}
+
+func (m *Node) GetJoinDistribution() Node_JoinDistribution {
+	if m != nil {
+		return m.JoinDistribution
+	}
+	return Node_AUTO_JOIN_DISTRIBUTION
+}
+
+func (m *Node) GetApplyType() Node_JoinType {
+	if m != nil {
+		return m.ApplyType
+	}
+	return Node_INNER
+}
+
+func (m *Node) GetReadCols() []string {
+	if m != nil {
+		return m.ReadCols
+	}
+	return nil
+}
+
+// RuntimeFilterSpec connects the hash build of a join to a table scan on its
+// probe side. Expr is the join key evaluated on the build side, col_name is
+// the scanned column compared with it.
+type RuntimeFilterSpec struct {
+	Tag                  int32    `protobuf:"varint,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Expr                 *Expr    `protobuf:"bytes,2,opt,name=expr,proto3" json:"expr,omitempty"`
+	ColName              string   `protobuf:"bytes,3,opt,name=col_name,json=colName,proto3" json:"col_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RuntimeFilterSpec) Reset()         { *m = RuntimeFilterSpec{} }
+func (m *RuntimeFilterSpec) String() string { return proto.CompactTextString(m) }
+func (*RuntimeFilterSpec) ProtoMessage()    {}
+func (*RuntimeFilterSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{49}
+}
+func (m *RuntimeFilterSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RuntimeFilterSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RuntimeFilterSpec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RuntimeFilterSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuntimeFilterSpec.Merge(m, src)
+}
+func (m *RuntimeFilterSpec) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *RuntimeFilterSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuntimeFilterSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RuntimeFilterSpec proto.InternalMessageInfo
+
+func (m *RuntimeFilterSpec) GetTag() int32 {
+	if m != nil {
+		return m.Tag
+	}
+	return 0
+}
+
+func (m *RuntimeFilterSpec) GetExpr() *Expr {
+	if m != nil {
+		return m.Expr
+	}
+	return nil
+}
+
+func (m *RuntimeFilterSpec) GetColName() string {
+	if m != nil {
+		return m.ColName
+	}
+	return ""
+}
+
 type PreInsertUkCtx struct {
 	// index of columns(parts of unique key) in pre batch
 	Columns              []int32  `protobuf:"varint,1,rep,packed,name=columns,proto3" json:"columns,omitempty"`
@@ -5152,7 +5559,7 @@ func (m *PreInsertUkCtx) Reset()         { *m = PreInsertUkCtx{} }
 func (m *PreInsertUkCtx) String() string { return proto.CompactTextString(m) }
 func (*PreInsertUkCtx) ProtoMessage()    {}
 func (*PreInsertUkCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{47}
+	return fileDescriptor_2d655ab2f7683c23, []int{50}
 }
 func (m *PreInsertUkCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5210,7 +5617,7 @@ func (m *PreInsertUkCtx) GetUkType() *Type {
 }
 
 type PreDeleteCtx struct {
-	// the indexes of row_id&pk column in the batch
+	//the indexes of row_id&pk column in the batch
 	Idx                  []int32  `protobuf:"varint,1,rep,packed,name=idx,proto3" json:"idx,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -5221,7 +5628,7 @@ func (m *PreDeleteCtx) Reset()         { *m = PreDeleteCtx{} }
 func (m *PreDeleteCtx) String() string { return proto.CompactTextString(m) }
 func (*PreDeleteCtx) ProtoMessage()    {}
 func (*PreDeleteCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{48}
+	return fileDescriptor_2d655ab2f7683c23, []int{51}
 }
 func (m *PreDeleteCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5271,7 +5678,7 @@ func (m *PreInsertCtx) Reset()         { *m = PreInsertCtx{} }
 func (m *PreInsertCtx) String() string { return proto.CompactTextString(m) }
 func (*PreInsertCtx) ProtoMessage()    {}
 func (*PreInsertCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{49}
+	return fileDescriptor_2d655ab2f7683c23, []int{52}
 }
 func (m *PreInsertCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5339,7 +5746,7 @@ func (m *IdList) Reset()         { *m = IdList{} }
 func (m *IdList) String() string { return proto.CompactTextString(m) }
 func (*IdList) ProtoMessage()    {}
 func (*IdList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{50}
+	return fileDescriptor_2d655ab2f7683c23, []int{53}
 }
 func (m *IdList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5386,7 +5793,7 @@ func (m *ColPosMap) Reset()         { *m = ColPosMap{} }
 func (m *ColPosMap) String() string { return proto.CompactTextString(m) }
 func (*ColPosMap) ProtoMessage()    {}
 func (*ColPosMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{51}
+	return fileDescriptor_2d655ab2f7683c23, []int{54}
 }
 func (m *ColPosMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5441,7 +5848,7 @@ func (m *DeleteCtx) Reset()         { *m = DeleteCtx{} }
 func (m *DeleteCtx) String() string { return proto.CompactTextString(m) }
 func (*DeleteCtx) ProtoMessage()    {}
 func (*DeleteCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{52}
+	return fileDescriptor_2d655ab2f7683c23, []int{55}
 }
 func (m *DeleteCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5539,7 +5946,9 @@ type Query struct {
 	// return head
 	Headings []string `protobuf:"bytes,5,rep,name=headings,proto3" json:"headings,omitempty"`
 	// load Tag
-	LoadTag              bool     `protobuf:"varint,6,opt,name=loadTag,proto3" json:"loadTag,omitempty"`
+	LoadTag bool `protobuf:"varint,6,opt,name=loadTag,proto3" json:"loadTag,omitempty"`
+	// warnings of building the query, like the optimizer hints not used
+	Warnings             []string `protobuf:"bytes,7,rep,name=warnings,proto3" json:"warnings,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -5549,7 +5958,7 @@ func (m *Query) Reset()         { *m = Query{} }
 func (m *Query) String() string { return proto.CompactTextString(m) }
 func (*Query) ProtoMessage()    {}
 func (*Query) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{53}
+	return fileDescriptor_2d655ab2f7683c23, []int{56}
 }
 func (m *Query) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5620,11 +6029,17 @@ func (m *Query) GetLoadTag() bool {
 	return false
 }
 
+func (m *Query) GetWarnings() []string {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
 type TransationControl struct {
-	// TransationControl type
+	//TransationControl type
 	TclType TransationControl_TclType `protobuf:"varint,1,opt,name=tcl_type,json=tclType,proto3,enum=plan.TransationControl_TclType" json:"tcl_type,omitempty"`
 	// Types that are valid to be assigned to Action:
-	//
 	//	*TransationControl_Begin
 	//	*TransationControl_Commit
 	//	*TransationControl_Rollback
@@ -5638,7 +6053,7 @@ func (m *TransationControl) Reset()         { *m = TransationControl{} }
 func (m *TransationControl) String() string { return proto.CompactTextString(m) }
 func (*TransationControl) ProtoMessage()    {}
 func (*TransationControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{54}
+	return fileDescriptor_2d655ab2f7683c23, []int{57}
 }
 func (m *TransationControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5742,7 +6157,7 @@ func (m *TransationBegin) Reset()         { *m = TransationBegin{} }
 func (m *TransationBegin) String() string { return proto.CompactTextString(m) }
 func (*TransationBegin) ProtoMessage()    {}
 func (*TransationBegin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{55}
+	return fileDescriptor_2d655ab2f7683c23, []int{58}
 }
 func (m *TransationBegin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5789,7 +6204,7 @@ func (m *TransationCommit) Reset()         { *m = TransationCommit{} }
 func (m *TransationCommit) String() string { return proto.CompactTextString(m) }
 func (*TransationCommit) ProtoMessage()    {}
 func (*TransationCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{56}
+	return fileDescriptor_2d655ab2f7683c23, []int{59}
 }
 func (m *TransationCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5836,7 +6251,7 @@ func (m *TransationRollback) Reset()         { *m = TransationRollback{} }
 func (m *TransationRollback) String() string { return proto.CompactTextString(m) }
 func (*TransationRollback) ProtoMessage()    {}
 func (*TransationRollback) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{57}
+	return fileDescriptor_2d655ab2f7683c23, []int{60}
 }
 func (m *TransationRollback) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5874,7 +6289,6 @@ func (m *TransationRollback) GetCompletionType() TransationCompletionType {
 
 type Plan struct {
 	// Types that are valid to be assigned to Plan:
-	//
 	//	*Plan_Query
 	//	*Plan_Tcl
 	//	*Plan_Ddl
@@ -5891,7 +6305,7 @@ func (m *Plan) Reset()         { *m = Plan{} }
 func (m *Plan) String() string { return proto.CompactTextString(m) }
 func (*Plan) ProtoMessage()    {}
 func (*Plan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{58}
+	return fileDescriptor_2d655ab2f7683c23, []int{61}
 }
 func (m *Plan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6007,7 +6421,7 @@ func (m *Column) Reset()         { *m = Column{} }
 func (m *Column) String() string { return proto.CompactTextString(m) }
 func (*Column) ProtoMessage()    {}
 func (*Column) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{59}
+	return fileDescriptor_2d655ab2f7683c23, []int{62}
 }
 func (m *Column) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6044,10 +6458,9 @@ func (m *Column) GetColumn() []*Expr {
 }
 
 type DataControl struct {
-	// DataDefinition type
+	//DataDefinition type
 	DclType DataControl_DclType `protobuf:"varint,1,opt,name=dcl_type,json=dclType,proto3,enum=plan.DataControl_DclType" json:"dcl_type,omitempty"`
 	// Types that are valid to be assigned to Control:
-	//
 	//	*DataControl_SetVariables
 	//	*DataControl_Prepare
 	//	*DataControl_Execute
@@ -6062,7 +6475,7 @@ func (m *DataControl) Reset()         { *m = DataControl{} }
 func (m *DataControl) String() string { return proto.CompactTextString(m) }
 func (*DataControl) ProtoMessage()    {}
 func (*DataControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{60}
+	return fileDescriptor_2d655ab2f7683c23, []int{63}
 }
 func (m *DataControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6168,14 +6581,13 @@ func (*DataControl) XXX_OneofWrappers() []interface{} {
 }
 
 type DataDefinition struct {
-	// DataDefinition type
+	//DataDefinition type
 	DdlType DataDefinition_DdlType `protobuf:"varint,1,opt,name=ddl_type,json=ddlType,proto3,enum=plan.DataDefinition_DdlType" json:"ddl_type,omitempty"`
-	// other show statement we will rewrite to a select statement
-	// then we will get a Query
-	// eg: 'show databases' will rewrite to 'select md.datname as `Database` from mo_database md'
+	//other show statement we will rewrite to a select statement
+	//then we will get a Query
+	//eg: 'show databases' will rewrite to 'select md.datname as `Database` from mo_database md'
 	Query *Query `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
 	// Types that are valid to be assigned to Definition:
-	//
 	//	*DataDefinition_CreateDatabase
 	//	*DataDefinition_AlterDatabase
 	//	*DataDefinition_DropDatabase
@@ -6203,7 +6615,7 @@ func (m *DataDefinition) Reset()         { *m = DataDefinition{} }
 func (m *DataDefinition) String() string { return proto.CompactTextString(m) }
 func (*DataDefinition) ProtoMessage()    {}
 func (*DataDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{61}
+	return fileDescriptor_2d655ab2f7683c23, []int{64}
 }
 func (m *DataDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6483,7 +6895,7 @@ func (m *SubscriptionOption) Reset()         { *m = SubscriptionOption{} }
 func (m *SubscriptionOption) String() string { return proto.CompactTextString(m) }
 func (*SubscriptionOption) ProtoMessage()    {}
 func (*SubscriptionOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{62}
+	return fileDescriptor_2d655ab2f7683c23, []int{65}
 }
 func (m *SubscriptionOption) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6539,7 +6951,7 @@ func (m *CreateDatabase) Reset()         { *m = CreateDatabase{} }
 func (m *CreateDatabase) String() string { return proto.CompactTextString(m) }
 func (*CreateDatabase) ProtoMessage()    {}
 func (*CreateDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{63}
+	return fileDescriptor_2d655ab2f7683c23, []int{66}
 }
 func (m *CreateDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6601,7 +7013,7 @@ func (m *AlterDatabase) Reset()         { *m = AlterDatabase{} }
 func (m *AlterDatabase) String() string { return proto.CompactTextString(m) }
 func (*AlterDatabase) ProtoMessage()    {}
 func (*AlterDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{64}
+	return fileDescriptor_2d655ab2f7683c23, []int{67}
 }
 func (m *AlterDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6656,7 +7068,7 @@ func (m *DropDatabase) Reset()         { *m = DropDatabase{} }
 func (m *DropDatabase) String() string { return proto.CompactTextString(m) }
 func (*DropDatabase) ProtoMessage()    {}
 func (*DropDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{65}
+	return fileDescriptor_2d655ab2f7683c23, []int{68}
 }
 func (m *DropDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6710,7 +7122,7 @@ func (m *FkColName) Reset()         { *m = FkColName{} }
 func (m *FkColName) String() string { return proto.CompactTextString(m) }
 func (*FkColName) ProtoMessage()    {}
 func (*FkColName) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{66}
+	return fileDescriptor_2d655ab2f7683c23, []int{69}
 }
 func (m *FkColName) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6767,7 +7179,7 @@ func (m *CreateTable) Reset()         { *m = CreateTable{} }
 func (m *CreateTable) String() string { return proto.CompactTextString(m) }
 func (*CreateTable) ProtoMessage()    {}
 func (*CreateTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{67}
+	return fileDescriptor_2d655ab2f7683c23, []int{70}
 }
 func (m *CreateTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6872,7 +7284,7 @@ func (m *AlterTableDrop) Reset()         { *m = AlterTableDrop{} }
 func (m *AlterTableDrop) String() string { return proto.CompactTextString(m) }
 func (*AlterTableDrop) ProtoMessage()    {}
 func (*AlterTableDrop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{68}
+	return fileDescriptor_2d655ab2f7683c23, []int{71}
 }
 func (m *AlterTableDrop) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6936,7 +7348,7 @@ func (m *AlterTableAddFk) Reset()         { *m = AlterTableAddFk{} }
 func (m *AlterTableAddFk) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAddFk) ProtoMessage()    {}
 func (*AlterTableAddFk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{69}
+	return fileDescriptor_2d655ab2f7683c23, []int{72}
 }
 func (m *AlterTableAddFk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7008,7 +7420,7 @@ func (m *AlterTableAddIndex) Reset()         { *m = AlterTableAddIndex{} }
 func (m *AlterTableAddIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAddIndex) ProtoMessage()    {}
 func (*AlterTableAddIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{70}
+	return fileDescriptor_2d655ab2f7683c23, []int{73}
 }
 func (m *AlterTableAddIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7086,7 +7498,7 @@ func (m *AlterTableDropIndex) Reset()         { *m = AlterTableDropIndex{} }
 func (m *AlterTableDropIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterTableDropIndex) ProtoMessage()    {}
 func (*AlterTableDropIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{71}
+	return fileDescriptor_2d655ab2f7683c23, []int{74}
 }
 func (m *AlterTableDropIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7157,7 +7569,7 @@ func (m *AlterTableAlterIndex) Reset()         { *m = AlterTableAlterIndex{} }
 func (m *AlterTableAlterIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAlterIndex) ProtoMessage()    {}
 func (*AlterTableAlterIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{72}
+	return fileDescriptor_2d655ab2f7683c23, []int{75}
 }
 func (m *AlterTableAlterIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7214,6 +7626,192 @@ func (m *AlterTableAlterIndex) GetVisible() bool {
 	return false
 }
 
+type AlterTablePartition struct {
+	Typ AlterTablePartition_Typ `protobuf:"varint,1,opt,name=typ,proto3,enum=plan.AlterTablePartition_Typ" json:"typ,omitempty"`
+	// the partition definition of the table after the alteration
+	PartitionDef *PartitionByDef `protobuf:"bytes,2,opt,name=partition_def,json=partitionDef,proto3" json:"partition_def,omitempty"`
+	// the hidden tables of the partitions dropped, truncated, exchanged or reorganized
+	PartitionTableNames []string `protobuf:"bytes,3,rep,name=partition_table_names,json=partitionTableNames,proto3" json:"partition_table_names,omitempty"`
+	// the hidden tables of the partitions added or reorganized into
+	NewPartitionTableNames []string `protobuf:"bytes,4,rep,name=new_partition_table_names,json=newPartitionTableNames,proto3" json:"new_partition_table_names,omitempty"`
+	// the standalone table of EXCHANGE PARTITION
+	ExchangeDbName       string   `protobuf:"bytes,5,opt,name=exchange_db_name,json=exchangeDbName,proto3" json:"exchange_db_name,omitempty"`
+	ExchangeTableName    string   `protobuf:"bytes,6,opt,name=exchange_table_name,json=exchangeTableName,proto3" json:"exchange_table_name,omitempty"`
+	WithoutValidation    bool     `protobuf:"varint,7,opt,name=without_validation,json=withoutValidation,proto3" json:"without_validation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterTablePartition) Reset()         { *m = AlterTablePartition{} }
+func (m *AlterTablePartition) String() string { return proto.CompactTextString(m) }
+func (*AlterTablePartition) ProtoMessage()    {}
+func (*AlterTablePartition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{76}
+}
+func (m *AlterTablePartition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTablePartition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTablePartition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTablePartition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTablePartition.Merge(m, src)
+}
+func (m *AlterTablePartition) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTablePartition) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTablePartition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTablePartition proto.InternalMessageInfo
+
+func (m *AlterTablePartition) GetTyp() AlterTablePartition_Typ {
+	if m != nil {
+		return m.Typ
+	}
+	return AlterTablePartition_ADD
+}
+
+func (m *AlterTablePartition) GetPartitionDef() *PartitionByDef {
+	if m != nil {
+		return m.PartitionDef
+	}
+	return nil
+}
+
+func (m *AlterTablePartition) GetPartitionTableNames() []string {
+	if m != nil {
+		return m.PartitionTableNames
+	}
+	return nil
+}
+
+func (m *AlterTablePartition) GetNewPartitionTableNames() []string {
+	if m != nil {
+		return m.NewPartitionTableNames
+	}
+	return nil
+}
+
+func (m *AlterTablePartition) GetExchangeDbName() string {
+	if m != nil {
+		return m.ExchangeDbName
+	}
+	return ""
+}
+
+func (m *AlterTablePartition) GetExchangeTableName() string {
+	if m != nil {
+		return m.ExchangeTableName
+	}
+	return ""
+}
+
+func (m *AlterTablePartition) GetWithoutValidation() bool {
+	if m != nil {
+		return m.WithoutValidation
+	}
+	return false
+}
+
+type AlterTableMergePolicy struct {
+	// the encoded merge properties, see catalog.MergeProperties
+	MergePolicy          string   `protobuf:"bytes,1,opt,name=merge_policy,json=mergePolicy,proto3" json:"merge_policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterTableMergePolicy) Reset()         { *m = AlterTableMergePolicy{} }
+func (m *AlterTableMergePolicy) String() string { return proto.CompactTextString(m) }
+func (*AlterTableMergePolicy) ProtoMessage()    {}
+func (*AlterTableMergePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{77}
+}
+func (m *AlterTableMergePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableMergePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableMergePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableMergePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableMergePolicy.Merge(m, src)
+}
+func (m *AlterTableMergePolicy) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableMergePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableMergePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableMergePolicy proto.InternalMessageInfo
+
+func (m *AlterTableMergePolicy) GetMergePolicy() string {
+	if m != nil {
+		return m.MergePolicy
+	}
+	return ""
+}
+
+type AlterTableCompact struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterTableCompact) Reset()         { *m = AlterTableCompact{} }
+func (m *AlterTableCompact) String() string { return proto.CompactTextString(m) }
+func (*AlterTableCompact) ProtoMessage()    {}
+func (*AlterTableCompact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{78}
+}
+func (m *AlterTableCompact) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableCompact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableCompact.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableCompact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableCompact.Merge(m, src)
+}
+func (m *AlterTableCompact) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableCompact) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableCompact.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableCompact proto.InternalMessageInfo
+
 type AlterTable struct {
 	Database             string               `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
 	TableDef             *TableDef            `protobuf:"bytes,2,opt,name=table_def,json=tableDef,proto3" json:"table_def,omitempty"`
@@ -7228,7 +7826,7 @@ func (m *AlterTable) Reset()         { *m = AlterTable{} }
 func (m *AlterTable) String() string { return proto.CompactTextString(m) }
 func (*AlterTable) ProtoMessage()    {}
 func (*AlterTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{73}
+	return fileDescriptor_2d655ab2f7683c23, []int{79}
 }
 func (m *AlterTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7287,11 +7885,13 @@ func (m *AlterTable) GetActions() []*AlterTable_Action {
 
 type AlterTable_Action struct {
 	// Types that are valid to be assigned to Action:
-	//
 	//	*AlterTable_Action_Drop
 	//	*AlterTable_Action_AddFk
 	//	*AlterTable_Action_AddIndex
 	//	*AlterTable_Action_AlterIndex
+	//	*AlterTable_Action_AlterPartition
+	//	*AlterTable_Action_MergePolicy
+	//	*AlterTable_Action_Compact
 	Action               isAlterTable_Action_Action `protobuf_oneof:"action"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
@@ -7302,7 +7902,7 @@ func (m *AlterTable_Action) Reset()         { *m = AlterTable_Action{} }
 func (m *AlterTable_Action) String() string { return proto.CompactTextString(m) }
 func (*AlterTable_Action) ProtoMessage()    {}
 func (*AlterTable_Action) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{73, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{79, 0}
 }
 func (m *AlterTable_Action) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7349,11 +7949,23 @@ type AlterTable_Action_AddIndex struct {
 type AlterTable_Action_AlterIndex struct {
 	AlterIndex *AlterTableAlterIndex `protobuf:"bytes,4,opt,name=alter_index,json=alterIndex,proto3,oneof" json:"alter_index,omitempty"`
 }
+type AlterTable_Action_AlterPartition struct {
+	AlterPartition *AlterTablePartition `protobuf:"bytes,5,opt,name=alter_partition,json=alterPartition,proto3,oneof" json:"alter_partition,omitempty"`
+}
+type AlterTable_Action_MergePolicy struct {
+	MergePolicy *AlterTableMergePolicy `protobuf:"bytes,6,opt,name=merge_policy,json=mergePolicy,proto3,oneof" json:"merge_policy,omitempty"`
+}
+type AlterTable_Action_Compact struct {
+	Compact *AlterTableCompact `protobuf:"bytes,7,opt,name=compact,proto3,oneof" json:"compact,omitempty"`
+}
 
-func (*AlterTable_Action_Drop) isAlterTable_Action_Action()       {}
-func (*AlterTable_Action_AddFk) isAlterTable_Action_Action()      {}
-func (*AlterTable_Action_AddIndex) isAlterTable_Action_Action()   {}
-func (*AlterTable_Action_AlterIndex) isAlterTable_Action_Action() {}
+func (*AlterTable_Action_Drop) isAlterTable_Action_Action()           {}
+func (*AlterTable_Action_AddFk) isAlterTable_Action_Action()          {}
+func (*AlterTable_Action_AddIndex) isAlterTable_Action_Action()       {}
+func (*AlterTable_Action_AlterIndex) isAlterTable_Action_Action()     {}
+func (*AlterTable_Action_AlterPartition) isAlterTable_Action_Action() {}
+func (*AlterTable_Action_MergePolicy) isAlterTable_Action_Action()    {}
+func (*AlterTable_Action_Compact) isAlterTable_Action_Action()        {}
 
 func (m *AlterTable_Action) GetAction() isAlterTable_Action_Action {
 	if m != nil {
@@ -7390,6 +8002,27 @@ func (m *AlterTable_Action) GetAlterIndex() *AlterTableAlterIndex {
 	return nil
 }
 
+func (m *AlterTable_Action) GetAlterPartition() *AlterTablePartition {
+	if x, ok := m.GetAction().(*AlterTable_Action_AlterPartition); ok {
+		return x.AlterPartition
+	}
+	return nil
+}
+
+func (m *AlterTable_Action) GetMergePolicy() *AlterTableMergePolicy {
+	if x, ok := m.GetAction().(*AlterTable_Action_MergePolicy); ok {
+		return x.MergePolicy
+	}
+	return nil
+}
+
+func (m *AlterTable_Action) GetCompact() *AlterTableCompact {
+	if x, ok := m.GetAction().(*AlterTable_Action_Compact); ok {
+		return x.Compact
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*AlterTable_Action) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -7397,6 +8030,9 @@ func (*AlterTable_Action) XXX_OneofWrappers() []interface{} {
 		(*AlterTable_Action_AddFk)(nil),
 		(*AlterTable_Action_AddIndex)(nil),
 		(*AlterTable_Action_AlterIndex)(nil),
+		(*AlterTable_Action_AlterPartition)(nil),
+		(*AlterTable_Action_MergePolicy)(nil),
+		(*AlterTable_Action_Compact)(nil),
 	}
 }
 
@@ -7418,7 +8054,7 @@ func (m *DropTable) Reset()         { *m = DropTable{} }
 func (m *DropTable) String() string { return proto.CompactTextString(m) }
 func (*DropTable) ProtoMessage()    {}
 func (*DropTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{74}
+	return fileDescriptor_2d655ab2f7683c23, []int{80}
 }
 func (m *DropTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7516,7 +8152,7 @@ func (m *AlterView) Reset()         { *m = AlterView{} }
 func (m *AlterView) String() string { return proto.CompactTextString(m) }
 func (*AlterView) ProtoMessage()    {}
 func (*AlterView) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{75}
+	return fileDescriptor_2d655ab2f7683c23, []int{81}
 }
 func (m *AlterView) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7579,7 +8215,7 @@ func (m *CreateSequence) Reset()         { *m = CreateSequence{} }
 func (m *CreateSequence) String() string { return proto.CompactTextString(m) }
 func (*CreateSequence) ProtoMessage()    {}
 func (*CreateSequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{76}
+	return fileDescriptor_2d655ab2f7683c23, []int{82}
 }
 func (m *CreateSequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7643,7 +8279,7 @@ func (m *DropSequence) Reset()         { *m = DropSequence{} }
 func (m *DropSequence) String() string { return proto.CompactTextString(m) }
 func (*DropSequence) ProtoMessage()    {}
 func (*DropSequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{77}
+	return fileDescriptor_2d655ab2f7683c23, []int{83}
 }
 func (m *DropSequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7710,7 +8346,7 @@ func (m *AlterSequence) Reset()         { *m = AlterSequence{} }
 func (m *AlterSequence) String() string { return proto.CompactTextString(m) }
 func (*AlterSequence) ProtoMessage()    {}
 func (*AlterSequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{78}
+	return fileDescriptor_2d655ab2f7683c23, []int{84}
 }
 func (m *AlterSequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7754,7 +8390,7 @@ func (m *CreateIndex) Reset()         { *m = CreateIndex{} }
 func (m *CreateIndex) String() string { return proto.CompactTextString(m) }
 func (*CreateIndex) ProtoMessage()    {}
 func (*CreateIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{79}
+	return fileDescriptor_2d655ab2f7683c23, []int{85}
 }
 func (m *CreateIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7829,7 +8465,7 @@ func (m *AlterIndex) Reset()         { *m = AlterIndex{} }
 func (m *AlterIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterIndex) ProtoMessage()    {}
 func (*AlterIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{80}
+	return fileDescriptor_2d655ab2f7683c23, []int{86}
 }
 func (m *AlterIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7879,7 +8515,7 @@ func (m *DropIndex) Reset()         { *m = DropIndex{} }
 func (m *DropIndex) String() string { return proto.CompactTextString(m) }
 func (*DropIndex) ProtoMessage()    {}
 func (*DropIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{81}
+	return fileDescriptor_2d655ab2f7683c23, []int{87}
 }
 func (m *DropIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7953,7 +8589,7 @@ func (m *TruncateTable) Reset()         { *m = TruncateTable{} }
 func (m *TruncateTable) String() string { return proto.CompactTextString(m) }
 func (*TruncateTable) ProtoMessage()    {}
 func (*TruncateTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{82}
+	return fileDescriptor_2d655ab2f7683c23, []int{88}
 }
 func (m *TruncateTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8044,7 +8680,7 @@ func (m *ClusterTable) Reset()         { *m = ClusterTable{} }
 func (m *ClusterTable) String() string { return proto.CompactTextString(m) }
 func (*ClusterTable) ProtoMessage()    {}
 func (*ClusterTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{83}
+	return fileDescriptor_2d655ab2f7683c23, []int{89}
 }
 func (m *ClusterTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8106,7 +8742,7 @@ func (m *ShowVariables) Reset()         { *m = ShowVariables{} }
 func (m *ShowVariables) String() string { return proto.CompactTextString(m) }
 func (*ShowVariables) ProtoMessage()    {}
 func (*ShowVariables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{84}
+	return fileDescriptor_2d655ab2f7683c23, []int{90}
 }
 func (m *ShowVariables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8160,7 +8796,7 @@ func (m *SetVariables) Reset()         { *m = SetVariables{} }
 func (m *SetVariables) String() string { return proto.CompactTextString(m) }
 func (*SetVariables) ProtoMessage()    {}
 func (*SetVariables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{85}
+	return fileDescriptor_2d655ab2f7683c23, []int{91}
 }
 func (m *SetVariables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8211,7 +8847,7 @@ func (m *SetVariablesItem) Reset()         { *m = SetVariablesItem{} }
 func (m *SetVariablesItem) String() string { return proto.CompactTextString(m) }
 func (*SetVariablesItem) ProtoMessage()    {}
 func (*SetVariablesItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{86}
+	return fileDescriptor_2d655ab2f7683c23, []int{92}
 }
 func (m *SetVariablesItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8289,7 +8925,7 @@ func (m *Prepare) Reset()         { *m = Prepare{} }
 func (m *Prepare) String() string { return proto.CompactTextString(m) }
 func (*Prepare) ProtoMessage()    {}
 func (*Prepare) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{87}
+	return fileDescriptor_2d655ab2f7683c23, []int{93}
 }
 func (m *Prepare) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8358,7 +8994,7 @@ func (m *Execute) Reset()         { *m = Execute{} }
 func (m *Execute) String() string { return proto.CompactTextString(m) }
 func (*Execute) ProtoMessage()    {}
 func (*Execute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{88}
+	return fileDescriptor_2d655ab2f7683c23, []int{94}
 }
 func (m *Execute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8412,7 +9048,7 @@ func (m *Deallocate) Reset()         { *m = Deallocate{} }
 func (m *Deallocate) String() string { return proto.CompactTextString(m) }
 func (*Deallocate) ProtoMessage()    {}
 func (*Deallocate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{89}
+	return fileDescriptor_2d655ab2f7683c23, []int{95}
 }
 func (m *Deallocate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8460,7 +9096,7 @@ func (m *TableLockInfo) Reset()         { *m = TableLockInfo{} }
 func (m *TableLockInfo) String() string { return proto.CompactTextString(m) }
 func (*TableLockInfo) ProtoMessage()    {}
 func (*TableLockInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{90}
+	return fileDescriptor_2d655ab2f7683c23, []int{96}
 }
 func (m *TableLockInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8514,7 +9150,7 @@ func (m *LockTables) Reset()         { *m = LockTables{} }
 func (m *LockTables) String() string { return proto.CompactTextString(m) }
 func (*LockTables) ProtoMessage()    {}
 func (*LockTables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{91}
+	return fileDescriptor_2d655ab2f7683c23, []int{97}
 }
 func (m *LockTables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8560,7 +9196,7 @@ func (m *UnLockTables) Reset()         { *m = UnLockTables{} }
 func (m *UnLockTables) String() string { return proto.CompactTextString(m) }
 func (*UnLockTables) ProtoMessage()    {}
 func (*UnLockTables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{92}
+	return fileDescriptor_2d655ab2f7683c23, []int{98}
 }
 func (m *UnLockTables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8601,12 +9237,15 @@ func init() {
 	proto.RegisterEnum("plan.Node_NodeType", Node_NodeType_name, Node_NodeType_value)
 	proto.RegisterEnum("plan.Node_JoinType", Node_JoinType_name, Node_JoinType_value)
 	proto.RegisterEnum("plan.Node_AggMode", Node_AggMode_name, Node_AggMode_value)
+	proto.RegisterEnum("plan.Node_JoinMethod", Node_JoinMethod_name, Node_JoinMethod_value)
+	proto.RegisterEnum("plan.Node_JoinDistribution", Node_JoinDistribution_name, Node_JoinDistribution_value)
 	proto.RegisterEnum("plan.Query_StatementType", Query_StatementType_name, Query_StatementType_value)
 	proto.RegisterEnum("plan.TransationControl_TclType", TransationControl_TclType_name, TransationControl_TclType_value)
 	proto.RegisterEnum("plan.TransationBegin_TransationMode", TransationBegin_TransationMode_name, TransationBegin_TransationMode_value)
 	proto.RegisterEnum("plan.DataControl_DclType", DataControl_DclType_name, DataControl_DclType_value)
 	proto.RegisterEnum("plan.DataDefinition_DdlType", DataDefinition_DdlType_name, DataDefinition_DdlType_value)
 	proto.RegisterEnum("plan.AlterTableDrop_Typ", AlterTableDrop_Typ_name, AlterTableDrop_Typ_value)
+	proto.RegisterEnum("plan.AlterTablePartition_Typ", AlterTablePartition_Typ_name, AlterTablePartition_Typ_value)
 	proto.RegisterType((*Type)(nil), "plan.Type")
 	proto.RegisterType((*Const)(nil), "plan.Const")
 	proto.RegisterType((*ParamRef)(nil), "plan.ParamRef")
@@ -8645,6 +9284,8 @@ func init() {
 	proto.RegisterType((*TableDef)(nil), "plan.TableDef")
 	proto.RegisterMapType((map[string]int32)(nil), "plan.TableDef.Name2colIndexEntry")
 	proto.RegisterType((*TableDef_DefType)(nil), "plan.TableDef.DefType")
+	proto.RegisterType((*TTLDef)(nil), "plan.TTLDef")
+	proto.RegisterType((*BloomFilterDef)(nil), "plan.BloomFilterDef")
 	proto.RegisterType((*TableFunction)(nil), "plan.TableFunction")
 	proto.RegisterType((*Stats)(nil), "plan.Stats")
 	proto.RegisterType((*ColData)(nil), "plan.ColData")
@@ -8657,6 +9298,7 @@ func init() {
 	proto.RegisterType((*UpdateCtx)(nil), "plan.UpdateCtx")
 	proto.RegisterType((*AnalyzeInfo)(nil), "plan.AnalyzeInfo")
 	proto.RegisterType((*Node)(nil), "plan.Node")
+	proto.RegisterType((*RuntimeFilterSpec)(nil), "plan.RuntimeFilterSpec")
 	proto.RegisterType((*PreInsertUkCtx)(nil), "plan.PreInsertUkCtx")
 	proto.RegisterType((*PreDeleteCtx)(nil), "plan.PreDeleteCtx")
 	proto.RegisterType((*PreInsertCtx)(nil), "plan.PreInsertCtx")
@@ -8684,6 +9326,9 @@ func init() {
 	proto.RegisterType((*AlterTableAddIndex)(nil), "plan.AlterTableAddIndex")
 	proto.RegisterType((*AlterTableDropIndex)(nil), "plan.AlterTableDropIndex")
 	proto.RegisterType((*AlterTableAlterIndex)(nil), "plan.AlterTableAlterIndex")
+	proto.RegisterType((*AlterTablePartition)(nil), "plan.AlterTablePartition")
+	proto.RegisterType((*AlterTableMergePolicy)(nil), "plan.AlterTableMergePolicy")
+	proto.RegisterType((*AlterTableCompact)(nil), "plan.AlterTableCompact")
 	proto.RegisterType((*AlterTable)(nil), "plan.AlterTable")
 	proto.RegisterType((*AlterTable_Action)(nil), "plan.AlterTable.Action")
 	proto.RegisterType((*DropTable)(nil), "plan.DropTable")
@@ -8710,486 +9355,527 @@ func init() {
 func init() { proto.RegisterFile("plan.proto", fileDescriptor_2d655ab2f7683c23) }
 
 var fileDescriptor_2d655ab2f7683c23 = []byte{
-	// 7650 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x7c, 0x4b, 0x8c, 0x23, 0xd9,
-	0x96, 0x50, 0xfa, 0x6f, 0x1f, 0x7f, 0x32, 0xf2, 0xd6, 0xcf, 0x55, 0x5d, 0x5d, 0x9d, 0x1d, 0xfd,
-	0xab, 0xae, 0xee, 0x57, 0xdd, 0x5d, 0xfd, 0xef, 0xe9, 0xa7, 0xf7, 0x9c, 0xb6, 0x2b, 0xd3, 0x5d,
-	0x2e, 0x3b, 0xdf, 0xb5, 0xb3, 0xaa, 0x9b, 0x11, 0xb2, 0xc2, 0x8e, 0x70, 0x56, 0x54, 0x86, 0x23,
-	0xdc, 0x11, 0xe1, 0xca, 0xcc, 0x27, 0x8d, 0xf4, 0x24, 0x24, 0x10, 0x2b, 0x84, 0x40, 0x03, 0xd2,
-	0x30, 0x30, 0xb0, 0x40, 0x82, 0x0d, 0x62, 0xcb, 0x0e, 0xd8, 0x80, 0xc4, 0x02, 0xb6, 0xc3, 0x06,
-	0x1e, 0x68, 0xf6, 0x68, 0x58, 0xb2, 0x40, 0xe7, 0xdc, 0x1b, 0x11, 0x37, 0x9c, 0xce, 0x57, 0xdd,
-	0x3d, 0x0f, 0xb1, 0xc9, 0x8c, 0x7b, 0x3e, 0xf7, 0x7b, 0xee, 0xf9, 0xdd, 0x7b, 0x0d, 0xb0, 0x74,
-	0x0c, 0xf7, 0xfe, 0xd2, 0xf7, 0x42, 0x8f, 0xe5, 0xf1, 0xfb, 0xd6, 0xcf, 0x8e, 0xed, 0xf0, 0xd9,
-	0x6a, 0x7a, 0x7f, 0xe6, 0x2d, 0x3e, 0x38, 0xf6, 0x8e, 0xbd, 0x0f, 0x08, 0x39, 0x5d, 0xcd, 0xa9,
-	0x44, 0x05, 0xfa, 0x12, 0x4c, 0xfa, 0x3f, 0xc8, 0x40, 0x7e, 0x7c, 0xbe, 0xb4, 0x58, 0x03, 0xb2,
-	0xb6, 0xd9, 0xcc, 0xec, 0x66, 0xee, 0x16, 0x78, 0xd6, 0x36, 0xd9, 0x2e, 0x54, 0x5d, 0x2f, 0x1c,
-	0xac, 0x1c, 0xc7, 0x98, 0x3a, 0x56, 0x33, 0xbb, 0x9b, 0xb9, 0x5b, 0xe6, 0x2a, 0x88, 0xbd, 0x02,
-	0x15, 0x63, 0x15, 0x7a, 0x13, 0xdb, 0x9d, 0xf9, 0xcd, 0x1c, 0xe1, 0xcb, 0x08, 0xe8, 0xb9, 0x33,
-	0x9f, 0x5d, 0x85, 0xc2, 0xa9, 0x6d, 0x86, 0xcf, 0x9a, 0x79, 0xaa, 0x51, 0x14, 0x10, 0x1a, 0xcc,
-	0x0c, 0xc7, 0x6a, 0x16, 0x04, 0x94, 0x0a, 0x08, 0x0d, 0xa9, 0x91, 0xe2, 0x6e, 0xe6, 0x6e, 0x85,
-	0x8b, 0x82, 0xfe, 0x5f, 0x0a, 0x50, 0x68, 0x7b, 0x6e, 0x10, 0xb2, 0xeb, 0x50, 0xb4, 0x03, 0x77,
-	0xe5, 0x38, 0xd4, 0xbd, 0x32, 0x97, 0x25, 0x76, 0x1d, 0x0a, 0xf6, 0x17, 0x2f, 0x0c, 0x87, 0x3a,
-	0x57, 0x38, 0xd8, 0xe2, 0xa2, 0xc8, 0x9a, 0x50, 0xb4, 0x3f, 0xfa, 0x0c, 0x11, 0x39, 0x89, 0x90,
-	0x65, 0xc2, 0x7c, 0xfc, 0x00, 0x31, 0xf9, 0x18, 0x43, 0x65, 0xc2, 0x7c, 0xf6, 0x09, 0x62, 0xb0,
-	0x6b, 0x39, 0xc2, 0x50, 0x19, 0x5b, 0x59, 0x51, 0x2b, 0xd8, 0xbb, 0x3a, 0xb6, 0xb2, 0x8a, 0x5a,
-	0x59, 0x89, 0x56, 0x4a, 0x12, 0x21, 0xcb, 0x84, 0x11, 0xad, 0x94, 0x63, 0x4c, 0xdc, 0xca, 0x4a,
-	0xb4, 0x52, 0xd9, 0xcd, 0xdc, 0xcd, 0x13, 0x46, 0xb4, 0x72, 0x15, 0xf2, 0x26, 0xc2, 0x61, 0x37,
-	0x73, 0x37, 0x73, 0xb0, 0xc5, 0xa9, 0x84, 0xd0, 0x00, 0xa1, 0x55, 0x9c, 0x18, 0x84, 0x06, 0x12,
-	0x3a, 0x45, 0x68, 0x0d, 0x67, 0x03, 0xa1, 0x53, 0x09, 0x9d, 0x23, 0xb4, 0xbe, 0x9b, 0xb9, 0x9b,
-	0x45, 0x28, 0x96, 0xd8, 0x2d, 0x28, 0x99, 0x46, 0x68, 0x21, 0xa2, 0x21, 0x87, 0x1c, 0x01, 0x10,
-	0x17, 0xda, 0x0b, 0xc2, 0x6d, 0xcb, 0x41, 0x47, 0x00, 0xa6, 0x43, 0x15, 0xc9, 0x22, 0xbc, 0x26,
-	0xf1, 0x2a, 0x90, 0x7d, 0x0a, 0x35, 0xd3, 0x9a, 0xd9, 0x0b, 0xc3, 0x11, 0x63, 0xda, 0xd9, 0xcd,
-	0xdc, 0xad, 0x3e, 0xd8, 0xbe, 0x4f, 0x32, 0x19, 0x63, 0x0e, 0xb6, 0x78, 0x8a, 0x8c, 0x7d, 0x01,
-	0x75, 0x59, 0xfe, 0xe8, 0x01, 0x4d, 0x2c, 0x23, 0x3e, 0x2d, 0xc5, 0xf7, 0xd1, 0x83, 0x2f, 0x0e,
-	0xb6, 0x78, 0x9a, 0x90, 0xbd, 0x09, 0x35, 0x6c, 0x3b, 0x08, 0x8d, 0xc5, 0x12, 0x19, 0xaf, 0xc8,
-	0x5e, 0xa5, 0xa0, 0x38, 0xac, 0xe7, 0x81, 0xe7, 0x22, 0xc1, 0x55, 0x39, 0x6f, 0x11, 0x80, 0xed,
-	0x02, 0x98, 0xd6, 0xdc, 0x58, 0x39, 0x21, 0xa2, 0xaf, 0xc9, 0x09, 0x54, 0x60, 0xec, 0x0e, 0x54,
-	0x56, 0x4b, 0x1c, 0xe5, 0x13, 0xc3, 0x69, 0x5e, 0x97, 0x04, 0x09, 0x08, 0x85, 0xd5, 0x0e, 0xf6,
-	0x6c, 0xb7, 0x79, 0x83, 0x64, 0x51, 0x14, 0xd8, 0x6d, 0xc8, 0x05, 0xfe, 0xac, 0xd9, 0xa4, 0x91,
-	0x80, 0x18, 0x49, 0xf7, 0x6c, 0xe9, 0x73, 0x04, 0xef, 0x95, 0xa0, 0xf0, 0xc2, 0x70, 0x56, 0x96,
-	0x7e, 0x1b, 0xca, 0x87, 0x86, 0x6f, 0x2c, 0xb8, 0x35, 0x67, 0x1a, 0xe4, 0x96, 0x5e, 0x20, 0x77,
-	0x1c, 0x7e, 0xea, 0x7d, 0x28, 0x3e, 0x31, 0x7c, 0xc4, 0x31, 0xc8, 0xbb, 0xc6, 0xc2, 0x22, 0x64,
-	0x85, 0xd3, 0x37, 0xee, 0x82, 0xe0, 0x3c, 0x08, 0xad, 0x85, 0xdc, 0x8b, 0xb2, 0x84, 0xf0, 0x63,
-	0xc7, 0x9b, 0x4a, 0x69, 0x2f, 0x73, 0x59, 0xd2, 0x07, 0x50, 0x6c, 0x7b, 0x0e, 0xd6, 0x76, 0x03,
-	0x4a, 0xbe, 0xe5, 0x4c, 0x92, 0xd6, 0x8a, 0xbe, 0xe5, 0x1c, 0x7a, 0x01, 0x22, 0x66, 0x9e, 0x40,
-	0x64, 0x05, 0x62, 0xe6, 0x11, 0x22, 0x6a, 0x3f, 0x97, 0xb4, 0xaf, 0x7f, 0x09, 0x15, 0x6e, 0x9c,
-	0xca, 0x2a, 0xaf, 0x41, 0x31, 0x9c, 0x3a, 0x13, 0xa9, 0x31, 0xf2, 0xbc, 0x10, 0x4e, 0x9d, 0x9e,
-	0x89, 0x60, 0xac, 0xd0, 0x36, 0xa9, 0xbe, 0x3c, 0x2f, 0xcc, 0x3c, 0xa7, 0x67, 0xea, 0x63, 0x80,
-	0xb6, 0xe7, 0xfb, 0x3f, 0xb9, 0x3b, 0x57, 0xa1, 0x60, 0x5a, 0xcb, 0xf0, 0x99, 0xd8, 0xcf, 0x5c,
-	0x14, 0xf4, 0x7b, 0x50, 0xc6, 0x29, 0xee, 0xdb, 0x41, 0xc8, 0xee, 0x40, 0xde, 0xb1, 0x83, 0xb0,
-	0x99, 0xd9, 0xcd, 0xad, 0x2d, 0x00, 0xc1, 0xf5, 0x5d, 0x28, 0x3f, 0x36, 0xce, 0x9e, 0xe0, 0x22,
-	0x60, 0x6d, 0xb4, 0x1a, 0x72, 0x76, 0xe5, 0xd2, 0xdc, 0x03, 0x18, 0x1b, 0xfe, 0xb1, 0x15, 0x92,
-	0x36, 0xbc, 0x0d, 0xb9, 0xf0, 0x7c, 0x49, 0x14, 0x71, 0x75, 0x88, 0xe0, 0x08, 0xd6, 0xff, 0x32,
-	0x03, 0xd5, 0xd1, 0x6a, 0xfa, 0xfd, 0xca, 0xf2, 0xcf, 0x71, 0x44, 0x77, 0x13, 0xea, 0xc6, 0x83,
-	0xeb, 0x82, 0x5a, 0xc1, 0x27, 0x9c, 0x38, 0x44, 0xd7, 0x33, 0xad, 0x68, 0x86, 0x0a, 0xbc, 0x88,
-	0xc5, 0x9e, 0x89, 0xea, 0xd7, 0x5b, 0xca, 0xf9, 0xce, 0x7a, 0x4b, 0xb6, 0x0b, 0x85, 0xd9, 0x33,
-	0xdb, 0x31, 0x49, 0x51, 0xa5, 0x47, 0x24, 0x10, 0xec, 0x26, 0x94, 0x7d, 0xef, 0x74, 0x12, 0xd8,
-	0xbf, 0x8e, 0xd4, 0x69, 0xc9, 0xf7, 0x4e, 0x47, 0xf6, 0xaf, 0x2d, 0x7d, 0x2c, 0x75, 0x3a, 0x40,
-	0x71, 0xd4, 0x6e, 0xf5, 0x5b, 0x5c, 0xdb, 0xc2, 0xef, 0xee, 0xb7, 0xbd, 0xd1, 0x78, 0xa4, 0x65,
-	0x58, 0x03, 0x60, 0x30, 0x1c, 0x4f, 0x64, 0x39, 0xcb, 0x8a, 0x90, 0xed, 0x0d, 0xb4, 0x1c, 0xd2,
-	0x20, 0xbc, 0x37, 0xd0, 0xf2, 0xac, 0x04, 0xb9, 0xd6, 0xe0, 0x3b, 0xad, 0x40, 0x1f, 0xfd, 0xbe,
-	0x56, 0xd4, 0xff, 0x79, 0x16, 0x2a, 0xc3, 0xe9, 0x73, 0x6b, 0x16, 0xe2, 0x98, 0x51, 0x1c, 0x2d,
-	0xff, 0x85, 0xe5, 0xd3, 0xb0, 0x73, 0x5c, 0x96, 0x70, 0x20, 0xe6, 0x94, 0x06, 0x97, 0xe3, 0x59,
-	0x73, 0x4a, 0x74, 0xb3, 0x67, 0xd6, 0xc2, 0xa0, 0xc1, 0x21, 0x1d, 0x95, 0x50, 0xfc, 0xbd, 0xe9,
-	0x73, 0x1a, 0x5e, 0x8e, 0xe3, 0x27, 0x7b, 0x0d, 0xaa, 0xa2, 0x8e, 0x09, 0xc9, 0x5e, 0x81, 0xe6,
-	0x02, 0x04, 0x68, 0x80, 0x3b, 0xe0, 0x06, 0x94, 0xcc, 0xa9, 0x40, 0x0a, 0x4b, 0x51, 0x34, 0xa7,
-	0x84, 0x40, 0x4e, 0xaa, 0x55, 0x20, 0x4b, 0x92, 0x93, 0x40, 0x44, 0x70, 0x13, 0xca, 0xde, 0xf4,
-	0xb9, 0xc0, 0x96, 0x09, 0x5b, 0xf2, 0xa6, 0xcf, 0x09, 0xf5, 0x1e, 0xec, 0x04, 0xab, 0x69, 0x30,
-	0xf3, 0xed, 0x65, 0x68, 0x7b, 0xae, 0xa0, 0xa9, 0x10, 0x8d, 0xa6, 0x22, 0x88, 0xf8, 0x4d, 0x68,
-	0x2c, 0x57, 0xd3, 0x89, 0x31, 0x9b, 0x79, 0x2b, 0x37, 0xc4, 0x55, 0x04, 0x9a, 0xf9, 0xda, 0x72,
-	0x35, 0x6d, 0x09, 0x60, 0xcf, 0xd4, 0xff, 0x51, 0x06, 0xb4, 0x91, 0xc2, 0xfa, 0xd8, 0x0a, 0x8d,
-	0x8d, 0x5b, 0xfa, 0x55, 0x00, 0xa5, 0x2a, 0x21, 0x10, 0x15, 0x23, 0xaa, 0x47, 0x1d, 0x6f, 0x2e,
-	0x35, 0xde, 0xd7, 0xa1, 0x16, 0xf1, 0x11, 0x36, 0x4f, 0xd8, 0xaa, 0x84, 0x45, 0x23, 0x0e, 0x56,
-	0x53, 0x75, 0x26, 0x4b, 0xc1, 0x8a, 0xb8, 0xf5, 0xff, 0x95, 0x81, 0xf2, 0xc3, 0x95, 0x3b, 0xc3,
-	0xae, 0xb1, 0x37, 0x20, 0x3f, 0x5f, 0xb9, 0x33, 0x29, 0xe9, 0x52, 0x77, 0xc7, 0xab, 0xcc, 0x09,
-	0x89, 0xbb, 0xcb, 0xf0, 0x8f, 0x71, 0x57, 0x5e, 0xd8, 0x5d, 0x08, 0xd7, 0xff, 0x89, 0xac, 0xf1,
-	0xa1, 0x63, 0x1c, 0xb3, 0x32, 0xe4, 0x07, 0xc3, 0x41, 0x57, 0xdb, 0x62, 0x35, 0x28, 0xf7, 0x06,
-	0xe3, 0x2e, 0x1f, 0xb4, 0xfa, 0x5a, 0x86, 0x84, 0x71, 0xdc, 0xda, 0xeb, 0x77, 0xb5, 0x2c, 0x62,
-	0x9e, 0x0c, 0xfb, 0xad, 0x71, 0xaf, 0xdf, 0xd5, 0xf2, 0x02, 0xc3, 0x7b, 0xed, 0xb1, 0x56, 0x66,
-	0x1a, 0xd4, 0x0e, 0xf9, 0xb0, 0x73, 0xd4, 0xee, 0x4e, 0x06, 0x47, 0xfd, 0xbe, 0xa6, 0xb1, 0x2b,
-	0xb0, 0x1d, 0x43, 0x86, 0x02, 0xb8, 0x8b, 0x2c, 0x4f, 0x5a, 0xbc, 0xc5, 0xf7, 0xb5, 0x5f, 0xb2,
-	0x32, 0xe4, 0x5a, 0xfb, 0xfb, 0xda, 0x6f, 0x32, 0xf8, 0xf5, 0xb4, 0x37, 0xd0, 0x7e, 0x93, 0x65,
-	0x0d, 0xa8, 0x3c, 0x1e, 0x0e, 0x86, 0xe3, 0xe1, 0xa0, 0xd7, 0xd6, 0x7e, 0x93, 0xd7, 0xff, 0x45,
-	0x0e, 0xf2, 0xd8, 0xe1, 0xdf, 0xbd, 0xb1, 0xd9, 0x2b, 0x90, 0x99, 0xd1, 0x3a, 0x54, 0x1f, 0x54,
-	0x05, 0x8e, 0x3c, 0x90, 0x83, 0x2d, 0x9e, 0xc1, 0x59, 0xc8, 0x88, 0x1d, 0x5a, 0x7d, 0xd0, 0x10,
-	0xc8, 0x48, 0x97, 0x23, 0x7e, 0xc9, 0x6e, 0x43, 0xe6, 0x85, 0xdc, 0xae, 0x35, 0x81, 0x17, 0xda,
-	0x1c, 0xb1, 0x2f, 0xd8, 0x2e, 0xe4, 0x66, 0x9e, 0xf0, 0x2e, 0x62, 0xbc, 0x50, 0x88, 0x07, 0x5b,
-	0x1c, 0x51, 0xec, 0x0d, 0xc8, 0xf9, 0xc6, 0x29, 0x89, 0x76, 0xbc, 0x12, 0xb1, 0xc6, 0x45, 0x22,
-	0xdf, 0x38, 0xc5, 0x4e, 0xcc, 0x49, 0xc0, 0xe3, 0x4e, 0x44, 0x4b, 0x89, 0xcd, 0xcc, 0xd9, 0x5b,
-	0x90, 0x0b, 0x56, 0x53, 0x12, 0xf2, 0xea, 0x83, 0x9d, 0x0b, 0xaa, 0x08, 0xab, 0x09, 0x56, 0x53,
-	0xf6, 0x36, 0xe4, 0x67, 0x9e, 0xef, 0x93, 0xa0, 0xc7, 0xa6, 0x37, 0xd1, 0xd1, 0xe8, 0x3e, 0x20,
-	0x9e, 0xed, 0x42, 0x26, 0x24, 0x19, 0x8f, 0x89, 0x12, 0x25, 0x89, 0x0d, 0x86, 0xec, 0x4d, 0xa9,
-	0x79, 0xab, 0x6a, 0x9f, 0x22, 0xbd, 0x8c, 0xf5, 0x20, 0x96, 0xe9, 0x90, 0x5b, 0x18, 0x67, 0xe4,
-	0xb1, 0xc4, 0x44, 0x91, 0x42, 0xc6, 0x3e, 0x2d, 0x8c, 0xb3, 0xbd, 0x22, 0xe4, 0xad, 0xb3, 0xa5,
-	0xaf, 0xdf, 0x84, 0x4a, 0xec, 0x2f, 0xb0, 0x1a, 0x64, 0x0c, 0xa9, 0x61, 0x32, 0x86, 0x7e, 0x17,
-	0xcd, 0x77, 0xe4, 0x11, 0xa4, 0x71, 0x58, 0x8a, 0xf4, 0x4e, 0x66, 0xaa, 0x7f, 0x0d, 0x35, 0x6e,
-	0x05, 0x2b, 0x27, 0x6c, 0x7b, 0x4e, 0xc7, 0x9a, 0xb3, 0xf7, 0x01, 0xe2, 0x72, 0x20, 0xcd, 0x44,
-	0xb2, 0x0a, 0x1d, 0x6b, 0xce, 0x15, 0xbc, 0xfe, 0x37, 0x72, 0x64, 0x3c, 0x3b, 0xc2, 0xd2, 0x49,
-	0x93, 0x96, 0x51, 0x4c, 0x5a, 0xbc, 0x9d, 0xb3, 0x69, 0x0b, 0xfd, 0xcc, 0x36, 0x4d, 0xcb, 0x8d,
-	0x2c, 0xb1, 0x28, 0xb1, 0x37, 0x21, 0x67, 0x38, 0xc7, 0x24, 0x1a, 0x8d, 0x07, 0x2c, 0x6a, 0x74,
-	0xb1, 0xf4, 0xad, 0x20, 0x10, 0xb2, 0x67, 0x38, 0xc7, 0x91, 0x64, 0x16, 0x36, 0x4b, 0xe6, 0x4d,
-	0x28, 0xbb, 0x5e, 0x38, 0x21, 0x2f, 0xb8, 0x48, 0xb5, 0x97, 0xa4, 0x2f, 0xce, 0xde, 0x81, 0x92,
-	0xf4, 0x5f, 0xa4, 0x60, 0xd4, 0x05, 0x73, 0x47, 0x00, 0x79, 0x84, 0x65, 0x4d, 0xb4, 0xaf, 0x8b,
-	0x85, 0xe5, 0x86, 0x91, 0x12, 0x94, 0x45, 0xf6, 0x1e, 0x54, 0x3c, 0x77, 0x22, 0x9c, 0x1c, 0x29,
-	0x13, 0x72, 0x91, 0x86, 0xee, 0x11, 0x41, 0x79, 0xd9, 0x93, 0x5f, 0xd8, 0x15, 0xc7, 0x3b, 0x9d,
-	0xcc, 0x0c, 0x5f, 0xa8, 0xbf, 0x32, 0x2f, 0x39, 0xde, 0x69, 0xdb, 0xf0, 0x4d, 0x76, 0x1b, 0x2a,
-	0x33, 0x67, 0x15, 0x84, 0x96, 0xbf, 0x77, 0x4e, 0x12, 0x51, 0xe6, 0x09, 0x00, 0xdb, 0x5f, 0xfa,
-	0xf6, 0xc2, 0xf0, 0xcf, 0x85, 0xeb, 0xca, 0xa3, 0x22, 0x9a, 0xe4, 0xe5, 0x89, 0x6d, 0x9e, 0x91,
-	0xf3, 0x5a, 0xe0, 0xa2, 0xa0, 0x7f, 0x0f, 0x25, 0x39, 0x06, 0xd4, 0x40, 0x28, 0x1b, 0xe9, 0x7d,
-	0x2b, 0x34, 0x10, 0xc2, 0xd9, 0x1b, 0x50, 0xf7, 0x7c, 0xfb, 0xd8, 0x76, 0x27, 0x41, 0xe8, 0xdb,
-	0xee, 0xb1, 0x5c, 0x97, 0x9a, 0x00, 0x8e, 0x08, 0x86, 0x6a, 0x13, 0xe7, 0x6f, 0x62, 0x4c, 0x6d,
-	0xc7, 0x0e, 0xcf, 0xe5, 0x2a, 0x55, 0x11, 0xd6, 0x12, 0x20, 0x7d, 0x08, 0xe5, 0x68, 0xc4, 0xbf,
-	0x97, 0x36, 0xf5, 0x3f, 0x80, 0x6a, 0xcf, 0x35, 0xad, 0xb3, 0x21, 0x59, 0x02, 0xf6, 0x3e, 0xb0,
-	0x99, 0x6f, 0x19, 0xa1, 0x35, 0xb1, 0xce, 0x42, 0xdf, 0x98, 0x88, 0xb8, 0x47, 0x84, 0x35, 0x9a,
-	0xc0, 0x74, 0x11, 0x31, 0xa6, 0x10, 0xe8, 0xcf, 0x33, 0x50, 0x3f, 0x14, 0x53, 0xf4, 0xc8, 0x3a,
-	0xef, 0x08, 0xc7, 0x70, 0x16, 0x09, 0x70, 0x9e, 0xd3, 0x37, 0xbb, 0x03, 0xd5, 0xe5, 0x89, 0x75,
-	0x3e, 0x49, 0x79, 0x5e, 0x15, 0x04, 0xb5, 0x49, 0x54, 0xdf, 0x85, 0xa2, 0x47, 0xad, 0x4b, 0xe5,
-	0x25, 0xb5, 0x82, 0xd2, 0x2d, 0x2e, 0x09, 0x98, 0x0e, 0xf5, 0xb8, 0x2a, 0xd5, 0xb2, 0xc8, 0xca,
-	0xc8, 0xb2, 0x5c, 0x85, 0x02, 0xa2, 0x82, 0x66, 0x61, 0x37, 0x87, 0xee, 0x13, 0x15, 0xd8, 0x87,
-	0x50, 0x9f, 0x79, 0x8b, 0xe5, 0x24, 0x62, 0x97, 0x6a, 0x2c, 0xbd, 0xc5, 0xaa, 0x48, 0x72, 0x28,
-	0xea, 0xd2, 0xff, 0x61, 0x16, 0xca, 0xd4, 0x07, 0xb9, 0xcb, 0x6c, 0xf3, 0x2c, 0xda, 0x65, 0x15,
-	0x5e, 0xb0, 0xcd, 0xb3, 0x9e, 0x89, 0x06, 0xd2, 0x46, 0x92, 0x89, 0xb2, 0xd7, 0x2a, 0x04, 0x89,
-	0xba, 0xb2, 0x34, 0xfc, 0x30, 0x68, 0xe6, 0x44, 0x57, 0xa8, 0x80, 0xdb, 0x70, 0xe5, 0xda, 0xdf,
-	0xaf, 0x44, 0xef, 0xcb, 0x5c, 0x96, 0xd8, 0x5d, 0xd0, 0x44, 0x65, 0x34, 0xe9, 0xaa, 0x69, 0x6c,
-	0x10, 0x9c, 0xe6, 0x3c, 0xf2, 0x27, 0x04, 0x8d, 0x75, 0x86, 0xaa, 0x4d, 0xec, 0x37, 0x20, 0x50,
-	0x17, 0x21, 0xea, 0x4e, 0x2a, 0xa5, 0x77, 0x52, 0x13, 0x4a, 0x2f, 0xec, 0xc0, 0xc6, 0x55, 0x2d,
-	0x0b, 0x19, 0x97, 0x45, 0x65, 0x19, 0x2a, 0x2f, 0x59, 0x06, 0xfd, 0x3f, 0x66, 0xa1, 0xfe, 0xd0,
-	0xf3, 0x2d, 0xfb, 0xd8, 0x4d, 0xd6, 0xfd, 0x82, 0xf7, 0x10, 0xc9, 0x42, 0x56, 0x91, 0x85, 0xd7,
-	0xa0, 0x3a, 0x17, 0x8c, 0x93, 0x70, 0x2a, 0x22, 0x82, 0x3c, 0x07, 0x09, 0x1a, 0x4f, 0x1d, 0xdc,
-	0x03, 0x11, 0x01, 0x31, 0xe7, 0x89, 0x39, 0x62, 0x42, 0xe5, 0xc7, 0xbe, 0x22, 0x65, 0x60, 0x5a,
-	0x8e, 0x15, 0x8a, 0x09, 0x6a, 0x3c, 0x78, 0x55, 0x9a, 0x1a, 0xb5, 0x4f, 0xf7, 0xb9, 0x35, 0x6f,
-	0x91, 0xe5, 0x41, 0xdd, 0xd0, 0x21, 0x72, 0xc9, 0x2b, 0x15, 0x49, 0xf1, 0x07, 0xf2, 0x8a, 0xfd,
-	0xa6, 0x8f, 0xa1, 0x12, 0x83, 0xd1, 0x43, 0xe0, 0x5d, 0xe9, 0x15, 0x6c, 0xb1, 0x2a, 0x94, 0xda,
-	0xad, 0x51, 0xbb, 0xd5, 0xe9, 0x6a, 0x19, 0x44, 0x8d, 0xba, 0x63, 0xe1, 0x09, 0x64, 0xd9, 0x36,
-	0x54, 0xb1, 0xd4, 0xe9, 0x3e, 0x6c, 0x1d, 0xf5, 0xc7, 0x5a, 0x8e, 0xd5, 0xa1, 0x32, 0x18, 0x4e,
-	0x5a, 0xed, 0x71, 0x6f, 0x38, 0xd0, 0xf2, 0xfa, 0x2f, 0xa1, 0xdc, 0x7e, 0x66, 0xcd, 0x4e, 0x2e,
-	0x9b, 0x45, 0x72, 0xb4, 0xad, 0xd9, 0x89, 0x34, 0xfb, 0x6b, 0x8e, 0xb6, 0x35, 0x3b, 0xd1, 0x3b,
-	0x50, 0x6b, 0x47, 0x3a, 0x0c, 0x6b, 0xd9, 0x8d, 0xa4, 0xee, 0x62, 0xb0, 0x21, 0x25, 0x70, 0x83,
-	0x71, 0xd0, 0x3f, 0x85, 0xea, 0xa1, 0xef, 0x2d, 0x2d, 0x3f, 0xa4, 0x4a, 0x34, 0xc8, 0x9d, 0x58,
-	0xe7, 0xb2, 0x27, 0xf8, 0x99, 0x84, 0x25, 0x59, 0x35, 0x2c, 0x79, 0x00, 0xe5, 0x88, 0xed, 0x07,
-	0xf3, 0xfc, 0x02, 0xb5, 0x06, 0xf1, 0xd8, 0x56, 0x80, 0x8d, 0xdd, 0x07, 0x58, 0xc6, 0x00, 0xd9,
-	0xed, 0xc8, 0x85, 0x91, 0x95, 0x73, 0x85, 0x42, 0xff, 0xcb, 0x1c, 0x34, 0x0e, 0x0d, 0x3f, 0xb4,
-	0x71, 0x29, 0xc4, 0xa0, 0xdf, 0x81, 0x7c, 0x78, 0xbe, 0xb4, 0x64, 0x8c, 0x73, 0x25, 0xf6, 0x7f,
-	0x04, 0x0d, 0xd9, 0x29, 0x22, 0x60, 0x5f, 0x41, 0x63, 0x19, 0x81, 0x27, 0xa4, 0x3f, 0xc5, 0xc4,
-	0xae, 0xb3, 0xd0, 0x7c, 0xd5, 0x97, 0x6a, 0x91, 0xfd, 0x1c, 0xae, 0xa6, 0x79, 0xad, 0x20, 0x48,
-	0xf4, 0x96, 0x3a, 0xd1, 0x57, 0x52, 0x8c, 0x82, 0x8c, 0xb5, 0x61, 0x27, 0x61, 0x9f, 0x79, 0xce,
-	0x6a, 0xe1, 0x06, 0xd2, 0x21, 0xbb, 0xbe, 0xd6, 0x7a, 0x5b, 0x60, 0xb9, 0xb6, 0x5c, 0x83, 0x30,
-	0x1d, 0x6a, 0x31, 0x6c, 0xb0, 0x5a, 0xd0, 0x06, 0xc8, 0xf3, 0x14, 0x8c, 0x7d, 0x0c, 0x10, 0x97,
-	0x83, 0x66, 0x91, 0xe6, 0x73, 0x7d, 0x7c, 0xbd, 0xd0, 0x5a, 0x70, 0x85, 0x0c, 0x6d, 0xa3, 0xe1,
-	0x1c, 0x7b, 0xbe, 0x1d, 0x3e, 0x5b, 0x90, 0xd6, 0xc8, 0xf1, 0x04, 0x40, 0xca, 0x29, 0x98, 0xa0,
-	0xcb, 0x1e, 0xb3, 0x48, 0x05, 0xd2, 0xb0, 0x83, 0xd1, 0x6a, 0x1a, 0xd7, 0x8b, 0x66, 0x27, 0x19,
-	0xe5, 0x22, 0x38, 0x96, 0xc1, 0x4a, 0xd2, 0xc3, 0xc7, 0xc1, 0x31, 0x7b, 0x00, 0xd7, 0x12, 0xa2,
-	0x44, 0xdf, 0x05, 0x4d, 0x20, 0x4d, 0x99, 0x4c, 0x5f, 0xac, 0xf4, 0x02, 0xfd, 0x1b, 0xa8, 0xa7,
-	0x56, 0xe7, 0xa5, 0x06, 0xf0, 0x26, 0x94, 0xf1, 0x3f, 0x9a, 0x3f, 0x29, 0x80, 0x25, 0x2c, 0x8f,
-	0x42, 0x5f, 0xb7, 0x40, 0x5b, 0x9f, 0x6b, 0xf6, 0x26, 0x85, 0xf7, 0xb4, 0x28, 0x17, 0x77, 0x4e,
-	0x84, 0xc2, 0x78, 0xec, 0xe2, 0x22, 0x66, 0xa9, 0xd7, 0x17, 0x16, 0x4b, 0xff, 0xa7, 0x59, 0xa5,
-	0xcf, 0x38, 0xe3, 0xec, 0x2d, 0x55, 0xfc, 0x94, 0xcd, 0x9e, 0xcc, 0x19, 0x69, 0xf8, 0x77, 0x41,
-	0xf3, 0x7c, 0xd3, 0x76, 0x0d, 0x4a, 0x37, 0x88, 0xe9, 0xc6, 0x21, 0xd4, 0xf9, 0xb6, 0x84, 0x1f,
-	0x4a, 0x30, 0xdb, 0x85, 0xaa, 0x69, 0xc5, 0xb1, 0x9c, 0x8c, 0xc4, 0x54, 0x90, 0x6a, 0x0d, 0xf2,
-	0x69, 0x6b, 0xf0, 0x0e, 0x54, 0x1c, 0x2b, 0x08, 0x26, 0xe1, 0x33, 0xc3, 0x25, 0x7b, 0x99, 0x1e,
-	0x74, 0x19, 0x91, 0xe3, 0x67, 0x86, 0x8b, 0x84, 0xb6, 0x3b, 0xa1, 0xed, 0x1b, 0x09, 0x54, 0x8a,
-	0xd0, 0x76, 0xc9, 0x55, 0x46, 0x3b, 0x7b, 0x75, 0xd3, 0xc2, 0x4a, 0x33, 0xc4, 0x2e, 0xae, 0xab,
-	0xfe, 0x2a, 0x94, 0x9e, 0xd8, 0xd6, 0xa9, 0xd4, 0x7f, 0x2f, 0x6c, 0xeb, 0x34, 0xd2, 0x7f, 0xf8,
-	0xad, 0xff, 0x9b, 0x12, 0x94, 0x89, 0xb8, 0x73, 0x79, 0x5a, 0xe7, 0xc7, 0x38, 0xbb, 0xbb, 0xd2,
-	0x2a, 0xe5, 0x37, 0xb8, 0xd8, 0xc2, 0x46, 0xbd, 0x0a, 0xc2, 0x94, 0x4e, 0x48, 0xa1, 0x08, 0x0b,
-	0x5c, 0x21, 0x88, 0x4c, 0xbd, 0x54, 0x84, 0x23, 0x14, 0x7c, 0xef, 0xc8, 0x38, 0x3f, 0x01, 0xb0,
-	0xfb, 0x50, 0xc6, 0x1e, 0x52, 0xcc, 0x5a, 0x52, 0x15, 0x0b, 0x8d, 0x21, 0x8a, 0x85, 0x78, 0x29,
-	0x9c, 0x3a, 0x58, 0x40, 0xbd, 0x85, 0x2e, 0x89, 0x0c, 0x4f, 0xa2, 0x4d, 0xaa, 0xfa, 0x54, 0x9c,
-	0x08, 0xd8, 0x5d, 0x28, 0x91, 0x17, 0x60, 0x05, 0xcd, 0x9a, 0xaa, 0x20, 0x23, 0x17, 0x85, 0x47,
-	0x68, 0xf6, 0x2e, 0x14, 0xe6, 0x27, 0xd6, 0x79, 0xd0, 0xac, 0xab, 0x1b, 0x3f, 0x65, 0xdf, 0xb8,
-	0xa0, 0x60, 0x6f, 0x42, 0xc3, 0xb7, 0xe6, 0x13, 0x4a, 0xd8, 0xa0, 0x41, 0x0e, 0x9a, 0x0d, 0xb2,
-	0xb7, 0x35, 0xdf, 0x9a, 0xb7, 0x11, 0x38, 0x9e, 0x3a, 0x01, 0x7b, 0x1b, 0x8a, 0x64, 0x69, 0x82,
-	0xe6, 0xb6, 0xda, 0x72, 0x64, 0xb6, 0xb8, 0xc4, 0xb2, 0x07, 0x50, 0x49, 0x94, 0xc3, 0x35, 0x1a,
-	0xd0, 0xd5, 0x35, 0xad, 0x43, 0xca, 0x9a, 0x27, 0x64, 0xec, 0x23, 0x00, 0xe9, 0x80, 0x4f, 0xa6,
-	0xe7, 0x94, 0xcf, 0xac, 0xc6, 0x21, 0x88, 0x62, 0xd4, 0x54, 0x37, 0xfd, 0x1d, 0x28, 0xa0, 0x2d,
-	0x08, 0x9a, 0x37, 0xa8, 0x37, 0x3b, 0x69, 0x43, 0x41, 0xa3, 0x23, 0x3c, 0xbb, 0x0b, 0x65, 0x14,
-	0xa1, 0x09, 0x2e, 0x54, 0x53, 0x8d, 0x3c, 0xa4, 0xbc, 0xa1, 0xef, 0x63, 0x9d, 0x8e, 0xbe, 0x77,
-	0xd8, 0x3d, 0xc8, 0x9b, 0xd6, 0x3c, 0x68, 0xde, 0xa4, 0x1a, 0xaf, 0x2b, 0x2b, 0x86, 0xce, 0x40,
-	0xc7, 0x9a, 0x0b, 0x03, 0x82, 0x34, 0xec, 0x00, 0x1a, 0x28, 0x60, 0x0f, 0xc8, 0x9d, 0xc5, 0x29,
-	0x6f, 0xde, 0x22, 0xae, 0xd7, 0xd7, 0xb8, 0x06, 0x92, 0x88, 0x16, 0xa8, 0xeb, 0x86, 0xfe, 0x39,
-	0xaf, 0xbb, 0x2a, 0x8c, 0xdd, 0x82, 0xb2, 0x1d, 0xf4, 0xbd, 0xd9, 0x89, 0x65, 0x36, 0x5f, 0x11,
-	0xe7, 0x13, 0x51, 0x99, 0x7d, 0x09, 0x75, 0x12, 0x39, 0x2c, 0x62, 0xe3, 0xcd, 0xdb, 0xaa, 0x61,
-	0x1b, 0xab, 0x28, 0x9e, 0xa6, 0xbc, 0xb5, 0x4f, 0x61, 0x09, 0xc9, 0xea, 0xa7, 0x6b, 0x86, 0x35,
-	0x25, 0x63, 0x8a, 0x05, 0x3e, 0xd8, 0x52, 0xed, 0xeb, 0x5e, 0x01, 0x72, 0xa6, 0x35, 0xbf, 0xf5,
-	0x4b, 0x60, 0x17, 0x07, 0xf1, 0x32, 0x2b, 0x5f, 0x90, 0x56, 0xfe, 0xab, 0xec, 0x17, 0x19, 0xfd,
-	0x4b, 0xa8, 0xa7, 0xe4, 0x7e, 0xa3, 0x87, 0x23, 0xbc, 0x64, 0x43, 0xe4, 0x8d, 0x6b, 0x5c, 0x14,
-	0xf4, 0xff, 0x94, 0x81, 0xc2, 0x28, 0x34, 0xc2, 0x80, 0xbd, 0x02, 0x95, 0xa9, 0xe3, 0xcd, 0x4e,
-	0x26, 0xee, 0x6a, 0x21, 0x33, 0xb2, 0x65, 0x02, 0xa0, 0xa9, 0x23, 0x27, 0x33, 0x08, 0x89, 0x37,
-	0xc3, 0xe9, 0x1b, 0xb7, 0xbe, 0xb7, 0x0a, 0x67, 0x6e, 0x48, 0x5b, 0x3f, 0xc3, 0x65, 0x09, 0xf5,
-	0xa0, 0xef, 0x9d, 0x52, 0x42, 0x32, 0x4f, 0x88, 0xa8, 0x88, 0x5e, 0xe7, 0x33, 0x23, 0x78, 0xb6,
-	0x30, 0x96, 0x49, 0xbe, 0x32, 0xc3, 0xab, 0x12, 0x36, 0x42, 0x92, 0x57, 0x40, 0xe8, 0x80, 0x09,
-	0xd6, 0x5b, 0x24, 0x7c, 0x99, 0x00, 0x6d, 0x37, 0x44, 0x1d, 0x1c, 0x58, 0x8e, 0x35, 0x0b, 0xed,
-	0x17, 0x18, 0xb8, 0x95, 0x04, 0xbb, 0x02, 0xd2, 0xdf, 0x85, 0x12, 0x2a, 0x19, 0x23, 0x34, 0xd0,
-	0x6c, 0x99, 0x46, 0x68, 0x6c, 0xca, 0x05, 0x23, 0x5c, 0xff, 0x00, 0x80, 0x7b, 0xa7, 0x81, 0x15,
-	0x12, 0xf5, 0xeb, 0x4a, 0x44, 0x15, 0x0b, 0xb0, 0xac, 0x4a, 0x28, 0x2c, 0xfd, 0xbf, 0x66, 0xa0,
-	0x3a, 0xf4, 0x4d, 0xdc, 0x1c, 0xa3, 0xa5, 0x35, 0x7b, 0xa9, 0x5d, 0x44, 0x0d, 0xe6, 0x39, 0x8e,
-	0x11, 0x5b, 0x15, 0xd4, 0x60, 0x11, 0x80, 0x7d, 0x04, 0xf9, 0xb9, 0x63, 0x1c, 0xd3, 0xdc, 0xc5,
-	0xde, 0xb1, 0x52, 0x7d, 0xf4, 0xfd, 0xd0, 0x31, 0x8e, 0x39, 0x91, 0xea, 0x7f, 0x18, 0xb7, 0x4f,
-	0x19, 0x36, 0x35, 0xaf, 0xb6, 0x45, 0xf9, 0xd9, 0x51, 0x5b, 0xcb, 0xb0, 0x32, 0xe4, 0x3b, 0xdd,
-	0x51, 0x5b, 0xf8, 0xc4, 0xe8, 0x1d, 0x8f, 0x26, 0x0f, 0x7b, 0x7c, 0x34, 0xd6, 0xf2, 0x94, 0xf0,
-	0x25, 0x40, 0xbf, 0x35, 0x1a, 0x6b, 0x65, 0x06, 0x50, 0x3c, 0x1a, 0xf4, 0x7e, 0x75, 0xd4, 0xd5,
-	0x34, 0xfd, 0xef, 0x64, 0x00, 0x9e, 0xda, 0xae, 0xe9, 0x9d, 0xd2, 0xe0, 0x7e, 0xa6, 0xf8, 0x3f,
-	0xa8, 0x32, 0x2e, 0xce, 0x62, 0x75, 0x99, 0x68, 0x1b, 0xf6, 0x3e, 0x94, 0x3d, 0xec, 0x1a, 0x92,
-	0x66, 0x55, 0x7d, 0xa1, 0x8c, 0x88, 0x97, 0x3c, 0x51, 0x40, 0x69, 0x72, 0x2c, 0xc3, 0x94, 0x79,
-	0x7c, 0xfa, 0x46, 0x79, 0xc7, 0xe9, 0x10, 0xe7, 0x84, 0xf8, 0xa9, 0xff, 0xfd, 0x2c, 0xec, 0x0c,
-	0xdd, 0xce, 0x6a, 0xe9, 0xd8, 0x33, 0x23, 0xb4, 0x1e, 0x59, 0xe7, 0xed, 0xf0, 0x8c, 0xbd, 0x17,
-	0x09, 0x88, 0x69, 0xcd, 0xe5, 0xd4, 0x37, 0xd2, 0x2a, 0x41, 0x0a, 0x4c, 0x87, 0x32, 0xf2, 0x1a,
-	0xc6, 0x30, 0x51, 0x15, 0x13, 0xdb, 0x3c, 0xa3, 0xee, 0x15, 0x78, 0xc3, 0x4b, 0x6a, 0xee, 0x99,
-	0x67, 0xec, 0x5b, 0xd8, 0x49, 0x51, 0xd2, 0xca, 0xe6, 0x68, 0x24, 0xef, 0x47, 0x29, 0x90, 0xb5,
-	0xae, 0xa8, 0x10, 0x9c, 0x11, 0xa1, 0x7c, 0xb6, 0xbd, 0x34, 0xf4, 0xd6, 0x00, 0xae, 0x6e, 0x22,
-	0xdc, 0xb0, 0xc1, 0x77, 0xd5, 0x0d, 0xbe, 0x16, 0x51, 0x24, 0x9b, 0xfd, 0x4f, 0xb3, 0x50, 0xe9,
-	0xb9, 0x81, 0xe5, 0x87, 0x38, 0x1d, 0xaf, 0x43, 0xce, 0x8f, 0x27, 0xe2, 0x42, 0xde, 0x16, 0x71,
-	0xec, 0x1e, 0xec, 0x18, 0xa6, 0x39, 0x31, 0xe6, 0x73, 0x6b, 0x16, 0x5a, 0xe6, 0x04, 0x77, 0xa3,
-	0x3c, 0x3c, 0xda, 0x36, 0x4c, 0xb3, 0x25, 0xe1, 0xb8, 0x19, 0xa4, 0xff, 0x19, 0x99, 0x0a, 0x91,
-	0x96, 0xc8, 0x45, 0xfe, 0xa7, 0xb4, 0x14, 0x34, 0xcf, 0xe9, 0x75, 0xc8, 0xbf, 0x64, 0x1d, 0xee,
-	0xc3, 0x95, 0x75, 0x77, 0xc5, 0x36, 0x45, 0xea, 0x20, 0xcf, 0x77, 0xd2, 0xde, 0x4a, 0xcf, 0x0c,
-	0xd2, 0xce, 0x2d, 0x2e, 0x5a, 0x51, 0xe6, 0xd7, 0x63, 0x27, 0xcf, 0x3c, 0xa3, 0x64, 0x41, 0x30,
-	0xb1, 0x5c, 0x93, 0x14, 0x01, 0x9d, 0xc1, 0x75, 0x5d, 0x53, 0xff, 0x97, 0x45, 0xa8, 0x88, 0x50,
-	0x32, 0x35, 0x3f, 0xb9, 0x4b, 0xe7, 0xe7, 0x0e, 0xe4, 0x22, 0xb9, 0x88, 0x3d, 0x95, 0x9e, 0xd9,
-	0xb7, 0x83, 0x90, 0x23, 0x82, 0xbd, 0x2f, 0x47, 0xda, 0x41, 0xd3, 0x95, 0x53, 0x4d, 0x73, 0x3c,
-	0xd2, 0x84, 0x00, 0x83, 0x2c, 0x11, 0xf7, 0x52, 0xfa, 0x23, 0xaf, 0xb6, 0xdb, 0xa6, 0x63, 0xac,
-	0xc7, 0xc6, 0x32, 0x3a, 0x48, 0x6c, 0x7b, 0x0e, 0x39, 0x1c, 0xe6, 0xd9, 0x04, 0x3b, 0x59, 0xd8,
-	0xdc, 0xc9, 0xa2, 0x6d, 0x9e, 0xc9, 0x03, 0x33, 0x91, 0x1c, 0x39, 0x23, 0xd7, 0xb0, 0x40, 0x08,
-	0x9c, 0x88, 0xcf, 0x61, 0xdb, 0x73, 0x27, 0xbe, 0x15, 0x84, 0xbe, 0x3d, 0x0b, 0xa9, 0xaa, 0xd2,
-	0xe6, 0xaa, 0xea, 0x9e, 0xcb, 0x25, 0x19, 0xd6, 0xf8, 0x76, 0x9a, 0x11, 0x6b, 0x2e, 0x53, 0xcd,
-	0x0a, 0x1d, 0x36, 0xf0, 0x29, 0x34, 0xd0, 0x0b, 0x37, 0x82, 0x99, 0x61, 0x5a, 0x54, 0x7f, 0x65,
-	0x73, 0xfd, 0x35, 0xcf, 0x6d, 0x0b, 0x2a, 0xac, 0xfe, 0x41, 0x8a, 0x0d, 0x6b, 0x87, 0x0d, 0x73,
-	0x9c, 0xf0, 0x60, 0x53, 0x9f, 0xa4, 0x78, 0x50, 0xb6, 0xaa, 0x1b, 0x67, 0x3c, 0xe1, 0x42, 0xf9,
-	0xda, 0x83, 0x6b, 0x0a, 0x97, 0x32, 0xff, 0xb5, 0xcd, 0xf3, 0xcf, 0x62, 0xee, 0xa3, 0x78, 0x21,
-	0x7e, 0x06, 0xe0, 0xb9, 0x93, 0xc0, 0x12, 0x13, 0x58, 0xdf, 0x3c, 0xc0, 0xb2, 0xe7, 0x8e, 0x2c,
-	0x9a, 0xbb, 0x7b, 0x31, 0x39, 0x0e, 0xac, 0xb1, 0x61, 0x60, 0x82, 0xb6, 0x47, 0x12, 0x14, 0xd1,
-	0xe2, 0x80, 0xb6, 0x37, 0x0e, 0x48, 0x50, 0xe3, 0x60, 0xbe, 0x22, 0x55, 0x84, 0xd4, 0xca, 0x40,
-	0xb4, 0xcd, 0x03, 0x69, 0x10, 0x57, 0x32, 0x88, 0xfb, 0x14, 0x92, 0x5a, 0xae, 0xe8, 0xd5, 0xce,
-	0x25, 0xd2, 0x27, 0x48, 0x7a, 0xe6, 0x99, 0xfe, 0x17, 0x39, 0xa8, 0xb6, 0x5c, 0xc3, 0x39, 0xff,
-	0xb5, 0xd5, 0x73, 0xe7, 0x9e, 0xc8, 0xb4, 0x2d, 0x57, 0xa1, 0x50, 0x12, 0x22, 0xa9, 0x5e, 0x21,
-	0x08, 0xa9, 0x87, 0xd7, 0xa0, 0xea, 0xad, 0xc2, 0x18, 0x2f, 0xd2, 0xec, 0x20, 0x40, 0x44, 0x10,
-	0xf3, 0x93, 0x7d, 0xcf, 0x29, 0xfc, 0x64, 0xdd, 0x13, 0xfe, 0xd8, 0x3d, 0x88, 0xf9, 0x89, 0xe0,
-	0x0d, 0xa8, 0x87, 0xf6, 0x02, 0x87, 0xec, 0x06, 0xab, 0x85, 0x65, 0x8a, 0x6b, 0x18, 0xe2, 0x64,
-	0xbf, 0x2d, 0x61, 0x58, 0xcb, 0xc2, 0x5a, 0x78, 0xfe, 0xb9, 0xa8, 0xa5, 0x28, 0x6a, 0x11, 0x20,
-	0xaa, 0xe5, 0x7d, 0x60, 0xa7, 0x86, 0x1d, 0x4e, 0xd2, 0x55, 0x89, 0x60, 0x5b, 0x43, 0xcc, 0x58,
-	0xad, 0xee, 0x3a, 0x14, 0x4d, 0x3b, 0x38, 0xe9, 0x0d, 0x29, 0xd2, 0xce, 0x71, 0x59, 0x42, 0x57,
-	0x24, 0xf8, 0xb8, 0x37, 0x9c, 0x4c, 0xcf, 0x65, 0x36, 0x3c, 0xc7, 0xcb, 0x08, 0xd8, 0x3b, 0x0f,
-	0x29, 0x8b, 0x48, 0x48, 0x31, 0x5a, 0x3a, 0x70, 0xa3, 0x2c, 0x78, 0x8e, 0x37, 0x10, 0xde, 0x43,
-	0x70, 0x1b, 0xa1, 0xa8, 0x7e, 0x89, 0x52, 0x0e, 0x5c, 0x90, 0x56, 0x89, 0x74, 0x1b, 0x11, 0x43,
-	0x82, 0x0b, 0xda, 0xdb, 0x50, 0x71, 0xad, 0xf0, 0xd4, 0xf3, 0xb1, 0x37, 0x35, 0x31, 0x7b, 0x31,
-	0x00, 0x1d, 0xd9, 0x60, 0x66, 0xb8, 0xd8, 0x79, 0xca, 0x90, 0x63, 0x7f, 0x64, 0x99, 0xdd, 0xc1,
-	0x89, 0x47, 0xa3, 0x40, 0xd8, 0x86, 0x98, 0x92, 0x04, 0xa2, 0xff, 0xf9, 0x0e, 0xe4, 0x07, 0x9e,
-	0x69, 0xb1, 0x0f, 0xa1, 0x42, 0x47, 0xcf, 0x17, 0xd3, 0x38, 0x88, 0xa6, 0x3f, 0xe4, 0xed, 0x96,
-	0x5d, 0xf9, 0x75, 0xf9, 0x61, 0xf5, 0xeb, 0x50, 0x08, 0xd0, 0x75, 0x94, 0x89, 0x19, 0x79, 0x54,
-	0x46, 0xde, 0x24, 0x17, 0x18, 0xec, 0x32, 0x45, 0x3d, 0xbe, 0xe5, 0x92, 0x2e, 0x2c, 0xf0, 0xb8,
-	0x4c, 0x2e, 0x86, 0xef, 0xe1, 0xce, 0x9a, 0xd0, 0xd1, 0x51, 0x61, 0x83, 0x8b, 0x21, 0xf0, 0x74,
-	0xb6, 0xff, 0x21, 0x54, 0x9e, 0x7b, 0xb6, 0x2b, 0x3a, 0x5e, 0xbc, 0xd0, 0xf1, 0x6f, 0x3c, 0x5b,
-	0xe4, 0x9f, 0xca, 0xcf, 0xe5, 0x17, 0x7b, 0x03, 0x4a, 0x9e, 0x2b, 0xea, 0x2e, 0x5d, 0xa8, 0xbb,
-	0xe8, 0xb9, 0x7d, 0x71, 0x24, 0x55, 0x9f, 0xae, 0x30, 0x2e, 0x43, 0x52, 0x6b, 0x1e, 0xca, 0x74,
-	0x4b, 0x95, 0x80, 0x43, 0xb7, 0x6f, 0xcd, 0x43, 0xf6, 0x1e, 0x54, 0xe7, 0xb6, 0x83, 0x16, 0x91,
-	0x2a, 0xab, 0x5c, 0xa8, 0x0c, 0x04, 0x9a, 0x2a, 0x7c, 0x0b, 0xca, 0xc7, 0xbe, 0xb7, 0x5a, 0xa2,
-	0x2b, 0x04, 0x17, 0x13, 0x1c, 0x84, 0xdb, 0x3b, 0xc7, 0xd1, 0xd3, 0xa7, 0xed, 0x1e, 0xe3, 0x5e,
-	0x97, 0x6a, 0x2e, 0x35, 0xfa, 0x08, 0x3f, 0xb2, 0xa8, 0x56, 0xe3, 0xf8, 0x58, 0xb4, 0x5f, 0xbb,
-	0x58, 0xab, 0x71, 0x7c, 0x4c, 0x8d, 0xbf, 0x07, 0xe5, 0x53, 0xdb, 0x9d, 0x04, 0x4b, 0x6b, 0x46,
-	0x22, 0x12, 0x9f, 0xd7, 0x25, 0xae, 0x1d, 0x2f, 0x9d, 0xda, 0x2e, 0xf9, 0x78, 0xaa, 0xd3, 0xd6,
-	0x78, 0xa9, 0xd3, 0xb6, 0x0b, 0x05, 0xc7, 0x5e, 0xd8, 0x21, 0x5d, 0x12, 0x5a, 0xf3, 0x4e, 0x08,
-	0xc1, 0x74, 0x28, 0x7a, 0xf3, 0x39, 0x0e, 0x46, 0xbb, 0x40, 0x22, 0x31, 0xaa, 0x79, 0x0c, 0xcf,
-	0xd2, 0x57, 0x85, 0x62, 0xa3, 0x1d, 0x9b, 0xc7, 0x75, 0x77, 0x8f, 0xbd, 0xc4, 0xcd, 0x78, 0x20,
-	0xa3, 0x39, 0x24, 0x9e, 0xbc, 0xb0, 0x66, 0xcd, 0x2b, 0x1b, 0x55, 0x6d, 0x35, 0x62, 0x78, 0x62,
-	0xcd, 0xd0, 0xfe, 0x7a, 0xd3, 0xe7, 0xa4, 0xf3, 0xaf, 0x6e, 0x76, 0xa2, 0x8a, 0xde, 0xf4, 0x39,
-	0x6a, 0xfc, 0x8f, 0xa0, 0xea, 0x53, 0xc0, 0x30, 0xa1, 0xb8, 0xe2, 0x9a, 0x3a, 0xbd, 0x49, 0x24,
-	0xc1, 0xc1, 0x4f, 0xa2, 0x8a, 0x37, 0xa0, 0x2e, 0x0e, 0x78, 0x44, 0x46, 0x3f, 0xa0, 0xc8, 0xbb,
-	0xc2, 0x6b, 0x04, 0x14, 0xd9, 0x7e, 0xf2, 0x18, 0x44, 0x96, 0x9d, 0xa6, 0xe4, 0x86, 0xda, 0x09,
-	0x91, 0x4e, 0xa7, 0x29, 0x31, 0xa3, 0x4f, 0x8c, 0xa2, 0xa6, 0xb6, 0x6b, 0xa2, 0xe0, 0x84, 0xc6,
-	0x71, 0xd0, 0x6c, 0xd2, 0xbe, 0xaa, 0x4a, 0xd8, 0xd8, 0x38, 0x0e, 0xd8, 0x27, 0x50, 0x33, 0x84,
-	0x56, 0x9f, 0xd8, 0xee, 0xdc, 0x6b, 0xde, 0x54, 0x8f, 0x1a, 0x14, 0x7d, 0xcf, 0xab, 0x86, 0xa2,
-	0xfc, 0x3f, 0x07, 0x16, 0x25, 0x55, 0xc8, 0xff, 0x15, 0xd2, 0x76, 0xeb, 0x82, 0xb4, 0x6d, 0xcb,
-	0xac, 0x4a, 0x7c, 0xed, 0x66, 0x17, 0x30, 0x18, 0x30, 0x1c, 0xc7, 0x72, 0xec, 0x60, 0x41, 0x41,
-	0x76, 0x81, 0xab, 0x20, 0xf6, 0x39, 0xd4, 0xd3, 0x4e, 0xe5, 0xed, 0x0d, 0x29, 0x08, 0x5a, 0x20,
-	0x5e, 0x9b, 0xa9, 0x6e, 0xe6, 0x1b, 0x50, 0x77, 0xbd, 0x70, 0x32, 0x33, 0x66, 0xcf, 0x2c, 0x62,
-	0x7c, 0x95, 0xb6, 0x67, 0xcd, 0xf5, 0xc2, 0x76, 0x04, 0xc3, 0x19, 0x14, 0xaa, 0x8e, 0x66, 0xf0,
-	0x8e, 0x3a, 0x83, 0xb1, 0xa7, 0x8c, 0x66, 0x28, 0x72, 0x9a, 0x5f, 0x83, 0x6a, 0xe0, 0xad, 0xfc,
-	0x99, 0x35, 0x09, 0x42, 0x6b, 0xd9, 0x7c, 0x8d, 0xfa, 0x0b, 0x02, 0x34, 0x0a, 0xad, 0x25, 0xfb,
-	0x02, 0x1a, 0x4b, 0xdf, 0x9a, 0x28, 0xcb, 0xb2, 0xab, 0xf6, 0xf7, 0xd0, 0xb7, 0x92, 0x95, 0xa9,
-	0x2d, 0x95, 0x52, 0xc4, 0xa9, 0x74, 0xe7, 0xf5, 0x35, 0xce, 0xa4, 0x47, 0xc8, 0x99, 0x78, 0xf2,
-	0xbf, 0x80, 0x1d, 0x85, 0x73, 0x75, 0x42, 0xcc, 0x7a, 0x2a, 0xbd, 0x13, 0x91, 0x1f, 0x9d, 0x20,
-	0x7b, 0x63, 0x99, 0x2a, 0xb3, 0xd6, 0x5a, 0xb0, 0x83, 0xd1, 0xc5, 0x1b, 0xc4, 0x7f, 0xe3, 0x92,
-	0x08, 0x26, 0x15, 0x05, 0x3d, 0xb2, 0xce, 0xf5, 0x7f, 0x9c, 0x87, 0x72, 0x64, 0x01, 0x58, 0x15,
-	0x4a, 0x47, 0x83, 0x47, 0x83, 0xe1, 0xd3, 0x81, 0xb6, 0x85, 0xa1, 0xe3, 0x93, 0x56, 0xff, 0xa8,
-	0x3b, 0x19, 0xb5, 0x5b, 0x03, 0x71, 0x77, 0x88, 0x6e, 0x71, 0x88, 0x72, 0x96, 0xed, 0x40, 0xfd,
-	0xe1, 0xd1, 0x80, 0x4e, 0x5b, 0x04, 0x28, 0x87, 0xa0, 0xee, 0xb7, 0x22, 0x3e, 0x15, 0xa0, 0x3c,
-	0x82, 0x1e, 0xb7, 0xc6, 0x5d, 0xde, 0x8b, 0x40, 0x05, 0x6c, 0xe5, 0x90, 0x0f, 0xbf, 0xe9, 0xb6,
-	0xc7, 0x1a, 0xb0, 0x6b, 0xb0, 0x13, 0xb3, 0x44, 0xd5, 0x69, 0x55, 0x8c, 0x74, 0x23, 0x36, 0xed,
-	0x2a, 0x56, 0xc2, 0xbb, 0xed, 0x23, 0x3e, 0xea, 0x3d, 0xe9, 0x4e, 0xda, 0xe3, 0xae, 0x76, 0x0d,
-	0x63, 0xde, 0x51, 0x6f, 0xf0, 0x48, 0xbb, 0xce, 0xea, 0x50, 0xc1, 0x2f, 0x51, 0xfb, 0x0d, 0x8a,
-	0x8a, 0xf7, 0xf7, 0xb5, 0x3b, 0x58, 0x45, 0xa7, 0x37, 0x1a, 0xf7, 0x06, 0xed, 0xb1, 0xf6, 0x1a,
-	0x06, 0xbe, 0x0f, 0x7b, 0xfd, 0x71, 0x97, 0x6b, 0xbb, 0xc8, 0xfb, 0xcd, 0xb0, 0x37, 0xd0, 0x5e,
-	0xa7, 0x0b, 0x28, 0xad, 0xc7, 0x87, 0xfd, 0xae, 0xa6, 0x53, 0x8d, 0x43, 0x3e, 0xd6, 0xde, 0x60,
-	0x15, 0x28, 0x1c, 0x0d, 0xb0, 0x1f, 0x6f, 0x62, 0xe5, 0xf4, 0x39, 0x69, 0xf5, 0xfb, 0xda, 0x5b,
-	0x4a, 0xf8, 0xfc, 0x36, 0x7e, 0x3f, 0xed, 0x0d, 0x3a, 0xc3, 0xa7, 0xda, 0x3b, 0x48, 0xb6, 0xc7,
-	0x87, 0xad, 0x4e, 0x1b, 0xa3, 0xec, 0xbb, 0x58, 0xc1, 0xe8, 0xb0, 0xdf, 0x1b, 0x6b, 0xef, 0x22,
-	0xd5, 0x7e, 0x6b, 0x7c, 0xd0, 0xe5, 0xda, 0x3d, 0xfc, 0x6e, 0x8d, 0x46, 0x5d, 0x3e, 0xd6, 0x1e,
-	0xe0, 0x77, 0x6f, 0x40, 0xdf, 0x1f, 0x53, 0xad, 0x87, 0x9d, 0xd6, 0xb8, 0xab, 0x7d, 0x82, 0xdf,
-	0x9d, 0x6e, 0xbf, 0x3b, 0xee, 0x6a, 0x9f, 0x62, 0xad, 0x14, 0xee, 0x8f, 0x70, 0xaa, 0x3e, 0xc3,
-	0x59, 0x88, 0x8b, 0xd4, 0x9f, 0xcf, 0xb1, 0xa1, 0xc7, 0xbd, 0xc1, 0xd1, 0x48, 0xfb, 0x02, 0x89,
-	0xe9, 0x93, 0x30, 0x5f, 0xb2, 0xab, 0xa0, 0x0d, 0x07, 0x93, 0xce, 0xd1, 0x61, 0xbf, 0xd7, 0x6e,
-	0x8d, 0xbb, 0x93, 0x47, 0xdd, 0xef, 0xb4, 0xaf, 0x70, 0x0d, 0x0f, 0x79, 0x77, 0x22, 0x5b, 0xfe,
-	0x83, 0xa8, 0x2c, 0x5b, 0xfc, 0x1a, 0x9b, 0x48, 0xf0, 0x93, 0xa3, 0x47, 0xda, 0xcf, 0xf5, 0xe7,
-	0x50, 0x8e, 0x0c, 0x2d, 0x36, 0xd7, 0x1b, 0x0c, 0xba, 0x5c, 0xdb, 0xc2, 0xd9, 0xea, 0x77, 0x1f,
-	0x8e, 0xb5, 0x0c, 0x02, 0x79, 0x6f, 0xff, 0x60, 0xac, 0x65, 0xf1, 0x73, 0x78, 0x84, 0x73, 0x9c,
-	0xa3, 0xd9, 0xec, 0x3e, 0xee, 0x69, 0x79, 0xfc, 0x6a, 0x0d, 0xc6, 0x3d, 0xad, 0x40, 0xb3, 0xdd,
-	0x1b, 0xec, 0xf7, 0xbb, 0x5a, 0x11, 0xa1, 0x8f, 0x5b, 0xfc, 0x91, 0x56, 0x42, 0xa6, 0xd6, 0xe1,
-	0x61, 0xff, 0x3b, 0xad, 0xac, 0xdf, 0x85, 0x52, 0xeb, 0xf8, 0xf8, 0x31, 0x3a, 0x2d, 0x65, 0xc8,
-	0x3f, 0x3c, 0xea, 0xf7, 0xc5, 0xfd, 0xb5, 0xbd, 0xe1, 0x78, 0x3c, 0x7c, 0xac, 0x65, 0x70, 0x71,
-	0xc7, 0xc3, 0x43, 0x2d, 0xab, 0xff, 0xdd, 0x0c, 0x34, 0xd2, 0x9b, 0x43, 0x24, 0xe3, 0x93, 0x53,
-	0x86, 0x42, 0x72, 0xb2, 0xf0, 0x0a, 0x54, 0x96, 0x27, 0xf2, 0x48, 0x41, 0x3a, 0x34, 0xe5, 0xe5,
-	0x89, 0x38, 0x4a, 0x40, 0x97, 0x61, 0x79, 0x22, 0x5c, 0x8c, 0xdc, 0x85, 0x1b, 0x18, 0xc5, 0xe5,
-	0x49, 0xe4, 0x57, 0xac, 0x24, 0x51, 0xfe, 0x22, 0xd1, 0x8a, 0x88, 0xf4, 0x5d, 0xa8, 0xa9, 0x6a,
-	0x02, 0xc3, 0x7d, 0x74, 0xc9, 0x45, 0x67, 0xf0, 0x53, 0xff, 0xd3, 0x0c, 0x91, 0xfc, 0xa8, 0x58,
-	0x3e, 0x65, 0x0e, 0xb3, 0x2f, 0x31, 0x87, 0xbb, 0x94, 0x6e, 0x9b, 0xd0, 0xed, 0x6c, 0x8c, 0x21,
-	0x44, 0x20, 0x0f, 0xcf, 0x8c, 0xa0, 0xb5, 0x0a, 0x3d, 0x0c, 0x17, 0x5e, 0x81, 0x8a, 0x1d, 0x44,
-	0xe7, 0xb4, 0xf9, 0x28, 0x37, 0x2a, 0x0f, 0x62, 0x6f, 0x43, 0x51, 0x44, 0x32, 0x94, 0xaf, 0x89,
-	0xae, 0x55, 0xe6, 0xe4, 0x55, 0x4a, 0x0f, 0x2a, 0x71, 0x44, 0xc1, 0xee, 0x41, 0x6e, 0x61, 0x2c,
-	0x65, 0x94, 0xdd, 0x5c, 0x8b, 0x37, 0xee, 0x3f, 0x36, 0x96, 0x22, 0x37, 0x82, 0x44, 0xb7, 0x3e,
-	0x83, 0x72, 0x04, 0xf8, 0x51, 0x49, 0xce, 0x7f, 0x9d, 0x85, 0x4a, 0x47, 0x35, 0x82, 0x33, 0xc3,
-	0x9d, 0x84, 0xfe, 0xca, 0x45, 0xe5, 0x25, 0xef, 0x4e, 0x54, 0xd1, 0x1d, 0x96, 0xa0, 0x68, 0x3a,
-	0xb3, 0xbf, 0x63, 0x3a, 0x6f, 0x03, 0x5a, 0xeb, 0x89, 0x6d, 0x52, 0xb8, 0x24, 0xd2, 0x51, 0x65,
-	0xdf, 0x3b, 0xed, 0x99, 0x18, 0xb6, 0x6d, 0x4c, 0x9c, 0xe4, 0x7f, 0x78, 0xe2, 0xa4, 0xb0, 0x31,
-	0x71, 0x72, 0x49, 0x2e, 0xa4, 0xf8, 0x83, 0x73, 0x21, 0xa5, 0xdf, 0x99, 0x0b, 0x29, 0xa7, 0x72,
-	0x21, 0x59, 0x28, 0xfc, 0x6a, 0x65, 0xf9, 0xe7, 0xec, 0x33, 0xa8, 0x04, 0xe1, 0x22, 0x54, 0xdd,
-	0xfe, 0x9b, 0x62, 0x4a, 0x08, 0x4f, 0x5e, 0xbb, 0xb5, 0xb0, 0xdc, 0x50, 0xf8, 0xd0, 0x48, 0x4b,
-	0xb2, 0x7e, 0x15, 0x7d, 0x7c, 0x6b, 0x19, 0xc8, 0xb4, 0x99, 0x28, 0xa0, 0x2f, 0x88, 0x31, 0x40,
-	0x94, 0x0e, 0x81, 0xc4, 0x0f, 0xe7, 0x02, 0x81, 0xbe, 0x20, 0x25, 0x98, 0xa3, 0x13, 0xa0, 0x94,
-	0x2f, 0x28, 0x30, 0x18, 0x1c, 0x3c, 0xb3, 0x0c, 0x74, 0x5a, 0xa2, 0x5b, 0x24, 0x71, 0x19, 0xf7,
-	0xaf, 0xe3, 0x19, 0xe6, 0xd8, 0x38, 0x8e, 0xee, 0x39, 0xc9, 0xa2, 0xfe, 0x14, 0xea, 0xa9, 0xce,
-	0xa6, 0xed, 0x14, 0x6a, 0x95, 0x6e, 0x1f, 0x55, 0x64, 0x46, 0xd1, 0xaa, 0x59, 0x45, 0x93, 0xe6,
-	0x14, 0x0d, 0x9b, 0x27, 0x9d, 0xd9, 0xe5, 0xfb, 0x5d, 0xad, 0xa0, 0xff, 0xb3, 0x2c, 0xec, 0x8c,
-	0x7d, 0xc3, 0x0d, 0x0c, 0x71, 0xb6, 0xe8, 0x86, 0xbe, 0xe7, 0xb0, 0xaf, 0xa0, 0x1c, 0xce, 0x1c,
-	0x75, 0xde, 0x5e, 0x93, 0x1b, 0x6e, 0x9d, 0xf4, 0xfe, 0x78, 0xe6, 0xd0, 0xec, 0x95, 0x42, 0xf1,
-	0xc1, 0x7e, 0x06, 0x85, 0xa9, 0x75, 0x6c, 0xbb, 0x52, 0x06, 0xaf, 0xad, 0x33, 0xee, 0x21, 0xf2,
-	0x60, 0x8b, 0x0b, 0x2a, 0xf6, 0x21, 0x14, 0x67, 0xde, 0x02, 0x5d, 0xec, 0x9c, 0x7a, 0x5a, 0xad,
-	0x36, 0x84, 0xd8, 0x83, 0x2d, 0x2e, 0xe9, 0xd8, 0x67, 0x50, 0xf6, 0x3d, 0xc7, 0x99, 0x1a, 0xb3,
-	0x13, 0xa9, 0x8a, 0x9a, 0xeb, 0x3c, 0x5c, 0xe2, 0x0f, 0xb6, 0x78, 0x4c, 0xab, 0xdf, 0x87, 0x92,
-	0xec, 0x2c, 0x4e, 0xc0, 0x5e, 0x77, 0xbf, 0x27, 0xe7, 0xae, 0x3d, 0x7c, 0xfc, 0xb8, 0x37, 0x16,
-	0xb7, 0x2b, 0xf8, 0xb0, 0xdf, 0xdf, 0x6b, 0xb5, 0x1f, 0x69, 0xd9, 0xbd, 0x32, 0x14, 0x0d, 0x3a,
-	0x59, 0xd0, 0xff, 0x66, 0x06, 0xb6, 0xd7, 0x06, 0xc0, 0xbe, 0x80, 0xfc, 0xc2, 0x33, 0xa3, 0xe9,
-	0x79, 0x73, 0xe3, 0x28, 0x95, 0x32, 0x6a, 0x74, 0x4e, 0x1c, 0xfa, 0x97, 0xd0, 0x48, 0xc3, 0x95,
-	0x6b, 0xa3, 0x75, 0xa8, 0xf0, 0x6e, 0xab, 0x33, 0x19, 0x0e, 0xfa, 0xdf, 0x09, 0x87, 0x83, 0x8a,
-	0x4f, 0x79, 0x6f, 0xdc, 0xd5, 0xb2, 0xfa, 0x1f, 0x82, 0xb6, 0x3e, 0x31, 0x6c, 0x1f, 0xb6, 0x67,
-	0xde, 0x62, 0xe9, 0x58, 0x62, 0x6f, 0x25, 0x4b, 0x76, 0x67, 0xc3, 0x4c, 0x4a, 0x32, 0x5a, 0xb1,
-	0xc6, 0x2c, 0x55, 0xd6, 0xff, 0x3a, 0xb0, 0x8b, 0x33, 0xf8, 0xfb, 0xab, 0xfe, 0xbf, 0x67, 0x20,
-	0x7f, 0xe8, 0x18, 0x68, 0x6e, 0x0a, 0x74, 0x25, 0x53, 0xea, 0xfc, 0xaa, 0xb2, 0x23, 0x51, 0x2c,
-	0x08, 0xc7, 0xde, 0x83, 0x5c, 0x38, 0x73, 0xa4, 0x0c, 0xdd, 0xb8, 0x44, 0xf8, 0x0e, 0xb6, 0x38,
-	0x52, 0xb1, 0xbb, 0x90, 0x33, 0x4d, 0x47, 0x0a, 0x90, 0xf4, 0x1b, 0x31, 0x14, 0xe9, 0x58, 0x73,
-	0xdb, 0xb5, 0xe5, 0x05, 0x51, 0x24, 0x61, 0x6f, 0x41, 0xce, 0x9c, 0x39, 0x52, 0x6c, 0x76, 0x12,
-	0x4a, 0xa5, 0x42, 0x73, 0xe6, 0xb0, 0xfb, 0x50, 0x6b, 0x85, 0x21, 0xba, 0xda, 0x26, 0x76, 0x39,
-	0x7d, 0x31, 0x11, 0x21, 0x3c, 0x85, 0xdf, 0x2b, 0x02, 0x3d, 0x40, 0xd2, 0xdf, 0xa7, 0x0b, 0x93,
-	0x68, 0x53, 0xf5, 0xe8, 0x6b, 0xc3, 0x21, 0x82, 0xc4, 0xe8, 0xff, 0x27, 0x0b, 0x55, 0xa5, 0x71,
-	0xf6, 0x09, 0x94, 0xcd, 0xf4, 0xae, 0xbb, 0x79, 0xa1, 0x87, 0xf7, 0x3b, 0xd1, 0x7e, 0x33, 0xa5,
-	0x2c, 0x7f, 0x09, 0x75, 0x0c, 0xcf, 0x5e, 0x18, 0xbe, 0x8d, 0xda, 0x33, 0x90, 0x73, 0x26, 0x7d,
-	0xef, 0x91, 0x15, 0x3e, 0x89, 0x30, 0x07, 0x5b, 0xbc, 0x16, 0x28, 0x65, 0xf6, 0x2e, 0x94, 0x96,
-	0xbe, 0xb5, 0x34, 0xfc, 0xc8, 0xf0, 0xd7, 0x63, 0x9f, 0x1b, 0x81, 0x07, 0x5b, 0x3c, 0xc2, 0x23,
-	0xa9, 0x75, 0x66, 0xcd, 0x56, 0x61, 0x64, 0xfe, 0xeb, 0xd1, 0x80, 0x08, 0x88, 0xa4, 0x12, 0xcf,
-	0x1e, 0x60, 0x68, 0x67, 0x38, 0x8e, 0x47, 0x36, 0xaa, 0xa0, 0x46, 0x8c, 0x9d, 0x18, 0x2e, 0x5e,
-	0x9e, 0x44, 0x25, 0xfd, 0x18, 0x4a, 0x72, 0x60, 0xe8, 0x80, 0x8d, 0xba, 0xe3, 0xc9, 0x93, 0x16,
-	0xef, 0xa1, 0xaf, 0x3d, 0xd2, 0xb6, 0x70, 0xbb, 0xee, 0xf3, 0xd6, 0x40, 0xaa, 0x37, 0xde, 0x7d,
-	0x32, 0x7c, 0xd4, 0xd5, 0xb2, 0xe2, 0xd0, 0x67, 0xf0, 0x9d, 0x96, 0x13, 0xfe, 0x74, 0xf7, 0xb0,
-	0xc5, 0x51, 0xbb, 0x55, 0xa1, 0xd4, 0xfd, 0xb6, 0xdb, 0x3e, 0x1a, 0x77, 0xb5, 0x02, 0xee, 0xa0,
-	0x4e, 0xb7, 0xd5, 0xef, 0x0f, 0xd1, 0x05, 0xd4, 0x8a, 0x7b, 0x15, 0x74, 0x91, 0x68, 0x26, 0xf5,
-	0x7f, 0x5b, 0x87, 0x46, 0x5a, 0x4a, 0xd8, 0xe7, 0x50, 0x36, 0xcd, 0xd4, 0x0a, 0xdc, 0xde, 0x24,
-	0x4d, 0xf7, 0x3b, 0x66, 0xb4, 0x08, 0xe2, 0x83, 0xbd, 0x1e, 0xc9, 0x74, 0xf6, 0x82, 0x4c, 0x47,
-	0x12, 0xfd, 0x0b, 0xd8, 0x96, 0xd7, 0x1f, 0x31, 0x92, 0x9e, 0x1a, 0x81, 0x95, 0x16, 0xd8, 0x36,
-	0x21, 0x3b, 0x12, 0x77, 0xb0, 0xc5, 0x1b, 0xb3, 0x14, 0x84, 0x7d, 0x0d, 0x0d, 0x83, 0xf2, 0x31,
-	0x31, 0x7f, 0x5e, 0x3d, 0x74, 0x6d, 0x21, 0x4e, 0x61, 0xaf, 0x1b, 0x2a, 0x00, 0xc5, 0xc4, 0xf4,
-	0xbd, 0x65, 0xc2, 0x5c, 0x50, 0xc5, 0xa4, 0xe3, 0x7b, 0x4b, 0x85, 0xb7, 0x66, 0x2a, 0x65, 0xf6,
-	0x19, 0xd4, 0x64, 0xcf, 0x93, 0xa7, 0x6a, 0xf1, 0xee, 0x11, 0xdd, 0x26, 0xc3, 0x7d, 0xb0, 0xc5,
-	0xab, 0xb3, 0xa4, 0xc8, 0x3e, 0x86, 0xaa, 0xe8, 0xb0, 0x60, 0x2b, 0xa9, 0x92, 0x40, 0xbd, 0x8d,
-	0xb8, 0xc0, 0x88, 0x4b, 0xec, 0x43, 0x00, 0xea, 0xa7, 0xe0, 0x29, 0xa7, 0x12, 0x03, 0xbe, 0xb7,
-	0x8c, 0x58, 0x2a, 0x66, 0x54, 0x50, 0xba, 0x27, 0x8e, 0xcc, 0x2b, 0x17, 0xbb, 0x47, 0x47, 0xcc,
-	0x49, 0xf7, 0xc4, 0x11, 0x79, 0xdc, 0x3d, 0xc1, 0x06, 0x17, 0xba, 0x17, 0x71, 0x89, 0xee, 0x09,
-	0xa6, 0xa8, 0x7b, 0x82, 0xa7, 0xba, 0xde, 0xbd, 0x88, 0x85, 0xba, 0x27, 0x38, 0xbe, 0x86, 0x46,
-	0xe4, 0xb0, 0xc9, 0x41, 0xd5, 0x52, 0x77, 0x37, 0x24, 0x2e, 0x1a, 0x58, 0x3d, 0x54, 0x01, 0xc8,
-	0x1d, 0x3c, 0xf3, 0x4e, 0x95, 0xed, 0x5d, 0x57, 0xb9, 0x47, 0xcf, 0xbc, 0x53, 0x75, 0x7f, 0xd7,
-	0x03, 0x15, 0x80, 0xbd, 0x15, 0x43, 0xa4, 0xab, 0x2f, 0x0d, 0xb5, 0xb7, 0x34, 0xc2, 0x27, 0xb6,
-	0x75, 0x8a, 0xbd, 0x35, 0xa2, 0x02, 0x4e, 0x0a, 0x9d, 0x87, 0x87, 0xa2, 0xb1, 0x6d, 0x75, 0x52,
-	0xe8, 0x16, 0x40, 0xd4, 0x12, 0x38, 0x71, 0x09, 0x65, 0x6b, 0xe5, 0xaa, 0x6c, 0x9a, 0x2a, 0x5b,
-	0x47, 0x6e, 0x8a, 0xb1, 0x26, 0x48, 0x25, 0x6b, 0xb2, 0x2b, 0x02, 0xeb, 0xfb, 0x95, 0xe5, 0xce,
-	0x2c, 0x99, 0x1f, 0x4b, 0xed, 0x8a, 0x91, 0xc4, 0x25, 0xbb, 0x22, 0x82, 0xc4, 0x72, 0x1d, 0xb3,
-	0xb3, 0x75, 0xb9, 0x56, 0x98, 0x49, 0xae, 0x63, 0xd6, 0x78, 0x43, 0xc5, 0xbc, 0x57, 0x2e, 0x6c,
-	0x28, 0x85, 0x59, 0x6c, 0xa8, 0x08, 0xa0, 0xff, 0xef, 0x3c, 0x94, 0xa4, 0x1e, 0x60, 0x57, 0x60,
-	0xbb, 0xcd, 0xbb, 0x18, 0x64, 0x76, 0x5a, 0xe3, 0xd6, 0x5e, 0x6b, 0x84, 0xb6, 0x9c, 0x41, 0xa3,
-	0x85, 0xe1, 0x76, 0x02, 0xcb, 0xa0, 0x72, 0xeb, 0xf0, 0xe1, 0x61, 0x02, 0xca, 0x32, 0x0d, 0x6a,
-	0x92, 0x57, 0xbc, 0x10, 0xc9, 0xb1, 0x6d, 0xa8, 0x0a, 0x46, 0x01, 0xa0, 0x23, 0x6c, 0xe2, 0x12,
-	0xe5, 0x82, 0xc2, 0xd2, 0x1b, 0x74, 0xba, 0xdf, 0x6a, 0xc5, 0x84, 0x45, 0x00, 0x4a, 0x31, 0x8b,
-	0x28, 0x97, 0xb1, 0x33, 0x63, 0x7e, 0x34, 0x68, 0x27, 0xed, 0x54, 0x90, 0x49, 0x56, 0xf3, 0xa4,
-	0xd7, 0x7d, 0xaa, 0x01, 0x32, 0x89, 0x5a, 0xa8, 0x5c, 0x45, 0x6f, 0x84, 0x2a, 0xa1, 0x62, 0x8d,
-	0xdd, 0x80, 0x2b, 0xa3, 0x83, 0xe1, 0xd3, 0x89, 0x60, 0x8a, 0x87, 0x50, 0xc7, 0x48, 0x5b, 0x41,
-	0x88, 0xea, 0x1b, 0xd8, 0x24, 0x41, 0x23, 0xc2, 0x91, 0xb6, 0x4d, 0x37, 0x56, 0x11, 0x36, 0x16,
-	0xaa, 0x5d, 0xc3, 0xa1, 0x08, 0xd6, 0x61, 0xff, 0xe8, 0xf1, 0x60, 0xa4, 0xed, 0x60, 0x27, 0x08,
-	0x22, 0x7a, 0xce, 0xe2, 0x6a, 0x12, 0x83, 0x70, 0x85, 0x6c, 0x04, 0xc2, 0x9e, 0xb6, 0xf8, 0xa0,
-	0x37, 0xd8, 0x1f, 0x69, 0x57, 0xe3, 0x9a, 0xbb, 0x9c, 0x0f, 0xf9, 0x48, 0xbb, 0x16, 0x03, 0x46,
-	0xe3, 0xd6, 0xf8, 0x68, 0xa4, 0x5d, 0x8f, 0x7b, 0x79, 0xc8, 0x87, 0xed, 0xee, 0x68, 0xd4, 0xef,
-	0x8d, 0xc6, 0xda, 0x0d, 0x76, 0x0d, 0x76, 0x92, 0x1e, 0x45, 0xc4, 0x4d, 0xa5, 0xa3, 0x7c, 0xbf,
-	0x3b, 0xd6, 0x6e, 0xc6, 0xdd, 0x68, 0x0f, 0xfb, 0xfd, 0x16, 0xa5, 0x68, 0x6e, 0x21, 0x51, 0x7f,
-	0xd8, 0x7e, 0x14, 0x8d, 0xe6, 0x15, 0xec, 0xd7, 0xd1, 0x40, 0x05, 0xdd, 0x56, 0x44, 0x63, 0xd4,
-	0xfd, 0xd5, 0x51, 0x77, 0xd0, 0xee, 0x6a, 0xaf, 0x26, 0xa2, 0x11, 0xc3, 0xee, 0xc4, 0xa2, 0x11,
-	0x83, 0x5e, 0x8b, 0xdb, 0x8c, 0x40, 0x23, 0x6d, 0x77, 0xaf, 0x46, 0xaf, 0x38, 0xa5, 0x21, 0xd2,
-	0xbf, 0x01, 0xa6, 0xbe, 0xb6, 0x92, 0x37, 0xed, 0x19, 0xe4, 0xe7, 0xbe, 0xb7, 0x88, 0x6e, 0xc2,
-	0xe0, 0x37, 0xa5, 0x2b, 0x57, 0x53, 0xca, 0x7a, 0x25, 0x57, 0x33, 0x54, 0x90, 0xfe, 0x27, 0x19,
-	0x68, 0xa4, 0x8d, 0x10, 0xd3, 0xa1, 0x6e, 0xcf, 0x27, 0xae, 0x17, 0x8a, 0xdb, 0xe0, 0x41, 0x14,
-	0x71, 0xda, 0xf3, 0x81, 0x17, 0xd2, 0x75, 0x70, 0x0a, 0x68, 0x62, 0x9b, 0x22, 0x6a, 0x8d, 0xcb,
-	0xac, 0x07, 0x57, 0x52, 0x0f, 0xcc, 0x52, 0x77, 0xf1, 0x9b, 0xf1, 0x0b, 0x9d, 0xb5, 0xfe, 0x73,
-	0x16, 0x5c, 0x80, 0xe9, 0x07, 0x50, 0x4f, 0x59, 0x38, 0x0a, 0xe3, 0xe7, 0xe9, 0x7e, 0x95, 0xed,
-	0xf9, 0xcb, 0x3b, 0xa5, 0xef, 0x43, 0x4d, 0x35, 0x77, 0x3f, 0xbd, 0xa2, 0xd7, 0xa0, 0xf2, 0xf0,
-	0x24, 0x7a, 0x1a, 0xa0, 0xbe, 0x4e, 0xa8, 0xc8, 0xcb, 0x33, 0xff, 0x33, 0x0b, 0x55, 0xc5, 0x3e,
-	0xfe, 0xa0, 0xe9, 0xbc, 0x0d, 0x95, 0xd0, 0x5a, 0x2c, 0x3d, 0xdf, 0x90, 0xde, 0x44, 0x99, 0x27,
-	0x80, 0x54, 0x77, 0x72, 0x6b, 0x93, 0xfd, 0xa3, 0x2e, 0x27, 0x7c, 0x04, 0x35, 0xe5, 0x41, 0x40,
-	0x20, 0xcf, 0xa1, 0x2e, 0x1c, 0x1a, 0x24, 0x8f, 0x03, 0x02, 0x0c, 0xb7, 0xe7, 0x27, 0x13, 0x73,
-	0x2a, 0xc2, 0xf6, 0x0a, 0x2f, 0xcc, 0x4f, 0x3a, 0x53, 0x4a, 0x2d, 0xcd, 0x63, 0xc5, 0x5f, 0x12,
-	0x11, 0xed, 0x3c, 0x52, 0xef, 0x77, 0xa1, 0x34, 0x3f, 0x11, 0xb7, 0xed, 0xcb, 0xea, 0xb9, 0x6c,
-	0x3c, 0x6f, 0xbc, 0x38, 0x3f, 0xa1, 0x9b, 0xf7, 0x5f, 0x82, 0xb6, 0x96, 0x21, 0x08, 0xe4, 0x99,
-	0xd3, 0x7a, 0xa7, 0xb6, 0xd3, 0xe9, 0x82, 0x40, 0xff, 0xf7, 0x19, 0x68, 0x24, 0xfe, 0x04, 0xae,
-	0x2d, 0xbb, 0xa7, 0xbe, 0x4a, 0x6d, 0xae, 0xbb, 0x1c, 0x48, 0x72, 0x7f, 0x7c, 0xbe, 0x14, 0xcf,
-	0x8b, 0x36, 0xdd, 0xf0, 0xdc, 0xf4, 0x5e, 0x22, 0xb7, 0xe9, 0xbd, 0x84, 0xbe, 0x0f, 0xb9, 0xf1,
-	0xf9, 0x52, 0x84, 0x91, 0xa8, 0xc2, 0x84, 0xbb, 0x2a, 0x94, 0x17, 0x65, 0xeb, 0x1e, 0x75, 0xbf,
-	0x13, 0xd7, 0x92, 0x0e, 0x79, 0xef, 0x71, 0x8b, 0x7f, 0x47, 0x79, 0x48, 0x52, 0xf2, 0x0f, 0x87,
-	0xbc, 0xdb, 0xdb, 0x1f, 0x10, 0x20, 0x4f, 0x41, 0x66, 0xd2, 0xc5, 0x96, 0x69, 0x3e, 0x3c, 0x51,
-	0x5f, 0x41, 0x66, 0x52, 0xaf, 0x20, 0xe3, 0x7b, 0xa4, 0xea, 0xe3, 0x90, 0x30, 0x7e, 0xc4, 0x11,
-	0x09, 0x63, 0x2e, 0x11, 0x46, 0xf6, 0x0e, 0xe4, 0xe7, 0x27, 0xd6, 0x79, 0xda, 0x69, 0x4c, 0xdf,
-	0xdc, 0x24, 0x02, 0xfd, 0xb7, 0x19, 0x60, 0xa9, 0x8e, 0x08, 0x3f, 0xe6, 0xa7, 0xf6, 0xe5, 0x73,
-	0x68, 0xca, 0xa7, 0x42, 0x82, 0x4a, 0xbe, 0x7b, 0xa2, 0x4c, 0xbd, 0x98, 0xd2, 0x6b, 0x02, 0x4f,
-	0xcd, 0x25, 0xd7, 0x53, 0xd9, 0x07, 0x20, 0x9e, 0xbb, 0xf4, 0xdc, 0xb9, 0x97, 0x8e, 0xd8, 0x94,
-	0x3d, 0xc5, 0x13, 0x1a, 0x76, 0x0f, 0x76, 0xd4, 0x45, 0x13, 0x0f, 0x58, 0x44, 0x3e, 0x6a, 0x3b,
-	0x59, 0x35, 0xda, 0x67, 0xfa, 0x1f, 0x67, 0xe0, 0x4a, 0x5a, 0x20, 0xfe, 0x6a, 0xa3, 0x4c, 0xbf,
-	0xd6, 0xc9, 0xad, 0xbf, 0xd6, 0xd9, 0x24, 0x4f, 0xf9, 0x8d, 0xf2, 0xf4, 0xb7, 0x32, 0x70, 0x55,
-	0x99, 0xfd, 0xc4, 0xf3, 0xfc, 0x7f, 0xd4, 0x33, 0xe5, 0xd1, 0x4e, 0x3e, 0xf5, 0x68, 0x47, 0xff,
-	0x93, 0x1c, 0x40, 0xd2, 0x93, 0x94, 0xea, 0xc9, 0xfc, 0x2e, 0xd5, 0x93, 0x7d, 0xf9, 0xfd, 0xb4,
-	0x1f, 0x78, 0xdd, 0xea, 0x23, 0x28, 0x89, 0x0c, 0x4c, 0x94, 0x50, 0xbb, 0xb1, 0xbe, 0x93, 0xef,
-	0xcb, 0x97, 0x34, 0x11, 0xdd, 0xad, 0xbf, 0xc8, 0x40, 0x51, 0x3e, 0xa3, 0xb9, 0x07, 0x79, 0x74,
-	0xf7, 0x64, 0x9a, 0xe1, 0xea, 0x26, 0x25, 0x40, 0x3f, 0x36, 0x81, 0xfa, 0xe2, 0x3e, 0x14, 0x0d,
-	0xd3, 0x9c, 0xcc, 0x4f, 0xd2, 0x59, 0xab, 0xb5, 0xfd, 0x78, 0xb0, 0xc5, 0x0b, 0x06, 0x6d, 0xcc,
-	0xcf, 0xa1, 0x82, 0xf4, 0x22, 0x0a, 0x48, 0x99, 0xb3, 0x8b, 0x3b, 0xe7, 0x60, 0x8b, 0x97, 0x8d,
-	0x68, 0x17, 0xfd, 0x3c, 0x1d, 0x74, 0x08, 0xb1, 0xbe, 0x75, 0x81, 0xf5, 0x92, 0xf0, 0x43, 0xc9,
-	0x49, 0xfd, 0xab, 0x2c, 0x54, 0xe2, 0x80, 0xe8, 0x27, 0xdb, 0xb0, 0xe4, 0xf7, 0x47, 0x72, 0xca,
-	0xef, 0x8f, 0xac, 0xef, 0x24, 0xf1, 0x7c, 0x22, 0x4f, 0xca, 0x64, 0x3b, 0x2d, 0xaf, 0xc1, 0xc5,
-	0x53, 0xce, 0xc2, 0x0f, 0x3c, 0xe5, 0xbc, 0x09, 0xe5, 0x28, 0x13, 0x4c, 0x21, 0x65, 0x9e, 0x97,
-	0x42, 0x91, 0xff, 0x5d, 0x7f, 0xca, 0x55, 0xa2, 0x34, 0xb1, 0xfa, 0x94, 0xeb, 0xd2, 0x37, 0x1e,
-	0xe5, 0xcb, 0xdf, 0x78, 0x7c, 0x0f, 0x95, 0x38, 0xe8, 0xf9, 0xe9, 0x13, 0xf6, 0x63, 0xac, 0xac,
-	0xfe, 0x47, 0x91, 0x47, 0x15, 0xc7, 0x1c, 0x7f, 0x55, 0x8f, 0x2a, 0xd5, 0x7c, 0xee, 0x25, 0xcd,
-	0x9f, 0x09, 0x4f, 0x27, 0x6e, 0xfc, 0xf7, 0x2c, 0x25, 0xea, 0x02, 0xe6, 0x53, 0x0b, 0xa8, 0x6f,
-	0x4b, 0x6f, 0x2d, 0x8e, 0x96, 0xfe, 0x5d, 0x26, 0x72, 0x85, 0xe2, 0xfb, 0xe9, 0x97, 0x6a, 0x93,
-	0xb8, 0xb5, 0xac, 0xda, 0xda, 0x4f, 0xb6, 0x23, 0xef, 0x40, 0x41, 0xdd, 0x6c, 0x1b, 0x6c, 0x88,
-	0xc0, 0xaf, 0x3f, 0x7d, 0x2c, 0xac, 0x3f, 0x7d, 0xd4, 0x75, 0xa9, 0x10, 0xc5, 0x10, 0xae, 0x46,
-	0xf5, 0x46, 0xcf, 0x36, 0xb1, 0x80, 0x66, 0xbc, 0x92, 0x98, 0x93, 0x1f, 0x3f, 0xcc, 0xdf, 0x9b,
-	0x21, 0xf9, 0xe3, 0x2c, 0xd4, 0x53, 0xc9, 0x85, 0x9f, 0xd0, 0x99, 0x8d, 0x7a, 0x20, 0xb7, 0x59,
-	0x0f, 0x5c, 0xba, 0x25, 0xf3, 0x97, 0x6e, 0xc9, 0xff, 0x2f, 0xba, 0x43, 0xff, 0x7b, 0x99, 0xf8,
-	0x51, 0xa3, 0xa8, 0x6c, 0x93, 0x41, 0xca, 0x6c, 0x34, 0x48, 0x77, 0xe2, 0x1f, 0xad, 0xe8, 0x75,
-	0xc4, 0xe9, 0x50, 0x9d, 0x2b, 0x10, 0xf6, 0x25, 0xdc, 0x14, 0xb9, 0x5d, 0xa1, 0xde, 0x27, 0xde,
-	0x7c, 0x12, 0xff, 0xa4, 0x85, 0x3c, 0x69, 0xbb, 0x2e, 0x08, 0xc4, 0xd3, 0xd7, 0x79, 0xf2, 0xc3,
-	0x19, 0x3d, 0xa8, 0xa7, 0x92, 0x39, 0xca, 0x6f, 0xdb, 0x64, 0xd4, 0xdf, 0xb6, 0x61, 0xbb, 0x50,
-	0x38, 0x7d, 0x66, 0xf9, 0xd6, 0x86, 0x5f, 0xa4, 0x10, 0x08, 0xfd, 0x6b, 0xa8, 0xa9, 0x69, 0x5f,
-	0xf6, 0x3e, 0x14, 0xec, 0xd0, 0x5a, 0x44, 0xf7, 0xfc, 0xaf, 0x5f, 0xcc, 0x0c, 0xd3, 0x83, 0x3d,
-	0x41, 0xa4, 0xff, 0x59, 0x06, 0xb4, 0x75, 0x9c, 0xf2, 0x03, 0x3c, 0x99, 0x4b, 0x7e, 0x80, 0x27,
-	0x9b, 0xea, 0xe4, 0x86, 0x1f, 0xd1, 0x49, 0x6e, 0x7a, 0xe7, 0x2f, 0xb9, 0xe9, 0xcd, 0xde, 0x86,
-	0xb2, 0x6f, 0xd1, 0x8f, 0x9e, 0x98, 0xe9, 0x94, 0xbb, 0x78, 0x08, 0x16, 0xe1, 0xf4, 0xbf, 0x9d,
-	0x81, 0x92, 0xcc, 0x51, 0x6f, 0x7c, 0xf5, 0xf1, 0x2e, 0x94, 0xc4, 0x0f, 0xa0, 0x44, 0x3f, 0xdb,
-	0x71, 0xe1, 0x20, 0x34, 0xc2, 0xb3, 0x3b, 0x22, 0x73, 0x9f, 0x3e, 0xf8, 0xa6, 0x0c, 0x3f, 0xc1,
-	0x51, 0x9a, 0xe8, 0xe0, 0x8e, 0x72, 0xc2, 0x81, 0xbc, 0xce, 0x07, 0x04, 0x1a, 0x23, 0x44, 0xff,
-	0x39, 0x94, 0x64, 0x0e, 0x7c, 0x63, 0x57, 0x5e, 0xf6, 0xf3, 0x21, 0xbb, 0x00, 0x49, 0x52, 0x7c,
-	0x53, 0x0d, 0xba, 0x23, 0xdf, 0xb9, 0xf4, 0xbd, 0xd9, 0x09, 0xb9, 0xb9, 0x1f, 0x40, 0xd9, 0x89,
-	0x5e, 0xee, 0x64, 0x2e, 0x7f, 0xb9, 0x13, 0x13, 0xb1, 0x7b, 0x10, 0x9b, 0x84, 0x97, 0x39, 0x67,
-	0x7a, 0x0b, 0x20, 0xc9, 0xd6, 0xb1, 0x8f, 0xa5, 0x6b, 0x89, 0xa0, 0x48, 0x7c, 0xd6, 0x1b, 0xa3,
-	0x2b, 0x55, 0x0a, 0x99, 0xde, 0x80, 0x9a, 0x9a, 0xf2, 0xbb, 0xf7, 0x3a, 0xd4, 0xd4, 0x5f, 0x7c,
-	0xa0, 0xd3, 0x2e, 0xcf, 0xb5, 0xc4, 0xf3, 0x8d, 0xfe, 0xaf, 0x3f, 0xd1, 0x32, 0xf7, 0xfe, 0x48,
-	0x79, 0xca, 0x48, 0x34, 0x32, 0x6e, 0xa2, 0x2b, 0x38, 0xfd, 0xde, 0xa0, 0xdb, 0xe2, 0x14, 0x25,
-	0xd1, 0x43, 0x8f, 0x83, 0xd6, 0xe8, 0x40, 0x44, 0x54, 0x12, 0x43, 0x80, 0x1c, 0xdd, 0xc2, 0x68,
-	0x0d, 0xf6, 0xbb, 0xe2, 0xca, 0x0d, 0x7d, 0xc6, 0x69, 0xa5, 0x02, 0xdd, 0xd6, 0xe8, 0x8d, 0xc6,
-	0x5a, 0x91, 0x69, 0x50, 0xc3, 0xaf, 0x18, 0x57, 0xba, 0xf7, 0x4b, 0x68, 0x5e, 0x76, 0x8c, 0x85,
-	0xb5, 0xb6, 0x0f, 0x5a, 0x74, 0x54, 0x58, 0x83, 0xf2, 0x60, 0x38, 0x11, 0xa5, 0x0c, 0xab, 0x42,
-	0x89, 0x77, 0xfb, 0x5d, 0x4a, 0xe2, 0xdd, 0xfb, 0x4d, 0x46, 0x59, 0xa5, 0xe8, 0x18, 0x23, 0x06,
-	0xc8, 0xe1, 0xaa, 0x20, 0x6e, 0x19, 0xa6, 0x96, 0x61, 0xd7, 0x81, 0xa5, 0x40, 0x7d, 0x6f, 0x66,
-	0x38, 0x5a, 0x96, 0xd2, 0x75, 0x11, 0xfc, 0xa9, 0x6f, 0x87, 0x96, 0x96, 0x63, 0xaf, 0xc2, 0xcd,
-	0x18, 0xd6, 0xf7, 0x4e, 0x0f, 0x7d, 0xdb, 0xf3, 0xed, 0xf0, 0x5c, 0xa0, 0xf3, 0x7b, 0xbf, 0xf8,
-	0x0f, 0xbf, 0xbd, 0x93, 0xf9, 0xcf, 0xbf, 0xbd, 0x93, 0xf9, 0x6f, 0xbf, 0xbd, 0xb3, 0xf5, 0x67,
-	0xff, 0xe3, 0x4e, 0xe6, 0xaf, 0xa9, 0x3f, 0x87, 0xb7, 0x30, 0x42, 0xdf, 0x3e, 0x13, 0x06, 0x32,
-	0x2a, 0xb8, 0xd6, 0x07, 0xcb, 0x93, 0xe3, 0x0f, 0x96, 0xd3, 0x0f, 0x70, 0x45, 0xa7, 0x45, 0xfa,
-	0x55, 0xbc, 0x8f, 0xff, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x67, 0xe8, 0xc6, 0xd9, 0x58, 0x4f,
-	0x00, 0x00,
+	// 8320 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x7c, 0x5b, 0x8f, 0x23, 0x49,
+	0xba, 0x50, 0xdb, 0xe9, 0xeb, 0xe7, 0x4b, 0x65, 0x45, 0xdf, 0xdc, 0x3d, 0x3d, 0x3d, 0x35, 0x39,
+	0xb3, 0x33, 0x3d, 0xbd, 0xb3, 0x3d, 0x3b, 0xd5, 0xb3, 0x3d, 0x97, 0xb3, 0xab, 0x5d, 0x97, 0xed,
+	0xae, 0xf2, 0xb4, 0xcb, 0xae, 0x4d, 0xbb, 0xba, 0x67, 0xce, 0x11, 0x32, 0x69, 0x67, 0xba, 0x2a,
+	0xbb, 0xd2, 0x99, 0x9e, 0xcc, 0x74, 0x57, 0xd5, 0x4a, 0x47, 0x5a, 0x09, 0x04, 0xe2, 0x09, 0x71,
+	0xd1, 0x01, 0x09, 0x0e, 0x9c, 0x03, 0x12, 0x12, 0xbc, 0x20, 0x7e, 0x01, 0x02, 0x24, 0x04, 0x12,
+	0x0f, 0xf0, 0x86, 0xe0, 0x05, 0x16, 0xfe, 0x00, 0x3a, 0x3c, 0xf2, 0x80, 0xbe, 0x2f, 0x22, 0x33,
+	0x23, 0x6d, 0xd7, 0xf6, 0xcc, 0x9c, 0x45, 0xbc, 0x54, 0x65, 0x7c, 0x97, 0xb8, 0x7c, 0x11, 0xf1,
+	0xdd, 0x22, 0xc2, 0x00, 0x0b, 0xc7, 0x70, 0x1f, 0x2d, 0x7c, 0x2f, 0xf4, 0x58, 0x0e, 0xbf, 0xef,
+	0xfe, 0xe8, 0xc4, 0x0e, 0x4f, 0x97, 0x93, 0x47, 0x53, 0x6f, 0xfe, 0xd1, 0x89, 0x77, 0xe2, 0x7d,
+	0x44, 0xc8, 0xc9, 0x72, 0x46, 0x25, 0x2a, 0xd0, 0x17, 0x67, 0xd2, 0xfe, 0x4e, 0x06, 0x72, 0xa3,
+	0xcb, 0x85, 0xc5, 0xea, 0x90, 0xb5, 0xcd, 0x46, 0x66, 0x27, 0xf3, 0x20, 0xaf, 0x67, 0x6d, 0x93,
+	0xed, 0x40, 0xc5, 0xf5, 0xc2, 0xfe, 0xd2, 0x71, 0x8c, 0x89, 0x63, 0x35, 0xb2, 0x3b, 0x99, 0x07,
+	0x25, 0x5d, 0x06, 0xb1, 0x37, 0xa0, 0x6c, 0x2c, 0x43, 0x6f, 0x6c, 0xbb, 0x53, 0xbf, 0xa1, 0x10,
+	0xbe, 0x84, 0x80, 0xae, 0x3b, 0xf5, 0xd9, 0x0d, 0xc8, 0x9f, 0xdb, 0x66, 0x78, 0xda, 0xc8, 0x51,
+	0x8d, 0xbc, 0x80, 0xd0, 0x60, 0x6a, 0x38, 0x56, 0x23, 0xcf, 0xa1, 0x54, 0x40, 0x68, 0x48, 0x8d,
+	0x14, 0x76, 0x32, 0x0f, 0xca, 0x3a, 0x2f, 0x68, 0xff, 0x29, 0x0f, 0xf9, 0x96, 0xe7, 0x06, 0x21,
+	0xbb, 0x05, 0x05, 0x3b, 0x70, 0x97, 0x8e, 0x43, 0xdd, 0x2b, 0xe9, 0xa2, 0xc4, 0x6e, 0x41, 0xde,
+	0xfe, 0xec, 0x95, 0xe1, 0x50, 0xe7, 0xf2, 0x07, 0xd7, 0x74, 0x5e, 0x64, 0x0d, 0x28, 0xd8, 0x1f,
+	0x3f, 0x41, 0x84, 0x22, 0x10, 0xa2, 0x4c, 0x98, 0xc7, 0xbb, 0x88, 0xc9, 0xc5, 0x98, 0xc7, 0xbb,
+	0x11, 0xe6, 0xc9, 0x27, 0x88, 0xc1, 0xae, 0x29, 0x84, 0xa1, 0x32, 0xb6, 0xb2, 0xa4, 0x56, 0xb0,
+	0x77, 0x35, 0x6c, 0x65, 0x19, 0xb5, 0xb2, 0xe4, 0xad, 0x14, 0x05, 0x42, 0x94, 0x09, 0xc3, 0x5b,
+	0x29, 0xc5, 0x98, 0xb8, 0x95, 0x25, 0x6f, 0xa5, 0xbc, 0x93, 0x79, 0x90, 0x23, 0x0c, 0x6f, 0xe5,
+	0x06, 0xe4, 0x4c, 0x84, 0xc3, 0x4e, 0xe6, 0x41, 0xe6, 0xe0, 0x9a, 0x9e, 0x33, 0x05, 0x34, 0x40,
+	0x68, 0x05, 0x05, 0x83, 0xd0, 0x40, 0x40, 0x27, 0x08, 0xad, 0xa2, 0x34, 0x10, 0x3a, 0x11, 0xd0,
+	0x19, 0x42, 0x6b, 0x3b, 0x99, 0x07, 0x59, 0x84, 0x62, 0x89, 0xdd, 0x85, 0xa2, 0x69, 0x84, 0x16,
+	0x22, 0xea, 0x62, 0xc8, 0x11, 0x00, 0x71, 0xa1, 0x3d, 0x27, 0xdc, 0x96, 0x18, 0x74, 0x04, 0x60,
+	0x1a, 0x54, 0x90, 0x2c, 0xc2, 0xab, 0x02, 0x2f, 0x03, 0xd9, 0x4f, 0xa0, 0x6a, 0x5a, 0x53, 0x7b,
+	0x6e, 0x38, 0x7c, 0x4c, 0xdb, 0x3b, 0x99, 0x07, 0x95, 0xdd, 0xad, 0x47, 0xb4, 0x26, 0x63, 0xcc,
+	0xc1, 0x35, 0x3d, 0x45, 0xc6, 0x3e, 0x83, 0x9a, 0x28, 0x7f, 0xbc, 0x4b, 0x82, 0x65, 0xc4, 0xa7,
+	0xa6, 0xf8, 0x3e, 0xde, 0xfd, 0xec, 0xe0, 0x9a, 0x9e, 0x26, 0x64, 0xef, 0x42, 0x15, 0xdb, 0x0e,
+	0x42, 0x63, 0xbe, 0x40, 0xc6, 0xeb, 0xa2, 0x57, 0x29, 0x28, 0x0e, 0xeb, 0x65, 0xe0, 0xb9, 0x48,
+	0x70, 0x43, 0xc8, 0x2d, 0x02, 0xb0, 0x1d, 0x00, 0xd3, 0x9a, 0x19, 0x4b, 0x27, 0x44, 0xf4, 0x4d,
+	0x21, 0x40, 0x09, 0xc6, 0xee, 0x43, 0x79, 0xb9, 0xc0, 0x51, 0x3e, 0x37, 0x9c, 0xc6, 0x2d, 0x41,
+	0x90, 0x80, 0x70, 0xb1, 0xda, 0xc1, 0x9e, 0xed, 0x36, 0x6e, 0x23, 0x4e, 0xe7, 0x05, 0x76, 0x0f,
+	0x94, 0xc0, 0x9f, 0x36, 0x1a, 0x34, 0x12, 0xe0, 0x23, 0xe9, 0x5c, 0x2c, 0x7c, 0x1d, 0xc1, 0x7b,
+	0x45, 0xc8, 0xbf, 0x32, 0x9c, 0xa5, 0xa5, 0xdd, 0x83, 0xd2, 0x91, 0xe1, 0x1b, 0x73, 0xdd, 0x9a,
+	0x31, 0x15, 0x94, 0x85, 0x17, 0x88, 0x1d, 0x87, 0x9f, 0x5a, 0x0f, 0x0a, 0xcf, 0x0d, 0x1f, 0x71,
+	0x0c, 0x72, 0xae, 0x31, 0xb7, 0x08, 0x59, 0xd6, 0xe9, 0x1b, 0x77, 0x41, 0x70, 0x19, 0x84, 0xd6,
+	0x5c, 0xec, 0x45, 0x51, 0x42, 0xf8, 0x89, 0xe3, 0x4d, 0xc4, 0x6a, 0x2f, 0xe9, 0xa2, 0xa4, 0xf5,
+	0xa1, 0xd0, 0xf2, 0x1c, 0xac, 0xed, 0x36, 0x14, 0x7d, 0xcb, 0x19, 0x27, 0xad, 0x15, 0x7c, 0xcb,
+	0x39, 0xf2, 0x02, 0x44, 0x4c, 0x3d, 0x8e, 0xc8, 0x72, 0xc4, 0xd4, 0x23, 0x44, 0xd4, 0xbe, 0x92,
+	0xb4, 0xaf, 0x7d, 0x0e, 0x65, 0xdd, 0x38, 0x17, 0x55, 0xde, 0x84, 0x42, 0x38, 0x71, 0xc6, 0x42,
+	0x63, 0xe4, 0xf4, 0x7c, 0x38, 0x71, 0xba, 0x26, 0x82, 0xb1, 0x42, 0xdb, 0xa4, 0xfa, 0x72, 0x7a,
+	0x7e, 0xea, 0x39, 0x5d, 0x53, 0x1b, 0x01, 0xb4, 0x3c, 0xdf, 0xff, 0xde, 0xdd, 0xb9, 0x01, 0x79,
+	0xd3, 0x5a, 0x84, 0xa7, 0x7c, 0x3f, 0xeb, 0xbc, 0xa0, 0x3d, 0x84, 0x12, 0x8a, 0xb8, 0x67, 0x07,
+	0x21, 0xbb, 0x0f, 0x39, 0xc7, 0x0e, 0xc2, 0x46, 0x66, 0x47, 0x59, 0x99, 0x00, 0x82, 0x6b, 0x3b,
+	0x50, 0x3a, 0x34, 0x2e, 0x9e, 0xe3, 0x24, 0xb0, 0x1b, 0x62, 0x36, 0x84, 0x74, 0xc5, 0xd4, 0x3c,
+	0x04, 0x18, 0x19, 0xfe, 0x89, 0x15, 0x92, 0x36, 0xbc, 0x07, 0x4a, 0x78, 0xb9, 0x20, 0x8a, 0xb8,
+	0x3a, 0x44, 0xe8, 0x08, 0xd6, 0xfe, 0x2c, 0x03, 0x95, 0xe1, 0x72, 0xf2, 0xcd, 0xd2, 0xf2, 0x2f,
+	0x71, 0x44, 0x0f, 0x12, 0xea, 0xfa, 0xee, 0x2d, 0x4e, 0x2d, 0xe1, 0x13, 0x4e, 0x1c, 0xa2, 0xeb,
+	0x99, 0x56, 0x24, 0xa1, 0xbc, 0x5e, 0xc0, 0x62, 0xd7, 0x44, 0xf5, 0xeb, 0x2d, 0x84, 0xbc, 0xb3,
+	0xde, 0x82, 0xed, 0x40, 0x7e, 0x7a, 0x6a, 0x3b, 0x66, 0x23, 0x27, 0x77, 0x81, 0x46, 0xc4, 0x11,
+	0xec, 0x0e, 0x94, 0x7c, 0xef, 0x7c, 0x1c, 0xd8, 0xbf, 0x8a, 0xd4, 0x69, 0xd1, 0xf7, 0xce, 0x87,
+	0xf6, 0xaf, 0x2c, 0x6d, 0x24, 0x74, 0x3a, 0x40, 0x61, 0xd8, 0x6a, 0xf6, 0x9a, 0xba, 0x7a, 0x0d,
+	0xbf, 0x3b, 0x5f, 0x75, 0x87, 0xa3, 0xa1, 0x9a, 0x61, 0x75, 0x80, 0xfe, 0x60, 0x34, 0x16, 0xe5,
+	0x2c, 0x2b, 0x40, 0xb6, 0xdb, 0x57, 0x15, 0xa4, 0x41, 0x78, 0xb7, 0xaf, 0xe6, 0x58, 0x11, 0x94,
+	0x66, 0xff, 0x6b, 0x35, 0x4f, 0x1f, 0xbd, 0x9e, 0x5a, 0xd0, 0xfe, 0x49, 0x16, 0xca, 0x83, 0xc9,
+	0x4b, 0x6b, 0x1a, 0xe2, 0x98, 0x71, 0x39, 0x5a, 0xfe, 0x2b, 0xcb, 0xa7, 0x61, 0x2b, 0xba, 0x28,
+	0xe1, 0x40, 0xcc, 0x09, 0x0d, 0x4e, 0xd1, 0xb3, 0xe6, 0x84, 0xe8, 0xa6, 0xa7, 0xd6, 0xdc, 0x68,
+	0x28, 0x82, 0x8e, 0x4a, 0xb8, 0xfc, 0xbd, 0xc9, 0x4b, 0x1a, 0x9e, 0xa2, 0xe3, 0x27, 0x7b, 0x0b,
+	0x2a, 0xbc, 0x8e, 0x31, 0xad, 0xbd, 0x3c, 0xc9, 0x02, 0x38, 0xa8, 0x8f, 0x3b, 0xe0, 0x36, 0x14,
+	0xcd, 0x09, 0x47, 0x72, 0x4b, 0x51, 0x30, 0x27, 0x84, 0x40, 0x4e, 0xaa, 0x95, 0x23, 0x8b, 0x82,
+	0x93, 0x40, 0x44, 0x70, 0x07, 0x4a, 0xde, 0xe4, 0x25, 0xc7, 0x96, 0x08, 0x5b, 0xf4, 0x26, 0x2f,
+	0x09, 0xf5, 0x43, 0xd8, 0x0e, 0x96, 0x93, 0x60, 0xea, 0xdb, 0x8b, 0xd0, 0xf6, 0x5c, 0x4e, 0x53,
+	0x26, 0x1a, 0x55, 0x46, 0x10, 0xf1, 0xbb, 0x50, 0x5f, 0x2c, 0x27, 0x63, 0x63, 0x3a, 0xf5, 0x96,
+	0x6e, 0x88, 0xb3, 0x08, 0x24, 0xf9, 0xea, 0x62, 0x39, 0x69, 0x72, 0x60, 0xd7, 0xd4, 0xfe, 0x7e,
+	0x06, 0xd4, 0xa1, 0xc4, 0x7a, 0x68, 0x85, 0xc6, 0xc6, 0x2d, 0xfd, 0x26, 0x80, 0x54, 0x15, 0x5f,
+	0x10, 0x65, 0x23, 0xaa, 0x47, 0x1e, 0xaf, 0x92, 0x1a, 0xef, 0xdb, 0x50, 0x8d, 0xf8, 0x08, 0x9b,
+	0x23, 0x6c, 0x45, 0xc0, 0xa2, 0x11, 0x07, 0xcb, 0x89, 0x2c, 0xc9, 0x62, 0xb0, 0x24, 0x6e, 0xed,
+	0x7f, 0x65, 0xa0, 0xf4, 0x74, 0xe9, 0x4e, 0xb1, 0x6b, 0xec, 0x1d, 0xc8, 0xcd, 0x96, 0xee, 0xb4,
+	0x91, 0x91, 0x75, 0x77, 0x3c, 0xcb, 0x3a, 0x21, 0x71, 0x77, 0x19, 0xfe, 0x09, 0xee, 0xca, 0xb5,
+	0xdd, 0x85, 0x70, 0xed, 0x1f, 0x8a, 0x1a, 0x9f, 0x3a, 0xc6, 0x09, 0x2b, 0x41, 0xae, 0x3f, 0xe8,
+	0x77, 0xd4, 0x6b, 0xac, 0x0a, 0xa5, 0x6e, 0x7f, 0xd4, 0xd1, 0xfb, 0xcd, 0x9e, 0x9a, 0xa1, 0xc5,
+	0x38, 0x6a, 0xee, 0xf5, 0x3a, 0x6a, 0x16, 0x31, 0xcf, 0x07, 0xbd, 0xe6, 0xa8, 0xdb, 0xeb, 0xa8,
+	0x39, 0x8e, 0xd1, 0xbb, 0xad, 0x91, 0x5a, 0x62, 0x2a, 0x54, 0x8f, 0xf4, 0x41, 0xfb, 0xb8, 0xd5,
+	0x19, 0xf7, 0x8f, 0x7b, 0x3d, 0x55, 0x65, 0xd7, 0x61, 0x2b, 0x86, 0x0c, 0x38, 0x70, 0x07, 0x59,
+	0x9e, 0x37, 0xf5, 0xa6, 0xbe, 0xaf, 0xfe, 0x82, 0x95, 0x40, 0x69, 0xee, 0xef, 0xab, 0xbf, 0xce,
+	0xe0, 0xd7, 0x8b, 0x6e, 0x5f, 0xfd, 0x75, 0x96, 0xd5, 0xa1, 0x7c, 0x38, 0xe8, 0x0f, 0x46, 0x83,
+	0x7e, 0xb7, 0xa5, 0xfe, 0x3a, 0xa7, 0xfd, 0x53, 0x05, 0x72, 0xd8, 0xe1, 0xdf, 0xbe, 0xb1, 0xd9,
+	0x1b, 0x90, 0x99, 0xd2, 0x3c, 0x54, 0x76, 0x2b, 0x1c, 0x47, 0x1e, 0xc8, 0xc1, 0x35, 0x3d, 0x83,
+	0x52, 0xc8, 0xf0, 0x1d, 0x5a, 0xd9, 0xad, 0x73, 0x64, 0xa4, 0xcb, 0x11, 0xbf, 0x60, 0xf7, 0x20,
+	0xf3, 0x4a, 0x6c, 0xd7, 0x2a, 0xc7, 0x73, 0x6d, 0x8e, 0xd8, 0x57, 0x6c, 0x07, 0x94, 0xa9, 0xc7,
+	0xbd, 0x8b, 0x18, 0xcf, 0x15, 0xe2, 0xc1, 0x35, 0x1d, 0x51, 0xec, 0x1d, 0x50, 0x7c, 0xe3, 0xbc,
+	0x51, 0x90, 0x67, 0x22, 0xd6, 0xb8, 0x48, 0xe4, 0x1b, 0xe7, 0xd8, 0x89, 0x59, 0xa3, 0x28, 0x77,
+	0x22, 0x9a, 0x4a, 0x6c, 0x66, 0xc6, 0x7e, 0x00, 0x4a, 0xb0, 0x9c, 0xd0, 0x22, 0xaf, 0xec, 0x6e,
+	0xaf, 0xa9, 0x22, 0xac, 0x26, 0x58, 0x4e, 0xd8, 0x7b, 0x90, 0x9b, 0x7a, 0xbe, 0xdf, 0x28, 0xcb,
+	0xa6, 0x37, 0xd1, 0xd1, 0xe8, 0x3e, 0x20, 0x9e, 0xed, 0x40, 0x26, 0x6c, 0x80, 0x4c, 0x94, 0x28,
+	0x49, 0x6c, 0x30, 0x64, 0xef, 0x0a, 0xcd, 0x5b, 0x91, 0xfb, 0x14, 0xe9, 0x65, 0xac, 0x07, 0xb1,
+	0x4c, 0x03, 0x65, 0x6e, 0x5c, 0x34, 0xaa, 0x32, 0x51, 0xa4, 0x90, 0xb1, 0x4f, 0x73, 0xe3, 0x62,
+	0xaf, 0x00, 0x39, 0xeb, 0x62, 0xe1, 0x6b, 0x77, 0xa0, 0x1c, 0xfb, 0x0b, 0xac, 0x0a, 0x19, 0x43,
+	0x68, 0x98, 0x8c, 0xa1, 0x3d, 0x00, 0x10, 0xa8, 0x8f, 0x77, 0x3f, 0x4b, 0xe3, 0xb0, 0x14, 0xe9,
+	0x9d, 0xcc, 0x44, 0xfb, 0x29, 0x54, 0x75, 0x2b, 0x58, 0x3a, 0x61, 0xcb, 0x73, 0xda, 0xd6, 0x8c,
+	0x7d, 0x08, 0x10, 0x97, 0x03, 0x61, 0x26, 0x92, 0x59, 0x68, 0x5b, 0x33, 0x5d, 0xc2, 0x6b, 0x7f,
+	0x49, 0x81, 0x82, 0x60, 0x4c, 0x4c, 0x5a, 0x46, 0x32, 0x69, 0xf1, 0x76, 0xce, 0xa6, 0x2d, 0xf4,
+	0xa9, 0x6d, 0x9a, 0x96, 0x1b, 0x59, 0x62, 0x5e, 0x62, 0xef, 0x82, 0x62, 0x38, 0x27, 0xb4, 0x34,
+	0xea, 0xbb, 0x2c, 0x6a, 0x74, 0xbe, 0xf0, 0xad, 0x20, 0xe0, 0x6b, 0xcf, 0x70, 0x4e, 0xa2, 0x95,
+	0x99, 0xdf, 0xbc, 0x32, 0xef, 0x40, 0xc9, 0xf5, 0xc2, 0x31, 0x79, 0xc1, 0x05, 0xaa, 0xbd, 0x28,
+	0x7c, 0x71, 0xf6, 0x3e, 0x14, 0x85, 0xff, 0x22, 0x16, 0x46, 0x8d, 0x33, 0xb7, 0x39, 0x50, 0x8f,
+	0xb0, 0xac, 0x81, 0xf6, 0x75, 0x3e, 0xb7, 0xdc, 0x30, 0x52, 0x82, 0xa2, 0xc8, 0x7e, 0x08, 0x65,
+	0xcf, 0x1d, 0x73, 0x27, 0xa7, 0x51, 0x96, 0x27, 0x69, 0xe0, 0x1e, 0x13, 0x54, 0x2f, 0x79, 0xe2,
+	0x0b, 0xbb, 0xe2, 0x78, 0xe7, 0xe3, 0xa9, 0xe1, 0x73, 0xf5, 0x57, 0xd2, 0x8b, 0x8e, 0x77, 0xde,
+	0x32, 0x7c, 0x93, 0xdd, 0x83, 0xf2, 0xd4, 0x59, 0x06, 0xa1, 0xe5, 0xef, 0x5d, 0xd2, 0x8a, 0x28,
+	0xe9, 0x09, 0x00, 0xdb, 0x5f, 0xf8, 0xf6, 0xdc, 0xf0, 0x2f, 0xb9, 0xeb, 0xaa, 0x47, 0x45, 0x34,
+	0xc9, 0x8b, 0x33, 0xdb, 0xbc, 0x20, 0xe7, 0x35, 0xaf, 0xf3, 0x82, 0xf6, 0x0d, 0x14, 0xc5, 0x18,
+	0xd8, 0x7d, 0xbe, 0x36, 0xd2, 0xfb, 0x96, 0x6b, 0x20, 0x84, 0xb3, 0x77, 0xa0, 0xe6, 0xf9, 0xf6,
+	0x89, 0xed, 0x8e, 0x83, 0xd0, 0xb7, 0xdd, 0x13, 0x31, 0x2f, 0x55, 0x0e, 0x1c, 0x12, 0x0c, 0xd5,
+	0x26, 0xca, 0x6f, 0x6c, 0x4c, 0x6c, 0xc7, 0x0e, 0x2f, 0xc5, 0x2c, 0x55, 0x10, 0xd6, 0xe4, 0x20,
+	0x6d, 0x00, 0xa5, 0x68, 0xc4, 0xbf, 0x93, 0x36, 0xb5, 0xdf, 0x83, 0x4a, 0xd7, 0x35, 0xad, 0x8b,
+	0x01, 0x59, 0x02, 0xf6, 0x21, 0xb0, 0xa9, 0x6f, 0x19, 0xa1, 0x35, 0xb6, 0x2e, 0x42, 0xdf, 0x18,
+	0xf3, 0xb8, 0x87, 0x87, 0x35, 0x2a, 0xc7, 0x74, 0x10, 0x31, 0x42, 0xb8, 0xf6, 0x5f, 0x32, 0x50,
+	0x3b, 0xe2, 0x22, 0x7a, 0x66, 0x5d, 0xb6, 0xb9, 0x63, 0x38, 0x8d, 0x16, 0x70, 0x4e, 0xa7, 0x6f,
+	0x76, 0x1f, 0x2a, 0x8b, 0x33, 0xeb, 0x72, 0x9c, 0xf2, 0xbc, 0xca, 0x08, 0x6a, 0xd1, 0x52, 0xfd,
+	0x00, 0x0a, 0x1e, 0xb5, 0xde, 0x50, 0x64, 0xad, 0x20, 0x75, 0x4b, 0x17, 0x04, 0x4c, 0x83, 0x5a,
+	0x5c, 0x95, 0x6c, 0x59, 0x44, 0x65, 0x64, 0x59, 0x6e, 0x40, 0x1e, 0x51, 0x41, 0x23, 0xbf, 0xa3,
+	0xa0, 0xfb, 0x44, 0x05, 0xf6, 0x63, 0xa8, 0x4d, 0xbd, 0xf9, 0x62, 0x1c, 0xb1, 0x0b, 0x35, 0x96,
+	0xde, 0x62, 0x15, 0x24, 0x39, 0xe2, 0x75, 0x69, 0x7f, 0x37, 0x0b, 0x25, 0xea, 0x83, 0xd8, 0x65,
+	0xb6, 0x79, 0x11, 0xed, 0xb2, 0xb2, 0x9e, 0xb7, 0xcd, 0x8b, 0xae, 0x89, 0x06, 0xd2, 0x46, 0x92,
+	0xb1, 0xb4, 0xd7, 0xca, 0x04, 0x89, 0xba, 0xb2, 0x30, 0xfc, 0x30, 0x68, 0x28, 0xbc, 0x2b, 0x54,
+	0xc0, 0x6d, 0xb8, 0x74, 0xed, 0x6f, 0x96, 0xbc, 0xf7, 0x25, 0x5d, 0x94, 0xd8, 0x03, 0x50, 0x79,
+	0x65, 0x24, 0x74, 0xd9, 0x34, 0xd6, 0x09, 0x4e, 0x32, 0x8f, 0xfc, 0x09, 0x4e, 0x63, 0x5d, 0xa0,
+	0x6a, 0xe3, 0xfb, 0x0d, 0x08, 0xd4, 0x41, 0x88, 0xbc, 0x93, 0x8a, 0xe9, 0x9d, 0xd4, 0x80, 0xe2,
+	0x2b, 0x3b, 0xb0, 0x71, 0x56, 0x4b, 0x7c, 0x8d, 0x8b, 0xa2, 0x34, 0x0d, 0xe5, 0xd7, 0x4c, 0x83,
+	0xf6, 0xef, 0xb3, 0x50, 0x7b, 0xea, 0xf9, 0x96, 0x7d, 0xe2, 0x26, 0xf3, 0xbe, 0xe6, 0x3d, 0x44,
+	0x6b, 0x21, 0x2b, 0xad, 0x85, 0xb7, 0xa0, 0x32, 0xe3, 0x8c, 0xe3, 0x70, 0xc2, 0x23, 0x82, 0x9c,
+	0x0e, 0x02, 0x34, 0x9a, 0x38, 0xb8, 0x07, 0x22, 0x02, 0x62, 0xce, 0x11, 0x73, 0xc4, 0x84, 0xca,
+	0x8f, 0x7d, 0x41, 0xca, 0xc0, 0xb4, 0x1c, 0x2b, 0xe4, 0x02, 0xaa, 0xef, 0xbe, 0x29, 0x4c, 0x8d,
+	0xdc, 0xa7, 0x47, 0xba, 0x35, 0x6b, 0x92, 0xe5, 0x41, 0xdd, 0xd0, 0x26, 0x72, 0xf6, 0x85, 0xac,
+	0x48, 0x0a, 0xdf, 0x92, 0x97, 0xef, 0x37, 0x6d, 0x04, 0xe5, 0x18, 0x8c, 0x1e, 0x82, 0xde, 0x11,
+	0x5e, 0xc1, 0x35, 0x56, 0x81, 0x62, 0xab, 0x39, 0x6c, 0x35, 0xdb, 0x1d, 0x35, 0x83, 0xa8, 0x61,
+	0x67, 0xc4, 0x3d, 0x81, 0x2c, 0xdb, 0x82, 0x0a, 0x96, 0xda, 0x9d, 0xa7, 0xcd, 0xe3, 0xde, 0x48,
+	0x55, 0x58, 0x0d, 0xca, 0xfd, 0xc1, 0xb8, 0xd9, 0x1a, 0x75, 0x07, 0x7d, 0x35, 0xa7, 0xfd, 0x02,
+	0x4a, 0xad, 0x53, 0x6b, 0x7a, 0x76, 0x95, 0x14, 0xc9, 0xd1, 0xb6, 0xa6, 0x67, 0x8d, 0xec, 0xda,
+	0x36, 0xe7, 0x08, 0xad, 0x0d, 0xd5, 0x56, 0xa4, 0xc3, 0xb0, 0x96, 0x9d, 0x68, 0xd5, 0xad, 0x07,
+	0x1b, 0x1c, 0xb1, 0xc9, 0x38, 0x68, 0x3f, 0x81, 0xca, 0x91, 0xef, 0x2d, 0x2c, 0x3f, 0xa4, 0x4a,
+	0x54, 0x50, 0xce, 0xac, 0x4b, 0xd1, 0x13, 0xfc, 0x4c, 0xc2, 0x92, 0xac, 0x1c, 0x96, 0xec, 0x42,
+	0x29, 0x62, 0xfb, 0xd6, 0x3c, 0x3f, 0x87, 0x9a, 0xe0, 0xb1, 0xad, 0x00, 0x1b, 0x7b, 0x04, 0xb0,
+	0x88, 0x01, 0xa2, 0xdb, 0x91, 0x0b, 0x23, 0x2a, 0xd7, 0x25, 0x0a, 0xed, 0xcf, 0x14, 0xa8, 0x1f,
+	0x19, 0x7e, 0x68, 0xe3, 0x54, 0xf0, 0x41, 0xbf, 0x0f, 0xb9, 0xf0, 0x72, 0x61, 0x89, 0x18, 0xe7,
+	0x7a, 0xec, 0xff, 0x70, 0x1a, 0xb2, 0x53, 0x44, 0xc0, 0xbe, 0x80, 0xfa, 0x22, 0x02, 0x8f, 0x49,
+	0x7f, 0x72, 0xc1, 0xae, 0xb2, 0x90, 0xbc, 0x6a, 0x0b, 0xb9, 0xc8, 0x7e, 0x06, 0x37, 0xd2, 0xbc,
+	0x56, 0x10, 0x24, 0x7a, 0x4b, 0x16, 0xf4, 0xf5, 0x14, 0x23, 0x27, 0x63, 0x2d, 0xd8, 0x4e, 0xd8,
+	0xa7, 0x9e, 0xb3, 0x9c, 0xbb, 0x81, 0x70, 0xc8, 0x6e, 0xad, 0xb4, 0xde, 0xe2, 0x58, 0x5d, 0x5d,
+	0xac, 0x40, 0x98, 0x06, 0xd5, 0x18, 0xd6, 0x5f, 0xce, 0x69, 0x03, 0xe4, 0xf4, 0x14, 0x8c, 0x3d,
+	0x06, 0x88, 0xcb, 0x41, 0xa3, 0xb0, 0xa3, 0x6c, 0x18, 0x5f, 0x37, 0xb4, 0xe6, 0xba, 0x44, 0x86,
+	0xb6, 0xd1, 0x70, 0x4e, 0x3c, 0xdf, 0x0e, 0x4f, 0xe7, 0xa4, 0x35, 0x14, 0x3d, 0x01, 0x90, 0x72,
+	0x0a, 0xc6, 0xe8, 0xb2, 0xc7, 0x2c, 0x42, 0x81, 0xd4, 0xed, 0x60, 0xb8, 0x9c, 0xc4, 0xf5, 0xa2,
+	0xd9, 0x49, 0x46, 0x39, 0x0f, 0x4e, 0x44, 0xb0, 0x92, 0xf4, 0xf0, 0x30, 0x38, 0x61, 0xbb, 0x70,
+	0x33, 0x21, 0x4a, 0xf4, 0x5d, 0xd0, 0x00, 0xd2, 0x94, 0x89, 0xf8, 0x62, 0xa5, 0x17, 0x68, 0x5f,
+	0x42, 0x2d, 0x35, 0x3b, 0xaf, 0x35, 0x80, 0x77, 0xa0, 0x84, 0xff, 0xd1, 0xfc, 0x89, 0x05, 0x58,
+	0xc4, 0xf2, 0x30, 0xf4, 0x35, 0x0b, 0xd4, 0x55, 0x59, 0xb3, 0x77, 0x29, 0xbc, 0xc7, 0xcf, 0x0d,
+	0x3b, 0x27, 0x42, 0x61, 0x3c, 0xb6, 0x3e, 0x89, 0x59, 0xea, 0xf5, 0xda, 0x64, 0x69, 0x7f, 0x9a,
+	0x85, 0x5a, 0x4a, 0xe2, 0xec, 0x07, 0xf2, 0xf2, 0x93, 0x36, 0x7b, 0x22, 0x33, 0xd2, 0xf0, 0x1f,
+	0x80, 0xea, 0xf9, 0xa6, 0xed, 0x1a, 0x94, 0x6e, 0xe0, 0xe2, 0xc6, 0x21, 0xd4, 0xf4, 0x2d, 0x01,
+	0x3f, 0x12, 0x60, 0x4c, 0x84, 0x9a, 0x56, 0x1c, 0xcb, 0x89, 0x48, 0x4c, 0x06, 0xc9, 0xd6, 0x20,
+	0x97, 0xb6, 0x06, 0xef, 0x43, 0xd9, 0xb1, 0x82, 0x60, 0x1c, 0x9e, 0x1a, 0x6e, 0x23, 0xbf, 0x36,
+	0xe8, 0x12, 0x22, 0x47, 0xa7, 0x86, 0x8b, 0x84, 0xb6, 0x3b, 0xa6, 0xed, 0x1b, 0x2d, 0xa8, 0x14,
+	0xa1, 0xed, 0x92, 0xab, 0x8c, 0x76, 0xf6, 0xc6, 0xa6, 0x89, 0x15, 0x66, 0x88, 0xad, 0xcf, 0xab,
+	0xf6, 0x26, 0x14, 0x9f, 0xdb, 0xd6, 0xb9, 0xd0, 0x7f, 0xaf, 0x6c, 0xeb, 0x3c, 0xd2, 0x7f, 0xf8,
+	0xad, 0xfd, 0xcd, 0x12, 0x94, 0x88, 0xb8, 0x7d, 0x75, 0x5a, 0xe7, 0xbb, 0x38, 0xbb, 0x3b, 0x90,
+	0x8b, 0x0d, 0xcb, 0xaa, 0xfd, 0x27, 0x0c, 0x1a, 0x75, 0xde, 0x71, 0x52, 0x28, 0xdc, 0x02, 0x97,
+	0x09, 0x22, 0x52, 0x2f, 0x65, 0xee, 0x08, 0x05, 0xdf, 0x38, 0x22, 0xce, 0x4f, 0x00, 0xec, 0x11,
+	0x94, 0xb0, 0x87, 0x14, 0xb3, 0x16, 0x65, 0xc5, 0x42, 0x63, 0x88, 0x62, 0x21, 0xbd, 0x18, 0x4e,
+	0x1c, 0x2c, 0xa0, 0xde, 0x42, 0x97, 0xa4, 0x51, 0x91, 0x69, 0x53, 0x3e, 0x95, 0x4e, 0x04, 0xec,
+	0x01, 0x14, 0xc9, 0x0b, 0xb0, 0x82, 0x46, 0x55, 0x56, 0x90, 0x91, 0x8b, 0xa2, 0x47, 0x68, 0xf6,
+	0x01, 0xe4, 0x67, 0x67, 0xd6, 0x65, 0xd0, 0xa8, 0xc9, 0x1b, 0x3f, 0x65, 0xdf, 0x74, 0x4e, 0x81,
+	0xf9, 0x02, 0xdf, 0x9a, 0x8d, 0x29, 0x61, 0x83, 0x06, 0x39, 0x68, 0xd4, 0xc9, 0xde, 0x56, 0x7d,
+	0x6b, 0xd6, 0x42, 0xe0, 0x68, 0xe2, 0x04, 0xec, 0x3d, 0x28, 0x90, 0xa5, 0x09, 0x1a, 0x5b, 0x72,
+	0xcb, 0x91, 0xd9, 0xd2, 0x05, 0x96, 0xed, 0x42, 0x39, 0x51, 0x0e, 0x37, 0x69, 0x40, 0x37, 0x56,
+	0xb4, 0x0e, 0x29, 0x6b, 0x3d, 0x21, 0x63, 0x1f, 0x03, 0x08, 0x07, 0x7c, 0x3c, 0xb9, 0xa4, 0x7c,
+	0x66, 0x25, 0x0e, 0x41, 0x24, 0xa3, 0x26, 0xbb, 0xe9, 0xef, 0x43, 0x1e, 0x6d, 0x41, 0xd0, 0xb8,
+	0xbd, 0xa3, 0x24, 0x7e, 0x8a, 0x64, 0xbc, 0x74, 0x8e, 0x67, 0x0f, 0xa0, 0x84, 0x4b, 0x68, 0x8c,
+	0x13, 0xd5, 0x90, 0x23, 0x0f, 0xb1, 0xde, 0xd0, 0xf7, 0xb1, 0xce, 0x87, 0xdf, 0x38, 0xec, 0x21,
+	0xe4, 0x4c, 0x6b, 0x16, 0x34, 0xee, 0xec, 0x28, 0x89, 0x32, 0x8e, 0x56, 0x1d, 0x06, 0x2a, 0xdc,
+	0x80, 0x20, 0x0d, 0x3b, 0x80, 0x3a, 0x2e, 0xb0, 0x5d, 0x72, 0x67, 0x51, 0xe4, 0x8d, 0xbb, 0xc4,
+	0xf5, 0xf6, 0x0a, 0x57, 0x5f, 0x10, 0xd1, 0x04, 0x75, 0xdc, 0xd0, 0xbf, 0xd4, 0x6b, 0xae, 0x0c,
+	0x63, 0x77, 0xa1, 0x64, 0x07, 0x3d, 0x6f, 0x7a, 0x66, 0x99, 0x8d, 0x37, 0xf8, 0xf9, 0x44, 0x54,
+	0x66, 0x9f, 0x43, 0x8d, 0x96, 0x1c, 0x16, 0xb1, 0xf1, 0xc6, 0x3d, 0xd9, 0xb0, 0x8d, 0x64, 0x94,
+	0x9e, 0xa6, 0x64, 0xf7, 0x41, 0x09, 0x43, 0xa7, 0xf1, 0xa6, 0xec, 0xe0, 0x8e, 0x46, 0x3d, 0x1c,
+	0x30, 0x22, 0xd8, 0x13, 0xa8, 0x4c, 0x1c, 0xcf, 0x9b, 0x3f, 0xb5, 0x9d, 0xd0, 0xf2, 0x1b, 0xf7,
+	0xe5, 0x89, 0xda, 0x4b, 0x10, 0x48, 0x2f, 0x13, 0xde, 0xdd, 0xa7, 0x70, 0x87, 0x9a, 0xf8, 0xc9,
+	0x8a, 0xc1, 0x4e, 0xad, 0x5d, 0xc9, 0xb2, 0x63, 0xee, 0x3a, 0x21, 0xdc, 0xcb, 0x83, 0x62, 0x5a,
+	0xb3, 0xbb, 0xbf, 0x00, 0xb6, 0x2e, 0x9c, 0xd7, 0x79, 0x0f, 0x79, 0xe1, 0x3d, 0x7c, 0x91, 0xfd,
+	0x2c, 0xa3, 0x3d, 0x81, 0x02, 0x1f, 0x11, 0x72, 0xa1, 0x37, 0x2f, 0xb8, 0x30, 0x4d, 0x81, 0x52,
+	0x75, 0x43, 0xcb, 0x8f, 0x0e, 0x5e, 0x14, 0x3d, 0x2e, 0x6b, 0xef, 0x42, 0x3d, 0x3d, 0xc2, 0x54,
+	0xc0, 0x52, 0xe6, 0x0a, 0x40, 0xfb, 0x1c, 0x6a, 0xa9, 0xdd, 0xba, 0xd1, 0x2f, 0xe3, 0xbe, 0xbd,
+	0xc1, 0xb3, 0xdd, 0x55, 0x9d, 0x17, 0xb4, 0xff, 0x90, 0x81, 0xfc, 0x30, 0x34, 0xc2, 0x00, 0x4f,
+	0x9f, 0x26, 0x8e, 0x37, 0x3d, 0x1b, 0xbb, 0xcb, 0xb9, 0xc8, 0x23, 0x97, 0x08, 0x80, 0x06, 0x9a,
+	0x5a, 0x0d, 0x42, 0xe2, 0xcd, 0xe8, 0xf4, 0x8d, 0x0a, 0xcb, 0x5b, 0x86, 0x53, 0x37, 0x24, 0x85,
+	0x95, 0xd1, 0x45, 0x09, 0xb5, 0xb7, 0xef, 0x9d, 0x53, 0x1a, 0x35, 0x47, 0x88, 0xa8, 0x88, 0xbe,
+	0xf2, 0xa9, 0x11, 0x9c, 0xce, 0x8d, 0x45, 0x92, 0x65, 0xcd, 0xe8, 0x15, 0x01, 0xc3, 0x4c, 0x2b,
+	0xf6, 0x82, 0xeb, 0x32, 0xac, 0xb7, 0x40, 0xf8, 0x12, 0x01, 0x5a, 0x6e, 0x88, 0x96, 0x23, 0xb0,
+	0x1c, 0x6b, 0x1a, 0xda, 0xaf, 0x30, 0xdc, 0x2c, 0x72, 0x76, 0x09, 0xa4, 0x7d, 0x00, 0x45, 0x54,
+	0x8d, 0x46, 0x68, 0xa0, 0xb1, 0x35, 0x8d, 0xd0, 0xd8, 0x94, 0xc1, 0x46, 0xb8, 0xf6, 0x11, 0x80,
+	0xee, 0x9d, 0x07, 0x56, 0x48, 0xd4, 0x6f, 0x4b, 0x62, 0x8d, 0xb7, 0x9d, 0xa8, 0x4a, 0x48, 0xf9,
+	0xbf, 0x66, 0xa0, 0x32, 0xf0, 0x4d, 0xdc, 0xd2, 0xc3, 0x85, 0x35, 0x7d, 0xad, 0x35, 0x47, 0xbd,
+	0xeb, 0x39, 0x8e, 0x11, 0xdb, 0xc2, 0xb2, 0x9e, 0x00, 0xd8, 0xc7, 0x90, 0x9b, 0x39, 0xc6, 0x49,
+	0x43, 0x91, 0x7d, 0x7a, 0xa9, 0xfa, 0xe8, 0x1b, 0x53, 0x80, 0x3a, 0x91, 0x6a, 0x7f, 0x00, 0x15,
+	0x09, 0x98, 0xca, 0x06, 0x5e, 0xa3, 0xac, 0xf2, 0xb0, 0xa5, 0x62, 0xce, 0x2e, 0xd7, 0xee, 0x0c,
+	0x5b, 0xdc, 0x93, 0x47, 0x9f, 0x7e, 0x38, 0x7e, 0xda, 0xd5, 0x87, 0x23, 0x35, 0x47, 0x69, 0x6a,
+	0x02, 0xf4, 0x9a, 0x43, 0xcc, 0x0d, 0x02, 0x14, 0x8e, 0xfb, 0xdd, 0x5f, 0x1e, 0x77, 0x54, 0x55,
+	0xfb, 0xeb, 0x19, 0x80, 0x17, 0xb6, 0x6b, 0x7a, 0xe7, 0x34, 0xb8, 0x1f, 0x49, 0x5e, 0x1b, 0x2a,
+	0xba, 0x75, 0x29, 0x56, 0x16, 0x89, 0x8e, 0x64, 0x1f, 0x42, 0xc9, 0xc3, 0xae, 0x21, 0x69, 0x56,
+	0xd6, 0x72, 0xd2, 0x88, 0xf4, 0xa2, 0xc7, 0x0b, 0xb8, 0x9a, 0x1c, 0xcb, 0x30, 0xc5, 0xe9, 0x03,
+	0x7d, 0xe3, 0xbe, 0x40, 0x71, 0xf0, 0xd3, 0x4d, 0xfc, 0xd4, 0xfe, 0x76, 0x16, 0xb6, 0x07, 0x6e,
+	0x7b, 0xb9, 0x70, 0xec, 0xa9, 0x11, 0x5a, 0xcf, 0xac, 0xcb, 0x56, 0x78, 0x81, 0x99, 0x15, 0xbe,
+	0x40, 0x4c, 0x6b, 0x26, 0x44, 0x5f, 0x4f, 0x2b, 0x32, 0xb1, 0x60, 0xda, 0x74, 0x8e, 0xa0, 0x62,
+	0xe4, 0x15, 0x55, 0x31, 0xc6, 0x8c, 0x08, 0x76, 0x2f, 0xaf, 0xd7, 0xbd, 0xa4, 0xe6, 0xae, 0x79,
+	0xc1, 0xbe, 0x82, 0xed, 0x14, 0x25, 0xcd, 0xac, 0x42, 0x23, 0xf9, 0x50, 0x8c, 0x64, 0xb5, 0x2b,
+	0x32, 0x04, 0x25, 0xc2, 0x55, 0xe6, 0x96, 0x97, 0x86, 0xde, 0xed, 0xc3, 0x8d, 0x4d, 0x84, 0x1b,
+	0xd4, 0xc7, 0x8e, 0xac, 0x3e, 0x56, 0xe2, 0xa0, 0x44, 0x95, 0xfc, 0x71, 0x16, 0xca, 0x5d, 0x37,
+	0xb0, 0xfc, 0x10, 0xc5, 0xf1, 0x36, 0x28, 0x7e, 0x2c, 0x88, 0xb5, 0x6c, 0x33, 0xe2, 0xd8, 0x43,
+	0xd8, 0x36, 0x4c, 0x73, 0x6c, 0xcc, 0x66, 0xd6, 0x34, 0xb4, 0xcc, 0x31, 0xee, 0x46, 0x71, 0xe4,
+	0xb5, 0x65, 0x98, 0x66, 0x53, 0xc0, 0x71, 0x33, 0x08, 0xaf, 0x39, 0x32, 0x70, 0x3c, 0x99, 0xa2,
+	0x44, 0x5e, 0xb3, 0xb0, 0x6f, 0x24, 0xe7, 0xf4, 0x3c, 0xe4, 0x5e, 0x33, 0x0f, 0x8f, 0xe0, 0xfa,
+	0xaa, 0x93, 0x65, 0x9b, 0x3c, 0xe1, 0x91, 0xd3, 0xb7, 0xd3, 0x3e, 0x56, 0xd7, 0x0c, 0xd2, 0x2e,
+	0x39, 0x4e, 0x5a, 0x41, 0x9c, 0x0a, 0x44, 0x40, 0x9c, 0x32, 0x4c, 0x71, 0x04, 0x63, 0xcb, 0x35,
+	0x1b, 0xc5, 0xe8, 0xe4, 0xb0, 0xe3, 0x9a, 0xda, 0x3f, 0x2b, 0x40, 0x99, 0x07, 0xc0, 0x29, 0xf9,
+	0x28, 0x57, 0xca, 0xe7, 0x3e, 0x28, 0xd1, 0xba, 0x88, 0xcd, 0x4f, 0xd7, 0xc4, 0x6c, 0xab, 0x8e,
+	0x08, 0xf6, 0xa1, 0x18, 0x69, 0x1b, 0x0d, 0xae, 0x22, 0x3b, 0x14, 0xf1, 0x48, 0x13, 0x02, 0x0c,
+	0x0d, 0x79, 0xb4, 0x4e, 0x49, 0x9b, 0x9c, 0xdc, 0x6e, 0x8b, 0x0e, 0xdf, 0x0e, 0x8d, 0x45, 0x74,
+	0xfc, 0xd9, 0xf2, 0x1c, 0x72, 0x93, 0xcc, 0x8b, 0x31, 0x76, 0x32, 0xbf, 0xb9, 0x93, 0x98, 0xc8,
+	0x11, 0xc7, 0x7c, 0x3c, 0xa5, 0x73, 0x41, 0x0e, 0x6d, 0x9e, 0x10, 0x28, 0x88, 0x4f, 0x61, 0xcb,
+	0x73, 0xc7, 0xbe, 0x85, 0x59, 0xb3, 0x69, 0x48, 0x55, 0x15, 0x37, 0x57, 0x55, 0xf3, 0x5c, 0x5d,
+	0x90, 0x61, 0x8d, 0xef, 0xa5, 0x19, 0xb1, 0xe6, 0x12, 0xd5, 0x2c, 0xd1, 0x61, 0x03, 0x3f, 0x81,
+	0x3a, 0xc6, 0x0e, 0x46, 0x30, 0x35, 0x4c, 0x8b, 0xea, 0x2f, 0x6f, 0xae, 0xbf, 0xea, 0xb9, 0x2d,
+	0x4e, 0x85, 0xd5, 0xef, 0xa6, 0xd8, 0xb0, 0x76, 0xd8, 0x20, 0xe3, 0x84, 0x07, 0x9b, 0xfa, 0x24,
+	0xc5, 0x83, 0x6b, 0xab, 0xb2, 0x51, 0xe2, 0x09, 0x17, 0xae, 0xaf, 0x3d, 0xb8, 0x29, 0x71, 0x49,
+	0xf2, 0xaf, 0x6e, 0x96, 0x3f, 0x8b, 0xb9, 0x8f, 0xe3, 0x89, 0xf8, 0x11, 0x80, 0xe7, 0x8e, 0x03,
+	0x8b, 0x0b, 0xb0, 0xb6, 0x79, 0x80, 0x25, 0xcf, 0x1d, 0x5a, 0xf8, 0xc5, 0x1e, 0xc6, 0xe4, 0x38,
+	0xb0, 0xfa, 0x86, 0x81, 0x71, 0xda, 0x2e, 0xad, 0xa0, 0x88, 0x16, 0x07, 0xb4, 0xb5, 0x71, 0x40,
+	0x9c, 0x1a, 0x07, 0xf3, 0x05, 0x6c, 0x0b, 0x6a, 0x69, 0x20, 0xea, 0xe6, 0x81, 0xd4, 0x89, 0x2b,
+	0x19, 0xc4, 0x23, 0x0a, 0xa4, 0x2d, 0x97, 0xf7, 0x6a, 0xfb, 0x8a, 0xd5, 0xc7, 0x49, 0xba, 0xe6,
+	0x85, 0xf6, 0xa7, 0x05, 0xa8, 0x34, 0x5d, 0xc3, 0xb9, 0xfc, 0x95, 0xd5, 0x75, 0x67, 0x1e, 0xcf,
+	0x0f, 0x2e, 0x96, 0x21, 0x57, 0x12, 0xfc, 0x28, 0xa0, 0x4c, 0x10, 0x52, 0x0f, 0x6f, 0x41, 0xc5,
+	0x5b, 0x86, 0x31, 0x9e, 0x7b, 0x2b, 0xc0, 0x41, 0x44, 0x10, 0xf3, 0x93, 0x7d, 0x57, 0x24, 0x7e,
+	0xb2, 0xee, 0x09, 0x7f, 0xec, 0x1e, 0xc4, 0xfc, 0x44, 0xf0, 0x0e, 0xd4, 0xf0, 0xea, 0xc1, 0x78,
+	0xea, 0xb9, 0xc1, 0x72, 0x6e, 0x99, 0xfc, 0xf2, 0x08, 0xbf, 0x8f, 0xd0, 0x12, 0x30, 0xac, 0x65,
+	0x6e, 0xcd, 0x3d, 0xff, 0x92, 0xd7, 0x52, 0xe0, 0xb5, 0x70, 0x10, 0xd5, 0xf2, 0x21, 0xb0, 0x73,
+	0xc3, 0x0e, 0xc7, 0xe9, 0xaa, 0x78, 0x8a, 0x40, 0x45, 0xcc, 0x48, 0xae, 0xee, 0x16, 0x14, 0x4c,
+	0x3b, 0x38, 0xeb, 0x0e, 0x28, 0x3f, 0xa0, 0xe8, 0xa2, 0x84, 0xae, 0x48, 0xf0, 0xb8, 0x3b, 0x18,
+	0x4f, 0x2e, 0x45, 0x0e, 0x5f, 0xd1, 0x4b, 0x08, 0xd8, 0xbb, 0x0c, 0x29, 0xf7, 0x49, 0x48, 0x3e,
+	0x5a, 0x3a, 0x26, 0xa4, 0xdc, 0xbd, 0xa2, 0xd7, 0x11, 0xde, 0x45, 0x70, 0x0b, 0xa1, 0xa8, 0x7e,
+	0x89, 0x52, 0x0c, 0x9c, 0x93, 0x56, 0x88, 0x74, 0x0b, 0x11, 0x83, 0x65, 0x18, 0xd3, 0xde, 0x83,
+	0xb2, 0x6b, 0x85, 0xe7, 0x9e, 0x8f, 0xbd, 0xa9, 0x72, 0xe9, 0xc5, 0x00, 0x74, 0x14, 0x83, 0xa9,
+	0xe1, 0x62, 0xe7, 0x1b, 0x35, 0xd1, 0x1f, 0x51, 0x66, 0xf7, 0x51, 0xf0, 0x68, 0x14, 0x08, 0x5b,
+	0xe7, 0x22, 0x49, 0x20, 0x92, 0xcc, 0x16, 0x96, 0x71, 0xd6, 0xd8, 0x92, 0x65, 0x76, 0x64, 0x19,
+	0x67, 0xe8, 0x9b, 0x85, 0x5e, 0x68, 0x38, 0x63, 0xf2, 0xf9, 0x02, 0x7e, 0x41, 0x45, 0xaf, 0x10,
+	0x6c, 0x8f, 0x40, 0xa4, 0x95, 0xfd, 0xa5, 0x6b, 0x99, 0x11, 0xcd, 0x36, 0x9f, 0x1c, 0x0e, 0x14,
+	0x44, 0x1a, 0xd4, 0x82, 0xc7, 0x63, 0xdf, 0x32, 0x4c, 0x31, 0x54, 0xc6, 0x2b, 0x0a, 0x1e, 0xeb,
+	0x96, 0x61, 0xf2, 0x61, 0x3e, 0x00, 0x75, 0x6a, 0x4c, 0x4f, 0x2d, 0x99, 0xec, 0x3a, 0x17, 0x1e,
+	0xc1, 0x13, 0xca, 0xf7, 0x60, 0x8b, 0x53, 0x9e, 0xda, 0x91, 0xe8, 0x6e, 0x10, 0x61, 0x8d, 0xc0,
+	0x07, 0xb6, 0x10, 0xdc, 0x1d, 0x28, 0x4d, 0xdd, 0xb1, 0x61, 0x9a, 0x7e, 0xd0, 0xb8, 0x49, 0x9e,
+	0x71, 0x71, 0xea, 0x36, 0xb1, 0x88, 0x21, 0x23, 0xb9, 0xb5, 0xf1, 0x8a, 0xa0, 0xa0, 0x4d, 0xd1,
+	0xab, 0x08, 0x7d, 0x21, 0x16, 0x03, 0x55, 0xb0, 0x58, 0x72, 0xfc, 0x6d, 0xc2, 0x17, 0xa7, 0x8b,
+	0x25, 0xa2, 0xb4, 0xbf, 0x7c, 0x0b, 0x72, 0x7d, 0xcf, 0xb4, 0xd8, 0x8f, 0xa1, 0x4c, 0x77, 0x0d,
+	0xd6, 0xf3, 0x76, 0x88, 0xa6, 0x3f, 0x14, 0xde, 0x94, 0x5c, 0xf1, 0x75, 0xf5, 0xed, 0x84, 0xb7,
+	0x21, 0x1f, 0xa0, 0xd7, 0xdd, 0x50, 0xe4, 0xb3, 0x51, 0x72, 0xc4, 0x75, 0x8e, 0xc1, 0xd9, 0xa6,
+	0x30, 0xd7, 0xb7, 0x5c, 0x32, 0x23, 0x79, 0x3d, 0x2e, 0x93, 0x77, 0xe6, 0x7b, 0xa8, 0x94, 0xc6,
+	0x74, 0x56, 0x98, 0xdf, 0xe0, 0x9d, 0x71, 0x3c, 0x5d, 0xe6, 0xf8, 0x31, 0x94, 0x5f, 0x7a, 0xb6,
+	0xcb, 0x3b, 0x5e, 0x58, 0xeb, 0xf8, 0x97, 0x9e, 0xcd, 0x13, 0x8e, 0xa5, 0x97, 0xe2, 0x8b, 0xbd,
+	0x03, 0x45, 0xcf, 0xe5, 0x75, 0x17, 0xd7, 0xea, 0x2e, 0x78, 0x6e, 0x8f, 0x9f, 0x41, 0xd6, 0x26,
+	0x4b, 0x0c, 0xc4, 0x91, 0xd4, 0x9a, 0x85, 0x22, 0xbf, 0x56, 0x21, 0xe0, 0xc0, 0xed, 0x59, 0x33,
+	0x3c, 0x08, 0xab, 0xcc, 0x28, 0x76, 0xe1, 0x95, 0x95, 0xd7, 0x2a, 0x03, 0x8e, 0xa6, 0x0a, 0x7f,
+	0x00, 0xa5, 0x13, 0xdf, 0x5b, 0x2e, 0xd0, 0x8b, 0x84, 0x35, 0xca, 0x22, 0xe1, 0xf6, 0x2e, 0x71,
+	0xf4, 0xf4, 0x69, 0xbb, 0x27, 0xa8, 0x26, 0x1b, 0x95, 0x35, 0xd2, 0x4a, 0x84, 0x1f, 0x5a, 0x54,
+	0xab, 0x71, 0x72, 0xc2, 0xdb, 0xaf, 0xae, 0xd7, 0x6a, 0x9c, 0x9c, 0x50, 0xe3, 0x3f, 0x84, 0xd2,
+	0x39, 0x1e, 0x3d, 0x2d, 0xac, 0x69, 0xa3, 0x26, 0x1f, 0xd0, 0x26, 0x5e, 0xb1, 0x5e, 0x3c, 0xb7,
+	0x5d, 0xfc, 0x48, 0xf9, 0xbb, 0xf5, 0xd7, 0xfa, 0xbb, 0x3b, 0x90, 0x77, 0xec, 0xb9, 0x1d, 0xd2,
+	0xb6, 0x5b, 0x71, 0xec, 0x08, 0xc1, 0x34, 0x28, 0x78, 0xb3, 0x19, 0x0e, 0x46, 0x5d, 0x23, 0x11,
+	0x18, 0xd9, 0xb3, 0x08, 0x2f, 0xd2, 0x77, 0xc3, 0x62, 0x7f, 0x27, 0xf6, 0x2c, 0x56, 0x3d, 0x65,
+	0xf6, 0x1a, 0x0f, 0x6d, 0x17, 0x6a, 0x31, 0xf1, 0xf8, 0x95, 0x35, 0x6d, 0x5c, 0xdf, 0x68, 0xa5,
+	0x2a, 0x11, 0xc3, 0x73, 0x6b, 0x8a, 0xae, 0x0b, 0x5e, 0x02, 0x41, 0x73, 0x79, 0x63, 0xb3, 0xff,
+	0x59, 0xf0, 0x26, 0x2f, 0xd1, 0x58, 0x7e, 0x0c, 0x15, 0x9f, 0x62, 0xad, 0x31, 0x85, 0x64, 0x37,
+	0x65, 0xf1, 0x26, 0x41, 0x98, 0x0e, 0x7e, 0xfc, 0x8d, 0xca, 0x86, 0x9f, 0xe8, 0xf1, 0x23, 0x9c,
+	0x80, 0x76, 0x6d, 0x59, 0xaf, 0x12, 0x90, 0x1f, 0xef, 0x90, 0xb3, 0xc5, 0x8f, 0x55, 0x48, 0x24,
+	0xb7, 0xe5, 0x4e, 0xf0, 0xf3, 0x13, 0x12, 0x89, 0x19, 0x7d, 0xa2, 0x92, 0x9b, 0xd8, 0xae, 0x89,
+	0x0b, 0x27, 0x34, 0x4e, 0x82, 0x46, 0x83, 0xf6, 0x55, 0x45, 0xc0, 0x46, 0xc6, 0x49, 0xc0, 0x3e,
+	0x81, 0xaa, 0xc1, 0x0d, 0xe2, 0xd8, 0x76, 0x67, 0x5e, 0xe3, 0x8e, 0x7c, 0xb6, 0x24, 0x99, 0x4a,
+	0xbd, 0x62, 0x24, 0x05, 0xf6, 0x29, 0xb0, 0x28, 0x8b, 0x46, 0xa1, 0x03, 0x5f, 0x6d, 0x77, 0xd7,
+	0x56, 0xdb, 0x96, 0x48, 0xa3, 0xc5, 0xf7, 0xac, 0x76, 0x00, 0xe3, 0x28, 0xc3, 0x71, 0x2c, 0xc7,
+	0x0e, 0xe6, 0x94, 0x55, 0xc9, 0xeb, 0x32, 0x88, 0x7d, 0x0a, 0xb5, 0xb4, 0x3f, 0x7e, 0x6f, 0x43,
+	0xce, 0x89, 0x26, 0x48, 0xaf, 0x4e, 0xa5, 0x12, 0x4a, 0x10, 0x4f, 0xb8, 0x49, 0x51, 0x12, 0xe3,
+	0x9b, 0xb4, 0x3d, 0xab, 0xae, 0x17, 0xb6, 0x22, 0x18, 0x4a, 0x90, 0x5b, 0x09, 0x92, 0xe0, 0x7d,
+	0x59, 0x82, 0x71, 0x90, 0x81, 0x16, 0x5c, 0x7c, 0xd2, 0xcd, 0x20, 0x6f, 0xe9, 0x4f, 0xad, 0x71,
+	0x10, 0x5a, 0x8b, 0xc6, 0x5b, 0xd4, 0x5f, 0xe0, 0xa0, 0x61, 0x68, 0x2d, 0xd8, 0x67, 0x50, 0x5f,
+	0xf8, 0xd6, 0x58, 0x9a, 0x96, 0x1d, 0xb9, 0xbf, 0x47, 0xbe, 0x95, 0xcc, 0x4c, 0x75, 0x21, 0x95,
+	0x22, 0x4e, 0xa9, 0x3b, 0x6f, 0xaf, 0x70, 0x26, 0x3d, 0xaa, 0x2e, 0xa4, 0x12, 0xfb, 0x39, 0x6c,
+	0x4b, 0x9c, 0xcb, 0x33, 0x62, 0xd6, 0x52, 0xf9, 0xbc, 0x88, 0xfc, 0xf8, 0x0c, 0xd9, 0xeb, 0x8b,
+	0x54, 0x99, 0x35, 0x57, 0xe2, 0x44, 0x0c, 0xcc, 0xde, 0x21, 0xfe, 0xdb, 0x57, 0x04, 0x7f, 0xa9,
+	0x00, 0xf2, 0x99, 0x75, 0xc9, 0x74, 0xb8, 0xe3, 0x2f, 0x5d, 0xf2, 0x38, 0x84, 0xc2, 0xe3, 0xba,
+	0x91, 0x16, 0xc2, 0xbb, 0x3b, 0x4a, 0x52, 0x97, 0xce, 0xc9, 0x78, 0x4a, 0x87, 0x14, 0xc5, 0x2d,
+	0x5f, 0x06, 0xed, 0x21, 0x1f, 0x2d, 0x8e, 0xf5, 0x3a, 0x17, 0xbe, 0x37, 0xb1, 0x78, 0x9d, 0x3f,
+	0xf8, 0x2e, 0x75, 0x1e, 0x21, 0x1f, 0xd5, 0xf9, 0x04, 0x2a, 0x64, 0x0b, 0xe6, 0x56, 0x78, 0xea,
+	0x99, 0x8d, 0xf7, 0xc8, 0x1a, 0xdc, 0x5c, 0xb1, 0x06, 0x87, 0x84, 0xd4, 0xe1, 0x65, 0xfc, 0xcd,
+	0x0e, 0x60, 0x9b, 0xf8, 0x4c, 0x1b, 0xe3, 0x82, 0xc9, 0x92, 0xb2, 0x1a, 0xef, 0x13, 0xf7, 0x1b,
+	0x2b, 0xdc, 0x6d, 0x89, 0x44, 0x57, 0x5f, 0xae, 0x40, 0xd8, 0x2e, 0x80, 0xb1, 0x58, 0x38, 0x97,
+	0xdc, 0x1c, 0x3d, 0xb8, 0xda, 0x1c, 0x95, 0x89, 0x0c, 0x3f, 0xd1, 0x17, 0x13, 0xbe, 0x82, 0x13,
+	0x34, 0x3e, 0x20, 0x03, 0x5f, 0xf2, 0xc9, 0x4b, 0x70, 0x02, 0xed, 0x1f, 0xe4, 0xa0, 0x14, 0x19,
+	0x5f, 0x3c, 0xd9, 0x3c, 0xee, 0x3f, 0xeb, 0x0f, 0x5e, 0xf4, 0xd5, 0x6b, 0x98, 0xf0, 0x78, 0xde,
+	0xec, 0x1d, 0x77, 0xc6, 0xc3, 0x56, 0xb3, 0xcf, 0xef, 0xe9, 0xd1, 0x8d, 0x29, 0x5e, 0xce, 0xb2,
+	0x6d, 0xa8, 0x3d, 0x3d, 0xee, 0xd3, 0xc9, 0x26, 0x07, 0x29, 0x08, 0xea, 0x7c, 0xc5, 0xb3, 0x2a,
+	0x1c, 0x94, 0x43, 0xd0, 0x61, 0x73, 0xd4, 0xd1, 0xbb, 0x11, 0x28, 0x8f, 0xad, 0x1c, 0xe9, 0x83,
+	0x2f, 0x3b, 0xad, 0x91, 0x0a, 0xec, 0x26, 0x6c, 0xc7, 0x2c, 0x51, 0x75, 0x6a, 0x05, 0xf3, 0x33,
+	0x11, 0x9b, 0x7a, 0x03, 0x2b, 0xd1, 0x3b, 0xad, 0x63, 0x7d, 0xd8, 0x7d, 0xde, 0x19, 0xb7, 0x46,
+	0x1d, 0xf5, 0x26, 0x66, 0x6a, 0x86, 0xdd, 0xfe, 0x33, 0xf5, 0x16, 0x1e, 0xb1, 0xe2, 0x17, 0xaf,
+	0xfd, 0x36, 0xe5, 0x72, 0xf6, 0xf7, 0xd5, 0xfb, 0x58, 0x45, 0xbb, 0x3b, 0x1c, 0x75, 0xfb, 0xad,
+	0x91, 0xfa, 0x16, 0xa6, 0x6b, 0x9e, 0x76, 0x7b, 0xa3, 0x8e, 0xae, 0xee, 0x20, 0xef, 0x97, 0x83,
+	0x6e, 0x5f, 0x7d, 0x1b, 0xa1, 0xc3, 0xe6, 0xe1, 0x51, 0xaf, 0xa3, 0x6a, 0x54, 0xe3, 0x40, 0x1f,
+	0xa9, 0xef, 0xb0, 0x32, 0xe4, 0x8f, 0xfb, 0xd8, 0x8f, 0x77, 0xb1, 0x72, 0xfa, 0x1c, 0xe3, 0xad,
+	0xc3, 0x1f, 0x48, 0x49, 0x9f, 0xf7, 0xf0, 0xfb, 0x45, 0xb7, 0xdf, 0x1e, 0xbc, 0x50, 0xdf, 0x47,
+	0xb2, 0x3d, 0x7d, 0xd0, 0x6c, 0xb7, 0x30, 0x37, 0xf4, 0x00, 0x2b, 0x18, 0x1e, 0xf5, 0xba, 0x23,
+	0xf5, 0x03, 0xa4, 0xda, 0x6f, 0x8e, 0x0e, 0x3a, 0xba, 0xfa, 0x10, 0xbf, 0x9b, 0xc3, 0x61, 0x47,
+	0x1f, 0xa9, 0xbb, 0xf8, 0xdd, 0xed, 0xd3, 0xf7, 0x63, 0xaa, 0xf5, 0xa8, 0xdd, 0x1c, 0x75, 0xd4,
+	0x4f, 0xf0, 0xbb, 0xdd, 0xe9, 0x75, 0x46, 0x1d, 0xf5, 0x27, 0x58, 0x2b, 0x25, 0xa9, 0x86, 0x28,
+	0xaa, 0x27, 0x28, 0x85, 0xb8, 0x48, 0xfd, 0xf9, 0x14, 0x1b, 0x3a, 0xec, 0xf6, 0x8f, 0x87, 0xea,
+	0x67, 0x48, 0x4c, 0x9f, 0x84, 0xf9, 0x9c, 0xdd, 0x00, 0x75, 0xd0, 0x1f, 0xb7, 0x8f, 0x8f, 0x7a,
+	0xdd, 0x56, 0x73, 0xd4, 0x19, 0x3f, 0xeb, 0x7c, 0xad, 0x7e, 0x81, 0x73, 0x78, 0xa4, 0x77, 0xc6,
+	0xa2, 0xe5, 0xdf, 0x8b, 0xca, 0xa2, 0xc5, 0x9f, 0x62, 0x13, 0x09, 0x7e, 0x7c, 0xfc, 0x4c, 0xfd,
+	0x99, 0xf6, 0x12, 0x4a, 0xd1, 0xa2, 0xc2, 0xe6, 0xba, 0xfd, 0x7e, 0x07, 0x6f, 0x70, 0x96, 0x20,
+	0xd7, 0xeb, 0x3c, 0x1d, 0xa9, 0x19, 0x04, 0xea, 0xdd, 0xfd, 0x83, 0x91, 0x9a, 0xc5, 0xcf, 0xc1,
+	0x31, 0xca, 0x58, 0x21, 0x69, 0x76, 0x0e, 0xbb, 0x6a, 0x0e, 0xbf, 0x9a, 0xfd, 0x51, 0x57, 0xcd,
+	0x93, 0xb4, 0xbb, 0xfd, 0xfd, 0x5e, 0x47, 0x2d, 0x20, 0xf4, 0xb0, 0xa9, 0x3f, 0x53, 0x8b, 0xc8,
+	0xd4, 0x3c, 0x3a, 0xea, 0x7d, 0xad, 0x96, 0xb4, 0x07, 0x50, 0x6c, 0x9e, 0x9c, 0x1c, 0xa2, 0xbf,
+	0x58, 0x82, 0xdc, 0x53, 0x3c, 0x53, 0xa7, 0xbb, 0xa2, 0x7b, 0x83, 0xd1, 0x68, 0x70, 0xa8, 0x66,
+	0x70, 0x72, 0x47, 0x83, 0x23, 0x35, 0xab, 0xbd, 0x00, 0x48, 0xf6, 0x1a, 0x0e, 0xb6, 0x79, 0x3c,
+	0x1a, 0x8c, 0x71, 0x56, 0xc7, 0x87, 0x9d, 0xd1, 0xc1, 0xa0, 0xad, 0x5e, 0x43, 0x89, 0x1c, 0x34,
+	0x87, 0x07, 0x04, 0x55, 0x33, 0x48, 0xd4, 0xef, 0x0c, 0x47, 0x9d, 0xf6, 0xb8, 0x37, 0x18, 0x1c,
+	0x71, 0x28, 0xde, 0xcd, 0x83, 0xc3, 0x8e, 0xbe, 0xdf, 0xe1, 0x65, 0x45, 0x1b, 0x81, 0xba, 0xba,
+	0x0d, 0xd9, 0x5d, 0xb8, 0x95, 0x54, 0x8f, 0x6b, 0x4a, 0xef, 0xee, 0x1d, 0xd3, 0x42, 0xbd, 0xc6,
+	0x18, 0xd4, 0xe3, 0x99, 0x8f, 0x5a, 0x52, 0xa1, 0x3a, 0x3c, 0x38, 0x7e, 0xfa, 0xb4, 0x27, 0x6a,
+	0xcd, 0x6a, 0x7f, 0x11, 0xb6, 0xd7, 0xb4, 0x0c, 0x26, 0xb1, 0x42, 0xe3, 0x24, 0xba, 0x73, 0x1d,
+	0x1a, 0x27, 0x71, 0x56, 0x34, 0x7b, 0xf5, 0x19, 0x67, 0x7c, 0x19, 0x46, 0x89, 0x0e, 0xf7, 0xe8,
+	0x22, 0x8c, 0xf6, 0x37, 0x32, 0x50, 0x4f, 0x2b, 0x6a, 0x7e, 0x12, 0x98, 0x1c, 0x71, 0xe6, 0x93,
+	0x63, 0xcd, 0x37, 0xa0, 0xbc, 0x38, 0x13, 0xe7, 0x99, 0xc2, 0xb9, 0x2e, 0x2d, 0xce, 0xf8, 0x39,
+	0x26, 0xba, 0xaf, 0x8b, 0x33, 0xae, 0x5f, 0x94, 0xb5, 0xeb, 0x5f, 0x85, 0xc5, 0x59, 0xe4, 0xe3,
+	0x2e, 0x05, 0x51, 0x6e, 0x9d, 0x68, 0x49, 0x44, 0xda, 0x0e, 0x54, 0x65, 0x93, 0x85, 0x03, 0xc6,
+	0xc8, 0x9a, 0x77, 0x06, 0x3f, 0xb5, 0x3f, 0xce, 0x40, 0x35, 0xee, 0xf5, 0xb7, 0x4c, 0xc9, 0xa5,
+	0x5c, 0xb3, 0xec, 0x6b, 0x5c, 0xb3, 0x1d, 0xca, 0x9a, 0x8f, 0xe9, 0x69, 0x08, 0xa6, 0x02, 0x78,
+	0x3e, 0x0e, 0x4e, 0x8d, 0xa0, 0xb9, 0x0c, 0x3d, 0x8c, 0xfa, 0xdf, 0x80, 0xb2, 0x1d, 0x44, 0x97,
+	0x44, 0x72, 0xd1, 0xc1, 0x8c, 0xb8, 0x05, 0x72, 0x0f, 0x0a, 0x3c, 0x21, 0x41, 0x69, 0xd7, 0xe8,
+	0x4e, 0xb7, 0x22, 0xee, 0x71, 0x7b, 0x50, 0x8e, 0x13, 0x03, 0xec, 0x21, 0x5e, 0x2a, 0x5c, 0x88,
+	0x64, 0x59, 0x63, 0x25, 0x6d, 0xf0, 0xe8, 0xd0, 0x58, 0xf0, 0x14, 0x27, 0x12, 0xdd, 0x7d, 0x02,
+	0xa5, 0x08, 0xf0, 0x9d, 0x4e, 0x42, 0xfe, 0x45, 0x16, 0xca, 0x6d, 0xd9, 0x21, 0x9b, 0x1a, 0xee,
+	0x38, 0xf4, 0x97, 0x2e, 0x1a, 0x52, 0x71, 0x71, 0xab, 0x82, 0x51, 0xad, 0x00, 0x45, 0xe2, 0xcc,
+	0xfe, 0x16, 0x71, 0xde, 0x03, 0xf4, 0x1c, 0xc7, 0xb6, 0x49, 0x59, 0x0f, 0x9e, 0x55, 0xc6, 0xbb,
+	0xdc, 0x5d, 0x13, 0xb3, 0x2f, 0x1b, 0xf3, 0x9f, 0xb9, 0x6f, 0x9f, 0xff, 0xcc, 0x6f, 0xcc, 0x7f,
+	0x5e, 0x91, 0xd2, 0x2c, 0x7c, 0xeb, 0x94, 0x66, 0xf1, 0xb7, 0xa6, 0x34, 0x4b, 0x72, 0x4a, 0xf3,
+	0xdf, 0x66, 0x21, 0xff, 0x4b, 0xbc, 0x70, 0xca, 0x9e, 0x40, 0x39, 0x08, 0xe7, 0xa1, 0x1c, 0x82,
+	0xde, 0xe1, 0x22, 0x21, 0x3c, 0x45, 0x90, 0x16, 0x9e, 0x94, 0xf3, 0x78, 0x0e, 0x69, 0xf1, 0x0b,
+	0xe7, 0x03, 0xfd, 0xb5, 0x40, 0x64, 0xbf, 0x79, 0x01, 0xe3, 0x12, 0x8c, 0x47, 0xa3, 0xac, 0x26,
+	0x24, 0x46, 0x58, 0xe7, 0x08, 0x8c, 0x4b, 0xe8, 0x9c, 0x28, 0x3a, 0x7e, 0x4e, 0xc5, 0x25, 0x1c,
+	0x83, 0x81, 0xea, 0xa9, 0x65, 0xa0, 0x03, 0x1d, 0x5d, 0x61, 0x8b, 0xcb, 0xb8, 0x7f, 0x1d, 0xcf,
+	0x30, 0x47, 0xc6, 0x49, 0x74, 0xc9, 0x52, 0x14, 0x91, 0xeb, 0xdc, 0xf0, 0x5d, 0xe2, 0x2a, 0x72,
+	0xae, 0xa8, 0xac, 0xbd, 0x80, 0x5a, 0x6a, 0x20, 0x69, 0xa3, 0x8e, 0x2a, 0xb8, 0xd3, 0x43, 0x7b,
+	0x92, 0x91, 0x4c, 0x50, 0x56, 0x32, 0x3b, 0x8a, 0x64, 0x8e, 0x72, 0x64, 0x60, 0x50, 0x3d, 0xaa,
+	0x79, 0xed, 0x1f, 0x65, 0x61, 0x7b, 0xe4, 0x1b, 0x6e, 0x60, 0xf0, 0x4b, 0x0f, 0x6e, 0xe8, 0x7b,
+	0x0e, 0xfb, 0x02, 0x4a, 0xe1, 0xd4, 0x91, 0x65, 0xfa, 0x96, 0xd8, 0x8c, 0xab, 0xa4, 0x8f, 0x46,
+	0x53, 0x87, 0x24, 0x5b, 0x0c, 0xf9, 0x07, 0xfb, 0x11, 0xe4, 0x27, 0xd6, 0x89, 0xed, 0x8a, 0xf5,
+	0x79, 0x73, 0x95, 0x71, 0x0f, 0x91, 0xf8, 0xc6, 0x89, 0xa8, 0xd8, 0x8f, 0xf1, 0xf2, 0xeb, 0x1c,
+	0x43, 0x41, 0x45, 0xbe, 0x46, 0x23, 0x37, 0x84, 0x58, 0x7c, 0xc7, 0xc4, 0xe9, 0xd8, 0x13, 0x7c,
+	0x95, 0xe0, 0x38, 0x13, 0x63, 0x7a, 0x26, 0xd4, 0x54, 0x63, 0x95, 0x47, 0x17, 0xf8, 0x83, 0x6b,
+	0x7a, 0x4c, 0xab, 0x3d, 0x82, 0xa2, 0xe8, 0x2c, 0x0a, 0x60, 0xaf, 0xb3, 0xdf, 0x15, 0xb2, 0x6b,
+	0x0d, 0x0e, 0x0f, 0xbb, 0x23, 0x7e, 0xed, 0x4b, 0x1f, 0xf4, 0x7a, 0x7b, 0xcd, 0xd6, 0x33, 0x35,
+	0xbb, 0x57, 0x82, 0x82, 0x41, 0x87, 0x87, 0xda, 0x5f, 0xc9, 0xc0, 0xd6, 0xca, 0x00, 0xd8, 0x67,
+	0x90, 0x9b, 0x7b, 0x66, 0x24, 0x9e, 0x77, 0x37, 0x8e, 0x52, 0x2a, 0xa3, 0xf9, 0xd3, 0x89, 0x43,
+	0xfb, 0x1c, 0xea, 0x69, 0xb8, 0x74, 0x9f, 0xbd, 0x06, 0x65, 0xbd, 0xd3, 0x6c, 0x8f, 0x07, 0xfd,
+	0xde, 0xd7, 0xdc, 0x3b, 0xa3, 0xe2, 0x0b, 0xbd, 0x3b, 0xea, 0xa8, 0x59, 0xed, 0x0f, 0x40, 0x5d,
+	0x15, 0x0c, 0xdb, 0x87, 0x2d, 0xbc, 0xf3, 0xe8, 0x58, 0x7c, 0xdf, 0x25, 0x53, 0x76, 0x7f, 0x83,
+	0x24, 0x05, 0x19, 0xcd, 0x58, 0x7d, 0x9a, 0x2a, 0x6b, 0x7f, 0x01, 0xd8, 0xba, 0x04, 0x7f, 0x77,
+	0xd5, 0xff, 0xf7, 0x0c, 0xe4, 0x8e, 0x1c, 0x03, 0x4d, 0x51, 0x9e, 0xee, 0x8a, 0x37, 0x32, 0x72,
+	0xa6, 0x87, 0x76, 0x2b, 0x2e, 0x0b, 0xc2, 0xb1, 0x1f, 0x82, 0x12, 0x4e, 0x1d, 0xb1, 0x86, 0x6e,
+	0x5f, 0xb1, 0xf8, 0xf0, 0x5a, 0x77, 0x38, 0xc5, 0x13, 0x03, 0xc5, 0x34, 0x9d, 0x86, 0x22, 0xc7,
+	0x37, 0x18, 0x32, 0xb7, 0xad, 0x99, 0xed, 0xda, 0xe2, 0xe6, 0x3a, 0x92, 0xe0, 0xdd, 0x75, 0x73,
+	0xea, 0x34, 0x72, 0x72, 0x08, 0x8b, 0x94, 0x52, 0x85, 0xe6, 0x14, 0x93, 0xc6, 0xd5, 0x66, 0x18,
+	0x62, 0x48, 0x68, 0x62, 0x97, 0xd3, 0x37, 0xa6, 0x11, 0xa2, 0xa7, 0xf0, 0x78, 0xaf, 0x1c, 0x51,
+	0xda, 0x87, 0x74, 0x93, 0x1b, 0xed, 0xad, 0x16, 0x7d, 0x6d, 0x38, 0x27, 0x14, 0x18, 0xed, 0xff,
+	0x64, 0xa1, 0x22, 0x35, 0xce, 0x3e, 0x81, 0x92, 0x39, 0x75, 0x36, 0x68, 0x32, 0x89, 0xe8, 0x51,
+	0x3b, 0xda, 0x6f, 0x26, 0xff, 0xc0, 0x6b, 0x06, 0x98, 0x46, 0x78, 0x65, 0xf8, 0x36, 0x6a, 0xd6,
+	0xa0, 0x91, 0x95, 0x63, 0xc4, 0xa1, 0x15, 0x3e, 0x8f, 0x30, 0xf8, 0x8c, 0x2d, 0x90, 0xca, 0xec,
+	0x03, 0xbc, 0x2d, 0x6d, 0x2d, 0x0c, 0x3f, 0x72, 0x0a, 0x6a, 0x71, 0x6c, 0x88, 0x40, 0x7c, 0xd5,
+	0x26, 0xf0, 0x48, 0x6a, 0x5d, 0x58, 0xd3, 0x65, 0x18, 0xb9, 0x06, 0xb5, 0x68, 0x40, 0x04, 0x44,
+	0x52, 0x81, 0xc7, 0x68, 0xc6, 0xb4, 0x0c, 0xc7, 0xf1, 0xc8, 0x7e, 0xe5, 0xe5, 0xcc, 0x46, 0x3b,
+	0x86, 0xf3, 0x27, 0x71, 0x51, 0x49, 0x3b, 0x81, 0xa2, 0x18, 0x18, 0x7a, 0xab, 0x78, 0xdb, 0xf2,
+	0x79, 0x53, 0xef, 0x62, 0x60, 0x32, 0x54, 0xaf, 0xe1, 0x76, 0xdd, 0xd7, 0x9b, 0x7d, 0xa1, 0xde,
+	0xf4, 0xce, 0xf3, 0xc1, 0x33, 0x7c, 0xe2, 0x41, 0xe7, 0xba, 0xfd, 0xaf, 0x55, 0x85, 0x07, 0x1f,
+	0x9d, 0xa3, 0xa6, 0x8e, 0xda, 0xad, 0x02, 0xc5, 0xce, 0x57, 0x9d, 0xd6, 0xf1, 0xa8, 0xa3, 0xe6,
+	0x71, 0x07, 0xb5, 0x3b, 0xcd, 0x5e, 0x6f, 0x80, 0xfe, 0xb2, 0x5a, 0xd8, 0x2b, 0xa3, 0xfb, 0x44,
+	0x92, 0xd4, 0xfe, 0x55, 0x0d, 0xea, 0xe9, 0x55, 0xc2, 0x3e, 0x85, 0x92, 0x69, 0xa6, 0x66, 0xe0,
+	0xde, 0xa6, 0xd5, 0xf4, 0xa8, 0x6d, 0x46, 0x93, 0xc0, 0x3f, 0x30, 0x7b, 0xc9, 0xd7, 0x74, 0x76,
+	0x6d, 0x4d, 0x47, 0x2b, 0xfa, 0xe7, 0xb0, 0x25, 0xee, 0x65, 0x63, 0xc6, 0x67, 0x62, 0x04, 0x56,
+	0x7a, 0xc1, 0xb6, 0x08, 0xd9, 0x16, 0xb8, 0x83, 0x6b, 0x7a, 0x7d, 0x9a, 0x82, 0xb0, 0x9f, 0x42,
+	0xdd, 0xa0, 0x90, 0x37, 0xe6, 0xcf, 0xc9, 0xb7, 0x36, 0x9a, 0x88, 0x93, 0xd8, 0x6b, 0x86, 0x0c,
+	0xc0, 0x65, 0x62, 0xfa, 0xde, 0x22, 0x61, 0xce, 0xcb, 0xcb, 0xa4, 0xed, 0x7b, 0x0b, 0x89, 0xb7,
+	0x6a, 0x4a, 0x65, 0xf6, 0x04, 0xaa, 0xa2, 0xe7, 0xc9, 0x1b, 0xda, 0x78, 0xf7, 0xf0, 0x6e, 0x93,
+	0x51, 0xc7, 0xc7, 0x9b, 0xd3, 0xa4, 0xc8, 0x1e, 0x43, 0x85, 0x77, 0x98, 0xb3, 0x15, 0xe5, 0x95,
+	0x40, 0xbd, 0x8d, 0xb8, 0xc0, 0x88, 0x4b, 0xec, 0xc7, 0x00, 0xd4, 0x4f, 0xce, 0x53, 0x4a, 0x25,
+	0xb0, 0x7c, 0x6f, 0x11, 0xb1, 0x94, 0xcd, 0xa8, 0x20, 0x75, 0x8f, 0xdf, 0xe5, 0x29, 0xaf, 0x77,
+	0x8f, 0xee, 0xa8, 0x24, 0xdd, 0xa3, 0x62, 0xd2, 0x3d, 0xce, 0x06, 0x6b, 0xdd, 0x8b, 0xb8, 0xc0,
+	0x88, 0x4b, 0x71, 0xf7, 0x38, 0x4f, 0x65, 0xb5, 0x7b, 0x11, 0x4b, 0xd9, 0x8c, 0x0a, 0x38, 0x6d,
+	0x91, 0x33, 0x27, 0x06, 0x55, 0x4d, 0x5d, 0x2a, 0x13, 0xb8, 0x68, 0x60, 0xb5, 0x50, 0x06, 0x20,
+	0x77, 0x70, 0xea, 0x9d, 0x4b, 0xdb, 0xbb, 0x26, 0x73, 0x0f, 0x4f, 0xbd, 0x73, 0x79, 0x7f, 0xd7,
+	0x02, 0x19, 0x80, 0xbd, 0xe5, 0x43, 0xa4, 0x3b, 0x79, 0x75, 0xb9, 0xb7, 0x34, 0x42, 0xbc, 0x45,
+	0x85, 0xbd, 0x35, 0xa2, 0x02, 0x0a, 0x85, 0xce, 0x06, 0x42, 0xde, 0xd8, 0x96, 0x2c, 0x14, 0xba,
+	0x9e, 0x14, 0xb5, 0x04, 0x4e, 0x5c, 0xc2, 0xb5, 0xb5, 0x74, 0x65, 0x36, 0x55, 0x5e, 0x5b, 0xc7,
+	0x6e, 0x8a, 0xb1, 0xca, 0x49, 0x05, 0x6b, 0xb2, 0x2b, 0x02, 0xeb, 0x9b, 0xa5, 0xe5, 0x4e, 0xad,
+	0xc6, 0xf6, 0xfa, 0xae, 0x18, 0x0a, 0x5c, 0xb2, 0x2b, 0x22, 0x48, 0xbc, 0xae, 0x63, 0x76, 0xb6,
+	0xba, 0xae, 0x25, 0xe6, 0xaa, 0x29, 0x95, 0x93, 0x0d, 0x15, 0xf3, 0x5e, 0x5f, 0xdb, 0x50, 0x12,
+	0x73, 0xcd, 0x90, 0x01, 0xda, 0xff, 0xce, 0x41, 0x51, 0xe8, 0x01, 0x7c, 0x40, 0xd6, 0xd2, 0x3b,
+	0x18, 0x91, 0xb7, 0x9b, 0xa3, 0xe6, 0x5e, 0x73, 0xd8, 0xe1, 0x41, 0x64, 0x13, 0x73, 0x13, 0x09,
+	0x2c, 0x83, 0xca, 0xad, 0xad, 0x0f, 0x8e, 0x12, 0x50, 0x16, 0xe3, 0x4a, 0xc1, 0xcb, 0x9f, 0xae,
+	0x29, 0x78, 0x4b, 0x85, 0x33, 0x72, 0x00, 0xdd, 0x52, 0x21, 0x2e, 0x5e, 0xce, 0x4b, 0x2c, 0xdd,
+	0x7e, 0xbb, 0xf3, 0x95, 0x5a, 0x48, 0x58, 0x38, 0xa0, 0x18, 0xb3, 0xf0, 0x72, 0x09, 0x3b, 0x33,
+	0xd2, 0x8f, 0xfb, 0xad, 0xa4, 0x9d, 0x32, 0x32, 0x89, 0x6a, 0x9e, 0x77, 0x3b, 0x2f, 0x54, 0x40,
+	0x26, 0x5e, 0x0b, 0x95, 0x2b, 0xe8, 0x8d, 0x50, 0x25, 0x54, 0xac, 0xb2, 0xdb, 0x70, 0x7d, 0x78,
+	0x30, 0x78, 0x31, 0xe6, 0x4c, 0xf1, 0x10, 0x6a, 0x18, 0x84, 0x4b, 0x08, 0x5e, 0x7d, 0x1d, 0x9b,
+	0x24, 0x68, 0x44, 0x38, 0x54, 0xb7, 0xb0, 0x49, 0x82, 0x8d, 0xb8, 0x6a, 0x57, 0x79, 0x54, 0x8d,
+	0xac, 0x83, 0xde, 0xf1, 0x61, 0x7f, 0xa8, 0x6e, 0x63, 0x27, 0x08, 0xc2, 0x7b, 0xce, 0xe2, 0x6a,
+	0x12, 0x83, 0x70, 0x9d, 0x6c, 0x04, 0xc2, 0x5e, 0x34, 0xf5, 0x7e, 0xb7, 0xbf, 0x3f, 0x54, 0x6f,
+	0xc4, 0x35, 0x77, 0x74, 0x7d, 0xa0, 0x0f, 0xd5, 0x9b, 0x31, 0x60, 0x38, 0x6a, 0x8e, 0x8e, 0x87,
+	0xea, 0xad, 0xb8, 0x97, 0x47, 0xfa, 0xa0, 0xd5, 0x19, 0x0e, 0x7b, 0xdd, 0xe1, 0x48, 0xbd, 0x8d,
+	0xa9, 0xaa, 0xa4, 0x47, 0x11, 0x71, 0x43, 0xea, 0xa8, 0xbe, 0xdf, 0x19, 0xa9, 0x77, 0xe2, 0x6e,
+	0xb4, 0x06, 0x3d, 0x7c, 0x55, 0x38, 0xe8, 0xab, 0x77, 0x91, 0xa8, 0x37, 0x68, 0x3d, 0x8b, 0x46,
+	0xf3, 0x06, 0xf6, 0xeb, 0xb8, 0x2f, 0x83, 0xee, 0x49, 0x4b, 0x63, 0xd8, 0xf9, 0xe5, 0x71, 0xa7,
+	0xdf, 0xea, 0xa8, 0x6f, 0x26, 0x4b, 0x23, 0x86, 0xdd, 0x8f, 0x97, 0x46, 0x0c, 0x7a, 0x2b, 0x6e,
+	0x33, 0x02, 0x0d, 0xd5, 0x9d, 0xbd, 0x2a, 0x3d, 0x2f, 0x17, 0x86, 0x48, 0xfb, 0x12, 0x98, 0xfc,
+	0x0c, 0x54, 0x3c, 0x01, 0x62, 0x90, 0x9b, 0xf9, 0xde, 0x3c, 0xba, 0xec, 0x86, 0xdf, 0x94, 0x56,
+	0x5f, 0x4e, 0x28, 0x3b, 0x9b, 0xdc, 0xbe, 0x92, 0x41, 0xda, 0xdf, 0xcb, 0x40, 0x3d, 0x6d, 0x84,
+	0xf0, 0x3c, 0xcb, 0x9e, 0x8d, 0x31, 0x67, 0x4e, 0xcf, 0x54, 0x82, 0x28, 0x1a, 0xb5, 0x67, 0x7d,
+	0x2f, 0xa4, 0x77, 0x2a, 0x14, 0xec, 0xc4, 0x36, 0x85, 0xd7, 0x1a, 0x97, 0x59, 0x17, 0xae, 0xa7,
+	0x5e, 0xbe, 0xa6, 0x1e, 0x09, 0x35, 0xe2, 0xa7, 0x83, 0x2b, 0xfd, 0xd7, 0x59, 0xb0, 0x06, 0xd3,
+	0x0e, 0xa0, 0x96, 0xb2, 0x70, 0x14, 0xe2, 0xcf, 0xd2, 0xfd, 0x2a, 0xd9, 0xb3, 0xd7, 0x77, 0x4a,
+	0xdb, 0x87, 0xaa, 0x6c, 0xee, 0xbe, 0x7f, 0x45, 0x6f, 0x41, 0xf9, 0xe9, 0x59, 0xf4, 0x66, 0x69,
+	0xd3, 0x2d, 0xc4, 0xff, 0x99, 0x85, 0x8a, 0x64, 0x1f, 0xbf, 0x95, 0x38, 0xef, 0x41, 0x39, 0xb4,
+	0xe6, 0x0b, 0xcf, 0x37, 0x84, 0x37, 0x51, 0xd2, 0x13, 0x40, 0xaa, 0x3b, 0xca, 0x8a, 0xb0, 0xbf,
+	0xd3, 0xfd, 0xa3, 0x8f, 0xa1, 0x2a, 0xbd, 0x54, 0x0a, 0xc4, 0x79, 0xe9, 0x2a, 0x7d, 0x25, 0x79,
+	0xb5, 0x14, 0x60, 0x28, 0x3e, 0x3b, 0x1b, 0x9b, 0x13, 0x1e, 0xd2, 0x97, 0xf1, 0x02, 0x72, 0x7b,
+	0x42, 0x69, 0xa7, 0x59, 0xac, 0xf8, 0x45, 0xdc, 0x3a, 0x8b, 0xd4, 0xfb, 0x03, 0x28, 0xce, 0xce,
+	0x78, 0x8e, 0xba, 0x24, 0x5f, 0xbd, 0x88, 0xe5, 0xa6, 0x17, 0x66, 0x67, 0xf4, 0x24, 0xe8, 0x73,
+	0x50, 0x57, 0xb2, 0x07, 0x41, 0xa3, 0xbc, 0xb1, 0x53, 0x5b, 0xe9, 0x54, 0x42, 0xa0, 0xfd, 0x9b,
+	0x0c, 0xd4, 0x13, 0x7f, 0x02, 0xe7, 0x96, 0x3d, 0xe4, 0x2f, 0x1d, 0xb9, 0x0f, 0xd7, 0x58, 0x75,
+	0x39, 0x90, 0x04, 0x93, 0x5a, 0xfc, 0xdd, 0xe3, 0xa6, 0xab, 0xe7, 0x9b, 0x1e, 0x72, 0x29, 0x9b,
+	0x1e, 0x72, 0x69, 0xfb, 0xa0, 0x8c, 0x2e, 0x17, 0x3c, 0x8c, 0x44, 0x15, 0xc6, 0xdd, 0x55, 0xae,
+	0xbc, 0x28, 0xb5, 0x89, 0x39, 0x5a, 0xba, 0x79, 0x78, 0xa4, 0x77, 0x0f, 0x9b, 0xfa, 0xd7, 0x94,
+	0xb4, 0x25, 0x25, 0xff, 0x74, 0xa0, 0x77, 0xba, 0xfb, 0x7d, 0x02, 0xe4, 0x28, 0xc8, 0x4c, 0xba,
+	0xd8, 0x34, 0xcd, 0xa7, 0x67, 0xf2, 0xf3, 0xec, 0x4c, 0xea, 0x79, 0x76, 0x7c, 0xc1, 0x5d, 0x7e,
+	0xb5, 0x16, 0x46, 0x9d, 0x8a, 0x17, 0xa3, 0x92, 0x2c, 0x46, 0xbc, 0xa6, 0x8e, 0x37, 0xc6, 0xd3,
+	0x4e, 0x63, 0xfa, 0x4a, 0x39, 0x11, 0x68, 0xbf, 0xc9, 0x00, 0x4b, 0x75, 0x84, 0xfb, 0x31, 0xdf,
+	0xb7, 0x2f, 0x9f, 0x42, 0x43, 0xbc, 0x61, 0xe4, 0x54, 0xe2, 0x41, 0x26, 0x9d, 0x28, 0x71, 0x91,
+	0xde, 0xe4, 0x78, 0x6a, 0x2e, 0xb9, 0x37, 0xcf, 0x3e, 0x02, 0xfe, 0x0e, 0x0f, 0x8f, 0x13, 0xd3,
+	0x11, 0x9b, 0xb4, 0xa7, 0xf4, 0x84, 0x06, 0xd3, 0x5a, 0xf2, 0xa4, 0xf1, 0x97, 0x75, 0x3c, 0x57,
+	0xb5, 0x95, 0xcc, 0x1a, 0xed, 0x33, 0xed, 0x8f, 0x32, 0x70, 0x3d, 0xbd, 0x20, 0xfe, 0x7c, 0xa3,
+	0x4c, 0x3f, 0x23, 0x54, 0x56, 0x9f, 0x11, 0x6e, 0x5a, 0x4f, 0xb9, 0x8d, 0xeb, 0xe9, 0xaf, 0x66,
+	0xe0, 0x86, 0x24, 0xfd, 0xc4, 0xf3, 0xfc, 0x7f, 0xd4, 0x33, 0xe9, 0x35, 0x61, 0x2e, 0xf5, 0x9a,
+	0x50, 0xfb, 0x97, 0x8a, 0x2c, 0xa2, 0xe4, 0x75, 0xd0, 0x47, 0xf2, 0xde, 0x7a, 0x73, 0x75, 0x6f,
+	0xc5, 0x74, 0xc9, 0x06, 0xfb, 0x5c, 0x4e, 0xf4, 0x25, 0xf9, 0xdd, 0xcd, 0x0f, 0x0b, 0x92, 0xf4,
+	0x1f, 0x3f, 0x84, 0xbf, 0xe2, 0x91, 0x91, 0x72, 0xe5, 0x23, 0x23, 0xf6, 0x39, 0xdc, 0x71, 0xad,
+	0xf3, 0xf1, 0x66, 0xbe, 0x1c, 0xf1, 0xdd, 0x72, 0xad, 0xf3, 0xa3, 0x0d, 0xac, 0x0f, 0x40, 0xb5,
+	0x2e, 0xa6, 0xa7, 0x86, 0x7b, 0x62, 0x8d, 0xcd, 0xd4, 0x4f, 0x1b, 0xd4, 0x23, 0x78, 0x9b, 0x0b,
+	0xfd, 0x11, 0x5c, 0x8f, 0x29, 0x25, 0xe9, 0xf3, 0xc7, 0x24, 0xdb, 0x11, 0x2a, 0xae, 0x9a, 0xfd,
+	0x08, 0xd8, 0xb9, 0x1d, 0x9e, 0x7a, 0x4b, 0x8c, 0xd4, 0x1d, 0xdb, 0xe4, 0x56, 0x98, 0x5f, 0xd3,
+	0xdc, 0x16, 0x98, 0xe7, 0x31, 0x42, 0x6b, 0x73, 0xad, 0x82, 0xc7, 0x5e, 0xed, 0x36, 0x3f, 0x98,
+	0x41, 0xe7, 0x80, 0xe7, 0xa8, 0x22, 0x47, 0x8e, 0xff, 0xca, 0x41, 0xe7, 0xab, 0xd6, 0x41, 0xb3,
+	0xbf, 0x8f, 0x8e, 0x23, 0xa5, 0x8b, 0x06, 0xfa, 0x7e, 0xb3, 0xdf, 0xfd, 0xfd, 0x8e, 0x9a, 0xd3,
+	0xbe, 0x80, 0x9b, 0xc9, 0xc4, 0x1c, 0x5a, 0xfe, 0x89, 0x75, 0xe4, 0x39, 0xf6, 0xf4, 0x12, 0x93,
+	0xcc, 0x73, 0x2c, 0x8e, 0x17, 0x54, 0x16, 0x0b, 0xaa, 0x32, 0x4f, 0x48, 0xb4, 0xeb, 0xb0, 0x9d,
+	0xf0, 0x62, 0x6a, 0xc7, 0x98, 0x86, 0xda, 0x7f, 0xce, 0x01, 0x24, 0xd0, 0x94, 0x35, 0xca, 0xfc,
+	0x36, 0x6b, 0x94, 0x7d, 0xfd, 0xad, 0xe4, 0x6f, 0x79, 0xc9, 0xf6, 0x63, 0x28, 0xf2, 0xa4, 0x5c,
+	0x94, 0x7f, 0xbd, 0xbd, 0xba, 0x00, 0x1f, 0x89, 0x57, 0x9f, 0x11, 0xdd, 0xdd, 0x7f, 0xac, 0x40,
+	0x81, 0xc3, 0xe8, 0x91, 0x88, 0xef, 0x45, 0xbf, 0xcd, 0x70, 0x63, 0x93, 0x5d, 0xa0, 0x1f, 0x46,
+	0x42, 0x13, 0xf2, 0x08, 0x0a, 0x98, 0x24, 0x9f, 0x9d, 0xa5, 0x13, 0x99, 0x2b, 0x2a, 0x1a, 0x33,
+	0x56, 0x06, 0x7e, 0xb0, 0x4f, 0xa1, 0x8c, 0xf4, 0x3c, 0x30, 0x4c, 0x79, 0x38, 0xeb, 0xca, 0x14,
+	0xf3, 0x92, 0x86, 0xf8, 0x66, 0x3f, 0x4b, 0xc7, 0xa1, 0x5c, 0xd3, 0xdd, 0x5d, 0x63, 0xbd, 0x2a,
+	0x22, 0x6d, 0xc3, 0x16, 0x67, 0x4f, 0x1e, 0xee, 0xf0, 0xd0, 0xfe, 0xce, 0x95, 0x5b, 0x13, 0xc3,
+	0x28, 0xe2, 0x89, 0x21, 0xec, 0x17, 0x2b, 0x2b, 0x82, 0xc7, 0xf8, 0x6f, 0xac, 0x56, 0x21, 0x2d,
+	0x22, 0x0c, 0xa7, 0xa5, 0x05, 0xc3, 0x1e, 0xd3, 0x13, 0x35, 0x5c, 0x26, 0x22, 0xd2, 0x5f, 0x9b,
+	0x19, 0xb1, 0x8a, 0x30, 0x57, 0x24, 0x28, 0xa5, 0x1c, 0xeb, 0x3f, 0xc7, 0x53, 0x90, 0x38, 0xa6,
+	0xff, 0xbe, 0x3e, 0x59, 0xf2, 0x43, 0x5f, 0x8a, 0xf4, 0x43, 0x5f, 0xab, 0x96, 0x41, 0x56, 0x05,
+	0x5b, 0x69, 0xfd, 0x1b, 0xac, 0xdf, 0x2e, 0xc9, 0x7f, 0xcb, 0xdb, 0x25, 0x77, 0xa0, 0x14, 0x9d,
+	0x7a, 0x90, 0xf8, 0x72, 0x7a, 0x31, 0xe4, 0x67, 0x1d, 0xab, 0x6f, 0xa6, 0x8b, 0x3b, 0xca, 0xca,
+	0x9b, 0xe9, 0x2b, 0xf5, 0x5c, 0xe9, 0xea, 0xc7, 0x94, 0xdf, 0x40, 0x39, 0x0e, 0xe2, 0xbf, 0xbf,
+	0xc0, 0xbe, 0x8b, 0xd7, 0xa8, 0xfd, 0x61, 0x14, 0x21, 0xc4, 0x31, 0xf4, 0x9f, 0x37, 0x42, 0x48,
+	0x35, 0xaf, 0xbc, 0xa6, 0xf9, 0x0b, 0xee, 0xb9, 0xc7, 0x8d, 0xff, 0x8e, 0x57, 0x89, 0x3c, 0x81,
+	0xb9, 0xd4, 0x04, 0x6a, 0x5b, 0x22, 0xfa, 0x88, 0xa3, 0xff, 0x7f, 0x9d, 0x89, 0x5c, 0xfb, 0xf8,
+	0x21, 0xd8, 0x95, 0xaa, 0x30, 0x6e, 0x2d, 0x2b, 0xb7, 0xf6, 0xbd, 0xfd, 0xa2, 0xf7, 0x21, 0x2f,
+	0x6b, 0x8a, 0x0d, 0x3e, 0x11, 0xc7, 0xaf, 0xfe, 0xc6, 0x40, 0x7e, 0xf5, 0x37, 0x06, 0x34, 0x4d,
+	0x68, 0x73, 0x3e, 0x84, 0x1b, 0x51, 0xbd, 0xd1, 0xef, 0x23, 0x60, 0x01, 0xdd, 0xd2, 0x72, 0xe2,
+	0x1e, 0x7d, 0xf7, 0x61, 0xfe, 0xce, 0x1c, 0xa3, 0x3f, 0xca, 0x42, 0x2d, 0x95, 0x2c, 0xfb, 0x1e,
+	0x9d, 0xd9, 0xa8, 0x07, 0x94, 0xcd, 0x7a, 0xe0, 0xca, 0x2d, 0x99, 0xbb, 0xda, 0xf5, 0xf8, 0xff,
+	0xa1, 0x3b, 0xb4, 0xbf, 0x95, 0x89, 0x7f, 0x3d, 0x80, 0x57, 0xb6, 0xc9, 0x9a, 0x66, 0x36, 0x5a,
+	0xd3, 0xfb, 0xf1, 0xaf, 0x43, 0x75, 0xdb, 0xfc, 0x24, 0xb4, 0xa6, 0x4b, 0x10, 0x74, 0xa5, 0xf8,
+	0x59, 0x05, 0xb7, 0x4d, 0x63, 0x6f, 0x16, 0xfd, 0x30, 0x55, 0x37, 0x7a, 0xab, 0x74, 0x8b, 0x13,
+	0xf0, 0xdf, 0x98, 0x98, 0x25, 0xbf, 0x50, 0xd5, 0x85, 0x5a, 0x2a, 0x39, 0x29, 0xfd, 0x88, 0x5c,
+	0x46, 0xfe, 0x11, 0x39, 0x3c, 0x72, 0x3d, 0x3f, 0xb5, 0x7c, 0x6b, 0xc3, 0x4f, 0x3f, 0x71, 0x04,
+	0xfe, 0xd0, 0x8e, 0x7c, 0x8c, 0xc1, 0x3e, 0x84, 0xbc, 0x1d, 0x5a, 0xf3, 0xe8, 0x69, 0xda, 0xad,
+	0xf5, 0x93, 0x0e, 0x7a, 0x19, 0xcf, 0x89, 0xb4, 0x3f, 0xc1, 0x9f, 0xca, 0x5a, 0xc1, 0x49, 0xbf,
+	0x74, 0x97, 0xb9, 0xe2, 0x97, 0xee, 0xb2, 0xa9, 0x4e, 0x6e, 0xf8, 0xb5, 0xba, 0xe4, 0x71, 0x52,
+	0xee, 0x8a, 0xc7, 0x49, 0xec, 0x3d, 0x28, 0xf9, 0x16, 0xfd, 0xba, 0x98, 0xd9, 0xc8, 0xaf, 0x11,
+	0xc5, 0x38, 0xed, 0xaf, 0x65, 0xa0, 0x28, 0xce, 0x5c, 0x36, 0x3e, 0x54, 0xfc, 0x00, 0x8a, 0xfc,
+	0x97, 0xc6, 0xa2, 0xdf, 0xc7, 0x5a, 0x3b, 0xf4, 0x8f, 0xf0, 0x78, 0xd9, 0x04, 0x51, 0xe9, 0x4b,
+	0x1e, 0x74, 0x62, 0x45, 0x70, 0x5c, 0x4d, 0x74, 0x48, 0x4d, 0x67, 0x1c, 0x81, 0xb8, 0x46, 0x0d,
+	0x04, 0xc2, 0x4c, 0x66, 0xa0, 0xfd, 0x0c, 0x8a, 0xe2, 0x4c, 0x67, 0x63, 0x57, 0x5e, 0xf7, 0x3b,
+	0x5d, 0x3b, 0x00, 0xc9, 0x21, 0xcf, 0xa6, 0x1a, 0x34, 0x47, 0x3c, 0xcd, 0xc4, 0xa4, 0x30, 0x85,
+	0x6d, 0x1f, 0xe1, 0x8f, 0xfd, 0x88, 0x27, 0xb2, 0x99, 0xab, 0x9f, 0xc8, 0xc6, 0x44, 0xec, 0x21,
+	0xc4, 0x26, 0xe1, 0x75, 0x9e, 0xa5, 0xd6, 0x04, 0x48, 0xb2, 0xcf, 0xf8, 0xab, 0x0a, 0xf1, 0x43,
+	0xdb, 0x68, 0xf9, 0xac, 0x36, 0x86, 0x7d, 0xd2, 0x25, 0x32, 0xad, 0x0e, 0x55, 0x39, 0x85, 0xfd,
+	0xf0, 0x6d, 0xa8, 0xca, 0x3f, 0xad, 0x44, 0xa7, 0xb7, 0x9e, 0x6b, 0xf1, 0x17, 0x87, 0xbd, 0x5f,
+	0x7d, 0xa2, 0x66, 0x1e, 0xfe, 0xa1, 0xf4, 0x9b, 0x01, 0x44, 0x23, 0xf2, 0x00, 0x74, 0xff, 0xae,
+	0xd7, 0xed, 0x77, 0x9a, 0x3a, 0x45, 0xfd, 0xf4, 0x36, 0x11, 0xaf, 0x33, 0xf1, 0x0c, 0x81, 0xc0,
+	0x10, 0x40, 0xa1, 0x2b, 0x58, 0xe4, 0xd8, 0xd3, 0x7d, 0x3b, 0xfa, 0x8c, 0xd3, 0xa4, 0x79, 0x64,
+	0xa4, 0x0c, 0x66, 0x01, 0x53, 0xa8, 0xf8, 0x15, 0xe3, 0x8a, 0x0f, 0x7f, 0x01, 0x8d, 0xab, 0x8e,
+	0x65, 0xb1, 0xd6, 0xd6, 0x41, 0x93, 0x8e, 0xbe, 0xab, 0x50, 0xea, 0x0f, 0xc6, 0xbc, 0x94, 0xc1,
+	0x63, 0x33, 0xbd, 0xd3, 0xeb, 0x50, 0x52, 0xfa, 0xe1, 0xaf, 0x33, 0xd2, 0x2c, 0x45, 0xc7, 0x72,
+	0x31, 0x40, 0x0c, 0x57, 0x06, 0xe9, 0x96, 0x61, 0xaa, 0x19, 0x76, 0x0b, 0x58, 0x0a, 0xd4, 0xf3,
+	0xa6, 0x86, 0xa3, 0x66, 0x29, 0xfd, 0x1c, 0xc1, 0x5f, 0xf8, 0x76, 0x68, 0xa9, 0x0a, 0x7b, 0x13,
+	0xee, 0xc4, 0xb0, 0x9e, 0x77, 0x7e, 0xe4, 0xdb, 0x9e, 0x6f, 0x87, 0x97, 0x1c, 0x9d, 0xdb, 0xfb,
+	0xf9, 0xbf, 0xfb, 0xcd, 0xfd, 0xcc, 0x7f, 0xfc, 0xcd, 0xfd, 0xcc, 0x7f, 0xfb, 0xcd, 0xfd, 0x6b,
+	0x7f, 0xf2, 0x3f, 0xee, 0x67, 0x7e, 0x5f, 0xfe, 0xdd, 0xd9, 0xb9, 0x11, 0xfa, 0xf6, 0x05, 0x37,
+	0x90, 0x51, 0xc1, 0xb5, 0x3e, 0x5a, 0x9c, 0x9d, 0x7c, 0xb4, 0x98, 0x7c, 0x84, 0x33, 0x3a, 0x29,
+	0xd0, 0xcf, 0xcf, 0x3e, 0xfe, 0xbf, 0x03, 0x00, 0x60, 0xbd, 0x0c, 0x3e, 0xc1, 0x56, 0x00, 0x00,
 }
 
 func (m *Type) Marshal() (dAtA []byte, err error) {
@@ -11750,6 +12436,34 @@ func (m *TableDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.BloomFilter != nil {
+		{
+			size, err := m.BloomFilter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
+	if m.Ttl != nil {
+		{
+			size, err := m.Ttl.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
 	if m.TableLockType != 0 {
 		i = encodeVarintPlan(dAtA, i, uint64(m.TableLockType))
 		i--
@@ -11877,20 +12591,20 @@ func (m *TableDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.RefChildTbls) > 0 {
-		dAtA42 := make([]byte, len(m.RefChildTbls)*10)
-		var j41 int
+		dAtA44 := make([]byte, len(m.RefChildTbls)*10)
+		var j43 int
 		for _, num := range m.RefChildTbls {
 			for num >= 1<<7 {
-				dAtA42[j41] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA44[j43] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j41++
+				j43++
 			}
-			dAtA42[j41] = uint8(num)
-			j41++
+			dAtA44[j43] = uint8(num)
+			j43++
 		}
-		i -= j41
-		copy(dAtA[i:], dAtA42[:j41])
-		i = encodeVarintPlan(dAtA, i, uint64(j41))
+		i -= j43
+		copy(dAtA[i:], dAtA44[:j43])
+		i = encodeVarintPlan(dAtA, i, uint64(j43))
 		i--
 		dAtA[i] = 0x72
 	}
@@ -12056,6 +12770,81 @@ func (m *TableDef_DefType_Properties) MarshalToSizedBuffer(dAtA []byte) (int, er
 	}
 	return len(dAtA) - i, nil
 }
+func (m *TTLDef) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TTLDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TTLDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Interval != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Col) > 0 {
+		i -= len(m.Col)
+		copy(dAtA[i:], m.Col)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Col)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BloomFilterDef) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BloomFilterDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BloomFilterDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Cols) > 0 {
+		for iNdEx := len(m.Cols) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Cols[iNdEx])
+			copy(dAtA[i:], m.Cols[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.Cols[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TableFunction) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -12414,21 +13203,21 @@ func (m *OnDuplicateKeyCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.OnDuplicateIdx) > 0 {
-		dAtA49 := make([]byte, len(m.OnDuplicateIdx)*10)
-		var j48 int
+		dAtA51 := make([]byte, len(m.OnDuplicateIdx)*10)
+		var j50 int
 		for _, num1 := range m.OnDuplicateIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA49[j48] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA51[j50] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j48++
+				j50++
 			}
-			dAtA49[j48] = uint8(num)
-			j48++
+			dAtA51[j50] = uint8(num)
+			j50++
 		}
-		i -= j48
-		copy(dAtA[i:], dAtA49[:j48])
-		i = encodeVarintPlan(dAtA, i, uint64(j48))
+		i -= j50
+		copy(dAtA[i:], dAtA51[:j50])
+		i = encodeVarintPlan(dAtA, i, uint64(j50))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -12487,20 +13276,20 @@ func (m *InsertCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x30
 	}
 	if len(m.PartitionTableIds) > 0 {
-		dAtA52 := make([]byte, len(m.PartitionTableIds)*10)
-		var j51 int
+		dAtA54 := make([]byte, len(m.PartitionTableIds)*10)
+		var j53 int
 		for _, num := range m.PartitionTableIds {
 			for num >= 1<<7 {
-				dAtA52[j51] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA54[j53] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j51++
+				j53++
 			}
-			dAtA52[j51] = uint8(num)
-			j51++
+			dAtA54[j53] = uint8(num)
+			j53++
 		}
-		i -= j51
-		copy(dAtA[i:], dAtA52[:j51])
-		i = encodeVarintPlan(dAtA, i, uint64(j51))
+		i -= j53
+		copy(dAtA[i:], dAtA54[:j53])
+		i = encodeVarintPlan(dAtA, i, uint64(j53))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -12706,21 +13495,21 @@ func (m *UpdateCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.OnRestrictIdx) > 0 {
-		dAtA56 := make([]byte, len(m.OnRestrictIdx)*10)
-		var j55 int
+		dAtA58 := make([]byte, len(m.OnRestrictIdx)*10)
+		var j57 int
 		for _, num1 := range m.OnRestrictIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA56[j55] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA58[j57] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j55++
+				j57++
 			}
-			dAtA56[j55] = uint8(num)
-			j55++
+			dAtA58[j57] = uint8(num)
+			j57++
 		}
-		i -= j55
-		copy(dAtA[i:], dAtA56[:j55])
-		i = encodeVarintPlan(dAtA, i, uint64(j55))
+		i -= j57
+		copy(dAtA[i:], dAtA58[:j57])
+		i = encodeVarintPlan(dAtA, i, uint64(j57))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -12739,21 +13528,21 @@ func (m *UpdateCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.IdxIdx) > 0 {
-		dAtA58 := make([]byte, len(m.IdxIdx)*10)
-		var j57 int
+		dAtA60 := make([]byte, len(m.IdxIdx)*10)
+		var j59 int
 		for _, num1 := range m.IdxIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA58[j57] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA60[j59] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j57++
+				j59++
 			}
-			dAtA58[j57] = uint8(num)
-			j57++
+			dAtA60[j59] = uint8(num)
+			j59++
 		}
-		i -= j57
-		copy(dAtA[i:], dAtA58[:j57])
-		i = encodeVarintPlan(dAtA, i, uint64(j57))
+		i -= j59
+		copy(dAtA[i:], dAtA60[:j59])
+		i = encodeVarintPlan(dAtA, i, uint64(j59))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -12854,6 +13643,71 @@ func (m *AnalyzeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.CpuTime != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.CpuTime))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.LockWaitTime != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.LockWaitTime))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if len(m.CnAddrs) > 0 {
+		for iNdEx := len(m.CnAddrs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CnAddrs[iNdEx])
+			copy(dAtA[i:], m.CnAddrs[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.CnAddrs[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if m.CacheHitCount != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.CacheHitCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.CacheReadCount != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.CacheReadCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.S3ReadCount != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.S3ReadCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.PrunedBlocks != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.PrunedBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.TotalBlocks != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.TotalBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MemoryPeak != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.MemoryPeak))
+		i--
+		dAtA[i] = 0x78
+	}
 	if m.InsertTime != 0 {
 		i = encodeVarintPlan(dAtA, i, uint64(m.InsertTime))
 		i--
@@ -12951,6 +13805,70 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ReadCols) > 0 {
+		for iNdEx := len(m.ReadCols) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReadCols[iNdEx])
+			copy(dAtA[i:], m.ReadCols[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.ReadCols[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if m.ApplyType != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.ApplyType))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.JoinDistribution != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.JoinDistribution))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.JoinMethod != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.JoinMethod))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb0
+	}
+	if len(m.RuntimeFilterProbeList) > 0 {
+		for iNdEx := len(m.RuntimeFilterProbeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RuntimeFilterProbeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.RuntimeFilterBuildList) > 0 {
+		for iNdEx := len(m.RuntimeFilterBuildList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RuntimeFilterBuildList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
 	if m.OnDuplicateKey != nil {
 		{
 			size, err := m.OnDuplicateKey.MarshalToSizedBuffer(dAtA[:i])
@@ -13092,21 +14010,21 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xca
 	}
 	if len(m.BindingTags) > 0 {
-		dAtA67 := make([]byte, len(m.BindingTags)*10)
-		var j66 int
+		dAtA69 := make([]byte, len(m.BindingTags)*10)
+		var j68 int
 		for _, num1 := range m.BindingTags {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA67[j66] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA69[j68] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j66++
+				j68++
 			}
-			dAtA67[j66] = uint8(num)
-			j66++
+			dAtA69[j68] = uint8(num)
+			j68++
 		}
-		i -= j66
-		copy(dAtA[i:], dAtA67[:j66])
-		i = encodeVarintPlan(dAtA, i, uint64(j66))
+		i -= j68
+		copy(dAtA[i:], dAtA69[:j68])
+		i = encodeVarintPlan(dAtA, i, uint64(j68))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -13359,21 +14277,21 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Children) > 0 {
-		dAtA77 := make([]byte, len(m.Children)*10)
-		var j76 int
+		dAtA79 := make([]byte, len(m.Children)*10)
+		var j78 int
 		for _, num1 := range m.Children {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA77[j76] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA79[j78] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j76++
+				j78++
 			}
-			dAtA77[j76] = uint8(num)
-			j76++
+			dAtA79[j78] = uint8(num)
+			j78++
 		}
-		i -= j76
-		copy(dAtA[i:], dAtA77[:j76])
-		i = encodeVarintPlan(dAtA, i, uint64(j76))
+		i -= j78
+		copy(dAtA[i:], dAtA79[:j78])
+		i = encodeVarintPlan(dAtA, i, uint64(j78))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -13402,6 +14320,57 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RuntimeFilterSpec) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RuntimeFilterSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RuntimeFilterSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ColName) > 0 {
+		i -= len(m.ColName)
+		copy(dAtA[i:], m.ColName)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.ColName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Expr != nil {
+		{
+			size, err := m.Expr.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Tag != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Tag))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PreInsertUkCtx) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -13456,21 +14425,21 @@ func (m *PreInsertUkCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x10
 	}
 	if len(m.Columns) > 0 {
-		dAtA82 := make([]byte, len(m.Columns)*10)
-		var j81 int
+		dAtA85 := make([]byte, len(m.Columns)*10)
+		var j84 int
 		for _, num1 := range m.Columns {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA82[j81] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA85[j84] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j81++
+				j84++
 			}
-			dAtA82[j81] = uint8(num)
-			j81++
+			dAtA85[j84] = uint8(num)
+			j84++
 		}
-		i -= j81
-		copy(dAtA[i:], dAtA82[:j81])
-		i = encodeVarintPlan(dAtA, i, uint64(j81))
+		i -= j84
+		copy(dAtA[i:], dAtA85[:j84])
+		i = encodeVarintPlan(dAtA, i, uint64(j84))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -13502,21 +14471,21 @@ func (m *PreDeleteCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Idx) > 0 {
-		dAtA84 := make([]byte, len(m.Idx)*10)
-		var j83 int
+		dAtA87 := make([]byte, len(m.Idx)*10)
+		var j86 int
 		for _, num1 := range m.Idx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA84[j83] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA87[j86] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j83++
+				j86++
 			}
-			dAtA84[j83] = uint8(num)
-			j83++
+			dAtA87[j86] = uint8(num)
+			j86++
 		}
-		i -= j83
-		copy(dAtA[i:], dAtA84[:j83])
-		i = encodeVarintPlan(dAtA, i, uint64(j83))
+		i -= j86
+		copy(dAtA[i:], dAtA87[:j86])
+		i = encodeVarintPlan(dAtA, i, uint64(j86))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -13619,21 +14588,21 @@ func (m *IdList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.List) > 0 {
-		dAtA88 := make([]byte, len(m.List)*10)
-		var j87 int
+		dAtA91 := make([]byte, len(m.List)*10)
+		var j90 int
 		for _, num1 := range m.List {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA88[j87] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA91[j90] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j87++
+				j90++
 			}
-			dAtA88[j87] = uint8(num)
-			j87++
+			dAtA91[j90] = uint8(num)
+			j90++
 		}
-		i -= j87
-		copy(dAtA[i:], dAtA88[:j87])
-		i = encodeVarintPlan(dAtA, i, uint64(j87))
+		i -= j90
+		copy(dAtA[i:], dAtA91[:j90])
+		i = encodeVarintPlan(dAtA, i, uint64(j90))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -13724,20 +14693,20 @@ func (m *DeleteCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x38
 	}
 	if len(m.PartitionTableIds) > 0 {
-		dAtA90 := make([]byte, len(m.PartitionTableIds)*10)
-		var j89 int
+		dAtA93 := make([]byte, len(m.PartitionTableIds)*10)
+		var j92 int
 		for _, num := range m.PartitionTableIds {
 			for num >= 1<<7 {
-				dAtA90[j89] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA93[j92] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j89++
+				j92++
 			}
-			dAtA90[j89] = uint8(num)
-			j89++
+			dAtA93[j92] = uint8(num)
+			j92++
 		}
-		i -= j89
-		copy(dAtA[i:], dAtA90[:j89])
-		i = encodeVarintPlan(dAtA, i, uint64(j89))
+		i -= j92
+		copy(dAtA[i:], dAtA93[:j92])
+		i = encodeVarintPlan(dAtA, i, uint64(j92))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -13815,6 +14784,15 @@ func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Warnings) > 0 {
+		for iNdEx := len(m.Warnings) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Warnings[iNdEx])
+			copy(dAtA[i:], m.Warnings[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.Warnings[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.LoadTag {
 		i--
 		if m.LoadTag {
@@ -13863,21 +14841,21 @@ func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Steps) > 0 {
-		dAtA93 := make([]byte, len(m.Steps)*10)
-		var j92 int
+		dAtA96 := make([]byte, len(m.Steps)*10)
+		var j95 int
 		for _, num1 := range m.Steps {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA93[j92] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA96[j95] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j92++
+				j95++
 			}
-			dAtA93[j92] = uint8(num)
-			j92++
+			dAtA96[j95] = uint8(num)
+			j95++
 		}
-		i -= j92
-		copy(dAtA[i:], dAtA93[:j92])
-		i = encodeVarintPlan(dAtA, i, uint64(j92))
+		i -= j95
+		copy(dAtA[i:], dAtA96[:j95])
+		i = encodeVarintPlan(dAtA, i, uint64(j95))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -15443,6 +16421,153 @@ func (m *AlterTableAlterIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AlterTablePartition) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTablePartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTablePartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.WithoutValidation {
+		i--
+		if m.WithoutValidation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ExchangeTableName) > 0 {
+		i -= len(m.ExchangeTableName)
+		copy(dAtA[i:], m.ExchangeTableName)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.ExchangeTableName)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ExchangeDbName) > 0 {
+		i -= len(m.ExchangeDbName)
+		copy(dAtA[i:], m.ExchangeDbName)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.ExchangeDbName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.NewPartitionTableNames) > 0 {
+		for iNdEx := len(m.NewPartitionTableNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NewPartitionTableNames[iNdEx])
+			copy(dAtA[i:], m.NewPartitionTableNames[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.NewPartitionTableNames[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PartitionTableNames) > 0 {
+		for iNdEx := len(m.PartitionTableNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PartitionTableNames[iNdEx])
+			copy(dAtA[i:], m.PartitionTableNames[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.PartitionTableNames[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PartitionDef != nil {
+		{
+			size, err := m.PartitionDef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Typ != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Typ))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AlterTableMergePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTableMergePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableMergePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.MergePolicy) > 0 {
+		i -= len(m.MergePolicy)
+		copy(dAtA[i:], m.MergePolicy)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.MergePolicy)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AlterTableCompact) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTableCompact) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableCompact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AlterTable) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -15633,6 +16758,69 @@ func (m *AlterTable_Action_AlterIndex) MarshalToSizedBuffer(dAtA []byte) (int, e
 	}
 	return len(dAtA) - i, nil
 }
+func (m *AlterTable_Action_AlterPartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTable_Action_AlterPartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AlterPartition != nil {
+		{
+			size, err := m.AlterPartition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AlterTable_Action_MergePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTable_Action_MergePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MergePolicy != nil {
+		{
+			size, err := m.MergePolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AlterTable_Action_Compact) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTable_Action_Compact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Compact != nil {
+		{
+			size, err := m.Compact.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *DropTable) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -15667,20 +16855,20 @@ func (m *DropTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.ForeignTbl) > 0 {
-		dAtA134 := make([]byte, len(m.ForeignTbl)*10)
-		var j133 int
+		dAtA141 := make([]byte, len(m.ForeignTbl)*10)
+		var j140 int
 		for _, num := range m.ForeignTbl {
 			for num >= 1<<7 {
-				dAtA134[j133] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA141[j140] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j133++
+				j140++
 			}
-			dAtA134[j133] = uint8(num)
-			j133++
+			dAtA141[j140] = uint8(num)
+			j140++
 		}
-		i -= j133
-		copy(dAtA[i:], dAtA134[:j133])
-		i = encodeVarintPlan(dAtA, i, uint64(j133))
+		i -= j140
+		copy(dAtA[i:], dAtA141[:j140])
+		i = encodeVarintPlan(dAtA, i, uint64(j140))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -16116,20 +17304,20 @@ func (m *TruncateTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.ForeignTbl) > 0 {
-		dAtA140 := make([]byte, len(m.ForeignTbl)*10)
-		var j139 int
+		dAtA147 := make([]byte, len(m.ForeignTbl)*10)
+		var j146 int
 		for _, num := range m.ForeignTbl {
 			for num >= 1<<7 {
-				dAtA140[j139] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA147[j146] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j139++
+				j146++
 			}
-			dAtA140[j139] = uint8(num)
-			j139++
+			dAtA147[j146] = uint8(num)
+			j146++
 		}
-		i -= j139
-		copy(dAtA[i:], dAtA140[:j139])
-		i = encodeVarintPlan(dAtA, i, uint64(j139))
+		i -= j146
+		copy(dAtA[i:], dAtA147[:j146])
+		i = encodeVarintPlan(dAtA, i, uint64(j146))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -16215,20 +17403,20 @@ func (m *ClusterTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x18
 	}
 	if len(m.AccountIDs) > 0 {
-		dAtA143 := make([]byte, len(m.AccountIDs)*10)
-		var j142 int
+		dAtA150 := make([]byte, len(m.AccountIDs)*10)
+		var j149 int
 		for _, num := range m.AccountIDs {
 			for num >= 1<<7 {
-				dAtA143[j142] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA150[j149] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j142++
+				j149++
 			}
-			dAtA143[j142] = uint8(num)
-			j142++
+			dAtA150[j149] = uint8(num)
+			j149++
 		}
-		i -= j142
-		copy(dAtA[i:], dAtA143[:j142])
-		i = encodeVarintPlan(dAtA, i, uint64(j142))
+		i -= j149
+		copy(dAtA[i:], dAtA150[:j149])
+		i = encodeVarintPlan(dAtA, i, uint64(j149))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -16440,21 +17628,21 @@ func (m *Prepare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.ParamTypes) > 0 {
-		dAtA147 := make([]byte, len(m.ParamTypes)*10)
-		var j146 int
+		dAtA154 := make([]byte, len(m.ParamTypes)*10)
+		var j153 int
 		for _, num1 := range m.ParamTypes {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA147[j146] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA154[j153] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j146++
+				j153++
 			}
-			dAtA147[j146] = uint8(num)
-			j146++
+			dAtA154[j153] = uint8(num)
+			j153++
 		}
-		i -= j146
-		copy(dAtA[i:], dAtA147[:j146])
-		i = encodeVarintPlan(dAtA, i, uint64(j146))
+		i -= j153
+		copy(dAtA[i:], dAtA154[:j153])
+		i = encodeVarintPlan(dAtA, i, uint64(j153))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -18027,6 +19215,14 @@ func (m *TableDef) ProtoSize() (n int) {
 	if m.TableLockType != 0 {
 		n += 2 + sovPlan(uint64(m.TableLockType))
 	}
+	if m.Ttl != nil {
+		l = m.Ttl.ProtoSize()
+		n += 2 + l + sovPlan(uint64(l))
+	}
+	if m.BloomFilter != nil {
+		l = m.BloomFilter.ProtoSize()
+		n += 2 + l + sovPlan(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -18060,6 +19256,43 @@ func (m *TableDef_DefType_Properties) ProtoSize() (n int) {
 	}
 	return n
 }
+func (m *TTLDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Col)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovPlan(uint64(m.Interval))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BloomFilterDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Cols) > 0 {
+		for _, s := range m.Cols {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *TableFunction) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -18441,6 +19674,36 @@ func (m *AnalyzeInfo) ProtoSize() (n int) {
 	if m.InsertTime != 0 {
 		n += 1 + sovPlan(uint64(m.InsertTime))
 	}
+	if m.MemoryPeak != 0 {
+		n += 1 + sovPlan(uint64(m.MemoryPeak))
+	}
+	if m.TotalBlocks != 0 {
+		n += 2 + sovPlan(uint64(m.TotalBlocks))
+	}
+	if m.PrunedBlocks != 0 {
+		n += 2 + sovPlan(uint64(m.PrunedBlocks))
+	}
+	if m.S3ReadCount != 0 {
+		n += 2 + sovPlan(uint64(m.S3ReadCount))
+	}
+	if m.CacheReadCount != 0 {
+		n += 2 + sovPlan(uint64(m.CacheReadCount))
+	}
+	if m.CacheHitCount != 0 {
+		n += 2 + sovPlan(uint64(m.CacheHitCount))
+	}
+	if len(m.CnAddrs) > 0 {
+		for _, s := range m.CnAddrs {
+			l = len(s)
+			n += 2 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.LockWaitTime != 0 {
+		n += 2 + sovPlan(uint64(m.LockWaitTime))
+	}
+	if m.CpuTime != 0 {
+		n += 2 + sovPlan(uint64(m.CpuTime))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -18610,6 +19873,56 @@ func (m *Node) ProtoSize() (n int) {
 		l = m.OnDuplicateKey.ProtoSize()
 		n += 2 + l + sovPlan(uint64(l))
 	}
+	if len(m.RuntimeFilterBuildList) > 0 {
+		for _, e := range m.RuntimeFilterBuildList {
+			l = e.ProtoSize()
+			n += 2 + l + sovPlan(uint64(l))
+		}
+	}
+	if len(m.RuntimeFilterProbeList) > 0 {
+		for _, e := range m.RuntimeFilterProbeList {
+			l = e.ProtoSize()
+			n += 2 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.JoinMethod != 0 {
+		n += 2 + sovPlan(uint64(m.JoinMethod))
+	}
+	if m.JoinDistribution != 0 {
+		n += 2 + sovPlan(uint64(m.JoinDistribution))
+	}
+	if m.ApplyType != 0 {
+		n += 2 + sovPlan(uint64(m.ApplyType))
+	}
+	if len(m.ReadCols) > 0 {
+		for _, s := range m.ReadCols {
+			l = len(s)
+			n += 2 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RuntimeFilterSpec) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tag != 0 {
+		n += 1 + sovPlan(uint64(m.Tag))
+	}
+	if m.Expr != nil {
+		l = m.Expr.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.ColName)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -18808,6 +20121,12 @@ func (m *Query) ProtoSize() (n int) {
 	if m.LoadTag {
 		n += 2
 	}
+	if len(m.Warnings) > 0 {
+		for _, s := range m.Warnings {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -19584,6 +20903,76 @@ func (m *AlterTableAlterIndex) ProtoSize() (n int) {
 	return n
 }
 
+func (m *AlterTablePartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Typ != 0 {
+		n += 1 + sovPlan(uint64(m.Typ))
+	}
+	if m.PartitionDef != nil {
+		l = m.PartitionDef.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if len(m.PartitionTableNames) > 0 {
+		for _, s := range m.PartitionTableNames {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if len(m.NewPartitionTableNames) > 0 {
+		for _, s := range m.NewPartitionTableNames {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	l = len(m.ExchangeDbName)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.ExchangeTableName)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.WithoutValidation {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterTableMergePolicy) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MergePolicy)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterTableCompact) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *AlterTable) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -19676,6 +21065,42 @@ func (m *AlterTable_Action_AlterIndex) ProtoSize() (n int) {
 	}
 	return n
 }
+func (m *AlterTable_Action_AlterPartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AlterPartition != nil {
+		l = m.AlterPartition.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTable_Action_MergePolicy) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MergePolicy != nil {
+		l = m.MergePolicy.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTable_Action_Compact) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Compact != nil {
+		l = m.Compact.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
 func (m *DropTable) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -27035,60 +28460,132 @@ func (m *TableDef) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TableDef_DefType) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DefType: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DefType: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Properties", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ttl == nil {
+				m.Ttl = &TTLDef{}
+			}
+			if err := m.Ttl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BloomFilter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BloomFilter == nil {
+				m.BloomFilter = &BloomFilterDef{}
+			}
+			if err := m.BloomFilter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TableDef_DefType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DefType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DefType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Properties", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27143,6 +28640,191 @@ func (m *TableDef_DefType) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TTLDef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TTLDef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TTLDef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Col", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Col = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BloomFilterDef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BloomFilterDef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BloomFilterDef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cols", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cols = append(m.Cols, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TableFunction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -29439,6 +31121,190 @@ func (m *AnalyzeInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryPeak", wireType)
+			}
+			m.MemoryPeak = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemoryPeak |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBlocks", wireType)
+			}
+			m.TotalBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrunedBlocks", wireType)
+			}
+			m.PrunedBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrunedBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S3ReadCount", wireType)
+			}
+			m.S3ReadCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.S3ReadCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheReadCount", wireType)
+			}
+			m.CacheReadCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CacheReadCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheHitCount", wireType)
+			}
+			m.CacheHitCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CacheHitCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CnAddrs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CnAddrs = append(m.CnAddrs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockWaitTime", wireType)
+			}
+			m.LockWaitTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockWaitTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuTime", wireType)
+			}
+			m.CpuTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CpuTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -30691,6 +32557,301 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeFilterBuildList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeFilterBuildList = append(m.RuntimeFilterBuildList, &RuntimeFilterSpec{})
+			if err := m.RuntimeFilterBuildList[len(m.RuntimeFilterBuildList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeFilterProbeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeFilterProbeList = append(m.RuntimeFilterProbeList, &RuntimeFilterSpec{})
+			if err := m.RuntimeFilterProbeList[len(m.RuntimeFilterProbeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 38:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinMethod", wireType)
+			}
+			m.JoinMethod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JoinMethod |= Node_JoinMethod(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 39:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinDistribution", wireType)
+			}
+			m.JoinDistribution = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JoinDistribution |= Node_JoinDistribution(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 40:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplyType", wireType)
+			}
+			m.ApplyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApplyType |= Node_JoinType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadCols", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReadCols = append(m.ReadCols, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RuntimeFilterSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RuntimeFilterSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RuntimeFilterSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			m.Tag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tag |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expr", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expr == nil {
+				m.Expr = &Expr{}
+			}
+			if err := m.Expr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ColName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -32037,6 +34198,38 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.LoadTag = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warnings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Warnings = append(m.Warnings, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -34443,11 +36636,360 @@ func (m *CreateTable) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FkTables = append(m.FkTables, string(dAtA[iNdEx:postIndex]))
+			m.FkTables = append(m.FkTables, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FkCols", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FkCols = append(m.FkCols, &FkColName{})
+			if err := m.FkCols[len(m.FkCols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionTables", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartitionTables = append(m.PartitionTables, &TableDef{})
+			if err := m.PartitionTables[len(m.PartitionTables)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTableDrop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableDrop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableDrop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Typ", wireType)
+			}
+			m.Typ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Typ |= AlterTableDrop_Typ(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexTableName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexTableName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableAddFk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableAddFk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DbName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TableName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cols", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cols = append(m.Cols, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FkCols", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fkey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34474,42 +37016,10 @@ func (m *CreateTable) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FkCols = append(m.FkCols, &FkColName{})
-			if err := m.FkCols[len(m.FkCols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionTables", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.Fkey == nil {
+				m.Fkey = &ForeignKeyDef{}
 			}
-			m.PartitionTables = append(m.PartitionTables, &TableDef{})
-			if err := m.PartitionTables[len(m.PartitionTables)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -34535,7 +37045,7 @@ func (m *CreateTable) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlterTableDrop) Unmarshal(dAtA []byte) error {
+func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34558,17 +37068,17 @@ func (m *AlterTableDrop) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterTableDrop: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTableAddIndex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterTableDrop: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTableAddIndex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Typ", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
 			}
-			m.Typ = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -34578,14 +37088,27 @@ func (m *AlterTableDrop) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Typ |= AlterTableDrop_Typ(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DbName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34613,11 +37136,11 @@ func (m *AlterTableDrop) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.TableName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexTableName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginTablePrimaryKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34645,8 +37168,64 @@ func (m *AlterTableDrop) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IndexTableName = string(dAtA[iNdEx:postIndex])
+			m.OriginTablePrimaryKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IndexInfo == nil {
+				m.IndexInfo = &CreateTable{}
+			}
+			if err := m.IndexInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexTableExist", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IndexTableExist = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -34669,7 +37248,7 @@ func (m *AlterTableDrop) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
+func (m *AlterTableDropIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34692,10 +37271,10 @@ func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterTableAddFk: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTableDropIndex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterTableAddFk: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTableDropIndex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -34764,7 +37343,7 @@ func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cols", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34792,13 +37371,13 @@ func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cols = append(m.Cols, string(dAtA[iNdEx:postIndex]))
+			m.IndexName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexTableName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -34808,27 +37387,23 @@ func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Fkey == nil {
-				m.Fkey = &ForeignKeyDef{}
-			}
-			if err := m.Fkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IndexTableName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34852,7 +37427,7 @@ func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
+func (m *AlterTableAlterIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34875,10 +37450,10 @@ func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterTableAddIndex: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTableAlterIndex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterTableAddIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTableAlterIndex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -34947,7 +37522,7 @@ func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginTablePrimaryKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34975,47 +37550,11 @@ func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginTablePrimaryKey = string(dAtA[iNdEx:postIndex])
+			m.IndexName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.IndexInfo == nil {
-				m.IndexInfo = &CreateTable{}
-			}
-			if err := m.IndexInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexTableExist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Visible", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -35032,7 +37571,7 @@ func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IndexTableExist = bool(v != 0)
+			m.Visible = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -35055,7 +37594,7 @@ func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlterTableDropIndex) Unmarshal(dAtA []byte) error {
+func (m *AlterTablePartition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35078,17 +37617,36 @@ func (m *AlterTableDropIndex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterTableDropIndex: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTablePartition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterTableDropIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTablePartition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Typ", wireType)
+			}
+			m.Typ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Typ |= AlterTablePartition_Typ(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionDef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -35098,27 +37656,31 @@ func (m *AlterTableDropIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DbName = string(dAtA[iNdEx:postIndex])
+			if m.PartitionDef == nil {
+				m.PartitionDef = &PartitionByDef{}
+			}
+			if err := m.PartitionDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionTableNames", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35146,11 +37708,11 @@ func (m *AlterTableDropIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TableName = string(dAtA[iNdEx:postIndex])
+			m.PartitionTableNames = append(m.PartitionTableNames, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPartitionTableNames", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35178,11 +37740,11 @@ func (m *AlterTableDropIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IndexName = string(dAtA[iNdEx:postIndex])
+			m.NewPartitionTableNames = append(m.NewPartitionTableNames, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexTableName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeDbName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35210,8 +37772,60 @@ func (m *AlterTableDropIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IndexTableName = string(dAtA[iNdEx:postIndex])
+			m.ExchangeDbName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeTableName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeTableName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithoutValidation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithoutValidation = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -35234,7 +37848,7 @@ func (m *AlterTableDropIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlterTableAlterIndex) Unmarshal(dAtA []byte) error {
+func (m *AlterTableMergePolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35257,15 +37871,15 @@ func (m *AlterTableAlterIndex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterTableAlterIndex: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTableMergePolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterTableAlterIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTableMergePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MergePolicy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35293,92 +37907,59 @@ func (m *AlterTableAlterIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DbName = string(dAtA[iNdEx:postIndex])
+			m.MergePolicy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPlan
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPlan
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TableName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTableCompact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IndexName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Visible", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Visible = bool(v != 0)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableCompact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableCompact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -35743,6 +38324,111 @@ func (m *AlterTable_Action) Unmarshal(dAtA []byte) error {
 			}
 			m.Action = &AlterTable_Action_AlterIndex{v}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlterPartition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTablePartition{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &AlterTable_Action_AlterPartition{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergePolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTableMergePolicy{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &AlterTable_Action_MergePolicy{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compact", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTableCompact{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &AlterTable_Action_Compact{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -78,6 +78,12 @@ type DistTAECounterSet struct {
 		ActiveRows   stats.Counter
 		InsertBlocks stats.Counter
 	}
+
+	Ranges struct {
+		Blocks            stats.Counter
+		ZonemapPruned     stats.Counter
+		BloomFilterPruned stats.Counter
+	}
 }
 
 var statsCounterType = reflect.TypeOf((*stats.Counter)(nil)).Elem()
@@ -0,0 +1,253 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(arg any, buf *bytes.Buffer) {
+	ap := arg.(*Argument)
+	buf.WriteString(" apply ")
+	buf.WriteString(ap.Typ.String())
+	buf.WriteString(" join ")
+}
+
+func Prepare(proc *process.Process, arg any) error {
+	ap := arg.(*Argument)
+	ap.ctr = &container{
+		results: make(map[string]*batch.Batch),
+	}
+	switch ap.Typ {
+	case plan.Node_INNER, plan.Node_LEFT, plan.Node_SINGLE:
+	case plan.Node_MARK:
+		for _, rp := range ap.Result {
+			if rp.Rel == -1 {
+				return nil
+			}
+		}
+		return moerr.NewInternalError(proc.Ctx, "MARK join must output mark column")
+	default:
+		return moerr.NewNYI(proc.Ctx, "apply join of type %s", ap.Typ)
+	}
+	return nil
+}
+
+func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (bool, error) {
+	anal := proc.GetAnalyze(idx)
+	anal.Start()
+	defer anal.Stop()
+
+	bat := proc.InputBatch()
+	if bat == nil {
+		proc.SetInputBatch(nil)
+		return true, nil
+	}
+	if bat.Length() == 0 {
+		bat.Clean(proc.Mp())
+		return false, nil
+	}
+	anal.Input(bat, isFirst)
+	ap := arg.(*Argument)
+	rbat, err := probe(bat, ap, proc)
+	proc.PutBatch(bat)
+	if err != nil {
+		return false, err
+	}
+	anal.Output(rbat, isLast)
+	proc.SetInputBatch(rbat)
+	return false, nil
+}
+
+func probe(bat *batch.Batch, ap *Argument, proc *process.Process) (*batch.Batch, error) {
+	rbat := batch.NewWithSize(len(ap.Result))
+	rbat.Zs = proc.Mp().GetSels()
+	for i, rp := range ap.Result {
+		switch rp.Rel {
+		case 0:
+			rbat.Vecs[i] = proc.GetVector(*bat.Vecs[rp.Pos].GetType())
+		case 1:
+			rbat.Vecs[i] = proc.GetVector(ap.Typs[rp.Pos])
+		default:
+			rbat.Vecs[i] = proc.GetVector(types.T_bool.ToType())
+		}
+	}
+	count := bat.Length()
+	for i := 0; i < count; i++ {
+		if err := probeRow(bat, i, rbat, ap, proc); err != nil {
+			rbat.Clean(proc.Mp())
+			return nil, err
+		}
+	}
+	return rbat, nil
+}
+
+// probeRow evaluates the right child for the i-th row of bat and appends the
+// joined rows to rbat.
+func probeRow(bat *batch.Batch, i int, rbat *batch.Batch, ap *Argument, proc *process.Process) error {
+	right, err := evalRight(bat, i, ap, proc)
+	if err != nil {
+		return err
+	}
+	defer right.Clean(proc.Mp())
+
+	// find the rows of the right child which satisfy the join condition,
+	// and whether the condition was unknown for some of the others
+	var sels []int
+	hasNull := false
+	if right.Length() > 0 {
+		if ap.Cond == nil {
+			sels = make([]int, right.Length())
+			for j := range sels {
+				sels[j] = j
+			}
+		} else {
+			vec, err := colexec.JoinFilterEvalExpr(bat, right, i, proc, ap.Cond)
+			if err != nil {
+				return err
+			}
+			bs := vector.MustFixedCol[bool](vec)
+			if vec.IsConst() {
+				if vec.IsConstNull() {
+					hasNull = true
+				} else if bs[0] {
+					sels = make([]int, right.Length())
+					for j := range sels {
+						sels[j] = j
+					}
+				}
+			} else {
+				nsp := vec.GetNulls()
+				for j, b := range bs {
+					if nsp.Contains(uint64(j)) {
+						hasNull = true
+					} else if b {
+						sels = append(sels, j)
+					}
+				}
+			}
+			vec.Free(proc.Mp())
+		}
+	}
+
+	switch ap.Typ {
+	case plan.Node_MARK:
+		for k, rp := range ap.Result {
+			if rp.Rel == 0 {
+				if err := rbat.Vecs[k].UnionOne(bat.Vecs[rp.Pos], int64(i), proc.Mp()); err != nil {
+					return err
+				}
+			} else if len(sels) > 0 {
+				if err := vector.AppendFixed(rbat.Vecs[k], true, false, proc.Mp()); err != nil {
+					return err
+				}
+			} else {
+				if err := vector.AppendFixed(rbat.Vecs[k], false, hasNull, proc.Mp()); err != nil {
+					return err
+				}
+			}
+		}
+		rbat.Zs = append(rbat.Zs, bat.Zs[i])
+		return nil
+
+	case plan.Node_SINGLE:
+		if len(sels) > 1 {
+			return moerr.NewInternalError(proc.Ctx, "scalar subquery returns more than 1 row")
+		}
+	}
+
+	for _, j := range sels {
+		for k, rp := range ap.Result {
+			if rp.Rel == 0 {
+				if err := rbat.Vecs[k].UnionOne(bat.Vecs[rp.Pos], int64(i), proc.Mp()); err != nil {
+					return err
+				}
+			} else {
+				if err := rbat.Vecs[k].UnionOne(right.Vecs[rp.Pos], int64(j), proc.Mp()); err != nil {
+					return err
+				}
+			}
+		}
+		rbat.Zs = append(rbat.Zs, bat.Zs[i]*right.Zs[j])
+	}
+	if len(sels) == 0 && ap.Typ != plan.Node_INNER {
+		for k, rp := range ap.Result {
+			if rp.Rel == 0 {
+				if err := rbat.Vecs[k].UnionOne(bat.Vecs[rp.Pos], int64(i), proc.Mp()); err != nil {
+					return err
+				}
+			} else {
+				if err := rbat.Vecs[k].UnionNull(proc.Mp()); err != nil {
+					return err
+				}
+			}
+		}
+		rbat.Zs = append(rbat.Zs, bat.Zs[i])
+	}
+	return nil
+}
+
+// evalRight returns the result of the right child for the i-th row of bat.
+// The result is cached by the values of the correlated columns of the row,
+// and the caller releases it by Clean like the one Eval returns.
+func evalRight(bat *batch.Batch, i int, ap *Argument, proc *process.Process) (*batch.Batch, error) {
+	ctr := ap.ctr
+	ctr.key = ctr.key[:0]
+	for _, pos := range ap.CorrCols {
+		ctr.key = appendKey(ctr.key, bat.Vecs[pos], i)
+	}
+	if right, ok := ctr.results[string(ctr.key)]; ok {
+		right.AddCnt(1)
+		return right, nil
+	}
+
+	right, err := ap.Eval(proc, bat, i)
+	if err != nil {
+		return nil, err
+	}
+	if size := right.Size(); ctr.size+size <= maxCachedSize {
+		right.AddCnt(1)
+		ctr.size += size
+		ctr.results[string(ctr.key)] = right
+	}
+	return right, nil
+}
+
+// appendKey appends the value of the i-th row of vec to the key
+func appendKey(key []byte, vec *vector.Vector, i int) []byte {
+	if vec.IsConst() {
+		i = 0
+	}
+	if vec.IsConstNull() || vec.GetNulls().Contains(uint64(i)) {
+		return append(key, 0)
+	}
+	key = append(key, 1)
+	if vec.GetType().IsVarlen() {
+		data := vec.GetBytesAt(i)
+		n := uint32(len(data))
+		key = append(key, types.EncodeUint32(&n)...)
+		return append(key, data...)
+	}
+	size := vec.GetType().TypeSize()
+	return append(key, vec.UnsafeGetRawData()[i*size:(i+1)*size]...)
+}
@@ -0,0 +1,209 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	Rows = 10 // default rows
+)
+
+// add unit tests for cases
+type applyTestCase struct {
+	arg   *Argument
+	types []types.Type
+	proc  *process.Process
+	// rows is the number of rows the right child produces for each row
+	rows int
+	// expected is the number of rows a batch of Rows rows is joined into
+	expected int
+}
+
+var (
+	tcs []applyTestCase
+)
+
+func init() {
+	typs := []types.Type{types.T_int8.ToType()}
+	joined := []colexec.ResultPos{colexec.NewResultPos(0, 0), colexec.NewResultPos(1, 0)}
+	marked := []colexec.ResultPos{colexec.NewResultPos(0, 0), colexec.NewResultPos(-1, 0)}
+	tcs = []applyTestCase{
+		newTestCase(plan.Node_INNER, typs, joined, true, Rows, Rows),
+		newTestCase(plan.Node_INNER, typs, joined, false, Rows, Rows*Rows),
+		newTestCase(plan.Node_INNER, typs, joined, true, 0, 0),
+		newTestCase(plan.Node_LEFT, typs, joined, true, Rows, Rows),
+		newTestCase(plan.Node_LEFT, typs, joined, true, 0, Rows),
+		newTestCase(plan.Node_SINGLE, typs, joined, true, Rows, Rows),
+		newTestCase(plan.Node_SINGLE, typs, joined, false, 1, Rows),
+		newTestCase(plan.Node_MARK, typs, marked, true, Rows, Rows),
+		newTestCase(plan.Node_MARK, typs, marked, false, 0, Rows),
+	}
+}
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	for _, tc := range tcs {
+		String(tc.arg, buf)
+	}
+}
+
+func TestPrepare(t *testing.T) {
+	for _, tc := range tcs {
+		err := Prepare(tc.proc, tc.arg)
+		require.NoError(t, err)
+	}
+	tc := newTestCase(plan.Node_MARK, []types.Type{types.T_int8.ToType()},
+		[]colexec.ResultPos{colexec.NewResultPos(0, 0)}, true, Rows, Rows)
+	require.Error(t, Prepare(tc.proc, tc.arg))
+}
+
+func TestApply(t *testing.T) {
+	for _, tc := range tcs {
+		err := Prepare(tc.proc, tc.arg)
+		require.NoError(t, err)
+		for _, bat := range []*batch.Batch{
+			testutil.NewBatch(tc.types, false, Rows, tc.proc.Mp()),
+			{},
+			testutil.NewBatch(tc.types, false, Rows, tc.proc.Mp()),
+		} {
+			empty := bat.Length() == 0
+			tc.proc.SetInputBatch(bat)
+			end, err := Call(0, tc.proc, tc.arg, false, false)
+			require.NoError(t, err)
+			require.False(t, end)
+			if empty {
+				continue
+			}
+			rbat := tc.proc.InputBatch()
+			require.Equal(t, tc.expected, rbat.Length())
+			rbat.Clean(tc.proc.Mp())
+		}
+		tc.proc.SetInputBatch(nil)
+		end, err := Call(0, tc.proc, tc.arg, false, false)
+		require.NoError(t, err)
+		require.True(t, end)
+		tc.arg.Free(tc.proc, false)
+		tc.proc.FreeVectors()
+		require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+	}
+}
+
+func TestApplySingleRows(t *testing.T) {
+	tc := newTestCase(plan.Node_SINGLE, []types.Type{types.T_int8.ToType()},
+		[]colexec.ResultPos{colexec.NewResultPos(0, 0), colexec.NewResultPos(1, 0)}, false, Rows, Rows)
+	require.NoError(t, Prepare(tc.proc, tc.arg))
+	tc.proc.SetInputBatch(testutil.NewBatch(tc.types, false, Rows, tc.proc.Mp()))
+	_, err := Call(0, tc.proc, tc.arg, false, false)
+	require.Error(t, err)
+	tc.arg.Free(tc.proc, false)
+	tc.proc.FreeVectors()
+	require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+}
+
+func TestApplyCache(t *testing.T) {
+	tc := newTestCase(plan.Node_INNER, []types.Type{types.T_int8.ToType()},
+		[]colexec.ResultPos{colexec.NewResultPos(0, 0), colexec.NewResultPos(1, 0)}, true, Rows, Rows)
+	evals := 0
+	eval := tc.arg.Eval
+	tc.arg.Eval = func(proc *process.Process, bat *batch.Batch, row int) (*batch.Batch, error) {
+		evals++
+		return eval(proc, bat, row)
+	}
+	tc.arg.CorrCols = []int32{0}
+	require.NoError(t, Prepare(tc.proc, tc.arg))
+	// the right child runs once for each distinct value of the correlated column
+	for i := 0; i < 2; i++ {
+		tc.proc.SetInputBatch(testutil.NewBatch(tc.types, false, Rows, tc.proc.Mp()))
+		_, err := Call(0, tc.proc, tc.arg, false, false)
+		require.NoError(t, err)
+		rbat := tc.proc.InputBatch()
+		require.Equal(t, Rows, rbat.Length())
+		rbat.Clean(tc.proc.Mp())
+	}
+	require.Equal(t, Rows, evals)
+	tc.arg.Free(tc.proc, false)
+	tc.proc.FreeVectors()
+	require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+}
+
+func newTestCase(typ plan.Node_JoinType, ts []types.Type, rp []colexec.ResultPos, hasCond bool, rows, expected int) applyTestCase {
+	proc := testutil.NewProcessWithMPool(mpool.MustNewZero())
+	var cond *plan.Expr
+	if hasCond {
+		fid := function.EncodeOverloadID(function.EQUAL, 4)
+		args := make([]*plan.Expr, 0, 2)
+		args = append(args, &plan.Expr{
+			Typ: &plan.Type{
+				Id: int32(ts[0].Oid),
+			},
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: 0,
+					ColPos: 0,
+				},
+			},
+		})
+		args = append(args, &plan.Expr{
+			Typ: &plan.Type{
+				Id: int32(ts[0].Oid),
+			},
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: 1,
+					ColPos: 0,
+				},
+			},
+		})
+		cond = &plan.Expr{
+			Typ: &plan.Type{
+				Id: int32(types.T_bool),
+			},
+			Expr: &plan.Expr_F{
+				F: &plan.Function{
+					Args: args,
+					Func: &plan.ObjectRef{Obj: fid, ObjName: "="},
+				},
+			},
+		}
+	}
+	return applyTestCase{
+		types:    ts,
+		proc:     proc,
+		rows:     rows,
+		expected: expected,
+		arg: &Argument{
+			Typ:    typ,
+			Typs:   ts,
+			Cond:   cond,
+			Result: rp,
+			Eval: func(proc *process.Process, _ *batch.Batch, _ int) (*batch.Batch, error) {
+				return testutil.NewBatch(ts, false, rows, proc.Mp()), nil
+			},
+		},
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// maxCachedSize is the max size of the results of the right child an apply
+// join keeps for the rows with the same values of the correlated columns
+const maxCachedSize = 64 << 20
+
+type container struct {
+	// results are the results of the right child by the values of the
+	// correlated columns, each of them holds a reference of its batch
+	results map[string]*batch.Batch
+	size    int
+	key     []byte
+}
+
+// Argument of the apply join, which evaluates its right child once for
+// every row of its input and joins the row with the result like the loop
+// join of the same type would.
+type Argument struct {
+	ctr *container
+	// Typ is one of INNER, LEFT, SINGLE and MARK.
+	Typ plan.Node_JoinType
+	// Typs are the types of the columns the right child produces.
+	Typs   []types.Type
+	Cond   *plan.Expr
+	Result []colexec.ResultPos
+	// CorrCols are the columns of the input the right child refers to. The
+	// rows with the same values of them share the result of the right child.
+	CorrCols []int32
+	// Eval runs the right child with the correlated columns bound to the
+	// row-th row of bat and returns all the rows it produces. The caller
+	// owns the returned batch.
+	Eval func(proc *process.Process, bat *batch.Batch, row int) (*batch.Batch, error)
+}
+
+func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
+	if arg.ctr != nil {
+		for _, bat := range arg.ctr.results {
+			bat.Clean(proc.Mp())
+		}
+		arg.ctr = nil
+	}
+}
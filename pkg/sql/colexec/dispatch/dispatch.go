@@ -79,6 +79,31 @@ func Prepare(proc *process.Process, arg any) error {
 		ap.prepared = true
 		ap.ctr.remoteReceivers = nil
 		ap.ctr.sendFunc = sendToAnyLocalFunc
+
+	case ShuffleFunc:
+		if ap.remoteRegsCnt == 0 {
+			return moerr.NewInternalError(proc.Ctx, "ShuffleFunc should include RemoteRegs")
+		}
+		if err := ap.prepareShuffle(proc); err != nil {
+			return err
+		}
+		ap.prepared = false
+		ap.ctr.remoteReceivers = make([]*WrapperClientSession, 0, ap.remoteRegsCnt)
+		ap.ctr.sendFunc = shuffleFunc
+		for _, rr := range ap.RemoteRegs {
+			colexec.Srv.PutNotifyChIntoUuidMap(rr.Uuid, proc.DispatchNotifyCh)
+		}
+
+	case ShuffleLocalFunc:
+		if ap.remoteRegsCnt != 0 {
+			return moerr.NewInternalError(proc.Ctx, "ShuffleLocalFunc should not send to remote")
+		}
+		if err := ap.prepareShuffle(proc); err != nil {
+			return err
+		}
+		ap.prepared = true
+		ap.ctr.remoteReceivers = nil
+		ap.ctr.sendFunc = shuffleFunc
 	default:
 		return moerr.NewInternalError(proc.Ctx, "wrong sendFunc id for dispatch")
 	}
@@ -117,3 +142,39 @@ func (arg *Argument) waitRemoteRegsReady(proc *process.Process) {
 	}
 	arg.prepared = true
 }
+
+// prepareShuffle checks the partition numbers of all regs and binds the
+// local regs to their partitions. Remote receivers arrive in any order,
+// so they are bound by uuid once they are ready.
+func (arg *Argument) prepareShuffle(proc *process.Process) error {
+	if len(arg.ShuffleExprs) == 0 {
+		return moerr.NewInternalError(proc.Ctx, "shuffle dispatch should include shuffle keys")
+	}
+	if len(arg.ShuffleLocalIdx) != arg.localRegsCnt || len(arg.ShuffleRemoteIdx) != arg.remoteRegsCnt {
+		return moerr.NewInternalError(proc.Ctx, "shuffle dispatch should specify the partition of each reg")
+	}
+	arg.ctr.shuffleTargets = make([]shuffleTarget, arg.aliveRegCnt)
+	arg.ctr.shuffleSels = make([][]int32, arg.aliveRegCnt)
+	bound := make([]bool, arg.aliveRegCnt)
+	for _, idx := range append(append([]int32{}, arg.ShuffleLocalIdx...), arg.ShuffleRemoteIdx...) {
+		if idx < 0 || int(idx) >= arg.aliveRegCnt || bound[idx] {
+			return moerr.NewInternalError(proc.Ctx, "wrong partition %d for shuffle dispatch", idx)
+		}
+		bound[idx] = true
+	}
+	for i, reg := range arg.LocalRegs {
+		arg.ctr.shuffleTargets[arg.ShuffleLocalIdx[i]].local = reg
+	}
+	return nil
+}
+
+func (arg *Argument) bindShuffleRemoteReceivers() {
+	for _, r := range arg.ctr.remoteReceivers {
+		for i := range arg.RemoteRegs {
+			if arg.RemoteRegs[i].Uuid == r.uuid {
+				arg.ctr.shuffleTargets[arg.ShuffleRemoteIdx[i]].remote = r
+				break
+			}
+		}
+	}
+}
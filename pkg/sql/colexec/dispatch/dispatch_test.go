@@ -22,6 +22,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"github.com/stretchr/testify/require"
@@ -118,3 +120,68 @@ func newTestCase(all bool) dispatchTestCase {
 func newBatch(t *testing.T, ts []types.Type, proc *process.Process, rows int64) *batch.Batch {
 	return testutil.NewBatch(ts, false, int(rows), proc.Mp())
 }
+
+func TestShuffleDispatch(t *testing.T) {
+	proc := testutil.NewProcessWithMPool(mpool.MustNewZero())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	regs := []*process.WaitRegister{
+		{Ctx: ctx, Ch: make(chan *batch.Batch, 10)},
+		{Ctx: ctx, Ch: make(chan *batch.Batch, 10)},
+		{Ctx: ctx, Ch: make(chan *batch.Batch, 10)},
+	}
+	arg := &Argument{
+		FuncId:          ShuffleLocalFunc,
+		LocalRegs:       regs,
+		ShuffleLocalIdx: []int32{2, 0, 1},
+		ShuffleExprs: []*plan.Expr{{
+			Typ:  &plan.Type{Id: int32(types.T_int8)},
+			Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: 0}},
+		}},
+	}
+	require.NoError(t, Prepare(proc, arg))
+
+	// the same batch twice, rows with the same key must meet in one reg.
+	for i := 0; i < 2; i++ {
+		proc.Reg.InputBatch = newBatch(t, []types.Type{types.T_int8.ToType()}, proc, Rows)
+		_, err := Call(0, proc, arg, false, false)
+		require.NoError(t, err)
+	}
+	arg.Free(proc, false)
+
+	seen := make(map[int8]int)
+	total := 0
+	for i, reg := range regs {
+		for bat := range reg.Ch {
+			if bat == nil {
+				continue
+			}
+			for _, v := range vector.MustFixedCol[int8](bat.Vecs[0]) {
+				if j, ok := seen[v]; ok {
+					require.Equal(t, i, j)
+				}
+				seen[v] = i
+			}
+			total += bat.Length()
+			bat.Clean(proc.Mp())
+		}
+	}
+	require.Equal(t, 2*Rows, total)
+	proc.FreeVectors()
+	require.Equal(t, int64(0), proc.Mp().CurrNB())
+}
+
+func TestShufflePrepareError(t *testing.T) {
+	proc := testutil.NewProcessWithMPool(mpool.MustNewZero())
+	reg := &process.WaitRegister{Ctx: context.Background(), Ch: make(chan *batch.Batch, 1)}
+	arg := &Argument{
+		FuncId:          ShuffleLocalFunc,
+		LocalRegs:       []*process.WaitRegister{reg},
+		ShuffleLocalIdx: []int32{1},
+		ShuffleExprs:    []*plan.Expr{{Expr: &plan.Expr_Col{Col: &plan.ColRef{}}}},
+	}
+	require.Error(t, Prepare(proc, arg))
+	arg.ShuffleExprs = nil
+	arg.ShuffleLocalIdx = []int32{0}
+	require.Error(t, Prepare(proc, arg))
+}
@@ -42,6 +42,10 @@ const (
 	SendToAnyLocalFunc
 	SendToAnyRemoteFunc
 	SendToAnyFunc
+
+	// shuffle functions, send each row to exactly one reg
+	ShuffleLocalFunc
+	ShuffleFunc
 )
 
 // common sender: send to all LocalReceiver
@@ -192,6 +196,52 @@ func sendToAnyFunc(bat *batch.Batch, ap *Argument, proc *process.Process) (bool,
 
 }
 
+// shuffle sender: split the batch by the hash of shuffle keys and send
+// each part to the reg serving its partition.
+func shuffleFunc(bat *batch.Batch, ap *Argument, proc *process.Process) (bool, error) {
+	if !ap.prepared {
+		ap.waitRemoteRegsReady(proc)
+		ap.bindShuffleRemoteReceivers()
+	}
+
+	bats, err := ap.ctr.shuffleBatch(bat, ap.ShuffleExprs, proc)
+	if err != nil {
+		return false, err
+	}
+	proc.SetInputBatch(nil)
+
+	for i, b := range bats {
+		if b == nil {
+			continue
+		}
+		target := ap.ctr.shuffleTargets[i]
+		if target.local != nil {
+			select {
+			case <-target.local.Ctx.Done():
+				// the receiver has quit, rows of its partition are useless.
+				proc.PutBatch(b)
+			case target.local.Ch <- b:
+			}
+			continue
+		}
+
+		encodeData, err := types.Encode(b)
+		proc.PutBatch(b)
+		if err == nil {
+			err = sendBatchToClientSession(encodeData, target.remote)
+		}
+		if err != nil && !moerr.IsMoErrCode(err, moerr.ErrStreamClosed) {
+			for _, rest := range bats[i+1:] {
+				if rest != nil {
+					proc.PutBatch(rest)
+				}
+			}
+			return false, err
+		}
+	}
+	return false, nil
+}
+
 func sendBatchToClientSession(encodeBatData []byte, wcs *WrapperClientSession) error {
 	checksum := crc32.ChecksumIEEE(encodeBatData)
 	if len(encodeBatData) <= maxMessageSizeToMoRpc {
@@ -0,0 +1,139 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dispatch
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// FNV-1a, every CN must route the same key to the same partition,
+// so the hash only depends on the bytes of key values.
+const (
+	shuffleHashOffset = uint64(14695981039346656037)
+	shuffleHashPrime  = uint64(1099511628211)
+)
+
+// shuffleBatch splits bat into one batch per partition, the partition of
+// a row is decided by the hash of its shuffle keys. A nil entry means
+// no row belongs to that partition. The result takes the ownership of bat.
+func (ctr *container) shuffleBatch(bat *batch.Batch, exprs []*plan.Expr, proc *process.Process) ([]*batch.Batch, error) {
+	keys := make([]*vector.Vector, 0, len(exprs))
+	needFree := make([]bool, 0, len(exprs))
+	defer func() {
+		for i, key := range keys {
+			if needFree[i] {
+				key.Free(proc.Mp())
+			}
+		}
+	}()
+	for _, expr := range exprs {
+		vec, err := colexec.EvalExpr(bat, proc, expr)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, vec)
+		needFree = append(needFree, !isBatchVector(bat, vec))
+	}
+
+	n := uint64(len(ctr.shuffleTargets))
+	for i := range ctr.shuffleSels {
+		ctr.shuffleSels[i] = ctr.shuffleSels[i][:0]
+	}
+	for row := 0; row < bat.Length(); row++ {
+		h := shuffleHashOffset
+		for _, key := range keys {
+			h = shuffleHashKey(h, key, row)
+		}
+		p := h % n
+		ctr.shuffleSels[p] = append(ctr.shuffleSels[p], int32(row))
+	}
+
+	bats := make([]*batch.Batch, n)
+	for i, sels := range ctr.shuffleSels {
+		if len(sels) == 0 {
+			continue
+		}
+		if len(sels) == bat.Length() { // all rows go to the same partition
+			bats[i] = bat
+			return bats, nil
+		}
+		b, err := shuffleSubBatch(bat, sels, proc)
+		if err != nil {
+			for _, b := range bats {
+				if b != nil {
+					b.Clean(proc.Mp())
+				}
+			}
+			return nil, err
+		}
+		bats[i] = b
+	}
+	proc.PutBatch(bat)
+	return bats, nil
+}
+
+func shuffleSubBatch(bat *batch.Batch, sels []int32, proc *process.Process) (*batch.Batch, error) {
+	b := batch.NewWithSize(len(bat.Vecs))
+	b.Attrs = bat.Attrs
+	for i, vec := range bat.Vecs {
+		b.Vecs[i] = vector.NewVec(*vec.GetType())
+		if err := b.Vecs[i].Union(vec, sels, proc.Mp()); err != nil {
+			b.Clean(proc.Mp())
+			return nil, err
+		}
+	}
+	b.Zs = proc.Mp().GetSels()
+	for _, sel := range sels {
+		b.Zs = append(b.Zs, bat.Zs[sel])
+	}
+	return b, nil
+}
+
+func shuffleHashKey(h uint64, vec *vector.Vector, row int) uint64 {
+	if vec.IsConstNull() || (!vec.IsConst() && nulls.Contains(vec.GetNulls(), uint64(row))) {
+		return shuffleHashBytes(h, []byte{0})
+	}
+	h = shuffleHashBytes(h, []byte{1})
+	if vec.GetType().IsVarlen() {
+		return shuffleHashBytes(h, vec.GetBytesAt(row))
+	}
+	if vec.IsConst() {
+		row = 0
+	}
+	size := vec.GetType().TypeSize()
+	return shuffleHashBytes(h, vec.UnsafeGetRawData()[row*size:(row+1)*size])
+}
+
+func shuffleHashBytes(h uint64, data []byte) uint64 {
+	for _, c := range data {
+		h ^= uint64(c)
+		h *= shuffleHashPrime
+	}
+	return h
+}
+
+func isBatchVector(bat *batch.Batch, vec *vector.Vector) bool {
+	for _, v := range bat.Vecs {
+		if v == vec {
+			return true
+		}
+	}
+	return false
+}
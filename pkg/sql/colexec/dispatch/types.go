@@ -24,6 +24,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/morpc"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/pb/pipeline"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -40,6 +41,17 @@ type container struct {
 	remoteReceivers []*WrapperClientSession
 	// sendFunc is the rule you want to send batch
 	sendFunc func(bat *batch.Batch, ap *Argument, proc *process.Process) (bool, error)
+
+	// shuffleTargets is indexed by partition, each partition is served
+	// by exactly one local reg or one remote receiver.
+	shuffleTargets []shuffleTarget
+	// shuffleSels records the rows of current batch for each partition.
+	shuffleSels [][]int32
+}
+
+type shuffleTarget struct {
+	local  *process.WaitRegister
+	remote *WrapperClientSession
 }
 
 type Argument struct {
@@ -60,10 +72,17 @@ type Argument struct {
 	LocalRegs []*process.WaitRegister
 	// RemoteRegs specific the remote reg you need to send to.
 	RemoteRegs []colexec.ReceiveInfo
+
+	// ShuffleExprs are the keys which the shuffle functions hash rows on.
+	ShuffleExprs []*plan.Expr
+	// ShuffleLocalIdx and ShuffleRemoteIdx are the partition numbers
+	// served by LocalRegs and RemoteRegs, they are only used by shuffle.
+	ShuffleLocalIdx  []int32
+	ShuffleRemoteIdx []int32
 }
 
 func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
-	if arg.FuncId == SendToAllFunc {
+	if arg.FuncId == SendToAllFunc || arg.FuncId == ShuffleFunc {
 		if !arg.prepared {
 			arg.waitRemoteRegsReady(proc)
 		}
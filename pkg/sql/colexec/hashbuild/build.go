@@ -64,6 +64,7 @@ func Call(idx int, proc *process.Process, arg any, isFirst bool, _ bool) (bool,
 				ap.Free(proc, true)
 				return false, err
 			}
+			ctr.sendRuntimeFilters(ap, proc)
 			if ap.ctr.mp != nil {
 				anal.Alloc(ap.ctr.mp.Size())
 			}
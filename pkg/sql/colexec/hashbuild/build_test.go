@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"context"
 	"testing"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
@@ -156,3 +157,51 @@ func newTestCase(flgs []bool, ts []types.Type, cs []*plan.Expr) buildTestCase {
 func newBatch(t *testing.T, flgs []bool, ts []types.Type, proc *process.Process, rows int64) *batch.Batch {
 	return testutil.NewBatch(ts, false, int(rows), proc.Mp())
 }
+
+func TestRuntimeFilter(t *testing.T) {
+	tc := newTestCase([]bool{false}, []types.Type{types.T_int64.ToType()},
+		[]*plan.Expr{
+			newExpr(0, types.T_int64.ToType()),
+		})
+	tc.arg.RuntimeFilterSpecs = []*plan.RuntimeFilterSpec{{Tag: 1, Expr: newExpr(0, types.T_int64.ToType())}}
+	require.NoError(t, Prepare(tc.proc, tc.arg))
+	tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+	tc.proc.Reg.MergeReceivers[0].Ch <- nil
+	ok, err := Call(0, tc.proc, tc.arg, false, false)
+	require.NoError(t, err)
+	require.True(t, ok)
+	msgs := tc.proc.WaitRuntimeFilters(context.Background(), []int32{1}, time.Second)
+	require.Equal(t, process.RuntimeFilter_IN, msgs[1].Typ)
+	require.Equal(t, Rows, len(msgs[1].Keys))
+	require.Equal(t, int64(0), types.DecodeInt64(msgs[1].Min))
+	require.Equal(t, int64(Rows-1), types.DecodeInt64(msgs[1].Max))
+	tc.proc.Reg.InputBatch.Ht.(*hashmap.JoinMap).Free()
+	tc.proc.Reg.InputBatch.Clean(tc.proc.Mp())
+	tc.arg.Free(tc.proc, false)
+	require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+
+	// an empty build drops everything of the probe side
+	tc = newTestCase([]bool{false}, []types.Type{types.T_int64.ToType()},
+		[]*plan.Expr{
+			newExpr(0, types.T_int64.ToType()),
+		})
+	tc.arg.RuntimeFilterSpecs = []*plan.RuntimeFilterSpec{{Tag: 2, Expr: newExpr(0, types.T_int64.ToType())}}
+	require.NoError(t, Prepare(tc.proc, tc.arg))
+	tc.proc.Reg.MergeReceivers[0].Ch <- nil
+	_, err = Call(0, tc.proc, tc.arg, false, false)
+	require.NoError(t, err)
+	msgs = tc.proc.WaitRuntimeFilters(context.Background(), []int32{2}, time.Second)
+	require.Equal(t, process.RuntimeFilter_DROP, msgs[2].Typ)
+
+	// too many keys for an IN filter
+	bat := newBatch(t, tc.flgs, tc.types, tc.proc, runtimeFilterInLimit+1)
+	msg := &process.RuntimeFilterMessage{}
+	buildRuntimeFilter(msg, bat.Vecs[0], runtimeFilterInLimit+1)
+	require.Equal(t, process.RuntimeFilter_BLOOM, msg.Typ)
+	for i := 0; i < bat.Length(); i++ {
+		ok, err := msg.Bloom.MayContainsKey(process.RuntimeFilterKey(bat.Vecs[0], i))
+		require.NoError(t, err)
+		require.True(t, ok)
+	}
+	bat.Clean(tc.proc.Mp())
+}
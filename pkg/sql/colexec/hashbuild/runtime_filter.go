@@ -0,0 +1,120 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hashbuild
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	// builds with no more distinct keys are sent as IN filters
+	runtimeFilterInLimit = 1024
+	// builds with more rows are only sent as MIN_MAX filters
+	runtimeFilterBloomLimit = 1 << 22
+)
+
+// sendRuntimeFilters sends the runtime filters of the build keys to the
+// probe scans. A filter failed to build is sent as PASS, so the scans
+// waiting for it go on at once.
+func (ctr *container) sendRuntimeFilters(ap *Argument, proc *process.Process) {
+	for _, spec := range ap.RuntimeFilterSpecs {
+		msg := &process.RuntimeFilterMessage{
+			Tag: spec.Tag,
+			Typ: process.RuntimeFilter_PASS,
+		}
+		if ctr.bat == nil || ctr.bat.Length() == 0 {
+			msg.Typ = process.RuntimeFilter_DROP
+		} else if vec, err := colexec.EvalExpr(ctr.bat, proc, spec.Expr); err == nil {
+			groups := uint64(ctr.bat.Length())
+			if ctr.mp != nil {
+				groups = ctr.mp.GroupCount()
+			}
+			buildRuntimeFilter(msg, vec, groups)
+			if !isBatchVector(ctr.bat.Vecs, vec) {
+				vec.Free(proc.Mp())
+			}
+		}
+		proc.SendRuntimeFilter(msg)
+	}
+}
+
+// buildRuntimeFilter fills msg with the keys of vec, groups is the count of
+// distinct join keys of the build.
+func buildRuntimeFilter(msg *process.RuntimeFilterMessage, vec *vector.Vector, groups uint64) {
+	if vec.IsConst() {
+		return
+	}
+	msg.Oid = vec.GetType().Oid
+	seen := make(map[string]struct{})
+	for i := 0; i < vec.Length(); i++ {
+		if nulls.Contains(vec.GetNulls(), uint64(i)) {
+			continue
+		}
+		key := process.RuntimeFilterKey(vec, i)
+		if msg.Min == nil || compute.Compare(key, msg.Min, msg.Oid) < 0 {
+			msg.Min = key
+		}
+		if msg.Max == nil || compute.Compare(key, msg.Max, msg.Oid) > 0 {
+			msg.Max = key
+		}
+		if groups <= runtimeFilterInLimit {
+			if _, ok := seen[string(key)]; !ok {
+				seen[string(key)] = struct{}{}
+				msg.Keys = append(msg.Keys, key)
+			}
+		}
+	}
+	if msg.Min == nil {
+		// all keys are null, nothing is joined
+		msg.Typ = process.RuntimeFilter_DROP
+		msg.Keys = nil
+		return
+	}
+	msg.Min = append([]byte{}, msg.Min...)
+	msg.Max = append([]byte{}, msg.Max...)
+	switch {
+	case groups <= runtimeFilterInLimit:
+		msg.Typ = process.RuntimeFilter_IN
+		for i := range msg.Keys {
+			msg.Keys[i] = append([]byte{}, msg.Keys[i]...)
+		}
+	case vec.Length() <= runtimeFilterBloomLimit:
+		msg.Keys = nil
+		if bloom, err := index.NewBinaryFuseFilter(containers.ToDNVector(vec)); err == nil {
+			msg.Typ = process.RuntimeFilter_BLOOM
+			msg.Bloom = bloom
+		} else {
+			msg.Typ = process.RuntimeFilter_MIN_MAX
+		}
+	default:
+		msg.Keys = nil
+		msg.Typ = process.RuntimeFilter_MIN_MAX
+	}
+}
+
+func isBatchVector(vecs []*vector.Vector, vec *vector.Vector) bool {
+	for _, v := range vecs {
+		if v == vec {
+			return true
+		}
+	}
+	return false
+}
@@ -58,6 +58,9 @@ type Argument struct {
 	Nbucket     uint64
 	Typs        []types.Type
 	Conditions  []*plan.Expr
+
+	// runtime filters of the build keys sent to the probe scans
+	RuntimeFilterSpecs []*plan.RuntimeFilterSpec
 }
 
 func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
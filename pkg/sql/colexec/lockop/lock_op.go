@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -83,6 +84,7 @@ func Call(
 	}
 	txnFeature := proc.TxnClient.(client.TxnClientWithFeature)
 	txnOp := proc.TxnOperator
+	anal := proc.GetAnalyze(idx)
 	needRetry := false
 	for _, target := range arg.targets {
 		getLogger().Debug("lock",
@@ -95,6 +97,7 @@ func Call(
 		if target.filter != nil {
 			filterCols = vector.MustFixedCol[int](bat.GetVector(target.filterColIndexInBatch))
 		}
+		lockStart := time.Now()
 		refreshTS, err := Lock(
 			proc.Ctx,
 			target.tableID,
@@ -108,6 +111,7 @@ func Call(
 				WithMaxBytesPerLock(int(proc.LockService.GetConfig().MaxLockRowBytes)).
 				WithFilterRows(target.filter, filterCols),
 		)
+		anal.AddLockWaitTime(lockStart)
 		if getLogger().Enabled(zap.DebugLevel) {
 			getLogger().Debug("lock result",
 				zap.Uint64("table", target.tableID),
@@ -0,0 +1,316 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mergejoin
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/compare"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(arg any, buf *bytes.Buffer) {
+	ap := arg.(*Argument)
+	switch ap.JoinType {
+	case plan.Node_LEFT:
+		buf.WriteString(" merge left join ")
+	case plan.Node_SEMI:
+		buf.WriteString(" merge semi join ")
+	case plan.Node_ANTI:
+		buf.WriteString(" merge anti join ")
+	default:
+		buf.WriteString(" merge join ")
+	}
+}
+
+func Prepare(proc *process.Process, arg any) error {
+	ap := arg.(*Argument)
+	ap.ctr = new(container)
+	typ := ap.Conditions[0][0].Typ
+	ap.ctr.lcmp = compare.New(types.New(types.T(typ.Id), typ.Width, typ.Scale), false, false)
+	ap.ctr.rcmp = compare.New(types.New(types.T(typ.Id), typ.Width, typ.Scale), false, false)
+	return nil
+}
+
+// Call joins a left batch with the right rows of the same keys each time.
+// As both sides are sorted, the right side is read forward only, and only
+// the rows of the current key are kept.
+func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (bool, error) {
+	anal := proc.GetAnalyze(idx)
+	anal.Start()
+	defer anal.Stop()
+	ap := arg.(*Argument)
+	ctr := ap.ctr
+	for {
+		switch ctr.state {
+		case Probe:
+			start := time.Now()
+			bat := <-proc.Reg.MergeReceivers[0].Ch
+			anal.WaitStop(start)
+
+			if bat == nil {
+				ctr.state = End
+				continue
+			}
+			if bat.Length() == 0 {
+				bat.Clean(proc.Mp())
+				continue
+			}
+			if err := ctr.probe(bat, ap, proc, anal, isFirst, isLast); err != nil {
+				ap.Free(proc, true)
+				return false, err
+			}
+			return false, nil
+
+		default:
+			ap.Free(proc, false)
+			proc.SetInputBatch(nil)
+			return true, nil
+		}
+	}
+}
+
+func (ctr *container) probe(bat *batch.Batch, ap *Argument, proc *process.Process, anal process.Analyze, isFirst bool, isLast bool) error {
+	defer proc.PutBatch(bat)
+	anal.Input(bat, isFirst)
+	rbat := batch.NewWithSize(len(ap.Result))
+	rbat.Zs = proc.Mp().GetSels()
+	for i, rp := range ap.Result {
+		if rp.Rel == 0 {
+			rbat.Vecs[i] = proc.GetVector(*bat.Vecs[rp.Pos].GetType())
+		} else {
+			rbat.Vecs[i] = proc.GetVector(ap.Typs[rp.Pos])
+		}
+	}
+
+	if err := ctr.evalKey(&ctr.lkey, bat, ap.Conditions[0][0], proc); err != nil {
+		rbat.Clean(proc.Mp())
+		return err
+	}
+	defer ctr.cleanEvalVector(&ctr.lkey, proc.Mp())
+	key := ctr.lkey.vec
+	ctr.lcmp.Set(0, key)
+
+	count := bat.Length()
+	for i := 0; i < count; i++ {
+		isNull := isNullKey(key, i)
+		matched := false
+		if !isNull {
+			var err error
+			if matched, err = ctr.seek(i, ap, proc, anal); err != nil {
+				rbat.Clean(proc.Mp())
+				return err
+			}
+		}
+
+		var err error
+		switch ap.JoinType {
+		case plan.Node_INNER:
+			if matched {
+				err = ctr.emitGroup(rbat, bat, i, ap, proc)
+			}
+		case plan.Node_LEFT:
+			if matched {
+				err = ctr.emitGroup(rbat, bat, i, ap, proc)
+			} else {
+				err = ctr.emitLeft(rbat, bat, i, ap, proc)
+			}
+		case plan.Node_SEMI:
+			if matched {
+				err = ctr.emitLeft(rbat, bat, i, ap, proc)
+			}
+		case plan.Node_ANTI:
+			// like the hash anti join, a null key matches nothing only if
+			// the right side is empty
+			if isNull {
+				var empty bool
+				if empty, err = ctr.rightEmpty(ap, proc, anal); err == nil && empty {
+					err = ctr.emitLeft(rbat, bat, i, ap, proc)
+				}
+			} else if !matched {
+				err = ctr.emitLeft(rbat, bat, i, ap, proc)
+			}
+		}
+		if err != nil {
+			rbat.Clean(proc.Mp())
+			return err
+		}
+	}
+	anal.Output(rbat, isLast)
+	proc.SetInputBatch(rbat)
+	return nil
+}
+
+// seek moves the group forward to the first key not less than the key of
+// the left row i, and reports whether the group has the same key.
+func (ctr *container) seek(i int, ap *Argument, proc *process.Process, anal process.Analyze) (bool, error) {
+	for {
+		if ctr.groupKey != nil {
+			cmp := ctr.lcmp.Compare(0, 1, int64(i), 0)
+			if cmp == 0 {
+				return true, nil
+			}
+			if cmp < 0 {
+				return false, nil
+			}
+		}
+		ok, err := ctr.nextGroup(ap, proc, anal)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+}
+
+// nextGroup reads the right rows of the next non-null key into the group,
+// it returns false if the right side is exhausted.
+func (ctr *container) nextGroup(ap *Argument, proc *process.Process, anal process.Analyze) (bool, error) {
+	ctr.cleanGroup(proc.Mp())
+	for {
+		ok, err := ctr.fill(ap, proc, anal)
+		if err != nil || !ok {
+			return false, err
+		}
+		if !isNullKey(ctr.rkey.vec, ctr.rpos) {
+			break
+		}
+		ctr.rpos++
+	}
+
+	ctr.groupKey = vector.NewVec(*ctr.rkey.vec.GetType())
+	if err := ctr.groupKey.UnionOne(ctr.rkey.vec, int64(ctr.rpos), proc.Mp()); err != nil {
+		return false, err
+	}
+	ctr.lcmp.Set(1, ctr.groupKey)
+	ctr.rcmp.Set(1, ctr.groupKey)
+
+	ctr.group = batch.NewWithSize(len(ctr.rbat.Vecs))
+	for j, vec := range ctr.rbat.Vecs {
+		ctr.group.Vecs[j] = vector.NewVec(*vec.GetType())
+	}
+	for {
+		ok, err := ctr.fill(ap, proc, anal)
+		if err != nil {
+			return false, err
+		}
+		if !ok || ctr.rcmp.Compare(0, 1, int64(ctr.rpos), 0) != 0 {
+			break
+		}
+		for j, vec := range ctr.rbat.Vecs {
+			if err := ctr.group.Vecs[j].UnionOne(vec, int64(ctr.rpos), proc.Mp()); err != nil {
+				return false, err
+			}
+		}
+		ctr.group.Zs = append(ctr.group.Zs, ctr.rbat.Zs[ctr.rpos])
+		ctr.rpos++
+	}
+	return true, nil
+}
+
+// fill makes sure there is a right row to read, it returns false if the
+// right side is exhausted.
+func (ctr *container) fill(ap *Argument, proc *process.Process, anal process.Analyze) (bool, error) {
+	for ctr.rbat == nil || ctr.rpos >= ctr.rbat.Length() {
+		if ctr.rdone {
+			return false, nil
+		}
+		ctr.cleanRight(proc)
+		start := time.Now()
+		bat := <-proc.Reg.MergeReceivers[1].Ch
+		anal.WaitStop(start)
+		if bat == nil {
+			ctr.rdone = true
+			return false, nil
+		}
+		if bat.Length() == 0 {
+			bat.Clean(proc.Mp())
+			continue
+		}
+		ctr.rbat, ctr.rpos, ctr.rseen = bat, 0, true
+		if err := ctr.evalKey(&ctr.rkey, bat, ap.Conditions[1][0], proc); err != nil {
+			return false, err
+		}
+		ctr.rcmp.Set(0, ctr.rkey.vec)
+	}
+	return true, nil
+}
+
+func (ctr *container) rightEmpty(ap *Argument, proc *process.Process, anal process.Analyze) (bool, error) {
+	if _, err := ctr.fill(ap, proc, anal); err != nil {
+		return false, err
+	}
+	return !ctr.rseen, nil
+}
+
+func (ctr *container) emitGroup(rbat, bat *batch.Batch, i int, ap *Argument, proc *process.Process) error {
+	for j := range ctr.group.Zs {
+		for k, rp := range ap.Result {
+			var err error
+			if rp.Rel == 0 {
+				err = rbat.Vecs[k].UnionOne(bat.Vecs[rp.Pos], int64(i), proc.Mp())
+			} else {
+				err = rbat.Vecs[k].UnionOne(ctr.group.Vecs[rp.Pos], int64(j), proc.Mp())
+			}
+			if err != nil {
+				return err
+			}
+		}
+		rbat.Zs = append(rbat.Zs, ctr.group.Zs[j])
+	}
+	return nil
+}
+
+// emitLeft outputs the left row i, the columns of the right side are null.
+func (ctr *container) emitLeft(rbat, bat *batch.Batch, i int, ap *Argument, proc *process.Process) error {
+	for k, rp := range ap.Result {
+		var err error
+		if rp.Rel == 0 {
+			err = rbat.Vecs[k].UnionOne(bat.Vecs[rp.Pos], int64(i), proc.Mp())
+		} else {
+			err = rbat.Vecs[k].UnionNull(proc.Mp())
+		}
+		if err != nil {
+			return err
+		}
+	}
+	rbat.Zs = append(rbat.Zs, bat.Zs[i])
+	return nil
+}
+
+func isNullKey(vec *vector.Vector, i int) bool {
+	return vec.IsConstNull() || vec.GetNulls().Contains(uint64(i))
+}
+
+func (ctr *container) evalKey(evec *evalVector, bat *batch.Batch, expr *plan.Expr, proc *process.Process) error {
+	ctr.cleanEvalVector(evec, proc.Mp())
+	vec, err := colexec.EvalExpr(bat, proc, expr)
+	if err != nil {
+		return err
+	}
+	evec.vec = vec
+	evec.needFree = true
+	for j := range bat.Vecs {
+		if bat.Vecs[j] == vec {
+			evec.needFree = false
+			break
+		}
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mergejoin
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+// null is the null key of the test batches
+const null = -1
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	for _, typ := range []plan.Node_JoinType{plan.Node_INNER, plan.Node_LEFT, plan.Node_SEMI, plan.Node_ANTI} {
+		String(&Argument{JoinType: typ}, buf)
+	}
+	require.Equal(t, " merge join  merge left join  merge semi join  merge anti join ", buf.String())
+}
+
+func TestMergeJoin(t *testing.T) {
+	// the right rows of key 2 and 4 are split across batches
+	left := [][][2]int64{
+		{{null, 9}, {1, 10}, {2, 20}},
+		{{2, 21}, {4, 40}, {5, 50}},
+	}
+	right := [][][2]int64{
+		{{null, 99}, {2, 200}},
+		{{2, 201}, {4, 400}},
+		{{4, 401}, {6, 600}},
+	}
+	joinResult := []colexec.ResultPos{colexec.NewResultPos(0, 0), colexec.NewResultPos(0, 1), colexec.NewResultPos(1, 1)}
+	semiResult := []colexec.ResultPos{colexec.NewResultPos(0, 0), colexec.NewResultPos(0, 1)}
+
+	require.Equal(t, [][]int64{
+		{2, 20, 200}, {2, 20, 201}, {2, 21, 200}, {2, 21, 201}, {4, 40, 400}, {4, 40, 401},
+	}, runMergeJoin(t, plan.Node_INNER, joinResult, left, right))
+	require.Equal(t, [][]int64{
+		{null, 9, null}, {1, 10, null},
+		{2, 20, 200}, {2, 20, 201}, {2, 21, 200}, {2, 21, 201}, {4, 40, 400}, {4, 40, 401},
+		{5, 50, null},
+	}, runMergeJoin(t, plan.Node_LEFT, joinResult, left, right))
+	require.Equal(t, [][]int64{
+		{2, 20}, {2, 21}, {4, 40},
+	}, runMergeJoin(t, plan.Node_SEMI, semiResult, left, right))
+	require.Equal(t, [][]int64{
+		{1, 10}, {5, 50},
+	}, runMergeJoin(t, plan.Node_ANTI, semiResult, left, right))
+	// a null key is kept by an anti join only if the right side is empty
+	require.Equal(t, [][]int64{
+		{null, 9}, {1, 10}, {2, 20}, {2, 21}, {4, 40}, {5, 50},
+	}, runMergeJoin(t, plan.Node_ANTI, semiResult, left, nil))
+}
+
+func runMergeJoin(t *testing.T, typ plan.Node_JoinType, result []colexec.ResultPos, left, right [][][2]int64) [][]int64 {
+	proc := testutil.NewProcessWithMPool(mpool.MustNewZero())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	proc.Reg.MergeReceivers = []*process.WaitRegister{
+		{Ctx: ctx, Ch: make(chan *batch.Batch, len(left)+1)},
+		{Ctx: ctx, Ch: make(chan *batch.Batch, len(right)+1)},
+	}
+	for _, rows := range left {
+		proc.Reg.MergeReceivers[0].Ch <- newBatch(t, proc, rows)
+	}
+	proc.Reg.MergeReceivers[0].Ch <- nil
+	for _, rows := range right {
+		proc.Reg.MergeReceivers[1].Ch <- newBatch(t, proc, rows)
+	}
+	proc.Reg.MergeReceivers[1].Ch <- nil
+
+	arg := &Argument{
+		JoinType:   typ,
+		Typs:       []types.Type{types.T_int64.ToType(), types.T_int64.ToType()},
+		Result:     result,
+		Conditions: [][]*plan.Expr{{newColExpr(0, 0)}, {newColExpr(1, 0)}},
+	}
+	require.NoError(t, Prepare(proc, arg))
+	var rows [][]int64
+	for {
+		ok, err := Call(0, proc, arg, false, false)
+		require.NoError(t, err)
+		if ok {
+			break
+		}
+		bat := proc.Reg.InputBatch
+		for i := 0; i < bat.Length(); i++ {
+			row := make([]int64, len(bat.Vecs))
+			for j, vec := range bat.Vecs {
+				if vec.GetNulls().Contains(uint64(i)) {
+					row[j] = null
+				} else {
+					row[j] = vector.MustFixedCol[int64](vec)[i]
+				}
+			}
+			rows = append(rows, row)
+		}
+		bat.Clean(proc.Mp())
+	}
+	proc.FreeVectors()
+	require.Equal(t, int64(0), proc.Mp().CurrNB())
+	return rows
+}
+
+func newBatch(t *testing.T, proc *process.Process, rows [][2]int64) *batch.Batch {
+	bat := batch.NewWithSize(2)
+	for j := range bat.Vecs {
+		bat.Vecs[j] = vector.NewVec(types.T_int64.ToType())
+		for _, row := range rows {
+			require.NoError(t, vector.AppendFixed(bat.Vecs[j], row[j], row[j] == null, proc.Mp()))
+		}
+	}
+	bat.Zs = make([]int64, len(rows))
+	for i := range bat.Zs {
+		bat.Zs[i] = 1
+	}
+	return bat
+}
+
+func newColExpr(rel, pos int32) *plan.Expr {
+	return &plan.Expr{
+		Typ: &plan.Type{Id: int32(types.T_int64)},
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{RelPos: rel, ColPos: pos},
+		},
+	}
+}
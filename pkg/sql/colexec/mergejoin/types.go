@@ -0,0 +1,130 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mergejoin
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/compare"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	Probe = iota
+	End
+)
+
+type evalVector struct {
+	needFree bool
+	vec      *vector.Vector
+}
+
+type container struct {
+	state int
+
+	// lkey is the join key of the left batch being joined
+	lkey evalVector
+
+	// rbat is the right batch being read, rpos is its next row to read
+	rbat  *batch.Batch
+	rkey  evalVector
+	rpos  int
+	rdone bool
+	// rseen is whether any right batch has been read
+	rseen bool
+
+	// group is the rows of the right side whose join key is groupKey,
+	// the key of the left rows are compared with it.
+	group    *batch.Batch
+	groupKey *vector.Vector
+
+	// lcmp compares the left key with the group key, rcmp compares the
+	// right key with the group key.
+	lcmp compare.Compare
+	rcmp compare.Compare
+}
+
+// Argument of the merge join, both inputs are sorted in ascending order of
+// the join key. Conditions are the key of the left and the right side.
+//
+// For semi and anti joins, the Rel of all Result is 0.
+type Argument struct {
+	ctr        *container
+	JoinType   plan.Node_JoinType
+	Typs       []types.Type
+	Result     []colexec.ResultPos
+	Conditions [][]*plan.Expr
+}
+
+func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
+	ctr := arg.ctr
+	if ctr != nil {
+		mp := proc.Mp()
+		ctr.cleanEvalVector(&ctr.lkey, mp)
+		ctr.cleanRight(proc)
+		ctr.cleanGroup(mp)
+		if !pipelineFailed {
+			ctr.drainRight(proc)
+		}
+	}
+}
+
+func (ctr *container) cleanEvalVector(evec *evalVector, mp *mpool.MPool) {
+	if evec.needFree && evec.vec != nil {
+		evec.vec.Free(mp)
+	}
+	evec.vec = nil
+	evec.needFree = false
+}
+
+func (ctr *container) cleanRight(proc *process.Process) {
+	ctr.cleanEvalVector(&ctr.rkey, proc.Mp())
+	if ctr.rbat != nil {
+		proc.PutBatch(ctr.rbat)
+		ctr.rbat = nil
+	}
+}
+
+func (ctr *container) cleanGroup(mp *mpool.MPool) {
+	if ctr.group != nil {
+		ctr.group.Clean(mp)
+		ctr.group = nil
+	}
+	if ctr.groupKey != nil {
+		ctr.groupKey.Free(mp)
+		ctr.groupKey = nil
+	}
+}
+
+// drainRight reads the rest of the right side, the left side may end
+// before it and its sender must not be blocked.
+func (ctr *container) drainRight(proc *process.Process) {
+	for !ctr.rdone {
+		select {
+		case bat := <-proc.Reg.MergeReceivers[1].Ch:
+			if bat == nil {
+				ctr.rdone = true
+				break
+			}
+			bat.Clean(proc.Mp())
+		case <-proc.Ctx.Done():
+			ctr.rdone = true
+		}
+	}
+}
@@ -37,7 +37,9 @@ import (
 type S3Writer struct {
 	sortIndex int
 	pk        map[string]struct{}
-	idx       int16
+	// columns with a bloom filter besides the primary key
+	bfCols map[string]struct{}
+	idx    int16
 
 	writer  *blockio.BlockWriter
 	lengths []uint64
@@ -179,6 +181,13 @@ func AllocS3Writers(tableDef *plan.TableDef) ([]*S3Writer, error) {
 			if tableDef.Pkey != nil && tableDef.Pkey.CompPkeyCol != nil {
 				writers[i].pk[tableDef.Pkey.CompPkeyCol.Name] = struct{}{}
 			}
+
+			if tableDef.BloomFilter != nil {
+				writers[i].bfCols = make(map[string]struct{}, len(tableDef.BloomFilter.Cols))
+				for _, name := range tableDef.BloomFilter.Cols {
+					writers[i].bfCols[name] = struct{}{}
+				}
+			}
 			continue
 		}
 		//handle for unique index table.
@@ -514,10 +523,21 @@ func getPrimaryKeyIdx(pk map[string]struct{}, attrs []string) (uint16, bool) {
 	return 0, false
 }
 
+func getBloomFilterIdxes(bfCols map[string]struct{}, attrs []string) []uint16 {
+	var idxes []uint16
+	for i := range attrs {
+		if _, ok := bfCols[attrs[i]]; ok {
+			idxes = append(idxes, uint16(i))
+		}
+	}
+	return idxes
+}
+
 func (w *S3Writer) WriteBlock(bat *batch.Batch) error {
 	if idx, ok := getPrimaryKeyIdx(w.pk, bat.Attrs); ok {
 		w.writer.SetPrimaryKey(idx)
 	}
+	w.writer.SetBloomFilterColumns(getBloomFilterIdxes(w.bfCols, bat.Attrs)...)
 	_, err := w.writer.WriteBatch(bat)
 	if err != nil {
 		return err
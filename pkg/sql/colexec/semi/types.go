@@ -56,6 +56,8 @@ type Argument struct {
 	Typs       []types.Type
 	Cond       *plan.Expr
 	Conditions [][]*plan.Expr
+
+	RuntimeFilterSpecs []*plan.RuntimeFilterSpec
 }
 
 func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
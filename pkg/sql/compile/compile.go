@@ -49,6 +49,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/output"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/rule"
 	"github.com/matrixorigin/matrixone/pkg/sql/util"
 	"github.com/matrixorigin/matrixone/pkg/util/trace"
 	"github.com/matrixorigin/matrixone/pkg/vm"
@@ -76,7 +77,8 @@ func New(addr, db string, sql string, uid string, ctx context.Context,
 		stmt: stmt,
 		addr: addr,
 
-		stepRegs: make(map[int32][]*process.WaitRegister),
+		stepRegs:    make(map[int32][]*process.WaitRegister),
+		sortedScans: make(map[int32]string),
 	}
 }
 
@@ -128,6 +130,7 @@ func (c *Compile) Compile(ctx context.Context, pn *plan.Plan, u any, fill func(a
 
 	// Compile may exec some function that need engine.Engine.
 	c.proc.Ctx = context.WithValue(c.proc.Ctx, defines.EngineKey{}, c.e)
+	c.proc.ResetRuntimeFilters()
 	// generate logic pipeline for query.
 	c.scope, err = c.compileScope(ctx, pn)
 	if err != nil {
@@ -646,12 +649,19 @@ func (c *Compile) compilePlanScope(ctx context.Context, step int32, curNodeIdx i
 		}
 		return c.compileSort(n, c.compileProjection(n, c.compileRestrict(n, ss))), nil
 	case plan.Node_JOIN:
+		if n.JoinMethod == plan.Node_MERGE_JOIN {
+			c.markSortedScans(n, ns)
+		}
 		curr := c.anal.curr
 		c.setAnalyzeCurrent(nil, int(n.Children[0]))
 		left, err := c.compilePlanScope(ctx, step, n.Children[0], ns)
 		if err != nil {
 			return nil, err
 		}
+		if n.JoinType == plan.Node_APPLY {
+			c.setAnalyzeCurrent(left, curr)
+			return c.compileSort(n, c.compileApply(n, ns[n.Children[1]], ns, left)), nil
+		}
 		c.setAnalyzeCurrent(left, int(n.Children[1]))
 		right, err := c.compilePlanScope(ctx, step, n.Children[1], ns)
 		if err != nil {
@@ -1029,11 +1039,39 @@ func (c *Compile) compileTableScan(n *plan.Node) ([]*Scope, error) {
 	}
 	ss := make([]*Scope, 0, len(nodes))
 	for i := range nodes {
-		ss = append(ss, c.compileTableScanWithNode(n, nodes[i]))
+		// a PARALLEL hint sets the number of readers of each CN
+		if n.Parallelism > 0 {
+			nodes[i].Mcpu = c.generateCPUNumber(int(n.Parallelism), int(n.Stats.BlockNum))
+		}
+		// the rows sorted are read by a single reader
+		key, sorted := c.sortedScans[n.NodeId]
+		if sorted {
+			nodes[i].Mcpu = 1
+		}
+		s := c.compileTableScanWithNode(n, nodes[i])
+		s.DataSource.SortKey = key
+		ss = append(ss, s)
 	}
 	return ss, nil
 }
 
+// markSortedScans marks the table scans of a merge join to read their rows
+// sorted on the sort key of the table, which is the join key.
+func (c *Compile) markSortedScans(n *plan.Node, ns []*plan.Node) {
+	for _, id := range n.Children {
+		child := ns[id]
+		if child.NodeType != plan.Node_TABLE_SCAN {
+			continue
+		}
+		if key := plan2.GetSortedScanKey(child); key != "" {
+			if c.sortedScans == nil {
+				c.sortedScans = make(map[int32]string)
+			}
+			c.sortedScans[child.NodeId] = key
+		}
+	}
+}
+
 func (c *Compile) compileTableScanWithNode(n *plan.Node, node engine.Node) *Scope {
 	var err error
 	var s *Scope
@@ -1126,6 +1164,7 @@ func (c *Compile) compileTableScanWithNode(n *plan.Node, node engine.Node) *Scop
 			SchemaName:             n.ObjRef.SchemaName,
 			AccountId:              n.ObjRef.PubAccountId,
 			Expr:                   colexec.RewriteFilterExprList(n.FilterList),
+			RuntimeFilterSpecs:     n.RuntimeFilterProbeList,
 		},
 	}
 	s.Proc = process.NewWithAnalyze(c.proc, c.ctx, 0, c.anal.Nodes())
@@ -1228,7 +1267,8 @@ func (c *Compile) compileUnionAll(ss []*Scope, children []*Scope) []*Scope {
 
 func (c *Compile) compileJoin(ctx context.Context, node, left, right *plan.Node, ss []*Scope, children []*Scope) []*Scope {
 	var rs []*Scope
-	isEq := plan2.IsEquiJoin(node.OnList)
+	// a NL_JOIN hint runs an equi join by the loop join operators
+	isEq := plan2.IsEquiJoin(node.OnList) && node.JoinMethod != plan.Node_NESTED_LOOP_JOIN
 
 	rightTyps := make([]types.Type, len(right.ProjectList))
 	for i, expr := range right.ProjectList {
@@ -1240,8 +1280,31 @@ func (c *Compile) compileJoin(ctx context.Context, node, left, right *plan.Node,
 		leftTyps[i] = dupType(expr.Typ)
 	}
 
+	if node.JoinMethod == plan.Node_MERGE_JOIN {
+		rs = c.newMergeJoinScopeList(node, ss, children)
+		for i := range rs {
+			rs[i].appendInstruction(vm.Instruction{
+				Op:  vm.MergeJoin,
+				Idx: c.anal.curr,
+				Arg: constructMergeJoin(node, rightTyps, c.proc),
+			})
+		}
+		return rs
+	}
+
 	switch node.JoinType {
 	case plan.Node_INNER:
+		if len(c.cnList) > 1 && plan2.ShouldShuffleJoin(node, left, right) {
+			rs = c.newShuffleJoinScopeList(node, ss, children)
+			for i := range rs {
+				rs[i].appendInstruction(vm.Instruction{
+					Op:  vm.Join,
+					Idx: c.anal.curr,
+					Arg: constructJoin(node, rightTyps, c.proc),
+				})
+			}
+			break
+		}
 		rs = c.newBroadcastJoinScopeList(ss, children)
 		if len(node.OnList) == 0 {
 			for i := range rs {
@@ -1400,6 +1463,137 @@ func (c *Compile) compileJoin(ctx context.Context, node, left, right *plan.Node,
 	return rs
 }
 
+// compileApply runs an APPLY join in a single local pipeline: the apply
+// operator binds the correlated columns of the right child to each row the
+// left child produces and runs the right child for it by a nested compile.
+func (c *Compile) compileApply(node, right *plan.Node, ns []*plan.Node, ss []*Scope) []*Scope {
+	rightTyps := make([]types.Type, len(right.ProjectList))
+	for i, expr := range right.ProjectList {
+		rightTyps[i] = dupType(expr.Typ)
+	}
+
+	rs := c.newMergeScope(ss)
+	rs.appendInstruction(vm.Instruction{
+		Op:  vm.Apply,
+		Idx: c.anal.curr,
+		Arg: constructApply(node, rightTyps, applyCorrCols(node.Children[1], ns), c.newApplyEval(node.Children[1], rightTyps, ns), c.proc),
+	})
+	return []*Scope{rs}
+}
+
+// applyCorrCols returns the columns of the left child of an APPLY join the
+// plan tree rooted at rightID refers to.
+func applyCorrCols(rightID int32, ns []*plan.Node) []int32 {
+	var cols []int32
+	_ = plan2.ReplaceApplyCorrColRefs(ns, rightID, func(expr *plan.Expr, corr *plan.CorrColRef) (*plan.Expr, error) {
+		for _, col := range cols {
+			if col == corr.ColPos {
+				return expr, nil
+			}
+		}
+		cols = append(cols, corr.ColPos)
+		return expr, nil
+	})
+	return cols
+}
+
+// newApplyEval returns the function the apply operator uses to run the plan
+// tree rooted at rightID for one row of its input.
+func (c *Compile) newApplyEval(rightID int32, typs []types.Type, ns []*plan.Node) func(*process.Process, *batch.Batch, int) (*batch.Batch, error) {
+	return func(proc *process.Process, bat *batch.Batch, row int) (rbat *batch.Batch, err error) {
+		defer func() {
+			if e := recover(); e != nil {
+				err = moerr.ConvertPanicError(proc.Ctx, e)
+			}
+		}()
+
+		nodes := make([]*plan.Node, len(ns))
+		copy(nodes, ns)
+		copyApplyTree(nodes, rightID)
+		err = plan2.ReplaceApplyCorrColRefs(nodes, rightID, func(expr *plan.Expr, corr *plan.CorrColRef) (*plan.Expr, error) {
+			return constantOfRow(proc, expr, bat.Vecs[corr.ColPos], row)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		sub := New(c.addr, c.db, c.sql, c.uid, proc.Ctx, c.e, c.proc, c.stmt)
+		sub.info = c.info
+		sub.cnList = engine.Nodes{engine.Node{Addr: c.addr, Mcpu: 1}}
+		sub.anal = &anaylze{
+			qry:       c.anal.qry,
+			analInfos: c.anal.analInfos,
+			curr:      int(rightID),
+		}
+		ss, err := sub.compilePlanScope(proc.Ctx, 0, rightID, nodes)
+		if err != nil {
+			return nil, err
+		}
+
+		rbat = batch.NewWithSize(len(typs))
+		for i, typ := range typs {
+			rbat.Vecs[i] = proc.GetVector(typ)
+		}
+		rs := sub.newMergeScope(ss)
+		updateScopesLastFlag([]*Scope{rs})
+		rs.appendInstruction(vm.Instruction{
+			Op: vm.Output,
+			Arg: &output.Argument{
+				Func: func(_ any, b *batch.Batch) error {
+					if b == nil {
+						return nil
+					}
+					for i, vec := range b.Vecs {
+						if err := rbat.Vecs[i].UnionBatch(vec, 0, b.Length(), nil, proc.Mp()); err != nil {
+							return err
+						}
+					}
+					rbat.Zs = append(rbat.Zs, b.Zs...)
+					return nil
+				},
+			},
+		})
+		if err = rs.MergeRun(sub); err != nil {
+			rbat.Clean(proc.Mp())
+			return nil, err
+		}
+		return rbat, nil
+	}
+}
+
+// copyApplyTree replaces the nodes of the tree rooted at nodeID by copies, so
+// that binding the correlated columns leaves the original plan untouched.
+// The runtime filters are dropped since the tree is run once per row.
+func copyApplyTree(nodes []*plan.Node, nodeID int32) {
+	node := plan2.DeepCopyNode(nodes[nodeID])
+	node.RuntimeFilterBuildList = nil
+	node.RuntimeFilterProbeList = nil
+	nodes[nodeID] = node
+	for _, child := range node.Children {
+		copyApplyTree(nodes, child)
+	}
+}
+
+// constantOfRow returns the value of vec at row as a constant of the type of
+// the correlated column expr.
+func constantOfRow(proc *process.Process, expr *plan.Expr, vec *vector.Vector, row int) (*plan.Expr, error) {
+	v := proc.GetVector(*vec.GetType())
+	defer v.Free(proc.Mp())
+	if err := v.UnionOne(vec, int64(row), proc.Mp()); err != nil {
+		return nil, err
+	}
+	c := rule.GetConstantValue(v, true)
+	if c == nil {
+		return nil, moerr.NewNYI(proc.Ctx, "correlated column of type %s", vec.GetType())
+	}
+	return &plan.Expr{
+		Typ: expr.Typ,
+		Expr: &plan.Expr_C{
+			C: c,
+		},
+	}, nil
+}
+
 func (c *Compile) compileSort(n *plan.Node, ss []*Scope) []*Scope {
 	switch {
 	case n.Limit != nil && n.Offset == nil && len(n.OrderBy) > 0: // top
@@ -1574,6 +1768,9 @@ func (c *Compile) compileGroup(n *plan.Node, ss []*Scope, ns []*plan.Node) []*Sc
 	currentIsFirst := c.anal.isFirst
 	c.anal.isFirst = false
 	rs := c.newScopeList(validScopeCount(ss), int(n.Stats.BlockNum))
+	// with a shuffle, each group scope receives all rows of its own groups,
+	// so it does not need to filter rows by bucket any more.
+	shuffle := len(rs) > 1 && plan2.ShouldShuffleGroup(n)
 	j := 0
 	for i := range ss {
 		if containBrokenNode(ss[i]) {
@@ -1582,9 +1779,13 @@ func (c *Compile) compileGroup(n *plan.Node, ss []*Scope, ns []*plan.Node) []*Sc
 			ss[i].IsEnd = isEnd
 		}
 		if !ss[i].IsEnd {
+			arg := constructBroadcastDispatch(j, rs, c.addr)
+			if shuffle {
+				arg = constructShuffleDispatch(j, rs, c.addr, n.GroupBy)
+			}
 			ss[i].appendInstruction(vm.Instruction{
 				Op:  vm.Dispatch,
-				Arg: constructBroadcastDispatch(j, rs, c.addr),
+				Arg: arg,
 			})
 			j++
 			ss[i].IsEnd = true
@@ -1592,11 +1793,15 @@ func (c *Compile) compileGroup(n *plan.Node, ss []*Scope, ns []*plan.Node) []*Sc
 	}
 
 	for i := range rs {
+		ibucket, nbucket := i, len(rs)
+		if shuffle {
+			ibucket, nbucket = 0, 1
+		}
 		rs[i].Instructions = append(rs[i].Instructions, vm.Instruction{
 			Op:      vm.Group,
 			Idx:     c.anal.curr,
 			IsFirst: currentIsFirst,
-			Arg:     constructGroup(c.ctx, n, ns[n.Children[0]], i, len(rs), true, c.proc),
+			Arg:     constructGroup(c.ctx, n, ns[n.Children[0]], ibucket, nbucket, true, c.proc),
 		})
 	}
 	return []*Scope{c.newMergeScope(append(rs, ss...))}
@@ -1771,6 +1976,133 @@ func (c *Compile) newScopeListForRightJoin(childrenCount int, leftScopes []*Scop
 	return ss
 }
 
+// newShuffleJoinScopeList builds one join scope per CN, both sides are hash
+// partitioned on the join keys, so each scope only joins the rows of its own
+// partition and nothing is broadcast. The scopes of a side are shuffled on the
+// CN running them, and each join scope merges what every CN shuffles to it.
+func (c *Compile) newShuffleJoinScopeList(n *plan.Node, ss, children []*Scope) []*Scope {
+	currentFirstFlag := c.anal.isFirst
+	rs := make([]*Scope, len(c.cnList))
+	idx := 0
+	for i, cn := range c.cnList {
+		rs[i] = &Scope{
+			Magic:    Remote,
+			IsJoin:   true,
+			Proc:     process.NewWithAnalyze(c.proc, c.ctx, 2, c.anal.Nodes()),
+			NodeInfo: engine.Node{Addr: cn.Addr, Mcpu: c.generateCPUNumber(cn.Mcpu, int(n.Stats.BlockNum))},
+		}
+		if isSameCN(cn.Addr, c.addr) {
+			idx = i
+		}
+	}
+	_, conds := extraJoinConditions(n.OnList)
+	keys := constructJoinConditions(conds, c.proc)
+
+	for i, input := range [][]*Scope{ss, children} {
+		// the scopes of the side run on the join scope of their CN, or on
+		// the one of the current CN
+		producers := make([][]*Scope, len(rs))
+		for _, s := range input {
+			j := idx
+			for k := range rs {
+				if len(s.NodeInfo.Addr) > 0 && isSameCN(rs[k].NodeInfo.Addr, s.NodeInfo.Addr) {
+					j = k
+					break
+				}
+			}
+			producers[j] = append(producers[j], s)
+		}
+		cnt := 0
+		for j := range producers {
+			if len(producers[j]) > 0 {
+				cnt++
+			}
+		}
+
+		receivers := make([]*Scope, len(rs))
+		for j := range rs {
+			receivers[j] = &Scope{
+				Magic:    Merge,
+				NodeInfo: engine.Node{Addr: rs[j].NodeInfo.Addr, Mcpu: 1},
+				Proc:     process.NewWithAnalyze(c.proc, c.ctx, cnt, c.anal.Nodes()),
+			}
+			receivers[j].appendInstruction(vm.Instruction{
+				Op:  vm.Merge,
+				Idx: c.anal.curr,
+				Arg: &merge.Argument{},
+			})
+			receivers[j].appendInstruction(vm.Instruction{
+				Op: vm.Connector,
+				Arg: &connector.Argument{
+					Reg: rs[j].Proc.Reg.MergeReceivers[i],
+				},
+			})
+		}
+
+		p := 0
+		for j := range producers {
+			if len(producers[j]) == 0 {
+				continue
+			}
+			c.anal.isFirst = currentFirstFlag
+			addr := rs[j].NodeInfo.Addr
+			shuffle := c.newMergeScope(producers[j])
+			shuffle.NodeInfo.Addr = addr
+			shuffle.appendInstruction(vm.Instruction{
+				Op:  vm.Dispatch,
+				Arg: constructShuffleDispatch(p, receivers, addr, keys[i]),
+			})
+			shuffle.IsEnd = true
+			rs[j].PreScopes = append(rs[j].PreScopes, shuffle)
+			p++
+		}
+		for j := range rs {
+			rs[j].PreScopes = append(rs[j].PreScopes, receivers[j])
+		}
+	}
+	c.anal.isFirst = false
+	return rs
+}
+
+// newMergeJoinScopeList builds the scopes of a merge join running in
+// parallel. Each side is a sorted stream, it is shuffled by the join key to
+// the scopes, so a scope receives the rows of its keys still sorted.
+func (c *Compile) newMergeJoinScopeList(n *plan.Node, ss, children []*Scope) []*Scope {
+	currentFirstFlag := c.anal.isFirst
+	_, conds := extraJoinConditions(n.OnList)
+	keys := constructJoinConditions(conds, c.proc)
+
+	blocks := 0
+	if n.Stats != nil {
+		blocks = int(n.Stats.BlockNum)
+	}
+	rs := make([]*Scope, c.generateCPUNumber(c.NumCPU(), blocks))
+	for i := range rs {
+		rs[i] = &Scope{
+			Magic:    Merge,
+			Proc:     process.NewWithAnalyze(c.proc, c.ctx, 2, c.anal.Nodes()),
+			NodeInfo: engine.Node{Addr: c.addr, Mcpu: 1},
+		}
+	}
+	for i, input := range [][]*Scope{ss, children} {
+		c.anal.isFirst = currentFirstFlag
+		// the sort or the sorted scan of a side ends in a single sorted stream
+		if len(input) != 1 {
+			input = c.compileOrder(&plan.Node{
+				OrderBy: []*plan.OrderBySpec{{Expr: keys[i][0]}},
+			}, input)
+		}
+		input[0].appendInstruction(vm.Instruction{
+			Op:  vm.Dispatch,
+			Arg: constructShuffleDispatch(i, rs, c.addr, keys[i]),
+		})
+		input[0].IsEnd = true
+		rs[0].PreScopes = append(rs[0].PreScopes, input[0])
+	}
+	c.anal.isFirst = false
+	return rs
+}
+
 func (c *Compile) newJoinScopeListWithBucket(rs, ss, children []*Scope) []*Scope {
 	currentFirstFlag := c.anal.isFirst
 	// construct left
@@ -1999,6 +2331,15 @@ func (c *Compile) fillAnalyzeInfo() {
 		atomic.StoreInt64(&c.anal.qry.Nodes[i].AnalyzeInfo.NetworkIO, atomic.LoadInt64(&anal.NetworkIO))
 		atomic.StoreInt64(&c.anal.qry.Nodes[i].AnalyzeInfo.ScanTime, atomic.LoadInt64(&anal.ScanTime))
 		atomic.StoreInt64(&c.anal.qry.Nodes[i].AnalyzeInfo.InsertTime, atomic.LoadInt64(&anal.InsertTime))
+		atomic.StoreInt64(&c.anal.qry.Nodes[i].AnalyzeInfo.MemoryPeak, atomic.LoadInt64(&anal.MemoryPeak))
+		atomic.StoreInt64(&c.anal.qry.Nodes[i].AnalyzeInfo.CpuTime, atomic.LoadInt64(&anal.CpuTime))
+		atomic.StoreInt64(&c.anal.qry.Nodes[i].AnalyzeInfo.TotalBlocks, atomic.LoadInt64(&anal.TotalBlocks))
+		atomic.StoreInt64(&c.anal.qry.Nodes[i].AnalyzeInfo.PrunedBlocks, atomic.LoadInt64(&anal.PrunedBlocks))
+		atomic.StoreInt64(&c.anal.qry.Nodes[i].AnalyzeInfo.S3ReadCount, atomic.LoadInt64(&anal.S3ReadCount))
+		atomic.StoreInt64(&c.anal.qry.Nodes[i].AnalyzeInfo.CacheReadCount, atomic.LoadInt64(&anal.CacheReadCount))
+		atomic.StoreInt64(&c.anal.qry.Nodes[i].AnalyzeInfo.CacheHitCount, atomic.LoadInt64(&anal.CacheHitCount))
+		atomic.StoreInt64(&c.anal.qry.Nodes[i].AnalyzeInfo.LockWaitTime, atomic.LoadInt64(&anal.LockWaitTime))
+		c.anal.qry.Nodes[i].AnalyzeInfo.CnAddrs = anal.CnAddrs()
 	}
 }
 
@@ -2043,6 +2384,14 @@ func (c *Compile) generateNodes(n *plan.Node) (engine.Nodes, error) {
 		}
 	}
 
+	// count the blocks pruned for this scan only.
+	var rangesCounter perfcounter.CounterSet
+	ctx = perfcounter.WithCounterSet(ctx, &rangesCounter)
+	defer func() {
+		c.anal.BlockCount(c.anal.curr, rangesCounter.DistTAE.Ranges.Blocks.Load(),
+			rangesCounter.DistTAE.Ranges.ZonemapPruned.Load()+rangesCounter.DistTAE.Ranges.BloomFilterPruned.Load())
+	}()
+
 	expr, _ := plan2.HandleFiltersForZM(n.FilterList, c.proc)
 	ranges, err = rel.Ranges(ctx, expr)
 	if err != nil {
@@ -2100,8 +2449,9 @@ func (c *Compile) generateNodes(n *plan.Node) (engine.Nodes, error) {
 		rel = nil
 	}
 	// for multi cn in luanch mode, put all payloads in current CN
-	// maybe delete this in the future
-	if isLaunchMode(c.cnList) {
+	// maybe delete this in the future. The rows read sorted are merged
+	// from all blocks in current CN too.
+	if _, sorted := c.sortedScans[n.NodeId]; sorted || isLaunchMode(c.cnList) {
 		return putBlocksInCurrentCN(c, ranges, rel, n), nil
 	}
 	// disttae engine , hash s3 objects to fixed CN
@@ -22,6 +22,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/dispatch"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
@@ -62,6 +63,11 @@ func init() {
 		newTestCase("select * from R limit 10", new(testing.T)),
 		newTestCase("select count(*) from R group by uid", new(testing.T)),
 		newTestCase("select count(distinct uid) from R", new(testing.T)),
+		newTestCase("select * from R where uid > (select max(uid) from S where S.uid < R.uid)", new(testing.T)),
+		newTestCase("select * from R join lateral (select * from S where S.uid < R.uid order by uid limit 1) t", new(testing.T)),
+		newTestCase("select * from R left join lateral (select count(*) c from S where S.uid > R.uid) t on true", new(testing.T)),
+		newTestCase("select * from R where exists (select * from S where S.uid < R.uid limit 1)", new(testing.T)),
+		newTestCase("select * from R where uid in (select uid from S where S.orderid > R.orderid limit 3)", new(testing.T)),
 		newTestCase("insert into R values('1', '2', '3')", new(testing.T)),
 		newTestCase("insert into R select * from R", new(testing.T)),
 	}
@@ -94,6 +100,144 @@ func TestCompile(t *testing.T) {
 	}
 }
 
+func TestCompileApply(t *testing.T) {
+	ctx := context.TODO()
+	// each query evaluated by an APPLY join returns as many rows as
+	// the equivalent query planned without one
+	pairs := [][2]string{
+		{
+			"select * from R join lateral (select * from S where S.uid < R.uid limit 100000) t",
+			"select * from R join S on S.uid < R.uid",
+		},
+		{
+			"select R.orderid from R left join lateral (select * from S where S.uid > R.uid limit 100000) t on true",
+			"select R.orderid from R left join S on S.uid > R.uid",
+		},
+		{
+			"select * from R where exists (select * from S where S.uid < R.uid limit 1)",
+			"select * from R where exists (select * from S where S.uid < R.uid)",
+		},
+		{
+			"select * from R where uid > (select max(uid) from S where S.uid < R.uid)",
+			"select * from R where exists (select * from S where S.uid < R.uid)",
+		},
+	}
+	run := func(sql string, apply bool) int {
+		tc := newTestCase(sql, t)
+		hasApply := false
+		for _, node := range tc.pn.GetQuery().Nodes {
+			if node.NodeType == plan.Node_JOIN && node.JoinType == plan.Node_APPLY {
+				hasApply = true
+			}
+		}
+		require.Equal(t, apply, hasApply, sql)
+		rows := 0
+		c := New("test", "test", tc.sql, "", context.TODO(), tc.e, tc.proc, tc.stmt)
+		err := c.Compile(ctx, tc.pn, nil, func(_ any, bat *batch.Batch) error {
+			if bat != nil {
+				rows += bat.Length()
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		require.NoError(t, c.Run(0))
+		tc.proc.FreeVectors()
+		require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+		return rows
+	}
+	for _, pair := range pairs {
+		expected := run(pair[1], false)
+		require.NotEqual(t, 0, expected)
+		require.Equal(t, expected, run(pair[0], true), pair[0])
+	}
+}
+
+func TestCompileMergeJoin(t *testing.T) {
+	ctx := context.TODO()
+	run := func(sql string, merge bool) int {
+		tc := newTestCase(sql, t)
+		hasMerge := false
+		for _, node := range tc.pn.GetQuery().Nodes {
+			if node.NodeType == plan.Node_JOIN && node.JoinMethod == plan.Node_MERGE_JOIN {
+				hasMerge = true
+			}
+		}
+		require.Equal(t, merge, hasMerge, sql)
+		rows := 0
+		c := New("test", "test", tc.sql, "", context.TODO(), tc.e, tc.proc, tc.stmt)
+		err := c.Compile(ctx, tc.pn, nil, func(_ any, bat *batch.Batch) error {
+			if bat != nil {
+				rows += bat.Length()
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		require.NoError(t, c.Run(0))
+		tc.proc.FreeVectors()
+		require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+		return rows
+	}
+	// the sorted sides are merged in parallel, and join the same rows as
+	// the hash join of the unsorted sides
+	expected := run("select * from R join S on R.uid = S.uid", false)
+	require.NotEqual(t, 0, expected)
+	require.Equal(t, expected, run("select * from (select uid from R order by uid) a join (select uid from S order by uid) b on a.uid = b.uid", true))
+}
+
+func TestShuffleJoinScopeList(t *testing.T) {
+	cn1, cn2 := "10.0.0.1:6001", "10.0.0.2:6001"
+	proc := testutil.NewProcess()
+	c := New(cn1, "test", "", "", context.TODO(), nil, proc, nil)
+	c.anal = &anaylze{}
+	c.cnList = engine.Nodes{{Addr: cn1, Mcpu: 1}, {Addr: cn2, Mcpu: 1}}
+	newInput := func(addrs ...string) []*Scope {
+		ss := make([]*Scope, len(addrs))
+		for i, addr := range addrs {
+			ss[i] = &Scope{
+				Magic:    Remote,
+				NodeInfo: engine.Node{Addr: addr, Mcpu: 1},
+				Proc:     process.NewWithAnalyze(proc, c.ctx, 0, nil),
+			}
+		}
+		return ss
+	}
+	// the right side only runs on cn1
+	rs := c.newShuffleJoinScopeList(&plan.Node{Stats: &plan.Stats{BlockNum: 1}},
+		newInput(cn1, cn2, cn2), newInput(cn1))
+	require.Equal(t, 2, len(rs))
+	for i, addr := range []string{cn1, cn2} {
+		var shuffles, receivers []*Scope
+		for _, s := range rs[i].PreScopes {
+			if s.IsEnd {
+				shuffles = append(shuffles, s)
+			} else {
+				receivers = append(receivers, s)
+			}
+		}
+		// each side is shuffled on the CN running its scopes
+		for _, s := range shuffles {
+			require.Equal(t, addr, s.NodeInfo.Addr)
+			for _, pre := range s.PreScopes {
+				require.Equal(t, addr, pre.NodeInfo.Addr)
+			}
+			arg := s.Instructions[len(s.Instructions)-1].Arg.(*dispatch.Argument)
+			require.Equal(t, 1, len(arg.LocalRegs))
+			require.Equal(t, 1, len(arg.RemoteRegs))
+		}
+		// and each join scope receives from every CN producing the side
+		require.Equal(t, 2, len(receivers))
+		require.Equal(t, 2, len(receivers[0].Proc.Reg.MergeReceivers))
+		require.Equal(t, 1, len(receivers[1].Proc.Reg.MergeReceivers))
+		for _, info := range receivers[0].RemoteReceivRegInfos {
+			require.NotEqual(t, addr, info.FromAddr)
+		}
+	}
+	// cn1 shuffles both sides, and cn2 shuffles its two scopes of the left
+	require.Equal(t, 4, len(rs[0].PreScopes))
+	require.Equal(t, 3, len(rs[1].PreScopes))
+	require.Equal(t, 2, len(rs[1].PreScopes[0].PreScopes))
+}
+
 func TestCompileWithFaults(t *testing.T) {
 	// Enable this line to trigger the Hung.
 	// fault.Enable()
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"strconv"
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
@@ -31,6 +32,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function/builtin/ctl"
 	"github.com/matrixorigin/matrixone/pkg/sql/util"
 	"github.com/matrixorigin/matrixone/pkg/util/trace"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
@@ -155,6 +157,12 @@ func (s *Scope) AlterView(c *Compile) error {
 }
 
 func (s *Scope) AlterTable(c *Compile) error {
+	if qry := s.Plan.GetDdl().GetAlterTable(); len(qry.Actions) == 1 {
+		if act, ok := qry.Actions[0].Action.(*plan.AlterTable_Action_AlterPartition); ok {
+			return s.AlterTablePartition(c, qry, act.AlterPartition)
+		}
+	}
+
 	errChan := make(chan error, len(s.PreScopes))
 	for i := range s.PreScopes {
 		switch s.PreScopes[i].Magic {
@@ -330,6 +338,18 @@ func (s *Scope) AlterTable(c *Compile) error {
 					break
 				}
 			}
+		case *plan.AlterTable_Action_MergePolicy:
+			if err = rel.UpdateMergePolicy(c.ctx, act.MergePolicy.MergePolicy); err != nil {
+				return err
+			}
+		case *plan.AlterTable_Action_Compact:
+			dbId, err := strconv.ParseUint(dbSource.GetDatabaseId(c.ctx), 10, 64)
+			if err != nil {
+				return err
+			}
+			if err = ctl.MergeTable(c.proc, dbId, tblId); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -388,6 +408,10 @@ func (s *Scope) AlterTable(c *Compile) error {
 			newCt.Cts = append(newCt.Cts, t)
 		case *engine.PrimaryKeyDef:
 			newCt.Cts = append(newCt.Cts, t)
+		case *engine.TTLDef:
+			newCt.Cts = append(newCt.Cts, t)
+		case *engine.BloomFilterDef:
+			newCt.Cts = append(newCt.Cts, t)
 		}
 	}
 	if !originHasFkDef {
@@ -434,6 +458,378 @@ func (s *Scope) AlterTable(c *Compile) error {
 	return nil
 }
 
+// AlterTablePartition runs the partition maintenance operations in the current transaction.
+// Each partition is stored in a hidden table, so the operations are done by creating, dropping
+// and truncating the hidden tables, and then the partition info of the main table is updated.
+// EXCHANGE keeps the partition info and renames the hidden table and the standalone table in
+// the catalog instead.
+func (s *Scope) AlterTablePartition(c *Compile, qry *plan.AlterTable, alterPartition *plan.AlterTablePartition) error {
+	dbSource, err := c.e.Database(c.ctx, qry.Database, c.proc.TxnOperator)
+	if err != nil {
+		return err
+	}
+	rel, err := dbSource.Relation(c.ctx, qry.TableDef.Name)
+	if err != nil {
+		return err
+	}
+
+	switch alterPartition.Typ {
+	case plan.AlterTablePartition_ADD:
+		defs, err := getPartitionTableDefs(c, dbSource, qry.TableDef.Partition.PartitionTableNames[0])
+		if err != nil {
+			return err
+		}
+		for _, name := range alterPartition.NewPartitionTableNames {
+			if err = dbSource.Create(c.ctx, name, defs); err != nil {
+				return err
+			}
+		}
+	case plan.AlterTablePartition_DROP:
+		for _, name := range alterPartition.PartitionTableNames {
+			if err = dbSource.Delete(c.ctx, name); err != nil {
+				return err
+			}
+		}
+	case plan.AlterTablePartition_TRUNCATE:
+		for _, name := range alterPartition.PartitionTableNames {
+			if _, err = dbSource.Truncate(c.ctx, name); err != nil {
+				return err
+			}
+		}
+		// the partitions are not changed
+		return nil
+	case plan.AlterTablePartition_EXCHANGE:
+		// the partitions are not changed
+		return exchangePartition(c, dbSource, alterPartition)
+	case plan.AlterTablePartition_REORGANIZE:
+		if err = reorganizePartitions(c, dbSource, alterPartition); err != nil {
+			return err
+		}
+	}
+
+	bytes, err := alterPartition.PartitionDef.MarshalPartitionInfo()
+	if err != nil {
+		return err
+	}
+	return rel.UpdatePartition(c.ctx, &engine.PartitionDef{
+		Partitioned: 1,
+		Partition:   string(bytes),
+	})
+}
+
+// getPartitionTableDefs returns the defs of a hidden partition table to create other partitions alike
+func getPartitionTableDefs(c *Compile, dbSource engine.Database, name string) ([]engine.TableDef, error) {
+	rel, err := dbSource.Relation(c.ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	tableDefs, err := rel.TableDefs(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+	defs := make([]engine.TableDef, 0, len(tableDefs))
+	for _, def := range tableDefs {
+		switch def.(type) {
+		case *engine.AttributeDef, *engine.PropertiesDef:
+			defs = append(defs, def)
+		}
+	}
+	return defs, nil
+}
+
+// getTableAttrs returns the names of the stored columns of the table
+func getTableAttrs(defs []engine.TableDef) []string {
+	var attrs []string
+	for _, def := range defs {
+		if attr, ok := def.(*engine.AttributeDef); ok && !attr.Attr.IsRowId {
+			attrs = append(attrs, attr.Attr.Name)
+		}
+	}
+	return attrs
+}
+
+// scanTableData reads all rows of the table in the current transaction and passes the
+// batches to fn, the batches are only valid during the call.
+func scanTableData(c *Compile, rel engine.Relation, attrs []string, fn func(*batch.Batch) error) error {
+	ranges, err := rel.Ranges(c.ctx, nil)
+	if err != nil {
+		return err
+	}
+	var rds []engine.Reader
+	if len(ranges) == 0 || len(ranges[0]) == 0 {
+		// the first range is the data in memory
+		if rds, err = rel.NewReader(c.ctx, 1, nil, nil); err != nil {
+			return err
+		}
+		if len(ranges) > 1 {
+			blockRds, err := rel.NewReader(c.ctx, 1, nil, ranges[1:])
+			if err != nil {
+				return err
+			}
+			rds = append(rds, blockRds...)
+		}
+	} else if rds, err = rel.NewReader(c.ctx, 1, nil, ranges); err != nil {
+		return err
+	}
+	defer func() {
+		for _, rd := range rds {
+			rd.Close()
+		}
+	}()
+
+	for len(rds) > 0 {
+		bat, err := rds[0].Read(c.ctx, attrs, nil, c.proc.Mp(), nil)
+		if err != nil {
+			return err
+		}
+		if bat == nil {
+			rds[0].Close()
+			rds = rds[1:]
+			continue
+		}
+		if bat.Length() == 0 {
+			continue
+		}
+		if err = fn(bat); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readTableData reads all rows of the table in the current transaction, the batches are
+// copied so that they are still valid after the table is truncated or dropped.
+func readTableData(c *Compile, rel engine.Relation, attrs []string) ([]*batch.Batch, error) {
+	var bats []*batch.Batch
+	err := scanTableData(c, rel, attrs, func(bat *batch.Batch) error {
+		copied := batch.NewWithSize(len(attrs))
+		copied.SetAttributes(attrs)
+		for i := range bat.Vecs {
+			copied.SetVector(int32(i), vector.NewVec(*bat.GetVector(int32(i)).GetType()))
+		}
+		if _, err := copied.Append(c.ctx, c.proc.Mp(), bat); err != nil {
+			copied.Clean(c.proc.Mp())
+			return err
+		}
+		bats = append(bats, copied)
+		return nil
+	})
+	if err != nil {
+		cleanBatches(c, bats)
+		return nil, err
+	}
+	return bats, nil
+}
+
+// writeTableData writes the batches into the table and frees them
+func writeTableData(c *Compile, dbSource engine.Database, name string, bats []*batch.Batch) error {
+	defer cleanBatches(c, bats)
+	rel, err := dbSource.Relation(c.ctx, name)
+	if err != nil {
+		return err
+	}
+	for _, bat := range bats {
+		if bat.Length() == 0 {
+			continue
+		}
+		if err = rel.Write(c.ctx, bat); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func cleanBatches(c *Compile, bats []*batch.Batch) {
+	for _, bat := range bats {
+		bat.Clean(c.proc.Mp())
+	}
+}
+
+// exchangePartition swaps a partition and a standalone table of the same database. The
+// data is not copied, the two tables exchange their names in the catalog and keep their
+// ids, so the hidden table of the partition becomes the standalone table and vice versa.
+func exchangePartition(c *Compile, dbSource engine.Database, alterPartition *plan.AlterTablePartition) error {
+	partitionTableName := alterPartition.PartitionTableNames[0]
+	partitionIdx := int32(-1)
+	for i, name := range alterPartition.PartitionDef.PartitionTableNames {
+		if name == partitionTableName {
+			partitionIdx = int32(i)
+		}
+	}
+
+	exchangeTableName := alterPartition.ExchangeTableName
+	exchangeRel, err := dbSource.Relation(c.ctx, exchangeTableName)
+	if err != nil {
+		return err
+	}
+	partitionRel, err := dbSource.Relation(c.ctx, partitionTableName)
+	if err != nil {
+		return err
+	}
+	exchangeDefs, err := exchangeRel.TableDefs(c.ctx)
+	if err != nil {
+		return err
+	}
+	partitionDefs, err := partitionRel.TableDefs(c.ctx)
+	if err != nil {
+		return err
+	}
+
+	if !alterPartition.WithoutValidation {
+		attrs := getTableAttrs(exchangeDefs)
+		err = scanTableData(c, exchangeRel, attrs, func(bat *batch.Batch) error {
+			return checkPartitionOfData(c, bat, alterPartition.PartitionDef, partitionIdx)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// the names can not be held by two tables at the same time, so the partition
+	// is moved aside under a temporary name first
+	tmpName := fmt.Sprintf("%%!%%exchange%%!%%%d", partitionRel.GetTableID(c.ctx))
+	if err = partitionRel.Rename(c.ctx, tmpName); err != nil {
+		return err
+	}
+	if err = exchangeRel.Rename(c.ctx, partitionTableName); err != nil {
+		return err
+	}
+	if err = partitionRel.Rename(c.ctx, exchangeTableName); err != nil {
+		return err
+	}
+
+	// the constraints belong to the names
+	exchangeCt, partitionCt := getConstraintDef(exchangeDefs), getConstraintDef(partitionDefs)
+	if exchangeCt != nil || partitionCt != nil {
+		if exchangeCt == nil {
+			exchangeCt = new(engine.ConstraintDef)
+		}
+		if partitionCt == nil {
+			partitionCt = new(engine.ConstraintDef)
+		}
+		if err = exchangeRel.UpdateConstraint(c.ctx, partitionCt); err != nil {
+			return err
+		}
+		if err = partitionRel.UpdateConstraint(c.ctx, exchangeCt); err != nil {
+			return err
+		}
+	}
+
+	// the auto increment values of the standalone table follow its name
+	for _, def := range exchangeDefs {
+		if attr, ok := def.(*engine.AttributeDef); ok && attr.Attr.AutoIncrement {
+			return colexec.MoveAutoIncrCol(c.e, c.ctx, exchangeTableName, dbSource, c.proc,
+				exchangeRel.GetTableID(c.ctx), partitionRel.GetTableID(c.ctx), alterPartition.ExchangeDbName)
+		}
+	}
+	return nil
+}
+
+func getConstraintDef(defs []engine.TableDef) *engine.ConstraintDef {
+	for _, def := range defs {
+		if ct, ok := def.(*engine.ConstraintDef); ok {
+			return ct
+		}
+	}
+	return nil
+}
+
+// checkPartitionOfData checks all rows of the batch belong to the partition
+func checkPartitionOfData(c *Compile, bat *batch.Batch, partitionDef *plan.PartitionByDef, partitionIdx int32) error {
+	vec, err := colexec.EvalExpr(bat, c.proc, partitionDef.PartitionExpression)
+	if err != nil {
+		return err
+	}
+	defer vec.Free(c.proc.Mp())
+	partitions := vector.MustFixedCol[int32](vec)
+	for i := 0; i < bat.Length(); i++ {
+		if vec.GetNulls().Contains(uint64(i)) || partitions[i] != partitionIdx {
+			return moerr.NewInvalidInput(c.ctx, "found a row that does not match the partition")
+		}
+	}
+	return nil
+}
+
+// reorganizePartitions replaces the partitions by the new partitions, and
+// distributes the rows of the old partitions to the new ones.
+func reorganizePartitions(c *Compile, dbSource engine.Database, alterPartition *plan.AlterTablePartition) error {
+	defs, err := getPartitionTableDefs(c, dbSource, alterPartition.PartitionTableNames[0])
+	if err != nil {
+		return err
+	}
+	attrs := getTableAttrs(defs)
+
+	var bats []*batch.Batch
+	for _, name := range alterPartition.PartitionTableNames {
+		rel, err := dbSource.Relation(c.ctx, name)
+		if err != nil {
+			return err
+		}
+		data, err := readTableData(c, rel, attrs)
+		if err != nil {
+			cleanBatches(c, bats)
+			return err
+		}
+		bats = append(bats, data...)
+	}
+	defer cleanBatches(c, bats)
+
+	newTables := make(map[string]bool, len(alterPartition.NewPartitionTableNames))
+	for _, name := range alterPartition.NewPartitionTableNames {
+		newTables[name] = true
+	}
+	oldTables := make(map[string]bool, len(alterPartition.PartitionTableNames))
+	for _, name := range alterPartition.PartitionTableNames {
+		oldTables[name] = true
+		// the hidden table of a partition keeping its name is reused
+		if newTables[name] {
+			_, err = dbSource.Truncate(c.ctx, name)
+		} else {
+			err = dbSource.Delete(c.ctx, name)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	for _, name := range alterPartition.NewPartitionTableNames {
+		if !oldTables[name] {
+			if err = dbSource.Create(c.ctx, name, defs); err != nil {
+				return err
+			}
+		}
+	}
+
+	partitionDef := alterPartition.PartitionDef
+	partitionNum := len(partitionDef.PartitionTableNames)
+	for _, bat := range bats {
+		vec, err := colexec.EvalExpr(bat, c.proc, partitionDef.PartitionExpression)
+		if err != nil {
+			return err
+		}
+		bat.Vecs = append(bat.Vecs, vec)
+		groups, err := colexec.GroupByPartitionForInsert(c.proc, bat, attrs, len(bat.Vecs)-1, partitionNum)
+		if err != nil {
+			return err
+		}
+		for i, group := range groups {
+			if group.Length() == 0 {
+				group.Clean(c.proc.Mp())
+				continue
+			}
+			name := partitionDef.PartitionTableNames[i]
+			if !newTables[name] {
+				cleanBatches(c, groups[i:])
+				return moerr.NewInvalidInput(c.ctx, "Table has no partition for value from column_list")
+			}
+			if err = writeTableData(c, dbSource, name, []*batch.Batch{group}); err != nil {
+				cleanBatches(c, groups[i+1:])
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func (s *Scope) CreateTable(c *Compile) error {
 	qry := s.Plan.GetDdl().GetCreateTable()
 	// convert the plan's cols to the execution's cols
@@ -1302,6 +1698,18 @@ func planDefsToExeDefs(tableDef *plan.TableDef) ([]engine.TableDef, error) {
 		})
 	}
 
+	if tableDef.Ttl != nil {
+		c.Cts = append(c.Cts, &engine.TTLDef{
+			Ttl: tableDef.Ttl,
+		})
+	}
+
+	if tableDef.BloomFilter != nil {
+		c.Cts = append(c.Cts, &engine.BloomFilterDef{
+			BloomFilter: tableDef.BloomFilter,
+		})
+	}
+
 	if len(c.Cts) > 0 {
 		exeDefs = append(exeDefs, c)
 	}
@@ -52,6 +52,7 @@ var debugInstructionNames = map[vm.OpType]string{
 	vm.LoopAnti:     "loop anti",
 	vm.LoopSingle:   "loop single",
 	vm.LoopMark:     "loop mark",
+	vm.Apply:        "apply",
 	vm.MergeTop:     "merge top",
 	vm.MergeLimit:   "merge limit",
 	vm.MergeOrder:   "merge order",
@@ -30,6 +30,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/anti"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/apply"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/connector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/deletion"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/dispatch"
@@ -51,6 +52,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mark"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/merge"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergegroup"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergejoin"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergelimit"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergeoffset"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergeorder"
@@ -114,12 +116,13 @@ func dupInstruction(sourceIns *vm.Instruction, regMap map[*process.WaitRegister]
 	case vm.Join:
 		t := sourceIns.Arg.(*join.Argument)
 		res.Arg = &join.Argument{
-			Ibucket:    t.Ibucket,
-			Nbucket:    t.Nbucket,
-			Result:     t.Result,
-			Cond:       t.Cond,
-			Typs:       t.Typs,
-			Conditions: t.Conditions,
+			Ibucket:            t.Ibucket,
+			Nbucket:            t.Nbucket,
+			Result:             t.Result,
+			Cond:               t.Cond,
+			Typs:               t.Typs,
+			Conditions:         t.Conditions,
+			RuntimeFilterSpecs: t.RuntimeFilterSpecs,
 		}
 	case vm.Left:
 		t := sourceIns.Arg.(*left.Argument)
@@ -174,6 +177,14 @@ func dupInstruction(sourceIns *vm.Instruction, regMap map[*process.WaitRegister]
 			Cond:   t.Cond,
 			Typs:   t.Typs,
 		}
+	case vm.MergeJoin:
+		t := sourceIns.Arg.(*mergejoin.Argument)
+		res.Arg = &mergejoin.Argument{
+			JoinType:   t.JoinType,
+			Typs:       t.Typs,
+			Result:     t.Result,
+			Conditions: t.Conditions,
+		}
 	case vm.LoopJoin:
 		t := sourceIns.Arg.(*loopjoin.Argument)
 		res.Arg = &loopjoin.Argument{
@@ -209,6 +220,15 @@ func dupInstruction(sourceIns *vm.Instruction, regMap map[*process.WaitRegister]
 			Cond:   t.Cond,
 			Typs:   t.Typs,
 		}
+	case vm.Apply:
+		t := sourceIns.Arg.(*apply.Argument)
+		res.Arg = &apply.Argument{
+			Typ:    t.Typ,
+			Typs:   t.Typs,
+			Cond:   t.Cond,
+			Result: t.Result,
+			Eval:   t.Eval,
+		}
 	case vm.Offset:
 		t := sourceIns.Arg.(*offset.Argument)
 		res.Arg = &offset.Argument{
@@ -238,12 +258,13 @@ func dupInstruction(sourceIns *vm.Instruction, regMap map[*process.WaitRegister]
 	case vm.Semi:
 		t := sourceIns.Arg.(*semi.Argument)
 		res.Arg = &semi.Argument{
-			Ibucket:    t.Ibucket,
-			Nbucket:    t.Nbucket,
-			Result:     t.Result,
-			Cond:       t.Cond,
-			Typs:       t.Typs,
-			Conditions: t.Conditions,
+			Ibucket:            t.Ibucket,
+			Nbucket:            t.Nbucket,
+			Result:             t.Result,
+			Cond:               t.Cond,
+			Typs:               t.Typs,
+			Conditions:         t.Conditions,
+			RuntimeFilterSpecs: t.RuntimeFilterSpecs,
 		}
 	case vm.Single:
 		t := sourceIns.Arg.(*single.Argument)
@@ -331,12 +352,13 @@ func dupInstruction(sourceIns *vm.Instruction, regMap map[*process.WaitRegister]
 	case vm.HashBuild:
 		t := sourceIns.Arg.(*hashbuild.Argument)
 		res.Arg = &hashbuild.Argument{
-			NeedHashMap: t.NeedHashMap,
-			NeedExpr:    t.NeedExpr,
-			Ibucket:     t.Ibucket,
-			Nbucket:     t.Nbucket,
-			Typs:        t.Typs,
-			Conditions:  t.Conditions,
+			NeedHashMap:        t.NeedHashMap,
+			NeedExpr:           t.NeedExpr,
+			Ibucket:            t.Ibucket,
+			Nbucket:            t.Nbucket,
+			Typs:               t.Typs,
+			Conditions:         t.Conditions,
+			RuntimeFilterSpecs: t.RuntimeFilterSpecs,
 		}
 	case vm.External:
 		t := sourceIns.Arg.(*external.Argument)
@@ -379,9 +401,12 @@ func dupInstruction(sourceIns *vm.Instruction, regMap map[*process.WaitRegister]
 		if regMap != nil {
 			sourceArg := sourceIns.Arg.(*dispatch.Argument)
 			arg := &dispatch.Argument{
-				FuncId:     sourceArg.FuncId,
-				LocalRegs:  make([]*process.WaitRegister, len(sourceArg.LocalRegs)),
-				RemoteRegs: make([]colexec.ReceiveInfo, len(sourceArg.RemoteRegs)),
+				FuncId:           sourceArg.FuncId,
+				LocalRegs:        make([]*process.WaitRegister, len(sourceArg.LocalRegs)),
+				RemoteRegs:       make([]colexec.ReceiveInfo, len(sourceArg.RemoteRegs)),
+				ShuffleExprs:     sourceArg.ShuffleExprs,
+				ShuffleLocalIdx:  sourceArg.ShuffleLocalIdx,
+				ShuffleRemoteIdx: sourceArg.ShuffleRemoteIdx,
 			}
 			for j := range arg.LocalRegs {
 				sourceReg := sourceArg.LocalRegs[j]
@@ -622,9 +647,24 @@ func constructJoin(n *plan.Node, typs []types.Type, proc *process.Process) *join
 	}
 	cond, conds := extraJoinConditions(n.OnList)
 	return &join.Argument{
+		Typs:               typs,
+		Result:             result,
+		Cond:               cond,
+		Conditions:         constructJoinConditions(conds, proc),
+		RuntimeFilterSpecs: n.RuntimeFilterBuildList,
+	}
+}
+
+func constructMergeJoin(n *plan.Node, typs []types.Type, proc *process.Process) *mergejoin.Argument {
+	result := make([]colexec.ResultPos, len(n.ProjectList))
+	for i, expr := range n.ProjectList {
+		result[i].Rel, result[i].Pos = constructJoinResult(expr, proc)
+	}
+	_, conds := extraJoinConditions(n.OnList)
+	return &mergejoin.Argument{
+		JoinType:   n.JoinType,
 		Typs:       typs,
 		Result:     result,
-		Cond:       cond,
 		Conditions: constructJoinConditions(conds, proc),
 	}
 }
@@ -640,10 +680,11 @@ func constructSemi(n *plan.Node, typs []types.Type, proc *process.Process) *semi
 	}
 	cond, conds := extraJoinConditions(n.OnList)
 	return &semi.Argument{
-		Typs:       typs,
-		Result:     result,
-		Cond:       cond,
-		Conditions: constructJoinConditions(conds, proc),
+		Typs:               typs,
+		Result:             result,
+		Cond:               cond,
+		Conditions:         constructJoinConditions(conds, proc),
+		RuntimeFilterSpecs: n.RuntimeFilterBuildList,
 	}
 }
 
@@ -993,7 +1034,11 @@ func constructDispatchLocalAndRemote(idx int, ss []*Scope, currentCNAddr string)
 	arg.LocalRegs = make([]*process.WaitRegister, 0, scopeLen)
 	arg.RemoteRegs = make([]colexec.ReceiveInfo, 0, scopeLen)
 
+	arg.ShuffleLocalIdx = make([]int32, 0, scopeLen)
+	arg.ShuffleRemoteIdx = make([]int32, 0, scopeLen)
+
 	hasRemote := false
+	partition := int32(0)
 	for _, s := range ss {
 		if s.IsEnd {
 			continue
@@ -1004,6 +1049,7 @@ func constructDispatchLocalAndRemote(idx int, ss []*Scope, currentCNAddr string)
 			// Local reg.
 			// Put them into arg.LocalRegs
 			arg.LocalRegs = append(arg.LocalRegs, s.Proc.Reg.MergeReceivers[idx])
+			arg.ShuffleLocalIdx = append(arg.ShuffleLocalIdx, partition)
 		} else {
 			// Remote reg.
 			// Generate uuid for them and put into arg.RemoteRegs & scope. receive info
@@ -1020,7 +1066,9 @@ func constructDispatchLocalAndRemote(idx int, ss []*Scope, currentCNAddr string)
 				Uuid:     newUuid,
 				FromAddr: currentCNAddr,
 			})
+			arg.ShuffleRemoteIdx = append(arg.ShuffleRemoteIdx, partition)
 		}
+		partition++
 	}
 	return hasRemote, arg
 }
@@ -1038,6 +1086,19 @@ func constructBroadcastDispatch(idx int, ss []*Scope, currentCNAddr string) *dis
 	return arg
 }
 
+// constructShuffleDispatch is a cross-cn dispatch which
+// sends each row to the only register serving the hash partition of keys.
+func constructShuffleDispatch(idx int, ss []*Scope, currentCNAddr string, keys []*plan.Expr) *dispatch.Argument {
+	hasRemote, arg := constructDispatchLocalAndRemote(idx, ss, currentCNAddr)
+	if hasRemote {
+		arg.FuncId = dispatch.ShuffleFunc
+	} else {
+		arg.FuncId = dispatch.ShuffleLocalFunc
+	}
+	arg.ShuffleExprs = keys
+	return arg
+}
+
 func constructMergeGroup(needEval bool) *mergegroup.Argument {
 	return &mergegroup.Argument{
 		NeedEval: needEval,
@@ -1165,6 +1226,21 @@ func constructLoopMark(n *plan.Node, typs []types.Type, proc *process.Process) *
 	}
 }
 
+func constructApply(n *plan.Node, typs []types.Type, corrCols []int32, eval func(*process.Process, *batch.Batch, int) (*batch.Batch, error), proc *process.Process) *apply.Argument {
+	result := make([]colexec.ResultPos, len(n.ProjectList))
+	for i, expr := range n.ProjectList {
+		result[i].Rel, result[i].Pos = constructJoinResult(expr, proc)
+	}
+	return &apply.Argument{
+		Typ:      n.ApplyType,
+		Typs:     typs,
+		Result:   result,
+		Cond:     colexec.RewriteFilterExprList(n.OnList),
+		CorrCols: corrCols,
+		Eval:     eval,
+	}
+}
+
 func constructHashBuild(in vm.Instruction, proc *process.Process) *hashbuild.Argument {
 	switch in.Op {
 	case vm.Anti:
@@ -1184,9 +1260,10 @@ func constructHashBuild(in vm.Instruction, proc *process.Process) *hashbuild.Arg
 	case vm.Join:
 		arg := in.Arg.(*join.Argument)
 		return &hashbuild.Argument{
-			NeedHashMap: true,
-			Typs:        arg.Typs,
-			Conditions:  arg.Conditions[1],
+			NeedHashMap:        true,
+			Typs:               arg.Typs,
+			Conditions:         arg.Conditions[1],
+			RuntimeFilterSpecs: arg.RuntimeFilterSpecs,
 		}
 	case vm.Left:
 		arg := in.Arg.(*left.Argument)
@@ -1225,9 +1302,10 @@ func constructHashBuild(in vm.Instruction, proc *process.Process) *hashbuild.Arg
 	case vm.Semi:
 		arg := in.Arg.(*semi.Argument)
 		return &hashbuild.Argument{
-			NeedHashMap: true,
-			Typs:        arg.Typs,
-			Conditions:  arg.Conditions[1],
+			NeedHashMap:        true,
+			Typs:               arg.Typs,
+			Conditions:         arg.Conditions[1],
+			RuntimeFilterSpecs: arg.RuntimeFilterSpecs,
 		}
 	case vm.Single:
 		arg := in.Arg.(*single.Argument)
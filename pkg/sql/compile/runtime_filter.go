@@ -0,0 +1,429 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/pipeline"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/disttae"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// runtimeFilter is a runtime filter received by a table scan on a column.
+type runtimeFilter struct {
+	col  string
+	oid  types.T
+	msg  *process.RuntimeFilterMessage
+	keys map[string]struct{}
+}
+
+// runtimeFilterTags returns the tags of the runtime filters of specs.
+func runtimeFilterTags(specs []*plan.RuntimeFilterSpec) []int32 {
+	tags := make([]int32, len(specs))
+	for i, spec := range specs {
+		tags[i] = spec.Tag
+	}
+	return tags
+}
+
+// runtimeFilters returns the runtime filters of the table scan arrived so
+// far, it never waits for the hash builds.
+func (s *Scope) runtimeFilters() []*runtimeFilter {
+	specs := s.DataSource.RuntimeFilterSpecs
+	if len(specs) == 0 {
+		return nil
+	}
+	msgs, _ := s.Proc.RuntimeFilters(runtimeFilterTags(specs))
+	filters := make([]*runtimeFilter, 0, len(specs))
+	for _, spec := range specs {
+		if f := newRuntimeFilter(spec, msgs[spec.Tag], s.DataSource.TableDef); f != nil {
+			filters = append(filters, f)
+		}
+	}
+	return filters
+}
+
+func newRuntimeFilter(spec *plan.RuntimeFilterSpec, msg *process.RuntimeFilterMessage, tableDef *plan.TableDef) *runtimeFilter {
+	if msg == nil || msg.Typ == process.RuntimeFilter_PASS {
+		return nil
+	}
+	f := &runtimeFilter{
+		col: spec.ColName,
+		msg: msg,
+	}
+	if msg.Typ != process.RuntimeFilter_DROP {
+		idx, ok := tableDef.GetName2ColIndex()[spec.ColName]
+		if !ok || types.T(tableDef.Cols[idx].Typ.Id) != msg.Oid {
+			return nil
+		}
+		f.oid = msg.Oid
+	}
+	if msg.Typ == process.RuntimeFilter_IN {
+		f.keys = make(map[string]struct{}, len(msg.Keys))
+		for _, key := range msg.Keys {
+			f.keys[string(key)] = struct{}{}
+		}
+	}
+	return f
+}
+
+// mayContainZonemap tells whether a block of the zonemap on the column may
+// have a row passing the filter.
+func (f *runtimeFilter) mayContainZonemap(zm index.ZM) bool {
+	switch f.msg.Typ {
+	case process.RuntimeFilter_DROP:
+		return false
+	case process.RuntimeFilter_IN:
+		for _, key := range f.msg.Keys {
+			if zm.ContainsKey(key) {
+				return true
+			}
+		}
+		return false
+	default:
+		// the max of a zonemap of strings may be truncated, its min is a
+		// prefix no greater than the real min
+		if compute.Compare(f.msg.Max, zm.GetMinBuf(), f.oid) < 0 {
+			return false
+		}
+		if zm.IsString() && zm.MaxTruncated() {
+			return true
+		}
+		return compute.Compare(f.msg.Min, zm.GetMaxBuf(), f.oid) <= 0
+	}
+}
+
+// mayContainKey tells whether a row of the key may pass the filter.
+func (f *runtimeFilter) mayContainKey(key []byte) bool {
+	switch f.msg.Typ {
+	case process.RuntimeFilter_DROP:
+		return false
+	case process.RuntimeFilter_IN:
+		_, ok := f.keys[string(key)]
+		return ok
+	}
+	if compute.Compare(key, f.msg.Min, f.oid) < 0 || compute.Compare(key, f.msg.Max, f.oid) > 0 {
+		return false
+	}
+	if f.msg.Typ == process.RuntimeFilter_BLOOM {
+		ok, err := f.msg.Bloom.MayContainsKey(key)
+		return err != nil || ok
+	}
+	return true
+}
+
+// pruneRangesByRuntimeFilters drops the blocks of the table scan not passing
+// the runtime filters by their zonemaps.
+func (s *Scope) pruneRangesByRuntimeFilters(ctx context.Context, filters []*runtimeFilter) error {
+	if len(s.NodeInfo.Data) == 0 || s.Proc.FileService == nil {
+		return nil
+	}
+	fs, err := fileservice.Get[fileservice.FileService](s.Proc.FileService, defines.SharedFileServiceName)
+	if err != nil {
+		return nil
+	}
+	for _, f := range filters {
+		col := s.DataSource.TableDef.GetName2ColIndex()[f.col]
+		if s.NodeInfo.Data, err = disttae.PruneRangesByZonemap(ctx, s.NodeInfo.Data, uint16(col),
+			f.mayContainZonemap, fs, s.Proc.Mp()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runtimeFilterReader drops the rows not passing the runtime filters from
+// the batches read by a reader. The filters arrived after the scan starts
+// are picked up by the later reads.
+type runtimeFilterReader struct {
+	engine.Reader
+	proc     *process.Process
+	tableDef *plan.TableDef
+	// pending are the specs of filters not arrived yet.
+	pending []*plan.RuntimeFilterSpec
+	filters []*runtimeFilter
+}
+
+func newRuntimeFilterReaders(rds []engine.Reader, proc *process.Process, tableDef *plan.TableDef,
+	specs []*plan.RuntimeFilterSpec, filters []*runtimeFilter) []engine.Reader {
+	for i := range rds {
+		r := &runtimeFilterReader{
+			Reader:   rds[i],
+			proc:     proc,
+			tableDef: tableDef,
+			filters:  append([]*runtimeFilter(nil), filters...),
+		}
+		for _, spec := range specs {
+			arrived := false
+			for _, f := range filters {
+				if f.col == spec.ColName && f.msg.Tag == spec.Tag {
+					arrived = true
+					break
+				}
+			}
+			if !arrived {
+				r.pending = append(r.pending, spec)
+			}
+		}
+		rds[i] = r
+	}
+	return rds
+}
+
+// poll picks up the filters arrived since the last read.
+func (r *runtimeFilterReader) poll() {
+	if len(r.pending) == 0 {
+		return
+	}
+	msgs, _ := r.proc.RuntimeFilters(runtimeFilterTags(r.pending))
+	if len(msgs) == 0 {
+		return
+	}
+	pending := r.pending[:0]
+	for _, spec := range r.pending {
+		msg, ok := msgs[spec.Tag]
+		if !ok {
+			pending = append(pending, spec)
+			continue
+		}
+		if f := newRuntimeFilter(spec, msg, r.tableDef); f != nil {
+			r.filters = append(r.filters, f)
+		}
+	}
+	r.pending = pending
+}
+
+func (r *runtimeFilterReader) Read(ctx context.Context, cols []string, expr *plan.Expr, m *mpool.MPool, vp engine.VectorPool) (*batch.Batch, error) {
+	r.poll()
+	for _, f := range r.filters {
+		if f.msg.Typ == process.RuntimeFilter_DROP {
+			return nil, nil
+		}
+	}
+	bat, err := r.Reader.Read(ctx, cols, expr, m, vp)
+	if err != nil || bat == nil || bat.Length() == 0 || len(r.filters) == 0 {
+		return bat, err
+	}
+	sels := make([]int64, 0, bat.Length())
+	for i := 0; i < bat.Length(); i++ {
+		sels = append(sels, int64(i))
+	}
+	for _, f := range r.filters {
+		for j, col := range cols {
+			if col == f.col {
+				sels = f.filter(bat, j, sels)
+				break
+			}
+		}
+	}
+	if len(sels) < bat.Length() {
+		bat.Shrink(sels)
+	}
+	return bat, nil
+}
+
+// filter returns the rows of sels whose value of column j passes the filter.
+func (f *runtimeFilter) filter(bat *batch.Batch, j int, sels []int64) []int64 {
+	vec := bat.Vecs[j]
+	if vec.IsConst() {
+		if vec.IsConstNull() || !f.mayContainKey(process.RuntimeFilterKey(vec, 0)) {
+			return sels[:0]
+		}
+		return sels
+	}
+	rows := sels[:0]
+	for _, sel := range sels {
+		if nulls.Contains(vec.GetNulls(), uint64(sel)) {
+			continue
+		}
+		if f.mayContainKey(process.RuntimeFilterKey(vec, int(sel))) {
+			rows = append(rows, sel)
+		}
+	}
+	return rows
+}
+
+// encodeRuntimeFilter encodes a runtime filter to send to the pipelines
+// running on the other CNs.
+func encodeRuntimeFilter(msg *process.RuntimeFilterMessage) ([]byte, error) {
+	rf := &pipeline.RuntimeFilter{
+		Tag:  msg.Tag,
+		Typ:  int32(msg.Typ),
+		Oid:  int32(msg.Oid),
+		Min:  msg.Min,
+		Max:  msg.Max,
+		Keys: msg.Keys,
+	}
+	if msg.Bloom != nil {
+		bloom, ok := msg.Bloom.(index.StaticFilter)
+		if !ok {
+			return nil, moerr.NewInternalErrorNoCtx("runtime filter %d can not be encoded", msg.Tag)
+		}
+		data, err := bloom.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		rf.Bloom = data
+	}
+	return rf.Marshal()
+}
+
+func decodeRuntimeFilter(data []byte) (*process.RuntimeFilterMessage, error) {
+	rf := &pipeline.RuntimeFilter{}
+	if err := rf.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	msg := &process.RuntimeFilterMessage{
+		Tag:  rf.Tag,
+		Typ:  int(rf.Typ),
+		Oid:  types.T(rf.Oid),
+		Min:  rf.Min,
+		Max:  rf.Max,
+		Keys: rf.Keys,
+	}
+	if len(rf.Bloom) > 0 {
+		bloom, err := index.DecodeBloomFilter(rf.Bloom)
+		if err != nil {
+			return nil, err
+		}
+		msg.Bloom = bloom
+	}
+	return msg, nil
+}
+
+// remoteRuntimeFilterTags returns the tags of the runtime filters probed by
+// the table scans of a scope sent to run on another CN.
+func remoteRuntimeFilterTags(s *Scope) []int32 {
+	var tags []int32
+	if s.DataSource != nil {
+		tags = append(tags, runtimeFilterTags(s.DataSource.RuntimeFilterSpecs)...)
+	}
+	for _, ps := range s.PreScopes {
+		tags = append(tags, remoteRuntimeFilterTags(ps)...)
+	}
+	return tags
+}
+
+// forwardRuntimeFilters sends the runtime filters of tags to a remote
+// pipeline as soon as they arrive, until all are sent or ctx is done.
+func forwardRuntimeFilters(ctx context.Context, proc *process.Process, tags []int32,
+	send func(*process.RuntimeFilterMessage) error) {
+	sent := make(map[int32]struct{}, len(tags))
+	for {
+		msgs, arrived := proc.RuntimeFilters(tags)
+		for tag, msg := range msgs {
+			if _, ok := sent[tag]; ok {
+				continue
+			}
+			sent[tag] = struct{}{}
+			if err := send(msg); err != nil {
+				// the remote scans read without the filter
+				logutil.Warnf("send runtime filter %d failed: %v", tag, err)
+				return
+			}
+		}
+		if len(sent) == len(tags) {
+			return
+		}
+		select {
+		case <-arrived:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// runtimeFilterPendingTimeout is how long a runtime filter arrived before its
+// pipeline is kept. The filters of pipelines already done are dropped then.
+const runtimeFilterPendingTimeout = time.Minute
+
+type pendingRuntimeFilters struct {
+	created time.Time
+	msgs    []*process.RuntimeFilterMessage
+}
+
+// runtimeFilterRouter delivers the runtime filters received from the other
+// CNs to the pipelines running on this CN.
+type runtimeFilterRouter struct {
+	sync.Mutex
+	procs   map[uuid.UUID]*process.Process
+	pending map[uuid.UUID]*pendingRuntimeFilters
+}
+
+var remoteRuntimeFilters = &runtimeFilterRouter{
+	procs:   make(map[uuid.UUID]*process.Process),
+	pending: make(map[uuid.UUID]*pendingRuntimeFilters),
+}
+
+// register routes the runtime filters of pipeline id to proc, including the
+// ones arrived before.
+func (r *runtimeFilterRouter) register(id uuid.UUID, proc *process.Process) {
+	r.Lock()
+	defer r.Unlock()
+	r.procs[id] = proc
+	if p, ok := r.pending[id]; ok {
+		for _, msg := range p.msgs {
+			proc.SendRuntimeFilter(msg)
+		}
+		delete(r.pending, id)
+	}
+	r.purge()
+}
+
+func (r *runtimeFilterRouter) unregister(id uuid.UUID) {
+	r.Lock()
+	defer r.Unlock()
+	delete(r.procs, id)
+}
+
+func (r *runtimeFilterRouter) deliver(id uuid.UUID, msg *process.RuntimeFilterMessage) {
+	r.Lock()
+	defer r.Unlock()
+	if proc, ok := r.procs[id]; ok {
+		proc.SendRuntimeFilter(msg)
+		return
+	}
+	p, ok := r.pending[id]
+	if !ok {
+		p = &pendingRuntimeFilters{created: time.Now()}
+		r.pending[id] = p
+	}
+	p.msgs = append(p.msgs, msg)
+	r.purge()
+}
+
+func (r *runtimeFilterRouter) purge() {
+	for id, p := range r.pending {
+		if time.Since(p.created) > runtimeFilterPendingTimeout {
+			delete(r.pending, id)
+		}
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+type testBatchReader struct {
+	bat *batch.Batch
+}
+
+func (r *testBatchReader) Close() error {
+	return nil
+}
+
+func (r *testBatchReader) Read(_ context.Context, _ []string, _ *plan.Expr, _ *mpool.MPool, _ engine.VectorPool) (*batch.Batch, error) {
+	bat := r.bat
+	r.bat = nil
+	return bat, nil
+}
+
+func TestRuntimeFilter(t *testing.T) {
+	tableDef := &plan.TableDef{
+		Cols:          []*plan.ColDef{{Name: "a", Typ: &plan.Type{Id: int32(types.T_int64)}}},
+		Name2ColIndex: map[string]int32{"a": 0},
+	}
+	spec := &plan.RuntimeFilterSpec{Tag: 1, ColName: "a"}
+	key := func(v int64) []byte {
+		return types.EncodeInt64(&v)
+	}
+	zm := func(min, max int64) index.ZM {
+		zm := index.NewZM(types.T_int64)
+		index.UpdateZM(zm, key(min))
+		index.UpdateZM(zm, key(max))
+		return *zm
+	}
+
+	require.Nil(t, newRuntimeFilter(spec, nil, tableDef))
+	require.Nil(t, newRuntimeFilter(spec, &process.RuntimeFilterMessage{Typ: process.RuntimeFilter_PASS}, tableDef))
+	// the key is not of the type of the column
+	require.Nil(t, newRuntimeFilter(spec, &process.RuntimeFilterMessage{
+		Typ: process.RuntimeFilter_MIN_MAX,
+		Oid: types.T_int32,
+	}, tableDef))
+
+	in := newRuntimeFilter(spec, &process.RuntimeFilterMessage{
+		Typ:  process.RuntimeFilter_IN,
+		Oid:  types.T_int64,
+		Min:  key(3),
+		Max:  key(7),
+		Keys: [][]byte{key(3), key(7)},
+	}, tableDef)
+	require.True(t, in.mayContainZonemap(zm(0, 3)))
+	require.False(t, in.mayContainZonemap(zm(4, 6)))
+	require.False(t, in.mayContainZonemap(zm(8, 10)))
+
+	minmax := newRuntimeFilter(spec, &process.RuntimeFilterMessage{
+		Typ: process.RuntimeFilter_MIN_MAX,
+		Oid: types.T_int64,
+		Min: key(3),
+		Max: key(7),
+	}, tableDef)
+	require.True(t, minmax.mayContainZonemap(zm(4, 6)))
+	require.True(t, minmax.mayContainZonemap(zm(7, 9)))
+	require.False(t, minmax.mayContainZonemap(zm(0, 2)))
+	require.False(t, minmax.mayContainZonemap(zm(8, 10)))
+
+	drop := newRuntimeFilter(spec, &process.RuntimeFilterMessage{Typ: process.RuntimeFilter_DROP}, tableDef)
+	require.False(t, drop.mayContainZonemap(zm(0, 10)))
+
+	// the rows 0 to 9 are read, only 3 and 7 pass
+	proc := testutil.NewProcess()
+	bat := testutil.NewBatch([]types.Type{types.T_int64.ToType()}, false, 10, proc.Mp())
+	specs := []*plan.RuntimeFilterSpec{spec}
+	rd := newRuntimeFilterReaders([]engine.Reader{&testBatchReader{bat: bat}}, proc, tableDef, specs, []*runtimeFilter{in})[0]
+	res, err := rd.Read(context.Background(), []string{"a"}, nil, proc.Mp(), nil)
+	require.NoError(t, err)
+	require.Equal(t, []int64{3, 7}, vector.MustFixedCol[int64](res.Vecs[0]))
+	res.Clean(proc.Mp())
+
+	rd = newRuntimeFilterReaders([]engine.Reader{&testBatchReader{bat: bat}}, proc, tableDef, specs, []*runtimeFilter{drop})[0]
+	res, err = rd.Read(context.Background(), []string{"a"}, nil, proc.Mp(), nil)
+	require.NoError(t, err)
+	require.Nil(t, res)
+}
+
+func TestRuntimeFilterArrivedLate(t *testing.T) {
+	tableDef := &plan.TableDef{
+		Cols:          []*plan.ColDef{{Name: "a", Typ: &plan.Type{Id: int32(types.T_int64)}}},
+		Name2ColIndex: map[string]int32{"a": 0},
+	}
+	specs := []*plan.RuntimeFilterSpec{{Tag: 1, ColName: "a"}}
+	key := func(v int64) []byte {
+		return types.EncodeInt64(&v)
+	}
+	proc := testutil.NewProcess()
+	proc.ResetRuntimeFilters()
+	s := &Scope{
+		DataSource: &Source{TableDef: tableDef, RuntimeFilterSpecs: specs},
+		Proc:       proc,
+	}
+	// the scan starts before the filter arrives
+	require.Empty(t, s.runtimeFilters())
+
+	bat := testutil.NewBatch([]types.Type{types.T_int64.ToType()}, false, 10, proc.Mp())
+	rd := &testBatchReader{bat: bat}
+	r := newRuntimeFilterReaders([]engine.Reader{rd}, proc, tableDef, specs, nil)[0]
+	res, err := r.Read(context.Background(), []string{"a"}, nil, proc.Mp(), nil)
+	require.NoError(t, err)
+	require.Equal(t, 10, res.Length())
+
+	// the filter arrived is applied by the next read
+	proc.SendRuntimeFilter(&process.RuntimeFilterMessage{
+		Tag: 1,
+		Typ: process.RuntimeFilter_MIN_MAX,
+		Oid: types.T_int64,
+		Min: key(2),
+		Max: key(4),
+	})
+	rd.bat = bat
+	res, err = r.Read(context.Background(), []string{"a"}, nil, proc.Mp(), nil)
+	require.NoError(t, err)
+	require.Equal(t, []int64{2, 3, 4}, vector.MustFixedCol[int64](res.Vecs[0]))
+	require.Len(t, s.runtimeFilters(), 1)
+	res.Clean(proc.Mp())
+}
+
+func TestRemoteRuntimeFilter(t *testing.T) {
+	key := func(v int64) []byte {
+		return types.EncodeInt64(&v)
+	}
+	proc := testutil.NewProcess()
+	bat := testutil.NewBatch([]types.Type{types.T_int64.ToType()}, false, 10, proc.Mp())
+	defer bat.Clean(proc.Mp())
+	bloom, err := index.NewBinaryFuseFilter(containers.ToDNVector(bat.Vecs[0]))
+	require.NoError(t, err)
+
+	data, err := encodeRuntimeFilter(&process.RuntimeFilterMessage{
+		Tag:   1,
+		Typ:   process.RuntimeFilter_BLOOM,
+		Oid:   types.T_int64,
+		Min:   key(0),
+		Max:   key(9),
+		Bloom: bloom,
+	})
+	require.NoError(t, err)
+	msg, err := decodeRuntimeFilter(data)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), msg.Tag)
+	require.Equal(t, process.RuntimeFilter_BLOOM, msg.Typ)
+	require.Equal(t, types.T_int64, msg.Oid)
+	require.Equal(t, key(9), msg.Max)
+	ok, err := msg.Bloom.MayContainsKey(key(3))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// the filter arrived before its pipeline is delivered when it registers
+	id := uuid.New()
+	remoteRuntimeFilters.deliver(id, msg)
+	proc.ResetRuntimeFilters()
+	remoteRuntimeFilters.register(id, proc)
+	msgs, _ := proc.RuntimeFilters([]int32{1})
+	require.Equal(t, msg, msgs[1])
+	remoteRuntimeFilters.deliver(id, &process.RuntimeFilterMessage{Tag: 2, Typ: process.RuntimeFilter_DROP})
+	msgs, _ = proc.RuntimeFilters([]int32{2})
+	require.Len(t, msgs, 1)
+	remoteRuntimeFilters.unregister(id)
+	require.Empty(t, remoteRuntimeFilters.procs)
+	require.Empty(t, remoteRuntimeFilters.pending)
+
+	// the filters are forwarded as they arrive
+	src := testutil.NewProcess()
+	src.ResetRuntimeFilters()
+	sent := make(chan int32, 2)
+	done := make(chan struct{})
+	go func() {
+		forwardRuntimeFilters(context.Background(), src, []int32{1, 2}, func(msg *process.RuntimeFilterMessage) error {
+			sent <- msg.Tag
+			return nil
+		})
+		close(done)
+	}()
+	src.SendRuntimeFilter(&process.RuntimeFilterMessage{Tag: 2, Typ: process.RuntimeFilter_PASS})
+	require.Equal(t, int32(2), <-sent)
+	src.SendRuntimeFilter(&process.RuntimeFilterMessage{Tag: 1, Typ: process.RuntimeFilter_PASS})
+	require.Equal(t, int32(1), <-sent)
+	<-done
+}
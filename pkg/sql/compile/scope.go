@@ -68,6 +68,7 @@ func PrintScope(prefix []byte, ss []*Scope) {
 // Run read data from storage engine and run the instructions of scope.
 func (s *Scope) Run(c *Compile) (err error) {
 	s.Proc.Ctx = context.WithValue(s.Proc.Ctx, defines.EngineKey{}, c.e)
+	s.recordCnAddr(c.addr)
 	p := pipeline.New(s.DataSource.Attributes, s.Instructions, s.Reg)
 	if s.DataSource.Bat != nil {
 		if _, err = p.ConstRun(s.DataSource.Bat, s.Proc); err != nil {
@@ -84,6 +85,7 @@ func (s *Scope) Run(c *Compile) (err error) {
 // MergeRun range and run the scope's pre-scopes by go-routine, and finally run itself to do merge work.
 func (s *Scope) MergeRun(c *Compile) error {
 	s.Proc.Ctx = context.WithValue(s.Proc.Ctx, defines.EngineKey{}, c.e)
+	s.recordCnAddr(c.addr)
 	errChan := make(chan error, len(s.PreScopes))
 
 	for _, scope := range s.PreScopes {
@@ -142,6 +144,16 @@ func (s *Scope) MergeRun(c *Compile) error {
 	}
 }
 
+// recordCnAddr records the CN running the scope in the analyze information
+// of its instructions.
+func (s *Scope) recordCnAddr(addr string) {
+	for _, in := range s.Instructions {
+		if in.Idx >= 0 && in.Idx < len(s.Proc.AnalInfos) {
+			s.Proc.AnalInfos[in.Idx].AddCnAddr(addr)
+		}
+	}
+}
+
 // RemoteRun send the scope to a remote node for execution.
 // if no target node information, just execute it at local.
 func (s *Scope) RemoteRun(c *Compile) error {
@@ -176,6 +188,14 @@ func (s *Scope) ParallelRun(c *Compile, remote bool) error {
 	if s.DataSource == nil {
 		return s.MergeRun(c)
 	}
+	// the scan starts without waiting for the hash builds, the filters
+	// arrived later are applied by the readers
+	filters := s.runtimeFilters()
+	if len(filters) > 0 {
+		if err := s.pruneRangesByRuntimeFilters(c.ctx, filters); err != nil {
+			return err
+		}
+	}
 	mcpu := s.NodeInfo.Mcpu
 	switch {
 	case remote:
@@ -193,6 +213,13 @@ func (s *Scope) ParallelRun(c *Compile, remote bool) error {
 			return err
 		}
 		s.NodeInfo.Data = nil
+	case s.NodeInfo.Rel != nil && s.DataSource.SortKey != "":
+		rd, err := s.newSortedScanReader(c.ctx, s.NodeInfo.Rel)
+		if err != nil {
+			return err
+		}
+		rds, mcpu = []engine.Reader{rd}, 1
+		s.NodeInfo.Data = nil
 	case s.NodeInfo.Rel != nil:
 		var err error
 
@@ -257,6 +284,9 @@ func (s *Scope) ParallelRun(c *Compile, remote bool) error {
 		}
 		rds = newRds
 	}
+	if specs := s.DataSource.RuntimeFilterSpecs; len(specs) > 0 {
+		rds = newRuntimeFilterReaders(rds, s.Proc, s.DataSource.TableDef, specs, filters)
+	}
 
 	ss := make([]*Scope, mcpu)
 	for i := 0; i < mcpu; i++ {
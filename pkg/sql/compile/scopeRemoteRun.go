@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"hash/crc32"
 	"runtime"
+	"sync"
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/preinsertunique"
@@ -59,6 +60,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mark"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/merge"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergegroup"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergejoin"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergelimit"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergeoffset"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergeorder"
@@ -109,6 +111,18 @@ func CnServerMessageHandler(
 	receiver := newMessageReceiverOnServer(ctx, cnAddr, msg,
 		cs, messageAcquirer, storeEngine, fileService, lockService, cli, aicm)
 
+	// a runtime filter is delivered to its running pipeline, no reply is
+	// expected by the sender.
+	if receiver.messageTyp == pipeline.RuntimeFilterMessage {
+		rf, err := decodeRuntimeFilter(receiver.scopeData)
+		if err != nil {
+			logutil.Warnf("decode runtime filter failed: %v", err)
+			return nil
+		}
+		remoteRuntimeFilters.deliver(receiver.messageUuid, rf)
+		return nil
+	}
+
 	// rebuild pipeline to run and send query result back.
 	err := cnMessageHandle(&receiver)
 	if err != nil {
@@ -154,6 +168,10 @@ func cnMessageHandle(receiver *messageReceiverOnServer) error {
 		}
 		s = refactorScope(c, c.ctx, s)
 
+		if receiver.messageUuid != (uuid.UUID{}) {
+			remoteRuntimeFilters.register(receiver.messageUuid, c.proc)
+			defer remoteRuntimeFilters.unregister(receiver.messageUuid)
+		}
 		err = s.ParallelRun(c, s.IsRemote)
 		if err != nil {
 			return err
@@ -255,16 +273,39 @@ func (s *Scope) remoteRun(c *Compile) error {
 	if err != nil {
 		return err
 	}
+	// the runtime filters built here are sent to the remote scans after
+	// the pipeline, they are routed by the uuid of the pipeline.
+	tags := remoteRuntimeFilterTags(s)
+	if len(tags) > 0 {
+		id := uuid.New()
+		sender.pipelineUuid = id[:]
+	}
 	err = sender.send(sData, pData, pipeline.PipelineMessage)
 	if err != nil {
 		sender.close()
 		return err
 	}
+	stopForward := func() {}
+	if len(tags) > 0 {
+		var wg sync.WaitGroup
+		ctx, cancel := context.WithCancel(c.ctx)
+		stopForward = func() {
+			cancel()
+			wg.Wait()
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			forwardRuntimeFilters(ctx, c.proc, tags, sender.sendRuntimeFilter)
+		}()
+	}
 
 	nextInstruction := s.Instructions[len(s.Instructions)-1]
 	nextAnalyze := c.proc.GetAnalyze(nextInstruction.Idx)
 	nextArg := nextInstruction.Arg.(*connector.Argument)
 	err = receiveMessageFromCnServer(c, sender, nextAnalyze, nextArg)
+	// the filters stop being sent before the stream is closed
+	stopForward()
 	sender.close()
 	return err
 }
@@ -417,6 +458,8 @@ func generatePipeline(s *Scope, ctx *scopeContext, ctxId int32) (*pipeline.Pipel
 			Expr:         s.DataSource.Expr,
 			TableDef:     s.DataSource.TableDef,
 			Timestamp:    &s.DataSource.Timestamp,
+
+			RuntimeFilterProbeList: s.DataSource.RuntimeFilterSpecs,
 		}
 		if s.DataSource.Bat != nil {
 			data, err := types.Encode(s.DataSource.Bat)
@@ -525,6 +568,8 @@ func generateScope(proc *process.Process, p *pipeline.Pipeline, ctx *scopeContex
 			Expr:         dsc.Expr,
 			TableDef:     dsc.TableDef,
 			Timestamp:    *dsc.Timestamp,
+
+			RuntimeFilterSpecs: dsc.RuntimeFilterProbeList,
 		}
 		if len(dsc.Block) > 0 {
 			bat := new(batch.Batch)
@@ -643,7 +688,12 @@ func convertToPipelineInstruction(opr *vm.Instruction, ctx *scopeContext, ctxId
 			Result:    t.Result,
 		}
 	case *dispatch.Argument:
-		in.Dispatch = &pipeline.Dispatch{FuncId: int32(t.FuncId)}
+		in.Dispatch = &pipeline.Dispatch{
+			FuncId:           int32(t.FuncId),
+			ShuffleExprs:     t.ShuffleExprs,
+			ShuffleLocalIdx:  t.ShuffleLocalIdx,
+			ShuffleRemoteIdx: t.ShuffleRemoteIdx,
+		}
 		in.Dispatch.LocalConnector = make([]*pipeline.Connector, len(t.LocalRegs))
 		for i := range t.LocalRegs {
 			idx, ctx0 := ctx.root.findRegister(t.LocalRegs[i])
@@ -690,6 +740,8 @@ func convertToPipelineInstruction(opr *vm.Instruction, ctx *scopeContext, ctxId
 			Types:     convertToPlanTypes(t.Typs),
 			LeftCond:  t.Conditions[0],
 			RightCond: t.Conditions[1],
+
+			RuntimeFilterBuildList: t.RuntimeFilterSpecs,
 		}
 	case *left.Argument:
 		relList, colList := getRelColList(t.Result)
@@ -744,6 +796,16 @@ func convertToPipelineInstruction(opr *vm.Instruction, ctx *scopeContext, ctxId
 			Expr:   t.Cond,
 			Types:  convertToPlanTypes(t.Typs),
 		}
+	case *mergejoin.Argument:
+		relList, colList := getRelColList(t.Result)
+		in.MergeJoin = &pipeline.MergeJoin{
+			JoinType:  int32(t.JoinType),
+			RelList:   relList,
+			ColList:   colList,
+			Types:     convertToPlanTypes(t.Typs),
+			LeftCond:  t.Conditions[0],
+			RightCond: t.Conditions[1],
+		}
 	case *loopjoin.Argument:
 		relList, colList := getRelColList(t.Result)
 		in.Join = &pipeline.Join{
@@ -804,6 +866,8 @@ func convertToPipelineInstruction(opr *vm.Instruction, ctx *scopeContext, ctxId
 			Types:     convertToPlanTypes(t.Typs),
 			LeftCond:  t.Conditions[0],
 			RightCond: t.Conditions[1],
+
+			RuntimeFilterBuildList: t.RuntimeFilterSpecs,
 		}
 	case *single.Argument:
 		relList, colList := getRelColList(t.Result)
@@ -889,6 +953,8 @@ func convertToPipelineInstruction(opr *vm.Instruction, ctx *scopeContext, ctxId
 			Nbucket:  t.Nbucket,
 			Types:    convertToPlanTypes(t.Typs),
 			Conds:    t.Conditions,
+
+			RuntimeFilterBuildList: t.RuntimeFilterSpecs,
 		}
 	case *external.Argument:
 		name2ColIndexSlice := make([]*pipeline.ExternalName2ColIndex, len(t.Es.Name2ColIndex))
@@ -1001,9 +1067,12 @@ func convertToVmInstruction(opr *pipeline.Instruction, ctx *scopeContext) (vm.In
 			}
 		}
 		v.Arg = &dispatch.Argument{
-			FuncId:     int(t.FuncId),
-			LocalRegs:  regs,
-			RemoteRegs: rrs,
+			FuncId:           int(t.FuncId),
+			LocalRegs:        regs,
+			RemoteRegs:       rrs,
+			ShuffleExprs:     t.ShuffleExprs,
+			ShuffleLocalIdx:  t.ShuffleLocalIdx,
+			ShuffleRemoteIdx: t.ShuffleRemoteIdx,
 		}
 	case vm.Group:
 		t := opr.GetAgg()
@@ -1025,6 +1094,8 @@ func convertToVmInstruction(opr *pipeline.Instruction, ctx *scopeContext) (vm.In
 			Typs:       convertToTypes(t.Types),
 			Result:     convertToResultPos(t.RelList, t.ColList),
 			Conditions: [][]*plan.Expr{t.LeftCond, t.RightCond},
+
+			RuntimeFilterSpecs: t.RuntimeFilterBuildList,
 		}
 	case vm.Left:
 		t := opr.GetLeftJoin()
@@ -1076,6 +1147,14 @@ func convertToVmInstruction(opr *pipeline.Instruction, ctx *scopeContext) (vm.In
 			Cond:   t.Expr,
 			Typs:   convertToTypes(t.Types),
 		}
+	case vm.MergeJoin:
+		t := opr.GetMergeJoin()
+		v.Arg = &mergejoin.Argument{
+			JoinType:   plan.Node_JoinType(t.JoinType),
+			Typs:       convertToTypes(t.Types),
+			Result:     convertToResultPos(t.RelList, t.ColList),
+			Conditions: [][]*plan.Expr{t.LeftCond, t.RightCond},
+		}
 	case vm.LoopJoin:
 		t := opr.GetJoin()
 		v.Arg = &loopjoin.Argument{
@@ -1134,6 +1213,8 @@ func convertToVmInstruction(opr *pipeline.Instruction, ctx *scopeContext) (vm.In
 			Cond:       t.Expr,
 			Typs:       convertToTypes(t.Types),
 			Conditions: [][]*plan.Expr{t.LeftCond, t.RightCond},
+
+			RuntimeFilterSpecs: t.RuntimeFilterBuildList,
 		}
 	case vm.Single:
 		t := opr.GetSingleJoin()
@@ -1225,6 +1306,8 @@ func convertToVmInstruction(opr *pipeline.Instruction, ctx *scopeContext) (vm.In
 			NeedExpr:    t.NeedExpr,
 			Typs:        convertToTypes(t.Types),
 			Conditions:  t.Conds,
+
+			RuntimeFilterSpecs: t.RuntimeFilterBuildList,
 		}
 	case vm.External:
 		t := opr.GetExternalScan()
@@ -1278,6 +1361,17 @@ func mergeAnalyseInfo(target *anaylze, ana *pipeline.AnalysisList) {
 		target.analInfos[i].NetworkIO += n.NetworkIO
 		target.analInfos[i].ScanTime += n.ScanTime
 		target.analInfos[i].InsertTime += n.InsertTime
+		target.analInfos[i].SetMemoryPeak(n.MemoryPeak)
+		target.analInfos[i].CpuTime += n.CpuTime
+		target.analInfos[i].TotalBlocks += n.TotalBlocks
+		target.analInfos[i].PrunedBlocks += n.PrunedBlocks
+		target.analInfos[i].S3ReadCount += n.S3ReadCount
+		target.analInfos[i].CacheReadCount += n.CacheReadCount
+		target.analInfos[i].CacheHitCount += n.CacheHitCount
+		target.analInfos[i].LockWaitTime += n.LockWaitTime
+		for _, addr := range n.CnAddrs {
+			target.analInfos[i].AddCnAddr(addr)
+		}
 	}
 }
 
@@ -1435,6 +1529,15 @@ func convertToPlanAnalyzeInfo(info *process.AnalyzeInfo) *plan.AnalyzeInfo {
 		NetworkIO:        info.NetworkIO,
 		ScanTime:         info.ScanTime,
 		InsertTime:       info.InsertTime,
+		MemoryPeak:       info.MemoryPeak,
+		CpuTime:          info.CpuTime,
+		TotalBlocks:      info.TotalBlocks,
+		PrunedBlocks:     info.PrunedBlocks,
+		S3ReadCount:      info.S3ReadCount,
+		CacheReadCount:   info.CacheReadCount,
+		CacheHitCount:    info.CacheHitCount,
+		LockWaitTime:     info.LockWaitTime,
+		CnAddrs:          info.CnAddrs(),
 	}
 }
 
@@ -71,6 +71,9 @@ type messageSenderOnClient struct {
 
 	streamSender morpc.Stream
 	receiveCh    chan morpc.Message
+
+	// pipelineUuid routes the runtime filters sent after the pipeline.
+	pipelineUuid []byte
 }
 
 func newMessageSenderOnClient(
@@ -105,6 +108,7 @@ func (sender *messageSenderOnClient) send(
 		message.SetProcData(procData)
 		message.SetSequence(0)
 		message.SetSid(pipeline.Last)
+		message.Uuid = sender.pipelineUuid
 		return sender.streamSender.Send(timeoutCtx, message)
 	}
 
@@ -124,6 +128,7 @@ func (sender *messageSenderOnClient) send(
 			message.SetData(scopeData[start:sdLen])
 			message.SetProcData(procData)
 			message.SetSid(pipeline.Last)
+			message.Uuid = sender.pipelineUuid
 		} else {
 			message.SetData(scopeData[start:end])
 			message.SetSid(pipeline.WaitingNext)
@@ -138,6 +143,21 @@ func (sender *messageSenderOnClient) send(
 	return nil
 }
 
+// sendRuntimeFilter sends a runtime filter to the pipeline sent before.
+func (sender *messageSenderOnClient) sendRuntimeFilter(msg *process.RuntimeFilterMessage) error {
+	data, err := encodeRuntimeFilter(msg)
+	if err != nil {
+		return err
+	}
+	message := cnclient.AcquireMessage()
+	message.SetID(sender.streamSender.ID())
+	message.SetMessageType(pipeline.RuntimeFilterMessage)
+	message.SetData(data)
+	message.SetSid(pipeline.Last)
+	message.Uuid = sender.pipelineUuid
+	return sender.streamSender.Send(sender.ctx, message)
+}
+
 func (sender *messageSenderOnClient) receiveMessage() (morpc.Message, error) {
 	var err error
 	if sender.receiveCh == nil {
@@ -237,6 +257,21 @@ func newMessageReceiverOnServer(
 			panic("cn receive a message with wrong process bytes")
 		}
 		receiver.scopeData = m.Data
+		if len(m.GetUuid()) > 0 {
+			if receiver.messageUuid, err = uuid.FromBytes(m.GetUuid()); err != nil {
+				logutil.Errorf("decode uuid from pipeline.Message failed, bytes are %v", m.GetUuid())
+				panic("cn receive a message with wrong uuid bytes")
+			}
+		}
+
+	case pipeline.RuntimeFilterMessage:
+		opUuid, err := uuid.FromBytes(m.GetUuid())
+		if err != nil {
+			logutil.Errorf("decode uuid from pipeline.Message failed, bytes are %v", m.GetUuid())
+			panic("cn receive a message with wrong uuid bytes")
+		}
+		receiver.messageUuid = opUuid
+		receiver.scopeData = m.Data
 
 	default:
 		logutil.Errorf("unknown cmd %d for pipeline.Message", m.GetCmd())
@@ -20,12 +20,14 @@ import (
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/pb/pipeline"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/testutil/testengine"
 	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"github.com/stretchr/testify/require"
 )
 
@@ -95,3 +97,40 @@ func generateScopeCases(t *testing.T, testCases []string) []*Scope {
 	}
 	return result
 }
+
+func TestMergeAnalyseInfo(t *testing.T) {
+	remote := process.NewAnalyzeInfo(0)
+	remote.InputRows = 10
+	remote.MemoryPeak = 100
+	remote.CpuTime = 40
+	remote.TotalBlocks = 8
+	remote.PrunedBlocks = 5
+	remote.S3ReadCount = 2
+	remote.CacheReadCount = 6
+	remote.CacheHitCount = 4
+	remote.LockWaitTime = 7
+	remote.AddCnAddr("cn2:6001")
+
+	local := process.NewAnalyzeInfo(0)
+	local.InputRows = 1
+	local.MemoryPeak = 300
+	local.CpuTime = 2
+	local.TotalBlocks = 2
+	local.AddCnAddr("cn1:6001")
+	local.AddCnAddr("cn2:6001")
+
+	anal := &anaylze{analInfos: []*process.AnalyzeInfo{local}}
+	mergeAnalyseInfo(anal, &pipeline.AnalysisList{
+		List: []*plan.AnalyzeInfo{convertToPlanAnalyzeInfo(remote)},
+	})
+	require.Equal(t, int64(11), local.InputRows)
+	require.Equal(t, int64(300), local.MemoryPeak)
+	require.Equal(t, int64(42), local.CpuTime)
+	require.Equal(t, int64(10), local.TotalBlocks)
+	require.Equal(t, int64(5), local.PrunedBlocks)
+	require.Equal(t, int64(2), local.S3ReadCount)
+	require.Equal(t, int64(6), local.CacheReadCount)
+	require.Equal(t, int64(4), local.CacheHitCount)
+	require.Equal(t, int64(7), local.LockWaitTime)
+	require.Equal(t, []string{"cn1:6001", "cn2:6001"}, local.CnAddrs())
+}
@@ -0,0 +1,286 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"container/heap"
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sort"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/disttae"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// sortedScanReader reads the rows of a table sorted on its sort key, for
+// the merge join. Each block of the table is sorted on the key, and the
+// blocks are read in the order of the minimums of their zonemaps, so the
+// rows read not greater than the minimum of the next block are final and
+// are merged out of the blocks read before the next block is. Only the
+// blocks of overlapping key ranges are held together. The data in memory
+// and the blocks without a zonemap are read first, the batches not sorted
+// are sorted when read.
+type sortedScanReader struct {
+	rel  engine.Relation
+	expr *plan.Expr
+	key  string
+	// ranges are the ranges not read yet, mins are the minimums of their
+	// keys, nil if unknown.
+	ranges [][]byte
+	mins   [][]byte
+	runs   sortedRuns
+}
+
+func (s *Scope) newSortedScanReader(ctx context.Context, rel engine.Relation) (engine.Reader, error) {
+	r := &sortedScanReader{
+		rel:    rel,
+		expr:   s.DataSource.Expr,
+		key:    s.DataSource.SortKey,
+		ranges: s.NodeInfo.Data,
+		mins:   make([][]byte, len(s.NodeInfo.Data)),
+	}
+	if len(r.ranges) == 0 {
+		// no range is to read all rows
+		r.ranges, r.mins = [][]byte{nil}, [][]byte{nil}
+	}
+	tableDef := s.DataSource.TableDef
+	col, ok := tableDef.GetName2ColIndex()[r.key]
+	if !ok {
+		return nil, moerr.NewInternalError(ctx, "sort key %s of the merge join is not in table %s", r.key, tableDef.Name)
+	}
+	if s.Proc.FileService == nil {
+		return r, nil
+	}
+	fs, err := fileservice.Get[fileservice.FileService](s.Proc.FileService, defines.SharedFileServiceName)
+	if err != nil {
+		return r, nil
+	}
+	r.ranges, r.mins, err = disttae.SortRangesByZonemap(ctx, r.ranges, uint16(col),
+		types.T(tableDef.Cols[col].Typ.Id), fs, s.Proc.Mp())
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *sortedScanReader) Close() error {
+	for _, run := range r.runs {
+		run.bat.Clean(run.mp)
+	}
+	r.runs = nil
+	return nil
+}
+
+func (r *sortedScanReader) Read(ctx context.Context, cols []string, expr *plan.Expr, m *mpool.MPool, vp engine.VectorPool) (*batch.Batch, error) {
+	j := -1
+	for i, col := range cols {
+		if col == r.key {
+			j = i
+			break
+		}
+	}
+	if j == -1 {
+		return nil, moerr.NewInternalError(ctx, "sort key %s of the merge join is not read", r.key)
+	}
+
+	var bat *batch.Batch
+	for {
+		// the next range may have keys less than the rows held
+		for len(r.ranges) > 0 && (len(r.runs) == 0 || !r.final(r.runs[0])) {
+			if err := r.readRange(ctx, cols, j, m, vp); err != nil {
+				if bat != nil {
+					bat.Clean(m)
+				}
+				return nil, err
+			}
+		}
+		if len(r.runs) == 0 {
+			return bat, nil
+		}
+
+		if bat == nil {
+			src := r.runs[0].bat
+			bat = batch.NewWithSize(len(src.Vecs))
+			bat.Attrs = append(bat.Attrs, src.Attrs...)
+			for i, vec := range src.Vecs {
+				bat.Vecs[i] = vector.NewVec(*vec.GetType())
+			}
+		}
+		for len(r.runs) > 0 && bat.Length() < int(options.DefaultBlockMaxRows) && r.final(r.runs[0]) {
+			run := r.runs[0]
+			for i, vec := range run.bat.Vecs {
+				if err := bat.Vecs[i].UnionOne(vec, int64(run.pos), m); err != nil {
+					bat.Clean(m)
+					return nil, err
+				}
+			}
+			bat.Zs = append(bat.Zs, run.bat.Zs[run.pos])
+			if run.pos++; run.pos == run.bat.Length() {
+				heap.Pop(&r.runs)
+				run.bat.Clean(run.mp)
+			} else {
+				heap.Fix(&r.runs, 0)
+			}
+		}
+		if bat.Length() >= int(options.DefaultBlockMaxRows) || len(r.runs) == 0 && len(r.ranges) == 0 {
+			return bat, nil
+		}
+	}
+}
+
+// final reports whether the current row of the run is not greater than the
+// keys of the ranges not read.
+func (r *sortedScanReader) final(run *sortedRun) bool {
+	if len(r.ranges) == 0 {
+		return true
+	}
+	if r.mins[0] == nil {
+		return false
+	}
+	return run.isNull() || compute.Compare(run.keyAt(), r.mins[0], run.oid) <= 0
+}
+
+// readRange reads the next range, each batch read is a run of the merge.
+func (r *sortedScanReader) readRange(ctx context.Context, cols []string, j int, m *mpool.MPool, vp engine.VectorPool) error {
+	var ranges [][]byte
+	if r.ranges[0] != nil {
+		ranges = [][]byte{r.ranges[0]}
+	}
+	r.ranges, r.mins = r.ranges[1:], r.mins[1:]
+	rds, err := r.rel.NewReader(ctx, 1, r.expr, ranges)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		for _, rd := range rds {
+			rd.Close()
+		}
+	}()
+	for _, rd := range rds {
+		for {
+			bat, err := rd.Read(ctx, cols, r.expr, m, vp)
+			if err != nil {
+				return err
+			}
+			if bat == nil {
+				break
+			}
+			if bat.Length() == 0 {
+				bat.Clean(m)
+				continue
+			}
+			run := &sortedRun{bat: bat, key: bat.Vecs[j], oid: bat.Vecs[j].GetType().Oid, mp: m}
+			if err = run.sort(); err != nil {
+				bat.Clean(m)
+				return err
+			}
+			heap.Push(&r.runs, run)
+		}
+	}
+	return nil
+}
+
+// sortedRun is a batch sorted on the key, the rows before pos are merged.
+// The null keys come first, the merge join skips them wherever they are.
+type sortedRun struct {
+	bat *batch.Batch
+	key *vector.Vector
+	oid types.T
+	pos int
+	mp  *mpool.MPool
+}
+
+func (run *sortedRun) isNullAt(i int) bool {
+	return run.key.IsConstNull() || nulls.Contains(run.key.GetNulls(), uint64(i))
+}
+
+func (run *sortedRun) keyAtRow(i int) []byte {
+	if run.key.IsConst() {
+		i = 0
+	}
+	return process.RuntimeFilterKey(run.key, i)
+}
+
+func (run *sortedRun) isNull() bool {
+	return run.isNullAt(run.pos)
+}
+
+func (run *sortedRun) keyAt() []byte {
+	return run.keyAtRow(run.pos)
+}
+
+// less compares the row i of the run with the row j of the other run.
+func (run *sortedRun) less(i int, other *sortedRun, j int) bool {
+	if other.isNullAt(j) {
+		return false
+	}
+	if run.isNullAt(i) {
+		return true
+	}
+	return compute.Compare(run.keyAtRow(i), other.keyAtRow(j), run.oid) < 0
+}
+
+// sort sorts the batch of the run on the key unless it is sorted already.
+func (run *sortedRun) sort() error {
+	n := run.bat.Length()
+	sorted := true
+	for i := 1; i < n && sorted; i++ {
+		sorted = !run.less(i, run, i-1)
+	}
+	if sorted || run.key.IsConst() {
+		return nil
+	}
+	sels := make([]int64, n)
+	for i := range sels {
+		sels[i] = int64(i)
+	}
+	var strCol []string
+	if run.key.GetType().IsVarlen() {
+		strCol = vector.MustStrCol(run.key)
+	}
+	sort.Sort(false, false, nulls.Any(run.key.GetNulls()), sels, run.key, strCol)
+	return run.bat.Shuffle(sels, run.mp)
+}
+
+// sortedRuns is a heap of the runs by their current rows.
+type sortedRuns []*sortedRun
+
+func (h sortedRuns) Len() int { return len(h) }
+
+func (h sortedRuns) Less(i, j int) bool {
+	return h[i].less(h[i].pos, h[j], h[j].pos)
+}
+
+func (h sortedRuns) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *sortedRuns) Push(x any) { *h = append(*h, x.(*sortedRun)) }
+
+func (h *sortedRuns) Pop() any {
+	old := *h
+	run := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return run
+}
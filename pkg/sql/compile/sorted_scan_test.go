@@ -0,0 +1,114 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/stretchr/testify/require"
+)
+
+// testRangeRelation reads a batch for each range.
+type testRangeRelation struct {
+	engine.Relation
+	bats map[string]*batch.Batch
+	// opened is called when a range is read.
+	opened func(rng string)
+}
+
+func (r *testRangeRelation) NewReader(_ context.Context, _ int, _ *plan.Expr, ranges [][]byte) ([]engine.Reader, error) {
+	rng := ""
+	if len(ranges) > 0 {
+		rng = string(ranges[0])
+	}
+	r.opened(rng)
+	return []engine.Reader{&testBatchReader{bat: r.bats[rng]}}, nil
+}
+
+func TestSortedScanReader(t *testing.T) {
+	proc := testutil.NewProcess()
+	mp := proc.Mp()
+	key := func(v int64) []byte {
+		return types.EncodeInt64(&v)
+	}
+	// the keys of a batch, -1 is null, and the values are the keys times 10
+	newBatch := func(keys ...int64) *batch.Batch {
+		bat := batch.NewWithSize(2)
+		bat.Vecs[0] = vector.NewVec(types.T_int64.ToType())
+		bat.Vecs[1] = vector.NewVec(types.T_int64.ToType())
+		for _, k := range keys {
+			require.NoError(t, vector.AppendFixed(bat.Vecs[0], k, k == -1, mp))
+			require.NoError(t, vector.AppendFixed(bat.Vecs[1], k*10, false, mp))
+		}
+		bat.SetZs(len(keys), mp)
+		return bat
+	}
+
+	rel := &testRangeRelation{
+		bats: map[string]*batch.Batch{
+			// the data in memory is not sorted
+			"":  newBatch(5, -1, 1),
+			"a": newBatch(2, 4, 9),
+			"b": newBatch(3, 10),
+			"c": newBatch(11, 12),
+		},
+	}
+	r := &sortedScanReader{
+		rel:    rel,
+		key:    "k",
+		ranges: [][]byte{nil, []byte("a"), []byte("b"), []byte("c")},
+		mins:   [][]byte{nil, key(2), key(3), key(11)},
+	}
+	// the blocks of overlapping keys are held together, the one after
+	// them is read only when they are all merged out
+	rel.opened = func(rng string) {
+		if rng == "c" {
+			require.Empty(t, r.runs)
+		}
+	}
+
+	var keys, values []int64
+	var nullKeys int
+	for {
+		bat, err := r.Read(context.TODO(), []string{"k", "v"}, nil, mp, nil)
+		require.NoError(t, err)
+		if bat == nil {
+			break
+		}
+		ks := vector.MustFixedCol[int64](bat.Vecs[0])
+		vs := vector.MustFixedCol[int64](bat.Vecs[1])
+		for i := range ks {
+			if bat.Vecs[0].GetNulls().Contains(uint64(i)) {
+				nullKeys++
+				continue
+			}
+			keys = append(keys, ks[i])
+			values = append(values, vs[i])
+		}
+		bat.Clean(mp)
+	}
+	require.Equal(t, 1, nullKeys)
+	require.Equal(t, []int64{1, 2, 3, 4, 5, 9, 10, 11, 12}, keys)
+	require.Equal(t, []int64{10, 20, 30, 40, 50, 90, 100, 110, 120}, values)
+	require.NoError(t, r.Close())
+	require.Equal(t, int64(0), mp.CurrNB())
+}
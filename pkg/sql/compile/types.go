@@ -85,6 +85,10 @@ type Source struct {
 	TableDef               *plan.TableDef
 	Timestamp              timestamp.Timestamp
 	AccountId              int32
+	// runtime filters from the hash joins on the scan
+	RuntimeFilterSpecs []*plan.RuntimeFilterSpec
+	// SortKey is the column the rows are read sorted on for the merge join
+	SortKey string
 }
 
 // Col is the information of attribute
@@ -160,6 +164,11 @@ func (a *anaylze) S3IOOutputCount(idx int, count int64) {
 	atomic.AddInt64(&a.analInfos[idx].S3IOOutputCount, count)
 }
 
+func (a *anaylze) BlockCount(idx int, total, pruned int64) {
+	atomic.AddInt64(&a.analInfos[idx].TotalBlocks, total)
+	atomic.AddInt64(&a.analInfos[idx].PrunedBlocks, pruned)
+}
+
 func (a *anaylze) Nodes() []*process.AnalyzeInfo {
 	return a.analInfos
 }
@@ -199,6 +208,8 @@ type Compile struct {
 	s3CounterSet perfcounter.CounterSet
 
 	stepRegs map[int32][]*process.WaitRegister
+	// sortedScans are the table scans read sorted on the keys of the merge joins
+	sortedScans map[int32]string
 }
 
 type RemoteReceivRegInfo struct {
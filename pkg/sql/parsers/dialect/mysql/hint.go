@@ -0,0 +1,96 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// parseOptimizerHints parses the text of a /*+ ... */ comment as a list of
+// NAME(arg ...) hints, the arguments separated by blanks or commas. The text
+// from the first error on is kept as invalid, like MySQL the hints before it
+// still work.
+func parseOptimizerHints(text string, lower int64) *tree.OptimizerHints {
+	hints := &tree.OptimizerHints{}
+	rest := strings.TrimSpace(text)
+	for rest != "" {
+		hint, next, ok := parseOptimizerHint(rest, lower)
+		if !ok {
+			hints.Invalid = rest
+			break
+		}
+		hints.Hints = append(hints.Hints, hint)
+		rest = strings.TrimLeftFunc(next, func(r rune) bool {
+			return unicode.IsSpace(r) || r == ','
+		})
+	}
+	return hints
+}
+
+func parseOptimizerHint(text string, lower int64) (*tree.OptimizerHint, string, bool) {
+	i := strings.IndexFunc(text, func(r rune) bool {
+		return !isHintNameChar(r)
+	})
+	if i <= 0 {
+		return nil, "", false
+	}
+	hint := &tree.OptimizerHint{Name: strings.ToUpper(text[:i])}
+	text = strings.TrimLeftFunc(text[i:], unicode.IsSpace)
+	if !strings.HasPrefix(text, "(") {
+		return nil, "", false
+	}
+	text = text[1:]
+	for {
+		text = strings.TrimLeftFunc(text, func(r rune) bool {
+			return unicode.IsSpace(r) || r == ','
+		})
+		switch {
+		case text == "":
+			return nil, "", false
+		case text[0] == ')':
+			return hint, text[1:], true
+		case text[0] == '`':
+			j := strings.IndexByte(text[1:], '`')
+			if j < 0 {
+				return nil, "", false
+			}
+			hint.Args = append(hint.Args, text[1:j+1])
+			text = text[j+2:]
+		default:
+			j := strings.IndexFunc(text, func(r rune) bool {
+				return !isHintNameChar(r) && r != '.'
+			})
+			if j == 0 {
+				return nil, "", false
+			}
+			if j < 0 {
+				j = len(text)
+			}
+			arg := text[:j]
+			if lower != 0 {
+				arg = strings.ToLower(arg)
+			}
+			hint.Args = append(hint.Args, arg)
+			text = text[j:]
+		}
+	}
+}
+
+func isHintNameChar(r rune) bool {
+	return r == '_' || r == '$' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,36 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptimizerHints(t *testing.T) {
+	hints := parseOptimizerHints(" LEADING(t1 t2)\tshuffle(`T3`) , PARALLEL(8)", 1)
+	require.Equal(t, []*tree.OptimizerHint{
+		{Name: "LEADING", Args: []string{"t1", "t2"}},
+		{Name: "SHUFFLE", Args: []string{"T3"}},
+		{Name: "PARALLEL", Args: []string{"8"}},
+	}, hints.Hints)
+	require.Equal(t, "", hints.Invalid)
+
+	hints = parseOptimizerHints("NL_JOIN(t1) HASH_JOIN(t2", 1)
+	require.Equal(t, []*tree.OptimizerHint{{Name: "NL_JOIN", Args: []string{"t1"}}}, hints.Hints)
+	require.Equal(t, "HASH_JOIN(t2", hints.Invalid)
+}
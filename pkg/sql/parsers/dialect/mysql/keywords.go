@@ -105,7 +105,7 @@ func init() {
 		"current_user":             CURRENT_USER,
 		"current_role":             CURRENT_ROLE,
 		"curtime":                  CURTIME,
-		"cursor":                   UNUSED,
+		"cursor":                   CURSOR,
 		"database":                 DATABASE,
 		"databases":                DATABASES,
 		"day":                      DAY,
@@ -220,6 +220,7 @@ func init() {
 		"key_block_size":           KEY_BLOCK_SIZE,
 		"kill":                     KILL,
 		"language":                 LANGUAGE,
+		"lateral":                  LATERAL,
 		"last":                     LAST,
 		"leading":                  LEADING,
 		"leave":                    LEAVE,
@@ -548,6 +549,21 @@ func init() {
 		"lastval":                  LASTVAL,
 		"until":                    UNTIL,
 		"publication":              PUBLICATION,
+		"policy":                   POLICY,
+		"audit":                    AUDIT,
+		"filter":                   FILTER,
+		"exchange":                 EXCHANGE,
+		"validation":               VALIDATION,
+		"without":                  WITHOUT,
+		"ttl":                      TTL,
+		"bloom_filter_columns":     BLOOM_FILTER_COLUMNS,
+		"zorder":                   ZORDER,
+		"changefeed":               CHANGEFEED,
+		"changefeeds":              CHANGEFEEDS,
+		"materialized":             MATERIALIZED,
+		"refresh":                  REFRESH,
+		"backup":                   BACKUP,
+		"restore":                  RESTORE,
 		"subscriptions":            SUBSCRIPTIONS,
 		"publications":             PUBLICATIONS,
 		"roles":                    ROLES,
@@ -70,6 +70,7 @@ func (l *Lexer) GetParamIndex() int {
 func (l *Lexer) Lex(lval *yySymType) int {
 	typ, str := l.scanner.Scan()
 	l.scanner.LastToken = str
+	l.scanner.afterSelect = typ == SELECT
 
 	switch typ {
 	case INTEGRAL:
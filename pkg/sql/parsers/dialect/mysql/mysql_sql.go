@@ -79,482 +79,500 @@ const CROSS = 57401
 const NATURAL = 57402
 const USE = 57403
 const FORCE = 57404
-const LOWER_THAN_ON = 57405
-const ON = 57406
-const USING = 57407
-const SUBQUERY_AS_EXPR = 57408
-const LOWER_THAN_STRING = 57409
-const ID = 57410
-const AT_ID = 57411
-const AT_AT_ID = 57412
-const STRING = 57413
-const VALUE_ARG = 57414
-const LIST_ARG = 57415
-const COMMENT = 57416
-const COMMENT_KEYWORD = 57417
-const QUOTE_ID = 57418
-const INTEGRAL = 57419
-const HEX = 57420
-const BIT_LITERAL = 57421
-const FLOAT = 57422
-const HEXNUM = 57423
-const NULL = 57424
-const TRUE = 57425
-const FALSE = 57426
-const LOWER_THAN_CHARSET = 57427
-const CHARSET = 57428
-const UNIQUE = 57429
-const KEY = 57430
-const OR = 57431
-const PIPE_CONCAT = 57432
-const XOR = 57433
-const AND = 57434
-const NOT = 57435
-const BETWEEN = 57436
-const CASE = 57437
-const WHEN = 57438
-const THEN = 57439
-const ELSE = 57440
-const END = 57441
-const ELSEIF = 57442
-const LOWER_THAN_EQ = 57443
-const LE = 57444
-const GE = 57445
-const NE = 57446
-const NULL_SAFE_EQUAL = 57447
-const IS = 57448
-const LIKE = 57449
-const REGEXP = 57450
-const IN = 57451
-const ASSIGNMENT = 57452
-const ILIKE = 57453
-const SHIFT_LEFT = 57454
-const SHIFT_RIGHT = 57455
-const DIV = 57456
-const MOD = 57457
-const UNARY = 57458
-const COLLATE = 57459
-const BINARY = 57460
-const UNDERSCORE_BINARY = 57461
-const INTERVAL = 57462
-const OUT = 57463
-const INOUT = 57464
-const BEGIN = 57465
-const START = 57466
-const TRANSACTION = 57467
-const COMMIT = 57468
-const ROLLBACK = 57469
-const WORK = 57470
-const CONSISTENT = 57471
-const SNAPSHOT = 57472
-const CHAIN = 57473
-const NO = 57474
-const RELEASE = 57475
-const PRIORITY = 57476
-const QUICK = 57477
-const BIT = 57478
-const TINYINT = 57479
-const SMALLINT = 57480
-const MEDIUMINT = 57481
-const INT = 57482
-const INTEGER = 57483
-const BIGINT = 57484
-const INTNUM = 57485
-const REAL = 57486
-const DOUBLE = 57487
-const FLOAT_TYPE = 57488
-const DECIMAL = 57489
-const NUMERIC = 57490
-const DECIMAL_VALUE = 57491
-const TIME = 57492
-const TIMESTAMP = 57493
-const DATETIME = 57494
-const YEAR = 57495
-const CHAR = 57496
-const VARCHAR = 57497
-const BOOL = 57498
-const CHARACTER = 57499
-const VARBINARY = 57500
-const NCHAR = 57501
-const TEXT = 57502
-const TINYTEXT = 57503
-const MEDIUMTEXT = 57504
-const LONGTEXT = 57505
-const BLOB = 57506
-const TINYBLOB = 57507
-const MEDIUMBLOB = 57508
-const LONGBLOB = 57509
-const JSON = 57510
-const ENUM = 57511
-const UUID = 57512
-const GEOMETRY = 57513
-const POINT = 57514
-const LINESTRING = 57515
-const POLYGON = 57516
-const GEOMETRYCOLLECTION = 57517
-const MULTIPOINT = 57518
-const MULTILINESTRING = 57519
-const MULTIPOLYGON = 57520
-const INT1 = 57521
-const INT2 = 57522
-const INT3 = 57523
-const INT4 = 57524
-const INT8 = 57525
-const S3OPTION = 57526
-const SQL_SMALL_RESULT = 57527
-const SQL_BIG_RESULT = 57528
-const SQL_BUFFER_RESULT = 57529
-const LOW_PRIORITY = 57530
-const HIGH_PRIORITY = 57531
-const DELAYED = 57532
-const CREATE = 57533
-const ALTER = 57534
-const DROP = 57535
-const RENAME = 57536
-const ANALYZE = 57537
-const ADD = 57538
-const RETURNS = 57539
-const SCHEMA = 57540
-const TABLE = 57541
-const SEQUENCE = 57542
-const INDEX = 57543
-const VIEW = 57544
-const TO = 57545
-const IGNORE = 57546
-const IF = 57547
-const PRIMARY = 57548
-const COLUMN = 57549
-const CONSTRAINT = 57550
-const SPATIAL = 57551
-const FULLTEXT = 57552
-const FOREIGN = 57553
-const KEY_BLOCK_SIZE = 57554
-const SHOW = 57555
-const DESCRIBE = 57556
-const EXPLAIN = 57557
-const DATE = 57558
-const ESCAPE = 57559
-const REPAIR = 57560
-const OPTIMIZE = 57561
-const TRUNCATE = 57562
-const MAXVALUE = 57563
-const PARTITION = 57564
-const REORGANIZE = 57565
-const LESS = 57566
-const THAN = 57567
-const PROCEDURE = 57568
-const TRIGGER = 57569
-const STATUS = 57570
-const VARIABLES = 57571
-const ROLE = 57572
-const PROXY = 57573
-const AVG_ROW_LENGTH = 57574
-const STORAGE = 57575
-const DISK = 57576
-const MEMORY = 57577
-const CHECKSUM = 57578
-const COMPRESSION = 57579
-const DATA = 57580
-const DIRECTORY = 57581
-const DELAY_KEY_WRITE = 57582
-const ENCRYPTION = 57583
-const ENGINE = 57584
-const MAX_ROWS = 57585
-const MIN_ROWS = 57586
-const PACK_KEYS = 57587
-const ROW_FORMAT = 57588
-const STATS_AUTO_RECALC = 57589
-const STATS_PERSISTENT = 57590
-const STATS_SAMPLE_PAGES = 57591
-const DYNAMIC = 57592
-const COMPRESSED = 57593
-const REDUNDANT = 57594
-const COMPACT = 57595
-const FIXED = 57596
-const COLUMN_FORMAT = 57597
-const AUTO_RANDOM = 57598
-const RESTRICT = 57599
-const CASCADE = 57600
-const ACTION = 57601
-const PARTIAL = 57602
-const SIMPLE = 57603
-const CHECK = 57604
-const ENFORCED = 57605
-const RANGE = 57606
-const LIST = 57607
-const ALGORITHM = 57608
-const LINEAR = 57609
-const PARTITIONS = 57610
-const SUBPARTITION = 57611
-const SUBPARTITIONS = 57612
-const CLUSTER = 57613
-const TYPE = 57614
-const ANY = 57615
-const SOME = 57616
-const EXTERNAL = 57617
-const LOCALFILE = 57618
-const URL = 57619
-const PREPARE = 57620
-const DEALLOCATE = 57621
-const RESET = 57622
-const EXTENSION = 57623
-const INCREMENT = 57624
-const CYCLE = 57625
-const MINVALUE = 57626
-const PUBLICATION = 57627
-const SUBSCRIPTIONS = 57628
-const PUBLICATIONS = 57629
-const PROPERTIES = 57630
-const PARSER = 57631
-const VISIBLE = 57632
-const INVISIBLE = 57633
-const BTREE = 57634
-const HASH = 57635
-const RTREE = 57636
-const BSI = 57637
-const ZONEMAP = 57638
-const LEADING = 57639
-const BOTH = 57640
-const TRAILING = 57641
-const UNKNOWN = 57642
-const EXPIRE = 57643
-const ACCOUNT = 57644
-const ACCOUNTS = 57645
-const UNLOCK = 57646
-const DAY = 57647
-const NEVER = 57648
-const PUMP = 57649
-const MYSQL_COMPATIBILITY_MODE = 57650
-const SECOND = 57651
-const ASCII = 57652
-const COALESCE = 57653
-const COLLATION = 57654
-const HOUR = 57655
-const MICROSECOND = 57656
-const MINUTE = 57657
-const MONTH = 57658
-const QUARTER = 57659
-const REPEAT = 57660
-const REVERSE = 57661
-const ROW_COUNT = 57662
-const WEEK = 57663
-const REVOKE = 57664
-const FUNCTION = 57665
-const PRIVILEGES = 57666
-const TABLESPACE = 57667
-const EXECUTE = 57668
-const SUPER = 57669
-const GRANT = 57670
-const OPTION = 57671
-const REFERENCES = 57672
-const REPLICATION = 57673
-const SLAVE = 57674
-const CLIENT = 57675
-const USAGE = 57676
-const RELOAD = 57677
-const FILE = 57678
-const TEMPORARY = 57679
-const ROUTINE = 57680
-const EVENT = 57681
-const SHUTDOWN = 57682
-const NULLX = 57683
-const AUTO_INCREMENT = 57684
-const APPROXNUM = 57685
-const SIGNED = 57686
-const UNSIGNED = 57687
-const ZEROFILL = 57688
-const ENGINES = 57689
-const LOW_CARDINALITY = 57690
-const ADMIN_NAME = 57691
-const RANDOM = 57692
-const SUSPEND = 57693
-const ATTRIBUTE = 57694
-const HISTORY = 57695
-const REUSE = 57696
-const CURRENT = 57697
-const OPTIONAL = 57698
-const FAILED_LOGIN_ATTEMPTS = 57699
-const PASSWORD_LOCK_TIME = 57700
-const UNBOUNDED = 57701
-const SECONDARY = 57702
-const USER = 57703
-const IDENTIFIED = 57704
-const CIPHER = 57705
-const ISSUER = 57706
-const X509 = 57707
-const SUBJECT = 57708
-const SAN = 57709
-const REQUIRE = 57710
-const SSL = 57711
-const NONE = 57712
-const PASSWORD = 57713
-const MAX_QUERIES_PER_HOUR = 57714
-const MAX_UPDATES_PER_HOUR = 57715
-const MAX_CONNECTIONS_PER_HOUR = 57716
-const MAX_USER_CONNECTIONS = 57717
-const FORMAT = 57718
-const VERBOSE = 57719
-const CONNECTION = 57720
-const TRIGGERS = 57721
-const PROFILES = 57722
-const LOAD = 57723
-const INFILE = 57724
-const TERMINATED = 57725
-const OPTIONALLY = 57726
-const ENCLOSED = 57727
-const ESCAPED = 57728
-const STARTING = 57729
-const LINES = 57730
-const ROWS = 57731
-const IMPORT = 57732
-const MODUMP = 57733
-const OVER = 57734
-const PRECEDING = 57735
-const FOLLOWING = 57736
-const GROUPS = 57737
-const DATABASES = 57738
-const TABLES = 57739
-const SEQUENCES = 57740
-const EXTENDED = 57741
-const FULL = 57742
-const PROCESSLIST = 57743
-const FIELDS = 57744
-const COLUMNS = 57745
-const OPEN = 57746
-const ERRORS = 57747
-const WARNINGS = 57748
-const INDEXES = 57749
-const SCHEMAS = 57750
-const NODE = 57751
-const LOCKS = 57752
-const ROLES = 57753
-const TABLE_NUMBER = 57754
-const COLUMN_NUMBER = 57755
-const TABLE_VALUES = 57756
-const TABLE_SIZE = 57757
-const NAMES = 57758
-const GLOBAL = 57759
-const SESSION = 57760
-const ISOLATION = 57761
-const LEVEL = 57762
-const READ = 57763
-const WRITE = 57764
-const ONLY = 57765
-const REPEATABLE = 57766
-const COMMITTED = 57767
-const UNCOMMITTED = 57768
-const SERIALIZABLE = 57769
-const LOCAL = 57770
-const EVENTS = 57771
-const PLUGINS = 57772
-const CURRENT_TIMESTAMP = 57773
-const DATABASE = 57774
-const CURRENT_TIME = 57775
-const LOCALTIME = 57776
-const LOCALTIMESTAMP = 57777
-const UTC_DATE = 57778
-const UTC_TIME = 57779
-const UTC_TIMESTAMP = 57780
-const REPLACE = 57781
-const CONVERT = 57782
-const SEPARATOR = 57783
-const TIMESTAMPDIFF = 57784
-const CURRENT_DATE = 57785
-const CURRENT_USER = 57786
-const CURRENT_ROLE = 57787
-const SECOND_MICROSECOND = 57788
-const MINUTE_MICROSECOND = 57789
-const MINUTE_SECOND = 57790
-const HOUR_MICROSECOND = 57791
-const HOUR_SECOND = 57792
-const HOUR_MINUTE = 57793
-const DAY_MICROSECOND = 57794
-const DAY_SECOND = 57795
-const DAY_MINUTE = 57796
-const DAY_HOUR = 57797
-const YEAR_MONTH = 57798
-const SQL_TSI_HOUR = 57799
-const SQL_TSI_DAY = 57800
-const SQL_TSI_WEEK = 57801
-const SQL_TSI_MONTH = 57802
-const SQL_TSI_QUARTER = 57803
-const SQL_TSI_YEAR = 57804
-const SQL_TSI_SECOND = 57805
-const SQL_TSI_MINUTE = 57806
-const RECURSIVE = 57807
-const CONFIG = 57808
-const DRAINER = 57809
-const MATCH = 57810
-const AGAINST = 57811
-const BOOLEAN = 57812
-const LANGUAGE = 57813
-const WITH = 57814
-const QUERY = 57815
-const EXPANSION = 57816
-const ADDDATE = 57817
-const BIT_AND = 57818
-const BIT_OR = 57819
-const BIT_XOR = 57820
-const CAST = 57821
-const COUNT = 57822
-const APPROX_COUNT_DISTINCT = 57823
-const APPROX_PERCENTILE = 57824
-const CURDATE = 57825
-const CURTIME = 57826
-const DATE_ADD = 57827
-const DATE_SUB = 57828
-const EXTRACT = 57829
-const GROUP_CONCAT = 57830
-const MAX = 57831
-const MID = 57832
-const MIN = 57833
-const NOW = 57834
-const POSITION = 57835
-const SESSION_USER = 57836
-const STD = 57837
-const STDDEV = 57838
-const MEDIAN = 57839
-const STDDEV_POP = 57840
-const STDDEV_SAMP = 57841
-const SUBDATE = 57842
-const SUBSTR = 57843
-const SUBSTRING = 57844
-const SUM = 57845
-const SYSDATE = 57846
-const SYSTEM_USER = 57847
-const TRANSLATE = 57848
-const TRIM = 57849
-const VARIANCE = 57850
-const VAR_POP = 57851
-const VAR_SAMP = 57852
-const AVG = 57853
-const RANK = 57854
-const NEXTVAL = 57855
-const SETVAL = 57856
-const CURRVAL = 57857
-const LASTVAL = 57858
-const ARROW = 57859
-const ROW = 57860
-const OUTFILE = 57861
-const HEADER = 57862
-const MAX_FILE_SIZE = 57863
-const FORCE_QUOTE = 57864
-const PARALLEL = 57865
-const UNUSED = 57866
-const BINDINGS = 57867
-const DO = 57868
-const DECLARE = 57869
-const LOOP = 57870
-const WHILE = 57871
-const LEAVE = 57872
-const ITERATE = 57873
-const UNTIL = 57874
-const CALL = 57875
-const SPBEGIN = 57876
-const BACKEND = 57877
-const SERVERS = 57878
-const KILL = 57879
-const QUERY_RESULT = 57880
+const LATERAL = 57405
+const LOWER_THAN_ON = 57406
+const ON = 57407
+const USING = 57408
+const SUBQUERY_AS_EXPR = 57409
+const LOWER_THAN_STRING = 57410
+const ID = 57411
+const AT_ID = 57412
+const AT_AT_ID = 57413
+const STRING = 57414
+const VALUE_ARG = 57415
+const LIST_ARG = 57416
+const COMMENT = 57417
+const COMMENT_KEYWORD = 57418
+const QUOTE_ID = 57419
+const OPTIMIZER_HINT = 57420
+const INTEGRAL = 57421
+const HEX = 57422
+const BIT_LITERAL = 57423
+const FLOAT = 57424
+const HEXNUM = 57425
+const NULL = 57426
+const TRUE = 57427
+const FALSE = 57428
+const LOWER_THAN_CHARSET = 57429
+const CHARSET = 57430
+const UNIQUE = 57431
+const KEY = 57432
+const OR = 57433
+const PIPE_CONCAT = 57434
+const XOR = 57435
+const AND = 57436
+const NOT = 57437
+const BETWEEN = 57438
+const CASE = 57439
+const WHEN = 57440
+const THEN = 57441
+const ELSE = 57442
+const END = 57443
+const ELSEIF = 57444
+const LOWER_THAN_EQ = 57445
+const LE = 57446
+const GE = 57447
+const NE = 57448
+const NULL_SAFE_EQUAL = 57449
+const IS = 57450
+const LIKE = 57451
+const REGEXP = 57452
+const IN = 57453
+const ASSIGNMENT = 57454
+const ILIKE = 57455
+const SHIFT_LEFT = 57456
+const SHIFT_RIGHT = 57457
+const DIV = 57458
+const MOD = 57459
+const UNARY = 57460
+const COLLATE = 57461
+const BINARY = 57462
+const UNDERSCORE_BINARY = 57463
+const INTERVAL = 57464
+const OUT = 57465
+const INOUT = 57466
+const BEGIN = 57467
+const START = 57468
+const TRANSACTION = 57469
+const COMMIT = 57470
+const ROLLBACK = 57471
+const WORK = 57472
+const CONSISTENT = 57473
+const SNAPSHOT = 57474
+const CHAIN = 57475
+const NO = 57476
+const RELEASE = 57477
+const PRIORITY = 57478
+const QUICK = 57479
+const BIT = 57480
+const TINYINT = 57481
+const SMALLINT = 57482
+const MEDIUMINT = 57483
+const INT = 57484
+const INTEGER = 57485
+const BIGINT = 57486
+const INTNUM = 57487
+const REAL = 57488
+const DOUBLE = 57489
+const FLOAT_TYPE = 57490
+const DECIMAL = 57491
+const NUMERIC = 57492
+const DECIMAL_VALUE = 57493
+const TIME = 57494
+const TIMESTAMP = 57495
+const DATETIME = 57496
+const YEAR = 57497
+const CHAR = 57498
+const VARCHAR = 57499
+const BOOL = 57500
+const CHARACTER = 57501
+const VARBINARY = 57502
+const NCHAR = 57503
+const TEXT = 57504
+const TINYTEXT = 57505
+const MEDIUMTEXT = 57506
+const LONGTEXT = 57507
+const BLOB = 57508
+const TINYBLOB = 57509
+const MEDIUMBLOB = 57510
+const LONGBLOB = 57511
+const JSON = 57512
+const ENUM = 57513
+const UUID = 57514
+const GEOMETRY = 57515
+const POINT = 57516
+const LINESTRING = 57517
+const POLYGON = 57518
+const GEOMETRYCOLLECTION = 57519
+const MULTIPOINT = 57520
+const MULTILINESTRING = 57521
+const MULTIPOLYGON = 57522
+const INT1 = 57523
+const INT2 = 57524
+const INT3 = 57525
+const INT4 = 57526
+const INT8 = 57527
+const S3OPTION = 57528
+const SQL_SMALL_RESULT = 57529
+const SQL_BIG_RESULT = 57530
+const SQL_BUFFER_RESULT = 57531
+const LOW_PRIORITY = 57532
+const HIGH_PRIORITY = 57533
+const DELAYED = 57534
+const CREATE = 57535
+const ALTER = 57536
+const DROP = 57537
+const RENAME = 57538
+const ANALYZE = 57539
+const ADD = 57540
+const RETURNS = 57541
+const SCHEMA = 57542
+const TABLE = 57543
+const SEQUENCE = 57544
+const INDEX = 57545
+const VIEW = 57546
+const TO = 57547
+const IGNORE = 57548
+const IF = 57549
+const PRIMARY = 57550
+const COLUMN = 57551
+const CONSTRAINT = 57552
+const SPATIAL = 57553
+const FULLTEXT = 57554
+const FOREIGN = 57555
+const KEY_BLOCK_SIZE = 57556
+const SHOW = 57557
+const DESCRIBE = 57558
+const EXPLAIN = 57559
+const DATE = 57560
+const ESCAPE = 57561
+const REPAIR = 57562
+const OPTIMIZE = 57563
+const TRUNCATE = 57564
+const MAXVALUE = 57565
+const PARTITION = 57566
+const REORGANIZE = 57567
+const LESS = 57568
+const THAN = 57569
+const PROCEDURE = 57570
+const TRIGGER = 57571
+const STATUS = 57572
+const VARIABLES = 57573
+const ROLE = 57574
+const PROXY = 57575
+const AVG_ROW_LENGTH = 57576
+const STORAGE = 57577
+const DISK = 57578
+const MEMORY = 57579
+const CHECKSUM = 57580
+const COMPRESSION = 57581
+const DATA = 57582
+const DIRECTORY = 57583
+const DELAY_KEY_WRITE = 57584
+const ENCRYPTION = 57585
+const ENGINE = 57586
+const MAX_ROWS = 57587
+const MIN_ROWS = 57588
+const PACK_KEYS = 57589
+const ROW_FORMAT = 57590
+const STATS_AUTO_RECALC = 57591
+const STATS_PERSISTENT = 57592
+const STATS_SAMPLE_PAGES = 57593
+const DYNAMIC = 57594
+const COMPRESSED = 57595
+const REDUNDANT = 57596
+const COMPACT = 57597
+const FIXED = 57598
+const COLUMN_FORMAT = 57599
+const AUTO_RANDOM = 57600
+const RESTRICT = 57601
+const CASCADE = 57602
+const ACTION = 57603
+const PARTIAL = 57604
+const SIMPLE = 57605
+const CHECK = 57606
+const ENFORCED = 57607
+const RANGE = 57608
+const LIST = 57609
+const ALGORITHM = 57610
+const LINEAR = 57611
+const PARTITIONS = 57612
+const SUBPARTITION = 57613
+const SUBPARTITIONS = 57614
+const CLUSTER = 57615
+const TYPE = 57616
+const ANY = 57617
+const SOME = 57618
+const EXTERNAL = 57619
+const LOCALFILE = 57620
+const URL = 57621
+const PREPARE = 57622
+const DEALLOCATE = 57623
+const RESET = 57624
+const EXTENSION = 57625
+const INCREMENT = 57626
+const CYCLE = 57627
+const MINVALUE = 57628
+const PUBLICATION = 57629
+const SUBSCRIPTIONS = 57630
+const PUBLICATIONS = 57631
+const POLICY = 57632
+const AUDIT = 57633
+const FILTER = 57634
+const EXCHANGE = 57635
+const VALIDATION = 57636
+const WITHOUT = 57637
+const TTL = 57638
+const BLOOM_FILTER_COLUMNS = 57639
+const ZORDER = 57640
+const BACKUP = 57641
+const RESTORE = 57642
+const CHANGEFEED = 57643
+const CHANGEFEEDS = 57644
+const CURSOR = 57645
+const MATERIALIZED = 57646
+const REFRESH = 57647
+const PROPERTIES = 57648
+const PARSER = 57649
+const VISIBLE = 57650
+const INVISIBLE = 57651
+const BTREE = 57652
+const HASH = 57653
+const RTREE = 57654
+const BSI = 57655
+const ZONEMAP = 57656
+const LEADING = 57657
+const BOTH = 57658
+const TRAILING = 57659
+const UNKNOWN = 57660
+const EXPIRE = 57661
+const ACCOUNT = 57662
+const ACCOUNTS = 57663
+const UNLOCK = 57664
+const DAY = 57665
+const NEVER = 57666
+const PUMP = 57667
+const MYSQL_COMPATIBILITY_MODE = 57668
+const SECOND = 57669
+const ASCII = 57670
+const COALESCE = 57671
+const COLLATION = 57672
+const HOUR = 57673
+const MICROSECOND = 57674
+const MINUTE = 57675
+const MONTH = 57676
+const QUARTER = 57677
+const REPEAT = 57678
+const REVERSE = 57679
+const ROW_COUNT = 57680
+const WEEK = 57681
+const REVOKE = 57682
+const FUNCTION = 57683
+const PRIVILEGES = 57684
+const TABLESPACE = 57685
+const EXECUTE = 57686
+const SUPER = 57687
+const GRANT = 57688
+const OPTION = 57689
+const REFERENCES = 57690
+const REPLICATION = 57691
+const SLAVE = 57692
+const CLIENT = 57693
+const USAGE = 57694
+const RELOAD = 57695
+const FILE = 57696
+const TEMPORARY = 57697
+const ROUTINE = 57698
+const EVENT = 57699
+const SHUTDOWN = 57700
+const NULLX = 57701
+const AUTO_INCREMENT = 57702
+const APPROXNUM = 57703
+const SIGNED = 57704
+const UNSIGNED = 57705
+const ZEROFILL = 57706
+const ENGINES = 57707
+const LOW_CARDINALITY = 57708
+const ADMIN_NAME = 57709
+const RANDOM = 57710
+const SUSPEND = 57711
+const ATTRIBUTE = 57712
+const HISTORY = 57713
+const REUSE = 57714
+const CURRENT = 57715
+const OPTIONAL = 57716
+const FAILED_LOGIN_ATTEMPTS = 57717
+const PASSWORD_LOCK_TIME = 57718
+const UNBOUNDED = 57719
+const SECONDARY = 57720
+const USER = 57721
+const IDENTIFIED = 57722
+const CIPHER = 57723
+const ISSUER = 57724
+const X509 = 57725
+const SUBJECT = 57726
+const SAN = 57727
+const REQUIRE = 57728
+const SSL = 57729
+const NONE = 57730
+const PASSWORD = 57731
+const MAX_QUERIES_PER_HOUR = 57732
+const MAX_UPDATES_PER_HOUR = 57733
+const MAX_CONNECTIONS_PER_HOUR = 57734
+const MAX_USER_CONNECTIONS = 57735
+const FORMAT = 57736
+const VERBOSE = 57737
+const CONNECTION = 57738
+const TRIGGERS = 57739
+const PROFILES = 57740
+const LOAD = 57741
+const INFILE = 57742
+const TERMINATED = 57743
+const OPTIONALLY = 57744
+const ENCLOSED = 57745
+const ESCAPED = 57746
+const STARTING = 57747
+const LINES = 57748
+const ROWS = 57749
+const IMPORT = 57750
+const MODUMP = 57751
+const OVER = 57752
+const PRECEDING = 57753
+const FOLLOWING = 57754
+const GROUPS = 57755
+const DATABASES = 57756
+const TABLES = 57757
+const SEQUENCES = 57758
+const EXTENDED = 57759
+const FULL = 57760
+const PROCESSLIST = 57761
+const FIELDS = 57762
+const COLUMNS = 57763
+const OPEN = 57764
+const ERRORS = 57765
+const WARNINGS = 57766
+const INDEXES = 57767
+const SCHEMAS = 57768
+const NODE = 57769
+const LOCKS = 57770
+const ROLES = 57771
+const TABLE_NUMBER = 57772
+const COLUMN_NUMBER = 57773
+const TABLE_VALUES = 57774
+const TABLE_SIZE = 57775
+const NAMES = 57776
+const GLOBAL = 57777
+const SESSION = 57778
+const ISOLATION = 57779
+const LEVEL = 57780
+const READ = 57781
+const WRITE = 57782
+const ONLY = 57783
+const REPEATABLE = 57784
+const COMMITTED = 57785
+const UNCOMMITTED = 57786
+const SERIALIZABLE = 57787
+const LOCAL = 57788
+const EVENTS = 57789
+const PLUGINS = 57790
+const CURRENT_TIMESTAMP = 57791
+const DATABASE = 57792
+const CURRENT_TIME = 57793
+const LOCALTIME = 57794
+const LOCALTIMESTAMP = 57795
+const UTC_DATE = 57796
+const UTC_TIME = 57797
+const UTC_TIMESTAMP = 57798
+const REPLACE = 57799
+const CONVERT = 57800
+const SEPARATOR = 57801
+const TIMESTAMPDIFF = 57802
+const CURRENT_DATE = 57803
+const CURRENT_USER = 57804
+const CURRENT_ROLE = 57805
+const SECOND_MICROSECOND = 57806
+const MINUTE_MICROSECOND = 57807
+const MINUTE_SECOND = 57808
+const HOUR_MICROSECOND = 57809
+const HOUR_SECOND = 57810
+const HOUR_MINUTE = 57811
+const DAY_MICROSECOND = 57812
+const DAY_SECOND = 57813
+const DAY_MINUTE = 57814
+const DAY_HOUR = 57815
+const YEAR_MONTH = 57816
+const SQL_TSI_HOUR = 57817
+const SQL_TSI_DAY = 57818
+const SQL_TSI_WEEK = 57819
+const SQL_TSI_MONTH = 57820
+const SQL_TSI_QUARTER = 57821
+const SQL_TSI_YEAR = 57822
+const SQL_TSI_SECOND = 57823
+const SQL_TSI_MINUTE = 57824
+const RECURSIVE = 57825
+const CONFIG = 57826
+const DRAINER = 57827
+const MATCH = 57828
+const AGAINST = 57829
+const BOOLEAN = 57830
+const LANGUAGE = 57831
+const WITH = 57832
+const QUERY = 57833
+const EXPANSION = 57834
+const ADDDATE = 57835
+const BIT_AND = 57836
+const BIT_OR = 57837
+const BIT_XOR = 57838
+const CAST = 57839
+const COUNT = 57840
+const APPROX_COUNT_DISTINCT = 57841
+const APPROX_PERCENTILE = 57842
+const CURDATE = 57843
+const CURTIME = 57844
+const DATE_ADD = 57845
+const DATE_SUB = 57846
+const EXTRACT = 57847
+const GROUP_CONCAT = 57848
+const MAX = 57849
+const MID = 57850
+const MIN = 57851
+const NOW = 57852
+const POSITION = 57853
+const SESSION_USER = 57854
+const STD = 57855
+const STDDEV = 57856
+const MEDIAN = 57857
+const STDDEV_POP = 57858
+const STDDEV_SAMP = 57859
+const SUBDATE = 57860
+const SUBSTR = 57861
+const SUBSTRING = 57862
+const SUM = 57863
+const SYSDATE = 57864
+const SYSTEM_USER = 57865
+const TRANSLATE = 57866
+const TRIM = 57867
+const VARIANCE = 57868
+const VAR_POP = 57869
+const VAR_SAMP = 57870
+const AVG = 57871
+const RANK = 57872
+const NEXTVAL = 57873
+const SETVAL = 57874
+const CURRVAL = 57875
+const LASTVAL = 57876
+const ARROW = 57877
+const ROW = 57878
+const OUTFILE = 57879
+const HEADER = 57880
+const MAX_FILE_SIZE = 57881
+const FORCE_QUOTE = 57882
+const PARALLEL = 57883
+const UNUSED = 57884
+const BINDINGS = 57885
+const DO = 57886
+const DECLARE = 57887
+const LOOP = 57888
+const WHILE = 57889
+const LEAVE = 57890
+const ITERATE = 57891
+const UNTIL = 57892
+const CALL = 57893
+const SPBEGIN = 57894
+const BACKEND = 57895
+const SERVERS = 57896
+const KILL = 57897
+const QUERY_RESULT = 57898
 
 var yyToknames = [...]string{
 	"$end",
@@ -619,6 +637,7 @@ var yyToknames = [...]string{
 	"NATURAL",
 	"USE",
 	"FORCE",
+	"LATERAL",
 	"LOWER_THAN_ON",
 	"ON",
 	"USING",
@@ -635,6 +654,7 @@ var yyToknames = [...]string{
 	"COMMENT",
 	"COMMENT_KEYWORD",
 	"QUOTE_ID",
+	"OPTIMIZER_HINT",
 	"INTEGRAL",
 	"HEX",
 	"BIT_LITERAL",
@@ -861,6 +881,22 @@ var yyToknames = [...]string{
 	"PUBLICATION",
 	"SUBSCRIPTIONS",
 	"PUBLICATIONS",
+	"POLICY",
+	"AUDIT",
+	"FILTER",
+	"EXCHANGE",
+	"VALIDATION",
+	"WITHOUT",
+	"TTL",
+	"BLOOM_FILTER_COLUMNS",
+	"ZORDER",
+	"BACKUP",
+	"RESTORE",
+	"CHANGEFEED",
+	"CHANGEFEEDS",
+	"CURSOR",
+	"MATERIALIZED",
+	"REFRESH",
 	"PROPERTIES",
 	"PARSER",
 	"VISIBLE",
@@ -1113,10 +1149,10 @@ var yyToknames = [...]string{
 	"KILL",
 	"QUERY_RESULT",
 	"';'",
-	"':'",
-	"'@'",
 	"'{'",
 	"'}'",
+	"':'",
+	"'@'",
 }
 
 var yyStatenames = [...]string{}
@@ -1125,3810 +1161,4128 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line mysql_sql.y:9376
+//line mysql_sql.y:9752
 
 //line yacctab:1
 var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 109,
-	21, 626,
-	-2, 607,
-	-1, 123,
-	218, 843,
-	-2, 914,
-	-1, 145,
-	42, 447,
-	218, 447,
-	245, 454,
-	246, 454,
-	424, 447,
-	-2, 480,
-	-1, 181,
-	557, 1573,
-	-2, 366,
-	-1, 500,
-	294, 130,
-	399, 130,
-	-2, 1487,
-	-1, 563,
-	67, 1293,
-	-2, 1627,
-	-1, 564,
-	67, 1311,
-	-2, 1598,
-	-1, 568,
-	67, 1312,
-	-2, 1626,
-	-1, 591,
-	67, 1223,
-	-2, 1688,
-	-1, 592,
-	67, 1224,
-	-2, 1687,
-	-1, 593,
-	67, 1225,
-	-2, 1677,
-	-1, 594,
-	67, 1652,
-	-2, 1672,
-	-1, 595,
-	67, 1653,
-	-2, 1673,
+	-1, 117,
+	21, 658,
+	-2, 639,
+	-1, 134,
+	220, 900,
+	-2, 971,
+	-1, 160,
+	42, 473,
+	220, 473,
+	247, 480,
+	248, 480,
+	442, 473,
+	-2, 507,
+	-1, 196,
+	577, 1633,
+	-2, 389,
+	-1, 533,
+	296, 138,
+	417, 138,
+	-2, 1547,
 	-1, 596,
-	67, 1654,
-	-2, 1679,
+	68, 1353,
+	-2, 1687,
 	-1, 597,
-	67, 1655,
-	-2, 1662,
-	-1, 598,
-	67, 1656,
-	-2, 1670,
-	-1, 599,
-	67, 1657,
-	-2, 1680,
-	-1, 600,
-	67, 1658,
-	-2, 1681,
+	68, 1371,
+	-2, 1658,
 	-1, 601,
-	67, 1659,
+	68, 1372,
 	-2, 1686,
-	-1, 602,
-	67, 1660,
-	-2, 1691,
-	-1, 603,
-	67, 1661,
-	-2, 1692,
-	-1, 605,
-	67, 1290,
-	-2, 1479,
-	-1, 612,
-	67, 1299,
-	-2, 1505,
-	-1, 616,
-	67, 1303,
-	-2, 1544,
-	-1, 617,
-	67, 1304,
-	-2, 1622,
+	-1, 624,
+	68, 1283,
+	-2, 1763,
 	-1, 625,
-	67, 1314,
-	-2, 1607,
+	68, 1284,
+	-2, 1762,
+	-1, 626,
+	68, 1285,
+	-2, 1752,
 	-1, 627,
-	67, 1316,
-	-2, 1617,
+	68, 1727,
+	-2, 1747,
 	-1, 628,
-	67, 1317,
-	-2, 1642,
-	-1, 639,
-	67, 1201,
-	-2, 1682,
-	-1, 640,
-	67, 1202,
-	-2, 1683,
-	-1, 641,
-	67, 1203,
-	-2, 1684,
+	68, 1728,
+	-2, 1748,
+	-1, 629,
+	68, 1729,
+	-2, 1754,
+	-1, 630,
+	68, 1730,
+	-2, 1737,
+	-1, 631,
+	68, 1731,
+	-2, 1745,
+	-1, 632,
+	68, 1732,
+	-2, 1755,
+	-1, 633,
+	68, 1733,
+	-2, 1756,
+	-1, 634,
+	68, 1734,
+	-2, 1761,
+	-1, 635,
+	68, 1735,
+	-2, 1766,
+	-1, 636,
+	68, 1736,
+	-2, 1767,
+	-1, 638,
+	68, 1350,
+	-2, 1539,
 	-1, 645,
-	21, 627,
-	-2, 590,
-	-1, 714,
-	419, 480,
-	420, 480,
-	-2, 448,
-	-1, 756,
-	105, 1479,
-	116, 1479,
-	136, 1479,
-	-2, 1454,
-	-1, 856,
-	21, 627,
-	-2, 590,
-	-1, 956,
-	21, 626,
-	-2, 1106,
-	-1, 1297,
-	67, 1361,
-	-2, 1624,
-	-1, 1298,
-	67, 1362,
-	-2, 1625,
-	-1, 1430,
-	68, 768,
-	-2, 774,
-	-1, 1753,
-	68, 1440,
-	137, 1440,
-	-2, 1609,
-	-1, 1754,
-	68, 1440,
-	137, 1440,
-	-2, 1608,
-	-1, 1755,
-	68, 1418,
-	137, 1418,
-	-2, 1595,
-	-1, 1756,
-	68, 1419,
-	137, 1419,
-	-2, 1600,
-	-1, 1757,
-	68, 1420,
-	137, 1420,
-	-2, 1532,
-	-1, 1758,
+	68, 1359,
+	-2, 1565,
+	-1, 649,
+	68, 1363,
+	-2, 1604,
+	-1, 650,
+	68, 1364,
+	-2, 1682,
+	-1, 658,
+	68, 1374,
+	-2, 1667,
+	-1, 660,
+	68, 1376,
+	-2, 1677,
+	-1, 661,
+	68, 1377,
+	-2, 1702,
+	-1, 672,
+	68, 1261,
+	-2, 1757,
+	-1, 673,
+	68, 1262,
+	-2, 1758,
+	-1, 674,
+	68, 1263,
+	-2, 1759,
+	-1, 678,
+	21, 659,
+	-2, 622,
+	-1, 754,
+	437, 507,
+	438, 507,
+	-2, 474,
+	-1, 797,
+	107, 1539,
+	118, 1539,
+	138, 1539,
+	-2, 1514,
+	-1, 894,
+	21, 659,
+	-2, 622,
+	-1, 995,
+	21, 658,
+	-2, 1166,
+	-1, 1354,
 	68, 1421,
-	137, 1421,
-	-2, 1526,
-	-1, 1759,
+	-2, 1684,
+	-1, 1355,
 	68, 1422,
-	137, 1422,
-	-2, 1470,
-	-1, 1760,
-	68, 1423,
-	137, 1423,
-	-2, 1597,
-	-1, 1761,
-	68, 1424,
-	137, 1424,
+	-2, 1685,
+	-1, 1490,
+	69, 807,
+	-2, 813,
+	-1, 1832,
+	69, 1500,
+	139, 1500,
+	-2, 1669,
+	-1, 1833,
+	69, 1500,
+	139, 1500,
+	-2, 1668,
+	-1, 1834,
+	69, 1478,
+	139, 1478,
+	-2, 1655,
+	-1, 1835,
+	69, 1479,
+	139, 1479,
+	-2, 1660,
+	-1, 1836,
+	69, 1480,
+	139, 1480,
+	-2, 1592,
+	-1, 1837,
+	69, 1481,
+	139, 1481,
+	-2, 1586,
+	-1, 1838,
+	69, 1482,
+	139, 1482,
 	-2, 1530,
-	-1, 1762,
-	68, 1425,
-	137, 1425,
-	-2, 1525,
-	-1, 1763,
-	68, 1426,
-	137, 1426,
-	-2, 1518,
-	-1, 1765,
-	68, 1429,
-	137, 1429,
+	-1, 1839,
+	69, 1483,
+	139, 1483,
+	-2, 1657,
+	-1, 1840,
+	69, 1484,
+	139, 1484,
+	-2, 1590,
+	-1, 1841,
+	69, 1485,
+	139, 1485,
+	-2, 1585,
+	-1, 1842,
+	69, 1486,
+	139, 1486,
+	-2, 1578,
+	-1, 1844,
+	69, 1489,
+	139, 1489,
+	-2, 1702,
+	-1, 1845,
+	69, 1469,
+	139, 1469,
+	-2, 1687,
+	-1, 1846,
+	69, 1498,
+	139, 1498,
+	-2, 1658,
+	-1, 1847,
+	69, 1498,
+	139, 1498,
+	-2, 1686,
+	-1, 1848,
+	69, 1498,
+	139, 1498,
+	-2, 1548,
+	-1, 1849,
+	69, 1496,
+	139, 1496,
+	-2, 1677,
+	-1, 1850,
+	69, 1493,
+	139, 1493,
+	-2, 1570,
+	-1, 1851,
+	68, 1451,
+	69, 1451,
+	139, 1451,
+	379, 1451,
+	380, 1451,
+	381, 1451,
+	-2, 1529,
+	-1, 1852,
+	68, 1452,
+	69, 1452,
+	139, 1452,
+	379, 1452,
+	380, 1452,
+	381, 1452,
+	-2, 1531,
+	-1, 1853,
+	68, 1455,
+	69, 1455,
+	139, 1455,
+	379, 1455,
+	380, 1455,
+	381, 1455,
+	-2, 1659,
+	-1, 1854,
+	68, 1457,
+	69, 1457,
+	139, 1457,
+	379, 1457,
+	380, 1457,
+	381, 1457,
 	-2, 1642,
-	-1, 1766,
-	68, 1409,
-	137, 1409,
+	-1, 1855,
+	68, 1459,
+	69, 1459,
+	139, 1459,
+	379, 1459,
+	380, 1459,
+	381, 1459,
+	-2, 1591,
+	-1, 1856,
+	68, 1461,
+	69, 1461,
+	139, 1461,
+	379, 1461,
+	380, 1461,
+	381, 1461,
+	-2, 1574,
+	-1, 1857,
+	68, 1462,
+	69, 1462,
+	139, 1462,
+	379, 1462,
+	380, 1462,
+	381, 1462,
+	-2, 1575,
+	-1, 1858,
+	68, 1464,
+	69, 1464,
+	139, 1464,
+	379, 1464,
+	380, 1464,
+	381, 1464,
+	-2, 1528,
+	-1, 1859,
+	69, 1503,
+	139, 1503,
+	379, 1503,
+	380, 1503,
+	381, 1503,
+	-2, 1553,
+	-1, 1860,
+	69, 1503,
+	139, 1503,
+	379, 1503,
+	380, 1503,
+	381, 1503,
+	-2, 1566,
+	-1, 1861,
+	69, 1506,
+	139, 1506,
+	379, 1506,
+	380, 1506,
+	381, 1506,
+	-2, 1549,
+	-1, 1862,
+	69, 1503,
+	139, 1503,
+	379, 1503,
+	380, 1503,
+	381, 1503,
 	-2, 1627,
-	-1, 1767,
-	68, 1438,
-	137, 1438,
-	-2, 1598,
-	-1, 1768,
-	68, 1438,
-	137, 1438,
-	-2, 1626,
-	-1, 1769,
-	68, 1438,
-	137, 1438,
-	-2, 1488,
-	-1, 1770,
-	68, 1436,
-	137, 1436,
-	-2, 1617,
-	-1, 1771,
-	68, 1433,
-	137, 1433,
-	-2, 1510,
-	-1, 1772,
-	67, 1391,
-	68, 1391,
-	137, 1391,
-	361, 1391,
-	362, 1391,
-	363, 1391,
-	-2, 1469,
-	-1, 1773,
-	67, 1392,
-	68, 1392,
-	137, 1392,
-	361, 1392,
-	362, 1392,
-	363, 1392,
-	-2, 1471,
-	-1, 1774,
-	67, 1395,
-	68, 1395,
-	137, 1395,
-	361, 1395,
-	362, 1395,
-	363, 1395,
-	-2, 1599,
-	-1, 1775,
-	67, 1397,
-	68, 1397,
-	137, 1397,
-	361, 1397,
-	362, 1397,
-	363, 1397,
-	-2, 1582,
-	-1, 1776,
-	67, 1399,
-	68, 1399,
-	137, 1399,
-	361, 1399,
-	362, 1399,
-	363, 1399,
-	-2, 1531,
-	-1, 1777,
-	67, 1401,
-	68, 1401,
-	137, 1401,
-	361, 1401,
-	362, 1401,
-	363, 1401,
-	-2, 1514,
-	-1, 1778,
-	67, 1402,
-	68, 1402,
-	137, 1402,
-	361, 1402,
-	362, 1402,
-	363, 1402,
-	-2, 1515,
-	-1, 1779,
-	67, 1404,
-	68, 1404,
-	137, 1404,
-	361, 1404,
-	362, 1404,
-	363, 1404,
-	-2, 1468,
-	-1, 1780,
-	68, 1443,
-	137, 1443,
-	361, 1443,
-	362, 1443,
-	363, 1443,
-	-2, 1493,
-	-1, 1781,
-	68, 1443,
-	137, 1443,
-	361, 1443,
-	362, 1443,
-	363, 1443,
-	-2, 1506,
-	-1, 1782,
-	68, 1446,
-	137, 1446,
-	361, 1446,
-	362, 1446,
-	363, 1446,
-	-2, 1489,
-	-1, 1783,
-	68, 1443,
-	137, 1443,
-	361, 1443,
-	362, 1443,
-	363, 1443,
-	-2, 1567,
-	-1, 1796,
-	88, 878,
-	132, 878,
-	171, 878,
-	174, 878,
-	258, 878,
-	-2, 871,
-	-1, 1905,
-	21, 626,
-	-2, 718,
-	-1, 2083,
-	88, 878,
-	132, 878,
-	171, 878,
-	174, 878,
-	258, 878,
-	-2, 872,
-	-1, 2095,
-	65, 534,
-	137, 534,
-	-2, 1009,
-	-1, 2113,
-	279, 1074,
-	-2, 1053,
-	-1, 2373,
-	279, 1074,
-	-2, 1054,
+	-1, 1879,
+	90, 935,
+	134, 935,
+	173, 935,
+	176, 935,
+	260, 935,
+	-2, 928,
+	-1, 2004,
+	21, 658,
+	-2, 753,
+	-1, 2188,
+	90, 935,
+	134, 935,
+	173, 935,
+	176, 935,
+	260, 935,
+	-2, 929,
+	-1, 2200,
+	66, 566,
+	139, 566,
+	-2, 1069,
+	-1, 2221,
+	281, 1134,
+	-2, 1113,
+	-1, 2393,
+	20, 892,
+	-2, 889,
 	-1, 2506,
-	88, 878,
-	132, 878,
-	171, 878,
-	174, 878,
-	-2, 957,
-	-1, 2509,
-	88, 878,
-	132, 878,
-	171, 878,
-	174, 878,
-	-2, 957,
-	-1, 2519,
-	65, 534,
-	137, 534,
-	-2, 1010,
-	-1, 2617,
-	88, 878,
-	132, 878,
-	171, 878,
-	174, 878,
-	-2, 958,
-	-1, 2907,
-	68, 929,
-	137, 929,
-	-2, 878,
-	-1, 2911,
-	68, 929,
-	137, 929,
-	-2, 878,
-	-1, 2925,
-	68, 933,
-	137, 933,
-	-2, 878,
-	-1, 2930,
-	68, 934,
-	137, 934,
-	-2, 878,
+	281, 1134,
+	-2, 1114,
+	-1, 2650,
+	90, 935,
+	134, 935,
+	173, 935,
+	176, 935,
+	-2, 1015,
+	-1, 2653,
+	90, 935,
+	134, 935,
+	173, 935,
+	176, 935,
+	-2, 1015,
+	-1, 2663,
+	66, 566,
+	139, 566,
+	-2, 1070,
+	-1, 2775,
+	90, 935,
+	134, 935,
+	173, 935,
+	176, 935,
+	-2, 1016,
+	-1, 2790,
+	69, 987,
+	139, 987,
+	-2, 935,
+	-1, 2878,
+	69, 987,
+	139, 987,
+	-2, 935,
+	-1, 3001,
+	69, 991,
+	139, 991,
+	-2, 935,
+	-1, 3044,
+	69, 992,
+	139, 992,
+	-2, 935,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 34107
+const yyLast = 37269
 
 var yyAct = [...]int{
-	530, 1216, 1492, 2910, 2911, 2890, 172, 2919, 1278, 2801,
-	511, 2849, 532, 2819, 2841, 2584, 2677, 2385, 2589, 509,
-	2760, 2649, 2761, 1731, 2728, 1090, 2461, 2744, 2748, 2610,
-	2671, 646, 2462, 2609, 987, 2693, 2661, 2587, 1207, 417,
-	1451, 2638, 2098, 560, 2616, 2179, 1281, 1274, 423, 2350,
-	428, 428, 2529, 2579, 2178, 2489, 428, 444, 453, 157,
-	2177, 453, 2164, 2611, 1549, 2374, 2397, 1833, 1899, 1141,
-	1524, 2174, 513, 2171, 2459, 1988, 1641, 2447, 1610, 1836,
-	2200, 2430, 2325, 2322, 2396, 2320, 1805, 53, 755, 850,
-	1749, 2084, 1562, 1987, 1495, 1203, 464, 458, 1741, 1637,
-	2230, 2348, 2029, 1619, 508, 1618, 502, 1412, 503, 1453,
-	2269, 1611, 1938, 2213, 1751, 1584, 761, 1542, 1065, 1888,
-	1636, 1900, 2066, 2115, 2062, 1488, 692, 1527, 1804, 168,
-	8, 1834, 6, 36, 1098, 167, 7, 1099, 1049, 1420,
-	1438, 1955, 1277, 805, 1272, 1638, 512, 422, 1669, 417,
-	1747, 1172, 1150, 1546, 1789, 1525, 108, 35, 1462, 501,
-	1461, 2030, 1327, 1263, 1648, 1311, 520, 26, 867, 1617,
-	1600, 15, 172, 13, 172, 1079, 796, 797, 14, 1023,
-	1179, 1574, 1271, 759, 1198, 503, 1614, 1067, 747, 32,
-	450, 1133, 510, 437, 1907, 1332, 1208, 440, 1437, 1479,
-	1333, 466, 23, 467, 691, 643, 16, 10, 1075, 1047,
-	1125, 1091, 158, 709, 2263, 689, 1171, 154, 988, 2263,
-	151, 748, 452, 1990, 449, 2454, 645, 1655, 445, 1645,
-	447, 792, 1944, 794, 721, 448, 1942, 1941, 1186, 1939,
-	765, 1182, 793, 788, 789, 789, 446, 789, 1852, 156,
-	424, 1111, 1184, 925, 926, 927, 924, 2577, 2226, 2224,
-	416, 1589, 925, 926, 927, 924, 2667, 2662, 2580, 2460,
-	1416, 2737, 982, 1613, 433, 644, 654, 2602, 155, 2703,
-	155, 155, 1039, 456, 1353, 155, 8, 2792, 155, 787,
-	155, 155, 7, 49, 147, 124, 1983, 2601, 2712, 155,
-	1230, 49, 147, 124, 155, 1215, 888, 1975, 1223, 762,
-	764, 462, 1642, 2292, 107, 155, 1227, 49, 147, 124,
-	463, 2245, 1653, 2704, 1220, 1793, 2238, 504, 903, 1919,
-	922, 904, 1560, 1040, 1920, 152, 1107, 1229, 152, 1108,
-	107, 2837, 152, 1956, 1353, 1222, 2064, 152, 152, 1424,
-	1425, 1096, 1097, 1087, 1248, 731, 152, 647, 1475, 906,
-	634, 152, 633, 635, 636, 736, 637, 638, 735, 2835,
-	1280, 655, 152, 920, 758, 771, 766, 770, 772, 915,
-	2764, 2765, 757, 1724, 896, 2738, 2739, 898, 2669, 1264,
-	1094, 2231, 1268, 2730, 1093, 1096, 1097, 2823, 2824, 2063,
-	2463, 2733, 776, 2597, 2730, 2665, 769, 925, 926, 927,
-	924, 2672, 2673, 2674, 2675, 899, 1267, 2232, 2463, 2233,
-	1283, 1970, 1110, 870, 861, 1539, 1543, 428, 2743, 2472,
-	2490, 901, 1649, 2336, 2497, 2607, 1349, 428, 860, 2791,
-	1346, 1535, 2685, 1597, 1348, 1345, 1347, 1351, 1352, 1259,
-	2258, 740, 1350, 2069, 774, 453, 453, 908, 428, 1879,
-	909, 777, 1185, 1183, 497, 855, 857, 499, 737, 2054,
-	2326, 1788, 498, 1980, 1192, 1191, 2392, 2256, 767, 917,
-	870, 918, 919, 891, 2341, 2578, 2225, 892, 911, 123,
-	902, 153, 1269, 2830, 2688, 1884, 1349, 790, 791, 775,
-	1346, 859, 795, 2334, 1348, 1345, 1347, 1351, 1352, 760,
-	894, 145, 1350, 1266, 2168, 1881, 958, 2794, 2795, 854,
-	2763, 2604, 897, 900, 2347, 1282, 2354, 739, 2596, 1085,
-	799, 765, 2330, 2839, 2598, 2405, 2406, 768, 1289, 1292,
-	1293, 1658, 1660, 1661, 2753, 2700, 893, 2091, 455, 1290,
-	1367, 856, 2550, 454, 2749, 2331, 2332, 1654, 883, 2904,
-	907, 905, 860, 2920, 2834, 1558, 1559, 2803, 2858, 1109,
-	2333, 2865, 1120, 913, 914, 450, 450, 1334, 1335, 1336,
-	1337, 1338, 1339, 1340, 1341, 1342, 1343, 1344, 1356, 1357,
-	1358, 1359, 1360, 1361, 1354, 1355, 912, 1074, 738, 2651,
-	762, 764, 765, 2719, 872, 871, 2542, 2869, 773, 449,
-	449, 1862, 1861, 445, 445, 447, 447, 895, 874, 910,
-	448, 448, 1265, 2799, 2800, 992, 2803, 2328, 2533, 2555,
-	2556, 446, 446, 2537, 991, 2412, 2075, 2476, 1129, 863,
-	864, 2921, 2262, 2639, 2640, 2641, 2643, 2642, 1356, 1357,
-	1358, 1359, 1360, 1361, 1354, 1355, 1128, 2927, 1643, 1643,
-	881, 872, 871, 880, 1643, 1045, 423, 1048, 1089, 1088,
-	1113, 762, 764, 1072, 1020, 1071, 2891, 876, 877, 789,
-	1839, 2149, 2844, 865, 2694, 2915, 789, 451, 964, 2702,
-	789, 692, 789, 1096, 1097, 789, 2701, 789, 851, 2793,
-	2308, 2511, 1670, 451, 2575, 1940, 2202, 2204, 960, 961,
-	962, 963, 1050, 1656, 1187, 462, 1644, 2078, 2079, 2080,
-	2081, 1096, 1097, 2727, 1126, 1976, 2740, 2741, 1095, 1910,
-	2337, 1646, 1842, 2068, 1092, 1086, 2686, 428, 888, 1122,
-	644, 1544, 1532, 1851, 2259, 50, 2603, 1055, 2261, 1846,
-	417, 417, 417, 2840, 1059, 1145, 1145, 125, 428, 125,
-	125, 50, 2327, 1058, 125, 1984, 1057, 125, 2608, 125,
-	125, 1291, 457, 2316, 1659, 453, 1048, 423, 125, 1175,
-	1175, 1538, 882, 125, 2650, 1152, 2072, 2073, 1000, 1001,
-	172, 760, 427, 427, 125, 1657, 1062, 1536, 435, 417,
-	2071, 2845, 1143, 1143, 2053, 1260, 1735, 1051, 1052, 1053,
-	1054, 2914, 1056, 1046, 1838, 2329, 1060, 2271, 2270, 1840,
-	1043, 887, 686, 687, 688, 1147, 1737, 1736, 2926, 1041,
-	1042, 732, 732, 928, 1427, 1428, 1734, 1426, 1076, 1080,
-	1080, 1080, 957, 1193, 2538, 2539, 656, 1214, 657, 1217,
-	966, 780, 785, 786, 1225, 2535, 461, 684, 1025, 2534,
-	1843, 1076, 2624, 1076, 1701, 1027, 2203, 1700, 2933, 648,
-	1841, 1744, 971, 2870, 1246, 2345, 1856, 1845, 1081, 1082,
-	645, 1231, 1849, 1847, 660, 1073, 2932, 1848, 1145, 923,
-	1145, 860, 1083, 2888, 1745, 1746, 2427, 1121, 1241, 1242,
-	1101, 1102, 2423, 1104, 1105, 1106, 1064, 2923, 2150, 2152,
-	2153, 2154, 2151, 2905, 734, 734, 2096, 733, 733, 888,
-	1205, 1206, 1958, 2507, 1168, 1100, 2842, 2843, 1103, 1725,
-	1454, 2359, 1112, 1975, 1114, 659, 923, 923, 765, 662,
-	661, 2900, 765, 1729, 1139, 1140, 1127, 2894, 1577, 2893,
-	2874, 1791, 2851, 1897, 1279, 923, 1454, 1262, 1299, 1300,
-	1301, 1302, 1303, 1304, 1305, 1306, 1307, 1308, 1309, 1310,
-	1331, 1136, 1137, 1138, 1322, 1323, 2924, 648, 1153, 1370,
-	1371, 1372, 1651, 1380, 1221, 1167, 433, 923, 1228, 1276,
-	1245, 1196, 1386, 1199, 1200, 1387, 1176, 1166, 1244, 450,
-	1680, 2346, 1177, 2097, 1898, 741, 2813, 1394, 1395, 1255,
-	2901, 2771, 925, 926, 927, 924, 1651, 1389, 1651, 1651,
-	1188, 2852, 782, 783, 784, 1257, 925, 926, 927, 924,
-	1273, 2059, 2766, 449, 2056, 1294, 1232, 445, 2097, 447,
-	1210, 2721, 1213, 1254, 448, 2720, 1410, 1251, 1790, 1250,
-	428, 1898, 1436, 1145, 1440, 446, 1442, 1443, 1728, 1963,
-	1898, 428, 1077, 1237, 692, 1921, 2717, 1452, 1642, 1233,
-	1575, 1145, 1679, 645, 2716, 2814, 1122, 2427, 1253, 888,
-	2690, 444, 1252, 1249, 2715, 2714, 1379, 1413, 1270, 2689,
-	1827, 1730, 1275, 545, 109, 1021, 1705, 1633, 2557, 109,
-	1474, 2690, 925, 926, 927, 924, 1174, 1174, 1480, 1480,
-	2722, 1122, 1435, 1122, 1809, 1122, 1556, 1313, 428, 1063,
-	1436, 1436, 1478, 1909, 1145, 1522, 1534, 1325, 533, 542,
-	2414, 417, 2197, 1145, 534, 2690, 541, 535, 539, 538,
-	536, 537, 1130, 2690, 2853, 1320, 1321, 434, 2290, 2035,
-	109, 1441, 940, 2690, 2690, 2522, 2360, 2496, 2690, 428,
-	1436, 1145, 1078, 1567, 428, 428, 1570, 1921, 1991, 852,
-	1365, 1573, 2215, 1973, 1967, 1579, 2099, 1978, 1977, 858,
-	1518, 1519, 172, 853, 1969, 172, 172, 1824, 172, 543,
-	1696, 1555, 853, 1467, 1681, 1439, 1444, 1445, 1446, 2415,
-	879, 1898, 1632, 1391, 1582, 1417, 1432, 1234, 1473, 1076,
-	969, 1476, 1477, 1457, 1463, 1965, 1465, 1466, 923, 1960,
-	1564, 540, 1468, 1380, 1380, 1621, 1540, 1411, 1953, 1471,
-	1380, 1380, 1545, 1080, 873, 1628, 1951, 923, 1949, 853,
-	848, 1588, 1809, 1961, 1591, 1592, 763, 1594, 1482, 1947,
-	109, 1284, 1285, 1286, 1287, 1288, 1568, 1569, 1566, 1452,
-	1460, 1455, 1456, 1145, 1640, 109, 1439, 109, 1449, 1483,
-	846, 1472, 1448, 1484, 1464, 1485, 1469, 1470, 1362, 1363,
-	1459, 1366, 1808, 1726, 1966, 2364, 1709, 1708, 1961, 1381,
-	2253, 1077, 658, 1553, 1554, 1329, 1330, 1954, 2883, 1634,
-	1134, 1364, 1388, 1273, 1390, 1952, 1622, 1948, 1481, 1374,
-	765, 1135, 1699, 1690, 1689, 1688, 1663, 765, 1948, 1541,
-	1523, 1650, 1521, 1261, 1238, 885, 1616, 1132, 1550, 1551,
-	1552, 2871, 1561, 1616, 1369, 1368, 1994, 1853, 1667, 1668,
-	2428, 2754, 2419, 1565, 943, 944, 945, 946, 947, 940,
-	1414, 1809, 1725, 2625, 1418, 923, 923, 1421, 1583, 1585,
-	939, 938, 948, 949, 941, 942, 943, 944, 945, 946,
-	947, 940, 450, 1602, 2514, 2355, 2512, 2416, 2264, 762,
-	764, 923, 923, 923, 923, 2755, 762, 764, 1068, 2169,
-	1651, 1078, 1069, 1239, 1706, 1964, 886, 2626, 886, 1912,
-	1625, 1713, 765, 862, 1939, 1328, 449, 663, 1631, 1131,
-	445, 1626, 447, 1627, 2452, 1998, 1623, 448, 2515, 1933,
-	2513, 925, 926, 927, 924, 1635, 1586, 1400, 446, 1180,
-	502, 2217, 860, 1784, 2356, 1319, 1630, 941, 942, 943,
-	944, 945, 946, 947, 940, 428, 428, 428, 1328, 1806,
-	1676, 1316, 1318, 1315, 1434, 1317, 1180, 2788, 1586, 1813,
-	1122, 1414, 1662, 925, 926, 927, 924, 1414, 1414, 1817,
-	1671, 762, 764, 924, 2455, 927, 924, 2545, 2357, 1118,
-	2544, 1664, 1313, 1122, 2909, 925, 926, 927, 924, 1675,
-	860, 2234, 2127, 2126, 1672, 1752, 1943, 2121, 2119, 2526,
-	1151, 2897, 1384, 925, 926, 927, 924, 2868, 1587, 1665,
-	1666, 1590, 2453, 1385, 1593, 2859, 2854, 1595, 939, 938,
-	948, 949, 941, 942, 943, 944, 945, 946, 947, 940,
-	1902, 1902, 1534, 1902, 2804, 109, 109, 763, 2605, 1828,
-	931, 932, 933, 934, 935, 936, 937, 929, 2779, 860,
-	2756, 2867, 1785, 1832, 1392, 1393, 1145, 428, 1396, 1397,
-	1398, 1399, 1401, 1402, 1403, 1404, 1405, 1406, 1407, 1408,
-	1815, 1723, 860, 423, 2494, 2705, 1175, 2606, 1534, 1818,
-	1819, 1928, 497, 1930, 1855, 499, 2663, 172, 2631, 1738,
-	498, 2172, 1117, 2160, 1119, 2628, 1123, 1124, 2627, 1904,
-	2516, 1908, 1906, 1917, 1792, 1826, 2493, 1821, 956, 2335,
-	1822, 2158, 992, 2495, 2758, 2249, 1080, 1732, 1733, 765,
-	1814, 991, 2229, 1158, 1159, 1160, 1161, 1162, 1163, 1164,
-	1165, 2228, 2159, 1971, 1170, 1752, 1640, 925, 926, 927,
-	924, 1825, 2144, 1145, 2156, 1145, 1934, 1145, 1927, 2143,
-	2157, 2146, 860, 1673, 1823, 2142, 1677, 2139, 2133, 1820,
-	939, 938, 948, 949, 941, 942, 943, 944, 945, 946,
-	947, 940, 925, 926, 927, 924, 1882, 2130, 2129, 1703,
-	1605, 1145, 2016, 2155, 1925, 1604, 2321, 1603, 762, 764,
-	2145, 1599, 1981, 1932, 1598, 1687, 2022, 2023, 925, 926,
-	927, 924, 1145, 1694, 1913, 1914, 1915, 2000, 1235, 1918,
-	925, 926, 927, 924, 1038, 1985, 2829, 2585, 2825, 1935,
-	1924, 1707, 1923, 2025, 1710, 1711, 1712, 1926, 1143, 1715,
-	1716, 1717, 1718, 1719, 1720, 1721, 1722, 2789, 2027, 2725,
-	2687, 1028, 1692, 1854, 860, 1857, 1858, 1859, 1860, 1143,
-	2015, 1863, 1864, 1865, 1866, 1867, 1868, 1869, 1870, 1871,
-	1872, 1873, 1874, 1875, 1876, 2664, 2615, 1982, 2583, 2581,
-	2561, 2024, 925, 926, 927, 924, 2898, 1996, 2559, 2165,
-	1972, 1974, 1810, 2528, 2492, 1273, 2491, 1979, 2488, 2481,
-	2046, 1145, 1433, 2002, 2076, 1691, 1684, 2475, 1436, 1989,
-	155, 2422, 2420, 1447, 2095, 2410, 2409, 2057, 2313, 2312,
-	2101, 2260, 2031, 2227, 1992, 1993, 2208, 2036, 925, 926,
-	927, 924, 2707, 2006, 2147, 2110, 939, 938, 948, 949,
-	941, 942, 943, 944, 945, 946, 947, 940, 2118, 2140,
-	925, 926, 927, 924, 2136, 2135, 2123, 2124, 2125, 1181,
-	2134, 1727, 2128, 1607, 1154, 590, 589, 152, 2086, 434,
-	1486, 1601, 1423, 1995, 1205, 1206, 1902, 2060, 1236, 2047,
-	2050, 2747, 925, 926, 927, 924, 2161, 999, 1414, 1414,
-	1414, 995, 994, 109, 970, 1436, 860, 1534, 1534, 1534,
-	1534, 2085, 849, 2676, 925, 926, 927, 924, 860, 1534,
-	2509, 1563, 1902, 1174, 2508, 2506, 1563, 1563, 2480, 2102,
-	2467, 1145, 2458, 2457, 2446, 2116, 2445, 2092, 2365, 2116,
-	2288, 2283, 428, 428, 155, 2281, 2104, 147, 124, 2273,
-	2106, 2074, 2094, 1439, 2268, 2212, 172, 8, 2058, 1200,
-	2100, 172, 2055, 7, 109, 1950, 1946, 2113, 109, 2180,
-	1945, 1714, 2193, 2112, 1704, 2105, 1702, 1698, 2109, 109,
-	2591, 2180, 1380, 2120, 1380, 2114, 2282, 2244, 1697, 109,
-	2248, 1695, 1686, 2590, 1683, 2117, 1145, 1682, 1210, 2255,
-	1213, 152, 2922, 925, 926, 927, 924, 2141, 1606, 925,
-	926, 927, 924, 1409, 1383, 2218, 925, 926, 927, 924,
-	2222, 1382, 2103, 2166, 1999, 2170, 1373, 1157, 1155, 2107,
-	2108, 2882, 2017, 2018, 2876, 2866, 2192, 2863, 2195, 2861,
-	2020, 2021, 645, 2196, 1413, 2778, 2194, 2723, 989, 2243,
-	2209, 2206, 1195, 2026, 2647, 2635, 2632, 2065, 2554, 2569,
-	2567, 2241, 2181, 2182, 2183, 2184, 2216, 2247, 765, 2276,
-	2220, 2278, 2219, 1414, 2552, 765, 2048, 2049, 1421, 2257,
-	860, 925, 926, 927, 924, 2240, 2324, 2237, 2551, 2242,
-	2235, 2548, 2547, 2252, 2541, 2251, 2339, 2501, 428, 925,
-	926, 927, 924, 1204, 2265, 1197, 1066, 2162, 860, 860,
-	860, 2122, 2089, 2088, 2239, 2087, 1209, 1534, 1806, 2266,
-	2363, 2246, 1212, 1201, 2131, 2132, 2367, 2277, 2045, 1959,
-	2137, 2138, 1911, 1877, 1807, 2272, 2395, 1314, 2398, 152,
-	2398, 2398, 1571, 1752, 2279, 2280, 1431, 2403, 2167, 1430,
-	1258, 2293, 1145, 1145, 2478, 2294, 2295, 2296, 2297, 1224,
-	2298, 2299, 2300, 2301, 2302, 2303, 2304, 2305, 2309, 765,
-	2317, 1832, 1832, 1832, 1202, 2314, 1022, 925, 926, 927,
-	924, 1019, 1018, 428, 1017, 1016, 1015, 2361, 2324, 1014,
-	1013, 1012, 2315, 1011, 2344, 2343, 1436, 1436, 2085, 1143,
-	1143, 2393, 2394, 2358, 2362, 1010, 1009, 1798, 1799, 1800,
-	2351, 2352, 2274, 2275, 1008, 2319, 2809, 2286, 1007, 765,
-	1006, 2407, 2408, 2285, 2399, 2400, 2007, 2284, 1005, 1004,
-	1003, 1816, 1002, 2366, 1678, 2205, 2016, 2368, 2369, 1533,
-	925, 926, 927, 924, 998, 2456, 925, 926, 927, 924,
-	925, 926, 927, 924, 845, 842, 843, 844, 997, 996,
-	2012, 993, 2011, 2010, 2008, 2371, 986, 2424, 2425, 985,
-	983, 2418, 2417, 2421, 982, 2413, 981, 980, 979, 978,
-	2401, 977, 428, 976, 975, 2435, 974, 2221, 973, 2223,
-	972, 925, 926, 927, 924, 109, 968, 967, 109, 109,
-	2439, 109, 2442, 2443, 2444, 2044, 890, 1414, 2426, 847,
-	2431, 2432, 1414, 2549, 2451, 1812, 2377, 2370, 1795, 1151,
-	878, 2807, 2762, 2438, 2434, 2077, 2009, 1922, 925, 926,
-	927, 924, 2880, 1609, 889, 2052, 763, 2468, 2437, 2436,
-	2387, 2189, 2187, 763, 2469, 95, 2190, 2188, 2267, 2471,
-	2043, 52, 109, 2380, 2191, 51, 1894, 1895, 2482, 2474,
-	2375, 2186, 1436, 2185, 425, 2390, 2391, 2470, 2505, 2908,
-	2287, 2376, 2042, 925, 926, 927, 924, 2310, 2311, 1902,
-	1534, 2519, 939, 938, 948, 949, 941, 942, 943, 944,
-	945, 946, 947, 940, 1986, 925, 926, 927, 924, 430,
-	1968, 1962, 1145, 1517, 2484, 431, 2041, 2318, 2381, 432,
-	1189, 2487, 2040, 428, 1024, 429, 2039, 2527, 1957, 1732,
-	1733, 2572, 2395, 2571, 1218, 1786, 2521, 2742, 956, 925,
-	926, 927, 924, 2500, 2499, 925, 926, 927, 924, 925,
-	926, 927, 924, 2038, 1436, 1572, 884, 2517, 860, 2530,
-	2518, 2486, 2111, 2061, 2013, 2014, 2037, 2570, 916, 2525,
-	2034, 2393, 1802, 1450, 1429, 2816, 925, 926, 927, 924,
-	2402, 2574, 1369, 1368, 172, 2441, 1036, 1037, 2563, 925,
-	926, 927, 924, 925, 926, 927, 924, 860, 2553, 1034,
-	1035, 2520, 2033, 2564, 2560, 1032, 1033, 2523, 2558, 2389,
-	2524, 1837, 1880, 1520, 2562, 2599, 1030, 1031, 1116, 1115,
-	1629, 2180, 2032, 2565, 1070, 925, 926, 927, 924, 2028,
-	1026, 2877, 860, 1145, 1145, 2797, 2383, 2785, 860, 2618,
-	2019, 2783, 2618, 2576, 2750, 925, 926, 927, 924, 1997,
-	2735, 2586, 925, 926, 927, 924, 2734, 2732, 2382, 2384,
-	2180, 2724, 2658, 925, 926, 927, 924, 2657, 2600, 2582,
-	1324, 2483, 925, 926, 927, 924, 2093, 2465, 860, 860,
-	1143, 2530, 860, 860, 2622, 2621, 2614, 2619, 2464, 2449,
-	1029, 648, 2521, 925, 926, 927, 924, 2448, 1452, 2214,
-	2655, 1832, 2613, 1454, 2811, 2810, 2811, 2250, 2659, 2660,
-	1797, 2636, 2637, 2592, 1685, 2645, 2646, 2633, 875, 2652,
-	2810, 2543, 2644, 2502, 2503, 2504, 649, 650, 651, 652,
-	2466, 1084, 2684, 2392, 649, 650, 651, 652, 2653, 648,
-	60, 2477, 159, 3, 2, 2378, 1557, 648, 2479, 1149,
-	2696, 2388, 1, 1422, 653, 1905, 2198, 2629, 2630, 2199,
-	2440, 2201, 1647, 1878, 1787, 2338, 860, 2682, 948, 949,
-	941, 942, 943, 944, 945, 946, 947, 940, 860, 1885,
-	2691, 1061, 685, 1375, 1243, 779, 869, 1240, 2698, 2697,
-	868, 2706, 866, 1326, 2210, 2211, 1156, 547, 2709, 2713,
-	1612, 1533, 1890, 1893, 1894, 1895, 1891, 2163, 1892, 1896,
-	109, 2718, 1890, 1893, 1894, 1895, 1891, 2654, 1892, 1896,
-	2815, 860, 2848, 2777, 2818, 2736, 1256, 531, 2751, 2726,
-	2668, 2731, 2729, 2781, 2670, 2588, 1652, 921, 925, 926,
-	927, 924, 2236, 705, 583, 2746, 558, 984, 1226, 1219,
-	2745, 2291, 781, 2772, 2775, 557, 2498, 2752, 2070, 2699,
-	674, 778, 2757, 706, 1596, 2666, 1190, 1211, 1194, 2623,
-	2776, 2510, 2767, 2768, 2769, 2770, 2353, 2090, 2784, 2918,
-	2786, 2787, 2907, 2889, 2782, 2780, 1414, 2875, 2802, 2566,
-	2903, 2833, 2568, 2864, 2595, 2593, 2594, 2857, 2798, 468,
-	1537, 2796, 415, 745, 2648, 1608, 2573, 469, 1811, 2822,
-	2805, 2790, 2808, 2806, 2634, 672, 1794, 1353, 673, 2812,
-	2083, 2821, 2082, 1295, 930, 1312, 2306, 2307, 860, 965,
-	507, 2826, 1674, 519, 2067, 2827, 2386, 2207, 59, 58,
-	57, 56, 1578, 180, 549, 2847, 179, 2774, 2836, 2838,
-	2342, 2820, 529, 528, 527, 526, 2846, 2850, 525, 1889,
-	1887, 2855, 1886, 860, 1529, 1528, 1576, 2404, 1850, 1844,
-	1487, 2856, 938, 948, 949, 941, 942, 943, 944, 945,
-	946, 947, 940, 2822, 2873, 2860, 2759, 2862, 2710, 2711,
-	2540, 2831, 860, 2148, 860, 2821, 2872, 2536, 2532, 2411,
-	2879, 2617, 2881, 2372, 2373, 2379, 1801, 804, 800, 802,
-	803, 2850, 2885, 860, 801, 2005, 2884, 2892, 2001, 2899,
-	1829, 2896, 2902, 1831, 1830, 2349, 1279, 1743, 1742, 1740,
-	1739, 109, 1044, 2683, 2485, 1563, 1750, 2906, 1748, 680,
-	2913, 2878, 2433, 2429, 2917, 2916, 2340, 1620, 1419, 2051,
-	1530, 2925, 1526, 2681, 2928, 1279, 1883, 1279, 2913, 2931,
-	2930, 1796, 2929, 2917, 86, 85, 93, 136, 46, 1349,
-	2692, 164, 163, 1346, 166, 165, 1279, 1348, 1345, 1347,
-	1351, 1352, 162, 1936, 1937, 1350, 161, 1178, 160, 2620,
-	2708, 939, 938, 948, 949, 941, 942, 943, 944, 945,
-	946, 947, 940, 642, 37, 33, 12, 11, 34, 21,
-	1533, 1533, 1533, 1533, 22, 20, 1247, 19, 25, 951,
-	31, 955, 1533, 30, 102, 101, 29, 100, 99, 98,
-	97, 28, 18, 41, 2473, 40, 2681, 952, 954, 950,
-	39, 953, 939, 938, 948, 949, 941, 942, 943, 944,
-	945, 946, 947, 940, 9, 92, 90, 27, 91, 109,
-	88, 89, 87, 71, 109, 682, 70, 677, 69, 667,
-	2828, 83, 82, 81, 80, 79, 679, 678, 78, 77,
-	704, 68, 67, 66, 109, 65, 64, 75, 84, 76,
-	74, 109, 73, 665, 72, 63, 62, 671, 61, 121,
-	122, 120, 119, 2289, 118, 117, 116, 115, 42, 43,
-	1334, 1335, 1336, 1337, 1338, 1339, 1340, 1341, 1342, 1343,
-	1344, 1356, 1357, 1358, 1359, 1360, 1361, 1354, 1355, 44,
-	45, 132, 131, 133, 135, 137, 134, 129, 676, 127,
-	130, 128, 675, 126, 54, 17, 2681, 24, 664, 4,
-	0, 0, 670, 939, 938, 948, 949, 941, 942, 943,
-	944, 945, 946, 947, 940, 2546, 0, 0, 0, 668,
-	0, 0, 0, 0, 0, 0, 0, 0, 351, 565,
-	0, 0, 0, 0, 0, 109, 0, 0, 0, 314,
-	666, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 521, 0, 683, 0, 260, 0, 0, 284,
-	0, 0, 0, 556, 0, 0, 343, 298, 0, 0,
-	1533, 0, 613, 621, 0, 0, 0, 0, 669, 2887,
-	0, 0, 0, 0, 514, 109, 0, 546, 590, 589,
-	533, 542, 0, 0, 242, 178, 534, 0, 541, 535,
-	539, 538, 536, 537, 0, 605, 0, 0, 0, 0,
-	0, 0, 505, 518, 2678, 522, 939, 938, 948, 949,
-	941, 942, 943, 944, 945, 946, 947, 940, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 515,
-	516, 0, 0, 0, 0, 566, 0, 517, 0, 681,
-	561, 543, 544, 0, 0, 0, 0, 233, 348, 364,
-	243, 339, 377, 248, 346, 238, 313, 336, 0, 0,
-	235, 362, 345, 295, 278, 279, 234, 0, 331, 258,
-	271, 255, 311, 540, 564, 568, 254, 627, 562, 372,
-	237, 0, 371, 310, 358, 363, 296, 290, 236, 360,
-	294, 289, 282, 262, 628, 275, 322, 288, 323, 276,
-	300, 299, 301, 0, 0, 0, 0, 0, 401, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 559, 0, 0, 0, 374, 0, 0, 611,
-	0, 0, 0, 347, 0, 0, 283, 0, 0, 0,
-	563, 0, 334, 316, 624, 506, 0, 332, 286, 359,
-	324, 365, 349, 373, 328, 325, 228, 350, 257, 297,
-	239, 241, 253, 259, 261, 263, 264, 306, 307, 319,
-	338, 352, 353, 354, 256, 249, 333, 250, 273, 251,
-	229, 340, 252, 231, 320, 357, 0, 269, 329, 293,
-	232, 292, 321, 356, 355, 240, 381, 387, 388, 393,
-	0, 394, 0, 0, 0, 402, 407, 408, 409, 411,
-	412, 413, 414, 0, 0, 0, 0, 396, 0, 0,
-	0, 0, 0, 1533, 386, 267, 225, 226, 421, 609,
-	312, 0, 0, 623, 604, 606, 607, 610, 614, 615,
-	616, 617, 618, 620, 622, 626, 420, 0, 0, 0,
-	0, 0, 419, 318, 0, 337, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 344, 367,
-	379, 397, 400, 0, 0, 0, 230, 399, 0, 2679,
-	0, 0, 0, 2680, 0, 625, 0, 0, 0, 378,
-	0, 0, 0, 0, 0, 567, 302, 303, 304, 305,
-	612, 0, 247, 398, 327, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 109, 0, 0,
-	0, 391, 392, 266, 272, 410, 274, 246, 317, 268,
-	376, 280, 0, 403, 0, 404, 0, 0, 0, 0,
-	309, 277, 341, 281, 287, 330, 375, 315, 335, 244,
-	366, 342, 291, 0, 0, 634, 608, 633, 635, 636,
-	632, 637, 638, 619, 524, 0, 571, 630, 629, 631,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 227, 0, 285, 0, 326, 265, 597,
-	576, 577, 578, 523, 579, 574, 575, 598, 569, 594,
-	595, 548, 572, 580, 593, 581, 596, 599, 600, 639,
-	640, 587, 641, 584, 601, 592, 591, 582, 570, 602,
-	603, 555, 550, 585, 586, 573, 588, 551, 552, 553,
-	554, 351, 565, 0, 382, 383, 384, 406, 368, 0,
-	418, 0, 314, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 521, 0, 0, 0, 260,
-	0, 0, 284, 0, 0, 0, 556, 0, 0, 343,
-	298, 0, 0, 0, 0, 613, 621, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 514, 0, 0,
-	546, 590, 589, 533, 542, 0, 0, 242, 178, 534,
-	0, 541, 535, 539, 538, 536, 537, 0, 605, 0,
-	0, 0, 0, 0, 0, 505, 518, 0, 522, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 515, 516, 0, 0, 0, 0, 566, 0,
-	517, 0, 0, 561, 543, 544, 0, 0, 0, 0,
-	233, 348, 364, 243, 339, 377, 248, 346, 238, 313,
-	336, 0, 0, 235, 362, 345, 295, 278, 279, 234,
-	0, 331, 258, 271, 255, 311, 540, 564, 568, 254,
-	627, 562, 372, 237, 0, 371, 310, 358, 363, 296,
-	290, 236, 360, 294, 289, 282, 262, 628, 275, 322,
-	288, 323, 276, 300, 299, 301, 0, 0, 0, 0,
-	0, 401, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 559, 0, 0, 0, 374,
-	0, 0, 611, 0, 0, 0, 347, 0, 0, 283,
-	0, 0, 0, 563, 0, 334, 316, 624, 506, 0,
-	332, 286, 359, 324, 365, 349, 373, 328, 325, 228,
-	350, 257, 297, 239, 241, 253, 259, 261, 263, 264,
-	306, 307, 319, 338, 352, 353, 354, 256, 249, 333,
-	250, 273, 251, 229, 340, 252, 231, 320, 357, 0,
-	269, 329, 293, 232, 292, 321, 356, 355, 240, 381,
-	387, 388, 393, 0, 394, 0, 0, 0, 402, 407,
-	408, 409, 411, 412, 413, 414, 0, 0, 0, 0,
-	396, 0, 0, 0, 1377, 1376, 1378, 386, 267, 225,
-	226, 421, 609, 312, 0, 0, 623, 604, 606, 607,
-	610, 614, 615, 616, 617, 618, 620, 622, 626, 420,
-	0, 0, 0, 0, 0, 419, 318, 0, 337, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 344, 367, 379, 397, 400, 0, 0, 0, 230,
-	399, 0, 0, 0, 0, 0, 0, 0, 625, 0,
-	0, 0, 378, 0, 0, 0, 0, 0, 567, 302,
-	303, 304, 305, 612, 0, 247, 398, 327, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 391, 392, 266, 272, 410, 274,
-	246, 317, 268, 376, 280, 0, 403, 0, 404, 0,
-	0, 0, 0, 309, 277, 341, 281, 287, 330, 375,
-	315, 335, 244, 366, 342, 291, 0, 0, 634, 608,
-	633, 635, 636, 632, 637, 638, 619, 524, 0, 571,
-	630, 629, 631, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 227, 0, 285, 0,
-	326, 265, 597, 576, 577, 578, 523, 579, 574, 575,
-	598, 569, 594, 595, 548, 572, 580, 593, 581, 596,
-	599, 600, 639, 640, 587, 641, 584, 601, 592, 591,
-	582, 570, 602, 603, 555, 550, 585, 586, 573, 588,
-	551, 552, 553, 554, 351, 565, 0, 382, 383, 384,
-	406, 368, 0, 418, 0, 314, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 521, 0,
-	0, 0, 260, 0, 0, 284, 0, 0, 0, 556,
-	0, 0, 343, 298, 0, 0, 0, 0, 613, 621,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	514, 0, 0, 546, 590, 589, 533, 542, 0, 0,
-	242, 178, 534, 0, 541, 535, 539, 538, 536, 537,
-	0, 605, 0, 0, 0, 0, 0, 0, 505, 518,
-	0, 522, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 515, 516, 0, 0, 0,
-	0, 566, 0, 517, 0, 0, 561, 543, 544, 0,
-	0, 0, 0, 233, 348, 364, 243, 339, 377, 248,
-	346, 238, 313, 336, 0, 0, 235, 362, 345, 295,
-	278, 279, 234, 0, 331, 258, 271, 255, 311, 540,
-	564, 568, 254, 627, 562, 372, 237, 0, 371, 310,
-	358, 363, 296, 290, 236, 360, 294, 289, 282, 262,
-	628, 275, 322, 288, 323, 276, 300, 299, 301, 0,
-	0, 0, 0, 0, 401, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 559, 0,
-	0, 0, 374, 0, 0, 611, 0, 0, 0, 347,
-	0, 0, 283, 0, 0, 0, 563, 0, 334, 316,
-	624, 506, 0, 332, 286, 359, 324, 365, 349, 373,
-	328, 325, 228, 350, 257, 297, 239, 241, 253, 259,
-	261, 263, 264, 306, 307, 319, 338, 352, 353, 354,
-	256, 249, 333, 250, 273, 251, 229, 340, 252, 231,
-	320, 357, 0, 269, 329, 293, 232, 292, 321, 356,
-	355, 240, 381, 387, 388, 393, 0, 394, 0, 0,
-	0, 402, 407, 408, 409, 411, 412, 413, 414, 0,
-	0, 0, 0, 396, 0, 0, 0, 0, 0, 0,
-	386, 267, 225, 226, 421, 609, 312, 0, 0, 623,
-	604, 606, 607, 610, 614, 615, 616, 617, 618, 620,
-	622, 626, 420, 0, 0, 0, 0, 0, 419, 318,
-	0, 337, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 344, 367, 379, 397, 400, 0,
-	0, 0, 230, 399, 0, 2679, 0, 0, 0, 2680,
-	0, 625, 0, 0, 0, 378, 0, 0, 0, 0,
-	0, 567, 302, 303, 304, 305, 612, 0, 247, 398,
-	327, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 391, 392, 266,
-	272, 410, 274, 246, 317, 268, 376, 280, 0, 403,
-	0, 404, 0, 0, 0, 0, 309, 277, 341, 281,
-	287, 330, 375, 315, 335, 244, 366, 342, 291, 0,
-	0, 634, 608, 633, 635, 636, 632, 637, 638, 619,
-	524, 0, 571, 630, 629, 631, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 227,
-	0, 285, 0, 326, 265, 597, 576, 577, 578, 523,
-	579, 574, 575, 598, 569, 594, 595, 548, 572, 580,
-	593, 581, 596, 599, 600, 639, 640, 587, 641, 584,
-	601, 592, 591, 582, 570, 602, 603, 555, 550, 585,
-	586, 573, 588, 551, 552, 553, 554, 351, 565, 0,
-	382, 383, 384, 406, 368, 0, 418, 0, 314, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 521, 0, 0, 0, 260, 1415, 0, 284, 0,
-	0, 0, 556, 0, 0, 343, 298, 0, 0, 0,
-	0, 613, 621, 0, 0, 0, 0, 0, 0, 0,
-	1547, 0, 0, 514, 0, 0, 546, 590, 589, 533,
-	542, 0, 0, 242, 178, 534, 0, 541, 535, 539,
-	538, 536, 537, 0, 605, 0, 0, 0, 0, 0,
-	0, 505, 518, 0, 522, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 515, 516,
-	0, 0, 0, 0, 566, 0, 517, 0, 0, 1548,
-	543, 544, 0, 0, 0, 0, 233, 348, 364, 243,
-	339, 377, 248, 346, 238, 313, 336, 0, 0, 235,
-	362, 345, 295, 278, 279, 234, 0, 331, 258, 271,
-	255, 311, 540, 564, 568, 254, 627, 562, 372, 237,
-	0, 371, 310, 358, 363, 296, 290, 236, 360, 294,
-	289, 282, 262, 628, 275, 322, 288, 323, 276, 300,
-	299, 301, 0, 0, 0, 0, 0, 401, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 559, 0, 0, 0, 374, 0, 0, 611, 0,
-	0, 0, 347, 0, 0, 283, 0, 0, 0, 563,
-	0, 334, 316, 624, 506, 0, 332, 286, 359, 324,
-	365, 349, 373, 328, 325, 228, 350, 257, 297, 239,
-	241, 253, 259, 261, 263, 264, 306, 307, 319, 338,
-	352, 353, 354, 256, 249, 333, 250, 273, 251, 229,
-	340, 252, 231, 320, 357, 0, 269, 329, 293, 232,
-	292, 321, 356, 355, 240, 381, 387, 388, 393, 0,
-	394, 0, 0, 0, 402, 407, 408, 409, 411, 412,
-	413, 414, 0, 0, 0, 0, 396, 0, 0, 0,
-	0, 0, 0, 386, 267, 225, 226, 421, 609, 312,
-	0, 0, 623, 604, 606, 607, 610, 614, 615, 616,
-	617, 618, 620, 622, 626, 420, 0, 0, 0, 0,
-	0, 419, 318, 0, 337, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 344, 367, 379,
-	397, 400, 0, 0, 0, 230, 399, 0, 0, 0,
-	0, 0, 0, 0, 625, 0, 0, 0, 378, 0,
-	0, 0, 0, 0, 567, 302, 303, 304, 305, 612,
-	0, 247, 398, 327, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	391, 392, 266, 272, 410, 274, 246, 317, 268, 376,
-	280, 0, 403, 0, 404, 0, 0, 0, 0, 309,
-	277, 341, 281, 287, 330, 375, 315, 335, 244, 366,
-	342, 291, 0, 0, 634, 608, 633, 635, 636, 632,
-	637, 638, 619, 524, 0, 571, 630, 629, 631, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 227, 0, 285, 0, 326, 265, 597, 576,
-	577, 578, 523, 579, 574, 575, 598, 569, 594, 595,
-	548, 572, 580, 593, 581, 596, 599, 600, 639, 640,
-	587, 641, 584, 601, 592, 591, 582, 570, 602, 603,
-	555, 550, 585, 586, 573, 588, 551, 552, 553, 554,
-	155, 351, 565, 382, 383, 384, 406, 368, 0, 418,
-	0, 0, 314, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 521, 0, 0, 0, 260,
-	0, 0, 284, 0, 0, 0, 959, 0, 0, 343,
-	298, 0, 0, 0, 0, 613, 621, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 514, 0, 0,
-	546, 590, 589, 533, 542, 0, 0, 242, 178, 534,
-	0, 541, 535, 539, 538, 536, 537, 0, 605, 0,
-	0, 0, 0, 0, 0, 505, 518, 0, 522, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 515, 516, 0, 0, 0, 0, 566, 0,
-	517, 0, 0, 561, 543, 544, 0, 0, 0, 0,
-	233, 348, 364, 243, 339, 377, 248, 346, 238, 313,
-	336, 0, 0, 235, 362, 345, 295, 278, 279, 234,
-	0, 331, 258, 271, 255, 311, 540, 564, 568, 254,
-	627, 562, 372, 237, 0, 371, 310, 358, 363, 296,
-	290, 236, 360, 294, 289, 282, 262, 628, 275, 322,
-	288, 323, 276, 300, 299, 301, 0, 0, 0, 0,
-	0, 401, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 559, 0, 0, 0, 374,
-	0, 0, 611, 0, 0, 0, 347, 0, 0, 283,
-	0, 0, 0, 563, 0, 334, 316, 624, 506, 0,
-	332, 286, 359, 324, 365, 349, 373, 328, 325, 228,
-	350, 257, 297, 239, 241, 253, 259, 261, 263, 264,
-	306, 307, 319, 338, 352, 353, 354, 256, 249, 333,
-	250, 273, 251, 229, 340, 252, 231, 320, 357, 0,
-	269, 329, 293, 232, 292, 321, 356, 355, 240, 381,
-	387, 388, 393, 0, 394, 0, 0, 0, 402, 407,
-	408, 409, 411, 412, 413, 414, 0, 0, 0, 0,
-	396, 0, 0, 0, 0, 0, 0, 386, 267, 225,
-	226, 421, 609, 312, 0, 0, 623, 604, 606, 607,
-	610, 614, 615, 616, 617, 618, 620, 622, 626, 420,
-	0, 0, 0, 0, 0, 419, 318, 0, 337, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 344, 367, 379, 397, 400, 0, 0, 0, 230,
-	399, 0, 0, 0, 0, 0, 0, 0, 625, 0,
-	0, 0, 378, 0, 0, 0, 0, 0, 567, 302,
-	303, 304, 305, 612, 0, 247, 398, 327, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 391, 392, 266, 272, 410, 274,
-	246, 317, 268, 376, 280, 0, 403, 0, 404, 0,
-	0, 0, 0, 309, 277, 341, 281, 287, 330, 375,
-	315, 335, 244, 366, 342, 291, 0, 0, 634, 608,
-	633, 635, 636, 632, 637, 638, 619, 524, 0, 571,
-	630, 629, 631, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 227, 0, 285, 125,
-	326, 265, 597, 576, 577, 578, 523, 579, 574, 575,
-	598, 569, 594, 595, 548, 572, 580, 593, 581, 596,
-	599, 600, 639, 640, 587, 641, 584, 601, 592, 591,
-	582, 570, 602, 603, 555, 550, 585, 586, 573, 588,
-	551, 552, 553, 554, 351, 565, 0, 382, 383, 384,
-	406, 368, 0, 418, 0, 314, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 521, 0,
-	0, 0, 260, 2886, 0, 284, 0, 0, 0, 556,
-	0, 0, 343, 298, 0, 0, 0, 0, 613, 621,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	514, 0, 0, 546, 590, 589, 533, 542, 0, 0,
-	242, 178, 534, 0, 541, 535, 539, 538, 536, 537,
-	0, 605, 0, 0, 0, 0, 0, 0, 505, 518,
-	0, 522, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 515, 516, 0, 0, 0,
-	0, 566, 0, 517, 0, 0, 561, 543, 544, 0,
-	0, 0, 0, 233, 348, 364, 243, 339, 377, 248,
-	346, 238, 313, 336, 0, 0, 235, 362, 345, 295,
-	278, 279, 234, 0, 331, 258, 271, 255, 311, 540,
-	564, 568, 254, 627, 562, 372, 237, 0, 371, 310,
-	358, 363, 296, 290, 236, 360, 294, 289, 282, 262,
-	628, 275, 322, 288, 323, 276, 300, 299, 301, 0,
-	0, 0, 0, 0, 401, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 559, 0,
-	0, 0, 374, 0, 0, 611, 0, 0, 0, 347,
-	0, 0, 283, 0, 0, 0, 563, 0, 334, 316,
-	624, 506, 0, 332, 286, 359, 324, 365, 349, 373,
-	328, 325, 228, 350, 257, 297, 239, 241, 253, 259,
-	261, 263, 264, 306, 307, 319, 338, 352, 353, 354,
-	256, 249, 333, 250, 273, 251, 229, 340, 252, 231,
-	320, 357, 0, 269, 329, 293, 232, 292, 321, 356,
-	355, 240, 381, 387, 388, 393, 0, 394, 0, 0,
-	0, 402, 407, 408, 409, 411, 412, 413, 414, 0,
-	0, 0, 0, 396, 0, 0, 0, 0, 0, 0,
-	386, 267, 225, 226, 421, 609, 312, 0, 0, 623,
-	604, 606, 607, 610, 614, 615, 616, 617, 618, 620,
-	622, 626, 420, 0, 0, 0, 0, 0, 419, 318,
-	0, 337, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 344, 367, 379, 397, 400, 0,
-	0, 0, 230, 399, 0, 0, 0, 0, 0, 0,
-	0, 625, 0, 0, 0, 378, 0, 0, 0, 0,
-	0, 567, 302, 303, 304, 305, 612, 0, 247, 398,
-	327, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 391, 392, 266,
-	272, 410, 274, 246, 317, 268, 376, 280, 0, 403,
-	0, 404, 0, 0, 0, 0, 309, 277, 341, 281,
-	287, 330, 375, 315, 335, 244, 366, 342, 291, 0,
-	0, 634, 608, 633, 635, 636, 632, 637, 638, 619,
-	524, 0, 571, 630, 629, 631, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 227,
-	0, 285, 0, 326, 265, 597, 576, 577, 578, 523,
-	579, 574, 575, 598, 569, 594, 595, 548, 572, 580,
-	593, 581, 596, 599, 600, 639, 640, 587, 641, 584,
-	601, 592, 591, 582, 570, 602, 603, 555, 550, 585,
-	586, 573, 588, 551, 552, 553, 554, 351, 565, 0,
-	382, 383, 384, 406, 368, 0, 418, 0, 314, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 521, 0, 0, 0, 260, 1415, 0, 284, 0,
-	0, 0, 556, 0, 0, 343, 298, 0, 0, 0,
-	0, 613, 621, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 514, 0, 0, 546, 590, 589, 533,
-	542, 0, 0, 242, 178, 534, 0, 541, 535, 539,
-	538, 536, 537, 0, 605, 0, 0, 0, 0, 0,
-	0, 505, 518, 0, 522, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 515, 516,
-	0, 0, 0, 0, 566, 0, 517, 0, 0, 561,
-	543, 544, 0, 0, 0, 0, 233, 348, 364, 243,
-	339, 377, 248, 346, 238, 313, 336, 0, 0, 235,
-	362, 345, 295, 278, 279, 234, 0, 331, 258, 271,
-	255, 311, 540, 564, 568, 254, 627, 562, 372, 237,
-	0, 371, 310, 358, 363, 296, 290, 236, 360, 294,
-	289, 282, 262, 628, 275, 322, 288, 323, 276, 300,
-	299, 301, 0, 0, 0, 0, 0, 401, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 559, 0, 0, 0, 374, 0, 0, 611, 0,
-	0, 0, 347, 0, 0, 283, 0, 0, 0, 563,
-	0, 334, 316, 624, 506, 0, 332, 286, 359, 324,
-	365, 349, 373, 328, 325, 228, 350, 257, 297, 239,
-	241, 253, 259, 261, 263, 264, 306, 307, 319, 338,
-	352, 353, 354, 256, 249, 333, 250, 273, 251, 229,
-	340, 252, 231, 320, 357, 0, 269, 329, 293, 232,
-	292, 321, 356, 355, 240, 381, 387, 388, 393, 0,
-	394, 0, 0, 0, 402, 407, 408, 409, 411, 412,
-	413, 414, 0, 0, 0, 0, 396, 0, 0, 0,
-	0, 0, 0, 386, 267, 225, 226, 421, 609, 312,
-	0, 0, 623, 604, 606, 607, 610, 614, 615, 616,
-	617, 618, 620, 622, 626, 420, 0, 0, 0, 0,
-	0, 419, 318, 0, 337, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 344, 367, 379,
-	397, 400, 0, 0, 0, 230, 399, 0, 0, 0,
-	0, 0, 0, 0, 625, 0, 0, 0, 378, 0,
-	0, 0, 0, 0, 567, 302, 303, 304, 305, 612,
-	0, 247, 398, 327, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	391, 392, 266, 272, 410, 274, 246, 317, 268, 376,
-	280, 0, 403, 0, 404, 0, 0, 0, 0, 309,
-	277, 341, 281, 287, 330, 375, 315, 335, 244, 366,
-	342, 291, 0, 0, 634, 608, 633, 635, 636, 632,
-	637, 638, 619, 524, 0, 571, 630, 629, 631, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 227, 0, 285, 0, 326, 265, 597, 576,
-	577, 578, 523, 579, 574, 575, 598, 569, 594, 595,
-	548, 572, 580, 593, 581, 596, 599, 600, 639, 640,
-	587, 641, 584, 601, 592, 591, 582, 570, 602, 603,
-	555, 550, 585, 586, 573, 588, 551, 552, 553, 554,
-	351, 565, 0, 382, 383, 384, 406, 368, 0, 418,
-	0, 314, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 521, 0, 0, 0, 260, 0,
-	0, 284, 0, 0, 0, 556, 0, 0, 343, 298,
-	0, 0, 0, 0, 613, 621, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 514, 0, 0, 546,
-	590, 589, 533, 542, 0, 0, 242, 178, 534, 0,
-	541, 535, 539, 538, 536, 537, 0, 605, 0, 0,
-	0, 0, 0, 0, 505, 518, 0, 522, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 515, 516, 1173, 0, 0, 0, 566, 0, 517,
-	0, 0, 561, 543, 544, 0, 0, 0, 0, 233,
-	348, 364, 243, 339, 377, 248, 346, 238, 313, 336,
-	0, 0, 235, 362, 345, 295, 278, 279, 234, 0,
-	331, 258, 271, 255, 311, 540, 564, 568, 254, 627,
-	562, 372, 237, 0, 371, 310, 358, 363, 296, 290,
-	236, 360, 294, 289, 282, 262, 628, 275, 322, 288,
-	323, 276, 300, 299, 301, 0, 0, 0, 0, 0,
-	401, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 559, 0, 0, 0, 374, 0,
-	0, 611, 0, 0, 0, 347, 0, 0, 283, 0,
-	0, 0, 563, 0, 334, 316, 624, 506, 0, 332,
-	286, 359, 324, 365, 349, 373, 328, 325, 228, 350,
-	257, 297, 239, 241, 253, 259, 261, 263, 264, 306,
-	307, 319, 338, 352, 353, 354, 256, 249, 333, 250,
-	273, 251, 229, 340, 252, 231, 320, 357, 0, 269,
-	329, 293, 232, 292, 321, 356, 355, 240, 381, 387,
-	388, 393, 0, 394, 0, 0, 0, 402, 407, 408,
-	409, 411, 412, 413, 414, 0, 0, 0, 0, 396,
-	0, 0, 0, 0, 0, 0, 386, 267, 225, 226,
-	421, 609, 312, 0, 0, 623, 604, 606, 607, 610,
-	614, 615, 616, 617, 618, 620, 622, 626, 420, 0,
-	0, 0, 0, 0, 419, 318, 0, 337, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	344, 367, 379, 397, 400, 0, 0, 0, 230, 399,
-	0, 0, 0, 0, 0, 0, 0, 625, 0, 0,
-	0, 378, 0, 0, 0, 0, 0, 567, 302, 303,
-	304, 305, 612, 0, 247, 398, 327, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 391, 392, 266, 272, 410, 274, 246,
-	317, 268, 376, 280, 0, 403, 0, 404, 0, 0,
-	0, 0, 309, 277, 341, 281, 287, 330, 375, 315,
-	335, 244, 366, 342, 291, 0, 0, 634, 608, 633,
-	635, 636, 632, 637, 638, 619, 524, 0, 571, 630,
-	629, 631, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 227, 0, 285, 0, 326,
-	265, 597, 576, 577, 578, 523, 579, 574, 575, 598,
-	569, 594, 595, 548, 572, 580, 593, 581, 596, 599,
-	600, 639, 640, 587, 641, 584, 601, 592, 591, 582,
-	570, 602, 603, 555, 550, 585, 586, 573, 588, 551,
-	552, 553, 554, 0, 0, 0, 382, 383, 384, 406,
-	368, 0, 418, 351, 565, 0, 0, 1693, 0, 0,
-	0, 0, 0, 0, 314, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 521, 0, 0,
-	0, 260, 0, 0, 284, 0, 0, 0, 556, 0,
-	0, 343, 298, 0, 0, 0, 0, 613, 621, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 514,
-	0, 0, 546, 590, 589, 533, 542, 0, 0, 242,
-	178, 534, 0, 541, 535, 539, 538, 536, 537, 0,
-	605, 0, 0, 0, 0, 0, 0, 505, 518, 0,
-	522, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 515, 516, 0, 0, 0, 0,
-	566, 0, 517, 0, 0, 561, 543, 544, 0, 0,
-	0, 0, 233, 348, 364, 243, 339, 377, 248, 346,
-	238, 313, 336, 0, 0, 235, 362, 345, 295, 278,
-	279, 234, 0, 331, 258, 271, 255, 311, 540, 564,
-	568, 254, 627, 562, 372, 237, 0, 371, 310, 358,
-	363, 296, 290, 236, 360, 294, 289, 282, 262, 628,
-	275, 322, 288, 323, 276, 300, 299, 301, 0, 0,
-	0, 0, 0, 401, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 559, 0, 0,
-	0, 374, 0, 0, 611, 0, 0, 0, 347, 0,
-	0, 283, 0, 0, 0, 563, 0, 334, 316, 624,
-	506, 0, 332, 286, 359, 324, 365, 349, 373, 328,
-	325, 228, 350, 257, 297, 239, 241, 253, 259, 261,
-	263, 264, 306, 307, 319, 338, 352, 353, 354, 256,
-	249, 333, 250, 273, 251, 229, 340, 252, 231, 320,
-	357, 0, 269, 329, 293, 232, 292, 321, 356, 355,
-	240, 381, 387, 388, 393, 0, 394, 0, 0, 0,
-	402, 407, 408, 409, 411, 412, 413, 414, 0, 0,
-	0, 0, 396, 0, 0, 0, 0, 0, 0, 386,
-	267, 225, 226, 421, 609, 312, 0, 0, 623, 604,
-	606, 607, 610, 614, 615, 616, 617, 618, 620, 622,
-	626, 420, 0, 0, 0, 0, 0, 419, 318, 0,
-	337, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 344, 367, 379, 397, 400, 0, 0,
-	0, 230, 399, 0, 0, 0, 0, 0, 0, 0,
-	625, 0, 0, 0, 378, 0, 0, 0, 0, 0,
-	567, 302, 303, 304, 305, 612, 0, 247, 398, 327,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 391, 392, 266, 272,
-	410, 274, 246, 317, 268, 376, 280, 0, 403, 0,
-	404, 0, 0, 0, 0, 309, 277, 341, 281, 287,
-	330, 375, 315, 335, 244, 366, 342, 291, 0, 0,
-	634, 608, 633, 635, 636, 632, 637, 638, 619, 524,
-	0, 571, 630, 629, 631, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 227, 0,
-	285, 0, 326, 265, 597, 576, 577, 578, 523, 579,
-	574, 575, 598, 569, 594, 595, 548, 572, 580, 593,
-	581, 596, 599, 600, 639, 640, 587, 641, 584, 601,
-	592, 591, 582, 570, 602, 603, 555, 550, 585, 586,
-	573, 588, 551, 552, 553, 554, 351, 565, 0, 382,
-	383, 384, 406, 368, 0, 418, 0, 314, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	521, 0, 0, 0, 260, 0, 0, 284, 0, 0,
-	0, 556, 0, 0, 343, 298, 0, 0, 0, 0,
-	613, 621, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 514, 0, 0, 546, 590, 589, 533, 542,
-	0, 0, 242, 178, 534, 0, 541, 535, 539, 538,
-	536, 537, 0, 605, 0, 0, 0, 0, 0, 0,
-	505, 518, 0, 522, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 515, 516, 0,
-	0, 0, 0, 566, 0, 517, 0, 0, 561, 543,
-	544, 0, 0, 0, 0, 233, 348, 364, 243, 339,
-	377, 248, 346, 238, 313, 336, 0, 0, 235, 362,
-	345, 295, 278, 279, 234, 0, 331, 258, 271, 255,
-	311, 540, 564, 568, 254, 627, 562, 372, 237, 0,
-	371, 310, 358, 363, 296, 290, 236, 360, 294, 289,
-	282, 262, 628, 275, 322, 288, 323, 276, 300, 299,
-	301, 0, 0, 0, 0, 0, 401, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	559, 0, 0, 0, 374, 0, 0, 611, 0, 0,
-	0, 347, 0, 0, 283, 0, 0, 0, 563, 0,
-	334, 316, 624, 506, 0, 332, 286, 359, 324, 365,
-	349, 373, 328, 325, 228, 350, 257, 297, 239, 241,
-	253, 259, 261, 263, 264, 306, 307, 319, 338, 352,
-	353, 354, 256, 249, 333, 250, 273, 251, 229, 340,
-	252, 231, 320, 357, 0, 269, 329, 293, 232, 292,
-	321, 356, 355, 240, 381, 387, 388, 393, 0, 394,
-	0, 0, 0, 402, 407, 408, 409, 411, 412, 413,
-	414, 0, 0, 0, 0, 396, 0, 0, 0, 0,
-	0, 0, 386, 267, 225, 226, 421, 609, 312, 0,
-	0, 623, 604, 606, 607, 610, 614, 615, 616, 617,
-	618, 620, 622, 626, 420, 0, 0, 0, 0, 0,
-	419, 318, 0, 337, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 344, 367, 379, 397,
-	400, 0, 0, 0, 230, 399, 0, 0, 0, 0,
-	0, 0, 0, 625, 0, 0, 0, 378, 0, 0,
-	0, 0, 0, 567, 302, 303, 304, 305, 612, 0,
-	247, 398, 327, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 391,
-	392, 266, 272, 410, 274, 246, 317, 268, 376, 280,
-	0, 403, 0, 404, 0, 0, 0, 0, 309, 277,
-	341, 281, 287, 330, 375, 315, 335, 244, 366, 342,
-	291, 0, 0, 634, 608, 633, 635, 636, 632, 637,
-	638, 619, 524, 0, 571, 630, 629, 631, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 227, 0, 285, 0, 326, 265, 597, 576, 577,
-	578, 523, 579, 574, 575, 598, 569, 594, 595, 548,
-	572, 580, 593, 581, 596, 599, 600, 639, 640, 587,
-	641, 584, 601, 592, 591, 582, 570, 602, 603, 555,
-	550, 585, 586, 573, 588, 551, 552, 553, 554, 351,
-	565, 0, 382, 383, 384, 406, 368, 0, 418, 0,
-	314, 0, 0, 0, 0, 0, 0, 0, 0, 1296,
-	0, 0, 0, 521, 0, 0, 0, 260, 0, 0,
-	284, 0, 0, 0, 556, 0, 0, 343, 298, 0,
-	0, 0, 0, 613, 621, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 514, 0, 0, 546, 590,
-	589, 533, 542, 0, 0, 242, 178, 534, 0, 541,
-	535, 539, 538, 536, 537, 0, 605, 0, 0, 0,
-	0, 0, 0, 0, 518, 0, 522, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	515, 516, 0, 0, 0, 0, 566, 0, 517, 0,
-	0, 561, 543, 544, 0, 0, 0, 0, 233, 348,
-	364, 243, 339, 377, 248, 346, 238, 313, 336, 0,
-	0, 235, 362, 345, 295, 278, 279, 234, 0, 331,
-	258, 271, 255, 311, 540, 564, 568, 254, 627, 562,
-	372, 237, 0, 371, 310, 358, 363, 296, 290, 236,
-	360, 294, 289, 282, 262, 628, 275, 322, 288, 323,
-	276, 300, 299, 301, 0, 0, 0, 0, 0, 401,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 559, 0, 0, 0, 374, 0, 0,
-	611, 0, 0, 0, 347, 0, 0, 283, 0, 0,
-	0, 563, 0, 334, 316, 624, 0, 0, 332, 286,
-	359, 324, 365, 349, 373, 328, 325, 228, 350, 257,
-	297, 239, 241, 253, 259, 261, 263, 264, 306, 307,
-	319, 338, 352, 353, 354, 256, 249, 333, 250, 273,
-	251, 229, 340, 252, 231, 320, 357, 0, 269, 329,
-	293, 232, 292, 321, 356, 355, 240, 381, 1297, 1298,
-	393, 0, 394, 0, 0, 0, 402, 407, 408, 409,
-	411, 412, 413, 414, 0, 0, 0, 0, 396, 0,
-	0, 0, 0, 0, 0, 386, 267, 225, 226, 421,
-	609, 312, 0, 0, 623, 604, 606, 607, 610, 614,
-	615, 616, 617, 618, 620, 622, 626, 420, 0, 0,
-	0, 0, 0, 419, 318, 0, 337, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 344,
-	367, 379, 397, 400, 0, 0, 0, 230, 399, 0,
-	0, 0, 0, 0, 0, 0, 625, 0, 0, 0,
-	378, 0, 0, 0, 0, 0, 567, 302, 303, 304,
-	305, 612, 0, 247, 398, 327, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 391, 392, 266, 272, 410, 274, 246, 317,
-	268, 376, 280, 0, 403, 0, 404, 0, 0, 0,
-	0, 309, 277, 341, 281, 287, 330, 375, 315, 335,
-	244, 366, 342, 291, 0, 0, 634, 608, 633, 635,
-	636, 632, 637, 638, 619, 524, 0, 571, 630, 629,
-	631, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 227, 0, 285, 0, 326, 265,
-	597, 576, 577, 578, 523, 579, 574, 575, 598, 569,
-	594, 595, 548, 572, 580, 593, 581, 596, 599, 600,
-	639, 640, 587, 641, 584, 601, 592, 591, 582, 570,
-	602, 603, 555, 550, 585, 586, 573, 588, 551, 552,
-	553, 554, 351, 565, 0, 382, 383, 384, 406, 368,
-	0, 418, 0, 314, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 521, 0, 0, 0,
-	260, 0, 0, 284, 0, 0, 0, 556, 0, 0,
-	343, 298, 0, 0, 0, 0, 613, 621, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 546, 590, 589, 533, 542, 0, 0, 242, 178,
-	534, 0, 541, 535, 539, 538, 536, 537, 0, 605,
-	0, 0, 0, 0, 0, 0, 505, 518, 0, 522,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 515, 516, 0, 0, 0, 0, 566,
-	0, 517, 0, 0, 561, 543, 544, 0, 0, 0,
-	0, 233, 348, 364, 243, 339, 377, 248, 346, 238,
-	313, 336, 0, 0, 235, 362, 345, 295, 278, 279,
-	234, 0, 331, 258, 271, 255, 311, 540, 564, 568,
-	254, 627, 562, 372, 237, 0, 371, 310, 358, 363,
-	296, 290, 236, 360, 294, 289, 282, 262, 628, 275,
-	322, 288, 323, 276, 300, 299, 301, 0, 0, 0,
-	0, 0, 401, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 559, 0, 0, 0,
-	374, 0, 0, 611, 0, 0, 0, 347, 0, 0,
-	283, 0, 0, 0, 563, 0, 334, 316, 624, 506,
-	0, 332, 286, 359, 324, 365, 349, 373, 328, 325,
-	228, 350, 257, 297, 239, 241, 253, 259, 261, 263,
-	264, 306, 307, 319, 338, 352, 353, 354, 256, 249,
-	333, 250, 273, 251, 229, 340, 252, 231, 320, 357,
-	0, 269, 329, 293, 232, 292, 321, 356, 355, 240,
-	381, 387, 388, 393, 0, 394, 0, 0, 0, 402,
-	407, 408, 409, 411, 412, 413, 414, 0, 0, 0,
-	0, 396, 0, 0, 0, 0, 0, 0, 386, 267,
-	225, 226, 421, 609, 312, 0, 0, 623, 604, 606,
-	607, 610, 614, 615, 616, 617, 618, 620, 622, 626,
-	420, 0, 0, 0, 0, 0, 419, 318, 0, 337,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 344, 367, 379, 397, 400, 0, 0, 0,
-	230, 399, 0, 0, 0, 0, 0, 0, 0, 625,
-	0, 0, 0, 378, 0, 0, 0, 0, 0, 567,
-	302, 303, 304, 305, 612, 0, 247, 398, 327, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 391, 392, 266, 272, 410,
-	274, 246, 317, 268, 376, 280, 0, 403, 0, 404,
-	0, 0, 0, 0, 309, 277, 341, 281, 287, 330,
-	375, 315, 335, 244, 366, 342, 291, 0, 0, 634,
-	608, 633, 635, 636, 632, 637, 638, 619, 524, 0,
-	571, 630, 629, 631, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 227, 0, 285,
-	0, 326, 265, 597, 576, 577, 578, 523, 579, 574,
-	575, 598, 569, 594, 595, 548, 572, 580, 593, 581,
-	596, 599, 600, 639, 640, 587, 641, 584, 601, 592,
-	591, 582, 570, 602, 603, 555, 550, 585, 586, 573,
-	588, 551, 552, 553, 554, 351, 565, 0, 382, 383,
-	384, 406, 368, 0, 418, 0, 314, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 521,
-	0, 0, 0, 260, 0, 0, 284, 0, 0, 0,
-	556, 0, 0, 343, 298, 0, 0, 0, 0, 613,
-	621, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 514, 0, 0, 546, 590, 589, 533, 542, 0,
-	0, 242, 178, 534, 0, 541, 535, 539, 538, 536,
-	537, 0, 605, 0, 0, 0, 0, 0, 0, 0,
-	518, 0, 522, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 515, 516, 0, 0,
-	0, 0, 566, 0, 517, 0, 0, 561, 543, 544,
-	0, 0, 0, 0, 233, 348, 364, 243, 339, 377,
-	248, 346, 238, 313, 336, 0, 0, 235, 362, 345,
-	295, 278, 279, 234, 0, 331, 258, 271, 255, 311,
-	540, 564, 568, 254, 627, 562, 372, 237, 0, 371,
-	310, 358, 363, 296, 290, 236, 360, 294, 289, 282,
-	262, 628, 275, 322, 288, 323, 276, 300, 299, 301,
-	0, 0, 0, 0, 0, 401, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 559,
-	0, 0, 0, 374, 0, 0, 611, 0, 0, 0,
-	347, 0, 0, 283, 0, 0, 0, 563, 0, 334,
-	316, 624, 0, 0, 332, 286, 359, 324, 365, 349,
-	373, 328, 325, 228, 350, 257, 297, 239, 241, 253,
-	259, 261, 263, 264, 306, 307, 319, 338, 352, 353,
-	354, 256, 249, 333, 250, 273, 251, 229, 340, 252,
-	231, 320, 357, 0, 269, 329, 293, 232, 292, 321,
-	356, 355, 240, 381, 387, 388, 393, 0, 394, 0,
-	0, 0, 402, 407, 408, 409, 411, 412, 413, 414,
-	0, 0, 0, 0, 396, 0, 0, 0, 0, 0,
-	0, 386, 267, 225, 226, 421, 609, 312, 0, 0,
-	623, 604, 606, 607, 610, 614, 615, 616, 617, 618,
-	620, 622, 626, 420, 0, 0, 0, 0, 0, 419,
-	318, 0, 337, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 344, 367, 379, 397, 400,
-	0, 0, 0, 230, 399, 0, 0, 0, 0, 0,
-	0, 0, 625, 0, 0, 0, 378, 0, 0, 0,
-	0, 0, 567, 302, 303, 304, 305, 612, 0, 247,
-	398, 327, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 391, 392,
-	266, 272, 410, 274, 246, 317, 268, 376, 280, 0,
-	403, 0, 404, 0, 0, 0, 0, 309, 277, 341,
-	281, 287, 330, 375, 315, 335, 244, 366, 342, 291,
-	0, 0, 634, 608, 633, 635, 636, 632, 637, 638,
-	619, 524, 0, 571, 630, 629, 631, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	227, 0, 285, 0, 326, 265, 597, 576, 577, 578,
-	523, 579, 574, 575, 598, 569, 594, 595, 548, 572,
-	580, 593, 581, 596, 599, 600, 639, 640, 587, 641,
-	584, 601, 592, 591, 582, 570, 602, 603, 555, 550,
-	585, 586, 573, 588, 551, 552, 553, 554, 0, 0,
-	0, 382, 383, 384, 406, 368, 0, 418, 155, 351,
-	49, 147, 124, 0, 0, 0, 0, 0, 0, 0,
-	314, 0, 0, 0, 0, 0, 0, 0, 148, 0,
-	0, 0, 0, 0, 0, 140, 0, 260, 0, 149,
-	284, 0, 0, 0, 107, 0, 0, 343, 298, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 96,
-	0, 0, 0, 0, 0, 152, 0, 0, 177, 0,
-	0, 0, 0, 0, 0, 242, 178, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 245, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 169, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 233, 348,
-	364, 243, 339, 377, 248, 346, 238, 313, 336, 0,
-	0, 235, 362, 345, 295, 278, 279, 234, 0, 331,
-	258, 271, 255, 311, 0, 361, 389, 254, 380, 0,
-	372, 237, 0, 371, 310, 358, 363, 296, 290, 236,
-	360, 294, 289, 282, 262, 405, 275, 322, 288, 323,
-	276, 300, 299, 301, 0, 0, 0, 0, 0, 401,
-	0, 0, 0, 0, 0, 0, 123, 146, 153, 0,
-	94, 0, 0, 0, 0, 0, 0, 374, 0, 0,
-	170, 0, 0, 0, 347, 0, 0, 283, 145, 139,
-	138, 390, 0, 334, 316, 55, 0, 0, 332, 286,
-	359, 324, 365, 349, 373, 328, 325, 228, 350, 257,
-	297, 239, 241, 253, 259, 261, 263, 264, 306, 307,
-	319, 338, 352, 353, 354, 256, 249, 333, 250, 273,
-	251, 229, 340, 252, 231, 320, 357, 0, 269, 329,
-	293, 232, 292, 321, 356, 355, 240, 381, 387, 388,
-	393, 0, 394, 141, 142, 143, 402, 407, 408, 409,
-	411, 412, 413, 414, 0, 0, 0, 0, 396, 0,
-	0, 0, 0, 0, 0, 386, 267, 225, 226, 369,
-	0, 312, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 308, 385, 173, 0, 0, 0, 181, 0, 0,
-	0, 144, 0, 182, 318, 0, 337, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 344,
-	367, 379, 397, 400, 0, 0, 0, 230, 399, 0,
-	0, 0, 0, 0, 0, 0, 370, 0, 0, 0,
-	378, 0, 0, 0, 0, 0, 395, 302, 303, 304,
-	305, 270, 0, 247, 398, 327, 105, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 48, 0, 0, 0,
-	0, 0, 391, 392, 266, 272, 410, 274, 246, 317,
-	268, 376, 280, 0, 403, 0, 404, 0, 0, 0,
-	0, 309, 277, 341, 281, 287, 330, 375, 315, 335,
-	244, 366, 342, 291, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 50, 0, 0, 220, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 227, 0, 285, 125, 326, 265,
-	184, 185, 186, 187, 188, 189, 190, 191, 192, 193,
-	194, 195, 196, 197, 198, 199, 200, 201, 202, 203,
-	204, 205, 0, 206, 207, 208, 209, 210, 211, 212,
-	213, 214, 215, 216, 217, 218, 219, 0, 221, 222,
-	223, 224, 0, 0, 0, 382, 383, 384, 406, 368,
-	351, 183, 38, 171, 174, 176, 175, 0, 47, 5,
-	0, 314, 110, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 260, 0,
-	0, 284, 0, 0, 0, 0, 0, 0, 343, 298,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 990, 0, 0, 177,
-	0, 0, 533, 542, 0, 0, 242, 178, 534, 0,
-	541, 535, 539, 538, 536, 537, 0, 245, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 543, 0, 0, 0, 0, 0, 233,
-	348, 364, 243, 339, 377, 248, 346, 238, 313, 336,
-	0, 0, 235, 362, 345, 295, 278, 279, 234, 0,
-	331, 258, 271, 255, 311, 540, 361, 389, 254, 380,
-	0, 372, 237, 0, 371, 310, 358, 363, 296, 290,
-	236, 360, 294, 289, 282, 262, 405, 275, 322, 288,
-	323, 276, 300, 299, 301, 0, 0, 0, 0, 0,
-	401, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 374, 0,
-	0, 0, 0, 0, 0, 347, 0, 0, 283, 0,
-	0, 0, 390, 0, 334, 316, 0, 0, 0, 332,
-	286, 359, 324, 365, 349, 373, 328, 325, 228, 350,
-	257, 297, 239, 241, 253, 259, 261, 263, 264, 306,
-	307, 319, 338, 352, 353, 354, 256, 249, 333, 250,
-	273, 251, 229, 340, 252, 231, 320, 357, 0, 269,
-	329, 293, 232, 292, 321, 356, 355, 240, 381, 387,
-	388, 393, 0, 394, 0, 0, 0, 402, 407, 408,
-	409, 411, 412, 413, 414, 0, 0, 0, 0, 396,
-	0, 0, 0, 0, 0, 0, 386, 267, 225, 226,
-	421, 0, 312, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 308, 385, 0, 0, 0, 0, 420, 0,
-	0, 0, 0, 0, 419, 318, 0, 337, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	344, 367, 379, 397, 400, 0, 0, 0, 230, 399,
-	0, 0, 0, 0, 0, 0, 0, 370, 0, 0,
-	0, 378, 0, 0, 0, 0, 0, 395, 302, 303,
-	304, 305, 270, 0, 247, 398, 327, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 391, 392, 266, 272, 410, 274, 246,
-	317, 268, 376, 280, 0, 403, 0, 404, 0, 0,
-	0, 0, 309, 277, 341, 281, 287, 330, 375, 315,
-	335, 244, 366, 342, 291, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 220, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 227, 0, 285, 0, 326,
-	265, 184, 185, 186, 187, 188, 189, 190, 191, 192,
-	193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
-	203, 204, 205, 0, 206, 207, 208, 209, 210, 211,
-	212, 213, 214, 215, 216, 217, 218, 219, 0, 221,
-	222, 223, 224, 0, 0, 0, 382, 383, 384, 406,
-	368, 0, 418, 155, 351, 49, 147, 124, 0, 0,
-	0, 0, 0, 0, 0, 314, 438, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 260, 0, 0, 284, 0, 0, 0, 0,
-	0, 0, 343, 298, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	443, 0, 0, 177, 0, 0, 0, 0, 0, 0,
-	242, 178, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 245, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 233, 348, 364, 243, 339, 377, 248,
-	346, 238, 313, 336, 0, 0, 235, 362, 345, 295,
-	278, 279, 234, 0, 331, 258, 271, 255, 311, 0,
-	361, 389, 254, 380, 0, 372, 237, 0, 371, 310,
-	358, 363, 296, 290, 236, 360, 294, 289, 282, 262,
-	405, 275, 322, 288, 323, 276, 300, 299, 301, 0,
-	0, 0, 0, 0, 401, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 442, 0, 0, 0, 0,
-	0, 0, 374, 0, 0, 0, 0, 0, 0, 347,
-	0, 0, 283, 0, 0, 0, 390, 0, 334, 316,
-	0, 0, 0, 332, 286, 359, 324, 365, 349, 373,
-	328, 325, 228, 350, 257, 297, 239, 241, 253, 259,
-	261, 263, 264, 306, 307, 319, 338, 352, 353, 354,
-	256, 249, 333, 250, 273, 251, 229, 340, 252, 231,
-	320, 357, 0, 269, 329, 293, 232, 292, 321, 356,
-	355, 240, 381, 387, 388, 393, 0, 394, 0, 0,
-	0, 402, 407, 408, 409, 411, 412, 413, 414, 0,
-	0, 0, 0, 396, 0, 0, 0, 0, 0, 0,
-	386, 267, 225, 226, 421, 0, 312, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 308, 385, 0, 0,
-	0, 0, 420, 0, 0, 0, 0, 0, 419, 318,
-	0, 337, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 344, 367, 379, 397, 400, 0,
-	0, 0, 230, 399, 0, 0, 0, 0, 0, 0,
-	0, 370, 0, 0, 0, 378, 0, 0, 0, 0,
-	0, 395, 302, 303, 304, 305, 439, 441, 247, 398,
-	327, 451, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 391, 392, 266,
-	272, 410, 274, 246, 317, 268, 376, 280, 0, 403,
-	0, 404, 0, 0, 0, 0, 309, 277, 341, 281,
-	287, 330, 375, 315, 335, 244, 366, 342, 291, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 50,
-	0, 0, 220, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 227,
-	0, 285, 125, 326, 265, 184, 185, 186, 187, 188,
-	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
-	199, 200, 201, 202, 203, 204, 205, 0, 206, 207,
-	208, 209, 210, 211, 212, 213, 214, 215, 216, 217,
-	218, 219, 0, 221, 222, 223, 224, 351, 0, 0,
-	382, 383, 384, 406, 368, 0, 418, 0, 314, 0,
-	0, 0, 0, 0, 0, 0, 820, 0, 0, 0,
-	0, 0, 0, 0, 0, 260, 0, 0, 284, 0,
-	0, 0, 0, 0, 0, 343, 298, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 177, 0, 0, 0,
-	0, 0, 0, 242, 178, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 245, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 808,
-	0, 0, 0, 0, 0, 0, 233, 348, 364, 243,
-	339, 377, 248, 346, 238, 313, 336, 0, 0, 1772,
-	1774, 1775, 1776, 1777, 1778, 1779, 0, 1783, 1780, 1781,
-	1782, 311, 0, 1767, 1768, 1769, 1770, 806, 1753, 1773,
-	0, 1754, 310, 1755, 1756, 1757, 1758, 1759, 1760, 1761,
-	1762, 1763, 1764, 1765, 1771, 322, 288, 323, 276, 300,
-	299, 301, 831, 833, 835, 837, 840, 401, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 374, 0, 0, 0, 0,
-	0, 0, 347, 0, 0, 283, 0, 0, 0, 1766,
-	0, 334, 316, 0, 0, 0, 332, 286, 359, 324,
-	365, 349, 373, 328, 325, 228, 350, 257, 297, 239,
-	241, 253, 259, 261, 263, 264, 306, 307, 319, 338,
-	352, 353, 354, 256, 249, 333, 250, 273, 251, 229,
-	340, 252, 231, 320, 357, 0, 269, 329, 293, 232,
-	292, 321, 356, 355, 240, 381, 387, 388, 393, 0,
-	394, 0, 0, 0, 402, 407, 408, 409, 411, 412,
-	413, 414, 0, 0, 0, 0, 396, 0, 0, 0,
-	0, 0, 0, 386, 267, 225, 226, 421, 0, 312,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 308,
-	385, 0, 0, 0, 0, 420, 0, 0, 0, 0,
-	0, 419, 318, 0, 337, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 344, 367, 379,
-	397, 400, 0, 0, 0, 230, 399, 0, 0, 0,
-	0, 0, 0, 0, 370, 0, 0, 0, 378, 0,
-	0, 0, 0, 0, 395, 302, 303, 304, 305, 270,
-	0, 247, 398, 327, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	391, 392, 266, 272, 410, 274, 246, 317, 268, 376,
-	280, 0, 403, 0, 404, 0, 0, 0, 0, 309,
-	277, 341, 281, 287, 330, 375, 315, 335, 244, 366,
-	342, 291, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 220, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 227, 830, 285, 0, 326, 265, 184, 185,
-	186, 187, 188, 189, 190, 191, 192, 193, 194, 195,
-	196, 197, 198, 199, 200, 201, 202, 203, 204, 205,
-	0, 206, 207, 208, 209, 210, 211, 212, 213, 214,
-	215, 216, 217, 218, 219, 0, 221, 222, 223, 224,
-	351, 0, 0, 382, 383, 384, 406, 368, 0, 418,
-	0, 314, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 260, 0,
-	0, 284, 0, 0, 0, 0, 0, 0, 343, 298,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 177,
-	0, 0, 0, 0, 0, 0, 242, 178, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 245, 1839, 1842,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 233,
-	348, 364, 243, 339, 377, 248, 346, 238, 313, 336,
-	0, 0, 235, 362, 345, 295, 278, 279, 234, 0,
-	331, 258, 271, 255, 311, 0, 361, 389, 254, 380,
-	0, 372, 237, 0, 371, 310, 358, 363, 296, 290,
-	236, 360, 294, 289, 282, 262, 405, 275, 322, 288,
-	323, 276, 300, 299, 301, 0, 0, 0, 0, 0,
-	401, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1843, 374, 0,
-	0, 0, 1838, 0, 1837, 347, 1835, 1840, 283, 0,
-	0, 0, 390, 0, 334, 316, 0, 0, 0, 332,
-	286, 359, 324, 365, 349, 373, 328, 325, 228, 350,
-	257, 297, 239, 241, 253, 259, 261, 263, 264, 306,
-	307, 319, 338, 352, 353, 354, 256, 249, 333, 250,
-	273, 251, 229, 340, 252, 231, 320, 357, 1841, 269,
-	329, 293, 232, 292, 321, 356, 355, 240, 381, 387,
-	388, 393, 0, 394, 0, 0, 0, 402, 407, 408,
-	409, 411, 412, 413, 414, 0, 0, 0, 0, 396,
-	0, 0, 0, 0, 0, 0, 386, 267, 225, 226,
-	421, 0, 312, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 308, 385, 0, 0, 0, 0, 420, 0,
-	0, 0, 0, 0, 419, 318, 0, 337, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	344, 367, 379, 397, 400, 0, 0, 0, 230, 399,
-	0, 0, 0, 0, 0, 0, 0, 370, 0, 0,
-	0, 378, 0, 0, 0, 0, 0, 395, 302, 303,
-	304, 305, 270, 0, 247, 398, 327, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 391, 392, 266, 272, 410, 274, 246,
-	317, 268, 376, 280, 0, 403, 0, 404, 0, 0,
-	0, 0, 309, 277, 341, 281, 287, 330, 375, 315,
-	335, 244, 366, 342, 291, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 220, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 227, 0, 285, 0, 326,
-	265, 184, 185, 186, 187, 188, 189, 190, 191, 192,
-	193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
-	203, 204, 205, 0, 206, 207, 208, 209, 210, 211,
-	212, 213, 214, 215, 216, 217, 218, 219, 0, 221,
-	222, 223, 224, 351, 0, 0, 382, 383, 384, 406,
-	368, 0, 418, 0, 314, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1580, 0, 0, 0,
-	0, 260, 0, 0, 284, 0, 0, 0, 0, 0,
-	0, 343, 298, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 177, 0, 0, 1581, 0, 0, 0, 242,
-	178, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	245, 0, 0, 925, 926, 927, 924, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 233, 348, 364, 243, 339, 377, 248, 346,
-	238, 313, 336, 0, 0, 235, 362, 345, 295, 278,
-	279, 234, 0, 331, 258, 271, 255, 311, 0, 361,
-	389, 254, 380, 0, 372, 237, 0, 371, 310, 358,
-	363, 296, 290, 236, 360, 294, 289, 282, 262, 405,
-	275, 322, 288, 323, 276, 300, 299, 301, 0, 0,
-	0, 0, 0, 401, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 374, 0, 0, 0, 0, 0, 0, 347, 0,
-	0, 283, 0, 0, 0, 390, 0, 334, 316, 0,
-	0, 0, 332, 286, 359, 324, 365, 349, 373, 328,
-	325, 228, 350, 257, 297, 239, 241, 253, 259, 261,
-	263, 264, 306, 307, 319, 338, 352, 353, 354, 256,
-	249, 333, 250, 273, 251, 229, 340, 252, 231, 320,
-	357, 0, 269, 329, 293, 232, 292, 321, 356, 355,
-	240, 381, 387, 388, 393, 0, 394, 0, 0, 0,
-	402, 407, 408, 409, 411, 412, 413, 414, 0, 0,
-	0, 0, 396, 0, 0, 0, 0, 0, 0, 386,
-	267, 225, 226, 421, 0, 312, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 308, 385, 0, 0, 0,
-	0, 420, 0, 0, 0, 0, 0, 419, 318, 0,
-	337, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 344, 367, 379, 397, 400, 0, 0,
-	0, 230, 399, 0, 0, 0, 0, 0, 0, 0,
-	370, 0, 0, 0, 378, 0, 0, 0, 0, 0,
-	395, 302, 303, 304, 305, 270, 0, 247, 398, 327,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 391, 392, 266, 272,
-	410, 274, 246, 317, 268, 376, 280, 0, 403, 0,
-	404, 0, 0, 0, 0, 309, 277, 341, 281, 287,
-	330, 375, 315, 335, 244, 366, 342, 291, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 220, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 227, 0,
-	285, 0, 326, 265, 184, 185, 186, 187, 188, 189,
-	190, 191, 192, 193, 194, 195, 196, 197, 198, 199,
-	200, 201, 202, 203, 204, 205, 0, 206, 207, 208,
-	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
-	219, 0, 221, 222, 223, 224, 351, 0, 0, 382,
-	383, 384, 406, 368, 0, 418, 0, 314, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 260, 744, 0, 284, 0, 0,
-	0, 0, 0, 0, 343, 298, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 177, 752, 753, 0, 0,
-	0, 0, 242, 178, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 756, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 233, 348, 364, 243, 339,
-	377, 248, 346, 238, 313, 336, 0, 0, 235, 362,
-	345, 295, 278, 279, 234, 0, 331, 258, 271, 255,
-	311, 0, 361, 389, 254, 380, 734, 372, 237, 733,
-	371, 310, 358, 363, 296, 290, 236, 360, 294, 289,
-	282, 262, 405, 275, 322, 288, 323, 276, 300, 299,
-	301, 0, 0, 0, 0, 0, 401, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 374, 0, 0, 0, 0, 0,
-	0, 347, 0, 0, 283, 0, 0, 0, 390, 0,
-	334, 316, 0, 0, 0, 332, 286, 359, 324, 365,
-	349, 373, 742, 325, 228, 350, 257, 297, 239, 241,
-	253, 259, 261, 263, 264, 306, 307, 319, 338, 352,
-	353, 354, 256, 249, 333, 250, 273, 251, 229, 340,
-	252, 231, 320, 357, 0, 269, 329, 293, 232, 292,
-	321, 356, 355, 240, 381, 387, 388, 393, 0, 394,
-	0, 0, 0, 402, 407, 408, 409, 411, 412, 413,
-	414, 0, 0, 0, 0, 396, 0, 0, 0, 0,
-	0, 0, 386, 267, 225, 226, 421, 0, 312, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 308, 385,
-	0, 0, 0, 0, 420, 0, 0, 0, 0, 0,
-	419, 318, 0, 337, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 344, 367, 379, 397,
-	400, 0, 0, 0, 230, 399, 0, 0, 0, 0,
-	0, 0, 743, 370, 0, 0, 0, 378, 0, 0,
-	0, 0, 0, 746, 302, 303, 304, 305, 270, 0,
-	247, 398, 327, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 391,
-	392, 266, 272, 410, 274, 246, 317, 268, 376, 280,
-	0, 403, 0, 404, 0, 0, 0, 0, 754, 749,
-	750, 281, 287, 330, 375, 315, 335, 244, 366, 342,
-	751, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 220, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 227, 0, 285, 0, 326, 265, 184, 185, 186,
-	187, 188, 189, 190, 191, 192, 193, 194, 195, 196,
-	197, 198, 199, 200, 201, 202, 203, 204, 205, 0,
-	206, 207, 208, 209, 210, 211, 212, 213, 214, 215,
-	216, 217, 218, 219, 0, 221, 222, 223, 224, 155,
-	351, 0, 382, 383, 384, 406, 368, 0, 418, 0,
-	0, 314, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 260, 0,
-	0, 284, 0, 0, 0, 107, 0, 0, 343, 298,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 152, 1624, 0, 177,
-	0, 0, 0, 0, 0, 0, 242, 178, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 245, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 233,
-	348, 364, 243, 339, 377, 248, 346, 238, 313, 336,
-	0, 0, 235, 362, 345, 295, 278, 279, 234, 0,
-	331, 258, 271, 255, 311, 0, 361, 389, 254, 380,
-	0, 372, 237, 0, 371, 310, 358, 363, 296, 290,
-	236, 360, 294, 289, 282, 262, 405, 275, 322, 288,
-	323, 276, 300, 299, 301, 0, 0, 0, 0, 0,
-	401, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 374, 0,
-	0, 0, 0, 0, 0, 347, 0, 0, 283, 0,
-	0, 0, 390, 0, 334, 316, 0, 0, 0, 332,
-	286, 359, 324, 365, 349, 373, 328, 325, 228, 350,
-	257, 297, 239, 241, 253, 259, 261, 263, 264, 306,
-	307, 319, 338, 352, 353, 354, 256, 249, 333, 250,
-	273, 251, 229, 340, 252, 231, 320, 357, 0, 269,
-	329, 293, 232, 292, 321, 356, 355, 240, 381, 387,
-	388, 393, 0, 394, 0, 0, 0, 402, 407, 408,
-	409, 411, 412, 413, 414, 0, 0, 0, 0, 396,
-	0, 0, 0, 0, 0, 0, 386, 267, 225, 226,
-	421, 0, 312, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 308, 385, 0, 0, 0, 0, 420, 0,
-	0, 0, 0, 0, 419, 318, 0, 337, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	344, 367, 379, 397, 400, 0, 0, 0, 230, 399,
-	0, 0, 0, 0, 0, 0, 0, 370, 0, 0,
-	0, 378, 0, 0, 0, 0, 0, 395, 302, 303,
-	304, 305, 270, 0, 247, 398, 327, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 391, 392, 266, 272, 410, 274, 246,
-	317, 268, 376, 280, 0, 403, 0, 404, 0, 0,
-	0, 0, 309, 277, 341, 281, 287, 330, 375, 315,
-	335, 244, 366, 342, 291, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 220, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 227, 0, 285, 125, 326,
-	265, 184, 185, 186, 187, 188, 189, 190, 191, 192,
-	193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
-	203, 204, 205, 0, 206, 207, 208, 209, 210, 211,
-	212, 213, 214, 215, 216, 217, 218, 219, 0, 221,
-	222, 223, 224, 155, 351, 0, 382, 383, 384, 406,
-	368, 0, 418, 0, 0, 314, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 260, 0, 0, 284, 0, 0, 0, 107,
-	0, 0, 343, 298, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	152, 1615, 0, 177, 0, 0, 0, 0, 0, 0,
-	242, 178, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 245, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 233, 348, 364, 243, 339, 377, 248,
-	346, 238, 313, 336, 0, 0, 235, 362, 345, 295,
-	278, 279, 234, 0, 331, 258, 271, 255, 311, 0,
-	361, 389, 254, 380, 0, 372, 237, 0, 371, 310,
-	358, 363, 296, 290, 236, 360, 294, 289, 282, 262,
-	405, 275, 322, 288, 323, 276, 300, 299, 301, 0,
-	0, 0, 0, 0, 401, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 374, 0, 0, 0, 0, 0, 0, 347,
-	0, 0, 283, 0, 0, 0, 390, 0, 334, 316,
-	0, 0, 0, 332, 286, 359, 324, 365, 349, 373,
-	328, 325, 228, 350, 257, 297, 239, 241, 253, 259,
-	261, 263, 264, 306, 307, 319, 338, 352, 353, 354,
-	256, 249, 333, 250, 273, 251, 229, 340, 252, 231,
-	320, 357, 0, 269, 329, 293, 232, 292, 321, 356,
-	355, 240, 381, 387, 388, 393, 0, 394, 0, 0,
-	0, 402, 407, 408, 409, 411, 412, 413, 414, 0,
-	0, 0, 0, 396, 0, 0, 0, 0, 0, 0,
-	386, 267, 225, 226, 421, 0, 312, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 308, 385, 0, 0,
-	0, 0, 420, 0, 0, 0, 0, 0, 419, 318,
-	0, 337, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 344, 367, 379, 397, 400, 0,
-	0, 0, 230, 399, 0, 0, 0, 0, 0, 0,
-	0, 370, 0, 0, 0, 378, 0, 0, 0, 0,
-	0, 395, 302, 303, 304, 305, 270, 0, 247, 398,
-	327, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 391, 392, 266,
-	272, 410, 274, 246, 317, 268, 376, 280, 0, 403,
-	0, 404, 0, 0, 0, 0, 309, 277, 341, 281,
-	287, 330, 375, 315, 335, 244, 366, 342, 291, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 220, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 227,
-	0, 285, 125, 326, 265, 184, 185, 186, 187, 188,
-	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
-	199, 200, 201, 202, 203, 204, 205, 0, 206, 207,
-	208, 209, 210, 211, 212, 213, 214, 215, 216, 217,
-	218, 219, 0, 221, 222, 223, 224, 155, 351, 0,
-	382, 383, 384, 406, 368, 0, 418, 0, 0, 314,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 260, 0, 0, 284,
-	0, 0, 0, 107, 0, 0, 343, 298, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1531, 0, 0, 177, 0, 0,
-	0, 0, 0, 0, 242, 178, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 245, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 233, 348, 364,
-	243, 339, 377, 248, 346, 238, 313, 336, 0, 0,
-	235, 362, 345, 295, 278, 279, 234, 0, 331, 258,
-	271, 255, 311, 0, 361, 389, 254, 380, 0, 372,
-	237, 0, 371, 310, 358, 363, 296, 290, 236, 360,
-	294, 289, 282, 262, 405, 275, 322, 288, 323, 276,
-	300, 299, 301, 0, 0, 0, 0, 0, 401, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 374, 0, 0, 0,
-	0, 0, 0, 347, 0, 0, 283, 0, 0, 0,
-	390, 0, 334, 316, 0, 0, 0, 332, 286, 359,
-	324, 365, 349, 373, 328, 325, 228, 350, 257, 297,
-	239, 241, 253, 259, 261, 263, 264, 306, 307, 319,
-	338, 352, 353, 354, 256, 249, 333, 250, 273, 251,
-	229, 340, 252, 231, 320, 357, 0, 269, 329, 293,
-	232, 292, 321, 356, 355, 240, 381, 387, 388, 393,
-	0, 394, 0, 0, 0, 402, 407, 408, 409, 411,
-	412, 413, 414, 0, 0, 0, 0, 396, 0, 0,
-	0, 0, 0, 0, 386, 267, 225, 226, 421, 0,
-	312, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	308, 385, 0, 0, 0, 0, 420, 0, 0, 0,
-	0, 0, 419, 318, 0, 337, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 344, 367,
-	379, 397, 400, 0, 0, 0, 230, 399, 0, 0,
-	0, 0, 0, 0, 0, 370, 0, 0, 0, 378,
-	0, 0, 0, 0, 0, 395, 302, 303, 304, 305,
-	270, 0, 247, 398, 327, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 391, 392, 266, 272, 410, 274, 246, 317, 268,
-	376, 280, 0, 403, 0, 404, 0, 0, 0, 0,
-	309, 277, 341, 281, 287, 330, 375, 315, 335, 244,
-	366, 342, 291, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 220, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 227, 0, 285, 125, 326, 265, 184,
-	185, 186, 187, 188, 189, 190, 191, 192, 193, 194,
-	195, 196, 197, 198, 199, 200, 201, 202, 203, 204,
-	205, 0, 206, 207, 208, 209, 210, 211, 212, 213,
-	214, 215, 216, 217, 218, 219, 0, 221, 222, 223,
-	224, 351, 0, 0, 382, 383, 384, 406, 368, 0,
-	418, 0, 314, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 260,
-	0, 0, 284, 0, 0, 0, 0, 0, 0, 343,
-	298, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	177, 752, 753, 0, 0, 0, 0, 242, 178, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 756, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	233, 348, 364, 243, 339, 377, 248, 346, 238, 313,
-	336, 0, 0, 235, 362, 345, 295, 278, 279, 234,
-	0, 331, 258, 271, 255, 311, 0, 361, 389, 254,
-	380, 734, 372, 237, 733, 371, 310, 358, 363, 296,
-	290, 236, 360, 294, 289, 282, 262, 405, 275, 322,
-	288, 323, 276, 300, 299, 301, 0, 0, 0, 0,
-	0, 401, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 374,
-	0, 0, 0, 0, 0, 0, 347, 0, 0, 283,
-	0, 0, 0, 390, 0, 334, 316, 0, 0, 0,
-	332, 286, 359, 324, 365, 349, 373, 328, 325, 228,
-	350, 257, 297, 239, 241, 253, 259, 261, 263, 264,
-	306, 307, 319, 338, 352, 353, 354, 256, 249, 333,
-	250, 273, 251, 229, 340, 252, 231, 320, 357, 0,
-	269, 329, 293, 232, 292, 321, 356, 355, 240, 381,
-	387, 388, 393, 0, 394, 0, 0, 0, 402, 407,
-	408, 409, 411, 412, 413, 414, 0, 0, 0, 0,
-	396, 0, 0, 0, 0, 0, 0, 386, 267, 225,
-	226, 421, 0, 312, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 308, 385, 0, 0, 0, 0, 420,
-	0, 0, 0, 0, 0, 419, 318, 0, 337, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 344, 367, 379, 397, 400, 0, 0, 0, 230,
-	399, 0, 0, 0, 0, 0, 0, 0, 370, 0,
-	0, 0, 378, 0, 0, 0, 0, 0, 395, 302,
-	303, 304, 305, 270, 0, 247, 398, 327, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 391, 392, 266, 272, 410, 274,
-	246, 317, 268, 376, 280, 0, 403, 0, 404, 0,
-	0, 0, 0, 754, 749, 750, 281, 287, 330, 375,
-	315, 335, 244, 366, 342, 751, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 220,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 227, 0, 285, 0,
-	326, 265, 184, 185, 186, 187, 188, 189, 190, 191,
-	192, 193, 194, 195, 196, 197, 198, 199, 200, 201,
-	202, 203, 204, 205, 0, 206, 207, 208, 209, 210,
-	211, 212, 213, 214, 215, 216, 217, 218, 219, 0,
-	221, 222, 223, 224, 351, 0, 0, 382, 383, 384,
-	406, 368, 0, 418, 0, 314, 0, 0, 0, 0,
-	0, 0, 0, 0, 2173, 0, 0, 0, 0, 0,
-	0, 0, 260, 0, 0, 284, 0, 0, 0, 0,
-	0, 0, 343, 298, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 177, 0, 0, 0, 0, 0, 0,
-	242, 178, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 245, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 233, 348, 364, 243, 339, 377, 248,
-	346, 238, 313, 336, 0, 0, 235, 362, 345, 295,
-	278, 279, 234, 0, 331, 258, 271, 255, 311, 0,
-	361, 389, 254, 380, 0, 372, 237, 0, 371, 310,
-	358, 363, 296, 290, 236, 360, 294, 289, 282, 262,
-	405, 275, 322, 288, 323, 276, 300, 299, 301, 0,
-	0, 0, 0, 0, 401, 0, 0, 0, 0, 0,
-	0, 0, 0, 2176, 0, 0, 2175, 0, 0, 0,
-	0, 0, 374, 0, 0, 0, 0, 0, 0, 347,
-	0, 0, 283, 0, 0, 0, 390, 0, 334, 316,
-	0, 0, 0, 332, 286, 359, 324, 365, 349, 373,
-	328, 325, 228, 350, 257, 297, 239, 241, 253, 259,
-	261, 263, 264, 306, 307, 319, 338, 352, 353, 354,
-	256, 249, 333, 250, 273, 251, 229, 340, 252, 231,
-	320, 357, 0, 269, 329, 293, 232, 292, 321, 356,
-	355, 240, 381, 387, 388, 393, 0, 394, 0, 0,
-	0, 402, 407, 408, 409, 411, 412, 413, 414, 0,
-	0, 0, 0, 396, 0, 0, 0, 0, 0, 0,
-	386, 267, 225, 226, 421, 0, 312, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 308, 385, 0, 0,
-	0, 0, 420, 0, 0, 0, 0, 0, 419, 318,
-	0, 337, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 344, 367, 379, 397, 400, 0,
-	0, 0, 230, 399, 0, 0, 0, 0, 0, 0,
-	0, 370, 0, 0, 0, 378, 0, 0, 0, 0,
-	0, 395, 302, 303, 304, 305, 270, 0, 247, 398,
-	327, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 391, 392, 266,
-	272, 410, 274, 246, 317, 268, 376, 280, 0, 403,
-	0, 404, 0, 0, 0, 0, 309, 277, 341, 281,
-	287, 330, 375, 315, 335, 244, 366, 342, 291, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 220, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 227,
-	0, 285, 0, 326, 265, 184, 185, 186, 187, 188,
-	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
-	199, 200, 201, 202, 203, 204, 205, 0, 206, 207,
-	208, 209, 210, 211, 212, 213, 214, 215, 216, 217,
-	218, 219, 0, 221, 222, 223, 224, 351, 0, 0,
-	382, 383, 384, 406, 368, 0, 418, 0, 314, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 260, 1148, 0, 284, 0,
-	0, 0, 0, 0, 0, 343, 298, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 177, 0, 0, 1146,
-	0, 0, 0, 242, 178, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 245, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1144,
-	0, 0, 0, 0, 0, 0, 233, 348, 364, 243,
-	339, 377, 248, 346, 238, 313, 336, 0, 0, 235,
-	362, 345, 295, 278, 279, 234, 0, 331, 258, 271,
-	255, 311, 0, 361, 389, 254, 380, 0, 372, 237,
-	0, 371, 310, 358, 363, 296, 290, 236, 360, 294,
-	289, 282, 262, 405, 275, 322, 288, 323, 276, 300,
-	299, 301, 0, 0, 0, 0, 0, 401, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 374, 0, 0, 0, 0,
-	0, 0, 347, 0, 0, 283, 0, 0, 0, 390,
-	0, 334, 316, 0, 0, 0, 332, 286, 359, 324,
-	365, 349, 373, 328, 325, 228, 350, 257, 297, 239,
-	241, 253, 259, 261, 263, 264, 306, 307, 319, 338,
-	352, 353, 354, 256, 249, 333, 250, 273, 251, 229,
-	340, 252, 231, 320, 357, 0, 269, 329, 293, 232,
-	292, 321, 356, 355, 240, 381, 387, 388, 393, 0,
-	394, 0, 0, 0, 402, 407, 408, 409, 411, 412,
-	413, 414, 0, 0, 0, 0, 396, 0, 0, 0,
-	0, 0, 0, 386, 267, 225, 226, 421, 0, 312,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 308,
-	385, 0, 0, 0, 0, 420, 0, 0, 0, 0,
-	0, 419, 318, 0, 337, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 344, 367, 379,
-	397, 400, 0, 0, 0, 230, 399, 0, 0, 0,
-	0, 0, 0, 0, 370, 0, 0, 0, 378, 0,
-	0, 0, 0, 0, 395, 302, 303, 304, 305, 270,
-	0, 247, 398, 327, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	391, 392, 266, 272, 410, 274, 246, 317, 268, 376,
-	280, 0, 403, 0, 404, 0, 0, 0, 0, 309,
-	277, 341, 281, 287, 330, 375, 315, 335, 244, 366,
-	342, 291, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 220, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 227, 0, 285, 0, 326, 265, 184, 185,
-	186, 187, 188, 189, 190, 191, 192, 193, 194, 195,
-	196, 197, 198, 199, 200, 201, 202, 203, 204, 205,
-	0, 206, 207, 208, 209, 210, 211, 212, 213, 214,
-	215, 216, 217, 218, 219, 0, 221, 222, 223, 224,
-	351, 0, 0, 382, 383, 384, 406, 368, 0, 418,
-	0, 314, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 260, 1142,
-	0, 284, 0, 0, 0, 0, 0, 0, 343, 298,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 177,
-	0, 0, 1146, 0, 0, 0, 242, 178, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 245, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1144, 0, 0, 0, 0, 0, 0, 233,
-	348, 364, 243, 339, 377, 248, 346, 238, 313, 336,
-	0, 0, 235, 362, 345, 295, 278, 279, 234, 0,
-	331, 258, 271, 255, 311, 0, 361, 389, 254, 380,
-	0, 372, 237, 0, 371, 310, 358, 363, 296, 290,
-	236, 360, 294, 289, 282, 262, 405, 275, 322, 288,
-	323, 276, 300, 299, 301, 0, 0, 0, 0, 0,
-	401, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 374, 0,
-	0, 0, 0, 0, 0, 347, 0, 0, 283, 0,
-	0, 0, 390, 0, 334, 316, 0, 0, 0, 332,
-	286, 359, 324, 365, 349, 373, 328, 325, 228, 350,
-	257, 297, 239, 241, 253, 259, 261, 263, 264, 306,
-	307, 319, 338, 352, 353, 354, 256, 249, 333, 250,
-	273, 251, 229, 340, 252, 231, 320, 357, 0, 269,
-	329, 293, 232, 292, 321, 356, 355, 240, 381, 387,
-	388, 393, 0, 394, 0, 0, 0, 402, 407, 408,
-	409, 411, 412, 413, 414, 0, 0, 0, 0, 396,
-	0, 0, 0, 0, 0, 0, 386, 267, 225, 226,
-	421, 0, 312, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 308, 385, 0, 0, 0, 0, 420, 0,
-	0, 0, 0, 0, 419, 318, 0, 337, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	344, 367, 379, 397, 400, 0, 0, 0, 230, 399,
-	0, 0, 0, 0, 0, 0, 0, 370, 0, 0,
-	0, 378, 0, 0, 0, 0, 0, 395, 302, 303,
-	304, 305, 270, 0, 247, 398, 327, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 391, 392, 266, 272, 410, 274, 246,
-	317, 268, 376, 280, 0, 403, 0, 404, 0, 0,
-	0, 0, 309, 277, 341, 281, 287, 330, 375, 315,
-	335, 244, 366, 342, 291, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 220, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 227, 0, 285, 0, 326,
-	265, 184, 185, 186, 187, 188, 189, 190, 191, 192,
-	193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
-	203, 204, 205, 0, 206, 207, 208, 209, 210, 211,
-	212, 213, 214, 215, 216, 217, 218, 219, 0, 221,
-	222, 223, 224, 351, 0, 0, 382, 383, 384, 406,
-	368, 0, 418, 0, 314, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 260, 0, 0, 284, 0, 0, 0, 0, 0,
-	0, 343, 298, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	2817, 0, 177, 590, 0, 0, 0, 0, 0, 242,
-	178, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	245, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 233, 348, 364, 243, 339, 377, 248, 346,
-	238, 313, 336, 0, 0, 235, 362, 345, 295, 278,
-	279, 234, 0, 331, 258, 271, 255, 311, 0, 361,
-	389, 254, 380, 0, 372, 237, 0, 371, 310, 358,
-	363, 296, 290, 236, 360, 294, 289, 282, 262, 405,
-	275, 322, 288, 323, 276, 300, 299, 301, 0, 0,
-	0, 0, 0, 401, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 374, 0, 0, 0, 0, 0, 0, 347, 0,
-	0, 283, 0, 0, 0, 390, 0, 334, 316, 0,
-	0, 0, 332, 286, 359, 324, 365, 349, 373, 328,
-	325, 228, 350, 257, 297, 239, 241, 253, 259, 261,
-	263, 264, 306, 307, 319, 338, 352, 353, 354, 256,
-	249, 333, 250, 273, 251, 229, 340, 252, 231, 320,
-	357, 0, 269, 329, 293, 232, 292, 321, 356, 355,
-	240, 381, 387, 388, 393, 0, 394, 0, 0, 0,
-	402, 407, 408, 409, 411, 412, 413, 414, 0, 0,
-	0, 0, 396, 0, 0, 0, 0, 0, 0, 386,
-	267, 225, 226, 421, 0, 312, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 308, 385, 0, 0, 0,
-	0, 420, 0, 0, 0, 0, 0, 419, 318, 0,
-	337, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 344, 367, 379, 397, 400, 0, 0,
-	0, 230, 399, 0, 0, 0, 0, 0, 0, 0,
-	370, 0, 0, 0, 378, 0, 0, 0, 0, 0,
-	395, 302, 303, 304, 305, 270, 0, 247, 398, 327,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 391, 392, 266, 272,
-	410, 274, 246, 317, 268, 376, 280, 0, 403, 0,
-	404, 0, 0, 0, 0, 309, 277, 341, 281, 287,
-	330, 375, 315, 335, 244, 366, 342, 291, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 220, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 227, 0,
-	285, 0, 326, 265, 184, 185, 186, 187, 188, 189,
-	190, 191, 192, 193, 194, 195, 196, 197, 198, 199,
-	200, 201, 202, 203, 204, 205, 0, 206, 207, 208,
-	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
-	219, 0, 221, 222, 223, 224, 351, 0, 0, 382,
-	383, 384, 406, 368, 0, 418, 0, 314, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 260, 0, 0, 284, 0, 0,
-	0, 0, 0, 0, 343, 298, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 177, 0, 0, 1146, 0,
-	0, 0, 242, 178, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 245, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 2531, 0,
-	0, 0, 0, 0, 0, 233, 348, 364, 243, 339,
-	377, 248, 346, 238, 313, 336, 0, 0, 235, 362,
-	345, 295, 278, 279, 234, 0, 331, 258, 271, 255,
-	311, 0, 361, 389, 254, 380, 0, 372, 237, 0,
-	371, 310, 358, 363, 296, 290, 236, 360, 294, 289,
-	282, 262, 405, 275, 322, 288, 323, 276, 300, 299,
-	301, 0, 0, 0, 0, 0, 401, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 374, 0, 0, 0, 0, 0,
-	0, 347, 0, 0, 283, 0, 0, 0, 390, 0,
-	334, 316, 0, 0, 0, 332, 286, 359, 324, 365,
-	349, 373, 328, 325, 228, 350, 257, 297, 239, 241,
-	253, 259, 261, 263, 264, 306, 307, 319, 338, 352,
-	353, 354, 256, 249, 333, 250, 273, 251, 229, 340,
-	252, 231, 320, 357, 0, 269, 329, 293, 232, 292,
-	321, 356, 355, 240, 381, 387, 388, 393, 0, 394,
-	0, 0, 0, 402, 407, 408, 409, 411, 412, 413,
-	414, 0, 0, 0, 0, 396, 0, 0, 0, 0,
-	0, 0, 386, 267, 225, 226, 421, 0, 312, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 308, 385,
-	0, 0, 0, 0, 420, 0, 0, 0, 0, 0,
-	419, 318, 0, 337, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 344, 367, 379, 397,
-	400, 0, 0, 0, 230, 399, 0, 0, 0, 0,
-	0, 0, 0, 370, 0, 0, 0, 378, 0, 0,
-	0, 0, 0, 395, 302, 303, 304, 305, 270, 0,
-	247, 398, 327, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 391,
-	392, 266, 272, 410, 274, 246, 317, 268, 376, 280,
-	0, 403, 0, 404, 0, 0, 0, 0, 309, 277,
-	341, 281, 287, 330, 375, 315, 335, 244, 366, 342,
-	291, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 220, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 227, 0, 285, 0, 326, 265, 184, 185, 186,
-	187, 188, 189, 190, 191, 192, 193, 194, 195, 196,
-	197, 198, 199, 200, 201, 202, 203, 204, 205, 0,
-	206, 207, 208, 209, 210, 211, 212, 213, 214, 215,
-	216, 217, 218, 219, 0, 221, 222, 223, 224, 351,
-	0, 0, 382, 383, 384, 406, 368, 0, 418, 0,
-	314, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 260, 0, 0,
-	284, 0, 0, 0, 0, 0, 0, 343, 298, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 177, 0,
-	0, 1146, 0, 0, 0, 242, 178, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 245, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1144, 0, 0, 0, 0, 0, 0, 233, 348,
-	364, 243, 339, 377, 248, 346, 238, 313, 336, 0,
-	0, 235, 362, 345, 295, 278, 279, 234, 0, 331,
-	258, 271, 255, 311, 0, 361, 389, 254, 380, 0,
-	372, 237, 0, 371, 310, 358, 363, 296, 290, 236,
-	360, 294, 289, 282, 262, 405, 275, 322, 288, 323,
-	276, 300, 299, 301, 0, 0, 0, 0, 0, 401,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 374, 0, 0,
-	0, 0, 0, 0, 347, 0, 0, 283, 0, 0,
-	0, 390, 0, 334, 316, 0, 0, 0, 332, 286,
-	359, 324, 365, 349, 373, 328, 325, 228, 350, 257,
-	297, 239, 241, 253, 259, 261, 263, 264, 306, 307,
-	319, 338, 352, 353, 354, 256, 249, 333, 250, 273,
-	251, 229, 340, 252, 231, 320, 357, 0, 269, 329,
-	293, 232, 292, 321, 356, 355, 240, 381, 387, 388,
-	393, 0, 394, 0, 0, 0, 402, 407, 408, 409,
-	411, 412, 413, 414, 0, 0, 0, 0, 396, 0,
-	0, 0, 0, 0, 0, 386, 267, 225, 226, 421,
-	0, 312, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 308, 385, 0, 0, 0, 0, 420, 0, 0,
-	0, 0, 0, 419, 318, 0, 337, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 344,
-	367, 379, 397, 400, 0, 0, 0, 230, 399, 0,
-	0, 0, 0, 0, 0, 0, 370, 0, 0, 0,
-	378, 0, 0, 0, 0, 0, 395, 302, 303, 304,
-	305, 270, 0, 247, 398, 327, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 391, 392, 266, 272, 410, 274, 246, 317,
-	268, 376, 280, 0, 403, 0, 404, 0, 0, 0,
-	0, 309, 277, 341, 281, 287, 330, 375, 315, 335,
-	244, 366, 342, 291, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 220, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 227, 0, 285, 0, 326, 265,
-	184, 185, 186, 187, 188, 189, 190, 191, 192, 193,
-	194, 195, 196, 197, 198, 199, 200, 201, 202, 203,
-	204, 205, 0, 206, 207, 208, 209, 210, 211, 212,
-	213, 214, 215, 216, 217, 218, 219, 0, 221, 222,
-	223, 224, 351, 0, 0, 382, 383, 384, 406, 368,
-	0, 418, 0, 314, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1901, 0, 0, 0, 0,
-	260, 0, 0, 284, 0, 0, 0, 0, 0, 0,
-	343, 298, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 177, 0, 0, 1903, 0, 0, 0, 242, 178,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 245,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	563, 2502, 542, 2995, 1335, 544, 187, 565, 3019, 3055,
+	2878, 1561, 3047, 1271, 2844, 2944, 2743, 2518, 2950, 2810,
+	1806, 2951, 2927, 2909, 2749, 2604, 1138, 2877, 2931, 2838,
+	2768, 2605, 1390, 1026, 2767, 679, 2863, 1262, 2747, 1515,
+	2828, 2799, 447, 2503, 593, 1517, 2203, 2774, 497, 1338,
+	1189, 2738, 2480, 2305, 2729, 456, 172, 461, 461, 1622,
+	2277, 2676, 2306, 461, 477, 486, 2631, 2304, 486, 2530,
+	1917, 2507, 2298, 2301, 2602, 1830, 1716, 546, 2591, 1683,
+	2769, 1920, 2328, 2573, 2461, 2456, 2458, 1888, 1635, 1564,
+	1113, 796, 1997, 1820, 1828, 1596, 2529, 2189, 2363, 888,
+	2401, 541, 1809, 1692, 1810, 491, 1712, 1940, 1258, 1691,
+	1684, 1331, 1469, 2478, 535, 2042, 1652, 1657, 1998, 1711,
+	1615, 1918, 1270, 1599, 1986, 1597, 2174, 1554, 536, 2170,
+	802, 1253, 36, 183, 8, 2223, 6, 732, 1887, 182,
+	7, 1499, 843, 2059, 1477, 1263, 543, 1329, 1744, 455,
+	26, 2027, 15, 1826, 1093, 1713, 14, 1198, 1601, 116,
+	2175, 1147, 1869, 545, 447, 1619, 1127, 534, 32, 1525,
+	1526, 2133, 1368, 1384, 13, 553, 1078, 1872, 473, 35,
+	1320, 906, 1292, 1690, 1334, 1234, 1328, 187, 1687, 187,
+	2132, 834, 835, 1673, 788, 1146, 483, 1651, 2006, 1498,
+	1062, 1543, 800, 1693, 1181, 470, 731, 676, 1389, 1173,
+	1115, 1139, 536, 23, 482, 499, 478, 16, 10, 500,
+	481, 173, 749, 729, 789, 485, 1091, 166, 1027, 2157,
+	1720, 2157, 479, 2157, 1605, 169, 831, 1123, 480, 1730,
+	2597, 2093, 2045, 830, 1481, 832, 2048, 2046, 678, 827,
+	1241, 2043, 762, 1237, 826, 827, 171, 827, 457, 1159,
+	2736, 2359, 1239, 2357, 1662, 2834, 446, 2829, 2739, 2603,
+	1473, 2918, 1686, 964, 965, 966, 963, 1021, 964, 965,
+	966, 963, 677, 687, 170, 858, 170, 170, 466, 2873,
+	170, 460, 460, 489, 2537, 2762, 927, 468, 170, 2986,
+	60, 170, 2898, 170, 1285, 8, 1278, 2088, 825, 2080,
+	170, 7, 56, 162, 135, 170, 1083, 56, 162, 135,
+	1282, 1717, 1275, 2378, 2761, 2888, 115, 803, 495, 496,
+	734, 2424, 1728, 2874, 2371, 1873, 1155, 115, 2180, 1156,
+	2018, 961, 1284, 1321, 1277, 167, 1325, 805, 167, 1484,
+	1485, 2019, 1633, 680, 2172, 1135, 167, 2060, 1410, 167,
+	772, 167, 935, 3039, 1539, 937, 942, 1084, 167, 943,
+	1324, 1144, 1145, 167, 1305, 2954, 2955, 667, 688, 666,
+	668, 669, 1142, 670, 671, 1337, 1141, 1144, 1145, 777,
+	846, 959, 776, 938, 773, 3037, 799, 945, 2757, 798,
+	170, 1799, 56, 162, 135, 2919, 2920, 2171, 954, 2836,
+	866, 870, 872, 874, 876, 877, 879, 2364, 883, 880,
+	881, 882, 1158, 2911, 861, 862, 863, 864, 844, 845,
+	867, 2365, 847, 2366, 848, 849, 850, 851, 852, 853,
+	854, 855, 856, 857, 859, 865, 3023, 3024, 2839, 2840,
+	2841, 2842, 2985, 869, 871, 873, 875, 878, 167, 2606,
+	461, 2606, 1326, 2911, 2914, 2832, 2074, 899, 806, 1340,
+	461, 898, 1240, 1238, 1975, 781, 909, 775, 1616, 2924,
+	774, 931, 2615, 1323, 1608, 940, 2632, 2765, 486, 486,
+	860, 461, 1975, 2563, 909, 1316, 1724, 2897, 964, 965,
+	966, 963, 893, 895, 933, 2716, 1969, 2462, 778, 2296,
+	134, 1424, 168, 2294, 759, 1612, 936, 939, 2852, 1868,
+	801, 837, 735, 2161, 2391, 2525, 1406, 2389, 1670, 2085,
+	1403, 2953, 160, 1133, 1405, 1402, 1404, 1408, 1409, 2756,
+	932, 2110, 1407, 922, 941, 2758, 2988, 2989, 956, 765,
+	930, 897, 2737, 1247, 1246, 530, 957, 958, 532, 2358,
+	2283, 997, 892, 531, 2816, 2291, 2292, 780, 1971, 883,
+	880, 881, 882, 2713, 2290, 2115, 2467, 2114, 2113, 2111,
+	2293, 2287, 1982, 1729, 3031, 1157, 691, 1733, 1735, 1736,
+	1339, 2858, 1322, 2540, 2541, 898, 2963, 2962, 894, 1221,
+	2477, 1631, 1632, 1100, 3041, 1168, 2484, 483, 483, 758,
+	756, 934, 2870, 2936, 488, 944, 2196, 487, 952, 953,
+	2697, 3065, 2932, 757, 3110, 482, 482, 478, 478, 2945,
+	3036, 481, 481, 2997, 2106, 2107, 803, 3072, 779, 1122,
+	2895, 2112, 755, 479, 479, 2689, 1346, 1349, 1350, 480,
+	480, 733, 3050, 901, 902, 3077, 805, 1347, 2183, 2184,
+	2185, 2186, 736, 768, 1718, 2812, 2618, 2680, 2396, 1923,
+	2156, 2288, 1030, 911, 910, 1031, 1950, 1718, 1413, 1414,
+	1415, 1416, 1417, 1418, 1411, 1412, 763, 2547, 1949, 1718,
+	2177, 911, 910, 913, 890, 999, 1000, 1001, 1002, 2704,
+	2705, 1089, 456, 1092, 896, 2993, 2994, 803, 2997, 2262,
+	827, 1059, 919, 915, 916, 2872, 827, 827, 764, 769,
+	827, 484, 1177, 827, 827, 918, 1176, 805, 2987, 920,
+	1003, 732, 1144, 1145, 1161, 752, 1719, 750, 754, 772,
+	1731, 2044, 1120, 751, 748, 747, 1242, 753, 738, 739,
+	737, 740, 741, 742, 743, 1134, 770, 771, 1137, 1136,
+	868, 1119, 677, 2871, 1144, 1145, 2946, 2882, 766, 767,
+	1976, 2504, 1974, 3051, 1143, 1140, 2864, 806, 461, 57,
+	1170, 136, 2763, 136, 136, 2116, 2117, 136, 1976, 3003,
+	1974, 447, 447, 447, 2089, 136, 1193, 1193, 136, 461,
+	136, 904, 903, 1922, 889, 761, 484, 136, 1924, 2655,
+	1617, 2440, 136, 1937, 1936, 1935, 2463, 486, 1092, 456,
+	801, 1745, 2297, 2734, 921, 1979, 1980, 1200, 187, 2853,
+	1724, 1039, 1040, 2921, 2922, 2392, 1734, 447, 2684, 1978,
+	3042, 1094, 495, 1979, 1980, 2637, 947, 1195, 806, 948,
+	2811, 1090, 2330, 2332, 2908, 537, 2717, 1978, 1609, 1925,
+	1096, 1097, 1098, 1099, 57, 1101, 1102, 1081, 1104, 1317,
+	1174, 2081, 1108, 927, 760, 2289, 2009, 950, 1721, 2286,
+	1248, 1482, 689, 1213, 1095, 1269, 1082, 1272, 1939, 1611,
+	1103, 1064, 1280, 2881, 2702, 1348, 2682, 136, 3048, 3049,
+	2681, 2395, 1124, 1128, 1128, 1128, 2181, 1191, 1191, 1129,
+	1130, 1107, 1106, 1303, 1105, 490, 1066, 2451, 1732, 1079,
+	1286, 2800, 2801, 2802, 2804, 2803, 1124, 1193, 1124, 1193,
+	898, 726, 727, 728, 1926, 678, 2159, 1110, 2263, 2265,
+	2266, 2267, 2264, 1225, 1230, 1231, 2160, 724, 1121, 1294,
+	1169, 2403, 2402, 1814, 1112, 1131, 926, 1293, 1816, 1815,
+	1087, 3002, 1487, 1149, 1150, 946, 1152, 1153, 1217, 1154,
+	1488, 1260, 1261, 1813, 1148, 1291, 1251, 1151, 1254, 1255,
+	1356, 1357, 1358, 1359, 1360, 1361, 1362, 1363, 1364, 1365,
+	1366, 1367, 1300, 1301, 1085, 1086, 1379, 1380, 1187, 1188,
+	1486, 951, 1160, 1175, 1162, 692, 693, 2782, 3078, 1388,
+	1336, 1995, 1166, 1318, 2331, 1184, 1185, 1186, 2855, 1518,
+	773, 1276, 1437, 1776, 949, 1283, 1775, 1265, 2201, 1268,
+	1427, 1428, 1429, 1199, 466, 1333, 483, 1201, 3111, 1446,
+	828, 829, 1215, 1443, 1216, 833, 1444, 1312, 1080, 1232,
+	1931, 2685, 2686, 1823, 482, 2643, 478, 773, 1451, 1452,
+	481, 1243, 1927, 1892, 2475, 1311, 3108, 1308, 1518, 2644,
+	962, 1351, 479, 2570, 1448, 3097, 1824, 1825, 480, 3102,
+	1294, 1314, 1295, 461, 1294, 3101, 1467, 925, 1293, 1307,
+	1302, 927, 1293, 2538, 461, 1287, 962, 1497, 1193, 1501,
+	1502, 1944, 1504, 775, 1506, 1507, 774, 1288, 1726, 461,
+	782, 1330, 732, 681, 1931, 1516, 1227, 1228, 1229, 1193,
+	1996, 1470, 2030, 2566, 1170, 1436, 678, 3098, 1310, 477,
+	927, 696, 1309, 1306, 1419, 1420, 2670, 1423, 1377, 1378,
+	775, 1327, 1996, 774, 3082, 1438, 3074, 1332, 1538, 1726,
+	2062, 1505, 2202, 2651, 1125, 1726, 1544, 1544, 1445, 1170,
+	1447, 1170, 3057, 1170, 1496, 1370, 461, 1800, 1497, 1497,
+	2020, 1892, 1193, 1594, 1607, 3046, 1542, 1531, 1930, 447,
+	2489, 1193, 695, 1934, 1932, 1871, 698, 697, 1933, 806,
+	2476, 2570, 1537, 806, 2070, 1540, 1541, 1726, 1804, 1929,
+	1755, 1996, 2080, 964, 965, 966, 963, 461, 1497, 1193,
+	2070, 1640, 461, 461, 1726, 1644, 962, 1319, 3013, 2999,
+	1647, 1648, 1422, 1650, 1655, 1655, 1613, 964, 965, 966,
+	963, 2166, 3058, 962, 2961, 1589, 1590, 187, 2956, 2902,
+	187, 187, 1930, 187, 2901, 962, 2028, 1934, 1932, 2422,
+	1495, 2163, 1933, 2202, 2899, 1503, 1126, 2893, 1639, 924,
+	1508, 1509, 1510, 1519, 1520, 1474, 2892, 2891, 1468, 964,
+	965, 966, 963, 1754, 2890, 1637, 2859, 891, 1437, 1437,
+	1694, 1124, 1500, 2067, 1870, 1437, 1437, 1618, 3014, 3000,
+	1701, 2710, 2020, 1661, 1532, 2706, 1664, 1665, 2669, 1667,
+	1641, 1642, 1717, 1521, 2860, 1128, 2549, 2325, 2860, 2903,
+	1536, 681, 1546, 2138, 1892, 1803, 1516, 1483, 1513, 1512,
+	1193, 1715, 2094, 1527, 2670, 1529, 1530, 2860, 1493, 1547,
+	1548, 1524, 1549, 925, 1528, 2078, 2860, 2860, 1535, 2071,
+	2069, 927, 2064, 1511, 2860, 2057, 2860, 1533, 1534, 1910,
+	2055, 2053, 1805, 1626, 1627, 1709, 1593, 1523, 1545, 2051,
+	1780, 1726, 1695, 1708, 1891, 2020, 1801, 1629, 2670, 1784,
+	1783, 1592, 1595, 1614, 1111, 1382, 2550, 1996, 1742, 1743,
+	1178, 1689, 1774, 962, 3059, 1738, 1765, 2666, 1689, 1623,
+	1624, 1625, 962, 1330, 967, 1638, 2593, 2490, 1764, 2204,
+	1550, 1634, 2083, 996, 1763, 1892, 2082, 1725, 1297, 2065,
+	2070, 1005, 2065, 2008, 2709, 2058, 2073, 2035, 1907, 1658,
+	2056, 2052, 1656, 1771, 1756, 483, 1707, 1492, 2562, 2052,
+	483, 1060, 1289, 1010, 1892, 2494, 1800, 803, 979, 962,
+	962, 1636, 1008, 482, 803, 478, 1636, 1636, 482, 481,
+	478, 1675, 962, 912, 481, 694, 962, 805, 891, 886,
+	884, 479, 1628, 1781, 805, 2552, 479, 480, 962, 1698,
+	1788, 1703, 480, 1696, 962, 2386, 1705, 1726, 1298, 1704,
+	1706, 1426, 1425, 891, 1116, 2937, 2783, 1699, 1117, 1700,
+	535, 1182, 898, 1863, 3092, 2043, 461, 2658, 2656, 1710,
+	3079, 1125, 1183, 1941, 1875, 2571, 2556, 2551, 2284, 461,
+	461, 461, 1723, 1889, 982, 983, 984, 985, 986, 979,
+	1740, 1741, 2158, 1896, 1170, 566, 575, 1746, 1737, 2938,
+	2784, 803, 567, 1900, 574, 568, 572, 571, 569, 570,
+	2485, 2659, 2657, 2068, 1180, 1739, 2011, 1170, 1370, 1296,
+	900, 805, 2595, 2101, 1449, 1450, 898, 1750, 1453, 1454,
+	1455, 1456, 1458, 1459, 1460, 1461, 1462, 1463, 1464, 1465,
+	1898, 2037, 1831, 1385, 1659, 1751, 1457, 1385, 806, 1901,
+	1902, 699, 2350, 2692, 1864, 806, 1494, 576, 978, 977,
+	987, 988, 980, 981, 982, 983, 984, 985, 986, 979,
+	2980, 2486, 2000, 1126, 2000, 1607, 2000, 1778, 1911, 987,
+	988, 980, 981, 982, 983, 984, 985, 986, 979, 573,
+	966, 963, 898, 964, 965, 966, 963, 494, 1179, 1193,
+	461, 963, 2691, 1235, 2598, 1659, 1916, 2367, 1798, 2236,
+	2235, 1909, 2229, 2227, 461, 2487, 898, 456, 2673, 3112,
+	2026, 964, 965, 966, 963, 2032, 1943, 1817, 1441, 1812,
+	187, 980, 981, 982, 983, 984, 985, 986, 979, 2714,
+	1442, 1942, 806, 1945, 1946, 1947, 1948, 3105, 2560, 1951,
+	1952, 1953, 1954, 1955, 1956, 1957, 1958, 1959, 1960, 1961,
+	1962, 1963, 1964, 1897, 1966, 1967, 2003, 3066, 2007, 1030,
+	2005, 3076, 1031, 824, 1128, 3060, 2998, 2273, 2076, 1906,
+	2715, 1715, 964, 965, 966, 963, 2038, 1908, 1193, 2561,
+	1193, 1376, 1193, 2047, 1767, 2271, 1831, 898, 2269, 2259,
+	1866, 2971, 1904, 2939, 2875, 1905, 2830, 1373, 1375, 1372,
+	2016, 1374, 2299, 1881, 1882, 1883, 1903, 3075, 2272, 964,
+	965, 966, 963, 2815, 1973, 1972, 1193, 2119, 2596, 2792,
+	2786, 2002, 2785, 2660, 803, 2559, 2270, 1899, 2382, 2268,
+	2258, 2362, 2126, 964, 965, 966, 963, 1193, 1766, 2361,
+	3030, 2128, 2103, 2883, 805, 2295, 2257, 2017, 2256, 2012,
+	2013, 2014, 964, 965, 966, 963, 2024, 2255, 2252, 2023,
+	2246, 2039, 964, 965, 966, 963, 2118, 2090, 2036, 970,
+	971, 972, 973, 974, 975, 976, 968, 2130, 1341, 1342,
+	1343, 1344, 1345, 898, 2243, 530, 2242, 2127, 532, 2105,
+	2457, 2086, 1678, 531, 2092, 2125, 1677, 483, 1676, 2415,
+	1672, 2087, 964, 965, 966, 963, 1671, 1290, 1077, 2099,
+	2744, 1236, 3025, 2983, 2981, 482, 2906, 478, 2854, 2831,
+	2149, 481, 1386, 1387, 1199, 2084, 2079, 1191, 1421, 1807,
+	1808, 1193, 2077, 479, 2178, 461, 1431, 2773, 2022, 480,
+	2766, 1497, 2746, 2075, 2742, 2414, 2740, 2200, 1191, 2095,
+	2096, 2712, 2098, 2206, 2843, 2708, 2278, 2109, 964, 965,
+	966, 963, 1330, 2164, 2675, 806, 1235, 2167, 2215, 964,
+	965, 966, 963, 964, 965, 966, 963, 1471, 2634, 2633,
+	2630, 1475, 2226, 2623, 1478, 2565, 964, 965, 966, 963,
+	1694, 2232, 2233, 2234, 2557, 2545, 1694, 2239, 1694, 2544,
+	2241, 2448, 2447, 2446, 2207, 2209, 2150, 3028, 2191, 2211,
+	2153, 2445, 2653, 1811, 2000, 2360, 1260, 1261, 2336, 2260,
+	2253, 2197, 2249, 2248, 2274, 1255, 2247, 898, 1802, 2190,
+	1680, 964, 965, 966, 963, 2134, 2173, 623, 622, 2652,
+	2139, 1674, 1480, 1497, 898, 1607, 1607, 1607, 1607, 2948,
+	1038, 1034, 2221, 2930, 3091, 1033, 898, 1607, 2650, 1009,
+	2000, 2224, 887, 2000, 2622, 2224, 1265, 2610, 1268, 2601,
+	1193, 2600, 2176, 964, 965, 966, 963, 964, 965, 966,
+	963, 461, 461, 2590, 8, 2199, 461, 2205, 2589, 1655,
+	7, 1607, 2495, 2420, 2345, 2413, 2347, 1471, 2217, 2222,
+	187, 2405, 2400, 1471, 1471, 187, 2340, 2279, 2165, 2320,
+	2162, 2244, 2245, 2054, 2050, 1500, 2049, 2250, 2251, 2228,
+	1789, 1779, 2210, 1777, 2307, 1773, 1437, 1772, 1437, 1759,
+	1770, 2377, 2254, 2342, 2381, 2225, 2307, 2282, 1761, 1654,
+	1654, 1193, 2349, 1758, 2388, 1757, 1679, 1466, 2322, 1440,
+	1193, 1660, 1439, 1430, 1663, 2324, 2351, 1666, 2281, 2285,
+	1668, 2355, 2214, 1205, 1203, 3085, 3073, 3070, 2208, 2179,
+	2308, 2309, 2310, 2311, 170, 2212, 2213, 3068, 2323, 2319,
+	2321, 2198, 2791, 1470, 3063, 2970, 2344, 2947, 2376, 2334,
+	2385, 2333, 2337, 2231, 2925, 678, 2751, 2904, 1028, 2237,
+	170, 2240, 1250, 162, 135, 2343, 2374, 964, 965, 966,
+	963, 2408, 2380, 2410, 2808, 2796, 2793, 2353, 2352, 2725,
+	964, 965, 966, 963, 2390, 2750, 2723, 898, 2703, 2373,
+	2699, 2698, 167, 2368, 2460, 2695, 2370, 2375, 2465, 2694,
+	2688, 1516, 461, 461, 2645, 1114, 1259, 2384, 2701, 964,
+	965, 966, 963, 898, 898, 898, 2397, 2372, 167, 2398,
+	1252, 2394, 1607, 1889, 2379, 2493, 2620, 2280, 2404, 2275,
+	2230, 2497, 964, 965, 966, 963, 2220, 2411, 2412, 898,
+	2470, 2194, 2193, 2409, 2528, 2192, 2531, 1264, 2531, 2531,
+	964, 965, 966, 963, 1267, 1256, 2536, 2148, 1748, 2418,
+	2450, 1752, 2406, 2407, 2063, 1193, 1193, 1831, 2010, 2441,
+	167, 1965, 1890, 1371, 2496, 2338, 2339, 2449, 2498, 2499,
+	2341, 2452, 2444, 964, 965, 966, 963, 1645, 1491, 1490,
+	2471, 1911, 1315, 1916, 1916, 1916, 461, 1279, 2190, 1257,
+	1762, 898, 2474, 2460, 1753, 2553, 2473, 1061, 1769, 1058,
+	2492, 1057, 2526, 2527, 1056, 2542, 2543, 2491, 2488, 1916,
+	1055, 2454, 2455, 1497, 1497, 1054, 1782, 2481, 2482, 1785,
+	1786, 1787, 1053, 1052, 1790, 1791, 1792, 1793, 1794, 1795,
+	1796, 1797, 2425, 1051, 2532, 2533, 2426, 2427, 2428, 2429,
+	1050, 2430, 2431, 2432, 2433, 2434, 2435, 2436, 2437, 682,
+	683, 684, 685, 964, 965, 966, 963, 1049, 1048, 2501,
+	1047, 2569, 681, 3009, 2417, 1046, 1191, 1191, 2599, 1045,
+	1044, 1336, 578, 117, 2548, 806, 2581, 2554, 117, 2555,
+	2558, 1043, 806, 2416, 1893, 1042, 2567, 2568, 964, 965,
+	966, 963, 1041, 1037, 2534, 1036, 1035, 1032, 1025, 1024,
+	1022, 1021, 1020, 1019, 2578, 2147, 461, 964, 965, 966,
+	963, 3007, 1204, 1018, 1165, 1017, 1167, 1016, 1171, 1172,
+	2583, 1015, 2586, 2587, 2588, 2582, 1636, 2472, 1014, 964,
+	965, 966, 963, 467, 1013, 2500, 117, 1012, 2594, 2146,
+	1011, 1007, 2613, 1006, 929, 1206, 1207, 1208, 1209, 1210,
+	1211, 1212, 3106, 1214, 885, 2574, 2575, 1219, 1220, 2696,
+	1222, 2611, 1895, 964, 965, 966, 963, 1878, 2612, 2145,
+	917, 2952, 2614, 2577, 2182, 2025, 2021, 1874, 1497, 806,
+	806, 1682, 928, 2580, 2624, 103, 2579, 59, 2649, 1471,
+	1471, 1471, 2144, 964, 965, 966, 963, 2316, 2313, 2000,
+	1607, 2663, 2317, 978, 977, 987, 988, 980, 981, 982,
+	983, 984, 985, 986, 979, 2671, 964, 965, 966, 963,
+	1636, 2312, 458, 2674, 2072, 1193, 2626, 806, 1988, 1991,
+	1992, 1993, 1989, 2066, 1990, 1994, 461, 2628, 2097, 2155,
+	804, 2629, 2442, 2443, 117, 2528, 463, 2664, 464, 2635,
+	2665, 2636, 2314, 2667, 1588, 58, 2668, 2315, 2143, 117,
+	2453, 117, 978, 977, 987, 988, 980, 981, 982, 983,
+	984, 985, 986, 979, 2142, 1244, 2318, 1497, 1992, 1993,
+	462, 898, 964, 965, 966, 963, 2141, 2526, 2672, 2061,
+	2091, 2661, 2646, 2647, 2648, 2662, 1807, 1808, 964, 965,
+	966, 963, 1063, 2140, 2119, 1273, 2728, 187, 2727, 1865,
+	964, 965, 966, 963, 2700, 2719, 465, 2102, 1646, 923,
+	898, 2707, 2923, 3016, 2216, 2120, 2121, 964, 965, 966,
+	963, 2169, 2711, 2123, 2124, 2168, 2677, 1885, 1514, 1489,
+	2617, 2721, 2726, 2720, 2718, 1970, 2129, 2639, 1591, 2759,
+	2640, 2641, 1747, 1426, 1425, 1164, 898, 1193, 1193, 2733,
+	1163, 2307, 898, 2735, 955, 2137, 1471, 2585, 2638, 2151,
+	2152, 1478, 2776, 2745, 2469, 2776, 978, 977, 987, 988,
+	980, 981, 982, 983, 984, 985, 986, 979, 2760, 964,
+	965, 966, 963, 1876, 1075, 1076, 2764, 1073, 1074, 1702,
+	2307, 2136, 1071, 1072, 1118, 898, 898, 2771, 1065, 898,
+	898, 2780, 2779, 461, 2777, 2814, 3086, 2787, 2788, 2772,
+	2665, 1069, 1070, 2991, 2789, 964, 965, 966, 963, 2977,
+	2975, 2933, 2916, 2915, 1516, 2913, 2820, 2905, 2135, 2797,
+	2798, 2823, 1916, 2806, 2807, 2794, 2822, 2826, 2827, 2805,
+	2741, 2731, 2625, 2616, 2608, 2825, 2817, 2607, 1068, 990,
+	2730, 994, 964, 965, 966, 963, 681, 2592, 1191, 2677,
+	2693, 1518, 2851, 2818, 2131, 2383, 1516, 991, 993, 989,
+	1880, 992, 978, 977, 987, 988, 980, 981, 982, 983,
+	984, 985, 986, 979, 3011, 3010, 3010, 2866, 964, 965,
+	966, 963, 1760, 914, 690, 2122, 3011, 898, 2690, 2609,
+	2100, 2849, 2880, 174, 3, 2856, 1132, 2861, 1381, 898,
+	67, 2, 1630, 2752, 1197, 1, 1479, 2868, 2867, 964,
+	965, 966, 963, 2876, 964, 965, 966, 963, 2885, 1983,
+	686, 2889, 964, 965, 966, 963, 2326, 117, 117, 804,
+	2327, 1643, 2584, 2894, 964, 965, 966, 963, 2900, 1649,
+	2329, 1722, 1988, 1991, 1992, 1993, 1989, 1968, 1990, 1994,
+	2917, 898, 682, 683, 684, 685, 1867, 2912, 2910, 2464,
+	1109, 725, 1432, 823, 1224, 681, 908, 2934, 1299, 907,
+	905, 1383, 2929, 580, 1654, 1685, 2276, 2819, 3015, 3054,
+	2942, 2928, 2969, 3018, 1313, 564, 2907, 2935, 2835, 2354,
+	2973, 2356, 2837, 2940, 2964, 2967, 2941, 720, 2748, 1727,
+	995, 960, 2369, 2957, 2958, 2959, 2960, 745, 616, 1471,
+	591, 1023, 2968, 1410, 1471, 1281, 1274, 2423, 1226, 590,
+	2976, 2564, 2978, 2979, 2974, 2972, 1977, 2813, 2869, 714,
+	1223, 746, 1669, 2833, 1245, 1266, 3001, 1249, 2781, 2654,
+	2483, 2990, 2195, 2943, 2790, 3084, 2896, 2996, 3109, 2399,
+	3035, 3004, 3071, 2755, 2753, 2754, 2926, 3064, 3005, 2992,
+	3008, 3022, 3006, 501, 1610, 445, 786, 2809, 3021, 3012,
+	1681, 2419, 502, 1894, 2984, 2795, 712, 1877, 713, 2188,
+	2187, 1352, 898, 969, 3026, 3027, 1369, 2438, 2439, 1004,
+	170, 540, 56, 162, 135, 1749, 552, 2519, 2335, 66,
+	65, 64, 3044, 2880, 63, 3053, 3043, 3045, 3038, 3040,
+	163, 2031, 3056, 195, 3052, 582, 194, 155, 2966, 3020,
+	562, 164, 3061, 722, 898, 717, 115, 703, 3062, 561,
+	3067, 560, 3069, 1067, 719, 718, 559, 558, 1987, 1985,
+	1984, 104, 1600, 2029, 2539, 1552, 2942, 1938, 167, 3022,
+	3081, 701, 1928, 1551, 898, 711, 3021, 3080, 3083, 898,
+	2949, 898, 3032, 3088, 2886, 3090, 3093, 2887, 2687, 2261,
+	2683, 2679, 2546, 2775, 3056, 2505, 2535, 3099, 3094, 2506,
+	3100, 1406, 898, 3029, 2512, 1403, 3104, 1884, 3107, 1405,
+	1402, 1404, 1408, 1409, 842, 838, 716, 1407, 840, 841,
+	715, 839, 2108, 2104, 1336, 1913, 700, 1915, 1914, 2479,
+	707, 1822, 1821, 708, 709, 3089, 1819, 1818, 1088, 2850,
+	2627, 1829, 122, 123, 710, 124, 125, 704, 1827, 2576,
+	2572, 2466, 1476, 2154, 1336, 1603, 1598, 1981, 1879, 1336,
+	2468, 1336, 2642, 705, 40, 151, 53, 2857, 95, 150,
+	52, 149, 51, 148, 1202, 50, 93, 92, 101, 467,
+	147, 49, 1336, 179, 702, 178, 978, 977, 987, 988,
+	980, 981, 982, 983, 984, 985, 986, 979, 723, 181,
+	117, 180, 177, 2040, 2041, 176, 1233, 175, 2778, 675,
+	134, 161, 168, 39, 102, 38, 37, 33, 12, 11,
+	34, 21, 706, 22, 20, 1304, 19, 25, 31, 30,
+	110, 109, 160, 154, 153, 29, 108, 107, 106, 62,
+	858, 105, 1391, 1392, 1393, 1394, 1395, 1396, 1397, 1398,
+	1399, 1400, 1401, 1413, 1414, 1415, 1416, 1417, 1418, 1411,
+	1412, 117, 28, 18, 44, 117, 43, 42, 9, 170,
+	100, 56, 162, 135, 98, 27, 117, 99, 96, 97,
+	94, 2619, 78, 77, 76, 90, 89, 117, 2621, 163,
+	88, 87, 86, 721, 85, 84, 155, 156, 157, 158,
+	164, 744, 75, 74, 73, 115, 72, 71, 82, 91,
+	83, 81, 80, 79, 70, 69, 119, 120, 68, 132,
+	104, 133, 121, 131, 130, 129, 128, 167, 812, 807,
+	811, 813, 127, 126, 45, 46, 47, 48, 143, 165,
+	142, 144, 146, 152, 145, 846, 140, 138, 141, 836,
+	139, 137, 61, 17, 24, 818, 4, 111, 0, 810,
+	0, 159, 0, 112, 0, 866, 870, 872, 874, 876,
+	877, 879, 0, 883, 880, 881, 882, 0, 0, 861,
+	862, 863, 864, 844, 845, 867, 0, 847, 0, 848,
+	849, 850, 851, 852, 853, 854, 855, 856, 857, 859,
+	865, 122, 123, 3087, 124, 125, 0, 816, 869, 871,
+	873, 875, 878, 0, 819, 0, 113, 820, 821, 0,
+	0, 0, 0, 0, 0, 0, 55, 0, 822, 0,
+	0, 814, 0, 0, 0, 0, 0, 1471, 0, 0,
+	2722, 0, 0, 2724, 0, 860, 0, 808, 0, 0,
+	0, 0, 0, 0, 978, 977, 987, 988, 980, 981,
+	982, 983, 984, 985, 986, 979, 0, 0, 817, 134,
+	161, 168, 0, 102, 57, 0, 0, 0, 0, 2510,
+	977, 987, 988, 980, 981, 982, 983, 984, 985, 986,
+	979, 160, 154, 153, 0, 0, 0, 0, 62, 0,
+	0, 0, 0, 0, 2520, 0, 809, 136, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2513, 0, 0,
+	0, 0, 0, 0, 0, 2508, 0, 0, 0, 0,
+	2523, 2524, 2421, 0, 0, 0, 2509, 0, 0, 0,
+	1410, 0, 0, 0, 0, 0, 1606, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 156, 157, 158, 0,
+	0, 114, 41, 0, 0, 0, 0, 0, 54, 5,
+	0, 0, 118, 2514, 0, 119, 120, 815, 0, 0,
+	0, 121, 0, 978, 977, 987, 988, 980, 981, 982,
+	983, 984, 985, 986, 979, 0, 2824, 0, 165, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 117,
+	0, 0, 117, 117, 0, 117, 111, 0, 0, 0,
+	159, 2848, 112, 0, 0, 0, 0, 0, 0, 858,
+	0, 0, 0, 0, 0, 0, 2862, 978, 977, 987,
+	988, 980, 981, 982, 983, 984, 985, 986, 979, 0,
+	804, 0, 0, 0, 0, 0, 0, 804, 0, 2884,
+	0, 0, 0, 1584, 2522, 117, 1921, 0, 0, 0,
+	117, 0, 0, 0, 0, 113, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 55, 0, 0, 0, 0,
+	0, 2516, 0, 0, 0, 0, 0, 1588, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1406, 0,
+	0, 2848, 1403, 2515, 2517, 868, 1405, 1402, 1404, 1408,
+	1409, 0, 0, 0, 1407, 0, 0, 0, 0, 0,
+	0, 0, 0, 57, 846, 1566, 0, 0, 0, 0,
+	0, 1584, 0, 0, 995, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 866, 870, 872, 874, 876, 877,
+	879, 0, 883, 880, 881, 882, 136, 0, 861, 862,
+	863, 864, 844, 845, 867, 1588, 847, 0, 848, 849,
+	850, 851, 852, 853, 854, 855, 856, 857, 859, 865,
+	0, 2982, 0, 0, 0, 0, 0, 869, 871, 873,
+	875, 878, 0, 0, 2525, 0, 0, 0, 0, 0,
+	0, 0, 0, 1566, 0, 0, 2511, 0, 0, 0,
+	114, 41, 2521, 0, 0, 0, 0, 54, 0, 0,
+	0, 118, 0, 0, 860, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2848, 0, 0, 1391,
+	1392, 1393, 1394, 1395, 1396, 1397, 1398, 1399, 1400, 1401,
+	1413, 1414, 1415, 1416, 1417, 1418, 1411, 1412, 1560, 1556,
+	0, 1584, 1555, 0, 0, 0, 0, 1570, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1574, 0,
+	0, 0, 0, 0, 0, 0, 1557, 0, 0, 1559,
+	0, 0, 0, 0, 0, 1588, 0, 0, 1563, 0,
+	0, 0, 1565, 1567, 1569, 0, 1571, 1572, 1573, 1575,
+	1576, 1577, 1579, 1580, 1581, 1582, 0, 0, 0, 1553,
+	0, 0, 0, 2879, 0, 0, 0, 0, 0, 0,
+	1584, 0, 0, 1566, 0, 3096, 1560, 2219, 0, 0,
+	2218, 0, 0, 0, 0, 1570, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1574, 1558, 0, 0,
+	1586, 1587, 0, 0, 1588, 1606, 0, 2004, 0, 0,
+	1585, 0, 0, 0, 0, 0, 1563, 0, 0, 0,
+	1565, 1567, 1569, 0, 1571, 1572, 1573, 1575, 1576, 1577,
+	1579, 1580, 1581, 1582, 0, 0, 0, 0, 0, 0,
+	0, 0, 1566, 0, 0, 0, 0, 1583, 0, 0,
+	0, 0, 512, 0, 511, 518, 508, 0, 0, 0,
+	0, 1584, 117, 0, 1562, 0, 515, 516, 0, 517,
+	521, 0, 0, 503, 0, 0, 0, 0, 1586, 1587,
+	0, 0, 0, 526, 0, 0, 0, 0, 1585, 0,
+	0, 0, 0, 1578, 0, 1588, 0, 0, 0, 0,
+	1568, 0, 0, 2865, 0, 0, 0, 117, 0, 0,
+	0, 0, 0, 530, 0, 1570, 532, 0, 0, 0,
+	0, 531, 0, 0, 0, 1583, 1574, 0, 0, 0,
+	0, 0, 0, 1566, 868, 0, 0, 0, 0, 0,
+	0, 0, 1562, 0, 0, 0, 1563, 0, 0, 0,
+	1565, 1567, 1569, 0, 1571, 1572, 1573, 1575, 1576, 1577,
+	1579, 1580, 1581, 1582, 0, 0, 0, 0, 0, 0,
+	0, 1578, 0, 512, 0, 511, 518, 508, 1568, 0,
+	0, 0, 0, 0, 1570, 0, 0, 515, 516, 0,
+	517, 521, 0, 0, 503, 1574, 0, 0, 0, 0,
+	0, 0, 0, 0, 526, 0, 0, 0, 1586, 1587,
+	0, 0, 0, 0, 0, 1563, 0, 0, 1585, 1565,
+	1567, 1569, 0, 1571, 1572, 1573, 1575, 1576, 1577, 1579,
+	1580, 1581, 1582, 0, 530, 0, 0, 532, 0, 0,
+	0, 0, 531, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 504, 506, 505, 1583, 0, 0, 0, 0,
+	0, 0, 510, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1562, 0, 514, 1570, 0, 1586, 1587, 0,
+	0, 529, 0, 0, 0, 0, 1574, 1585, 507, 0,
+	0, 0, 498, 117, 0, 0, 0, 0, 0, 0,
+	0, 1578, 0, 0, 0, 0, 1563, 0, 1568, 0,
+	1565, 1567, 1569, 0, 1571, 1572, 1573, 1575, 1576, 1577,
+	1579, 1580, 1581, 1582, 1583, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1562, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1586, 1587,
+	1578, 0, 0, 504, 506, 505, 0, 1568, 1585, 0,
+	0, 0, 0, 510, 0, 0, 0, 1606, 1606, 1606,
+	1606, 0, 0, 0, 0, 514, 0, 0, 0, 1606,
+	0, 0, 529, 509, 513, 519, 0, 520, 522, 507,
+	0, 523, 524, 525, 0, 1583, 527, 528, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1562, 1606, 0, 0, 0, 0, 0, 0,
+	0, 0, 117, 0, 0, 0, 0, 117, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1578, 0, 0, 0, 0, 0, 117, 1568, 0,
+	0, 0, 0, 0, 117, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 369,
+	598, 0, 0, 0, 509, 513, 519, 0, 520, 522,
+	332, 0, 523, 524, 525, 0, 0, 527, 528, 0,
+	0, 0, 0, 554, 0, 0, 0, 278, 0, 0,
+	302, 0, 0, 0, 589, 0, 0, 361, 316, 0,
+	0, 0, 0, 646, 654, 0, 0, 0, 0, 0,
+	0, 117, 117, 0, 0, 0, 547, 0, 0, 579,
+	623, 622, 566, 575, 0, 0, 260, 193, 0, 567,
+	0, 574, 568, 572, 571, 569, 570, 0, 638, 0,
+	0, 0, 0, 0, 1606, 538, 551, 2845, 555, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 117,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 548, 549, 0, 0, 0, 0, 599, 0,
+	550, 0, 0, 594, 576, 577, 0, 0, 0, 0,
+	251, 366, 382, 261, 357, 395, 266, 364, 256, 331,
+	354, 0, 0, 253, 380, 363, 313, 296, 297, 252,
+	0, 349, 276, 289, 273, 329, 573, 597, 601, 272,
+	660, 595, 390, 255, 0, 389, 328, 376, 381, 314,
+	308, 254, 378, 312, 307, 300, 280, 661, 293, 340,
+	306, 341, 294, 318, 317, 319, 0, 0, 0, 0,
+	0, 419, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 592, 0, 0, 0, 392,
+	0, 0, 644, 0, 0, 0, 365, 0, 0, 301,
+	0, 0, 0, 596, 0, 352, 334, 657, 539, 0,
+	350, 304, 377, 342, 383, 367, 391, 346, 343, 246,
+	368, 275, 315, 257, 259, 271, 277, 279, 281, 282,
+	324, 325, 337, 356, 370, 371, 372, 274, 267, 351,
+	268, 291, 269, 247, 358, 270, 249, 338, 375, 0,
+	287, 347, 311, 250, 310, 339, 374, 373, 258, 399,
+	405, 406, 411, 0, 412, 0, 0, 0, 420, 425,
+	426, 427, 429, 442, 443, 430, 431, 432, 433, 434,
+	435, 436, 437, 438, 452, 453, 439, 440, 0, 441,
+	454, 444, 0, 0, 0, 0, 414, 0, 0, 0,
+	0, 0, 0, 404, 285, 243, 244, 451, 642, 330,
+	0, 0, 656, 637, 639, 640, 643, 647, 648, 649,
+	650, 651, 653, 655, 659, 450, 0, 0, 0, 0,
+	0, 449, 336, 0, 355, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 362, 385, 397,
+	415, 418, 1606, 0, 0, 248, 417, 0, 2846, 0,
+	0, 0, 2847, 0, 658, 0, 0, 0, 396, 0,
+	0, 0, 0, 0, 600, 320, 321, 322, 323, 645,
+	0, 265, 416, 345, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	409, 410, 284, 290, 428, 292, 264, 335, 286, 394,
+	298, 0, 421, 0, 422, 0, 0, 0, 0, 327,
+	295, 359, 299, 305, 348, 393, 333, 353, 262, 384,
+	360, 309, 0, 0, 667, 641, 666, 668, 669, 665,
+	670, 671, 652, 557, 0, 604, 663, 662, 664, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 117,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 245, 0, 303, 0, 344, 283, 630, 609,
+	610, 611, 556, 612, 607, 608, 631, 602, 627, 628,
+	581, 605, 613, 626, 614, 629, 632, 633, 672, 673,
+	620, 674, 617, 634, 625, 624, 615, 603, 635, 636,
+	588, 583, 618, 619, 606, 621, 584, 585, 586, 587,
+	369, 598, 0, 400, 401, 402, 424, 386, 0, 448,
+	0, 332, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 554, 0, 0, 0, 278, 0,
+	0, 302, 0, 0, 0, 589, 0, 0, 361, 316,
+	0, 0, 0, 0, 646, 654, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 547, 0, 0,
+	579, 623, 622, 566, 575, 0, 0, 260, 193, 0,
+	567, 0, 574, 568, 572, 571, 569, 570, 0, 638,
+	0, 0, 0, 0, 0, 0, 538, 551, 0, 555,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 548, 549, 0, 0, 0, 0, 599,
+	0, 550, 0, 0, 594, 576, 577, 0, 0, 0,
+	0, 251, 366, 382, 261, 357, 395, 266, 364, 256,
+	331, 354, 0, 0, 253, 380, 363, 313, 296, 297,
+	252, 0, 349, 276, 289, 273, 329, 573, 597, 601,
+	272, 660, 595, 390, 255, 0, 389, 328, 376, 381,
+	314, 308, 254, 378, 312, 307, 300, 280, 661, 293,
+	340, 306, 341, 294, 318, 317, 319, 0, 0, 0,
+	0, 0, 419, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 592, 0, 0, 0,
+	392, 0, 0, 644, 0, 0, 0, 365, 0, 0,
+	301, 0, 0, 0, 596, 0, 352, 334, 657, 539,
+	0, 350, 304, 377, 342, 383, 367, 391, 346, 343,
+	246, 368, 275, 315, 257, 259, 271, 277, 279, 281,
+	282, 324, 325, 337, 356, 370, 371, 372, 274, 267,
+	351, 268, 291, 269, 247, 358, 270, 249, 338, 375,
+	0, 287, 347, 311, 250, 310, 339, 374, 373, 258,
+	399, 405, 406, 411, 0, 412, 0, 0, 0, 420,
+	425, 426, 427, 429, 442, 443, 430, 431, 432, 433,
+	434, 435, 436, 437, 438, 452, 453, 439, 440, 0,
+	441, 454, 444, 0, 0, 0, 0, 414, 0, 0,
+	0, 1434, 1433, 1435, 404, 285, 243, 244, 451, 642,
+	330, 0, 0, 656, 637, 639, 640, 643, 647, 648,
+	649, 650, 651, 653, 655, 659, 450, 0, 0, 0,
+	0, 0, 449, 336, 0, 355, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 362, 385,
+	397, 415, 418, 0, 0, 0, 248, 417, 0, 0,
+	0, 0, 0, 0, 0, 658, 0, 0, 0, 396,
+	0, 0, 0, 0, 0, 600, 320, 321, 322, 323,
+	645, 0, 265, 416, 345, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 409, 410, 284, 290, 428, 292, 264, 335, 286,
+	394, 298, 0, 421, 0, 422, 0, 0, 0, 0,
+	327, 295, 359, 299, 305, 348, 393, 333, 353, 262,
+	384, 360, 309, 0, 0, 667, 641, 666, 668, 669,
+	665, 670, 671, 652, 557, 0, 604, 663, 662, 664,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 245, 0, 303, 0, 344, 283, 630,
+	609, 610, 611, 556, 612, 607, 608, 631, 602, 627,
+	628, 581, 605, 613, 626, 614, 629, 632, 633, 672,
+	673, 620, 674, 617, 634, 625, 624, 615, 603, 635,
+	636, 588, 583, 618, 619, 606, 621, 584, 585, 586,
+	587, 369, 598, 0, 400, 401, 402, 424, 386, 0,
+	448, 0, 332, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 554, 0, 0, 0, 278,
+	0, 0, 302, 0, 0, 0, 589, 0, 0, 361,
+	316, 0, 0, 0, 0, 646, 654, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 547, 0,
+	0, 579, 623, 622, 566, 575, 0, 0, 260, 193,
+	0, 567, 0, 574, 568, 572, 571, 569, 570, 0,
+	638, 0, 0, 0, 0, 0, 0, 538, 551, 0,
+	555, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 548, 549, 0, 0, 0, 0,
+	599, 0, 550, 0, 0, 594, 576, 577, 0, 0,
+	0, 0, 251, 366, 382, 261, 357, 395, 266, 364,
+	256, 331, 354, 0, 0, 253, 380, 363, 313, 296,
+	297, 252, 0, 349, 276, 289, 273, 329, 573, 597,
+	601, 272, 660, 595, 390, 255, 0, 389, 328, 376,
+	381, 314, 308, 254, 378, 312, 307, 300, 280, 661,
+	293, 340, 306, 341, 294, 318, 317, 319, 0, 0,
+	0, 0, 0, 419, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 592, 0, 0,
+	0, 392, 0, 0, 644, 0, 0, 0, 365, 0,
+	0, 301, 0, 0, 0, 596, 0, 352, 334, 657,
+	539, 0, 350, 304, 377, 342, 383, 367, 391, 346,
+	343, 246, 368, 275, 315, 257, 259, 271, 277, 279,
+	281, 282, 324, 325, 337, 356, 370, 371, 372, 274,
+	267, 351, 268, 291, 269, 247, 358, 270, 249, 338,
+	375, 0, 287, 347, 311, 250, 310, 339, 374, 373,
+	258, 399, 405, 406, 411, 0, 412, 0, 0, 0,
+	420, 425, 426, 427, 429, 442, 443, 430, 431, 432,
+	433, 434, 435, 436, 437, 438, 452, 453, 439, 440,
+	0, 441, 454, 444, 0, 0, 0, 0, 414, 0,
+	0, 0, 0, 0, 0, 404, 285, 243, 244, 451,
+	642, 330, 0, 0, 656, 637, 639, 640, 643, 647,
+	648, 649, 650, 651, 653, 655, 659, 450, 0, 0,
+	0, 0, 0, 449, 336, 0, 355, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 362,
+	385, 397, 415, 418, 0, 0, 0, 248, 417, 0,
+	2846, 0, 0, 0, 2847, 0, 658, 0, 0, 0,
+	396, 0, 0, 0, 0, 0, 600, 320, 321, 322,
+	323, 645, 0, 265, 416, 345, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 409, 410, 284, 290, 428, 292, 264, 335,
+	286, 394, 298, 0, 421, 0, 422, 0, 0, 0,
+	0, 327, 295, 359, 299, 305, 348, 393, 333, 353,
+	262, 384, 360, 309, 0, 0, 667, 641, 666, 668,
+	669, 665, 670, 671, 652, 557, 0, 604, 663, 662,
+	664, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 245, 0, 303, 0, 344, 283,
+	630, 609, 610, 611, 556, 612, 607, 608, 631, 602,
+	627, 628, 581, 605, 613, 626, 614, 629, 632, 633,
+	672, 673, 620, 674, 617, 634, 625, 624, 615, 603,
+	635, 636, 588, 583, 618, 619, 606, 621, 584, 585,
+	586, 587, 369, 598, 0, 400, 401, 402, 424, 386,
+	0, 448, 0, 332, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 554, 0, 0, 0,
+	278, 1472, 0, 302, 0, 0, 0, 589, 0, 0,
+	361, 316, 0, 0, 0, 0, 646, 654, 0, 0,
+	0, 0, 0, 0, 0, 0, 1620, 0, 0, 547,
+	0, 0, 579, 623, 622, 566, 575, 0, 0, 260,
+	193, 0, 567, 0, 574, 568, 572, 571, 569, 570,
+	0, 638, 0, 0, 0, 0, 0, 0, 538, 551,
+	0, 555, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 548, 549, 0, 0, 0,
+	0, 599, 0, 550, 0, 0, 1621, 576, 577, 0,
+	0, 0, 0, 251, 366, 382, 261, 357, 395, 266,
+	364, 256, 331, 354, 0, 0, 253, 380, 363, 313,
+	296, 297, 252, 0, 349, 276, 289, 273, 329, 573,
+	597, 601, 272, 660, 595, 390, 255, 0, 389, 328,
+	376, 381, 314, 308, 254, 378, 312, 307, 300, 280,
+	661, 293, 340, 306, 341, 294, 318, 317, 319, 0,
+	0, 0, 0, 0, 419, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 592, 0,
+	0, 0, 392, 0, 0, 644, 0, 0, 0, 365,
+	0, 0, 301, 0, 0, 0, 596, 0, 352, 334,
+	657, 539, 0, 350, 304, 377, 342, 383, 367, 391,
+	346, 343, 246, 368, 275, 315, 257, 259, 271, 277,
+	279, 281, 282, 324, 325, 337, 356, 370, 371, 372,
+	274, 267, 351, 268, 291, 269, 247, 358, 270, 249,
+	338, 375, 0, 287, 347, 311, 250, 310, 339, 374,
+	373, 258, 399, 405, 406, 411, 0, 412, 0, 0,
+	0, 420, 425, 426, 427, 429, 442, 443, 430, 431,
+	432, 433, 434, 435, 436, 437, 438, 452, 453, 439,
+	440, 0, 441, 454, 444, 0, 0, 0, 0, 414,
+	0, 0, 0, 0, 0, 0, 404, 285, 243, 244,
+	451, 642, 330, 0, 0, 656, 637, 639, 640, 643,
+	647, 648, 649, 650, 651, 653, 655, 659, 450, 0,
+	0, 0, 0, 0, 449, 336, 0, 355, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	362, 385, 397, 415, 418, 0, 0, 0, 248, 417,
+	0, 0, 0, 0, 0, 0, 0, 658, 0, 0,
+	0, 396, 0, 0, 0, 0, 0, 600, 320, 321,
+	322, 323, 645, 0, 265, 416, 345, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 284, 290, 428, 292, 264,
+	335, 286, 394, 298, 0, 421, 0, 422, 0, 0,
+	0, 0, 327, 295, 359, 299, 305, 348, 393, 333,
+	353, 262, 384, 360, 309, 0, 0, 667, 641, 666,
+	668, 669, 665, 670, 671, 652, 557, 0, 604, 663,
+	662, 664, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 245, 0, 303, 0, 344,
+	283, 630, 609, 610, 611, 556, 612, 607, 608, 631,
+	602, 627, 628, 581, 605, 613, 626, 614, 629, 632,
+	633, 672, 673, 620, 674, 617, 634, 625, 624, 615,
+	603, 635, 636, 588, 583, 618, 619, 606, 621, 584,
+	585, 586, 587, 170, 369, 598, 400, 401, 402, 424,
+	386, 0, 448, 0, 0, 332, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 554, 0,
+	0, 0, 278, 0, 0, 302, 0, 0, 0, 998,
+	0, 0, 361, 316, 0, 0, 0, 0, 646, 654,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 547, 0, 0, 579, 623, 622, 566, 575, 0,
+	0, 260, 193, 0, 567, 0, 574, 568, 572, 571,
+	569, 570, 0, 638, 0, 0, 0, 0, 0, 0,
+	538, 551, 0, 555, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 548, 549, 0,
+	0, 0, 0, 599, 0, 550, 0, 0, 594, 576,
+	577, 0, 0, 0, 0, 251, 366, 382, 261, 357,
+	395, 266, 364, 256, 331, 354, 0, 0, 253, 380,
+	363, 313, 296, 297, 252, 0, 349, 276, 289, 273,
+	329, 573, 597, 601, 272, 660, 595, 390, 255, 0,
+	389, 328, 376, 381, 314, 308, 254, 378, 312, 307,
+	300, 280, 661, 293, 340, 306, 341, 294, 318, 317,
+	319, 0, 0, 0, 0, 0, 419, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	592, 0, 0, 0, 392, 0, 0, 644, 0, 0,
+	0, 365, 0, 0, 301, 0, 0, 0, 596, 0,
+	352, 334, 657, 539, 0, 350, 304, 377, 342, 383,
+	367, 391, 346, 343, 246, 368, 275, 315, 257, 259,
+	271, 277, 279, 281, 282, 324, 325, 337, 356, 370,
+	371, 372, 274, 267, 351, 268, 291, 269, 247, 358,
+	270, 249, 338, 375, 0, 287, 347, 311, 250, 310,
+	339, 374, 373, 258, 399, 405, 406, 411, 0, 412,
+	0, 0, 0, 420, 425, 426, 427, 429, 442, 443,
+	430, 431, 432, 433, 434, 435, 436, 437, 438, 452,
+	453, 439, 440, 0, 441, 454, 444, 0, 0, 0,
+	0, 414, 0, 0, 0, 0, 0, 0, 404, 285,
+	243, 244, 451, 642, 330, 0, 0, 656, 637, 639,
+	640, 643, 647, 648, 649, 650, 651, 653, 655, 659,
+	450, 0, 0, 0, 0, 0, 449, 336, 0, 355,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 362, 385, 397, 415, 418, 0, 0, 0,
+	248, 417, 0, 0, 0, 0, 0, 0, 0, 658,
+	0, 0, 0, 396, 0, 0, 0, 0, 0, 600,
+	320, 321, 322, 323, 645, 0, 265, 416, 345, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 409, 410, 284, 290, 428,
+	292, 264, 335, 286, 394, 298, 0, 421, 0, 422,
+	0, 0, 0, 0, 327, 295, 359, 299, 305, 348,
+	393, 333, 353, 262, 384, 360, 309, 0, 0, 667,
+	641, 666, 668, 669, 665, 670, 671, 652, 557, 0,
+	604, 663, 662, 664, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 245, 0, 303,
+	136, 344, 283, 630, 609, 610, 611, 556, 612, 607,
+	608, 631, 602, 627, 628, 581, 605, 613, 626, 614,
+	629, 632, 633, 672, 673, 620, 674, 617, 634, 625,
+	624, 615, 603, 635, 636, 588, 583, 618, 619, 606,
+	621, 584, 585, 586, 587, 369, 598, 0, 400, 401,
+	402, 424, 386, 0, 448, 0, 332, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 554,
+	0, 0, 0, 278, 3095, 0, 302, 0, 0, 0,
+	589, 0, 0, 361, 316, 0, 0, 0, 0, 646,
+	654, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 547, 0, 0, 579, 623, 622, 566, 575,
+	0, 0, 260, 193, 0, 567, 0, 574, 568, 572,
+	571, 569, 570, 0, 638, 0, 0, 0, 0, 0,
+	0, 538, 551, 0, 555, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 548, 549,
+	0, 0, 0, 0, 599, 0, 550, 0, 0, 594,
+	576, 577, 0, 0, 0, 0, 251, 366, 382, 261,
+	357, 395, 266, 364, 256, 331, 354, 0, 0, 253,
+	380, 363, 313, 296, 297, 252, 0, 349, 276, 289,
+	273, 329, 573, 597, 601, 272, 660, 595, 390, 255,
+	0, 389, 328, 376, 381, 314, 308, 254, 378, 312,
+	307, 300, 280, 661, 293, 340, 306, 341, 294, 318,
+	317, 319, 0, 0, 0, 0, 0, 419, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 592, 0, 0, 0, 392, 0, 0, 644, 0,
+	0, 0, 365, 0, 0, 301, 0, 0, 0, 596,
+	0, 352, 334, 657, 539, 0, 350, 304, 377, 342,
+	383, 367, 391, 346, 343, 246, 368, 275, 315, 257,
+	259, 271, 277, 279, 281, 282, 324, 325, 337, 356,
+	370, 371, 372, 274, 267, 351, 268, 291, 269, 247,
+	358, 270, 249, 338, 375, 0, 287, 347, 311, 250,
+	310, 339, 374, 373, 258, 399, 405, 406, 411, 0,
+	412, 0, 0, 0, 420, 425, 426, 427, 429, 442,
+	443, 430, 431, 432, 433, 434, 435, 436, 437, 438,
+	452, 453, 439, 440, 0, 441, 454, 444, 0, 0,
+	0, 0, 414, 0, 0, 0, 0, 0, 0, 404,
+	285, 243, 244, 451, 642, 330, 0, 0, 656, 637,
+	639, 640, 643, 647, 648, 649, 650, 651, 653, 655,
+	659, 450, 0, 0, 0, 0, 0, 449, 336, 0,
+	355, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 362, 385, 397, 415, 418, 0, 0,
+	0, 248, 417, 0, 0, 0, 0, 0, 0, 0,
+	658, 0, 0, 0, 396, 0, 0, 0, 0, 0,
+	600, 320, 321, 322, 323, 645, 0, 265, 416, 345,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 409, 410, 284, 290,
+	428, 292, 264, 335, 286, 394, 298, 0, 421, 0,
+	422, 0, 0, 0, 0, 327, 295, 359, 299, 305,
+	348, 393, 333, 353, 262, 384, 360, 309, 0, 0,
+	667, 641, 666, 668, 669, 665, 670, 671, 652, 557,
+	0, 604, 663, 662, 664, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 245, 0,
+	303, 0, 344, 283, 630, 609, 610, 611, 556, 612,
+	607, 608, 631, 602, 627, 628, 581, 605, 613, 626,
+	614, 629, 632, 633, 672, 673, 620, 674, 617, 634,
+	625, 624, 615, 603, 635, 636, 588, 583, 618, 619,
+	606, 621, 584, 585, 586, 587, 369, 598, 0, 400,
+	401, 402, 424, 386, 0, 448, 0, 332, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	554, 0, 0, 0, 278, 1472, 0, 302, 0, 0,
+	0, 589, 0, 0, 361, 316, 0, 0, 0, 0,
+	646, 654, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 547, 0, 0, 579, 623, 622, 566,
+	575, 0, 0, 260, 193, 0, 567, 0, 574, 568,
+	572, 571, 569, 570, 0, 638, 0, 0, 0, 0,
+	0, 0, 538, 551, 0, 555, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 548,
+	549, 0, 0, 0, 0, 599, 0, 550, 0, 0,
+	594, 576, 577, 0, 0, 0, 0, 251, 366, 382,
+	261, 357, 395, 266, 364, 256, 331, 354, 0, 0,
+	253, 380, 363, 313, 296, 297, 252, 0, 349, 276,
+	289, 273, 329, 573, 597, 601, 272, 660, 595, 390,
+	255, 0, 389, 328, 376, 381, 314, 308, 254, 378,
+	312, 307, 300, 280, 661, 293, 340, 306, 341, 294,
+	318, 317, 319, 0, 0, 0, 0, 0, 419, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 592, 0, 0, 0, 392, 0, 0, 644,
+	0, 0, 0, 365, 0, 0, 301, 0, 0, 0,
+	596, 0, 352, 334, 657, 539, 0, 350, 304, 377,
+	342, 383, 367, 391, 346, 343, 246, 368, 275, 315,
+	257, 259, 271, 277, 279, 281, 282, 324, 325, 337,
+	356, 370, 371, 372, 274, 267, 351, 268, 291, 269,
+	247, 358, 270, 249, 338, 375, 0, 287, 347, 311,
+	250, 310, 339, 374, 373, 258, 399, 405, 406, 411,
+	0, 412, 0, 0, 0, 420, 425, 426, 427, 429,
+	442, 443, 430, 431, 432, 433, 434, 435, 436, 437,
+	438, 452, 453, 439, 440, 0, 441, 454, 444, 0,
+	0, 0, 0, 414, 0, 0, 0, 0, 0, 0,
+	404, 285, 243, 244, 451, 642, 330, 0, 0, 656,
+	637, 639, 640, 643, 647, 648, 649, 650, 651, 653,
+	655, 659, 450, 0, 0, 0, 0, 0, 449, 336,
+	0, 355, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 362, 385, 397, 415, 418, 0,
+	0, 0, 248, 417, 0, 0, 0, 0, 0, 0,
+	0, 658, 0, 0, 0, 396, 0, 0, 0, 0,
+	0, 600, 320, 321, 322, 323, 645, 0, 265, 416,
+	345, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 409, 410, 284,
+	290, 428, 292, 264, 335, 286, 394, 298, 0, 421,
+	0, 422, 0, 0, 0, 0, 327, 295, 359, 299,
+	305, 348, 393, 333, 353, 262, 384, 360, 309, 0,
+	0, 667, 641, 666, 668, 669, 665, 670, 671, 652,
+	557, 0, 604, 663, 662, 664, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 245,
+	0, 303, 0, 344, 283, 630, 609, 610, 611, 556,
+	612, 607, 608, 631, 602, 627, 628, 581, 605, 613,
+	626, 614, 629, 632, 633, 672, 673, 620, 674, 617,
+	634, 625, 624, 615, 603, 635, 636, 588, 583, 618,
+	619, 606, 621, 584, 585, 586, 587, 369, 598, 0,
+	400, 401, 402, 424, 386, 0, 448, 0, 332, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 554, 0, 0, 0, 278, 0, 0, 302, 0,
+	0, 0, 589, 0, 0, 361, 316, 0, 0, 0,
+	0, 646, 654, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 547, 0, 0, 579, 623, 622,
+	566, 575, 0, 0, 260, 193, 0, 567, 0, 574,
+	568, 572, 571, 569, 570, 0, 638, 0, 0, 0,
+	0, 0, 0, 538, 551, 0, 555, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	548, 549, 1653, 0, 0, 0, 599, 0, 550, 0,
+	0, 594, 576, 577, 0, 0, 0, 0, 251, 366,
+	382, 261, 357, 395, 266, 364, 256, 331, 354, 0,
+	0, 253, 380, 363, 313, 296, 297, 252, 0, 349,
+	276, 289, 273, 329, 573, 597, 601, 272, 660, 595,
+	390, 255, 0, 389, 328, 376, 381, 314, 308, 254,
+	378, 312, 307, 300, 280, 661, 293, 340, 306, 341,
+	294, 318, 317, 319, 0, 0, 0, 0, 0, 419,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 592, 0, 0, 0, 392, 0, 0,
+	644, 0, 0, 0, 365, 0, 0, 301, 0, 0,
+	0, 596, 0, 352, 334, 657, 539, 0, 350, 304,
+	377, 342, 383, 367, 391, 346, 343, 246, 368, 275,
+	315, 257, 259, 271, 277, 279, 281, 282, 324, 325,
+	337, 356, 370, 371, 372, 274, 267, 351, 268, 291,
+	269, 247, 358, 270, 249, 338, 375, 0, 287, 347,
+	311, 250, 310, 339, 374, 373, 258, 399, 405, 406,
+	411, 0, 412, 0, 0, 0, 420, 425, 426, 427,
+	429, 442, 443, 430, 431, 432, 433, 434, 435, 436,
+	437, 438, 452, 453, 439, 440, 0, 441, 454, 444,
+	0, 0, 0, 0, 414, 0, 0, 0, 0, 0,
+	0, 404, 285, 243, 244, 451, 642, 330, 0, 0,
+	656, 637, 639, 640, 643, 647, 648, 649, 650, 651,
+	653, 655, 659, 450, 0, 0, 0, 0, 0, 449,
+	336, 0, 355, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 362, 385, 397, 415, 418,
+	0, 0, 0, 248, 417, 0, 0, 0, 0, 0,
+	0, 0, 658, 0, 0, 0, 396, 0, 0, 0,
+	0, 0, 600, 320, 321, 322, 323, 645, 0, 265,
+	416, 345, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 409, 410,
+	284, 290, 428, 292, 264, 335, 286, 394, 298, 0,
+	421, 0, 422, 0, 0, 0, 0, 327, 295, 359,
+	299, 305, 348, 393, 333, 353, 262, 384, 360, 309,
+	0, 0, 667, 641, 666, 668, 669, 665, 670, 671,
+	652, 557, 0, 604, 663, 662, 664, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	245, 0, 303, 0, 344, 283, 630, 609, 610, 611,
+	556, 612, 607, 608, 631, 602, 627, 628, 581, 605,
+	613, 626, 614, 629, 632, 633, 672, 673, 620, 674,
+	617, 634, 625, 624, 615, 603, 635, 636, 588, 583,
+	618, 619, 606, 621, 584, 585, 586, 587, 0, 0,
+	0, 400, 401, 402, 424, 386, 0, 448, 369, 598,
+	0, 0, 1768, 0, 0, 0, 0, 0, 0, 332,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 554, 0, 0, 0, 278, 0, 0, 302,
+	0, 0, 0, 589, 0, 0, 361, 316, 0, 0,
+	0, 0, 646, 654, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 547, 0, 0, 579, 623,
+	622, 566, 575, 0, 0, 260, 193, 0, 567, 0,
+	574, 568, 572, 571, 569, 570, 0, 638, 0, 0,
+	0, 0, 0, 0, 538, 551, 0, 555, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 548, 549, 0, 0, 0, 0, 599, 0, 550,
+	0, 0, 594, 576, 577, 0, 0, 0, 0, 251,
+	366, 382, 261, 357, 395, 266, 364, 256, 331, 354,
+	0, 0, 253, 380, 363, 313, 296, 297, 252, 0,
+	349, 276, 289, 273, 329, 573, 597, 601, 272, 660,
+	595, 390, 255, 0, 389, 328, 376, 381, 314, 308,
+	254, 378, 312, 307, 300, 280, 661, 293, 340, 306,
+	341, 294, 318, 317, 319, 0, 0, 0, 0, 0,
+	419, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 592, 0, 0, 0, 392, 0,
+	0, 644, 0, 0, 0, 365, 0, 0, 301, 0,
+	0, 0, 596, 0, 352, 334, 657, 539, 0, 350,
+	304, 377, 342, 383, 367, 391, 346, 343, 246, 368,
+	275, 315, 257, 259, 271, 277, 279, 281, 282, 324,
+	325, 337, 356, 370, 371, 372, 274, 267, 351, 268,
+	291, 269, 247, 358, 270, 249, 338, 375, 0, 287,
+	347, 311, 250, 310, 339, 374, 373, 258, 399, 405,
+	406, 411, 0, 412, 0, 0, 0, 420, 425, 426,
+	427, 429, 442, 443, 430, 431, 432, 433, 434, 435,
+	436, 437, 438, 452, 453, 439, 440, 0, 441, 454,
+	444, 0, 0, 0, 0, 414, 0, 0, 0, 0,
+	0, 0, 404, 285, 243, 244, 451, 642, 330, 0,
+	0, 656, 637, 639, 640, 643, 647, 648, 649, 650,
+	651, 653, 655, 659, 450, 0, 0, 0, 0, 0,
+	449, 336, 0, 355, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 362, 385, 397, 415,
+	418, 0, 0, 0, 248, 417, 0, 0, 0, 0,
+	0, 0, 0, 658, 0, 0, 0, 396, 0, 0,
+	0, 0, 0, 600, 320, 321, 322, 323, 645, 0,
+	265, 416, 345, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 409,
+	410, 284, 290, 428, 292, 264, 335, 286, 394, 298,
+	0, 421, 0, 422, 0, 0, 0, 0, 327, 295,
+	359, 299, 305, 348, 393, 333, 353, 262, 384, 360,
+	309, 0, 0, 667, 641, 666, 668, 669, 665, 670,
+	671, 652, 557, 0, 604, 663, 662, 664, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 245, 0, 303, 0, 344, 283, 630, 609, 610,
+	611, 556, 612, 607, 608, 631, 602, 627, 628, 581,
+	605, 613, 626, 614, 629, 632, 633, 672, 673, 620,
+	674, 617, 634, 625, 624, 615, 603, 635, 636, 588,
+	583, 618, 619, 606, 621, 584, 585, 586, 587, 369,
+	598, 0, 400, 401, 402, 424, 386, 0, 448, 0,
+	332, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 554, 0, 0, 0, 278, 0, 0,
+	302, 0, 0, 0, 589, 0, 0, 361, 316, 0,
+	0, 0, 0, 646, 654, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 547, 0, 0, 579,
+	623, 622, 566, 575, 0, 0, 260, 193, 0, 567,
+	0, 574, 568, 572, 571, 569, 570, 0, 638, 0,
+	0, 0, 0, 0, 0, 538, 551, 0, 555, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 548, 549, 0, 0, 0, 0, 599, 0,
+	550, 0, 0, 594, 576, 577, 0, 0, 0, 0,
+	251, 366, 382, 261, 357, 395, 266, 364, 256, 331,
+	354, 0, 0, 253, 380, 363, 313, 296, 297, 252,
+	0, 349, 276, 289, 273, 329, 573, 597, 601, 272,
+	660, 595, 390, 255, 0, 389, 328, 376, 381, 314,
+	308, 254, 378, 312, 307, 300, 280, 661, 293, 340,
+	306, 341, 294, 318, 317, 319, 0, 0, 0, 0,
+	0, 419, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 592, 0, 0, 0, 392,
+	0, 0, 644, 0, 0, 0, 365, 0, 0, 301,
+	0, 0, 0, 596, 0, 352, 334, 657, 539, 0,
+	350, 304, 377, 342, 383, 367, 391, 346, 343, 246,
+	368, 275, 315, 257, 259, 271, 277, 279, 281, 282,
+	324, 325, 337, 356, 370, 371, 372, 274, 267, 351,
+	268, 291, 269, 247, 358, 270, 249, 338, 375, 0,
+	287, 347, 311, 250, 310, 339, 374, 373, 258, 399,
+	405, 406, 411, 0, 412, 0, 0, 0, 420, 425,
+	426, 427, 429, 442, 443, 430, 431, 432, 433, 434,
+	435, 436, 437, 438, 452, 453, 439, 440, 0, 441,
+	454, 444, 0, 0, 0, 0, 414, 0, 0, 0,
+	0, 0, 0, 404, 285, 243, 244, 451, 642, 330,
+	0, 0, 656, 637, 639, 640, 643, 647, 648, 649,
+	650, 651, 653, 655, 659, 450, 0, 0, 0, 0,
+	0, 449, 336, 0, 355, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 362, 385, 397,
+	415, 418, 0, 0, 0, 248, 417, 0, 0, 0,
+	0, 0, 0, 0, 658, 0, 0, 0, 396, 0,
+	0, 0, 0, 0, 600, 320, 321, 322, 323, 645,
+	0, 265, 416, 345, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	409, 410, 284, 290, 428, 292, 264, 335, 286, 394,
+	298, 0, 421, 0, 422, 0, 0, 0, 0, 327,
+	295, 359, 299, 305, 348, 393, 333, 353, 262, 384,
+	360, 309, 0, 0, 667, 641, 666, 668, 669, 665,
+	670, 671, 652, 557, 0, 604, 663, 662, 664, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 245, 0, 303, 0, 344, 283, 630, 609,
+	610, 611, 556, 612, 607, 608, 631, 602, 627, 628,
+	581, 605, 613, 626, 614, 629, 632, 633, 672, 673,
+	620, 674, 617, 634, 625, 624, 615, 603, 635, 636,
+	588, 583, 618, 619, 606, 621, 584, 585, 586, 587,
+	369, 598, 0, 400, 401, 402, 424, 386, 0, 448,
+	0, 332, 0, 0, 0, 0, 0, 0, 0, 0,
+	1353, 0, 0, 0, 554, 0, 0, 0, 278, 0,
+	0, 302, 0, 0, 0, 589, 0, 0, 361, 316,
+	0, 0, 0, 0, 646, 654, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 547, 0, 0,
+	579, 623, 622, 566, 575, 0, 0, 260, 193, 0,
+	567, 0, 574, 568, 572, 571, 569, 570, 0, 638,
+	0, 0, 0, 0, 0, 0, 0, 551, 0, 555,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 548, 549, 0, 0, 0, 0, 599,
+	0, 550, 0, 0, 594, 576, 577, 0, 0, 0,
+	0, 251, 366, 382, 261, 357, 395, 266, 364, 256,
+	331, 354, 0, 0, 253, 380, 363, 313, 296, 297,
+	252, 0, 349, 276, 289, 273, 329, 573, 597, 601,
+	272, 660, 595, 390, 255, 0, 389, 328, 376, 381,
+	314, 308, 254, 378, 312, 307, 300, 280, 661, 293,
+	340, 306, 341, 294, 318, 317, 319, 0, 0, 0,
+	0, 0, 419, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 592, 0, 0, 0,
+	392, 0, 0, 644, 0, 0, 0, 365, 0, 0,
+	301, 0, 0, 0, 596, 0, 352, 334, 657, 0,
+	0, 350, 304, 377, 342, 383, 367, 391, 346, 343,
+	246, 368, 275, 315, 257, 259, 271, 277, 279, 281,
+	282, 324, 325, 337, 356, 370, 371, 372, 274, 267,
+	351, 268, 291, 269, 247, 358, 270, 249, 338, 375,
+	0, 287, 347, 311, 250, 310, 339, 374, 373, 258,
+	399, 1354, 1355, 411, 0, 412, 0, 0, 0, 420,
+	425, 426, 427, 429, 442, 443, 430, 431, 432, 433,
+	434, 435, 436, 437, 438, 452, 453, 439, 440, 0,
+	441, 454, 444, 0, 0, 0, 0, 414, 0, 0,
+	0, 0, 0, 0, 404, 285, 243, 244, 451, 642,
+	330, 0, 0, 656, 637, 639, 640, 643, 647, 648,
+	649, 650, 651, 653, 655, 659, 450, 0, 0, 0,
+	0, 0, 449, 336, 0, 355, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 362, 385,
+	397, 415, 418, 0, 0, 0, 248, 417, 0, 0,
+	0, 0, 0, 0, 0, 658, 0, 0, 0, 396,
+	0, 0, 0, 0, 0, 600, 320, 321, 322, 323,
+	645, 0, 265, 416, 345, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 409, 410, 284, 290, 428, 292, 264, 335, 286,
+	394, 298, 0, 421, 0, 422, 0, 0, 0, 0,
+	327, 295, 359, 299, 305, 348, 393, 333, 353, 262,
+	384, 360, 309, 0, 0, 667, 641, 666, 668, 669,
+	665, 670, 671, 652, 557, 0, 604, 663, 662, 664,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 245, 0, 303, 0, 344, 283, 630,
+	609, 610, 611, 556, 612, 607, 608, 631, 602, 627,
+	628, 581, 605, 613, 626, 614, 629, 632, 633, 672,
+	673, 620, 674, 617, 634, 625, 624, 615, 603, 635,
+	636, 588, 583, 618, 619, 606, 621, 584, 585, 586,
+	587, 369, 598, 0, 400, 401, 402, 424, 386, 0,
+	448, 0, 332, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 554, 0, 0, 0, 278,
+	0, 0, 302, 0, 0, 0, 589, 0, 0, 361,
+	316, 0, 0, 0, 0, 646, 654, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 579, 623, 622, 566, 575, 0, 0, 260, 193,
+	0, 567, 0, 574, 568, 572, 571, 569, 570, 0,
+	638, 0, 0, 0, 0, 0, 0, 538, 551, 0,
+	555, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 548, 549, 0, 0, 0, 0,
+	599, 0, 550, 0, 0, 594, 576, 577, 0, 0,
+	0, 0, 251, 366, 382, 261, 357, 395, 266, 364,
+	256, 331, 354, 0, 0, 253, 380, 363, 313, 296,
+	297, 252, 0, 349, 276, 289, 273, 329, 573, 597,
+	601, 272, 660, 595, 390, 255, 0, 389, 328, 376,
+	381, 314, 308, 254, 378, 312, 307, 300, 280, 661,
+	293, 340, 306, 341, 294, 318, 317, 319, 0, 0,
+	0, 0, 0, 419, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 592, 0, 0,
+	0, 392, 0, 0, 644, 0, 0, 0, 365, 0,
+	0, 301, 0, 0, 0, 596, 0, 352, 334, 657,
+	539, 0, 350, 304, 377, 342, 383, 367, 391, 346,
+	343, 246, 368, 275, 315, 257, 259, 271, 277, 279,
+	281, 282, 324, 325, 337, 356, 370, 371, 372, 274,
+	267, 351, 268, 291, 269, 247, 358, 270, 249, 338,
+	375, 0, 287, 347, 311, 250, 310, 339, 374, 373,
+	258, 399, 405, 406, 411, 0, 412, 0, 0, 0,
+	420, 425, 426, 427, 429, 442, 443, 430, 431, 432,
+	433, 434, 435, 436, 437, 438, 452, 453, 439, 440,
+	0, 441, 454, 444, 0, 0, 0, 0, 414, 0,
+	0, 0, 0, 0, 0, 404, 285, 243, 244, 451,
+	642, 330, 0, 0, 656, 637, 639, 640, 643, 647,
+	648, 649, 650, 651, 653, 655, 659, 450, 0, 0,
+	0, 0, 0, 449, 336, 0, 355, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 362,
+	385, 397, 415, 418, 0, 0, 0, 248, 417, 0,
+	0, 0, 0, 0, 0, 0, 658, 0, 0, 0,
+	396, 0, 0, 0, 0, 0, 600, 320, 321, 322,
+	323, 645, 0, 265, 416, 345, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 409, 410, 284, 290, 428, 292, 264, 335,
+	286, 394, 298, 0, 421, 0, 422, 0, 0, 0,
+	0, 327, 295, 359, 299, 305, 348, 393, 333, 353,
+	262, 384, 360, 309, 0, 0, 667, 641, 666, 668,
+	669, 665, 670, 671, 652, 557, 0, 604, 663, 662,
+	664, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 245, 0, 303, 0, 344, 283,
+	630, 609, 610, 611, 556, 612, 607, 608, 631, 602,
+	627, 628, 581, 605, 613, 626, 614, 629, 632, 633,
+	672, 673, 620, 674, 617, 634, 625, 624, 615, 603,
+	635, 636, 588, 583, 618, 619, 606, 621, 584, 585,
+	586, 587, 369, 598, 0, 400, 401, 402, 424, 386,
+	0, 448, 0, 332, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 554, 0, 0, 0,
+	278, 0, 0, 302, 0, 0, 0, 589, 0, 0,
+	361, 316, 0, 0, 0, 0, 646, 654, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 547,
+	0, 0, 579, 623, 622, 566, 575, 0, 0, 260,
+	193, 0, 567, 0, 574, 568, 572, 571, 569, 570,
+	0, 638, 0, 0, 0, 0, 0, 0, 0, 551,
+	0, 555, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 548, 549, 0, 0, 0,
+	0, 599, 0, 550, 0, 0, 594, 576, 577, 0,
+	0, 0, 0, 251, 366, 382, 261, 357, 395, 266,
+	364, 256, 331, 354, 0, 0, 253, 380, 363, 313,
+	296, 297, 252, 0, 349, 276, 289, 273, 329, 573,
+	597, 601, 272, 660, 595, 390, 255, 0, 389, 328,
+	376, 381, 314, 308, 254, 378, 312, 307, 300, 280,
+	661, 293, 340, 306, 341, 294, 318, 317, 319, 0,
+	0, 0, 0, 0, 419, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 592, 0,
+	0, 0, 392, 0, 0, 644, 0, 0, 0, 365,
+	0, 0, 301, 0, 0, 0, 596, 0, 352, 334,
+	657, 0, 0, 350, 304, 377, 342, 383, 367, 391,
+	346, 343, 246, 368, 275, 315, 257, 259, 271, 277,
+	279, 281, 282, 324, 325, 337, 356, 370, 371, 372,
+	274, 267, 351, 268, 291, 269, 247, 358, 270, 249,
+	338, 375, 0, 287, 347, 311, 250, 310, 339, 374,
+	373, 258, 399, 405, 406, 411, 0, 412, 0, 0,
+	0, 420, 425, 426, 427, 429, 442, 443, 430, 431,
+	432, 433, 434, 435, 436, 437, 438, 452, 453, 439,
+	440, 0, 441, 454, 444, 0, 0, 0, 0, 414,
+	0, 0, 0, 0, 0, 0, 404, 285, 243, 244,
+	451, 642, 330, 0, 0, 656, 637, 639, 640, 643,
+	647, 648, 649, 650, 651, 653, 655, 659, 450, 0,
+	0, 0, 0, 0, 449, 336, 0, 355, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	362, 385, 397, 415, 418, 0, 0, 0, 248, 417,
+	0, 0, 0, 0, 0, 0, 0, 658, 0, 0,
+	0, 396, 0, 0, 0, 0, 0, 600, 320, 321,
+	322, 323, 645, 0, 265, 416, 345, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 284, 290, 428, 292, 264,
+	335, 286, 394, 298, 0, 421, 0, 422, 0, 0,
+	0, 0, 327, 295, 359, 299, 305, 348, 393, 333,
+	353, 262, 384, 360, 309, 0, 0, 667, 641, 666,
+	668, 669, 665, 670, 671, 652, 557, 0, 604, 663,
+	662, 664, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 245, 0, 303, 0, 344,
+	283, 630, 609, 610, 611, 556, 612, 607, 608, 631,
+	602, 627, 628, 581, 605, 613, 626, 614, 629, 632,
+	633, 672, 673, 620, 674, 617, 634, 625, 624, 615,
+	603, 635, 636, 588, 583, 618, 619, 606, 621, 584,
+	585, 586, 587, 0, 0, 0, 400, 401, 402, 424,
+	386, 0, 448, 170, 369, 56, 162, 135, 0, 0,
+	0, 0, 0, 0, 0, 332, 0, 0, 0, 0,
+	0, 0, 0, 163, 0, 0, 0, 0, 0, 0,
+	155, 0, 278, 0, 164, 302, 0, 0, 0, 115,
+	0, 0, 361, 316, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 104, 0, 0, 0, 0, 0,
+	0, 167, 0, 0, 192, 0, 0, 0, 0, 0,
+	0, 260, 193, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 263, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 184, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 251, 366, 382, 261, 357,
+	395, 266, 364, 256, 331, 354, 0, 0, 253, 380,
+	363, 313, 296, 297, 252, 0, 349, 276, 289, 273,
+	329, 0, 379, 407, 272, 398, 0, 390, 255, 0,
+	389, 328, 376, 381, 314, 308, 254, 378, 312, 307,
+	300, 280, 423, 293, 340, 306, 341, 294, 318, 317,
+	319, 0, 0, 0, 0, 0, 419, 0, 0, 0,
+	0, 0, 0, 134, 161, 168, 0, 102, 0, 0,
+	0, 0, 0, 0, 392, 0, 0, 185, 0, 0,
+	0, 365, 0, 0, 301, 160, 154, 153, 408, 0,
+	352, 334, 62, 0, 0, 350, 304, 377, 342, 383,
+	367, 391, 346, 343, 246, 368, 275, 315, 257, 259,
+	271, 277, 279, 281, 282, 324, 325, 337, 356, 370,
+	371, 372, 274, 267, 351, 268, 291, 269, 247, 358,
+	270, 249, 338, 375, 0, 287, 347, 311, 250, 310,
+	339, 374, 373, 258, 399, 405, 406, 411, 0, 412,
+	156, 157, 158, 420, 425, 426, 427, 429, 442, 443,
+	430, 431, 432, 433, 434, 435, 436, 437, 438, 199,
+	200, 439, 440, 0, 441, 201, 444, 0, 0, 0,
+	0, 414, 0, 0, 0, 0, 0, 0, 404, 285,
+	243, 244, 387, 0, 330, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 403, 188, 0, 0, 0,
+	196, 0, 0, 0, 159, 0, 197, 336, 0, 355,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 362, 385, 397, 415, 418, 0, 0, 0,
+	248, 417, 0, 0, 0, 0, 0, 0, 0, 388,
+	0, 0, 0, 396, 0, 0, 0, 0, 0, 413,
+	320, 321, 322, 323, 288, 0, 265, 416, 345, 113,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 55,
+	0, 0, 0, 0, 0, 409, 410, 284, 290, 428,
+	292, 264, 335, 286, 394, 298, 0, 421, 0, 422,
+	0, 0, 0, 0, 327, 295, 359, 299, 305, 348,
+	393, 333, 353, 262, 384, 360, 309, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 57, 0, 0,
+	238, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 245, 0, 303,
+	136, 344, 283, 202, 203, 204, 205, 206, 207, 208,
+	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
+	219, 220, 221, 222, 223, 0, 224, 225, 226, 227,
+	228, 229, 230, 231, 232, 233, 234, 235, 236, 237,
+	0, 239, 240, 241, 242, 0, 0, 0, 400, 401,
+	402, 424, 386, 369, 198, 41, 186, 189, 191, 190,
+	0, 54, 5, 0, 332, 118, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 278, 0, 0, 302, 0, 0, 0, 0, 0,
+	0, 361, 316, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1029, 0, 0, 192, 0, 0, 566, 575, 0, 0,
+	260, 193, 0, 567, 0, 574, 568, 572, 571, 569,
+	570, 0, 263, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 576, 0,
+	0, 0, 0, 0, 251, 366, 382, 261, 357, 395,
+	266, 364, 256, 331, 354, 0, 0, 253, 380, 363,
+	313, 296, 297, 252, 0, 349, 276, 289, 273, 329,
+	573, 379, 407, 272, 398, 0, 390, 255, 0, 389,
+	328, 376, 381, 314, 308, 254, 378, 312, 307, 300,
+	280, 423, 293, 340, 306, 341, 294, 318, 317, 319,
+	0, 0, 0, 0, 0, 419, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 392, 0, 0, 0, 0, 0, 0,
+	365, 0, 0, 301, 0, 0, 0, 408, 0, 352,
+	334, 0, 0, 0, 350, 304, 377, 342, 383, 367,
+	391, 346, 343, 246, 368, 275, 315, 257, 259, 271,
+	277, 279, 281, 282, 324, 325, 337, 356, 370, 371,
+	372, 274, 267, 351, 268, 291, 269, 247, 358, 270,
+	249, 338, 375, 0, 287, 347, 311, 250, 310, 339,
+	374, 373, 258, 399, 405, 406, 411, 0, 412, 0,
+	0, 0, 420, 425, 426, 427, 429, 442, 443, 430,
+	431, 432, 433, 434, 435, 436, 437, 438, 452, 453,
+	439, 440, 0, 441, 454, 444, 0, 0, 0, 0,
+	414, 0, 0, 0, 0, 0, 0, 404, 285, 243,
+	244, 451, 0, 330, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 326, 403, 0, 0, 0, 0, 450,
+	0, 0, 0, 0, 0, 449, 336, 0, 355, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 362, 385, 397, 415, 418, 0, 0, 0, 248,
+	417, 0, 0, 0, 0, 0, 0, 0, 388, 0,
+	0, 0, 396, 0, 0, 0, 0, 0, 413, 320,
+	321, 322, 323, 288, 0, 265, 416, 345, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 409, 410, 284, 290, 428, 292,
+	264, 335, 286, 394, 298, 0, 421, 0, 422, 0,
+	0, 0, 0, 327, 295, 359, 299, 305, 348, 393,
+	333, 353, 262, 384, 360, 309, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 238,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 245, 0, 303, 0,
+	344, 283, 202, 203, 204, 205, 206, 207, 208, 209,
+	210, 211, 212, 213, 214, 215, 216, 217, 218, 219,
+	220, 221, 222, 223, 0, 224, 225, 226, 227, 228,
+	229, 230, 231, 232, 233, 234, 235, 236, 237, 0,
+	239, 240, 241, 242, 0, 0, 0, 400, 401, 402,
+	424, 386, 0, 448, 170, 369, 56, 162, 135, 0,
+	0, 0, 0, 0, 0, 0, 332, 471, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 278, 0, 0, 302, 0, 0, 0,
+	0, 0, 0, 361, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 476, 0, 0, 192, 0, 0, 0, 0,
+	0, 0, 260, 193, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 263, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 251, 366, 382, 261,
+	357, 395, 266, 364, 256, 331, 354, 0, 0, 253,
+	380, 363, 313, 296, 297, 252, 0, 349, 276, 289,
+	273, 329, 0, 379, 407, 272, 398, 0, 390, 255,
+	0, 389, 328, 376, 381, 314, 308, 254, 378, 312,
+	307, 300, 280, 423, 293, 340, 306, 341, 294, 318,
+	317, 319, 0, 0, 0, 0, 0, 419, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 475, 0,
+	0, 0, 0, 0, 0, 392, 0, 0, 0, 0,
+	0, 0, 365, 0, 0, 301, 0, 0, 0, 408,
+	0, 352, 334, 0, 0, 0, 350, 304, 377, 342,
+	383, 367, 391, 346, 343, 246, 368, 275, 315, 257,
+	259, 271, 277, 279, 281, 282, 324, 325, 337, 356,
+	370, 371, 372, 274, 267, 351, 268, 291, 269, 247,
+	358, 270, 249, 338, 375, 0, 287, 347, 311, 250,
+	310, 339, 374, 373, 258, 399, 405, 406, 411, 0,
+	412, 0, 0, 0, 420, 425, 426, 427, 429, 442,
+	443, 430, 431, 432, 433, 434, 435, 436, 437, 438,
+	452, 453, 439, 440, 0, 441, 454, 444, 0, 0,
+	0, 0, 414, 0, 0, 0, 0, 0, 0, 404,
+	285, 243, 244, 451, 0, 330, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 326, 403, 0, 0, 0,
+	0, 450, 0, 0, 0, 0, 0, 449, 336, 0,
+	355, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 362, 385, 397, 415, 418, 0, 0,
+	0, 248, 417, 0, 0, 0, 0, 0, 0, 0,
+	388, 0, 0, 0, 396, 0, 0, 0, 0, 0,
+	413, 320, 321, 322, 323, 472, 474, 265, 416, 345,
+	484, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 409, 410, 284, 290,
+	428, 292, 264, 335, 286, 394, 298, 0, 421, 0,
+	422, 0, 0, 0, 0, 327, 295, 359, 299, 305,
+	348, 393, 333, 353, 262, 384, 360, 309, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 57, 0,
+	0, 238, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 245, 0,
+	303, 136, 344, 283, 202, 203, 204, 205, 206, 207,
+	208, 209, 210, 211, 212, 213, 214, 215, 216, 217,
+	218, 219, 220, 221, 222, 223, 0, 224, 225, 226,
+	227, 228, 229, 230, 231, 232, 233, 234, 235, 236,
+	237, 0, 239, 240, 241, 242, 369, 0, 0, 400,
+	401, 402, 424, 386, 0, 448, 0, 332, 0, 0,
+	0, 0, 0, 0, 0, 858, 0, 0, 0, 0,
+	0, 0, 0, 0, 278, 0, 0, 302, 0, 0,
+	0, 0, 0, 0, 361, 316, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 192, 0, 0, 0,
+	0, 0, 0, 260, 193, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 263, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	846, 0, 0, 0, 0, 0, 0, 251, 366, 382,
+	261, 357, 395, 266, 364, 256, 331, 354, 0, 0,
+	1851, 1853, 1854, 1855, 1856, 1857, 1858, 0, 1862, 1859,
+	1860, 1861, 329, 0, 1846, 1847, 1848, 1849, 844, 1832,
+	1852, 0, 1833, 328, 1834, 1835, 1836, 1837, 1838, 1839,
+	1840, 1841, 1842, 1843, 1844, 1850, 340, 306, 341, 294,
+	318, 317, 319, 869, 871, 873, 875, 878, 419, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 392, 0, 0, 0,
+	0, 0, 0, 365, 0, 0, 301, 0, 0, 0,
+	1845, 0, 352, 334, 0, 0, 0, 350, 304, 377,
+	342, 383, 367, 391, 346, 343, 246, 368, 275, 315,
+	257, 259, 271, 277, 279, 281, 282, 324, 325, 337,
+	356, 370, 371, 372, 274, 267, 351, 268, 291, 269,
+	247, 358, 270, 249, 338, 375, 0, 287, 347, 311,
+	250, 310, 339, 374, 373, 258, 399, 405, 406, 411,
+	0, 412, 0, 0, 0, 420, 425, 426, 427, 429,
+	442, 443, 430, 431, 432, 433, 434, 435, 436, 437,
+	438, 452, 453, 439, 440, 0, 441, 454, 444, 0,
+	0, 0, 0, 414, 0, 0, 0, 0, 0, 0,
+	404, 285, 243, 244, 451, 0, 330, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 326, 403, 0, 0,
+	0, 0, 450, 0, 0, 0, 0, 0, 449, 336,
+	0, 355, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 362, 385, 397, 415, 418, 0,
+	0, 0, 248, 417, 0, 0, 0, 0, 0, 0,
+	0, 388, 0, 0, 0, 396, 0, 0, 0, 0,
+	0, 413, 320, 321, 322, 323, 288, 0, 265, 416,
+	345, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 409, 410, 284,
+	290, 428, 292, 264, 335, 286, 394, 298, 0, 421,
+	0, 422, 0, 0, 0, 0, 327, 295, 359, 299,
+	305, 348, 393, 333, 353, 262, 384, 360, 309, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 238, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 233, 348, 364, 243, 339, 377, 248, 346, 238,
-	313, 336, 0, 0, 235, 362, 345, 295, 278, 279,
-	234, 0, 331, 258, 271, 255, 311, 0, 361, 389,
-	254, 380, 0, 372, 237, 0, 371, 310, 358, 363,
-	296, 290, 236, 360, 294, 289, 282, 262, 405, 275,
-	322, 288, 323, 276, 300, 299, 301, 0, 0, 0,
-	0, 0, 401, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 245,
+	868, 303, 0, 344, 283, 202, 203, 204, 205, 206,
+	207, 208, 209, 210, 211, 212, 213, 214, 215, 216,
+	217, 218, 219, 220, 221, 222, 223, 0, 224, 225,
+	226, 227, 228, 229, 230, 231, 232, 233, 234, 235,
+	236, 237, 0, 239, 240, 241, 242, 369, 0, 0,
+	400, 401, 402, 424, 386, 0, 448, 0, 332, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 278, 0, 0, 302, 0,
+	0, 0, 0, 0, 0, 361, 316, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 192, 0, 0,
+	0, 0, 0, 0, 260, 193, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 263, 1923, 1926, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 251, 366,
+	382, 261, 357, 395, 266, 364, 256, 331, 354, 0,
+	0, 253, 380, 363, 313, 296, 297, 252, 0, 349,
+	276, 289, 273, 329, 0, 379, 407, 272, 398, 0,
+	390, 255, 0, 389, 328, 376, 381, 314, 308, 254,
+	378, 312, 307, 300, 280, 423, 293, 340, 306, 341,
+	294, 318, 317, 319, 0, 0, 0, 0, 0, 419,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1927, 392, 0, 0,
+	0, 1922, 0, 1921, 365, 1919, 1924, 301, 0, 0,
+	0, 408, 0, 352, 334, 0, 0, 1912, 350, 304,
+	377, 342, 383, 367, 391, 346, 343, 246, 368, 275,
+	315, 257, 259, 271, 277, 279, 281, 282, 324, 325,
+	337, 356, 370, 371, 372, 274, 267, 351, 268, 291,
+	269, 247, 358, 270, 249, 338, 375, 1925, 287, 347,
+	311, 250, 310, 339, 374, 373, 258, 399, 405, 406,
+	411, 0, 412, 0, 0, 0, 420, 425, 426, 427,
+	429, 442, 443, 430, 431, 432, 433, 434, 435, 436,
+	437, 438, 452, 453, 439, 440, 0, 441, 454, 444,
+	0, 0, 0, 0, 414, 0, 0, 0, 0, 0,
+	0, 404, 285, 243, 244, 451, 0, 330, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 326, 403, 0,
+	0, 0, 0, 450, 0, 0, 0, 0, 0, 449,
+	336, 0, 355, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 362, 385, 397, 415, 418,
+	0, 0, 0, 248, 417, 0, 0, 0, 0, 0,
+	0, 0, 388, 0, 0, 0, 396, 0, 0, 0,
+	0, 0, 413, 320, 321, 322, 323, 288, 0, 265,
+	416, 345, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 409, 410,
+	284, 290, 428, 292, 264, 335, 286, 394, 298, 0,
+	421, 0, 422, 0, 0, 0, 0, 327, 295, 359,
+	299, 305, 348, 393, 333, 353, 262, 384, 360, 309,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 238, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	245, 0, 303, 0, 344, 283, 202, 203, 204, 205,
+	206, 207, 208, 209, 210, 211, 212, 213, 214, 215,
+	216, 217, 218, 219, 220, 221, 222, 223, 0, 224,
+	225, 226, 227, 228, 229, 230, 231, 232, 233, 234,
+	235, 236, 237, 0, 239, 240, 241, 242, 369, 0,
+	0, 400, 401, 402, 424, 386, 0, 448, 0, 332,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 278, 0, 0, 302,
+	0, 0, 0, 0, 0, 0, 361, 316, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 192, 0,
+	0, 0, 0, 0, 0, 260, 193, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 263, 1923, 1926,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 251,
+	366, 382, 261, 357, 395, 266, 364, 256, 331, 354,
+	0, 0, 253, 380, 363, 313, 296, 297, 252, 0,
+	349, 276, 289, 273, 329, 0, 379, 407, 272, 398,
+	0, 390, 255, 0, 389, 328, 376, 381, 314, 308,
+	254, 378, 312, 307, 300, 280, 423, 293, 340, 306,
+	341, 294, 318, 317, 319, 0, 0, 0, 0, 0,
+	419, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1927, 392, 0,
+	0, 0, 1922, 0, 1921, 365, 1919, 1924, 301, 0,
+	0, 0, 408, 0, 352, 334, 0, 0, 0, 350,
+	304, 377, 342, 383, 367, 391, 346, 343, 246, 368,
+	275, 315, 257, 259, 271, 277, 279, 281, 282, 324,
+	325, 337, 356, 370, 371, 372, 274, 267, 351, 268,
+	291, 269, 247, 358, 270, 249, 338, 375, 1925, 287,
+	347, 311, 250, 310, 339, 374, 373, 258, 399, 405,
+	406, 411, 0, 412, 0, 0, 0, 420, 425, 426,
+	427, 429, 442, 443, 430, 431, 432, 433, 434, 435,
+	436, 437, 438, 452, 453, 439, 440, 0, 441, 454,
+	444, 0, 0, 0, 0, 414, 0, 0, 0, 0,
+	0, 0, 404, 285, 243, 244, 451, 0, 330, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 326, 403,
+	0, 0, 0, 0, 450, 0, 0, 0, 0, 0,
+	449, 336, 0, 355, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 362, 385, 397, 415,
+	418, 0, 0, 0, 248, 417, 0, 0, 0, 0,
+	0, 0, 0, 388, 0, 0, 0, 396, 0, 0,
+	0, 0, 0, 413, 320, 321, 322, 323, 288, 0,
+	265, 416, 345, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 409,
+	410, 284, 290, 428, 292, 264, 335, 286, 394, 298,
+	0, 421, 0, 422, 0, 0, 0, 0, 327, 295,
+	359, 299, 305, 348, 393, 333, 353, 262, 384, 360,
+	309, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 238, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 245, 0, 303, 0, 344, 283, 202, 203, 204,
+	205, 206, 207, 208, 209, 210, 211, 212, 213, 214,
+	215, 216, 217, 218, 219, 220, 221, 222, 223, 0,
+	224, 225, 226, 227, 228, 229, 230, 231, 232, 233,
+	234, 235, 236, 237, 0, 239, 240, 241, 242, 369,
+	0, 0, 400, 401, 402, 424, 386, 0, 448, 0,
+	332, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2033, 0, 0, 0, 0, 278, 0, 0,
+	302, 0, 0, 0, 0, 0, 0, 361, 316, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 192,
+	0, 0, 2034, 0, 0, 0, 260, 193, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	0, 964, 965, 966, 963, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	251, 366, 382, 261, 357, 395, 266, 364, 256, 331,
+	354, 0, 0, 253, 380, 363, 313, 296, 297, 252,
+	0, 349, 276, 289, 273, 329, 0, 379, 407, 272,
+	398, 0, 390, 255, 0, 389, 328, 376, 381, 314,
+	308, 254, 378, 312, 307, 300, 280, 423, 293, 340,
+	306, 341, 294, 318, 317, 319, 0, 0, 0, 0,
+	0, 419, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 392,
+	0, 0, 0, 0, 0, 0, 365, 0, 0, 301,
+	0, 0, 0, 408, 0, 352, 334, 0, 0, 0,
+	350, 304, 377, 342, 383, 367, 391, 346, 343, 246,
+	368, 275, 315, 257, 259, 271, 277, 279, 281, 282,
+	324, 325, 337, 356, 370, 371, 372, 274, 267, 351,
+	268, 291, 269, 247, 358, 270, 249, 338, 375, 0,
+	287, 347, 311, 250, 310, 339, 374, 373, 258, 399,
+	405, 406, 411, 0, 412, 0, 0, 0, 420, 425,
+	426, 427, 429, 442, 443, 430, 431, 432, 433, 434,
+	435, 436, 437, 438, 452, 453, 439, 440, 0, 441,
+	454, 444, 0, 0, 0, 0, 414, 0, 0, 0,
+	0, 0, 0, 404, 285, 243, 244, 451, 0, 330,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 326,
+	403, 0, 0, 0, 0, 450, 0, 0, 0, 0,
+	0, 449, 336, 0, 355, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 362, 385, 397,
+	415, 418, 0, 0, 0, 248, 417, 0, 0, 0,
+	0, 0, 0, 0, 388, 0, 0, 0, 396, 0,
+	0, 0, 0, 0, 413, 320, 321, 322, 323, 288,
+	0, 265, 416, 345, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	409, 410, 284, 290, 428, 292, 264, 335, 286, 394,
+	298, 0, 421, 0, 422, 0, 0, 0, 0, 327,
+	295, 359, 299, 305, 348, 393, 333, 353, 262, 384,
+	360, 309, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 238, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 245, 0, 303, 0, 344, 283, 202, 203,
+	204, 205, 206, 207, 208, 209, 210, 211, 212, 213,
+	214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
+	0, 224, 225, 226, 227, 228, 229, 230, 231, 232,
+	233, 234, 235, 236, 237, 0, 239, 240, 241, 242,
+	369, 0, 0, 400, 401, 402, 424, 386, 0, 448,
+	0, 332, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 278, 785,
+	0, 302, 0, 0, 0, 0, 0, 0, 361, 316,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	192, 793, 794, 0, 0, 0, 0, 260, 193, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 797,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 251, 366, 382, 261, 357, 395, 266, 364, 256,
+	331, 354, 0, 0, 253, 380, 363, 313, 296, 297,
+	252, 0, 349, 276, 289, 273, 329, 0, 379, 407,
+	272, 398, 775, 390, 255, 774, 389, 328, 376, 381,
+	314, 308, 254, 378, 312, 307, 300, 280, 423, 293,
+	340, 306, 341, 294, 318, 317, 319, 0, 0, 0,
+	0, 0, 419, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	392, 0, 0, 0, 0, 0, 0, 365, 0, 0,
+	301, 0, 0, 0, 408, 0, 352, 334, 0, 0,
+	0, 350, 304, 377, 342, 383, 367, 391, 783, 343,
+	246, 368, 275, 315, 257, 259, 271, 277, 279, 281,
+	282, 324, 325, 337, 356, 370, 371, 372, 274, 267,
+	351, 268, 291, 269, 247, 358, 270, 249, 338, 375,
+	0, 287, 347, 311, 250, 310, 339, 374, 373, 258,
+	399, 405, 406, 411, 0, 412, 0, 0, 0, 420,
+	425, 426, 427, 429, 442, 443, 430, 431, 432, 433,
+	434, 435, 436, 437, 438, 452, 453, 439, 440, 0,
+	441, 454, 444, 0, 0, 0, 0, 414, 0, 0,
+	0, 0, 0, 0, 404, 285, 243, 244, 451, 0,
+	330, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	326, 403, 0, 0, 0, 0, 450, 0, 0, 0,
+	0, 0, 449, 336, 0, 355, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 362, 385,
+	397, 415, 418, 0, 0, 0, 248, 417, 0, 0,
+	0, 0, 0, 0, 784, 388, 0, 0, 0, 396,
+	0, 0, 0, 0, 0, 787, 320, 321, 322, 323,
+	288, 0, 265, 416, 345, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 409, 410, 284, 290, 428, 292, 264, 335, 286,
+	394, 298, 0, 421, 0, 422, 0, 0, 0, 0,
+	795, 790, 791, 299, 305, 348, 393, 333, 353, 262,
+	384, 360, 792, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 238, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 245, 0, 303, 0, 344, 283, 202,
+	203, 204, 205, 206, 207, 208, 209, 210, 211, 212,
+	213, 214, 215, 216, 217, 218, 219, 220, 221, 222,
+	223, 0, 224, 225, 226, 227, 228, 229, 230, 231,
+	232, 233, 234, 235, 236, 237, 0, 239, 240, 241,
+	242, 170, 369, 0, 400, 401, 402, 424, 386, 0,
+	448, 0, 0, 332, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	278, 0, 0, 302, 0, 0, 0, 115, 0, 0,
+	361, 316, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1602, 0, 0, 0, 0, 1604,
+	0, 0, 192, 0, 0, 0, 0, 0, 0, 260,
+	193, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 263, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 251, 366, 382, 261, 357, 395, 266,
+	364, 256, 331, 354, 0, 0, 253, 380, 363, 313,
+	296, 297, 252, 0, 349, 276, 289, 273, 329, 0,
+	379, 407, 272, 398, 0, 390, 255, 0, 389, 328,
+	376, 381, 314, 308, 254, 378, 312, 307, 300, 280,
+	423, 293, 340, 306, 341, 294, 318, 317, 319, 0,
+	0, 0, 0, 0, 419, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 392, 0, 0, 0, 0, 0, 0, 365,
+	0, 0, 301, 0, 0, 0, 408, 0, 352, 334,
+	0, 0, 0, 350, 304, 377, 342, 383, 367, 391,
+	346, 343, 246, 368, 275, 315, 257, 259, 271, 277,
+	279, 281, 282, 324, 325, 337, 356, 370, 371, 372,
+	274, 267, 351, 268, 291, 269, 247, 358, 270, 249,
+	338, 375, 0, 287, 347, 311, 250, 310, 339, 374,
+	373, 258, 399, 405, 406, 411, 0, 412, 0, 0,
+	0, 420, 425, 426, 427, 429, 442, 443, 430, 431,
+	432, 433, 434, 435, 436, 437, 438, 452, 453, 439,
+	440, 0, 441, 454, 444, 0, 0, 0, 0, 414,
+	0, 0, 0, 0, 0, 0, 404, 285, 243, 244,
+	451, 0, 330, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 326, 403, 0, 0, 0, 0, 450, 0,
+	0, 0, 0, 0, 449, 336, 0, 355, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	362, 385, 397, 415, 418, 0, 0, 0, 248, 417,
+	0, 0, 0, 0, 0, 0, 0, 388, 0, 0,
+	0, 396, 0, 0, 0, 0, 0, 413, 320, 321,
+	322, 323, 288, 0, 265, 416, 345, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 284, 290, 428, 292, 264,
+	335, 286, 394, 298, 0, 421, 0, 422, 0, 0,
+	0, 0, 327, 295, 359, 299, 305, 348, 393, 333,
+	353, 262, 384, 360, 309, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 238, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 245, 0, 303, 136, 344,
+	283, 202, 203, 204, 205, 206, 207, 208, 209, 210,
+	211, 212, 213, 214, 215, 216, 217, 218, 219, 220,
+	221, 222, 223, 0, 224, 225, 226, 227, 228, 229,
+	230, 231, 232, 233, 234, 235, 236, 237, 0, 239,
+	240, 241, 242, 170, 369, 0, 400, 401, 402, 424,
+	386, 0, 448, 0, 0, 332, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 278, 0, 0, 302, 0, 0, 0, 115,
+	0, 0, 361, 316, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 167, 1697, 0, 192, 0, 0, 0, 0, 0,
+	0, 260, 193, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 263, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 251, 366, 382, 261, 357,
+	395, 266, 364, 256, 331, 354, 0, 0, 253, 380,
+	363, 313, 296, 297, 252, 0, 349, 276, 289, 273,
+	329, 0, 379, 407, 272, 398, 0, 390, 255, 0,
+	389, 328, 376, 381, 314, 308, 254, 378, 312, 307,
+	300, 280, 423, 293, 340, 306, 341, 294, 318, 317,
+	319, 0, 0, 0, 0, 0, 419, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 392, 0, 0, 0, 0, 0,
+	0, 365, 0, 0, 301, 0, 0, 0, 408, 0,
+	352, 334, 0, 0, 0, 350, 304, 377, 342, 383,
+	367, 391, 346, 343, 246, 368, 275, 315, 257, 259,
+	271, 277, 279, 281, 282, 324, 325, 337, 356, 370,
+	371, 372, 274, 267, 351, 268, 291, 269, 247, 358,
+	270, 249, 338, 375, 0, 287, 347, 311, 250, 310,
+	339, 374, 373, 258, 399, 405, 406, 411, 0, 412,
+	0, 0, 0, 420, 425, 426, 427, 429, 442, 443,
+	430, 431, 432, 433, 434, 435, 436, 437, 438, 452,
+	453, 439, 440, 0, 441, 454, 444, 0, 0, 0,
+	0, 414, 0, 0, 0, 0, 0, 0, 404, 285,
+	243, 244, 451, 0, 330, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 403, 0, 0, 0, 0,
+	450, 0, 0, 0, 0, 0, 449, 336, 0, 355,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 362, 385, 397, 415, 418, 0, 0, 0,
+	248, 417, 0, 0, 0, 0, 0, 0, 0, 388,
+	0, 0, 0, 396, 0, 0, 0, 0, 0, 413,
+	320, 321, 322, 323, 288, 0, 265, 416, 345, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 409, 410, 284, 290, 428,
+	292, 264, 335, 286, 394, 298, 0, 421, 0, 422,
+	0, 0, 0, 0, 327, 295, 359, 299, 305, 348,
+	393, 333, 353, 262, 384, 360, 309, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	238, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 245, 0, 303,
+	136, 344, 283, 202, 203, 204, 205, 206, 207, 208,
+	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
+	219, 220, 221, 222, 223, 0, 224, 225, 226, 227,
+	228, 229, 230, 231, 232, 233, 234, 235, 236, 237,
+	0, 239, 240, 241, 242, 170, 369, 0, 400, 401,
+	402, 424, 386, 0, 448, 0, 0, 332, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 278, 0, 0, 302, 0, 0,
+	0, 115, 0, 0, 361, 316, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 167, 1688, 0, 192, 0, 0, 0,
+	0, 0, 0, 260, 193, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 263, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 251, 366, 382,
+	261, 357, 395, 266, 364, 256, 331, 354, 0, 0,
+	253, 380, 363, 313, 296, 297, 252, 0, 349, 276,
+	289, 273, 329, 0, 379, 407, 272, 398, 0, 390,
+	255, 0, 389, 328, 376, 381, 314, 308, 254, 378,
+	312, 307, 300, 280, 423, 293, 340, 306, 341, 294,
+	318, 317, 319, 0, 0, 0, 0, 0, 419, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 392, 0, 0, 0,
+	0, 0, 0, 365, 0, 0, 301, 0, 0, 0,
+	408, 0, 352, 334, 0, 0, 0, 350, 304, 377,
+	342, 383, 367, 391, 346, 343, 246, 368, 275, 315,
+	257, 259, 271, 277, 279, 281, 282, 324, 325, 337,
+	356, 370, 371, 372, 274, 267, 351, 268, 291, 269,
+	247, 358, 270, 249, 338, 375, 0, 287, 347, 311,
+	250, 310, 339, 374, 373, 258, 399, 405, 406, 411,
+	0, 412, 0, 0, 0, 420, 425, 426, 427, 429,
+	442, 443, 430, 431, 432, 433, 434, 435, 436, 437,
+	438, 452, 453, 439, 440, 0, 441, 454, 444, 0,
+	0, 0, 0, 414, 0, 0, 0, 0, 0, 0,
+	404, 285, 243, 244, 451, 0, 330, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 326, 403, 0, 0,
+	0, 0, 450, 0, 0, 0, 0, 0, 449, 336,
+	0, 355, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 362, 385, 397, 415, 418, 0,
+	0, 0, 248, 417, 0, 0, 0, 0, 0, 0,
+	0, 388, 0, 0, 0, 396, 0, 0, 0, 0,
+	0, 413, 320, 321, 322, 323, 288, 0, 265, 416,
+	345, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 409, 410, 284,
+	290, 428, 292, 264, 335, 286, 394, 298, 0, 421,
+	0, 422, 0, 0, 0, 0, 327, 295, 359, 299,
+	305, 348, 393, 333, 353, 262, 384, 360, 309, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 238, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	374, 0, 0, 0, 0, 0, 0, 347, 0, 0,
-	283, 0, 0, 0, 390, 0, 334, 316, 0, 0,
-	0, 332, 286, 359, 324, 365, 349, 373, 328, 325,
-	228, 350, 257, 297, 239, 241, 253, 259, 261, 263,
-	264, 306, 307, 319, 338, 352, 353, 354, 256, 249,
-	333, 250, 273, 251, 229, 340, 252, 231, 320, 357,
-	0, 269, 329, 293, 232, 292, 321, 356, 355, 240,
-	381, 387, 388, 393, 0, 394, 0, 0, 0, 402,
-	407, 408, 409, 411, 412, 413, 414, 0, 0, 0,
-	0, 396, 0, 0, 0, 0, 0, 0, 386, 267,
-	225, 226, 421, 0, 312, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 308, 385, 0, 0, 0, 0,
-	420, 0, 0, 0, 0, 0, 419, 318, 0, 337,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 245,
+	0, 303, 136, 344, 283, 202, 203, 204, 205, 206,
+	207, 208, 209, 210, 211, 212, 213, 214, 215, 216,
+	217, 218, 219, 220, 221, 222, 223, 0, 224, 225,
+	226, 227, 228, 229, 230, 231, 232, 233, 234, 235,
+	236, 237, 0, 239, 240, 241, 242, 369, 0, 0,
+	400, 401, 402, 424, 386, 0, 448, 0, 332, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 278, 0, 0, 302, 0,
+	0, 0, 0, 0, 0, 361, 316, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 192, 793, 794,
+	0, 0, 0, 0, 260, 193, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 797, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 251, 366,
+	382, 261, 357, 395, 266, 364, 256, 331, 354, 0,
+	0, 253, 380, 363, 313, 296, 297, 252, 0, 349,
+	276, 289, 273, 329, 0, 379, 407, 272, 398, 775,
+	390, 255, 774, 389, 328, 376, 381, 314, 308, 254,
+	378, 312, 307, 300, 280, 423, 293, 340, 306, 341,
+	294, 318, 317, 319, 0, 0, 0, 0, 0, 419,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 392, 0, 0,
+	0, 0, 0, 0, 365, 0, 0, 301, 0, 0,
+	0, 408, 0, 352, 334, 0, 0, 0, 350, 304,
+	377, 342, 383, 367, 391, 346, 343, 246, 368, 275,
+	315, 257, 259, 271, 277, 279, 281, 282, 324, 325,
+	337, 356, 370, 371, 372, 274, 267, 351, 268, 291,
+	269, 247, 358, 270, 249, 338, 375, 0, 287, 347,
+	311, 250, 310, 339, 374, 373, 258, 399, 405, 406,
+	411, 0, 412, 0, 0, 0, 420, 425, 426, 427,
+	429, 442, 443, 430, 431, 432, 433, 434, 435, 436,
+	437, 438, 452, 453, 439, 440, 0, 441, 454, 444,
+	0, 0, 0, 0, 414, 0, 0, 0, 0, 0,
+	0, 404, 285, 243, 244, 451, 0, 330, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 326, 403, 0,
+	0, 0, 0, 450, 0, 0, 0, 0, 0, 449,
+	336, 0, 355, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 362, 385, 397, 415, 418,
+	0, 0, 0, 248, 417, 0, 0, 0, 0, 0,
+	0, 0, 388, 0, 0, 0, 396, 0, 0, 0,
+	0, 0, 413, 320, 321, 322, 323, 288, 0, 265,
+	416, 345, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 409, 410,
+	284, 290, 428, 292, 264, 335, 286, 394, 298, 0,
+	421, 0, 422, 0, 0, 0, 0, 795, 790, 791,
+	299, 305, 348, 393, 333, 353, 262, 384, 360, 792,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 238, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	245, 0, 303, 0, 344, 283, 202, 203, 204, 205,
+	206, 207, 208, 209, 210, 211, 212, 213, 214, 215,
+	216, 217, 218, 219, 220, 221, 222, 223, 0, 224,
+	225, 226, 227, 228, 229, 230, 231, 232, 233, 234,
+	235, 236, 237, 0, 239, 240, 241, 242, 369, 0,
+	0, 400, 401, 402, 424, 386, 0, 448, 0, 332,
+	0, 0, 0, 0, 0, 0, 0, 0, 2300, 0,
+	0, 0, 0, 0, 0, 0, 278, 0, 0, 302,
+	0, 0, 0, 0, 0, 0, 361, 316, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 192, 0,
+	0, 0, 0, 0, 0, 260, 193, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 263, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 251,
+	366, 382, 261, 357, 395, 266, 364, 256, 331, 354,
+	0, 0, 253, 380, 363, 313, 296, 297, 252, 0,
+	349, 276, 289, 273, 329, 0, 379, 407, 272, 398,
+	0, 390, 255, 0, 389, 328, 376, 381, 314, 308,
+	254, 378, 312, 307, 300, 280, 423, 293, 340, 306,
+	341, 294, 318, 317, 319, 0, 0, 0, 0, 0,
+	419, 0, 0, 0, 0, 0, 0, 0, 0, 2303,
+	0, 0, 2302, 0, 0, 0, 0, 0, 392, 0,
+	0, 0, 0, 0, 0, 365, 0, 0, 301, 0,
+	0, 0, 408, 0, 352, 334, 0, 0, 0, 350,
+	304, 377, 342, 383, 367, 391, 346, 343, 246, 368,
+	275, 315, 257, 259, 271, 277, 279, 281, 282, 324,
+	325, 337, 356, 370, 371, 372, 274, 267, 351, 268,
+	291, 269, 247, 358, 270, 249, 338, 375, 0, 287,
+	347, 311, 250, 310, 339, 374, 373, 258, 399, 405,
+	406, 411, 0, 412, 0, 0, 0, 420, 425, 426,
+	427, 429, 442, 443, 430, 431, 432, 433, 434, 435,
+	436, 437, 438, 452, 453, 439, 440, 0, 441, 454,
+	444, 0, 0, 0, 0, 414, 0, 0, 0, 0,
+	0, 0, 404, 285, 243, 244, 451, 0, 330, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 326, 403,
+	0, 0, 0, 0, 450, 0, 0, 0, 0, 0,
+	449, 336, 0, 355, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 362, 385, 397, 415,
+	418, 0, 0, 0, 248, 417, 0, 0, 0, 0,
+	0, 0, 0, 388, 0, 0, 0, 396, 0, 0,
+	0, 0, 0, 413, 320, 321, 322, 323, 288, 0,
+	265, 416, 345, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 409,
+	410, 284, 290, 428, 292, 264, 335, 286, 394, 298,
+	0, 421, 0, 422, 0, 0, 0, 0, 327, 295,
+	359, 299, 305, 348, 393, 333, 353, 262, 384, 360,
+	309, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 238, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 245, 0, 303, 0, 344, 283, 202, 203, 204,
+	205, 206, 207, 208, 209, 210, 211, 212, 213, 214,
+	215, 216, 217, 218, 219, 220, 221, 222, 223, 0,
+	224, 225, 226, 227, 228, 229, 230, 231, 232, 233,
+	234, 235, 236, 237, 0, 239, 240, 241, 242, 369,
+	0, 0, 400, 401, 402, 424, 386, 0, 448, 0,
+	332, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 278, 1196, 0,
+	302, 0, 0, 0, 0, 0, 0, 361, 316, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 192,
+	0, 0, 1194, 0, 0, 0, 260, 193, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1192, 0, 0, 0, 0, 0, 0,
+	251, 366, 382, 261, 357, 395, 266, 364, 256, 331,
+	354, 0, 0, 253, 380, 363, 313, 296, 297, 252,
+	0, 349, 276, 289, 273, 329, 0, 379, 407, 272,
+	398, 0, 390, 255, 0, 389, 328, 376, 381, 314,
+	308, 254, 378, 312, 307, 300, 280, 423, 293, 340,
+	306, 341, 294, 318, 317, 319, 0, 0, 0, 0,
+	0, 419, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 392,
+	0, 0, 0, 0, 0, 0, 365, 0, 0, 301,
+	0, 0, 0, 408, 0, 352, 334, 0, 0, 0,
+	350, 304, 377, 342, 383, 367, 391, 346, 343, 246,
+	368, 275, 315, 257, 259, 271, 277, 279, 281, 282,
+	324, 325, 337, 356, 370, 371, 372, 274, 267, 351,
+	268, 291, 269, 247, 358, 270, 249, 338, 375, 0,
+	287, 347, 311, 250, 310, 339, 374, 373, 258, 399,
+	405, 406, 411, 0, 412, 0, 0, 0, 420, 425,
+	426, 427, 429, 442, 443, 430, 431, 432, 433, 434,
+	435, 436, 437, 438, 452, 453, 439, 440, 0, 441,
+	454, 444, 0, 0, 0, 0, 414, 0, 0, 0,
+	0, 0, 0, 404, 285, 243, 244, 451, 0, 330,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 326,
+	403, 0, 0, 0, 0, 450, 0, 0, 0, 0,
+	0, 449, 336, 0, 355, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 362, 385, 397,
+	415, 418, 0, 0, 0, 248, 417, 0, 0, 0,
+	0, 0, 0, 0, 388, 0, 0, 0, 396, 0,
+	0, 0, 0, 0, 413, 320, 321, 322, 323, 288,
+	0, 265, 416, 345, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	409, 410, 284, 290, 428, 292, 264, 335, 286, 394,
+	298, 0, 421, 0, 422, 0, 0, 0, 0, 327,
+	295, 359, 299, 305, 348, 393, 333, 353, 262, 384,
+	360, 309, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 238, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 245, 0, 303, 0, 344, 283, 202, 203,
+	204, 205, 206, 207, 208, 209, 210, 211, 212, 213,
+	214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
+	0, 224, 225, 226, 227, 228, 229, 230, 231, 232,
+	233, 234, 235, 236, 237, 0, 239, 240, 241, 242,
+	369, 0, 0, 400, 401, 402, 424, 386, 0, 448,
+	0, 332, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 278, 1190,
+	0, 302, 0, 0, 0, 0, 0, 0, 361, 316,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	192, 0, 0, 1194, 0, 0, 0, 260, 193, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 263,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1192, 0, 0, 0, 0, 0,
+	0, 251, 366, 382, 261, 357, 395, 266, 364, 256,
+	331, 354, 0, 0, 253, 380, 363, 313, 296, 297,
+	252, 0, 349, 276, 289, 273, 329, 0, 379, 407,
+	272, 398, 0, 390, 255, 0, 389, 328, 376, 381,
+	314, 308, 254, 378, 312, 307, 300, 280, 423, 293,
+	340, 306, 341, 294, 318, 317, 319, 0, 0, 0,
+	0, 0, 419, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	392, 0, 0, 0, 0, 0, 0, 365, 0, 0,
+	301, 0, 0, 0, 408, 0, 352, 334, 0, 0,
+	0, 350, 304, 377, 342, 383, 367, 391, 346, 343,
+	246, 368, 275, 315, 257, 259, 271, 277, 279, 281,
+	282, 324, 325, 337, 356, 370, 371, 372, 274, 267,
+	351, 268, 291, 269, 247, 358, 270, 249, 338, 375,
+	0, 287, 347, 311, 250, 310, 339, 374, 373, 258,
+	399, 405, 406, 411, 0, 412, 0, 0, 0, 420,
+	425, 426, 427, 429, 442, 443, 430, 431, 432, 433,
+	434, 435, 436, 437, 438, 452, 453, 439, 440, 0,
+	441, 454, 444, 0, 0, 0, 0, 414, 0, 0,
+	0, 0, 0, 0, 404, 285, 243, 244, 451, 0,
+	330, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	326, 403, 0, 0, 0, 0, 450, 0, 0, 0,
+	0, 0, 449, 336, 0, 355, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 362, 385,
+	397, 415, 418, 0, 0, 0, 248, 417, 0, 0,
+	0, 0, 0, 0, 0, 388, 0, 0, 0, 396,
+	0, 0, 0, 0, 0, 413, 320, 321, 322, 323,
+	288, 0, 265, 416, 345, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 409, 410, 284, 290, 428, 292, 264, 335, 286,
+	394, 298, 0, 421, 0, 422, 0, 0, 0, 0,
+	327, 295, 359, 299, 305, 348, 393, 333, 353, 262,
+	384, 360, 309, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 238, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 245, 0, 303, 0, 344, 283, 202,
+	203, 204, 205, 206, 207, 208, 209, 210, 211, 212,
+	213, 214, 215, 216, 217, 218, 219, 220, 221, 222,
+	223, 0, 224, 225, 226, 227, 228, 229, 230, 231,
+	232, 233, 234, 235, 236, 237, 0, 239, 240, 241,
+	242, 369, 0, 0, 400, 401, 402, 424, 386, 0,
+	448, 0, 332, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
+	0, 0, 302, 0, 0, 0, 0, 0, 0, 361,
+	316, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 3017,
+	0, 192, 623, 0, 0, 0, 0, 0, 260, 193,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	263, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 251, 366, 382, 261, 357, 395, 266, 364,
+	256, 331, 354, 0, 0, 253, 380, 363, 313, 296,
+	297, 252, 0, 349, 276, 289, 273, 329, 0, 379,
+	407, 272, 398, 0, 390, 255, 0, 389, 328, 376,
+	381, 314, 308, 254, 378, 312, 307, 300, 280, 423,
+	293, 340, 306, 341, 294, 318, 317, 319, 0, 0,
+	0, 0, 0, 419, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 392, 0, 0, 0, 0, 0, 0, 365, 0,
+	0, 301, 0, 0, 0, 408, 0, 352, 334, 0,
+	0, 0, 350, 304, 377, 342, 383, 367, 391, 346,
+	343, 246, 368, 275, 315, 257, 259, 271, 277, 279,
+	281, 282, 324, 325, 337, 356, 370, 371, 372, 274,
+	267, 351, 268, 291, 269, 247, 358, 270, 249, 338,
+	375, 0, 287, 347, 311, 250, 310, 339, 374, 373,
+	258, 399, 405, 406, 411, 0, 412, 0, 0, 0,
+	420, 425, 426, 427, 429, 442, 443, 430, 431, 432,
+	433, 434, 435, 436, 437, 438, 452, 453, 439, 440,
+	0, 441, 454, 444, 0, 0, 0, 0, 414, 0,
+	0, 0, 0, 0, 0, 404, 285, 243, 244, 451,
+	0, 330, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 326, 403, 0, 0, 0, 0, 450, 0, 0,
+	0, 0, 0, 449, 336, 0, 355, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 362,
+	385, 397, 415, 418, 0, 0, 0, 248, 417, 0,
+	0, 0, 0, 0, 0, 0, 388, 0, 0, 0,
+	396, 0, 0, 0, 0, 0, 413, 320, 321, 322,
+	323, 288, 0, 265, 416, 345, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 409, 410, 284, 290, 428, 292, 264, 335,
+	286, 394, 298, 0, 421, 0, 422, 0, 0, 0,
+	0, 327, 295, 359, 299, 305, 348, 393, 333, 353,
+	262, 384, 360, 309, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 238, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 245, 0, 303, 0, 344, 283,
+	202, 203, 204, 205, 206, 207, 208, 209, 210, 211,
+	212, 213, 214, 215, 216, 217, 218, 219, 220, 221,
+	222, 223, 0, 224, 225, 226, 227, 228, 229, 230,
+	231, 232, 233, 234, 235, 236, 237, 0, 239, 240,
+	241, 242, 369, 0, 0, 400, 401, 402, 424, 386,
+	0, 448, 0, 332, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	278, 0, 0, 302, 0, 0, 0, 0, 0, 0,
+	361, 316, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 192, 0, 0, 1194, 0, 0, 0, 260,
+	193, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 263, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2678, 0, 0, 0,
+	0, 0, 0, 251, 366, 382, 261, 357, 395, 266,
+	364, 256, 331, 354, 0, 0, 253, 380, 363, 313,
+	296, 297, 252, 0, 349, 276, 289, 273, 329, 0,
+	379, 407, 272, 398, 0, 390, 255, 0, 389, 328,
+	376, 381, 314, 308, 254, 378, 312, 307, 300, 280,
+	423, 293, 340, 306, 341, 294, 318, 317, 319, 0,
+	0, 0, 0, 0, 419, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 392, 0, 0, 0, 0, 0, 0, 365,
+	0, 0, 301, 0, 0, 0, 408, 0, 352, 334,
+	0, 0, 0, 350, 304, 377, 342, 383, 367, 391,
+	346, 343, 246, 368, 275, 315, 257, 259, 271, 277,
+	279, 281, 282, 324, 325, 337, 356, 370, 371, 372,
+	274, 267, 351, 268, 291, 269, 247, 358, 270, 249,
+	338, 375, 0, 287, 347, 311, 250, 310, 339, 374,
+	373, 258, 399, 405, 406, 411, 0, 412, 0, 0,
+	0, 420, 425, 426, 427, 429, 442, 443, 430, 431,
+	432, 433, 434, 435, 436, 437, 438, 452, 453, 439,
+	440, 0, 441, 454, 444, 0, 0, 0, 0, 414,
+	0, 0, 0, 0, 0, 0, 404, 285, 243, 244,
+	451, 0, 330, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 326, 403, 0, 0, 0, 0, 450, 0,
+	0, 0, 0, 0, 449, 336, 0, 355, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	362, 385, 397, 415, 418, 0, 0, 0, 248, 417,
+	0, 0, 0, 0, 0, 0, 0, 388, 0, 0,
+	0, 396, 0, 0, 0, 0, 0, 413, 320, 321,
+	322, 323, 288, 0, 265, 416, 345, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 284, 290, 428, 292, 264,
+	335, 286, 394, 298, 0, 421, 0, 422, 0, 0,
+	0, 0, 327, 295, 359, 299, 305, 348, 393, 333,
+	353, 262, 384, 360, 309, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 238, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 245, 0, 303, 0, 344,
+	283, 202, 203, 204, 205, 206, 207, 208, 209, 210,
+	211, 212, 213, 214, 215, 216, 217, 218, 219, 220,
+	221, 222, 223, 0, 224, 225, 226, 227, 228, 229,
+	230, 231, 232, 233, 234, 235, 236, 237, 0, 239,
+	240, 241, 242, 369, 0, 0, 400, 401, 402, 424,
+	386, 0, 448, 0, 332, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 278, 0, 0, 302, 0, 0, 0, 0, 0,
+	0, 361, 316, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 192, 0, 0, 1194, 0, 0, 0,
+	260, 193, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 263, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1192, 0, 0,
+	0, 0, 0, 0, 251, 366, 382, 261, 357, 395,
+	266, 364, 256, 331, 354, 0, 0, 253, 380, 363,
+	313, 296, 297, 252, 0, 349, 276, 289, 273, 329,
+	0, 379, 407, 272, 398, 0, 390, 255, 0, 389,
+	328, 376, 381, 314, 308, 254, 378, 312, 307, 300,
+	280, 423, 293, 340, 306, 341, 294, 318, 317, 319,
+	0, 0, 0, 0, 0, 419, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 392, 0, 0, 0, 0, 0, 0,
+	365, 0, 0, 301, 0, 0, 0, 408, 0, 352,
+	334, 0, 0, 0, 350, 304, 377, 342, 383, 367,
+	391, 346, 343, 246, 368, 275, 315, 257, 259, 271,
+	277, 279, 281, 282, 324, 325, 337, 356, 370, 371,
+	372, 274, 267, 351, 268, 291, 269, 247, 358, 270,
+	249, 338, 375, 0, 287, 347, 311, 250, 310, 339,
+	374, 373, 258, 399, 405, 406, 411, 0, 412, 0,
+	0, 0, 420, 425, 426, 427, 429, 442, 443, 430,
+	431, 432, 433, 434, 435, 436, 437, 438, 452, 453,
+	439, 440, 0, 441, 454, 444, 0, 0, 0, 0,
+	414, 0, 0, 0, 0, 0, 0, 404, 285, 243,
+	244, 451, 0, 330, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 326, 403, 0, 0, 0, 0, 450,
+	0, 0, 0, 0, 0, 449, 336, 0, 355, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 362, 385, 397, 415, 418, 0, 0, 0, 248,
+	417, 0, 0, 0, 0, 0, 0, 0, 388, 0,
+	0, 0, 396, 0, 0, 0, 0, 0, 413, 320,
+	321, 322, 323, 288, 0, 265, 416, 345, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 409, 410, 284, 290, 428, 292,
+	264, 335, 286, 394, 298, 0, 421, 0, 422, 0,
+	0, 0, 0, 327, 295, 359, 299, 305, 348, 393,
+	333, 353, 262, 384, 360, 309, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 238,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 245, 0, 303, 0,
+	344, 283, 202, 203, 204, 205, 206, 207, 208, 209,
+	210, 211, 212, 213, 214, 215, 216, 217, 218, 219,
+	220, 221, 222, 223, 0, 224, 225, 226, 227, 228,
+	229, 230, 231, 232, 233, 234, 235, 236, 237, 0,
+	239, 240, 241, 242, 369, 0, 0, 400, 401, 402,
+	424, 386, 0, 448, 0, 332, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 278, 0, 0, 302, 0, 0, 0, 0,
+	0, 0, 361, 316, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1602, 0, 0, 0,
+	0, 1604, 0, 0, 192, 0, 0, 0, 0, 0,
+	0, 260, 193, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 263, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 251, 366, 382, 261, 357,
+	395, 266, 364, 256, 331, 354, 0, 0, 253, 380,
+	363, 313, 296, 297, 252, 0, 349, 276, 289, 273,
+	329, 0, 379, 407, 272, 398, 0, 390, 255, 0,
+	389, 328, 376, 381, 314, 308, 254, 378, 312, 307,
+	300, 280, 423, 293, 340, 306, 341, 294, 318, 317,
+	319, 0, 0, 0, 0, 0, 419, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 392, 0, 0, 0, 0, 0,
+	0, 365, 0, 0, 301, 0, 0, 0, 408, 0,
+	352, 334, 0, 0, 0, 350, 304, 377, 342, 383,
+	367, 391, 346, 343, 246, 368, 275, 315, 257, 259,
+	271, 277, 279, 281, 282, 324, 325, 337, 356, 370,
+	371, 372, 274, 267, 351, 268, 291, 269, 247, 358,
+	270, 249, 338, 375, 0, 287, 347, 311, 250, 310,
+	339, 374, 373, 258, 399, 405, 406, 411, 0, 412,
+	0, 0, 0, 420, 425, 426, 427, 429, 442, 443,
+	430, 431, 432, 433, 434, 435, 436, 437, 438, 452,
+	453, 439, 440, 0, 441, 454, 444, 0, 0, 0,
+	0, 414, 0, 0, 0, 0, 0, 0, 404, 285,
+	243, 244, 451, 0, 330, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 403, 0, 0, 0, 0,
+	450, 0, 0, 0, 0, 0, 449, 336, 0, 355,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 362, 385, 397, 415, 418, 0, 0, 0,
+	248, 417, 0, 0, 0, 0, 0, 0, 0, 388,
+	0, 0, 0, 396, 0, 0, 0, 0, 0, 413,
+	320, 321, 322, 323, 288, 0, 265, 416, 345, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 409, 410, 284, 290, 428,
+	292, 264, 335, 286, 394, 298, 0, 421, 0, 422,
+	0, 0, 0, 0, 327, 295, 359, 299, 305, 348,
+	393, 333, 353, 262, 384, 360, 309, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	238, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 245, 0, 303,
+	0, 344, 283, 202, 203, 204, 205, 206, 207, 208,
+	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
+	219, 220, 221, 222, 223, 0, 224, 225, 226, 227,
+	228, 229, 230, 231, 232, 233, 234, 235, 236, 237,
+	0, 239, 240, 241, 242, 369, 0, 0, 400, 401,
+	402, 424, 386, 0, 448, 0, 332, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1999, 0,
+	0, 0, 0, 278, 0, 0, 302, 0, 0, 0,
+	0, 0, 0, 361, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 192, 0, 0, 2001, 0,
+	0, 0, 260, 193, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 263, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 251, 366, 382, 261,
+	357, 395, 266, 364, 256, 331, 354, 0, 0, 253,
+	380, 363, 313, 296, 297, 252, 0, 349, 276, 289,
+	273, 329, 0, 379, 407, 272, 398, 0, 390, 255,
+	0, 389, 328, 376, 381, 314, 308, 254, 378, 312,
+	307, 300, 280, 423, 293, 340, 306, 341, 294, 318,
+	317, 319, 0, 0, 0, 0, 0, 419, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 392, 0, 0, 0, 0,
+	0, 0, 365, 0, 0, 301, 0, 0, 0, 408,
+	0, 352, 334, 0, 0, 0, 350, 304, 377, 342,
+	383, 367, 391, 346, 343, 246, 368, 275, 315, 257,
+	259, 271, 277, 279, 281, 282, 324, 325, 337, 356,
+	370, 371, 372, 274, 267, 351, 268, 291, 269, 247,
+	358, 270, 249, 338, 375, 0, 287, 347, 311, 250,
+	310, 339, 374, 373, 258, 399, 405, 406, 411, 0,
+	412, 0, 0, 0, 420, 425, 426, 427, 429, 442,
+	443, 430, 431, 432, 433, 434, 435, 436, 437, 438,
+	452, 453, 439, 440, 0, 441, 454, 444, 0, 0,
+	0, 0, 414, 0, 0, 0, 0, 0, 0, 404,
+	285, 243, 244, 451, 0, 330, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 326, 403, 0, 0, 0,
+	0, 450, 0, 0, 0, 0, 0, 449, 336, 0,
+	355, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 362, 385, 397, 415, 418, 0, 0,
+	0, 248, 417, 0, 0, 0, 0, 0, 0, 0,
+	388, 0, 0, 0, 396, 0, 0, 0, 0, 0,
+	413, 320, 321, 322, 323, 288, 0, 265, 416, 345,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 409, 410, 284, 290,
+	428, 292, 264, 335, 286, 394, 298, 0, 421, 0,
+	422, 0, 0, 0, 0, 327, 295, 359, 299, 305,
+	348, 393, 333, 353, 262, 384, 360, 309, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 238, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 344, 367, 379, 397, 400, 0, 0, 0,
-	230, 399, 0, 0, 0, 0, 0, 0, 0, 370,
-	0, 0, 0, 378, 0, 0, 0, 0, 0, 395,
-	302, 303, 304, 305, 270, 0, 247, 398, 327, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 245, 0,
+	303, 0, 344, 283, 202, 203, 204, 205, 206, 207,
+	208, 209, 210, 211, 212, 213, 214, 215, 216, 217,
+	218, 219, 220, 221, 222, 223, 0, 224, 225, 226,
+	227, 228, 229, 230, 231, 232, 233, 234, 235, 236,
+	237, 0, 239, 240, 241, 242, 369, 0, 0, 400,
+	401, 402, 424, 386, 0, 448, 0, 332, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 278, 2015, 0, 302, 0, 0,
+	0, 0, 0, 0, 361, 316, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 192, 0, 0, 1194,
+	0, 0, 0, 260, 193, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 263, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 251, 366, 382,
+	261, 357, 395, 266, 364, 256, 331, 354, 0, 0,
+	253, 380, 363, 313, 296, 297, 252, 0, 349, 276,
+	289, 273, 329, 0, 379, 407, 272, 398, 0, 390,
+	255, 0, 389, 328, 376, 381, 314, 308, 254, 378,
+	312, 307, 300, 280, 423, 293, 340, 306, 341, 294,
+	318, 317, 319, 0, 0, 0, 0, 0, 419, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 392, 0, 0, 0,
+	0, 0, 0, 365, 0, 0, 301, 0, 0, 0,
+	408, 0, 352, 334, 0, 0, 0, 350, 304, 377,
+	342, 383, 367, 391, 346, 343, 246, 368, 275, 315,
+	257, 259, 271, 277, 279, 281, 282, 324, 325, 337,
+	356, 370, 371, 372, 274, 267, 351, 268, 291, 269,
+	247, 358, 270, 249, 338, 375, 0, 287, 347, 311,
+	250, 310, 339, 374, 373, 258, 399, 405, 406, 411,
+	0, 412, 0, 0, 0, 420, 425, 426, 427, 429,
+	442, 443, 430, 431, 432, 433, 434, 435, 436, 437,
+	438, 452, 453, 439, 440, 0, 441, 454, 444, 0,
+	0, 0, 0, 414, 0, 0, 0, 0, 0, 0,
+	404, 285, 243, 244, 451, 0, 330, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 326, 403, 0, 0,
+	0, 0, 450, 0, 0, 0, 0, 0, 449, 336,
+	0, 355, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 362, 385, 397, 415, 418, 0,
+	0, 0, 248, 417, 0, 0, 0, 0, 0, 0,
+	0, 388, 0, 0, 0, 396, 0, 0, 0, 0,
+	0, 413, 320, 321, 322, 323, 288, 0, 265, 416,
+	345, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 409, 410, 284,
+	290, 428, 292, 264, 335, 286, 394, 298, 0, 421,
+	0, 422, 0, 0, 0, 0, 327, 295, 359, 299,
+	305, 348, 393, 333, 353, 262, 384, 360, 309, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 238, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 391, 392, 266, 272, 410,
-	274, 246, 317, 268, 376, 280, 0, 403, 0, 404,
-	0, 0, 0, 0, 309, 277, 341, 281, 287, 330,
-	375, 315, 335, 244, 366, 342, 291, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 245,
+	0, 303, 0, 344, 283, 202, 203, 204, 205, 206,
+	207, 208, 209, 210, 211, 212, 213, 214, 215, 216,
+	217, 218, 219, 220, 221, 222, 223, 0, 224, 225,
+	226, 227, 228, 229, 230, 231, 232, 233, 234, 235,
+	236, 237, 0, 239, 240, 241, 242, 369, 0, 0,
+	400, 401, 402, 424, 386, 0, 448, 0, 332, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 278, 0, 0, 302, 0,
+	0, 0, 0, 0, 0, 361, 316, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 3103, 0, 192, 0, 0,
+	0, 0, 0, 0, 260, 193, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 263, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 251, 366,
+	382, 261, 357, 395, 266, 364, 256, 331, 354, 0,
+	0, 253, 380, 363, 313, 296, 297, 252, 0, 349,
+	276, 289, 273, 329, 0, 379, 407, 272, 398, 0,
+	390, 255, 0, 389, 328, 376, 381, 314, 308, 254,
+	378, 312, 307, 300, 280, 423, 293, 340, 306, 341,
+	294, 318, 317, 319, 0, 0, 0, 0, 0, 419,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 392, 0, 0,
+	0, 0, 0, 0, 365, 0, 0, 301, 0, 0,
+	0, 408, 0, 352, 334, 0, 0, 0, 350, 304,
+	377, 342, 383, 367, 391, 346, 343, 246, 368, 275,
+	315, 257, 259, 271, 277, 279, 281, 282, 324, 325,
+	337, 356, 370, 371, 372, 274, 267, 351, 268, 291,
+	269, 247, 358, 270, 249, 338, 375, 0, 287, 347,
+	311, 250, 310, 339, 374, 373, 258, 399, 405, 406,
+	411, 0, 412, 0, 0, 0, 420, 425, 426, 427,
+	429, 442, 443, 430, 431, 432, 433, 434, 435, 436,
+	437, 438, 452, 453, 439, 440, 0, 441, 454, 444,
+	0, 0, 0, 0, 414, 0, 0, 0, 0, 0,
+	0, 404, 285, 243, 244, 451, 0, 330, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 326, 403, 0,
+	0, 0, 0, 450, 0, 0, 0, 0, 0, 449,
+	336, 0, 355, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 362, 385, 397, 415, 418,
+	0, 0, 0, 248, 417, 0, 0, 0, 0, 0,
+	0, 0, 388, 0, 0, 0, 396, 0, 0, 0,
+	0, 0, 413, 320, 321, 322, 323, 288, 0, 265,
+	416, 345, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 409, 410,
+	284, 290, 428, 292, 264, 335, 286, 394, 298, 0,
+	421, 0, 422, 0, 0, 0, 0, 327, 295, 359,
+	299, 305, 348, 393, 333, 353, 262, 384, 360, 309,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 238, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	245, 0, 303, 0, 344, 283, 202, 203, 204, 205,
+	206, 207, 208, 209, 210, 211, 212, 213, 214, 215,
+	216, 217, 218, 219, 220, 221, 222, 223, 0, 224,
+	225, 226, 227, 228, 229, 230, 231, 232, 233, 234,
+	235, 236, 237, 0, 239, 240, 241, 242, 369, 0,
+	0, 400, 401, 402, 424, 386, 0, 448, 0, 332,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 278, 0, 0, 302,
+	0, 0, 0, 0, 0, 0, 361, 316, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 192, 623,
+	0, 0, 0, 0, 0, 260, 193, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 263, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 251,
+	366, 382, 261, 357, 395, 266, 364, 256, 331, 354,
+	0, 0, 253, 380, 363, 313, 296, 297, 252, 0,
+	349, 276, 289, 273, 329, 0, 379, 407, 272, 398,
+	0, 390, 255, 0, 389, 328, 376, 381, 314, 308,
+	254, 378, 312, 307, 300, 280, 423, 293, 340, 306,
+	341, 294, 318, 317, 319, 0, 0, 0, 0, 0,
+	419, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 392, 0,
+	0, 0, 0, 0, 0, 365, 0, 0, 301, 0,
+	0, 0, 408, 0, 352, 334, 0, 0, 0, 350,
+	304, 377, 342, 383, 367, 391, 346, 343, 246, 368,
+	275, 315, 257, 259, 271, 277, 279, 281, 282, 324,
+	325, 337, 356, 370, 371, 372, 274, 267, 351, 268,
+	291, 269, 247, 358, 270, 249, 338, 375, 0, 287,
+	347, 311, 250, 310, 339, 374, 373, 258, 399, 405,
+	406, 411, 0, 412, 0, 0, 0, 420, 425, 426,
+	427, 429, 442, 443, 430, 431, 432, 433, 434, 435,
+	436, 437, 438, 452, 453, 439, 440, 0, 441, 454,
+	444, 0, 0, 0, 0, 414, 0, 0, 0, 0,
+	0, 0, 404, 285, 243, 244, 451, 0, 330, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 326, 403,
+	0, 0, 0, 0, 450, 0, 0, 0, 0, 0,
+	449, 336, 0, 355, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 362, 385, 397, 415,
+	418, 0, 0, 0, 248, 417, 0, 0, 0, 0,
+	0, 0, 0, 388, 0, 0, 0, 396, 0, 0,
+	0, 0, 0, 413, 320, 321, 322, 323, 288, 0,
+	265, 416, 345, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 409,
+	410, 284, 290, 428, 292, 264, 335, 286, 394, 298,
+	0, 421, 0, 422, 0, 0, 0, 0, 327, 295,
+	359, 299, 305, 348, 393, 333, 353, 262, 384, 360,
+	309, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 238, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 245, 0, 303, 0, 344, 283, 202, 203, 204,
+	205, 206, 207, 208, 209, 210, 211, 212, 213, 214,
+	215, 216, 217, 218, 219, 220, 221, 222, 223, 0,
+	224, 225, 226, 227, 228, 229, 230, 231, 232, 233,
+	234, 235, 236, 237, 0, 239, 240, 241, 242, 369,
+	0, 0, 400, 401, 402, 424, 386, 0, 448, 0,
+	332, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 278, 0, 0,
+	302, 0, 0, 0, 0, 0, 0, 361, 316, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 3033, 0, 0, 192,
+	0, 0, 0, 0, 0, 0, 260, 193, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	251, 366, 382, 261, 357, 395, 266, 364, 256, 331,
+	354, 0, 0, 253, 380, 363, 313, 296, 297, 252,
+	0, 349, 276, 289, 273, 329, 0, 379, 407, 272,
+	398, 0, 390, 255, 0, 389, 328, 376, 381, 314,
+	308, 254, 378, 312, 307, 300, 280, 423, 293, 340,
+	306, 341, 294, 318, 317, 319, 0, 0, 0, 0,
+	0, 419, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 392,
+	0, 0, 0, 0, 0, 0, 365, 0, 0, 301,
+	0, 0, 0, 408, 0, 352, 334, 0, 0, 0,
+	350, 304, 377, 342, 383, 367, 391, 346, 343, 246,
+	368, 275, 315, 257, 259, 271, 277, 279, 281, 282,
+	324, 325, 337, 356, 370, 371, 372, 274, 267, 351,
+	268, 291, 269, 247, 358, 270, 249, 338, 375, 0,
+	287, 347, 311, 250, 310, 339, 374, 373, 258, 399,
+	405, 406, 411, 0, 412, 0, 0, 0, 420, 425,
+	426, 427, 429, 442, 443, 430, 431, 432, 433, 434,
+	435, 436, 437, 3034, 452, 453, 439, 440, 0, 441,
+	454, 444, 0, 0, 0, 0, 414, 0, 0, 0,
+	0, 0, 0, 404, 285, 243, 244, 451, 0, 330,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 326,
+	403, 0, 0, 0, 0, 450, 0, 0, 0, 0,
+	0, 449, 336, 0, 355, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 362, 385, 397,
+	415, 418, 0, 0, 0, 248, 417, 0, 0, 0,
+	0, 0, 0, 0, 388, 0, 0, 0, 396, 0,
+	0, 0, 0, 0, 413, 320, 321, 322, 323, 288,
+	0, 265, 416, 345, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	409, 410, 284, 290, 428, 292, 264, 335, 286, 394,
+	298, 0, 421, 0, 422, 0, 0, 0, 0, 327,
+	295, 359, 299, 305, 348, 393, 333, 353, 262, 384,
+	360, 309, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 238, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 245, 0, 303, 0, 344, 283, 202, 203,
+	204, 205, 206, 207, 208, 209, 210, 211, 212, 213,
+	214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
+	0, 224, 225, 226, 227, 228, 229, 230, 231, 232,
+	233, 234, 235, 236, 237, 0, 239, 240, 241, 242,
+	369, 0, 0, 400, 401, 402, 424, 386, 0, 448,
+	0, 332, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 278, 0,
+	0, 302, 0, 0, 0, 0, 0, 0, 361, 316,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	192, 0, 0, 0, 0, 0, 0, 260, 193, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 263,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 251, 366, 382, 261, 357, 395, 266, 364, 256,
+	331, 354, 0, 0, 253, 380, 363, 313, 296, 297,
+	252, 0, 349, 276, 289, 273, 329, 0, 379, 407,
+	272, 398, 0, 390, 255, 0, 389, 328, 376, 381,
+	314, 308, 254, 378, 312, 307, 300, 280, 423, 293,
+	340, 306, 341, 294, 318, 317, 319, 0, 0, 0,
+	0, 0, 419, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	392, 0, 0, 0, 2965, 0, 0, 365, 0, 0,
+	301, 0, 0, 0, 408, 0, 352, 334, 0, 0,
+	0, 350, 304, 377, 342, 383, 367, 391, 346, 343,
+	246, 368, 275, 315, 257, 259, 271, 277, 279, 281,
+	282, 324, 325, 337, 356, 370, 371, 372, 274, 267,
+	351, 268, 291, 269, 247, 358, 270, 249, 338, 375,
+	0, 287, 347, 311, 250, 310, 339, 374, 373, 258,
+	399, 405, 406, 411, 0, 412, 0, 0, 0, 420,
+	425, 426, 427, 429, 442, 443, 430, 431, 432, 433,
+	434, 435, 436, 437, 438, 452, 453, 439, 440, 0,
+	441, 454, 444, 0, 0, 0, 0, 414, 0, 0,
+	0, 0, 0, 0, 404, 285, 243, 244, 451, 0,
+	330, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	326, 403, 0, 0, 0, 0, 450, 0, 0, 0,
+	0, 0, 449, 336, 0, 355, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 362, 385,
+	397, 415, 418, 0, 0, 0, 248, 417, 0, 0,
+	0, 0, 0, 0, 0, 388, 0, 0, 0, 396,
+	0, 0, 0, 0, 0, 413, 320, 321, 322, 323,
+	288, 0, 265, 416, 345, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 409, 410, 284, 290, 428, 292, 264, 335, 286,
+	394, 298, 0, 421, 0, 422, 0, 0, 0, 0,
+	327, 295, 359, 299, 305, 348, 393, 333, 353, 262,
+	384, 360, 309, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 238, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 245, 0, 303, 0, 344, 283, 202,
+	203, 204, 205, 206, 207, 208, 209, 210, 211, 212,
+	213, 214, 215, 216, 217, 218, 219, 220, 221, 222,
+	223, 0, 224, 225, 226, 227, 228, 229, 230, 231,
+	232, 233, 234, 235, 236, 237, 0, 239, 240, 241,
+	242, 369, 0, 0, 400, 401, 402, 424, 386, 0,
+	448, 0, 332, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
+	0, 0, 302, 0, 0, 0, 0, 0, 0, 361,
+	316, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2770, 0,
+	0, 192, 0, 0, 0, 0, 0, 0, 260, 193,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	263, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 251, 366, 382, 261, 357, 395, 266, 364,
+	256, 331, 354, 0, 0, 253, 380, 363, 313, 296,
+	297, 252, 0, 349, 276, 289, 273, 329, 0, 379,
+	407, 272, 398, 0, 390, 255, 0, 389, 328, 376,
+	381, 314, 308, 254, 378, 312, 307, 300, 280, 423,
+	293, 340, 306, 341, 294, 318, 317, 319, 0, 0,
+	0, 0, 0, 419, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 392, 0, 0, 0, 0, 0, 0, 365, 0,
+	0, 301, 0, 0, 0, 408, 0, 352, 334, 0,
+	0, 0, 350, 304, 377, 342, 383, 367, 391, 346,
+	343, 246, 368, 275, 315, 257, 259, 271, 277, 279,
+	281, 282, 324, 325, 337, 356, 370, 371, 372, 274,
+	267, 351, 268, 291, 269, 247, 358, 270, 249, 338,
+	375, 0, 287, 347, 311, 250, 310, 339, 374, 373,
+	258, 399, 405, 406, 411, 0, 412, 0, 0, 0,
+	420, 425, 426, 427, 429, 442, 443, 430, 431, 432,
+	433, 434, 435, 436, 437, 438, 452, 453, 439, 440,
+	0, 441, 454, 444, 0, 0, 0, 0, 414, 0,
+	0, 0, 0, 0, 0, 404, 285, 243, 244, 451,
+	0, 330, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 326, 403, 0, 0, 0, 0, 450, 0, 0,
+	0, 0, 0, 449, 336, 0, 355, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 362,
+	385, 397, 415, 418, 0, 0, 0, 248, 417, 0,
+	0, 0, 0, 0, 0, 0, 388, 0, 0, 0,
+	396, 0, 0, 0, 0, 0, 413, 320, 321, 322,
+	323, 288, 0, 265, 416, 345, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 409, 410, 284, 290, 428, 292, 264, 335,
+	286, 394, 298, 0, 421, 0, 422, 0, 0, 0,
+	0, 327, 295, 359, 299, 305, 348, 393, 333, 353,
+	262, 384, 360, 309, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 238, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 245, 0, 303, 0, 344, 283,
+	202, 203, 204, 205, 206, 207, 208, 209, 210, 211,
+	212, 213, 214, 215, 216, 217, 218, 219, 220, 221,
+	222, 223, 0, 224, 225, 226, 227, 228, 229, 230,
+	231, 232, 233, 234, 235, 236, 237, 0, 239, 240,
+	241, 242, 369, 0, 0, 400, 401, 402, 424, 386,
+	0, 448, 0, 332, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	278, 0, 0, 302, 0, 0, 0, 0, 0, 0,
+	361, 316, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 192, 0, 0, 0, 0, 0, 0, 260,
+	193, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 263, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 251, 366, 382, 261, 357, 395, 266,
+	364, 256, 331, 354, 0, 0, 253, 380, 363, 313,
+	296, 297, 252, 0, 349, 276, 289, 273, 329, 0,
+	379, 407, 272, 398, 0, 390, 255, 0, 389, 328,
+	376, 381, 314, 308, 254, 378, 312, 307, 300, 280,
+	423, 293, 340, 306, 341, 294, 318, 317, 319, 0,
+	0, 0, 0, 0, 419, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 392, 0, 0, 0, 2821, 0, 0, 365,
+	0, 0, 301, 0, 0, 0, 408, 0, 352, 334,
+	0, 0, 0, 350, 304, 377, 342, 383, 367, 391,
+	346, 343, 246, 368, 275, 315, 257, 259, 271, 277,
+	279, 281, 282, 324, 325, 337, 356, 370, 371, 372,
+	274, 267, 351, 268, 291, 269, 247, 358, 270, 249,
+	338, 375, 0, 287, 347, 311, 250, 310, 339, 374,
+	373, 258, 399, 405, 406, 411, 0, 412, 0, 0,
+	0, 420, 425, 426, 427, 429, 442, 443, 430, 431,
+	432, 433, 434, 435, 436, 437, 438, 452, 453, 439,
+	440, 0, 441, 454, 444, 0, 0, 0, 0, 414,
+	0, 0, 0, 0, 0, 0, 404, 285, 243, 244,
+	451, 0, 330, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 326, 403, 0, 0, 0, 0, 450, 0,
+	0, 0, 0, 0, 449, 336, 0, 355, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	362, 385, 397, 415, 418, 0, 0, 0, 248, 417,
+	0, 0, 0, 0, 0, 0, 0, 388, 0, 0,
+	0, 396, 0, 0, 0, 0, 0, 413, 320, 321,
+	322, 323, 288, 0, 265, 416, 345, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 284, 290, 428, 292, 264,
+	335, 286, 394, 298, 0, 421, 0, 422, 0, 0,
+	0, 0, 327, 295, 359, 299, 305, 348, 393, 333,
+	353, 262, 384, 360, 309, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 238, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 245, 0, 303, 0, 344,
+	283, 202, 203, 204, 205, 206, 207, 208, 209, 210,
+	211, 212, 213, 214, 215, 216, 217, 218, 219, 220,
+	221, 222, 223, 0, 224, 225, 226, 227, 228, 229,
+	230, 231, 232, 233, 234, 235, 236, 237, 0, 239,
+	240, 241, 242, 369, 0, 0, 400, 401, 402, 424,
+	386, 0, 448, 0, 332, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 278, 0, 0, 302, 0, 0, 0, 0, 0,
+	0, 361, 316, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 192, 0, 0, 0, 0, 0, 0,
+	260, 193, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 263, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2732, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 251, 366, 382, 261, 357, 395,
+	266, 364, 256, 331, 354, 0, 0, 253, 380, 363,
+	313, 296, 297, 252, 0, 349, 276, 289, 273, 329,
+	0, 379, 407, 272, 398, 0, 390, 255, 0, 389,
+	328, 376, 381, 314, 308, 254, 378, 312, 307, 300,
+	280, 423, 293, 340, 306, 341, 294, 318, 317, 319,
+	0, 0, 0, 0, 0, 419, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 392, 0, 0, 0, 0, 0, 0,
+	365, 0, 0, 301, 0, 0, 0, 408, 0, 352,
+	334, 0, 0, 0, 350, 304, 377, 342, 383, 367,
+	391, 346, 343, 246, 368, 275, 315, 257, 259, 271,
+	277, 279, 281, 282, 324, 325, 337, 356, 370, 371,
+	372, 274, 267, 351, 268, 291, 269, 247, 358, 270,
+	249, 338, 375, 0, 287, 347, 311, 250, 310, 339,
+	374, 373, 258, 399, 405, 406, 411, 0, 412, 0,
+	0, 0, 420, 425, 426, 427, 429, 442, 443, 430,
+	431, 432, 433, 434, 435, 436, 437, 438, 452, 453,
+	439, 440, 0, 441, 454, 444, 0, 0, 0, 0,
+	414, 0, 0, 0, 0, 0, 0, 404, 285, 243,
+	244, 451, 0, 330, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 326, 403, 0, 0, 0, 0, 450,
+	0, 0, 0, 0, 0, 449, 336, 0, 355, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 362, 385, 397, 415, 418, 0, 0, 0, 248,
+	417, 0, 0, 0, 0, 0, 0, 0, 388, 0,
+	0, 0, 396, 0, 0, 0, 0, 0, 413, 320,
+	321, 322, 323, 288, 0, 265, 416, 345, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 409, 410, 284, 290, 428, 292,
+	264, 335, 286, 394, 298, 0, 421, 0, 422, 0,
+	0, 0, 0, 327, 295, 359, 299, 305, 348, 393,
+	333, 353, 262, 384, 360, 309, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 238,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 245, 0, 303, 0,
+	344, 283, 202, 203, 204, 205, 206, 207, 208, 209,
+	210, 211, 212, 213, 214, 215, 216, 217, 218, 219,
+	220, 221, 222, 223, 0, 224, 225, 226, 227, 228,
+	229, 230, 231, 232, 233, 234, 235, 236, 237, 0,
+	239, 240, 241, 242, 369, 0, 0, 400, 401, 402,
+	424, 386, 0, 448, 0, 332, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 278, 0, 0, 302, 0, 0, 0, 0,
+	0, 0, 361, 316, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 192, 0, 0, 0, 0, 0,
+	0, 260, 193, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 263, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2494,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 251, 366, 382, 261, 357,
+	395, 266, 364, 256, 331, 354, 0, 0, 253, 380,
+	363, 313, 296, 297, 252, 0, 349, 276, 289, 273,
+	329, 0, 379, 407, 272, 398, 0, 390, 255, 0,
+	389, 328, 376, 381, 314, 308, 254, 378, 312, 307,
+	300, 280, 423, 293, 340, 306, 341, 294, 318, 317,
+	319, 0, 0, 0, 0, 0, 419, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 392, 0, 0, 0, 0, 0,
+	0, 365, 0, 0, 301, 0, 0, 0, 408, 0,
+	352, 334, 0, 0, 0, 350, 304, 377, 342, 383,
+	367, 391, 346, 343, 246, 368, 275, 315, 257, 259,
+	271, 277, 279, 281, 282, 324, 325, 337, 356, 370,
+	371, 372, 274, 267, 351, 268, 291, 269, 247, 358,
+	270, 249, 338, 375, 0, 287, 347, 311, 250, 310,
+	339, 374, 373, 258, 399, 405, 406, 411, 0, 412,
+	0, 0, 0, 420, 425, 426, 427, 429, 442, 443,
+	430, 431, 432, 433, 434, 435, 436, 437, 438, 452,
+	453, 439, 440, 0, 441, 454, 444, 0, 0, 0,
+	0, 414, 0, 0, 0, 0, 0, 0, 404, 285,
+	243, 244, 451, 0, 330, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 403, 0, 0, 0, 0,
+	450, 0, 0, 0, 0, 0, 449, 336, 0, 355,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 362, 385, 397, 415, 418, 0, 0, 0,
+	248, 417, 0, 0, 0, 0, 0, 0, 0, 388,
+	0, 0, 0, 396, 0, 0, 0, 0, 0, 413,
+	320, 321, 322, 323, 288, 0, 265, 416, 345, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 409, 410, 284, 290, 428,
+	292, 264, 335, 286, 394, 298, 0, 421, 0, 422,
+	0, 0, 0, 0, 327, 295, 359, 299, 305, 348,
+	393, 333, 353, 262, 384, 360, 309, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	238, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 245, 0, 303,
+	0, 344, 283, 202, 203, 204, 205, 206, 207, 208,
+	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
+	219, 220, 221, 222, 223, 0, 224, 225, 226, 227,
+	228, 229, 230, 231, 232, 233, 234, 235, 236, 237,
+	0, 239, 240, 241, 242, 369, 0, 0, 400, 401,
+	402, 424, 386, 0, 448, 0, 332, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 278, 0, 0, 302, 0, 0, 0,
+	0, 0, 0, 361, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 192, 0, 0, 2459, 0,
+	0, 0, 260, 193, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 263, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 251, 366, 382, 261,
+	357, 395, 266, 364, 256, 331, 354, 0, 0, 253,
+	380, 363, 313, 296, 297, 252, 0, 349, 276, 289,
+	273, 329, 0, 379, 407, 272, 398, 0, 390, 255,
+	0, 389, 328, 376, 381, 314, 308, 254, 378, 312,
+	307, 300, 280, 423, 293, 340, 306, 341, 294, 318,
+	317, 319, 0, 0, 0, 0, 0, 419, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 392, 0, 0, 0, 0,
+	0, 0, 365, 0, 0, 301, 0, 0, 0, 408,
+	0, 352, 334, 0, 0, 0, 350, 304, 377, 342,
+	383, 367, 391, 346, 343, 246, 368, 275, 315, 257,
+	259, 271, 277, 279, 281, 282, 324, 325, 337, 356,
+	370, 371, 372, 274, 267, 351, 268, 291, 269, 247,
+	358, 270, 249, 338, 375, 0, 287, 347, 311, 250,
+	310, 339, 374, 373, 258, 399, 405, 406, 411, 0,
+	412, 0, 0, 0, 420, 425, 426, 427, 429, 442,
+	443, 430, 431, 432, 433, 434, 435, 436, 437, 438,
+	452, 453, 439, 440, 0, 441, 454, 444, 0, 0,
+	0, 0, 414, 0, 0, 0, 0, 0, 0, 404,
+	285, 243, 244, 451, 0, 330, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 326, 403, 0, 0, 0,
+	0, 450, 0, 0, 0, 0, 0, 449, 336, 0,
+	355, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 362, 385, 397, 415, 418, 0, 0,
+	0, 248, 417, 0, 0, 0, 0, 0, 0, 0,
+	388, 0, 0, 0, 396, 0, 0, 0, 0, 0,
+	413, 320, 321, 322, 323, 288, 0, 265, 416, 345,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 409, 410, 284, 290,
+	428, 292, 264, 335, 286, 394, 298, 0, 421, 0,
+	422, 0, 0, 0, 0, 327, 295, 359, 299, 305,
+	348, 393, 333, 353, 262, 384, 360, 309, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 238, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	220, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 245, 0,
+	303, 0, 344, 283, 202, 203, 204, 205, 206, 207,
+	208, 209, 210, 211, 212, 213, 214, 215, 216, 217,
+	218, 219, 220, 221, 222, 223, 0, 224, 225, 226,
+	227, 228, 229, 230, 231, 232, 233, 234, 235, 236,
+	237, 0, 239, 240, 241, 242, 369, 0, 0, 400,
+	401, 402, 424, 386, 0, 448, 0, 332, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 278, 0, 0, 302, 0, 0,
+	0, 0, 0, 0, 361, 316, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 192, 0, 0, 2393,
+	0, 0, 0, 260, 193, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 263, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 251, 366, 382,
+	261, 357, 395, 266, 364, 256, 331, 354, 0, 0,
+	253, 380, 363, 313, 296, 297, 252, 0, 349, 276,
+	289, 273, 329, 0, 379, 407, 272, 398, 0, 390,
+	255, 0, 389, 328, 376, 381, 314, 308, 254, 378,
+	312, 307, 300, 280, 423, 293, 340, 306, 341, 294,
+	318, 317, 319, 0, 0, 0, 0, 0, 419, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 392, 0, 0, 0,
+	0, 0, 0, 365, 0, 0, 301, 0, 0, 0,
+	408, 0, 352, 334, 0, 0, 0, 350, 304, 377,
+	342, 383, 367, 391, 346, 343, 246, 368, 275, 315,
+	257, 259, 271, 277, 279, 281, 282, 324, 325, 337,
+	356, 370, 371, 372, 274, 267, 351, 268, 291, 269,
+	247, 358, 270, 249, 338, 375, 0, 287, 347, 311,
+	250, 310, 339, 374, 373, 258, 399, 405, 406, 411,
+	0, 412, 0, 0, 0, 420, 425, 426, 427, 429,
+	442, 443, 430, 431, 432, 433, 434, 435, 436, 437,
+	438, 452, 453, 439, 440, 0, 441, 454, 444, 0,
+	0, 0, 0, 414, 0, 0, 0, 0, 0, 0,
+	404, 285, 243, 244, 451, 0, 330, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 326, 403, 0, 0,
+	0, 0, 450, 0, 0, 0, 0, 0, 449, 336,
+	0, 355, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 362, 385, 397, 415, 418, 0,
+	0, 0, 248, 417, 0, 0, 0, 0, 0, 0,
+	0, 388, 0, 0, 0, 396, 0, 0, 0, 0,
+	0, 413, 320, 321, 322, 323, 288, 0, 265, 416,
+	345, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 409, 410, 284,
+	290, 428, 292, 264, 335, 286, 394, 298, 0, 421,
+	0, 422, 0, 0, 0, 0, 327, 295, 359, 299,
+	305, 348, 393, 333, 353, 262, 384, 360, 309, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 238, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 227, 0, 285,
-	0, 326, 265, 184, 185, 186, 187, 188, 189, 190,
-	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
-	201, 202, 203, 204, 205, 0, 206, 207, 208, 209,
-	210, 211, 212, 213, 214, 215, 216, 217, 218, 219,
-	0, 221, 222, 223, 224, 351, 0, 0, 382, 383,
-	384, 406, 368, 0, 418, 0, 314, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 260, 1916, 0, 284, 0, 0, 0,
-	0, 0, 0, 343, 298, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 177, 0, 0, 1146, 0, 0,
-	0, 242, 178, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 245, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 233, 348, 364, 243, 339, 377,
-	248, 346, 238, 313, 336, 0, 0, 235, 362, 345,
-	295, 278, 279, 234, 0, 331, 258, 271, 255, 311,
-	0, 361, 389, 254, 380, 0, 372, 237, 0, 371,
-	310, 358, 363, 296, 290, 236, 360, 294, 289, 282,
-	262, 405, 275, 322, 288, 323, 276, 300, 299, 301,
-	0, 0, 0, 0, 0, 401, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 374, 0, 0, 0, 0, 0, 0,
-	347, 0, 0, 283, 0, 0, 0, 390, 0, 334,
-	316, 0, 0, 0, 332, 286, 359, 324, 365, 349,
-	373, 328, 325, 228, 350, 257, 297, 239, 241, 253,
-	259, 261, 263, 264, 306, 307, 319, 338, 352, 353,
-	354, 256, 249, 333, 250, 273, 251, 229, 340, 252,
-	231, 320, 357, 0, 269, 329, 293, 232, 292, 321,
-	356, 355, 240, 381, 387, 388, 393, 0, 394, 0,
-	0, 0, 402, 407, 408, 409, 411, 412, 413, 414,
-	0, 0, 0, 0, 396, 0, 0, 0, 0, 0,
-	0, 386, 267, 225, 226, 421, 0, 312, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 308, 385, 0,
-	0, 0, 0, 420, 0, 0, 0, 0, 0, 419,
-	318, 0, 337, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 344, 367, 379, 397, 400,
-	0, 0, 0, 230, 399, 0, 0, 0, 0, 0,
-	0, 0, 370, 0, 0, 0, 378, 0, 0, 0,
-	0, 0, 395, 302, 303, 304, 305, 270, 0, 247,
-	398, 327, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 391, 392,
-	266, 272, 410, 274, 246, 317, 268, 376, 280, 0,
-	403, 0, 404, 0, 0, 0, 0, 309, 277, 341,
-	281, 287, 330, 375, 315, 335, 244, 366, 342, 291,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 220, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	227, 0, 285, 0, 326, 265, 184, 185, 186, 187,
-	188, 189, 190, 191, 192, 193, 194, 195, 196, 197,
-	198, 199, 200, 201, 202, 203, 204, 205, 0, 206,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 245,
+	0, 303, 0, 344, 283, 202, 203, 204, 205, 206,
 	207, 208, 209, 210, 211, 212, 213, 214, 215, 216,
-	217, 218, 219, 0, 221, 222, 223, 224, 351, 0,
-	0, 382, 383, 384, 406, 368, 0, 418, 0, 314,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 260, 0, 0, 284,
-	0, 0, 0, 0, 0, 0, 343, 298, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 2895, 0, 177, 0, 0,
-	0, 0, 0, 0, 242, 178, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 245, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 233, 348, 364,
-	243, 339, 377, 248, 346, 238, 313, 336, 0, 0,
-	235, 362, 345, 295, 278, 279, 234, 0, 331, 258,
-	271, 255, 311, 0, 361, 389, 254, 380, 0, 372,
-	237, 0, 371, 310, 358, 363, 296, 290, 236, 360,
-	294, 289, 282, 262, 405, 275, 322, 288, 323, 276,
-	300, 299, 301, 0, 0, 0, 0, 0, 401, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 374, 0, 0, 0,
-	0, 0, 0, 347, 0, 0, 283, 0, 0, 0,
-	390, 0, 334, 316, 0, 0, 0, 332, 286, 359,
-	324, 365, 349, 373, 328, 325, 228, 350, 257, 297,
-	239, 241, 253, 259, 261, 263, 264, 306, 307, 319,
-	338, 352, 353, 354, 256, 249, 333, 250, 273, 251,
-	229, 340, 252, 231, 320, 357, 0, 269, 329, 293,
-	232, 292, 321, 356, 355, 240, 381, 387, 388, 393,
-	0, 394, 0, 0, 0, 402, 407, 408, 409, 411,
-	412, 413, 414, 0, 0, 0, 0, 396, 0, 0,
-	0, 0, 0, 0, 386, 267, 225, 226, 421, 0,
-	312, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	308, 385, 0, 0, 0, 0, 420, 0, 0, 0,
-	0, 0, 419, 318, 0, 337, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 344, 367,
-	379, 397, 400, 0, 0, 0, 230, 399, 0, 0,
-	0, 0, 0, 0, 0, 370, 0, 0, 0, 378,
-	0, 0, 0, 0, 0, 395, 302, 303, 304, 305,
-	270, 0, 247, 398, 327, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 391, 392, 266, 272, 410, 274, 246, 317, 268,
-	376, 280, 0, 403, 0, 404, 0, 0, 0, 0,
-	309, 277, 341, 281, 287, 330, 375, 315, 335, 244,
-	366, 342, 291, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 220, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 227, 0, 285, 0, 326, 265, 184,
-	185, 186, 187, 188, 189, 190, 191, 192, 193, 194,
-	195, 196, 197, 198, 199, 200, 201, 202, 203, 204,
-	205, 0, 206, 207, 208, 209, 210, 211, 212, 213,
-	214, 215, 216, 217, 218, 219, 0, 221, 222, 223,
-	224, 351, 0, 0, 382, 383, 384, 406, 368, 0,
-	418, 0, 314, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 260,
-	0, 0, 284, 0, 0, 0, 0, 0, 0, 343,
-	298, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	177, 590, 0, 0, 0, 0, 0, 242, 178, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 245, 0,
+	217, 218, 219, 220, 221, 222, 223, 0, 224, 225,
+	226, 227, 228, 229, 230, 231, 232, 233, 234, 235,
+	236, 237, 0, 239, 240, 241, 242, 369, 0, 0,
+	400, 401, 402, 424, 386, 0, 448, 0, 332, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 278, 0, 0, 302, 0,
+	0, 0, 0, 0, 0, 361, 316, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 192, 0, 0,
+	0, 0, 0, 0, 260, 193, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 263, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2387, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 251, 366,
+	382, 261, 357, 395, 266, 364, 256, 331, 354, 0,
+	0, 253, 380, 363, 313, 296, 297, 252, 0, 349,
+	276, 289, 273, 329, 0, 379, 407, 272, 398, 0,
+	390, 255, 0, 389, 328, 376, 381, 314, 308, 254,
+	378, 312, 307, 300, 280, 423, 293, 340, 306, 341,
+	294, 318, 317, 319, 0, 0, 0, 0, 0, 419,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 392, 0, 0,
+	0, 0, 0, 0, 365, 0, 0, 301, 0, 0,
+	0, 408, 0, 352, 334, 0, 0, 0, 350, 304,
+	377, 342, 383, 367, 391, 346, 343, 246, 368, 275,
+	315, 257, 259, 271, 277, 279, 281, 282, 324, 325,
+	337, 356, 370, 371, 372, 274, 267, 351, 268, 291,
+	269, 247, 358, 270, 249, 338, 375, 0, 287, 347,
+	311, 250, 310, 339, 374, 373, 258, 399, 405, 406,
+	411, 0, 412, 0, 0, 0, 420, 425, 426, 427,
+	429, 442, 443, 430, 431, 432, 433, 434, 435, 436,
+	437, 438, 452, 453, 439, 440, 0, 441, 454, 444,
+	0, 0, 0, 0, 414, 0, 0, 0, 0, 0,
+	0, 404, 285, 243, 244, 451, 0, 330, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 326, 403, 0,
+	0, 0, 0, 450, 0, 0, 0, 0, 0, 449,
+	336, 0, 355, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 362, 385, 397, 415, 418,
+	0, 0, 0, 248, 417, 0, 0, 0, 0, 0,
+	0, 0, 388, 0, 0, 0, 396, 0, 0, 0,
+	0, 0, 413, 320, 321, 322, 323, 288, 0, 265,
+	416, 345, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 409, 410,
+	284, 290, 428, 292, 264, 335, 286, 394, 298, 0,
+	421, 0, 422, 0, 0, 0, 0, 327, 295, 359,
+	299, 305, 348, 393, 333, 353, 262, 384, 360, 309,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 238, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	245, 0, 303, 0, 344, 283, 202, 203, 204, 205,
+	206, 207, 208, 209, 210, 211, 212, 213, 214, 215,
+	216, 217, 218, 219, 220, 221, 222, 223, 0, 224,
+	225, 226, 227, 228, 229, 230, 231, 232, 233, 234,
+	235, 236, 237, 0, 239, 240, 241, 242, 369, 0,
+	0, 400, 401, 402, 424, 386, 0, 448, 0, 332,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 278, 0, 0, 302,
+	0, 0, 0, 0, 0, 0, 361, 316, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 192, 0,
+	0, 1194, 0, 0, 0, 260, 193, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 263, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 251,
+	366, 382, 261, 357, 395, 266, 364, 256, 331, 354,
+	0, 0, 253, 380, 363, 313, 296, 297, 252, 0,
+	349, 276, 289, 273, 329, 0, 379, 407, 272, 398,
+	0, 390, 255, 0, 389, 328, 376, 381, 314, 308,
+	254, 378, 312, 307, 300, 280, 423, 293, 340, 306,
+	341, 294, 318, 317, 319, 0, 0, 0, 0, 0,
+	419, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 392, 0,
+	0, 0, 0, 0, 0, 365, 0, 0, 301, 0,
+	0, 0, 408, 0, 352, 334, 0, 0, 0, 350,
+	304, 377, 342, 383, 367, 391, 346, 343, 246, 368,
+	275, 315, 257, 259, 271, 277, 279, 281, 282, 324,
+	325, 337, 356, 370, 371, 372, 274, 267, 351, 268,
+	291, 269, 247, 358, 270, 249, 338, 375, 0, 287,
+	347, 311, 250, 310, 339, 374, 373, 258, 399, 405,
+	406, 411, 0, 412, 0, 0, 0, 420, 425, 426,
+	427, 429, 442, 443, 430, 431, 432, 433, 434, 435,
+	436, 437, 438, 452, 453, 439, 440, 0, 441, 454,
+	444, 0, 0, 0, 0, 414, 0, 0, 0, 0,
+	0, 0, 404, 285, 243, 244, 451, 0, 330, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 326, 403,
+	0, 0, 0, 0, 450, 0, 0, 0, 0, 0,
+	449, 336, 0, 355, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 362, 385, 397, 415,
+	418, 0, 0, 0, 248, 417, 0, 0, 0, 0,
+	0, 0, 0, 388, 0, 0, 0, 396, 0, 0,
+	0, 0, 0, 413, 320, 321, 322, 323, 288, 0,
+	265, 416, 345, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 409,
+	410, 284, 290, 428, 292, 264, 335, 286, 394, 298,
+	0, 421, 0, 422, 0, 0, 0, 0, 327, 295,
+	359, 299, 305, 348, 393, 333, 353, 262, 384, 360,
+	309, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 238, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 245, 0, 303, 0, 344, 283, 202, 203, 204,
+	205, 206, 207, 208, 209, 210, 211, 212, 213, 214,
+	215, 216, 217, 218, 219, 220, 221, 222, 223, 0,
+	224, 225, 226, 227, 228, 229, 230, 231, 232, 233,
+	234, 235, 236, 237, 0, 239, 240, 241, 242, 369,
+	0, 0, 400, 401, 402, 424, 386, 0, 448, 0,
+	332, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 278, 0, 0,
+	302, 0, 0, 0, 0, 0, 0, 361, 316, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 192,
+	0, 0, 0, 0, 0, 0, 260, 193, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2348, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	251, 366, 382, 261, 357, 395, 266, 364, 256, 331,
+	354, 0, 0, 253, 380, 363, 313, 296, 297, 252,
+	0, 349, 276, 289, 273, 329, 0, 379, 407, 272,
+	398, 0, 390, 255, 0, 389, 328, 376, 381, 314,
+	308, 254, 378, 312, 307, 300, 280, 423, 293, 340,
+	306, 341, 294, 318, 317, 319, 0, 0, 0, 0,
+	0, 419, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 392,
+	0, 0, 0, 0, 0, 0, 365, 0, 0, 301,
+	0, 0, 0, 408, 0, 352, 334, 0, 0, 0,
+	350, 304, 377, 342, 383, 367, 391, 346, 343, 246,
+	368, 275, 315, 257, 259, 271, 277, 279, 281, 282,
+	324, 325, 337, 356, 370, 371, 372, 274, 267, 351,
+	268, 291, 269, 247, 358, 270, 249, 338, 375, 0,
+	287, 347, 311, 250, 310, 339, 374, 373, 258, 399,
+	405, 406, 411, 0, 412, 0, 0, 0, 420, 425,
+	426, 427, 429, 442, 443, 430, 431, 432, 433, 434,
+	435, 436, 437, 438, 452, 453, 439, 440, 0, 441,
+	454, 444, 0, 0, 0, 0, 414, 0, 0, 0,
+	0, 0, 0, 404, 285, 243, 244, 451, 0, 330,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 326,
+	403, 0, 0, 0, 0, 450, 0, 0, 0, 0,
+	0, 449, 336, 0, 355, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 362, 385, 397,
+	415, 418, 0, 0, 0, 248, 417, 0, 0, 0,
+	0, 0, 0, 0, 388, 0, 0, 0, 396, 0,
+	0, 0, 0, 0, 413, 320, 321, 322, 323, 288,
+	0, 265, 416, 345, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	409, 410, 284, 290, 428, 292, 264, 335, 286, 394,
+	298, 0, 421, 0, 422, 0, 0, 0, 0, 327,
+	295, 359, 299, 305, 348, 393, 333, 353, 262, 384,
+	360, 309, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 238, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 245, 0, 303, 0, 344, 283, 202, 203,
+	204, 205, 206, 207, 208, 209, 210, 211, 212, 213,
+	214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
+	0, 224, 225, 226, 227, 228, 229, 230, 231, 232,
+	233, 234, 235, 236, 237, 0, 239, 240, 241, 242,
+	369, 0, 0, 400, 401, 402, 424, 386, 0, 448,
+	0, 332, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 278, 0,
+	0, 302, 0, 0, 0, 0, 0, 0, 361, 316,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	192, 0, 0, 2346, 0, 0, 0, 260, 193, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 263,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 251, 366, 382, 261, 357, 395, 266, 364, 256,
+	331, 354, 0, 0, 253, 380, 363, 313, 296, 297,
+	252, 0, 349, 276, 289, 273, 329, 0, 379, 407,
+	272, 398, 0, 390, 255, 0, 389, 328, 376, 381,
+	314, 308, 254, 378, 312, 307, 300, 280, 423, 293,
+	340, 306, 341, 294, 318, 317, 319, 0, 0, 0,
+	0, 0, 419, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	392, 0, 0, 0, 0, 0, 0, 365, 0, 0,
+	301, 0, 0, 0, 408, 0, 352, 334, 0, 0,
+	0, 350, 304, 377, 342, 383, 367, 391, 346, 343,
+	246, 368, 275, 315, 257, 259, 271, 277, 279, 281,
+	282, 324, 325, 337, 356, 370, 371, 372, 274, 267,
+	351, 268, 291, 269, 247, 358, 270, 249, 338, 375,
+	0, 287, 347, 311, 250, 310, 339, 374, 373, 258,
+	399, 405, 406, 411, 0, 412, 0, 0, 0, 420,
+	425, 426, 427, 429, 442, 443, 430, 431, 432, 433,
+	434, 435, 436, 437, 438, 452, 453, 439, 440, 0,
+	441, 454, 444, 0, 0, 0, 0, 414, 0, 0,
+	0, 0, 0, 0, 404, 285, 243, 244, 451, 0,
+	330, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	326, 403, 0, 0, 0, 0, 450, 0, 0, 0,
+	0, 0, 449, 336, 0, 355, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 362, 385,
+	397, 415, 418, 0, 0, 0, 248, 417, 0, 0,
+	0, 0, 0, 0, 0, 388, 0, 0, 0, 396,
+	0, 0, 0, 0, 0, 413, 320, 321, 322, 323,
+	288, 0, 265, 416, 345, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 409, 410, 284, 290, 428, 292, 264, 335, 286,
+	394, 298, 0, 421, 0, 422, 0, 0, 0, 0,
+	327, 295, 359, 299, 305, 348, 393, 333, 353, 262,
+	384, 360, 309, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 238, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 245, 0, 303, 0, 344, 283, 202,
+	203, 204, 205, 206, 207, 208, 209, 210, 211, 212,
+	213, 214, 215, 216, 217, 218, 219, 220, 221, 222,
+	223, 0, 224, 225, 226, 227, 228, 229, 230, 231,
+	232, 233, 234, 235, 236, 237, 0, 239, 240, 241,
+	242, 369, 0, 0, 400, 401, 402, 424, 386, 0,
+	448, 0, 332, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
+	0, 0, 302, 0, 0, 0, 0, 0, 0, 361,
+	316, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 192, 0, 0, 2001, 0, 0, 0, 260, 193,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	263, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 251, 366, 382, 261, 357, 395, 266, 364,
+	256, 331, 354, 0, 0, 253, 380, 363, 313, 296,
+	297, 252, 0, 349, 276, 289, 273, 329, 0, 379,
+	407, 272, 398, 0, 390, 255, 0, 389, 328, 376,
+	381, 314, 308, 254, 378, 312, 307, 300, 280, 423,
+	293, 340, 306, 341, 294, 318, 317, 319, 0, 0,
+	0, 0, 0, 419, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 392, 0, 0, 0, 0, 0, 0, 365, 0,
+	0, 301, 0, 0, 0, 408, 0, 352, 334, 0,
+	0, 0, 350, 304, 377, 342, 383, 367, 391, 346,
+	343, 246, 368, 275, 315, 257, 259, 271, 277, 279,
+	281, 282, 324, 325, 337, 356, 370, 371, 372, 274,
+	267, 351, 268, 291, 269, 247, 358, 270, 249, 338,
+	375, 0, 287, 347, 311, 250, 310, 339, 374, 373,
+	258, 399, 405, 406, 411, 0, 412, 0, 0, 0,
+	420, 425, 426, 427, 429, 442, 443, 430, 431, 432,
+	433, 434, 435, 436, 437, 438, 452, 453, 439, 440,
+	0, 441, 454, 444, 0, 0, 0, 0, 414, 0,
+	0, 0, 0, 0, 0, 404, 285, 243, 244, 451,
+	0, 330, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 326, 403, 0, 0, 0, 0, 450, 0, 0,
+	0, 0, 0, 449, 336, 0, 355, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 362,
+	385, 397, 415, 418, 0, 0, 0, 248, 417, 0,
+	0, 0, 0, 0, 0, 0, 388, 0, 0, 0,
+	396, 0, 0, 0, 0, 0, 413, 320, 321, 322,
+	323, 288, 0, 265, 416, 345, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 409, 410, 284, 290, 428, 292, 264, 335,
+	286, 394, 298, 0, 421, 0, 422, 0, 0, 0,
+	0, 327, 295, 359, 299, 305, 348, 393, 333, 353,
+	262, 384, 360, 309, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 238, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 245, 0, 303, 0, 344, 283,
+	202, 203, 204, 205, 206, 207, 208, 209, 210, 211,
+	212, 213, 214, 215, 216, 217, 218, 219, 220, 221,
+	222, 223, 0, 224, 225, 226, 227, 228, 229, 230,
+	231, 232, 233, 234, 235, 236, 237, 0, 239, 240,
+	241, 242, 369, 0, 0, 400, 401, 402, 424, 386,
+	0, 448, 0, 332, 0, 0, 0, 0, 0, 0,
+	0, 0, 2238, 0, 0, 0, 0, 0, 0, 0,
+	278, 0, 0, 302, 0, 0, 0, 0, 0, 0,
+	361, 316, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 192, 0, 0, 0, 0, 0, 0, 260,
+	193, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 263, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 251, 366, 382, 261, 357, 395, 266,
+	364, 256, 331, 354, 0, 0, 253, 380, 363, 313,
+	296, 297, 252, 0, 349, 276, 289, 273, 329, 0,
+	379, 407, 272, 398, 0, 390, 255, 0, 389, 328,
+	376, 381, 314, 308, 254, 378, 312, 307, 300, 280,
+	423, 293, 340, 306, 341, 294, 318, 317, 319, 0,
+	0, 0, 0, 0, 419, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 392, 0, 0, 0, 0, 0, 0, 365,
+	0, 0, 301, 0, 0, 0, 408, 0, 352, 334,
+	0, 0, 0, 350, 304, 377, 342, 383, 367, 391,
+	346, 343, 246, 368, 275, 315, 257, 259, 271, 277,
+	279, 281, 282, 324, 325, 337, 356, 370, 371, 372,
+	274, 267, 351, 268, 291, 269, 247, 358, 270, 249,
+	338, 375, 0, 287, 347, 311, 250, 310, 339, 374,
+	373, 258, 399, 405, 406, 411, 0, 412, 0, 0,
+	0, 420, 425, 426, 427, 429, 442, 443, 430, 431,
+	432, 433, 434, 435, 436, 437, 438, 452, 453, 439,
+	440, 0, 441, 454, 444, 0, 0, 0, 0, 414,
+	0, 0, 0, 0, 0, 0, 404, 285, 243, 244,
+	451, 0, 330, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 326, 403, 0, 0, 0, 0, 450, 0,
+	0, 0, 0, 0, 449, 336, 0, 355, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	362, 385, 397, 415, 418, 0, 0, 0, 248, 417,
+	0, 0, 0, 0, 0, 0, 0, 388, 0, 0,
+	0, 396, 0, 0, 0, 0, 0, 413, 320, 321,
+	322, 323, 288, 0, 265, 416, 345, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 284, 290, 428, 292, 264,
+	335, 286, 394, 298, 0, 421, 0, 422, 0, 0,
+	0, 0, 327, 295, 359, 299, 305, 348, 393, 333,
+	353, 262, 384, 360, 309, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 238, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 245, 0, 303, 0, 344,
+	283, 202, 203, 204, 205, 206, 207, 208, 209, 210,
+	211, 212, 213, 214, 215, 216, 217, 218, 219, 220,
+	221, 222, 223, 0, 224, 225, 226, 227, 228, 229,
+	230, 231, 232, 233, 234, 235, 236, 237, 0, 239,
+	240, 241, 242, 369, 0, 0, 400, 401, 402, 424,
+	386, 0, 448, 0, 332, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 278, 0, 0, 302, 0, 0, 0, 0, 0,
+	0, 361, 316, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 192, 0, 0, 0, 0, 0, 0,
+	260, 193, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 263, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1714, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 251, 366, 382, 261, 357, 395,
+	266, 364, 256, 331, 354, 0, 0, 253, 380, 363,
+	313, 296, 297, 252, 0, 349, 276, 289, 273, 329,
+	0, 379, 407, 272, 398, 0, 390, 255, 0, 389,
+	328, 376, 381, 314, 308, 254, 378, 312, 307, 300,
+	280, 423, 293, 340, 306, 341, 294, 318, 317, 319,
+	0, 0, 0, 0, 0, 419, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 392, 0, 0, 0, 0, 0, 0,
+	365, 0, 0, 301, 0, 0, 0, 408, 0, 352,
+	334, 0, 0, 0, 350, 304, 377, 342, 383, 367,
+	391, 346, 343, 246, 368, 275, 315, 257, 259, 271,
+	277, 279, 281, 282, 324, 325, 337, 356, 370, 371,
+	372, 274, 267, 351, 268, 291, 269, 247, 358, 270,
+	249, 338, 375, 0, 287, 347, 311, 250, 310, 339,
+	374, 373, 258, 399, 405, 406, 411, 0, 412, 0,
+	0, 0, 420, 425, 426, 427, 429, 442, 443, 430,
+	431, 432, 433, 434, 435, 436, 437, 438, 452, 453,
+	439, 440, 0, 441, 454, 444, 0, 0, 0, 0,
+	414, 0, 0, 0, 0, 0, 0, 404, 285, 243,
+	244, 451, 0, 330, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 326, 403, 0, 0, 0, 0, 450,
+	0, 0, 0, 0, 0, 449, 336, 0, 355, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 362, 385, 397, 415, 418, 0, 0, 0, 248,
+	417, 0, 0, 0, 0, 0, 0, 0, 388, 0,
+	0, 0, 396, 0, 0, 0, 0, 0, 413, 320,
+	321, 322, 323, 288, 0, 265, 416, 345, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 409, 410, 284, 290, 428, 292,
+	264, 335, 286, 394, 298, 0, 421, 0, 422, 0,
+	0, 0, 0, 327, 295, 359, 299, 305, 348, 393,
+	333, 353, 262, 384, 360, 309, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 238,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 245, 0, 303, 0,
+	344, 283, 202, 203, 204, 205, 206, 207, 208, 209,
+	210, 211, 212, 213, 214, 215, 216, 217, 218, 219,
+	220, 221, 222, 223, 0, 224, 225, 226, 227, 228,
+	229, 230, 231, 232, 233, 234, 235, 236, 237, 0,
+	239, 240, 241, 242, 0, 0, 0, 400, 401, 402,
+	424, 386, 369, 448, 0, 0, 1886, 0, 0, 0,
+	0, 0, 0, 332, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	278, 0, 0, 302, 0, 0, 0, 0, 0, 0,
+	361, 316, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 192, 0, 0, 0, 0, 0, 0, 260,
+	193, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 263, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 251, 366, 382, 261, 357, 395, 266,
+	364, 256, 331, 354, 0, 0, 253, 380, 363, 313,
+	296, 297, 252, 0, 349, 276, 289, 273, 329, 0,
+	379, 407, 272, 398, 0, 390, 255, 0, 389, 328,
+	376, 381, 314, 308, 254, 378, 312, 307, 300, 280,
+	423, 293, 340, 306, 341, 294, 318, 317, 319, 0,
+	0, 0, 0, 0, 419, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 392, 0, 0, 0, 0, 0, 0, 365,
+	0, 0, 301, 0, 0, 0, 408, 0, 352, 334,
+	0, 0, 0, 350, 304, 377, 342, 383, 367, 391,
+	346, 343, 246, 368, 275, 315, 257, 259, 271, 277,
+	279, 281, 282, 324, 325, 337, 356, 370, 371, 372,
+	274, 267, 351, 268, 291, 269, 247, 358, 270, 249,
+	338, 375, 0, 287, 347, 311, 250, 310, 339, 374,
+	373, 258, 399, 405, 406, 411, 0, 412, 0, 0,
+	0, 420, 425, 426, 427, 429, 442, 443, 430, 431,
+	432, 433, 434, 435, 436, 437, 438, 452, 453, 439,
+	440, 0, 441, 454, 444, 0, 0, 0, 0, 414,
+	0, 0, 0, 0, 0, 0, 404, 285, 243, 244,
+	451, 0, 330, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 326, 403, 0, 0, 0, 0, 450, 0,
+	0, 0, 0, 0, 449, 336, 0, 355, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	362, 385, 397, 415, 418, 0, 0, 0, 248, 417,
+	0, 0, 0, 0, 0, 0, 0, 388, 0, 0,
+	0, 396, 0, 0, 0, 0, 0, 413, 320, 321,
+	322, 323, 288, 0, 265, 416, 345, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 410, 284, 290, 428, 292, 264,
+	335, 286, 394, 298, 0, 421, 0, 422, 0, 0,
+	0, 0, 327, 295, 359, 299, 305, 348, 393, 333,
+	353, 262, 384, 360, 309, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 238, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 245, 0, 303, 0, 344,
+	283, 202, 203, 204, 205, 206, 207, 208, 209, 210,
+	211, 212, 213, 214, 215, 216, 217, 218, 219, 220,
+	221, 222, 223, 0, 224, 225, 226, 227, 228, 229,
+	230, 231, 232, 233, 234, 235, 236, 237, 0, 239,
+	240, 241, 242, 369, 0, 0, 400, 401, 402, 424,
+	386, 0, 448, 0, 332, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 278, 0, 0, 302, 0, 0, 0, 0, 0,
+	0, 361, 316, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 192, 0, 0, 1194, 0, 0, 0,
+	260, 193, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 263, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 251, 366, 382, 261, 357, 395,
+	266, 364, 256, 331, 354, 0, 0, 253, 380, 363,
+	313, 296, 297, 252, 0, 349, 276, 289, 273, 329,
+	0, 379, 407, 272, 398, 0, 390, 255, 0, 389,
+	328, 376, 381, 314, 308, 254, 378, 312, 307, 300,
+	280, 423, 293, 340, 306, 341, 294, 318, 317, 319,
+	0, 0, 0, 0, 0, 419, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 392, 0, 0, 0, 0, 0, 0,
+	365, 0, 0, 301, 0, 0, 0, 408, 0, 352,
+	334, 0, 0, 0, 350, 304, 377, 342, 383, 367,
+	391, 1522, 343, 246, 368, 275, 315, 257, 259, 271,
+	277, 279, 281, 282, 324, 325, 337, 356, 370, 371,
+	372, 274, 267, 351, 268, 291, 269, 247, 358, 270,
+	249, 338, 375, 0, 287, 347, 311, 250, 310, 339,
+	374, 373, 258, 399, 405, 406, 411, 0, 412, 0,
+	0, 0, 420, 425, 426, 427, 429, 442, 443, 430,
+	431, 432, 433, 434, 435, 436, 437, 438, 452, 453,
+	439, 440, 0, 441, 454, 444, 0, 0, 0, 0,
+	414, 0, 0, 0, 0, 0, 0, 404, 285, 243,
+	244, 451, 0, 330, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 326, 403, 0, 0, 0, 0, 450,
+	0, 0, 0, 0, 0, 449, 336, 0, 355, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 362, 385, 397, 415, 418, 0, 0, 0, 248,
+	417, 0, 0, 0, 0, 0, 0, 0, 388, 0,
+	0, 0, 396, 0, 0, 0, 0, 0, 413, 320,
+	321, 322, 323, 288, 0, 265, 416, 345, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 409, 410, 284, 290, 428, 292,
+	264, 335, 286, 394, 298, 0, 421, 0, 422, 0,
+	0, 0, 0, 327, 295, 359, 299, 305, 348, 393,
+	333, 353, 262, 384, 360, 309, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 238,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 245, 0, 303, 0,
+	344, 283, 202, 203, 204, 205, 206, 207, 208, 209,
+	210, 211, 212, 213, 214, 215, 216, 217, 218, 219,
+	220, 221, 222, 223, 0, 224, 225, 226, 227, 228,
+	229, 230, 231, 232, 233, 234, 235, 236, 237, 0,
+	239, 240, 241, 242, 369, 0, 0, 400, 401, 402,
+	424, 386, 0, 448, 0, 332, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 278, 0, 0, 302, 0, 0, 0, 0,
+	0, 0, 361, 316, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 192, 0, 0, 0, 0, 0,
+	0, 260, 193, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 263, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 251, 366, 382, 261, 357,
+	395, 266, 364, 256, 331, 354, 0, 0, 253, 380,
+	363, 313, 296, 297, 252, 0, 349, 276, 289, 273,
+	329, 0, 379, 407, 272, 398, 0, 390, 255, 0,
+	389, 328, 376, 381, 314, 308, 254, 378, 312, 307,
+	300, 280, 423, 293, 340, 306, 341, 294, 318, 317,
+	319, 0, 0, 0, 0, 0, 419, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 392, 0, 0, 1218, 0, 0,
+	0, 365, 0, 0, 301, 0, 0, 0, 408, 0,
+	352, 334, 0, 0, 0, 350, 304, 377, 342, 383,
+	367, 391, 346, 343, 246, 368, 275, 315, 257, 259,
+	271, 277, 279, 281, 282, 324, 325, 337, 356, 370,
+	371, 372, 274, 267, 351, 268, 291, 269, 247, 358,
+	270, 249, 338, 375, 0, 287, 347, 311, 250, 310,
+	339, 374, 373, 258, 399, 405, 406, 411, 0, 412,
+	0, 0, 0, 420, 425, 426, 427, 429, 442, 443,
+	430, 431, 432, 433, 434, 435, 436, 437, 438, 452,
+	453, 439, 440, 0, 441, 454, 444, 0, 0, 0,
+	0, 414, 0, 0, 0, 0, 0, 0, 404, 285,
+	243, 244, 451, 0, 330, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 403, 0, 0, 0, 0,
+	450, 0, 0, 0, 0, 0, 449, 336, 0, 355,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 362, 385, 397, 415, 418, 0, 0, 0,
+	248, 417, 0, 0, 0, 0, 0, 0, 0, 388,
+	0, 0, 0, 396, 0, 0, 0, 0, 0, 413,
+	320, 321, 322, 323, 288, 0, 265, 416, 345, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 409, 410, 284, 290, 428,
+	292, 264, 335, 286, 394, 298, 0, 421, 0, 422,
+	0, 0, 0, 0, 327, 295, 359, 299, 305, 348,
+	393, 333, 353, 262, 384, 360, 309, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	238, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 245, 0, 303,
+	0, 344, 283, 202, 203, 204, 205, 206, 207, 208,
+	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
+	219, 220, 221, 222, 223, 0, 224, 225, 226, 227,
+	228, 229, 230, 231, 232, 233, 234, 235, 236, 237,
+	0, 239, 240, 241, 242, 369, 0, 0, 400, 401,
+	402, 424, 386, 0, 448, 0, 332, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 278, 0, 0, 302, 0, 0, 0,
+	0, 0, 0, 361, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 192, 0, 0, 0, 0,
+	0, 0, 260, 193, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 263, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 251, 366, 382, 261,
+	357, 395, 266, 364, 256, 331, 354, 0, 0, 253,
+	380, 363, 313, 296, 297, 252, 0, 349, 276, 289,
+	273, 329, 0, 379, 407, 272, 398, 0, 390, 255,
+	0, 389, 328, 376, 381, 314, 308, 254, 378, 312,
+	307, 300, 280, 423, 293, 340, 306, 341, 294, 318,
+	317, 319, 0, 0, 0, 0, 0, 419, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 392, 0, 0, 0, 0,
+	0, 0, 365, 0, 0, 301, 0, 0, 0, 408,
+	0, 352, 334, 0, 0, 0, 350, 304, 377, 342,
+	383, 367, 391, 346, 343, 246, 368, 275, 315, 257,
+	259, 271, 277, 279, 281, 282, 324, 325, 337, 356,
+	370, 371, 372, 274, 267, 351, 268, 291, 269, 247,
+	358, 270, 249, 338, 375, 0, 287, 347, 311, 250,
+	310, 339, 374, 373, 258, 399, 405, 406, 411, 0,
+	412, 0, 0, 0, 420, 425, 426, 427, 429, 442,
+	443, 430, 431, 432, 433, 434, 435, 436, 437, 438,
+	452, 453, 439, 440, 0, 441, 454, 444, 0, 0,
+	0, 0, 414, 0, 0, 0, 0, 0, 0, 404,
+	285, 243, 244, 451, 0, 330, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 326, 403, 0, 0, 0,
+	0, 450, 0, 0, 0, 0, 0, 449, 336, 0,
+	355, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 362, 385, 397, 415, 418, 0, 0,
+	0, 248, 417, 0, 0, 0, 0, 0, 0, 0,
+	388, 0, 0, 0, 396, 0, 0, 0, 0, 0,
+	413, 320, 321, 322, 323, 288, 0, 265, 416, 345,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 409, 410, 284, 290,
+	428, 292, 264, 335, 286, 394, 298, 0, 421, 0,
+	422, 0, 0, 0, 0, 327, 295, 359, 299, 305,
+	348, 393, 333, 353, 262, 384, 360, 309, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 238, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 730, 0, 0, 0, 245, 0,
+	303, 0, 344, 283, 202, 203, 204, 205, 206, 207,
+	208, 209, 210, 211, 212, 213, 214, 215, 216, 217,
+	218, 219, 220, 221, 222, 223, 0, 224, 225, 226,
+	227, 228, 229, 230, 231, 232, 233, 234, 235, 236,
+	237, 0, 239, 240, 241, 242, 369, 0, 0, 400,
+	401, 402, 424, 386, 0, 448, 0, 332, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 278, 0, 0, 302, 0, 0,
+	0, 0, 0, 0, 361, 316, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 192, 0, 0, 0,
+	0, 0, 0, 260, 193, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 263, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 251, 366, 382,
+	261, 357, 395, 266, 364, 256, 331, 354, 0, 0,
+	253, 380, 363, 313, 296, 297, 252, 0, 349, 276,
+	289, 273, 329, 0, 379, 407, 272, 398, 0, 390,
+	255, 0, 389, 328, 376, 381, 314, 308, 254, 378,
+	312, 307, 300, 280, 423, 293, 340, 306, 341, 294,
+	318, 317, 319, 0, 0, 0, 0, 0, 419, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 392, 0, 0, 0,
+	0, 0, 0, 365, 0, 0, 301, 0, 0, 0,
+	408, 0, 352, 334, 0, 0, 0, 350, 304, 377,
+	342, 383, 367, 391, 492, 343, 246, 368, 275, 315,
+	257, 259, 271, 277, 279, 281, 282, 324, 325, 337,
+	356, 370, 371, 372, 274, 267, 351, 268, 291, 269,
+	247, 358, 270, 249, 338, 375, 0, 287, 347, 311,
+	250, 310, 339, 374, 373, 258, 399, 405, 406, 411,
+	0, 412, 0, 0, 0, 420, 425, 426, 427, 429,
+	442, 443, 430, 431, 432, 433, 434, 435, 436, 437,
+	438, 452, 453, 439, 440, 0, 441, 454, 444, 0,
+	0, 0, 0, 414, 0, 0, 0, 0, 0, 0,
+	404, 285, 243, 244, 451, 0, 330, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 326, 403, 0, 0,
+	0, 0, 450, 0, 0, 0, 0, 0, 449, 336,
+	0, 355, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 362, 385, 397, 415, 418, 0,
+	0, 0, 248, 417, 0, 0, 0, 0, 0, 0,
+	493, 388, 0, 0, 0, 396, 0, 0, 0, 0,
+	0, 413, 320, 321, 322, 323, 288, 0, 265, 416,
+	345, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 409, 410, 284,
+	290, 428, 292, 264, 335, 286, 394, 298, 0, 421,
+	0, 422, 0, 0, 0, 0, 327, 295, 359, 299,
+	305, 348, 393, 333, 353, 262, 384, 360, 309, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 238, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 245,
+	0, 303, 0, 344, 283, 202, 203, 204, 205, 206,
+	207, 208, 209, 210, 211, 212, 213, 214, 215, 216,
+	217, 218, 219, 220, 221, 222, 223, 0, 224, 225,
+	226, 227, 228, 229, 230, 231, 232, 233, 234, 235,
+	236, 237, 0, 239, 240, 241, 242, 369, 0, 0,
+	400, 401, 402, 424, 386, 0, 448, 0, 332, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 278, 0, 0, 302, 0,
+	0, 0, 0, 0, 0, 361, 316, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 192, 0, 0,
+	0, 0, 0, 0, 260, 193, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 263, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 251, 366,
+	382, 261, 357, 395, 266, 364, 256, 331, 354, 0,
+	0, 253, 380, 363, 313, 296, 297, 252, 0, 349,
+	276, 289, 273, 329, 0, 379, 407, 272, 398, 0,
+	390, 255, 0, 389, 328, 376, 381, 314, 308, 254,
+	378, 312, 307, 300, 280, 423, 293, 340, 306, 341,
+	294, 318, 317, 319, 0, 0, 0, 0, 0, 419,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 469, 0, 0, 392, 0, 0,
+	0, 0, 0, 0, 365, 0, 0, 301, 0, 0,
+	0, 408, 0, 352, 334, 0, 0, 0, 350, 304,
+	377, 342, 383, 367, 391, 346, 343, 246, 368, 275,
+	315, 257, 259, 271, 277, 279, 281, 282, 324, 325,
+	337, 356, 370, 371, 372, 274, 267, 351, 268, 291,
+	269, 247, 358, 270, 249, 338, 375, 0, 287, 347,
+	311, 250, 310, 339, 374, 373, 258, 399, 405, 406,
+	411, 0, 412, 0, 0, 0, 420, 425, 426, 427,
+	429, 442, 443, 430, 431, 432, 433, 434, 435, 436,
+	437, 438, 452, 453, 439, 440, 0, 441, 454, 444,
+	0, 0, 0, 0, 414, 0, 0, 0, 0, 0,
+	0, 404, 285, 243, 244, 451, 0, 330, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 326, 403, 0,
+	0, 0, 0, 450, 0, 0, 0, 0, 0, 449,
+	336, 0, 355, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 362, 385, 397, 415, 418,
+	0, 0, 0, 248, 417, 0, 0, 0, 0, 0,
+	0, 0, 388, 0, 0, 0, 396, 0, 0, 0,
+	0, 0, 413, 320, 321, 322, 323, 288, 0, 265,
+	416, 345, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 409, 410,
+	284, 290, 428, 292, 264, 335, 286, 394, 298, 0,
+	421, 0, 422, 0, 0, 0, 0, 327, 295, 359,
+	299, 305, 348, 393, 333, 353, 262, 384, 360, 309,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 238, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	245, 0, 303, 0, 344, 283, 202, 203, 204, 205,
+	206, 207, 208, 209, 210, 211, 212, 213, 214, 215,
+	216, 217, 218, 219, 220, 221, 222, 223, 0, 224,
+	225, 226, 227, 228, 229, 230, 231, 232, 233, 234,
+	235, 236, 237, 0, 239, 240, 241, 242, 369, 0,
+	0, 400, 401, 402, 424, 386, 0, 448, 0, 332,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 459, 278, 0, 0, 302,
+	0, 0, 0, 0, 0, 0, 361, 316, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 192, 0,
+	0, 0, 0, 0, 0, 260, 193, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 263, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 251,
+	366, 382, 261, 357, 395, 266, 364, 256, 331, 354,
+	0, 0, 253, 380, 363, 313, 296, 297, 252, 0,
+	349, 276, 289, 273, 329, 0, 379, 407, 272, 398,
+	0, 390, 255, 0, 389, 328, 376, 381, 314, 308,
+	254, 378, 312, 307, 300, 280, 423, 293, 340, 306,
+	341, 294, 318, 317, 319, 0, 0, 0, 0, 0,
+	419, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 392, 0,
+	0, 0, 0, 0, 0, 365, 0, 0, 301, 0,
+	0, 0, 408, 0, 352, 334, 0, 0, 0, 350,
+	304, 377, 342, 383, 367, 391, 346, 343, 246, 368,
+	275, 315, 257, 259, 271, 277, 279, 281, 282, 324,
+	325, 337, 356, 370, 371, 372, 274, 267, 351, 268,
+	291, 269, 247, 358, 270, 249, 338, 375, 0, 287,
+	347, 311, 250, 310, 339, 374, 373, 258, 399, 405,
+	406, 411, 0, 412, 0, 0, 0, 420, 425, 426,
+	427, 429, 442, 443, 430, 431, 432, 433, 434, 435,
+	436, 437, 438, 452, 453, 439, 440, 0, 441, 454,
+	444, 0, 0, 0, 0, 414, 0, 0, 0, 0,
+	0, 0, 404, 285, 243, 244, 451, 0, 330, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 326, 403,
+	0, 0, 0, 0, 450, 0, 0, 0, 0, 0,
+	449, 336, 0, 355, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 362, 385, 397, 415,
+	418, 0, 0, 0, 248, 417, 0, 0, 0, 0,
+	0, 0, 0, 388, 0, 0, 0, 396, 0, 0,
+	0, 0, 0, 413, 320, 321, 322, 323, 288, 0,
+	265, 416, 345, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 409,
+	410, 284, 290, 428, 292, 264, 335, 286, 394, 298,
+	0, 421, 0, 422, 0, 0, 0, 0, 327, 295,
+	359, 299, 305, 348, 393, 333, 353, 262, 384, 360,
+	309, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 238, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 245, 0, 303, 0, 344, 283, 202, 203, 204,
+	205, 206, 207, 208, 209, 210, 211, 212, 213, 214,
+	215, 216, 217, 218, 219, 220, 221, 222, 223, 0,
+	224, 225, 226, 227, 228, 229, 230, 231, 232, 233,
+	234, 235, 236, 237, 0, 239, 240, 241, 242, 369,
+	0, 0, 400, 401, 402, 424, 386, 0, 448, 0,
+	332, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 278, 0, 0,
+	302, 0, 0, 0, 0, 0, 0, 361, 316, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 192,
+	0, 0, 0, 0, 0, 0, 260, 193, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	251, 366, 382, 261, 357, 395, 266, 364, 256, 331,
+	354, 0, 0, 253, 380, 363, 313, 296, 297, 252,
+	0, 349, 276, 289, 273, 329, 0, 379, 407, 272,
+	398, 0, 390, 255, 0, 389, 328, 376, 381, 314,
+	308, 254, 378, 312, 307, 300, 280, 423, 293, 340,
+	306, 341, 294, 318, 317, 319, 0, 0, 0, 0,
+	0, 419, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 392,
+	0, 0, 0, 0, 0, 0, 365, 0, 0, 301,
+	0, 0, 0, 408, 0, 352, 334, 0, 0, 0,
+	350, 304, 377, 342, 383, 367, 391, 346, 343, 246,
+	368, 275, 315, 257, 259, 271, 277, 279, 281, 282,
+	324, 325, 337, 356, 370, 371, 372, 274, 267, 351,
+	268, 291, 269, 247, 358, 270, 249, 338, 375, 0,
+	287, 347, 311, 250, 310, 339, 374, 373, 258, 399,
+	405, 406, 411, 0, 412, 0, 0, 0, 420, 425,
+	426, 427, 429, 442, 443, 430, 431, 432, 433, 434,
+	435, 436, 437, 438, 452, 453, 439, 440, 0, 441,
+	454, 444, 0, 0, 0, 0, 414, 0, 0, 0,
+	0, 0, 0, 404, 285, 243, 244, 451, 0, 330,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 326,
+	403, 0, 0, 0, 0, 450, 0, 0, 0, 0,
+	0, 449, 336, 0, 355, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 362, 385, 397,
+	415, 418, 0, 0, 0, 248, 417, 0, 0, 0,
+	0, 0, 0, 0, 388, 0, 0, 0, 396, 0,
+	0, 0, 0, 0, 413, 320, 321, 322, 323, 288,
+	0, 265, 416, 345, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	409, 410, 284, 290, 428, 292, 264, 335, 286, 394,
+	298, 0, 421, 0, 422, 0, 0, 0, 0, 327,
+	295, 359, 299, 305, 348, 393, 333, 353, 262, 384,
+	360, 309, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 238, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 245, 0, 303, 0, 344, 283, 202, 203,
+	204, 205, 206, 207, 208, 209, 210, 211, 212, 213,
+	214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
+	0, 224, 225, 226, 227, 228, 229, 230, 231, 232,
+	233, 234, 235, 236, 237, 0, 239, 240, 241, 242,
+	369, 0, 0, 400, 401, 402, 424, 386, 0, 448,
+	0, 332, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 278, 0,
+	0, 302, 0, 0, 0, 0, 0, 0, 361, 316,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	192, 0, 0, 0, 0, 0, 0, 260, 193, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 263,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 251, 366, 382, 261, 357, 395, 266, 364, 256,
+	331, 354, 0, 0, 253, 380, 363, 313, 296, 297,
+	252, 0, 349, 276, 289, 273, 329, 0, 379, 407,
+	272, 398, 0, 390, 255, 0, 389, 328, 376, 381,
+	314, 308, 254, 378, 312, 307, 300, 280, 423, 293,
+	340, 306, 341, 294, 318, 317, 319, 0, 0, 0,
+	0, 0, 419, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	392, 0, 0, 0, 0, 0, 0, 365, 0, 0,
+	301, 0, 0, 0, 408, 0, 352, 334, 0, 0,
+	0, 350, 304, 377, 342, 383, 367, 391, 346, 343,
+	246, 368, 275, 315, 257, 259, 533, 277, 279, 281,
+	282, 324, 325, 337, 356, 370, 371, 372, 274, 267,
+	351, 268, 291, 269, 247, 358, 270, 249, 338, 375,
+	0, 287, 347, 311, 250, 310, 339, 374, 373, 258,
+	399, 405, 406, 411, 0, 412, 0, 0, 0, 420,
+	425, 426, 427, 429, 442, 443, 430, 431, 432, 433,
+	434, 435, 436, 437, 438, 452, 453, 439, 440, 0,
+	441, 454, 444, 0, 0, 0, 0, 414, 0, 0,
+	0, 0, 0, 0, 404, 285, 243, 244, 451, 0,
+	330, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	326, 403, 0, 0, 0, 0, 450, 0, 0, 0,
+	0, 0, 449, 336, 0, 355, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 362, 385,
+	397, 415, 418, 0, 0, 0, 248, 417, 0, 0,
+	0, 0, 0, 0, 0, 388, 0, 0, 0, 396,
+	0, 0, 0, 0, 0, 413, 320, 321, 322, 323,
+	288, 0, 265, 416, 345, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 409, 410, 284, 290, 428, 292, 264, 335, 286,
+	394, 298, 0, 421, 0, 422, 0, 0, 0, 0,
+	327, 295, 359, 299, 305, 348, 393, 333, 353, 262,
+	384, 360, 309, 512, 0, 511, 518, 508, 0, 0,
+	0, 0, 0, 0, 0, 0, 238, 515, 516, 0,
+	517, 521, 0, 0, 503, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 526, 0, 0, 0, 0, 0,
+	0, 0, 0, 245, 0, 303, 0, 344, 283, 202,
+	203, 204, 205, 206, 207, 208, 209, 210, 211, 212,
+	213, 214, 215, 216, 217, 218, 219, 220, 221, 222,
+	223, 0, 224, 225, 226, 227, 228, 229, 230, 231,
+	232, 233, 234, 235, 236, 237, 0, 239, 240, 241,
+	242, 0, 0, 0, 400, 401, 402, 424, 386, 0,
+	448, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	233, 348, 364, 243, 339, 377, 248, 346, 238, 313,
-	336, 0, 0, 235, 362, 345, 295, 278, 279, 234,
-	0, 331, 258, 271, 255, 311, 0, 361, 389, 254,
-	380, 0, 372, 237, 0, 371, 310, 358, 363, 296,
-	290, 236, 360, 294, 289, 282, 262, 405, 275, 322,
-	288, 323, 276, 300, 299, 301, 0, 0, 0, 0,
-	0, 401, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 374,
-	0, 0, 0, 0, 0, 0, 347, 0, 0, 283,
-	0, 0, 0, 390, 0, 334, 316, 0, 0, 0,
-	332, 286, 359, 324, 365, 349, 373, 328, 325, 228,
-	350, 257, 297, 239, 241, 253, 259, 261, 263, 264,
-	306, 307, 319, 338, 352, 353, 354, 256, 249, 333,
-	250, 273, 251, 229, 340, 252, 231, 320, 357, 0,
-	269, 329, 293, 232, 292, 321, 356, 355, 240, 381,
-	387, 388, 393, 0, 394, 0, 0, 0, 402, 407,
-	408, 409, 411, 412, 413, 414, 0, 0, 0, 0,
-	396, 0, 0, 0, 0, 0, 0, 386, 267, 225,
-	226, 421, 0, 312, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 308, 385, 0, 0, 0, 0, 420,
-	0, 0, 0, 0, 0, 419, 318, 0, 337, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 344, 367, 379, 397, 400, 0, 0, 0, 230,
-	399, 0, 0, 0, 0, 0, 0, 0, 370, 0,
-	0, 0, 378, 0, 0, 0, 0, 0, 395, 302,
-	303, 304, 305, 270, 0, 247, 398, 327, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 391, 392, 266, 272, 410, 274,
-	246, 317, 268, 376, 280, 0, 403, 0, 404, 0,
-	0, 0, 0, 309, 277, 341, 281, 287, 330, 375,
-	315, 335, 244, 366, 342, 291, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 220,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 227, 0, 285, 0,
-	326, 265, 184, 185, 186, 187, 188, 189, 190, 191,
-	192, 193, 194, 195, 196, 197, 198, 199, 200, 201,
-	202, 203, 204, 205, 0, 206, 207, 208, 209, 210,
-	211, 212, 213, 214, 215, 216, 217, 218, 219, 0,
-	221, 222, 223, 224, 351, 0, 0, 382, 383, 384,
-	406, 368, 0, 418, 0, 314, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 260, 0, 0, 284, 0, 0, 0, 0,
-	0, 0, 343, 298, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	2832, 0, 0, 177, 0, 0, 0, 0, 0, 0,
-	242, 178, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 245, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 233, 348, 364, 243, 339, 377, 248,
-	346, 238, 313, 336, 0, 0, 235, 362, 345, 295,
-	278, 279, 234, 0, 331, 258, 271, 255, 311, 0,
-	361, 389, 254, 380, 0, 372, 237, 0, 371, 310,
-	358, 363, 296, 290, 236, 360, 294, 289, 282, 262,
-	405, 275, 322, 288, 323, 276, 300, 299, 301, 0,
-	0, 0, 0, 0, 401, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 374, 0, 0, 0, 0, 0, 0, 347,
-	0, 0, 283, 0, 0, 0, 390, 0, 334, 316,
-	0, 0, 0, 332, 286, 359, 324, 365, 349, 373,
-	328, 325, 228, 350, 257, 297, 239, 241, 253, 259,
-	261, 263, 264, 306, 307, 319, 338, 352, 353, 354,
-	256, 249, 333, 250, 273, 251, 229, 340, 252, 231,
-	320, 357, 0, 269, 329, 293, 232, 292, 321, 356,
-	355, 240, 381, 387, 388, 393, 0, 394, 0, 0,
-	0, 402, 407, 408, 409, 411, 412, 413, 414, 0,
-	0, 0, 0, 396, 0, 0, 0, 0, 0, 0,
-	386, 267, 225, 226, 421, 0, 312, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 308, 385, 0, 0,
-	0, 0, 420, 0, 0, 0, 0, 0, 419, 318,
-	0, 337, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 344, 367, 379, 397, 400, 0,
-	0, 0, 230, 399, 0, 0, 0, 0, 0, 0,
-	0, 370, 0, 0, 0, 378, 0, 0, 0, 0,
-	0, 395, 302, 303, 304, 305, 270, 0, 247, 398,
-	327, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 391, 392, 266,
-	272, 410, 274, 246, 317, 268, 376, 280, 0, 403,
-	0, 404, 0, 0, 0, 0, 309, 277, 341, 281,
-	287, 330, 375, 315, 335, 244, 366, 342, 291, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 220, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 227,
-	0, 285, 0, 326, 265, 184, 185, 186, 187, 188,
-	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
-	199, 200, 201, 202, 203, 204, 205, 0, 206, 207,
-	208, 209, 210, 211, 212, 213, 214, 215, 216, 217,
-	218, 219, 0, 221, 222, 223, 224, 351, 0, 0,
-	382, 383, 384, 406, 368, 0, 418, 0, 314, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 260, 0, 0, 284, 0,
-	0, 0, 0, 0, 0, 343, 298, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 177, 0, 0, 0,
-	0, 0, 0, 242, 178, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 245, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 233, 348, 364, 243,
-	339, 377, 248, 346, 238, 313, 336, 0, 0, 235,
-	362, 345, 295, 278, 279, 234, 0, 331, 258, 271,
-	255, 311, 0, 361, 389, 254, 380, 0, 372, 237,
-	0, 371, 310, 358, 363, 296, 290, 236, 360, 294,
-	289, 282, 262, 405, 275, 322, 288, 323, 276, 300,
-	299, 301, 0, 0, 0, 0, 0, 401, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 374, 0, 0, 0, 2773,
-	0, 0, 347, 0, 0, 283, 0, 0, 0, 390,
-	0, 334, 316, 0, 0, 0, 332, 286, 359, 324,
-	365, 349, 373, 328, 325, 228, 350, 257, 297, 239,
-	241, 253, 259, 261, 263, 264, 306, 307, 319, 338,
-	352, 353, 354, 256, 249, 333, 250, 273, 251, 229,
-	340, 252, 231, 320, 357, 0, 269, 329, 293, 232,
-	292, 321, 356, 355, 240, 381, 387, 388, 393, 0,
-	394, 0, 0, 0, 402, 407, 408, 409, 411, 412,
-	413, 414, 0, 0, 0, 0, 396, 0, 0, 0,
-	0, 0, 0, 386, 267, 225, 226, 421, 0, 312,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 308,
-	385, 0, 0, 0, 0, 420, 0, 0, 0, 0,
-	0, 419, 318, 0, 337, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 344, 367, 379,
-	397, 400, 0, 0, 0, 230, 399, 0, 0, 0,
-	0, 0, 0, 0, 370, 0, 0, 0, 378, 0,
-	0, 0, 0, 0, 395, 302, 303, 304, 305, 270,
-	0, 247, 398, 327, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	391, 392, 266, 272, 410, 274, 246, 317, 268, 376,
-	280, 0, 403, 0, 404, 0, 0, 0, 0, 309,
-	277, 341, 281, 287, 330, 375, 315, 335, 244, 366,
-	342, 291, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 220, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 227, 0, 285, 0, 326, 265, 184, 185,
-	186, 187, 188, 189, 190, 191, 192, 193, 194, 195,
-	196, 197, 198, 199, 200, 201, 202, 203, 204, 205,
-	0, 206, 207, 208, 209, 210, 211, 212, 213, 214,
-	215, 216, 217, 218, 219, 0, 221, 222, 223, 224,
-	351, 0, 0, 382, 383, 384, 406, 368, 0, 418,
-	0, 314, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 260, 0,
-	0, 284, 0, 0, 0, 0, 0, 0, 343, 298,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 2612, 0, 0, 177,
-	0, 0, 0, 0, 0, 0, 242, 178, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 245, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 233,
-	348, 364, 243, 339, 377, 248, 346, 238, 313, 336,
-	0, 0, 235, 362, 345, 295, 278, 279, 234, 0,
-	331, 258, 271, 255, 311, 0, 361, 389, 254, 380,
-	0, 372, 237, 0, 371, 310, 358, 363, 296, 290,
-	236, 360, 294, 289, 282, 262, 405, 275, 322, 288,
-	323, 276, 300, 299, 301, 0, 0, 0, 0, 0,
-	401, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 374, 0,
-	0, 0, 0, 0, 0, 347, 0, 0, 283, 0,
-	0, 0, 390, 0, 334, 316, 0, 0, 0, 332,
-	286, 359, 324, 365, 349, 373, 328, 325, 228, 350,
-	257, 297, 239, 241, 253, 259, 261, 263, 264, 306,
-	307, 319, 338, 352, 353, 354, 256, 249, 333, 250,
-	273, 251, 229, 340, 252, 231, 320, 357, 0, 269,
-	329, 293, 232, 292, 321, 356, 355, 240, 381, 387,
-	388, 393, 0, 394, 0, 0, 0, 402, 407, 408,
-	409, 411, 412, 413, 414, 0, 0, 0, 0, 396,
-	0, 0, 0, 0, 0, 0, 386, 267, 225, 226,
-	421, 0, 312, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 308, 385, 0, 0, 0, 0, 420, 0,
-	0, 0, 0, 0, 419, 318, 0, 337, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	344, 367, 379, 397, 400, 0, 0, 0, 230, 399,
-	0, 0, 0, 0, 0, 0, 0, 370, 0, 0,
-	0, 378, 0, 0, 0, 0, 0, 395, 302, 303,
-	304, 305, 270, 0, 247, 398, 327, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 391, 392, 266, 272, 410, 274, 246,
-	317, 268, 376, 280, 0, 403, 0, 404, 0, 0,
-	0, 0, 309, 277, 341, 281, 287, 330, 375, 315,
-	335, 244, 366, 342, 291, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 220, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 227, 0, 285, 0, 326,
-	265, 184, 185, 186, 187, 188, 189, 190, 191, 192,
-	193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
-	203, 204, 205, 0, 206, 207, 208, 209, 210, 211,
-	212, 213, 214, 215, 216, 217, 218, 219, 0, 221,
-	222, 223, 224, 351, 0, 0, 382, 383, 384, 406,
-	368, 0, 418, 0, 314, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 260, 0, 0, 284, 0, 0, 0, 0, 0,
-	0, 343, 298, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 177, 0, 0, 0, 0, 0, 0, 242,
-	178, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	245, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 233, 348, 364, 243, 339, 377, 248, 346,
-	238, 313, 336, 0, 0, 235, 362, 345, 295, 278,
-	279, 234, 0, 331, 258, 271, 255, 311, 0, 361,
-	389, 254, 380, 0, 372, 237, 0, 371, 310, 358,
-	363, 296, 290, 236, 360, 294, 289, 282, 262, 405,
-	275, 322, 288, 323, 276, 300, 299, 301, 0, 0,
-	0, 0, 0, 401, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 374, 0, 0, 0, 2656, 0, 0, 347, 0,
-	0, 283, 0, 0, 0, 390, 0, 334, 316, 0,
-	0, 0, 332, 286, 359, 324, 365, 349, 373, 328,
-	325, 228, 350, 257, 297, 239, 241, 253, 259, 261,
-	263, 264, 306, 307, 319, 338, 352, 353, 354, 256,
-	249, 333, 250, 273, 251, 229, 340, 252, 231, 320,
-	357, 0, 269, 329, 293, 232, 292, 321, 356, 355,
-	240, 381, 387, 388, 393, 0, 394, 0, 0, 0,
-	402, 407, 408, 409, 411, 412, 413, 414, 0, 0,
-	0, 0, 396, 0, 0, 0, 0, 0, 0, 386,
-	267, 225, 226, 421, 0, 312, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 308, 385, 0, 0, 0,
-	0, 420, 0, 0, 0, 0, 0, 419, 318, 0,
-	337, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 344, 367, 379, 397, 400, 0, 0,
-	0, 230, 399, 0, 0, 0, 0, 0, 0, 0,
-	370, 0, 0, 0, 378, 0, 0, 0, 0, 0,
-	395, 302, 303, 304, 305, 270, 0, 247, 398, 327,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 391, 392, 266, 272,
-	410, 274, 246, 317, 268, 376, 280, 0, 403, 0,
-	404, 0, 0, 0, 0, 309, 277, 341, 281, 287,
-	330, 375, 315, 335, 244, 366, 342, 291, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 220, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 227, 0,
-	285, 0, 326, 265, 184, 185, 186, 187, 188, 189,
-	190, 191, 192, 193, 194, 195, 196, 197, 198, 199,
-	200, 201, 202, 203, 204, 205, 0, 206, 207, 208,
-	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
-	219, 0, 221, 222, 223, 224, 351, 0, 0, 382,
-	383, 384, 406, 368, 0, 418, 0, 314, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 260, 0, 0, 284, 0, 0,
-	0, 0, 0, 0, 343, 298, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 177, 0, 0, 0, 0,
-	0, 0, 242, 178, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 245, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 2364,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 233, 348, 364, 243, 339,
-	377, 248, 346, 238, 313, 336, 0, 0, 235, 362,
-	345, 295, 278, 279, 234, 0, 331, 258, 271, 255,
-	311, 0, 361, 389, 254, 380, 0, 372, 237, 0,
-	371, 310, 358, 363, 296, 290, 236, 360, 294, 289,
-	282, 262, 405, 275, 322, 288, 323, 276, 300, 299,
-	301, 0, 0, 0, 0, 0, 401, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 374, 0, 0, 0, 0, 0,
-	0, 347, 0, 0, 283, 0, 0, 0, 390, 0,
-	334, 316, 0, 0, 0, 332, 286, 359, 324, 365,
-	349, 373, 328, 325, 228, 350, 257, 297, 239, 241,
-	253, 259, 261, 263, 264, 306, 307, 319, 338, 352,
-	353, 354, 256, 249, 333, 250, 273, 251, 229, 340,
-	252, 231, 320, 357, 0, 269, 329, 293, 232, 292,
-	321, 356, 355, 240, 381, 387, 388, 393, 0, 394,
-	0, 0, 0, 402, 407, 408, 409, 411, 412, 413,
-	414, 0, 0, 0, 0, 396, 0, 0, 0, 0,
-	0, 0, 386, 267, 225, 226, 421, 0, 312, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 308, 385,
-	0, 0, 0, 0, 420, 0, 0, 0, 0, 0,
-	419, 318, 0, 337, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 344, 367, 379, 397,
-	400, 0, 0, 0, 230, 399, 0, 0, 0, 0,
-	0, 0, 0, 370, 0, 0, 0, 378, 0, 0,
-	0, 0, 0, 395, 302, 303, 304, 305, 270, 0,
-	247, 398, 327, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 391,
-	392, 266, 272, 410, 274, 246, 317, 268, 376, 280,
-	0, 403, 0, 404, 0, 0, 0, 0, 309, 277,
-	341, 281, 287, 330, 375, 315, 335, 244, 366, 342,
-	291, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 220, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 227, 0, 285, 0, 326, 265, 184, 185, 186,
-	187, 188, 189, 190, 191, 192, 193, 194, 195, 196,
-	197, 198, 199, 200, 201, 202, 203, 204, 205, 0,
-	206, 207, 208, 209, 210, 211, 212, 213, 214, 215,
-	216, 217, 218, 219, 0, 221, 222, 223, 224, 351,
-	0, 0, 382, 383, 384, 406, 368, 0, 418, 0,
-	314, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 260, 0, 0,
-	284, 0, 0, 0, 0, 0, 0, 343, 298, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1531, 0, 0, 177, 0,
-	0, 0, 0, 0, 0, 242, 178, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 245, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 233, 348,
-	364, 243, 339, 377, 248, 346, 238, 313, 336, 0,
-	0, 235, 362, 345, 295, 278, 279, 234, 0, 331,
-	258, 271, 255, 311, 0, 361, 389, 254, 380, 0,
-	372, 237, 0, 371, 310, 358, 363, 296, 290, 236,
-	360, 294, 289, 282, 262, 405, 275, 322, 288, 323,
-	276, 300, 299, 301, 0, 0, 0, 0, 0, 401,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 374, 0, 0,
-	0, 0, 0, 0, 347, 0, 0, 283, 0, 0,
-	0, 390, 0, 334, 316, 0, 0, 0, 332, 286,
-	359, 324, 365, 349, 373, 328, 325, 228, 350, 257,
-	297, 239, 241, 253, 259, 261, 263, 264, 306, 307,
-	319, 338, 352, 353, 354, 256, 249, 333, 250, 273,
-	251, 229, 340, 252, 231, 320, 357, 0, 269, 329,
-	293, 232, 292, 321, 356, 355, 240, 381, 387, 388,
-	393, 0, 394, 0, 0, 0, 402, 407, 408, 409,
-	411, 412, 413, 414, 0, 0, 0, 0, 396, 0,
-	0, 0, 0, 0, 0, 386, 267, 225, 226, 421,
-	0, 312, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 308, 385, 0, 0, 0, 0, 420, 0, 0,
-	0, 0, 0, 419, 318, 0, 337, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 344,
-	367, 379, 397, 400, 0, 0, 0, 230, 399, 0,
-	0, 0, 0, 0, 0, 0, 370, 0, 0, 0,
-	378, 0, 0, 0, 0, 0, 395, 302, 303, 304,
-	305, 270, 0, 247, 398, 327, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 391, 392, 266, 272, 410, 274, 246, 317,
-	268, 376, 280, 0, 403, 0, 404, 0, 0, 0,
-	0, 309, 277, 341, 281, 287, 330, 375, 315, 335,
-	244, 366, 342, 291, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 220, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 227, 0, 285, 0, 326, 265,
-	184, 185, 186, 187, 188, 189, 190, 191, 192, 193,
-	194, 195, 196, 197, 198, 199, 200, 201, 202, 203,
-	204, 205, 0, 206, 207, 208, 209, 210, 211, 212,
-	213, 214, 215, 216, 217, 218, 219, 0, 221, 222,
-	223, 224, 351, 0, 0, 382, 383, 384, 406, 368,
-	0, 418, 0, 314, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	260, 0, 0, 284, 0, 0, 0, 0, 0, 0,
-	343, 298, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 177, 0, 0, 0, 0, 0, 0, 242, 178,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 245,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 2450, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 233, 348, 364, 243, 339, 377, 248, 346, 238,
-	313, 336, 0, 0, 235, 362, 345, 295, 278, 279,
-	234, 0, 331, 258, 271, 255, 311, 0, 361, 389,
-	254, 380, 0, 372, 237, 0, 371, 310, 358, 363,
-	296, 290, 236, 360, 294, 289, 282, 262, 405, 275,
-	322, 288, 323, 276, 300, 299, 301, 0, 0, 0,
-	0, 0, 401, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	374, 0, 0, 0, 0, 0, 0, 347, 0, 0,
-	283, 0, 0, 0, 390, 0, 334, 316, 0, 0,
-	0, 332, 286, 359, 324, 365, 349, 373, 328, 325,
-	228, 350, 257, 297, 239, 241, 253, 259, 261, 263,
-	264, 306, 307, 319, 338, 352, 353, 354, 256, 249,
-	333, 250, 273, 251, 229, 340, 252, 231, 320, 357,
-	0, 269, 329, 293, 232, 292, 321, 356, 355, 240,
-	381, 387, 388, 393, 0, 394, 0, 0, 0, 402,
-	407, 408, 409, 411, 412, 413, 414, 0, 0, 0,
-	0, 396, 0, 0, 0, 0, 0, 0, 386, 267,
-	225, 226, 421, 0, 312, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 308, 385, 0, 0, 0, 0,
-	420, 0, 0, 0, 0, 0, 419, 318, 0, 337,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 344, 367, 379, 397, 400, 0, 0, 0,
-	230, 399, 0, 0, 0, 0, 0, 0, 0, 370,
-	0, 0, 0, 378, 0, 0, 0, 0, 0, 395,
-	302, 303, 304, 305, 270, 0, 247, 398, 327, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 391, 392, 266, 272, 410,
-	274, 246, 317, 268, 376, 280, 0, 403, 0, 404,
-	0, 0, 0, 0, 309, 277, 341, 281, 287, 330,
-	375, 315, 335, 244, 366, 342, 291, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	220, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 227, 0, 285,
-	0, 326, 265, 184, 185, 186, 187, 188, 189, 190,
-	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
-	201, 202, 203, 204, 205, 0, 206, 207, 208, 209,
-	210, 211, 212, 213, 214, 215, 216, 217, 218, 219,
-	0, 221, 222, 223, 224, 351, 0, 0, 382, 383,
-	384, 406, 368, 0, 418, 0, 314, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 260, 0, 0, 284, 0, 0, 0,
-	0, 0, 0, 343, 298, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 177, 0, 0, 2323, 0, 0,
-	0, 242, 178, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 245, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 233, 348, 364, 243, 339, 377,
-	248, 346, 238, 313, 336, 0, 0, 235, 362, 345,
-	295, 278, 279, 234, 0, 331, 258, 271, 255, 311,
-	0, 361, 389, 254, 380, 0, 372, 237, 0, 371,
-	310, 358, 363, 296, 290, 236, 360, 294, 289, 282,
-	262, 405, 275, 322, 288, 323, 276, 300, 299, 301,
-	0, 0, 0, 0, 0, 401, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 374, 0, 0, 0, 0, 0, 0,
-	347, 0, 0, 283, 0, 0, 0, 390, 0, 334,
-	316, 0, 0, 0, 332, 286, 359, 324, 365, 349,
-	373, 328, 325, 228, 350, 257, 297, 239, 241, 253,
-	259, 261, 263, 264, 306, 307, 319, 338, 352, 353,
-	354, 256, 249, 333, 250, 273, 251, 229, 340, 252,
-	231, 320, 357, 0, 269, 329, 293, 232, 292, 321,
-	356, 355, 240, 381, 387, 388, 393, 0, 394, 0,
-	0, 0, 402, 407, 408, 409, 411, 412, 413, 414,
-	0, 0, 0, 0, 396, 0, 0, 0, 0, 0,
-	0, 386, 267, 225, 226, 421, 0, 312, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 308, 385, 0,
-	0, 0, 0, 420, 0, 0, 0, 0, 0, 419,
-	318, 0, 337, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 344, 367, 379, 397, 400,
-	0, 0, 0, 230, 399, 0, 0, 0, 0, 0,
-	0, 0, 370, 0, 0, 0, 378, 0, 0, 0,
-	0, 0, 395, 302, 303, 304, 305, 270, 0, 247,
-	398, 327, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 391, 392,
-	266, 272, 410, 274, 246, 317, 268, 376, 280, 0,
-	403, 0, 404, 0, 0, 0, 0, 309, 277, 341,
-	281, 287, 330, 375, 315, 335, 244, 366, 342, 291,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 220, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	227, 0, 285, 0, 326, 265, 184, 185, 186, 187,
-	188, 189, 190, 191, 192, 193, 194, 195, 196, 197,
-	198, 199, 200, 201, 202, 203, 204, 205, 0, 206,
-	207, 208, 209, 210, 211, 212, 213, 214, 215, 216,
-	217, 218, 219, 0, 221, 222, 223, 224, 351, 0,
-	0, 382, 383, 384, 406, 368, 0, 418, 0, 314,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 260, 0, 0, 284,
-	0, 0, 0, 0, 0, 0, 343, 298, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 177, 0, 0,
-	0, 0, 0, 0, 242, 178, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 245, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 2254, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 233, 348, 364,
-	243, 339, 377, 248, 346, 238, 313, 336, 0, 0,
-	235, 362, 345, 295, 278, 279, 234, 0, 331, 258,
-	271, 255, 311, 0, 361, 389, 254, 380, 0, 372,
-	237, 0, 371, 310, 358, 363, 296, 290, 236, 360,
-	294, 289, 282, 262, 405, 275, 322, 288, 323, 276,
-	300, 299, 301, 0, 0, 0, 0, 0, 401, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 374, 0, 0, 0,
-	0, 0, 0, 347, 0, 0, 283, 0, 0, 0,
-	390, 0, 334, 316, 0, 0, 0, 332, 286, 359,
-	324, 365, 349, 373, 328, 325, 228, 350, 257, 297,
-	239, 241, 253, 259, 261, 263, 264, 306, 307, 319,
-	338, 352, 353, 354, 256, 249, 333, 250, 273, 251,
-	229, 340, 252, 231, 320, 357, 0, 269, 329, 293,
-	232, 292, 321, 356, 355, 240, 381, 387, 388, 393,
-	0, 394, 0, 0, 0, 402, 407, 408, 409, 411,
-	412, 413, 414, 0, 0, 0, 0, 396, 0, 0,
-	0, 0, 0, 0, 386, 267, 225, 226, 421, 0,
-	312, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	308, 385, 0, 0, 0, 0, 420, 0, 0, 0,
-	0, 0, 419, 318, 0, 337, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 344, 367,
-	379, 397, 400, 0, 0, 0, 230, 399, 0, 0,
-	0, 0, 0, 0, 0, 370, 0, 0, 0, 378,
-	0, 0, 0, 0, 0, 395, 302, 303, 304, 305,
-	270, 0, 247, 398, 327, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 391, 392, 266, 272, 410, 274, 246, 317, 268,
-	376, 280, 0, 403, 0, 404, 0, 0, 0, 0,
-	309, 277, 341, 281, 287, 330, 375, 315, 335, 244,
-	366, 342, 291, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 220, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 227, 0, 285, 0, 326, 265, 184,
-	185, 186, 187, 188, 189, 190, 191, 192, 193, 194,
-	195, 196, 197, 198, 199, 200, 201, 202, 203, 204,
-	205, 0, 206, 207, 208, 209, 210, 211, 212, 213,
-	214, 215, 216, 217, 218, 219, 0, 221, 222, 223,
-	224, 351, 0, 0, 382, 383, 384, 406, 368, 0,
-	418, 0, 314, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 260,
-	0, 0, 284, 0, 0, 0, 0, 0, 0, 343,
-	298, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	177, 0, 0, 1146, 0, 0, 0, 242, 178, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 245, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 504, 506, 505, 0, 0, 0, 0,
+	0, 0, 0, 510, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 514, 0, 0, 0, 0,
+	0, 0, 529, 0, 0, 0, 0, 0, 0, 507,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	233, 348, 364, 243, 339, 377, 248, 346, 238, 313,
-	336, 0, 0, 235, 362, 345, 295, 278, 279, 234,
-	0, 331, 258, 271, 255, 311, 0, 361, 389, 254,
-	380, 0, 372, 237, 0, 371, 310, 358, 363, 296,
-	290, 236, 360, 294, 289, 282, 262, 405, 275, 322,
-	288, 323, 276, 300, 299, 301, 0, 0, 0, 0,
-	0, 401, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 374,
-	0, 0, 0, 0, 0, 0, 347, 0, 0, 283,
-	0, 0, 0, 390, 0, 334, 316, 0, 0, 0,
-	332, 286, 359, 324, 365, 349, 373, 328, 325, 228,
-	350, 257, 297, 239, 241, 253, 259, 261, 263, 264,
-	306, 307, 319, 338, 352, 353, 354, 256, 249, 333,
-	250, 273, 251, 229, 340, 252, 231, 320, 357, 0,
-	269, 329, 293, 232, 292, 321, 356, 355, 240, 381,
-	387, 388, 393, 0, 394, 0, 0, 0, 402, 407,
-	408, 409, 411, 412, 413, 414, 0, 0, 0, 0,
-	396, 0, 0, 0, 0, 0, 0, 386, 267, 225,
-	226, 421, 0, 312, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 308, 385, 0, 0, 0, 0, 420,
-	0, 0, 0, 0, 0, 419, 318, 0, 337, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 344, 367, 379, 397, 400, 0, 0, 0, 230,
-	399, 0, 0, 0, 0, 0, 0, 0, 370, 0,
-	0, 0, 378, 0, 0, 0, 0, 0, 395, 302,
-	303, 304, 305, 270, 0, 247, 398, 327, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 391, 392, 266, 272, 410, 274,
-	246, 317, 268, 376, 280, 0, 403, 0, 404, 0,
-	0, 0, 0, 309, 277, 341, 281, 287, 330, 375,
-	315, 335, 244, 366, 342, 291, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 220,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 227, 0, 285, 0,
-	326, 265, 184, 185, 186, 187, 188, 189, 190, 191,
-	192, 193, 194, 195, 196, 197, 198, 199, 200, 201,
-	202, 203, 204, 205, 0, 206, 207, 208, 209, 210,
-	211, 212, 213, 214, 215, 216, 217, 218, 219, 0,
-	221, 222, 223, 224, 351, 0, 0, 382, 383, 384,
-	406, 368, 0, 418, 0, 314, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 260, 0, 0, 284, 0, 0, 0, 0,
-	0, 0, 343, 298, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 177, 0, 0, 1903, 0, 0, 0,
-	242, 178, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 245, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 233, 348, 364, 243, 339, 377, 248,
-	346, 238, 313, 336, 0, 0, 235, 362, 345, 295,
-	278, 279, 234, 0, 331, 258, 271, 255, 311, 0,
-	361, 389, 254, 380, 0, 372, 237, 0, 371, 310,
-	358, 363, 296, 290, 236, 360, 294, 289, 282, 262,
-	405, 275, 322, 288, 323, 276, 300, 299, 301, 0,
-	0, 0, 0, 0, 401, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 374, 0, 0, 0, 0, 0, 0, 347,
-	0, 0, 283, 0, 0, 0, 390, 0, 334, 316,
-	0, 0, 0, 332, 286, 359, 324, 365, 349, 373,
-	328, 325, 228, 350, 257, 297, 239, 241, 253, 259,
-	261, 263, 264, 306, 307, 319, 338, 352, 353, 354,
-	256, 249, 333, 250, 273, 251, 229, 340, 252, 231,
-	320, 357, 0, 269, 329, 293, 232, 292, 321, 356,
-	355, 240, 381, 387, 388, 393, 0, 394, 0, 0,
-	0, 402, 407, 408, 409, 411, 412, 413, 414, 0,
-	0, 0, 0, 396, 0, 0, 0, 0, 0, 0,
-	386, 267, 225, 226, 421, 0, 312, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 308, 385, 0, 0,
-	0, 0, 420, 0, 0, 0, 0, 0, 419, 318,
-	0, 337, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 344, 367, 379, 397, 400, 0,
-	0, 0, 230, 399, 0, 0, 0, 0, 0, 0,
-	0, 370, 0, 0, 0, 378, 0, 0, 0, 0,
-	0, 395, 302, 303, 304, 305, 270, 0, 247, 398,
-	327, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 391, 392, 266,
-	272, 410, 274, 246, 317, 268, 376, 280, 0, 403,
-	0, 404, 0, 0, 0, 0, 309, 277, 341, 281,
-	287, 330, 375, 315, 335, 244, 366, 342, 291, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 220, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 227,
-	0, 285, 0, 326, 265, 184, 185, 186, 187, 188,
-	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
-	199, 200, 201, 202, 203, 204, 205, 0, 206, 207,
-	208, 209, 210, 211, 212, 213, 214, 215, 216, 217,
-	218, 219, 0, 221, 222, 223, 224, 351, 0, 0,
-	382, 383, 384, 406, 368, 0, 418, 0, 314, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 260, 0, 0, 284, 0,
-	0, 0, 0, 0, 0, 343, 298, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 177, 0, 0, 0,
-	0, 0, 0, 242, 178, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 245, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1639, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 233, 348, 364, 243,
-	339, 377, 248, 346, 238, 313, 336, 0, 0, 235,
-	362, 345, 295, 278, 279, 234, 0, 331, 258, 271,
-	255, 311, 0, 361, 389, 254, 380, 0, 372, 237,
-	0, 371, 310, 358, 363, 296, 290, 236, 360, 294,
-	289, 282, 262, 405, 275, 322, 288, 323, 276, 300,
-	299, 301, 0, 0, 0, 0, 0, 401, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 374, 0, 0, 0, 0,
-	0, 0, 347, 0, 0, 283, 0, 0, 0, 390,
-	0, 334, 316, 0, 0, 0, 332, 286, 359, 324,
-	365, 349, 373, 328, 325, 228, 350, 257, 297, 239,
-	241, 253, 259, 261, 263, 264, 306, 307, 319, 338,
-	352, 353, 354, 256, 249, 333, 250, 273, 251, 229,
-	340, 252, 231, 320, 357, 0, 269, 329, 293, 232,
-	292, 321, 356, 355, 240, 381, 387, 388, 393, 0,
-	394, 0, 0, 0, 402, 407, 408, 409, 411, 412,
-	413, 414, 0, 0, 0, 0, 396, 0, 0, 0,
-	0, 0, 0, 386, 267, 225, 226, 421, 0, 312,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 308,
-	385, 0, 0, 0, 0, 420, 0, 0, 0, 0,
-	0, 419, 318, 0, 337, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 344, 367, 379,
-	397, 400, 0, 0, 0, 230, 399, 0, 0, 0,
-	0, 0, 0, 0, 370, 0, 0, 0, 378, 0,
-	0, 0, 0, 0, 395, 302, 303, 304, 305, 270,
-	0, 247, 398, 327, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	391, 392, 266, 272, 410, 274, 246, 317, 268, 376,
-	280, 0, 403, 0, 404, 0, 0, 0, 0, 309,
-	277, 341, 281, 287, 330, 375, 315, 335, 244, 366,
-	342, 291, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 220, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 227, 0, 285, 0, 326, 265, 184, 185,
-	186, 187, 188, 189, 190, 191, 192, 193, 194, 195,
-	196, 197, 198, 199, 200, 201, 202, 203, 204, 205,
-	0, 206, 207, 208, 209, 210, 211, 212, 213, 214,
-	215, 216, 217, 218, 219, 0, 221, 222, 223, 224,
-	351, 0, 0, 382, 383, 384, 406, 368, 0, 418,
-	0, 314, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 260, 0,
-	0, 284, 0, 0, 0, 0, 0, 0, 343, 298,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 177,
-	0, 0, 0, 0, 0, 0, 242, 178, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 245, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1931, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 233,
-	348, 364, 243, 339, 377, 248, 346, 238, 313, 336,
-	0, 0, 235, 362, 345, 295, 278, 279, 234, 0,
-	331, 258, 271, 255, 311, 0, 361, 389, 254, 380,
-	0, 372, 237, 0, 371, 310, 358, 363, 296, 290,
-	236, 360, 294, 289, 282, 262, 405, 275, 322, 288,
-	323, 276, 300, 299, 301, 0, 0, 0, 0, 0,
-	401, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 374, 0,
-	0, 0, 0, 0, 0, 347, 0, 0, 283, 0,
-	0, 0, 390, 0, 334, 316, 0, 0, 0, 332,
-	286, 359, 324, 365, 349, 373, 328, 325, 228, 350,
-	257, 297, 239, 241, 253, 259, 261, 263, 264, 306,
-	307, 319, 338, 352, 353, 354, 256, 249, 333, 250,
-	273, 251, 229, 340, 252, 231, 320, 357, 0, 269,
-	329, 293, 232, 292, 321, 356, 355, 240, 381, 387,
-	388, 393, 0, 394, 0, 0, 0, 402, 407, 408,
-	409, 411, 412, 413, 414, 0, 0, 0, 0, 396,
-	0, 0, 0, 0, 0, 0, 386, 267, 225, 226,
-	421, 0, 312, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 308, 385, 0, 0, 0, 0, 420, 0,
-	0, 0, 0, 0, 419, 318, 0, 337, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	344, 367, 379, 397, 400, 0, 0, 0, 230, 399,
-	0, 0, 0, 0, 0, 0, 0, 370, 0, 0,
-	0, 378, 0, 0, 0, 0, 0, 395, 302, 303,
-	304, 305, 270, 0, 247, 398, 327, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 391, 392, 266, 272, 410, 274, 246,
-	317, 268, 376, 280, 0, 403, 0, 404, 0, 0,
-	0, 0, 309, 277, 341, 281, 287, 330, 375, 315,
-	335, 244, 366, 342, 291, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 220, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 227, 0, 285, 0, 326,
-	265, 184, 185, 186, 187, 188, 189, 190, 191, 192,
-	193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
-	203, 204, 205, 0, 206, 207, 208, 209, 210, 211,
-	212, 213, 214, 215, 216, 217, 218, 219, 0, 221,
-	222, 223, 224, 351, 0, 0, 382, 383, 384, 406,
-	368, 0, 418, 0, 314, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 260, 0, 0, 284, 0, 0, 0, 0, 0,
-	0, 343, 298, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 177, 0, 0, 1929, 0, 0, 0, 242,
-	178, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	245, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 233, 348, 364, 243, 339, 377, 248, 346,
-	238, 313, 336, 0, 0, 235, 362, 345, 295, 278,
-	279, 234, 0, 331, 258, 271, 255, 311, 0, 361,
-	389, 254, 380, 0, 372, 237, 0, 371, 310, 358,
-	363, 296, 290, 236, 360, 294, 289, 282, 262, 405,
-	275, 322, 288, 323, 276, 300, 299, 301, 0, 0,
-	0, 0, 0, 401, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 374, 0, 0, 0, 0, 0, 0, 347, 0,
-	0, 283, 0, 0, 0, 390, 0, 334, 316, 0,
-	0, 0, 332, 286, 359, 324, 365, 349, 373, 328,
-	325, 228, 350, 257, 297, 239, 241, 253, 259, 261,
-	263, 264, 306, 307, 319, 338, 352, 353, 354, 256,
-	249, 333, 250, 273, 251, 229, 340, 252, 231, 320,
-	357, 0, 269, 329, 293, 232, 292, 321, 356, 355,
-	240, 381, 387, 388, 393, 0, 394, 0, 0, 0,
-	402, 407, 408, 409, 411, 412, 413, 414, 0, 0,
-	0, 0, 396, 0, 0, 0, 0, 0, 0, 386,
-	267, 225, 226, 421, 0, 312, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 308, 385, 0, 0, 0,
-	0, 420, 0, 0, 0, 0, 0, 419, 318, 0,
-	337, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 344, 367, 379, 397, 400, 0, 0,
-	0, 230, 399, 0, 0, 0, 0, 0, 0, 0,
-	370, 0, 0, 0, 378, 0, 0, 0, 0, 0,
-	395, 302, 303, 304, 305, 270, 0, 247, 398, 327,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 391, 392, 266, 272,
-	410, 274, 246, 317, 268, 376, 280, 0, 403, 0,
-	404, 0, 0, 0, 0, 309, 277, 341, 281, 287,
-	330, 375, 315, 335, 244, 366, 342, 291, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 220, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 227, 0,
-	285, 0, 326, 265, 184, 185, 186, 187, 188, 189,
-	190, 191, 192, 193, 194, 195, 196, 197, 198, 199,
-	200, 201, 202, 203, 204, 205, 0, 206, 207, 208,
-	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
-	219, 0, 221, 222, 223, 224, 0, 0, 0, 382,
-	383, 384, 406, 368, 351, 418, 0, 0, 1803, 0,
-	0, 0, 0, 0, 0, 314, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 260, 0, 0, 284, 0, 0, 0, 0,
-	0, 0, 343, 298, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 177, 0, 0, 0, 0, 0, 0,
-	242, 178, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 245, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 233, 348, 364, 243, 339, 377, 248,
-	346, 238, 313, 336, 0, 0, 235, 362, 345, 295,
-	278, 279, 234, 0, 331, 258, 271, 255, 311, 0,
-	361, 389, 254, 380, 0, 372, 237, 0, 371, 310,
-	358, 363, 296, 290, 236, 360, 294, 289, 282, 262,
-	405, 275, 322, 288, 323, 276, 300, 299, 301, 0,
-	0, 0, 0, 0, 401, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 374, 0, 0, 0, 0, 0, 0, 347,
-	0, 0, 283, 0, 0, 0, 390, 0, 334, 316,
-	0, 0, 0, 332, 286, 359, 324, 365, 349, 373,
-	328, 325, 228, 350, 257, 297, 239, 241, 253, 259,
-	261, 263, 264, 306, 307, 319, 338, 352, 353, 354,
-	256, 249, 333, 250, 273, 251, 229, 340, 252, 231,
-	320, 357, 0, 269, 329, 293, 232, 292, 321, 356,
-	355, 240, 381, 387, 388, 393, 0, 394, 0, 0,
-	0, 402, 407, 408, 409, 411, 412, 413, 414, 0,
-	0, 0, 0, 396, 0, 0, 0, 0, 0, 0,
-	386, 267, 225, 226, 421, 0, 312, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 308, 385, 0, 0,
-	0, 0, 420, 0, 0, 0, 0, 0, 419, 318,
-	0, 337, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 344, 367, 379, 397, 400, 0,
-	0, 0, 230, 399, 0, 0, 0, 0, 0, 0,
-	0, 370, 0, 0, 0, 378, 0, 0, 0, 0,
-	0, 395, 302, 303, 304, 305, 270, 0, 247, 398,
-	327, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 391, 392, 266,
-	272, 410, 274, 246, 317, 268, 376, 280, 0, 403,
-	0, 404, 0, 0, 0, 0, 309, 277, 341, 281,
-	287, 330, 375, 315, 335, 244, 366, 342, 291, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 220, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 227,
-	0, 285, 0, 326, 265, 184, 185, 186, 187, 188,
-	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
-	199, 200, 201, 202, 203, 204, 205, 0, 206, 207,
-	208, 209, 210, 211, 212, 213, 214, 215, 216, 217,
-	218, 219, 0, 221, 222, 223, 224, 351, 0, 0,
-	382, 383, 384, 406, 368, 0, 418, 0, 314, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 260, 0, 0, 284, 0,
-	0, 0, 0, 0, 0, 343, 298, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 177, 0, 0, 1146,
-	0, 0, 0, 242, 178, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 245, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 233, 348, 364, 243,
-	339, 377, 248, 346, 238, 313, 336, 0, 0, 235,
-	362, 345, 295, 278, 279, 234, 0, 331, 258, 271,
-	255, 311, 0, 361, 389, 254, 380, 0, 372, 237,
-	0, 371, 310, 358, 363, 296, 290, 236, 360, 294,
-	289, 282, 262, 405, 275, 322, 288, 323, 276, 300,
-	299, 301, 0, 0, 0, 0, 0, 401, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 374, 0, 0, 0, 0,
-	0, 0, 347, 0, 0, 283, 0, 0, 0, 390,
-	0, 334, 316, 0, 0, 0, 332, 286, 359, 324,
-	365, 349, 373, 1458, 325, 228, 350, 257, 297, 239,
-	241, 253, 259, 261, 263, 264, 306, 307, 319, 338,
-	352, 353, 354, 256, 249, 333, 250, 273, 251, 229,
-	340, 252, 231, 320, 357, 0, 269, 329, 293, 232,
-	292, 321, 356, 355, 240, 381, 387, 388, 393, 0,
-	394, 0, 0, 0, 402, 407, 408, 409, 411, 412,
-	413, 414, 0, 0, 0, 0, 396, 0, 0, 0,
-	0, 0, 0, 386, 267, 225, 226, 421, 0, 312,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 308,
-	385, 0, 0, 0, 0, 420, 0, 0, 0, 0,
-	0, 419, 318, 0, 337, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 344, 367, 379,
-	397, 400, 0, 0, 0, 230, 399, 0, 0, 0,
-	0, 0, 0, 0, 370, 0, 0, 0, 378, 0,
-	0, 0, 0, 0, 395, 302, 303, 304, 305, 270,
-	0, 247, 398, 327, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	391, 392, 266, 272, 410, 274, 246, 317, 268, 376,
-	280, 0, 403, 0, 404, 0, 0, 0, 0, 309,
-	277, 341, 281, 287, 330, 375, 315, 335, 244, 366,
-	342, 291, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 220, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 227, 0, 285, 0, 326, 265, 184, 185,
-	186, 187, 188, 189, 190, 191, 192, 193, 194, 195,
-	196, 197, 198, 199, 200, 201, 202, 203, 204, 205,
-	0, 206, 207, 208, 209, 210, 211, 212, 213, 214,
-	215, 216, 217, 218, 219, 0, 221, 222, 223, 224,
-	351, 0, 0, 382, 383, 384, 406, 368, 0, 418,
-	0, 314, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 260, 0,
-	0, 284, 0, 0, 0, 0, 0, 0, 343, 298,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 177,
-	0, 0, 0, 0, 0, 0, 242, 178, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 245, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 233,
-	348, 364, 243, 339, 377, 248, 346, 238, 313, 336,
-	0, 0, 235, 362, 345, 295, 278, 279, 234, 0,
-	331, 258, 271, 255, 311, 0, 361, 389, 254, 380,
-	0, 372, 237, 0, 371, 310, 358, 363, 296, 290,
-	236, 360, 294, 289, 282, 262, 405, 275, 322, 288,
-	323, 276, 300, 299, 301, 0, 0, 0, 0, 0,
-	401, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 374, 0,
-	0, 1169, 0, 0, 0, 347, 0, 0, 283, 0,
-	0, 0, 390, 0, 334, 316, 0, 0, 0, 332,
-	286, 359, 324, 365, 349, 373, 328, 325, 228, 350,
-	257, 297, 239, 241, 253, 259, 261, 263, 264, 306,
-	307, 319, 338, 352, 353, 354, 256, 249, 333, 250,
-	273, 251, 229, 340, 252, 231, 320, 357, 0, 269,
-	329, 293, 232, 292, 321, 356, 355, 240, 381, 387,
-	388, 393, 0, 394, 0, 0, 0, 402, 407, 408,
-	409, 411, 412, 413, 414, 0, 0, 0, 0, 396,
-	0, 0, 0, 0, 0, 0, 386, 267, 225, 226,
-	421, 0, 312, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 308, 385, 0, 0, 0, 0, 420, 0,
-	0, 0, 0, 0, 419, 318, 0, 337, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	344, 367, 379, 397, 400, 0, 0, 0, 230, 399,
-	0, 0, 0, 0, 0, 0, 0, 370, 0, 0,
-	0, 378, 0, 0, 0, 0, 0, 395, 302, 303,
-	304, 305, 270, 0, 247, 398, 327, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 391, 392, 266, 272, 410, 274, 246,
-	317, 268, 376, 280, 0, 403, 0, 404, 0, 0,
-	0, 0, 309, 277, 341, 281, 287, 330, 375, 315,
-	335, 244, 366, 342, 291, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 220, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 227, 0, 285, 0, 326,
-	265, 184, 185, 186, 187, 188, 189, 190, 191, 192,
-	193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
-	203, 204, 205, 0, 206, 207, 208, 209, 210, 211,
-	212, 213, 214, 215, 216, 217, 218, 219, 0, 221,
-	222, 223, 224, 351, 0, 0, 382, 383, 384, 406,
-	368, 0, 418, 0, 314, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 260, 0, 0, 284, 0, 0, 0, 0, 0,
-	0, 343, 298, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 177, 0, 0, 0, 0, 0, 0, 242,
-	178, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	245, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 233, 348, 364, 243, 339, 377, 248, 346,
-	238, 313, 336, 0, 0, 235, 362, 345, 295, 278,
-	279, 234, 0, 331, 258, 271, 255, 311, 0, 361,
-	389, 254, 380, 0, 372, 237, 0, 371, 310, 358,
-	363, 296, 290, 236, 360, 294, 289, 282, 262, 405,
-	275, 322, 288, 323, 276, 300, 299, 301, 0, 0,
-	0, 0, 0, 401, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 374, 0, 0, 0, 0, 0, 0, 347, 0,
-	0, 283, 0, 0, 0, 390, 0, 334, 316, 0,
-	0, 0, 332, 286, 359, 324, 365, 349, 373, 328,
-	325, 228, 350, 257, 297, 239, 241, 253, 259, 261,
-	263, 264, 306, 307, 319, 338, 352, 353, 354, 256,
-	249, 333, 250, 273, 251, 229, 340, 252, 231, 320,
-	357, 0, 269, 329, 293, 232, 292, 321, 356, 355,
-	240, 381, 387, 388, 393, 0, 394, 0, 0, 0,
-	402, 407, 408, 409, 411, 412, 413, 414, 0, 0,
-	0, 0, 396, 0, 0, 0, 0, 0, 0, 386,
-	267, 225, 226, 421, 0, 312, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 308, 385, 0, 0, 0,
-	0, 420, 0, 0, 0, 0, 0, 419, 318, 0,
-	337, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 344, 367, 379, 397, 400, 0, 0,
-	0, 230, 399, 0, 0, 0, 0, 0, 0, 0,
-	370, 0, 0, 0, 378, 0, 0, 0, 0, 0,
-	395, 302, 303, 304, 305, 270, 0, 247, 398, 327,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 391, 392, 266, 272,
-	410, 274, 246, 317, 268, 376, 280, 0, 403, 0,
-	404, 0, 0, 0, 0, 309, 277, 341, 281, 287,
-	330, 375, 315, 335, 244, 366, 342, 291, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 220, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 690, 0, 0, 0, 227, 0,
-	285, 0, 326, 265, 184, 185, 186, 187, 188, 189,
-	190, 191, 192, 193, 194, 195, 196, 197, 198, 199,
-	200, 201, 202, 203, 204, 205, 0, 206, 207, 208,
-	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
-	219, 0, 221, 222, 223, 224, 351, 0, 0, 382,
-	383, 384, 406, 368, 0, 418, 0, 314, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 260, 0, 0, 284, 0, 0,
-	0, 0, 0, 0, 343, 298, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 177, 0, 0, 0, 0,
-	0, 0, 242, 178, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 245, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 233, 348, 364, 243, 339,
-	377, 248, 346, 238, 313, 336, 0, 0, 235, 362,
-	345, 295, 278, 279, 234, 0, 331, 258, 271, 255,
-	311, 0, 361, 389, 254, 380, 0, 372, 237, 0,
-	371, 310, 358, 363, 296, 290, 236, 360, 294, 289,
-	282, 262, 405, 275, 322, 288, 323, 276, 300, 299,
-	301, 0, 0, 0, 0, 0, 401, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 374, 0, 0, 0, 0, 0,
-	0, 347, 0, 0, 283, 0, 0, 0, 390, 0,
-	334, 316, 0, 0, 0, 332, 286, 359, 324, 365,
-	349, 373, 459, 325, 228, 350, 257, 297, 239, 241,
-	253, 259, 261, 263, 264, 306, 307, 319, 338, 352,
-	353, 354, 256, 249, 333, 250, 273, 251, 229, 340,
-	252, 231, 320, 357, 0, 269, 329, 293, 232, 292,
-	321, 356, 355, 240, 381, 387, 388, 393, 0, 394,
-	0, 0, 0, 402, 407, 408, 409, 411, 412, 413,
-	414, 0, 0, 0, 0, 396, 0, 0, 0, 0,
-	0, 0, 386, 267, 225, 226, 421, 0, 312, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 308, 385,
-	0, 0, 0, 0, 420, 0, 0, 0, 0, 0,
-	419, 318, 0, 337, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 344, 367, 379, 397,
-	400, 0, 0, 0, 230, 399, 0, 0, 0, 0,
-	0, 0, 460, 370, 0, 0, 0, 378, 0, 0,
-	0, 0, 0, 395, 302, 303, 304, 305, 270, 0,
-	247, 398, 327, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 391,
-	392, 266, 272, 410, 274, 246, 317, 268, 376, 280,
-	0, 403, 0, 404, 0, 0, 0, 0, 309, 277,
-	341, 281, 287, 330, 375, 315, 335, 244, 366, 342,
-	291, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 220, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 227, 0, 285, 0, 326, 265, 184, 185, 186,
-	187, 188, 189, 190, 191, 192, 193, 194, 195, 196,
-	197, 198, 199, 200, 201, 202, 203, 204, 205, 0,
-	206, 207, 208, 209, 210, 211, 212, 213, 214, 215,
-	216, 217, 218, 219, 0, 221, 222, 223, 224, 351,
-	0, 0, 382, 383, 384, 406, 368, 0, 418, 0,
-	314, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 260, 0, 0,
-	284, 0, 0, 0, 0, 0, 0, 343, 298, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 177, 0,
-	0, 0, 0, 0, 0, 242, 178, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 245, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 233, 348,
-	364, 243, 339, 377, 248, 346, 238, 313, 336, 0,
-	0, 235, 362, 345, 295, 278, 279, 234, 0, 331,
-	258, 271, 255, 311, 0, 361, 389, 254, 380, 0,
-	372, 237, 0, 371, 310, 358, 363, 296, 290, 236,
-	360, 294, 289, 282, 262, 405, 275, 322, 288, 323,
-	276, 300, 299, 301, 0, 0, 0, 0, 0, 401,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 436, 0, 0, 374, 0, 0,
-	0, 0, 0, 0, 347, 0, 0, 283, 0, 0,
-	0, 390, 0, 334, 316, 0, 0, 0, 332, 286,
-	359, 324, 365, 349, 373, 328, 325, 228, 350, 257,
-	297, 239, 241, 253, 259, 261, 263, 264, 306, 307,
-	319, 338, 352, 353, 354, 256, 249, 333, 250, 273,
-	251, 229, 340, 252, 231, 320, 357, 0, 269, 329,
-	293, 232, 292, 321, 356, 355, 240, 381, 387, 388,
-	393, 0, 394, 0, 0, 0, 402, 407, 408, 409,
-	411, 412, 413, 414, 0, 0, 0, 0, 396, 0,
-	0, 0, 0, 0, 0, 386, 267, 225, 226, 421,
-	0, 312, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 308, 385, 0, 0, 0, 0, 420, 0, 0,
-	0, 0, 0, 419, 318, 0, 337, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 344,
-	367, 379, 397, 400, 0, 0, 0, 230, 399, 0,
-	0, 0, 0, 0, 0, 0, 370, 0, 0, 0,
-	378, 0, 0, 0, 0, 0, 395, 302, 303, 304,
-	305, 270, 0, 247, 398, 327, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 391, 392, 266, 272, 410, 274, 246, 317,
-	268, 376, 280, 0, 403, 0, 404, 0, 0, 0,
-	0, 309, 277, 341, 281, 287, 330, 375, 315, 335,
-	244, 366, 342, 291, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 220, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 227, 0, 285, 0, 326, 265,
-	184, 185, 186, 187, 188, 189, 190, 191, 192, 193,
-	194, 195, 196, 197, 198, 199, 200, 201, 202, 203,
-	204, 205, 0, 206, 207, 208, 209, 210, 211, 212,
-	213, 214, 215, 216, 217, 218, 219, 0, 221, 222,
-	223, 224, 351, 0, 0, 382, 383, 384, 406, 368,
-	0, 418, 0, 314, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 426,
-	260, 0, 0, 284, 0, 0, 0, 0, 0, 0,
-	343, 298, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 177, 0, 0, 0, 0, 0, 0, 242, 178,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 245,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 233, 348, 364, 243, 339, 377, 248, 346, 238,
-	313, 336, 0, 0, 235, 362, 345, 295, 278, 279,
-	234, 0, 331, 258, 271, 255, 311, 0, 361, 389,
-	254, 380, 0, 372, 237, 0, 371, 310, 358, 363,
-	296, 290, 236, 360, 294, 289, 282, 262, 405, 275,
-	322, 288, 323, 276, 300, 299, 301, 0, 0, 0,
-	0, 0, 401, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	374, 0, 0, 0, 0, 0, 0, 347, 0, 0,
-	283, 0, 0, 0, 390, 0, 334, 316, 0, 0,
-	0, 332, 286, 359, 324, 365, 349, 373, 328, 325,
-	228, 350, 257, 297, 239, 241, 253, 259, 261, 263,
-	264, 306, 307, 319, 338, 352, 353, 354, 256, 249,
-	333, 250, 273, 251, 229, 340, 252, 231, 320, 357,
-	0, 269, 329, 293, 232, 292, 321, 356, 355, 240,
-	381, 387, 388, 393, 0, 394, 0, 0, 0, 402,
-	407, 408, 409, 411, 412, 413, 414, 0, 0, 0,
-	0, 396, 0, 0, 0, 0, 0, 0, 386, 267,
-	225, 226, 421, 0, 312, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 308, 385, 0, 0, 0, 0,
-	420, 0, 0, 0, 0, 0, 419, 318, 0, 337,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 344, 367, 379, 397, 400, 0, 0, 0,
-	230, 399, 0, 0, 0, 0, 0, 0, 0, 370,
-	0, 0, 0, 378, 0, 0, 0, 0, 0, 395,
-	302, 303, 304, 305, 270, 0, 247, 398, 327, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 391, 392, 266, 272, 410,
-	274, 246, 317, 268, 376, 280, 0, 403, 0, 404,
-	0, 0, 0, 0, 309, 277, 341, 281, 287, 330,
-	375, 315, 335, 244, 366, 342, 291, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	220, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 227, 0, 285,
-	0, 326, 265, 184, 185, 186, 187, 188, 189, 190,
-	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
-	201, 202, 203, 204, 205, 0, 206, 207, 208, 209,
-	210, 211, 212, 213, 214, 215, 216, 217, 218, 219,
-	0, 221, 222, 223, 224, 351, 0, 0, 382, 383,
-	384, 406, 368, 0, 418, 0, 314, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 260, 0, 0, 284, 0, 0, 0,
-	0, 0, 0, 343, 298, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 177, 0, 0, 0, 0, 0,
-	0, 242, 178, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 245, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 233, 348, 364, 243, 339, 377,
-	248, 346, 238, 313, 336, 0, 0, 235, 362, 345,
-	295, 278, 279, 234, 0, 331, 258, 271, 255, 311,
-	0, 361, 389, 254, 380, 0, 372, 237, 0, 371,
-	310, 358, 363, 296, 290, 236, 360, 294, 289, 282,
-	262, 405, 275, 322, 288, 323, 276, 300, 299, 301,
-	0, 0, 0, 0, 0, 401, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 374, 0, 0, 0, 0, 0, 0,
-	347, 0, 0, 283, 0, 0, 0, 390, 0, 334,
-	316, 0, 0, 0, 332, 286, 359, 324, 365, 349,
-	373, 328, 325, 228, 350, 257, 297, 239, 241, 253,
-	259, 261, 263, 264, 306, 307, 319, 338, 352, 353,
-	354, 256, 249, 333, 250, 273, 251, 229, 340, 252,
-	231, 320, 357, 0, 269, 329, 293, 232, 292, 321,
-	356, 355, 240, 381, 387, 388, 393, 0, 394, 0,
-	0, 0, 402, 407, 408, 409, 411, 412, 413, 414,
-	0, 0, 0, 0, 396, 0, 0, 0, 0, 0,
-	0, 386, 267, 225, 226, 421, 0, 312, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 308, 385, 0,
-	0, 0, 0, 420, 0, 0, 0, 0, 0, 419,
-	318, 0, 337, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 344, 367, 379, 397, 400,
-	0, 0, 0, 230, 399, 0, 0, 0, 0, 0,
-	0, 0, 370, 0, 0, 0, 378, 0, 0, 0,
-	0, 0, 395, 302, 303, 304, 305, 270, 0, 247,
-	398, 327, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 391, 392,
-	266, 272, 410, 274, 246, 317, 268, 376, 280, 0,
-	403, 0, 404, 0, 0, 0, 0, 309, 277, 341,
-	281, 287, 330, 375, 315, 335, 244, 366, 342, 291,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 220, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	227, 0, 285, 0, 326, 265, 184, 185, 186, 187,
-	188, 189, 190, 191, 192, 193, 194, 195, 196, 197,
-	198, 199, 200, 201, 202, 203, 204, 205, 0, 206,
-	207, 208, 209, 210, 211, 212, 213, 214, 215, 216,
-	217, 218, 219, 0, 221, 222, 223, 224, 351, 0,
-	0, 382, 383, 384, 406, 368, 0, 418, 0, 314,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 260, 0, 0, 284,
-	0, 0, 0, 0, 0, 0, 343, 298, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 177, 0, 0,
-	0, 0, 0, 0, 242, 178, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 245, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 233, 348, 364,
-	243, 339, 377, 248, 346, 238, 313, 336, 0, 0,
-	235, 362, 345, 295, 278, 279, 234, 0, 331, 258,
-	271, 255, 311, 0, 361, 389, 254, 380, 0, 372,
-	237, 0, 371, 310, 358, 363, 296, 290, 236, 360,
-	294, 289, 282, 262, 405, 275, 322, 288, 323, 276,
-	300, 299, 301, 0, 0, 0, 0, 0, 401, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 374, 0, 0, 0,
-	0, 0, 0, 347, 0, 0, 283, 0, 0, 0,
-	390, 0, 334, 316, 0, 0, 0, 332, 286, 359,
-	324, 365, 349, 373, 328, 325, 228, 350, 257, 297,
-	239, 241, 500, 259, 261, 263, 264, 306, 307, 319,
-	338, 352, 353, 354, 256, 249, 333, 250, 273, 251,
-	229, 340, 252, 231, 320, 357, 0, 269, 329, 293,
-	232, 292, 321, 356, 355, 240, 381, 387, 388, 393,
-	0, 394, 0, 0, 0, 402, 407, 408, 409, 411,
-	412, 413, 414, 0, 0, 0, 0, 396, 0, 0,
-	0, 0, 0, 0, 386, 267, 225, 226, 421, 0,
-	312, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	308, 385, 0, 0, 0, 0, 420, 0, 0, 0,
-	0, 0, 419, 318, 0, 337, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 344, 367,
-	379, 397, 400, 0, 0, 0, 230, 399, 0, 0,
-	0, 0, 0, 0, 0, 370, 0, 0, 0, 378,
-	0, 0, 0, 0, 0, 395, 302, 303, 304, 305,
-	270, 0, 247, 398, 327, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 391, 392, 266, 272, 410, 274, 246, 317, 268,
-	376, 280, 0, 403, 155, 404, 49, 147, 124, 0,
-	309, 277, 341, 281, 287, 330, 375, 315, 335, 244,
-	366, 342, 291, 0, 148, 0, 0, 0, 0, 0,
-	0, 140, 0, 0, 0, 149, 220, 0, 0, 0,
-	107, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 96, 0, 0, 0, 0,
-	0, 152, 0, 227, 0, 285, 0, 326, 265, 184,
-	185, 186, 187, 188, 189, 190, 191, 192, 193, 194,
-	195, 196, 197, 198, 199, 200, 201, 202, 203, 204,
-	205, 0, 206, 207, 208, 209, 210, 211, 212, 213,
-	214, 215, 216, 217, 218, 219, 0, 221, 222, 223,
-	224, 0, 0, 0, 382, 383, 384, 406, 368, 0,
-	418, 0, 155, 0, 49, 147, 124, 0, 0, 0,
-	0, 0, 0, 0, 111, 112, 0, 113, 114, 0,
-	0, 0, 148, 0, 0, 0, 0, 0, 0, 140,
-	0, 0, 0, 149, 0, 0, 0, 0, 107, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 96, 0, 0, 0, 0, 0, 152,
-	0, 0, 0, 694, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 123, 146, 153, 0, 94, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1515, 0, 0, 0,
-	0, 0, 0, 0, 145, 139, 138, 0, 0, 820,
-	0, 55, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 732, 0, 0, 0, 0,
-	1517, 0, 111, 112, 0, 113, 114, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1515, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1497, 0, 141,
-	142, 143, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1517, 0, 0, 0, 0, 150, 0, 0, 0, 0,
-	123, 146, 153, 0, 94, 0, 0, 0, 734, 0,
-	0, 733, 808, 103, 0, 0, 0, 144, 0, 104,
-	0, 0, 145, 139, 138, 0, 0, 1497, 0, 55,
-	0, 0, 828, 832, 834, 836, 838, 839, 841, 0,
-	845, 842, 843, 844, 0, 718, 823, 824, 825, 826,
-	806, 807, 829, 695, 809, 0, 810, 811, 812, 813,
-	814, 815, 816, 817, 818, 819, 821, 827, 0, 0,
-	0, 0, 105, 0, 0, 831, 833, 835, 837, 840,
-	724, 0, 48, 0, 0, 0, 0, 141, 142, 143,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1491,
-	1490, 0, 0, 1489, 0, 0, 0, 0, 1501, 0,
-	0, 0, 822, 150, 0, 0, 0, 0, 0, 1505,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	50, 103, 0, 0, 0, 144, 0, 104, 0, 1494,
-	717, 716, 0, 1496, 1498, 1500, 0, 1502, 1503, 1504,
-	1506, 1507, 1508, 1510, 1511, 1512, 1513, 715, 0, 0,
-	0, 0, 0, 125, 0, 0, 693, 0, 1501, 0,
-	0, 0, 0, 0, 0, 0, 0, 696, 727, 1505,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	105, 0, 0, 0, 0, 1516, 0, 0, 0, 1494,
-	48, 722, 0, 1496, 1498, 1500, 0, 1502, 1503, 1504,
-	1506, 1507, 1508, 1510, 1511, 1512, 1513, 106, 38, 0,
-	0, 0, 0, 0, 47, 5, 0, 0, 110, 0,
-	820, 0, 1514, 723, 728, 0, 0, 0, 0, 0,
-	2003, 2004, 0, 0, 0, 0, 1515, 0, 50, 1493,
-	712, 0, 710, 714, 731, 1516, 0, 0, 711, 708,
-	707, 0, 713, 698, 699, 697, 700, 701, 702, 703,
-	0, 729, 730, 0, 0, 0, 0, 0, 1509, 0,
-	1517, 125, 0, 725, 726, 1499, 0, 820, 0, 0,
-	0, 0, 1514, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2912, 0, 1493,
-	0, 0, 0, 0, 0, 0, 0, 1497, 0, 0,
-	720, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 808, 0, 106, 38, 798, 1509, 0,
-	0, 0, 47, 0, 0, 1499, 110, 0, 0, 0,
-	0, 0, 0, 828, 832, 834, 836, 838, 839, 841,
-	1515, 845, 842, 843, 844, 0, 830, 823, 824, 825,
-	826, 806, 807, 829, 0, 809, 0, 810, 811, 812,
-	813, 814, 815, 816, 817, 818, 819, 821, 827, 719,
-	808, 0, 0, 0, 1517, 0, 831, 833, 835, 837,
-	840, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	828, 832, 834, 836, 838, 839, 841, 0, 845, 842,
-	843, 844, 0, 0, 823, 824, 825, 826, 806, 807,
-	829, 1497, 809, 822, 810, 811, 812, 813, 814, 815,
-	816, 817, 818, 819, 821, 827, 0, 479, 0, 478,
-	485, 475, 0, 831, 833, 835, 837, 840, 1501, 0,
-	0, 482, 483, 0, 484, 488, 0, 0, 470, 1505,
-	0, 0, 0, 0, 0, 0, 0, 0, 493, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1494,
-	822, 2695, 0, 1496, 1498, 1500, 0, 1502, 1503, 1504,
-	1506, 1507, 1508, 1510, 1511, 1512, 1513, 497, 0, 0,
-	499, 0, 0, 0, 0, 498, 479, 0, 478, 485,
-	475, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	482, 483, 0, 484, 488, 0, 0, 470, 0, 479,
-	0, 478, 485, 475, 0, 1516, 0, 493, 0, 0,
-	0, 0, 0, 482, 483, 0, 484, 488, 0, 0,
-	470, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	493, 0, 1501, 0, 0, 0, 497, 0, 0, 499,
-	0, 0, 1514, 1505, 498, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1493,
-	0, 0, 0, 1494, 0, 0, 0, 1496, 1498, 1500,
-	0, 1502, 1503, 1504, 1506, 1507, 1508, 1510, 1511, 1512,
-	1513, 0, 0, 0, 0, 0, 0, 0, 1509, 0,
-	0, 0, 0, 0, 0, 1499, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 471, 473, 472, 0, 0,
-	0, 0, 0, 0, 0, 477, 0, 0, 0, 1516,
-	0, 0, 0, 0, 0, 0, 0, 481, 0, 0,
-	0, 0, 0, 0, 496, 0, 0, 0, 0, 0,
-	0, 474, 0, 0, 0, 465, 0, 830, 0, 0,
-	0, 0, 0, 0, 0, 0, 1514, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1493, 471, 473, 472, 0, 0, 0,
-	0, 0, 0, 0, 477, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 481, 471, 473, 472,
-	0, 0, 1509, 496, 830, 0, 0, 477, 0, 1499,
-	474, 0, 0, 0, 0, 0, 0, 0, 0, 481,
-	0, 0, 0, 0, 0, 0, 496, 0, 0, 0,
-	0, 0, 0, 474, 0, 0, 0, 0, 0, 0,
-	476, 480, 486, 0, 487, 489, 0, 0, 490, 491,
-	492, 0, 0, 494, 495, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 476,
-	480, 486, 0, 487, 489, 0, 0, 490, 491, 492,
-	0, 0, 494, 495, 0, 0, 0, 0, 0, 0,
-	0, 0, 476, 480, 486, 0, 487, 489, 0, 0,
-	490, 491, 492, 0, 0, 494, 495,
+	0, 0, 0, 0, 509, 513, 519, 0, 520, 522,
+	0, 0, 523, 524, 525, 0, 0, 527, 528,
 }
 
 var yyPact = [...]int{
-	32894, -1000, -1000, -1000, -307, 9958, -1000, -1000, -1000, -1000,
+	2990, -1000, -1000, -1000, -318, 11523, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 31954, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 31954, -305, 31431,
-	31431, -1000, -1000, 1914, -1000, 30908, 11023, 31954, 258, 253,
-	31954, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 35898, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 35898, -315, 35357, 35357, -1000, -1000,
+	2130, -1000, 34816, 12624, 35898, 320, 317, 35898, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 556, -1000, 30385, -1000, -1000, -1000,
-	-1000, -1000, -1000, 493, 33657, 32477, 7855, -260, -1000, 2590,
-	-119, 701, 706, 790, 790, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 2820, 617, 29862, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 33057, 149, 617, 13115, -32,
-	-40, 2590, 294, 159, -1000, 820, 33012, 141, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 7855,
-	7855, 9958, -315, 9958, 7855, 31954, 31954, -1000, -1000, -1000,
-	-1000, 493, 33657, 7855, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 697, -1000, 34275, -1000, -1000, -1000, -1000, -1000,
+	-1000, 618, 4002, 36439, 9348, -271, -1000, 2856, -130, 660,
+	2779, 265, 858, 862, 1035, 1035, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 2816, 724, 33734, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	304, 171, 724, 15329, -33, -36, 2856, 291, 3100, -1000,
+	1623, 3249, 150, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 9348, 9348, 11523, -341, 11523, 9348,
+	35898, 35898, -1000, -1000, -1000, -1000, 618, 4002, 9348, 660,
+	2779, 265, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -4943,320 +5297,341 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -40,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -36, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 33420, -1000, 1134, -1000, -1000,
-	-1000, -1000, 2222, 1104, 1819, 459, 31954, -1000, 1103, 459,
-	-1000, -1000, -1000, 2590, 2590, -1000, 31954, 31954, 29, 1298,
-	-1000, 305, 289, 268, 1098, -1000, -1000, -1000, -1000, -1000,
-	-1000, 363, 2573, -1000, 31954, 31954, 2235, 31954, -1000, 1512,
-	413, 33726, 2391, 1261, 601, 2250, -1000, -1000, 2219, -1000,
-	142, 168, 112, 241, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 160, -1000, 2412, -1000, -1000, 133, -1000, -1000, 132,
-	-1000, -1000, -1000, -41, -1000, -1000, -1000, -1000, -1000, -1000,
-	-115, -1000, -1000, 752, 1988, 7855, -1000, 1435, -1000, 2894,
-	-1000, -1000, -1000, -1000, 5230, 9424, 9424, 9424, 9424, -1000,
-	-1000, 2052, 7855, 2210, 2209, -1000, -1000, -1000, -1000, -1000,
-	1074, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1811, 8901, -1000, 2203, 2201, 2199, 2197,
-	2196, 2194, 2192, 2191, 2190, 2189, 2187, 2183, 2182, 2179,
-	1961, 10489, 2174, 1809, 1808, 2172, 2171, 2157, 1804, 1961,
-	1961, 2145, 2143, 2142, 2141, 2133, 2131, 2127, 2119, 2118,
-	2106, 2104, 2103, 2102, 2099, 2098, 2097, 2095, 2094, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 3200, -1000, 1322, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 2376, 1321, 1928, 563, 35898,
+	-1000, 1320, 563, -1000, -1000, -1000, 2856, 2856, -1000, 35898,
+	35898, 54, 1443, -1000, 305, 390, 280, 1315, -1000, -1000,
+	-1000, -1000, -1000, -1000, 436, 2778, -1000, 35898, 35898, 2394,
+	35898, -1000, 1754, 480, 4123, 2574, 1194, 734, 2407, -1000,
+	-1000, 2366, -1000, 191, 144, 148, 628, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 187, -1000, 2628, -1000, -1000, 184,
+	-1000, -1000, 189, -1000, -1000, -1000, -41, -1000, -1000, -1000,
+	-1000, -1000, -1000, -122, -1000, -1000, 931, 1558, 9348, -1000,
+	1702, -1000, 2652, -1000, -1000, -1000, -1000, 6633, 10971, 10971,
+	10971, 10971, -1000, -1000, 2182, 9348, 2365, 2363, -1000, -1000,
+	-1000, -1000, -1000, 1304, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1925, 10430, -1000, 2362,
+	2359, 2356, 2350, 2343, 2339, 2337, 2335, 2325, 2324, 2323,
+	2322, 2321, 2320, 2070, 12072, 2319, 1921, 1917, 2318, 2317,
+	2315, 1916, 2070, 2070, 2314, 2307, 2303, 2292, 2291, 2287,
+	2282, 2280, 2279, 2262, 2255, 2245, 2244, 2237, 2232, 2226,
+	2223, 2221, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 958, -1000, 2089, 2356, 2479, 1790, 2540, 2455,
-	2444, 2438, 2425, 1635, -1000, -1000, -1000, -156, -1000, -1000,
-	681, -1000, 670, -1000, 31954, 31954, 31954, 490, 490, 490,
-	490, 490, 529, 490, 550, 547, 538, 490, -1000, -1000,
-	-1000, -1000, -1000, -1000, 644, -1000, -1000, -1000, -1000, 982,
-	31954, -1000, 2019, 1275, 2471, 430, 428, 1275, 315, -1000,
-	1276, 1276, 1276, 1276, 1275, 311, 423, 2479, 2479, -24,
-	1276, -62, 1275, 1275, -62, 1275, 1275, 1275, 120, -302,
-	-1000, -1000, -1000, 1276, 425, 1276, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 2459, 2458, 493, 31954, 89, 31954, 493,
-	493, 503, 1512, 409, 391, 1005, 1304, -1000, 1195, 31954,
-	31954, 31954, 1195, 1195, 16779, 16256, -1000, 31954, -1000, 2479,
-	1790, -1000, 1940, 2598, 1939, 1790, 493, 493, 493, 493,
-	493, 493, 493, 493, 31954, 31954, 29339, 493, 6799, 6799,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 9958,
-	1330, 1749, 139, -83, -311, 171, -1000, -1000, 31954, 2350,
-	113, -1000, -1000, -1000, 1965, -1000, 2018, 2018, 2018, 2018,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 2036,
-	2087, -1000, -1000, 2016, 2016, 2016, 1965, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 2029, 2029, 2035, 2029, 31954, 7855, 31954, 2366,
-	278, 2072, -1000, 31954, 270, 2479, 2356, 2479, -1000, -1000,
-	1071, 1629, 1795, -1000, -1000, 305, 1256, -1000, 813, -1000,
-	-1000, -1000, -1000, 31954, -115, 281, -1000, -1000, 1784, 2063,
-	-1000, 418, 1259, 942, -1000, 173, 33749, 25670, 1512, 25670,
-	31954, -1000, -1000, -1000, -1000, -1000, -1000, -44, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 126, -1000, 7855, 7855, 7855, 7855, 7855, -1000, 454,
-	8378, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 9424, 9424,
-	9424, 9424, 9424, 9424, 9424, 9424, 9424, 9424, 9424, 9424,
-	2050, 1338, 9424, 9424, 9424, 9424, 2598, 2472, 990, 205,
-	-1000, -1000, -1000, -1000, -1000, 1306, 1988, 7855, 7855, 31954,
-	-1000, 2617, 7855, 7855, 114, 7855, 2421, 7855, 7855, 7855,
-	1938, 3660, 31954, 7855, -1000, 1933, 1926, -1000, -1000, 1434,
-	7855, -1000, -1000, 7855, -1000, -1000, 7855, 9424, 7855, -1000,
-	-1000, -1000, 174, 2421, 2421, 7855, 7855, 2421, 2421, 2421,
-	1303, 2421, 2421, 2421, 2421, 2421, 2421, 2421, 2421, 1925,
-	2479, -260, 6276, -1000, -266, 2356, 7855, -1000, -1000, 7855,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1789, -90,
-	691, 685, 687, -1000, 2410, -1000, 2062, 2059, 1070, 31954,
-	1359, 31954, 25670, 31954, 1512, 31954, 31954, 490, 490, 490,
-	31954, 503, -1000, 31954, 982, 2409, 31954, 2557, 9424, 9424,
-	28816, 1276, 1275, -1000, -1000, 31954, -1000, -1000, -1000, 1276,
-	31954, 1276, 1276, 2557, 1276, -1000, -1000, -1000, 1275, 1275,
-	-1000, -1000, -1000, -1000, 1276, 1276, -1000, -1000, 2557, 31954,
-	-56, 2557, 2557, -68, -1000, -1000, -1000, 31954, 31954, 490,
-	31954, -1000, 31954, -1000, 31954, -1000, -1000, 31954, 33110, 31954,
-	31954, 2453, -1000, 25670, 31954, 23578, -1000, -1000, 410, 394,
-	15210, 353, 25670, 4706, -1000, -1000, 1195, 1195, 1195, 4706,
-	4706, 1059, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 979,
-	-1000, 127, 2356, -1000, -1000, -1000, -1000, -1000, 31954, 31954,
-	25670, 1512, 31954, 31954, 31954, 31954, -1000, 2055, -1000, 2390,
-	31954, 933, -1000, -1000, 12592, 1068, 933, -1000, 1357, -1000,
-	7855, 9958, -284, 7855, 9958, 9958, 7855, 9958, -1000, 7855,
-	80, -1000, -1000, -1000, -1000, 1615, -1000, 1612, -1000, -1000,
-	-1000, 1788, 1788, -1000, 1608, -1000, -1000, -1000, -1000, 1606,
-	-1000, -1000, 1601, -1000, -1000, 1920, 752, -1000, 1780, 2249,
-	-262, -1000, 14163, 31954, 31954, -1000, -1000, -262, -1000, 13639,
-	31954, 2356, -1000, 2356, 31954, -1000, 2467, -1000, 305, 211,
-	-1000, -1000, -1000, -1000, -1000, -1000, 1066, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 960, -1000, 31954, -1000,
-	-1000, 173, 25670, 26716, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 175, -1000, -1000, 158, -1000, 511, 53, 1253, -1000,
-	-1000, 101, 154, 594, 1988, -1000, 1382, 1382, 1379, -1000,
-	457, -1000, -1000, -1000, -1000, 2052, -1000, -1000, -1000, 2518,
-	2723, -1000, 1220, 1220, 1023, 1023, 1023, 1023, 1023, 1315,
-	1315, -1000, -1000, -1000, 5230, 2050, 9424, 9424, 9424, 9424,
-	468, 468, 3118, 1400, -1000, 7855, 1349, -1000, 7855, 2180,
-	935, 1058, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1292, -1000, 2219, 2554, 2677,
+	2104, 2728, 2680, 2661, 2656, 2653, 1767, -1000, -1000, 845,
+	845, 665, -1000, -140, -1000, -1000, 844, -1000, 808, -1000,
+	35898, 35898, 35898, 617, 663, 617, 617, 617, 617, 294,
+	617, 617, 670, 617, 696, 694, 693, 617, -1000, -1000,
+	-1000, -1000, -1000, -1000, 783, -1000, -1000, -1000, -1000, 1235,
+	35898, -1000, 2117, 1369, 2671, 514, 495, 1369, 355, -1000,
+	1486, 1486, 1486, 1486, 1369, 313, 511, 2677, 2677, -50,
+	1486, -75, 1369, 1369, -75, 1369, 1369, -1000, 1369, 118,
+	-312, -1000, -1000, -1000, 1486, 487, 1486, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 2620, 2615, 618, 35898, 104, 35898,
+	618, 618, 647, 1754, 477, 473, 1241, 1521, -1000, 1384,
+	35898, 35898, 35898, 1384, 1384, 19119, 18578, -1000, 35898, -1000,
+	2677, 2104, -1000, 2035, 2333, 2034, 2104, 618, 618, 618,
+	618, 618, 618, 618, 662, 618, 35898, 35898, 33193, 618,
+	618, 290, 618, 912, -1000, -1000, -1000, 11523, 1805, 1749,
+	149, -91, -317, 185, -1000, -1000, 35898, 2525, 174, -1000,
+	-1000, -1000, 2074, -1000, 2132, 2132, 2132, 2132, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 2167, 2211, -1000,
+	-1000, 2118, 2118, 2118, 2074, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	2159, 2159, 2166, 2159, 35898, 9348, 35898, 2557, 276, 2209,
+	-1000, 35898, 274, 2677, 2554, 2677, -1000, -1000, 1294, 1766,
+	901, -1000, -1000, 305, 1442, 1339, -1000, 905, -1000, -1000,
+	-1000, -1000, 35898, -122, 300, -1000, -1000, 1905, 2204, -1000,
+	464, 948, 1202, -1000, 125, 36893, 28857, 1754, 28857, 35898,
+	-1000, -1000, -1000, -1000, -1000, -1000, -47, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1909, 1906, 1781, 2569, 1904, 7855, -1000, -1000,
-	1247, 1246, 1245, -1000, 1727, 7332, -1000, -1000, -1000, 1903,
-	1054, 1900, -1000, -1000, -1000, 1889, 1244, 799, 1888, 1542,
-	1886, 959, 7855, 7855, 1219, 1218, 7855, 7855, 7855, 7855,
-	1883, 7855, 7855, 7855, 7855, 7855, 7855, 7855, 7855, -26,
-	-1000, -1000, 1215, -1000, 1988, -1000, 1778, -1000, 921, 954,
-	-1000, 1581, -1000, -1000, -1000, -1000, 689, 656, 677, 31954,
-	756, 11546, 31954, 2019, 2370, 105, -1000, 911, -1000, 53,
-	-124, 782, 2233, 2565, 31954, 31954, 31954, 2408, 28293, -1000,
-	2047, 1214, -1000, -1000, 7855, -1000, -1000, 2230, 31954, 31954,
-	2557, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 31954, 2557,
-	2557, 1275, 1276, -1000, -1000, 1276, -1000, -1000, 1276, -1000,
-	1051, -1000, 31954, -1000, -1000, -1000, 2019, 953, -1000, 12069,
-	659, 525, -1000, 1232, 1232, 744, 1232, 1232, 1232, 1232,
-	356, 355, 1232, 1232, 1232, 1232, 1232, 1232, 1232, 1232,
-	1232, 1232, 1232, 1232, 1232, 1232, 2046, -1000, 93, 2452,
-	190, 911, 176, 2619, 923, -1000, -1000, -1000, -1000, 18871,
-	18871, 14687, 18871, -1000, 1056, -1000, -1000, 509, -1000, -1000,
-	782, -1000, -1000, -1000, 2045, 1294, -1000, -1000, 10489, -1000,
-	4706, 4706, 4706, -1000, -1000, 19394, 31954, -1000, -116, -1000,
-	-105, -1000, 928, -1000, -1000, 931, 782, 2243, 928, 928,
-	-1000, 11546, 31954, -1000, 2557, 6799, -1000, 23578, -1000, -1000,
-	27762, -1000, 27239, 2557, 1317, -1000, 9958, 1619, 136, -1000,
-	162, -312, 134, 1394, 130, 1988, -1000, -1000, 1882, 1878,
-	1181, -1000, 1170, 1877, 1168, 1160, -1000, -76, -1000, 2359,
-	785, -1000, 2042, -1000, 1151, 2335, -1000, 922, -1000, 1290,
-	1147, -1000, 785, 1106, 2334, 922, -1000, -1000, 1048, 26,
-	-1000, -1000, 31954, 1784, 1105, 26716, 796, -1000, 505, 1042,
-	1041, -1000, 25670, 128, 25670, -1000, 25670, -1000, -1000, 276,
-	-1000, 31954, 2336, -1000, -1000, -1000, 1726, -336, -1000, -1000,
-	-1000, -1000, -1000, 1100, -1000, 468, 468, 3118, 1242, -1000,
-	9424, -1000, 9424, 2451, 1313, -1000, 7855, 1607, 33099, 2073,
-	18348, 31954, -1000, -1000, 7855, 7855, -1000, 2442, -1000, -1000,
-	-1000, -1000, 7855, 7855, 1681, -1000, 31954, -1000, -1000, -1000,
-	-1000, 18348, -1000, 9424, -1000, 7855, 850, 2431, -26, -26,
-	2424, 2404, 2372, 1081, -26, 2368, 2355, 2328, 2324, 2318,
-	2284, 2262, 2217, -1000, 2041, 6276, -1000, -76, 7855, 7855,
-	7855, 2272, -1000, -1000, -1000, -1000, -1000, 654, 116, 1874,
-	897, -1000, -1000, 31954, -1000, -1000, -1000, 1870, 894, -1000,
-	-1000, -1000, 33477, 2018, 2018, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 2036, -1000, -1000, 2016, 2016, 2016,
-	1965, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	2029, 2029, 2035, 2029, -1000, 2399, -1000, -22, 1232, 412,
-	25670, 389, -1000, 31954, 2241, 408, 2343, 31954, 2028, 2026,
-	2025, 248, 33477, 31954, 901, -1000, 1040, 33012, -1000, 31954,
-	1988, -1000, 1512, -1000, 1275, -1000, 2557, 1047, -1000, -1000,
-	2557, 1275, 1275, 1276, 31954, -1000, 2398, 33110, -1000, -1000,
-	-1000, -1000, 33477, 591, -1000, 642, 490, 31954, 1408, 642,
-	1407, 2024, -1000, -1000, -1000, 31954, 31954, 31954, 1403, 1402,
-	-1000, 31954, 1599, -1000, 1598, 1232, 1232, 1579, 1777, 1772,
-	1771, 1232, 1232, 1578, 1766, 26193, 1576, 1570, 1563, 1611,
-	1751, 641, 1604, 1571, 1553, 31954, 2020, 1706, -22, 1232,
-	189, 1284, 412, 1514, 15733, 31954, 23578, 23578, 23578, 23578,
-	-1000, 2290, 2288, -1000, 2269, 2268, 2281, 31954, 23578, 2019,
-	-1000, 26193, -1000, -1000, -1000, 2598, 1064, 2629, 645, 7855,
-	25670, 1743, 353, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 31954, 31954, 1867, -1000, 2552, -1000, 867, -1000, -1000,
-	1036, -1000, 2552, 1333, -309, 9958, 1325, 1301, -1000, 7855,
-	9958, 7855, -286, 151, -288, -1000, -1000, -1000, 1740, -1000,
-	-1000, -1000, 1552, -1000, 1543, -14, 17, 1401, -262, 6276,
-	280, 31954, -262, 31954, 6276, -1000, 31954, 275, -262, 31954,
-	1536, -1000, -1000, -1000, 2562, 25670, 1512, 1166, 25147, -1000,
-	131, -1000, 170, 377, 1738, -1000, 532, 82, -1000, 1273,
-	1726, -1000, -1000, -1000, 9424, -1000, -1000, -1000, -1000, 1988,
-	7855, 1866, -1000, 660, 660, 1861, -1000, 2018, 2018, -1000,
-	1965, 2016, 1965, 660, 660, 1857, -1000, 1898, 2139, -1000,
-	2135, 2129, 7855, -1000, 1852, 3005, 1011, -145, -26, -1000,
-	-1000, -1000, -26, -26, -26, -26, -1000, -26, -26, -26,
-	-26, -26, -26, -26, -26, 461, -1000, -14, 1988, 1988,
-	-1000, -1000, 2313, -1000, 1736, 1735, 756, 33477, 559, 11546,
-	2347, 271, 1609, -1000, -1000, 24624, 393, -1000, -1000, -1000,
-	492, 185, 1530, 354, -1000, 31954, 165, 31954, -1000, -1000,
-	-1000, -1000, -1000, 2343, -1000, 743, 222, 12069, 12069, 12069,
-	225, 1355, -1000, 459, 866, 1020, 23578, 31954, -1000, 23055,
-	1850, -1000, 782, 2557, -1000, 31954, -1000, 2557, 2557, 1275,
-	-1000, 271, -1000, 2256, -1000, 31954, -1000, 31954, -1000, 31954,
-	31954, 490, 7855, -1000, -1000, -1000, 31954, -1000, 228, -1000,
-	-1000, 18348, 18348, -1000, -1000, -1000, -1000, 1733, 1732, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	173, -1000, 9348, 9348, 9348, 9348, 9348, -1000, 560, 9889,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 10971, 10971, 10971,
+	10971, 10971, 10971, 10971, 10971, 10971, 10971, 10971, 10971, 2185,
+	1622, 10971, 10971, 10971, 10971, 2333, 2739, 1236, 209, -1000,
+	-1000, -1000, -1000, -1000, 1476, 1558, 9348, 9348, 35898, -1000,
+	2751, 9348, 9348, 3358, 9348, 2612, 9348, 9348, 9348, 2024,
+	5009, 35898, 9348, -1000, 2023, 2020, -1000, -1000, 1589, 9348,
+	-1000, -1000, 9348, -1000, -1000, 9348, 10971, 9348, -1000, -1000,
+	-1000, 186, 2612, 2612, 9348, 9348, 2612, 2612, 2612, 1450,
+	2612, 2612, 2612, 2612, 2612, 2612, 2612, 2612, 2018, 2677,
+	-271, 7715, -1000, -284, 2554, 9348, -1000, -1000, 9348, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1908, -1000, -1000,
+	-331, 659, 35898, -108, 852, 811, 820, -1000, 2595, -1000,
+	2201, 2200, 1289, 35898, 1489, 617, 35898, 28857, 35898, 35898,
+	617, 35898, 1754, 35898, 35898, 617, 617, 617, 35898, 647,
+	-1000, 35898, 1235, 2594, 35898, 2745, 10971, 10971, 32652, 1486,
+	1369, -1000, -1000, 35898, -1000, -1000, -1000, 1486, 35898, 1486,
+	1486, 2745, 1486, -1000, -1000, -1000, 1369, 1369, -1000, -1000,
+	-1000, -1000, 1486, 1486, -1000, -1000, 2745, 35898, -68, 2745,
+	2745, -66, -1000, -1000, -1000, 35898, 35898, 617, 35898, -1000,
+	35898, -1000, 35898, -1000, -1000, 35898, 3647, 35898, 35898, 2608,
+	-1000, 28857, 35898, 21283, -1000, -1000, 453, 484, 17496, 404,
+	28857, 6091, -1000, -1000, 1384, 1384, 1384, 6091, 6091, 1328,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1228, -1000, 145,
+	2554, -1000, -1000, -1000, -1000, -1000, 35898, 35898, 28857, 1754,
+	35898, 35898, 35898, 618, 35898, -1000, 2199, -1000, 2573, 35898,
+	35898, 618, 35898, 8256, 8256, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1532, -1000, 9348, 11523, -299, 9348, 11523,
+	11523, 9348, 11523, -1000, 9348, 147, -1000, -1000, -1000, -1000,
+	1765, -1000, 1759, -1000, -1000, -1000, 1907, 1907, -1000, 1757,
+	-1000, -1000, -1000, -1000, 1755, -1000, -1000, 1751, -1000, -1000,
+	2017, 931, -1000, 1896, 2406, -281, -1000, 16955, 35898, 35898,
+	-1000, -1000, -281, -1000, 16413, 35898, 2554, -1000, 2554, 35898,
+	-1000, 2666, 305, -1000, -1000, -1000, 770, 305, 262, -1000,
+	-1000, -1000, -1000, 1288, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1224, -1000, 35898, -1000, -1000, 125, 28857,
+	31562, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 182, -1000,
+	-1000, 158, -1000, 656, 99, 1338, -1000, -1000, 109, 165,
+	715, 1558, -1000, 1525, 1525, 1535, -1000, 501, -1000, -1000,
+	-1000, -1000, 2182, -1000, -1000, -1000, 1487, 3349, -1000, 1388,
+	1388, 1307, 1307, 1307, 1307, 1307, 1537, 1537, -1000, -1000,
+	-1000, 6633, 2185, 10971, 10971, 10971, 10971, 585, 585, 3507,
+	2546, -1000, 9348, 1472, -1000, 9348, 2250, 1134, 1286, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 385, 31954, 1062, -1000, 1272, 1609, 24624, 1237, 1729,
-	393, -1000, 1728, -1000, 765, 31954, 31954, -1000, 940, -1000,
-	1235, 2226, 2240, 2226, -1000, -1000, -1000, -1000, 2266, -1000,
-	2265, -1000, -1000, 940, -1000, -1000, -1000, -1000, -1000, 645,
-	-1000, 2432, 642, 642, 642, 1848, 796, 1846, -1000, -1000,
-	-1000, -1000, -1000, 2549, 2539, 24101, 2549, -1000, -309, 1312,
-	-1000, 1412, 123, 1372, 31954, -1000, -1000, -1000, 1845, 1844,
-	-268, 18, 2538, 2527, 2587, -1000, 1842, 792, -262, -1000,
-	-1000, 785, -1000, -1000, -1000, -262, -1000, 785, -1000, -1000,
-	1512, -1000, 169, -1000, -1000, -1000, -1000, -1000, -1000, 41,
-	-1000, 31954, -1000, 1726, 1724, 77, -1000, 1988, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 7855, -1000, -1000, -1000, 2066, -1000, -1000,
-	7855, 1840, 1716, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 2542, -1000, 2521, -268,
-	-1000, -1000, -1000, -1000, -1000, -1000, 33477, -1000, 1055, -1000,
-	-1000, 1715, 51, -1000, -1000, -1000, 1713, 1711, 1527, -1000,
-	-1000, 1534, 1022, 62, -1000, -1000, -1000, -1000, -1000, -1000,
-	1514, 31954, 2010, -1000, 1232, 1232, 1232, 31954, 1837, 786,
-	-1000, 1836, 1832, 463, 1297, 1295, -1000, 1521, 18871, 23578,
-	23055, 914, -1000, 1019, -1000, -1000, -1000, 2557, -1000, -1000,
-	2557, -1000, -1000, 2256, -1000, -1000, 1415, 9424, -1000, -1000,
-	1710, 17825, 588, 593, 2007, -1000, 327, 2578, -1000, 1390,
-	1387, -1000, 31954, -1000, 2005, -1000, 2004, 2228, 263, 2001,
-	1987, 31954, 1970, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 378, 1030, -1000, 1706, 1705, -1000, 51, 1697,
-	-1000, -1000, -1000, 31954, 765, 765, 2542, 31954, 6276, -1000,
-	-1000, 7855, 1973, -1000, 7855, -1000, -1000, -1000, -1000, -1000,
-	1972, 2384, -1000, -1000, -1000, -1000, -1000, -1000, 7855, 7855,
-	-1000, -1000, 482, 9958, -289, 150, -1000, -1000, -1000, -270,
-	1696, -1000, -1000, 2519, 1695, 1644, 31954, -1000, 785, 785,
-	782, -1000, -1000, -68, -1000, -1000, -1000, 1905, -1000, 1892,
-	-26, -1000, 122, 7855, -270, -191, -1000, -1000, -1000, -1000,
-	257, -1000, -1000, 199, -1000, -1000, 1498, 395, -1000, -1000,
-	765, 22009, 18348, 17825, 1693, -1000, 33170, 12069, 126, 33170,
-	721, 1274, -1000, 1519, -1000, 1516, -1000, 2557, 914, 1019,
-	-1000, -1000, 1161, -1000, -1000, -1000, -1000, 3118, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 2016,
+	2014, 2054, 2777, 2009, 9348, -1000, -1000, 1335, 1329, 1317,
+	-1000, 1709, 8807, -1000, -1000, -1000, 2001, 1285, 1998, -1000,
+	-1000, -1000, 1996, 1313, 957, 1994, 1468, 1992, 1221, 9348,
+	9348, 1301, 1300, 9348, 9348, 9348, 9348, 1991, 9348, 9348,
+	9348, 9348, 9348, 9348, 9348, 9348, -26, -1000, -1000, 1297,
+	-1000, 1558, -1000, 1894, -1000, 1176, 1213, -1000, 1833, -1000,
+	-1000, 1879, 845, -1000, -1000, -1000, 824, 801, 807, 35898,
+	936, 13165, 35898, 2117, 2564, 35898, 135, -1000, 1145, -1000,
+	99, -132, 2402, 35898, 2660, 991, 2391, 2755, 35898, 35898,
+	35898, 2593, 32111, -1000, 2184, 1295, -1000, -1000, 9348, -1000,
+	-1000, 2386, 35898, 35898, 2745, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 35898, 2745, 2745, 1369, 1486, -1000, -1000, 1486,
+	-1000, -1000, 1486, -1000, 1280, -1000, 35898, -1000, -1000, -1000,
+	2117, 1210, -1000, -1000, -1000, 13706, 958, 574, 573, 572,
+	668, -1000, 1396, 1396, 967, 1396, 1396, 1396, 1396, 430,
+	418, 1396, 1396, 1396, 1396, 1396, 1396, 1396, 1396, 1396,
+	1396, 1396, 1396, 1396, 1396, 2183, 1396, 1396, -1000, 122,
+	2605, 225, 1145, 433, 245, 2799, 981, -1000, -1000, -1000,
+	-1000, 21824, 2182, 21824, 15871, 21824, -1000, 1345, -1000, -1000,
+	654, -1000, -1000, 991, -1000, -1000, -1000, 2180, 1439, -1000,
+	-1000, 12072, -1000, 6091, 6091, 6091, -1000, -1000, 22365, 35898,
+	-1000, -123, -1000, -106, -1000, 1153, -1000, -1000, 1163, 991,
+	2401, 1153, 1153, 35898, -1000, 13165, 35898, -1000, 2400, 35898,
+	-1000, 1107, -1000, -1000, 14788, 1279, 1107, 1467, -1000, 11523,
+	1699, 146, -1000, 180, -325, 143, 1619, 142, 1558, -1000,
+	-1000, 1987, 1985, 1290, -1000, 1282, 1984, 1281, 1276, -1000,
+	-80, -1000, 2540, 1011, -1000, 2176, -1000, 1273, 2477, -1000,
+	1144, -1000, 1436, 1271, -1000, 1011, 1270, 2468, 1144, -1000,
+	-1000, 1278, 53, -1000, 305, -1000, -1000, 35898, 1905, 1266,
+	31562, 1063, -1000, 649, 1268, 1264, -1000, 28857, 166, 28857,
+	-1000, 28857, -1000, -1000, 287, -1000, 35898, 2542, -1000, -1000,
+	-1000, 1879, -334, -1000, -1000, -1000, -1000, -1000, 1253, -1000,
+	585, 585, 3507, 2432, -1000, 10971, -1000, 10971, 2731, 1449,
+	-1000, 9348, 1680, 255, 406, 20742, 35898, -1000, -1000, 9348,
+	9348, -1000, 2726, -1000, -1000, -1000, -1000, 9348, 9348, 1820,
+	-1000, 35898, -1000, -1000, -1000, -1000, 20742, -1000, 10971, -1000,
+	9348, 1094, 2695, -26, -26, 2659, 2622, 2586, 1244, -26,
+	2524, 2507, 2495, 2479, 2413, 2390, 2360, 2326, -1000, 2169,
+	7715, -1000, -80, 9348, 9348, 9348, 2486, -1000, -1000, 94,
+	-1000, 1415, 766, -1000, -1000, -1000, 794, 152, 1981, 1112,
+	-1000, -1000, 35898, -1000, -1000, -1000, 1979, 1092, -1000, -1000,
+	-1000, 3589, 2132, 2132, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 2167, -1000, -1000, 2118, 2118, 2118, 2074,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 2159,
+	2159, 2166, 2159, -1000, 2591, -1000, 2587, -32, 1396, 451,
+	28857, 441, -1000, 35898, 35898, -126, 688, 2399, 331, 2504,
+	35898, 2157, 2154, 2153, 315, 3589, 35898, 1013, -1000, 1261,
+	3249, -1000, 35898, 1558, -1000, 1754, -1000, 1369, -1000, 2745,
+	1139, -1000, -1000, 2745, 1369, 1369, 1486, 35898, -1000, 2580,
+	3725, -1000, 2148, -1000, -1000, -1000, 3589, 578, -1000, 842,
+	617, 35898, 1551, 842, 1550, 2142, -1000, -1000, -1000, 35898,
+	35898, 35898, 35898, 1548, 1547, 31021, 35898, 35898, -1000, 35898,
+	1745, -1000, 1743, 1396, 1396, 1719, 1892, 1889, 1888, 1396,
+	1396, 1717, 1886, 30480, 1716, 1707, 1705, 1689, 1885, 669,
+	1688, 1685, 1667, 35898, 2141, 1822, 35898, 2139, -32, 1396,
+	217, 1401, 451, -1000, -1000, -1000, 540, 534, 177, 1704,
+	428, 1664, 18037, 35898, 21283, 21283, 21283, 21283, -1000, 2458,
+	2435, -1000, 2489, 2434, 2513, 35898, 21283, 2117, -1000, 30480,
+	-1000, -1000, 21824, -1000, 2333, 1238, 2465, 791, 9348, 28857,
+	1884, 404, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	35898, 35898, -1000, 1977, -1000, 35898, -1000, 2745, 8256, -1000,
+	21283, -1000, -1000, 29939, -1000, 29398, 2745, 1482, -319, 11523,
+	1471, 1390, -1000, 9348, 11523, 9348, -300, 206, -303, -1000,
+	-1000, -1000, 1881, -1000, -1000, -1000, 1698, -1000, 1690, -6,
+	13, 1545, -281, 7715, 288, 35898, -281, 35898, 7715, -1000,
+	35898, 277, -281, 35898, 1687, -1000, -1000, -1000, -1000, 2750,
+	28857, 1754, 1349, 28316, -1000, 163, -1000, 170, 450, 27775,
+	-1000, 683, 92, 1879, -1000, -1000, -1000, 10971, -1000, -1000,
+	-1000, -1000, 1558, 9348, 1973, -1000, 792, 792, 1972, -1000,
+	2132, 2132, -1000, 2074, 2118, 2074, 792, 792, 1966, -1000,
+	1816, 2304, -1000, 2285, 2170, 9348, -1000, 1964, 3453, 1110,
+	-145, -26, -1000, -1000, -1000, -26, -26, -26, -26, -1000,
+	-26, -26, -26, -26, -26, -26, -26, -26, 570, -1000,
+	-6, 1558, 1558, -1000, -1000, 2488, -1000, 1879, 1877, 1869,
+	-1000, 1868, 1867, 936, 3589, 701, 13165, 2510, 293, 293,
+	1752, -1000, -1000, 27234, 429, -1000, -1000, 35898, 239, 2641,
+	35898, 35898, 35898, -1000, -1000, -1000, -1000, -1000, 2504, -1000,
+	930, 296, 14247, 14247, 14247, 303, 1520, -1000, 563, 1114,
+	1259, 21283, 35898, -1000, 26693, 1963, -1000, 991, 2745, -1000,
+	35898, -1000, 2745, 2745, 1369, -1000, 293, -1000, 14247, 1022,
+	530, 3429, -1000, 35898, -1000, 35898, -1000, 35898, 35898, 617,
+	9348, 1071, -1000, -1000, -1000, 35898, -1000, 1071, -1000, -213,
+	1055, 268, -1000, -1000, 20742, 20742, -1000, -1000, -1000, -1000,
+	1865, 1861, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1509, 1969, -88, -1000, -1000, 1968, 22009, 22009, 334,
-	334, 22009, 22009, 1967, 504, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 2479, -1000, -1000, 1988, 31954, 1988, 22532,
-	-1000, 2517, 2512, 1988, 752, -1000, -309, 31954, 31954, -272,
-	1507, -1000, 1692, 3, -1000, -1000, 759, -274, -17, 11,
-	-1000, -1000, -1000, 1825, -1000, 3137, -1000, -1000, -1000, 752,
-	-272, 31954, 369, 1667, -1000, -1000, 172, -1000, -1000, 1021,
-	-1000, 1965, 7855, -1000, -1000, -1000, 445, 33574, -1000, -1000,
-	-1000, -68, 445, 396, 200, -1000, 1496, -1000, -1000, 2542,
-	-1000, 1754, 7855, 1961, -187, 22009, 1017, 1016, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 1006, 998, 22009, -1000, -1000,
-	-1000, 323, -1000, 977, 973, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1960, -1000, -1000, 2511, -1000, 1666, 502, 0,
-	11, -1000, 2507, -1, 2506, 2500, -1000, -1000, 4183, -264,
-	-25, 316, -1000, 2373, -1000, -1000, 40, -1000, -1000, -1000,
-	22009, 2363, 1803, 266, 2494, 33170, -1000, -1000, 266, -1000,
-	244, -1000, 1262, -1000, 1471, -1000, 2479, -1000, 1546, -1000,
-	2238, -1000, 103, 964, -1000, -1000, -1000, -1000, 943, -1000,
-	-1000, -1000, 21486, 31954, 1644, -1000, 1958, 1469, 18, -11,
-	2491, -1000, 1644, 2487, 1644, 1644, 1363, -1000, -1000, -1000,
-	-1000, -1000, 1664, -1000, 210, -1000, -1000, 2363, -1000, 2485,
-	342, -1000, -1000, -1000, -1000, 1455, -1000, -1000, 504, -1000,
-	2237, 2132, 2561, -1000, -1000, -1000, -1000, 210, 210, 210,
-	210, 129, -1000, -1000, 938, -1000, -1000, 2415, 17302, -8,
-	-1000, -1000, -1000, 1645, -1000, 1644, -1000, -1000, 4183, -1000,
-	-1000, 1232, 1643, 187, -1000, -1000, -1000, 20963, 279, 302,
-	274, -1000, 443, -1000, -1000, -1000, -1000, 2563, -1000, 2576,
-	652, 652, -1000, -1000, 31954, -1000, 31954, -1000, 884, -1000,
-	-1000, -1000, 1008, -1000, -1000, -1000, -1000, -1000, 1437, -1000,
-	31954, -1000, 31954, 282, 1436, 9424, 1952, 9424, 1950, 288,
-	1948, -1000, -1000, -1000, 1467, 331, -1000, -1000, 736, -1000,
-	1226, -1000, 20440, 31954, -1000, -1000, 882, 1947, 2481, -1000,
-	2843, 31954, 2244, 31954, 1944, 1193, 9424, -1000, -1000, -1000,
-	31954, 5753, -1000, 757, -1000, -1000, 437, 283, -1000, 881,
-	-1000, 879, 19917, 1422, 1708, -1000, -1000, 1988, 31954, 873,
-	-1000, 31954, 272, -1000, -1000, -1000, 845, -1000, -1000, -1000,
-	-1000, 437, 2303, -1000, 1405, -1000, -1000, 33460, 570, -1000,
-	-1000, 33460, 277, -1000, 399, 1915, -1000, -1000, 839, -1000,
-	31954, 590, 7855, -1000, 277, 33170, -1000, 7855, 818, -1000,
-	33170, 800, -1000, -1000,
+	-1000, -1000, -1000, -1000, 435, 35898, 1237, -1000, 1400, 1341,
+	35898, 1752, 27234, 1399, 1860, 429, -1000, -1000, 1684, -1000,
+	-1000, 1638, 1291, 103, -1000, -1000, -1000, -1000, -1000, 1851,
+	-1000, 984, 35898, 35898, -1000, 1052, -1000, 1398, 2380, 2398,
+	2380, -1000, -1000, -1000, -1000, 2423, -1000, 2420, -1000, -1000,
+	1052, -1000, -1000, -1000, 2117, -1000, -1000, 791, -1000, 2634,
+	842, 842, 842, 1959, 1063, 1954, -1000, -1000, -1000, -1000,
+	-1000, -1000, 2740, -1000, 1062, -1000, -1000, 1258, -1000, 2740,
+	-1000, -319, 1448, -1000, 1656, 136, 1530, 35898, -1000, -1000,
+	-1000, 1942, 1940, -286, 43, 2727, 2724, 2786, -1000, 1938,
+	1028, -281, -1000, -1000, 1011, -1000, -1000, -1000, -281, -1000,
+	1011, -1000, -1000, 1754, -1000, 157, -1000, -1000, -1000, -1000,
+	-1000, -1000, 76, -1000, 2723, 35898, -1000, 90, -1000, 1558,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 9348, -1000, -1000, -1000, 2137,
+	-1000, -1000, 9348, 1935, 1849, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 2737, -1000,
+	2722, -286, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 3589, -1000, 1451, -1000, -1000, -1000, 1846, 89, -1000,
+	-1000, -1000, 1845, 1844, -1000, -1000, 1664, 35898, 623, 2627,
+	1032, 1031, 2116, -1000, 1396, 1396, 1396, 35898, 1929, 1014,
+	-1000, 1910, 1883, 569, 1417, 1416, -1000, 1682, 21824, 21283,
+	26693, 1003, -1000, 1249, -1000, -1000, -1000, 2745, -1000, -1000,
+	2745, -1000, 1229, -1000, 35898, -1000, 3429, -1000, -1000, 1562,
+	10971, -1000, -1000, 1830, 20201, 627, 798, 2112, -1000, 364,
+	2785, -1000, 1540, 1491, -1000, 35898, -1000, 2111, -1000, 2107,
+	2383, 329, 2103, 2102, 35898, 2119, -1000, 676, 2100, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 446, 1226, -1000,
+	1822, 1821, 1277, 1222, -1000, 89, 1817, -1000, -1000, 233,
+	-1000, -1000, 1629, 465, -1000, -1000, 35898, 984, 984, 2737,
+	35898, 7715, -1000, -1000, 9348, 2098, -1000, 9348, -1000, -1000,
+	-1000, -1000, -1000, -1000, 2091, 2579, -1000, -1000, -1000, -1000,
+	-1000, 2732, 2721, 26152, 2732, 599, 11523, -304, 199, -1000,
+	-1000, -1000, -288, 1812, -1000, -1000, 2720, 1810, 1776, 35898,
+	-1000, 1011, 1011, 991, -1000, -1000, 1808, -66, -1000, 2096,
+	-1000, 2067, -26, -1000, 115, 9348, -288, -182, -1000, -1000,
+	-1000, -1000, 275, -1000, -1000, -1000, 984, 1754, -1000, -1000,
+	-1000, -1000, -1000, 91, 1806, 25070, 20742, 20201, 1803, -1000,
+	4015, 14247, 173, 4015, 864, 1405, -1000, 1681, -1000, 1679,
+	-1000, 2745, 1003, 1249, -1000, -1000, 1309, -1000, -1000, -1000,
+	530, 2076, -1000, -1000, 3507, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1678, 2088,
+	-89, -1000, -1000, 2087, 25070, 25070, 594, 594, 25070, 25070,
+	2086, 568, 35898, 530, -1000, -1000, -1000, -1000, -1000, 1672,
+	-1000, -1000, -1000, -1000, -1000, 224, -1000, -1000, -1000, 2677,
+	-1000, -1000, 1558, 35898, 1558, 25611, -1000, 2716, 2711, -1000,
+	9348, 9348, -1000, -1000, -1000, -319, 35898, 35898, -290, 1655,
+	-1000, 1785, 45, -1000, -1000, 934, -1000, -293, -14, 30,
+	-1000, -1000, -1000, 1825, -1000, 4468, -1000, -1000, -1000, 931,
+	-290, 35898, 444, 1784, 952, 35898, 271, 1207, -1000, 2074,
+	9348, -1000, -1000, -1000, 535, 3924, -1000, -1000, -1000, -66,
+	535, 461, 208, -1000, 1653, -1000, -1000, 2737, -1000, -1000,
+	3855, 650, 1714, 9348, 2070, -178, 25070, 1205, 1198, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1197, 1188, 25070, -1000,
+	-1000, -1000, 358, -10, 1185, 186, -1000, -1000, 1175, 1170,
+	-1000, -1000, -1000, -1000, 1558, 931, -1000, -1000, -1000, 2069,
+	-1000, -1000, 2707, -1000, 1782, 631, 41, 30, -1000, 2705,
+	44, 2703, 2702, -1000, -1000, 5550, -282, -23, 405, -1000,
+	2578, -1000, -1000, 73, -1000, 2066, 924, -1000, 1396, -1000,
+	25070, 2550, 1924, 332, 2701, 4015, -1000, -1000, 332, -1000,
+	311, -1000, 1404, -1000, 1652, -1000, 2677, -1000, 3855, 341,
+	-1000, 522, 2059, -1000, 1920, -1000, 2396, -1000, 96, 1169,
+	-1000, -1000, -1000, -1000, 1165, -1000, -1000, 286, 285, -1000,
+	-1000, -1000, -1000, 24529, 35898, 1776, -1000, 2057, 1650, 43,
+	1, 2700, -1000, 1776, 2699, 1776, 1776, 1504, -1000, -1000,
+	-1000, -1000, -1000, 1780, -1000, 9348, 1779, 221, -1000, -1000,
+	2550, -1000, 2693, 422, -1000, -1000, -1000, -1000, 1625, -1000,
+	-1000, -1000, -1000, 1150, -1000, 35898, 721, 9348, 568, -1000,
+	2336, 2288, 2771, -1000, -1000, -1000, -1000, 221, 221, 221,
+	221, 160, -1000, -1000, -1000, -1000, 1149, -1000, -1000, 2583,
+	19660, 23, -1000, -1000, -1000, 1778, -1000, 1776, -1000, -1000,
+	5550, -1000, 1878, -1000, -1000, 1396, 1706, 260, -1000, -1000,
+	-1000, 23988, 343, 327, 295, -1000, 512, -1000, -1000, -1000,
+	341, 4015, -1000, 9348, 1106, -1000, -1000, 2783, -1000, 2772,
+	622, 622, -1000, -1000, 35898, -1000, 35898, -1000, 1093, -1000,
+	-1000, -1000, 1246, -1000, -1000, -1000, -1000, -1000, -1000, 1624,
+	-1000, 35898, -1000, 35898, 2056, 333, 1616, 10971, 2049, 10971,
+	2039, 352, 2038, -1000, 4015, 1077, -1000, -1000, -1000, -1000,
+	1661, 377, -1000, -1000, 869, -1000, 1393, -1000, 23447, 35898,
+	-1000, -1000, 1075, 35898, 2037, 2686, -1000, 3324, 35898, 3056,
+	35898, 1926, 1387, 10971, -1000, -1000, -1000, -1000, 35898, 7174,
+	-1000, 937, -1000, 1058, -1000, 530, 347, -1000, 1016, -1000,
+	1010, 22906, 1596, 2373, -1000, -1000, 1558, 35898, -1000, 997,
+	335, -1000, -1000, -1000, 969, -1000, -1000, -1000, -1000, -1000,
+	1568, -1000, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 132, 2612, 212, 135, 3119, 59, 207, 173, 171,
-	206, 3117, 3115, 2335, 2331, 3114, 3113, 3111, 3110, 3109,
-	3107, 3106, 3105, 3104, 3103, 3102, 3101, 3100, 3099, 3079,
-	3078, 3077, 3076, 3075, 3074, 3072, 3071, 3070, 3069, 202,
-	3068, 3066, 3065, 3064, 3062, 3060, 3059, 3058, 3057, 3056,
-	3055, 3053, 3052, 3051, 3050, 3049, 3048, 3045, 3044, 3043,
-	3042, 3041, 3038, 3036, 3033, 3032, 3031, 3030, 3028, 167,
-	3027, 2325, 3026, 3025, 3024, 3010, 3005, 3003, 3002, 197,
-	3001, 3000, 2999, 2998, 2997, 2996, 2995, 2994, 2993, 2990,
-	189, 2988, 2987, 2986, 2985, 2984, 2979, 178, 2978, 129,
-	157, 2977, 2976, 2975, 2974, 2973, 205, 179, 46, 2959,
-	37, 2958, 180, 2957, 115, 2956, 112, 2954, 2953, 2952,
-	2945, 2944, 2942, 2941, 2938, 2937, 2936, 2935, 2934, 73,
-	2931, 2926, 133, 156, 220, 1093, 217, 216, 151, 128,
-	70, 2922, 2344, 2920, 155, 194, 127, 23, 2919, 139,
-	2918, 116, 31, 25, 211, 103, 40, 118, 89, 2917,
-	186, 71, 2916, 81, 2913, 2912, 209, 150, 2908, 90,
-	2906, 2904, 2903, 2902, 159, 147, 2900, 2899, 98, 2898,
-	2897, 101, 2895, 49, 2894, 131, 2893, 742, 86, 92,
-	2890, 2888, 2885, 114, 2884, 2880, 2879, 2878, 143, 2877,
-	2876, 110, 65, 2875, 2874, 2873, 44, 2871, 69, 2869,
-	52, 2868, 2867, 2863, 2860, 53, 2859, 2858, 14, 20,
-	22, 2856, 17, 2840, 125, 2839, 2838, 2837, 2, 2836,
-	181, 109, 77, 113, 2835, 357, 2834, 2832, 2830, 119,
-	2829, 550, 2828, 2825, 2824, 2823, 2822, 63, 2821, 170,
-	36, 2817, 75, 93, 88, 158, 160, 2816, 2814, 2813,
-	248, 68, 47, 0, 2812, 121, 2811, 2810, 2809, 222,
-	2808, 193, 175, 199, 187, 213, 134, 2807, 2806, 67,
-	2804, 122, 72, 104, 19, 2803, 192, 2802, 327, 148,
-	2800, 165, 2799, 305, 1, 111, 2797, 2796, 34, 218,
-	2795, 2794, 2793, 91, 2792, 2790, 138, 94, 2788, 2786,
-	2785, 33, 2784, 29, 27, 2781, 96, 2778, 203, 2777,
-	142, 99, 144, 140, 120, 182, 198, 45, 54, 2775,
-	1292, 117, 76, 21, 2774, 188, 2773, 221, 191, 2772,
-	153, 2770, 201, 320, 163, 2769, 145, 8, 35, 28,
-	2768, 9, 2767, 102, 161, 2766, 2765, 16, 2764, 18,
-	2763, 2761, 2760, 2758, 5, 2757, 2753, 2752, 4, 7,
-	2749, 3, 166, 2747, 2746, 2741, 2739, 38, 184, 2738,
-	95, 196, 2737, 2736, 74, 2735, 2734, 2733, 856, 2731,
-	2730, 2729, 2728, 2726, 2725, 2722, 2721, 2719, 2718, 78,
-	43, 2717, 2716, 2714, 2713, 64, 107, 2712, 2707, 2706,
-	2705, 30, 141, 2704, 15, 2703, 26, 24, 32, 2700,
-	100, 2699, 12, 146, 2697, 2696, 13, 2694, 2693, 10,
-	11, 2692, 2690, 84, 2687, 66, 41, 123, 79, 2677,
-	62, 169, 105, 2670, 2667, 195, 200, 162, 2663, 87,
-	204, 215, 2662, 168, 2660, 2657, 2656, 2655, 2654, 2653,
-	857, 2652, 2651, 210, 42, 60, 83, 2635, 2634, 2633,
-	55, 124, 85, 82, 164, 2632, 154, 2631, 2630, 80,
-	2629, 2626, 2624, 2623, 2622, 152, 2619, 2616, 2614, 2610,
-	208, 137, 2601,
+	0, 136, 2803, 221, 139, 3346, 56, 218, 174, 152,
+	217, 3344, 3343, 2545, 2477, 3342, 3341, 3340, 3338, 3337,
+	3336, 3334, 3333, 3332, 3331, 3330, 3328, 3327, 3326, 3325,
+	3324, 3323, 3322, 3316, 3315, 3314, 3313, 3311, 3309, 213,
+	3308, 3305, 3304, 3303, 3302, 3301, 3300, 3299, 3298, 3297,
+	3296, 3294, 3293, 3292, 3291, 3285, 3284, 3282, 3281, 3280,
+	3276, 3275, 3274, 3273, 3272, 3270, 3269, 3268, 3267, 150,
+	3265, 2475, 3264, 3260, 3258, 3257, 3256, 3254, 3253, 178,
+	3252, 3231, 3228, 3227, 3226, 3225, 3221, 3220, 3219, 3218,
+	168, 3217, 3216, 3215, 3214, 3213, 3211, 156, 3210, 133,
+	179, 3209, 3208, 3207, 3206, 3205, 3203, 176, 3199, 207,
+	200, 49, 3198, 38, 3197, 185, 3196, 117, 3195, 115,
+	3194, 3193, 3192, 3191, 3189, 3175, 3173, 3171, 3170, 3168,
+	3167, 3166, 72, 3165, 3163, 3162, 3161, 3160, 3159, 3158,
+	3157, 3156, 3155, 3154, 3152, 3150, 3148, 3147, 132, 159,
+	227, 2362, 235, 197, 116, 138, 95, 3146, 2512, 3145,
+	125, 198, 123, 20, 3143, 144, 3142, 130, 35, 26,
+	211, 103, 39, 90, 99, 203, 188, 73, 3141, 83,
+	3140, 3139, 226, 153, 3138, 94, 3131, 3130, 3129, 3128,
+	167, 149, 3127, 3126, 93, 3122, 3121, 113, 3119, 52,
+	3118, 121, 3117, 234, 87, 88, 3115, 3113, 3112, 75,
+	3111, 3109, 3108, 3105, 142, 3104, 3097, 100, 71, 3094,
+	3089, 3085, 47, 3083, 50, 3082, 61, 3081, 3080, 3079,
+	3078, 51, 3077, 3074, 12, 18, 21, 3070, 17, 3063,
+	127, 3062, 3057, 3055, 3054, 11, 3053, 151, 45, 54,
+	78, 3052, 353, 158, 3050, 3049, 124, 3048, 511, 3047,
+	3046, 3041, 3039, 3030, 80, 3029, 193, 40, 3028, 104,
+	102, 91, 170, 169, 3026, 3025, 3023, 107, 92, 111,
+	0, 3021, 118, 3014, 3011, 3010, 225, 3009, 205, 166,
+	201, 210, 222, 195, 3008, 3007, 70, 160, 126, 77,
+	101, 2, 3006, 146, 3005, 855, 148, 3001, 172, 2999,
+	122, 13, 110, 2998, 2997, 33, 228, 2996, 2993, 2991,
+	97, 2990, 2989, 154, 89, 2988, 2987, 2986, 34, 2985,
+	30, 22, 2984, 48, 2983, 219, 2982, 184, 106, 147,
+	141, 119, 186, 199, 62, 53, 2980, 1455, 120, 76,
+	19, 2977, 194, 2976, 224, 204, 2975, 165, 2974, 215,
+	329, 180, 2973, 155, 4, 36, 28, 2969, 3, 2967,
+	190, 171, 2965, 2964, 14, 2963, 24, 2962, 2960, 2958,
+	2957, 2956, 43, 2955, 1, 2954, 10, 15, 2953, 27,
+	175, 2952, 2950, 2949, 2948, 37, 131, 2947, 108, 145,
+	2945, 2944, 74, 2943, 2942, 2941, 1627, 2940, 2939, 2938,
+	2936, 2931, 2929, 2928, 2927, 2926, 2925, 79, 44, 2921,
+	2920, 2918, 2917, 59, 112, 2912, 2911, 2909, 2908, 29,
+	143, 2902, 16, 2900, 25, 23, 31, 2898, 98, 2896,
+	7, 163, 2895, 2894, 8, 2893, 2892, 5, 9, 2889,
+	2888, 96, 2887, 69, 41, 135, 81, 2886, 60, 183,
+	109, 2885, 2883, 208, 32, 173, 2881, 300, 206, 223,
+	2880, 181, 2879, 2878, 2876, 2874, 2873, 182, 2872, 947,
+	2871, 2870, 209, 46, 67, 86, 2869, 2866, 2857, 66,
+	129, 85, 84, 177, 2851, 162, 2850, 2842, 82, 2840,
+	2836, 2830, 2816, 2815, 157, 2814, 2812, 2811, 2810, 237,
+	161, 2806,
 }
 
-//line mysql_sql.y:9376
+//line mysql_sql.y:9752
 type yySymType struct {
 	union interface{}
 	id    int
@@ -5350,6 +5725,11 @@ func (st *yySymType) authRecordUnion() *tree.AuthRecord {
 	return v
 }
 
+func (st *yySymType) backupTargetUnion() tree.BackupTarget {
+	v, _ := st.union.(tree.BackupTarget)
+	return v
+}
+
 func (st *yySymType) boolValUnion() bool {
 	v, _ := st.union.(bool)
 	return v
@@ -5685,6 +6065,11 @@ func (st *yySymType) objectTypeUnion() tree.ObjectType {
 	return v
 }
 
+func (st *yySymType) optimizerHintsUnion() *tree.OptimizerHints {
+	v, _ := st.union.(*tree.OptimizerHints)
+	return v
+}
+
 func (st *yySymType) orderUnion() *tree.Order {
 	v, _ := st.union.(*tree.Order)
 	return v
@@ -6121,177 +6506,184 @@ func (st *yySymType) zeroFillOptUnion() bool {
 }
 
 var yyR1 = [...]int{
-	0, 484, 488, 488, 5, 5, 2, 6, 6, 3,
+	0, 503, 507, 507, 5, 5, 2, 6, 6, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	1, 1, 1, 1, 4, 4, 4, 4, 4, 4,
 	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
 	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
-	4, 4, 104, 482, 482, 482, 483, 483, 101, 121,
-	120, 123, 123, 122, 122, 119, 119, 115, 118, 118,
-	117, 117, 116, 111, 113, 113, 112, 114, 114, 102,
-	90, 103, 432, 432, 431, 431, 430, 430, 385, 385,
-	429, 429, 429, 428, 428, 428, 427, 427, 426, 426,
-	425, 425, 423, 423, 424, 422, 421, 421, 421, 419,
-	419, 419, 415, 415, 417, 416, 416, 418, 410, 410,
-	413, 413, 411, 411, 411, 411, 414, 409, 409, 409,
-	408, 408, 89, 89, 89, 332, 332, 88, 88, 346,
-	346, 346, 346, 346, 344, 344, 344, 344, 344, 344,
-	343, 343, 342, 342, 347, 347, 345, 345, 345, 345,
-	345, 345, 345, 345, 345, 345, 345, 345, 345, 345,
-	345, 345, 345, 345, 345, 345, 345, 345, 345, 345,
-	345, 345, 345, 345, 345, 345, 345, 345, 345, 345,
-	345, 345, 345, 345, 345, 345, 345, 345, 345, 345,
-	345, 345, 345, 345, 345, 345, 80, 80, 80, 80,
-	83, 83, 83, 84, 341, 341, 341, 81, 82, 82,
-	331, 331, 336, 336, 335, 335, 335, 335, 335, 335,
-	335, 335, 335, 335, 335, 335, 340, 340, 340, 338,
-	338, 337, 337, 339, 339, 74, 74, 74, 77, 76,
-	330, 330, 330, 330, 330, 330, 330, 330, 330, 75,
-	75, 75, 75, 75, 75, 70, 70, 70, 70, 70,
-	69, 69, 71, 71, 328, 328, 327, 85, 85, 86,
-	486, 486, 485, 487, 487, 487, 487, 87, 93, 93,
-	93, 93, 93, 93, 93, 92, 92, 95, 95, 94,
-	96, 79, 79, 79, 79, 79, 79, 78, 78, 78,
-	78, 78, 78, 78, 78, 78, 456, 456, 456, 458,
-	458, 267, 268, 489, 270, 266, 266, 266, 452, 452,
-	453, 454, 455, 455, 455, 91, 11, 11, 11, 11,
-	11, 11, 68, 73, 223, 223, 224, 224, 224, 224,
-	225, 225, 225, 225, 225, 226, 227, 227, 66, 72,
-	72, 469, 469, 67, 476, 476, 388, 388, 281, 281,
-	280, 280, 280, 280, 280, 280, 280, 280, 280, 280,
-	280, 280, 280, 280, 280, 280, 392, 393, 277, 39,
+	4, 4, 4, 4, 4, 105, 106, 106, 107, 107,
+	104, 501, 501, 501, 502, 502, 101, 124, 123, 126,
+	126, 125, 125, 122, 122, 118, 121, 121, 120, 120,
+	119, 114, 116, 116, 115, 117, 117, 102, 90, 103,
+	450, 450, 449, 449, 448, 448, 403, 403, 447, 447,
+	447, 446, 446, 446, 445, 445, 444, 444, 443, 443,
+	441, 441, 442, 440, 439, 439, 439, 437, 437, 437,
+	433, 433, 435, 434, 434, 436, 428, 428, 431, 431,
+	429, 429, 429, 429, 432, 427, 427, 427, 426, 426,
+	89, 89, 89, 349, 349, 88, 88, 363, 363, 363,
+	363, 363, 361, 361, 361, 361, 361, 361, 360, 360,
+	359, 359, 364, 364, 362, 362, 362, 362, 362, 362,
+	362, 362, 362, 362, 362, 362, 362, 362, 362, 362,
+	362, 362, 362, 362, 362, 362, 362, 362, 362, 362,
+	362, 362, 362, 362, 362, 362, 362, 362, 362, 362,
+	362, 362, 362, 362, 362, 362, 362, 362, 362, 362,
+	362, 362, 362, 362, 80, 80, 80, 80, 83, 83,
+	83, 84, 358, 358, 358, 81, 82, 82, 348, 348,
+	353, 353, 352, 352, 352, 352, 352, 352, 352, 352,
+	352, 352, 352, 352, 357, 357, 357, 355, 355, 354,
+	354, 356, 356, 74, 74, 74, 77, 76, 347, 347,
+	347, 347, 347, 347, 347, 347, 347, 75, 75, 75,
+	75, 75, 75, 70, 70, 70, 70, 70, 69, 69,
+	71, 71, 345, 345, 344, 85, 85, 86, 505, 505,
+	504, 506, 506, 506, 506, 87, 93, 93, 93, 93,
+	93, 93, 93, 92, 92, 95, 95, 94, 96, 79,
+	79, 79, 79, 79, 79, 78, 78, 78, 78, 78,
+	78, 78, 78, 78, 78, 78, 474, 474, 474, 477,
+	477, 284, 285, 508, 287, 283, 283, 283, 470, 470,
+	471, 472, 473, 473, 473, 91, 91, 11, 11, 11,
+	11, 11, 11, 68, 73, 73, 73, 239, 239, 240,
+	240, 240, 240, 243, 243, 243, 243, 243, 243, 381,
+	381, 381, 241, 241, 241, 241, 241, 242, 244, 244,
+	66, 72, 72, 488, 488, 67, 67, 495, 495, 406,
+	406, 298, 298, 297, 297, 297, 297, 297, 297, 297,
+	297, 297, 297, 297, 297, 297, 297, 297, 297, 297,
+	297, 410, 411, 294, 39, 39, 39, 39, 39, 39,
 	39, 39, 39, 39, 39, 39, 39, 39, 39, 39,
 	39, 39, 39, 39, 39, 39, 39, 39, 39, 39,
-	39, 39, 39, 39, 39, 39, 46, 45, 45, 45,
-	317, 317, 44, 490, 490, 256, 256, 55, 48, 56,
-	57, 58, 59, 60, 61, 43, 54, 54, 54, 54,
-	54, 54, 54, 54, 54, 64, 64, 404, 404, 492,
-	492, 492, 62, 63, 387, 387, 387, 53, 52, 51,
-	50, 49, 49, 42, 42, 41, 41, 47, 127, 128,
-	274, 274, 274, 276, 276, 272, 491, 491, 359, 359,
-	275, 275, 40, 40, 40, 40, 65, 273, 273, 255,
-	271, 271, 271, 12, 12, 10, 15, 15, 15, 15,
-	15, 15, 15, 15, 15, 15, 15, 15, 23, 24,
-	26, 325, 325, 322, 25, 18, 17, 20, 16, 19,
-	21, 22, 22, 9, 9, 9, 9, 13, 13, 14,
-	139, 139, 188, 188, 464, 464, 460, 460, 461, 461,
-	461, 462, 462, 463, 463, 97, 398, 398, 398, 398,
-	398, 398, 8, 162, 162, 161, 161, 397, 397, 397,
-	397, 397, 397, 329, 329, 441, 441, 441, 442, 160,
-	160, 155, 155, 399, 399, 295, 443, 443, 407, 407,
-	406, 406, 405, 405, 158, 158, 159, 159, 142, 142,
-	107, 107, 412, 412, 412, 412, 420, 420, 384, 384,
-	215, 215, 250, 250, 251, 251, 132, 132, 133, 133,
-	133, 133, 133, 133, 449, 449, 451, 451, 450, 157,
-	157, 153, 153, 154, 154, 154, 152, 152, 151, 150,
-	150, 149, 147, 147, 147, 148, 148, 148, 135, 135,
-	135, 134, 134, 134, 134, 134, 235, 235, 235, 235,
-	235, 235, 235, 235, 235, 235, 235, 235, 136, 136,
-	457, 457, 457, 389, 389, 389, 395, 395, 232, 232,
-	233, 233, 231, 231, 137, 137, 138, 138, 138, 138,
-	230, 230, 229, 140, 140, 146, 145, 145, 141, 141,
-	141, 141, 240, 240, 239, 239, 239, 239, 100, 105,
-	105, 106, 165, 165, 238, 237, 237, 237, 164, 164,
-	163, 163, 156, 156, 144, 144, 144, 144, 236, 143,
-	234, 481, 481, 480, 480, 479, 477, 477, 477, 478,
-	478, 478, 478, 434, 434, 434, 434, 434, 261, 261,
-	261, 265, 265, 264, 264, 264, 264, 264, 269, 7,
-	7, 7, 7, 7, 30, 30, 30, 30, 30, 30,
-	30, 30, 36, 173, 174, 37, 175, 175, 176, 176,
-	177, 177, 178, 179, 180, 180, 180, 180, 35, 166,
-	166, 167, 167, 168, 168, 169, 170, 170, 170, 172,
-	171, 34, 27, 465, 468, 466, 466, 470, 470, 470,
-	471, 471, 471, 472, 472, 28, 124, 129, 129, 126,
-	131, 131, 131, 131, 131, 125, 467, 473, 473, 473,
-	326, 326, 323, 324, 324, 321, 320, 320, 320, 475,
-	475, 474, 474, 474, 262, 262, 29, 316, 316, 318,
-	319, 319, 319, 310, 310, 310, 310, 33, 314, 314,
-	315, 315, 315, 315, 315, 311, 311, 313, 313, 309,
-	309, 309, 309, 309, 32, 130, 130, 308, 308, 306,
-	306, 304, 304, 305, 305, 303, 303, 303, 307, 307,
-	31, 31, 31, 109, 108, 108, 108, 253, 253, 252,
-	252, 110, 38, 200, 200, 373, 373, 373, 373, 373,
-	391, 391, 391, 374, 374, 374, 375, 375, 375, 376,
-	376, 376, 376, 376, 390, 390, 348, 348, 349, 349,
-	349, 352, 352, 365, 365, 366, 366, 364, 364, 371,
-	371, 370, 370, 369, 369, 368, 368, 367, 367, 367,
-	367, 362, 362, 361, 361, 350, 350, 350, 350, 350,
-	351, 351, 351, 360, 360, 363, 363, 206, 206, 207,
-	207, 207, 228, 228, 228, 228, 228, 228, 228, 228,
-	228, 228, 228, 228, 228, 228, 228, 228, 228, 228,
-	228, 228, 228, 228, 228, 228, 228, 228, 228, 228,
-	228, 439, 439, 440, 209, 209, 209, 213, 213, 213,
-	213, 213, 213, 208, 208, 210, 210, 189, 189, 187,
-	187, 181, 181, 182, 182, 183, 183, 183, 186, 186,
-	184, 184, 185, 185, 185, 185, 334, 334, 437, 437,
-	438, 438, 433, 433, 433, 436, 436, 436, 436, 436,
-	435, 435, 190, 248, 248, 248, 263, 263, 263, 263,
-	247, 247, 247, 205, 205, 204, 204, 202, 202, 202,
-	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
-	202, 202, 333, 333, 278, 278, 279, 279, 222, 221,
-	221, 221, 221, 221, 219, 220, 218, 218, 218, 218,
-	218, 217, 217, 216, 216, 216, 312, 312, 214, 214,
-	212, 212, 212, 211, 211, 211, 372, 284, 284, 284,
-	284, 284, 284, 284, 284, 284, 284, 284, 284, 284,
-	286, 286, 286, 286, 286, 286, 286, 286, 286, 286,
-	286, 286, 286, 286, 286, 286, 286, 286, 286, 286,
-	286, 246, 287, 287, 292, 292, 448, 448, 447, 191,
-	191, 191, 192, 192, 192, 192, 192, 192, 192, 192,
-	192, 201, 201, 201, 357, 357, 357, 357, 357, 358,
-	358, 358, 355, 355, 356, 356, 296, 297, 297, 396,
-	396, 353, 353, 354, 245, 245, 245, 245, 245, 245,
+	39, 39, 46, 45, 45, 45, 334, 334, 44, 509,
+	509, 273, 273, 55, 48, 56, 57, 58, 59, 60,
+	61, 43, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 64, 64, 422, 422, 511, 511, 511, 62, 63,
+	405, 405, 405, 53, 52, 51, 50, 49, 49, 42,
+	42, 41, 41, 47, 130, 139, 131, 291, 291, 291,
+	293, 293, 289, 510, 510, 376, 376, 292, 292, 40,
+	40, 40, 40, 65, 290, 290, 272, 288, 288, 288,
+	12, 12, 10, 15, 15, 15, 15, 15, 15, 15,
+	15, 15, 15, 15, 15, 15, 15, 15, 15, 23,
+	24, 26, 342, 342, 339, 25, 18, 17, 20, 142,
+	16, 19, 21, 22, 22, 9, 9, 9, 9, 13,
+	13, 14, 155, 155, 204, 204, 483, 483, 479, 479,
+	480, 480, 480, 481, 481, 482, 482, 97, 416, 416,
+	416, 416, 416, 416, 8, 178, 178, 177, 177, 415,
+	415, 415, 415, 415, 415, 346, 346, 459, 459, 459,
+	460, 176, 176, 171, 171, 417, 417, 312, 461, 461,
+	425, 425, 424, 424, 423, 423, 174, 174, 175, 175,
+	158, 158, 110, 110, 430, 430, 430, 430, 438, 438,
+	402, 402, 231, 231, 267, 267, 268, 268, 148, 148,
+	149, 149, 149, 149, 149, 149, 467, 467, 469, 469,
+	468, 173, 173, 169, 169, 170, 170, 170, 168, 168,
+	167, 166, 166, 165, 163, 163, 163, 164, 164, 164,
+	151, 151, 151, 150, 150, 150, 150, 150, 252, 252,
+	252, 252, 252, 252, 252, 252, 252, 252, 252, 252,
+	152, 152, 476, 476, 475, 475, 475, 407, 407, 407,
+	413, 413, 249, 249, 250, 250, 248, 248, 153, 153,
+	154, 154, 154, 154, 247, 247, 246, 156, 156, 162,
+	161, 161, 157, 157, 157, 157, 257, 257, 256, 256,
+	256, 256, 100, 108, 108, 109, 181, 181, 255, 254,
+	254, 254, 180, 180, 179, 179, 172, 172, 160, 160,
+	160, 160, 160, 253, 159, 251, 500, 500, 499, 499,
+	498, 496, 496, 496, 497, 497, 497, 497, 452, 452,
+	452, 452, 452, 278, 278, 278, 282, 282, 281, 281,
+	281, 281, 281, 286, 7, 7, 7, 7, 7, 7,
+	7, 7, 7, 30, 30, 30, 30, 30, 30, 30,
+	30, 36, 189, 190, 37, 191, 191, 192, 192, 193,
+	193, 194, 195, 196, 196, 196, 196, 35, 182, 182,
+	183, 183, 184, 184, 185, 186, 186, 186, 188, 187,
+	34, 141, 143, 27, 484, 487, 485, 485, 489, 489,
+	489, 490, 490, 490, 491, 491, 28, 127, 132, 132,
+	129, 147, 147, 147, 147, 147, 128, 133, 145, 145,
+	145, 145, 145, 134, 135, 144, 144, 136, 137, 140,
+	140, 138, 486, 492, 492, 492, 343, 343, 340, 341,
+	341, 338, 337, 337, 337, 494, 494, 493, 493, 493,
+	493, 279, 279, 29, 333, 333, 335, 336, 336, 336,
+	327, 327, 327, 327, 33, 331, 331, 332, 332, 332,
+	332, 332, 328, 328, 330, 330, 326, 326, 326, 326,
+	326, 32, 146, 146, 325, 325, 323, 323, 321, 321,
+	322, 322, 320, 320, 320, 324, 324, 31, 31, 31,
+	112, 111, 111, 111, 270, 270, 269, 269, 113, 38,
+	216, 216, 391, 391, 391, 391, 391, 409, 409, 409,
+	392, 392, 392, 393, 393, 393, 394, 394, 394, 394,
+	394, 408, 408, 365, 365, 366, 366, 366, 366, 369,
+	369, 383, 383, 384, 384, 382, 382, 389, 389, 388,
+	388, 387, 387, 386, 386, 385, 385, 385, 385, 379,
+	379, 378, 378, 367, 367, 367, 367, 367, 368, 368,
+	368, 377, 377, 380, 380, 222, 222, 223, 223, 223,
 	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
-	245, 403, 403, 403, 242, 242, 242, 242, 242, 242,
-	242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
-	459, 459, 459, 444, 444, 444, 445, 445, 445, 445,
-	445, 445, 445, 445, 445, 445, 445, 445, 446, 446,
-	446, 446, 446, 446, 446, 446, 446, 446, 446, 446,
-	446, 446, 446, 446, 446, 244, 244, 244, 243, 243,
-	243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
-	243, 243, 243, 298, 298, 299, 299, 400, 400, 400,
-	400, 400, 400, 401, 401, 402, 402, 402, 402, 394,
-	394, 394, 394, 394, 394, 394, 394, 394, 394, 394,
-	394, 394, 394, 394, 394, 394, 394, 394, 394, 394,
-	394, 394, 394, 394, 394, 394, 394, 394, 285, 241,
-	241, 241, 300, 293, 293, 294, 294, 288, 288, 288,
-	288, 288, 288, 288, 290, 290, 290, 290, 290, 290,
-	290, 290, 290, 290, 290, 283, 283, 283, 283, 283,
-	283, 283, 283, 283, 283, 283, 289, 289, 291, 291,
-	302, 302, 302, 301, 301, 301, 301, 301, 301, 301,
-	203, 203, 203, 203, 282, 282, 282, 282, 282, 282,
-	282, 282, 282, 282, 282, 193, 193, 193, 193, 197,
-	197, 199, 199, 199, 199, 199, 199, 199, 199, 199,
-	199, 199, 199, 199, 199, 198, 198, 198, 198, 196,
-	196, 196, 196, 196, 194, 194, 194, 194, 194, 194,
-	194, 194, 194, 194, 194, 194, 194, 194, 194, 194,
-	98, 99, 99, 195, 249, 249, 377, 377, 380, 380,
-	378, 378, 379, 381, 381, 381, 382, 382, 382, 383,
-	383, 383, 386, 386, 254, 254, 254, 260, 260, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 457, 457, 458, 225, 225, 225, 229, 229, 229,
+	229, 229, 229, 224, 224, 226, 226, 205, 205, 203,
+	203, 197, 197, 198, 198, 199, 199, 199, 202, 202,
+	200, 200, 201, 201, 201, 201, 351, 351, 455, 455,
+	456, 456, 451, 451, 451, 454, 454, 454, 454, 454,
+	453, 453, 206, 265, 265, 265, 280, 280, 280, 280,
+	264, 264, 264, 221, 221, 220, 220, 218, 218, 218,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
+	218, 218, 350, 350, 295, 295, 296, 296, 238, 237,
+	237, 237, 237, 237, 235, 236, 234, 234, 234, 234,
+	234, 233, 233, 232, 232, 232, 329, 329, 230, 230,
+	228, 228, 228, 227, 227, 227, 390, 301, 301, 301,
+	301, 301, 301, 301, 301, 301, 301, 301, 301, 301,
+	303, 303, 303, 303, 303, 303, 303, 303, 303, 303,
+	303, 303, 303, 303, 303, 303, 303, 303, 303, 303,
+	303, 263, 304, 304, 309, 309, 466, 466, 465, 207,
+	207, 207, 208, 208, 208, 208, 208, 208, 208, 208,
+	208, 217, 217, 217, 374, 374, 374, 374, 374, 375,
+	375, 375, 372, 372, 373, 373, 313, 314, 314, 414,
+	414, 370, 370, 371, 262, 262, 262, 262, 262, 262,
+	262, 262, 262, 262, 262, 262, 262, 262, 262, 262,
+	262, 421, 421, 421, 259, 259, 259, 259, 259, 259,
 	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 258, 258, 258, 258, 258, 258, 258, 258,
-	258, 258, 257, 257, 257, 257, 257, 257, 257, 257,
-	257, 257, 257, 257, 257, 257, 257, 257, 257, 257,
-	257, 257, 257, 257, 257, 257, 257, 257, 257, 257,
-	257, 257, 257, 257, 257, 257, 257, 257, 257, 257,
-	257, 257, 257,
+	478, 478, 478, 462, 462, 462, 463, 463, 463, 463,
+	463, 463, 463, 463, 463, 463, 463, 463, 464, 464,
+	464, 464, 464, 464, 464, 464, 464, 464, 464, 464,
+	464, 464, 464, 464, 464, 261, 261, 261, 260, 260,
+	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
+	260, 260, 260, 315, 315, 316, 316, 418, 418, 418,
+	418, 418, 418, 419, 419, 420, 420, 420, 420, 412,
+	412, 412, 412, 412, 412, 412, 412, 412, 412, 412,
+	412, 412, 412, 412, 412, 412, 412, 412, 412, 412,
+	412, 412, 412, 412, 412, 412, 412, 412, 302, 258,
+	258, 258, 317, 310, 310, 311, 311, 305, 305, 305,
+	305, 305, 305, 305, 307, 307, 307, 307, 307, 307,
+	307, 307, 307, 307, 307, 300, 300, 300, 300, 300,
+	300, 300, 300, 300, 300, 300, 306, 306, 308, 308,
+	319, 319, 319, 318, 318, 318, 318, 318, 318, 318,
+	219, 219, 219, 219, 299, 299, 299, 299, 299, 299,
+	299, 299, 299, 299, 299, 209, 209, 209, 209, 213,
+	213, 215, 215, 215, 215, 215, 215, 215, 215, 215,
+	215, 215, 215, 215, 215, 214, 214, 214, 214, 212,
+	212, 212, 212, 212, 210, 210, 210, 210, 210, 210,
+	210, 210, 210, 210, 210, 210, 210, 210, 210, 210,
+	98, 99, 99, 211, 266, 266, 395, 395, 398, 398,
+	396, 396, 397, 399, 399, 399, 400, 400, 400, 401,
+	401, 401, 404, 404, 271, 271, 271, 277, 277, 276,
+	276, 276, 276, 276, 276, 276, 276, 276, 276, 276,
+	276, 276, 276, 276, 276, 276, 276, 276, 276, 276,
+	276, 276, 276, 276, 276, 276, 276, 276, 276, 276,
+	276, 276, 276, 276, 276, 276, 276, 276, 276, 276,
+	276, 276, 276, 276, 276, 276, 276, 276, 276, 276,
+	276, 276, 276, 276, 276, 276, 276, 276, 276, 276,
+	276, 276, 276, 276, 276, 276, 276, 276, 276, 276,
+	276, 276, 276, 276, 276, 276, 276, 276, 276, 276,
+	276, 276, 276, 276, 276, 276, 276, 276, 276, 276,
+	276, 276, 276, 276, 276, 276, 276, 276, 276, 276,
+	276, 276, 276, 276, 276, 276, 276, 276, 276, 276,
+	276, 276, 276, 276, 276, 276, 276, 276, 276, 276,
+	276, 276, 276, 276, 276, 276, 276, 276, 276, 276,
+	276, 276, 276, 276, 276, 276, 276, 276, 276, 276,
+	276, 276, 276, 276, 276, 276, 276, 276, 276, 276,
+	276, 276, 276, 276, 276, 276, 276, 276, 276, 276,
+	276, 276, 276, 276, 276, 276, 276, 276, 276, 276,
+	276, 276, 276, 276, 276, 276, 276, 276, 276, 276,
+	276, 276, 276, 276, 276, 276, 276, 276, 276, 276,
+	276, 276, 276, 276, 276, 276, 276, 276, 276, 276,
+	276, 276, 276, 276, 276, 276, 276, 275, 275, 275,
+	275, 275, 275, 275, 275, 275, 275, 274, 274, 274,
+	274, 274, 274, 274, 274, 274, 274, 274, 274, 274,
+	274, 274, 274, 274, 274, 274, 274, 274, 274, 274,
+	274, 274, 274, 274, 274, 274, 274, 274, 274, 274,
+	274, 274, 274, 274, 274, 274, 274, 274,
 }
 
 var yyR2 = [...]int{
@@ -6300,101 +6692,107 @@ var yyR2 = [...]int{
 	1, 1, 1, 0, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 4, 0, 1, 1, 0, 1, 5, 2,
-	2, 6, 9, 6, 9, 4, 7, 8, 0, 1,
-	1, 2, 4, 6, 1, 2, 4, 0, 2, 10,
-	10, 2, 0, 2, 1, 3, 3, 3, 0, 2,
-	1, 3, 5, 0, 2, 3, 1, 3, 1, 1,
-	1, 3, 1, 1, 1, 1, 0, 3, 3, 0,
-	3, 3, 0, 1, 3, 0, 1, 3, 0, 2,
-	1, 2, 3, 4, 3, 3, 1, 0, 1, 1,
-	0, 1, 8, 5, 7, 0, 3, 8, 5, 1,
-	3, 3, 3, 1, 1, 1, 1, 1, 1, 1,
-	1, 3, 1, 4, 1, 3, 1, 2, 2, 2,
-	2, 2, 2, 1, 2, 2, 2, 2, 1, 1,
-	2, 2, 1, 1, 1, 1, 1, 2, 2, 2,
-	1, 2, 1, 2, 2, 1, 2, 1, 1, 2,
-	2, 1, 1, 1, 3, 2, 2, 2, 2, 2,
-	2, 2, 2, 1, 1, 1, 1, 1, 1, 1,
-	3, 4, 4, 6, 1, 1, 1, 2, 4, 6,
-	1, 4, 1, 3, 3, 4, 4, 4, 3, 3,
-	2, 4, 4, 2, 2, 2, 1, 1, 1, 1,
-	1, 1, 3, 1, 3, 1, 1, 1, 2, 2,
-	0, 1, 4, 2, 4, 1, 5, 3, 2, 1,
-	2, 2, 4, 4, 5, 2, 1, 3, 4, 4,
-	1, 2, 9, 7, 1, 3, 3, 1, 1, 3,
-	1, 3, 2, 1, 2, 1, 2, 2, 1, 1,
-	1, 1, 1, 1, 1, 4, 4, 2, 4, 3,
-	3, 1, 1, 1, 1, 1, 1, 2, 3, 4,
-	7, 2, 3, 3, 4, 5, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 3, 5, 7, 1, 4,
+	4, 0, 1, 1, 0, 1, 5, 2, 2, 6,
+	9, 6, 9, 4, 7, 8, 0, 1, 1, 2,
+	4, 6, 1, 2, 4, 0, 2, 10, 10, 2,
+	0, 2, 1, 3, 3, 3, 0, 2, 1, 3,
+	5, 0, 2, 3, 1, 3, 1, 1, 1, 3,
+	1, 1, 1, 1, 0, 3, 3, 0, 3, 3,
+	0, 1, 3, 0, 1, 3, 0, 2, 1, 2,
+	3, 4, 3, 3, 1, 0, 1, 1, 0, 1,
+	8, 5, 7, 0, 3, 8, 5, 1, 3, 3,
+	3, 1, 1, 1, 1, 1, 1, 1, 1, 3,
+	1, 4, 1, 3, 1, 2, 2, 2, 2, 2,
+	2, 1, 2, 2, 2, 2, 1, 1, 2, 2,
+	1, 1, 1, 1, 1, 2, 2, 2, 1, 2,
+	1, 2, 2, 1, 2, 1, 1, 2, 2, 1,
+	1, 1, 3, 2, 2, 2, 2, 2, 2, 2,
+	2, 1, 1, 1, 1, 1, 1, 1, 3, 4,
+	4, 6, 1, 1, 1, 2, 4, 6, 1, 4,
+	1, 3, 3, 4, 4, 4, 3, 3, 2, 4,
+	4, 2, 2, 2, 1, 1, 1, 1, 1, 1,
+	3, 1, 3, 1, 1, 1, 2, 2, 0, 1,
+	4, 2, 4, 1, 5, 3, 2, 1, 2, 2,
+	4, 4, 5, 2, 1, 3, 4, 4, 1, 2,
+	9, 7, 1, 3, 3, 1, 1, 3, 1, 3,
+	2, 1, 2, 1, 2, 2, 1, 1, 1, 1,
+	1, 1, 1, 4, 4, 2, 4, 3, 3, 1,
+	1, 1, 1, 1, 1, 2, 3, 4, 7, 2,
+	3, 3, 4, 6, 5, 5, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 3,
-	2, 1, 1, 1, 1, 6, 1, 1, 1, 1,
-	1, 1, 7, 4, 1, 3, 2, 2, 2, 1,
-	2, 2, 2, 3, 2, 3, 1, 1, 7, 7,
-	8, 0, 4, 7, 0, 3, 0, 2, 0, 1,
-	1, 1, 1, 4, 2, 2, 3, 3, 4, 5,
-	3, 4, 4, 2, 2, 2, 3, 0, 1, 1,
+	2, 1, 1, 1, 1, 3, 6, 1, 1, 1,
+	1, 1, 1, 7, 4, 4, 4, 1, 3, 2,
+	2, 2, 1, 5, 3, 3, 3, 7, 7, 0,
+	2, 2, 2, 2, 2, 3, 2, 3, 1, 1,
+	7, 7, 8, 0, 4, 7, 5, 0, 3, 0,
+	2, 0, 1, 1, 1, 2, 2, 1, 4, 2,
+	2, 3, 3, 4, 5, 3, 4, 4, 2, 2,
+	2, 3, 0, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 4, 2, 5, 5,
-	0, 2, 7, 0, 1, 0, 1, 5, 3, 3,
-	2, 4, 4, 4, 4, 4, 1, 1, 1, 3,
-	2, 3, 1, 1, 1, 6, 8, 0, 1, 1,
-	1, 1, 5, 5, 0, 1, 1, 3, 3, 3,
-	4, 6, 7, 4, 4, 7, 8, 3, 3, 3,
-	0, 2, 2, 0, 2, 2, 1, 1, 1, 1,
-	0, 1, 4, 4, 5, 4, 3, 1, 3, 1,
-	1, 3, 5, 2, 3, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 4, 4,
-	4, 1, 3, 1, 4, 6, 4, 4, 4, 3,
-	6, 3, 5, 1, 1, 2, 2, 11, 8, 9,
-	1, 3, 2, 4, 0, 2, 0, 1, 1, 1,
-	1, 0, 1, 0, 1, 4, 2, 1, 5, 4,
-	4, 2, 5, 0, 2, 1, 3, 2, 1, 5,
-	4, 4, 2, 0, 5, 0, 1, 3, 3, 1,
-	3, 1, 3, 1, 3, 4, 0, 1, 0, 1,
-	1, 3, 1, 1, 0, 4, 1, 3, 2, 1,
-	0, 8, 0, 4, 7, 4, 0, 2, 0, 2,
-	0, 2, 0, 4, 1, 3, 1, 1, 4, 3,
-	4, 5, 4, 5, 2, 3, 1, 3, 6, 0,
-	3, 0, 1, 2, 4, 4, 0, 1, 3, 1,
-	3, 3, 0, 1, 1, 0, 2, 2, 3, 3,
-	3, 1, 3, 3, 3, 3, 1, 2, 2, 1,
-	2, 2, 1, 2, 2, 1, 2, 2, 7, 7,
-	1, 1, 1, 0, 1, 1, 1, 1, 0, 2,
-	0, 3, 0, 2, 1, 3, 1, 2, 3, 5,
-	0, 1, 2, 1, 3, 1, 1, 1, 4, 4,
-	4, 3, 2, 2, 2, 3, 2, 3, 4, 1,
-	3, 4, 0, 2, 1, 1, 2, 2, 0, 1,
-	2, 4, 1, 3, 1, 3, 2, 3, 1, 4,
-	3, 0, 1, 1, 2, 5, 2, 2, 2, 0,
-	2, 3, 3, 0, 1, 3, 1, 3, 0, 1,
-	2, 1, 1, 0, 1, 2, 1, 2, 1, 1,
+	1, 1, 4, 2, 5, 5, 0, 2, 7, 0,
+	1, 0, 1, 5, 3, 3, 2, 4, 4, 4,
+	4, 4, 1, 1, 1, 3, 2, 3, 1, 1,
+	1, 6, 8, 0, 1, 1, 1, 1, 5, 5,
+	0, 1, 1, 3, 3, 3, 4, 6, 7, 4,
+	4, 7, 8, 3, 3, 2, 3, 0, 2, 2,
+	0, 2, 2, 1, 1, 1, 1, 0, 1, 4,
+	4, 5, 4, 3, 1, 3, 1, 1, 3, 5,
+	2, 3, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 4,
+	4, 4, 1, 3, 1, 4, 6, 4, 4, 5,
+	4, 3, 6, 3, 5, 1, 1, 2, 2, 11,
+	8, 9, 1, 3, 2, 4, 0, 2, 0, 1,
+	1, 1, 1, 0, 1, 0, 1, 4, 2, 1,
+	5, 4, 4, 2, 5, 0, 2, 1, 3, 2,
+	1, 5, 4, 4, 2, 0, 5, 0, 1, 3,
+	3, 1, 3, 1, 3, 1, 3, 4, 0, 1,
+	0, 1, 1, 3, 1, 1, 0, 4, 1, 3,
+	2, 1, 0, 8, 0, 4, 7, 4, 0, 2,
+	0, 2, 0, 2, 0, 4, 1, 3, 1, 1,
+	4, 3, 4, 5, 4, 5, 2, 3, 1, 3,
+	6, 0, 3, 0, 1, 2, 4, 4, 0, 1,
+	3, 1, 3, 3, 0, 1, 1, 0, 2, 2,
+	3, 3, 3, 1, 3, 3, 3, 3, 1, 2,
+	2, 1, 2, 2, 1, 2, 2, 1, 2, 2,
+	8, 8, 0, 1, 1, 1, 1, 0, 1, 1,
+	1, 1, 0, 2, 0, 3, 0, 2, 1, 3,
+	1, 2, 3, 5, 0, 1, 2, 1, 3, 1,
+	1, 1, 4, 4, 4, 3, 2, 2, 2, 3,
+	2, 3, 4, 1, 3, 4, 0, 2, 1, 1,
+	2, 2, 0, 1, 2, 4, 1, 3, 1, 3,
+	4, 2, 3, 1, 4, 3, 0, 1, 1, 2,
+	5, 2, 2, 2, 0, 2, 3, 3, 0, 1,
+	3, 1, 3, 0, 1, 2, 1, 1, 0, 1,
+	2, 1, 2, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 7, 1, 1, 7, 1, 3, 0, 1,
-	1, 3, 1, 3, 0, 1, 1, 1, 12, 1,
-	3, 0, 1, 1, 3, 1, 1, 2, 4, 1,
-	1, 7, 7, 1, 4, 1, 1, 3, 4, 3,
-	0, 1, 1, 0, 2, 7, 8, 0, 2, 6,
-	0, 2, 2, 3, 3, 4, 1, 0, 2, 2,
-	1, 3, 2, 1, 3, 2, 1, 3, 2, 0,
-	1, 3, 4, 3, 1, 1, 4, 1, 3, 1,
-	1, 1, 1, 0, 1, 1, 1, 11, 0, 2,
-	3, 2, 3, 1, 1, 1, 3, 3, 4, 0,
-	2, 2, 2, 2, 6, 0, 4, 1, 1, 0,
-	3, 0, 1, 1, 2, 4, 4, 4, 0, 1,
-	11, 9, 11, 2, 2, 4, 5, 1, 3, 0,
-	3, 5, 10, 0, 2, 0, 3, 2, 4, 3,
-	0, 2, 1, 0, 2, 3, 0, 2, 3, 0,
-	3, 2, 4, 3, 0, 1, 0, 6, 0, 3,
-	5, 0, 4, 0, 3, 1, 3, 4, 5, 0,
-	3, 1, 3, 2, 3, 1, 2, 0, 4, 6,
-	5, 0, 2, 0, 2, 4, 5, 4, 5, 1,
-	5, 6, 5, 0, 3, 0, 1, 0, 1, 1,
-	3, 2, 3, 3, 4, 4, 3, 3, 3, 3,
-	4, 4, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 4, 5,
-	4, 1, 3, 3, 0, 2, 2, 1, 1, 1,
+	1, 7, 1, 1, 7, 1, 3, 0, 1, 1,
+	3, 1, 3, 0, 1, 1, 1, 12, 1, 3,
+	0, 1, 1, 3, 1, 1, 2, 4, 1, 1,
+	7, 7, 4, 7, 1, 4, 1, 1, 3, 4,
+	3, 0, 1, 1, 0, 2, 7, 8, 0, 2,
+	6, 0, 2, 2, 3, 3, 4, 13, 0, 2,
+	2, 2, 2, 6, 8, 0, 3, 5, 10, 0,
+	3, 4, 1, 0, 2, 2, 1, 3, 2, 1,
+	3, 2, 1, 3, 2, 0, 1, 3, 4, 3,
+	5, 1, 1, 4, 1, 3, 1, 1, 1, 1,
+	0, 1, 1, 1, 11, 0, 2, 3, 2, 3,
+	1, 1, 1, 3, 3, 4, 0, 2, 2, 2,
+	2, 6, 0, 4, 1, 1, 0, 3, 0, 1,
+	1, 2, 4, 4, 4, 0, 1, 11, 9, 11,
+	2, 2, 4, 5, 1, 3, 0, 3, 5, 10,
+	0, 2, 0, 3, 2, 4, 3, 0, 2, 1,
+	0, 2, 3, 0, 2, 3, 0, 3, 2, 4,
+	3, 0, 1, 0, 6, 0, 3, 5, 6, 0,
+	4, 0, 3, 1, 3, 4, 5, 0, 3, 1,
+	3, 2, 3, 1, 2, 0, 4, 6, 5, 0,
+	2, 0, 2, 4, 5, 4, 5, 1, 5, 6,
+	5, 0, 3, 0, 1, 0, 1, 1, 3, 2,
+	3, 3, 4, 4, 3, 3, 3, 3, 4, 4,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 4, 5, 4, 7,
+	5, 1, 3, 3, 0, 2, 2, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 3, 1,
 	3, 0, 1, 1, 3, 1, 1, 1, 7, 7,
 	2, 1, 7, 7, 8, 5, 0, 1, 0, 1,
@@ -6465,617 +6863,654 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -484, -488, -2, -5, 551, -1, -4, -99, -74,
+	-1000, -503, -507, -2, -5, 569, -1, -4, -99, -74,
 	-7, -101, -102, -8, -97, -9, -10, -12, -78, -92,
 	-94, -96, -95, -39, -11, -91, -69, -70, -80, -85,
-	-88, -89, -90, -103, -98, -100, -132, -104, 544, -75,
-	-76, -77, -30, -29, -28, -27, -124, 550, 408, 12,
-	456, -13, -14, -449, -15, 237, -266, -267, -268, -270,
-	-489, -40, -41, -42, -49, -50, -51, -52, -53, -62,
-	-63, -64, -43, -44, -45, -48, -46, -55, -56, -57,
-	-58, -59, -60, -61, -47, -127, -128, -65, -67, -66,
-	-72, -68, -73, -126, 212, -71, 61, -81, -82, -83,
-	-84, -86, -87, 339, 345, 398, 543, 46, -133, -135,
-	554, 140, 141, 143, 144, -31, -32, -33, -34, -35,
-	-36, -38, -37, 208, 14, 489, -16, -19, -17, -20,
-	-18, -25, -26, -24, -21, -23, -125, -22, 232, 231,
-	37, 295, 296, 297, 343, 230, 209, 13, 30, 41,
-	321, -134, 67, 210, -136, 10, 556, -6, -3, -2,
-	-111, -115, -119, -122, -123, -120, -121, -4, -99, 98,
-	222, 545, -263, 335, 546, 548, 547, 70, 78, -257,
-	-259, 339, 345, 543, 492, 493, 494, 495, 496, 497,
-	498, 499, 500, 501, 502, 503, 504, 505, 506, 507,
-	508, 509, 510, 511, 512, 513, 515, 516, 517, 518,
-	519, 520, 521, 522, 523, 524, 525, 526, 527, 528,
-	459, 530, 531, 532, 533, 319, 320, 486, 249, 273,
-	369, 276, 283, 140, 159, 153, 181, 173, 148, 253,
-	288, 254, 77, 143, 442, 88, 420, 395, 146, 268,
-	270, 272, 275, 255, 169, 164, 267, 251, 162, 256,
-	39, 257, 186, 258, 259, 491, 416, 318, 422, 280,
-	393, 163, 417, 271, 419, 188, 192, 434, 157, 158,
-	424, 436, 185, 229, 42, 488, 241, 437, 190, 184,
-	180, 445, 284, 282, 183, 156, 179, 252, 50, 194,
-	193, 195, 389, 390, 391, 392, 260, 261, 333, 433,
-	176, 165, 323, 149, 22, 440, 236, 421, 346, 262,
-	277, 285, 189, 191, 243, 248, 490, 397, 247, 281,
-	438, 161, 240, 269, 235, 441, 150, 348, 263, 144,
-	274, 435, 444, 49, 361, 155, 147, 226, 141, 245,
-	250, 11, 264, 265, 266, 287, 286, 278, 177, 242,
-	182, 167, 154, 178, 142, 244, 443, 362, 541, 321,
-	378, 175, 172, 246, 219, 439, 423, 145, 382, 363,
-	170, 289, 537, 538, 539, 334, 317, 290, 291, 168,
-	233, 414, 415, 292, 294, 388, 310, 364, 396, 370,
-	365, 201, 298, 426, 428, 187, 540, 299, 300, 301,
-	418, 302, 303, 304, 305, -339, -337, -263, 543, 345,
-	339, 321, -175, -263, 555, -142, 38, -187, -263, -142,
-	-71, -14, -13, -134, -135, -187, 216, -271, 23, 393,
-	-79, 394, 212, 67, -263, -9, -90, -8, -97, -69,
-	-132, 398, -269, -263, 295, 295, -269, 216, -263, 247,
-	377, -388, 222, -343, -316, 248, -342, -318, -345, -319,
-	31, 208, 210, 209, 244, 14, 343, 218, 12, 10,
-	344, 230, 24, 25, 27, 13, 345, 347, 28, 348,
-	351, 352, 353, 41, 356, 357, 237, 70, 78, 73,
-	255, -174, -263, -294, -288, 95, 238, -290, -283, -284,
-	-286, -429, -423, -282, 67, 122, 123, 130, 96, -285,
-	-372, 35, 98, 496, 457, -242, -243, -244, -245, -246,
-	-263, -424, -422, 73, 79, 82, 85, 86, 84, 83,
-	166, 81, 74, 134, 135, -135, 70, -444, 504, -258,
-	525, 530, 531, 532, 533, 524, 46, -394, -402, 215,
-	-400, 133, 171, 233, 167, 12, 128, 388, 168, 501,
-	521, 459, 505, 528, 498, 499, 493, 494, 495, 497,
-	506, 508, 520, -403, 516, 526, 527, 514, 529, 72,
-	71, 519, 518, 507, 502, 503, 509, 492, 500, 510,
-	511, 517, 522, 523, 327, 88, 328, 329, 449, 322,
-	330, 222, 393, 55, 331, 332, 333, 334, 335, 456,
-	336, 56, 337, 326, 237, 378, 338, 170, 187, 461,
-	460, 462, 453, 450, 448, 451, 452, 454, 455, 512,
-	513, 515, -105, -106, 535, -151, -152, -235, 19, 6,
-	7, 8, 9, -482, 395, 490, 145, 142, -330, 145,
-	94, 150, 149, -330, 298, 243, 340, 219, 319, 378,
-	302, 247, -310, -308, -390, 292, 288, 217, 227, 226,
-	89, 449, 215, 354, -460, -461, 205, 206, 207, -451,
-	482, -450, -263, 329, 26, 216, 340, 428, 426, 427,
-	429, 430, 431, 432, -54, -404, -387, 423, 422, -275,
-	415, 421, 413, 425, 416, 320, 304, 303, 208, 552,
-	483, -254, 364, 396, 243, 446, 447, 341, 397, 434,
-	435, 417, 88, 174, 171, 219, 216, 319, 449, 378,
-	302, -460, 247, 377, 40, -336, 388, -335, -337, 434,
-	435, 445, 71, 72, 433, -254, 88, 414, 414, -152,
-	-235, -151, -133, -135, -100, -449, 217, 319, 378, 247,
-	218, 216, 219, 449, 295, 340, 243, 302, -389, -457,
-	31, -395, 202, 203, 204, 32, 33, -1, 102, 556,
-	-288, -288, -6, 557, -6, -288, -263, -263, 137, -193,
-	-197, -194, -196, -195, -199, -198, 171, 172, 133, 175,
-	177, 178, 179, 180, 181, 182, 183, 184, 185, 186,
-	30, 187, 233, 167, 168, 169, 170, 188, 153, 173,
-	487, 196, 154, 197, 155, 198, 156, 199, 157, 158,
-	200, 159, 162, 163, 164, 161, 136, 67, 136, 73,
-	-158, 239, -187, 136, -158, -152, -151, -152, -187, -247,
-	-263, 395, 105, -79, -79, 394, -452, -453, -454, -456,
-	212, 394, 393, 136, 255, 15, -269, -269, 65, -187,
-	-318, 247, -343, -316, 35, 64, 137, 220, 137, 64,
-	67, 341, 319, 378, 342, 449, 216, 354, 219, 247,
-	355, 319, 378, 216, 219, 449, 247, 319, 216, 219,
-	378, 247, 355, 413, 414, 219, 26, 346, 349, 350,
-	414, -408, 445, 137, 94, 91, 92, 93, -288, 112,
-	-301, 105, 106, 107, 108, 109, 110, 111, 119, 118,
-	129, 122, 123, 124, 125, 126, 127, 128, 120, 121,
-	115, 95, 113, 117, 114, 97, -135, -288, -294, 46,
-	-286, -286, -286, -286, -372, -292, -288, 67, 67, 136,
-	73, -288, 67, 67, 67, 67, 67, 67, 67, 67,
-	67, 67, 67, 67, -401, 67, 67, -298, -299, 67,
-	67, -282, -247, 67, 73, 73, 67, 67, 67, 73,
-	-299, -299, 67, 67, 67, 67, 67, 67, 67, 67,
-	67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
-	-152, 137, 67, -107, 38, -154, 21, -136, -135, 20,
-	31, 32, 31, 32, 31, 32, 31, 32, 79, 438,
-	489, 148, 149, 150, -173, -263, -175, -166, -263, -306,
-	222, -306, -306, -306, -306, 218, -306, 216, 216, 216,
-	-306, -462, 152, 137, -451, -157, 67, -274, 113, 117,
-	23, 245, 245, -274, 282, -490, -491, 15, 115, -272,
-	-491, -272, -272, -274, -492, 218, 424, 42, 246, 245,
-	-153, -154, -153, 418, 414, -359, 419, 420, -276, -491,
-	-275, -274, -274, -275, -274, -274, -274, 216, 219, 449,
-	302, 553, -490, 245, -490, 30, 30, -388, -187, -388,
-	483, -255, -263, -388, -388, -463, 221, -318, 247, 247,
-	137, 105, 23, -338, 105, 116, -337, -337, -337, -338,
-	-338, -208, 40, -262, 133, -263, 73, -208, 40, -486,
-	-485, -187, -153, -136, -135, 68, 68, 68, -388, -388,
-	-388, -388, -388, -388, -388, -388, -269, -166, -175, 222,
-	-388, -137, -138, 124, -288, -263, -137, -3, -113, -112,
-	99, 100, 102, 546, 335, 545, 549, 543, -337, 40,
-	-383, 362, 361, -377, -379, 67, -378, 67, -378, -378,
-	-378, 67, 67, -380, 67, -380, -380, -377, -381, 67,
-	-381, -382, 67, -381, -263, -293, -294, -263, 38, -397,
-	46, -132, 67, 30, 67, -263, -398, 46, -132, 67,
-	30, -153, -107, -154, 136, 79, 73, -79, 68, 137,
-	-455, 85, 86, -458, 185, 177, -263, -93, 73, -7,
-	-8, -9, -10, -39, -69, -132, -425, -423, 67, 31,
-	387, 64, 15, -344, 216, 449, 340, 243, 219, 319,
-	-342, -325, -322, -320, -262, -318, -321, -320, -347, -247,
-	414, -108, 399, 294, -288, -288, -288, -288, -288, 84,
-	95, 317, 85, 86, -283, -302, 31, 290, 291, -284,
-	-284, -284, -284, -284, -284, -284, -284, -284, -284, -284,
-	-284, -291, -300, -372, 67, 115, 113, 117, 114, 97,
-	-286, -286, -284, -284, 68, 137, -448, -447, 99, -288,
-	-288, -263, -445, -446, 463, 464, 465, 466, 467, 468,
-	469, 470, 471, 472, 473, 331, 326, 332, 330, 322,
-	338, 333, 334, 170, 480, 481, 474, 475, 476, 477,
-	478, 479, -293, -293, -288, -445, -293, -241, 32, 31,
-	-294, -294, -294, 68, -288, -459, 315, 314, 316, -155,
-	-263, -293, 68, 68, 68, 79, -294, -294, -293, -284,
-	-293, -446, -241, -241, -294, -294, -241, -241, -241, -241,
-	124, -241, -241, -241, -241, -241, -241, -241, -241, 68,
-	-153, -106, -406, -405, -288, 40, 536, -107, -288, -150,
-	-149, -288, -483, 73, 439, 440, 146, 149, 148, 34,
-	67, 67, 136, -187, 95, -465, -263, -326, -323, -320,
-	-263, -316, -263, -263, -306, -306, -306, -187, -463, -450,
-	34, -156, -263, -231, 16, -286, -286, -320, 247, -490,
-	-274, -256, -255, -276, -271, -276, -276, -231, -491, -274,
-	-274, -276, -272, -231, -263, 414, -231, -231, -359, -273,
-	-263, -273, -306, -255, -256, -256, -187, -223, -224, 213,
-	210, 209, -228, 359, 249, -307, 253, 77, 254, 395,
-	255, 218, 257, 258, 259, 229, 260, 261, 262, 388,
-	263, 264, 265, 266, 342, 6, 305, 40, -465, -465,
-	30, -326, -263, -145, -140, -144, -141, -146, -234, -236,
-	-143, 67, -187, -135, -263, 31, 387, -341, 387, 31,
-	-316, -335, -331, 73, 388, -321, -340, 64, 133, -405,
-	-338, -338, -338, -340, -340, 132, 137, -487, 438, 439,
-	205, -107, -189, -187, -465, -325, -316, -263, -189, -189,
-	-263, 67, 35, -263, -230, 137, -229, 15, -264, -263,
-	34, 73, 136, -230, -114, -112, 101, -288, -6, 545,
-	-288, -6, -6, -288, -6, -288, -386, 363, 79, 79,
-	-249, 73, -249, 79, 79, 79, 68, 73, -329, 64,
-	-399, -295, -443, 535, -160, 68, -155, -441, -442, -155,
-	-159, -263, -399, -160, 68, -441, -107, -107, -263, 23,
-	-79, -453, 136, 137, -156, -344, -324, -321, -346, 124,
-	-263, -332, 137, 489, 558, 71, 220, -475, -474, 379,
-	68, 137, -409, 221, 456, 73, 559, 201, 84, 317,
-	85, 86, -372, -294, -291, -286, -286, -284, -284, -289,
-	234, -289, 94, -288, -287, -447, 101, -288, 34, 137,
-	65, 136, 68, 68, 15, 15, 68, -288, 68, 68,
-	68, 68, 15, 15, -288, 68, 136, 68, 68, 68,
-	68, 65, 68, 137, 68, 137, -294, -288, 68, 68,
-	-288, -288, -288, -294, 68, -288, -288, -288, -288, -288,
-	-288, -288, -288, -354, 409, 137, 68, 73, 137, 22,
-	137, -147, 36, 37, 147, 150, 150, 149, -174, -176,
-	-177, -178, -179, -180, 115, 138, 139, -167, -168, -169,
-	-170, -193, -247, 172, 175, 177, 178, 179, 180, 181,
-	182, 183, 184, 185, 186, 187, 233, 167, 168, 169,
-	170, 188, 153, 173, 154, 155, 156, 157, 158, 159,
-	162, 163, 164, 161, -263, -157, 35, -468, 366, -476,
-	137, 40, -474, 449, -309, 65, -130, 15, -187, -187,
-	-187, -200, 34, 15, -139, -188, -263, 67, 68, 137,
-	-288, -317, 65, -263, -256, -231, -187, -263, -231, -231,
-	-274, -276, -276, -272, 136, -255, -157, 137, -183, -190,
-	-184, -186, -247, -279, -185, 227, -438, 225, 223, 89,
-	228, 279, 90, 218, -225, 218, 90, 224, 228, 223,
-	-226, 218, -260, 105, -260, -254, 132, -260, -260, -260,
-	-260, 256, 256, -260, -260, -260, -260, -260, -260, -260,
-	-260, -260, -260, -260, -260, -260, -260, 67, -469, 366,
-	30, 325, -476, -131, 319, 30, -237, -238, -239, -240,
-	53, 57, 59, 54, 55, 56, 60, 30, 137, -261,
-	-265, 34, -263, 73, -261, -135, -140, -145, -261, 67,
-	220, 67, 105, -340, -340, -340, 40, -262, -485, 445,
-	439, 137, 64, -167, -175, -231, -138, -140, -263, 73,
-	-263, 124, -231, 102, -6, 100, -118, -117, -116, 103,
-	543, 549, 102, 102, 102, 68, 68, 68, 137, 68,
-	68, 68, 137, 68, 137, -412, 419, 39, 137, 67,
-	68, 137, 46, 137, 105, 68, 137, 68, 46, 136,
-	395, -263, -423, 68, -346, 137, 220, 136, 136, -322,
-	345, -262, -324, 20, 489, -247, 38, -253, -252, 73,
-	559, 68, -289, -289, 94, -286, -283, 68, 102, -288,
-	100, -191, -193, 361, 362, -192, -198, 133, 171, 233,
-	170, 169, 167, 361, 362, -208, -263, -288, -288, 68,
-	-288, -288, 15, -263, -208, -284, -288, -152, 68, -353,
-	-354, -353, 68, 68, 68, 68, -353, 68, 68, 68,
-	68, 68, 68, 68, 68, 67, -405, -412, -288, -288,
-	-149, -148, 43, 150, 353, 68, 137, -247, 68, 137,
-	-193, 34, -471, 421, 368, -260, -281, -280, 321, 41,
-	-392, 388, 374, 375, -323, 247, -263, 64, 309, 310,
-	311, 312, -304, -305, -303, -307, -465, 67, 67, 67,
-	-373, 299, -193, -187, -139, -263, 15, 137, -464, 136,
-	-1, -263, -316, -274, -231, -491, -231, -274, -274, -276,
-	-263, 34, -224, -193, -185, -437, -438, -306, -263, 90,
-	-437, 90, 67, -263, -263, -263, 90, 90, -263, 79,
-	79, -260, -260, 79, 73, 73, 73, -260, -260, 79,
-	73, -265, 79, 79, 79, 79, 40, 73, -213, 40,
-	267, 271, 268, 269, 270, 79, 40, 79, 40, 79,
-	40, -263, 67, -439, -440, 73, -471, -260, 325, 105,
-	-281, -129, 77, 31, -161, 213, 210, -465, -328, -327,
-	-247, -144, -144, -144, -144, 53, 53, 53, 58, 53,
-	58, 53, -239, -328, -146, -157, -265, 68, -481, -480,
-	-479, -477, 61, 221, 62, -293, -324, -277, 73, -331,
-	-187, -187, 68, -233, 17, 136, -233, 98, -6, -114,
-	-116, -288, -6, -288, 545, 335, 546, 73, 79, 79,
-	-420, 405, 400, 402, 90, -295, -407, -406, 46, -132,
-	-155, -399, -442, -405, -263, 46, -132, -399, -263, 79,
-	15, -321, -316, 124, 124, -263, 346, -332, 73, 367,
-	73, 216, 560, 137, 105, -253, -283, -288, 68, -201,
-	158, 157, -201, 68, -378, -378, -377, -380, -377, -201,
-	-201, 68, 68, 23, 68, 68, 68, -288, 68, 68,
-	137, -396, 458, -353, -353, -353, -353, -353, -353, -353,
-	-353, -353, -353, -353, -353, -353, -297, -296, 239, -420,
-	44, 45, 73, 73, -178, -193, 214, -169, 40, -132,
-	-472, 77, -466, 73, -263, -473, 77, 369, 135, 323,
-	40, 370, 371, 385, 318, 79, 79, 376, -467, -263,
-	-162, 319, -187, -303, -254, 132, 258, 302, -181, -182,
-	-183, -181, -181, -374, 301, 20, 79, 123, -158, 65,
-	136, -140, -188, -263, 124, 68, -231, -263, -231, -231,
-	-274, -132, -205, -204, -202, 84, 95, 40, 359, -203,
-	77, 132, 272, 250, 273, -222, -278, 64, 365, 223,
-	89, 90, 347, -279, -435, -263, -433, -435, -263, -433,
-	-433, -306, -288, -263, -227, 307, 308, -208, -208, 73,
-	73, -209, 250, -189, 68, 137, 105, -472, -466, 105,
-	73, -473, 73, 137, -161, -161, -231, 137, 105, -164,
-	-163, 64, 65, -165, 64, -163, 53, 53, -231, -479,
-	-478, 23, -438, -438, -438, 68, 68, -232, 18, 20,
-	124, -232, 102, 100, 102, 102, -263, 68, 68, -384,
-	537, -416, -418, 400, 20, 20, 13, 68, -399, -399,
-	-316, -332, 388, -187, -252, 73, 560, -288, 68, -288,
-	68, 73, -152, 20, -384, -171, -193, -282, 73, -470,
-	379, 73, 73, 79, 40, 79, 135, 372, -393, -129,
-	-161, 67, -260, -260, -260, -263, 68, 137, 68, 68,
-	-375, 238, 79, 123, 79, 123, 79, -261, -140, -263,
-	-231, -464, 136, -231, -231, -202, 84, -284, 73, -210,
-	-262, 133, -211, 40, 271, 267, -212, 40, 251, 252,
-	-214, 67, 279, 13, 90, 90, -187, 67, 67, 65,
-	289, 67, 67, -435, 68, 251, 252, 68, -440, 73,
-	-470, 73, -465, -152, -327, -405, -288, 67, -288, 67,
-	53, 19, 17, -288, -294, 222, -6, 546, 335, -215,
-	538, 73, 20, 73, -414, 73, -328, -110, -410, -359,
-	68, 68, -353, -356, -355, -358, 406, 281, 412, -294,
-	-215, 488, 20, 489, 322, 40, 79, 40, 373, -311,
-	-313, -247, 67, -208, -210, 73, -206, -207, -228, -183,
-	-109, -108, -206, -376, 141, 79, 123, 79, 79, -231,
-	-231, 79, 67, -400, -312, 67, -311, -311, -436, 309,
-	310, 311, 313, 312, -436, -311, -311, 67, -334, -333,
-	280, 95, -153, -156, -434, -263, 223, 20, 20, -263,
-	-263, -250, 539, 79, 73, 402, -385, 540, -419, 405,
-	-413, -411, 400, 401, 402, 403, 68, -357, 97, 372,
-	376, -288, -250, -172, -263, 73, 367, 73, 322, 68,
-	137, -377, -288, -348, 239, 137, -228, -110, -348, -391,
-	149, 300, 489, 79, 123, 79, -152, 68, -288, -298,
-	-217, -216, 485, -311, 68, 68, 68, 68, -311, 280,
-	68, 68, 137, 67, 20, 73, -421, 221, -417, -418,
-	404, -411, 20, 402, 20, 20, -357, 535, 410, 411,
-	410, 411, 34, 388, -314, -313, -147, 68, -349, 288,
-	20, -228, -349, 300, 79, 123, 79, -153, 68, -221,
-	-219, -220, 64, 417, 277, 278, 68, -314, -314, -314,
-	-314, 68, -263, 223, -251, -263, -414, -428, 67, 79,
-	-416, -415, -417, 20, -414, 20, -414, -414, 94, 73,
-	-315, 229, 77, 489, 307, 308, -147, 20, -350, 281,
-	282, -351, -363, 284, 79, -333, -220, 64, -219, 64,
-	14, 13, -222, 68, 137, -432, 30, 68, -427, -426,
-	-248, -422, -263, 405, 406, 73, -414, -357, -260, 73,
-	306, -247, 67, -361, 285, 67, -359, 67, -359, 90,
-	310, -218, 274, 275, 30, 149, -218, -263, -431, -430,
-	-429, 68, 137, 136, 79, -263, -347, -352, 286, 79,
-	-284, 67, -284, 67, -360, 283, 67, 84, 40, 276,
-	137, 105, -426, -263, 68, -365, 67, 20, 68, -347,
-	68, -347, 67, 105, -284, -430, 40, -288, 136, -366,
-	-364, 239, -351, 68, 68, 68, -347, 79, 68, -263,
-	68, 137, -263, -362, 287, 68, -364, -367, 46, 79,
-	-371, -368, 67, -228, 241, 115, -371, -228, -370, -369,
-	286, 242, 67, 68, 137, -263, 238, 67, -294, -369,
-	-368, -294, 68, 68,
+	-88, -89, -90, -103, -98, -100, -148, -104, -105, -106,
+	-143, 562, -75, -76, -77, -30, -29, -28, -27, -127,
+	-133, -135, -137, -141, 568, 426, 12, 474, -13, -14,
+	-467, -15, 239, -283, -284, -285, -287, -508, -40, -41,
+	-42, -49, -50, -51, -52, -53, -62, -63, -64, -43,
+	-44, -45, -48, -46, -55, -56, -57, -58, -59, -60,
+	-61, -47, -130, -131, -65, -139, -67, -66, -72, -68,
+	-73, -129, 214, -71, 61, -81, -82, -83, -84, -86,
+	-87, 357, 363, 416, 561, 46, -149, -151, 572, 316,
+	317, 322, 142, 143, 145, 146, -31, -32, -33, -34,
+	-35, -36, -38, -37, 210, 14, 507, -16, -19, -17,
+	-20, -18, -25, -26, -24, -21, -23, -128, -134, -136,
+	-138, -142, -22, 234, 233, 37, 297, 298, 299, 361,
+	232, 211, 13, 30, 41, 339, -150, 68, 212, -152,
+	10, 574, -6, -3, -2, -114, -118, -122, -125, -126,
+	-123, -124, -4, -99, 100, 224, 563, -280, 353, 564,
+	566, 565, 71, 79, -274, -276, 357, 363, 561, 316,
+	317, 322, 510, 511, 512, 513, 514, 515, 516, 517,
+	518, 519, 520, 521, 522, 523, 524, 525, 526, 527,
+	528, 529, 530, 531, 533, 534, 535, 536, 537, 538,
+	539, 540, 541, 542, 543, 544, 545, 546, 477, 548,
+	549, 550, 551, 337, 338, 504, 251, 275, 387, 278,
+	285, 142, 161, 155, 183, 175, 150, 255, 290, 256,
+	78, 145, 460, 90, 438, 413, 148, 270, 272, 274,
+	277, 257, 171, 166, 269, 253, 164, 258, 39, 259,
+	188, 260, 261, 509, 434, 336, 440, 282, 411, 165,
+	435, 273, 437, 190, 194, 452, 159, 160, 442, 454,
+	187, 231, 42, 506, 243, 455, 192, 186, 182, 463,
+	286, 284, 185, 158, 181, 254, 50, 196, 195, 197,
+	407, 408, 409, 410, 262, 263, 351, 451, 178, 167,
+	341, 151, 22, 458, 238, 439, 364, 264, 279, 287,
+	191, 193, 245, 250, 508, 415, 249, 283, 456, 163,
+	242, 271, 237, 459, 152, 366, 265, 146, 276, 453,
+	462, 49, 379, 157, 149, 228, 143, 247, 252, 11,
+	266, 267, 268, 289, 288, 280, 179, 244, 184, 169,
+	156, 180, 144, 246, 461, 380, 559, 339, 396, 177,
+	174, 248, 221, 457, 441, 147, 400, 381, 172, 291,
+	555, 556, 557, 352, 335, 292, 293, 170, 235, 432,
+	433, 294, 296, 406, 328, 382, 414, 388, 383, 203,
+	300, 444, 446, 189, 558, 301, 302, 303, 436, 304,
+	307, 308, 309, 310, 311, 312, 313, 314, 315, 318,
+	319, 321, 305, 306, 323, -356, -354, -280, 561, 363,
+	357, 339, 316, 317, 322, -191, -280, 573, -158, 38,
+	-203, -280, -158, -71, -14, -13, -150, -151, -203, 218,
+	-288, 23, 411, -79, 412, 214, 68, -280, -9, -90,
+	-8, -97, -69, -148, 416, -286, -280, 297, 297, -286,
+	218, -280, 249, 395, -406, 224, -360, -333, 250, -359,
+	-335, -362, -336, 31, 210, 212, 211, 246, 14, 361,
+	220, 12, 10, 362, 232, 24, 25, 27, 13, 363,
+	365, 28, 366, 369, 370, 371, 41, 374, 375, 239,
+	71, 79, 74, 257, -190, -280, -311, -305, 97, 240,
+	-307, -300, -301, -303, -447, -441, -299, 68, 124, 125,
+	132, 98, -302, -390, 35, 100, 514, 475, -259, -260,
+	-261, -262, -263, -280, -442, -440, 74, 81, 84, 87,
+	88, 86, 85, 168, 83, 75, 136, 137, -151, 71,
+	-462, 522, -275, 543, 548, 549, 550, 551, 542, 46,
+	-412, -420, 217, -418, 135, 173, 235, 169, 12, 130,
+	406, 170, 519, 539, 477, 523, 546, 516, 517, 511,
+	512, 513, 515, 524, 526, 538, -421, 534, 544, 545,
+	532, 547, 73, 72, 537, 536, 525, 520, 521, 527,
+	510, 518, 528, 529, 535, 540, 541, 345, 90, 346,
+	347, 467, 340, 348, 224, 411, 55, 349, 350, 351,
+	352, 353, 474, 354, 56, 355, 344, 239, 396, 356,
+	172, 189, 479, 478, 480, 471, 468, 466, 469, 470,
+	472, 473, 530, 531, 533, -108, -109, 553, -167, -168,
+	-252, 19, 6, 7, 8, 9, -501, 413, 508, 222,
+	15, 321, 147, 144, -347, 147, 96, 152, 151, -347,
+	300, 245, 358, 221, 321, 337, 396, 304, 307, 308,
+	318, 249, -327, -325, -408, 294, 290, 219, 229, 228,
+	91, 467, 217, 372, -479, -480, 207, 208, 209, -469,
+	500, -468, -280, 347, 26, 218, 358, 446, 444, 445,
+	447, 448, 449, 450, -54, -422, -405, 441, 440, -292,
+	433, 439, 431, 443, 434, 338, 306, 319, 305, 210,
+	570, 501, -271, 382, 414, 245, 464, 465, 359, 415,
+	452, 453, 435, 90, 176, 173, 221, 218, 337, 467,
+	396, 304, -479, 249, 395, 40, -353, 406, -352, -354,
+	452, 453, 463, 72, 73, 451, -271, 90, 432, 432,
+	-168, -252, -167, -149, -151, -100, -467, 219, 337, 396,
+	249, 220, 218, 221, 321, 467, 297, 358, 245, 304,
+	307, 308, 318, -476, 80, -1, 104, 574, -305, -305,
+	-6, 577, -6, -305, -280, -280, 139, -209, -213, -210,
+	-212, -211, -215, -214, 173, 174, 135, 177, 179, 180,
+	181, 182, 183, 184, 185, 186, 187, 188, 30, 189,
+	235, 169, 170, 171, 172, 190, 155, 175, 505, 198,
+	156, 199, 157, 200, 158, 201, 159, 160, 202, 161,
+	164, 165, 166, 163, 138, 68, 138, 74, -174, 241,
+	-203, 138, -174, -168, -167, -168, -203, -264, -280, 413,
+	107, -79, -79, 412, 411, -470, -471, -472, -474, 214,
+	412, 411, 138, 257, 15, -286, -286, 66, -203, -335,
+	249, -360, -333, 35, 65, 139, 222, 139, 65, 68,
+	359, 337, 396, 360, 467, 218, 372, 221, 249, 373,
+	337, 396, 218, 221, 467, 249, 337, 218, 221, 396,
+	249, 373, 431, 432, 221, 26, 364, 367, 368, 432,
+	-426, 463, 139, 96, 93, 94, 95, -305, 114, -318,
+	107, 108, 109, 110, 111, 112, 113, 121, 120, 131,
+	124, 125, 126, 127, 128, 129, 130, 122, 123, 117,
+	97, 115, 119, 116, 99, -151, -305, -311, 46, -303,
+	-303, -303, -303, -390, -309, -305, 68, 68, 138, 74,
+	-305, 68, 68, 68, 68, 68, 68, 68, 68, 68,
+	68, 68, 68, -419, 68, 68, -315, -316, 68, 68,
+	-299, -264, 68, 74, 74, 68, 68, 68, 74, -316,
+	-316, 68, 68, 68, 68, 68, 68, 68, 68, 68,
+	68, 68, 68, 68, 68, 68, 68, 68, 68, -168,
+	139, 68, -110, 38, -170, 21, -152, -151, 20, 31,
+	32, 31, 32, 31, 32, 31, 32, 81, -107, 74,
+	203, -107, 221, 456, 507, 150, 151, 152, -189, -280,
+	-191, -182, -280, -323, 224, 221, -323, -323, -323, -323,
+	309, -323, -323, 220, -323, 218, 218, 218, -323, -481,
+	154, 139, -469, -173, 68, -291, 115, 119, 23, 247,
+	247, -291, 284, -509, -510, 15, 117, -289, -510, -289,
+	-289, -291, -511, 220, 442, 42, 248, 247, -169, -170,
+	-169, 436, 432, -376, 437, 438, -293, -510, -292, -291,
+	-291, -292, -291, -291, -291, 218, 221, 467, 304, 571,
+	-509, 247, -509, 30, 30, -406, -203, -406, 501, -272,
+	-280, -406, -406, -482, 223, -335, 249, 249, 139, 107,
+	23, -355, 107, 118, -354, -354, -354, -355, -355, -224,
+	40, -279, 135, -280, 74, -224, 40, -505, -504, -203,
+	-169, -152, -151, 69, 69, 69, -406, -406, -406, -406,
+	-406, -406, -406, 221, -406, -286, -182, -191, 224, -406,
+	-406, 309, -406, -407, -475, 31, -413, 204, 205, 206,
+	32, 33, -3, -116, -115, 101, 102, 104, 564, 353,
+	563, 567, 561, -354, 40, -401, 380, 379, -395, -397,
+	68, -396, 68, -396, -396, -396, 68, 68, -398, 68,
+	-398, -398, -395, -399, 68, -399, -400, 68, -399, -280,
+	-310, -311, -280, 38, -415, 46, -148, 68, 30, 68,
+	-280, -416, 46, -148, 68, 30, -169, -110, -170, 138,
+	81, 74, -477, 187, 179, -79, 107, 69, 139, -473,
+	87, 88, -477, -280, -93, 74, -7, -8, -9, -10,
+	-39, -69, -148, -443, -441, 68, 31, 405, 65, 15,
+	-361, 218, 467, 358, 245, 221, 337, -359, -342, -339,
+	-337, -279, -335, -338, -337, -364, -264, 432, -111, 417,
+	296, -305, -305, -305, -305, -305, 86, 97, 335, 87,
+	88, -300, -319, 31, 292, 293, -301, -301, -301, -301,
+	-301, -301, -301, -301, -301, -301, -301, -301, -308, -317,
+	-390, 68, 117, 115, 119, 116, 99, -303, -303, -301,
+	-301, 69, 139, -466, -465, 101, -305, -305, -280, -463,
+	-464, 481, 482, 483, 484, 485, 486, 487, 488, 489,
+	490, 491, 349, 344, 350, 348, 340, 356, 351, 352,
+	172, 498, 499, 492, 493, 494, 495, 496, 497, -310,
+	-310, -305, -463, -310, -258, 32, 31, -311, -311, -311,
+	69, -305, -478, 333, 332, 334, -171, -280, -310, 69,
+	69, 69, 81, -311, -311, -310, -301, -310, -464, -258,
+	-258, -311, -311, -258, -258, -258, -258, 126, -258, -258,
+	-258, -258, -258, -258, -258, -258, 69, -169, -109, -424,
+	-423, -305, 40, 554, -110, -305, -166, -165, -305, -502,
+	74, 575, 222, -203, 457, 458, 148, 151, 150, 34,
+	68, 68, 138, -203, 97, -323, -484, -280, -343, -340,
+	-337, -280, -280, -323, -280, -333, -280, -280, -323, -323,
+	-323, -203, -482, -468, 34, -172, -280, -248, 16, -303,
+	-303, -337, 249, -509, -291, -273, -272, -293, -288, -293,
+	-293, -248, -510, -291, -291, -293, -289, -248, -280, 432,
+	-248, -248, -376, -290, -280, -290, -323, -272, -273, -273,
+	-203, -239, -243, 272, -240, 215, 212, 239, 310, 242,
+	211, -245, 377, 251, -324, 255, 78, 256, 413, 257,
+	220, 259, 260, 261, 231, 262, 263, 264, 406, 265,
+	266, 267, 268, 360, 6, 323, 313, 314, 40, -484,
+	-484, 30, -343, -337, -280, -161, -156, -160, -157, -162,
+	-251, -253, 63, -159, 68, -203, -151, -280, 31, 405,
+	-358, 405, 31, -333, -352, -348, 74, 406, -338, -357,
+	65, 135, -423, -355, -355, -355, -357, -357, 134, 139,
+	-506, 456, 457, 207, -110, -205, -203, -484, -342, -333,
+	-280, -205, -205, -406, -280, 68, 35, -280, -280, -406,
+	-280, -153, -154, 126, -305, -280, -153, -117, -115, 103,
+	-305, -6, 563, -305, -6, -6, -305, -6, -305, -404,
+	381, 81, 81, -266, 74, -266, 81, 81, 81, 69,
+	74, -346, 65, -417, -312, -461, 553, -176, 69, -171,
+	-459, -460, -171, -175, -280, -417, -176, 69, -459, -110,
+	-110, -280, 23, -79, -477, -79, -471, 138, 139, -172,
+	-361, -341, -338, -363, 126, -280, -349, 139, 507, 578,
+	72, 222, -494, -493, 397, 69, 139, -427, 223, 474,
+	74, 575, 203, 86, 335, 87, 88, -390, -311, -308,
+	-303, -303, -301, -301, -306, 236, -306, 96, -305, -304,
+	-465, 103, -305, 34, 139, 66, 138, 69, 69, 15,
+	15, 69, -305, 69, 69, 69, 69, 15, 15, -305,
+	69, 138, 69, 69, 69, 69, 66, 69, 139, 69,
+	139, -311, -305, 69, 69, -305, -305, -305, -311, 69,
+	-305, -305, -305, -305, -305, -305, -305, -305, -371, 427,
+	139, 69, 74, 139, 22, 139, -163, 36, 37, -270,
+	-269, 74, -107, 149, 152, 152, 151, -190, -192, -193,
+	-194, -195, -196, 117, 140, 141, -183, -184, -185, -186,
+	-209, -264, 174, 177, 179, 180, 181, 182, 183, 184,
+	185, 186, 187, 188, 189, 235, 169, 170, 171, 172,
+	190, 155, 175, 156, 157, 158, 159, 160, 161, 164,
+	165, 166, 163, -280, -173, 35, -203, -487, 384, -495,
+	139, 40, -493, 467, 65, -280, 23, -326, 66, -146,
+	15, -203, -203, -203, -216, 34, 15, -155, -204, -280,
+	68, 69, 139, -305, -334, 66, -280, -273, -248, -203,
+	-280, -248, -248, -291, -293, -293, -289, 138, -272, -173,
+	139, -199, 241, -206, -200, -202, -264, -296, -201, 229,
+	-456, 227, 225, 91, 230, 281, 92, 220, -241, 241,
+	220, 92, 226, 230, 225, 241, 241, 241, -242, 220,
+	-277, 107, -277, -271, 134, -277, -277, -277, -277, 258,
+	258, -277, -277, -277, -277, -277, -277, -277, -277, -277,
+	-277, -277, -277, -277, -277, 68, -277, -277, -488, 384,
+	30, 343, -495, -297, 339, 41, 337, -410, 406, 392,
+	393, -147, 337, 30, -254, -255, -256, -257, 53, 57,
+	59, 54, 55, 56, 60, 30, 139, -278, -282, 34,
+	-280, 74, -253, -278, -151, -156, -161, -278, 68, 222,
+	68, 107, -357, -357, -357, 40, -279, -504, 463, 457,
+	139, 65, -203, -183, -191, 65, -280, -247, 139, -246,
+	15, -281, -280, 34, 74, 138, -247, 104, -6, 102,
+	-121, -120, -119, 105, 561, 567, 104, 104, 104, 69,
+	69, 69, 139, 69, 69, 69, 139, 69, 139, -430,
+	437, 39, 139, 68, 69, 139, 46, 139, 107, 69,
+	139, 69, 46, 138, 413, -79, -280, -441, 69, -363,
+	139, 222, 138, 138, -339, 363, -279, -341, 20, 507,
+	-264, 38, -270, 575, 69, -306, -306, 96, -303, -300,
+	69, 104, -305, 102, -207, -209, 379, 380, -208, -214,
+	135, 173, 235, 172, 171, 169, 379, 380, -224, -280,
+	-305, -305, 69, -305, -305, 15, -280, -224, -301, -305,
+	-168, 69, -370, -371, -370, 69, 69, 69, 69, -370,
+	69, 69, 69, 69, 69, 69, 69, 69, 68, -423,
+	-430, -305, -305, -165, -164, 43, 576, 139, 107, 170,
+	152, 371, 69, 139, -264, 69, 139, -209, 34, 34,
+	-490, 439, 386, -277, -298, -297, -340, 249, -280, -203,
+	464, 218, 65, 327, 328, 329, 330, -321, -322, -320,
+	-324, -484, 68, 68, 68, -391, 301, -209, -203, -155,
+	-280, 15, 139, -483, 138, -1, -280, -333, -291, -248,
+	-510, -248, -291, -291, -293, -280, 34, -240, 215, 212,
+	68, -209, -201, -455, -456, -323, -280, 92, -455, 92,
+	68, -175, -280, -280, -280, 92, 92, -175, 31, -280,
+	-175, -280, 81, 81, -277, -277, 81, 74, 74, 74,
+	-277, -277, 81, 74, -282, 81, 81, 81, 81, 40,
+	74, -229, 40, 269, 273, 270, 271, 272, 81, 40,
+	81, 40, 81, 40, -280, 68, -457, -458, 74, -264,
+	68, -490, -277, 343, 107, -298, 339, 41, 137, 341,
+	40, 388, 389, 403, 336, 81, 81, 394, -132, 78,
+	31, -177, 215, 212, -484, -345, -344, -264, -160, -160,
+	-160, -160, 53, 53, 53, 58, 53, 58, 53, -256,
+	-345, -162, -173, -282, -278, 69, -500, -499, -498, -496,
+	61, 223, 62, -310, -341, -294, 74, -348, -203, -203,
+	69, -203, -248, -154, -156, -280, 74, -280, 126, -248,
+	100, -6, -117, -119, -305, -6, -305, 563, 353, 564,
+	74, 81, 81, -438, 423, 418, 420, 92, -312, -425,
+	-424, 46, -148, -171, -417, -460, -423, -280, 46, -148,
+	-417, -280, 81, 15, -338, -333, 126, 126, -280, 364,
+	-349, 74, 385, 74, -279, 218, 576, -270, -300, -305,
+	69, -217, 160, 159, -217, 69, -396, -396, -395, -398,
+	-395, -217, -217, 69, 69, 23, 69, 69, 69, -305,
+	69, 69, 139, -414, 476, -370, -370, -370, -370, -370,
+	-370, -370, -370, -370, -370, -370, -370, -370, -314, -313,
+	241, -438, 44, 45, -269, 74, 74, 74, 74, -194,
+	-209, 216, -185, 40, -148, -148, -491, 78, -485, 74,
+	-280, -492, 78, 387, -486, -280, -178, 337, -145, 23,
+	-172, -205, -203, -320, -271, 134, 260, 304, -197, -198,
+	-199, -197, -197, -392, 303, 20, 81, 125, -174, 66,
+	138, -156, -204, -280, 126, 69, -248, -280, -248, -248,
+	-291, -148, -384, -382, 241, -221, -220, -218, 86, 97,
+	40, 377, -219, 78, 134, 274, 252, 275, -238, -295,
+	65, 383, 225, 91, 92, 365, -296, -453, -280, -451,
+	-453, -280, -451, -451, -323, -305, -280, 507, 38, -244,
+	325, 326, -224, -224, 74, 74, -225, 252, -205, 69,
+	139, 107, 124, -364, -491, -485, 107, 74, -492, 81,
+	40, 81, 137, 390, -411, 74, 139, -177, -177, -248,
+	139, 107, -180, -179, 65, 66, -181, 65, -179, 53,
+	53, -248, -173, -498, -497, 23, -456, -456, -456, 69,
+	69, -250, 17, 138, -250, 104, 102, 104, 104, -280,
+	69, 69, -402, 555, -434, -436, 418, 20, 20, 13,
+	69, -417, -417, -333, -349, 406, 20, -203, 576, -305,
+	69, -305, 69, 74, -168, 20, -402, -187, -209, -299,
+	74, -489, 397, 74, 74, -132, -177, 222, 31, 10,
+	13, 14, -144, 23, 38, 68, -277, -277, -277, -280,
+	69, 139, 69, 69, -393, 240, 81, 125, 81, 125,
+	81, -278, -156, -280, -248, -483, 138, -248, -248, 69,
+	139, -280, -218, 86, -301, 74, -226, -279, 135, -227,
+	40, 273, 269, -228, 40, 253, 254, -230, 68, 281,
+	13, 92, 92, -203, 68, 68, 66, 291, 68, 68,
+	-453, 69, 218, 68, 253, 254, 69, -458, 74, 137,
+	69, -489, 74, 340, 40, 81, 40, 391, -484, -168,
+	-344, -423, -305, 68, -305, 68, 53, 19, 17, -249,
+	18, 20, 126, -249, 224, -6, 564, 353, -231, 556,
+	74, 20, 74, -432, 74, -345, 74, -113, -428, -376,
+	69, 69, -370, -373, -372, -375, 424, 283, 430, -311,
+	-231, 506, 20, 507, -333, 396, 74, -328, -330, -264,
+	68, -224, -226, 74, -222, -223, -245, -199, -112, -111,
+	-222, -394, 143, 81, 125, 81, 81, -248, -248, -382,
+	-385, 46, 81, 68, -418, -329, 68, -328, -328, -454,
+	327, 328, 329, 331, 330, -454, -328, -328, 68, -351,
+	-350, 282, 97, -203, -384, 81, 340, -169, -172, -452,
+	-280, 225, 20, 20, -305, -311, -280, -280, -267, 557,
+	81, 74, 420, -403, 558, -437, 423, -431, -429, 418,
+	419, 420, 421, 69, -374, 99, 390, 394, -305, -267,
+	-188, -280, 74, 385, 74, 66, -172, -140, 320, 69,
+	139, -395, -305, -365, 241, 139, -245, -113, -365, -409,
+	151, 302, 507, 81, 125, 81, -168, -389, -386, 68,
+	-245, 243, 117, 69, -305, -315, -233, -232, 503, -328,
+	69, 69, 69, 69, -328, 282, -381, 507, 312, 69,
+	-464, 69, 69, 139, 68, 20, 74, -439, 223, -435,
+	-436, 422, -429, 20, 420, 20, 20, -374, 553, 428,
+	429, 428, 429, 34, 406, 68, -277, -331, -330, -163,
+	69, -366, 290, 20, -245, -366, 302, 81, 125, 81,
+	-169, -389, -245, -388, -387, 288, 244, 68, 69, -237,
+	-235, -236, 65, 435, 279, 280, 69, -331, -331, -331,
+	-331, 69, 311, 311, -280, 225, -268, -280, -432, -446,
+	68, 81, -434, -433, -435, 20, -432, 20, -432, -432,
+	96, 74, -305, 74, -332, 231, 78, 507, 325, 326,
+	-163, 20, -367, 283, 284, -368, -380, 286, 81, 69,
+	139, -280, 240, 68, -311, -350, -236, 65, -235, 65,
+	14, 13, -238, 69, 139, -450, 30, 69, -445, -444,
+	-265, -440, -280, 423, 424, 74, -432, -374, 69, -277,
+	74, 324, -264, 68, 315, -378, 287, 68, -376, 68,
+	-376, 92, 328, -387, -386, -311, 69, -234, 276, 277,
+	30, 151, -234, -280, -449, -448, -447, 69, 139, 138,
+	81, -280, -364, 68, -369, 288, 81, -301, 68, -301,
+	68, -377, 285, 68, 69, 86, 40, 278, 139, 107,
+	-444, -280, 69, -364, -383, 68, 20, 69, -364, 69,
+	-364, 68, 107, -301, -448, 40, -305, 138, 69, -384,
+	-368, 69, 69, 69, -364, 81, 69, -280, 69, -379,
+	289, 69, 81,
 }
 
 var yyDef = [...]int{
 	23, -2, 1, 2, 3, 19, 4, 20, 21, 22,
 	24, 25, 26, 27, 28, 29, 30, 31, 32, 33,
 	34, 35, 36, 37, 38, 39, 40, 41, 42, 43,
-	44, 45, 46, 47, 48, 49, 50, 51, 0, 245,
-	246, 247, 749, 750, 751, 752, 753, 0, 0, 0,
-	0, 523, 524, 0, 495, 0, 0, 0, 0, 0,
-	0, 389, 390, 391, 392, 393, 394, 395, 396, 397,
-	398, 399, 400, 401, 402, 403, 404, 405, 406, 407,
-	408, 409, 410, 411, 412, 413, 414, 415, 336, 337,
-	338, 339, 340, 341, 0, 270, 266, 206, 207, 208,
-	209, 277, 278, 366, 0, 0, 0, 0, 606, -2,
-	53, 259, 0, 250, 250, 754, 755, 756, 757, 758,
-	759, 760, 761, -2, 536, 0, 496, 497, 498, 499,
-	500, 501, 502, 503, 504, 505, 506, 507, 325, 326,
-	327, 321, 322, 324, 323, -2, 0, 536, 0, 0,
-	0, 626, 0, 0, 641, 663, 23, 0, 7, 9,
-	10, 11, 12, 13, 14, 15, 16, 17, 18, 0,
-	0, 19, 0, 19, 0, 0, 0, 1046, 1047, 1048,
-	1049, -2, 1513, 1492, 1662, 1663, 1664, 1665, 1666, 1667,
-	1668, 1669, 1670, 1671, 1672, 1673, 1674, 1675, 1676, 1677,
-	1678, 1679, 1680, 1681, 1682, 1683, 1684, 1685, 1686, 1687,
-	1688, 1689, 1690, 1691, 1692, 1693, 1694, 1695, 1696, 1697,
-	1698, 1699, 1700, 1701, 1702, 1459, 1460, 1461, 1462, 1463,
-	1464, 1465, 1466, 1467, 1468, 1469, 1470, 1471, 1472, 1473,
-	1474, 1475, 1476, 1477, 1478, 1479, 1480, 1481, 1482, 1483,
-	1484, 1485, 1486, 1487, 1488, 1489, 1490, 1491, 1493, 1494,
-	1495, 1496, 1497, 1498, 1499, 1500, 1501, 1502, 1503, 1504,
-	1505, 1506, 1507, 1508, 1509, 1510, 1511, 1512, 1514, 1515,
-	1516, 1517, 1518, 1519, 1520, 1521, 1522, 1523, 1524, 1525,
+	44, 45, 46, 47, 48, 49, 50, 51, 52, 53,
+	54, 0, 253, 254, 255, 784, 785, 786, 787, 788,
+	789, 790, 791, 792, 0, 0, 0, 0, 555, 556,
+	0, 522, 0, 0, 0, 0, 0, 0, 414, 415,
+	416, 417, 418, 419, 420, 421, 422, 423, 424, 425,
+	426, 427, 428, 429, 430, 431, 432, 433, 434, 435,
+	436, 437, 438, 439, 440, 441, 347, 348, 349, 350,
+	351, 352, 0, 278, 274, 214, 215, 216, 217, 285,
+	286, 389, 0, 0, 0, 0, 638, -2, 61, 0,
+	0, 0, 267, 0, 258, 258, 793, 794, 795, 796,
+	797, 798, 799, 800, -2, 568, 0, 523, 524, 525,
+	526, 527, 528, 529, 530, 531, 532, 533, 534, 535,
+	536, 537, 538, 335, 336, 337, 331, 332, 334, 333,
+	-2, 0, 568, 0, 0, 0, 658, 0, 0, 673,
+	692, 23, 0, 7, 9, 10, 11, 12, 13, 14,
+	15, 16, 17, 18, 0, 0, 19, 0, 19, 0,
+	0, 0, 1106, 1107, 1108, 1109, -2, 1573, 1552, 1718,
+	1719, 1723, 1737, 1738, 1739, 1740, 1741, 1742, 1743, 1744,
+	1745, 1746, 1747, 1748, 1749, 1750, 1751, 1752, 1753, 1754,
+	1755, 1756, 1757, 1758, 1759, 1760, 1761, 1762, 1763, 1764,
+	1765, 1766, 1767, 1768, 1769, 1770, 1771, 1772, 1773, 1774,
+	1775, 1776, 1777, 1519, 1520, 1521, 1522, 1523, 1524, 1525,
 	1526, 1527, 1528, 1529, 1530, 1531, 1532, 1533, 1534, 1535,
 	1536, 1537, 1538, 1539, 1540, 1541, 1542, 1543, 1544, 1545,
-	1546, 1547, 1548, 1549, 1550, 1551, 1552, 1553, 1554, 1555,
-	1556, 1557, 1558, 1559, 1560, 1561, 1562, 1563, 1564, 1565,
-	1566, 1567, 1568, 1569, 1570, 1571, 1572, 1574, 1575, 1576,
-	1577, 1578, 1579, 1580, 1581, 1582, 1583, 1584, 1585, 1586,
-	1587, 1588, 1589, 1590, 1591, 1592, 1593, 1594, 1595, 1596,
-	1597, 1598, 1599, 1600, 1601, 1602, 1603, 1604, 1605, 1606,
-	1607, 1608, 1609, 1610, 1611, 1612, 1613, 1614, 1615, 1616,
-	1617, 1618, 1619, 1620, 1621, 1622, 1623, 1624, 1625, 1626,
-	1627, 1628, 1629, 1630, 1631, 1632, 1633, 1634, 1635, 1636,
-	1637, 1638, 1639, 1640, 1641, 1642, 1643, 1644, 1645, 1646,
-	1647, 1648, 1649, 1650, 1651, 0, 243, 241, 1492, 1513,
-	1573, 1606, 0, 766, 0, 584, 0, 589, 1009, 584,
-	271, 525, 526, 626, 626, 493, 0, 307, 0, 1505,
-	311, 0, 0, 0, 490, 301, 302, 303, 304, 305,
-	306, 0, 0, 748, 0, 0, 297, 0, 265, 1564,
-	0, 0, 0, 0, 0, 0, 150, 837, 152, 839,
-	156, 163, 0, 0, 168, 169, 172, 173, 174, 175,
-	176, 0, 180, 0, 182, 185, 0, 187, 188, 0,
-	191, 192, 193, 0, 203, 204, 205, 840, 841, 842,
-	-2, 81, 764, 1430, 1325, 0, 1332, 1333, 1344, 1355,
-	1119, 1120, 1121, 1122, 0, 0, 0, 0, 0, 1129,
-	1130, 0, 1144, 1666, 0, 1136, 1137, 1138, 1139, 1140,
-	90, 102, 103, 1374, 1375, 1376, 1377, 1378, 1379, 1380,
-	1381, 1382, 1383, 0, 1298, 1106, 1046, 0, 1674, 0,
-	1694, 1699, 1700, 1701, 1702, 1693, 0, 0, 1283, 0,
-	1273, 0, 0, -2, -2, 0, 0, 1632, -2, 1671,
-	1690, 1698, 1675, 1697, 1668, 1669, 1663, 1664, 1665, 1667,
-	1676, 1678, 1689, 0, 1685, 1695, 1696, 0, 0, 104,
-	105, -2, -2, -2, -2, -2, -2, -2, -2, -2,
-	-2, -2, -2, -2, 1289, -2, 1291, 1292, 1294, 1295,
-	1296, 1297, -2, 1300, 1301, 1302, -2, -2, 1305, 1306,
-	1307, 1308, 1309, 1310, 1313, -2, 1315, -2, -2, 1285,
-	1286, 1287, 1288, 1277, 1278, 1279, 1280, 1281, 1282, -2,
-	-2, -2, 626, 699, 0, -2, 0, 0, 0, 646,
-	649, 652, 655, 0, 54, 55, 260, 261, 249, 251,
-	0, 255, 0, 248, 0, 0, 0, 869, 869, 869,
-	869, 869, 0, 869, 0, 0, 0, 869, 844, 845,
-	846, 867, 868, 915, 541, 537, 538, 539, 540, 614,
-	0, 616, 619, 470, 417, 0, 0, 470, 0, 430,
-	423, 0, 0, 0, 470, 0, 0, 621, 621, 0,
-	473, 480, 470, 470, -2, 470, 470, 470, 0, 0,
-	436, 437, 438, 423, 0, 423, 442, 443, 444, 455,
-	456, 481, 1454, 0, 0, 366, 0, 366, 0, 366,
-	366, 543, 1564, 0, 0, 217, 1632, 222, 0, 1512,
-	1578, 1527, 0, 0, 1545, 0, -2, 0, 287, 621,
-	0, 627, 0, 626, 0, 0, 366, 366, 366, 366,
-	366, 366, 366, 366, 0, 0, 0, 366, 0, 0,
-	664, 665, 660, 661, 662, 666, 667, 5, 6, 19,
-	0, 0, 0, 0, 0, 0, 60, 59, 0, 1431,
-	1449, 1386, 1387, 1388, 1436, 1390, 1440, 1440, 1440, 1440,
-	1418, 1419, 1420, 1421, 1422, 1423, 1424, 1425, 1426, 0,
-	0, 1429, 1409, 1438, 1438, 1438, 1436, 1433, 1391, 1392,
-	1393, 1394, 1395, 1396, 1397, 1398, 1399, 1400, 1401, 1402,
-	1403, 1404, 1443, 1443, 1446, 1443, 0, 1323, 0, 0,
-	0, 0, 588, 0, 0, 621, -2, 0, 494, 308,
-	1050, 0, 0, 312, 313, 0, 0, 328, 0, 331,
-	316, 317, 318, 0, 130, 0, 299, 300, 0, 0,
-	267, 0, 0, 0, 367, 0, 0, 0, 0, 0,
-	0, 160, 157, 164, 167, 177, 184, 0, 196, 198,
-	201, 158, 165, 170, 171, 178, 199, 159, 161, 162,
-	166, 200, 202, 179, 183, 197, 181, 186, 189, 190,
-	195, 0, 131, 0, 0, 0, 0, 0, 1331, 0,
-	0, 1363, 1364, 1365, 1366, 1367, 1368, 1369, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, -2, 1325, 0, 0,
-	1125, 1126, 1127, 1128, 1131, 0, 1145, 0, 0, 0,
-	1384, 0, 1323, 1323, 0, 1323, 1319, 0, 0, 0,
-	0, 0, 0, 1323, 1259, 0, 0, 1261, 1274, 0,
-	0, 1263, 1264, 0, 1267, 1268, 1323, 0, 1323, 1272,
-	1255, 1256, 0, 1319, 1319, 0, 0, 1319, 1319, 1319,
-	1319, 1319, 1319, 1319, 1319, 1319, 1319, 1319, 1319, 0,
-	621, 0, 0, 609, 0, 590, 0, 643, 645, 0,
-	647, 648, 650, 651, 653, 654, 656, 657, 56, 0,
-	0, 253, 0, 258, 0, 763, 0, 0, 779, 0,
-	0, 0, 0, 0, 0, 0, 0, 869, 869, 869,
-	0, 543, 542, 0, 615, 0, 0, 672, 0, 0,
-	0, 423, 470, 428, 429, 425, 424, 476, 477, 473,
-	0, 473, 473, 672, 0, 449, 450, 451, 470, 470,
-	457, 622, 458, 459, 473, 0, 478, 479, 672, 0,
-	0, 672, 672, 0, 467, 468, 469, 0, 0, 869,
-	0, 486, 425, 440, 425, 1455, 1456, 0, 878, 0,
-	0, 0, 489, 0, 0, 0, 544, 210, 0, 0,
-	0, 0, 0, 0, 239, 240, 0, 0, 0, 0,
-	0, 230, 233, 1003, 1004, 834, 835, 234, 235, 279,
-	280, 0, 590, 642, 644, 638, 639, 640, 0, 0,
-	0, 0, 0, 0, 0, 0, 519, 0, 521, 0,
-	0, 680, 674, 676, 743, 90, 680, 8, 77, 74,
-	0, 19, 0, 0, 19, 19, 0, 19, 244, 0,
-	1452, 1450, 1451, 1389, 1437, 0, 1414, 0, 1415, 1416,
-	1417, 0, 0, 1410, 0, 1411, 1412, 1413, 1405, 0,
-	1406, 1407, 0, 1408, 242, 0, 1324, 767, 0, 563,
-	576, 558, 0, 565, 0, 1010, 545, 576, 547, 0,
-	565, 590, 612, 590, 0, 309, 0, 314, 0, 0,
-	330, 332, 333, 334, 319, 320, 491, 295, 296, 288,
-	289, 290, 291, 292, 293, 294, 298, 100, 0, 268,
-	269, 0, 0, 0, 144, 145, 146, 147, 148, 149,
-	151, 135, 511, 513, 826, 838, 0, 829, 0, 154,
-	194, 127, 0, 0, 1326, 1327, 1328, 1329, 1330, 1334,
-	0, 1336, 1338, 1340, 1342, 0, 1360, -2, -2, 1107,
-	1108, 1109, 1110, 1111, 1112, 1113, 1114, 1115, 1116, 1117,
-	1118, 1345, 1358, 1359, 0, 0, 0, 0, 0, 0,
-	1356, 1356, 1351, 0, 1123, 0, 1142, 1146, 0, 0,
-	0, 91, 1318, 1226, 1227, 1228, 1229, 1230, 1231, 1232,
-	1233, 1234, 1235, 1236, 1237, 1238, 1239, 1240, 1241, 1242,
-	1243, 1244, 1245, 1246, 1247, 1248, 1249, 1250, 1251, 1252,
-	1253, 1254, 0, 0, 1325, 0, 0, 0, 1320, 1321,
-	0, 0, 0, 1214, 0, 0, 1220, 1221, 1222, 0,
-	571, 0, 1284, 1260, 1275, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	698, 700, 0, 580, 582, 583, 0, 610, 623, 628,
-	629, 632, 52, 57, 262, 263, 0, 0, 257, 0,
-	-2, 781, 0, 619, 0, 0, 793, 364, 820, 0,
-	0, 836, 859, 865, 0, 0, 0, 893, 0, 617,
-	0, 0, 712, 416, 0, 471, 472, 420, 1564, 425,
-	672, 431, 426, 432, 475, 433, 434, 435, 0, 672,
-	672, 470, 473, 460, 474, 473, 463, 464, 0, 482,
-	487, 483, 0, 485, 439, 441, 619, 343, 344, 0,
-	0, 0, 349, 1457, 1457, 0, 1457, 1457, 1457, 1457,
-	0, 0, 1457, 1457, 1457, 1457, 1457, 1457, 1457, 1457,
-	1457, 1457, 1457, 1457, 1457, 1457, 0, 879, 361, 0,
-	0, 364, 810, 685, 0, 686, 687, 683, 714, 738,
-	738, 0, 738, 718, 1009, 211, 212, 0, 214, 215,
-	216, 223, 218, 220, 0, 0, 224, 236, 237, 238,
-	0, 0, 0, 228, 229, 0, 0, 282, 283, 285,
-	0, 608, 508, 1007, 509, 510, 514, 0, 516, 517,
-	518, 781, 0, 815, 672, 0, 681, 0, 677, 744,
-	0, 746, 0, 672, 0, 75, 19, 0, 68, 65,
-	0, 0, 0, 0, 0, 1432, 1385, 1453, 0, 0,
-	0, 1434, 0, 0, 0, 0, 58, 592, 552, 0,
-	557, 573, 0, 577, 0, 0, 569, 562, 566, 0,
-	0, 586, 546, 0, 0, 551, 611, 613, 1051, 0,
-	315, 329, 0, 0, 0, 0, 138, 823, 0, 139,
-	143, 133, 0, 0, 0, 828, 0, 825, 830, 0,
-	153, 0, 0, 128, 129, 884, 889, 0, 1335, 1337,
-	1339, 1341, 1343, 0, 1346, 1356, 1356, 1352, 0, 1347,
-	0, 1349, 0, 1326, 0, 1147, 0, 0, 0, 0,
-	0, 0, 1204, 1205, 0, 0, 1209, 0, 1211, 1212,
-	1213, 1215, 0, 0, 0, 1219, 0, 1258, 1276, 1262,
-	1265, 0, 1269, 0, 1271, 0, 626, 0, 1181, 1181,
-	0, 0, 0, 0, 1181, 0, 0, 0, 0, 0,
-	0, 0, 0, 1141, 0, 0, 701, 592, 0, 0,
-	0, 635, 633, 634, 264, 252, 254, 0, 0, 0,
-	769, 770, 772, 0, 775, 776, 777, 0, 782, 783,
-	785, 786, 0, -2, -2, -2, -2, -2, -2, -2,
-	-2, -2, -2, -2, 1497, -2, -2, -2, -2, -2,
+	1546, 1547, 1548, 1549, 1550, 1551, 1553, 1554, 1555, 1556,
+	1557, 1558, 1559, 1560, 1561, 1562, 1563, 1564, 1565, 1566,
+	1567, 1568, 1569, 1570, 1571, 1572, 1574, 1575, 1576, 1577,
+	1578, 1579, 1580, 1581, 1582, 1583, 1584, 1585, 1586, 1587,
+	1588, 1589, 1590, 1591, 1592, 1593, 1594, 1595, 1596, 1597,
+	1598, 1599, 1600, 1601, 1602, 1603, 1604, 1605, 1606, 1607,
+	1608, 1609, 1610, 1611, 1612, 1613, 1614, 1615, 1616, 1617,
+	1618, 1619, 1620, 1621, 1622, 1623, 1624, 1625, 1626, 1627,
+	1628, 1629, 1630, 1631, 1632, 1634, 1635, 1636, 1637, 1638,
+	1639, 1640, 1641, 1642, 1643, 1644, 1645, 1646, 1647, 1648,
+	1649, 1650, 1651, 1652, 1653, 1654, 1655, 1656, 1657, 1658,
+	1659, 1660, 1661, 1662, 1663, 1664, 1665, 1666, 1667, 1668,
+	1669, 1670, 1671, 1672, 1673, 1674, 1675, 1676, 1677, 1678,
+	1679, 1680, 1681, 1682, 1683, 1684, 1685, 1686, 1687, 1688,
+	1689, 1690, 1691, 1692, 1693, 1694, 1695, 1696, 1697, 1698,
+	1699, 1700, 1701, 1702, 1703, 1704, 1705, 1706, 1707, 1708,
+	1709, 1710, 1711, 1712, 1713, 1714, 1715, 1716, 1717, 1720,
+	1721, 1722, 1724, 1725, 1726, 0, 251, 249, 1552, 1573,
+	1633, 1666, 1718, 1719, 1723, 0, 805, 0, 616, 0,
+	621, 1069, 616, 279, 557, 558, 658, 658, 520, 0,
+	315, 0, 1565, 319, 0, 0, 0, 517, 309, 310,
+	311, 312, 313, 314, 0, 0, 783, 0, 0, 305,
+	0, 273, 1624, 0, 0, 0, 0, 0, 0, 158,
+	894, 160, 896, 164, 171, 0, 0, 176, 177, 180,
+	181, 182, 183, 184, 0, 188, 0, 190, 193, 0,
+	195, 196, 0, 199, 200, 201, 0, 211, 212, 213,
+	897, 898, 899, -2, 89, 803, 1490, 1385, 0, 1392,
+	1393, 1404, 1415, 1179, 1180, 1181, 1182, 0, 0, 0,
+	0, 0, 1189, 1190, 0, 1204, 1741, 0, 1196, 1197,
+	1198, 1199, 1200, 98, 110, 111, 1434, 1435, 1436, 1437,
+	1438, 1439, 1440, 1441, 1442, 1443, 0, 1358, 1166, 1106,
+	0, 1749, 0, 1769, 1774, 1775, 1776, 1777, 1768, 0,
+	0, 1343, 0, 1333, 0, 0, -2, -2, 0, 0,
+	1692, -2, 1746, 1765, 1773, 1750, 1772, 1743, 1744, 1738,
+	1739, 1740, 1742, 1751, 1753, 1764, 0, 1760, 1770, 1771,
+	0, 0, 112, 113, -2, -2, -2, -2, -2, -2,
+	-2, -2, -2, -2, -2, -2, -2, 1349, -2, 1351,
+	1352, 1354, 1355, 1356, 1357, -2, 1360, 1361, 1362, -2,
+	-2, 1365, 1366, 1367, 1368, 1369, 1370, 1373, -2, 1375,
+	-2, -2, 1345, 1346, 1347, 1348, 1337, 1338, 1339, 1340,
+	1341, 1342, -2, -2, -2, 658, 733, 0, -2, 0,
+	0, 0, 678, 681, 684, 687, 0, 62, 63, 0,
+	0, 0, 268, 269, 257, 259, 0, 263, 0, 256,
+	0, 0, 0, 926, 0, 926, 926, 926, 926, 0,
+	926, 926, 0, 926, 0, 0, 0, 926, 901, 902,
+	903, 924, 925, 972, 573, 569, 570, 571, 572, 646,
+	0, 648, 651, 497, 443, 0, 0, 497, 0, 456,
+	449, 0, 0, 0, 497, 0, 0, 653, 653, 0,
+	500, 507, 497, 497, -2, 497, 497, 495, 497, 0,
+	0, 462, 463, 464, 449, 0, 449, 468, 469, 470,
+	481, 482, 508, 1514, 0, 0, 389, 0, 389, 0,
+	389, 389, 575, 1624, 0, 0, 225, 1692, 230, 0,
+	1572, 1638, 1587, 0, 0, 1605, 0, -2, 0, 295,
+	653, 0, 659, 0, 658, 0, 0, 389, 389, 389,
+	389, 389, 389, 389, 0, 389, 0, 0, 0, 389,
+	389, 0, 389, 697, 693, 5, 6, 19, 0, 0,
+	0, 0, 0, 0, 68, 67, 0, 1491, 1509, 1446,
+	1447, 1448, 1496, 1450, 1500, 1500, 1500, 1500, 1478, 1479,
+	1480, 1481, 1482, 1483, 1484, 1485, 1486, 0, 0, 1489,
+	1469, 1498, 1498, 1498, 1496, 1493, 1451, 1452, 1453, 1454,
+	1455, 1456, 1457, 1458, 1459, 1460, 1461, 1462, 1463, 1464,
+	1503, 1503, 1506, 1503, 0, 1383, 0, 0, 0, 0,
+	620, 0, 0, 653, -2, 0, 521, 316, 1110, 0,
+	0, 320, 321, 0, 0, 0, 338, 0, 341, 326,
+	327, 328, 0, 138, 0, 307, 308, 0, 345, 275,
+	0, 0, 0, 390, 0, 0, 0, 0, 0, 0,
+	168, 165, 172, 175, 185, 192, 0, 204, 206, 209,
+	166, 173, 178, 179, 186, 207, 167, 169, 170, 174,
+	208, 210, 187, 191, 205, 189, 194, 197, 198, 203,
+	0, 139, 0, 0, 0, 0, 0, 1391, 0, 0,
+	1423, 1424, 1425, 1426, 1427, 1428, 1429, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, -2, 1385, 0, 0, 1185,
+	1186, 1187, 1188, 1191, 0, 1205, 0, 0, 0, 1444,
+	0, 1383, 1383, 0, 1383, 1379, 0, 0, 0, 0,
+	0, 0, 1383, 1319, 0, 0, 1321, 1334, 0, 0,
+	1323, 1324, 0, 1327, 1328, 1383, 0, 1383, 1332, 1315,
+	1316, 0, 1379, 1379, 0, 0, 1379, 1379, 1379, 1379,
+	1379, 1379, 1379, 1379, 1379, 1379, 1379, 1379, 0, 653,
+	0, 0, 641, 0, 622, 0, 675, 677, 0, 679,
+	680, 682, 683, 685, 686, 688, 689, 64, 55, 58,
+	0, 0, 0, 0, 0, 261, 0, 266, 0, 802,
+	0, 0, 818, 0, 0, 926, 0, 0, 0, 0,
+	926, 0, 0, 0, 0, 926, 926, 926, 0, 575,
+	574, 0, 647, 0, 0, 706, 0, 0, 0, 449,
+	497, 454, 455, 451, 450, 503, 504, 500, 0, 500,
+	500, 706, 0, 475, 476, 477, 497, 497, 483, 654,
+	484, 485, 500, 0, 505, 506, 706, 0, 0, 706,
+	706, 0, 493, 494, 496, 0, 0, 926, 0, 513,
+	451, 466, 451, 1515, 1516, 0, 935, 0, 0, 0,
+	516, 0, 0, 0, 576, 218, 0, 0, 0, 0,
+	0, 0, 247, 248, 0, 0, 0, 0, 0, 238,
+	241, 1063, 1064, 891, 892, 242, 243, 287, 288, 0,
+	622, 674, 676, 670, 671, 672, 0, 0, 0, 0,
+	0, 0, 0, 389, 0, 551, 0, 553, 0, 0,
+	0, 389, 0, 0, 0, 698, 699, 694, 695, 696,
+	700, 701, 8, 85, 82, 0, 19, 0, 0, 19,
+	19, 0, 19, 252, 0, 1512, 1510, 1511, 1449, 1497,
+	0, 1474, 0, 1475, 1476, 1477, 0, 0, 1470, 0,
+	1471, 1472, 1473, 1465, 0, 1466, 1467, 0, 1468, 250,
+	0, 1384, 806, 0, 595, 608, 590, 0, 597, 0,
+	1070, 577, 608, 579, 0, 597, 622, 644, 622, 0,
+	317, 0, 0, 329, 330, 322, 0, 0, 0, 340,
+	342, 343, 344, 518, 303, 304, 296, 297, 298, 299,
+	300, 301, 302, 306, 108, 0, 276, 277, 0, 0,
+	0, 152, 153, 154, 155, 156, 157, 159, 143, 542,
+	544, 882, 895, 0, 885, 0, 162, 202, 135, 0,
+	0, 1386, 1387, 1388, 1389, 1390, 1394, 0, 1396, 1398,
+	1400, 1402, 0, 1420, -2, -2, 1167, 1168, 1169, 1170,
+	1171, 1172, 1173, 1174, 1175, 1176, 1177, 1178, 1405, 1418,
+	1419, 0, 0, 0, 0, 0, 0, 1416, 1416, 1411,
+	0, 1183, 0, 1202, 1206, 0, 0, 0, 99, 1378,
+	1286, 1287, 1288, 1289, 1290, 1291, 1292, 1293, 1294, 1295,
+	1296, 1297, 1298, 1299, 1300, 1301, 1302, 1303, 1304, 1305,
+	1306, 1307, 1308, 1309, 1310, 1311, 1312, 1313, 1314, 0,
+	0, 1385, 0, 0, 0, 1380, 1381, 0, 0, 0,
+	1274, 0, 0, 1280, 1281, 1282, 0, 603, 0, 1344,
+	1320, 1335, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 732, 734, 0,
+	612, 614, 615, 0, 642, 655, 660, 661, 664, 60,
+	65, 946, 0, 832, 270, 271, 0, 0, 265, 0,
+	-2, 820, 0, 651, 0, 0, 0, 834, 387, 876,
+	0, 0, 0, 0, 0, 893, 916, 922, 0, 0,
+	0, 950, 0, 649, 0, 0, 746, 442, 0, 498,
+	499, 446, 1624, 451, 706, 457, 452, 458, 502, 459,
+	460, 461, 0, 706, 706, 497, 500, 486, 501, 500,
+	489, 490, 0, 509, 514, 510, 0, 512, 465, 467,
+	651, 354, 355, 356, 357, 0, 0, 0, 0, 0,
+	0, 362, 1517, 1517, 0, 1517, 1517, 1517, 1517, 0,
+	0, 1517, 1517, 1517, 1517, 1517, 1517, 1517, 1517, 1517,
+	1517, 1517, 1517, 1517, 1517, 0, 1517, 1517, 936, 383,
+	0, 0, 387, 0, 851, 719, 0, 720, 721, 717,
+	748, 773, 0, 773, 0, 773, 753, 1069, 219, 220,
+	0, 222, 223, 224, 231, 226, 228, 0, 0, 232,
+	244, 245, 246, 0, 0, 0, 236, 237, 0, 0,
+	290, 291, 293, 0, 640, 539, 1067, 540, 541, 545,
+	0, 547, 548, 0, 550, 820, 0, 856, 0, 0,
+	871, 714, 708, 710, 778, 98, 714, 0, 83, 19,
+	0, 76, 73, 0, 0, 0, 0, 0, 1492, 1445,
+	1513, 0, 0, 0, 1494, 0, 0, 0, 0, 66,
+	624, 584, 0, 589, 605, 0, 609, 0, 0, 601,
+	594, 598, 0, 0, 618, 578, 0, 0, 583, 643,
+	645, 1111, 0, 324, 0, 325, 339, 0, 0, 0,
+	0, 146, 879, 0, 147, 151, 141, 0, 0, 0,
+	884, 0, 881, 886, 0, 161, 0, 0, 136, 137,
+	941, 946, 0, 1395, 1397, 1399, 1401, 1403, 0, 1406,
+	1416, 1416, 1412, 0, 1407, 0, 1409, 0, 1386, 0,
+	1207, 0, 0, 0, 0, 0, 0, 1264, 1265, 0,
+	0, 1269, 0, 1271, 1272, 1273, 1275, 0, 0, 0,
+	1279, 0, 1318, 1336, 1322, 1325, 0, 1329, 0, 1331,
+	0, 658, 0, 1241, 1241, 0, 0, 0, 0, 1241,
+	0, 0, 0, 0, 0, 0, 0, 0, 1201, 0,
+	0, 735, 624, 0, 0, 0, 667, 665, 666, 0,
+	944, 0, 56, 272, 260, 262, 0, 0, 0, 808,
+	809, 811, 0, 814, 815, 816, 0, 821, 822, 824,
+	825, 0, -2, -2, -2, -2, -2, -2, -2, -2,
+	-2, -2, -2, 1557, -2, -2, -2, -2, -2, -2,
 	-2, -2, -2, -2, -2, -2, -2, -2, -2, -2,
-	-2, -2, -2, -2, 780, 0, 870, 800, 1457, 368,
-	0, 0, 822, 0, 0, 0, -2, 0, 0, 0,
-	0, 895, 0, 0, 0, 530, 534, 23, 620, 0,
-	673, 418, 0, 419, 470, 427, 672, 1009, 452, 453,
-	672, 470, 470, 473, 0, 484, 0, 878, 346, 1015,
-	1016, 1017, 0, 0, 1021, 1028, 869, 1076, 0, 1028,
-	0, 0, 1030, 1031, 347, 0, 0, 0, 0, 0,
-	348, 0, 0, 1458, 0, 1457, 1457, 0, 0, 0,
-	0, 1457, 1457, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 800, 1457,
-	0, 0, 368, 807, 0, 0, 0, 0, 0, 0,
-	705, 0, 0, 704, 0, 0, 0, 0, 0, 619,
-	739, 0, 741, 742, 716, -2, 0, 685, 721, 1323,
-	0, 0, 0, 225, 226, 227, 231, 232, 281, 284,
-	286, 0, 0, 0, 522, 670, 675, 682, 745, 747,
-	91, 678, 670, 0, 78, 19, 77, 69, 70, 0,
-	19, 0, 0, 0, 0, 1442, 1441, 1427, 0, 1428,
-	1439, 1444, 0, 1447, 0, 596, 0, 0, 576, 578,
-	0, 0, 576, 0, 0, 585, 0, 0, 576, 0,
-	0, 492, 101, 335, 0, 0, 0, 0, 0, 512,
-	0, 827, 135, 0, 0, 155, 0, 0, 887, 0,
-	889, 1322, 1348, 1350, 0, 1357, 1353, 1124, 1132, 1143,
-	0, 0, 1149, 1161, 1161, 0, 1152, 1440, 1440, 1155,
-	1436, 1438, 1436, 1161, 1161, 0, 92, 0, 0, 1210,
-	0, 0, 0, 572, 0, 0, 0, 1179, 1181, 1186,
-	1182, 1187, 1181, 1181, 1181, 1181, 1192, 1181, 1181, 1181,
-	1181, 1181, 1181, 1181, 1181, 1177, 581, 596, 624, 625,
-	630, 631, 0, 256, 0, 0, 774, 0, 0, 0,
-	787, 0, 803, 801, 802, 0, 817, 369, 370, 371,
-	372, 0, 0, 0, 821, 0, 553, 0, 860, 861,
-	862, 863, 864, -2, 873, 0, 0, 1011, 1011, 1011,
-	903, 0, 894, 584, 0, -2, 0, 0, 532, 0,
-	0, 713, 421, 672, 445, 0, 461, 672, 672, 470,
-	488, 0, 345, -2, 1020, 1040, 1029, 1040, 1077, 1040,
-	1040, 869, 0, 350, 351, 352, 0, 354, 0, 962,
-	963, 0, 0, 966, 967, 968, 969, 0, 0, 972,
-	973, 974, 975, 976, 977, 978, 979, 980, 981, 997,
-	998, 999, 1000, 1001, 1002, 982, 983, 984, 985, 986,
-	987, 994, 0, 0, 991, 0, 803, 0, 0, 0,
-	817, 809, 0, 811, 812, 0, 0, 555, 672, 274,
-	0, 708, 702, 0, 691, 706, 707, 694, 0, 696,
-	0, 692, 693, 672, 684, 715, 740, 717, 720, 722,
-	723, 729, 0, 0, 0, 0, 213, 0, 388, 219,
-	1008, 515, 520, 668, 0, 0, 668, 73, 76, 0,
-	71, 0, 0, 0, 0, 63, 61, 1435, 0, 0,
-	598, 115, 0, 0, 0, 574, 0, 579, 576, 561,
-	570, 560, 567, 568, 587, 576, 550, 549, 1052, 310,
-	0, 824, 135, 140, 141, 142, 136, 134, 831, 0,
-	833, 0, 885, 889, 0, 0, 1354, 1148, 1133, 1150,
-	1162, 1163, 1151, 1134, 1153, 1154, 1156, 1157, 1158, 1159,
-	1160, 1135, 1206, 0, 1208, 1216, 1217, 0, 1266, 1270,
-	0, 0, 0, 1185, 1188, 1189, 1190, 1191, 1193, 1194,
-	1195, 1196, 1197, 1198, 1199, 1200, 626, 1178, 0, 598,
-	636, 637, 762, 765, 771, 773, 0, 784, 0, 791,
-	792, 0, 0, 795, 796, 805, 0, 0, 0, 374,
-	375, 0, 0, 0, 387, 383, 384, 385, 365, 816,
-	807, 0, 0, 874, 1457, 1457, 1457, 0, 0, 1012,
-	1013, 0, 0, 906, 0, 0, 897, 0, 738, 0,
-	0, 672, 531, 534, 535, 618, 422, 672, 465, 462,
-	672, 342, 1042, -2, 1055, 1057, 0, 0, 1060, 1061,
-	0, 0, 0, 0, 1098, 1067, 0, 0, 1071, 0,
-	1372, 1373, 0, 1075, 0, 1041, 0, 1032, 1041, 0,
-	0, 1040, 0, 353, 355, 356, 357, 964, 965, 970,
-	971, 988, 0, 0, 990, 0, 0, 358, 0, 0,
-	359, 363, 808, 0, 813, 814, 626, 0, 0, 688,
-	709, 0, 0, 689, 0, 690, 695, 697, 273, 724,
-	0, 0, 726, 727, 728, 719, 221, 658, 0, 0,
-	679, 659, 0, 19, 0, 0, 66, 1445, 1448, 600,
-	0, 597, 116, 0, 0, 0, 0, 575, 559, 548,
-	137, 132, 832, 118, 888, 890, 886, 0, 1218, 0,
-	1181, 1180, 1174, 0, 600, 0, 790, 788, 804, 794,
-	0, 818, 819, 0, 376, 377, 0, 380, 386, 806,
-	554, 0, 0, 0, 0, 866, -2, 0, 0, -2,
-	909, 0, 904, 0, 896, 0, 899, 672, 672, -2,
-	528, 533, 0, 446, 466, 1056, 1058, 1059, 1062, 1063,
-	1005, 1006, 1064, 1103, 1104, 1105, 1065, 1100, 1101, 1102,
-	1066, 0, 0, 0, 1370, 1371, 1096, 0, 0, 0,
-	0, 0, 0, 0, 1026, 995, 996, 989, 992, 993,
-	362, 360, 556, 621, 275, 276, 710, 0, 703, 733,
-	730, 0, 0, 669, 671, 67, 72, 0, 0, 602,
-	0, 599, 0, 593, 595, 126, 564, 88, 109, 0,
-	1207, 1257, 1184, 0, 1175, 0, 1169, 1170, 1171, 1176,
-	602, 0, 0, 0, 373, 378, 0, 381, 382, 0,
-	855, 1436, 0, 875, 876, 877, 916, -2, 959, 1014,
-	881, 118, 916, 900, 0, 907, 0, 905, 898, 626,
-	529, 0, 0, 1273, 1091, 0, 0, 0, 1033, 1035,
-	1036, 1037, 1038, 1039, 1034, 0, 0, 0, 1025, 1027,
-	1072, 0, 272, 0, 0, 734, 736, 731, 732, 62,
-	64, 79, 0, 601, 117, 0, 80, 0, 106, 0,
-	119, 120, 0, 0, 0, 0, 1183, 1172, 0, 0,
-	0, 0, 591, 0, 789, 797, 0, 799, 379, 848,
-	0, 632, 0, 918, 0, 878, 961, 883, 918, 892,
-	0, 902, 0, 911, 0, 908, 621, 1099, 0, 1070,
-	1079, 1092, 0, 0, 848, 848, 848, 848, 0, 1073,
-	711, 725, 0, 0, 0, 89, 93, 0, 115, 112,
-	0, 121, 0, 0, 0, 0, 0, 1164, 1165, 1167,
-	1166, 1168, 0, 798, 847, 856, 857, 632, 880, 0,
-	955, 960, 882, 901, 910, 0, 913, 527, 1068, 1078,
-	1080, 1081, 0, 1093, 1094, 1095, 1097, 1018, 1019, 1022,
-	1023, 0, 735, 737, 0, 604, 594, 82, 0, 0,
-	110, 111, 113, 0, 122, 0, 124, 125, 0, 778,
-	849, 1457, 0, 0, 853, 854, 858, 0, 943, 0,
-	0, 949, 0, 956, 912, 1069, 1082, 0, 1083, 0,
-	0, 0, 1024, 603, 0, 891, 0, 94, 0, 96,
-	98, 99, 1043, 107, 108, 114, 123, 1173, 0, 851,
-	0, 919, 0, 921, 0, 0, 0, 0, 0, 953,
-	0, 1084, 1086, 1087, 0, 0, 1085, 605, 83, 84,
-	0, 95, 0, 0, 850, 852, 0, 923, 0, 944,
-	0, 0, 0, 0, 0, 0, 0, 1088, 1090, 1089,
-	0, 0, 97, 1044, 920, 917, 0, 955, 945, 0,
-	947, 0, 0, 0, 0, 85, 86, 87, 0, 0,
-	925, 0, 941, 946, 948, 950, 0, 954, 952, 1045,
-	924, 0, 937, 922, 0, 951, 926, -2, 0, 942,
-	927, -2, 0, 935, 0, 0, 928, 936, 0, 931,
-	0, 0, 0, 930, 0, -2, 938, 0, 0, 932,
-	-2, 0, 940, 939,
+	-2, -2, -2, 819, 0, 927, 0, 841, 1517, 391,
+	0, 0, 878, 0, 0, 0, 0, 0, 0, -2,
+	0, 0, 0, 0, 952, 0, 0, 0, 562, 566,
+	23, 652, 0, 707, 444, 0, 445, 497, 453, 706,
+	1069, 478, 479, 706, 497, 497, 500, 0, 511, 0,
+	935, 359, 0, 1075, 1076, 1077, 0, 0, 1081, 1088,
+	926, 1136, 0, 1088, 0, 0, 1090, 1091, 360, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 361, 0,
+	0, 1518, 0, 1517, 1517, 0, 0, 0, 0, 1517,
+	1517, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 841, 1517,
+	0, 0, 391, 386, 393, 394, 0, 397, 0, 0,
+	0, 848, 0, 0, 0, 0, 0, 0, 739, 0,
+	0, 738, 0, 0, 0, 0, 0, 651, 774, 0,
+	776, 777, 773, 751, -2, 0, 719, 756, 1383, 0,
+	0, 0, 233, 234, 235, 239, 240, 289, 292, 294,
+	0, 0, 549, 0, 554, 0, 867, 706, 0, 715,
+	0, 711, 779, 0, 781, 0, 706, 0, 86, 19,
+	85, 77, 78, 0, 19, 0, 0, 0, 0, 1502,
+	1501, 1487, 0, 1488, 1499, 1504, 0, 1507, 0, 628,
+	0, 0, 608, 610, 0, 0, 608, 0, 0, 617,
+	0, 0, 608, 0, 0, 323, 519, 109, 346, 0,
+	0, 0, 0, 0, 543, 0, 883, 143, 0, 0,
+	163, 0, 0, 946, 1382, 1408, 1410, 0, 1417, 1413,
+	1184, 1192, 1203, 0, 0, 1209, 1221, 1221, 0, 1212,
+	1500, 1500, 1215, 1496, 1498, 1496, 1221, 1221, 0, 100,
+	0, 0, 1270, 0, 0, 0, 604, 0, 0, 0,
+	1239, 1241, 1246, 1242, 1247, 1241, 1241, 1241, 1241, 1252,
+	1241, 1241, 1241, 1241, 1241, 1241, 1241, 1241, 1237, 613,
+	628, 656, 657, 662, 663, 0, 59, 946, 0, 0,
+	264, 0, 0, 813, 0, 0, 0, 826, 0, 0,
+	844, 842, 843, 0, 873, 392, 877, 0, 585, 858,
+	0, 0, 0, 917, 918, 919, 920, 921, -2, 930,
+	0, 0, 1071, 1071, 1071, 960, 0, 951, 616, 0,
+	-2, 0, 0, 564, 0, 0, 747, 447, 706, 471,
+	0, 487, 706, 706, 497, 515, 0, 358, 0, 0,
+	0, -2, 1080, 1100, 1089, 1100, 1137, 1100, 1100, 926,
+	0, 364, 372, 373, 374, 0, 376, 365, 366, 0,
+	0, 0, 1020, 1021, 0, 0, 1024, 1025, 1026, 1027,
+	0, 0, 1030, 1031, 1032, 1033, 1034, 1035, 1036, 1037,
+	1038, 1039, 1057, 1058, 1059, 1060, 1061, 1062, 1040, 1041,
+	1042, 1043, 1044, 1045, 1054, 0, 0, 1051, 0, 0,
+	0, 844, 0, 0, 0, 873, 395, 396, 0, 399,
+	400, 0, 0, 0, 412, 408, 409, 410, 850, 0,
+	852, 853, 0, 0, 587, 706, 282, 0, 742, 736,
+	0, 725, 740, 741, 728, 0, 730, 0, 726, 727,
+	706, 718, 749, 775, 651, 752, 755, 757, 758, 764,
+	0, 0, 0, 0, 221, 0, 413, 227, 1068, 546,
+	552, 863, 704, 709, 716, 780, 782, 99, 712, 704,
+	81, 84, 0, 79, 0, 0, 0, 0, 71, 69,
+	1495, 0, 0, 630, 123, 0, 0, 0, 606, 0,
+	611, 608, 593, 602, 592, 599, 600, 619, 608, 582,
+	581, 1112, 318, 0, 880, 143, 148, 149, 150, 144,
+	142, 887, 0, -2, 0, 0, 942, 0, 1414, 1208,
+	1193, 1210, 1222, 1223, 1211, 1194, 1213, 1214, 1216, 1217,
+	1218, 1219, 1220, 1195, 1266, 0, 1268, 1276, 1277, 0,
+	1326, 1330, 0, 0, 0, 1245, 1248, 1249, 1250, 1251,
+	1253, 1254, 1255, 1256, 1257, 1258, 1259, 1260, 658, 1238,
+	0, 630, 668, 669, 945, 947, 57, 801, 804, 810,
+	812, 0, 823, 0, 830, 831, 833, 0, 0, 836,
+	837, 846, 0, 0, 388, 872, 848, 0, 0, 0,
+	865, 0, 0, 931, 1517, 1517, 1517, 0, 0, 1072,
+	1073, 0, 0, 963, 0, 0, 954, 0, 773, 0,
+	0, 706, 563, 566, 567, 650, 448, 706, 491, 488,
+	706, 353, 0, 983, 0, 1102, -2, 1115, 1117, 0,
+	0, 1120, 1121, 0, 0, 0, 0, 1158, 1127, 0,
+	0, 1131, 0, 1432, 1433, 0, 1135, 0, 1101, 0,
+	1092, 1101, 0, 0, 1100, 0, 375, 0, 0, 377,
+	378, 379, 1022, 1023, 1028, 1029, 1046, 0, 0, 1048,
+	0, 0, 0, 0, 380, 0, 0, 381, 385, 0,
+	401, 402, 0, 405, 411, 849, 0, 854, 855, 658,
+	0, 0, 722, 743, 0, 0, 723, 0, 724, 729,
+	731, 281, 750, 759, 0, 0, 761, 762, 763, 754,
+	229, 702, 0, 0, 702, 0, 19, 0, 0, 74,
+	1505, 1508, 632, 0, 629, 124, 0, 0, 0, 0,
+	607, 591, 580, 145, 140, 888, 0, 126, 943, 0,
+	1278, 0, 1241, 1240, 1234, 0, 632, 0, 829, 827,
+	845, 835, 0, 874, 875, 847, 586, 0, 859, 860,
+	861, 862, 864, 0, 0, 0, 0, 0, 0, 923,
+	-2, 0, 0, -2, 966, 0, 961, 0, 953, 0,
+	956, 706, 706, -2, 560, 565, 0, 472, 492, 363,
+	0, 995, 1116, 1118, 1119, 1122, 1123, 1065, 1066, 1124,
+	1163, 1164, 1165, 1125, 1160, 1161, 1162, 1126, 0, 0,
+	0, 1430, 1431, 1156, 0, 0, 0, 0, 0, 0,
+	0, 1086, 0, 0, 1055, 1056, 1047, 1052, 1053, 0,
+	1050, 384, 382, 398, 403, 0, 406, 407, 588, 653,
+	283, 284, 744, 0, 737, 768, 765, 0, 0, 690,
+	0, 0, 713, 691, 75, 80, 0, 0, 634, 0,
+	631, 0, 625, 627, 134, 596, 890, 96, 117, 0,
+	1267, 1317, 1244, 0, 1235, 0, 1229, 1230, 1231, 1236,
+	634, 0, 0, 0, 0, 0, 869, 0, 912, 1496,
+	0, 932, 933, 934, 973, -2, 1017, 1074, 938, 126,
+	973, 957, 0, 964, 0, 962, 955, 658, 561, 984,
+	-2, 0, 0, 0, 1333, 1151, 0, 0, 0, 1093,
+	1095, 1096, 1097, 1098, 1099, 1094, 0, 0, 0, 1085,
+	1087, 1132, 0, 369, 0, 0, 404, 280, 0, 0,
+	769, 771, 766, 767, 703, 705, 70, 72, 87, 0,
+	633, 125, 0, 88, 0, 114, 0, 127, 128, 0,
+	0, 0, 0, 1243, 1232, 0, 0, 0, 0, 623,
+	0, 828, 838, 0, 840, 0, 866, 868, 1517, 905,
+	0, 664, 0, 975, 0, 935, 1019, 940, 975, 949,
+	0, 959, 0, 968, 0, 965, 653, 985, -2, 0,
+	993, 0, 0, 1159, 0, 1130, 1139, 1152, 0, 0,
+	905, 905, 905, 905, 0, 1133, 367, 0, 0, 368,
+	1049, 745, 760, 0, 0, 0, 97, 101, 0, 123,
+	120, 0, 129, 0, 0, 0, 0, 0, 1224, 1225,
+	1227, 1226, 1228, 0, 839, 0, 0, 904, 913, 914,
+	664, 937, 0, 1013, 1018, 939, 958, 967, 0, 970,
+	559, 986, 994, 0, 989, 0, 0, 0, 1128, 1138,
+	1140, 1141, 0, 1153, 1154, 1155, 1157, 1078, 1079, 1082,
+	1083, 0, 370, 371, 770, 772, 0, 636, 626, 90,
+	0, 0, 118, 119, 121, 0, 130, 0, 132, 133,
+	0, 817, 0, 870, 906, 1517, 0, 0, 910, 911,
+	915, 0, 1001, 0, 0, 1007, 0, 1014, 969, 988,
+	0, -2, 996, 0, 0, 1129, 1142, 0, 1143, 0,
+	0, 0, 1084, 635, 0, 948, 0, 102, 0, 104,
+	106, 107, 1103, 115, 116, 122, 131, 1233, 857, 0,
+	908, 0, 976, 0, 1717, 979, 0, 0, 0, 0,
+	0, 1011, 0, 990, -2, 0, 998, 1144, 1146, 1147,
+	0, 0, 1145, 637, 91, 92, 0, 103, 0, 0,
+	907, 909, 0, 0, 981, 0, 1002, 0, 0, 0,
+	0, 0, 0, 0, 997, 1148, 1150, 1149, 0, 0,
+	105, 1104, 977, 0, 974, 0, 1013, 1003, 0, 1005,
+	0, 0, 0, 0, 93, 94, 95, 0, 978, 0,
+	999, 1004, 1006, 1008, 0, 1012, 1010, 1105, 982, 980,
+	0, 1009, 1000,
 }
 
 var yyTok1 = [...]int{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 96, 3, 3, 3, 127, 119, 3,
-	67, 68, 124, 122, 137, 123, 136, 125, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 557, 556,
-	106, 105, 107, 3, 558, 3, 3, 3, 3, 3,
+	3, 3, 3, 98, 3, 3, 3, 129, 121, 3,
+	68, 69, 126, 124, 139, 125, 138, 127, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 577, 574,
+	108, 107, 109, 3, 578, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 129, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 131, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 559, 118, 560, 130,
+	3, 3, 3, 575, 120, 576, 132,
 }
 
 var yyTok2 = [...]int{
@@ -7085,13 +7520,13 @@ var yyTok2 = [...]int{
 	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
 	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
-	62, 63, 64, 65, 66, 69, 70, 71, 72, 73,
+	62, 63, 64, 65, 66, 67, 70, 71, 72, 73,
 	74, 75, 76, 77, 78, 79, 80, 81, 82, 83,
 	84, 85, 86, 87, 88, 89, 90, 91, 92, 93,
-	94, 95, 97, 98, 99, 100, 101, 102, 103, 104,
-	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
-	120, 121, 126, 128, 131, 132, 133, 134, 135, 138,
-	139, 140, 141, 142, 143, 144, 145, 146, 147, 148,
+	94, 95, 96, 97, 99, 100, 101, 102, 103, 104,
+	105, 106, 110, 111, 112, 113, 114, 115, 116, 117,
+	118, 119, 122, 123, 128, 130, 133, 134, 135, 136,
+	137, 140, 141, 142, 143, 144, 145, 146, 147, 148,
 	149, 150, 151, 152, 153, 154, 155, 156, 157, 158,
 	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
 	169, 170, 171, 172, 173, 174, 175, 176, 177, 178,
@@ -7164,7 +7599,10 @@ var yyTok3 = [...]int{
 	57865, 540, 57866, 541, 57867, 542, 57868, 543, 57869, 544,
 	57870, 545, 57871, 546, 57872, 547, 57873, 548, 57874, 549,
 	57875, 550, 57876, 551, 57877, 552, 57878, 553, 57879, 554,
-	57880, 555, 0,
+	57880, 555, 57881, 556, 57882, 557, 57883, 558, 57884, 559,
+	57885, 560, 57886, 561, 57887, 562, 57888, 563, 57889, 564,
+	57890, 565, 57891, 566, 57892, 567, 57893, 568, 57894, 569,
+	57895, 570, 57896, 571, 57897, 572, 57898, 573, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -7514,13 +7952,13 @@ yydefault:
 
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:710
+//line mysql_sql.y:740
 		{
 			yylex.(*Lexer).AppendStmt(yyDollar[1].statementUnion())
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:717
+//line mysql_sql.y:747
 		{
 			if yyDollar[1].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[1].statementUnion())
@@ -7528,7 +7966,7 @@ yydefault:
 		}
 	case 5:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:723
+//line mysql_sql.y:753
 		{
 			if yyDollar[3].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[3].statementUnion())
@@ -7537,7 +7975,7 @@ yydefault:
 	case 6:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:731
+//line mysql_sql.y:761
 		{
 			yyLOCAL = tree.NewCompoundStmt(yyDollar[2].statementsUnion())
 		}
@@ -7545,7 +7983,7 @@ yydefault:
 	case 7:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Statement
-//line mysql_sql.y:737
+//line mysql_sql.y:767
 		{
 			yyLOCAL = []tree.Statement{yyDollar[1].statementUnion()}
 		}
@@ -7553,7 +7991,7 @@ yydefault:
 	case 8:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Statement
-//line mysql_sql.y:741
+//line mysql_sql.y:771
 		{
 			yyLOCAL = append(yyDollar[1].statementsUnion(), yyDollar[3].statementUnion())
 		}
@@ -7561,7 +7999,7 @@ yydefault:
 	case 18:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:756
+//line mysql_sql.y:786
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
@@ -7569,7 +8007,7 @@ yydefault:
 	case 19:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:760
+//line mysql_sql.y:790
 		{
 			yyLOCAL = tree.Statement(nil)
 		}
@@ -7577,7 +8015,7 @@ yydefault:
 	case 20:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:766
+//line mysql_sql.y:796
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
@@ -7585,7 +8023,7 @@ yydefault:
 	case 22:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:771
+//line mysql_sql.y:801
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
@@ -7593,7 +8031,7 @@ yydefault:
 	case 23:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:775
+//line mysql_sql.y:805
 		{
 			yyLOCAL = tree.Statement(nil)
 		}
@@ -7601,15 +8039,55 @@ yydefault:
 	case 50:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:807
+//line mysql_sql.y:837
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 52:
+	case 55:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:847
+		{
+			yyLOCAL = &tree.Backup{BackupTarget: yyDollar[3].backupTargetUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 56:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:853
+		{
+			yyLOCAL = &tree.Restore{From: yyDollar[3].backupTargetUnion(), To: yyDollar[5].backupTargetUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 57:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:857
+		{
+			yyLOCAL = &tree.Restore{From: yyDollar[3].backupTargetUnion(), To: yyDollar[5].backupTargetUnion(), Ts: yyDollar[7].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 58:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.BackupTarget
+//line mysql_sql.y:863
+		{
+			yyLOCAL = tree.BackupTarget{Dir: yyDollar[1].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 59:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.BackupTarget
+//line mysql_sql.y:867
+		{
+			yyLOCAL = tree.BackupTarget{Option: yyDollar[3].strsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 60:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:814
+//line mysql_sql.y:873
 		{
 			var connectionId uint64
 			switch v := yyDollar[3].item.(type) {
@@ -7629,20 +8107,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 53:
+	case 61:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.KillOption
-//line mysql_sql.y:834
+//line mysql_sql.y:893
 		{
 			yyLOCAL = tree.KillOption{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 54:
+	case 62:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.KillOption
-//line mysql_sql.y:840
+//line mysql_sql.y:899
 		{
 			yyLOCAL = tree.KillOption{
 				Exist: true,
@@ -7650,10 +8128,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 55:
+	case 63:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.KillOption
-//line mysql_sql.y:847
+//line mysql_sql.y:906
 		{
 			yyLOCAL = tree.KillOption{
 				Exist: true,
@@ -7661,20 +8139,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 56:
+	case 64:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.StatementOption
-//line mysql_sql.y:855
+//line mysql_sql.y:914
 		{
 			yyLOCAL = tree.StatementOption{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 57:
+	case 65:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.StatementOption
-//line mysql_sql.y:861
+//line mysql_sql.y:920
 		{
 			yyLOCAL = tree.StatementOption{
 				Exist:       true,
@@ -7682,10 +8160,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 58:
+	case 66:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:870
+//line mysql_sql.y:929
 		{
 			yyLOCAL = &tree.CallStmt{
 				Name: yyDollar[2].procNameUnion(),
@@ -7693,30 +8171,30 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 59:
+	case 67:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:880
+//line mysql_sql.y:939
 		{
 			yyLOCAL = &tree.LeaveStmt{
 				Name: tree.Identifier(yyDollar[2].cstrUnion().ToLower()),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 60:
+	case 68:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:888
+//line mysql_sql.y:947
 		{
 			yyLOCAL = &tree.IterateStmt{
 				Name: tree.Identifier(yyDollar[2].cstrUnion().ToLower()),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 61:
+	case 69:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:896
+//line mysql_sql.y:955
 		{
 			yyLOCAL = &tree.WhileStmt{
 				Name: "",
@@ -7725,10 +8203,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 62:
+	case 70:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:904
+//line mysql_sql.y:963
 		{
 			yyLOCAL = &tree.WhileStmt{
 				Name: tree.Identifier(yyDollar[1].cstrUnion().ToLower()),
@@ -7737,10 +8215,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 63:
+	case 71:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:914
+//line mysql_sql.y:973
 		{
 			yyLOCAL = &tree.RepeatStmt{
 				Name: "",
@@ -7749,10 +8227,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 64:
+	case 72:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:922
+//line mysql_sql.y:981
 		{
 			yyLOCAL = &tree.RepeatStmt{
 				Name: tree.Identifier(yyDollar[1].cstrUnion().ToLower()),
@@ -7761,10 +8239,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 65:
+	case 73:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:932
+//line mysql_sql.y:991
 		{
 			yyLOCAL = &tree.LoopStmt{
 				Name: "",
@@ -7772,10 +8250,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 66:
+	case 74:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:939
+//line mysql_sql.y:998
 		{
 			yyLOCAL = &tree.LoopStmt{
 				Name: tree.Identifier(yyDollar[1].cstrUnion().ToLower()),
@@ -7783,10 +8261,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 67:
+	case 75:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:948
+//line mysql_sql.y:1007
 		{
 			yyLOCAL = &tree.IfStmt{
 				Cond:  yyDollar[2].exprUnion(),
@@ -7796,42 +8274,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 68:
+	case 76:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.ElseIfStmt
-//line mysql_sql.y:958
+//line mysql_sql.y:1017
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 69:
+	case 77:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.ElseIfStmt
-//line mysql_sql.y:962
+//line mysql_sql.y:1021
 		{
 			yyLOCAL = yyDollar[1].elseIfClauseListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 70:
+	case 78:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.ElseIfStmt
-//line mysql_sql.y:968
+//line mysql_sql.y:1027
 		{
 			yyLOCAL = []*tree.ElseIfStmt{yyDollar[1].elseIfClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 71:
+	case 79:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.ElseIfStmt
-//line mysql_sql.y:972
+//line mysql_sql.y:1031
 		{
 			yyLOCAL = append(yyDollar[1].elseIfClauseListUnion(), yyDollar[2].elseIfClauseUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 72:
+	case 80:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.ElseIfStmt
-//line mysql_sql.y:978
+//line mysql_sql.y:1037
 		{
 			yyLOCAL = &tree.ElseIfStmt{
 				Cond: yyDollar[2].exprUnion(),
@@ -7839,10 +8317,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 73:
+	case 81:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:987
+//line mysql_sql.y:1046
 		{
 			yyLOCAL = &tree.CaseStmt{
 				Expr:  yyDollar[2].exprUnion(),
@@ -7851,26 +8329,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 74:
+	case 82:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.WhenStmt
-//line mysql_sql.y:997
+//line mysql_sql.y:1056
 		{
 			yyLOCAL = []*tree.WhenStmt{yyDollar[1].whenClause2Union()}
 		}
 		yyVAL.union = yyLOCAL
-	case 75:
+	case 83:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.WhenStmt
-//line mysql_sql.y:1001
+//line mysql_sql.y:1060
 		{
 			yyLOCAL = append(yyDollar[1].whenClauseList2Union(), yyDollar[2].whenClause2Union())
 		}
 		yyVAL.union = yyLOCAL
-	case 76:
+	case 84:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.WhenStmt
-//line mysql_sql.y:1007
+//line mysql_sql.y:1066
 		{
 			yyLOCAL = &tree.WhenStmt{
 				Cond: yyDollar[2].exprUnion(),
@@ -7878,26 +8356,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 77:
+	case 85:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.Statement
-//line mysql_sql.y:1016
+//line mysql_sql.y:1075
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 78:
+	case 86:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.Statement
-//line mysql_sql.y:1020
+//line mysql_sql.y:1079
 		{
 			yyLOCAL = yyDollar[2].statementsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 79:
+	case 87:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1026
+//line mysql_sql.y:1085
 		{
 			ep := &tree.ExportParam{
 				Outfile:     true,
@@ -7914,10 +8392,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 80:
+	case 88:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1047
+//line mysql_sql.y:1106
 		{
 			yyLOCAL = &tree.Load{
 				Local:             yyDollar[3].boolValUnion(),
@@ -7929,52 +8407,52 @@ yydefault:
 			yyLOCAL.(*tree.Load).Param.Parallel = yyDollar[10].unsignedOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 81:
+	case 89:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1060
+//line mysql_sql.y:1119
 		{
 			yyLOCAL = &tree.LoadExtension{
 				Name: tree.Identifier(yyDollar[2].str),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 82:
+	case 90:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1067
+//line mysql_sql.y:1126
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 83:
+	case 91:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1071
+//line mysql_sql.y:1130
 		{
 			yyLOCAL = yyDollar[2].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 84:
+	case 92:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1077
+//line mysql_sql.y:1136
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 85:
+	case 93:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1081
+//line mysql_sql.y:1140
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 86:
+	case 94:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:1087
+//line mysql_sql.y:1146
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -7982,10 +8460,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 87:
+	case 95:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:1094
+//line mysql_sql.y:1153
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -7993,18 +8471,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 88:
+	case 96:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1102
+//line mysql_sql.y:1161
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 89:
+	case 97:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1106
+//line mysql_sql.y:1165
 		{
 			str := strings.ToLower(yyDollar[2].str)
 			if str == "true" {
@@ -8017,58 +8495,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 90:
+	case 98:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:1120
+//line mysql_sql.y:1179
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 91:
+	case 99:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:1124
+//line mysql_sql.y:1183
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 92:
+	case 100:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:1128
+//line mysql_sql.y:1187
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare(), yyDollar[5].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 93:
+	case 101:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:1133
+//line mysql_sql.y:1192
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 94:
+	case 102:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:1137
+//line mysql_sql.y:1196
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 95:
+	case 103:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:1141
+//line mysql_sql.y:1200
 		{
 			yyLOCAL = yyDollar[2].loadColumnsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 96:
+	case 104:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:1147
+//line mysql_sql.y:1206
 		{
 			switch yyDollar[1].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -8078,10 +8556,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 97:
+	case 105:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:1156
+//line mysql_sql.y:1215
 		{
 			switch yyDollar[3].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -8091,58 +8569,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 98:
+	case 106:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:1167
+//line mysql_sql.y:1226
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 99:
+	case 107:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:1171
+//line mysql_sql.y:1230
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 100:
+	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.VarExpr
-//line mysql_sql.y:1177
+//line mysql_sql.y:1236
 		{
 			yyLOCAL = []*tree.VarExpr{yyDollar[1].varExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 101:
+	case 109:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.VarExpr
-//line mysql_sql.y:1181
+//line mysql_sql.y:1240
 		{
 			yyLOCAL = append(yyDollar[1].varExprsUnion(), yyDollar[3].varExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 102:
+	case 110:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:1187
+//line mysql_sql.y:1246
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 103:
+	case 111:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:1191
+//line mysql_sql.y:1250
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 104:
+	case 112:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:1197
+//line mysql_sql.y:1256
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -8165,10 +8643,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 105:
+	case 113:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:1221
+//line mysql_sql.y:1280
 		{
 			//        vs := strings.Split($1, ".")
 			//        var r string
@@ -8187,42 +8665,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 106:
+	case 114:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:1240
+//line mysql_sql.y:1299
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 107:
+	case 115:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:1244
+//line mysql_sql.y:1303
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 108:
+	case 116:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:1248
+//line mysql_sql.y:1307
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 109:
+	case 117:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:1253
+//line mysql_sql.y:1312
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 110:
+	case 118:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:1257
+//line mysql_sql.y:1316
 		{
 			yyLOCAL = &tree.Lines{
 				StartingBy:   yyDollar[2].str,
@@ -8230,10 +8708,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 111:
+	case 119:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:1264
+//line mysql_sql.y:1323
 		{
 			yyLOCAL = &tree.Lines{
 				StartingBy:   yyDollar[3].str,
@@ -8241,42 +8719,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 112:
+	case 120:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1272
+//line mysql_sql.y:1331
 		{
 			yyVAL.str = ""
 		}
-	case 114:
+	case 122:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1279
+//line mysql_sql.y:1338
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 115:
+	case 123:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1284
+//line mysql_sql.y:1343
 		{
 			yyVAL.str = "\n"
 		}
-	case 117:
+	case 125:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1291
+//line mysql_sql.y:1350
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 118:
+	case 126:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1296
+//line mysql_sql.y:1355
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 119:
+	case 127:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1300
+//line mysql_sql.y:1359
 		{
 			res := &tree.Fields{
 				Terminated: "\t",
@@ -8299,36 +8777,36 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 120:
+	case 128:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:1324
+//line mysql_sql.y:1383
 		{
 			yyLOCAL = []*tree.Fields{yyDollar[1].fieldsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 121:
+	case 129:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:1328
+//line mysql_sql.y:1387
 		{
 			yyLOCAL = append(yyDollar[1].fieldsListUnion(), yyDollar[2].fieldsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 122:
+	case 130:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1334
+//line mysql_sql.y:1393
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[3].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 123:
+	case 131:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1340
+//line mysql_sql.y:1399
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -8347,10 +8825,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 124:
+	case 132:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1358
+//line mysql_sql.y:1417
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -8368,10 +8846,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 125:
+	case 133:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1375
+//line mysql_sql.y:1434
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -8389,50 +8867,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 127:
+	case 135:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:1398
+//line mysql_sql.y:1457
 		{
 			yyLOCAL = &tree.DuplicateKeyError{}
 		}
 		yyVAL.union = yyLOCAL
-	case 128:
+	case 136:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:1402
+//line mysql_sql.y:1461
 		{
 			yyLOCAL = &tree.DuplicateKeyIgnore{}
 		}
 		yyVAL.union = yyLOCAL
-	case 129:
+	case 137:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:1406
+//line mysql_sql.y:1465
 		{
 			yyLOCAL = &tree.DuplicateKeyReplace{}
 		}
 		yyVAL.union = yyLOCAL
-	case 130:
+	case 138:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1411
+//line mysql_sql.y:1470
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 131:
+	case 139:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1415
+//line mysql_sql.y:1474
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 132:
+	case 140:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1421
+//line mysql_sql.y:1480
 		{
 			yyLOCAL = &tree.Grant{
 				Typ: tree.GrantTypePrivilege,
@@ -8446,10 +8924,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 133:
+	case 141:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1434
+//line mysql_sql.y:1493
 		{
 			yyLOCAL = &tree.Grant{
 				Typ: tree.GrantTypeRole,
@@ -8461,10 +8939,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 134:
+	case 142:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1445
+//line mysql_sql.y:1504
 		{
 			yyLOCAL = &tree.Grant{
 				Typ: tree.GrantTypeProxy,
@@ -8477,26 +8955,26 @@ yydefault:
 
 		}
 		yyVAL.union = yyLOCAL
-	case 135:
+	case 143:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1458
+//line mysql_sql.y:1517
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 136:
+	case 144:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1462
+//line mysql_sql.y:1521
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 137:
+	case 145:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1472
+//line mysql_sql.y:1531
 		{
 			yyLOCAL = &tree.Revoke{
 				Typ: tree.RevokeTypePrivilege,
@@ -8510,10 +8988,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 138:
+	case 146:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1485
+//line mysql_sql.y:1544
 		{
 			yyLOCAL = &tree.Revoke{
 				Typ: tree.RevokeTypeRole,
@@ -8525,30 +9003,30 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 139:
+	case 147:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1498
+//line mysql_sql.y:1557
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_STAR,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 140:
+	case 148:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1504
+//line mysql_sql.y:1563
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_STAR_STAR,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 141:
+	case 149:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1510
+//line mysql_sql.y:1569
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:  tree.PRIVILEGE_LEVEL_TYPE_DATABASE_STAR,
@@ -8556,10 +9034,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 142:
+	case 150:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1517
+//line mysql_sql.y:1576
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_DATABASE_TABLE,
@@ -8568,10 +9046,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 143:
+	case 151:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1525
+//line mysql_sql.y:1584
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_TABLE,
@@ -8579,74 +9057,74 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 144:
+	case 152:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1534
+//line mysql_sql.y:1593
 		{
 			yyLOCAL = tree.OBJECT_TYPE_TABLE
 		}
 		yyVAL.union = yyLOCAL
-	case 145:
+	case 153:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1538
+//line mysql_sql.y:1597
 		{
 			yyLOCAL = tree.OBJECT_TYPE_DATABASE
 		}
 		yyVAL.union = yyLOCAL
-	case 146:
+	case 154:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1542
+//line mysql_sql.y:1601
 		{
 			yyLOCAL = tree.OBJECT_TYPE_FUNCTION
 		}
 		yyVAL.union = yyLOCAL
-	case 147:
+	case 155:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1546
+//line mysql_sql.y:1605
 		{
 			yyLOCAL = tree.OBJECT_TYPE_PROCEDURE
 		}
 		yyVAL.union = yyLOCAL
-	case 148:
+	case 156:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1550
+//line mysql_sql.y:1609
 		{
 			yyLOCAL = tree.OBJECT_TYPE_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 149:
+	case 157:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1554
+//line mysql_sql.y:1613
 		{
 			yyLOCAL = tree.OBJECT_TYPE_ACCOUNT
 		}
 		yyVAL.union = yyLOCAL
-	case 150:
+	case 158:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:1561
+//line mysql_sql.y:1620
 		{
 			yyLOCAL = []*tree.Privilege{yyDollar[1].privilegeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 151:
+	case 159:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:1565
+//line mysql_sql.y:1624
 		{
 			yyLOCAL = append(yyDollar[1].privilegesUnion(), yyDollar[3].privilegeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 152:
+	case 160:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:1571
+//line mysql_sql.y:1630
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -8654,10 +9132,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 153:
+	case 161:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:1578
+//line mysql_sql.y:1637
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -8665,426 +9143,426 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 154:
+	case 162:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:1587
+//line mysql_sql.y:1646
 		{
 			yyLOCAL = []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 155:
+	case 163:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:1591
+//line mysql_sql.y:1650
 		{
 			yyLOCAL = append(yyDollar[1].unresolveNamesUnion(), yyDollar[3].unresolvedNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 156:
+	case 164:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1597
+//line mysql_sql.y:1656
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 157:
+	case 165:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1601
+//line mysql_sql.y:1660
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ACCOUNT
 		}
 		yyVAL.union = yyLOCAL
-	case 158:
+	case 166:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1605
+//line mysql_sql.y:1664
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_ACCOUNT
 		}
 		yyVAL.union = yyLOCAL
-	case 159:
+	case 167:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1609
+//line mysql_sql.y:1668
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_ACCOUNT
 		}
 		yyVAL.union = yyLOCAL
-	case 160:
+	case 168:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1613
+//line mysql_sql.y:1672
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 161:
+	case 169:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1617
+//line mysql_sql.y:1676
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_TABLE
 		}
 		yyVAL.union = yyLOCAL
-	case 162:
+	case 170:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1621
+//line mysql_sql.y:1680
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 163:
+	case 171:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1625
+//line mysql_sql.y:1684
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE
 		}
 		yyVAL.union = yyLOCAL
-	case 164:
+	case 172:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1629
+//line mysql_sql.y:1688
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_USER
 		}
 		yyVAL.union = yyLOCAL
-	case 165:
+	case 173:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1633
+//line mysql_sql.y:1692
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_USER
 		}
 		yyVAL.union = yyLOCAL
-	case 166:
+	case 174:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1637
+//line mysql_sql.y:1696
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_USER
 		}
 		yyVAL.union = yyLOCAL
-	case 167:
+	case 175:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1641
+//line mysql_sql.y:1700
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TABLESPACE
 		}
 		yyVAL.union = yyLOCAL
-	case 168:
+	case 176:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1645
+//line mysql_sql.y:1704
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_TRIGGER
 		}
 		yyVAL.union = yyLOCAL
-	case 169:
+	case 177:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1649
+//line mysql_sql.y:1708
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DELETE
 		}
 		yyVAL.union = yyLOCAL
-	case 170:
+	case 178:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1653
+//line mysql_sql.y:1712
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_TABLE
 		}
 		yyVAL.union = yyLOCAL
-	case 171:
+	case 179:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1657
+//line mysql_sql.y:1716
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 172:
+	case 180:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1661
+//line mysql_sql.y:1720
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EXECUTE
 		}
 		yyVAL.union = yyLOCAL
-	case 173:
+	case 181:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1665
+//line mysql_sql.y:1724
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INDEX
 		}
 		yyVAL.union = yyLOCAL
-	case 174:
+	case 182:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1669
+//line mysql_sql.y:1728
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INSERT
 		}
 		yyVAL.union = yyLOCAL
-	case 175:
+	case 183:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1673
+//line mysql_sql.y:1732
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SELECT
 		}
 		yyVAL.union = yyLOCAL
-	case 176:
+	case 184:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1677
+//line mysql_sql.y:1736
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SUPER
 		}
 		yyVAL.union = yyLOCAL
-	case 177:
+	case 185:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1681
+//line mysql_sql.y:1740
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_DATABASE
 		}
 		yyVAL.union = yyLOCAL
-	case 178:
+	case 186:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1685
+//line mysql_sql.y:1744
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_DATABASE
 		}
 		yyVAL.union = yyLOCAL
-	case 179:
+	case 187:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1689
+//line mysql_sql.y:1748
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_DATABASES
 		}
 		yyVAL.union = yyLOCAL
-	case 180:
+	case 188:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1693
+//line mysql_sql.y:1752
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CONNECT
 		}
 		yyVAL.union = yyLOCAL
-	case 181:
+	case 189:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1697
+//line mysql_sql.y:1756
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_MANAGE_GRANTS
 		}
 		yyVAL.union = yyLOCAL
-	case 182:
+	case 190:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1701
+//line mysql_sql.y:1760
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_OWNERSHIP
 		}
 		yyVAL.union = yyLOCAL
-	case 183:
+	case 191:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1705
+//line mysql_sql.y:1764
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 184:
+	case 192:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1709
+//line mysql_sql.y:1768
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TABLE
 		}
 		yyVAL.union = yyLOCAL
-	case 185:
+	case 193:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1713
+//line mysql_sql.y:1772
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_UPDATE
 		}
 		yyVAL.union = yyLOCAL
-	case 186:
+	case 194:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1717
+//line mysql_sql.y:1776
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION
 		}
 		yyVAL.union = yyLOCAL
-	case 187:
+	case 195:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1721
+//line mysql_sql.y:1780
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REFERENCES
 		}
 		yyVAL.union = yyLOCAL
-	case 188:
+	case 196:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1725
+//line mysql_sql.y:1784
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REFERENCE
 		}
 		yyVAL.union = yyLOCAL
-	case 189:
+	case 197:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1729
+//line mysql_sql.y:1788
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_SLAVE
 		}
 		yyVAL.union = yyLOCAL
-	case 190:
+	case 198:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1733
+//line mysql_sql.y:1792
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_CLIENT
 		}
 		yyVAL.union = yyLOCAL
-	case 191:
+	case 199:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1737
+//line mysql_sql.y:1796
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_USAGE
 		}
 		yyVAL.union = yyLOCAL
-	case 192:
+	case 200:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1741
+//line mysql_sql.y:1800
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_RELOAD
 		}
 		yyVAL.union = yyLOCAL
-	case 193:
+	case 201:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1745
+//line mysql_sql.y:1804
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_FILE
 		}
 		yyVAL.union = yyLOCAL
-	case 194:
+	case 202:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1749
+//line mysql_sql.y:1808
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TEMPORARY_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 195:
+	case 203:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1753
+//line mysql_sql.y:1812
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_LOCK_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 196:
+	case 204:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1757
+//line mysql_sql.y:1816
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 197:
+	case 205:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1761
+//line mysql_sql.y:1820
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 198:
+	case 206:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1765
+//line mysql_sql.y:1824
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 199:
+	case 207:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1769
+//line mysql_sql.y:1828
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 200:
+	case 208:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1773
+//line mysql_sql.y:1832
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 201:
+	case 209:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1777
+//line mysql_sql.y:1836
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROUTINE
 		}
 		yyVAL.union = yyLOCAL
-	case 202:
+	case 210:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1781
+//line mysql_sql.y:1840
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_ROUTINE
 		}
 		yyVAL.union = yyLOCAL
-	case 203:
+	case 211:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1785
+//line mysql_sql.y:1844
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EVENT
 		}
 		yyVAL.union = yyLOCAL
-	case 204:
+	case 212:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1789
+//line mysql_sql.y:1848
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHUTDOWN
 		}
 		yyVAL.union = yyLOCAL
-	case 205:
+	case 213:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1793
+//line mysql_sql.y:1852
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_TRUNCATE
 		}
 		yyVAL.union = yyLOCAL
-	case 210:
+	case 218:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1805
+//line mysql_sql.y:1864
 		{
 			yyLOCAL = &tree.SetRole{
 				SecondaryRole: false,
@@ -9092,10 +9570,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 211:
+	case 219:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1812
+//line mysql_sql.y:1871
 		{
 			yyLOCAL = &tree.SetRole{
 				SecondaryRole:     true,
@@ -9103,10 +9581,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 212:
+	case 220:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1819
+//line mysql_sql.y:1878
 		{
 			yyLOCAL = &tree.SetRole{
 				SecondaryRole:     true,
@@ -9114,90 +9592,90 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 213:
+	case 221:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1828
+//line mysql_sql.y:1887
 		{
 			dr := yyDollar[4].setDefaultRoleUnion()
 			dr.Users = yyDollar[6].usersUnion()
 			yyLOCAL = dr
 		}
 		yyVAL.union = yyLOCAL
-	case 214:
+	case 222:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1858
+//line mysql_sql.y:1917
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NONE, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 215:
+	case 223:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1862
+//line mysql_sql.y:1921
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_ALL, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 216:
+	case 224:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1866
+//line mysql_sql.y:1925
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 217:
+	case 225:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1872
+//line mysql_sql.y:1931
 		{
 			yyLOCAL = &tree.SetVar{Assignments: yyDollar[2].varAssignmentExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 218:
+	case 226:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1878
+//line mysql_sql.y:1937
 		{
 			yyLOCAL = &tree.SetPassword{Password: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 219:
+	case 227:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1882
+//line mysql_sql.y:1941
 		{
 			yyLOCAL = &tree.SetPassword{User: yyDollar[4].userUnion(), Password: yyDollar[6].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 221:
+	case 229:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line mysql_sql.y:1889
+//line mysql_sql.y:1948
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 222:
+	case 230:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1895
+//line mysql_sql.y:1954
 		{
 			yyLOCAL = []*tree.VarAssignmentExpr{yyDollar[1].varAssignmentExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 223:
+	case 231:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1899
+//line mysql_sql.y:1958
 		{
 			yyLOCAL = append(yyDollar[1].varAssignmentExprsUnion(), yyDollar[3].varAssignmentExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 224:
+	case 232:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1905
+//line mysql_sql.y:1964
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -9206,10 +9684,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 225:
+	case 233:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1913
+//line mysql_sql.y:1972
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -9219,10 +9697,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 226:
+	case 234:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1922
+//line mysql_sql.y:1981
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -9231,10 +9709,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 227:
+	case 235:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1930
+//line mysql_sql.y:1989
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -9243,10 +9721,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 228:
+	case 236:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1938
+//line mysql_sql.y:1997
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -9270,10 +9748,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 229:
+	case 237:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1961
+//line mysql_sql.y:2020
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -9297,10 +9775,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 230:
+	case 238:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1984
+//line mysql_sql.y:2043
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  strings.ToLower(yyDollar[1].str),
@@ -9308,10 +9786,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 231:
+	case 239:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1991
+//line mysql_sql.y:2050
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  strings.ToLower(yyDollar[1].str),
@@ -9319,10 +9797,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 232:
+	case 240:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1998
+//line mysql_sql.y:2057
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:     strings.ToLower(yyDollar[1].str),
@@ -9331,10 +9809,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 233:
+	case 241:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:2006
+//line mysql_sql.y:2065
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  strings.ToLower(yyDollar[1].str),
@@ -9342,10 +9820,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 234:
+	case 242:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:2013
+//line mysql_sql.y:2072
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  strings.ToLower(yyDollar[1].str),
@@ -9353,10 +9831,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 235:
+	case 243:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:2020
+//line mysql_sql.y:2079
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  strings.ToLower(yyDollar[1].str),
@@ -9364,212 +9842,212 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 236:
+	case 244:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:2029
+//line mysql_sql.y:2088
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_char)
 		}
 		yyVAL.union = yyLOCAL
-	case 237:
+	case 245:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:2033
+//line mysql_sql.y:2092
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_char)
 		}
 		yyVAL.union = yyLOCAL
-	case 238:
+	case 246:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:2037
+//line mysql_sql.y:2096
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 239:
+	case 247:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2043
+//line mysql_sql.y:2102
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 240:
+	case 248:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2047
+//line mysql_sql.y:2106
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 241:
+	case 249:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2053
+//line mysql_sql.y:2112
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 242:
+	case 250:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2057
+//line mysql_sql.y:2116
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare() + "." + yyDollar[3].cstrUnion().Compare()
 		}
-	case 243:
+	case 251:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2063
+//line mysql_sql.y:2122
 		{
 			yyLOCAL = []string{yyDollar[1].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 244:
+	case 252:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2067
+//line mysql_sql.y:2126
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 248:
+	case 256:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2078
+//line mysql_sql.y:2137
 		{
 			yyLOCAL = &tree.RollbackTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 249:
+	case 257:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2084
+//line mysql_sql.y:2143
 		{
 			yyLOCAL = &tree.CommitTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 250:
+	case 258:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2089
+//line mysql_sql.y:2148
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 251:
+	case 259:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2093
+//line mysql_sql.y:2152
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 252:
+	case 260:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2097
+//line mysql_sql.y:2156
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 253:
+	case 261:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2101
+//line mysql_sql.y:2160
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 254:
+	case 262:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2105
+//line mysql_sql.y:2164
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
 		yyVAL.union = yyLOCAL
-	case 255:
+	case 263:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2109
+//line mysql_sql.y:2168
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
 		yyVAL.union = yyLOCAL
-	case 256:
+	case 264:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2113
+//line mysql_sql.y:2172
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 257:
+	case 265:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2117
+//line mysql_sql.y:2176
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 258:
+	case 266:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2121
+//line mysql_sql.y:2180
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 259:
+	case 267:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2127
+//line mysql_sql.y:2186
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 260:
+	case 268:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2131
+//line mysql_sql.y:2190
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 261:
+	case 269:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2135
+//line mysql_sql.y:2194
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 262:
+	case 270:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2139
+//line mysql_sql.y:2198
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_WRITE)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
 		}
 		yyVAL.union = yyLOCAL
-	case 263:
+	case 271:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2144
+//line mysql_sql.y:2203
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_ONLY)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
 		}
 		yyVAL.union = yyLOCAL
-	case 264:
+	case 272:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2149
+//line mysql_sql.y:2208
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 265:
+	case 273:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2155
+//line mysql_sql.y:2214
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole: false,
@@ -9577,20 +10055,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 266:
+	case 274:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2162
+//line mysql_sql.y:2221
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 267:
+	case 275:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2168
+//line mysql_sql.y:2227
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole: false,
@@ -9598,10 +10076,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 268:
+	case 276:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2175
+//line mysql_sql.y:2234
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole:     true,
@@ -9609,10 +10087,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 269:
+	case 277:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2182
+//line mysql_sql.y:2241
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole:     true,
@@ -9620,19 +10098,19 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 271:
+	case 279:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2192
+//line mysql_sql.y:2251
 		{
 			yyDollar[2].statementUnion().(*tree.Update).With = yyDollar[1].withClauseUnion()
 			yyLOCAL = yyDollar[2].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 272:
+	case 280:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2199
+//line mysql_sql.y:2258
 		{
 			// Single-table syntax
 			yyLOCAL = &tree.Update{
@@ -9644,10 +10122,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 273:
+	case 281:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2210
+//line mysql_sql.y:2269
 		{
 			// Multiple-table syntax
 			yyLOCAL = &tree.Update{
@@ -9657,210 +10135,210 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 274:
+	case 282:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:2221
+//line mysql_sql.y:2280
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 275:
+	case 283:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:2225
+//line mysql_sql.y:2284
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 276:
+	case 284:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:2231
+//line mysql_sql.y:2290
 		{
 			yyLOCAL = &tree.UpdateExpr{Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}, Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 279:
+	case 287:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2241
+//line mysql_sql.y:2300
 		{
 			yyLOCAL = &tree.LockTableStmt{TableLocks: yyDollar[3].tableLocksUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 280:
+	case 288:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableLock
-//line mysql_sql.y:2247
+//line mysql_sql.y:2306
 		{
 			yyLOCAL = []tree.TableLock{yyDollar[1].tableLockUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 281:
+	case 289:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TableLock
-//line mysql_sql.y:2251
+//line mysql_sql.y:2310
 		{
 			yyLOCAL = append(yyDollar[1].tableLocksUnion(), yyDollar[3].tableLockUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 282:
+	case 290:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableLock
-//line mysql_sql.y:2257
+//line mysql_sql.y:2316
 		{
 			yyLOCAL = tree.TableLock{*yyDollar[1].tableNameUnion(), yyDollar[2].tableLockTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 283:
+	case 291:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableLockType
-//line mysql_sql.y:2263
+//line mysql_sql.y:2322
 		{
 			yyLOCAL = tree.TableLockRead
 		}
 		yyVAL.union = yyLOCAL
-	case 284:
+	case 292:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableLockType
-//line mysql_sql.y:2267
+//line mysql_sql.y:2326
 		{
 			yyLOCAL = tree.TableLockReadLocal
 		}
 		yyVAL.union = yyLOCAL
-	case 285:
+	case 293:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableLockType
-//line mysql_sql.y:2271
+//line mysql_sql.y:2330
 		{
 			yyLOCAL = tree.TableLockWrite
 		}
 		yyVAL.union = yyLOCAL
-	case 286:
+	case 294:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableLockType
-//line mysql_sql.y:2275
+//line mysql_sql.y:2334
 		{
 			yyLOCAL = tree.TableLockLowPriorityWrite
 		}
 		yyVAL.union = yyLOCAL
-	case 287:
+	case 295:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2281
+//line mysql_sql.y:2340
 		{
 			yyLOCAL = &tree.UnLockTableStmt{}
 		}
 		yyVAL.union = yyLOCAL
-	case 294:
+	case 302:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2293
+//line mysql_sql.y:2352
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 295:
+	case 303:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2299
+//line mysql_sql.y:2358
 		{
 			yyLOCAL = tree.NewPrepareStmt(tree.Identifier(yyDollar[2].str), yyDollar[4].statementUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 296:
+	case 304:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2303
+//line mysql_sql.y:2362
 		{
 			yyLOCAL = tree.NewPrepareString(tree.Identifier(yyDollar[2].str), yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 297:
+	case 305:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2309
+//line mysql_sql.y:2368
 		{
 			yyLOCAL = tree.NewExecute(tree.Identifier(yyDollar[2].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 298:
+	case 306:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2313
+//line mysql_sql.y:2372
 		{
 			yyLOCAL = tree.NewExecuteWithVariables(tree.Identifier(yyDollar[2].str), yyDollar[4].varExprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 299:
+	case 307:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2319
+//line mysql_sql.y:2378
 		{
 			yyLOCAL = tree.NewDeallocate(tree.Identifier(yyDollar[3].str), false)
 		}
 		yyVAL.union = yyLOCAL
-	case 300:
+	case 308:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2325
+//line mysql_sql.y:2384
 		{
 			yyLOCAL = tree.NewReset(tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 306:
+	case 314:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2336
+//line mysql_sql.y:2395
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 307:
+	case 315:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2342
+//line mysql_sql.y:2401
 		{
 			yyLOCAL = &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 308:
+	case 316:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2346
+//line mysql_sql.y:2405
 		{
 			yyLOCAL = &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion(), ColName: yyDollar[3].unresolvedNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 309:
+	case 317:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2350
+//line mysql_sql.y:2409
 		{
 			yyLOCAL = tree.NewExplainFor("", uint64(yyDollar[4].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 310:
+	case 318:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2354
+//line mysql_sql.y:2413
 		{
 			yyLOCAL = tree.NewExplainFor(yyDollar[4].str, uint64(yyDollar[7].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 311:
+	case 319:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2358
+//line mysql_sql.y:2417
 		{
 			yyLOCAL = tree.NewExplainStmt(yyDollar[2].statementUnion(), "text")
 		}
 		yyVAL.union = yyLOCAL
-	case 312:
+	case 320:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2362
+//line mysql_sql.y:2421
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[3].statementUnion(), "text")
 			optionElem := tree.MakeOptionElem("verbose", "NULL")
@@ -9869,10 +10347,10 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 313:
+	case 321:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2370
+//line mysql_sql.y:2429
 		{
 			explainStmt := tree.NewExplainAnalyze(yyDollar[3].statementUnion(), "text")
 			optionElem := tree.MakeOptionElem("analyze", "NULL")
@@ -9881,10 +10359,10 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 314:
+	case 322:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2378
+//line mysql_sql.y:2437
 		{
 			explainStmt := tree.NewExplainAnalyze(yyDollar[4].statementUnion(), "text")
 			optionElem1 := tree.MakeOptionElem("analyze", "NULL")
@@ -9895,10 +10373,36 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 315:
+	case 323:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2447
+		{
+			explainStmt := tree.NewExplainAnalyze(yyDollar[6].statementUnion(), yyDollar[5].str)
+			optionElem1 := tree.MakeOptionElem("analyze", "NULL")
+			optionElem2 := tree.MakeOptionElem("format", yyDollar[5].str)
+			options := tree.MakeOptions(optionElem1)
+			options = append(options, optionElem2)
+			explainStmt.Options = options
+			yyLOCAL = explainStmt
+		}
+		yyVAL.union = yyLOCAL
+	case 324:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2457
+		{
+			explainStmt := tree.NewExplainStmt(yyDollar[5].statementUnion(), yyDollar[4].str)
+			optionElem := tree.MakeOptionElem("format", yyDollar[4].str)
+			options := tree.MakeOptions(optionElem)
+			explainStmt.Options = options
+			yyLOCAL = explainStmt
+		}
+		yyVAL.union = yyLOCAL
+	case 325:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2388
+//line mysql_sql.y:2465
 		{
 			if tree.IsContainAnalyze(yyDollar[3].epxlainOptionsUnion()) {
 				explainStmt := tree.NewExplainAnalyze(yyDollar[5].statementUnion(), "text")
@@ -9911,66 +10415,74 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 328:
+	case 338:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.OptionElem
-//line mysql_sql.y:2429
+//line mysql_sql.y:2506
 		{
 			yyLOCAL = tree.MakeOptions(yyDollar[1].epxlainOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 329:
+	case 339:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.OptionElem
-//line mysql_sql.y:2433
+//line mysql_sql.y:2510
 		{
 			yyLOCAL = append(yyDollar[1].epxlainOptionsUnion(), yyDollar[3].epxlainOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 330:
+	case 340:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.OptionElem
-//line mysql_sql.y:2439
+//line mysql_sql.y:2516
 		{
 			yyLOCAL = tree.MakeOptionElem(yyDollar[1].str, yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 331:
+	case 341:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2445
+//line mysql_sql.y:2522
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 332:
+	case 342:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2450
+//line mysql_sql.y:2527
 		{
 			yyVAL.str = "true"
 		}
-	case 333:
+	case 343:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2451
+//line mysql_sql.y:2528
 		{
 			yyVAL.str = "false"
 		}
-	case 334:
+	case 344:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2452
+//line mysql_sql.y:2529
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 335:
+	case 345:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2534
+		{
+			yyLOCAL = tree.NewAnalyzeStmt(yyDollar[3].tableNameUnion(), nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 346:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2457
+//line mysql_sql.y:2538
 		{
 			yyLOCAL = tree.NewAnalyzeStmt(yyDollar[3].tableNameUnion(), yyDollar[5].identifierListUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 342:
+	case 353:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2472
+//line mysql_sql.y:2553
 		{
 			yyLOCAL = &tree.AlterView{
 				Name:     yyDollar[4].tableNameUnion(),
@@ -9980,10 +10492,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 343:
+	case 354:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2483
+//line mysql_sql.y:2564
 		{
 			yyLOCAL = &tree.AlterTable{
 				Table:   yyDollar[3].tableNameUnion(),
@@ -9991,26 +10503,48 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 344:
+	case 355:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2571
+		{
+			yyLOCAL = &tree.AlterTable{
+				Table:   yyDollar[3].tableNameUnion(),
+				Options: []tree.AlterTableOption{yyDollar[4].alterTableOptionUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 356:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2578
+		{
+			yyLOCAL = &tree.AlterTable{
+				Table:   yyDollar[3].tableNameUnion(),
+				Options: []tree.AlterTableOption{&tree.AlterOptionCompact{}},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 357:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.AlterTableOptions
-//line mysql_sql.y:2492
+//line mysql_sql.y:2587
 		{
 			yyLOCAL = []tree.AlterTableOption{yyDollar[1].alterTableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 345:
+	case 358:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AlterTableOptions
-//line mysql_sql.y:2496
+//line mysql_sql.y:2591
 		{
 			yyLOCAL = append(yyDollar[1].alterTableOptionsUnion(), yyDollar[3].alterTableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 346:
+	case 359:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2502
+//line mysql_sql.y:2597
 		{
 			opt := &tree.AlterOptionAdd{
 				Def: yyDollar[2].tableDefUnion(),
@@ -10018,34 +10552,127 @@ yydefault:
 			yyLOCAL = tree.AlterTableOption(opt)
 		}
 		yyVAL.union = yyLOCAL
-	case 347:
+	case 360:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2509
+//line mysql_sql.y:2604
 		{
 			yyLOCAL = tree.AlterTableOption(yyDollar[2].alterTableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 348:
+	case 361:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2513
+//line mysql_sql.y:2608
 		{
 			yyLOCAL = tree.AlterTableOption(yyDollar[2].alterTableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 349:
+	case 362:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2517
+//line mysql_sql.y:2612
 		{
 			yyLOCAL = tree.AlterTableOption(yyDollar[1].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 350:
+	case 363:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2618
+		{
+			yyLOCAL = &tree.AlterOptionPartition{
+				Typ:        tree.AlterPartitionAdd,
+				Partitions: yyDollar[4].partitionsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 364:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2625
+		{
+			yyLOCAL = &tree.AlterOptionPartition{
+				Typ:   tree.AlterPartitionDrop,
+				Names: yyDollar[3].identifierListUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 365:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2632
+		{
+			yyLOCAL = &tree.AlterOptionPartition{
+				Typ:   tree.AlterPartitionTruncate,
+				Names: yyDollar[3].identifierListUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 366:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2639
+		{
+			yyLOCAL = &tree.AlterOptionPartition{
+				Typ: tree.AlterPartitionTruncate,
+				All: true,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 367:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2646
+		{
+			yyLOCAL = &tree.AlterOptionPartition{
+				Typ:               tree.AlterPartitionExchange,
+				Names:             tree.IdentifierList{tree.Identifier(yyDollar[3].cstrUnion().Compare())},
+				ExchangeTable:     yyDollar[6].tableNameUnion(),
+				WithoutValidation: yyDollar[7].boolValUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 368:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2655
+		{
+			yyLOCAL = &tree.AlterOptionPartition{
+				Typ:        tree.AlterPartitionReorganize,
+				Names:      yyDollar[3].identifierListUnion(),
+				Partitions: yyDollar[6].partitionsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 369:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL bool
+//line mysql_sql.y:2664
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 370:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL bool
+//line mysql_sql.y:2668
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 371:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL bool
+//line mysql_sql.y:2672
+		{
+			yyLOCAL = true
+		}
+		yyVAL.union = yyLOCAL
+	case 372:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2523
+//line mysql_sql.y:2678
 		{
 			yyLOCAL = &tree.AlterOptionDrop{
 				Typ:  tree.AlterTableDropIndex,
@@ -10053,10 +10680,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 351:
+	case 373:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2530
+//line mysql_sql.y:2685
 		{
 			yyLOCAL = &tree.AlterOptionDrop{
 				Typ:  tree.AlterTableDropKey,
@@ -10064,10 +10691,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 352:
+	case 374:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2537
+//line mysql_sql.y:2692
 		{
 			yyLOCAL = &tree.AlterOptionDrop{
 				Typ:  tree.AlterTableDropColumn,
@@ -10075,10 +10702,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 353:
+	case 375:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2544
+//line mysql_sql.y:2699
 		{
 			yyLOCAL = &tree.AlterOptionDrop{
 				Typ:  tree.AlterTableDropForeignKey,
@@ -10086,20 +10713,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 354:
+	case 376:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2551
+//line mysql_sql.y:2706
 		{
 			yyLOCAL = &tree.AlterOptionDrop{
 				Typ: tree.AlterTableDropPrimaryKey,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 355:
+	case 377:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2559
+//line mysql_sql.y:2714
 		{
 			yyLOCAL = &tree.AlterOptionAlterIndex{
 				Visibility: yyDollar[3].indexVisibilityUnion(),
@@ -10107,26 +10734,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 356:
+	case 378:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.VisibleType
-//line mysql_sql.y:2568
+//line mysql_sql.y:2723
 		{
 			yyLOCAL = tree.VISIBLE_TYPE_VISIBLE
 		}
 		yyVAL.union = yyLOCAL
-	case 357:
+	case 379:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.VisibleType
-//line mysql_sql.y:2572
+//line mysql_sql.y:2727
 		{
 			yyLOCAL = tree.VISIBLE_TYPE_INVISIBLE
 		}
 		yyVAL.union = yyLOCAL
-	case 358:
+	case 380:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2579
+//line mysql_sql.y:2734
 		{
 			yyLOCAL = &tree.AlterAccount{
 				IfExists:     yyDollar[3].boolValUnion(),
@@ -10137,10 +10764,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 359:
+	case 381:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2591
+//line mysql_sql.y:2746
 		{
 			yyLOCAL = &tree.AlterDataBaseConfig{
 				DbName:         yyDollar[3].str,
@@ -10149,10 +10776,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 360:
+	case 382:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2599
+//line mysql_sql.y:2754
 		{
 			yyLOCAL = &tree.AlterDataBaseConfig{
 				AccountName:    yyDollar[4].str,
@@ -10161,20 +10788,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 361:
+	case 383:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.AlterAccountAuthOption
-//line mysql_sql.y:2608
+//line mysql_sql.y:2763
 		{
 			yyLOCAL = tree.AlterAccountAuthOption{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 362:
+	case 384:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.AlterAccountAuthOption
-//line mysql_sql.y:2614
+//line mysql_sql.y:2769
 		{
 			yyLOCAL = tree.AlterAccountAuthOption{
 				Exist:          true,
@@ -10184,10 +10811,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 363:
+	case 385:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2625
+//line mysql_sql.y:2780
 		{
 			yyLOCAL = &tree.AlterUser{
 				IfExists:           yyDollar[3].boolValUnion(),
@@ -10198,222 +10825,250 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 364:
+	case 386:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2790
+		{
+			yyLOCAL = &tree.AlterUser{
+				IfExists: yyDollar[3].boolValUnion(),
+				Users:    []*tree.User{{Username: yyDollar[4].usernameRecordUnion().Username, Hostname: yyDollar[4].usernameRecordUnion().Hostname}},
+				MiscOpt:  yyDollar[5].userMiscOptionUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 387:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:2636
+//line mysql_sql.y:2799
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 365:
+	case 388:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:2640
+//line mysql_sql.y:2803
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 366:
+	case 389:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2645
+//line mysql_sql.y:2808
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 367:
+	case 390:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2649
+//line mysql_sql.y:2812
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 368:
+	case 391:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2654
+//line mysql_sql.y:2817
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 369:
+	case 392:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2658
+//line mysql_sql.y:2821
 		{
 			yyLOCAL = yyDollar[1].userMiscOptionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 370:
+	case 393:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2674
+//line mysql_sql.y:2837
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountUnlock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 371:
+	case 394:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2678
+//line mysql_sql.y:2841
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountLock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 372:
+	case 395:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.UserMiscOption
+//line mysql_sql.y:2845
+		{
+			yyLOCAL = &tree.UserMiscOptionAccountUnlock{}
+		}
+		yyVAL.union = yyLOCAL
+	case 396:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.UserMiscOption
+//line mysql_sql.y:2849
+		{
+			yyLOCAL = &tree.UserMiscOptionAccountLock{}
+		}
+		yyVAL.union = yyLOCAL
+	case 397:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2682
+//line mysql_sql.y:2853
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNone{}
 		}
 		yyVAL.union = yyLOCAL
-	case 373:
+	case 398:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2686
+//line mysql_sql.y:2857
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireInterval{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 374:
+	case 399:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2690
+//line mysql_sql.y:2861
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNever{}
 		}
 		yyVAL.union = yyLOCAL
-	case 375:
+	case 400:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2694
+//line mysql_sql.y:2865
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 376:
+	case 401:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2698
+//line mysql_sql.y:2869
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordHistoryDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 377:
+	case 402:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2702
+//line mysql_sql.y:2873
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordHistoryCount{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 378:
+	case 403:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2706
+//line mysql_sql.y:2877
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordReuseIntervalDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 379:
+	case 404:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2710
+//line mysql_sql.y:2881
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordReuseIntervalCount{Value: yyDollar[4].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 380:
+	case 405:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2714
+//line mysql_sql.y:2885
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordRequireCurrentNone{}
 		}
 		yyVAL.union = yyLOCAL
-	case 381:
+	case 406:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2718
+//line mysql_sql.y:2889
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordRequireCurrentDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 382:
+	case 407:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2722
+//line mysql_sql.y:2893
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordRequireCurrentOptional{}
 		}
 		yyVAL.union = yyLOCAL
-	case 383:
+	case 408:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2726
+//line mysql_sql.y:2897
 		{
 			yyLOCAL = &tree.UserMiscOptionFailedLoginAttempts{Value: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 384:
+	case 409:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2730
+//line mysql_sql.y:2901
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordLockTimeCount{Value: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 385:
+	case 410:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2734
+//line mysql_sql.y:2905
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordLockTimeUnbounded{}
 		}
 		yyVAL.union = yyLOCAL
-	case 386:
+	case 411:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2740
+//line mysql_sql.y:2911
 		{
 			yyVAL.item = nil
 		}
-	case 387:
+	case 412:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2745
+//line mysql_sql.y:2916
 		{
 			yyVAL.item = nil
 		}
-	case 416:
+	case 442:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2783
+//line mysql_sql.y:2955
 		{
 			yyLOCAL = &tree.ShowCollation{}
 		}
 		yyVAL.union = yyLOCAL
-	case 417:
+	case 443:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2788
+//line mysql_sql.y:2960
 		{
 			yyLOCAL = &tree.ShowGrants{ShowGrantType: tree.GrantForUser}
 		}
 		yyVAL.union = yyLOCAL
-	case 418:
+	case 444:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2792
+//line mysql_sql.y:2964
 		{
 			yyLOCAL = &tree.ShowGrants{Username: yyDollar[4].usernameRecordUnion().Username, Hostname: yyDollar[4].usernameRecordUnion().Hostname, Roles: yyDollar[5].rolesUnion(), ShowGrantType: tree.GrantForUser}
 		}
 		yyVAL.union = yyLOCAL
-	case 419:
+	case 445:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2796
+//line mysql_sql.y:2968
 		{
 			s := &tree.ShowGrants{}
 			roles := []*tree.Role{tree.NewRole(yyDollar[5].cstrUnion().Compare())}
@@ -10422,44 +11077,44 @@ yydefault:
 			yyLOCAL = s
 		}
 		yyVAL.union = yyLOCAL
-	case 420:
+	case 446:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:2805
+//line mysql_sql.y:2977
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 421:
+	case 447:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:2809
+//line mysql_sql.y:2981
 		{
 			yyLOCAL = yyDollar[2].rolesUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 422:
+	case 448:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2815
+//line mysql_sql.y:2987
 		{
 			yyLOCAL = &tree.ShowTableStatus{DbName: yyDollar[5].str, Like: yyDollar[6].comparisionExprUnion(), Where: yyDollar[7].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 423:
+	case 449:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2820
+//line mysql_sql.y:2992
 		{
 		}
-	case 425:
+	case 451:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2824
+//line mysql_sql.y:2996
 		{
 		}
-	case 427:
+	case 453:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2829
+//line mysql_sql.y:3001
 		{
 			yyLOCAL = &tree.ShowFunctionStatus{
 				Like:  yyDollar[4].comparisionExprUnion(),
@@ -10467,68 +11122,68 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 428:
+	case 454:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2838
+//line mysql_sql.y:3010
 		{
 			yyLOCAL = &tree.ShowRolesStmt{
 				Like: yyDollar[3].comparisionExprUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 429:
+	case 455:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2846
+//line mysql_sql.y:3018
 		{
 			yyLOCAL = &tree.ShowNodeList{}
 		}
 		yyVAL.union = yyLOCAL
-	case 430:
+	case 456:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2852
+//line mysql_sql.y:3024
 		{
 			yyLOCAL = &tree.ShowLocks{}
 		}
 		yyVAL.union = yyLOCAL
-	case 431:
+	case 457:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2858
+//line mysql_sql.y:3030
 		{
 			yyLOCAL = &tree.ShowTableNumber{DbName: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 432:
+	case 458:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2864
+//line mysql_sql.y:3036
 		{
 			yyLOCAL = &tree.ShowColumnNumber{Table: yyDollar[3].unresolvedObjectNameUnion(), DbName: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 433:
+	case 459:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2870
+//line mysql_sql.y:3042
 		{
 			yyLOCAL = &tree.ShowTableValues{Table: yyDollar[3].unresolvedObjectNameUnion(), DbName: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 434:
+	case 460:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2876
+//line mysql_sql.y:3048
 		{
 			yyLOCAL = &tree.ShowTableSize{Table: yyDollar[3].unresolvedObjectNameUnion(), DbName: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 435:
+	case 461:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2882
+//line mysql_sql.y:3054
 		{
 			s := yyDollar[2].statementUnion().(*tree.ShowTarget)
 			s.Like = yyDollar[3].comparisionExprUnion()
@@ -10536,82 +11191,82 @@ yydefault:
 			yyLOCAL = s
 		}
 		yyVAL.union = yyLOCAL
-	case 436:
+	case 462:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2891
+//line mysql_sql.y:3063
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowConfig}
 		}
 		yyVAL.union = yyLOCAL
-	case 437:
+	case 463:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2895
+//line mysql_sql.y:3067
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowCharset}
 		}
 		yyVAL.union = yyLOCAL
-	case 438:
+	case 464:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2899
+//line mysql_sql.y:3071
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowEngines}
 		}
 		yyVAL.union = yyLOCAL
-	case 439:
+	case 465:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2903
+//line mysql_sql.y:3075
 		{
 			yyLOCAL = &tree.ShowTarget{DbName: yyDollar[3].str, Type: tree.ShowTriggers}
 		}
 		yyVAL.union = yyLOCAL
-	case 440:
+	case 466:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2907
+//line mysql_sql.y:3079
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowProcedureStatus}
 		}
 		yyVAL.union = yyLOCAL
-	case 441:
+	case 467:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2911
+//line mysql_sql.y:3083
 		{
 			yyLOCAL = &tree.ShowTarget{DbName: yyDollar[3].str, Type: tree.ShowEvents}
 		}
 		yyVAL.union = yyLOCAL
-	case 442:
+	case 468:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2915
+//line mysql_sql.y:3087
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowPlugins}
 		}
 		yyVAL.union = yyLOCAL
-	case 443:
+	case 469:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2919
+//line mysql_sql.y:3091
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowPrivileges}
 		}
 		yyVAL.union = yyLOCAL
-	case 444:
+	case 470:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2923
+//line mysql_sql.y:3095
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowProfiles}
 		}
 		yyVAL.union = yyLOCAL
-	case 445:
+	case 471:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2929
+//line mysql_sql.y:3101
 		{
 			yyLOCAL = &tree.ShowIndex{
 				TableName: *yyDollar[5].tableNameUnion(),
@@ -10619,10 +11274,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 446:
+	case 472:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2936
+//line mysql_sql.y:3108
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[7].cstrUnion().Compare()), ExplicitSchema: true}
 			tbl := tree.NewTableName(tree.Identifier(yyDollar[5].cstrUnion().Compare()), prefix)
@@ -10632,20 +11287,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 447:
+	case 473:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2946
+//line mysql_sql.y:3118
 		{
 		}
-	case 448:
+	case 474:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2948
+//line mysql_sql.y:3120
 		{
 		}
-	case 452:
+	case 478:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2957
+//line mysql_sql.y:3129
 		{
 			yyLOCAL = &tree.ShowVariables{
 				Global: yyDollar[2].boolValUnion(),
@@ -10654,10 +11309,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 453:
+	case 479:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2967
+//line mysql_sql.y:3139
 		{
 			yyLOCAL = &tree.ShowStatus{
 				Global: yyDollar[2].boolValUnion(),
@@ -10666,58 +11321,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 454:
+	case 480:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2976
+//line mysql_sql.y:3148
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 455:
+	case 481:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2980
+//line mysql_sql.y:3152
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 456:
+	case 482:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2984
+//line mysql_sql.y:3156
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 457:
+	case 483:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2990
+//line mysql_sql.y:3162
 		{
 			yyLOCAL = &tree.ShowWarnings{}
 		}
 		yyVAL.union = yyLOCAL
-	case 458:
+	case 484:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2996
+//line mysql_sql.y:3168
 		{
 			yyLOCAL = &tree.ShowErrors{}
 		}
 		yyVAL.union = yyLOCAL
-	case 459:
+	case 485:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3002
+//line mysql_sql.y:3174
 		{
 			yyLOCAL = &tree.ShowProcessList{Full: yyDollar[2].fullOptUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 460:
+	case 486:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3008
+//line mysql_sql.y:3180
 		{
 			yyLOCAL = &tree.ShowSequences{
 				DBName: yyDollar[3].str,
@@ -10725,10 +11380,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 461:
+	case 487:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3017
+//line mysql_sql.y:3189
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   false,
@@ -10739,10 +11394,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 462:
+	case 488:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3027
+//line mysql_sql.y:3199
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   true,
@@ -10753,26 +11408,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 463:
+	case 489:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3039
+//line mysql_sql.y:3211
 		{
 			yyLOCAL = &tree.ShowDatabases{Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 464:
+	case 490:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3043
+//line mysql_sql.y:3215
 		{
 			yyLOCAL = &tree.ShowDatabases{Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 465:
+	case 491:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3049
+//line mysql_sql.y:3221
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   false,
@@ -10785,10 +11440,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 466:
+	case 492:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3061
+//line mysql_sql.y:3233
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   true,
@@ -10801,196 +11456,204 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 467:
+	case 493:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3075
+//line mysql_sql.y:3247
 		{
 			yyLOCAL = &tree.ShowAccounts{Like: yyDollar[3].comparisionExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 468:
+	case 494:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3081
+//line mysql_sql.y:3253
 		{
 			yyLOCAL = &tree.ShowPublications{Like: yyDollar[3].comparisionExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 469:
+	case 495:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:3260
+		{
+			yyLOCAL = &tree.ShowChangefeeds{}
+		}
+		yyVAL.union = yyLOCAL
+	case 496:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3088
+//line mysql_sql.y:3266
 		{
 			yyLOCAL = &tree.ShowSubscriptions{Like: yyDollar[3].comparisionExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 470:
+	case 497:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:3093
+//line mysql_sql.y:3271
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 471:
+	case 498:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:3097
+//line mysql_sql.y:3275
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.LIKE, nil, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 472:
+	case 499:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:3101
+//line mysql_sql.y:3279
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.ILIKE, nil, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 473:
+	case 500:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3106
+//line mysql_sql.y:3284
 		{
 			yyVAL.str = ""
 		}
-	case 474:
+	case 501:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3110
+//line mysql_sql.y:3288
 		{
 			yyVAL.str = yyDollar[2].cstrUnion().Compare()
 		}
-	case 475:
+	case 502:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:3116
+//line mysql_sql.y:3294
 		{
 			yyLOCAL = yyDollar[2].unresolvedObjectNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 480:
+	case 507:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3129
+//line mysql_sql.y:3307
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 481:
+	case 508:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3133
+//line mysql_sql.y:3311
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 482:
+	case 509:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3139
+//line mysql_sql.y:3317
 		{
 			yyLOCAL = &tree.ShowCreateTable{Name: yyDollar[4].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 483:
+	case 510:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3144
+//line mysql_sql.y:3322
 		{
 			yyLOCAL = &tree.ShowCreateView{Name: yyDollar[4].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 484:
+	case 511:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3148
+//line mysql_sql.y:3326
 		{
 			yyLOCAL = &tree.ShowCreateDatabase{IfNotExists: yyDollar[4].ifNotExistsUnion(), Name: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 485:
+	case 512:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3152
+//line mysql_sql.y:3330
 		{
 			yyLOCAL = &tree.ShowCreatePublications{Name: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 486:
+	case 513:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3158
+//line mysql_sql.y:3336
 		{
 			yyLOCAL = &tree.ShowBackendServers{}
 		}
 		yyVAL.union = yyLOCAL
-	case 487:
+	case 514:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:3164
+//line mysql_sql.y:3342
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].cstrUnion().Compare()})
 		}
 		yyVAL.union = yyLOCAL
-	case 488:
+	case 515:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:3168
+//line mysql_sql.y:3346
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].cstrUnion().Compare(), yyDollar[1].cstrUnion().Compare()})
 		}
 		yyVAL.union = yyLOCAL
-	case 489:
+	case 516:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3174
+//line mysql_sql.y:3352
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 490:
+	case 517:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:3180
+//line mysql_sql.y:3358
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].cstrUnion().Compare()})
 		}
 		yyVAL.union = yyLOCAL
-	case 491:
+	case 518:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:3184
+//line mysql_sql.y:3362
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].cstrUnion().Compare(), yyDollar[1].cstrUnion().Compare()})
 		}
 		yyVAL.union = yyLOCAL
-	case 492:
+	case 519:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:3188
+//line mysql_sql.y:3366
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(3, [3]string{yyDollar[5].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare(), yyDollar[1].cstrUnion().Compare()})
 		}
 		yyVAL.union = yyLOCAL
-	case 493:
+	case 520:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3194
+//line mysql_sql.y:3372
 		{
 			yyLOCAL = tree.NewTruncateTable(yyDollar[2].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 494:
+	case 521:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3198
+//line mysql_sql.y:3376
 		{
 			yyLOCAL = tree.NewTruncateTable(yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 508:
+	case 539:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3221
+//line mysql_sql.y:3403
 		{
 			yyLOCAL = &tree.DropSequence{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -10998,10 +11661,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 509:
+	case 540:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3230
+//line mysql_sql.y:3412
 		{
 			yyLOCAL = &tree.DropAccount{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -11009,10 +11672,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 510:
+	case 541:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3239
+//line mysql_sql.y:3421
 		{
 			yyLOCAL = &tree.DropUser{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -11020,26 +11683,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 511:
+	case 542:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:3248
+//line mysql_sql.y:3430
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 512:
+	case 543:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:3252
+//line mysql_sql.y:3434
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 513:
+	case 544:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:3258
+//line mysql_sql.y:3440
 		{
 			yyLOCAL = &tree.User{
 				Username: yyDollar[1].usernameRecordUnion().Username,
@@ -11047,10 +11710,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 514:
+	case 545:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3267
+//line mysql_sql.y:3449
 		{
 			yyLOCAL = &tree.DropRole{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -11058,10 +11721,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 515:
+	case 546:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3276
+//line mysql_sql.y:3458
 		{
 			yyLOCAL = &tree.DropIndex{
 				Name:      tree.Identifier(yyDollar[4].cstrUnion().Compare()),
@@ -11070,42 +11733,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 516:
+	case 547:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3286
+//line mysql_sql.y:3468
 		{
 			yyLOCAL = &tree.DropTable{IfExists: yyDollar[3].boolValUnion(), Names: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 517:
+	case 548:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3292
+//line mysql_sql.y:3474
 		{
 			yyLOCAL = &tree.DropView{IfExists: yyDollar[3].boolValUnion(), Names: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 518:
+	case 549:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:3480
+		{
+			yyLOCAL = &tree.DropMaterializedView{IfExists: yyDollar[4].boolValUnion(), Name: yyDollar[5].tableNameUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 550:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3298
+//line mysql_sql.y:3486
 		{
 			yyLOCAL = &tree.DropDatabase{Name: tree.Identifier(yyDollar[4].cstrUnion().Compare()), IfExists: yyDollar[3].boolValUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 519:
+	case 551:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3304
+//line mysql_sql.y:3492
 		{
 			yyLOCAL = tree.NewDeallocate(tree.Identifier(yyDollar[3].str), true)
 		}
 		yyVAL.union = yyLOCAL
-	case 520:
+	case 552:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3310
+//line mysql_sql.y:3498
 		{
 			yyLOCAL = &tree.DropFunction{
 				Name: yyDollar[3].functionNameUnion(),
@@ -11113,10 +11784,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 521:
+	case 553:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3319
+//line mysql_sql.y:3507
 		{
 			yyLOCAL = &tree.DropProcedure{
 				Name:     yyDollar[3].procNameUnion(),
@@ -11124,10 +11795,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 522:
+	case 554:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3326
+//line mysql_sql.y:3514
 		{
 			yyLOCAL = &tree.DropProcedure{
 				Name:     yyDollar[5].procNameUnion(),
@@ -11135,28 +11806,28 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 525:
+	case 557:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3337
+//line mysql_sql.y:3525
 		{
 			yyDollar[2].statementUnion().(*tree.Delete).With = yyDollar[1].withClauseUnion()
 			yyLOCAL = yyDollar[2].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 526:
+	case 558:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3342
+//line mysql_sql.y:3530
 		{
 			yyDollar[2].statementUnion().(*tree.Delete).With = yyDollar[1].withClauseUnion()
 			yyLOCAL = yyDollar[2].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 527:
+	case 559:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3349
+//line mysql_sql.y:3537
 		{
 			// Single-Table Syntax
 			t := &tree.AliasedTableExpr{
@@ -11173,10 +11844,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 528:
+	case 560:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3365
+//line mysql_sql.y:3553
 		{
 			// Multiple-Table Syntax
 			yyLOCAL = &tree.Delete{
@@ -11186,10 +11857,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 529:
+	case 561:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3378
+//line mysql_sql.y:3566
 		{
 			// Multiple-Table Syntax
 			yyLOCAL = &tree.Delete{
@@ -11199,69 +11870,69 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 530:
+	case 562:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:3389
+//line mysql_sql.y:3577
 		{
 			yyLOCAL = tree.TableExprs{yyDollar[1].tableNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 531:
+	case 563:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:3393
+//line mysql_sql.y:3581
 		{
 			yyLOCAL = append(yyDollar[1].tableExprsUnion(), yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 532:
+	case 564:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:3399
+//line mysql_sql.y:3587
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[1].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 533:
+	case 565:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:3404
+//line mysql_sql.y:3592
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].cstrUnion().Compare()), ExplicitSchema: true}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[3].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 534:
+	case 566:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3411
+//line mysql_sql.y:3599
 		{
 		}
-	case 535:
+	case 567:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3413
+//line mysql_sql.y:3601
 		{
 		}
-	case 536:
+	case 568:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3416
+//line mysql_sql.y:3604
 		{
 		}
-	case 541:
+	case 573:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3425
+//line mysql_sql.y:3613
 		{
 		}
-	case 543:
+	case 575:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3429
+//line mysql_sql.y:3617
 		{
 		}
-	case 545:
+	case 577:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3434
+//line mysql_sql.y:3622
 		{
 			rep := yyDollar[4].replaceUnion()
 			rep.Table = yyDollar[2].tableExprUnion()
@@ -11269,10 +11940,10 @@ yydefault:
 			yyLOCAL = rep
 		}
 		yyVAL.union = yyLOCAL
-	case 546:
+	case 578:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3443
+//line mysql_sql.y:3631
 		{
 			vc := tree.NewValuesClause(yyDollar[2].rowsExprsUnion())
 			yyLOCAL = &tree.Replace{
@@ -11280,20 +11951,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 547:
+	case 579:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3450
+//line mysql_sql.y:3638
 		{
 			yyLOCAL = &tree.Replace{
 				Rows: yyDollar[1].selectUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 548:
+	case 580:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3456
+//line mysql_sql.y:3644
 		{
 			vc := tree.NewValuesClause(yyDollar[5].rowsExprsUnion())
 			yyLOCAL = &tree.Replace{
@@ -11302,10 +11973,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 549:
+	case 581:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3464
+//line mysql_sql.y:3652
 		{
 			vc := tree.NewValuesClause(yyDollar[4].rowsExprsUnion())
 			yyLOCAL = &tree.Replace{
@@ -11313,10 +11984,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 550:
+	case 582:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3471
+//line mysql_sql.y:3659
 		{
 			yyLOCAL = &tree.Replace{
 				Columns: yyDollar[2].identifierListUnion(),
@@ -11324,10 +11995,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 551:
+	case 583:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3478
+//line mysql_sql.y:3666
 		{
 			if yyDollar[2].assignmentsUnion() == nil {
 				yylex.Error("the set list of replace can not be empty")
@@ -11346,10 +12017,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 552:
+	case 584:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3498
+//line mysql_sql.y:3686
 		{
 			ins := yyDollar[4].insertUnion()
 			ins.Table = yyDollar[2].tableExprUnion()
@@ -11358,42 +12029,42 @@ yydefault:
 			yyLOCAL = ins
 		}
 		yyVAL.union = yyLOCAL
-	case 553:
+	case 585:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3507
+//line mysql_sql.y:3695
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 554:
+	case 586:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3511
+//line mysql_sql.y:3699
 		{
 			yyLOCAL = yyDollar[2].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 555:
+	case 587:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3517
+//line mysql_sql.y:3705
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 556:
+	case 588:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3521
+//line mysql_sql.y:3709
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 557:
+	case 589:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3527
+//line mysql_sql.y:3715
 		{
 			vc := tree.NewValuesClause(yyDollar[2].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -11401,20 +12072,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 558:
+	case 590:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3534
+//line mysql_sql.y:3722
 		{
 			yyLOCAL = &tree.Insert{
 				Rows: yyDollar[1].selectUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 559:
+	case 591:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3540
+//line mysql_sql.y:3728
 		{
 			vc := tree.NewValuesClause(yyDollar[5].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -11423,10 +12094,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 560:
+	case 592:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3548
+//line mysql_sql.y:3736
 		{
 			vc := tree.NewValuesClause(yyDollar[4].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -11434,10 +12105,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 561:
+	case 593:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3555
+//line mysql_sql.y:3743
 		{
 			yyLOCAL = &tree.Insert{
 				Columns: yyDollar[2].identifierListUnion(),
@@ -11445,10 +12116,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 562:
+	case 594:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3562
+//line mysql_sql.y:3750
 		{
 			if yyDollar[2].assignmentsUnion() == nil {
 				yylex.Error("the set list of insert can not be empty")
@@ -11467,50 +12138,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 563:
+	case 595:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:3581
+//line mysql_sql.y:3769
 		{
 			yyLOCAL = []*tree.UpdateExpr{}
 		}
 		yyVAL.union = yyLOCAL
-	case 564:
+	case 596:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:3585
+//line mysql_sql.y:3773
 		{
 			yyLOCAL = yyDollar[5].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 565:
+	case 597:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:3590
+//line mysql_sql.y:3778
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 566:
+	case 598:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:3594
+//line mysql_sql.y:3782
 		{
 			yyLOCAL = []*tree.Assignment{yyDollar[1].assignmentUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 567:
+	case 599:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:3598
+//line mysql_sql.y:3786
 		{
 			yyLOCAL = append(yyDollar[1].assignmentsUnion(), yyDollar[3].assignmentUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 568:
+	case 600:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Assignment
-//line mysql_sql.y:3604
+//line mysql_sql.y:3792
 		{
 			yyLOCAL = &tree.Assignment{
 				Column: tree.Identifier(yyDollar[1].str),
@@ -11518,155 +12189,155 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 569:
+	case 601:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3613
+//line mysql_sql.y:3801
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 570:
+	case 602:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3617
+//line mysql_sql.y:3805
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 571:
+	case 603:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3623
+//line mysql_sql.y:3811
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 572:
+	case 604:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:3627
+//line mysql_sql.y:3815
 		{
 			yyVAL.str = yyDollar[3].cstrUnion().Compare()
 		}
-	case 573:
+	case 605:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:3633
+//line mysql_sql.y:3821
 		{
 			yyLOCAL = []tree.Exprs{yyDollar[1].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 574:
+	case 606:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:3637
+//line mysql_sql.y:3825
 		{
 			yyLOCAL = append(yyDollar[1].rowsExprsUnion(), yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 575:
+	case 607:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:3643
+//line mysql_sql.y:3831
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 576:
+	case 608:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3648
+//line mysql_sql.y:3836
 		{
 		}
-	case 578:
+	case 610:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:3652
+//line mysql_sql.y:3840
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 580:
+	case 612:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:3659
+//line mysql_sql.y:3847
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 581:
+	case 613:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:3663
+//line mysql_sql.y:3851
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 583:
+	case 615:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:3670
+//line mysql_sql.y:3858
 		{
 			yyLOCAL = &tree.DefaultVal{}
 		}
 		yyVAL.union = yyLOCAL
-	case 584:
+	case 616:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3675
+//line mysql_sql.y:3863
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 585:
+	case 617:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3679
+//line mysql_sql.y:3867
 		{
 			yyLOCAL = yyDollar[3].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 586:
+	case 618:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3685
+//line mysql_sql.y:3873
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].cstrUnion().Compare())}
 		}
 		yyVAL.union = yyLOCAL
-	case 587:
+	case 619:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3689
+//line mysql_sql.y:3877
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].cstrUnion().Compare()))
 		}
 		yyVAL.union = yyLOCAL
-	case 588:
+	case 620:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:3695
+//line mysql_sql.y:3883
 		{
 			yyLOCAL = yyDollar[2].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 589:
+	case 621:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:3699
+//line mysql_sql.y:3887
 		{
 			yyLOCAL = yyDollar[1].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 590:
+	case 622:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:3704
+//line mysql_sql.y:3892
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 591:
+	case 623:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:3708
+//line mysql_sql.y:3896
 		{
 			yyLOCAL = &tree.ExportParam{
 				Outfile:     true,
@@ -11679,10 +12350,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 592:
+	case 624:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:3721
+//line mysql_sql.y:3909
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: ",",
@@ -11690,10 +12361,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 593:
+	case 625:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:3728
+//line mysql_sql.y:3916
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[4].str,
@@ -11701,10 +12372,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 594:
+	case 626:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:3735
+//line mysql_sql.y:3923
 		{
 			str := yyDollar[7].str
 			if str != "\\" && len(str) > 1 {
@@ -11723,10 +12394,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 595:
+	case 627:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:3753
+//line mysql_sql.y:3941
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -11745,38 +12416,38 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 596:
+	case 628:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:3772
+//line mysql_sql.y:3960
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: "\n",
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 597:
+	case 629:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:3778
+//line mysql_sql.y:3966
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: yyDollar[2].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 598:
+	case 630:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3785
+//line mysql_sql.y:3973
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 599:
+	case 631:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3789
+//line mysql_sql.y:3977
 		{
 			str := strings.ToLower(yyDollar[2].str)
 			if str == "true" {
@@ -11789,115 +12460,115 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 600:
+	case 632:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3802
+//line mysql_sql.y:3990
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 601:
+	case 633:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3806
+//line mysql_sql.y:3994
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 602:
+	case 634:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3811
+//line mysql_sql.y:3999
 		{
 			yyLOCAL = []string{}
 		}
 		yyVAL.union = yyLOCAL
-	case 603:
+	case 635:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3815
+//line mysql_sql.y:4003
 		{
 			yyLOCAL = yyDollar[3].strsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 604:
+	case 636:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3822
+//line mysql_sql.y:4010
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 605:
+	case 637:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3827
+//line mysql_sql.y:4015
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 607:
+	case 639:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3834
+//line mysql_sql.y:4022
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 608:
+	case 640:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3840
+//line mysql_sql.y:4028
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 609:
+	case 641:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3844
+//line mysql_sql.y:4032
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Ep: yyDollar[3].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 610:
+	case 642:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3848
+//line mysql_sql.y:4036
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 611:
+	case 643:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3852
+//line mysql_sql.y:4040
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 612:
+	case 644:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3856
+//line mysql_sql.y:4044
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Ep: yyDollar[4].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 613:
+	case 645:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3860
+//line mysql_sql.y:4048
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 614:
+	case 646:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.With
-//line mysql_sql.y:3866
+//line mysql_sql.y:4054
 		{
 			yyLOCAL = &tree.With{
 				IsRecursive: false,
@@ -11905,10 +12576,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 615:
+	case 647:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.With
-//line mysql_sql.y:3873
+//line mysql_sql.y:4061
 		{
 			yyLOCAL = &tree.With{
 				IsRecursive: true,
@@ -11916,26 +12587,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 616:
+	case 648:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.CTE
-//line mysql_sql.y:3882
+//line mysql_sql.y:4070
 		{
 			yyLOCAL = []*tree.CTE{yyDollar[1].cteUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 617:
+	case 649:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.CTE
-//line mysql_sql.y:3886
+//line mysql_sql.y:4074
 		{
 			yyLOCAL = append(yyDollar[1].cteListUnion(), yyDollar[3].cteUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 618:
+	case 650:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.CTE
-//line mysql_sql.y:3892
+//line mysql_sql.y:4080
 		{
 			yyLOCAL = &tree.CTE{
 				Name: &tree.AliasClause{Alias: tree.Identifier(yyDollar[1].cstrUnion().Compare()), Cols: yyDollar[2].identifierListUnion()},
@@ -11943,178 +12614,178 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 619:
+	case 651:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3900
+//line mysql_sql.y:4088
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 620:
+	case 652:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3904
+//line mysql_sql.y:4092
 		{
 			yyLOCAL = yyDollar[2].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 621:
+	case 653:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:3909
+//line mysql_sql.y:4097
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 622:
+	case 654:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:3913
+//line mysql_sql.y:4101
 		{
 			yyLOCAL = yyDollar[1].limitUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 623:
+	case 655:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:3919
+//line mysql_sql.y:4107
 		{
 			yyLOCAL = &tree.Limit{Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 624:
+	case 656:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:3923
+//line mysql_sql.y:4111
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[2].exprUnion(), Count: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 625:
+	case 657:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:3927
+//line mysql_sql.y:4115
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[4].exprUnion(), Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 626:
+	case 658:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:3932
+//line mysql_sql.y:4120
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 627:
+	case 659:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:3936
+//line mysql_sql.y:4124
 		{
 			yyLOCAL = yyDollar[1].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 628:
+	case 660:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:3942
+//line mysql_sql.y:4130
 		{
 			yyLOCAL = yyDollar[3].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 629:
+	case 661:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:3948
+//line mysql_sql.y:4136
 		{
 			yyLOCAL = tree.OrderBy{yyDollar[1].orderUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 630:
+	case 662:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:3952
+//line mysql_sql.y:4140
 		{
 			yyLOCAL = append(yyDollar[1].orderByUnion(), yyDollar[3].orderUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 631:
+	case 663:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Order
-//line mysql_sql.y:3958
+//line mysql_sql.y:4146
 		{
 			yyLOCAL = &tree.Order{Expr: yyDollar[1].exprUnion(), Direction: yyDollar[2].directionUnion(), NullsPosition: yyDollar[3].nullsPositionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 632:
+	case 664:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:3963
+//line mysql_sql.y:4151
 		{
 			yyLOCAL = tree.DefaultDirection
 		}
 		yyVAL.union = yyLOCAL
-	case 633:
+	case 665:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:3967
+//line mysql_sql.y:4155
 		{
 			yyLOCAL = tree.Ascending
 		}
 		yyVAL.union = yyLOCAL
-	case 634:
+	case 666:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:3971
+//line mysql_sql.y:4159
 		{
 			yyLOCAL = tree.Descending
 		}
 		yyVAL.union = yyLOCAL
-	case 635:
+	case 667:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.NullsPosition
-//line mysql_sql.y:3976
+//line mysql_sql.y:4164
 		{
 			yyLOCAL = tree.DefaultNullsPosition
 		}
 		yyVAL.union = yyLOCAL
-	case 636:
+	case 668:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.NullsPosition
-//line mysql_sql.y:3980
+//line mysql_sql.y:4168
 		{
 			yyLOCAL = tree.NullsFirst
 		}
 		yyVAL.union = yyLOCAL
-	case 637:
+	case 669:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.NullsPosition
-//line mysql_sql.y:3984
+//line mysql_sql.y:4172
 		{
 			yyLOCAL = tree.NullsLast
 		}
 		yyVAL.union = yyLOCAL
-	case 638:
+	case 670:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:3990
+//line mysql_sql.y:4178
 		{
 			yyLOCAL = &tree.ParenSelect{Select: yyDollar[2].selectUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 639:
+	case 671:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:3994
+//line mysql_sql.y:4182
 		{
 			yyLOCAL = &tree.ParenSelect{Select: &tree.Select{Select: yyDollar[2].selectStatementUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 640:
+	case 672:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:3998
+//line mysql_sql.y:4186
 		{
 			valuesStmt := yyDollar[2].statementUnion().(*tree.ValuesStatement)
 			yyLOCAL = &tree.ParenSelect{Select: &tree.Select{
@@ -12127,18 +12798,18 @@ yydefault:
 			}}
 		}
 		yyVAL.union = yyLOCAL
-	case 641:
+	case 673:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4012
+//line mysql_sql.y:4200
 		{
 			yyLOCAL = yyDollar[1].selectStatementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 642:
+	case 674:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4016
+//line mysql_sql.y:4204
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -12149,10 +12820,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 643:
+	case 675:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4026
+//line mysql_sql.y:4214
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -12163,10 +12834,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 644:
+	case 676:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4036
+//line mysql_sql.y:4224
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -12177,10 +12848,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 645:
+	case 677:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4046
+//line mysql_sql.y:4234
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -12191,10 +12862,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 646:
+	case 678:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4058
+//line mysql_sql.y:4246
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -12203,10 +12874,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 647:
+	case 679:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4066
+//line mysql_sql.y:4254
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -12215,10 +12886,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 648:
+	case 680:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4074
+//line mysql_sql.y:4262
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -12227,10 +12898,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 649:
+	case 681:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4083
+//line mysql_sql.y:4271
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.EXCEPT,
@@ -12239,10 +12910,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 650:
+	case 682:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4091
+//line mysql_sql.y:4279
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.EXCEPT,
@@ -12251,10 +12922,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 651:
+	case 683:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4099
+//line mysql_sql.y:4287
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.EXCEPT,
@@ -12263,10 +12934,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 652:
+	case 684:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4107
+//line mysql_sql.y:4295
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.INTERSECT,
@@ -12275,10 +12946,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 653:
+	case 685:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4115
+//line mysql_sql.y:4303
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.INTERSECT,
@@ -12287,10 +12958,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 654:
+	case 686:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4123
+//line mysql_sql.y:4311
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.INTERSECT,
@@ -12299,10 +12970,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 655:
+	case 687:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4131
+//line mysql_sql.y:4319
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UT_MINUS,
@@ -12311,10 +12982,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 656:
+	case 688:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4139
+//line mysql_sql.y:4327
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UT_MINUS,
@@ -12323,10 +12994,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 657:
+	case 689:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4147
+//line mysql_sql.y:4335
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UT_MINUS,
@@ -12335,180 +13006,198 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 658:
-		yyDollar = yyS[yypt-7 : yypt+1]
+	case 690:
+		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4157
+//line mysql_sql.y:4345
 		{
 			yyLOCAL = &tree.SelectClause{
-				Distinct: yyDollar[2].boolValUnion(),
-				Exprs:    yyDollar[3].selectExprsUnion(),
-				From:     yyDollar[4].fromUnion(),
-				Where:    yyDollar[5].whereUnion(),
-				GroupBy:  yyDollar[6].groupByUnion(),
-				Having:   yyDollar[7].whereUnion(),
+				Distinct: yyDollar[3].boolValUnion(),
+				Exprs:    yyDollar[4].selectExprsUnion(),
+				From:     yyDollar[5].fromUnion(),
+				Where:    yyDollar[6].whereUnion(),
+				GroupBy:  yyDollar[7].groupByUnion(),
+				Having:   yyDollar[8].whereUnion(),
+				Hints:    yyDollar[2].optimizerHintsUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 659:
-		yyDollar = yyS[yypt-7 : yypt+1]
+	case 691:
+		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4168
+//line mysql_sql.y:4357
 		{
 			yyLOCAL = &tree.SelectClause{
 				Distinct: false,
-				Exprs:    yyDollar[3].selectExprsUnion(),
-				From:     yyDollar[4].fromUnion(),
-				Where:    yyDollar[5].whereUnion(),
-				GroupBy:  yyDollar[6].groupByUnion(),
-				Having:   yyDollar[7].whereUnion(),
-				Option:   yyDollar[2].str,
+				Exprs:    yyDollar[4].selectExprsUnion(),
+				From:     yyDollar[5].fromUnion(),
+				Where:    yyDollar[6].whereUnion(),
+				GroupBy:  yyDollar[7].groupByUnion(),
+				Having:   yyDollar[8].whereUnion(),
+				Option:   yyDollar[3].str,
+				Hints:    yyDollar[2].optimizerHintsUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 660:
+	case 692:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.OptimizerHints
+//line mysql_sql.y:4371
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 693:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4182
+		var yyLOCAL *tree.OptimizerHints
+//line mysql_sql.y:4375
+		{
+			yyLOCAL = parseOptimizerHints(yyDollar[1].str, yylex.(*Lexer).lower)
+		}
+		yyVAL.union = yyLOCAL
+	case 694:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line mysql_sql.y:4381
 		{
 			yyVAL.str = strings.ToLower(yyDollar[1].str)
 		}
-	case 661:
+	case 695:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4186
+//line mysql_sql.y:4385
 		{
 			yyVAL.str = strings.ToLower(yyDollar[1].str)
 		}
-	case 662:
+	case 696:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4190
+//line mysql_sql.y:4389
 		{
 			yyVAL.str = strings.ToLower(yyDollar[1].str)
 		}
-	case 663:
+	case 697:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4195
+//line mysql_sql.y:4394
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 664:
+	case 698:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4199
+//line mysql_sql.y:4398
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 665:
+	case 699:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4203
+//line mysql_sql.y:4402
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 668:
+	case 702:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:4212
+//line mysql_sql.y:4411
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 669:
+	case 703:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:4216
+//line mysql_sql.y:4415
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstHaving, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 670:
+	case 704:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:4221
+//line mysql_sql.y:4420
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 671:
+	case 705:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:4225
+//line mysql_sql.y:4424
 		{
 			yyLOCAL = tree.GroupBy(yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 672:
+	case 706:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:4230
+//line mysql_sql.y:4429
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 673:
+	case 707:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:4234
+//line mysql_sql.y:4433
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstWhere, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 674:
+	case 708:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:4240
+//line mysql_sql.y:4439
 		{
 			yyLOCAL = tree.SelectExprs{yyDollar[1].selectExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 675:
+	case 709:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:4244
+//line mysql_sql.y:4443
 		{
 			yyLOCAL = append(yyDollar[1].selectExprsUnion(), yyDollar[3].selectExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 676:
+	case 710:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:4250
+//line mysql_sql.y:4449
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.StarExpr()}
 		}
 		yyVAL.union = yyLOCAL
-	case 677:
+	case 711:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:4254
+//line mysql_sql.y:4453
 		{
 			yyDollar[2].cstrUnion().SetConfig(0)
 			yyLOCAL = tree.SelectExpr{Expr: yyDollar[1].exprUnion(), As: yyDollar[2].cstrUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 678:
+	case 712:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:4259
+//line mysql_sql.y:4458
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[1].cstrUnion().Compare())}
 		}
 		yyVAL.union = yyLOCAL
-	case 679:
+	case 713:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:4263
+//line mysql_sql.y:4462
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[3].cstrUnion().Compare(), yyDollar[1].cstrUnion().Compare())}
 		}
 		yyVAL.union = yyLOCAL
-	case 680:
+	case 714:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:4268
+//line mysql_sql.y:4467
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			tn := tree.NewTableName(tree.Identifier(""), prefix)
@@ -12517,28 +13206,28 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 681:
+	case 715:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:4276
+//line mysql_sql.y:4475
 		{
 			yyLOCAL = yyDollar[1].fromUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 682:
+	case 716:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:4282
+//line mysql_sql.y:4481
 		{
 			yyLOCAL = &tree.From{
 				Tables: tree.TableExprs{yyDollar[2].joinTableExprUnion()},
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 683:
+	case 717:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:4290
+//line mysql_sql.y:4489
 		{
 			if t, ok := yyDollar[1].tableExprUnion().(*tree.JoinTableExpr); ok {
 				yyLOCAL = t
@@ -12547,26 +13236,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 684:
+	case 718:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:4298
+//line mysql_sql.y:4497
 		{
 			yyLOCAL = &tree.JoinTableExpr{Left: yyDollar[1].joinTableExprUnion(), Right: yyDollar[3].tableExprUnion(), JoinType: tree.JOIN_TYPE_CROSS}
 		}
 		yyVAL.union = yyLOCAL
-	case 687:
+	case 721:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4308
+//line mysql_sql.y:4507
 		{
 			yyLOCAL = yyDollar[1].joinTableExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 688:
+	case 722:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:4314
+//line mysql_sql.y:4513
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -12576,10 +13265,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 689:
+	case 723:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:4323
+//line mysql_sql.y:4522
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -12589,10 +13278,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 690:
+	case 724:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:4332
+//line mysql_sql.y:4531
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -12602,10 +13291,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 691:
+	case 725:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:4341
+//line mysql_sql.y:4540
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -12614,15 +13303,15 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 692:
+	case 726:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4351
+//line mysql_sql.y:4550
 		{
 			yyVAL.str = tree.JOIN_TYPE_NATURAL
 		}
-	case 693:
+	case 727:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4355
+//line mysql_sql.y:4554
 		{
 			if yyDollar[2].str == tree.JOIN_TYPE_LEFT {
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_LEFT
@@ -12630,34 +13319,34 @@ yydefault:
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_RIGHT
 			}
 		}
-	case 694:
+	case 728:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4365
+//line mysql_sql.y:4564
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 695:
+	case 729:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:4369
+//line mysql_sql.y:4568
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 696:
+	case 730:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4373
+//line mysql_sql.y:4572
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 697:
+	case 731:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:4377
+//line mysql_sql.y:4576
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 698:
+	case 732:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4383
+//line mysql_sql.y:4582
 		{
 			yyLOCAL = &tree.ValuesStatement{
 				Rows:    yyDollar[2].rowsExprsUnion(),
@@ -12666,130 +13355,130 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 699:
+	case 733:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:4393
+//line mysql_sql.y:4592
 		{
 			yyLOCAL = []tree.Exprs{yyDollar[1].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 700:
+	case 734:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:4397
+//line mysql_sql.y:4596
 		{
 			yyLOCAL = append(yyDollar[1].rowsExprsUnion(), yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 701:
+	case 735:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4403
+//line mysql_sql.y:4602
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 702:
+	case 736:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4409
+//line mysql_sql.y:4608
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 703:
+	case 737:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4413
+//line mysql_sql.y:4612
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 704:
+	case 738:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4419
+//line mysql_sql.y:4618
 		{
 			yyVAL.str = tree.JOIN_TYPE_STRAIGHT
 		}
-	case 705:
+	case 739:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4425
+//line mysql_sql.y:4624
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 706:
+	case 740:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4429
+//line mysql_sql.y:4628
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 707:
+	case 741:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4433
+//line mysql_sql.y:4632
 		{
 			yyVAL.str = tree.JOIN_TYPE_CROSS
 		}
-	case 708:
+	case 742:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4439
+//line mysql_sql.y:4638
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 709:
+	case 743:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4443
+//line mysql_sql.y:4642
 		{
 			yyLOCAL = yyDollar[1].joinCondUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 710:
+	case 744:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4449
+//line mysql_sql.y:4648
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 711:
+	case 745:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4453
+//line mysql_sql.y:4652
 		{
 			yyLOCAL = &tree.UsingJoinCond{Cols: yyDollar[3].identifierListUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 712:
+	case 746:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:4459
+//line mysql_sql.y:4658
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].cstrUnion().Compare())}
 		}
 		yyVAL.union = yyLOCAL
-	case 713:
+	case 747:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:4463
+//line mysql_sql.y:4662
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].cstrUnion().Compare()))
 		}
 		yyVAL.union = yyLOCAL
-	case 714:
+	case 748:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4469
+//line mysql_sql.y:4668
 		{
 			yyLOCAL = yyDollar[1].aliasedTableExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 715:
+	case 749:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4473
+//line mysql_sql.y:4672
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].parenTableExprUnion(),
@@ -12800,10 +13489,25 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 716:
+	case 750:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.TableExpr
+//line mysql_sql.y:4682
+		{
+			yyLOCAL = &tree.AliasedTableExpr{
+				Expr: yyDollar[2].parenTableExprUnion(),
+				As: tree.AliasClause{
+					Alias: tree.Identifier(yyDollar[3].str),
+					Cols:  yyDollar[4].identifierListUnion(),
+				},
+				Lateral: true,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 751:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4483
+//line mysql_sql.y:4693
 		{
 			if yyDollar[2].str != "" {
 				yyLOCAL = &tree.AliasedTableExpr{
@@ -12817,26 +13521,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 717:
+	case 752:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4496
+//line mysql_sql.y:4706
 		{
 			yyLOCAL = yyDollar[2].joinTableExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 718:
+	case 753:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ParenTableExpr
-//line mysql_sql.y:4502
+//line mysql_sql.y:4712
 		{
 			yyLOCAL = &tree.ParenTableExpr{Expr: yyDollar[1].selectStatementUnion().(*tree.ParenSelect).Select}
 		}
 		yyVAL.union = yyLOCAL
-	case 719:
+	case 754:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4508
+//line mysql_sql.y:4718
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].cstrUnion().Compare()))
 			yyLOCAL = &tree.TableFunction{
@@ -12848,10 +13552,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 720:
+	case 755:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AliasedTableExpr
-//line mysql_sql.y:4521
+//line mysql_sql.y:4731
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].tableNameUnion(),
@@ -12862,34 +13566,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 721:
+	case 756:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.IndexHint
-//line mysql_sql.y:4532
+//line mysql_sql.y:4742
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 723:
+	case 758:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.IndexHint
-//line mysql_sql.y:4539
+//line mysql_sql.y:4749
 		{
 			yyLOCAL = []*tree.IndexHint{yyDollar[1].indexHintUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 724:
+	case 759:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.IndexHint
-//line mysql_sql.y:4543
+//line mysql_sql.y:4753
 		{
 			yyLOCAL = append(yyDollar[1].indexHintListUnion(), yyDollar[2].indexHintUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 725:
+	case 760:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.IndexHint
-//line mysql_sql.y:4549
+//line mysql_sql.y:4759
 		{
 			yyLOCAL = &tree.IndexHint{
 				IndexNames: yyDollar[4].strsUnion(),
@@ -12898,176 +13602,176 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 726:
+	case 761:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexHintType
-//line mysql_sql.y:4559
+//line mysql_sql.y:4769
 		{
 			yyLOCAL = tree.HintUse
 		}
 		yyVAL.union = yyLOCAL
-	case 727:
+	case 762:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexHintType
-//line mysql_sql.y:4563
+//line mysql_sql.y:4773
 		{
 			yyLOCAL = tree.HintIgnore
 		}
 		yyVAL.union = yyLOCAL
-	case 728:
+	case 763:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexHintType
-//line mysql_sql.y:4567
+//line mysql_sql.y:4777
 		{
 			yyLOCAL = tree.HintForce
 		}
 		yyVAL.union = yyLOCAL
-	case 729:
+	case 764:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexHintScope
-//line mysql_sql.y:4572
+//line mysql_sql.y:4782
 		{
 			yyLOCAL = tree.HintForScan
 		}
 		yyVAL.union = yyLOCAL
-	case 730:
+	case 765:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexHintScope
-//line mysql_sql.y:4576
+//line mysql_sql.y:4786
 		{
 			yyLOCAL = tree.HintForJoin
 		}
 		yyVAL.union = yyLOCAL
-	case 731:
+	case 766:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IndexHintScope
-//line mysql_sql.y:4580
+//line mysql_sql.y:4790
 		{
 			yyLOCAL = tree.HintForOrderBy
 		}
 		yyVAL.union = yyLOCAL
-	case 732:
+	case 767:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IndexHintScope
-//line mysql_sql.y:4584
+//line mysql_sql.y:4794
 		{
 			yyLOCAL = tree.HintForGroupBy
 		}
 		yyVAL.union = yyLOCAL
-	case 733:
+	case 768:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4589
+//line mysql_sql.y:4799
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 734:
+	case 769:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4593
+//line mysql_sql.y:4803
 		{
 			yyLOCAL = []string{yyDollar[1].cstrUnion().Compare()}
 		}
 		yyVAL.union = yyLOCAL
-	case 735:
+	case 770:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4597
+//line mysql_sql.y:4807
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 736:
+	case 771:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4601
+//line mysql_sql.y:4811
 		{
 			yyLOCAL = []string{yyDollar[1].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 737:
+	case 772:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4605
+//line mysql_sql.y:4815
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 738:
+	case 773:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4610
+//line mysql_sql.y:4820
 		{
 			yyVAL.str = ""
 		}
-	case 739:
+	case 774:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4614
+//line mysql_sql.y:4824
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 740:
+	case 775:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4618
+//line mysql_sql.y:4828
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 741:
+	case 776:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4624
+//line mysql_sql.y:4834
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 743:
+	case 778:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:4630
+//line mysql_sql.y:4840
 		{
 			yyLOCAL = tree.NewCStr("", yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 744:
+	case 779:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:4634
+//line mysql_sql.y:4844
 		{
 			yyLOCAL = yyDollar[1].cstrUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 745:
+	case 780:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:4638
+//line mysql_sql.y:4848
 		{
 			yyLOCAL = yyDollar[2].cstrUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 746:
+	case 781:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:4642
+//line mysql_sql.y:4852
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 747:
+	case 782:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:4646
+//line mysql_sql.y:4856
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[2].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 748:
+	case 783:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4652
+//line mysql_sql.y:4862
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 762:
+	case 801:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4685
+//line mysql_sql.y:4899
 		{
 			yyLOCAL = &tree.CreateExtension{
 				Language: yyDollar[3].str,
@@ -13076,22 +13780,22 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 763:
+	case 802:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4695
+//line mysql_sql.y:4909
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 764:
+	case 803:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4701
+//line mysql_sql.y:4915
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 765:
+	case 804:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4707
+//line mysql_sql.y:4921
 		{
 			yyLOCAL = &tree.CreateProcedure{
 				Name: yyDollar[3].procNameUnion(),
@@ -13100,100 +13804,100 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 766:
+	case 805:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ProcedureName
-//line mysql_sql.y:4717
+//line mysql_sql.y:4931
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewProcedureName(tree.Identifier(yyDollar[1].cstrUnion().ToLower()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 767:
+	case 806:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ProcedureName
-//line mysql_sql.y:4722
+//line mysql_sql.y:4936
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].cstrUnion().ToLower()), ExplicitSchema: true}
 			yyLOCAL = tree.NewProcedureName(tree.Identifier(yyDollar[3].cstrUnion().ToLower()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 768:
+	case 807:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.ProcedureArgs
-//line mysql_sql.y:4728
+//line mysql_sql.y:4942
 		{
 			yyLOCAL = tree.ProcedureArgs(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 770:
+	case 809:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ProcedureArgs
-//line mysql_sql.y:4735
+//line mysql_sql.y:4949
 		{
 			yyLOCAL = tree.ProcedureArgs{yyDollar[1].procArgUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 771:
+	case 810:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ProcedureArgs
-//line mysql_sql.y:4739
+//line mysql_sql.y:4953
 		{
 			yyLOCAL = append(yyDollar[1].procArgsUnion(), yyDollar[3].procArgUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 772:
+	case 811:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ProcedureArg
-//line mysql_sql.y:4745
+//line mysql_sql.y:4959
 		{
 			yyLOCAL = tree.ProcedureArg(yyDollar[1].procArgDeclUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 773:
+	case 812:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ProcedureArgDecl
-//line mysql_sql.y:4751
+//line mysql_sql.y:4965
 		{
 			yyLOCAL = tree.NewProcedureArgDecl(yyDollar[1].procArgTypeUnion(), yyDollar[2].unresolvedNameUnion(), yyDollar[3].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 774:
+	case 813:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.InOutArgType
-//line mysql_sql.y:4756
+//line mysql_sql.y:4970
 		{
 			yyLOCAL = tree.TYPE_IN
 		}
 		yyVAL.union = yyLOCAL
-	case 775:
+	case 814:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.InOutArgType
-//line mysql_sql.y:4760
+//line mysql_sql.y:4974
 		{
 			yyLOCAL = tree.TYPE_IN
 		}
 		yyVAL.union = yyLOCAL
-	case 776:
+	case 815:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.InOutArgType
-//line mysql_sql.y:4764
+//line mysql_sql.y:4978
 		{
 			yyLOCAL = tree.TYPE_OUT
 		}
 		yyVAL.union = yyLOCAL
-	case 777:
+	case 816:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.InOutArgType
-//line mysql_sql.y:4768
+//line mysql_sql.y:4982
 		{
 			yyLOCAL = tree.TYPE_INOUT
 		}
 		yyVAL.union = yyLOCAL
-	case 778:
+	case 817:
 		yyDollar = yyS[yypt-12 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4775
+//line mysql_sql.y:4989
 		{
 			yyLOCAL = &tree.CreateFunction{
 				Name:       yyDollar[3].functionNameUnion(),
@@ -13204,98 +13908,98 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 779:
+	case 818:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.FunctionName
-//line mysql_sql.y:4787
+//line mysql_sql.y:5001
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewFuncName(tree.Identifier(yyDollar[1].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 780:
+	case 819:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.FunctionName
-//line mysql_sql.y:4792
+//line mysql_sql.y:5006
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].cstrUnion().Compare()), ExplicitSchema: true}
 			yyLOCAL = tree.NewFuncName(tree.Identifier(yyDollar[3].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 781:
+	case 820:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.FunctionArgs
-//line mysql_sql.y:4798
+//line mysql_sql.y:5012
 		{
 			yyLOCAL = tree.FunctionArgs(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 783:
+	case 822:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FunctionArgs
-//line mysql_sql.y:4805
+//line mysql_sql.y:5019
 		{
 			yyLOCAL = tree.FunctionArgs{yyDollar[1].funcArgUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 784:
+	case 823:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.FunctionArgs
-//line mysql_sql.y:4809
+//line mysql_sql.y:5023
 		{
 			yyLOCAL = append(yyDollar[1].funcArgsUnion(), yyDollar[3].funcArgUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 785:
+	case 824:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FunctionArg
-//line mysql_sql.y:4815
+//line mysql_sql.y:5029
 		{
 			yyLOCAL = tree.FunctionArg(yyDollar[1].funcArgDeclUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 786:
+	case 825:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.FunctionArgDecl
-//line mysql_sql.y:4821
+//line mysql_sql.y:5035
 		{
 			yyLOCAL = tree.NewFunctionArgDecl(nil, yyDollar[1].columnTypeUnion(), nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 787:
+	case 826:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FunctionArgDecl
-//line mysql_sql.y:4825
+//line mysql_sql.y:5039
 		{
 			yyLOCAL = tree.NewFunctionArgDecl(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 788:
+	case 827:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FunctionArgDecl
-//line mysql_sql.y:4829
+//line mysql_sql.y:5043
 		{
 			yyLOCAL = tree.NewFunctionArgDecl(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 789:
+	case 828:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4835
+//line mysql_sql.y:5049
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 790:
+	case 829:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReturnType
-//line mysql_sql.y:4841
+//line mysql_sql.y:5055
 		{
 			yyLOCAL = tree.NewReturnType(yyDollar[1].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 791:
+	case 830:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4847
+//line mysql_sql.y:5061
 		{
 			yyLOCAL = &tree.CreateView{
 				Name:        yyDollar[4].tableNameUnion(),
@@ -13305,10 +14009,30 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 792:
+	case 831:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:5072
+		{
+			yyLOCAL = &tree.CreateMaterializedView{
+				Name:        yyDollar[5].tableNameUnion(),
+				AsSource:    yyDollar[7].selectUnion(),
+				IfNotExists: yyDollar[4].ifNotExistsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 832:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:5082
+		{
+			yyLOCAL = &tree.RefreshMaterializedView{Name: yyDollar[4].tableNameUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 833:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4858
+//line mysql_sql.y:5088
 		{
 			yyLOCAL = &tree.CreateAccount{
 				IfNotExists:  yyDollar[3].ifNotExistsUnion(),
@@ -13319,16 +14043,16 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 793:
+	case 834:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4870
+//line mysql_sql.y:5100
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 794:
+	case 835:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.AccountAuthOption
-//line mysql_sql.y:4876
+//line mysql_sql.y:5106
 		{
 			yyLOCAL = tree.AccountAuthOption{
 				Equal:          yyDollar[2].str,
@@ -13337,22 +14061,22 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 795:
+	case 836:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4886
+//line mysql_sql.y:5116
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 796:
+	case 837:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4890
+//line mysql_sql.y:5120
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 797:
+	case 838:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AccountIdentified
-//line mysql_sql.y:4896
+//line mysql_sql.y:5126
 		{
 			yyLOCAL = tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedByPassword,
@@ -13360,20 +14084,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 798:
+	case 839:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.AccountIdentified
-//line mysql_sql.y:4903
+//line mysql_sql.y:5133
 		{
 			yyLOCAL = tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedByRandomPassword,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 799:
+	case 840:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AccountIdentified
-//line mysql_sql.y:4909
+//line mysql_sql.y:5139
 		{
 			yyLOCAL = tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedWithSSL,
@@ -13381,20 +14105,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 800:
+	case 841:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.AccountStatus
-//line mysql_sql.y:4917
+//line mysql_sql.y:5147
 		{
 			yyLOCAL = tree.AccountStatus{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 801:
+	case 842:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.AccountStatus
-//line mysql_sql.y:4923
+//line mysql_sql.y:5153
 		{
 			yyLOCAL = tree.AccountStatus{
 				Exist:  true,
@@ -13402,10 +14126,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 802:
+	case 843:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.AccountStatus
-//line mysql_sql.y:4930
+//line mysql_sql.y:5160
 		{
 			yyLOCAL = tree.AccountStatus{
 				Exist:  true,
@@ -13413,20 +14137,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 803:
+	case 844:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.AccountComment
-//line mysql_sql.y:4938
+//line mysql_sql.y:5168
 		{
 			yyLOCAL = tree.AccountComment{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 804:
+	case 845:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AccountComment
-//line mysql_sql.y:4944
+//line mysql_sql.y:5174
 		{
 			yyLOCAL = tree.AccountComment{
 				Exist:   true,
@@ -13434,10 +14158,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 805:
+	case 846:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4953
+//line mysql_sql.y:5183
 		{
 			yyLOCAL = &tree.CreateUser{
 				IfNotExists:        yyDollar[3].ifNotExistsUnion(),
@@ -13448,10 +14172,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 806:
+	case 847:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4965
+//line mysql_sql.y:5195
 		{
 			yyLOCAL = &tree.CreatePublication{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -13462,22 +14186,22 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 807:
+	case 848:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4976
+//line mysql_sql.y:5206
 		{
 			yyVAL.str = ""
 		}
-	case 808:
+	case 849:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4980
+//line mysql_sql.y:5210
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 809:
+	case 850:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4986
+//line mysql_sql.y:5216
 		{
 			yyLOCAL = &tree.AlterPublication{
 				IfExists:    yyDollar[3].boolValUnion(),
@@ -13487,58 +14211,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 810:
+	case 851:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.AccountsSetOption
-//line mysql_sql.y:4996
+//line mysql_sql.y:5226
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 811:
+	case 852:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.AccountsSetOption
-//line mysql_sql.y:5000
+//line mysql_sql.y:5230
 		{
 			yyLOCAL = &tree.AccountsSetOption{
 				All: true,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 812:
+	case 853:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.AccountsSetOption
-//line mysql_sql.y:5006
+//line mysql_sql.y:5236
 		{
 			yyLOCAL = &tree.AccountsSetOption{
 				SetAccounts: yyDollar[2].identifierListUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 813:
+	case 854:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AccountsSetOption
-//line mysql_sql.y:5012
+//line mysql_sql.y:5242
 		{
 			yyLOCAL = &tree.AccountsSetOption{
 				AddAccounts: yyDollar[3].identifierListUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 814:
+	case 855:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AccountsSetOption
-//line mysql_sql.y:5018
+//line mysql_sql.y:5248
 		{
 			yyLOCAL = &tree.AccountsSetOption{
 				DropAccounts: yyDollar[3].identifierListUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 815:
+	case 856:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5027
+//line mysql_sql.y:5257
 		{
 			yyLOCAL = &tree.DropPublication{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -13546,26 +14270,160 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 816:
+	case 857:
+		yyDollar = yyS[yypt-13 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:5266
+		{
+			yyLOCAL = &tree.CreatePolicy{
+				IfNotExists: yyDollar[3].ifNotExistsUnion(),
+				Name:        tree.Identifier(yyDollar[4].cstrUnion().Compare()),
+				Table:       yyDollar[6].tableNameUnion(),
+				Command:     yyDollar[7].str,
+				Roles:       yyDollar[9].rolesUnion(),
+				Using:       yyDollar[12].exprUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 858:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:5278
+		{
+			yyVAL.str = tree.PolicyCommandAll
+		}
+	case 859:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line mysql_sql.y:5282
+		{
+			yyVAL.str = tree.PolicyCommandAll
+		}
+	case 860:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line mysql_sql.y:5286
+		{
+			yyVAL.str = tree.PolicyCommandSelect
+		}
+	case 861:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line mysql_sql.y:5290
+		{
+			yyVAL.str = tree.PolicyCommandUpdate
+		}
+	case 862:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line mysql_sql.y:5294
+		{
+			yyVAL.str = tree.PolicyCommandDelete
+		}
+	case 863:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:5300
+		{
+			yyLOCAL = &tree.DropPolicy{
+				IfExists: yyDollar[3].boolValUnion(),
+				Name:     tree.Identifier(yyDollar[4].cstrUnion().Compare()),
+				Table:    yyDollar[6].tableNameUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 864:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:5310
+		{
+			yyLOCAL = &tree.CreateAuditFilter{
+				IfNotExists: yyDollar[4].ifNotExistsUnion(),
+				Name:        tree.Identifier(yyDollar[5].cstrUnion().Compare()),
+				Events:      yyDollar[7].identifierListUnion(),
+				Users:       yyDollar[8].identifierListUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 865:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.IdentifierList
+//line mysql_sql.y:5320
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 866:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.IdentifierList
+//line mysql_sql.y:5324
+		{
+			yyLOCAL = yyDollar[3].identifierListUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 867:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:5330
+		{
+			yyLOCAL = &tree.DropAuditFilter{
+				IfExists: yyDollar[4].boolValUnion(),
+				Name:     tree.Identifier(yyDollar[5].cstrUnion().Compare()),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 868:
+		yyDollar = yyS[yypt-10 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:5339
+		{
+			yyLOCAL = &tree.CreateChangefeed{
+				IfNotExists: yyDollar[3].ifNotExistsUnion(),
+				Name:        tree.Identifier(yyDollar[4].cstrUnion().Compare()),
+				Tables:      yyDollar[7].tableNamesUnion(),
+				Sink:        yyDollar[9].str,
+				Cursor:      yyDollar[10].str,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 869:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:5350
+		{
+			yyVAL.str = ""
+		}
+	case 870:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line mysql_sql.y:5354
+		{
+			yyVAL.str = yyDollar[3].str
+		}
+	case 871:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:5360
+		{
+			yyLOCAL = &tree.DropChangefeed{
+				IfExists: yyDollar[3].boolValUnion(),
+				Name:     tree.Identifier(yyDollar[4].cstrUnion().Compare()),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 872:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:5036
+//line mysql_sql.y:5369
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 817:
+	case 873:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.AccountCommentOrAttribute
-//line mysql_sql.y:5041
+//line mysql_sql.y:5374
 		{
 			yyLOCAL = tree.AccountCommentOrAttribute{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 818:
+	case 874:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AccountCommentOrAttribute
-//line mysql_sql.y:5047
+//line mysql_sql.y:5380
 		{
 			yyLOCAL = tree.AccountCommentOrAttribute{
 				Exist:     true,
@@ -13574,10 +14432,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 819:
+	case 875:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AccountCommentOrAttribute
-//line mysql_sql.y:5055
+//line mysql_sql.y:5388
 		{
 			yyLOCAL = tree.AccountCommentOrAttribute{
 				Exist:     true,
@@ -13586,26 +14444,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 820:
+	case 876:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:5161
+//line mysql_sql.y:5494
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 821:
+	case 877:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:5165
+//line mysql_sql.y:5498
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 822:
+	case 878:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:5171
+//line mysql_sql.y:5504
 		{
 			yyLOCAL = &tree.User{
 				Username:   yyDollar[1].usernameRecordUnion().Username,
@@ -13614,26 +14472,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 823:
+	case 879:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:5181
+//line mysql_sql.y:5514
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 824:
+	case 880:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:5185
+//line mysql_sql.y:5518
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 825:
+	case 881:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:5191
+//line mysql_sql.y:5524
 		{
 			yyLOCAL = &tree.User{
 				Username:   yyDollar[1].usernameRecordUnion().Username,
@@ -13642,50 +14500,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 826:
+	case 882:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:5201
+//line mysql_sql.y:5534
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: "%"}
 		}
 		yyVAL.union = yyLOCAL
-	case 827:
+	case 883:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:5205
+//line mysql_sql.y:5538
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 828:
+	case 884:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:5209
+//line mysql_sql.y:5542
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 829:
+	case 885:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:5214
+//line mysql_sql.y:5547
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 830:
+	case 886:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:5218
+//line mysql_sql.y:5551
 		{
 			yyLOCAL = yyDollar[1].userIdentifiedUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 831:
+	case 887:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:5224
+//line mysql_sql.y:5557
 		{
 			yyLOCAL = &tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedByPassword,
@@ -13693,20 +14551,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 832:
+	case 888:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:5231
+//line mysql_sql.y:5564
 		{
 			yyLOCAL = &tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedByRandomPassword,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 833:
+	case 889:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:5237
+//line mysql_sql.y:5570
 		{
 			yyLOCAL = &tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedWithSSL,
@@ -13714,16 +14572,28 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 834:
+	case 890:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.AccountIdentified
+//line mysql_sql.y:5577
+		{
+			yyLOCAL = &tree.AccountIdentified{
+				Typ:        tree.AccountIdentifiedByPassword,
+				Str:        yyDollar[5].str,
+				AuthPlugin: strings.ToLower(yyDollar[3].str),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 891:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:5246
+//line mysql_sql.y:5587
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 836:
+	case 893:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5253
+//line mysql_sql.y:5594
 		{
 			yyLOCAL = &tree.CreateRole{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -13731,90 +14601,90 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 837:
+	case 894:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:5262
+//line mysql_sql.y:5603
 		{
 			yyLOCAL = []*tree.Role{yyDollar[1].roleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 838:
+	case 895:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:5266
+//line mysql_sql.y:5607
 		{
 			yyLOCAL = append(yyDollar[1].rolesUnion(), yyDollar[3].roleUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 839:
+	case 896:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:5272
+//line mysql_sql.y:5613
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].cstrUnion().Compare()}
 		}
 		yyVAL.union = yyLOCAL
-	case 840:
+	case 897:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:5286
+//line mysql_sql.y:5627
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 841:
+	case 898:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:5290
+//line mysql_sql.y:5631
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 842:
+	case 899:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:5294
+//line mysql_sql.y:5635
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 843:
+	case 900:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:5299
+//line mysql_sql.y:5640
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_NONE
 		}
 		yyVAL.union = yyLOCAL
-	case 844:
+	case 901:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:5303
+//line mysql_sql.y:5644
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_FULLTEXT
 		}
 		yyVAL.union = yyLOCAL
-	case 845:
+	case 902:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:5307
+//line mysql_sql.y:5648
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_SPATIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 846:
+	case 903:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:5311
+//line mysql_sql.y:5652
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_UNIQUE
 		}
 		yyVAL.union = yyLOCAL
-	case 847:
+	case 904:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5317
+//line mysql_sql.y:5658
 		{
 			var io *tree.IndexOption = nil
 			if yyDollar[11].indexOptionUnion() == nil && yyDollar[5].indexTypeUnion() != tree.INDEX_TYPE_INVALID {
@@ -13833,18 +14703,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 848:
+	case 905:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:5336
+//line mysql_sql.y:5677
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 849:
+	case 906:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:5340
+//line mysql_sql.y:5681
 		{
 			// Merge the options
 			if yyDollar[1].indexOptionUnion() == nil {
@@ -13865,123 +14735,123 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 850:
+	case 907:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:5362
+//line mysql_sql.y:5703
 		{
 			yyLOCAL = &tree.IndexOption{KeyBlockSize: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 851:
+	case 908:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:5366
+//line mysql_sql.y:5707
 		{
 			yyLOCAL = &tree.IndexOption{Comment: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 852:
+	case 909:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:5370
+//line mysql_sql.y:5711
 		{
 			yyLOCAL = &tree.IndexOption{ParserName: yyDollar[3].cstrUnion().Compare()}
 		}
 		yyVAL.union = yyLOCAL
-	case 853:
+	case 910:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:5374
+//line mysql_sql.y:5715
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_VISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 854:
+	case 911:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:5378
+//line mysql_sql.y:5719
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_INVISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 855:
+	case 912:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:5384
+//line mysql_sql.y:5725
 		{
 			yyLOCAL = []*tree.KeyPart{yyDollar[1].keyPartUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 856:
+	case 913:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:5388
+//line mysql_sql.y:5729
 		{
 			yyLOCAL = append(yyDollar[1].keyPartsUnion(), yyDollar[3].keyPartUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 857:
+	case 914:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:5394
+//line mysql_sql.y:5735
 		{
 			// Order is parsed but just ignored as MySQL dtree.
 			yyLOCAL = &tree.KeyPart{ColName: yyDollar[1].unresolvedNameUnion(), Length: int(yyDollar[2].lengthOptUnion()), Direction: yyDollar[3].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 858:
+	case 915:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:5399
+//line mysql_sql.y:5740
 		{
 			yyLOCAL = &tree.KeyPart{Expr: yyDollar[2].exprUnion(), Direction: yyDollar[4].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 859:
+	case 916:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:5404
+//line mysql_sql.y:5745
 		{
 			yyLOCAL = tree.INDEX_TYPE_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 860:
+	case 917:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:5408
+//line mysql_sql.y:5749
 		{
 			yyLOCAL = tree.INDEX_TYPE_BTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 861:
+	case 918:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:5412
+//line mysql_sql.y:5753
 		{
 			yyLOCAL = tree.INDEX_TYPE_HASH
 		}
 		yyVAL.union = yyLOCAL
-	case 862:
+	case 919:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:5416
+//line mysql_sql.y:5757
 		{
 			yyLOCAL = tree.INDEX_TYPE_RTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 863:
+	case 920:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:5420
+//line mysql_sql.y:5761
 		{
 			yyLOCAL = tree.INDEX_TYPE_BSI
 		}
 		yyVAL.union = yyLOCAL
-	case 864:
+	case 921:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5426
+//line mysql_sql.y:5767
 		{
 			yyLOCAL = &tree.CreateDatabase{
 				IfNotExists:        yyDollar[3].ifNotExistsUnion(),
@@ -13991,114 +14861,114 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 865:
+	case 922:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.SubscriptionOption
-//line mysql_sql.y:5437
+//line mysql_sql.y:5778
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 866:
+	case 923:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.SubscriptionOption
-//line mysql_sql.y:5441
+//line mysql_sql.y:5782
 		{
 			yyLOCAL = &tree.SubscriptionOption{From: tree.Identifier(yyDollar[2].str), Publication: tree.Identifier(yyDollar[4].cstrUnion().Compare())}
 		}
 		yyVAL.union = yyLOCAL
-	case 869:
+	case 926:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5451
+//line mysql_sql.y:5792
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 870:
+	case 927:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5455
+//line mysql_sql.y:5796
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 871:
+	case 928:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:5460
+//line mysql_sql.y:5801
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 872:
+	case 929:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:5464
+//line mysql_sql.y:5805
 		{
 			yyLOCAL = yyDollar[1].createOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 873:
+	case 930:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:5470
+//line mysql_sql.y:5811
 		{
 			yyLOCAL = []tree.CreateOption{yyDollar[1].createOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 874:
+	case 931:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:5474
+//line mysql_sql.y:5815
 		{
 			yyLOCAL = append(yyDollar[1].createOptionsUnion(), yyDollar[2].createOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 875:
+	case 932:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:5480
+//line mysql_sql.y:5821
 		{
 			yyLOCAL = &tree.CreateOptionCharset{IsDefault: yyDollar[1].defaultOptionalUnion(), Charset: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 876:
+	case 933:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:5484
+//line mysql_sql.y:5825
 		{
 			yyLOCAL = &tree.CreateOptionCollate{IsDefault: yyDollar[1].defaultOptionalUnion(), Collate: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 877:
+	case 934:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:5488
+//line mysql_sql.y:5829
 		{
 			yyLOCAL = &tree.CreateOptionEncryption{Encrypt: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 878:
+	case 935:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5493
+//line mysql_sql.y:5834
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 879:
+	case 936:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5497
+//line mysql_sql.y:5838
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 880:
+	case 937:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5503
+//line mysql_sql.y:5844
 		{
 			yyLOCAL = &tree.CreateTable{
 				Temporary:       yyDollar[2].boolValUnion(),
@@ -14111,10 +14981,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 881:
+	case 938:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5515
+//line mysql_sql.y:5856
 		{
 			yyLOCAL = &tree.CreateTable{
 				IfNotExists: yyDollar[4].ifNotExistsUnion(),
@@ -14124,10 +14994,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 882:
+	case 939:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5524
+//line mysql_sql.y:5865
 		{
 			yyLOCAL = &tree.CreateTable{
 				IsClusterTable:  true,
@@ -14140,19 +15010,19 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 883:
+	case 940:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ExternParam
-//line mysql_sql.y:5537
+//line mysql_sql.y:5878
 		{
 			yyLOCAL = yyDollar[1].loadParamUnion()
 			yyLOCAL.Tail = yyDollar[2].tailParamUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 884:
+	case 941:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ExternParam
-//line mysql_sql.y:5544
+//line mysql_sql.y:5885
 		{
 			yyLOCAL = &tree.ExternParam{
 				ExParamConst: tree.ExParamConst{
@@ -14163,10 +15033,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 885:
+	case 942:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.ExternParam
-//line mysql_sql.y:5554
+//line mysql_sql.y:5895
 		{
 			yyLOCAL = &tree.ExternParam{
 				ExParamConst: tree.ExParamConst{
@@ -14175,10 +15045,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 886:
+	case 943:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.ExternParam
-//line mysql_sql.y:5562
+//line mysql_sql.y:5903
 		{
 			yyLOCAL = &tree.ExternParam{
 				ExParamConst: tree.ExParamConst{
@@ -14188,43 +15058,43 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 887:
+	case 944:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5573
+//line mysql_sql.y:5914
 		{
 			yyLOCAL = yyDollar[1].strsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 888:
+	case 945:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5577
+//line mysql_sql.y:5918
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].strsUnion()...)
 		}
 		yyVAL.union = yyLOCAL
-	case 889:
+	case 946:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5582
+//line mysql_sql.y:5923
 		{
 			yyLOCAL = []string{}
 		}
 		yyVAL.union = yyLOCAL
-	case 890:
+	case 947:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5586
+//line mysql_sql.y:5927
 		{
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 			yyLOCAL = append(yyLOCAL, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 891:
+	case 948:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.TailParameter
-//line mysql_sql.y:5593
+//line mysql_sql.y:5934
 		{
 			yyLOCAL = &tree.TailParameter{
 				Fields:       yyDollar[1].fieldsUnion(),
@@ -14235,10 +15105,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 892:
+	case 949:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5604
+//line mysql_sql.y:5945
 		{
 			yyLOCAL = &tree.CreateSequence{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -14252,10 +15122,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 893:
+	case 950:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5617
+//line mysql_sql.y:5958
 		{
 			locale := ""
 			fstr := "bigint"
@@ -14270,26 +15140,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 894:
+	case 951:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5631
+//line mysql_sql.y:5972
 		{
 			yyLOCAL = yyDollar[2].columnTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 895:
+	case 952:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.IncrementByOption
-//line mysql_sql.y:5635
+//line mysql_sql.y:5976
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 896:
+	case 953:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IncrementByOption
-//line mysql_sql.y:5639
+//line mysql_sql.y:5980
 		{
 			yyLOCAL = &tree.IncrementByOption{
 				Minus: false,
@@ -14297,10 +15167,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 897:
+	case 954:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IncrementByOption
-//line mysql_sql.y:5646
+//line mysql_sql.y:5987
 		{
 			yyLOCAL = &tree.IncrementByOption{
 				Minus: false,
@@ -14308,10 +15178,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 898:
+	case 955:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.IncrementByOption
-//line mysql_sql.y:5653
+//line mysql_sql.y:5994
 		{
 			yyLOCAL = &tree.IncrementByOption{
 				Minus: true,
@@ -14319,10 +15189,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 899:
+	case 956:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IncrementByOption
-//line mysql_sql.y:5660
+//line mysql_sql.y:6001
 		{
 			yyLOCAL = &tree.IncrementByOption{
 				Minus: true,
@@ -14330,42 +15200,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 900:
+	case 957:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5667
+//line mysql_sql.y:6008
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 901:
+	case 958:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5671
+//line mysql_sql.y:6012
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 902:
+	case 959:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5675
+//line mysql_sql.y:6016
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 903:
+	case 960:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.MinValueOption
-//line mysql_sql.y:5679
+//line mysql_sql.y:6020
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 904:
+	case 961:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.MinValueOption
-//line mysql_sql.y:5683
+//line mysql_sql.y:6024
 		{
 			yyLOCAL = &tree.MinValueOption{
 				Minus: false,
@@ -14373,10 +15243,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 905:
+	case 962:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.MinValueOption
-//line mysql_sql.y:5690
+//line mysql_sql.y:6031
 		{
 			yyLOCAL = &tree.MinValueOption{
 				Minus: true,
@@ -14384,18 +15254,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 906:
+	case 963:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.MaxValueOption
-//line mysql_sql.y:5697
+//line mysql_sql.y:6038
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 907:
+	case 964:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.MaxValueOption
-//line mysql_sql.y:5701
+//line mysql_sql.y:6042
 		{
 			yyLOCAL = &tree.MaxValueOption{
 				Minus: false,
@@ -14403,10 +15273,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 908:
+	case 965:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.MaxValueOption
-//line mysql_sql.y:5708
+//line mysql_sql.y:6049
 		{
 			yyLOCAL = &tree.MaxValueOption{
 				Minus: true,
@@ -14414,18 +15284,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 909:
+	case 966:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.StartWithOption
-//line mysql_sql.y:5715
+//line mysql_sql.y:6056
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 910:
+	case 967:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.StartWithOption
-//line mysql_sql.y:5719
+//line mysql_sql.y:6060
 		{
 			yyLOCAL = &tree.StartWithOption{
 				Minus: false,
@@ -14433,10 +15303,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 911:
+	case 968:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.StartWithOption
-//line mysql_sql.y:5726
+//line mysql_sql.y:6067
 		{
 			yyLOCAL = &tree.StartWithOption{
 				Minus: false,
@@ -14444,10 +15314,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 912:
+	case 969:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.StartWithOption
-//line mysql_sql.y:5733
+//line mysql_sql.y:6074
 		{
 			yyLOCAL = &tree.StartWithOption{
 				Minus: true,
@@ -14455,10 +15325,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 913:
+	case 970:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.StartWithOption
-//line mysql_sql.y:5740
+//line mysql_sql.y:6081
 		{
 			yyLOCAL = &tree.StartWithOption{
 				Minus: true,
@@ -14466,34 +15336,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 914:
+	case 971:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5747
+//line mysql_sql.y:6088
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 915:
+	case 972:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5751
+//line mysql_sql.y:6092
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 916:
+	case 973:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:5756
+//line mysql_sql.y:6097
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 917:
+	case 974:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:5760
+//line mysql_sql.y:6101
 		{
 			yyDollar[3].partitionByUnion().Num = uint64(yyDollar[4].int64ValUnion())
 			yyLOCAL = &tree.PartitionOption{
@@ -14503,18 +15373,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 918:
+	case 975:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ClusterByOption
-//line mysql_sql.y:5770
+//line mysql_sql.y:6111
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 919:
+	case 976:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ClusterByOption
-//line mysql_sql.y:5774
+//line mysql_sql.y:6115
 		{
 			yyLOCAL = &tree.ClusterByOption{
 				ColumnList: []*tree.UnresolvedName{yyDollar[3].unresolvedNameUnion()},
@@ -14522,28 +15392,39 @@ yydefault:
 
 		}
 		yyVAL.union = yyLOCAL
-	case 920:
+	case 977:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.ClusterByOption
-//line mysql_sql.y:5781
+//line mysql_sql.y:6122
 		{
 			yyLOCAL = &tree.ClusterByOption{
 				ColumnList: yyDollar[4].unresolveNamesUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 921:
+	case 978:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *tree.ClusterByOption
+//line mysql_sql.y:6128
+		{
+			yyLOCAL = &tree.ClusterByOption{
+				ColumnList: yyDollar[5].unresolveNamesUnion(),
+				ZOrder:     true,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 979:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5788
+//line mysql_sql.y:6136
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 922:
+	case 980:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5792
+//line mysql_sql.y:6140
 		{
 			yyLOCAL = &tree.PartitionBy{
 				IsSubPartition: true,
@@ -14552,42 +15433,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 923:
+	case 981:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:5801
+//line mysql_sql.y:6149
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 924:
+	case 982:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:5805
+//line mysql_sql.y:6153
 		{
 			yyLOCAL = yyDollar[2].partitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 925:
+	case 983:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:5811
+//line mysql_sql.y:6159
 		{
 			yyLOCAL = []*tree.Partition{yyDollar[1].partitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 926:
+	case 984:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:5815
+//line mysql_sql.y:6163
 		{
 			yyLOCAL = append(yyDollar[1].partitionsUnion(), yyDollar[3].partitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 927:
+	case 985:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:5821
+//line mysql_sql.y:6169
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].cstrUnion().Compare()),
@@ -14597,10 +15478,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 928:
+	case 986:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:5830
+//line mysql_sql.y:6178
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].cstrUnion().Compare()),
@@ -14610,42 +15491,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 929:
+	case 987:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:5840
+//line mysql_sql.y:6188
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 930:
+	case 988:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:5844
+//line mysql_sql.y:6192
 		{
 			yyLOCAL = yyDollar[2].subPartitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 931:
+	case 989:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:5850
+//line mysql_sql.y:6198
 		{
 			yyLOCAL = []*tree.SubPartition{yyDollar[1].subPartitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 932:
+	case 990:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:5854
+//line mysql_sql.y:6202
 		{
 			yyLOCAL = append(yyDollar[1].subPartitionsUnion(), yyDollar[3].subPartitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 933:
+	case 991:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:5860
+//line mysql_sql.y:6208
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].cstrUnion().Compare()),
@@ -14653,10 +15534,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 934:
+	case 992:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:5867
+//line mysql_sql.y:6215
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].cstrUnion().Compare()),
@@ -14664,67 +15545,67 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 935:
+	case 993:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:5876
+//line mysql_sql.y:6224
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 936:
+	case 994:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:5880
+//line mysql_sql.y:6228
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 937:
+	case 995:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:5885
+//line mysql_sql.y:6233
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 938:
+	case 996:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:5889
+//line mysql_sql.y:6237
 		{
 			expr := tree.NewMaxValue()
 			yyLOCAL = &tree.ValuesLessThan{ValueList: tree.Exprs{expr}}
 		}
 		yyVAL.union = yyLOCAL
-	case 939:
+	case 997:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:5894
+//line mysql_sql.y:6242
 		{
 			yyLOCAL = &tree.ValuesLessThan{ValueList: yyDollar[5].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 940:
+	case 998:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:5898
+//line mysql_sql.y:6246
 		{
 			yyLOCAL = &tree.ValuesIn{ValueList: yyDollar[4].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 941:
+	case 999:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:5903
+//line mysql_sql.y:6251
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 942:
+	case 1000:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:5907
+//line mysql_sql.y:6255
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -14734,18 +15615,18 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 943:
+	case 1001:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:5917
+//line mysql_sql.y:6265
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 944:
+	case 1002:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:5921
+//line mysql_sql.y:6269
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -14755,10 +15636,10 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 945:
+	case 1003:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5932
+//line mysql_sql.y:6280
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -14767,10 +15648,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 946:
+	case 1004:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5940
+//line mysql_sql.y:6288
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -14779,10 +15660,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 947:
+	case 1005:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5948
+//line mysql_sql.y:6296
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -14791,10 +15672,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 948:
+	case 1006:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5956
+//line mysql_sql.y:6304
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -14803,10 +15684,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 950:
+	case 1008:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5967
+//line mysql_sql.y:6315
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.KeyType{
@@ -14816,10 +15697,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 951:
+	case 1009:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5976
+//line mysql_sql.y:6324
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.KeyType{
@@ -14830,10 +15711,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 952:
+	case 1010:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5986
+//line mysql_sql.y:6334
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.HashType{
@@ -14843,487 +15724,507 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 953:
+	case 1011:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:5996
+//line mysql_sql.y:6344
 		{
 			yyLOCAL = 2
 		}
 		yyVAL.union = yyLOCAL
-	case 954:
+	case 1012:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:6000
+//line mysql_sql.y:6348
 		{
 			yyLOCAL = yyDollar[3].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 955:
+	case 1013:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6005
+//line mysql_sql.y:6353
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 956:
+	case 1014:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6009
+//line mysql_sql.y:6357
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 957:
+	case 1015:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:6014
+//line mysql_sql.y:6362
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 958:
+	case 1016:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:6018
+//line mysql_sql.y:6366
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 959:
+	case 1017:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:6024
+//line mysql_sql.y:6372
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 960:
+	case 1018:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:6028
+//line mysql_sql.y:6376
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[3].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 961:
+	case 1019:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:6032
+//line mysql_sql.y:6380
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 962:
+	case 1020:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6038
+//line mysql_sql.y:6386
 		{
 			yyLOCAL = tree.NewTableOptionAutoIncrement(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 963:
+	case 1021:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6042
+//line mysql_sql.y:6390
 		{
 			yyLOCAL = tree.NewTableOptionAvgRowLength(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 964:
+	case 1022:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6046
+//line mysql_sql.y:6394
 		{
 			yyLOCAL = tree.NewTableOptionCharset(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 965:
+	case 1023:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6050
+//line mysql_sql.y:6398
 		{
 			yyLOCAL = tree.NewTableOptionCollate(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 966:
+	case 1024:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6054
+//line mysql_sql.y:6402
 		{
 			yyLOCAL = tree.NewTableOptionChecksum(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 967:
+	case 1025:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6058
+//line mysql_sql.y:6406
 		{
 			str := util.DealCommentString(yyDollar[3].str)
 			yyLOCAL = tree.NewTableOptionComment(str)
 		}
 		yyVAL.union = yyLOCAL
-	case 968:
+	case 1026:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6063
+//line mysql_sql.y:6411
 		{
 			yyLOCAL = tree.NewTableOptionCompression(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 969:
+	case 1027:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6067
+//line mysql_sql.y:6415
 		{
 			yyLOCAL = tree.NewTableOptionConnection(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 970:
+	case 1028:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6071
+//line mysql_sql.y:6419
 		{
 			yyLOCAL = tree.NewTableOptionDataDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 971:
+	case 1029:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6075
+//line mysql_sql.y:6423
 		{
 			yyLOCAL = tree.NewTableOptionIndexDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 972:
+	case 1030:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6079
+//line mysql_sql.y:6427
 		{
 			yyLOCAL = tree.NewTableOptionDelayKeyWrite(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 973:
+	case 1031:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6083
+//line mysql_sql.y:6431
 		{
 			yyLOCAL = tree.NewTableOptionEncryption(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 974:
+	case 1032:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6087
+//line mysql_sql.y:6435
 		{
 			yyLOCAL = tree.NewTableOptionEngine(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 975:
+	case 1033:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6091
+//line mysql_sql.y:6439
 		{
 			yyLOCAL = tree.NewTableOptionKeyBlockSize(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 976:
+	case 1034:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6095
+//line mysql_sql.y:6443
 		{
 			yyLOCAL = tree.NewTableOptionMaxRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 977:
+	case 1035:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6099
+//line mysql_sql.y:6447
 		{
 			yyLOCAL = tree.NewTableOptionMinRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 978:
+	case 1036:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6103
+//line mysql_sql.y:6451
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 979:
+	case 1037:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6107
+//line mysql_sql.y:6455
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 980:
+	case 1038:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6111
+//line mysql_sql.y:6459
 		{
 			yyLOCAL = tree.NewTableOptionPassword(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 981:
+	case 1039:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6115
+//line mysql_sql.y:6463
 		{
 			yyLOCAL = tree.NewTableOptionRowFormat(yyDollar[3].rowFormatTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 982:
+	case 1040:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6119
+//line mysql_sql.y:6467
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 983:
+	case 1041:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6123
+//line mysql_sql.y:6471
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 984:
+	case 1042:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6127
+//line mysql_sql.y:6475
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 985:
+	case 1043:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6131
+//line mysql_sql.y:6479
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 986:
+	case 1044:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6135
+//line mysql_sql.y:6483
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 987:
+	case 1045:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6139
+//line mysql_sql.y:6487
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 988:
+	case 1046:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6143
+//line mysql_sql.y:6491
 		{
 			yyLOCAL = tree.NewTableOptionTablespace(yyDollar[3].cstrUnion().Compare(), yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 989:
+	case 1047:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6147
+//line mysql_sql.y:6495
 		{
 			yyLOCAL = tree.NewTableOptionUnion(yyDollar[4].tableNamesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 990:
+	case 1048:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6151
+//line mysql_sql.y:6499
 		{
 			yyLOCAL = &tree.TableOptionProperties{Preperties: yyDollar[3].propertiesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 991:
+	case 1049:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.TableOption
+//line mysql_sql.y:6503
+		{
+			yyLOCAL = &tree.TableOptionTTL{
+				Column:   yyDollar[3].unresolvedNameUnion(),
+				Interval: yyDollar[6].item.(int64),
+				Unit:     strings.ToLower(yyDollar[7].str),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1050:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.TableOption
+//line mysql_sql.y:6511
+		{
+			yyLOCAL = &tree.TableOptionBloomFilter{Columns: yyDollar[4].unresolveNamesUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 1051:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:6158
+//line mysql_sql.y:6518
 		{
 			yyLOCAL = []tree.Property{yyDollar[1].propertyUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 992:
+	case 1052:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:6162
+//line mysql_sql.y:6522
 		{
 			yyLOCAL = append(yyDollar[1].propertiesUnion(), yyDollar[3].propertyUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 993:
+	case 1053:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Property
-//line mysql_sql.y:6168
+//line mysql_sql.y:6528
 		{
 			yyLOCAL = tree.Property{Key: yyDollar[1].str, Value: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 994:
+	case 1054:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:6173
+//line mysql_sql.y:6533
 		{
 			yyVAL.str = ""
 		}
-	case 995:
+	case 1055:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:6177
+//line mysql_sql.y:6537
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 996:
+	case 1056:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:6181
+//line mysql_sql.y:6541
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 997:
+	case 1057:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:6187
+//line mysql_sql.y:6547
 		{
 			yyLOCAL = tree.ROW_FORMAT_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 998:
+	case 1058:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:6191
+//line mysql_sql.y:6551
 		{
 			yyLOCAL = tree.ROW_FORMAT_DYNAMIC
 		}
 		yyVAL.union = yyLOCAL
-	case 999:
+	case 1059:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:6195
+//line mysql_sql.y:6555
 		{
 			yyLOCAL = tree.ROW_FORMAT_FIXED
 		}
 		yyVAL.union = yyLOCAL
-	case 1000:
+	case 1060:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:6199
+//line mysql_sql.y:6559
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPRESSED
 		}
 		yyVAL.union = yyLOCAL
-	case 1001:
+	case 1061:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:6203
+//line mysql_sql.y:6563
 		{
 			yyLOCAL = tree.ROW_FORMAT_REDUNDANT
 		}
 		yyVAL.union = yyLOCAL
-	case 1002:
+	case 1062:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:6207
+//line mysql_sql.y:6567
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPACT
 		}
 		yyVAL.union = yyLOCAL
-	case 1007:
+	case 1067:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:6221
+//line mysql_sql.y:6581
 		{
 			yyLOCAL = tree.TableNames{yyDollar[1].tableNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1008:
+	case 1068:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:6225
+//line mysql_sql.y:6585
 		{
 			yyLOCAL = append(yyDollar[1].tableNamesUnion(), yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1009:
+	case 1069:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:6234
+//line mysql_sql.y:6594
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[1].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 1010:
+	case 1070:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:6239
+//line mysql_sql.y:6599
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].cstrUnion().Compare()), ExplicitSchema: true}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[3].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 1011:
+	case 1071:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:6245
+//line mysql_sql.y:6605
 		{
 			yyLOCAL = tree.TableDefs(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 1013:
+	case 1073:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:6252
+//line mysql_sql.y:6612
 		{
 			yyLOCAL = tree.TableDefs{yyDollar[1].tableDefUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1014:
+	case 1074:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:6256
+//line mysql_sql.y:6616
 		{
 			yyLOCAL = append(yyDollar[1].tableDefsUnion(), yyDollar[3].tableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1015:
+	case 1075:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6262
+//line mysql_sql.y:6622
 		{
 			yyLOCAL = tree.TableDef(yyDollar[1].columnTableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1016:
+	case 1076:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6266
+//line mysql_sql.y:6626
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1017:
+	case 1077:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6270
+//line mysql_sql.y:6630
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1018:
+	case 1078:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6276
+//line mysql_sql.y:6636
 		{
 			yyLOCAL = &tree.FullTextIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -15333,10 +16234,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1019:
+	case 1079:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6285
+//line mysql_sql.y:6645
 		{
 			keyTyp := tree.INDEX_TYPE_INVALID
 			if yyDollar[3].strsUnion()[1] != "" {
@@ -15360,10 +16261,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1020:
+	case 1080:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6310
+//line mysql_sql.y:6670
 		{
 			if yyDollar[1].str != "" {
 				switch v := yyDollar[2].tableDefUnion().(type) {
@@ -15378,18 +16279,18 @@ yydefault:
 			yyLOCAL = yyDollar[2].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1021:
+	case 1081:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6324
+//line mysql_sql.y:6684
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1022:
+	case 1082:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6330
+//line mysql_sql.y:6690
 		{
 			yyLOCAL = &tree.PrimaryKeyIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -15399,10 +16300,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1023:
+	case 1083:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6339
+//line mysql_sql.y:6699
 		{
 			yyLOCAL = &tree.UniqueIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -15412,10 +16313,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1024:
+	case 1084:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6348
+//line mysql_sql.y:6708
 		{
 			yyLOCAL = &tree.ForeignKey{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -15426,10 +16327,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1025:
+	case 1085:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6358
+//line mysql_sql.y:6718
 		{
 			yyLOCAL = &tree.CheckIndex{
 				Expr:     yyDollar[3].exprUnion(),
@@ -15437,297 +16338,297 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1026:
+	case 1086:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6366
+//line mysql_sql.y:6726
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1028:
+	case 1088:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:6372
+//line mysql_sql.y:6732
 		{
 			yyVAL.str = ""
 		}
-	case 1029:
+	case 1089:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:6376
+//line mysql_sql.y:6736
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 1032:
+	case 1092:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:6386
+//line mysql_sql.y:6746
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = ""
 		}
 		yyVAL.union = yyLOCAL
-	case 1033:
+	case 1093:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:6392
+//line mysql_sql.y:6752
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 1034:
+	case 1094:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:6398
+//line mysql_sql.y:6758
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].cstrUnion().Compare()
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 1040:
+	case 1100:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:6412
+//line mysql_sql.y:6772
 		{
 			yyVAL.str = ""
 		}
-	case 1041:
+	case 1101:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:6416
+//line mysql_sql.y:6776
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 1042:
+	case 1102:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ColumnTableDef
-//line mysql_sql.y:6422
+//line mysql_sql.y:6782
 		{
 			yyLOCAL = tree.NewColumnTableDef(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), yyDollar[3].columnAttributesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1043:
+	case 1103:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:6428
+//line mysql_sql.y:6788
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 1044:
+	case 1104:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:6432
+//line mysql_sql.y:6792
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 1045:
+	case 1105:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:6436
+//line mysql_sql.y:6796
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare(), yyDollar[5].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 1046:
+	case 1106:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:6442
+//line mysql_sql.y:6802
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 1047:
+	case 1107:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:6446
+//line mysql_sql.y:6806
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 1048:
+	case 1108:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:6450
+//line mysql_sql.y:6810
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 1049:
+	case 1109:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:6454
+//line mysql_sql.y:6814
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 1050:
+	case 1110:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:6460
+//line mysql_sql.y:6820
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 1051:
+	case 1111:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:6464
+//line mysql_sql.y:6824
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 1052:
+	case 1112:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:6468
+//line mysql_sql.y:6828
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare(), yyDollar[5].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 1053:
+	case 1113:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:6473
+//line mysql_sql.y:6833
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1054:
+	case 1114:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:6477
+//line mysql_sql.y:6837
 		{
 			yyLOCAL = yyDollar[1].columnAttributesUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1055:
+	case 1115:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:6483
+//line mysql_sql.y:6843
 		{
 			yyLOCAL = []tree.ColumnAttribute{yyDollar[1].columnAttributeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1056:
+	case 1116:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:6487
+//line mysql_sql.y:6847
 		{
 			yyLOCAL = append(yyDollar[1].columnAttributesUnion(), yyDollar[2].columnAttributeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1057:
+	case 1117:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6493
+//line mysql_sql.y:6853
 		{
 			yyLOCAL = tree.NewAttributeNull(true)
 		}
 		yyVAL.union = yyLOCAL
-	case 1058:
+	case 1118:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6497
+//line mysql_sql.y:6857
 		{
 			yyLOCAL = tree.NewAttributeNull(false)
 		}
 		yyVAL.union = yyLOCAL
-	case 1059:
+	case 1119:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6501
+//line mysql_sql.y:6861
 		{
 			yyLOCAL = tree.NewAttributeDefault(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1060:
+	case 1120:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6505
+//line mysql_sql.y:6865
 		{
 			yyLOCAL = tree.NewAttributeAutoIncrement()
 		}
 		yyVAL.union = yyLOCAL
-	case 1061:
+	case 1121:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6509
+//line mysql_sql.y:6869
 		{
 			yyLOCAL = yyDollar[1].columnAttributeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1062:
+	case 1122:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6513
+//line mysql_sql.y:6873
 		{
 			str := util.DealCommentString(yyDollar[2].str)
 			yyLOCAL = tree.NewAttributeComment(tree.NewNumValWithType(constant.MakeString(str), str, false, tree.P_char))
 		}
 		yyVAL.union = yyLOCAL
-	case 1063:
+	case 1123:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6518
+//line mysql_sql.y:6878
 		{
 			yyLOCAL = tree.NewAttributeCollate(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1064:
+	case 1124:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6522
+//line mysql_sql.y:6882
 		{
 			yyLOCAL = tree.NewAttributeColumnFormat(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1065:
+	case 1125:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6526
+//line mysql_sql.y:6886
 		{
 			yyLOCAL = tree.NewAttributeStorage(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1066:
+	case 1126:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6530
+//line mysql_sql.y:6890
 		{
 			yyLOCAL = tree.NewAttributeAutoRandom(int(yyDollar[2].int64ValUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 1067:
+	case 1127:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6534
+//line mysql_sql.y:6894
 		{
 			yyLOCAL = yyDollar[1].attributeReferenceUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1068:
+	case 1128:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6538
+//line mysql_sql.y:6898
 		{
 			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), false, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1069:
+	case 1129:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6542
+//line mysql_sql.y:6902
 		{
 			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), yyDollar[6].boolValUnion(), yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1070:
+	case 1130:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6546
+//line mysql_sql.y:6906
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[3].str))
 			var es tree.Exprs = nil
@@ -15741,58 +16642,58 @@ yydefault:
 			yyLOCAL = tree.NewAttributeOnUpdate(expr)
 		}
 		yyVAL.union = yyLOCAL
-	case 1071:
+	case 1131:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6559
+//line mysql_sql.y:6919
 		{
 			yyLOCAL = tree.NewAttributeLowCardinality()
 		}
 		yyVAL.union = yyLOCAL
-	case 1072:
+	case 1132:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6565
+//line mysql_sql.y:6925
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1073:
+	case 1133:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6569
+//line mysql_sql.y:6929
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1074:
+	case 1134:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:6574
+//line mysql_sql.y:6934
 		{
 			yyVAL.str = ""
 		}
-	case 1075:
+	case 1135:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:6578
+//line mysql_sql.y:6938
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 1076:
+	case 1136:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:6584
+//line mysql_sql.y:6944
 		{
 			yyVAL.str = ""
 		}
-	case 1077:
+	case 1137:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:6588
+//line mysql_sql.y:6948
 		{
 			yyVAL.str = yyDollar[2].cstrUnion().Compare()
 		}
-	case 1078:
+	case 1138:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AttributeReference
-//line mysql_sql.y:6594
+//line mysql_sql.y:6954
 		{
 			yyLOCAL = &tree.AttributeReference{
 				TableName: yyDollar[2].tableNameUnion(),
@@ -15803,10 +16704,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1079:
+	case 1139:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:6606
+//line mysql_sql.y:6966
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -15814,10 +16715,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1080:
+	case 1140:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:6613
+//line mysql_sql.y:6973
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -15825,10 +16726,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1081:
+	case 1141:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:6620
+//line mysql_sql.y:6980
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -15836,10 +16737,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1082:
+	case 1142:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:6627
+//line mysql_sql.y:6987
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -15847,10 +16748,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1083:
+	case 1143:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:6634
+//line mysql_sql.y:6994
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[2].referenceOptionTypeUnion(),
@@ -15858,339 +16759,339 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1084:
+	case 1144:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6643
+//line mysql_sql.y:7003
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1085:
+	case 1145:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6649
+//line mysql_sql.y:7009
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1086:
+	case 1146:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6655
+//line mysql_sql.y:7015
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_RESTRICT
 		}
 		yyVAL.union = yyLOCAL
-	case 1087:
+	case 1147:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6659
+//line mysql_sql.y:7019
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_CASCADE
 		}
 		yyVAL.union = yyLOCAL
-	case 1088:
+	case 1148:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6663
+//line mysql_sql.y:7023
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_NULL
 		}
 		yyVAL.union = yyLOCAL
-	case 1089:
+	case 1149:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6667
+//line mysql_sql.y:7027
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_NO_ACTION
 		}
 		yyVAL.union = yyLOCAL
-	case 1090:
+	case 1150:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6671
+//line mysql_sql.y:7031
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 1091:
+	case 1151:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:6676
+//line mysql_sql.y:7036
 		{
 			yyLOCAL = tree.MATCH_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 1093:
+	case 1153:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:6683
+//line mysql_sql.y:7043
 		{
 			yyLOCAL = tree.MATCH_FULL
 		}
 		yyVAL.union = yyLOCAL
-	case 1094:
+	case 1154:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:6687
+//line mysql_sql.y:7047
 		{
 			yyLOCAL = tree.MATCH_PARTIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1095:
+	case 1155:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:6691
+//line mysql_sql.y:7051
 		{
 			yyLOCAL = tree.MATCH_SIMPLE
 		}
 		yyVAL.union = yyLOCAL
-	case 1096:
+	case 1156:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:6696
+//line mysql_sql.y:7056
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1097:
+	case 1157:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:6700
+//line mysql_sql.y:7060
 		{
 			yyLOCAL = yyDollar[2].keyPartsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1098:
+	case 1158:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:6705
+//line mysql_sql.y:7065
 		{
 			yyLOCAL = -1
 		}
 		yyVAL.union = yyLOCAL
-	case 1099:
+	case 1159:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:6709
+//line mysql_sql.y:7069
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 1106:
+	case 1166:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Subquery
-//line mysql_sql.y:6725
+//line mysql_sql.y:7085
 		{
 			yyLOCAL = &tree.Subquery{Select: yyDollar[1].selectStatementUnion(), Exists: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 1107:
+	case 1167:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6731
+//line mysql_sql.y:7091
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_AND, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1108:
+	case 1168:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6735
+//line mysql_sql.y:7095
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_OR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1109:
+	case 1169:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6739
+//line mysql_sql.y:7099
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_XOR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1110:
+	case 1170:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6743
+//line mysql_sql.y:7103
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.PLUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1111:
+	case 1171:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6747
+//line mysql_sql.y:7107
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MINUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1112:
+	case 1172:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6751
+//line mysql_sql.y:7111
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MULTI, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1113:
+	case 1173:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6755
+//line mysql_sql.y:7115
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1114:
+	case 1174:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6759
+//line mysql_sql.y:7119
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.INTEGER_DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1115:
+	case 1175:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6763
+//line mysql_sql.y:7123
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1116:
+	case 1176:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6767
+//line mysql_sql.y:7127
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1117:
+	case 1177:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6771
+//line mysql_sql.y:7131
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.LEFT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1118:
+	case 1178:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6775
+//line mysql_sql.y:7135
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.RIGHT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1119:
+	case 1179:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6779
+//line mysql_sql.y:7139
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1120:
+	case 1180:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6785
+//line mysql_sql.y:7145
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1121:
+	case 1181:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6789
+//line mysql_sql.y:7149
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1122:
+	case 1182:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6793
+//line mysql_sql.y:7153
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1123:
+	case 1183:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6797
+//line mysql_sql.y:7157
 		{
 			yyLOCAL = tree.NewParenExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1124:
+	case 1184:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6801
+//line mysql_sql.y:7161
 		{
 			yyLOCAL = tree.NewTuple(append(yyDollar[2].exprsUnion(), yyDollar[4].exprUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 1125:
+	case 1185:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6805
+//line mysql_sql.y:7165
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_PLUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1126:
+	case 1186:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6809
+//line mysql_sql.y:7169
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MINUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1127:
+	case 1187:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6813
+//line mysql_sql.y:7173
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_TILDE, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1128:
+	case 1188:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6817
+//line mysql_sql.y:7177
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MARK, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1129:
+	case 1189:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6821
+//line mysql_sql.y:7181
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1130:
+	case 1190:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6825
+//line mysql_sql.y:7185
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1131:
+	case 1191:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6829
+//line mysql_sql.y:7189
 		{
 			yyDollar[2].subqueryUnion().Exists = true
 			yyLOCAL = yyDollar[2].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1132:
+	case 1192:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6834
+//line mysql_sql.y:7194
 		{
 			yyLOCAL = &tree.CaseExpr{
 				Expr:  yyDollar[2].exprUnion(),
@@ -16199,26 +17100,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1133:
+	case 1193:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6842
+//line mysql_sql.y:7202
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1134:
+	case 1194:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6847
+//line mysql_sql.y:7207
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1135:
+	case 1195:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6851
+//line mysql_sql.y:7211
 		{
 			name := tree.SetUnresolvedName("convert")
 			es := tree.NewNumValWithType(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false, tree.P_char)
@@ -16228,50 +17129,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1136:
+	case 1196:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6860
+//line mysql_sql.y:7220
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1137:
+	case 1197:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6864
+//line mysql_sql.y:7224
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1138:
+	case 1198:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6868
+//line mysql_sql.y:7228
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1139:
+	case 1199:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6872
+//line mysql_sql.y:7232
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1140:
+	case 1200:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6876
+//line mysql_sql.y:7236
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1141:
+	case 1201:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6882
+//line mysql_sql.y:7242
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16280,58 +17181,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1142:
+	case 1202:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6891
+//line mysql_sql.y:7251
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1143:
+	case 1203:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6895
+//line mysql_sql.y:7255
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1144:
+	case 1204:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6900
+//line mysql_sql.y:7260
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1145:
+	case 1205:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6904
+//line mysql_sql.y:7264
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1146:
+	case 1206:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.When
-//line mysql_sql.y:6910
+//line mysql_sql.y:7270
 		{
 			yyLOCAL = []*tree.When{yyDollar[1].whenClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1147:
+	case 1207:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.When
-//line mysql_sql.y:6914
+//line mysql_sql.y:7274
 		{
 			yyLOCAL = append(yyDollar[1].whenClauseListUnion(), yyDollar[2].whenClauseUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1148:
+	case 1208:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.When
-//line mysql_sql.y:6920
+//line mysql_sql.y:7280
 		{
 			yyLOCAL = &tree.When{
 				Cond: yyDollar[2].exprUnion(),
@@ -16339,19 +17240,19 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1149:
+	case 1209:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:6929
+//line mysql_sql.y:7289
 		{
 			t := yyVAL.columnTypeUnion()
 			if strings.ToLower(t.InternalType.FamilyString) == "binary" {
 				t.InternalType.Scale = -1
 			}
 		}
-	case 1150:
+	case 1210:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6935
+//line mysql_sql.y:7295
 		{
 			name := yyDollar[1].str
 			if yyDollar[2].str != "" {
@@ -16369,10 +17270,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1151:
+	case 1211:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6952
+//line mysql_sql.y:7312
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16387,10 +17288,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1153:
+	case 1213:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6969
+//line mysql_sql.y:7329
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16404,10 +17305,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1154:
+	case 1214:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6982
+//line mysql_sql.y:7342
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16421,10 +17322,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1155:
+	case 1215:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6995
+//line mysql_sql.y:7355
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16437,10 +17338,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1156:
+	case 1216:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7007
+//line mysql_sql.y:7367
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16455,10 +17356,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1157:
+	case 1217:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7021
+//line mysql_sql.y:7381
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16474,10 +17375,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1158:
+	case 1218:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7036
+//line mysql_sql.y:7396
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16493,10 +17394,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1159:
+	case 1219:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7051
+//line mysql_sql.y:7411
 		{
 			name := yyDollar[1].str
 			if yyDollar[2].str != "" {
@@ -16514,10 +17415,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1160:
+	case 1220:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7068
+//line mysql_sql.y:7428
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16532,83 +17433,83 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1161:
+	case 1221:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:7083
+//line mysql_sql.y:7443
 		{
 		}
-	case 1164:
+	case 1224:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.WindowFrameBound
-//line mysql_sql.y:7089
+//line mysql_sql.y:7449
 		{
 			yyLOCAL = &tree.WindowFrameBoundCurrentRow{}
 		}
 		yyVAL.union = yyLOCAL
-	case 1165:
+	case 1225:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.WindowFrameBound
-//line mysql_sql.y:7093
+//line mysql_sql.y:7453
 		{
 			yyLOCAL = &tree.WindowFrameBoundPreceding{}
 		}
 		yyVAL.union = yyLOCAL
-	case 1166:
+	case 1226:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.WindowFrameBound
-//line mysql_sql.y:7097
+//line mysql_sql.y:7457
 		{
 			yyLOCAL = &tree.WindowFrameBoundPreceding{
 				Expr: yyDollar[1].exprUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1167:
+	case 1227:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.WindowFrameBound
-//line mysql_sql.y:7103
+//line mysql_sql.y:7463
 		{
 			yyLOCAL = &tree.WindowFrameBoundFollowing{}
 		}
 		yyVAL.union = yyLOCAL
-	case 1168:
+	case 1228:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.WindowFrameBound
-//line mysql_sql.y:7107
+//line mysql_sql.y:7467
 		{
 			yyLOCAL = &tree.WindowFrameBoundFollowing{
 				Expr: yyDollar[1].exprUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1169:
+	case 1229:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.WindowFrameUnits
-//line mysql_sql.y:7115
+//line mysql_sql.y:7475
 		{
 			yyLOCAL = tree.WIN_FRAME_UNIT_ROWS
 		}
 		yyVAL.union = yyLOCAL
-	case 1170:
+	case 1230:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.WindowFrameUnits
-//line mysql_sql.y:7119
+//line mysql_sql.y:7479
 		{
 			yyLOCAL = tree.WIN_FRAME_UNIT_RANGE
 		}
 		yyVAL.union = yyLOCAL
-	case 1171:
+	case 1231:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.WindowFrameUnits
-//line mysql_sql.y:7123
+//line mysql_sql.y:7483
 		{
 			yyLOCAL = tree.WIN_FRAME_UNIT_GROUPS
 		}
 		yyVAL.union = yyLOCAL
-	case 1172:
+	case 1232:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.WindowFrame
-//line mysql_sql.y:7129
+//line mysql_sql.y:7489
 		{
 			yyLOCAL = &tree.WindowFrame{
 				Unit:       yyDollar[1].windowFrameUnitUnion(),
@@ -16616,10 +17517,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1173:
+	case 1233:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.WindowFrame
-//line mysql_sql.y:7136
+//line mysql_sql.y:7496
 		{
 			yyLOCAL = &tree.WindowFrame{
 				Unit:       yyDollar[1].windowFrameUnitUnion(),
@@ -16628,70 +17529,70 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1174:
+	case 1234:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.WindowFrame
-//line mysql_sql.y:7145
+//line mysql_sql.y:7505
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1175:
+	case 1235:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.WindowFrame
-//line mysql_sql.y:7149
+//line mysql_sql.y:7509
 		{
 			yyLOCAL = yyDollar[1].windowFrameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1176:
+	case 1236:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:7156
+//line mysql_sql.y:7516
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1177:
+	case 1237:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:7161
+//line mysql_sql.y:7521
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1178:
+	case 1238:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:7165
+//line mysql_sql.y:7525
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1179:
+	case 1239:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:7170
+//line mysql_sql.y:7530
 		{
 			yyVAL.str = ","
 		}
-	case 1180:
+	case 1240:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:7174
+//line mysql_sql.y:7534
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 1181:
+	case 1241:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.WindowSpec
-//line mysql_sql.y:7179
+//line mysql_sql.y:7539
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1183:
+	case 1243:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.WindowSpec
-//line mysql_sql.y:7186
+//line mysql_sql.y:7546
 		{
 			yyLOCAL = &tree.WindowSpec{
 				PartitionBy: yyDollar[3].exprsUnion(),
@@ -16700,10 +17601,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1184:
+	case 1244:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7196
+//line mysql_sql.y:7556
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16715,10 +17616,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1185:
+	case 1245:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7207
+//line mysql_sql.y:7567
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16729,10 +17630,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1186:
+	case 1246:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7217
+//line mysql_sql.y:7577
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16742,10 +17643,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1187:
+	case 1247:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7226
+//line mysql_sql.y:7586
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16755,10 +17656,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1188:
+	case 1248:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7235
+//line mysql_sql.y:7595
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16769,10 +17670,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1189:
+	case 1249:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7245
+//line mysql_sql.y:7605
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16783,10 +17684,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1190:
+	case 1250:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7255
+//line mysql_sql.y:7615
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16797,10 +17698,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1191:
+	case 1251:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7265
+//line mysql_sql.y:7625
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16811,10 +17712,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1192:
+	case 1252:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7275
+//line mysql_sql.y:7635
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			es := tree.NewNumValWithType(constant.MakeString("*"), "*", false, tree.P_char)
@@ -16825,10 +17726,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1193:
+	case 1253:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7285
+//line mysql_sql.y:7645
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16839,10 +17740,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1194:
+	case 1254:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7295
+//line mysql_sql.y:7655
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16853,10 +17754,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1195:
+	case 1255:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7305
+//line mysql_sql.y:7665
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16867,10 +17768,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1196:
+	case 1256:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7315
+//line mysql_sql.y:7675
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16881,10 +17782,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1197:
+	case 1257:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7325
+//line mysql_sql.y:7685
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16895,10 +17796,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1198:
+	case 1258:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7335
+//line mysql_sql.y:7695
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16909,10 +17810,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1199:
+	case 1259:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7345
+//line mysql_sql.y:7705
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16923,10 +17824,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1200:
+	case 1260:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7355
+//line mysql_sql.y:7715
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16937,10 +17838,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1204:
+	case 1264:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7372
+//line mysql_sql.y:7732
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16949,10 +17850,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1205:
+	case 1265:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7380
+//line mysql_sql.y:7740
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16961,10 +17862,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1206:
+	case 1266:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7388
+//line mysql_sql.y:7748
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16973,10 +17874,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1207:
+	case 1267:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7396
+//line mysql_sql.y:7756
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16985,10 +17886,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1208:
+	case 1268:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7404
+//line mysql_sql.y:7764
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			str := strings.ToLower(yyDollar[3].str)
@@ -16999,10 +17900,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1209:
+	case 1269:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7414
+//line mysql_sql.y:7774
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -17011,10 +17912,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1210:
+	case 1270:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7422
+//line mysql_sql.y:7782
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -17024,10 +17925,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1211:
+	case 1271:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7431
+//line mysql_sql.y:7791
 		{
 			name := tree.SetUnresolvedName("nextval")
 			yyLOCAL = &tree.FuncExpr{
@@ -17036,10 +17937,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1212:
+	case 1272:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7439
+//line mysql_sql.y:7799
 		{
 			name := tree.SetUnresolvedName("setval")
 			yyLOCAL = &tree.FuncExpr{
@@ -17048,10 +17949,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1213:
+	case 1273:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7447
+//line mysql_sql.y:7807
 		{
 			name := tree.SetUnresolvedName("currval")
 			yyLOCAL = &tree.FuncExpr{
@@ -17060,10 +17961,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1214:
+	case 1274:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7455
+//line mysql_sql.y:7815
 		{
 			name := tree.SetUnresolvedName("lastval")
 			yyLOCAL = &tree.FuncExpr{
@@ -17072,10 +17973,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1215:
+	case 1275:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7463
+//line mysql_sql.y:7823
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			arg0 := tree.NewNumValWithType(constant.MakeInt64(0), "0", false, tree.P_int64)
@@ -17087,10 +17988,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1216:
+	case 1276:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7474
+//line mysql_sql.y:7834
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			arg0 := tree.NewNumValWithType(constant.MakeInt64(1), "1", false, tree.P_int64)
@@ -17101,10 +18002,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1217:
+	case 1277:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7484
+//line mysql_sql.y:7844
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			arg0 := tree.NewNumValWithType(constant.MakeInt64(2), "2", false, tree.P_int64)
@@ -17117,10 +18018,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1218:
+	case 1278:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7496
+//line mysql_sql.y:7856
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			arg0 := tree.NewNumValWithType(constant.MakeInt64(3), "3", false, tree.P_int64)
@@ -17132,10 +18033,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1219:
+	case 1279:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7507
+//line mysql_sql.y:7867
 		{
 			column := tree.SetUnresolvedName(strings.ToLower(yyDollar[3].str))
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
@@ -17145,16 +18046,16 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1226:
+	case 1286:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:7529
+//line mysql_sql.y:7889
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 1255:
+	case 1315:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7565
+//line mysql_sql.y:7925
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -17167,10 +18068,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1256:
+	case 1316:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7577
+//line mysql_sql.y:7937
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -17183,10 +18084,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1257:
+	case 1317:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7589
+//line mysql_sql.y:7949
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			str := strings.ToLower(yyDollar[3].str)
@@ -17197,10 +18098,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1258:
+	case 1318:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7600
+//line mysql_sql.y:7960
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -17209,10 +18110,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1259:
+	case 1319:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7608
+//line mysql_sql.y:7968
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -17220,10 +18121,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1260:
+	case 1320:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7615
+//line mysql_sql.y:7975
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -17231,10 +18132,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1261:
+	case 1321:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7622
+//line mysql_sql.y:7982
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -17247,10 +18148,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1262:
+	case 1322:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7634
+//line mysql_sql.y:7994
 		{
 			name := tree.SetUnresolvedName("binary")
 			yyLOCAL = &tree.FuncExpr{
@@ -17259,10 +18160,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1263:
+	case 1323:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7642
+//line mysql_sql.y:8002
 		{
 			name := tree.SetUnresolvedName("binary")
 			exprs := make([]tree.Expr, 1)
@@ -17273,10 +18174,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1264:
+	case 1324:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7652
+//line mysql_sql.y:8012
 		{
 			name := tree.SetUnresolvedName("binary")
 			exprs := make([]tree.Expr, 1)
@@ -17287,10 +18188,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1265:
+	case 1325:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7662
+//line mysql_sql.y:8022
 		{
 			name := tree.SetUnresolvedName("char")
 			yyLOCAL = &tree.FuncExpr{
@@ -17299,10 +18200,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1266:
+	case 1326:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7670
+//line mysql_sql.y:8030
 		{
 			cn := tree.NewNumValWithType(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false, tree.P_char)
 			es := yyDollar[3].exprsUnion()
@@ -17314,10 +18215,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1267:
+	case 1327:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7681
+//line mysql_sql.y:8041
 		{
 			val := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
 			name := tree.SetUnresolvedName("date")
@@ -17327,10 +18228,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1268:
+	case 1328:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7690
+//line mysql_sql.y:8050
 		{
 			val := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
 			name := tree.SetUnresolvedName("time")
@@ -17340,10 +18241,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1269:
+	case 1329:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7699
+//line mysql_sql.y:8059
 		{
 			name := tree.SetUnresolvedName("insert")
 			yyLOCAL = &tree.FuncExpr{
@@ -17352,10 +18253,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1270:
+	case 1330:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7707
+//line mysql_sql.y:8067
 		{
 			es := tree.Exprs{yyDollar[3].exprUnion()}
 			es = append(es, yyDollar[5].exprUnion())
@@ -17366,10 +18267,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1271:
+	case 1331:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7717
+//line mysql_sql.y:8077
 		{
 			name := tree.SetUnresolvedName("password")
 			yyLOCAL = &tree.FuncExpr{
@@ -17378,10 +18279,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1272:
+	case 1332:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7725
+//line mysql_sql.y:8085
 		{
 			val := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
 			name := tree.SetUnresolvedName("timestamp")
@@ -17391,34 +18292,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1273:
+	case 1333:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7735
+//line mysql_sql.y:8095
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1274:
+	case 1334:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7739
+//line mysql_sql.y:8099
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1275:
+	case 1335:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7745
+//line mysql_sql.y:8105
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1276:
+	case 1336:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7749
+//line mysql_sql.y:8109
 		{
 			ival, errStr := util.GetInt64(yyDollar[2].item)
 			if errStr != "" {
@@ -17429,20 +18330,20 @@ yydefault:
 			yyLOCAL = tree.NewNumValWithType(constant.MakeInt64(ival), str, false, tree.P_int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 1283:
+	case 1343:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:7768
+//line mysql_sql.y:8128
 		{
 		}
-	case 1284:
+	case 1344:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:7770
+//line mysql_sql.y:8130
 		{
 		}
-	case 1318:
+	case 1378:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7811
+//line mysql_sql.y:8171
 		{
 			name := tree.SetUnresolvedName("interval")
 			str := strings.ToLower(yyDollar[3].str)
@@ -17453,90 +18354,90 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1319:
+	case 1379:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:7822
+//line mysql_sql.y:8182
 		{
 			yyLOCAL = tree.FUNC_TYPE_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 1320:
+	case 1380:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:7826
+//line mysql_sql.y:8186
 		{
 			yyLOCAL = tree.FUNC_TYPE_DISTINCT
 		}
 		yyVAL.union = yyLOCAL
-	case 1321:
+	case 1381:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:7830
+//line mysql_sql.y:8190
 		{
 			yyLOCAL = tree.FUNC_TYPE_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 1322:
+	case 1382:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Tuple
-//line mysql_sql.y:7836
+//line mysql_sql.y:8196
 		{
 			yyLOCAL = tree.NewTuple(yyDollar[2].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1323:
+	case 1383:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:7841
+//line mysql_sql.y:8201
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1324:
+	case 1384:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:7845
+//line mysql_sql.y:8205
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1325:
+	case 1385:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:7851
+//line mysql_sql.y:8211
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1326:
+	case 1386:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:7855
+//line mysql_sql.y:8215
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1327:
+	case 1387:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7862
+//line mysql_sql.y:8222
 		{
 			yyLOCAL = tree.NewAndExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1328:
+	case 1388:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7866
+//line mysql_sql.y:8226
 		{
 			yyLOCAL = tree.NewOrExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1329:
+	case 1389:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7870
+//line mysql_sql.y:8230
 		{
 			name := tree.SetUnresolvedName(strings.ToLower("concat"))
 			yyLOCAL = &tree.FuncExpr{
@@ -17545,355 +18446,355 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1330:
+	case 1390:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7878
+//line mysql_sql.y:8238
 		{
 			yyLOCAL = tree.NewXorExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1331:
+	case 1391:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7882
+//line mysql_sql.y:8242
 		{
 			yyLOCAL = tree.NewNotExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1332:
+	case 1392:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7886
+//line mysql_sql.y:8246
 		{
 			yyLOCAL = tree.NewMaxValue()
 		}
 		yyVAL.union = yyLOCAL
-	case 1333:
+	case 1393:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7890
+//line mysql_sql.y:8250
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1334:
+	case 1394:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7896
+//line mysql_sql.y:8256
 		{
 			yyLOCAL = tree.NewIsNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1335:
+	case 1395:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7900
+//line mysql_sql.y:8260
 		{
 			yyLOCAL = tree.NewIsNotNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1336:
+	case 1396:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7904
+//line mysql_sql.y:8264
 		{
 			yyLOCAL = tree.NewIsUnknownExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1337:
+	case 1397:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7908
+//line mysql_sql.y:8268
 		{
 			yyLOCAL = tree.NewIsNotUnknownExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1338:
+	case 1398:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7912
+//line mysql_sql.y:8272
 		{
 			yyLOCAL = tree.NewIsTrueExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1339:
+	case 1399:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7916
+//line mysql_sql.y:8276
 		{
 			yyLOCAL = tree.NewIsNotTrueExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1340:
+	case 1400:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7920
+//line mysql_sql.y:8280
 		{
 			yyLOCAL = tree.NewIsFalseExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1341:
+	case 1401:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7924
+//line mysql_sql.y:8284
 		{
 			yyLOCAL = tree.NewIsNotFalseExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1342:
+	case 1402:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7928
+//line mysql_sql.y:8288
 		{
 			yyLOCAL = tree.NewComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1343:
+	case 1403:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7932
+//line mysql_sql.y:8292
 		{
 			yyLOCAL = tree.NewSubqueryComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[3].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[4].subqueryUnion())
 			yyLOCAL = tree.NewSubqueryComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[3].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[4].subqueryUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1345:
+	case 1405:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7940
+//line mysql_sql.y:8300
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.IN, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1346:
+	case 1406:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7944
+//line mysql_sql.y:8304
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_IN, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1347:
+	case 1407:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7948
+//line mysql_sql.y:8308
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.LIKE, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1348:
+	case 1408:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7952
+//line mysql_sql.y:8312
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.NOT_LIKE, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1349:
+	case 1409:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7956
+//line mysql_sql.y:8316
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.ILIKE, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1350:
+	case 1410:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7960
+//line mysql_sql.y:8320
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.NOT_ILIKE, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1351:
+	case 1411:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7964
+//line mysql_sql.y:8324
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.REG_MATCH, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1352:
+	case 1412:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7968
+//line mysql_sql.y:8328
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_REG_MATCH, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1353:
+	case 1413:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7972
+//line mysql_sql.y:8332
 		{
 			yyLOCAL = tree.NewRangeCond(false, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1354:
+	case 1414:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7976
+//line mysql_sql.y:8336
 		{
 			yyLOCAL = tree.NewRangeCond(true, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[6].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1356:
+	case 1416:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7982
+//line mysql_sql.y:8342
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1357:
+	case 1417:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7986
+//line mysql_sql.y:8346
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1358:
+	case 1418:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7992
+//line mysql_sql.y:8352
 		{
 			yyLOCAL = yyDollar[1].tupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1359:
+	case 1419:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7996
+//line mysql_sql.y:8356
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1360:
+	case 1420:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8003
+//line mysql_sql.y:8363
 		{
 			yyLOCAL = tree.ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 1361:
+	case 1421:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8007
+//line mysql_sql.y:8367
 		{
 			yyLOCAL = tree.ANY
 		}
 		yyVAL.union = yyLOCAL
-	case 1362:
+	case 1422:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8011
+//line mysql_sql.y:8371
 		{
 			yyLOCAL = tree.SOME
 		}
 		yyVAL.union = yyLOCAL
-	case 1363:
+	case 1423:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8017
+//line mysql_sql.y:8377
 		{
 			yyLOCAL = tree.EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1364:
+	case 1424:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8021
+//line mysql_sql.y:8381
 		{
 			yyLOCAL = tree.LESS_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 1365:
+	case 1425:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8025
+//line mysql_sql.y:8385
 		{
 			yyLOCAL = tree.GREAT_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 1366:
+	case 1426:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8029
+//line mysql_sql.y:8389
 		{
 			yyLOCAL = tree.LESS_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1367:
+	case 1427:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8033
+//line mysql_sql.y:8393
 		{
 			yyLOCAL = tree.GREAT_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1368:
+	case 1428:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8037
+//line mysql_sql.y:8397
 		{
 			yyLOCAL = tree.NOT_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1369:
+	case 1429:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8041
+//line mysql_sql.y:8401
 		{
 			yyLOCAL = tree.NULL_SAFE_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1370:
+	case 1430:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:8047
+//line mysql_sql.y:8407
 		{
 			yyLOCAL = tree.NewAttributePrimaryKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 1371:
+	case 1431:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:8051
+//line mysql_sql.y:8411
 		{
 			yyLOCAL = tree.NewAttributeUniqueKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 1372:
+	case 1432:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:8055
+//line mysql_sql.y:8415
 		{
 			yyLOCAL = tree.NewAttributeUnique()
 		}
 		yyVAL.union = yyLOCAL
-	case 1373:
+	case 1433:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:8059
+//line mysql_sql.y:8419
 		{
 			yyLOCAL = tree.NewAttributeKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 1374:
+	case 1434:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8065
+//line mysql_sql.y:8425
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_char)
 		}
 		yyVAL.union = yyLOCAL
-	case 1375:
+	case 1435:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8069
+//line mysql_sql.y:8429
 		{
 			str := fmt.Sprintf("%v", yyDollar[1].item)
 			switch v := yyDollar[1].item.(type) {
@@ -17907,59 +18808,59 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1376:
+	case 1436:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8082
+//line mysql_sql.y:8442
 		{
 			fval := yyDollar[1].item.(float64)
 			yyLOCAL = tree.NewNumValWithType(constant.MakeFloat64(fval), yylex.(*Lexer).scanner.LastToken, false, tree.P_float64)
 		}
 		yyVAL.union = yyLOCAL
-	case 1377:
+	case 1437:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8087
+//line mysql_sql.y:8447
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeBool(true), "true", false, tree.P_bool)
 		}
 		yyVAL.union = yyLOCAL
-	case 1378:
+	case 1438:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8091
+//line mysql_sql.y:8451
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeBool(false), "false", false, tree.P_bool)
 		}
 		yyVAL.union = yyLOCAL
-	case 1379:
+	case 1439:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8095
+//line mysql_sql.y:8455
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeUnknown(), "null", false, tree.P_null)
 		}
 		yyVAL.union = yyLOCAL
-	case 1380:
+	case 1440:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8099
+//line mysql_sql.y:8459
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_hexnum)
 		}
 		yyVAL.union = yyLOCAL
-	case 1381:
+	case 1441:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8103
+//line mysql_sql.y:8463
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_decimal)
 		}
 		yyVAL.union = yyLOCAL
-	case 1382:
+	case 1442:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8107
+//line mysql_sql.y:8467
 		{
 			switch v := yyDollar[1].item.(type) {
 			case uint64:
@@ -17974,53 +18875,53 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1383:
+	case 1443:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8121
+//line mysql_sql.y:8481
 		{
 			yyLOCAL = tree.NewParamExpr(yylex.(*Lexer).GetParamIndex())
 		}
 		yyVAL.union = yyLOCAL
-	case 1384:
+	case 1444:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8125
+//line mysql_sql.y:8485
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_ScoreBinary)
 		}
 		yyVAL.union = yyLOCAL
-	case 1385:
+	case 1445:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8132
+//line mysql_sql.y:8492
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.Unsigned = yyDollar[2].unsignedOptUnion()
 			yyLOCAL.InternalType.Zerofill = yyDollar[3].zeroFillOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1389:
+	case 1449:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8143
+//line mysql_sql.y:8503
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.DisplayWith = yyDollar[2].lengthOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1390:
+	case 1450:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8148
+//line mysql_sql.y:8508
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1391:
+	case 1451:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8154
+//line mysql_sql.y:8514
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18033,10 +18934,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1392:
+	case 1452:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8166
+//line mysql_sql.y:8526
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18049,10 +18950,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1393:
+	case 1453:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8178
+//line mysql_sql.y:8538
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18065,10 +18966,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1394:
+	case 1454:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8190
+//line mysql_sql.y:8550
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18082,10 +18983,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1395:
+	case 1455:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8203
+//line mysql_sql.y:8563
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18099,10 +19000,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1396:
+	case 1456:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8216
+//line mysql_sql.y:8576
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18116,10 +19017,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1397:
+	case 1457:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8229
+//line mysql_sql.y:8589
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18133,10 +19034,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1398:
+	case 1458:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8242
+//line mysql_sql.y:8602
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18150,10 +19051,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1399:
+	case 1459:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8255
+//line mysql_sql.y:8615
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18167,10 +19068,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1400:
+	case 1460:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8268
+//line mysql_sql.y:8628
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18184,10 +19085,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1401:
+	case 1461:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8281
+//line mysql_sql.y:8641
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18201,10 +19102,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1402:
+	case 1462:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8294
+//line mysql_sql.y:8654
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18218,10 +19119,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1403:
+	case 1463:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8307
+//line mysql_sql.y:8667
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18235,10 +19136,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1404:
+	case 1464:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8320
+//line mysql_sql.y:8680
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18252,10 +19153,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1405:
+	case 1465:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8335
+//line mysql_sql.y:8695
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().DisplayWith > 255 {
@@ -18283,10 +19184,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1406:
+	case 1466:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8362
+//line mysql_sql.y:8722
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().DisplayWith > 255 {
@@ -18328,10 +19229,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1407:
+	case 1467:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8404
+//line mysql_sql.y:8764
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().Scale != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Scale > yyDollar[2].lengthScaleOptUnion().DisplayWith {
@@ -18368,10 +19269,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1408:
+	case 1468:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8452
+//line mysql_sql.y:8812
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18387,10 +19288,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1409:
+	case 1469:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8469
+//line mysql_sql.y:8829
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18403,10 +19304,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1410:
+	case 1470:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8481
+//line mysql_sql.y:8841
 		{
 			locale := ""
 			if yyDollar[2].lengthOptUnion() < 0 || yyDollar[2].lengthOptUnion() > 6 {
@@ -18427,10 +19328,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1411:
+	case 1471:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8501
+//line mysql_sql.y:8861
 		{
 			locale := ""
 			if yyDollar[2].lengthOptUnion() < 0 || yyDollar[2].lengthOptUnion() > 6 {
@@ -18451,10 +19352,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1412:
+	case 1472:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8521
+//line mysql_sql.y:8881
 		{
 			locale := ""
 			if yyDollar[2].lengthOptUnion() < 0 || yyDollar[2].lengthOptUnion() > 6 {
@@ -18475,10 +19376,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1413:
+	case 1473:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8541
+//line mysql_sql.y:8901
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18493,10 +19394,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1414:
+	case 1474:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8557
+//line mysql_sql.y:8917
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18510,10 +19411,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1415:
+	case 1475:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8570
+//line mysql_sql.y:8930
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18527,10 +19428,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1416:
+	case 1476:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8583
+//line mysql_sql.y:8943
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18544,10 +19445,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1417:
+	case 1477:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8596
+//line mysql_sql.y:8956
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18561,10 +19462,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1418:
+	case 1478:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8609
+//line mysql_sql.y:8969
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18577,10 +19478,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1419:
+	case 1479:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8621
+//line mysql_sql.y:8981
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18593,10 +19494,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1420:
+	case 1480:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8633
+//line mysql_sql.y:8993
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18609,10 +19510,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1421:
+	case 1481:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8645
+//line mysql_sql.y:9005
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18625,10 +19526,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1422:
+	case 1482:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8657
+//line mysql_sql.y:9017
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18641,10 +19542,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1423:
+	case 1483:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8669
+//line mysql_sql.y:9029
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18657,10 +19558,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1424:
+	case 1484:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8681
+//line mysql_sql.y:9041
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18673,10 +19574,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1425:
+	case 1485:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8693
+//line mysql_sql.y:9053
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18689,10 +19590,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1426:
+	case 1486:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8705
+//line mysql_sql.y:9065
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18705,10 +19606,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1427:
+	case 1487:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8717
+//line mysql_sql.y:9077
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18722,10 +19623,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1428:
+	case 1488:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8730
+//line mysql_sql.y:9090
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18739,10 +19640,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1429:
+	case 1489:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8743
+//line mysql_sql.y:9103
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18756,20 +19657,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1430:
+	case 1490:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:8758
+//line mysql_sql.y:9118
 		{
 			yyLOCAL = &tree.Do{
 				Exprs: yyDollar[2].exprsUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1431:
+	case 1491:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:8766
+//line mysql_sql.y:9126
 		{
 			yyLOCAL = &tree.Declare{
 				Variables:  yyDollar[2].strsUnion(),
@@ -18778,10 +19679,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1432:
+	case 1492:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:8775
+//line mysql_sql.y:9135
 		{
 			yyLOCAL = &tree.Declare{
 				Variables:  yyDollar[2].strsUnion(),
@@ -18790,10 +19691,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1433:
+	case 1493:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8785
+//line mysql_sql.y:9145
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18806,75 +19707,75 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1434:
+	case 1494:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:8808
+//line mysql_sql.y:9168
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1435:
+	case 1495:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:8813
+//line mysql_sql.y:9173
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1436:
+	case 1496:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:8819
+//line mysql_sql.y:9179
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 1438:
+	case 1498:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:8826
+//line mysql_sql.y:9186
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 1439:
+	case 1499:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:8830
+//line mysql_sql.y:9190
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 1440:
+	case 1500:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:8835
+//line mysql_sql.y:9195
 		{
 			yyLOCAL = int32(-1)
 		}
 		yyVAL.union = yyLOCAL
-	case 1441:
+	case 1501:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:8839
+//line mysql_sql.y:9199
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 1442:
+	case 1502:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:8845
+//line mysql_sql.y:9205
 		{
 			yyLOCAL = tree.GetDisplayWith(int32(yyDollar[2].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 1443:
+	case 1503:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:8851
+//line mysql_sql.y:9211
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.NotDefineDisplayWidth,
@@ -18882,10 +19783,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1444:
+	case 1504:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:8858
+//line mysql_sql.y:9218
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -18893,10 +19794,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1445:
+	case 1505:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:8865
+//line mysql_sql.y:9225
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -18904,10 +19805,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1446:
+	case 1506:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:8874
+//line mysql_sql.y:9234
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: 38, // this is the default precision for decimal
@@ -18915,10 +19816,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1447:
+	case 1507:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:8881
+//line mysql_sql.y:9241
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -18926,10 +19827,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1448:
+	case 1508:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:8888
+//line mysql_sql.y:9248
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -18937,52 +19838,52 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1449:
+	case 1509:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:8897
+//line mysql_sql.y:9257
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1450:
+	case 1510:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:8901
+//line mysql_sql.y:9261
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1451:
+	case 1511:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:8905
+//line mysql_sql.y:9265
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1452:
+	case 1512:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:8911
+//line mysql_sql.y:9271
 		{
 		}
-	case 1453:
+	case 1513:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:8913
+//line mysql_sql.y:9273
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1457:
+	case 1517:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:8923
+//line mysql_sql.y:9283
 		{
 			yyVAL.str = ""
 		}
-	case 1458:
+	case 1518:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:8927
+//line mysql_sql.y:9287
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
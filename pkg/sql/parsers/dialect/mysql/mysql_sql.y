@@ -53,6 +53,7 @@ import (
     matchType tree.MatchType
     attributeReference *tree.AttributeReference
     loadParam *tree.ExternParam
+    backupTarget tree.BackupTarget
     tailParam *tree.TailParameter
 
     functionName *tree.FunctionName
@@ -204,6 +205,7 @@ import (
     indexHintScope tree.IndexHintScope
     indexHint *tree.IndexHint
     indexHintList []*tree.IndexHint
+    optimizerHints *tree.OptimizerHints
     indexVisibility tree.VisibleType
 
     killOption tree.KillOption
@@ -238,6 +240,7 @@ import (
 %token <str> NEXT VALUE SHARE MODE
 %token <str> SQL_NO_CACHE SQL_CACHE
 %left <str> JOIN STRAIGHT_JOIN LEFT RIGHT INNER OUTER CROSS NATURAL USE FORCE
+%token <str> LATERAL
 %nonassoc LOWER_THAN_ON
 %nonassoc <str> ON USING
 %left <str> SUBQUERY_AS_EXPR
@@ -245,6 +248,7 @@ import (
 %left <str> ')'
 %nonassoc LOWER_THAN_STRING
 %nonassoc <str> ID AT_ID AT_AT_ID STRING VALUE_ARG LIST_ARG COMMENT COMMENT_KEYWORD QUOTE_ID
+%token <str> OPTIMIZER_HINT
 %token <item> INTEGRAL HEX BIT_LITERAL FLOAT
 %token <str>  HEXNUM
 %token <str> NULL TRUE FALSE
@@ -309,6 +313,22 @@ import (
 %token <str> INCREMENT CYCLE MINVALUE
 // publication
 %token <str> PUBLICATION SUBSCRIPTIONS PUBLICATIONS
+// row level security
+%token <str> POLICY
+// audit
+%token <str> AUDIT FILTER
+// partition maintenance
+%token <str> EXCHANGE VALIDATION WITHOUT
+// time to live of rows
+%token <str> TTL
+// bloom filters on non key columns
+%token <str> BLOOM_FILTER_COLUMNS ZORDER
+// physical backup
+%token <str> BACKUP RESTORE
+// change data capture
+%token <str> CHANGEFEED CHANGEFEEDS CURSOR
+// materialized view
+%token <str> MATERIALIZED REFRESH
 
 
 // MO table option
@@ -445,6 +465,8 @@ import (
 %type <statement> mo_dump_stmt
 %type <statement> load_extension_stmt
 %type <statement> kill_stmt
+%type <statement> backup_stmt restore_stmt
+%type <backupTarget> backup_target
 %type <rowsExprs> row_constructor_list
 %type <exprs>  row_constructor
 %type <exportParm> export_data_param_opt
@@ -466,6 +488,13 @@ import (
 %type <statement> loop_stmt iterate_stmt leave_stmt repeat_stmt while_stmt
 %type <statement>  create_publication_stmt drop_publication_stmt alter_publication_stmt show_publications_stmt show_subscriptions_stmt
 %type <str> comment_opt
+%type <statement> create_policy_stmt drop_policy_stmt
+%type <statement> create_audit_filter_stmt drop_audit_filter_stmt
+%type <statement> create_changefeed_stmt drop_changefeed_stmt show_changefeeds_stmt
+%type <str> changefeed_cursor_opt
+%type <statement> create_mview_stmt drop_mview_stmt refresh_mview_stmt
+%type <identifierList> audit_user_list_opt
+%type <str> policy_command_opt
 %type <subscriptionOption> subcription_opt
 %type <accountsSetOption> alter_publication_accounts_opt
 
@@ -517,7 +546,7 @@ import (
 %type <referenceOnRecord> on_delete_update_opt
 %type <attributeReference> references_def
 %type <alterTableOptions> alter_option_list
-%type <alterTableOption> alter_option alter_table_drop alter_table_alter
+%type <alterTableOption> alter_option alter_table_drop alter_table_alter alter_partition_option
 %type <indexVisibility> visibility
 
 %type <tableOption> table_option
@@ -610,7 +639,7 @@ import (
 %type <windowFrameUnit> window_frame_unit
 %type <str> fields_or_columns
 %type <int64Val> algorithm_opt partition_num_opt sub_partition_num_opt
-%type <boolVal> linear_opt
+%type <boolVal> linear_opt without_validation_opt
 %type <partition> partition
 %type <partitions> partition_list_opt partition_list
 %type <values> values_opt
@@ -671,6 +700,7 @@ import (
 %type <epxlainOption> utility_option_elem
 %type <str> utility_option_name utility_option_arg
 %type <str> explain_option_key select_option_opt
+%type <optimizerHints> optimizer_hints_opt
 %type <str> explain_foramt_value trim_direction
 %type <str> priority_opt priority quick_opt ignore_opt wild_opt
 
@@ -808,6 +838,35 @@ normal_stmt:
         $$ = $1
     }
 |   kill_stmt
+|   backup_stmt
+|   restore_stmt
+|   refresh_mview_stmt
+
+backup_stmt:
+    BACKUP TO backup_target
+    {
+        $$ = &tree.Backup{BackupTarget: $3}
+    }
+
+restore_stmt:
+    RESTORE FROM backup_target TO backup_target
+    {
+        $$ = &tree.Restore{From: $3, To: $5}
+    }
+|   RESTORE FROM backup_target TO backup_target TIMESTAMP STRING
+    {
+        $$ = &tree.Restore{From: $3, To: $5, Ts: $7}
+    }
+
+backup_target:
+    STRING
+    {
+        $$ = tree.BackupTarget{Dir: $1}
+    }
+|   S3OPTION '{' infile_or_s3_params '}'
+    {
+        $$ = tree.BackupTarget{Option: $3}
+    }
 
 kill_stmt:
     KILL kill_opt INTEGRAL statement_id_opt
@@ -2384,6 +2443,24 @@ explain_stmt:
         explainStmt.Options = options
         $$ = explainStmt
     }
+|   explain_sym ANALYZE FORMAT '=' explain_foramt_value explainable_stmt
+    {
+        explainStmt := tree.NewExplainAnalyze($6, $5)
+        optionElem1 := tree.MakeOptionElem("analyze", "NULL")
+        optionElem2 := tree.MakeOptionElem("format", $5)
+        options := tree.MakeOptions(optionElem1)
+        options = append(options, optionElem2)
+        explainStmt.Options = options
+        $$ = explainStmt
+    }
+|   explain_sym FORMAT '=' explain_foramt_value explainable_stmt
+    {
+        explainStmt := tree.NewExplainStmt($5, $4)
+        optionElem := tree.MakeOptionElem("format", $4)
+        options := tree.MakeOptions(optionElem)
+        explainStmt.Options = options
+        $$ = explainStmt
+    }
 |   explain_sym '(' utility_option_list ')' explainable_stmt
     {
         if tree.IsContainAnalyze($3) {
@@ -2453,7 +2530,11 @@ utility_option_arg:
 
 
 analyze_stmt:
-    ANALYZE TABLE table_name '(' column_list ')'
+    ANALYZE TABLE table_name
+    {
+        $$ = tree.NewAnalyzeStmt($3, nil)
+    }
+|   ANALYZE TABLE table_name '(' column_list ')'
     {
         $$ = tree.NewAnalyzeStmt($3, $5)
     }
@@ -2486,6 +2567,20 @@ alter_table_stmt:
             Options: $4,
         }
     }
+|   ALTER TABLE table_name alter_partition_option
+    {
+        $$ = &tree.AlterTable{
+            Table: $3,
+            Options: []tree.AlterTableOption{$4},
+        }
+    }
+|   ALTER TABLE table_name COMPACT
+    {
+        $$ = &tree.AlterTable{
+            Table: $3,
+            Options: []tree.AlterTableOption{&tree.AlterOptionCompact{}},
+        }
+    }
 
 alter_option_list:
 alter_option
@@ -2518,6 +2613,66 @@ ADD table_elem
         $$ =  tree.AlterTableOption($1)
     }
 
+alter_partition_option:
+    ADD PARTITION '(' partition_list ')'
+    {
+        $$ = &tree.AlterOptionPartition{
+            Typ: tree.AlterPartitionAdd,
+            Partitions: $4,
+        }
+    }
+|   DROP PARTITION partition_id_list
+    {
+        $$ = &tree.AlterOptionPartition{
+            Typ: tree.AlterPartitionDrop,
+            Names: $3,
+        }
+    }
+|   TRUNCATE PARTITION partition_id_list
+    {
+        $$ = &tree.AlterOptionPartition{
+            Typ: tree.AlterPartitionTruncate,
+            Names: $3,
+        }
+    }
+|   TRUNCATE PARTITION ALL
+    {
+        $$ = &tree.AlterOptionPartition{
+            Typ: tree.AlterPartitionTruncate,
+            All: true,
+        }
+    }
+|   EXCHANGE PARTITION ident WITH TABLE table_name without_validation_opt
+    {
+        $$ = &tree.AlterOptionPartition{
+            Typ: tree.AlterPartitionExchange,
+            Names: tree.IdentifierList{tree.Identifier($3.Compare())},
+            ExchangeTable: $6,
+            WithoutValidation: $7,
+        }
+    }
+|   REORGANIZE PARTITION partition_id_list INTO '(' partition_list ')'
+    {
+        $$ = &tree.AlterOptionPartition{
+            Typ: tree.AlterPartitionReorganize,
+            Names: $3,
+            Partitions: $6,
+        }
+    }
+
+without_validation_opt:
+    {
+        $$ = false
+    }
+|   WITH VALIDATION
+    {
+        $$ = false
+    }
+|   WITHOUT VALIDATION
+    {
+        $$ = true
+    }
+
 alter_table_drop:
     INDEX ident 
     {
@@ -2631,6 +2786,14 @@ alter_user_stmt:
             CommentOrAttribute: $7,
         }
     }
+|   ALTER USER exists_opt user_name pwd_or_lck
+    {
+        $$ = &tree.AlterUser{
+            IfExists: $3,
+            Users: []*tree.User{{Username: $4.Username, Hostname: $4.Hostname}},
+            MiscOpt: $5,
+        }
+    }
 
 default_role_opt:
     {
@@ -2678,6 +2841,14 @@ pwd_or_lck:
     {
         $$ = &tree.UserMiscOptionAccountLock{}
     }
+|   ACCOUNT UNLOCK
+    {
+        $$ = &tree.UserMiscOptionAccountUnlock{}
+    }
+|   ACCOUNT LOCK
+    {
+        $$ = &tree.UserMiscOptionAccountLock{}
+    }
 |   pwd_expire
     {
         $$ = &tree.UserMiscOptionPasswordExpireNone{}
@@ -2777,6 +2948,7 @@ show_stmt:
 |   show_publications_stmt
 |   show_subscriptions_stmt
 |   show_servers_stmt
+|   show_changefeeds_stmt
 
 show_collation_stmt:
     SHOW COLLATION like_opt where_expression_opt
@@ -3083,6 +3255,12 @@ show_publications_stmt:
     }
 
 
+show_changefeeds_stmt:
+    SHOW CHANGEFEEDS
+    {
+	$$ = &tree.ShowChangefeeds{}
+    }
+
 show_subscriptions_stmt:
     SHOW SUBSCRIPTIONS like_opt
     {
@@ -3214,6 +3392,10 @@ drop_ddl_stmt:
 |   drop_function_stmt
 |   drop_sequence_stmt
 |   drop_publication_stmt
+|   drop_policy_stmt
+|   drop_audit_filter_stmt
+|   drop_changefeed_stmt
+|   drop_mview_stmt
 |   drop_procedure_stmt
 
 drop_sequence_stmt:
@@ -3293,6 +3475,12 @@ drop_view_stmt:
         $$ = &tree.DropView{IfExists: $3, Names: $4}
     }
 
+drop_mview_stmt:
+    DROP MATERIALIZED VIEW exists_opt table_name
+    {
+        $$ = &tree.DropMaterializedView{IfExists: $4, Name: $5}
+    }
+
 drop_database_stmt:
     DROP DATABASE exists_opt ident
     {
@@ -4153,30 +4341,41 @@ union_op:
     }
 
 simple_select_clause:
-    SELECT distinct_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt
+    SELECT optimizer_hints_opt distinct_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt
     {
         $$ = &tree.SelectClause{
-            Distinct: $2,
-            Exprs: $3,
-            From: $4,
-            Where: $5,
-            GroupBy: $6,
-            Having: $7,
+            Distinct: $3,
+            Exprs: $4,
+            From: $5,
+            Where: $6,
+            GroupBy: $7,
+            Having: $8,
+            Hints: $2,
         }
     }
-|    SELECT select_option_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt
+|    SELECT optimizer_hints_opt select_option_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt
     {
         $$ = &tree.SelectClause{
             Distinct: false,
-            Exprs: $3,
-            From: $4,
-            Where: $5,
-            GroupBy: $6,
-            Having: $7,
-            Option: $2,
+            Exprs: $4,
+            From: $5,
+            Where: $6,
+            GroupBy: $7,
+            Having: $8,
+            Option: $3,
+            Hints: $2,
         }
     }
 
+optimizer_hints_opt:
+    {
+        $$ = nil
+    }
+|   OPTIMIZER_HINT
+    {
+        $$ = parseOptimizerHints($1, yylex.(*Lexer).lower)
+    }
+
 select_option_opt:
     SQL_SMALL_RESULT
     {
@@ -4479,6 +4678,17 @@ table_factor:
             },
         }
     }
+|   LATERAL table_subquery as_opt_id column_list_opt
+    {
+        $$ = &tree.AliasedTableExpr{
+            Expr: $2,
+            As: tree.AliasClause{
+                Alias: tree.Identifier($3),
+                Cols: $4,
+            },
+            Lateral: true,
+        }
+    }
 |   table_function as_opt_id
     {
         if $2 != "" {
@@ -4669,6 +4879,10 @@ create_stmt:
 |   create_user_stmt
 |   create_account_stmt
 |   create_publication_stmt
+|   create_policy_stmt
+|   create_audit_filter_stmt
+|   create_changefeed_stmt
+|   create_mview_stmt
 
 create_ddl_stmt:
     create_table_stmt
@@ -4853,6 +5067,22 @@ create_view_stmt:
         }
     }
 
+create_mview_stmt:
+    CREATE MATERIALIZED VIEW not_exists_opt table_name AS select_stmt
+    {
+        $$ = &tree.CreateMaterializedView{
+            Name: $5,
+            AsSource: $7,
+            IfNotExists: $4,
+        }
+    }
+
+refresh_mview_stmt:
+    REFRESH MATERIALIZED VIEW table_name
+    {
+        $$ = &tree.RefreshMaterializedView{Name: $4}
+    }
+
 create_account_stmt:
     CREATE ACCOUNT not_exists_opt account_name account_auth_option account_status_option account_comment_opt
     {
@@ -5031,6 +5261,109 @@ DROP PUBLICATION exists_opt ident
 	}
     }
 
+create_policy_stmt:
+    CREATE POLICY not_exists_opt ident ON table_name policy_command_opt TO role_spec_list USING '(' expression ')'
+    {
+	$$ = &tree.CreatePolicy{
+	    IfNotExists: $3,
+	    Name: tree.Identifier($4.Compare()),
+	    Table: $6,
+	    Command: $7,
+	    Roles: $9,
+	    Using: $12,
+	}
+    }
+
+policy_command_opt:
+    {
+	$$ = tree.PolicyCommandAll
+    }
+|   FOR ALL
+    {
+	$$ = tree.PolicyCommandAll
+    }
+|   FOR SELECT
+    {
+	$$ = tree.PolicyCommandSelect
+    }
+|   FOR UPDATE
+    {
+	$$ = tree.PolicyCommandUpdate
+    }
+|   FOR DELETE
+    {
+	$$ = tree.PolicyCommandDelete
+    }
+
+drop_policy_stmt:
+    DROP POLICY exists_opt ident ON table_name
+    {
+	$$ = &tree.DropPolicy{
+	    IfExists: $3,
+	    Name: tree.Identifier($4.Compare()),
+	    Table: $6,
+	}
+    }
+
+create_audit_filter_stmt:
+    CREATE AUDIT FILTER not_exists_opt ident EVENTS column_list audit_user_list_opt
+    {
+	$$ = &tree.CreateAuditFilter{
+	    IfNotExists: $4,
+	    Name: tree.Identifier($5.Compare()),
+	    Events: $7,
+	    Users: $8,
+	}
+    }
+
+audit_user_list_opt:
+    {
+	$$ = nil
+    }
+|   FOR USER column_list
+    {
+	$$ = $3
+    }
+
+drop_audit_filter_stmt:
+    DROP AUDIT FILTER exists_opt ident
+    {
+	$$ = &tree.DropAuditFilter{
+	    IfExists: $4,
+	    Name: tree.Identifier($5.Compare()),
+	}
+    }
+
+create_changefeed_stmt:
+    CREATE CHANGEFEED not_exists_opt ident FOR TABLE table_name_list INTO STRING changefeed_cursor_opt
+    {
+	$$ = &tree.CreateChangefeed{
+	    IfNotExists: $3,
+	    Name: tree.Identifier($4.Compare()),
+	    Tables: $7,
+	    Sink: $9,
+	    Cursor: $10,
+	}
+    }
+
+changefeed_cursor_opt:
+    {
+	$$ = ""
+    }
+|   CURSOR equal_opt STRING
+    {
+	$$ = $3
+    }
+
+drop_changefeed_stmt:
+    DROP CHANGEFEED exists_opt ident
+    {
+	$$ = &tree.DropChangefeed{
+	    IfExists: $3,
+	    Name: tree.Identifier($4.Compare()),
+	}
+    }
+
 account_role_name:
     ident
     {
@@ -5240,6 +5573,14 @@ user_identified:
         Str: $3,
     }
     }
+|   IDENTIFIED WITH name_string BY STRING
+    {
+    $$ = &tree.AccountIdentified{
+        Typ: tree.AccountIdentifiedByPassword,
+        Str: $5,
+        AuthPlugin: strings.ToLower($3),
+    }
+    }
 
 name_string:
     ident
@@ -5783,6 +6124,13 @@ cluster_by_opt:
             ColumnList : $4,
         }
     }
+|   CLUSTER BY ZORDER '(' column_name_list ')'
+    {
+        $$ = &tree.ClusterByOption{
+            ColumnList : $5,
+            ZOrder: true,
+        }
+    }
 
 sub_partition_opt:
     {
@@ -6151,6 +6499,18 @@ table_option:
     {
         $$ = &tree.TableOptionProperties{Preperties: $3}
     }
+|   TTL equal_opt column_name '+' INTERVAL INTEGRAL time_stamp_unit
+    {
+        $$ = &tree.TableOptionTTL{
+            Column: $3,
+            Interval: $6.(int64),
+            Unit: strings.ToLower($7),
+        }
+    }
+|   BLOOM_FILTER_COLUMNS equal_opt '(' column_name_list ')'
+    {
+        $$ = &tree.TableOptionBloomFilter{Columns: $4}
+    }
 // |   INSERT_METHOD equal_opt insert_method_options
 
 properties_list:
@@ -9048,6 +9408,7 @@ equal_opt:
 //|   SET
 //|   SHOW
 //|   STRAIGHT_JOIN
+//|   LATERAL
 //|   TABLE
 //|   THEN
 //|   TO
@@ -9310,6 +9671,21 @@ non_reserved_keyword:
 |   MINVALUE
 |	PROCESSLIST
 |   PUBLICATION
+|   POLICY
+|   AUDIT
+|   FILTER
+|   EXCHANGE
+|   VALIDATION
+|   WITHOUT
+|   TTL
+|   BLOOM_FILTER_COLUMNS
+|   ZORDER
+|   BACKUP
+|   RESTORE
+|   CHANGEFEED
+|   CHANGEFEEDS
+|   MATERIALIZED
+|   REFRESH
 |   SUBSCRIPTIONS
 |   PUBLICATIONS
 |   PROPERTIES
@@ -31,6 +31,8 @@ type Scanner struct {
 	posVarIndex         int
 	dialectType         dialect.DialectType
 	MysqlSpecialComment *Scanner
+	// the last token is SELECT, a /*+ ... */ comment is the optimizer hints
+	afterSelect bool
 
 	Pos    int
 	Line   int
@@ -156,6 +158,8 @@ func (s *Scanner) Scan() (int, string) {
 			case s.cur() == '!' && s.dialectType == dialect.MYSQL:
 				// TODO: ExtractMysqlComment
 				return s.scanMySQLSpecificComment()
+			case s.cur() == '+' && s.afterSelect:
+				return s.scanOptimizerHint()
 			default:
 				id, str := s.scanCommentTypeBlock()
 				if id == LEX_ERROR {
@@ -400,6 +404,16 @@ func (s *Scanner) scanCommentTypeBlock() (int, string) {
 	return COMMENT, s.buf[start:s.Pos]
 }
 
+// scanOptimizerHint scans a '/*+' delimited comment of optimizer hints, and
+// returns the text inside the comment.
+func (s *Scanner) scanOptimizerHint() (int, string) {
+	id, str := s.scanCommentTypeBlock()
+	if id == LEX_ERROR {
+		return id, str
+	}
+	return OPTIMIZER_HINT, str[3 : len(str)-2]
+}
+
 // scanMySQLSpecificComment scans a MySQL comment pragma, which always starts with '//*`
 func (s *Scanner) scanMySQLSpecificComment() (int, string) {
 	start := s.Pos - 3
@@ -71,9 +71,21 @@ var HandleSqlForRecord = func(sql string) []string {
 		scanner := mysql.NewScanner(dialect.MYSQL, split[i])
 		indexes := []int{-1}
 		eq := int('=')
+		changefeed := false
 		for scanner.Pos < len(split[i]) {
 			typ, s := scanner.Scan()
-			if typ == mysql.IDENTIFIED {
+			if typ == mysql.CHANGEFEED {
+				changefeed = true
+			} else if changefeed && typ == mysql.INTO {
+				// the password in the sink uri of the changefeed
+				typ, s = scanner.Scan()
+				if typ == mysql.STRING {
+					if begin, end, ok := tree.SinkPasswordRange(s); ok {
+						start := scanner.Pos - len(s) - 1
+						indexes = append(indexes, start+begin, start+end-1)
+					}
+				}
+			} else if typ == mysql.IDENTIFIED {
 				typ, _ = scanner.Scan()
 				if typ == mysql.BY || typ == mysql.WITH {
 					typ, s = scanner.Scan()
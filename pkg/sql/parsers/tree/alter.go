@@ -266,6 +266,87 @@ func (node *AlterOptionDrop) Format(ctx *FmtCtx) {
 	}
 }
 
+type AlterPartitionType int
+
+const (
+	AlterPartitionAdd AlterPartitionType = iota
+	AlterPartitionDrop
+	AlterPartitionTruncate
+	AlterPartitionExchange
+	AlterPartitionReorganize
+)
+
+// AlterOptionPartition is a partition maintenance operation of alter table:
+//
+//	ADD PARTITION (partition_definition [, partition_definition] ...)
+//	DROP PARTITION partition_names
+//	TRUNCATE PARTITION {partition_names | ALL}
+//	EXCHANGE PARTITION partition_name WITH TABLE tbl_name [{WITH | WITHOUT} VALIDATION]
+//	REORGANIZE PARTITION partition_names INTO (partition_definitions ...)
+type AlterOptionPartition struct {
+	alterOptionImpl
+	Typ AlterPartitionType
+	// Names are the existing partitions operated on
+	Names IdentifierList
+	// All is true for TRUNCATE PARTITION ALL
+	All bool
+	// Partitions are the partitions added or reorganized into
+	Partitions []*Partition
+	// ExchangeTable is the standalone table of EXCHANGE PARTITION
+	ExchangeTable     *TableName
+	WithoutValidation bool
+}
+
+func (node *AlterOptionPartition) Format(ctx *FmtCtx) {
+	switch node.Typ {
+	case AlterPartitionAdd:
+		ctx.WriteString("add partition ")
+		node.formatPartitions(ctx)
+	case AlterPartitionDrop:
+		ctx.WriteString("drop partition ")
+		node.Names.Format(ctx)
+	case AlterPartitionTruncate:
+		ctx.WriteString("truncate partition ")
+		if node.All {
+			ctx.WriteString("all")
+		} else {
+			node.Names.Format(ctx)
+		}
+	case AlterPartitionExchange:
+		ctx.WriteString("exchange partition ")
+		node.Names.Format(ctx)
+		ctx.WriteString(" with table ")
+		node.ExchangeTable.Format(ctx)
+		if node.WithoutValidation {
+			ctx.WriteString(" without validation")
+		}
+	case AlterPartitionReorganize:
+		ctx.WriteString("reorganize partition ")
+		node.Names.Format(ctx)
+		ctx.WriteString(" into ")
+		node.formatPartitions(ctx)
+	}
+}
+
+func (node *AlterOptionPartition) formatPartitions(ctx *FmtCtx) {
+	prefix := "("
+	for _, p := range node.Partitions {
+		ctx.WriteString(prefix)
+		p.Format(ctx)
+		prefix = ", "
+	}
+	ctx.WriteByte(')')
+}
+
+// AlterOptionCompact forces the merge (compaction) of the blocks of the table
+type AlterOptionCompact struct {
+	alterOptionImpl
+}
+
+func (node *AlterOptionCompact) Format(ctx *FmtCtx) {
+	ctx.WriteString("compact")
+}
+
 type AccountsSetOption struct {
 	All          bool
 	SetAccounts  IdentifierList
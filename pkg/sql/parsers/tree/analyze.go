@@ -14,7 +14,8 @@
 
 package tree
 
-// Use statement
+// AnalyzeStmt collects the statistics of the columns, all the columns of the
+// table if Cols is empty
 type AnalyzeStmt struct {
 	statementImpl
 	Table *TableName
@@ -24,9 +25,11 @@ type AnalyzeStmt struct {
 func (node *AnalyzeStmt) Format(ctx *FmtCtx) {
 	ctx.WriteString("analyze table ")
 	node.Table.Format(ctx)
-	ctx.WriteString("(")
-	node.Cols.Format(ctx)
-	ctx.WriteString(")")
+	if len(node.Cols) > 0 {
+		ctx.WriteString("(")
+		node.Cols.Format(ctx)
+		ctx.WriteString(")")
+	}
 }
 
 func (node *AnalyzeStmt) GetStatementType() string { return "Analyze Table" }
@@ -0,0 +1,59 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+// CreateAuditFilter decides which events of the account are audited.
+// CREATE AUDIT FILTER [IF NOT EXISTS] name EVENTS event [, event] [FOR USER user [, user]]
+type CreateAuditFilter struct {
+	statementImpl
+	IfNotExists bool
+	Name        Identifier
+	Events      IdentifierList
+	Users       IdentifierList
+}
+
+func (node *CreateAuditFilter) Format(ctx *FmtCtx) {
+	ctx.WriteString("create audit filter ")
+	if node.IfNotExists {
+		ctx.WriteString("if not exists ")
+	}
+	node.Name.Format(ctx)
+	ctx.WriteString(" events ")
+	node.Events.Format(ctx)
+	if len(node.Users) > 0 {
+		ctx.WriteString(" for user ")
+		node.Users.Format(ctx)
+	}
+}
+
+func (node *CreateAuditFilter) GetStatementType() string { return "Create Audit Filter" }
+func (node *CreateAuditFilter) GetQueryType() string     { return QueryTypeDCL }
+
+type DropAuditFilter struct {
+	statementImpl
+	IfExists bool
+	Name     Identifier
+}
+
+func (node *DropAuditFilter) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop audit filter ")
+	if node.IfExists {
+		ctx.WriteString("if exists ")
+	}
+	node.Name.Format(ctx)
+}
+
+func (node *DropAuditFilter) GetStatementType() string { return "Drop Audit Filter" }
+func (node *DropAuditFilter) GetQueryType() string     { return QueryTypeDCL }
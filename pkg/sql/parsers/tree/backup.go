@@ -0,0 +1,83 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+import "strings"
+
+// BackupTarget is where a backup is stored, a local directory of DN, or a S3
+// compatible storage if Option is not empty.
+type BackupTarget struct {
+	Dir string
+	// Option is the S3 options as key value pairs
+	Option []string
+}
+
+func (node *BackupTarget) Format(ctx *FmtCtx) {
+	if len(node.Option) == 0 {
+		ctx.WriteString("'" + node.Dir + "'")
+		return
+	}
+	ctx.WriteString("s3option {")
+	for i := 0; i < len(node.Option); i += 2 {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		switch strings.ToLower(node.Option[i]) {
+		case "access_key_id", "secret_access_key":
+			ctx.WriteString("'" + node.Option[i] + "'='******'")
+		default:
+			ctx.WriteString("'" + node.Option[i] + "'='" + node.Option[i+1] + "'")
+		}
+	}
+	ctx.WriteString("}")
+}
+
+// Backup is the BACKUP statement, which takes a physical backup of the cluster
+// into the target.
+type Backup struct {
+	statementImpl
+	BackupTarget
+}
+
+func (node *Backup) Format(ctx *FmtCtx) {
+	ctx.WriteString("backup to ")
+	node.BackupTarget.Format(ctx)
+}
+
+func (node *Backup) GetStatementType() string { return "Backup" }
+func (node *Backup) GetQueryType() string     { return QueryTypeOth }
+
+// Restore is the RESTORE statement, which restores the backup in From as of Ts
+// into To, the latest backup is restored if Ts is empty. To must not be used by
+// the running cluster.
+type Restore struct {
+	statementImpl
+	From BackupTarget
+	To   BackupTarget
+	Ts   string
+}
+
+func (node *Restore) Format(ctx *FmtCtx) {
+	ctx.WriteString("restore from ")
+	node.From.Format(ctx)
+	ctx.WriteString(" to ")
+	node.To.Format(ctx)
+	if node.Ts != "" {
+		ctx.WriteString(" timestamp '" + node.Ts + "'")
+	}
+}
+
+func (node *Restore) GetStatementType() string { return "Restore" }
+func (node *Restore) GetQueryType() string     { return QueryTypeOth }
@@ -201,6 +201,11 @@ func (node *CreateTable) Format(ctx *FmtCtx) {
 		node.PartitionOption.Format(ctx)
 	}
 
+	if node.ClusterByOption != nil {
+		ctx.WriteByte(' ')
+		node.ClusterByOption.Format(ctx)
+	}
+
 	if node.Param != nil {
 		if len(node.Param.Option) == 0 {
 			ctx.WriteString(" infile ")
@@ -1096,6 +1101,42 @@ func (node *TableOptionProperties) Format(ctx *FmtCtx) {
 	}
 }
 
+// TableOptionTTL expires the rows of the table when the value of the
+// column plus the interval is earlier than the current time.
+type TableOptionTTL struct {
+	tableOptionImpl
+	Column   *UnresolvedName
+	Interval int64
+	Unit     string
+}
+
+func (node *TableOptionTTL) Format(ctx *FmtCtx) {
+	ctx.WriteString("ttl = ")
+	node.Column.Format(ctx)
+	ctx.WriteString(" + interval ")
+	ctx.WriteString(strconv.FormatInt(node.Interval, 10))
+	ctx.WriteByte(' ')
+	ctx.WriteString(node.Unit)
+}
+
+// TableOptionBloomFilter builds the bloom filters on the columns for every
+// block of the table, so that the point lookups on them can skip blocks.
+type TableOptionBloomFilter struct {
+	tableOptionImpl
+	Columns []*UnresolvedName
+}
+
+func (node *TableOptionBloomFilter) Format(ctx *FmtCtx) {
+	ctx.WriteString("bloom_filter_columns = (")
+	for i, col := range node.Columns {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		col.Format(ctx)
+	}
+	ctx.WriteByte(')')
+}
+
 type Property struct {
 	Key   string
 	Value string
@@ -1841,6 +1882,24 @@ func NewSubPartition(n Identifier, o []TableOption) *SubPartition {
 
 type ClusterByOption struct {
 	ColumnList []*UnresolvedName
+	// ZOrder clusters the rows on the interleaved bits of the columns
+	// instead of their lexicographic order
+	ZOrder bool
+}
+
+func (node *ClusterByOption) Format(ctx *FmtCtx) {
+	ctx.WriteString("cluster by ")
+	if node.ZOrder {
+		ctx.WriteString("zorder ")
+	}
+	ctx.WriteByte('(')
+	for i, col := range node.ColumnList {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		col.Format(ctx)
+	}
+	ctx.WriteByte(')')
 }
 
 type PartitionOption struct {
@@ -2443,12 +2502,22 @@ const (
 type AccountIdentified struct {
 	Typ AccountIdentifiedOption
 	Str string
+	// AuthPlugin is the authentication plugin designated by
+	// IDENTIFIED WITH auth_plugin BY 'auth_string'. It is empty
+	// when the plugin is not designated.
+	AuthPlugin string
 }
 
 func (node *AccountIdentified) Format(ctx *FmtCtx) {
 	switch node.Typ {
 	case AccountIdentifiedByPassword:
-		ctx.WriteString(" identified by '******'")
+		if len(node.AuthPlugin) != 0 {
+			ctx.WriteString(" identified with ")
+			ctx.WriteString(node.AuthPlugin)
+			ctx.WriteString(" by '******'")
+		} else {
+			ctx.WriteString(" identified by '******'")
+		}
 	case AccountIdentifiedByRandomPassword:
 		ctx.WriteString(" identified by random password")
 	case AccountIdentifiedWithSSL:
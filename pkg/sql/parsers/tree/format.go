@@ -28,6 +28,8 @@ type FmtCtx struct {
 	// quoteString string
 	quoteString       bool
 	singleQuoteString bool
+	fingerprint       bool
+	normalize         bool
 }
 
 func NewFmtCtx(dialectType dialect.DialectType, opts ...FmtCtxOption) *FmtCtx {
@@ -58,6 +60,22 @@ func WithSingleQuoteString() FmtCtxOption {
 	})
 }
 
+// WithFingerprint quotes the strings and tags the hex and bit values with
+// their types, so that different statements never have the same text.
+func WithFingerprint() FmtCtxOption {
+	return FmtCtxOption(func(ctx *FmtCtx) {
+		ctx.fingerprint = true
+	})
+}
+
+// WithNormalize replaces the literal values except NULL and booleans with '?',
+// so that the statements differing only in their literals have the same text.
+func WithNormalize() FmtCtxOption {
+	return FmtCtxOption(func(ctx *FmtCtx) {
+		ctx.normalize = true
+	})
+}
+
 // NodeFormatter for formatted output of the node.
 type NodeFormatter interface {
 	Format(ctx *FmtCtx)
@@ -89,6 +107,24 @@ func (ctx *FmtCtx) PrintExpr(currentExpr Expr, expr Expr, left bool) {
 }
 
 func (ctx *FmtCtx) WriteValue(t P_TYPE, v string) (int, error) {
+	if ctx.normalize {
+		switch t {
+		case P_bool, P_null:
+			return ctx.WriteString(v)
+		default:
+			return ctx.WriteString("?")
+		}
+	}
+	if ctx.fingerprint {
+		switch t {
+		case P_int64, P_uint64, P_float64, P_decimal, P_bool, P_null:
+			return ctx.WriteString(v)
+		case P_char:
+			return ctx.WriteString(fmt.Sprintf("%q", v))
+		default:
+			return ctx.WriteString(fmt.Sprintf("%d:%q", t, v))
+		}
+	}
 	if ctx.quoteString {
 		switch t {
 		case P_char:
@@ -0,0 +1,81 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+// the commands that a row level security policy applies to
+const (
+	PolicyCommandAll    = "all"
+	PolicyCommandSelect = "select"
+	PolicyCommandUpdate = "update"
+	PolicyCommandDelete = "delete"
+)
+
+// CreatePolicy restricts the rows of the table that the roles can see.
+// CREATE POLICY [IF NOT EXISTS] name ON table [FOR {ALL | SELECT | UPDATE | DELETE}] TO role [, role] USING (expr)
+type CreatePolicy struct {
+	statementImpl
+	IfNotExists bool
+	Name        Identifier
+	Table       *TableName
+	Command     string
+	Roles       []*Role
+	Using       Expr
+}
+
+func (node *CreatePolicy) Format(ctx *FmtCtx) {
+	ctx.WriteString("create policy ")
+	if node.IfNotExists {
+		ctx.WriteString("if not exists ")
+	}
+	node.Name.Format(ctx)
+	ctx.WriteString(" on ")
+	node.Table.Format(ctx)
+	ctx.WriteString(" for ")
+	ctx.WriteString(node.Command)
+	ctx.WriteString(" to ")
+	prefix := ""
+	for _, r := range node.Roles {
+		ctx.WriteString(prefix)
+		r.Format(ctx)
+		prefix = ", "
+	}
+	ctx.WriteString(" using (")
+	node.Using.Format(ctx)
+	ctx.WriteByte(')')
+}
+
+func (node *CreatePolicy) GetStatementType() string { return "Create Policy" }
+func (node *CreatePolicy) GetQueryType() string     { return QueryTypeDCL }
+
+// DropPolicy removes the row level security policy of the table.
+type DropPolicy struct {
+	statementImpl
+	IfExists bool
+	Name     Identifier
+	Table    *TableName
+}
+
+func (node *DropPolicy) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop policy ")
+	if node.IfExists {
+		ctx.WriteString("if exists ")
+	}
+	node.Name.Format(ctx)
+	ctx.WriteString(" on ")
+	node.Table.Format(ctx)
+}
+
+func (node *DropPolicy) GetStatementType() string { return "Drop Policy" }
+func (node *DropPolicy) GetQueryType() string     { return QueryTypeDCL }
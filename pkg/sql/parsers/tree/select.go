@@ -200,10 +200,15 @@ type SelectClause struct {
 	GroupBy  GroupBy
 	Having   *Where
 	Option   string
+	Hints    *OptimizerHints
 }
 
 func (node *SelectClause) Format(ctx *FmtCtx) {
 	ctx.WriteString("select ")
+	if node.Hints != nil {
+		node.Hints.Format(ctx)
+		ctx.WriteByte(' ')
+	}
 	if node.Distinct {
 		ctx.WriteString("distinct ")
 	}
@@ -453,9 +458,15 @@ type AliasedTableExpr struct {
 	Expr       TableExpr
 	As         AliasClause
 	IndexHints []*IndexHint
+	// Lateral is set for a derived table declared with LATERAL, which
+	// may refer to the tables that precede it in the FROM clause.
+	Lateral bool
 }
 
 func (node *AliasedTableExpr) Format(ctx *FmtCtx) {
+	if node.Lateral {
+		ctx.WriteString("lateral ")
+	}
 	node.Expr.Format(ctx)
 	if node.As.Alias != "" {
 		ctx.WriteString(" as ")
@@ -516,6 +527,36 @@ func NewFrom(t TableExprs) *From {
 	return &From{Tables: t}
 }
 
+// OptimizerHint is a hint in the /*+ ... */ comment after SELECT, like
+// LEADING(t1 t2) or PARALLEL(4). Name is in upper case.
+type OptimizerHint struct {
+	Name string
+	Args []string
+}
+
+func (node *OptimizerHint) Format(ctx *FmtCtx) {
+	ctx.WriteString(node.Name)
+	ctx.WriteByte('(')
+	ctx.WriteString(strings.Join(node.Args, " "))
+	ctx.WriteByte(')')
+}
+
+// OptimizerHints are the optimizer hints of a query block. Invalid is the
+// text of the comment failed to parse as hints, it is ignored with a warning.
+type OptimizerHints struct {
+	Hints   []*OptimizerHint
+	Invalid string
+}
+
+func (node *OptimizerHints) Format(ctx *FmtCtx) {
+	ctx.WriteString("/*+")
+	for _, hint := range node.Hints {
+		ctx.WriteByte(' ')
+		hint.Format(ctx)
+	}
+	ctx.WriteString(" */")
+}
+
 type IndexHintType int
 
 const (
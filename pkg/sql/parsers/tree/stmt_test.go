@@ -78,6 +78,10 @@ func TestQueryType(t *testing.T) {
 					&CreatePublication{}: QueryTypeDCL,
 					&DropPublication{}:   QueryTypeDCL,
 					&AlterPublication{}:  QueryTypeDCL,
+					&CreatePolicy{}:      QueryTypeDCL,
+					&DropPolicy{}:        QueryTypeDCL,
+					&CreateAuditFilter{}: QueryTypeDCL,
+					&DropAuditFilter{}:   QueryTypeDCL,
 
 					// TCL
 					&BeginTransaction{}:    QueryTypeTCL,
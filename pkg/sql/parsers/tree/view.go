@@ -43,3 +43,57 @@ func (node *CreateView) Format(ctx *FmtCtx) {
 
 func (node *CreateView) GetStatementType() string { return "Create View" }
 func (node *CreateView) GetQueryType() string     { return QueryTypeDDL }
+
+// CreateMaterializedView creates a view whose result is stored and kept fresh
+// incrementally.
+// CREATE MATERIALIZED VIEW [IF NOT EXISTS] name AS select
+type CreateMaterializedView struct {
+	statementImpl
+	Name        *TableName
+	AsSource    *Select
+	IfNotExists bool
+}
+
+func (node *CreateMaterializedView) Format(ctx *FmtCtx) {
+	ctx.WriteString("create materialized view ")
+	if node.IfNotExists {
+		ctx.WriteString("if not exists ")
+	}
+	node.Name.Format(ctx)
+	ctx.WriteString(" as ")
+	node.AsSource.Format(ctx)
+}
+
+func (node *CreateMaterializedView) GetStatementType() string { return "Create Materialized View" }
+func (node *CreateMaterializedView) GetQueryType() string     { return QueryTypeDDL }
+
+type DropMaterializedView struct {
+	statementImpl
+	Name     *TableName
+	IfExists bool
+}
+
+func (node *DropMaterializedView) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop materialized view ")
+	if node.IfExists {
+		ctx.WriteString("if exists ")
+	}
+	node.Name.Format(ctx)
+}
+
+func (node *DropMaterializedView) GetStatementType() string { return "Drop Materialized View" }
+func (node *DropMaterializedView) GetQueryType() string     { return QueryTypeDDL }
+
+// RefreshMaterializedView rebuilds the result of the materialized view.
+type RefreshMaterializedView struct {
+	statementImpl
+	Name *TableName
+}
+
+func (node *RefreshMaterializedView) Format(ctx *FmtCtx) {
+	ctx.WriteString("refresh materialized view ")
+	node.Name.Format(ctx)
+}
+
+func (node *RefreshMaterializedView) GetStatementType() string { return "Refresh Materialized View" }
+func (node *RefreshMaterializedView) GetQueryType() string     { return QueryTypeDDL }
@@ -73,6 +73,13 @@ func replaceCol(expr *plan.Expr, oldRelPos, oldColPos, newRelPos, newColPos int3
 			exprImpl.Col.RelPos = newRelPos
 			exprImpl.Col.ColPos = newColPos
 		}
+
+	case *plan.Expr_Corr:
+		// the right child of an APPLY join refers to the columns of the left child
+		if exprImpl.Corr.RelPos == oldRelPos && exprImpl.Corr.ColPos == oldColPos {
+			exprImpl.Corr.RelPos = newRelPos
+			exprImpl.Corr.ColPos = newColPos
+		}
 	}
 }
 
@@ -0,0 +1,61 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// buildBloomFilter checks the BLOOM_FILTER_COLUMNS option of the table and
+// builds the bloom filter definition. The single column primary key always has
+// a bloom filter, so it is not allowed here, neither are the columns of types
+// whose values are not stored inline.
+func buildBloomFilter(ctx CompilerContext, opt *tree.TableOptionBloomFilter, tableDef *TableDef) (*plan.BloomFilterDef, error) {
+	cols := make([]string, 0, len(opt.Columns))
+	seen := make(map[string]struct{}, len(opt.Columns))
+	for _, name := range opt.Columns {
+		if name.NumParts != 1 {
+			return nil, moerr.NewInvalidInput(ctx.GetContext(), "invalid bloom filter column '%s'", tree.String(name, dialect.MYSQL))
+		}
+		colName := name.Parts[0]
+		var col *ColDef
+		for _, c := range tableDef.Cols {
+			if c.Name == colName && !c.Hidden {
+				col = c
+				break
+			}
+		}
+		if col == nil {
+			return nil, moerr.NewBadFieldError(ctx.GetContext(), colName, tableDef.Name)
+		}
+		if _, ok := seen[colName]; ok {
+			return nil, moerr.NewInvalidInput(ctx.GetContext(), "duplicate bloom filter column '%s'", colName)
+		}
+		if col.Primary {
+			return nil, moerr.NewInvalidInput(ctx.GetContext(), "primary key column '%s' already has a bloom filter", colName)
+		}
+		switch types.T(col.Typ.Id) {
+		case types.T_blob, types.T_text, types.T_json:
+			return nil, moerr.NewNotSupported(ctx.GetContext(), "bloom filter on column '%s' of type %s", colName, types.T(col.Typ.Id).String())
+		}
+		seen[colName] = struct{}{}
+		cols = append(cols, colName)
+	}
+	return &plan.BloomFilterDef{Cols: cols}, nil
+}
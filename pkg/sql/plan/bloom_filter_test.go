@@ -0,0 +1,51 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBloomFilter(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	sql := "create table t_bf (id int primary key, trace_id varchar(32), span_id char(16), ts timestamp) bloom_filter_columns = (trace_id, span_id)"
+	p, err := buildSingleStmt(mock, t, sql)
+	require.NoError(t, err)
+	tableDef := p.GetDdl().GetCreateTable().GetTableDef()
+	require.Equal(t, &plan.BloomFilterDef{Cols: []string{"trace_id", "span_id"}}, tableDef.BloomFilter)
+	require.Equal(t, tableDef.BloomFilter, DeepCopyTableDef(tableDef).BloomFilter)
+
+	// the parts of a composite primary key are allowed
+	sql = "create table t_bf2 (a int, b int, c int, primary key (a, b)) bloom_filter_columns (b, c)"
+	p, err = buildSingleStmt(mock, t, sql)
+	require.NoError(t, err)
+	require.Equal(t, []string{"b", "c"}, p.GetDdl().GetCreateTable().GetTableDef().BloomFilter.Cols)
+
+	sqls := []string{
+		// no such column
+		"create table t1 (a int, b int) bloom_filter_columns = (c)",
+		// duplicate column
+		"create table t1 (a int, b int) bloom_filter_columns = (b, b)",
+		// primary key
+		"create table t1 (a int primary key, b int) bloom_filter_columns = (a)",
+		// not stored inline
+		"create table t1 (a int, b text) bloom_filter_columns = (b)",
+		"create temporary table t1 (a int, b int) bloom_filter_columns = (b)",
+	}
+	runTestShouldError(mock, t, sqls)
+}
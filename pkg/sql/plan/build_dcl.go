@@ -147,16 +147,13 @@ func buildPrepare(stmt tree.Prepare, ctx CompilerContext) (*Plan, error) {
 }
 
 func buildExecute(stmt *tree.Execute, ctx CompilerContext) (*Plan, error) {
-	builder := NewQueryBuilder(plan.Query_SELECT, ctx)
-	binder := NewWhereBinder(builder, &BindContext{})
-
-	args := make([]*Expr, len(stmt.Variables))
+	variables := make([]tree.Expr, len(stmt.Variables))
 	for idx, variable := range stmt.Variables {
-		arg, err := binder.baseBindExpr(variable, 0, true)
-		if err != nil {
-			return nil, err
-		}
-		args[idx] = arg
+		variables[idx] = variable
+	}
+	args, err := BuildExecuteArgs(ctx, variables)
+	if err != nil {
+		return nil, err
 	}
 
 	execute := &plan.Execute{
@@ -176,6 +173,24 @@ func buildExecute(stmt *tree.Execute, ctx CompilerContext) (*Plan, error) {
 	}, nil
 }
 
+// BuildExecuteArgs binds the values of the params of a prepared statement,
+// which are the variables of EXECUTE or the literals of a query parameterised
+// automatically.
+func BuildExecuteArgs(ctx CompilerContext, exprs []tree.Expr) ([]*Expr, error) {
+	builder := NewQueryBuilder(plan.Query_SELECT, ctx)
+	binder := NewWhereBinder(builder, &BindContext{})
+
+	args := make([]*Expr, len(exprs))
+	for idx, expr := range exprs {
+		arg, err := binder.baseBindExpr(expr, 0, true)
+		if err != nil {
+			return nil, err
+		}
+		args[idx] = arg
+	}
+	return args, nil
+}
+
 func buildDeallocate(stmt *tree.Deallocate, _ CompilerContext) (*Plan, error) {
 	deallocate := &plan.Deallocate{
 		Name: string(stmt.Name),
@@ -335,6 +335,9 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 	for _, option := range stmt.Options {
 		switch opt := option.(type) {
 		case *tree.TableOptionProperties:
+			if _, err := buildMergeProperties(ctx, createTable.TableDef, opt.Preperties, false); err != nil {
+				return nil, err
+			}
 			properties := make([]*plan.Property, len(opt.Preperties))
 			for idx, property := range opt.Preperties {
 				properties[idx] = &plan.Property{
@@ -349,6 +352,24 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 					},
 				},
 			})
+		case *tree.TableOptionTTL:
+			if stmt.Param != nil || stmt.Temporary {
+				return nil, moerr.NewNotSupported(ctx.GetContext(), "TTL of external or temporary table")
+			}
+			ttl, err := buildTTL(ctx, opt, createTable.TableDef)
+			if err != nil {
+				return nil, err
+			}
+			createTable.TableDef.Ttl = ttl
+		case *tree.TableOptionBloomFilter:
+			if stmt.Param != nil || stmt.Temporary {
+				return nil, moerr.NewNotSupported(ctx.GetContext(), "bloom filter of external or temporary table")
+			}
+			bf, err := buildBloomFilter(ctx, opt, createTable.TableDef)
+			if err != nil {
+				return nil, err
+			}
+			createTable.TableDef.BloomFilter = bf
 		// todo confirm: option data store like this?
 		case *tree.TableOptionComment:
 			if getNumOfCharacters(opt.Comment) > maxLengthOfTableComment {
@@ -514,8 +535,24 @@ func addPartitionTableDef(ctx context.Context, mainTableName string, createTable
 		}}
 
 	partitionDef := createTable.TableDef.Partition
+	if err := setPartitionTableNames(ctx, mainTableName, partitionDef); err != nil {
+		return err
+	}
+
 	partitionTableDefs := make([]*TableDef, partitionDef.PartitionNum)
+	for i, partitionTableName := range partitionDef.PartitionTableNames {
+		partitionTableDefs[i] = &TableDef{
+			Name: partitionTableName,
+			Cols: createTable.TableDef.Cols, //same as the main table's column defs
+		}
+		partitionTableDefs[i].Defs = append(partitionTableDefs[i].Defs, partitionPropsDef)
+	}
+	createTable.PartitionTables = partitionTableDefs
+	return nil
+}
 
+// setPartitionTableNames names the hidden table of every partition after the main table
+func setPartitionTableNames(ctx context.Context, mainTableName string, partitionDef *plan.PartitionByDef) error {
 	partitionTableNames := make([]string, partitionDef.PartitionNum)
 	for i := 0; i < int(partitionDef.PartitionNum); i++ {
 		part := partitionDef.Partitions[i]
@@ -527,15 +564,8 @@ func addPartitionTableDef(ctx context.Context, mainTableName string, createTable
 		//save the table name for a partition
 		part.PartitionTableName = partitionTableName
 		partitionTableNames[i] = partitionTableName
-
-		partitionTableDefs[i] = &TableDef{
-			Name: partitionTableName,
-			Cols: createTable.TableDef.Cols, //same as the main table's column defs
-		}
-		partitionTableDefs[i].Defs = append(partitionTableDefs[i].Defs, partitionPropsDef)
 	}
 	partitionDef.PartitionTableNames = partitionTableNames
-	createTable.PartitionTables = partitionTableDefs
 	return nil
 }
 
@@ -787,7 +817,20 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 		}
 
 		clusterByColName := clusterByKeys[0]
-		if lenClusterBy == 1 {
+		if stmt.ClusterByOption.ZOrder {
+			if lenClusterBy < 2 {
+				return moerr.NewInvalidInput(ctx.GetContext(), "cluster by zorder needs at least two columns")
+			}
+			for _, key := range clusterByKeys {
+				if !isZOrderType(types.T(colMap[key].Typ.Id)) {
+					return moerr.NewNotSupported(ctx.GetContext(), "cluster by zorder on column '%s' of type %s", key, types.T(colMap[key].Typ.Id).String())
+				}
+			}
+			clusterByColName = util.BuildZOrderClusterByColumnName(clusterByKeys)
+			colDef := MakeHiddenColDefByName(clusterByColName)
+			createTable.TableDef.Cols = append(createTable.TableDef.Cols, colDef)
+			colMap[clusterByColName] = colDef
+		} else if lenClusterBy == 1 {
 			for _, col := range createTable.TableDef.Cols {
 				if col.Name == clusterByColName {
 					col.ClusterBy = true
@@ -1798,6 +1841,37 @@ func buildAlterTable(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, error)
 
 			}
 
+		case *tree.AlterOptionPartition:
+			alterPartition, err := buildAlterTablePartition(ctx, databaseName, tableDef, opt)
+			if err != nil {
+				return nil, err
+			}
+			alterTable.Actions[i] = &plan.AlterTable_Action{
+				Action: &plan.AlterTable_Action_AlterPartition{
+					AlterPartition: alterPartition,
+				},
+			}
+
+		case *tree.TableOptionProperties:
+			mergePolicy, err := buildMergeProperties(ctx, tableDef, opt.Preperties, true)
+			if err != nil {
+				return nil, err
+			}
+			alterTable.Actions[i] = &plan.AlterTable_Action{
+				Action: &plan.AlterTable_Action_MergePolicy{
+					MergePolicy: &plan.AlterTableMergePolicy{
+						MergePolicy: mergePolicy,
+					},
+				},
+			}
+
+		case *tree.AlterOptionCompact:
+			alterTable.Actions[i] = &plan.AlterTable_Action{
+				Action: &plan.AlterTable_Action_Compact{
+					Compact: &plan.AlterTableCompact{},
+				},
+			}
+
 		case *tree.AlterOptionAlterIndex:
 			alterTableIndex := new(plan.AlterTableAlterIndex)
 			constraintName := string(opt.Name)
@@ -1852,6 +1926,41 @@ func buildAlterTable(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, error)
 	}, nil
 }
 
+// buildMergeProperties checks the merge properties of the table and encodes them.
+// Only the merge properties can be altered, which replace the old ones of the table.
+func buildMergeProperties(ctx CompilerContext, tableDef *TableDef, properties []tree.Property, alter bool) (string, error) {
+	props := new(catalog.MergeProperties)
+	for _, property := range properties {
+		if !catalog.IsMergeProperty(property.Key) {
+			if alter {
+				return "", moerr.NewNotSupported(ctx.GetContext(), "alter table property '%s'", property.Key)
+			}
+			continue
+		}
+		if err := props.Set(ctx.GetContext(), property.Key, property.Value); err != nil {
+			return "", err
+		}
+	}
+	if props.TimeColumn != "" {
+		found := false
+		for _, col := range tableDef.Cols {
+			if !strings.EqualFold(col.Name, props.TimeColumn) {
+				continue
+			}
+			switch types.T(col.Typ.Id) {
+			case types.T_date, types.T_datetime, types.T_timestamp:
+				found = true
+			default:
+				return "", moerr.NewInvalidInput(ctx.GetContext(), "%s '%s' is not a date or time column", catalog.PropMergeTimeColumn, col.Name)
+			}
+		}
+		if !found {
+			return "", moerr.NewInvalidInput(ctx.GetContext(), "%s '%s' does not exist", catalog.PropMergeTimeColumn, props.TimeColumn)
+		}
+	}
+	return props.Encode(), nil
+}
+
 func buildLockTables(stmt *tree.LockTableStmt, ctx CompilerContext) (*Plan, error) {
 	lockTables := make([]*plan.TableLockInfo, 0, len(stmt.TableLocks))
 	uniqueTableName := make(map[string]bool)
@@ -29,12 +29,21 @@ func buildDelete(stmt *tree.Delete, ctx CompilerContext) (*Plan, error) {
 		return nil, err
 	}
 	builder := NewQueryBuilder(plan.Query_SELECT, ctx)
+	for _, tableDef := range tblInfo.tableDefs {
+		builder.setPolicyCommand(tableDef.TblId, tree.PolicyCommandDelete)
+	}
 
 	queryBindCtx := NewBindContext(builder, nil)
 	lastNodeId, err := deleteToSelect(builder, queryBindCtx, stmt, true, tblInfo)
 	if err != nil {
 		return nil, err
 	}
+	// the deleted rows are passed through, only the filters read the columns
+	passThrough := make(map[int32]bool)
+	for i := range builder.qry.Nodes[lastNodeId].ProjectList {
+		passThrough[int32(i)] = true
+	}
+	builder.setReadColsOfScans(lastNodeId, passThrough)
 	sourceStep := builder.appendStep(lastNodeId)
 	query, err := builder.createQuery()
 	if err != nil {
@@ -1030,3 +1030,121 @@ func appendJoinNodeForGetRowIdOfUniqueKey(builder *QueryBuilder, bindCtx *BindCo
 
 	return lastNodeId, nil
 }
+
+// setReadColsOfScans sets the visible columns read by an update or a delete
+// into its table scans, see Node.ReadCols. The project node projectId feeds
+// the dml, and its entries in passThrough are the old values of the rows
+// passed through to be deleted or rewritten, which are not read. It must be
+// called before the query is optimized, when the column refs still refer to
+// the binding tags and the filters of the table scans are only the ones added
+// by the system, like the row level security policies.
+func (builder *QueryBuilder) setReadColsOfScans(projectId int32, passThrough map[int32]bool) {
+	scans := make(map[int32]*plan.Node)
+	projects := make(map[int32]*plan.Node)
+	for _, node := range builder.qry.Nodes {
+		if len(node.BindingTags) == 0 {
+			continue
+		}
+		switch node.NodeType {
+		case plan.Node_TABLE_SCAN:
+			scans[node.BindingTags[0]] = node
+		case plan.Node_PROJECT:
+			projects[node.BindingTags[0]] = node
+		}
+	}
+
+	// the entries of the projects below only forwarding the passed through columns
+	skipped := make(map[[2]int32]bool)
+	projectNode := builder.qry.Nodes[projectId]
+	for pos := range passThrough {
+		tag, expr := projectNode.BindingTags[0], projectNode.ProjectList[pos]
+		for {
+			skipped[[2]int32{tag, pos}] = true
+			col, ok := expr.Expr.(*plan.Expr_Col)
+			if !ok {
+				break
+			}
+			project, ok := projects[col.Col.RelPos]
+			if !ok || int(col.Col.ColPos) >= len(project.ProjectList) {
+				break
+			}
+			tag, pos, expr = col.Col.RelPos, col.Col.ColPos, project.ProjectList[col.Col.ColPos]
+		}
+	}
+
+	read := make(map[[2]int32]bool)
+	var readExpr func(expr *plan.Expr)
+	readCol := func(tag, pos int32) {
+		ref := [2]int32{tag, pos}
+		if read[ref] {
+			return
+		}
+		read[ref] = true
+		if project, ok := projects[tag]; ok && int(pos) < len(project.ProjectList) {
+			readExpr(project.ProjectList[pos])
+		}
+	}
+	readExpr = func(expr *plan.Expr) {
+		if expr == nil {
+			return
+		}
+		switch exprImpl := expr.Expr.(type) {
+		case *plan.Expr_Col:
+			readCol(exprImpl.Col.RelPos, exprImpl.Col.ColPos)
+		case *plan.Expr_Corr:
+			readCol(exprImpl.Corr.RelPos, exprImpl.Corr.ColPos)
+		case *plan.Expr_F:
+			for _, arg := range exprImpl.F.Args {
+				readExpr(arg)
+			}
+		case *plan.Expr_List:
+			for _, arg := range exprImpl.List.List {
+				readExpr(arg)
+			}
+		}
+	}
+	readExprs := func(exprs []*plan.Expr) {
+		for _, expr := range exprs {
+			readExpr(expr)
+		}
+	}
+
+	for _, node := range builder.qry.Nodes {
+		for i, expr := range node.ProjectList {
+			if node.NodeType == plan.Node_PROJECT && skipped[[2]int32{node.BindingTags[0], int32(i)}] {
+				continue
+			}
+			readExpr(expr)
+		}
+		if node.NodeType != plan.Node_TABLE_SCAN {
+			readExprs(node.FilterList)
+		}
+		readExprs(node.OnList)
+		readExprs(node.GroupBy)
+		readExprs(node.GroupingSet)
+		readExprs(node.AggList)
+		readExprs(node.TblFuncExprList)
+		for _, orderBy := range node.OrderBy {
+			readExpr(orderBy.Expr)
+		}
+		if node.WinSpec != nil {
+			readExprs(node.WinSpec.PartitionBy)
+			for _, orderBy := range node.WinSpec.OrderBy {
+				readExpr(orderBy.Expr)
+			}
+		}
+		readExpr(node.Limit)
+		readExpr(node.Offset)
+	}
+
+	for tag, scan := range scans {
+		if scan.TableDef == nil {
+			continue
+		}
+		for i, col := range scan.TableDef.Cols {
+			if !col.Hidden && read[[2]int32{tag, int32(i)}] {
+				scan.ReadCols = append(scan.ReadCols, col.Name)
+			}
+		}
+	}
+}
@@ -245,6 +245,9 @@ func buildShowCreateTable(stmt *tree.ShowCreateTable, ctx CompilerContext) (*Pla
 
 	if tableDef.ClusterBy != nil {
 		clusterby := " CLUSTER BY ("
+		if util.JudgeIsZOrderClusterByColumn(tableDef.ClusterBy.Name) {
+			clusterby = " CLUSTER BY ZORDER ("
+		}
 		if util.JudgeIsCompositeClusterByColumn(tableDef.ClusterBy.Name) {
 			//multi column clusterby
 			cbNames := util.SplitCompositeClusterByColumnName(tableDef.ClusterBy.Name)
@@ -624,6 +624,48 @@ func TestInsert(t *testing.T) {
 	runTestShouldError(mock, t, sqls)
 }
 
+func TestReadColsOfDml(t *testing.T) {
+	mock := NewMockOptimizer(true)
+	tests := []struct {
+		sql      string
+		readCols map[string][]string
+	}{
+		{
+			sql:      "update nation set n_name = 'a'",
+			readCols: map[string][]string{},
+		},
+		{
+			sql:      "update nation set n_name = n_comment where n_nationkey > 10",
+			readCols: map[string][]string{"nation": {"n_nationkey", "n_comment"}},
+		},
+		{
+			sql:      "update nation set n_name = 'a' where exists (select 1 from region where r_name = n_comment)",
+			readCols: map[string][]string{"nation": {"n_comment"}, "region": {"r_name"}},
+		},
+		{
+			sql:      "update nation set n_name = 'a' order by n_regionkey limit 1",
+			readCols: map[string][]string{"nation": {"n_regionkey"}},
+		},
+		{
+			sql:      "delete from nation where n_regionkey in (select r_regionkey from region where r_comment = n_comment)",
+			readCols: map[string][]string{"nation": {"n_regionkey", "n_comment"}, "region": {"r_regionkey", "r_comment"}},
+		},
+	}
+	for _, test := range tests {
+		logicPlan, err := runOneStmt(mock, t, test.sql)
+		if err != nil {
+			t.Fatalf("%+v, sql=%v", err, test.sql)
+		}
+		readCols := make(map[string][]string)
+		for _, node := range logicPlan.GetQuery().Nodes {
+			if node.NodeType == plan.Node_TABLE_SCAN && len(node.ReadCols) != 0 {
+				readCols[node.TableDef.Name] = node.ReadCols
+			}
+		}
+		assert.Equal(t, test.readCols, readCols, test.sql)
+	}
+}
+
 func TestUpdate(t *testing.T) {
 	mock := NewMockOptimizer(true)
 	// should pass
@@ -676,6 +718,7 @@ func TestSubQuery(t *testing.T) {
 		"SELECT * FROM NATION where N_REGIONKEY not in (select max(R_REGIONKEY) from REGION)",                            // unrelated
 		"SELECT * FROM NATION where exists (select max(R_REGIONKEY) from REGION)",                                        // unrelated
 		"SELECT * FROM NATION where N_REGIONKEY > (select max(R_REGIONKEY) from REGION where R_REGIONKEY = N_REGIONKEY)", // related
+		"SELECT * FROM NATION where N_REGIONKEY > (select max(R_REGIONKEY) from REGION where R_REGIONKEY < N_REGIONKEY)", // related, applied
 		//"DELETE FROM NATION WHERE N_NATIONKEY > 10",
 		`select
 		sum(l_extendedprice) / 7.0 as avg_yearly
@@ -702,7 +745,53 @@ func TestSubQuery(t *testing.T) {
 	sqls = []string{
 		"SELECT * FROM NATION where N_REGIONKEY > (select max(R_REGIONKEY) from REGION222)",                                 // table not exist
 		"SELECT * FROM NATION where N_REGIONKEY > (select max(R_REGIONKEY) from REGION where R_REGIONKEY < N_REGIONKEY222)", // column not exist
-		"SELECT * FROM NATION where N_REGIONKEY > (select max(R_REGIONKEY) from REGION where R_REGIONKEY < N_REGIONKEY)",    // related
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+func TestLateralAndApply(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	// whether the correlated subquery has to be evaluated by an APPLY join
+	cases := []struct {
+		sql   string
+		apply bool
+	}{
+		{"select n_name, d.r_name from nation, lateral (select r_name from region where r_regionkey = n_regionkey) d", false},
+		{"select n_name, d.r_name from nation join lateral (select r_name from region where r_regionkey > n_regionkey order by r_name desc limit 2) d", true},
+		{"select n_name, d.m from nation left join lateral (select max(r_regionkey) m from region where r_regionkey > n_regionkey) d on true", true},
+		{"select n_name, d.c from nation, lateral (select count(*) c from region where r_regionkey = n_regionkey) d", true},
+		{"select n_name, (select count(*) from region where r_regionkey < n_regionkey) c from nation", true},
+		{"select n_name from nation where n_regionkey in (select r_regionkey from region where r_name > n_name limit 1)", true},
+		{"select n_name from nation where n_regionkey not in (select r_regionkey from region where r_name > n_name limit 1)", true},
+		{"select n_name from nation where exists (select 1 from region where r_regionkey = n_regionkey and exists (select 1 from supplier where s_nationkey = n_nationkey and s_suppkey > r_regionkey))", true},
+		{"select n_name from nation where exists (select 1 from region where r_regionkey = n_regionkey)", false},
+		{"select * from nation where exists (select * from (select * from region where r_regionkey = n_regionkey) d)", false},
+		{"select n_name from nation where n_regionkey in (select max(r_regionkey) from region where r_regionkey = n_regionkey)", false},
+		{"select n_name from nation where n_regionkey > any (select max(r_regionkey) from region where r_regionkey = n_regionkey)", false},
+		{"select n_name from nation where n_regionkey not in (select max(r_regionkey) from region where r_regionkey = n_regionkey)", true},
+		{"select n_name from nation where exists (select max(r_regionkey) from region where r_regionkey = n_regionkey)", true},
+	}
+	for _, c := range cases {
+		logicPlan, err := runOneStmt(mock, t, c.sql)
+		if err != nil {
+			t.Fatalf("%+v, sql=%v", err, c.sql)
+		}
+		testDeepCopy(logicPlan)
+		apply := false
+		for _, node := range logicPlan.GetQuery().Nodes {
+			if node.NodeType == plan.Node_JOIN && node.JoinType == plan.Node_APPLY {
+				apply = true
+			}
+		}
+		if apply != c.apply {
+			t.Fatalf("apply join expected %v, got %v, sql=%v", c.apply, apply, c.sql)
+		}
+	}
+
+	sqls := []string{
+		"select * from nation right join lateral (select * from region where r_regionkey > n_regionkey limit 1) d on true", // only inner and left
+		"select * from lateral (select * from region where r_regionkey = n_regionkey) d, nation",                           // nothing to refer to
+		"select * from nation, (select * from region where r_regionkey = n_regionkey) d",                                   // not lateral
 	}
 	runTestShouldError(mock, t, sqls)
 }
@@ -769,11 +858,16 @@ func TestDdl(t *testing.T) {
 		"create view v_nation as select n_nationkey,n_name,n_regionkey,n_comment from nation",
 		"CREATE TABLE t1(id INT PRIMARY KEY,name VARCHAR(25),deptId INT,CONSTRAINT fk_t1 FOREIGN KEY(deptId) REFERENCES nation(n_nationkey)) COMMENT='xxxxx'",
 		"create table t2(empno int unsigned,ename varchar(15),job varchar(10)) cluster by(empno,ename)",
+		"create table t2(empno int unsigned,ename varchar(15),hiredate date) cluster by zorder (empno,hiredate)",
 		"lock tables nation read",
 		"lock tables nation write, supplier read",
 		"unlock tables",
 		"alter table emp drop foreign key fk1",
 		"alter table nation add FOREIGN KEY fk_t1(n_nationkey) REFERENCES nation2(n_nationkey)",
+		"create table t3(a int) properties('merge_policy' = 'time_window', 'merge_window' = '1h')",
+		"alter table nation properties('merge_policy' = 'size_tiered', 'merge_min_blocks' = '5')",
+		"create table t3(a int, b datetime) properties('merge_policy' = 'time_window', 'merge_time_column' = 'B')",
+		"alter table nation compact",
 	}
 	runTestShouldPass(mock, t, sqls, false, false)
 
@@ -787,12 +881,21 @@ func TestDdl(t *testing.T) {
 		"drop table tpch.tbl_not_exist", //database not exists
 		"drop table db_not_exist.tbl",   //table not exists
 		"create table t6(empno int unsigned,ename varchar(15) auto_increment) cluster by(empno,ename)",
+		"create table t6(empno int unsigned,ename varchar(15)) cluster by zorder (empno)",
+		"create table t6(empno int unsigned,doc json) cluster by zorder (empno,doc)",
+		"create table t6(empno int unsigned primary key,ename varchar(15)) cluster by zorder (empno,ename)",
 		"lock tables t3 read",
 		"lock tables t1 read, t1 write",
 		"lock tables nation read, nation write",
 		"alter table nation drop foreign key fk1", //key not exists
 		"alter table nation add FOREIGN KEY fk_t1(col_not_exist) REFERENCES nation2(n_nationkey)",
 		"alter table nation add FOREIGN KEY fk_t1(n_nationkey) REFERENCES nation2(col_not_exist)",
+		"create table t3(a int) properties('merge_policy' = 'leveled')",
+		"alter table nation properties('merge_window' = 'an hour')",
+		"create table t3(a int, b datetime) properties('merge_time_column' = 'c')",
+		"create table t3(a int, b datetime) properties('merge_time_column' = 'a')",
+		"alter table nation properties('engine' = 'tae')",
+		"alter table tbl_not_exist compact",
 	}
 	runTestShouldError(mock, t, sqls)
 }
@@ -27,6 +27,9 @@ func buildTableUpdate(stmt *tree.Update, ctx CompilerContext) (p *Plan, err erro
 	}
 	// new logic
 	builder := NewQueryBuilder(plan.Query_SELECT, ctx)
+	for _, tableDef := range tblInfo.tableDefs {
+		builder.setPolicyCommand(tableDef.TblId, tree.PolicyCommandUpdate)
+	}
 	queryBindCtx := NewBindContext(builder, nil)
 	lastNodeId, updatePlanCtxs, err := selectUpdateTables(builder, queryBindCtx, stmt, tblInfo)
 	if err != nil {
@@ -36,6 +39,17 @@ func buildTableUpdate(stmt *tree.Update, ctx CompilerContext) (p *Plan, err erro
 	if err != nil {
 		return nil, err
 	}
+	// the old values of the columns are passed through, the new values are read
+	passThrough := make(map[int32]bool)
+	pos := 0
+	for i, tableDef := range tblInfo.tableDefs {
+		for range tableDef.Cols {
+			passThrough[int32(pos)] = true
+			pos++
+		}
+		pos += updatePlanCtxs[i].updateColLength
+	}
+	builder.setReadColsOfScans(lastNodeId, passThrough)
 
 	sourceStep := builder.appendStep(lastNodeId)
 	query, err := builder.createQuery()
@@ -30,6 +30,17 @@ func DeepCopyExprList(list []*Expr) []*Expr {
 	return newList
 }
 
+func DeepCopyRuntimeFilterSpec(spec *plan.RuntimeFilterSpec) *plan.RuntimeFilterSpec {
+	if spec == nil {
+		return nil
+	}
+	return &plan.RuntimeFilterSpec{
+		Tag:     spec.Tag,
+		Expr:    DeepCopyExpr(spec.Expr),
+		ColName: spec.ColName,
+	}
+}
+
 func DeepCopyOrderBy(orderBy *plan.OrderBySpec) *plan.OrderBySpec {
 	if orderBy == nil {
 		return nil
@@ -300,6 +311,15 @@ func DeepCopyNode(node *plan.Node) *plan.Node {
 		PreDeleteCtx:    DeepCopyPreDeleteCtx(node.PreDeleteCtx),
 	}
 
+	newNode.JoinMethod = node.JoinMethod
+	newNode.JoinDistribution = node.JoinDistribution
+	newNode.ApplyType = node.ApplyType
+	newNode.Parallelism = node.Parallelism
+	if node.ReadCols != nil {
+		newNode.ReadCols = make([]string, len(node.ReadCols))
+		copy(newNode.ReadCols, node.ReadCols)
+	}
+
 	copy(newNode.Children, node.Children)
 	copy(newNode.BindingTags, node.BindingTags)
 
@@ -335,6 +355,14 @@ func DeepCopyNode(node *plan.Node) *plan.Node {
 		newNode.TableDefVec[i] = DeepCopyTableDef(tbl)
 	}
 
+	for _, spec := range node.RuntimeFilterBuildList {
+		newNode.RuntimeFilterBuildList = append(newNode.RuntimeFilterBuildList, DeepCopyRuntimeFilterSpec(spec))
+	}
+
+	for _, spec := range node.RuntimeFilterProbeList {
+		newNode.RuntimeFilterProbeList = append(newNode.RuntimeFilterProbeList, DeepCopyRuntimeFilterSpec(spec))
+	}
+
 	if node.Stats != nil {
 		newNode.Stats = &plan.Stats{
 			BlockNum:    node.Stats.BlockNum,
@@ -537,6 +565,19 @@ func DeepCopyTableDef(table *plan.TableDef) *plan.TableDef {
 		}
 	}
 
+	if table.Ttl != nil {
+		newTable.Ttl = &plan.TTLDef{
+			Col:      table.Ttl.Col,
+			Interval: table.Ttl.Interval,
+		}
+	}
+
+	if table.BloomFilter != nil {
+		newTable.BloomFilter = &plan.BloomFilterDef{
+			Cols: append([]string(nil), table.BloomFilter.Cols...),
+		}
+	}
+
 	if table.Partition != nil {
 		partitionDef := &plan.PartitionByDef{
 			Type:                table.Partition.GetType(),
@@ -623,6 +664,7 @@ func DeepCopyQuery(qry *plan.Query) *plan.Query {
 		Nodes:    make([]*plan.Node, len(qry.Nodes)),
 		Params:   make([]*plan.Expr, len(qry.Params)),
 		Headings: qry.Headings,
+		Warnings: qry.Warnings,
 	}
 	for idx, param := range qry.Params {
 		newQry.Params[idx] = DeepCopyExpr(param)
@@ -285,6 +285,15 @@ func (ndesc *NodeDescribeImpl) GetExtraInfo(ctx context.Context, options *Explai
 		lines = append(lines, filterInfo)
 	}
 
+	// Get the partitions of the table scan
+	if ndesc.Node.NodeType == plan.Node_TABLE_SCAN && ndesc.Node.TableDef != nil && ndesc.Node.TableDef.Partition != nil {
+		partitionInfo, err := ndesc.GetPartitionInfo(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, partitionInfo)
+	}
+
 	// Get Limit And Offset info
 	if ndesc.Node.Limit != nil {
 		var temp string
@@ -328,10 +337,19 @@ func (ndesc *NodeDescribeImpl) GetProjectListInfo(ctx context.Context, options *
 }
 
 func (ndesc *NodeDescribeImpl) GetJoinTypeInfo(ctx context.Context, options *ExplainOptions) (string, error) {
-	result := "Join Type: " + ndesc.Node.JoinType.String()
+	result := "Join Type: " + joinTypeString(ndesc.Node)
 	return result, nil
 }
 
+// joinTypeString shows the join type of an APPLY join along with the way its
+// children are joined, like APPLY (LEFT).
+func joinTypeString(node *plan.Node) string {
+	if node.JoinType == plan.Node_APPLY {
+		return node.JoinType.String() + " (" + node.ApplyType.String() + ")"
+	}
+	return node.JoinType.String()
+}
+
 func (ndesc *NodeDescribeImpl) GetJoinConditionInfo(ctx context.Context, options *ExplainOptions) (string, error) {
 	result := "Join Cond: "
 	exprs := NewExprListDescribeImpl(ndesc.Node.OnList)
@@ -366,6 +384,21 @@ func (ndesc *NodeDescribeImpl) GetFilterConditionInfo(ctx context.Context, optio
 	return result, nil
 }
 
+func (ndesc *NodeDescribeImpl) GetPartitionInfo(ctx context.Context, options *ExplainOptions) (string, error) {
+	result := "Partitions: "
+	partitions := ndesc.Node.TableDef.Partition.Partitions
+	if len(partitions) == 0 {
+		return result + "none", nil
+	}
+	for i, partition := range partitions {
+		if i > 0 {
+			result += ", "
+		}
+		result += partition.PartitionName
+	}
+	return result, nil
+}
+
 func (ndesc *NodeDescribeImpl) GetGroupByInfo(ctx context.Context, options *ExplainOptions) (string, error) {
 	result := "Group Key: "
 	if options.Format == EXPLAIN_FORMAT_TEXT {
@@ -15,8 +15,12 @@
 package explain
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
+
 	"github.com/google/uuid"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
@@ -36,6 +40,9 @@ func NewExplainQueryImpl(query *plan.Query) *ExplainQueryImpl {
 }
 
 func (e *ExplainQueryImpl) ExplainPlan(ctx context.Context, buffer *ExplainDataBuffer, options *ExplainOptions) error {
+	if options.Format == EXPLAIN_FORMAT_JSON {
+		return e.explainJsonPlan(ctx, buffer, options)
+	}
 	nodes := e.QueryPlan.Nodes
 
 	isForest := false
@@ -65,6 +72,25 @@ func (e *ExplainQueryImpl) ExplainPlan(ctx context.Context, buffer *ExplainDataB
 	return nil
 }
 
+// explainJsonPlan writes the json serialized plan into the buffer as a single line.
+func (e *ExplainQueryImpl) explainJsonPlan(ctx context.Context, buffer *ExplainDataBuffer, options *ExplainOptions) error {
+	// the labels of the nodes are described in text, like the plans recorded in statement info.
+	textOptions := *options
+	textOptions.Format = EXPLAIN_FORMAT_TEXT
+	expdata := e.BuildJsonPlan(ctx, uuid.Nil, &textOptions)
+	if !expdata.Success {
+		return moerr.NewInternalError(ctx, "%s", expdata.Message)
+	}
+	data := &bytes.Buffer{}
+	encoder := json.NewEncoder(data)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(expdata.Steps); err != nil {
+		return moerr.NewInternalError(ctx, "serialize plan to json error: %s", err.Error())
+	}
+	buffer.PushLine(strings.TrimSuffix(data.String(), "\n"))
+	return nil
+}
+
 func (e *ExplainQueryImpl) BuildJsonPlan(ctx context.Context, uuid uuid.UUID, options *ExplainOptions) *ExplainData {
 	nodes := e.QueryPlan.Nodes
 	expdata := NewExplainData(uuid)
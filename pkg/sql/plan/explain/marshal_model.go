@@ -95,6 +95,8 @@ type Statistics struct {
 	Throughput []StatisticValue `json:"Throughput"`
 	IO         []StatisticValue `json:"IO"`
 	Network    []StatisticValue `json:"Network"`
+	// CN lists the addresses of the CNs the node ran on
+	CN []string `json:"CN,omitempty"`
 }
 
 type StatisticValue struct {
@@ -413,7 +413,7 @@ func (m MarshalNodeImpl) GetNodeLabels(ctx context.Context, options *ExplainOpti
 		// Get Join type
 		labels = append(labels, Label{
 			Name:  "Join type",
-			Value: m.node.JoinType.String(),
+			Value: joinTypeString(m.node),
 		})
 
 		// Get Join Condition info
@@ -573,17 +573,26 @@ const TimeConsumed = "Time Consumed"
 const WaitTime = "Wait Time"
 const ScanTime = "Scan Time"
 const InsertTime = "Insert Time"
+const WallTime = "Wall Time"
+const CpuTime = "CPU Time"
+const LockWaitTime = "Lock Wait Time"
 
 const InputRows = "Input Rows"
 const OutputRows = "Output Rows"
 const InputSize = "Input Size"
 const OutputSize = "Output Size"
 const MemorySize = "Memory Size"
+const MemoryPeak = "Memory Peak"
 const DiskIO = "Disk IO"
 const S3IOByte = "S3 IO Byte"
 const S3IOInputCount = "S3 IO Input Count"
 const S3IOOutputCount = "S3 IO Output Count"
 const Network = "Network"
+const TotalBlocks = "Total Blocks"
+const PrunedBlocks = "Pruned Blocks"
+const S3ReadCount = "S3 Read Count"
+const CacheReadCount = "Cache Read Count"
+const CacheHitCount = "Cache Hit Count"
 
 func (m MarshalNodeImpl) GetStatistics(ctx context.Context, options *ExplainOptions) Statistics {
 	statistics := NewStatistics()
@@ -610,6 +619,21 @@ func (m MarshalNodeImpl) GetStatistics(ctx context.Context, options *ExplainOpti
 				Value: analyzeInfo.InsertTime,
 				Unit:  "ns",
 			},
+			{
+				Name:  WallTime,
+				Value: analyzeInfo.TimeConsumed + analyzeInfo.WaitTimeConsumed,
+				Unit:  "ns",
+			},
+			{
+				Name:  CpuTime,
+				Value: analyzeInfo.CpuTime,
+				Unit:  "ns",
+			},
+			{
+				Name:  LockWaitTime,
+				Value: analyzeInfo.LockWaitTime,
+				Unit:  "ns",
+			},
 		}
 		mbps := []StatisticValue{
 			{
@@ -640,6 +664,11 @@ func (m MarshalNodeImpl) GetStatistics(ctx context.Context, options *ExplainOpti
 				Value: analyzeInfo.MemorySize,
 				Unit:  "byte",
 			},
+			{
+				Name:  MemoryPeak,
+				Value: analyzeInfo.MemoryPeak,
+				Unit:  "byte",
+			},
 		}
 
 		io := []StatisticValue{
@@ -663,6 +692,31 @@ func (m MarshalNodeImpl) GetStatistics(ctx context.Context, options *ExplainOpti
 				Value: analyzeInfo.S3IOOutputCount,
 				Unit:  "count",
 			},
+			{
+				Name:  TotalBlocks,
+				Value: analyzeInfo.TotalBlocks,
+				Unit:  "count",
+			},
+			{
+				Name:  PrunedBlocks,
+				Value: analyzeInfo.PrunedBlocks,
+				Unit:  "count",
+			},
+			{
+				Name:  S3ReadCount,
+				Value: analyzeInfo.S3ReadCount,
+				Unit:  "count",
+			},
+			{
+				Name:  CacheReadCount,
+				Value: analyzeInfo.CacheReadCount,
+				Unit:  "count",
+			},
+			{
+				Name:  CacheHitCount,
+				Value: analyzeInfo.CacheHitCount,
+				Unit:  "count",
+			},
 		}
 
 		nw := []StatisticValue{
@@ -678,6 +732,7 @@ func (m MarshalNodeImpl) GetStatistics(ctx context.Context, options *ExplainOpti
 		statistics.Memory = append(statistics.Memory, mems...)
 		statistics.IO = append(statistics.IO, io...)
 		statistics.Network = append(statistics.Network, nw...)
+		statistics.CN = append(statistics.CN, analyzeInfo.CnAddrs...)
 	}
 	return *statistics
 }
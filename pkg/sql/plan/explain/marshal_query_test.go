@@ -228,6 +228,78 @@ func TestDMLToJson(t *testing.T) {
 	buildPlanMarshalTest(mock, t, sqls)
 }
 
+func TestExplainAnalyzeJson(t *testing.T) {
+	mock := plan.NewMockOptimizer(true)
+	logicPlan, err := runSingleSql(mock, t, "SELECT N_NAME FROM NATION WHERE N_REGIONKEY > 0")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	queryPlan := logicPlan.GetQuery()
+	for _, node := range queryPlan.Nodes {
+		node.AnalyzeInfo = &plan2.AnalyzeInfo{
+			InputRows:        12,
+			OutputRows:       6,
+			TimeConsumed:     5,
+			WaitTimeConsumed: 3,
+			CpuTime:          4,
+			MemoryPeak:       1024,
+			TotalBlocks:      10,
+			PrunedBlocks:     7,
+			S3ReadCount:      2,
+			CacheReadCount:   4,
+			CacheHitCount:    3,
+			LockWaitTime:     2,
+			CnAddrs:          []string{"cn1:6001", "cn2:6001"},
+		}
+	}
+
+	buffer := NewExplainDataBuffer()
+	options := &ExplainOptions{
+		Analyze: true,
+		Format:  EXPLAIN_FORMAT_JSON,
+	}
+	if err = NewExplainQueryImpl(queryPlan).ExplainPlan(context.TODO(), buffer, options); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if len(buffer.Lines) != 1 {
+		t.Fatalf("expect one line, got %d", len(buffer.Lines))
+	}
+	var steps []Step
+	if err = json.Unmarshal([]byte(buffer.Lines[0]), &steps); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if len(steps) != 1 || len(steps[0].GraphData.Nodes) == 0 {
+		t.Fatalf("unexpected plan: %s", buffer.Lines[0])
+	}
+
+	values := make(map[string]int64)
+	statistics := steps[0].GraphData.Nodes[0].Statistics
+	for _, list := range [][]StatisticValue{statistics.Time, statistics.Memory, statistics.IO} {
+		for _, v := range list {
+			values[v.Name] = v.Value
+		}
+	}
+	expected := map[string]int64{
+		WallTime:       8,
+		CpuTime:        4,
+		MemoryPeak:     1024,
+		TotalBlocks:    10,
+		PrunedBlocks:   7,
+		S3ReadCount:    2,
+		CacheReadCount: 4,
+		CacheHitCount:  3,
+		LockWaitTime:   2,
+	}
+	for name, value := range expected {
+		if values[name] != value {
+			t.Errorf("%s: expect %d, got %d", name, value, values[name])
+		}
+	}
+	if len(statistics.CN) != 2 || statistics.CN[0] != "cn1:6001" || statistics.CN[1] != "cn2:6001" {
+		t.Errorf("unexpected cn list %v", statistics.CN)
+	}
+}
+
 func buildPlanMarshalTest(opt plan.Optimizer, t *testing.T, sqls []string) {
 	ctx := context.TODO()
 	for _, sql := range sqls {
@@ -108,6 +108,16 @@ func (buf *ExplainDataBuffer) PushNewLine(line string, isNewNode bool, level int
 	buf.End++
 }
 
+// PushLine appends a line as it is, without indentation.
+func (buf *ExplainDataBuffer) PushLine(line string) {
+	if buf.Start == -1 {
+		buf.Start++
+	}
+	buf.CurrentLine++
+	buf.Lines = append(buf.Lines, line)
+	buf.End++
+}
+
 func (buf *ExplainDataBuffer) PushPlanTitle(title string) {
 	if buf.Start == -1 {
 		buf.Start++
@@ -117,21 +117,17 @@ func (builder *QueryBuilder) flattenSubquery(nodeID int32, subquery *plan.Subque
 		}
 	}
 
+	if !builder.canPullupCorrelatedPredicates(subID, dependsOnEmptyGroup(subquery.Typ)) {
+		return builder.applySubquery(nodeID, subquery, ctx)
+	}
+
 	subID, preds, err := builder.pullupCorrelatedPredicates(subID, subCtx)
 	if err != nil {
 		return 0, nil, err
 	}
 
-	if subquery.Typ == plan.SubqueryRef_SCALAR && len(subCtx.aggregates) > 0 && builder.findNonEqPred(preds) {
-		return 0, nil, moerr.NewNYI(builder.GetContext(), "aggregation with non equal predicate in %s subquery  will be supported in future version", subquery.Typ.String())
-	}
-
 	filterPreds, joinPreds := decreaseDepthAndDispatch(preds)
 
-	if len(filterPreds) > 0 && subquery.Typ >= plan.SubqueryRef_SCALAR {
-		return 0, nil, moerr.NewNYI(builder.GetContext(), "correlated columns in %s subquery deeper than 1 level will be supported in future version", subquery.Typ.String())
-	}
-
 	switch subquery.Typ {
 	case plan.SubqueryRef_SCALAR:
 		var rewrite bool
@@ -260,6 +256,94 @@ func (builder *QueryBuilder) flattenSubquery(nodeID int32, subquery *plan.Subque
 	}
 }
 
+// applySubquery joins the subquery to the outer query by an APPLY join, which
+// evaluates the subquery for each row of the outer query with the correlated
+// columns bound to the values of the row.
+func (builder *QueryBuilder) applySubquery(nodeID int32, subquery *plan.SubqueryRef, ctx *BindContext) (int32, *plan.Expr, error) {
+	subID := subquery.NodeId
+	subCtx := builder.ctxByNode[subID]
+
+	switch subquery.Typ {
+	case plan.SubqueryRef_SCALAR:
+		applyType := plan.Node_SINGLE
+		if subCtx.hasSingleRow {
+			applyType = plan.Node_LEFT
+		}
+
+		nodeID = builder.appendNode(&plan.Node{
+			NodeType:  plan.Node_JOIN,
+			Children:  []int32{nodeID, subID},
+			JoinType:  plan.Node_APPLY,
+			ApplyType: applyType,
+		}, ctx)
+
+		return nodeID, &plan.Expr{
+			Typ: subCtx.results[0].Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: subCtx.rootTag(),
+					ColPos: 0,
+				},
+			},
+		}, nil
+
+	case plan.SubqueryRef_EXISTS, plan.SubqueryRef_NOT_EXISTS:
+		// one row is enough to tell whether the subquery is empty
+		subNode := builder.qry.Nodes[subID]
+		if subNode.NodeType == plan.Node_PROJECT && subNode.Limit == nil && subNode.Offset == nil {
+			subNode.Limit = makePlan2Int64ConstExprWithType(1)
+		}
+
+		return builder.insertMarkApply(nodeID, subID, nil, subquery.Typ == plan.SubqueryRef_NOT_EXISTS, ctx)
+
+	case plan.SubqueryRef_IN, plan.SubqueryRef_NOT_IN:
+		outerPred, err := builder.generateRowComparison("=", subquery.Child, subCtx, false)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		return builder.insertMarkApply(nodeID, subID, outerPred, subquery.Typ == plan.SubqueryRef_NOT_IN, ctx)
+
+	case plan.SubqueryRef_ANY:
+		outerPred, err := builder.generateRowComparison(subquery.Op, subquery.Child, subCtx, false)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		return builder.insertMarkApply(nodeID, subID, outerPred, false, ctx)
+
+	case plan.SubqueryRef_ALL:
+		outerPred, err := builder.generateRowComparison(subquery.Op, subquery.Child, subCtx, false)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		outerPred, err = bindFuncExprImplByPlanExpr(builder.GetContext(), "not", []*plan.Expr{outerPred})
+		if err != nil {
+			return 0, nil, err
+		}
+
+		return builder.insertMarkApply(nodeID, subID, outerPred, true, ctx)
+
+	default:
+		return 0, nil, moerr.NewNotSupported(builder.GetContext(), "%s subquery not supported", subquery.Typ.String())
+	}
+}
+
+// insertMarkApply is insertMarkJoin with the MARK join evaluated by an APPLY join.
+func (builder *QueryBuilder) insertMarkApply(left, right int32, outerPred *plan.Expr, negate bool, ctx *BindContext) (int32, *plan.Expr, error) {
+	nodeID, markExpr, err := builder.insertMarkJoin(left, right, nil, outerPred, negate, ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	node := builder.qry.Nodes[nodeID]
+	node.JoinType = plan.Node_APPLY
+	node.ApplyType = plan.Node_MARK
+
+	return nodeID, markExpr, nil
+}
+
 func (builder *QueryBuilder) insertMarkJoin(left, right int32, joinPreds []*plan.Expr, outerPred *plan.Expr, negate bool, ctx *BindContext) (nodeID int32, markExpr *plan.Expr, err error) {
 	markTag := builder.genNewTag()
 
@@ -427,6 +511,119 @@ func (builder *QueryBuilder) findNonEqPred(preds []*plan.Expr) bool {
 	return false
 }
 
+// canPullupCorrelatedPredicates reports whether all the correlated columns of
+// the subquery are in the predicates pullupCorrelatedPredicates lifts, and the
+// subquery keeps its result when they are evaluated by the join with the outer
+// query. emptyGroup tells whether the result depends on the row an aggregation
+// without GROUP BY gives for the rows of the outer query matching nothing.
+func (builder *QueryBuilder) canPullupCorrelatedPredicates(nodeID int32, emptyGroup bool) bool {
+	_, _, ok := builder.checkCorrelatedPredicates(nodeID, emptyGroup)
+	return ok
+}
+
+// dependsOnEmptyGroup tells whether the result of the subquery differs between
+// the row of NULLs an aggregation without GROUP BY gives and no row at all. IN
+// and ANY are not true either way, and the scalar subquery gives NULL either way
+// except for COUNT, which flattenSubquery rewrites.
+func dependsOnEmptyGroup(typ plan.SubqueryRef_Type) bool {
+	switch typ {
+	case plan.SubqueryRef_NOT_IN, plan.SubqueryRef_ALL, plan.SubqueryRef_EXISTS, plan.SubqueryRef_NOT_EXISTS:
+		return true
+	}
+	return false
+}
+
+// checkCorrelatedPredicates returns whether predicates are lifted from the
+// subtree of nodeID, whether any of them is not an equality, and whether they
+// can be lifted at all.
+func (builder *QueryBuilder) checkCorrelatedPredicates(nodeID int32, emptyGroup bool) (lifted, nonEq, ok bool) {
+	node := builder.qry.Nodes[nodeID]
+
+	var maxOuter int32
+	visit := func(expr *plan.Expr, corr *plan.CorrColRef, outer int32) (*plan.Expr, error) {
+		if outer > maxOuter {
+			maxOuter = outer
+		}
+		return expr, nil
+	}
+
+	if node.NodeType == plan.Node_FILTER {
+		for _, cond := range node.FilterList {
+			maxOuter = 0
+			_, _ = walkExprCorrColRefs(cond, 0, visit)
+			if maxOuter == 0 {
+				continue
+			}
+			// only the columns of the outer query can be bound by the join
+			if maxOuter > 1 || !hasCorrCol(cond) {
+				return false, false, false
+			}
+			lifted = true
+			nonEq = nonEq || builder.findNonEqPred([]*plan.Expr{cond})
+		}
+	} else {
+		_ = walkNodeCorrColRefs(node, 0, visit)
+		if maxOuter > 0 {
+			return false, false, false
+		}
+	}
+
+	var childLifted, childNonEq bool
+	for i, childID := range node.Children {
+		if i == 1 && node.NodeType == plan.Node_JOIN && node.JoinType == plan.Node_APPLY {
+			// the right child of an APPLY join may only refer to its left child
+			maxOuter = 0
+			_ = walkCorrColRefs(builder.qry.Nodes, childID, 1, visit)
+			if maxOuter > 0 {
+				return false, false, false
+			}
+			continue
+		}
+
+		subLifted, subNonEq, subOk := builder.checkCorrelatedPredicates(childID, emptyGroup)
+		if !subOk {
+			return false, false, false
+		}
+		if subLifted && i > 0 && (node.NodeType != plan.Node_JOIN || node.JoinType != plan.Node_INNER) {
+			return false, false, false
+		}
+		childLifted = childLifted || subLifted
+		childNonEq = childNonEq || subNonEq
+	}
+
+	if childLifted {
+		switch node.NodeType {
+		case plan.Node_FILTER:
+			// lifted along with the predicates of the filter
+
+		case plan.Node_PROJECT, plan.Node_SORT:
+			if node.Limit != nil || node.Offset != nil {
+				return false, false, false
+			}
+
+		case plan.Node_AGG:
+			// the aggregations would be grouped by the columns of the
+			// predicates, and an aggregation without GROUP BY gives one row
+			// even for the rows of the outer query matching nothing
+			if childNonEq || (emptyGroup && len(node.GroupBy) == 0) {
+				return false, false, false
+			}
+
+		case plan.Node_JOIN:
+			switch node.JoinType {
+			case plan.Node_INNER, plan.Node_LEFT, plan.Node_SEMI, plan.Node_ANTI, plan.Node_SINGLE, plan.Node_MARK, plan.Node_APPLY:
+			default:
+				return false, false, false
+			}
+
+		default:
+			return false, false, false
+		}
+	}
+
+	return lifted || childLifted, nonEq || childNonEq, true
+}
+
 func (builder *QueryBuilder) pullupCorrelatedPredicates(nodeID int32, ctx *BindContext) (int32, []*plan.Expr, error) {
 	node := builder.qry.Nodes[nodeID]
 
@@ -435,6 +632,12 @@ func (builder *QueryBuilder) pullupCorrelatedPredicates(nodeID int32, ctx *BindC
 
 	var subPreds []*plan.Expr
 	for i, childID := range node.Children {
+		// the right child of an APPLY join is evaluated with the columns of
+		// the left child bound, its predicates stay where they are
+		if i == 1 && node.NodeType == plan.Node_JOIN && node.JoinType == plan.Node_APPLY {
+			continue
+		}
+
 		node.Children[i], subPreds, err = builder.pullupCorrelatedPredicates(childID, ctx)
 		if err != nil {
 			return 0, nil, err
@@ -0,0 +1,80 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ctl
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/ctl"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// Backup asks DN to backup its data to the file service described by the request,
+// and returns the summaries of the backups.
+func Backup(proc *process.Process, req db.Backup) ([]string, error) {
+	return handleBackupCmd(proc, pb.CmdMethod_Backup, func(info db.AccessInfo) ([]byte, error) {
+		req.AccessInfo = info
+		return types.Encode(&req)
+	})
+}
+
+// Restore asks DN to restore the backup in req.Src into req.Dst, and returns the
+// summaries of the restored backups.
+func Restore(proc *process.Process, req db.RestoreReq) ([]string, error) {
+	return handleBackupCmd(proc, pb.CmdMethod_Restore, func(info db.AccessInfo) ([]byte, error) {
+		req.AccessInfo = info
+		return types.Encode(&req)
+	})
+}
+
+func handleBackupCmd(
+	proc *process.Process,
+	method pb.CmdMethod,
+	encode func(db.AccessInfo) ([]byte, error)) ([]string, error) {
+	f := getDNHandlerFunc(
+		method,
+		func(_ string) ([]uint64, error) {
+			return nil, nil
+		},
+		func(dnShardID uint64, parameter string, proc *process.Process) ([]byte, error) {
+			payload, err := encode(db.AccessInfo{
+				AccountID: proc.SessionInfo.AccountId,
+				UserID:    proc.SessionInfo.UserId,
+				RoleID:    proc.SessionInfo.RoleId,
+			})
+			if err != nil {
+				return nil, moerr.NewInternalError(proc.Ctx, "payload encode err")
+			}
+			return payload, nil
+		},
+		func(data []byte) (interface{}, error) {
+			resp := &db.InspectResp{}
+			if err := types.Decode(data, resp); err != nil {
+				return nil, err
+			}
+			return resp.Message, nil
+		})
+	result, err := f(proc, dn, "", newTxnRequestSender(proc))
+	if err != nil {
+		return nil, err
+	}
+	results := result.Data.([]interface{})
+	messages := make([]string, 0, len(results))
+	for _, r := range results {
+		messages = append(messages, r.(string))
+	}
+	return messages, nil
+}
@@ -35,42 +35,12 @@ func handleFlush() handleFunc {
 		},
 		func(dnShardID uint64, parameter string, proc *process.Process) ([]byte, error) {
 			// parameter should be "DbName.TableName"
-			parameters := strings.Split(parameter, ".")
-			txnOp := proc.TxnOperator
-			if proc.TxnOperator == nil {
-				v, err := proc.TxnClient.New(proc.Ctx, timestamp.Timestamp{})
-				if err != nil {
-					return nil, err
-				}
-				txnOp = v
-				if err = proc.SessionInfo.StorageEngine.New(proc.Ctx, txnOp); err != nil {
-					return nil, err
-				}
-
-				defer func() {
-					if err := proc.SessionInfo.StorageEngine.Commit(proc.Ctx, txnOp); err != nil {
-						_ = txnOp.Rollback(proc.Ctx)
-					} else {
-						_ = txnOp.Commit(proc.Ctx)
-					}
-				}()
-			}
-			database, err := proc.SessionInfo.StorageEngine.Database(proc.Ctx, parameters[0], txnOp)
-			if err != nil {
-				return nil, err
-			}
-			rel, err := database.Relation(proc.Ctx, parameters[1])
-			if err != nil {
-				return nil, err
-			}
-			dId := database.GetDatabaseId(proc.Ctx)
-			tableId := rel.GetTableID(proc.Ctx)
-			dbId, err := strconv.Atoi(dId)
+			dbId, tableId, err := getDatabaseAndTableID(proc, parameter)
 			if err != nil {
 				return nil, err
 			}
 			payload, err := types.Encode(&db.FlushTable{
-				DatabaseID: uint64(dbId),
+				DatabaseID: dbId,
 				TableID:    tableId,
 				AccessInfo: db.AccessInfo{
 					AccountID: proc.SessionInfo.AccountId,
@@ -89,3 +59,43 @@ func handleFlush() handleFunc {
 			return resp, nil
 		})
 }
+
+// getDatabaseAndTableID resolves the ids of the table by parameter "DbName.TableName"
+func getDatabaseAndTableID(proc *process.Process, parameter string) (uint64, uint64, error) {
+	parameters := strings.Split(parameter, ".")
+	if len(parameters) != 2 {
+		return 0, 0, moerr.NewInvalidInput(proc.Ctx, "parameter should be DbName.TableName, got '%s'", parameter)
+	}
+	txnOp := proc.TxnOperator
+	if proc.TxnOperator == nil {
+		v, err := proc.TxnClient.New(proc.Ctx, timestamp.Timestamp{})
+		if err != nil {
+			return 0, 0, err
+		}
+		txnOp = v
+		if err = proc.SessionInfo.StorageEngine.New(proc.Ctx, txnOp); err != nil {
+			return 0, 0, err
+		}
+
+		defer func() {
+			if err := proc.SessionInfo.StorageEngine.Commit(proc.Ctx, txnOp); err != nil {
+				_ = txnOp.Rollback(proc.Ctx)
+			} else {
+				_ = txnOp.Commit(proc.Ctx)
+			}
+		}()
+	}
+	database, err := proc.SessionInfo.StorageEngine.Database(proc.Ctx, parameters[0], txnOp)
+	if err != nil {
+		return 0, 0, err
+	}
+	rel, err := database.Relation(proc.Ctx, parameters[1])
+	if err != nil {
+		return 0, 0, err
+	}
+	dbId, err := strconv.Atoi(database.GetDatabaseId(proc.Ctx))
+	if err != nil {
+		return 0, 0, err
+	}
+	return uint64(dbId), rel.GetTableID(proc.Ctx), nil
+}
@@ -0,0 +1,73 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ctl
+
+import (
+	"github.com/fagongzi/util/protoc"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/ctl"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func handleMerge() handleFunc {
+	return getMergeHandlerFunc(func(parameter string, proc *process.Process) (uint64, uint64, error) {
+		// parameter should be "DbName.TableName"
+		return getDatabaseAndTableID(proc, parameter)
+	})
+}
+
+// MergeTable forces DN to merge the blocks of the table in its next scan round,
+// regardless of the merge policy of the table.
+func MergeTable(proc *process.Process, dbId, tableId uint64) error {
+	f := getMergeHandlerFunc(func(_ string, _ *process.Process) (uint64, uint64, error) {
+		return dbId, tableId, nil
+	})
+	_, err := f(proc, dn, "", newTxnRequestSender(proc))
+	return err
+}
+
+func getMergeHandlerFunc(getTable func(parameter string, proc *process.Process) (uint64, uint64, error)) handleFunc {
+	return getDNHandlerFunc(
+		pb.CmdMethod_Merge,
+		func(_ string) ([]uint64, error) {
+			return nil, nil
+		},
+		func(dnShardID uint64, parameter string, proc *process.Process) ([]byte, error) {
+			dbId, tableId, err := getTable(parameter, proc)
+			if err != nil {
+				return nil, err
+			}
+			payload, err := types.Encode(&db.MergeTable{
+				DatabaseID: dbId,
+				TableID:    tableId,
+				AccessInfo: db.AccessInfo{
+					AccountID: proc.SessionInfo.AccountId,
+					UserID:    proc.SessionInfo.UserId,
+					RoleID:    proc.SessionInfo.RoleId,
+				},
+			})
+			if err != nil {
+				return nil, moerr.NewInternalError(proc.Ctx, "payload encode err")
+			}
+			return payload, nil
+		},
+		func(data []byte) (interface{}, error) {
+			resp := pb.DNStringResponse{}
+			protoc.MustUnmarshal(&resp, data)
+			return resp, nil
+		})
+}
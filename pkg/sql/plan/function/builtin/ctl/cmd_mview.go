@@ -0,0 +1,54 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ctl
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/ctl"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// MView asks DN to create, drop or refresh the maintenance of a materialized
+// view in the account of the session.
+func MView(proc *process.Process, req db.MView) error {
+	f := getDNHandlerFunc(
+		pb.CmdMethod_MView,
+		func(_ string) ([]uint64, error) {
+			return nil, nil
+		},
+		func(dnShardID uint64, parameter string, proc *process.Process) ([]byte, error) {
+			req.AccessInfo = db.AccessInfo{
+				AccountID: proc.SessionInfo.AccountId,
+				UserID:    proc.SessionInfo.UserId,
+				RoleID:    proc.SessionInfo.RoleId,
+			}
+			payload, err := types.Encode(&req)
+			if err != nil {
+				return nil, moerr.NewInternalError(proc.Ctx, "payload encode err")
+			}
+			return payload, nil
+		},
+		func(data []byte) (interface{}, error) {
+			resp := &db.InspectResp{}
+			if err := types.Decode(data, resp); err != nil {
+				return nil, err
+			}
+			return resp.Message, nil
+		})
+	_, err := f(proc, dn, "", newTxnRequestSender(proc))
+	return err
+}
@@ -54,47 +54,7 @@ func Handler(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error)
 	result, err := f(proc,
 		service,
 		parameter,
-		// We use a transaction client to send debug requests with the following in mind.
-		// 1. reuse the RPC mechanism of cn and dn
-		// 2. may support debug support for transactions in the future, such as testing the
-		//    correctness of the transaction by forcing the timestamp of the transaction to
-		//    be modified, etc.
-		// TODO: add more ut tests for this.
-		func(ctx context.Context, requests []txn.CNOpRequest) ([]txn.CNOpResponse, error) {
-			txnOp := proc.TxnOperator
-			if txnOp == nil {
-				v, err := proc.TxnClient.New(proc.Ctx, timestamp.Timestamp{})
-				if err != nil {
-					return nil, err
-				}
-				txnOp = v
-				defer func() {
-					_ = txnOp.Commit(proc.Ctx)
-				}()
-			}
-			op, ok := txnOp.(client.DebugableTxnOperator)
-			if !ok {
-				return nil, moerr.NewNotSupported(proc.Ctx, "debug function not supported")
-			}
-
-			debugRequests := make([]txn.TxnRequest, 0, len(requests))
-			for _, req := range requests {
-				tq := txn.NewTxnRequest(&req)
-				tq.Method = txn.TxnMethod_DEBUG
-				debugRequests = append(debugRequests, tq)
-			}
-			result, err := op.Debug(ctx, debugRequests)
-			if err != nil {
-				return nil, err
-			}
-			defer result.Release()
-
-			responses := make([]txn.CNOpResponse, 0, len(requests))
-			for _, resp := range result.Responses {
-				responses = append(responses, *resp.CNOpResponse)
-			}
-			return responses, nil
-		})
+		newTxnRequestSender(proc))
 	if err != nil {
 		return nil, err
 	}
@@ -105,3 +65,47 @@ func Handler(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error)
 	}
 	return value, nil
 }
+
+func newTxnRequestSender(proc *process.Process) requestSender {
+	// We use a transaction client to send debug requests with the following in mind.
+	// 1. reuse the RPC mechanism of cn and dn
+	// 2. may support debug support for transactions in the future, such as testing the
+	//    correctness of the transaction by forcing the timestamp of the transaction to
+	//    be modified, etc.
+	// TODO: add more ut tests for this.
+	return func(ctx context.Context, requests []txn.CNOpRequest) ([]txn.CNOpResponse, error) {
+		txnOp := proc.TxnOperator
+		if txnOp == nil {
+			v, err := proc.TxnClient.New(proc.Ctx, timestamp.Timestamp{})
+			if err != nil {
+				return nil, err
+			}
+			txnOp = v
+			defer func() {
+				_ = txnOp.Commit(proc.Ctx)
+			}()
+		}
+		op, ok := txnOp.(client.DebugableTxnOperator)
+		if !ok {
+			return nil, moerr.NewNotSupported(proc.Ctx, "debug function not supported")
+		}
+
+		debugRequests := make([]txn.TxnRequest, 0, len(requests))
+		for _, req := range requests {
+			tq := txn.NewTxnRequest(&req)
+			tq.Method = txn.TxnMethod_DEBUG
+			debugRequests = append(debugRequests, tq)
+		}
+		result, err := op.Debug(ctx, debugRequests)
+		if err != nil {
+			return nil, err
+		}
+		defer result.Release()
+
+		responses := make([]txn.CNOpResponse, 0, len(requests))
+		for _, resp := range result.Responses {
+			responses = append(responses, *resp.CNOpResponse)
+		}
+		return responses, nil
+	}
+}
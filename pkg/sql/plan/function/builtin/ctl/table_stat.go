@@ -298,6 +298,59 @@ func MoTableColMin(vecs []*vector.Vector, proc *process.Process) (*vector.Vector
 	return resultVec, nil
 }
 
+// MoTableClusteringDepth returns the clustering depth of a table: the average
+// number of blocks whose zonemaps on the clustering columns overlap those of a
+// block.
+func MoTableClusteringDepth(vecs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	count := vecs[0].Length()
+	dbs := vector.MustStrCol(vecs[0])
+	tbls := vector.MustStrCol(vecs[1])
+
+	rtyp := types.T_float64.ToType()
+	if vecs[0].IsConstNull() || vecs[1].IsConstNull() {
+		return vector.NewConstNull(rtyp, count, proc.Mp()), nil
+	}
+	resultNsp := nulls.NewWithSize(count)
+	nulls.Or(vecs[0].GetNulls(), vecs[1].GetNulls(), resultNsp)
+
+	e := proc.Ctx.Value(defines.EngineKey{}).(engine.Engine)
+	txn, err := proc.TxnClient.New(proc.Ctx, timestamp.Timestamp{})
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Rollback(proc.Ctx)
+	if err := e.New(proc.Ctx, txn); err != nil {
+		return nil, err
+	}
+	defer e.Rollback(proc.Ctx, txn)
+
+	rvals := make([]float64, count)
+	for i := 0; i < count; i++ {
+		if resultNsp.Contains(uint64(i)) {
+			continue
+		}
+		db, err := e.Database(proc.Ctx, dbs[i], txn)
+		if err != nil {
+			return nil, err
+		}
+		rel, err := db.Relation(proc.Ctx, tbls[i])
+		if err != nil {
+			return nil, err
+		}
+		rel.Ranges(proc.Ctx, nil)
+		if rvals[i], err = rel.ClusteringDepth(proc.Ctx); err != nil {
+			return nil, err
+		}
+	}
+	resultVec := vector.NewVec(rtyp)
+	if err := vector.AppendFixedList(resultVec, rvals, nil, proc.Mp()); err != nil {
+		resultVec.Free(proc.Mp())
+		return nil, err
+	}
+	resultVec.SetNulls(resultNsp)
+	return resultVec, nil
+}
+
 func getValueInStr(value any) string {
 	switch v := value.(type) {
 	case bool:
@@ -392,5 +445,14 @@ var (
 		catalog.AutoIncrTableName:     0,
 		"mo_indexes":                  0,
 		"mo_pubs":                     0,
+		"mo_user_password_policy":     0,
+		"mo_user_password_history":    0,
+		"mo_role_column_privs":        0,
+		"mo_row_policies":             0,
+		"mo_audit_filters":            0,
+		"mo_mviews":                   0,
+		"mo_column_stats":             0,
+		"mo_account_variables":        0,
+		"mo_slow_query_log":           0,
 	}
 )
@@ -88,3 +88,35 @@ func TestMoTableRowsAndTableSize(t *testing.T) {
 	require.Equal(t, "120", size.String())
 
 }
+
+func TestMoTableClusteringDepth(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	proc := testutil.NewProc()
+	txnOperator := mock_frontend.NewMockTxnOperator(ctrl)
+	txnOperator.EXPECT().Rollback(gomock.Any()).Return(nil).AnyTimes()
+	txnClient := mock_frontend.NewMockTxnClient(ctrl)
+	txnClient.EXPECT().New(gomock.Any(), gomock.Any()).Return(txnOperator, nil).AnyTimes()
+
+	table := mock_frontend.NewMockRelation(ctrl)
+	table.EXPECT().Ranges(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	table.EXPECT().ClusteringDepth(gomock.Any()).Return(1.5, nil).AnyTimes()
+	db := mock_frontend.NewMockDatabase(ctrl)
+	db.EXPECT().Relation(gomock.Any(), gomock.Any()).Return(table, nil).AnyTimes()
+
+	eng := mock_frontend.NewMockEngine(ctrl)
+	eng.EXPECT().New(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	eng.EXPECT().Rollback(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	eng.EXPECT().Database(gomock.Any(), gomock.Any(), gomock.Any()).Return(db, nil).AnyTimes()
+	proc.Ctx = context.WithValue(proc.Ctx, defines.EngineKey{}, eng)
+	proc.TxnClient = txnClient
+
+	dbs := testutil.MakeVarcharVector([]string{"db", "db"}, nil)
+	tbls := testutil.MakeVarcharVector([]string{"t", ""}, []uint64{1})
+	r, err := MoTableClusteringDepth([]*vector.Vector{dbs, tbls}, proc)
+	require.NoError(t, err)
+	require.Equal(t, 2, r.Length())
+	require.Equal(t, 1.5, vector.MustFixedCol[float64](r)[0])
+	require.True(t, r.GetNulls().Contains(1))
+}
@@ -49,6 +49,7 @@ var (
 		strings.ToUpper(pb.CmdMethod_Inspect.String()):     handleInspectDN(),
 		strings.ToUpper(pb.CmdMethod_Label.String()):       handleSetLabel,
 		strings.ToUpper(pb.CmdMethod_SyncCommit.String()):  handleSyncCommit,
+		strings.ToUpper(pb.CmdMethod_Merge.String()):       handleMerge(),
 	}
 )
 
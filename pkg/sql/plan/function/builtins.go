@@ -3085,6 +3085,21 @@ var builtins = map[int]Functions{
 			},
 		},
 	},
+	MO_TABLE_CLUSTERING_DEPTH: {
+		Id:     MO_TABLE_CLUSTERING_DEPTH,
+		Flag:   plan.Function_STRICT,
+		Layout: STANDARD_FUNCTION,
+		Overloads: []Function{
+			{
+				Index:           0,
+				Args:            []types.T{types.T_varchar, types.T_varchar},
+				ReturnTyp:       types.T_float64,
+				Volatile:        true,
+				RealTimeRelated: true,
+				Fn:              ctl.MoTableClusteringDepth,
+			},
+		},
+	},
 	TRIM: {
 		Id:     TRIM,
 		Flag:   plan.Function_STRICT,
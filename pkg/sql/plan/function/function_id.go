@@ -313,6 +313,8 @@ const (
 	CURRVAL
 	LASTVAL
 
+	MO_TABLE_CLUSTERING_DEPTH // table clustering depth
+
 	// FUNCTION_END_NUMBER is not a function, just a flag to record the max number of function.
 	// TODO: every one should put the new function id in front of this one if you want to make a new function.
 	FUNCTION_END_NUMBER
@@ -524,6 +526,7 @@ var functionIdRegister = map[string]int32{
 	"mo_table_rows":                  MO_TABLE_ROWS,
 	"mo_table_size":                  MO_TABLE_SIZE,
 	"mo_table_col_max":               MO_TABLE_COL_MAX,
+	"mo_table_clustering_depth":      MO_TABLE_CLUSTERING_DEPTH,
 	"mo_table_col_min":               MO_TABLE_COL_MIN,
 	"trim":                           TRIM,
 	"mo_log_date":                    MO_LOG_DATE,
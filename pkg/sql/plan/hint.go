@@ -0,0 +1,301 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// leadingHint is a LEADING hint resolved to the binding tags of its tables,
+// the tables are joined first and in the order of tags.
+type leadingHint struct {
+	text string
+	tags []int32
+	used bool
+}
+
+// joinHint is a join method or distribution hint on the join of a table,
+// e.g. HASH_JOIN(t1 t2) is resolved to one joinHint of each table.
+type joinHint struct {
+	text string
+	name string
+	tag  int32
+	used bool
+}
+
+// resolveHints resolves the optimizer hints of a query block against the
+// tables of its FROM clause. A hint which can't be resolved is ignored with
+// a warning, hints never fail a query.
+func (builder *QueryBuilder) resolveHints(hints *tree.OptimizerHints, ctx *BindContext) {
+	if hints.Invalid != "" {
+		builder.addHintWarning("Optimizer hint syntax error near '%s'", hints.Invalid)
+	}
+	hasLeading := false
+	for _, hint := range hints.Hints {
+		text := fmt.Sprintf("%s(%s)", hint.Name, strings.Join(hint.Args, " "))
+		switch hint.Name {
+		case "LEADING":
+			if len(hint.Args) == 0 {
+				builder.addHintWarning("Wrong arguments of %s hint", text)
+				continue
+			}
+			if hasLeading {
+				builder.addHintWarning("%s hint conflicts with another LEADING hint", text)
+				continue
+			}
+			leading := &leadingHint{text: text}
+			for _, table := range hint.Args {
+				binding := ctx.hintBinding(table)
+				if binding == nil {
+					builder.addHintWarning("Unresolved name '%s' for %s hint", table, text)
+					leading = nil
+					break
+				}
+				leading.tags = append(leading.tags, binding.tag)
+			}
+			if leading != nil {
+				hasLeading = true
+				builder.leadingHints = append(builder.leadingHints, leading)
+			}
+
+		case "HASH_JOIN", "NL_JOIN", "BROADCAST", "SHUFFLE":
+			if len(hint.Args) == 0 {
+				builder.addHintWarning("Wrong arguments of %s hint", text)
+				continue
+			}
+			for _, table := range hint.Args {
+				binding := ctx.hintBinding(table)
+				if binding == nil {
+					builder.addHintWarning("Unresolved name '%s' for %s hint", table, text)
+					continue
+				}
+				builder.joinHints = append(builder.joinHints, &joinHint{
+					text: fmt.Sprintf("%s(%s)", hint.Name, table),
+					name: hint.Name,
+					tag:  binding.tag,
+				})
+			}
+
+		case "USE_INDEX", "NO_INDEX":
+			if len(hint.Args) < 2 {
+				builder.addHintWarning("Wrong arguments of %s hint", text)
+				continue
+			}
+			binding := ctx.hintBinding(hint.Args[0])
+			if binding == nil {
+				builder.addHintWarning("Unresolved name '%s' for %s hint", hint.Args[0], text)
+				continue
+			}
+			resolved := true
+			for _, index := range hint.Args[1:] {
+				if !builder.hasIndex(binding, index) {
+					builder.addHintWarning("Unresolved index '%s' of table '%s' for %s hint", index, hint.Args[0], text)
+					resolved = false
+				}
+			}
+			// the planner reads tables by full scans only, there is no
+			// index access path to choose or to rule out yet.
+			if resolved {
+				builder.addHintWarning("%s hint is ignored, no index access path is planned", text)
+			}
+
+		case "PARALLEL":
+			n, err := strconv.ParseInt(strings.Join(hint.Args, ""), 10, 32)
+			if len(hint.Args) != 1 || err != nil || n <= 0 {
+				builder.addHintWarning("Wrong arguments of %s hint", text)
+				continue
+			}
+			used := false
+			for _, binding := range ctx.bindings {
+				node := builder.qry.Nodes[binding.nodeId]
+				if node.NodeType == plan.Node_TABLE_SCAN {
+					node.Parallelism = int32(n)
+					used = true
+				}
+			}
+			if !used {
+				builder.addHintWarning("%s hint is not used", text)
+			}
+
+		default:
+			builder.addHintWarning("Unknown optimizer hint %s", hint.Name)
+		}
+	}
+}
+
+func (builder *QueryBuilder) addHintWarning(format string, args ...any) {
+	builder.hintWarnings = append(builder.hintWarnings, fmt.Sprintf(format, args...))
+}
+
+// hintBinding returns the binding of a table named in a hint, the name is
+// matched case-insensitively if there is no exact match.
+func (ctx *BindContext) hintBinding(name string) *Binding {
+	if binding, ok := ctx.bindingByTable[name]; ok {
+		return binding
+	}
+	for _, binding := range ctx.bindings {
+		if strings.EqualFold(binding.table, name) {
+			return binding
+		}
+	}
+	return nil
+}
+
+func (builder *QueryBuilder) hasIndex(binding *Binding, name string) bool {
+	node := builder.qry.Nodes[binding.nodeId]
+	if node.NodeType != plan.Node_TABLE_SCAN || node.TableDef == nil {
+		return false
+	}
+	if strings.EqualFold(name, "primary") && node.TableDef.Pkey != nil {
+		return true
+	}
+	for _, index := range node.TableDef.Indexes {
+		if strings.EqualFold(index.IndexName, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// leadingHintOf returns the unused LEADING hint whose tables are all among
+// the leaves of a join, each table in a leaf of its own.
+func (builder *QueryBuilder) leadingHintOf(leaves []*plan.Node) *leadingHint {
+	if len(builder.leadingHints) == 0 {
+		return nil
+	}
+	leafByTag := make(map[int32]int)
+	for i, leaf := range leaves {
+		for _, tag := range builder.enumerateTags(leaf.NodeId) {
+			leafByTag[tag] = i
+		}
+	}
+	for _, hint := range builder.leadingHints {
+		if hint.used {
+			continue
+		}
+		seen := make(map[int]bool)
+		ok := true
+		for _, tag := range hint.tags {
+			i, found := leafByTag[tag]
+			if !found || seen[i] {
+				ok = false
+				break
+			}
+			seen[i] = true
+		}
+		if ok {
+			return hint
+		}
+	}
+	return nil
+}
+
+// applyJoinHints sets the join method and distribution of the joins by the
+// join hints. A hint on a table applies to the join which joins the table
+// in, if that join can run the way the hint asks.
+func (builder *QueryBuilder) applyJoinHints(nodeID int32) {
+	node := builder.qry.Nodes[nodeID]
+	for _, childID := range node.Children {
+		builder.applyJoinHints(childID)
+	}
+	if node.NodeType != plan.Node_JOIN || node.JoinType == plan.Node_APPLY || len(builder.joinHints) == 0 {
+		return
+	}
+
+	for _, childID := range node.Children {
+		if builder.qry.Nodes[childID].NodeType == plan.Node_JOIN {
+			continue
+		}
+		for _, tag := range builder.enumerateTags(childID) {
+			for _, hint := range builder.joinHints {
+				if hint.tag == tag && !hint.used {
+					builder.applyJoinHint(node, hint)
+				}
+			}
+		}
+	}
+}
+
+func (builder *QueryBuilder) applyJoinHint(node *plan.Node, hint *joinHint) {
+	isEq := len(node.OnList) > 0 && IsEquiJoin(node.OnList)
+	switch hint.name {
+	case "HASH_JOIN":
+		if !isEq {
+			return
+		}
+		if node.JoinMethod == plan.Node_NESTED_LOOP_JOIN {
+			builder.addHintWarning("%s hint conflicts with another hint", hint.text)
+		} else {
+			node.JoinMethod = plan.Node_HASH_JOIN
+		}
+
+	case "NL_JOIN":
+		switch node.JoinType {
+		case plan.Node_INNER, plan.Node_LEFT, plan.Node_SEMI, plan.Node_ANTI, plan.Node_SINGLE:
+		default:
+			return
+		}
+		if node.JoinMethod == plan.Node_HASH_JOIN || node.JoinDistribution == plan.Node_SHUFFLE_JOIN {
+			builder.addHintWarning("%s hint conflicts with another hint", hint.text)
+		} else {
+			// the loop joins always build on the right side
+			node.JoinMethod = plan.Node_NESTED_LOOP_JOIN
+			node.BuildOnLeft = false
+		}
+
+	case "BROADCAST":
+		if node.JoinType != plan.Node_INNER {
+			return
+		}
+		if node.JoinDistribution == plan.Node_SHUFFLE_JOIN {
+			builder.addHintWarning("%s hint conflicts with another hint", hint.text)
+		} else {
+			node.JoinDistribution = plan.Node_BROADCAST_JOIN
+		}
+
+	case "SHUFFLE":
+		if node.JoinType != plan.Node_INNER || !isEq {
+			return
+		}
+		if node.JoinDistribution == plan.Node_BROADCAST_JOIN || node.JoinMethod == plan.Node_NESTED_LOOP_JOIN {
+			builder.addHintWarning("%s hint conflicts with another hint", hint.text)
+		} else {
+			node.JoinDistribution = plan.Node_SHUFFLE_JOIN
+		}
+	}
+	hint.used = true
+}
+
+// hintWarningsOf returns the warnings of the hints, including the hints
+// resolved but not used by the plan.
+func (builder *QueryBuilder) hintWarningsOf() []string {
+	warnings := builder.hintWarnings
+	for _, hint := range builder.leadingHints {
+		if !hint.used {
+			warnings = append(warnings, fmt.Sprintf("%s hint is not used", hint.text))
+		}
+	}
+	for _, hint := range builder.joinHints {
+		if !hint.used {
+			warnings = append(warnings, fmt.Sprintf("%s hint is not used", hint.text))
+		}
+	}
+	return warnings
+}
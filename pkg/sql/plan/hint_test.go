@@ -0,0 +1,103 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptimizerHints(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	build := func(sql string) *plan.Query {
+		p, err := runOneStmt(mock, t, sql)
+		require.NoError(t, err)
+		return p.GetQuery()
+	}
+	scanTables := func(qry *plan.Query, nodeID int32) []string {
+		var tables []string
+		var walk func(int32)
+		walk = func(id int32) {
+			node := qry.Nodes[id]
+			if node.NodeType == plan.Node_TABLE_SCAN {
+				tables = append(tables, node.TableDef.Name)
+			}
+			for _, child := range node.Children {
+				walk(child)
+			}
+		}
+		walk(nodeID)
+		sort.Strings(tables)
+		return tables
+	}
+	// the first join of the plan, both of its children are not joins
+	firstJoin := func(qry *plan.Query) *plan.Node {
+		var join *plan.Node
+		var walk func(int32)
+		walk = func(id int32) {
+			node := qry.Nodes[id]
+			if node.NodeType == plan.Node_JOIN && join == nil &&
+				qry.Nodes[node.Children[0]].NodeType != plan.Node_JOIN &&
+				qry.Nodes[node.Children[1]].NodeType != plan.Node_JOIN {
+				join = node
+			}
+			for _, child := range node.Children {
+				walk(child)
+			}
+		}
+		walk(qry.Steps[0])
+		return join
+	}
+
+	// supplier and region are joined first even if they are not connected
+	qry := build("select /*+ LEADING(s r) */ s_name from supplier s join nation n on s_nationkey = n_nationkey join region r on n_regionkey = r_regionkey")
+	require.Equal(t, []string{"region", "supplier"}, scanTables(qry, firstJoin(qry).NodeId))
+	require.Empty(t, qry.Warnings)
+
+	// the equi join of nation is run by a loop join without runtime filters
+	qry = build("select /*+ NL_JOIN(n) */ s_name from supplier s join nation n on s_nationkey = n_nationkey")
+	join := firstJoin(qry)
+	require.Equal(t, plan.Node_NESTED_LOOP_JOIN, join.JoinMethod)
+	require.Empty(t, join.RuntimeFilterBuildList)
+	require.Empty(t, qry.Warnings)
+
+	qry = build("select /*+ SHUFFLE(s) */ s_name from supplier s join nation n on s_nationkey = n_nationkey")
+	join = firstJoin(qry)
+	require.Equal(t, plan.Node_SHUFFLE_JOIN, join.JoinDistribution)
+	require.True(t, ShouldShuffleJoin(join, qry.Nodes[join.Children[0]], qry.Nodes[join.Children[1]]))
+
+	qry = build("select /*+ PARALLEL(4) */ count(*) from lineitem")
+	for _, node := range qry.Nodes {
+		if node.NodeType == plan.Node_TABLE_SCAN {
+			require.Equal(t, int32(4), node.Parallelism)
+		}
+	}
+
+	// hints which can't be used are ignored with warnings
+	qry = build("select /*+ LEADING(x) FOO(a) PARALLEL(0) HASH_JOIN(r) USE_INDEX(n idx) NL_JOIN(n) SHUFFLE(n) oops */ n_name from nation n, region r")
+	require.Equal(t, []string{
+		"Optimizer hint syntax error near 'oops'",
+		"Unresolved name 'x' for LEADING(x) hint",
+		"Unknown optimizer hint FOO",
+		"Wrong arguments of PARALLEL(0) hint",
+		"Unresolved index 'idx' of table 'n' for USE_INDEX(n idx) hint",
+		"HASH_JOIN(r) hint is not used",
+		"SHUFFLE(n) hint is not used",
+	}, qry.Warnings)
+}
@@ -0,0 +1,450 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"math"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// the default size of the statistics collected by ANALYZE TABLE
+const (
+	HistogramBuckets = 64
+	TopNValues       = 16
+)
+
+// ColumnStats are the statistics of a column collected by ANALYZE TABLE.
+// The values are encoded into float64 by StatsValueOf, which keeps their order,
+// so that the histograms of all the types are built and searched in the same way.
+type ColumnStats struct {
+	Type      types.T           `json:"type"`
+	RowCount  float64           `json:"row_count"`
+	NullCount float64           `json:"null_count"`
+	Ndv       float64           `json:"ndv"`
+	TopN      []TopNValue       `json:"top_n,omitempty"`
+	Buckets   []HistogramBucket `json:"buckets,omitempty"`
+}
+
+// TopNValue is one of the most common values and its rows.
+type TopNValue struct {
+	Value float64 `json:"value"`
+	Count float64 `json:"count"`
+}
+
+// HistogramBucket is a bucket of the equi-depth histogram of the values not in
+// the TopN. Count and Ndv are the rows and the distinct values in [Lower, Upper].
+type HistogramBucket struct {
+	Lower float64 `json:"lower"`
+	Upper float64 `json:"upper"`
+	Count float64 `json:"count"`
+	Ndv   float64 `json:"ndv"`
+}
+
+type valueCount struct {
+	value float64
+	count int
+}
+
+// BuildColumnStats builds the statistics of a column from the non-null values
+// of a sample. rowCount, nullCount and ndv are counted on the whole table.
+func BuildColumnStats(typ types.T, sample []float64, rowCount, nullCount, ndv float64, topN, buckets int) *ColumnStats {
+	cs := &ColumnStats{
+		Type:      typ,
+		RowCount:  rowCount,
+		NullCount: nullCount,
+		Ndv:       ndv,
+	}
+	if len(sample) == 0 {
+		return cs
+	}
+	scale := (rowCount - nullCount) / float64(len(sample))
+
+	sort.Float64s(sample)
+	var groups []valueCount
+	for i, v := range sample {
+		if i == 0 || v != sample[i-1] {
+			groups = append(groups, valueCount{value: v})
+		}
+		groups[len(groups)-1].count++
+	}
+
+	// the values more common than the average are the TopN
+	common := make([]valueCount, 0, len(groups))
+	for _, g := range groups {
+		if g.count > 1 && float64(g.count*len(groups)) > 1.25*float64(len(sample)) {
+			common = append(common, g)
+		}
+	}
+	sort.SliceStable(common, func(i, j int) bool {
+		return common[i].count > common[j].count
+	})
+	if len(common) > topN {
+		common = common[:topN]
+	}
+	isTopN := make(map[float64]bool, len(common))
+	for _, g := range common {
+		cs.TopN = append(cs.TopN, TopNValue{Value: g.value, Count: float64(g.count) * scale})
+		isTopN[g.value] = true
+	}
+
+	rest := make([]valueCount, 0, len(groups)-len(common))
+	restRows := 0
+	for _, g := range groups {
+		if !isTopN[g.value] {
+			rest = append(rest, g)
+			restRows += g.count
+		}
+	}
+	if len(rest) == 0 || buckets <= 0 {
+		return cs
+	}
+
+	// a value is never split into two buckets
+	depth := (restRows + buckets - 1) / buckets
+	var bucket *HistogramBucket
+	for _, g := range rest {
+		if bucket == nil || bucket.Count >= float64(depth) {
+			cs.Buckets = append(cs.Buckets, HistogramBucket{Lower: g.value})
+			bucket = &cs.Buckets[len(cs.Buckets)-1]
+		}
+		bucket.Upper = g.value
+		bucket.Count += float64(g.count)
+		bucket.Ndv++
+	}
+
+	// the sample misses some distinct values, which are spread over the buckets
+	ndvScale := (ndv - float64(len(cs.TopN))) / float64(len(rest))
+	if ndvScale < 1 {
+		ndvScale = 1
+	}
+	for i := range cs.Buckets {
+		cs.Buckets[i].Count *= scale
+		cs.Buckets[i].Ndv *= ndvScale
+	}
+	return cs
+}
+
+func (cs *ColumnStats) nonNullRows() float64 {
+	return cs.RowCount - cs.NullCount
+}
+
+// EqualRows estimates the rows equal to the value
+func (cs *ColumnStats) EqualRows(v float64) float64 {
+	var topNRows float64
+	for _, top := range cs.TopN {
+		if top.Value == v {
+			return top.Count
+		}
+		topNRows += top.Count
+	}
+	if len(cs.Buckets) == 0 {
+		ndv := cs.Ndv - float64(len(cs.TopN))
+		if ndv < 1 {
+			return 0
+		}
+		return (cs.nonNullRows() - topNRows) / ndv
+	}
+	i := sort.Search(len(cs.Buckets), func(i int) bool {
+		return cs.Buckets[i].Upper >= v
+	})
+	if i == len(cs.Buckets) || cs.Buckets[i].Lower > v {
+		return 0
+	}
+	return cs.Buckets[i].Count / math.Max(cs.Buckets[i].Ndv, 1)
+}
+
+// LessRows estimates the rows less than the value, or not greater than the
+// value if inclusive
+func (cs *ColumnStats) LessRows(v float64, inclusive bool) float64 {
+	var rows float64
+	for _, top := range cs.TopN {
+		if top.Value < v || inclusive && top.Value == v {
+			rows += top.Count
+		}
+	}
+	for _, b := range cs.Buckets {
+		switch {
+		case v < b.Lower, v == b.Lower && !inclusive:
+			return rows
+		case v > b.Upper, v == b.Upper && inclusive:
+			rows += b.Count
+		default:
+			// v is in the bucket, assume that the values are uniformly distributed
+			rows += b.Count * (v - b.Lower) / (b.Upper - b.Lower)
+			if inclusive {
+				rows += b.Count / math.Max(b.Ndv, 1)
+			}
+			return rows
+		}
+	}
+	return rows
+}
+
+// RangeRows estimates the rows of the filter like col op v
+func (cs *ColumnStats) RangeRows(op string, v float64) float64 {
+	switch op {
+	case "<":
+		return cs.LessRows(v, false)
+	case "<=":
+		return cs.LessRows(v, true)
+	case ">":
+		return cs.nonNullRows() - cs.LessRows(v, true)
+	case ">=":
+		return cs.nonNullRows() - cs.LessRows(v, false)
+	}
+	return -1
+}
+
+type statsValueKind int
+
+const (
+	statsValueNone statsValueKind = iota
+	statsValueNumber
+	statsValueString
+	statsValueDate
+	statsValueTime
+	statsValueDatetime
+	statsValueTimestamp
+)
+
+// statsValueKindOf returns the kind of the values of the type, the values of
+// different kinds can not be compared
+func statsValueKindOf(typ types.T) statsValueKind {
+	switch typ {
+	case types.T_bool, types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64, types.T_decimal64, types.T_decimal128:
+		return statsValueNumber
+	case types.T_char, types.T_varchar, types.T_text, types.T_blob, types.T_binary, types.T_varbinary:
+		return statsValueString
+	case types.T_date:
+		return statsValueDate
+	case types.T_time:
+		return statsValueTime
+	case types.T_datetime:
+		return statsValueDatetime
+	case types.T_timestamp:
+		return statsValueTimestamp
+	}
+	return statsValueNone
+}
+
+// IsStatsType returns whether ANALYZE TABLE can collect the statistics of the type
+func IsStatsType(typ types.T) bool {
+	return statsValueKindOf(typ) != statsValueNone
+}
+
+// statsValueOfBytes encodes the first 6 bytes of the string, which keeps the
+// order of the strings except those sharing the prefix
+func statsValueOfBytes(b []byte) float64 {
+	var v float64
+	for i := 0; i < 6; i++ {
+		v *= 256
+		if i < len(b) {
+			v += float64(b[i])
+		}
+	}
+	return v
+}
+
+// StatsValueOf returns the value of the row of the vector in the encoding of
+// the histograms, false if it is null or the type is not supported
+func StatsValueOf(vec *vector.Vector, row int) (float64, bool) {
+	if vec.IsConstNull() || vec.GetNulls().Contains(uint64(row)) {
+		return 0, false
+	}
+	if vec.IsConst() {
+		row = 0
+	}
+	typ := vec.GetType()
+	switch typ.Oid {
+	case types.T_bool:
+		if vector.GetFixedAt[bool](vec, row) {
+			return 1, true
+		}
+		return 0, true
+	case types.T_int8:
+		return float64(vector.GetFixedAt[int8](vec, row)), true
+	case types.T_int16:
+		return float64(vector.GetFixedAt[int16](vec, row)), true
+	case types.T_int32:
+		return float64(vector.GetFixedAt[int32](vec, row)), true
+	case types.T_int64:
+		return float64(vector.GetFixedAt[int64](vec, row)), true
+	case types.T_uint8:
+		return float64(vector.GetFixedAt[uint8](vec, row)), true
+	case types.T_uint16:
+		return float64(vector.GetFixedAt[uint16](vec, row)), true
+	case types.T_uint32:
+		return float64(vector.GetFixedAt[uint32](vec, row)), true
+	case types.T_uint64:
+		return float64(vector.GetFixedAt[uint64](vec, row)), true
+	case types.T_float32:
+		return float64(vector.GetFixedAt[float32](vec, row)), true
+	case types.T_float64:
+		return vector.GetFixedAt[float64](vec, row), true
+	case types.T_decimal64:
+		return types.Decimal64ToFloat64(vector.GetFixedAt[types.Decimal64](vec, row), typ.Scale), true
+	case types.T_decimal128:
+		return types.Decimal128ToFloat64(vector.GetFixedAt[types.Decimal128](vec, row), typ.Scale), true
+	case types.T_date:
+		return float64(vector.GetFixedAt[types.Date](vec, row)), true
+	case types.T_time:
+		return float64(vector.GetFixedAt[types.Time](vec, row)), true
+	case types.T_datetime:
+		return float64(vector.GetFixedAt[types.Datetime](vec, row)), true
+	case types.T_timestamp:
+		return float64(vector.GetFixedAt[types.Timestamp](vec, row)), true
+	case types.T_char, types.T_varchar, types.T_text, types.T_blob, types.T_binary, types.T_varbinary:
+		return statsValueOfBytes(vec.GetBytesAt(row)), true
+	}
+	return 0, false
+}
+
+// statsValueOfConst returns the constant in the encoding of the histograms of
+// the column, false if they can not be compared
+func (cs *ColumnStats) statsValueOfConst(expr *plan.Expr) (float64, bool) {
+	c, ok := expr.Expr.(*plan.Expr_C)
+	if !ok || c.C.Isnull || statsValueKindOf(types.T(expr.Typ.Id)) != statsValueKindOf(cs.Type) {
+		return 0, false
+	}
+	switch v := c.C.Value.(type) {
+	case *plan.Const_Bval:
+		if v.Bval {
+			return 1, true
+		}
+		return 0, true
+	case *plan.Const_I8Val:
+		return float64(v.I8Val), true
+	case *plan.Const_I16Val:
+		return float64(v.I16Val), true
+	case *plan.Const_I32Val:
+		return float64(v.I32Val), true
+	case *plan.Const_I64Val:
+		return float64(v.I64Val), true
+	case *plan.Const_U8Val:
+		return float64(v.U8Val), true
+	case *plan.Const_U16Val:
+		return float64(v.U16Val), true
+	case *plan.Const_U32Val:
+		return float64(v.U32Val), true
+	case *plan.Const_U64Val:
+		return float64(v.U64Val), true
+	case *plan.Const_Fval:
+		return float64(v.Fval), true
+	case *plan.Const_Dval:
+		return v.Dval, true
+	case *plan.Const_Decimal64Val:
+		return types.Decimal64ToFloat64(types.Decimal64(v.Decimal64Val.A), expr.Typ.Scale), true
+	case *plan.Const_Decimal128Val:
+		d := types.Decimal128{B0_63: uint64(v.Decimal128Val.A), B64_127: uint64(v.Decimal128Val.B)}
+		return types.Decimal128ToFloat64(d, expr.Typ.Scale), true
+	case *plan.Const_Dateval:
+		return float64(v.Dateval), true
+	case *plan.Const_Timeval:
+		return float64(v.Timeval), true
+	case *plan.Const_Datetimeval:
+		return float64(v.Datetimeval), true
+	case *plan.Const_Timestampval:
+		return float64(v.Timestampval), true
+	case *plan.Const_Sval:
+		return statsValueOfBytes([]byte(v.Sval)), true
+	}
+	return 0, false
+}
+
+// estimateOutCntByColumnStats estimates the output lines of the filter like
+// col op const by the statistics collected by ANALYZE TABLE, -1 if the column
+// is not analyzed
+func estimateOutCntByColumnStats(expr *plan.Expr, tableCnt float64, s *StatsInfoMap) float64 {
+	ret, col, _, funcName := CheckStrictFilter(expr)
+	if !ret {
+		return -1
+	}
+	cs := s.ColumnStats[col.Name]
+	if cs == nil || cs.RowCount <= 0 {
+		return -1
+	}
+	v, ok := cs.statsValueOfConst(expr.GetF().Args[1])
+	if !ok {
+		return -1
+	}
+	var rows float64
+	if funcName == "=" {
+		rows = cs.EqualRows(v)
+	} else {
+		rows = cs.RangeRows(funcName, v)
+	}
+	// the table may have changed since it was analyzed
+	return rows * tableCnt / cs.RowCount
+}
+
+// getColumnStats returns the statistics collected by ANALYZE TABLE of the
+// column, which is scanned in the subtree of the node
+func getColumnStats(col *plan.ColRef, nodeID int32, builder *QueryBuilder) *ColumnStats {
+	sc := builder.compCtx.GetStatsCache()
+	if sc == nil {
+		return nil
+	}
+	node := builder.qry.Nodes[nodeID]
+	if node.NodeType == plan.Node_TABLE_SCAN {
+		if len(node.BindingTags) == 0 || node.BindingTags[0] != col.RelPos || node.TableDef == nil || int(col.ColPos) >= len(node.TableDef.Cols) {
+			return nil
+		}
+		return sc.GetStatsInfoMap(node.TableDef.TblId).ColumnStats[node.TableDef.Cols[col.ColPos].Name]
+	}
+	for _, child := range node.Children {
+		if cs := getColumnStats(col, child, builder); cs != nil {
+			return cs
+		}
+	}
+	return nil
+}
+
+// getJoinKeyNdv returns the ndv of the join keys on both sides of the join,
+// which are the first equi-condition whose columns are analyzed. They are -1 if
+// there is no such condition.
+func getJoinKeyNdv(node *plan.Node, builder *QueryBuilder) (float64, float64) {
+	leftStats := builder.qry.Nodes[node.Children[0]].Stats
+	rightStats := builder.qry.Nodes[node.Children[1]].Stats
+	for _, cond := range node.OnList {
+		f, ok := cond.Expr.(*plan.Expr_F)
+		if !ok || f.F.Func.ObjName != "=" {
+			continue
+		}
+		col0, ok0 := f.F.Args[0].Expr.(*plan.Expr_Col)
+		col1, ok1 := f.F.Args[1].Expr.(*plan.Expr_Col)
+		if !ok0 || !ok1 {
+			continue
+		}
+		left, right := col0.Col, col1.Col
+		leftCs := getColumnStats(left, node.Children[0], builder)
+		if leftCs == nil {
+			left, right = right, left
+			leftCs = getColumnStats(left, node.Children[0], builder)
+		}
+		rightCs := getColumnStats(right, node.Children[1], builder)
+		if leftCs == nil || rightCs == nil || leftCs.Ndv <= 0 || rightCs.Ndv <= 0 {
+			continue
+		}
+		// the filters under the join may remove some distinct values
+		return math.Min(leftCs.Ndv, math.Max(leftStats.Outcnt, 1)), math.Min(rightCs.Ndv, math.Max(rightStats.Outcnt, 1))
+	}
+	return -1, -1
+}
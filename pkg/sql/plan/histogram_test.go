@@ -0,0 +1,161 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// newSkewedColumnStats returns the statistics of 20000 rows, half of them are 1
+// and the others are from 2 to 5001, sampled by half
+func newSkewedColumnStats() *ColumnStats {
+	sample := make([]float64, 0, 10000)
+	for i := 0; i < 5000; i++ {
+		sample = append(sample, 1, float64(i+2))
+	}
+	return BuildColumnStats(types.T_int64, sample, 20000, 0, 5001, TopNValues, HistogramBuckets)
+}
+
+func TestBuildColumnStats(t *testing.T) {
+	cs := newSkewedColumnStats()
+	require.Equal(t, []TopNValue{{Value: 1, Count: 10000}}, cs.TopN)
+	require.Equal(t, HistogramBuckets, len(cs.Buckets))
+	require.Equal(t, float64(2), cs.Buckets[0].Lower)
+	require.Equal(t, float64(5001), cs.Buckets[len(cs.Buckets)-1].Upper)
+	var rows float64
+	for i, b := range cs.Buckets {
+		if i > 0 {
+			require.Less(t, cs.Buckets[i-1].Upper, b.Lower)
+		}
+		rows += b.Count
+	}
+	require.Equal(t, float64(10000), rows)
+
+	require.Equal(t, float64(10000), cs.EqualRows(1))
+	require.InDelta(t, 2, cs.EqualRows(100), 0.01)
+	require.Equal(t, float64(0), cs.EqualRows(6000))
+	require.Equal(t, float64(10000), cs.RangeRows("<", 2))
+	require.InDelta(t, 10002, cs.RangeRows("<=", 2), 0.01)
+	require.InDelta(t, 15000, cs.RangeRows("<", 2502), 200)
+	require.InDelta(t, 20000, cs.RangeRows("<=", 5001), 0.01)
+	require.InDelta(t, 0, cs.RangeRows(">", 5001), 0.01)
+	require.InDelta(t, 10000, cs.RangeRows(">=", 2), 0.01)
+
+	// without the sample, the rows are spread over the ndv evenly
+	cs = BuildColumnStats(types.T_int64, nil, 1000, 100, 10, TopNValues, HistogramBuckets)
+	require.Equal(t, float64(100), cs.NullCount)
+	require.Equal(t, float64(90), cs.EqualRows(1))
+}
+
+func TestStatsValueOf(t *testing.T) {
+	proc := testutil.NewProcess()
+	vec := vector.NewVec(types.T_varchar.ToType())
+	for _, s := range []string{"", "a", "ab", "b", "abcdefgh"} {
+		require.NoError(t, vector.AppendBytes(vec, []byte(s), false, proc.Mp()))
+	}
+	require.NoError(t, vector.AppendBytes(vec, nil, true, proc.Mp()))
+	defer vec.Free(proc.Mp())
+
+	var values []float64
+	for i := 0; i < 4; i++ {
+		v, ok := StatsValueOf(vec, i)
+		require.True(t, ok)
+		values = append(values, v)
+	}
+	for i := 1; i < len(values); i++ {
+		require.Less(t, values[i-1], values[i])
+	}
+	v, ok := StatsValueOf(vec, 4)
+	require.True(t, ok)
+	require.Less(t, values[2], v)
+	_, ok = StatsValueOf(vec, 5)
+	require.False(t, ok)
+
+	cs := &ColumnStats{Type: types.T_varchar}
+	c, ok := cs.statsValueOfConst(&plan.Expr{
+		Typ:  &plan.Type{Id: int32(types.T_varchar)},
+		Expr: &plan.Expr_C{C: &plan.Const{Value: &plan.Const_Sval{Sval: "ab"}}},
+	})
+	require.True(t, ok)
+	require.Equal(t, values[2], c)
+	// the values of a date can not be compared with the strings
+	_, ok = cs.statsValueOfConst(&plan.Expr{
+		Typ:  &plan.Type{Id: int32(types.T_date)},
+		Expr: &plan.Expr_C{C: &plan.Const{Value: &plan.Const_Dateval{Dateval: 1}}},
+	})
+	require.False(t, ok)
+}
+
+func TestEstimateOutCntByColumnStats(t *testing.T) {
+	filter := func(op string, val int64) *plan.Expr {
+		typ := &plan.Type{Id: int32(types.T_int64)}
+		return &plan.Expr{
+			Typ: &plan.Type{Id: int32(types.T_bool)},
+			Expr: &plan.Expr_F{F: &plan.Function{
+				Func: &plan.ObjectRef{ObjName: op},
+				Args: []*plan.Expr{
+					{Typ: typ, Expr: &plan.Expr_Col{Col: &plan.ColRef{Name: "a"}}},
+					{Typ: typ, Expr: &plan.Expr_C{C: &plan.Const{Value: &plan.Const_I64Val{I64Val: val}}}},
+				},
+			}},
+		}
+	}
+
+	s := NewStatsInfoMap()
+	s.NdvMap["a"] = 10
+	// without the statistics of ANALYZE TABLE, the ndv is used
+	require.Equal(t, float64(4000), EstimateOutCnt(filter("=", 1), "", 40000, 40000, s))
+
+	s.SetColumnStats(map[string]*ColumnStats{"a": newSkewedColumnStats()})
+	require.Equal(t, float64(5001), s.NdvMap["a"])
+	// the table has doubled since it was analyzed
+	require.Equal(t, float64(20000), EstimateOutCnt(filter("=", 1), "", 40000, 40000, s))
+	require.InDelta(t, 4, EstimateOutCnt(filter("=", 100), "", 40000, 40000, s), 0.01)
+	require.Equal(t, float64(1), EstimateOutCnt(filter(">", 6000), "", 40000, 40000, s))
+	require.InDelta(t, 20000, EstimateOutCnt(filter(">=", 2), "", 40000, 40000, s), 0.01)
+}
+
+func TestJoinStatsByColumnStats(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	mock.ctxt.statsCache = NewStatsCache()
+	// many customers and suppliers are in the same nation
+	sql := "select c_name, s_name from customer join supplier on c_nationkey = s_nationkey"
+
+	joinOutcnt := func() float64 {
+		p, err := runOneStmt(mock, t, sql)
+		require.NoError(t, err)
+		for _, node := range p.GetQuery().Nodes {
+			if node.NodeType == plan.Node_JOIN {
+				return node.Stats.Outcnt
+			}
+		}
+		return -1
+	}
+
+	require.Equal(t, float64(15e4), joinOutcnt())
+	mock.ctxt.statsCache.GetStatsInfoMap(mock.ctxt.tables["customer"].TblId).SetColumnStats(map[string]*ColumnStats{
+		"c_nationkey": {Type: types.T_int32, RowCount: 15e4, Ndv: 25},
+	})
+	mock.ctxt.statsCache.GetStatsInfoMap(mock.ctxt.tables["supplier"].TblId).SetColumnStats(map[string]*ColumnStats{
+		"s_nationkey": {Type: types.T_int32, RowCount: 1e4, Ndv: 25},
+	})
+	require.Equal(t, float64(15e4*1e4/25), joinOutcnt())
+}
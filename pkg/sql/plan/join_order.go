@@ -191,18 +191,26 @@ func (builder *QueryBuilder) determineJoinOrder(nodeID int32) int32 {
 
 	leaves, conds := builder.gatherJoinLeavesAndConds(node, nil, nil)
 
-	vertices := builder.getJoinGraph(leaves, conds)
-	subTrees := make([]*plan.Node, 0, len(leaves))
-	for i, vertex := range vertices {
-		// TODO handle cycles in the "dimension -> fact" DAG
-		if vertex.parent == -1 {
-			builder.buildSubJoinTree(vertices, int32(i))
-			subTrees = append(subTrees, vertex.node)
+	// a LEADING hint joins its tables first, the leaves are not grouped
+	// into sub join trees so that they can be joined in the given order
+	hint := builder.leadingHintOf(leaves)
+	var subTrees []*plan.Node
+	if hint != nil {
+		subTrees = leaves
+	} else {
+		vertices := builder.getJoinGraph(leaves, conds)
+		subTrees = make([]*plan.Node, 0, len(leaves))
+		for i, vertex := range vertices {
+			// TODO handle cycles in the "dimension -> fact" DAG
+			if vertex.parent == -1 {
+				builder.buildSubJoinTree(vertices, int32(i))
+				subTrees = append(subTrees, vertex.node)
+			}
 		}
-	}
-	for _, vertex := range vertices {
-		if !vertex.joined {
-			subTrees = append(subTrees, vertex.node)
+		for _, vertex := range vertices {
+			if !vertex.joined {
+				subTrees = append(subTrees, vertex.node)
+			}
 		}
 	}
 
@@ -220,6 +228,14 @@ func (builder *QueryBuilder) determineJoinOrder(nodeID int32) int32 {
 
 	nLeaf := int32(len(subTrees))
 
+	var leading []int32
+	if hint != nil {
+		for _, tag := range hint.tags {
+			leading = append(leading, leafByTag[tag])
+		}
+		hint.used = true
+	}
+
 	adjMat := make([]bool, nLeaf*nLeaf)
 	firstConnected := nLeaf
 	visited := make([]bool, nLeaf)
@@ -238,25 +254,18 @@ func (builder *QueryBuilder) determineJoinOrder(nodeID int32) int32 {
 		}
 	}
 
-	if firstConnected < nLeaf {
-		nodeID = subTrees[firstConnected].NodeId
-		visited[firstConnected] = true
+	if len(leading) == 0 && firstConnected < nLeaf {
+		leading = []int32{firstConnected}
+	}
 
-		eligible := adjMat[firstConnected*nLeaf : (firstConnected+1)*nLeaf]
+	if len(leading) > 0 {
+		nodeID = subTrees[leading[0]].NodeId
+		visited[leading[0]] = true
 
-		for {
-			nextSibling := nLeaf
-			for i := range eligible {
-				if !visited[i] && eligible[i] {
-					nextSibling = int32(i)
-					break
-				}
-			}
-
-			if nextSibling == nLeaf {
-				break
-			}
+		eligible := make([]bool, nLeaf)
+		copy(eligible, adjMat[leading[0]*nLeaf:(leading[0]+1)*nLeaf])
 
+		joinNext := func(nextSibling int32) {
 			visited[nextSibling] = true
 
 			children := []int32{nodeID, subTrees[nextSibling].NodeId}
@@ -272,6 +281,26 @@ func (builder *QueryBuilder) determineJoinOrder(nodeID int32) int32 {
 			}
 		}
 
+		for _, nextSibling := range leading[1:] {
+			joinNext(nextSibling)
+		}
+
+		for {
+			nextSibling := nLeaf
+			for i := range eligible {
+				if !visited[i] && eligible[i] {
+					nextSibling = int32(i)
+					break
+				}
+			}
+
+			if nextSibling == nLeaf {
+				break
+			}
+
+			joinNext(nextSibling)
+		}
+
 		for i := range visited {
 			if !visited[i] {
 				nodeID = builder.appendNode(&plan.Node{
@@ -0,0 +1,131 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
+)
+
+// applyMergeJoins chooses the merge join for the equi joins whose both sides
+// are sorted on the join key, either sorts on it or scans of tables sorted
+// by it, so the join needs no hash table and no sort of its own. The blocks
+// of a table are each sorted on its sort key, a scan reads them in the order
+// of their zonemaps and merges only the blocks of overlapping key ranges, so
+// its rows stream sorted too.
+//
+// Only the joins of a single key column are merged, the join method chosen
+// by a hint is kept.
+func (builder *QueryBuilder) applyMergeJoins(nodeID int32) {
+	node := builder.qry.Nodes[nodeID]
+	for _, childID := range node.Children {
+		builder.applyMergeJoins(childID)
+	}
+
+	if node.NodeType != plan.Node_JOIN || node.JoinMethod != plan.Node_AUTO_JOIN_METHOD ||
+		node.JoinDistribution != plan.Node_AUTO_JOIN_DISTRIBUTION || len(node.OnList) != 1 {
+		return
+	}
+	switch node.JoinType {
+	case plan.Node_INNER, plan.Node_LEFT, plan.Node_SEMI, plan.Node_ANTI:
+	default:
+		return
+	}
+	left, right := builder.qry.Nodes[node.Children[0]], builder.qry.Nodes[node.Children[1]]
+
+	f, ok := node.OnList[0].Expr.(*plan.Expr_F)
+	if !ok || !SupportedJoinCondition(f.F.Func.GetObj()) || len(f.F.Args) != 2 {
+		return
+	}
+	col0, col1 := f.F.Args[0].GetCol(), f.F.Args[1].GetCol()
+	if col0 == nil || col1 == nil || !isMergeJoinKeyType(f.F.Args[0].Typ, f.F.Args[1].Typ) {
+		return
+	}
+	leftTags := make(map[int32]bool)
+	for _, tag := range builder.enumerateTags(left.NodeId) {
+		leftTags[tag] = true
+	}
+	if !leftTags[col0.RelPos] {
+		col0, col1 = col1, col0
+	}
+	if !leftTags[col0.RelPos] || leftTags[col1.RelPos] {
+		return
+	}
+	if !builder.isSortedOn(left, col0) || !builder.isSortedOn(right, col1) {
+		return
+	}
+
+	node.JoinMethod = plan.Node_MERGE_JOIN
+	node.BuildOnLeft = false
+}
+
+// isSortedOn reports whether the output of a node is sorted on a column in
+// ascending order, or can be read so if it is a table scan.
+func (builder *QueryBuilder) isSortedOn(node *plan.Node, col *plan.ColRef) bool {
+	switch node.NodeType {
+	case plan.Node_TABLE_SCAN:
+		if len(node.BindingTags) == 0 || node.BindingTags[0] != col.RelPos ||
+			int(col.ColPos) >= len(node.TableDef.Cols) {
+			return false
+		}
+		key := GetSortedScanKey(node)
+		return key != "" && key == node.TableDef.Cols[col.ColPos].Name
+
+	case plan.Node_SORT:
+		if node.Limit != nil || node.Offset != nil || len(node.OrderBy) == 0 {
+			return false
+		}
+		spec := node.OrderBy[0]
+		c := spec.Expr.GetCol()
+		return c != nil && c.RelPos == col.RelPos && c.ColPos == col.ColPos &&
+			spec.Flag&plan.OrderBySpec_DESC == 0
+	}
+	return false
+}
+
+// GetSortedScanKey returns the column a table scan can read its rows sorted
+// on, the single column primary key or cluster by key of the table, or "" if
+// there is none. The partitions of a table are not merged.
+func GetSortedScanKey(node *plan.Node) string {
+	tableDef := node.TableDef
+	if node.NodeType != plan.Node_TABLE_SCAN || tableDef == nil || tableDef.Partition != nil {
+		return ""
+	}
+	if tableDef.Pkey != nil && tableDef.Pkey.PkeyColName != "" &&
+		tableDef.Pkey.PkeyColName != catalog.CPrimaryKeyColName {
+		return tableDef.Pkey.PkeyColName
+	}
+	if tableDef.ClusterBy != nil && !util.JudgeIsCompositeClusterByColumn(tableDef.ClusterBy.Name) {
+		return tableDef.ClusterBy.Name
+	}
+	return ""
+}
+
+func isMergeJoinKeyType(typ0, typ1 *plan.Type) bool {
+	if typ0.Id != typ1.Id || typ0.Scale != typ1.Scale {
+		return false
+	}
+	switch types.T(typ0.Id) {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64, types.T_decimal64, types.T_decimal128,
+		types.T_date, types.T_time, types.T_datetime, types.T_timestamp,
+		types.T_char, types.T_varchar:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,70 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeJoin(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	joinMethods := func(sql string) []plan.Node_JoinMethod {
+		p, err := runOneStmt(mock, t, sql)
+		require.NoError(t, err)
+		qry := p.GetQuery()
+		var methods []plan.Node_JoinMethod
+		var walk func(int32)
+		walk = func(id int32) {
+			node := qry.Nodes[id]
+			if node.NodeType == plan.Node_JOIN {
+				methods = append(methods, node.JoinMethod)
+				require.Empty(t, node.RuntimeFilterBuildList)
+			}
+			for _, child := range node.Children {
+				walk(child)
+			}
+		}
+		walk(qry.Steps[0])
+		return methods
+	}
+
+	// both sides are sorted on the key, or are scans of the tables sorted by it
+	sqls := []string{
+		"select a.o_orderkey, b.o_totalprice from orders a join orders b on a.o_orderkey = b.o_orderkey",
+		"select o_orderkey from orders a where exists (select 1 from orders b where a.o_orderkey = b.o_orderkey)",
+		"select a.k, b.o_totalprice from (select o_orderkey k from orders order by o_orderkey) a join orders b on a.k = b.o_orderkey",
+		"select a.k, b.v from (select o_orderkey k from orders order by o_orderkey) a join (select o_orderkey k, o_totalprice v from orders order by o_orderkey) b on a.k = b.k",
+		"select a.k, b.v from (select o_orderkey k from orders order by o_orderkey) a left join (select o_orderkey k, o_totalprice v from orders order by o_orderkey) b on a.k = b.k",
+	}
+	for _, sql := range sqls {
+		require.Equal(t, []plan.Node_JoinMethod{plan.Node_MERGE_JOIN}, joinMethods(sql), sql)
+	}
+
+	sqls = []string{
+		// one side is not sorted on the key
+		"select a.k from (select o_orderkey k from orders order by o_orderkey) a join lineitem b on a.k = b.l_orderkey",
+		// sorted in descending order
+		"select a.k from (select o_orderkey k from orders order by o_orderkey desc) a join (select o_orderkey k from orders order by o_orderkey desc) b on a.k = b.k",
+	}
+	for _, sql := range sqls {
+		for _, method := range joinMethods(sql) {
+			require.NotEqual(t, plan.Node_MERGE_JOIN, method, sql)
+		}
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 
@@ -35,6 +36,9 @@ type MockCompilerContext struct {
 	stats           map[string]*Stats
 	pks             map[string][]int
 	id2name         map[uint64]string
+	policies        map[string]map[string]string
+	mviews          map[string][]*MaterializedView
+	statsCache      *StatsCache
 	isDml           bool
 	mysqlCompatible bool
 
@@ -51,6 +55,29 @@ func (m *MockCompilerContext) ResolveUdf(name string, ast []*plan.Expr) (string,
 	return "", nil
 }
 
+func (m *MockCompilerContext) ResolvePolicy(obj *ObjectRef, tableDef *TableDef, command string) (tree.Expr, error) {
+	policies, ok := m.policies[tableDef.Name]
+	if !ok {
+		return nil, nil
+	}
+	predicate, ok := policies[command]
+	if !ok {
+		predicate = policies["all"]
+	}
+	if len(predicate) == 0 {
+		return nil, nil
+	}
+	stmts, err := mysql.Parse(context.TODO(), "select "+predicate, 1)
+	if err != nil {
+		return nil, err
+	}
+	return stmts[0].(*tree.Select).Select.(*tree.SelectClause).Exprs[0].Expr, nil
+}
+
+func (m *MockCompilerContext) ResolveMaterializedViews(obj *ObjectRef, tableDef *TableDef) ([]*MaterializedView, error) {
+	return m.mviews[tableDef.Name], nil
+}
+
 func (m *MockCompilerContext) ResolveAccountIds(accountNames []string) ([]uint32, error) {
 	return []uint32{catalog.System_Account}, nil
 }
@@ -64,6 +91,7 @@ func (m *MockCompilerContext) ResolveVariable(varName string, isSystemVar, isGlo
 	dec, _ := types.ParseDecimal128("200.001", 38, 3)
 	vars["decimal_var"] = dec
 	vars["null_var"] = nil
+	vars[mviewRewriteVar] = int8(1)
 
 	if m.mysqlCompatible {
 		vars["sql_mode"] = ""
@@ -809,7 +837,7 @@ func (m *MockCompilerContext) Stats(obj *ObjectRef, e *Expr) *Stats {
 }
 
 func (m *MockCompilerContext) GetStatsCache() *StatsCache {
-	return nil
+	return m.statsCache
 }
 
 func (m *MockCompilerContext) GetAccountId() uint32 {
@@ -0,0 +1,749 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/cdc"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// A materialized view is a plain view on its state table, which is kept fresh
+// by DN with the changes of the table of the view. The view is a filter, a
+// projection or an aggregation on a single table, which DN can maintain
+// incrementally.
+
+// MaterializedView is a materialized view on a table
+type MaterializedView struct {
+	Database string
+	Name     string
+	// Definition is the query of the view, whose table is qualified by its
+	// database
+	Definition string
+}
+
+// MaterializedViewDef is how a materialized view is created
+type MaterializedViewDef struct {
+	Database    string
+	Name        string
+	BaseTableId uint64
+	// StateTable is the state table of the view in Database
+	StateTable string
+	Definition string
+	// Spec is what DN maintains the state table by
+	Spec cdc.MViewSpec
+	// CreateStateSql creates the state table, and CreateViewSql creates the
+	// view on the state table
+	CreateStateSql string
+	CreateViewSql  string
+}
+
+const (
+	mviewStatePrefix = "__mo_mv_"
+	// the session variable enabling the rewriting of the queries
+	mviewRewriteVar = "materialized_view_rewrite"
+)
+
+// mviewOutput is a result of the query of a materialized view, which is a
+// column, or an aggregate function on a column. arg is empty for count(*).
+type mviewOutput struct {
+	heading string
+	fn      cdc.MViewFunc
+	arg     string
+}
+
+// mviewAvg is avg(), which is computed from the sum and the count of the
+// column by the view
+const mviewAvg cdc.MViewFunc = "avg"
+
+// mviewQuery is the query of a materialized view analyzed
+type mviewQuery struct {
+	obj       *ObjectRef
+	tableDef  *TableDef
+	alias     string
+	cols      map[string]*ColDef
+	filter    *cdc.MViewExpr
+	aggregate bool
+	keys      []string
+	outputs   []mviewOutput
+}
+
+// BuildMaterializedView checks the query of the materialized view, and makes
+// the definitions of its state table and its view
+func BuildMaterializedView(ctx CompilerContext, stmt *tree.CreateMaterializedView) (*MaterializedViewDef, error) {
+	dbName := string(stmt.Name.SchemaName)
+	if len(dbName) == 0 {
+		dbName = ctx.DefaultDatabase()
+	}
+	if len(dbName) == 0 {
+		return nil, moerr.NewNoDB(ctx.GetContext())
+	}
+	name := string(stmt.Name.ObjectName)
+	q, err := analyzeMViewQuery(ctx, stmt.AsSource)
+	if err != nil {
+		return nil, err
+	}
+	def := &MaterializedViewDef{
+		Database:    dbName,
+		Name:        name,
+		BaseTableId: q.tableDef.TblId,
+		StateTable:  mviewStatePrefix + name,
+	}
+	def.Spec = cdc.MViewSpec{
+		Base:      q.obj.GetSchemaName() + "." + q.tableDef.Name,
+		State:     dbName + "." + def.StateTable,
+		Filter:    q.filter,
+		Aggregate: q.aggregate,
+		Keys:      q.keys,
+	}
+	colDefs := []string{
+		fmt.Sprintf("`%s` varchar(65535) primary key", cdc.MViewKeyCol),
+		fmt.Sprintf("`%s` varchar(64)", cdc.MViewTSCol),
+		fmt.Sprintf("`%s` bigint", cdc.MViewCountCol),
+	}
+	added := make(map[string]bool)
+	addCol := func(name string, fn cdc.MViewFunc, arg string, typ string) {
+		if !added[name] {
+			added[name] = true
+			def.Spec.Columns = append(def.Spec.Columns, cdc.MViewColumn{Name: name, Func: fn, Arg: arg})
+			colDefs = append(colDefs, fmt.Sprintf("`%s` %s", name, typ))
+		}
+	}
+	colType := func(arg string) (string, error) {
+		return mviewColType(ctx, q.cols[arg])
+	}
+	if q.aggregate {
+		for _, key := range q.keys {
+			typ, err := colType(key)
+			if err != nil {
+				return nil, err
+			}
+			addCol(key, cdc.MViewValue, key, typ)
+		}
+	}
+	items := make([]string, 0, len(q.outputs))
+	for _, out := range q.outputs {
+		var item string
+		count := mviewStatePrefix + "n_" + out.arg
+		sum := mviewStatePrefix + "sum_" + out.arg
+		switch out.fn {
+		case cdc.MViewValue:
+			typ, err := colType(out.arg)
+			if err != nil {
+				return nil, err
+			}
+			addCol(out.arg, cdc.MViewValue, out.arg, typ)
+			item = quoteMViewName(out.arg)
+		case cdc.MViewCount:
+			if out.arg == "" {
+				item = quoteMViewName(cdc.MViewCountCol)
+				break
+			}
+			addCol(count, cdc.MViewCount, out.arg, "bigint")
+			item = quoteMViewName(count)
+		case cdc.MViewSum, mviewAvg:
+			// the count makes the sum null if there is no value
+			addCol(count, cdc.MViewCount, out.arg, "bigint")
+			addCol(sum, cdc.MViewSum, out.arg, mviewSumType(q.cols[out.arg]))
+			item = quoteMViewName(sum)
+			if out.fn == mviewAvg {
+				item = fmt.Sprintf("%s / %s", quoteMViewName(sum), quoteMViewName(count))
+			}
+		case cdc.MViewMin, cdc.MViewMax:
+			typ, err := colType(out.arg)
+			if err != nil {
+				return nil, err
+			}
+			col := mviewStatePrefix + string(out.fn) + "_" + out.arg
+			addCol(col, out.fn, out.arg, typ)
+			item = quoteMViewName(col)
+		}
+		items = append(items, fmt.Sprintf("%s as %s", item, quoteMViewName(out.heading)))
+	}
+	def.CreateStateSql = fmt.Sprintf("create table %s.%s (%s)",
+		quoteMViewName(dbName), quoteMViewName(def.StateTable), strings.Join(colDefs, ", "))
+	// the row of the watermark is not a group
+	def.CreateViewSql = fmt.Sprintf("create view %s.%s as select %s from %s.%s where %s <> '%s'",
+		quoteMViewName(dbName), quoteMViewName(name), strings.Join(items, ", "),
+		quoteMViewName(dbName), quoteMViewName(def.StateTable),
+		quoteMViewName(cdc.MViewKeyCol), cdc.MViewWatermarkKey)
+	def.Definition = q.definition()
+	return def, nil
+}
+
+// definition returns the query in the canonical form, which is analyzed into
+// the same query again. The table is qualified by its database, so the
+// definition is used by the sessions in any database.
+func (q *mviewQuery) definition() string {
+	var buf strings.Builder
+	buf.WriteString("select ")
+	for i, out := range q.outputs {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		switch {
+		case out.fn == cdc.MViewValue:
+			buf.WriteString(quoteMViewName(out.arg))
+		case out.arg == "":
+			buf.WriteString("count(*)")
+		default:
+			fmt.Fprintf(&buf, "%s(%s)", out.fn, quoteMViewName(out.arg))
+		}
+		fmt.Fprintf(&buf, " as %s", quoteMViewName(out.heading))
+	}
+	fmt.Fprintf(&buf, " from %s.%s", quoteMViewName(q.obj.GetSchemaName()), quoteMViewName(q.tableDef.Name))
+	if q.filter != nil {
+		buf.WriteString(" where ")
+		writeMViewFilter(&buf, q.filter)
+	}
+	if q.aggregate && len(q.keys) > 0 {
+		buf.WriteString(" group by ")
+		for i, key := range q.keys {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(quoteMViewName(key))
+		}
+	}
+	return buf.String()
+}
+
+// writeMViewFilter writes the filter in SQL. The values are written as
+// strings, which are converted to the types of the columns.
+func writeMViewFilter(buf *strings.Builder, filter *cdc.MViewExpr) {
+	switch filter.Op {
+	case cdc.MViewOpAnd, cdc.MViewOpOr:
+		for i, arg := range filter.Args {
+			if i > 0 {
+				fmt.Fprintf(buf, " %s ", filter.Op)
+			}
+			buf.WriteString("(")
+			writeMViewFilter(buf, arg)
+			buf.WriteString(")")
+		}
+	case cdc.MViewOpNot:
+		buf.WriteString("not (")
+		writeMViewFilter(buf, filter.Args[0])
+		buf.WriteString(")")
+	case cdc.MViewOpIsNull, cdc.MViewOpIsNotNull:
+		fmt.Fprintf(buf, "%s %s", quoteMViewName(filter.Col), filter.Op)
+	default:
+		value := strings.ReplaceAll(filter.Value, `\`, `\\`)
+		value = strings.ReplaceAll(value, `'`, `\'`)
+		fmt.Fprintf(buf, "%s %s '%s'", quoteMViewName(filter.Col), filter.Op, value)
+	}
+}
+
+func quoteMViewName(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+// mviewTable returns the table of the query of a materialized view and its
+// alias, the table is nil if the query is not on a table
+func mviewTable(stmt *tree.Select) (*tree.TableName, string) {
+	tbl := stmt.Select.(*tree.SelectClause).From.Tables[0]
+	if join, ok := tbl.(*tree.JoinTableExpr); ok && join.Right == nil {
+		tbl = join.Left
+	}
+	aliased, ok := tbl.(*tree.AliasedTableExpr)
+	if !ok {
+		return nil, ""
+	}
+	name, _ := aliased.Expr.(*tree.TableName)
+	return name, string(aliased.As.Alias)
+}
+
+// analyzeMViewQuery checks the query can be maintained incrementally, and
+// analyzes it
+func analyzeMViewQuery(ctx CompilerContext, stmt *tree.Select) (*mviewQuery, error) {
+	reqCtx := ctx.GetContext()
+	clause, ok := stmt.Select.(*tree.SelectClause)
+	if !ok || stmt.With != nil || stmt.Ep != nil || len(stmt.OrderBy) > 0 || stmt.Limit != nil {
+		return nil, moerr.NewNotSupported(reqCtx, "materialized view of the query other than a select on a table")
+	}
+	if clause.Distinct || clause.Having != nil || clause.Option != "" {
+		return nil, moerr.NewNotSupported(reqCtx, "materialized view with DISTINCT or HAVING")
+	}
+	if clause.From == nil || len(clause.From.Tables) != 1 {
+		return nil, moerr.NewNotSupported(reqCtx, "materialized view on more than one table")
+	}
+	table, alias := mviewTable(stmt)
+	if table == nil {
+		return nil, moerr.NewNotSupported(reqCtx, "materialized view on a join or a subquery")
+	}
+	dbName := string(table.SchemaName)
+	if len(dbName) == 0 {
+		dbName = ctx.DefaultDatabase()
+	}
+	if len(dbName) == 0 {
+		return nil, moerr.NewNoDB(reqCtx)
+	}
+	obj, tableDef := ctx.Resolve(dbName, string(table.ObjectName))
+	if tableDef == nil {
+		return nil, moerr.NewNoSuchTable(reqCtx, dbName, string(table.ObjectName))
+	}
+	if tableDef.TableType != catalog.SystemOrdinaryRel {
+		return nil, moerr.NewNotSupported(reqCtx, "materialized view on the view or the external table %s", tableDef.Name)
+	}
+
+	q := &mviewQuery{
+		obj:      obj,
+		tableDef: tableDef,
+		alias:    strings.ToLower(tableDef.Name),
+		cols:     make(map[string]*ColDef, len(tableDef.Cols)),
+	}
+	if len(alias) > 0 {
+		q.alias = strings.ToLower(alias)
+	}
+	for _, col := range tableDef.Cols {
+		if !col.Hidden {
+			q.cols[strings.ToLower(col.Name)] = col
+		}
+	}
+
+	for _, expr := range clause.GroupBy {
+		key, err := q.column(ctx, expr)
+		if err != nil {
+			return nil, err
+		}
+		if !containsString(q.keys, key) {
+			q.keys = append(q.keys, key)
+		}
+	}
+	q.aggregate = len(q.keys) > 0
+	for _, selectExpr := range clause.Exprs {
+		fn, arg, err := q.output(ctx, selectExpr.Expr)
+		if err != nil {
+			return nil, err
+		}
+		if fn != cdc.MViewValue {
+			q.aggregate = true
+		}
+		q.outputs = append(q.outputs, mviewOutput{
+			heading: mviewHeading(selectExpr),
+			fn:      fn,
+			arg:     arg,
+		})
+	}
+	if q.aggregate {
+		for _, out := range q.outputs {
+			if out.fn == cdc.MViewValue && !containsString(q.keys, out.arg) {
+				return nil, moerr.NewNotSupported(reqCtx, "column %s of materialized view is not in GROUP BY", out.arg)
+			}
+		}
+	} else {
+		// the rows are kept by the primary key of the table
+		if tableDef.Pkey == nil || len(tableDef.Pkey.Names) == 0 {
+			return nil, moerr.NewNotSupported(reqCtx, "materialized view without aggregation on the table %s without primary key", tableDef.Name)
+		}
+		for _, name := range tableDef.Pkey.Names {
+			name = strings.ToLower(name)
+			if _, ok := q.cols[name]; !ok {
+				return nil, moerr.NewNotSupported(reqCtx, "materialized view without aggregation on the table %s without primary key", tableDef.Name)
+			}
+			q.keys = append(q.keys, name)
+		}
+	}
+
+	if clause.Where != nil {
+		filter, err := q.buildFilter(ctx, clause.Where.Expr)
+		if err != nil {
+			return nil, err
+		}
+		q.filter = filter
+	}
+	return q, nil
+}
+
+// mviewHeading returns the heading of the result as the query builder does
+func mviewHeading(selectExpr tree.SelectExpr) string {
+	if selectExpr.As != nil && !selectExpr.As.Empty() {
+		return selectExpr.As.Origin()
+	}
+	expr := selectExpr.Expr
+	if name, ok := expr.(*tree.UnresolvedName); ok {
+		return name.Parts[0]
+	}
+	for {
+		paren, ok := expr.(*tree.ParenExpr)
+		if !ok {
+			break
+		}
+		expr = paren.Expr
+	}
+	return tree.String(expr, dialect.MYSQL)
+}
+
+// column returns the column of the table that the expression refers to
+func (q *mviewQuery) column(ctx CompilerContext, expr tree.Expr) (string, error) {
+	for {
+		paren, ok := expr.(*tree.ParenExpr)
+		if !ok {
+			break
+		}
+		expr = paren.Expr
+	}
+	name, ok := expr.(*tree.UnresolvedName)
+	if !ok || name.Star || name.NumParts > 2 {
+		return "", moerr.NewNotSupported(ctx.GetContext(), "expression %s in materialized view", tree.String(expr, dialect.MYSQL))
+	}
+	if name.NumParts == 2 && strings.ToLower(name.Parts[1]) != q.alias {
+		return "", moerr.NewInvalidInput(ctx.GetContext(), "table %s does not exist", name.Parts[1])
+	}
+	col := strings.ToLower(name.Parts[0])
+	if _, ok = q.cols[col]; !ok {
+		return "", moerr.NewInvalidInput(ctx.GetContext(), "column %s does not exist", name.Parts[0])
+	}
+	return col, nil
+}
+
+// output returns the function and the column of a result of the query
+func (q *mviewQuery) output(ctx CompilerContext, expr tree.Expr) (cdc.MViewFunc, string, error) {
+	for {
+		paren, ok := expr.(*tree.ParenExpr)
+		if !ok {
+			break
+		}
+		expr = paren.Expr
+	}
+	f, ok := expr.(*tree.FuncExpr)
+	if !ok {
+		col, err := q.column(ctx, expr)
+		return cdc.MViewValue, col, err
+	}
+	name, ok := f.Func.FunctionReference.(*tree.UnresolvedName)
+	if !ok {
+		return "", "", moerr.NewNotSupported(ctx.GetContext(), "expression %s in materialized view", tree.String(f, dialect.MYSQL))
+	}
+	fn := cdc.MViewFunc(strings.ToLower(name.Parts[0]))
+	switch fn {
+	case cdc.MViewCount, cdc.MViewSum, cdc.MViewMin, cdc.MViewMax, mviewAvg:
+	default:
+		return "", "", moerr.NewNotSupported(ctx.GetContext(), "function %s in materialized view", fn)
+	}
+	if f.WindowSpec != nil || f.Type != tree.FUNC_TYPE_DEFAULT || len(f.Exprs) != 1 {
+		return "", "", moerr.NewNotSupported(ctx.GetContext(), "expression %s in materialized view", tree.String(f, dialect.MYSQL))
+	}
+	if num, ok := f.Exprs[0].(*tree.NumVal); ok && fn == cdc.MViewCount && num.String() == "*" {
+		return fn, "", nil
+	}
+	col, err := q.column(ctx, f.Exprs[0])
+	if err != nil {
+		return "", "", err
+	}
+	if fn == cdc.MViewSum || fn == mviewAvg {
+		typ := types.T(q.cols[col].Typ.Id)
+		if !typ.IsInteger() && !typ.IsFloat() && !typ.IsDecimal() {
+			return "", "", moerr.NewNotSupported(ctx.GetContext(), "%s of the column %s in materialized view", fn, col)
+		}
+	}
+	return fn, col, nil
+}
+
+var mviewComparisonOps = map[tree.ComparisonOp][2]string{
+	// the operator, and the operator with the operands swapped
+	tree.EQUAL:            {cdc.MViewOpEQ, cdc.MViewOpEQ},
+	tree.NOT_EQUAL:        {cdc.MViewOpNE, cdc.MViewOpNE},
+	tree.LESS_THAN:        {cdc.MViewOpLT, cdc.MViewOpGT},
+	tree.LESS_THAN_EQUAL:  {cdc.MViewOpLE, cdc.MViewOpGE},
+	tree.GREAT_THAN:       {cdc.MViewOpGT, cdc.MViewOpLT},
+	tree.GREAT_THAN_EQUAL: {cdc.MViewOpGE, cdc.MViewOpLE},
+}
+
+// buildFilter converts the WHERE clause into the filter of DN
+func (q *mviewQuery) buildFilter(ctx CompilerContext, expr tree.Expr) (*cdc.MViewExpr, error) {
+	logical := func(op string, exprs ...tree.Expr) (*cdc.MViewExpr, error) {
+		result := &cdc.MViewExpr{Op: op}
+		for _, expr := range exprs {
+			arg, err := q.buildFilter(ctx, expr)
+			if err != nil {
+				return nil, err
+			}
+			result.Args = append(result.Args, arg)
+		}
+		return result, nil
+	}
+	switch e := expr.(type) {
+	case *tree.ParenExpr:
+		return q.buildFilter(ctx, e.Expr)
+	case *tree.AndExpr:
+		return logical(cdc.MViewOpAnd, e.Left, e.Right)
+	case *tree.OrExpr:
+		return logical(cdc.MViewOpOr, e.Left, e.Right)
+	case *tree.NotExpr:
+		return logical(cdc.MViewOpNot, e.Expr)
+	case *tree.IsNullExpr:
+		col, err := q.column(ctx, e.Expr)
+		return &cdc.MViewExpr{Op: cdc.MViewOpIsNull, Col: col}, err
+	case *tree.IsNotNullExpr:
+		col, err := q.column(ctx, e.Expr)
+		return &cdc.MViewExpr{Op: cdc.MViewOpIsNotNull, Col: col}, err
+	case *tree.ComparisonExpr:
+		ops, ok := mviewComparisonOps[e.Op]
+		if !ok {
+			break
+		}
+		if value, ok := mviewLiteral(e.Right); ok {
+			col, err := q.column(ctx, e.Left)
+			return &cdc.MViewExpr{Op: ops[0], Col: col, Value: value}, err
+		}
+		if value, ok := mviewLiteral(e.Left); ok {
+			col, err := q.column(ctx, e.Right)
+			return &cdc.MViewExpr{Op: ops[1], Col: col, Value: value}, err
+		}
+	}
+	return nil, moerr.NewNotSupported(ctx.GetContext(), "filter %s in materialized view", tree.String(expr, dialect.MYSQL))
+}
+
+// mviewLiteral returns the value of a literal which is not null
+func mviewLiteral(expr tree.Expr) (string, bool) {
+	switch e := expr.(type) {
+	case *tree.ParenExpr:
+		return mviewLiteral(e.Expr)
+	case *tree.UnaryExpr:
+		if e.Op != tree.UNARY_MINUS && e.Op != tree.UNARY_PLUS {
+			return "", false
+		}
+		num, ok := e.Expr.(*tree.NumVal)
+		if !ok {
+			return "", false
+		}
+		switch num.ValType {
+		case tree.P_int64, tree.P_uint64, tree.P_float64, tree.P_decimal:
+			value, _ := mviewLiteral(num)
+			if e.Op == tree.UNARY_MINUS {
+				value = "-" + value
+			}
+			return value, true
+		}
+	case *tree.NumVal:
+		value := e.String()
+		if e.Negative() {
+			value = "-" + value
+		}
+		switch e.ValType {
+		case tree.P_int64, tree.P_uint64, tree.P_float64, tree.P_decimal, tree.P_char:
+			return value, true
+		case tree.P_bool:
+			return strings.ToLower(e.Value.String()), true
+		}
+	}
+	return "", false
+}
+
+// mviewColType returns the type of the column of the state table that keeps
+// the values of the column of the table
+func mviewColType(ctx CompilerContext, col *ColDef) (string, error) {
+	typ := types.T(col.Typ.Id)
+	switch typ {
+	case types.T_bool, types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64, types.T_date, types.T_uuid, types.T_text, types.T_blob:
+		return typ.String(), nil
+	case types.T_decimal64, types.T_decimal128:
+		return fmt.Sprintf("DECIMAL(%d,%d)", col.Typ.Width, col.Typ.Scale), nil
+	case types.T_char, types.T_varchar:
+		return fmt.Sprintf("%s(%d)", typ.String(), col.Typ.Width), nil
+	case types.T_datetime, types.T_time, types.T_timestamp:
+		return fmt.Sprintf("%s(%d)", typ.String(), col.Typ.Scale), nil
+	}
+	return "", moerr.NewNotSupported(ctx.GetContext(), "column %s of type %s in materialized view", col.Name, typ.String())
+}
+
+// mviewSumType returns the type of the sum of the column
+func mviewSumType(col *ColDef) string {
+	typ := types.T(col.Typ.Id)
+	switch {
+	case typ.IsDecimal():
+		return fmt.Sprintf("DECIMAL(38,%d)", col.Typ.Scale)
+	case typ.IsFloat():
+		return types.T_float64.String()
+	case typ.IsUnsignedInt():
+		return types.T_uint64.String()
+	}
+	return types.T_int64.String()
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// buildSelectWithMaterializedView builds the query on a materialized view of
+// its table if the view has the results of the query, or builds the query as
+// it is.
+func buildSelectWithMaterializedView(ctx CompilerContext, stmt *tree.Select) (*Plan, error) {
+	if rewritten := rewriteByMaterializedView(ctx, stmt); rewritten != nil {
+		if pn, err := runBuildSelectByBinder(plan.Query_SELECT, ctx, rewritten); err == nil {
+			// the plan depends on the materialized views of the table
+			for _, node := range pn.GetQuery().Nodes {
+				node.NotCacheable = true
+			}
+			return pn, nil
+		}
+	}
+	return runBuildSelectByBinder(plan.Query_SELECT, ctx, stmt)
+}
+
+func mviewRewriteEnabled(ctx CompilerContext) bool {
+	val, err := ctx.ResolveVariable(mviewRewriteVar, true, false)
+	if err != nil {
+		return false
+	}
+	switch v := val.(type) {
+	case int8:
+		return v != 0
+	case int64:
+		return v != 0
+	}
+	return false
+}
+
+// rewriteByMaterializedView returns the query reading from a materialized view
+// on its table, which has the same filter and the same groups as the query, and
+// all the results of the query. It returns nil if there is no such view.
+func rewriteByMaterializedView(ctx CompilerContext, stmt *tree.Select) *tree.Select {
+	if clause, ok := stmt.Select.(*tree.SelectClause); !ok || clause.From == nil || !mviewRewriteEnabled(ctx) {
+		return nil
+	}
+	// the results are sorted and limited on the view
+	orderBy, limit := stmt.OrderBy, stmt.Limit
+	query := *stmt
+	query.OrderBy, query.Limit = nil, nil
+	q, err := analyzeMViewQuery(ctx, &query)
+	if err != nil {
+		return nil
+	}
+	views, err := ctx.ResolveMaterializedViews(q.obj, q.tableDef)
+	if err != nil || len(views) == 0 {
+		return nil
+	}
+	// the state table is not restricted by the policies on the table
+	if predicate, err := ctx.ResolvePolicy(q.obj, q.tableDef, tree.PolicyCommandSelect); err != nil || predicate != nil {
+		return nil
+	}
+	for _, view := range views {
+		sql, ok := q.rewrite(ctx, view, orderBy, limit)
+		if !ok {
+			continue
+		}
+		stmts, err := mysql.Parse(ctx.GetContext(), sql, 1)
+		if err != nil || len(stmts) != 1 {
+			continue
+		}
+		if rewritten, ok := stmts[0].(*tree.Select); ok {
+			return rewritten
+		}
+	}
+	return nil
+}
+
+// rewrite returns the query on the materialized view if the view matches
+func (q *mviewQuery) rewrite(ctx CompilerContext, view *MaterializedView, orderBy tree.OrderBy, limit *tree.Limit) (string, bool) {
+	stmts, err := mysql.Parse(ctx.GetContext(), view.Definition, 1)
+	if err != nil || len(stmts) != 1 {
+		return "", false
+	}
+	def, ok := stmts[0].(*tree.Select)
+	if !ok {
+		return "", false
+	}
+	mv, err := analyzeMViewQuery(ctx, def)
+	if err != nil || mv.tableDef.TblId != q.tableDef.TblId ||
+		mv.aggregate != q.aggregate || !reflect.DeepEqual(mv.filter, q.filter) ||
+		len(mv.keys) != len(q.keys) {
+		return "", false
+	}
+	for _, key := range q.keys {
+		if !containsString(mv.keys, key) {
+			return "", false
+		}
+	}
+	columns := make(map[mviewOutput]string, len(mv.outputs))
+	for _, out := range mv.outputs {
+		columns[mviewOutput{fn: out.fn, arg: out.arg}] = out.heading
+	}
+	if q.aggregate {
+		// the groups are in the view without being selected
+		for _, key := range mv.keys {
+			if _, ok := columns[mviewOutput{arg: key}]; !ok {
+				columns[mviewOutput{arg: key}] = key
+			}
+		}
+	}
+
+	var buf strings.Builder
+	buf.WriteString("select ")
+	headings := make(map[string]bool, len(q.outputs))
+	for i, out := range q.outputs {
+		col, ok := columns[mviewOutput{fn: out.fn, arg: out.arg}]
+		if !ok {
+			return "", false
+		}
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		fmt.Fprintf(&buf, "%s as %s", quoteMViewName(col), quoteMViewName(out.heading))
+		headings[strings.ToLower(out.heading)] = true
+	}
+	fmt.Fprintf(&buf, " from %s.%s", quoteMViewName(view.Database), quoteMViewName(view.Name))
+	for i, order := range orderBy {
+		// the results are sorted by their headings, or by the columns of
+		// the view
+		var col string
+		name, ok := order.Expr.(*tree.UnresolvedName)
+		if ok && name.NumParts == 1 && !name.Star && headings[strings.ToLower(name.Parts[0])] {
+			col = name.Parts[0]
+		} else {
+			fn, arg, err := q.output(ctx, order.Expr)
+			if err != nil {
+				return "", false
+			}
+			if col, ok = columns[mviewOutput{fn: fn, arg: arg}]; !ok {
+				return "", false
+			}
+		}
+		if i == 0 {
+			buf.WriteString(" order by ")
+		} else {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(quoteMViewName(col))
+		if order.Direction != tree.DefaultDirection {
+			buf.WriteString(" " + order.Direction.String())
+		}
+		if order.NullsPosition != tree.DefaultNullsPosition {
+			buf.WriteString(" " + order.NullsPosition.String())
+		}
+	}
+	if limit != nil {
+		buf.WriteString(" ")
+		buf.WriteString(tree.String(limit, dialect.MYSQL))
+	}
+	return buf.String(), true
+}
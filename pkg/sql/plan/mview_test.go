@@ -0,0 +1,148 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/cdc"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/stretchr/testify/require"
+)
+
+func buildMViewForTest(t *testing.T, ctx CompilerContext, sql string) (*MaterializedViewDef, error) {
+	stmts, err := mysql.Parse(ctx.GetContext(), sql, 1)
+	require.NoError(t, err)
+	return BuildMaterializedView(ctx, stmts[0].(*tree.CreateMaterializedView))
+}
+
+func TestBuildMaterializedView(t *testing.T) {
+	ctx := NewMockCompilerContext(true)
+	def, err := buildMViewForTest(t, ctx, "create materialized view mv1 as "+
+		"select n_regionkey, count(*), sum(n_nationkey) as s, avg(nation.n_nationkey), min(n_name) from nation "+
+		"where n_nationkey > -1 and not (n_name = 'CHINA' or 2 >= n_regionkey) group by n_regionkey")
+	require.NoError(t, err)
+	require.Equal(t, "tpch", def.Database)
+	require.Equal(t, "__mo_mv_mv1", def.StateTable)
+	require.Equal(t, "tpch.nation", def.Spec.Base)
+	require.Equal(t, "tpch.__mo_mv_mv1", def.Spec.State)
+	require.True(t, def.Spec.Aggregate)
+	require.Equal(t, []string{"n_regionkey"}, def.Spec.Keys)
+	require.Equal(t, &cdc.MViewExpr{Op: cdc.MViewOpAnd, Args: []*cdc.MViewExpr{
+		{Op: cdc.MViewOpGT, Col: "n_nationkey", Value: "-1"},
+		{Op: cdc.MViewOpNot, Args: []*cdc.MViewExpr{
+			{Op: cdc.MViewOpOr, Args: []*cdc.MViewExpr{
+				{Op: cdc.MViewOpEQ, Col: "n_name", Value: "CHINA"},
+				{Op: cdc.MViewOpLE, Col: "n_regionkey", Value: "2"},
+			}},
+		}},
+	}}, def.Spec.Filter)
+	require.Equal(t, []cdc.MViewColumn{
+		{Name: "n_regionkey", Arg: "n_regionkey"},
+		{Name: "__mo_mv_n_n_nationkey", Func: cdc.MViewCount, Arg: "n_nationkey"},
+		{Name: "__mo_mv_sum_n_nationkey", Func: cdc.MViewSum, Arg: "n_nationkey"},
+		{Name: "__mo_mv_min_n_name", Func: cdc.MViewMin, Arg: "n_name"},
+	}, def.Spec.Columns)
+	require.Equal(t, "create table `tpch`.`__mo_mv_mv1` (`__mo_mv_key` varchar(65535) primary key, "+
+		"`__mo_mv_ts` varchar(64), `__mo_mv_count` bigint, `n_regionkey` INT, `__mo_mv_n_n_nationkey` bigint, "+
+		"`__mo_mv_sum_n_nationkey` BIGINT, `__mo_mv_min_n_name` VARCHAR(25))", def.CreateStateSql)
+	require.Equal(t, "create view `tpch`.`mv1` as select `n_regionkey` as `n_regionkey`, "+
+		"`__mo_mv_count` as `count(*)`, `__mo_mv_sum_n_nationkey` as `s`, "+
+		"`__mo_mv_sum_n_nationkey` / `__mo_mv_n_n_nationkey` as `avg(nation.n_nationkey)`, "+
+		"`__mo_mv_min_n_name` as `min(n_name)` from `tpch`.`__mo_mv_mv1` where `__mo_mv_key` <> ''", def.CreateViewSql)
+	require.Equal(t, "select `n_regionkey` as `n_regionkey`, count(*) as `count(*)`, sum(`n_nationkey`) as `s`, "+
+		"avg(`n_nationkey`) as `avg(nation.n_nationkey)`, min(`n_name`) as `min(n_name)` from `tpch`.`nation` "+
+		"where (`n_nationkey` > '-1') and (not ((`n_name` = 'CHINA') or (`n_regionkey` <= '2'))) group by `n_regionkey`", def.Definition)
+
+	// the definition is the same query
+	stmts, err := mysql.Parse(ctx.GetContext(), def.Definition, 1)
+	require.NoError(t, err)
+	q, err := analyzeMViewQuery(ctx, stmts[0].(*tree.Select))
+	require.NoError(t, err)
+	require.Equal(t, def.Spec.Filter, q.filter)
+	require.Equal(t, def.Definition, q.definition())
+
+	// the rows are kept by the primary key without aggregation
+	def, err = buildMViewForTest(t, ctx, "create materialized view mv2 as select n_name, n_comment from nation n where n.n_comment is not null")
+	require.NoError(t, err)
+	require.False(t, def.Spec.Aggregate)
+	require.Equal(t, []string{"n_nationkey"}, def.Spec.Keys)
+	require.Equal(t, &cdc.MViewExpr{Op: cdc.MViewOpIsNotNull, Col: "n_comment"}, def.Spec.Filter)
+
+	for _, sql := range []string{
+		"select n_name from nation, region",
+		"select n_name from (select n_name from nation) t",
+		"select distinct n_name from nation",
+		"select n_regionkey, count(*) from nation group by n_regionkey having count(*) > 1",
+		"select n_name, count(*) from nation group by n_regionkey",
+		"select upper(n_name) from nation",
+		"select count(distinct n_name) from nation",
+		"select sum(n_name) from nation",
+		"select n_name from nation where n_name like 'A%'",
+		"select n_name from nation where n_nationkey > n_regionkey",
+		"select n_name from nation where n_nationkey = null",
+		"select x from nation",
+		"select n_name from nation order by n_name",
+		"select n_name from no_such_table",
+	} {
+		_, err = buildMViewForTest(t, ctx, "create materialized view mv3 as "+sql)
+		require.Error(t, err, sql)
+	}
+}
+
+func TestRewriteByMaterializedView(t *testing.T) {
+	ctx := NewMockCompilerContext(true)
+	def, err := buildMViewForTest(t, ctx, "create materialized view mv1 as "+
+		"select n_regionkey, count(*), sum(n_nationkey) as s, min(n_name) from nation where n_nationkey > 0 and n_name <> 'A' group by n_regionkey")
+	require.NoError(t, err)
+	ctx.mviews = map[string][]*MaterializedView{
+		"nation": {{Database: def.Database, Name: def.Name, Definition: def.Definition}},
+	}
+
+	rewrite := func(sql string) string {
+		stmts, err := mysql.Parse(ctx.GetContext(), sql, 1)
+		require.NoError(t, err)
+		rewritten := rewriteByMaterializedView(ctx, stmts[0].(*tree.Select))
+		if rewritten == nil {
+			return ""
+		}
+		return tree.String(rewritten, dialect.MYSQL)
+	}
+	require.Equal(t, "select s as sum(n_nationkey), n_regionkey as n_regionkey from tpch.mv1",
+		rewrite("select sum(n_nationkey), n_regionkey from nation where n_nationkey > 0 and n_name <> 'A' group by n_regionkey"))
+	require.Equal(t, "select count(*) as c from tpch.mv1 order by min(n_name) desc, c limit 3",
+		rewrite("select count(*) as c from nation where nation.n_nationkey > 0 and n_name <> 'A' group by n_regionkey order by min(n_name) desc, c limit 3"))
+
+	for _, sql := range []string{
+		"select sum(n_nationkey) from nation where n_nationkey > 1 group by n_regionkey",
+		"select sum(n_nationkey) from nation where n_nationkey > 0",
+		"select max(n_name) from nation where n_nationkey > 0 and n_name <> 'A' group by n_regionkey",
+		"select n_name from nation where n_nationkey > 0",
+		"select count(*) from nation where n_nationkey > 0 and n_name <> 'A' group by n_regionkey order by max(n_name)",
+		"select count(*) from region",
+	} {
+		require.Empty(t, rewrite(sql), sql)
+	}
+
+	// the query is built on the table if the view can not be read
+	_, err = runOneStmt(&MockOptimizer{ctxt: *ctx}, t, "select count(*) from nation where n_nationkey > 0 and n_name <> 'A' group by n_regionkey")
+	require.NoError(t, err)
+
+	// the view is not used if the table has policies
+	ctx.policies = map[string]map[string]string{"nation": {"select": "n_regionkey = 1"}}
+	require.Empty(t, rewrite("select count(*) from nation where n_nationkey > 0 and n_name <> 'A' group by n_regionkey"))
+}
@@ -33,8 +33,12 @@ func (builder *QueryBuilder) removeSimpleProjections(nodeID int32, parentType pl
 
 	switch node.NodeType {
 	case plan.Node_JOIN:
-		leftFlag := flag || node.JoinType == plan.Node_RIGHT || node.JoinType == plan.Node_OUTER
-		rightFlag := flag || node.JoinType == plan.Node_LEFT || node.JoinType == plan.Node_OUTER
+		joinType := node.JoinType
+		if joinType == plan.Node_APPLY {
+			joinType = node.ApplyType
+		}
+		leftFlag := flag || joinType == plan.Node_RIGHT || joinType == plan.Node_OUTER
+		rightFlag := flag || joinType == plan.Node_LEFT || joinType == plan.Node_OUTER
 
 		newChildID, childProjMap := builder.removeSimpleProjections(node.Children[0], plan.Node_JOIN, leftFlag, colRefCnt)
 		node.Children[0] = newChildID
@@ -42,6 +46,10 @@ func (builder *QueryBuilder) removeSimpleProjections(nodeID int32, parentType pl
 			projMap[ref] = expr
 		}
 
+		if node.JoinType == plan.Node_APPLY {
+			removeProjectionsForCorrColRefs(builder.qry.Nodes, node.Children[1], childProjMap)
+		}
+
 		newChildID, childProjMap = builder.removeSimpleProjections(node.Children[1], plan.Node_JOIN, rightFlag, colRefCnt)
 		node.Children[1] = newChildID
 		for ref, expr := range childProjMap {
@@ -176,6 +184,17 @@ func removeProjectionsForExpr(expr *plan.Expr, projMap map[[2]int32]*plan.Expr)
 	return expr
 }
 
+// removeProjectionsForCorrColRefs replaces the references to the removed
+// projections of the left child of an APPLY join in its right child.
+func removeProjectionsForCorrColRefs(nodes []*plan.Node, rightID int32, projMap map[[2]int32]*plan.Expr) {
+	_ = ReplaceApplyCorrColRefs(nodes, rightID, func(expr *plan.Expr, corr *plan.CorrColRef) (*plan.Expr, error) {
+		if projExpr, ok := projMap[[2]int32{corr.RelPos, corr.ColPos}]; ok {
+			return increaseDepth(DeepCopyExpr(projExpr), corr.Depth), nil
+		}
+		return expr, nil
+	})
+}
+
 func (builder *QueryBuilder) pushdownFilters(nodeID int32, filters []*plan.Expr, separateNonEquiConds bool) (int32, []*plan.Expr) {
 	node := builder.qry.Nodes[nodeID]
 
@@ -222,6 +241,11 @@ func (builder *QueryBuilder) pushdownFilters(nodeID int32, filters []*plan.Expr,
 		}
 
 	case plan.Node_JOIN:
+		if node.JoinType == plan.Node_APPLY {
+			cantPushdown = builder.pushdownFiltersThroughApply(node, filters, separateNonEquiConds)
+			break
+		}
+
 		leftTags := make(map[int32]*Binding)
 		for _, tag := range builder.enumerateTags(node.Children[0]) {
 			leftTags[tag] = nil
@@ -570,6 +594,53 @@ func (builder *QueryBuilder) pushdownFilters(nodeID int32, filters []*plan.Expr,
 	return nodeID, cantPushdown
 }
 
+// pushdownFiltersThroughApply pushes the filters on the columns of the left
+// child of an APPLY join down to it, and returns the others. The right child is
+// evaluated for each row of the left child, only the filters in it are pushed
+// down inside it.
+func (builder *QueryBuilder) pushdownFiltersThroughApply(node *plan.Node, filters []*plan.Expr, separateNonEquiConds bool) []*plan.Expr {
+	leftTags := make(map[int32]*Binding)
+	for _, tag := range builder.enumerateTags(node.Children[0]) {
+		leftTags[tag] = nil
+	}
+
+	rightTags := make(map[int32]*Binding)
+	for _, tag := range builder.enumerateTags(node.Children[1]) {
+		rightTags[tag] = nil
+	}
+
+	var markTag int32
+	if node.ApplyType == plan.Node_MARK {
+		markTag = node.BindingTags[0]
+	}
+
+	var leftPushdown, cantPushdown []*plan.Expr
+	for _, filter := range filters {
+		// the correlated columns are bound before the join is evaluated
+		if getJoinSide(filter, leftTags, rightTags, markTag)&^JoinSideCorrelated == JoinSideLeft {
+			leftPushdown = append(leftPushdown, filter)
+		} else {
+			cantPushdown = append(cantPushdown, filter)
+		}
+	}
+
+	for i, pushdown := range [][]*plan.Expr{leftPushdown, nil} {
+		childID, cantPushdownChild := builder.pushdownFilters(node.Children[i], pushdown, separateNonEquiConds)
+
+		if len(cantPushdownChild) > 0 {
+			childID = builder.appendNode(&plan.Node{
+				NodeType:   plan.Node_FILTER,
+				Children:   []int32{childID},
+				FilterList: cantPushdownChild,
+			}, nil)
+		}
+
+		node.Children[i] = childID
+	}
+
+	return cantPushdown
+}
+
 func (builder *QueryBuilder) swapJoinChildren(nodeID int32) {
 	node := builder.qry.Nodes[nodeID]
 
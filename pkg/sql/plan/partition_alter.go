@@ -0,0 +1,346 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// buildAlterTablePartition builds the partition maintenance operation of alter table.
+// The partitions are stored in hidden tables, so ADD, DROP and TRUNCATE only work on
+// the metadata and the hidden tables, while EXCHANGE and REORGANIZE move the rows of
+// the partitions involved at execution time.
+func buildAlterTablePartition(ctx CompilerContext, databaseName string, tableDef *TableDef, opt *tree.AlterOptionPartition) (*plan.AlterTablePartition, error) {
+	partitionDef := tableDef.Partition
+	if partitionDef == nil {
+		return nil, moerr.NewInvalidInput(ctx.GetContext(), "partition management on a not partitioned table is not possible")
+	}
+	for _, indexDef := range tableDef.Indexes {
+		if indexDef.TableExist {
+			return nil, moerr.NewNotSupported(ctx.GetContext(), "partition management on a table with secondary index %s", indexDef.IndexName)
+		}
+	}
+
+	alterPartition := &plan.AlterTablePartition{}
+	switch opt.Typ {
+	case tree.AlterPartitionAdd:
+		alterPartition.Typ = plan.AlterTablePartition_ADD
+		if !isRangeOrListPartition(partitionDef) {
+			return nil, moerr.NewNotSupported(ctx.GetContext(), "ADD PARTITION on %s partitioned table", partitionDef.Type.String())
+		}
+		partitionOp, err := parsePartitionOption(ctx, partitionDef)
+		if err != nil {
+			return nil, err
+		}
+		partitionOp.Partitions = append(partitionOp.Partitions, opt.Partitions...)
+		if alterPartition.PartitionDef, err = buildAlteredPartitionDef(ctx, tableDef, partitionOp); err != nil {
+			return nil, err
+		}
+		alterPartition.NewPartitionTableNames = alterPartition.PartitionDef.PartitionTableNames[len(partitionDef.Partitions):]
+
+	case tree.AlterPartitionDrop:
+		alterPartition.Typ = plan.AlterTablePartition_DROP
+		if !isRangeOrListPartition(partitionDef) {
+			return nil, moerr.NewInvalidInput(ctx.GetContext(), "DROP PARTITION can only be used on RANGE/LIST partitions")
+		}
+		positions, err := findPartitions(ctx, partitionDef, opt.Names, "DROP")
+		if err != nil {
+			return nil, err
+		}
+		if len(positions) == len(partitionDef.Partitions) {
+			return nil, moerr.NewInvalidInput(ctx.GetContext(), "cannot remove all partitions, use DROP TABLE instead")
+		}
+		partitionOp, err := parsePartitionOption(ctx, partitionDef)
+		if err != nil {
+			return nil, err
+		}
+		partitions := make([]*tree.Partition, 0, len(partitionOp.Partitions)-len(positions))
+		for i, partition := range partitionOp.Partitions {
+			if _, ok := positions[i]; !ok {
+				partitions = append(partitions, partition)
+			}
+		}
+		partitionOp.Partitions = partitions
+		if alterPartition.PartitionDef, err = buildAlteredPartitionDef(ctx, tableDef, partitionOp); err != nil {
+			return nil, err
+		}
+		alterPartition.PartitionTableNames = partitionTableNamesOf(partitionDef, opt.Names, positions)
+
+	case tree.AlterPartitionTruncate:
+		alterPartition.Typ = plan.AlterTablePartition_TRUNCATE
+		if opt.All {
+			alterPartition.PartitionTableNames = partitionDef.PartitionTableNames
+			break
+		}
+		positions, err := findPartitions(ctx, partitionDef, opt.Names, "TRUNCATE")
+		if err != nil {
+			return nil, err
+		}
+		alterPartition.PartitionTableNames = partitionTableNamesOf(partitionDef, opt.Names, positions)
+
+	case tree.AlterPartitionExchange:
+		alterPartition.Typ = plan.AlterTablePartition_EXCHANGE
+		positions, err := findPartitions(ctx, partitionDef, opt.Names, "EXCHANGE")
+		if err != nil {
+			return nil, err
+		}
+		alterPartition.PartitionTableNames = partitionTableNamesOf(partitionDef, opt.Names, positions)
+		alterPartition.PartitionDef = partitionDef
+		alterPartition.WithoutValidation = opt.WithoutValidation
+
+		exchangeDbName := string(opt.ExchangeTable.SchemaName)
+		if exchangeDbName == "" {
+			exchangeDbName = ctx.DefaultDatabase()
+		}
+		if exchangeDbName != databaseName {
+			return nil, moerr.NewNotSupported(ctx.GetContext(), "exchange partition with a table of another database")
+		}
+		exchangeTableName := string(opt.ExchangeTable.ObjectName)
+		if exchangeTableName == tableDef.Name {
+			return nil, moerr.NewInvalidInput(ctx.GetContext(), "cannot exchange a partition with the partitioned table itself")
+		}
+		_, exchangeTableDef := ctx.Resolve(exchangeDbName, exchangeTableName)
+		if exchangeTableDef == nil {
+			return nil, moerr.NewNoSuchTable(ctx.GetContext(), exchangeDbName, exchangeTableName)
+		}
+		if err = checkExchangeTable(ctx, tableDef, exchangeTableDef); err != nil {
+			return nil, err
+		}
+		alterPartition.ExchangeDbName = exchangeDbName
+		alterPartition.ExchangeTableName = exchangeTableName
+
+	case tree.AlterPartitionReorganize:
+		alterPartition.Typ = plan.AlterTablePartition_REORGANIZE
+		if !isRangeOrListPartition(partitionDef) {
+			return nil, moerr.NewNotSupported(ctx.GetContext(), "REORGANIZE PARTITION on %s partitioned table", partitionDef.Type.String())
+		}
+		positions, err := findPartitions(ctx, partitionDef, opt.Names, "REORGANIZE")
+		if err != nil {
+			return nil, err
+		}
+		// the reorganized partitions must be consecutive, and they are replaced
+		// by the new partitions in place
+		first, last := len(partitionDef.Partitions), -1
+		for i := range positions {
+			if i < first {
+				first = i
+			}
+			if i > last {
+				last = i
+			}
+		}
+		if last-first+1 != len(positions) {
+			return nil, moerr.NewInvalidInput(ctx.GetContext(), "when reorganizing a set of partitions they must be in consecutive order")
+		}
+		partitionOp, err := parsePartitionOption(ctx, partitionDef)
+		if err != nil {
+			return nil, err
+		}
+		partitions := make([]*tree.Partition, 0, len(partitionOp.Partitions)-len(positions)+len(opt.Partitions))
+		partitions = append(partitions, partitionOp.Partitions[:first]...)
+		partitions = append(partitions, opt.Partitions...)
+		partitions = append(partitions, partitionOp.Partitions[last+1:]...)
+		partitionOp.Partitions = partitions
+		if alterPartition.PartitionDef, err = buildAlteredPartitionDef(ctx, tableDef, partitionOp); err != nil {
+			return nil, err
+		}
+
+		// the range covered by the partitions can only be changed when the last partition is reorganized
+		newLast := first + len(opt.Partitions) - 1
+		if isRangePartition(partitionDef) && last != len(partitionDef.Partitions)-1 &&
+			partitionDef.Partitions[last].Description != alterPartition.PartitionDef.Partitions[newLast].Description {
+			return nil, moerr.NewInvalidInput(ctx.GetContext(), "reorganize of range partitions cannot change total ranges except for last partition where it can extend the range")
+		}
+		alterPartition.PartitionTableNames = partitionTableNamesOf(partitionDef, opt.Names, positions)
+		alterPartition.NewPartitionTableNames = alterPartition.PartitionDef.PartitionTableNames[first : newLast+1]
+	}
+	return alterPartition, nil
+}
+
+func isRangePartition(partitionDef *plan.PartitionByDef) bool {
+	return partitionDef.Type == plan.PartitionType_RANGE || partitionDef.Type == plan.PartitionType_RANGE_COLUMNS
+}
+
+func isRangeOrListPartition(partitionDef *plan.PartitionByDef) bool {
+	return isRangePartition(partitionDef) || partitionDef.Type == plan.PartitionType_LIST || partitionDef.Type == plan.PartitionType_LIST_COLUMNS
+}
+
+// findPartitions returns the positions of the named partitions in the partition definition
+func findPartitions(ctx CompilerContext, partitionDef *plan.PartitionByDef, names tree.IdentifierList, op string) (map[int]struct{}, error) {
+	positions := make(map[int]struct{}, len(names))
+	for _, name := range names {
+		found := false
+		for i, partition := range partitionDef.Partitions {
+			if partition.PartitionName == string(name) {
+				if _, ok := positions[i]; ok {
+					return nil, moerr.NewInvalidInput(ctx.GetContext(), "duplicate partition name %s", name)
+				}
+				positions[i] = struct{}{}
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, moerr.NewInvalidInput(ctx.GetContext(), "error in list of partitions to %s", op)
+		}
+	}
+	return positions, nil
+}
+
+// partitionTableNamesOf returns the hidden table names of the named partitions in the given order
+func partitionTableNamesOf(partitionDef *plan.PartitionByDef, names tree.IdentifierList, positions map[int]struct{}) []string {
+	tableNames := make([]string, 0, len(positions))
+	for _, name := range names {
+		for i, partition := range partitionDef.Partitions {
+			if partition.PartitionName == string(name) {
+				tableNames = append(tableNames, partitionDef.PartitionTableNames[i])
+				break
+			}
+		}
+	}
+	return tableNames
+}
+
+// parsePartitionOption parses the partition clause of the table back to the syntax tree,
+// so that the altered partitions can be built by the partition builders again.
+func parsePartitionOption(ctx CompilerContext, partitionDef *plan.PartitionByDef) (*tree.PartitionOption, error) {
+	stmt, err := parsers.ParseOne(ctx.GetContext(), dialect.MYSQL, "create table t (a int) "+partitionDef.PartitionMsg, 1)
+	if err != nil {
+		return nil, err
+	}
+	createTable, ok := stmt.(*tree.CreateTable)
+	if !ok || createTable.PartitionOption == nil {
+		return nil, moerr.NewInternalError(ctx.GetContext(), "invalid partition info %s", partitionDef.PartitionMsg)
+	}
+	// the number of partitions is given by the partition list
+	createTable.PartitionOption.PartBy.Num = 0
+	return createTable.PartitionOption, nil
+}
+
+// buildAlteredPartitionDef builds and checks the partition definition of the table after alteration.
+func buildAlteredPartitionDef(ctx CompilerContext, tableDef *TableDef, partitionOp *tree.PartitionOption) (*plan.PartitionByDef, error) {
+	stmt := &tree.CreateTable{
+		Table:           *tree.NewTableName(tree.Identifier(tableDef.Name), tree.ObjectNamePrefix{}),
+		PartitionOption: partitionOp,
+	}
+	scanDef := DeepCopyTableDef(tableDef)
+	scanDef.Partition = nil
+
+	builder := NewQueryBuilder(plan.Query_SELECT, ctx)
+	bindContext := NewBindContext(builder, nil)
+	nodeID := builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_TABLE_SCAN,
+		TableDef:    scanDef,
+		BindingTags: []int32{builder.genNewTag()},
+	}, bindContext)
+	if err := builder.addBinding(nodeID, tree.AliasClause{}, bindContext); err != nil {
+		return nil, err
+	}
+	partitionBinder := NewPartitionBinder(builder, bindContext)
+	if err := buildPartitionByClause(ctx.GetContext(), partitionBinder, stmt, scanDef); err != nil {
+		return nil, err
+	}
+
+	partitionDef := scanDef.Partition
+	if isRangePartition(partitionDef) {
+		if err := checkRangePartitionsIncreasing(ctx, partitionDef); err != nil {
+			return nil, err
+		}
+	}
+	if err := setPartitionTableNames(ctx.GetContext(), tableDef.Name, partitionDef); err != nil {
+		return nil, err
+	}
+	return partitionDef, nil
+}
+
+// checkRangePartitionsIncreasing checks the VALUES LESS THAN value is strictly increasing for each partition.
+// Only the single value bounds are checked.
+func checkRangePartitionsIncreasing(ctx CompilerContext, partitionDef *plan.PartitionByDef) error {
+	for i := 1; i < len(partitionDef.Partitions); i++ {
+		prev, cur := partitionDef.Partitions[i-1].LessThan, partitionDef.Partitions[i].LessThan
+		if len(prev) != 1 || len(cur) != 1 {
+			return nil
+		}
+		if _, ok := prev[0].Expr.(*plan.Expr_Max); ok {
+			return moerr.NewInvalidInput(ctx.GetContext(), "MAXVALUE can only be used in last partition definition")
+		}
+		if _, ok := cur[0].Expr.(*plan.Expr_Max); ok {
+			continue
+		}
+		expr, err := bindFuncExprImplByPlanExpr(ctx.GetContext(), "<", []*Expr{DeepCopyExpr(prev[0]), DeepCopyExpr(cur[0])})
+		if err != nil {
+			return err
+		}
+		bat := batch.NewWithSize(0)
+		bat.Zs = []int64{1}
+		if expr, err = ConstantFold(bat, expr, ctx.GetProcess()); err != nil {
+			return err
+		}
+		if c, ok := expr.Expr.(*plan.Expr_C); ok && !c.C.Isnull && !c.C.GetBval() {
+			return moerr.NewInvalidInput(ctx.GetContext(), "VALUES LESS THAN value must be strictly increasing for each partition")
+		}
+	}
+	return nil
+}
+
+// checkExchangeTable checks the standalone table can be exchanged with a partition of the table
+func checkExchangeTable(ctx CompilerContext, tableDef *TableDef, exchangeTableDef *TableDef) error {
+	name := exchangeTableDef.Name
+	if exchangeTableDef.Partition != nil {
+		return moerr.NewInvalidInput(ctx.GetContext(), "table to exchange with partition is partitioned: '%s'", name)
+	}
+	if exchangeTableDef.ViewSql != nil || exchangeTableDef.TableType != catalog.SystemOrdinaryRel {
+		return moerr.NewInvalidInput(ctx.GetContext(), "table to exchange with partition is not a normal table: '%s'", name)
+	}
+	if len(exchangeTableDef.Fkeys) > 0 || len(exchangeTableDef.RefChildTbls) > 0 {
+		return moerr.NewInvalidInput(ctx.GetContext(), "table to exchange with partition has foreign key references: '%s'", name)
+	}
+	for _, indexDef := range exchangeTableDef.Indexes {
+		if indexDef.TableExist {
+			return moerr.NewNotSupported(ctx.GetContext(), "exchange partition with table %s which has secondary index %s", name, indexDef.IndexName)
+		}
+	}
+
+	if len(tableDef.Cols) != len(exchangeTableDef.Cols) {
+		return moerr.NewInvalidInput(ctx.GetContext(), "tables have different definitions")
+	}
+	for i, col := range tableDef.Cols {
+		other := exchangeTableDef.Cols[i]
+		if col.Name != other.Name || col.Typ.Id != other.Typ.Id ||
+			col.Typ.Width != other.Typ.Width || col.Typ.Scale != other.Typ.Scale {
+			return moerr.NewInvalidInput(ctx.GetContext(), "tables have different definitions")
+		}
+	}
+	if getTablePriKeyName(tableDef.Pkey) != getTablePriKeyName(exchangeTableDef.Pkey) {
+		return moerr.NewInvalidInput(ctx.GetContext(), "tables have different definitions")
+	}
+	if tableDef.Pkey != nil {
+		if len(tableDef.Pkey.Names) != len(exchangeTableDef.Pkey.Names) {
+			return moerr.NewInvalidInput(ctx.GetContext(), "tables have different definitions")
+		}
+		for i, name := range tableDef.Pkey.Names {
+			if name != exchangeTableDef.Pkey.Names[i] {
+				return moerr.NewInvalidInput(ctx.GetContext(), "tables have different definitions")
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func getAlterTablePartition(t *testing.T, mock *MockOptimizer, sql string) *plan.AlterTablePartition {
+	p, err := buildSingleStmt(mock, t, sql)
+	require.NoError(t, err, sql)
+	actions := p.GetDdl().GetAlterTable().GetActions()
+	require.Equal(t, 1, len(actions), sql)
+	return actions[0].GetAlterPartition()
+}
+
+func getPartitionNames(partitionDef *plan.PartitionByDef) []string {
+	names := make([]string, len(partitionDef.Partitions))
+	for i, partition := range partitionDef.Partitions {
+		names[i] = partition.PartitionName
+	}
+	return names
+}
+
+func TestAlterTablePartition(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	mockPartitionTable(t, mock, "pt_range", `create table pt_range (a int, b varchar(20))
+		partition by range (a) (
+			partition p0 values less than (10),
+			partition p1 values less than (20),
+			partition p2 values less than (30))`)
+	mockPartitionTable(t, mock, "pt_list", `create table pt_list (a int, b int)
+		partition by list (a) (
+			partition p0 values in (1, 2, 3),
+			partition p1 values in (4, 5, 6))`)
+
+	// add
+	alter := getAlterTablePartition(t, mock, "alter table pt_range add partition (partition p3 values less than (40), partition p4 values less than maxvalue)")
+	require.Equal(t, plan.AlterTablePartition_ADD, alter.Typ)
+	require.Equal(t, []string{"p0", "p1", "p2", "p3", "p4"}, getPartitionNames(alter.PartitionDef))
+	require.Equal(t, []string{"%!%p3%!%pt_range", "%!%p4%!%pt_range"}, alter.NewPartitionTableNames)
+	require.Equal(t, 5, int(alter.PartitionDef.PartitionNum))
+
+	alter = getAlterTablePartition(t, mock, "alter table pt_list add partition (partition p2 values in (7, 8))")
+	require.Equal(t, []string{"p0", "p1", "p2"}, getPartitionNames(alter.PartitionDef))
+
+	// drop
+	alter = getAlterTablePartition(t, mock, "alter table pt_range drop partition p1")
+	require.Equal(t, plan.AlterTablePartition_DROP, alter.Typ)
+	require.Equal(t, []string{"p0", "p2"}, getPartitionNames(alter.PartitionDef))
+	require.Equal(t, []string{"%!%p1%!%pt_range"}, alter.PartitionTableNames)
+	require.Equal(t, []string{"%!%p0%!%pt_range", "%!%p2%!%pt_range"}, alter.PartitionDef.PartitionTableNames)
+
+	// truncate
+	alter = getAlterTablePartition(t, mock, "alter table pt_range truncate partition p2, p0")
+	require.Equal(t, plan.AlterTablePartition_TRUNCATE, alter.Typ)
+	require.Equal(t, []string{"%!%p2%!%pt_range", "%!%p0%!%pt_range"}, alter.PartitionTableNames)
+	alter = getAlterTablePartition(t, mock, "alter table pt_range truncate partition all")
+	require.Equal(t, 3, len(alter.PartitionTableNames))
+
+	// reorganize
+	alter = getAlterTablePartition(t, mock, `alter table pt_range reorganize partition p0, p1 into (
+		partition p0 values less than (5), partition p05 values less than (10), partition p1 values less than (20))`)
+	require.Equal(t, plan.AlterTablePartition_REORGANIZE, alter.Typ)
+	require.Equal(t, []string{"p0", "p05", "p1", "p2"}, getPartitionNames(alter.PartitionDef))
+	require.Equal(t, []string{"%!%p0%!%pt_range", "%!%p1%!%pt_range"}, alter.PartitionTableNames)
+	require.Equal(t, []string{"%!%p0%!%pt_range", "%!%p05%!%pt_range", "%!%p1%!%pt_range"}, alter.NewPartitionTableNames)
+
+	// the last partition can extend the range
+	alter = getAlterTablePartition(t, mock, "alter table pt_range reorganize partition p2 into (partition p2 values less than (30), partition p3 values less than maxvalue)")
+	require.Equal(t, []string{"p0", "p1", "p2", "p3"}, getPartitionNames(alter.PartitionDef))
+
+	// exchange
+	for name, sql := range map[string]string{
+		"t_exchange": "create table t_exchange (a int, b varchar(20))",
+		"t_other":    "create table t_other (a int, b varchar(10))",
+	} {
+		p, err := buildSingleStmt(mock, t, sql)
+		require.NoError(t, err)
+		tableDef := p.GetDdl().GetCreateTable().GetTableDef()
+		tableDef.TableType = catalog.SystemOrdinaryRel
+		mock.ctxt.tables[name] = tableDef
+		mock.ctxt.objects[name] = &ObjectRef{SchemaName: "tpch", ObjName: name, PubAccountId: -1}
+	}
+
+	alter = getAlterTablePartition(t, mock, "alter table pt_range exchange partition p1 with table t_exchange without validation")
+	require.Equal(t, plan.AlterTablePartition_EXCHANGE, alter.Typ)
+	require.Equal(t, []string{"%!%p1%!%pt_range"}, alter.PartitionTableNames)
+	require.Equal(t, "tpch", alter.ExchangeDbName)
+	require.Equal(t, "t_exchange", alter.ExchangeTableName)
+	require.True(t, alter.WithoutValidation)
+
+	kases := []string{
+		"alter table nation add partition (partition p0 values less than (10))",
+		"alter table pt_range add partition (partition p3 values less than (25))",
+		"alter table pt_range add partition (partition p2 values less than (40))",
+		"alter table pt_list add partition (partition p2 values in (6, 7))",
+		"alter table pt_range drop partition p3",
+		"alter table pt_range drop partition p0, p1, p2",
+		"alter table pt_range truncate partition p0, p0",
+		"alter table pt_range reorganize partition p0, p2 into (partition p0 values less than (30))",
+		"alter table pt_range reorganize partition p0, p1 into (partition p0 values less than (25))",
+		"alter table pt_range exchange partition p0 with table t_other",
+		"alter table pt_range exchange partition p0 with table pt_list",
+		"alter table pt_range exchange partition p0 with table pt_range",
+		"alter table pt_range exchange partition p1 with table other_db.t_exchange without validation",
+	}
+	for _, sql := range kases {
+		_, err := buildSingleStmt(mock, t, sql)
+		require.Error(t, err, sql)
+	}
+
+	// the partition definition of the table is not changed
+	_, tableDef := mock.ctxt.Resolve("tpch", "pt_range")
+	require.Equal(t, []string{"p0", "p1", "p2"}, getPartitionNames(tableDef.Partition))
+}
@@ -0,0 +1,471 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/rule"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// partitionColumnFilter is the condition on one partition column that is
+// derived from the filters of the table scan.
+type partitionColumnFilter struct {
+	// points are the constants that the column equals to.
+	points []*Expr
+	// lower and upper are the bounds of the column. nil means unbounded.
+	lower, upper *Expr
+}
+
+func (f *partitionColumnFilter) isPoints() bool {
+	return len(f.points) != 0
+}
+
+func (f *partitionColumnFilter) isRange() bool {
+	return f.lower != nil || f.upper != nil
+}
+
+// partitionPruner evaluates the filters of the table scan against the
+// partition definitions, and keeps only the partitions that may have the rows.
+type partitionPruner struct {
+	proc      *process.Process
+	partition *plan.PartitionByDef
+	// colPos maps the column in the filters to the column position in the
+	// partition expression. false means the column is not the column of the table.
+	colPos func(col *plan.ColRef) (int32, bool)
+	// colTypes are the types of the columns used by the partition expression.
+	colTypes map[int32]*plan.Type
+}
+
+// partitionMaxCombinations limits the number of the value combinations evaluated
+// for the partition expression.
+const partitionMaxCombinations = PartitionCountLimit
+
+func newPartitionPruner(proc *process.Process, partition *plan.PartitionByDef, colPos func(col *plan.ColRef) (int32, bool)) *partitionPruner {
+	pruner := &partitionPruner{
+		proc:      proc,
+		partition: partition,
+		colPos:    colPos,
+		colTypes:  make(map[int32]*plan.Type),
+	}
+	pruner.collectColumns(partition.PartitionExpression)
+	return pruner
+}
+
+func (pruner *partitionPruner) collectColumns(expr *Expr) {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		pruner.colTypes[exprImpl.Col.ColPos] = expr.Typ
+	case *plan.Expr_F:
+		for _, arg := range exprImpl.F.Args {
+			pruner.collectColumns(arg)
+		}
+	}
+}
+
+// prune returns the partition definition that has only the partitions matching the filters.
+// The partition definition is returned unchanged if the filters can not prune any partition.
+func (pruner *partitionPruner) prune(filters []*Expr) *plan.PartitionByDef {
+	partition := pruner.partition
+	if pruner.proc == nil || partition.PartitionExpression == nil || len(pruner.colTypes) == 0 || len(filters) == 0 {
+		return partition
+	}
+
+	colFilters := make(map[int32]*partitionColumnFilter)
+	for _, filter := range filters {
+		mergePartitionColumnFilters(colFilters, pruner.buildColumnFilters(filter))
+	}
+
+	selected, ok := pruner.selectByPoints(colFilters)
+	if !ok {
+		selected, ok = pruner.selectByRange(colFilters)
+	}
+	if !ok {
+		return partition
+	}
+	return prunePartitionDef(partition, selected)
+}
+
+// buildColumnFilters derives the conditions of the partition columns from the filter.
+func (pruner *partitionPruner) buildColumnFilters(expr *Expr) map[int32]*partitionColumnFilter {
+	fn, ok := expr.Expr.(*plan.Expr_F)
+	if !ok {
+		return nil
+	}
+	args := fn.F.Args
+	switch fn.F.Func.ObjName {
+	case "and":
+		colFilters := pruner.buildColumnFilters(args[0])
+		if colFilters == nil {
+			colFilters = make(map[int32]*partitionColumnFilter)
+		}
+		mergePartitionColumnFilters(colFilters, pruner.buildColumnFilters(args[1]))
+		return colFilters
+
+	case "or":
+		left := pruner.buildColumnFilters(args[0])
+		right := pruner.buildColumnFilters(args[1])
+		var colFilters map[int32]*partitionColumnFilter
+		for pos, l := range left {
+			r, ok := right[pos]
+			if !ok || !l.isPoints() || !r.isPoints() {
+				continue
+			}
+			if colFilters == nil {
+				colFilters = make(map[int32]*partitionColumnFilter)
+			}
+			colFilters[pos] = &partitionColumnFilter{
+				points: append(append([]*Expr{}, l.points...), r.points...),
+			}
+		}
+		return colFilters
+
+	case "=", "<", "<=", ">", ">=":
+		op := fn.F.Func.ObjName
+		pos, ok := pruner.columnOf(args[0])
+		value := args[1]
+		if !ok {
+			pos, ok = pruner.columnOf(args[1])
+			value = args[0]
+			op = reverseComparisonOp(op)
+		}
+		if !ok {
+			return nil
+		}
+		if value, ok = pruner.valueOfColumn(value, pos); !ok {
+			return nil
+		}
+		colFilter := &partitionColumnFilter{}
+		switch op {
+		case "=":
+			colFilter.points = []*Expr{value}
+		case "<", "<=":
+			colFilter.upper = value
+		case ">", ">=":
+			colFilter.lower = value
+		}
+		return map[int32]*partitionColumnFilter{pos: colFilter}
+
+	case "in":
+		pos, ok := pruner.columnOf(args[0])
+		if !ok {
+			return nil
+		}
+		list, ok := args[1].Expr.(*plan.Expr_List)
+		if !ok || len(list.List.List) == 0 {
+			return nil
+		}
+		points := make([]*Expr, len(list.List.List))
+		for i, value := range list.List.List {
+			if points[i], ok = pruner.valueOfColumn(value, pos); !ok {
+				return nil
+			}
+		}
+		return map[int32]*partitionColumnFilter{pos: {points: points}}
+	}
+	return nil
+}
+
+func (pruner *partitionPruner) columnOf(expr *Expr) (int32, bool) {
+	col, ok := expr.Expr.(*plan.Expr_Col)
+	if !ok {
+		return 0, false
+	}
+	pos, ok := pruner.colPos(col.Col)
+	if !ok {
+		return 0, false
+	}
+	if _, ok = pruner.colTypes[pos]; !ok {
+		return 0, false
+	}
+	return pos, true
+}
+
+// valueOfColumn folds the expr into a non-null constant with the same type as the column.
+// The constant is not cast to avoid the partition being computed on a different value.
+func (pruner *partitionPruner) valueOfColumn(expr *Expr, pos int32) (*Expr, bool) {
+	if expr.Typ.Id != pruner.colTypes[pos].Id || !rule.IsConstant(expr) {
+		return nil, false
+	}
+	if _, ok := expr.Expr.(*plan.Expr_C); !ok {
+		bat := batch.NewWithSize(0)
+		bat.Zs = []int64{1}
+		var err error
+		if expr, err = ConstantFold(bat, DeepCopyExpr(expr), pruner.proc); err != nil {
+			return nil, false
+		}
+	}
+	c, ok := expr.Expr.(*plan.Expr_C)
+	if !ok || c.C.Isnull {
+		return nil, false
+	}
+	return expr, true
+}
+
+func reverseComparisonOp(op string) string {
+	switch op {
+	case "<":
+		return ">"
+	case "<=":
+		return ">="
+	case ">":
+		return "<"
+	case ">=":
+		return "<="
+	}
+	return op
+}
+
+// mergePartitionColumnFilters merges the conditions that are connected by AND.
+// The points are preferred over the ranges.
+func mergePartitionColumnFilters(dst, src map[int32]*partitionColumnFilter) {
+	for pos, s := range src {
+		d, ok := dst[pos]
+		if !ok {
+			dst[pos] = s
+			continue
+		}
+		switch {
+		case s.isPoints():
+			if !d.isPoints() || len(s.points) < len(d.points) {
+				dst[pos] = s
+			}
+		case d.isPoints():
+		default:
+			if d.lower == nil {
+				d.lower = s.lower
+			}
+			if d.upper == nil {
+				d.upper = s.upper
+			}
+		}
+	}
+}
+
+// selectByPoints computes the partitions of all the combinations of the values,
+// when every partition column equals to some constants.
+func (pruner *partitionPruner) selectByPoints(colFilters map[int32]*partitionColumnFilter) (map[int32]bool, bool) {
+	positions := make([]int32, 0, len(pruner.colTypes))
+	combinations := 1
+	for pos := range pruner.colTypes {
+		colFilter, ok := colFilters[pos]
+		if !ok || !colFilter.isPoints() {
+			return nil, false
+		}
+		combinations *= len(colFilter.points)
+		if combinations > partitionMaxCombinations {
+			return nil, false
+		}
+		positions = append(positions, pos)
+	}
+
+	selected := make(map[int32]bool)
+	values := make(map[int32]*Expr, len(positions))
+	var walk func(i int) bool
+	walk = func(i int) bool {
+		if i == len(positions) {
+			idx, ok := pruner.evalPartition(values)
+			if !ok {
+				return false
+			}
+			if idx >= 0 {
+				selected[idx] = true
+			}
+			return true
+		}
+		for _, point := range colFilters[positions[i]].points {
+			values[positions[i]] = point
+			if !walk(i + 1) {
+				return false
+			}
+		}
+		return true
+	}
+	if !walk(0) {
+		return nil, false
+	}
+	return selected, true
+}
+
+// selectByRange computes the first and the last partitions of the range on the column,
+// when the table is partitioned by RANGE on a non-decreasing function of one column.
+func (pruner *partitionPruner) selectByRange(colFilters map[int32]*partitionColumnFilter) (map[int32]bool, bool) {
+	partition := pruner.partition
+	var rangeExpr *Expr
+	switch partition.Type {
+	case plan.PartitionType_RANGE:
+		if partition.PartitionExpr != nil {
+			rangeExpr = partition.PartitionExpr.Expr
+		}
+	case plan.PartitionType_RANGE_COLUMNS:
+		if partition.PartitionColumns != nil && len(partition.PartitionColumns.Columns) == 1 {
+			rangeExpr = partition.PartitionColumns.Columns[0]
+		}
+	}
+	if rangeExpr == nil || len(pruner.colTypes) != 1 || !isNonDecreasingPartitionExpr(rangeExpr) {
+		return nil, false
+	}
+
+	var pos int32
+	for colPos := range pruner.colTypes {
+		pos = colPos
+	}
+	colFilter, ok := colFilters[pos]
+	if !ok || !colFilter.isRange() {
+		return nil, false
+	}
+
+	//the partitions are numbered from 0 in the order of VALUES LESS THAN
+	first, last := int32(0), int32(len(partition.Partitions))-1
+	if len(partition.Partitions) != 0 {
+		first = int32(partition.Partitions[0].OrdinalPosition) - 1
+		last = int32(partition.Partitions[len(partition.Partitions)-1].OrdinalPosition) - 1
+	}
+	if colFilter.lower != nil {
+		idx, ok := pruner.evalPartition(map[int32]*Expr{pos: colFilter.lower})
+		if !ok {
+			return nil, false
+		}
+		if idx < 0 {
+			// the lower bound is beyond all the partitions
+			return map[int32]bool{}, true
+		}
+		if idx > first {
+			first = idx
+		}
+	}
+	if colFilter.upper != nil {
+		idx, ok := pruner.evalPartition(map[int32]*Expr{pos: colFilter.upper})
+		if !ok {
+			return nil, false
+		}
+		if idx >= 0 && idx < last {
+			last = idx
+		}
+	}
+
+	selected := make(map[int32]bool)
+	for idx := first; idx <= last; idx++ {
+		selected[idx] = true
+	}
+	return selected, true
+}
+
+// isNonDecreasingPartitionExpr checks the value of the partition expression does not
+// decrease as the column increases.
+func isNonDecreasingPartitionExpr(expr *Expr) bool {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		return true
+	case *plan.Expr_F:
+		args := exprImpl.F.Args
+		switch exprImpl.F.Func.ObjName {
+		case "year", "to_days", "to_seconds", "unix_timestamp":
+			return len(args) >= 1 && isNonDecreasingPartitionExpr(args[0])
+		case "cast":
+			// the order of the strings is different from the order of the values cast from them
+			return len(args) >= 1 && !types.T(args[0].Typ.Id).IsMySQLString() && isNonDecreasingPartitionExpr(args[0])
+		case "+":
+			if _, ok := args[0].Expr.(*plan.Expr_C); ok {
+				return isNonDecreasingPartitionExpr(args[1])
+			}
+			if _, ok := args[1].Expr.(*plan.Expr_C); ok {
+				return isNonDecreasingPartitionExpr(args[0])
+			}
+		case "-":
+			if _, ok := args[1].Expr.(*plan.Expr_C); ok {
+				return isNonDecreasingPartitionExpr(args[0])
+			}
+		}
+	}
+	return false
+}
+
+// evalPartition computes the partition expression on the values of the columns.
+// It returns -1 if the values do not belong to any partition.
+func (pruner *partitionPruner) evalPartition(values map[int32]*Expr) (int32, bool) {
+	expr := replaceColumnsWithValues(DeepCopyExpr(pruner.partition.PartitionExpression), values)
+	bat := batch.NewWithSize(0)
+	bat.Zs = []int64{1}
+	expr, err := ConstantFold(bat, expr, pruner.proc)
+	if err != nil {
+		return 0, false
+	}
+	c, ok := expr.Expr.(*plan.Expr_C)
+	if !ok || c.C.Isnull {
+		return 0, false
+	}
+	switch v := c.C.Value.(type) {
+	case *plan.Const_I32Val:
+		return v.I32Val, true
+	case *plan.Const_I64Val:
+		return int32(v.I64Val), true
+	}
+	return 0, false
+}
+
+func replaceColumnsWithValues(expr *Expr, values map[int32]*Expr) *Expr {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		if value, ok := values[exprImpl.Col.ColPos]; ok {
+			expr.Expr = DeepCopyExpr(value).Expr
+		}
+	case *plan.Expr_F:
+		for i, arg := range exprImpl.F.Args {
+			exprImpl.F.Args[i] = replaceColumnsWithValues(arg, values)
+		}
+	}
+	return expr
+}
+
+// prunePartitionDef keeps the partitions whose ordinal position is selected.
+func prunePartitionDef(partition *plan.PartitionByDef, selected map[int32]bool) *plan.PartitionByDef {
+	pruned := &plan.PartitionByDef{
+		Type:                partition.Type,
+		PartitionExpr:       partition.PartitionExpr,
+		PartitionExpression: partition.PartitionExpression,
+		PartitionColumns:    partition.PartitionColumns,
+		Algorithm:           partition.Algorithm,
+		IsSubPartition:      partition.IsSubPartition,
+		PartitionMsg:        partition.PartitionMsg,
+	}
+	for i, item := range partition.Partitions {
+		if !selected[int32(item.OrdinalPosition)-1] {
+			continue
+		}
+		pruned.Partitions = append(pruned.Partitions, item)
+		if i < len(partition.PartitionTableNames) {
+			pruned.PartitionTableNames = append(pruned.PartitionTableNames, partition.PartitionTableNames[i])
+		}
+	}
+	pruned.PartitionNum = uint64(len(pruned.PartitionTableNames))
+	return pruned
+}
+
+// prunePartitionsOfTableScan prunes the partitions of the table scan before
+// the column references in the filters are remapped.
+func (builder *QueryBuilder) prunePartitionsOfTableScan(node *Node) *plan.PartitionByDef {
+	partition := node.TableDef.Partition
+	if partition == nil || len(node.FilterList) == 0 {
+		return partition
+	}
+	tag := node.BindingTags[0]
+	pruner := newPartitionPruner(builder.compCtx.GetProcess(), partition, func(col *plan.ColRef) (int32, bool) {
+		return col.ColPos, col.RelPos == tag
+	})
+	return pruner.prune(node.FilterList)
+}
@@ -0,0 +1,179 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"go/constant"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/stretchr/testify/require"
+)
+
+func mockPartitionTable(t *testing.T, mock *MockOptimizer, name string, sql string) {
+	p, err := buildSingleStmt(mock, t, sql)
+	require.NoError(t, err)
+	tableDef := p.GetDdl().GetCreateTable().GetTableDef()
+	require.NotNil(t, tableDef.Partition)
+	mock.ctxt.tables[name] = tableDef
+	mock.ctxt.objects[name] = &ObjectRef{SchemaName: "tpch", ObjName: name, PubAccountId: -1}
+	mock.ctxt.stats[name] = &plan.Stats{Outcnt: 1}
+}
+
+func getPartitionsOfTableScan(p *Plan, tableName string) []string {
+	for _, node := range p.GetQuery().GetNodes() {
+		if node.NodeType == plan.Node_TABLE_SCAN && node.TableDef.Name == tableName {
+			names := make([]string, 0, len(node.TableDef.Partition.Partitions))
+			for _, partition := range node.TableDef.Partition.Partitions {
+				names = append(names, partition.PartitionName)
+			}
+			require.Equal(nil, len(names), int(node.TableDef.Partition.PartitionNum))
+			require.Equal(nil, len(names), len(node.TableDef.Partition.PartitionTableNames))
+			return names
+		}
+	}
+	return nil
+}
+
+func TestPartitionPrune(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	mockPartitionTable(t, mock, "pt_range", `create table pt_range (a int, b varchar(20))
+		partition by range (a) (
+			partition p0 values less than (10),
+			partition p1 values less than (20),
+			partition p2 values less than (30),
+			partition p3 values less than maxvalue)`)
+	mockPartitionTable(t, mock, "pt_date", `create table pt_date (a int, d date)
+		partition by range (year(d)) (
+			partition p0 values less than (2000),
+			partition p1 values less than (2010),
+			partition p2 values less than (2020))`)
+	mockPartitionTable(t, mock, "pt_list", `create table pt_list (a int, b int)
+		partition by list (a) (
+			partition p0 values in (1, 2, 3),
+			partition p1 values in (4, 5, 6),
+			partition p2 values in (7, 8, 9))`)
+	mockPartitionTable(t, mock, "pt_hash", `create table pt_hash (a int, b int)
+		partition by hash (a) partitions 4`)
+
+	kases := []struct {
+		sql        string
+		table      string
+		partitions []string
+	}{
+		{"select * from pt_range where a = 15", "pt_range", []string{"p1"}},
+		{"select * from pt_range where a in (1, 25)", "pt_range", []string{"p0", "p2"}},
+		{"select * from pt_range where a = 1 or a = 100", "pt_range", []string{"p0", "p3"}},
+		{"select * from pt_range where a > 12 and a < 25", "pt_range", []string{"p1", "p2"}},
+		{"select * from pt_range where a between 15 and 35", "pt_range", []string{"p1", "p2", "p3"}},
+		{"select * from pt_range where a <= 5", "pt_range", []string{"p0"}},
+		{"select * from pt_range where 25 < a", "pt_range", []string{"p2", "p3"}},
+		{"select * from pt_range where a > 15 and a = 3", "pt_range", []string{"p0"}},
+		{"select * from pt_range where b = 'x'", "pt_range", []string{"p0", "p1", "p2", "p3"}},
+		{"select * from pt_range where a = 1 or b = 'x'", "pt_range", []string{"p0", "p1", "p2", "p3"}},
+		{"select * from pt_date where d >= '2012-01-01'", "pt_date", []string{"p2"}},
+		{"select * from pt_date where d > '2030-01-01'", "pt_date", []string{}},
+		{"select * from pt_list where a in (2, 8)", "pt_list", []string{"p0", "p2"}},
+		{"select * from pt_list where a = 10", "pt_list", []string{}},
+		{"select * from pt_list where a > 5", "pt_list", []string{"p0", "p1", "p2"}},
+		{"select * from pt_hash where a = 3", "pt_hash", []string{"p2"}},
+		{"select * from pt_hash where a = 3 or a = 4", "pt_hash", []string{"p1", "p2"}},
+		{"select b from pt_range where a = 15", "pt_range", []string{"p1"}},
+	}
+	for _, kase := range kases {
+		p, err := runOneStmt(mock, t, kase.sql)
+		require.NoError(t, err, kase.sql)
+		require.Equal(t, kase.partitions, getPartitionsOfTableScan(p, kase.table), kase.sql)
+	}
+
+	// the partition definition of the table is not changed
+	_, tableDef := mock.ctxt.Resolve("tpch", "pt_range")
+	require.Equal(t, 4, len(tableDef.Partition.Partitions))
+}
+
+func TestPartitionPruneRule(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	mockPartitionTable(t, mock, "pt_range", `create table pt_range (a int, b varchar(20))
+		partition by range (a) (
+			partition p0 values less than (10),
+			partition p1 values less than (20),
+			partition p2 values less than (30),
+			partition p3 values less than maxvalue)`)
+
+	kases := []struct {
+		sql string
+		// the partitions pruned by the filters without the parameters
+		prepared   []string
+		partitions []string
+	}{
+		{"prepare s1 from 'select b from pt_range where a in (?, 5)'",
+			[]string{"p0", "p1", "p2", "p3"}, []string{"p0", "p2"}},
+		{"prepare s1 from 'select b from pt_range where a > ? and a < 25'",
+			[]string{"p0", "p1", "p2"}, []string{"p2"}},
+		{"prepare s1 from 'select b from pt_range where a <= ? and b = ?'",
+			[]string{"p0", "p1", "p2", "p3"}, []string{"p0", "p1", "p2"}},
+	}
+	for _, kase := range kases {
+		p, err := runOneStmt(mock, t, kase.sql)
+		require.NoError(t, err, kase.sql)
+		preparePlan := DeepCopyPlan(p.GetDcl().GetPrepare().GetPlan())
+		require.Equal(t, kase.prepared, getPartitionsOfTableScan(preparePlan, "pt_range"), kase.sql)
+
+		// @int_var is 20 and @str_var is 'str'
+		e, err := runOneStmt(mock, t, "execute s1 using @int_var, @str_var")
+		require.NoError(t, err)
+		compCtx := mock.CurrentContext()
+		vp := NewVisitPlan(preparePlan, []VisitPlanRule{
+			NewResetParamRefRule(context.TODO(), e.GetDcl().GetExecute().GetArgs()),
+			NewResetVarRefRule(compCtx, compCtx.GetProcess()),
+			NewConstantFoldRule(compCtx),
+			NewPartitionPruneRule(compCtx),
+		})
+		require.NoError(t, vp.Visit(context.TODO()), kase.sql)
+		require.Equal(t, kase.partitions, getPartitionsOfTableScan(preparePlan, "pt_range"), kase.sql)
+	}
+}
+
+func TestPartitionPruneRuleWithLiterals(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	mockPartitionTable(t, mock, "pt_range", `create table pt_range (a int, b varchar(20))
+		partition by range (a) (
+			partition p0 values less than (10),
+			partition p1 values less than (20),
+			partition p2 values less than (30),
+			partition p3 values less than maxvalue)`)
+
+	// the query parameterised automatically binds its literals to the params
+	p, err := runOneStmt(mock, t, "prepare s1 from 'select b from pt_range where a > ? and a < ? and b = ?'")
+	require.NoError(t, err)
+	preparePlan := DeepCopyPlan(p.GetDcl().GetPrepare().GetPlan())
+	compCtx := mock.CurrentContext()
+	args, err := BuildExecuteArgs(compCtx, []tree.Expr{
+		tree.NewNumValWithType(constant.MakeInt64(12), "12", false, tree.P_int64),
+		tree.NewNumValWithType(constant.MakeInt64(25), "25", false, tree.P_int64),
+		tree.NewNumValWithType(constant.MakeString("str"), "str", false, tree.P_char),
+	})
+	require.NoError(t, err)
+	vp := NewVisitPlan(preparePlan, []VisitPlanRule{
+		NewResetParamRefRule(context.TODO(), args),
+		NewResetVarRefRule(compCtx, compCtx.GetProcess()),
+		NewConstantFoldRule(compCtx),
+		NewPartitionPruneRule(compCtx),
+	})
+	require.NoError(t, vp.Visit(context.TODO()))
+	require.Equal(t, []string{"p1", "p2"}, getPartitionsOfTableScan(preparePlan, "pt_range"))
+}
@@ -246,6 +246,9 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, colRefCnt map[[2]int3
 			TableType:     node.TableDef.TableType,
 			Partition:     node.TableDef.Partition,
 		}
+		if node.NodeType == plan.Node_TABLE_SCAN {
+			newTableDef.Partition = builder.prunePartitionsOfTableScan(node)
+		}
 
 		for i, col := range node.TableDef.Cols {
 			globalRef := [2]int32{tag, int32(i)}
@@ -377,6 +380,17 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, colRefCnt map[[2]int3
 			increaseRefCnt(expr, colRefCnt)
 		}
 
+		// the right child of an APPLY join refers to the columns of the left
+		// child, which are bound to the values of each row of the left child
+		var corrRefs []*plan.CorrColRef
+		if node.JoinType == plan.Node_APPLY {
+			_ = ReplaceApplyCorrColRefs(builder.qry.Nodes, node.Children[1], func(expr *plan.Expr, corr *plan.CorrColRef) (*plan.Expr, error) {
+				colRefCnt[[2]int32{corr.RelPos, corr.ColPos}]++
+				corrRefs = append(corrRefs, corr)
+				return expr, nil
+			})
+		}
+
 		internalMap := make(map[[2]int32][2]int32)
 
 		leftID := node.Children[0]
@@ -389,6 +403,17 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, colRefCnt map[[2]int3
 			internalMap[k] = v
 		}
 
+		for _, corr := range corrRefs {
+			mapID := [2]int32{corr.RelPos, corr.ColPos}
+			colRefCnt[mapID]--
+			ids, ok := leftRemapping.globalToLocal[mapID]
+			if !ok {
+				return nil, moerr.NewParseError(builder.GetContext(), "can't find column %v in context's map %v", mapID, leftRemapping.globalToLocal)
+			}
+			corr.RelPos = ids[0]
+			corr.ColPos = ids[1]
+		}
+
 		rightID := node.Children[1]
 		rightRemapping, err := builder.remapAllColRefs(rightID, colRefCnt)
 		if err != nil {
@@ -407,6 +432,13 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, colRefCnt map[[2]int3
 			}
 		}
 
+		for _, spec := range node.RuntimeFilterBuildList {
+			err := builder.remapColRefForExpr(spec.Expr, internalMap)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		childProjList := builder.qry.Nodes[leftID].ProjectList
 		for i, globalRef := range leftRemapping.localToGlobal {
 			if colRefCnt[globalRef] == 0 {
@@ -427,7 +459,7 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, colRefCnt map[[2]int3
 			})
 		}
 
-		if node.JoinType == plan.Node_MARK {
+		if node.JoinType == plan.Node_MARK || (node.JoinType == plan.Node_APPLY && node.ApplyType == plan.Node_MARK) {
 			globalRef := [2]int32{node.BindingTags[0], 0}
 			remapping.addColRef(globalRef)
 
@@ -839,8 +871,12 @@ func (builder *QueryBuilder) createQuery() (*Query, error) {
 		SortFilterListByStats(builder.GetContext(), rootID, builder)
 		builder.qry.Steps[i] = rootID
 
+		builder.applyJoinHints(rootID)
+		builder.applyMergeJoins(rootID)
+
 		// XXX: This will be removed soon, after merging implementation of all join operators
 		builder.swapJoinChildren(rootID)
+		builder.pushdownRuntimeFilters(rootID)
 
 		colRefCnt = make(map[[2]int32]int)
 		rootNode := builder.qry.Nodes[rootID]
@@ -854,6 +890,7 @@ func (builder *QueryBuilder) createQuery() (*Query, error) {
 			return nil, err
 		}
 	}
+	builder.qry.Warnings = builder.hintWarningsOf()
 	return builder.qry, nil
 }
 
@@ -1391,6 +1428,9 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 		if err != nil {
 			return 0, err
 		}
+		if clause.Hints != nil {
+			builder.resolveHints(clause.Hints, ctx)
+		}
 
 		ctx.binder = NewWhereBinder(builder, ctx)
 		// unfold stars and generate headings
@@ -1846,8 +1886,8 @@ func (builder *QueryBuilder) buildTable(stmt tree.TableExpr, ctx *BindContext, p
 	case *tree.Select:
 		subCtx := NewBindContext(builder, ctx)
 		nodeID, err = builder.buildSelect(tbl, subCtx, false)
-		if subCtx.isCorrelated {
-			return 0, moerr.NewNYI(builder.GetContext(), "correlated subquery in FROM clause")
+		if err != nil {
+			return
 		}
 
 		if subCtx.hasSingleRow {
@@ -2039,7 +2079,11 @@ func (builder *QueryBuilder) buildTable(stmt tree.TableExpr, ctx *BindContext, p
 			}
 		}
 
-		nodeID, err = builder.buildTable(tbl.Expr, ctx, preNodeId, leftCtx)
+		if tbl.Lateral {
+			nodeID, err = builder.buildLateralTable(tbl.Expr, ctx, preNodeId, leftCtx)
+		} else {
+			nodeID, err = builder.buildTable(tbl.Expr, ctx, preNodeId, leftCtx)
+		}
 		if err != nil {
 			return
 		}
@@ -2108,6 +2152,13 @@ func (builder *QueryBuilder) buildTable(stmt tree.TableExpr, ctx *BindContext, p
 					builder.qry.Nodes[nodeID].FilterList = accountFilterExprs
 				}
 			}
+
+			if err == nil {
+				err = builder.appendPolicyFilter(nodeID, ctx)
+			}
+			if err == nil {
+				err = builder.appendTTLFilter(nodeID, tbl.As, ctx)
+			}
 		}
 		return
 	case *tree.StatementSource:
@@ -2273,16 +2324,25 @@ func (builder *QueryBuilder) buildJoinTable(tbl *tree.JoinTableExpr, ctx *BindCo
 		}
 	}
 
+	joinNode := &plan.Node{
+		NodeType: plan.Node_JOIN,
+		Children: []int32{leftChildID, rightChildID},
+		JoinType: joinType,
+	}
+	if rightCtx.isLateral {
+		if joinType != plan.Node_INNER && joinType != plan.Node_LEFT {
+			return 0, moerr.NewSyntaxError(builder.GetContext(), "LATERAL derived table can only be used in an inner or left join")
+		}
+		joinNode.JoinType = plan.Node_APPLY
+		joinNode.ApplyType = joinType
+	}
+
 	err = ctx.mergeContexts(builder.GetContext(), leftCtx, rightCtx)
 	if err != nil {
 		return 0, err
 	}
 
-	nodeID := builder.appendNode(&plan.Node{
-		NodeType: plan.Node_JOIN,
-		Children: []int32{leftChildID, rightChildID},
-		JoinType: joinType,
-	}, ctx)
+	nodeID := builder.appendNode(joinNode, ctx)
 	node := builder.qry.Nodes[nodeID]
 
 	ctx.binder = NewTableBinder(builder, ctx)
@@ -2338,9 +2398,120 @@ func (builder *QueryBuilder) buildJoinTable(tbl *tree.JoinTableExpr, ctx *BindCo
 		}
 	}
 
+	if node.JoinType == plan.Node_APPLY {
+		err = builder.unnestLateralTable(node)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return nodeID, nil
+}
+
+// buildLateralTable builds a LATERAL derived table, which may refer to the
+// tables before it in the FROM clause. If it does, the context is marked so
+// that the join with them is built as an APPLY join.
+func (builder *QueryBuilder) buildLateralTable(stmt tree.TableExpr, ctx *BindContext, preNodeId int32, leftCtx *BindContext) (int32, error) {
+	paren, ok := stmt.(*tree.ParenTableExpr)
+	if !ok || preNodeId == -1 {
+		return builder.buildTable(stmt, ctx, preNodeId, leftCtx)
+	}
+	sel, ok := paren.Expr.(*tree.Select)
+	if !ok {
+		return builder.buildTable(stmt, ctx, preNodeId, leftCtx)
+	}
+
+	if leftCtx.binder == nil {
+		leftCtx.binder = NewTableBinder(builder, leftCtx)
+	}
+
+	subCtx := NewBindContext(builder, leftCtx)
+	nodeID, err := builder.buildSelect(sel, subCtx, false)
+	if err != nil {
+		return 0, err
+	}
+
+	if subCtx.isCorrelated {
+		ctx.isLateral = true
+	}
+	if subCtx.hasSingleRow {
+		ctx.hasSingleRow = true
+	}
+
 	return nodeID, nil
 }
 
+// unnestLateralTable turns the APPLY join with a LATERAL derived table into a
+// plain join, if the predicates referring to the tables before it can be
+// lifted into the join conditions.
+func (builder *QueryBuilder) unnestLateralTable(node *plan.Node) error {
+	rightID := node.Children[1]
+	// every row of the outer query joins the row of an aggregation without GROUP BY
+	if !builder.canPullupCorrelatedPredicates(rightID, true) {
+		return nil
+	}
+
+	rightID, preds, err := builder.pullupCorrelatedPredicates(rightID, builder.ctxByNode[rightID])
+	if err != nil {
+		return err
+	}
+
+	_, joinPreds := decreaseDepthAndDispatch(preds)
+	node.Children[1] = rightID
+	node.JoinType = node.ApplyType
+	node.ApplyType = plan.Node_INNER
+	node.OnList = append(node.OnList, joinPreds...)
+
+	return nil
+}
+
+// setPolicyCommand sets the command of the row level security policies used by the table.
+func (builder *QueryBuilder) setPolicyCommand(tableId uint64, command string) {
+	if builder.policyCmdByTable == nil {
+		builder.policyCmdByTable = make(map[uint64]string)
+	}
+	builder.policyCmdByTable[tableId] = command
+}
+
+// appendPolicyFilter appends the predicate of the row level security policies on the table
+// into the filters of the table scan. The predicate is added before the optimization,
+// so it is pushed down and used to prune the blocks like the filters written by the user.
+func (builder *QueryBuilder) appendPolicyFilter(nodeID int32, ctx *BindContext) error {
+	node := builder.qry.Nodes[nodeID]
+	command, ok := builder.policyCmdByTable[node.TableDef.TblId]
+	if !ok {
+		command = tree.PolicyCommandSelect
+	}
+	predicate, err := builder.compCtx.ResolvePolicy(node.ObjRef, node.TableDef, command)
+	if err != nil || predicate == nil {
+		return err
+	}
+
+	// the predicate is shared by the statements, so the column names are
+	// not qualified in place like splitAndBindCondition does
+	ctx.binder = NewWhereBinder(builder, ctx)
+	conds := splitAstConjunction(predicate)
+	policyFilterExprs := make([]*plan.Expr, 0, len(conds))
+	for _, cond := range conds {
+		filter, err := ctx.binder.BindExpr(cond, 0, true)
+		if err != nil {
+			return err
+		}
+		if hasSubquery(filter) || hasCorrCol(filter) {
+			return moerr.NewNotSupported(builder.GetContext(), "the predicate of the policy on the table %s refers to other tables", node.TableDef.Name)
+		}
+		filter, err = makePlan2CastExpr(builder.GetContext(), filter, &plan.Type{Id: int32(types.T_bool)})
+		if err != nil {
+			return err
+		}
+		policyFilterExprs = append(policyFilterExprs, filter)
+	}
+	node.FilterList = append(node.FilterList, policyFilterExprs...)
+	// the plan depends on the role of the session
+	node.NotCacheable = true
+	return nil
+}
+
 func (builder *QueryBuilder) buildTableFunction(tbl *tree.TableFunction, ctx *BindContext, preNodeId int32, leftCtx *BindContext) (int32, error) {
 	var (
 		childId int32
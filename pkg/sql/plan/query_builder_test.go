@@ -66,6 +66,7 @@ func TestBuildTable_AlterView(t *testing.T) {
 	}
 	ctx := NewMockCompilerContext2(ctrl)
 	ctx.EXPECT().ResolveVariable(gomock.Any(), gomock.Any(), gomock.Any()).Return("", nil).AnyTimes()
+	ctx.EXPECT().ResolvePolicy(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 	ctx.EXPECT().Resolve(gomock.Any(), gomock.Any()).DoAndReturn(
 		func(schemaName string, tableName string) (*ObjectRef, *TableDef) {
 			if schemaName == "" {
@@ -0,0 +1,64 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func getFiltersOfTableScan(p *Plan, tableName string) []*plan.Expr {
+	var filters []*plan.Expr
+	for _, node := range p.GetQuery().GetNodes() {
+		if node.NodeType == plan.Node_TABLE_SCAN && node.TableDef.Name == tableName {
+			filters = append(filters, node.FilterList...)
+		}
+	}
+	return filters
+}
+
+func TestRowPolicy(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	mock.ctxt.policies = map[string]map[string]string{
+		"nation": {
+			"select": "n_regionkey = 1",
+			"delete": "n_nationkey > 10 and n_name != 'CHINA'",
+		},
+		"region": {
+			"all": "r_regionkey in (select n_regionkey from nation)",
+		},
+	}
+
+	p, err := runOneStmt(mock, t, "select n_name from nation")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(getFiltersOfTableScan(p, "nation")))
+
+	p, err = runOneStmt(mock, t, "select n_name from nation where n_nationkey < 5")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(getFiltersOfTableScan(p, "nation")))
+
+	p, err = runOneStmt(mock, t, "delete from nation where n_nationkey < 5")
+	require.NoError(t, err)
+	require.Equal(t, 3, len(getFiltersOfTableScan(p, "nation")))
+
+	p, err = runOneStmt(mock, t, "update nation set n_comment = 'x'")
+	require.NoError(t, err)
+	require.Equal(t, 0, len(getFiltersOfTableScan(p, "nation")))
+
+	_, err = runOneStmt(mock, t, "select r_name from region")
+	require.Error(t, err)
+}
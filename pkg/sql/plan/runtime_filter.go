@@ -0,0 +1,124 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// A table scan smaller than a block is read as cheap as it is filtered.
+const runtimeFilterMinProbeRows = 8192
+
+// pushdownRuntimeFilters connects the hash build of every broadcast equi join
+// to the table scans on its probe side whose columns are the join keys. At
+// run time the build side sends the filters of its keys, and the scan prunes
+// blocks and rows with them before they enter the pipeline.
+//
+// It must run after the join children are swapped and before the column
+// references are remapped, because the scans are found by binding tags.
+func (builder *QueryBuilder) pushdownRuntimeFilters(nodeID int32) {
+	node := builder.qry.Nodes[nodeID]
+	for _, childID := range node.Children {
+		builder.pushdownRuntimeFilters(childID)
+	}
+
+	if node.NodeType != plan.Node_JOIN || node.BuildOnLeft || len(node.OnList) == 0 ||
+		node.JoinMethod == plan.Node_NESTED_LOOP_JOIN || node.JoinMethod == plan.Node_MERGE_JOIN {
+		return
+	}
+	if node.JoinType != plan.Node_INNER && node.JoinType != plan.Node_SEMI {
+		return
+	}
+	left, right := builder.qry.Nodes[node.Children[0]], builder.qry.Nodes[node.Children[1]]
+	// a shuffled join builds a part of the keys in each scope
+	if ShouldShuffleJoin(node, left, right) {
+		return
+	}
+
+	rightTags := make(map[int32]*Binding)
+	for _, tag := range builder.enumerateTags(right.NodeId) {
+		rightTags[tag] = nil
+	}
+	for _, expr := range node.OnList {
+		f, ok := expr.Expr.(*plan.Expr_F)
+		if !ok || !SupportedJoinCondition(f.F.Func.GetObj()) || len(f.F.Args) != 2 {
+			continue
+		}
+		for i := 0; i < 2; i++ {
+			probeExpr, buildExpr := f.F.Args[i], f.F.Args[1-i]
+			col, ok := probeExpr.Expr.(*plan.Expr_Col)
+			if !ok || !isRuntimeFilterType(probeExpr.Typ, buildExpr.Typ) {
+				continue
+			}
+			if getJoinSide(buildExpr, nil, rightTags, 0) != JoinSideRight {
+				continue
+			}
+			scan := builder.findRuntimeFilterProbeScan(left.NodeId, col.Col.RelPos)
+			if scan == nil || scan.Stats == nil || scan.Stats.Outcnt < runtimeFilterMinProbeRows ||
+				right.Stats == nil || right.Stats.Outcnt >= scan.Stats.Outcnt {
+				continue
+			}
+			tag := builder.genNewTag()
+			node.RuntimeFilterBuildList = append(node.RuntimeFilterBuildList, &plan.RuntimeFilterSpec{
+				Tag:  tag,
+				Expr: DeepCopyExpr(buildExpr),
+			})
+			scan.RuntimeFilterProbeList = append(scan.RuntimeFilterProbeList, &plan.RuntimeFilterSpec{
+				Tag:     tag,
+				ColName: scan.TableDef.Cols[col.Col.ColPos].Name,
+			})
+			break
+		}
+	}
+}
+
+// findRuntimeFilterProbeScan finds the table scan bound to tag, through the
+// probe sides of the joins which keep their probe rows in the same scopes.
+func (builder *QueryBuilder) findRuntimeFilterProbeScan(nodeID int32, tag int32) *plan.Node {
+	node := builder.qry.Nodes[nodeID]
+	switch node.NodeType {
+	case plan.Node_TABLE_SCAN:
+		if len(node.BindingTags) > 0 && node.BindingTags[0] == tag && node.TableDef != nil {
+			return node
+		}
+	case plan.Node_JOIN:
+		if node.BuildOnLeft {
+			return nil
+		}
+		switch node.JoinType {
+		case plan.Node_INNER, plan.Node_SEMI, plan.Node_LEFT:
+			return builder.findRuntimeFilterProbeScan(node.Children[0], tag)
+		}
+	}
+	return nil
+}
+
+// isRuntimeFilterType tells whether the keys of both sides are compared by
+// their bytes, the scan filters rows by the encoded build keys.
+func isRuntimeFilterType(probe, build *plan.Type) bool {
+	if probe == nil || build == nil || probe.Id != build.Id || probe.Scale != build.Scale {
+		return false
+	}
+	switch types.T(probe.Id) {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_date, types.T_time, types.T_datetime, types.T_timestamp,
+		types.T_decimal64, types.T_decimal128, types.T_uuid,
+		types.T_char, types.T_varchar, types.T_binary, types.T_varbinary:
+		return true
+	}
+	return false
+}
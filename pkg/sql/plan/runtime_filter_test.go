@@ -0,0 +1,54 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuntimeFilter(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	collect := func(sql string) (builds, probes []*plan.RuntimeFilterSpec) {
+		p, err := runOneStmt(mock, t, sql)
+		require.NoError(t, err)
+		for _, node := range p.GetQuery().Nodes {
+			builds = append(builds, node.RuntimeFilterBuildList...)
+			probes = append(probes, node.RuntimeFilterProbeList...)
+		}
+		return
+	}
+
+	// the small nation table filters the scan of supplier
+	builds, probes := collect("select s_name, n_name from supplier join nation on s_nationkey = n_nationkey")
+	require.Equal(t, 1, len(builds))
+	require.Equal(t, 1, len(probes))
+	require.Equal(t, builds[0].Tag, probes[0].Tag)
+	require.Equal(t, "s_nationkey", probes[0].ColName)
+	// the build key is remapped to the build side of the join
+	require.Equal(t, int32(1), builds[0].Expr.GetCol().RelPos)
+
+	// the probe side is too small to be filtered
+	builds, probes = collect("select n_name, r_name from nation join region on n_regionkey = r_regionkey")
+	require.Equal(t, 0, len(builds))
+	require.Equal(t, 0, len(probes))
+
+	// the scan is not on the probe side of an outer join
+	builds, _ = collect("select s_name, n_name from nation left join supplier on s_nationkey = n_nationkey")
+	require.Equal(t, 0, len(builds))
+}
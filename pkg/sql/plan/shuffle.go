@@ -14,8 +14,53 @@
 
 package plan
 
+import (
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
 func SimpleHashToRange(bytes []byte, upperLimit int) int {
 	lenBytes := len(bytes)
 	//sample five bytes
 	return (int(bytes[0])*(int(bytes[lenBytes/4])+int(bytes[lenBytes/2])+int(bytes[lenBytes*3/4])) + int(bytes[lenBytes-1])) % upperLimit
 }
+
+// Below these estimated cardinalities a broadcast is cheaper than a shuffle,
+// because every consumer builds a small hashmap anyway.
+const (
+	ShuffleGroupNDVThreshold = 50000
+	ShuffleJoinNDVThreshold  = 100000
+)
+
+// ShouldShuffleGroup reports whether the input of an AGG node should be hash
+// partitioned on the group by keys instead of broadcast to all group scopes.
+// The group count estimated from NDV is kept in the HashmapSize of its stats.
+func ShouldShuffleGroup(n *plan.Node) bool {
+	if n.NodeType != plan.Node_AGG || len(n.GroupBy) == 0 || n.Stats == nil {
+		return false
+	}
+	return n.Stats.HashmapSize >= ShuffleGroupNDVThreshold
+}
+
+// ShouldShuffleJoin reports whether both sides of an equi inner join should be
+// hash partitioned on the join keys instead of broadcasting the build side.
+// Like the join stats, the NDV of join keys is estimated by the smaller side.
+// A BROADCAST or SHUFFLE hint on the join overrides the estimation.
+func ShouldShuffleJoin(n, left, right *plan.Node) bool {
+	if n.NodeType != plan.Node_JOIN || n.JoinType != plan.Node_INNER ||
+		len(n.OnList) == 0 || !IsEquiJoin(n.OnList) ||
+		n.JoinMethod == plan.Node_NESTED_LOOP_JOIN || n.JoinMethod == plan.Node_MERGE_JOIN {
+		return false
+	}
+	switch n.JoinDistribution {
+	case plan.Node_BROADCAST_JOIN:
+		return false
+	case plan.Node_SHUFFLE_JOIN:
+		return true
+	}
+	if left.Stats == nil || right.Stats == nil {
+		return false
+	}
+	return math.Min(left.Stats.Outcnt, right.Stats.Outcnt) >= ShuffleJoinNDVThreshold
+}
@@ -0,0 +1,56 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldShuffleGroup(t *testing.T) {
+	groupBy := []*plan.Expr{{Expr: &plan.Expr_Col{Col: &plan.ColRef{}}}}
+	n := &plan.Node{NodeType: plan.Node_AGG, GroupBy: groupBy, Stats: &plan.Stats{HashmapSize: ShuffleGroupNDVThreshold}}
+	require.True(t, ShouldShuffleGroup(n))
+
+	n.Stats.HashmapSize = 100
+	require.False(t, ShouldShuffleGroup(n))
+
+	n = &plan.Node{NodeType: plan.Node_AGG, Stats: &plan.Stats{HashmapSize: 1, Outcnt: 1}}
+	require.False(t, ShouldShuffleGroup(n))
+}
+
+func TestShouldShuffleJoin(t *testing.T) {
+	col := func(rel int32) *plan.Expr {
+		return &plan.Expr{Expr: &plan.Expr_Col{Col: &plan.ColRef{RelPos: rel}}}
+	}
+	eq := &plan.Expr{Expr: &plan.Expr_F{F: &plan.Function{
+		Func: &plan.ObjectRef{ObjName: "="},
+		Args: []*plan.Expr{col(0), col(1)},
+	}}}
+	big := &plan.Node{Stats: &plan.Stats{Outcnt: ShuffleJoinNDVThreshold * 10}}
+	small := &plan.Node{Stats: &plan.Stats{Outcnt: 100}}
+
+	n := &plan.Node{NodeType: plan.Node_JOIN, JoinType: plan.Node_INNER, OnList: []*plan.Expr{eq}}
+	require.True(t, ShouldShuffleJoin(n, big, big))
+	require.False(t, ShouldShuffleJoin(n, big, small))
+
+	n.JoinType = plan.Node_LEFT
+	require.False(t, ShouldShuffleJoin(n, big, big))
+
+	n = &plan.Node{NodeType: plan.Node_JOIN, JoinType: plan.Node_INNER}
+	require.False(t, ShouldShuffleJoin(n, big, big))
+}
@@ -47,6 +47,8 @@ type StatsInfoMap struct {
 	BlockNumber int //detect if block number changes , update stats info map
 	TableCnt    float64
 	tableName   string
+	// collected by ANALYZE TABLE
+	ColumnStats map[string]*ColumnStats
 }
 
 func NewStatsInfoMap() *StatsInfoMap {
@@ -67,6 +69,15 @@ func (sc *StatsInfoMap) NeedUpdate(currentBlockNum int) bool {
 	return false
 }
 
+// SetColumnStats sets the statistics collected by ANALYZE TABLE, whose ndv
+// overrides the one estimated by the zonemaps
+func (sc *StatsInfoMap) SetColumnStats(stats map[string]*ColumnStats) {
+	sc.ColumnStats = stats
+	for colName, cs := range stats {
+		sc.NdvMap[colName] = cs.Ndv
+	}
+}
+
 func (sc *StatsCache) GetStatsInfoMap(tableID uint64) *StatsInfoMap {
 	if sc == nil {
 		return NewStatsInfoMap()
@@ -155,6 +166,9 @@ func UpdateStatsInfoMap(info *InfoFromZoneMap, columns []int, blockNumTotal int,
 			s.MaxValMap[colName] = float64(info.MaxVal[i].(types.Date))
 		}
 	}
+	for colName, cs := range s.ColumnStats {
+		s.NdvMap[colName] = cs.Ndv
+	}
 }
 
 func MakeAllColumns(tableDef *plan.TableDef) []int {
@@ -302,10 +316,16 @@ func EstimateOutCnt(expr *plan.Expr, sortKeyName string, tableCnt, cost float64,
 		funcName := exprImpl.F.Func.ObjName
 		switch funcName {
 		case "=":
-			outcnt = estimateOutCntForEquality(expr, sortKeyName, tableCnt, cost, s.NdvMap)
+			outcnt = estimateOutCntByColumnStats(expr, tableCnt, s)
+			if outcnt < 0 {
+				outcnt = estimateOutCntForEquality(expr, sortKeyName, tableCnt, cost, s.NdvMap)
+			}
 		case ">", "<", ">=", "<=":
-			//for filters like a>1, no good way to estimate, return 3 * equality
-			outcnt = estimateOutCntForNonEquality(expr, funcName, sortKeyName, tableCnt, cost, s)
+			outcnt = estimateOutCntByColumnStats(expr, tableCnt, s)
+			if outcnt < 0 {
+				//for filters like a>1, no good way to estimate, return 3 * equality
+				outcnt = estimateOutCntForNonEquality(expr, funcName, sortKeyName, tableCnt, cost, s)
+			}
 		case "and":
 			//get the smaller one of two children, and tune it down a little bit
 			out1 := EstimateOutCnt(exprImpl.F.Args[0], sortKeyName, tableCnt, cost, s)
@@ -508,6 +528,15 @@ func ReCalcNodeStats(nodeID int32, builder *QueryBuilder, recursive bool, leafNo
 		selectivity := math.Pow(rightStats.Selectivity, math.Pow(leftStats.Selectivity, 0.5))
 		selectivity_out := math.Min(math.Pow(leftStats.Selectivity, math.Pow(rightStats.Selectivity, 0.5)), selectivity)
 
+		//if the join keys are analyzed, each key on the side with less ndv
+		//matches outcnt / ndv rows on the other side
+		leftNdv, rightNdv := getJoinKeyNdv(node, builder)
+		analyzed := leftNdv > 0 && rightNdv > 0
+		if analyzed {
+			ndv = math.Max(leftNdv, rightNdv)
+			selectivity = 1
+		}
+
 		switch node.JoinType {
 		case plan.Node_INNER:
 			outcnt := leftStats.Outcnt * rightStats.Outcnt / ndv
@@ -561,8 +590,16 @@ func ReCalcNodeStats(nodeID int32, builder *QueryBuilder, recursive bool, leafNo
 			}
 
 		case plan.Node_SEMI, plan.Node_ANTI:
+			outcnt := leftStats.Outcnt * selectivity
+			if analyzed {
+				matched := math.Min(leftNdv, rightNdv) / leftNdv
+				if node.JoinType == plan.Node_ANTI {
+					matched = 1 - matched
+				}
+				outcnt = math.Max(leftStats.Outcnt*matched, 1)
+			}
 			node.Stats = &plan.Stats{
-				Outcnt:      leftStats.Outcnt * selectivity,
+				Outcnt:      outcnt,
 				Cost:        leftStats.Cost + rightStats.Cost,
 				HashmapSize: rightStats.Outcnt,
 				Selectivity: selectivity_out,
@@ -575,6 +612,18 @@ func ReCalcNodeStats(nodeID int32, builder *QueryBuilder, recursive bool, leafNo
 				HashmapSize: rightStats.Outcnt,
 				Selectivity: selectivity_out,
 			}
+
+		case plan.Node_APPLY:
+			// the right child is evaluated once for each row of the left child
+			outcnt := leftStats.Outcnt
+			if node.ApplyType == plan.Node_INNER || node.ApplyType == plan.Node_LEFT {
+				outcnt *= math.Max(rightStats.Outcnt, 1)
+			}
+			node.Stats = &plan.Stats{
+				Outcnt:      outcnt,
+				Cost:        leftStats.Cost + leftStats.Outcnt*rightStats.Cost,
+				Selectivity: selectivity_out,
+			}
 		}
 
 	case plan.Node_AGG:
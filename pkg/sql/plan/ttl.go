@@ -0,0 +1,133 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// ttlUnitSeconds is the length in seconds of the interval units allowed by TTL.
+// MONTH, QUARTER and YEAR are not allowed, because their length is not fixed.
+var ttlUnitSeconds = map[string]int64{
+	"second":         1,
+	"minute":         60,
+	"hour":           60 * 60,
+	"day":            24 * 60 * 60,
+	"week":           7 * 24 * 60 * 60,
+	"sql_tsi_second": 1,
+	"sql_tsi_minute": 60,
+	"sql_tsi_hour":   60 * 60,
+	"sql_tsi_day":    24 * 60 * 60,
+	"sql_tsi_week":   7 * 24 * 60 * 60,
+}
+
+// buildTTL checks the TTL option of the table and builds the TTL definition.
+// The column of TTL must be a NOT NULL column of DATE, DATETIME or TIMESTAMP type.
+func buildTTL(ctx CompilerContext, opt *tree.TableOptionTTL, tableDef *TableDef) (*plan.TTLDef, error) {
+	if opt.Column.NumParts != 1 {
+		return nil, moerr.NewInvalidInput(ctx.GetContext(), "invalid TTL column '%s'", tree.String(opt.Column, dialect.MYSQL))
+	}
+	name := opt.Column.Parts[0]
+	var col *ColDef
+	for _, c := range tableDef.Cols {
+		if c.Name == name {
+			col = c
+			break
+		}
+	}
+	if col == nil {
+		return nil, moerr.NewBadFieldError(ctx.GetContext(), name, tableDef.Name)
+	}
+	switch types.T(col.Typ.Id) {
+	case types.T_date, types.T_datetime, types.T_timestamp:
+	default:
+		return nil, moerr.NewInvalidInput(ctx.GetContext(), "TTL column '%s' must be of DATE, DATETIME or TIMESTAMP type", name)
+	}
+	if !col.Primary && (col.Default == nil || col.Default.NullAbility) {
+		return nil, moerr.NewInvalidInput(ctx.GetContext(), "TTL column '%s' must be NOT NULL", name)
+	}
+
+	seconds, ok := ttlUnitSeconds[opt.Unit]
+	if !ok {
+		return nil, moerr.NewNotSupported(ctx.GetContext(), "TTL interval unit %s", opt.Unit)
+	}
+	if opt.Interval <= 0 || opt.Interval > math.MaxInt64/seconds {
+		return nil, moerr.NewInvalidInput(ctx.GetContext(), "invalid TTL interval %d %s", opt.Interval, opt.Unit)
+	}
+	return &plan.TTLDef{
+		Col:      name,
+		Interval: opt.Interval * seconds,
+	}, nil
+}
+
+// appendTTLFilter appends the predicate hiding the expired rows into the filters
+// of the table scan, the rows expired but not removed by DN yet are invisible.
+// TIMESTAMP values are compared with the current time, DATE and DATETIME values
+// are taken as UTC, which is the same as DN.
+func (builder *QueryBuilder) appendTTLFilter(nodeID int32, alias tree.AliasClause, ctx *BindContext) error {
+	node := builder.qry.Nodes[nodeID]
+	ttl := node.TableDef.Ttl
+	if ttl == nil {
+		return nil
+	}
+
+	table := string(alias.Alias)
+	if table == "" {
+		table = node.TableDef.Name
+	}
+	var col *ColDef
+	colName := ttl.Col
+	for i, c := range node.TableDef.Cols {
+		if c.Name == ttl.Col {
+			col = c
+			if i < len(alias.Cols) {
+				colName = string(alias.Cols[i])
+			}
+			break
+		}
+	}
+	if col == nil {
+		return moerr.NewInternalError(builder.GetContext(), "TTL column '%s' of table %s not found", ttl.Col, node.TableDef.Name)
+	}
+	now := "utc_timestamp()"
+	if types.T(col.Typ.Id) == types.T_timestamp {
+		now = "current_timestamp()"
+	}
+
+	stmts, err := mysql.Parse(builder.GetContext(), fmt.Sprintf("select date_sub(%s, interval %d second)", now, ttl.Interval), 1)
+	if err != nil {
+		return err
+	}
+	expr := &tree.ComparisonExpr{
+		Op:    tree.GREAT_THAN_EQUAL,
+		Left:  tree.SetUnresolvedName(table, colName),
+		Right: stmts[0].(*tree.Select).Select.(*tree.SelectClause).Exprs[0].Expr,
+	}
+	ctx.binder = NewWhereBinder(builder, ctx)
+	ttlFilterExprs, err := splitAndBindCondition(expr, ctx)
+	if err != nil {
+		return err
+	}
+	node.FilterList = append(node.FilterList, ttlFilterExprs...)
+	return nil
+}
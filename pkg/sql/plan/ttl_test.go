@@ -0,0 +1,90 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func mockTTLTable(t *testing.T, mock *MockOptimizer, name string, sql string) *plan.TTLDef {
+	p, err := buildSingleStmt(mock, t, sql)
+	require.NoError(t, err, sql)
+	tableDef := p.GetDdl().GetCreateTable().GetTableDef()
+	require.NotNil(t, tableDef.Ttl)
+	mock.ctxt.tables[name] = tableDef
+	mock.ctxt.objects[name] = &ObjectRef{SchemaName: "tpch", ObjName: name, PubAccountId: -1}
+	mock.ctxt.stats[name] = &plan.Stats{Outcnt: 1}
+	return tableDef.Ttl
+}
+
+func hasTTLFilter(t *testing.T, p *Plan, tableName string) bool {
+	for _, node := range p.GetQuery().GetNodes() {
+		if node.NodeType == plan.Node_TABLE_SCAN && node.TableDef.Name == tableName {
+			for _, filter := range node.FilterList {
+				if strings.Contains(filter.String(), "date_sub") {
+					return true
+				}
+			}
+			return false
+		}
+	}
+	t.Fatalf("no table scan of %s", tableName)
+	return false
+}
+
+func TestTTL(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	ttl := mockTTLTable(t, mock, "t_ttl", "create table t_ttl (a int, ts timestamp not null) ttl = ts + interval 30 day")
+	require.Equal(t, &plan.TTLDef{Col: "ts", Interval: 30 * 24 * 3600}, ttl)
+	ttl = mockTTLTable(t, mock, "t_ttl2", "create table t_ttl2 (a int, d date primary key) ttl d + interval 2 week")
+	require.Equal(t, &plan.TTLDef{Col: "d", Interval: 14 * 24 * 3600}, ttl)
+
+	sqls := []string{
+		"select * from t_ttl",
+		"select * from t_ttl where a > 1",
+		"select x.a from t_ttl as x where x.ts > '2023-01-01'",
+		"select * from t_ttl2 join t_ttl on t_ttl2.a = t_ttl.a",
+		"delete from t_ttl where a = 1",
+		"update t_ttl set a = 2 where a = 1",
+	}
+	for _, sql := range sqls {
+		p, err := runOneStmt(mock, t, sql)
+		require.NoError(t, err, sql)
+		if p.GetQuery() != nil && p.GetQuery().StmtType == plan.Query_SELECT {
+			require.True(t, hasTTLFilter(t, p, "t_ttl"), sql)
+		}
+	}
+
+	p, err := runOneStmt(mock, t, "select * from nation")
+	require.NoError(t, err)
+	require.False(t, hasTTLFilter(t, p, "nation"))
+
+	sqls = []string{
+		// nullable
+		"create table t1 (a int, ts timestamp) ttl = ts + interval 1 day",
+		// not a time column
+		"create table t1 (a int not null) ttl = a + interval 1 day",
+		// no such column
+		"create table t1 (a int, ts timestamp not null) ttl = b + interval 1 day",
+		// unit of variable length
+		"create table t1 (a int, ts timestamp not null) ttl = ts + interval 1 month",
+		"create table t1 (a int, ts timestamp not null) ttl = ts + interval 0 day",
+	}
+	runTestShouldError(mock, t, sqls)
+}
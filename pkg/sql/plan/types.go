@@ -60,6 +60,9 @@ type ForeignKeyDef = plan.ForeignKeyDef
 type ClusterTable = plan.ClusterTable
 type PrimaryKeyDef = plan.PrimaryKeyDef
 type IndexDef = plan.IndexDef
+type TTLDef = plan.TTLDef
+type BloomFilterDef = plan.BloomFilterDef
+type RuntimeFilterSpec = plan.RuntimeFilterSpec
 type SubscriptionMeta = plan.SubscriptionMeta
 
 type CompilerContext interface {
@@ -77,6 +80,12 @@ type CompilerContext interface {
 	ResolveAccountIds(accountNames []string) ([]uint32, error)
 	// get the relevant information of udf
 	ResolveUdf(name string, args []*Expr) (string, error)
+	// get the predicate of the row level security policies on the table for the command.
+	// it is nil if the table does not have any policy for the current roles.
+	// the predicate may be shared by the statements and must not be changed.
+	ResolvePolicy(obj *ObjectRef, tableDef *TableDef, command string) (tree.Expr, error)
+	// get the materialized views on the table
+	ResolveMaterializedViews(obj *ObjectRef, tableDef *TableDef) ([]*MaterializedView, error)
 	// get the definition of primary key
 	GetPrimaryKeyDef(dbName string, tableName string) []*ColDef
 	// get estimated stats by table & expr
@@ -156,6 +165,15 @@ type QueryBuilder struct {
 	nextTag int32
 
 	mysqlCompatible bool
+
+	// the command of the row level security policies used by the tables
+	// updated or deleted by the statement. other tables use the select policies.
+	policyCmdByTable map[uint64]string
+
+	// the optimizer hints of all query blocks, see hint.go
+	leadingHints []*leadingHint
+	joinHints    []*joinHint
+	hintWarnings []string
 }
 
 type CTERef struct {
@@ -200,6 +218,9 @@ type BindContext struct {
 	isDistinct   bool
 	isCorrelated bool
 	hasSingleRow bool
+	// the table of the context is a LATERAL derived table which refers to
+	// the tables before it in the FROM clause
+	isLateral bool
 
 	parent     *BindContext
 	leftChild  *BindContext
@@ -342,6 +342,36 @@ func (mr *MockCompilerContext2MockRecorder) ResolveUdf(name, args interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveUdf", reflect.TypeOf((*MockCompilerContext2)(nil).ResolveUdf), name, args)
 }
 
+// ResolveMaterializedViews mocks base method.
+func (m *MockCompilerContext2) ResolveMaterializedViews(obj *ObjectRef, tableDef *TableDef) ([]*MaterializedView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveMaterializedViews", obj, tableDef)
+	ret0, _ := ret[0].([]*MaterializedView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveMaterializedViews indicates an expected call of ResolveMaterializedViews.
+func (mr *MockCompilerContext2MockRecorder) ResolveMaterializedViews(obj, tableDef interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveMaterializedViews", reflect.TypeOf((*MockCompilerContext2)(nil).ResolveMaterializedViews), obj, tableDef)
+}
+
+// ResolvePolicy mocks base method.
+func (m *MockCompilerContext2) ResolvePolicy(obj *ObjectRef, tableDef *TableDef, command string) (tree.Expr, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolvePolicy", obj, tableDef, command)
+	ret0, _ := ret[0].(tree.Expr)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolvePolicy indicates an expected call of ResolvePolicy.
+func (mr *MockCompilerContext2MockRecorder) ResolvePolicy(obj, tableDef, command interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolvePolicy", reflect.TypeOf((*MockCompilerContext2)(nil).ResolvePolicy), obj, tableDef, command)
+}
+
 // ResolveVariable mocks base method.
 func (m *MockCompilerContext2) ResolveVariable(varName string, isSystemVar, isGlobalVar bool) (interface{}, error) {
 	m.ctrl.T.Helper()
@@ -82,6 +82,24 @@ func hasCorrCol(expr *plan.Expr) bool {
 	}
 }
 
+func hasSubquery(expr *plan.Expr) bool {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_Sub:
+		return true
+
+	case *plan.Expr_F:
+		for _, arg := range exprImpl.F.Args {
+			if hasSubquery(arg) {
+				return true
+			}
+		}
+		return false
+
+	default:
+		return false
+	}
+}
+
 func decreaseDepthAndDispatch(preds []*plan.Expr) ([]*plan.Expr, []*plan.Expr) {
 	filterPreds := make([]*plan.Expr, 0, len(preds))
 	joinPreds := make([]*plan.Expr, 0, len(preds))
@@ -126,6 +144,139 @@ func decreaseDepth(expr *plan.Expr) (*plan.Expr, bool) {
 	return expr, correlated
 }
 
+// increaseDepth turns the column references of an expression moved depth
+// levels deeper, into the right child of an APPLY join, into correlated ones.
+func increaseDepth(expr *plan.Expr, depth int32) *plan.Expr {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		expr.Expr = &plan.Expr_Corr{
+			Corr: &plan.CorrColRef{
+				RelPos: exprImpl.Col.RelPos,
+				ColPos: exprImpl.Col.ColPos,
+				Depth:  depth,
+			},
+		}
+
+	case *plan.Expr_Corr:
+		exprImpl.Corr.Depth += depth
+
+	case *plan.Expr_F:
+		for i, arg := range exprImpl.F.Args {
+			exprImpl.F.Args[i] = increaseDepth(arg, depth)
+		}
+
+	case *plan.Expr_List:
+		for i, arg := range exprImpl.List.List {
+			exprImpl.List.List[i] = increaseDepth(arg, depth)
+		}
+	}
+
+	return expr
+}
+
+// corrColRefVisitor is called on a correlated column reference, outer is the
+// depth of the reference minus the level of the node it is in. It returns the
+// expression to replace the reference.
+type corrColRefVisitor func(expr *plan.Expr, corr *plan.CorrColRef, outer int32) (*plan.Expr, error)
+
+// walkCorrColRefs visits the correlated column references in the subtree of
+// nodeID, which is at the given level. The right child of an APPLY join is one
+// level deeper than the join, so a reference with outer 1 in the subtree of
+// the right child, walked from level 0, refers to the left child of the join.
+func walkCorrColRefs(nodes []*plan.Node, nodeID int32, level int32, fn corrColRefVisitor) error {
+	node := nodes[nodeID]
+	if err := walkNodeCorrColRefs(node, level, fn); err != nil {
+		return err
+	}
+
+	for i, childID := range node.Children {
+		childLevel := level
+		if i == 1 && node.NodeType == plan.Node_JOIN && node.JoinType == plan.Node_APPLY {
+			childLevel++
+		}
+		if err := walkCorrColRefs(nodes, childID, childLevel, fn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// walkNodeCorrColRefs visits the correlated column references in the
+// expressions of the node, but not in its children.
+func walkNodeCorrColRefs(node *plan.Node, level int32, fn corrColRefVisitor) (err error) {
+	walkList := func(exprs []*plan.Expr) {
+		for i := range exprs {
+			if err == nil {
+				exprs[i], err = walkExprCorrColRefs(exprs[i], level, fn)
+			}
+		}
+	}
+
+	walkList(node.ProjectList)
+	walkList(node.OnList)
+	walkList(node.FilterList)
+	walkList(node.GroupBy)
+	walkList(node.GroupingSet)
+	walkList(node.AggList)
+	walkList(node.TblFuncExprList)
+	for _, orderBy := range node.OrderBy {
+		if err == nil {
+			orderBy.Expr, err = walkExprCorrColRefs(orderBy.Expr, level, fn)
+		}
+	}
+	if node.RowsetData != nil {
+		for _, col := range node.RowsetData.Cols {
+			walkList(col.Data)
+		}
+	}
+	if err == nil && node.Limit != nil {
+		node.Limit, err = walkExprCorrColRefs(node.Limit, level, fn)
+	}
+	if err == nil && node.Offset != nil {
+		node.Offset, err = walkExprCorrColRefs(node.Offset, level, fn)
+	}
+
+	return
+}
+
+func walkExprCorrColRefs(expr *plan.Expr, level int32, fn corrColRefVisitor) (*plan.Expr, error) {
+	var err error
+
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_Corr:
+		return fn(expr, exprImpl.Corr, exprImpl.Corr.Depth-level)
+
+	case *plan.Expr_F:
+		for i, arg := range exprImpl.F.Args {
+			if exprImpl.F.Args[i], err = walkExprCorrColRefs(arg, level, fn); err != nil {
+				return nil, err
+			}
+		}
+
+	case *plan.Expr_List:
+		for i, arg := range exprImpl.List.List {
+			if exprImpl.List.List[i], err = walkExprCorrColRefs(arg, level, fn); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return expr, nil
+}
+
+// ReplaceApplyCorrColRefs replaces the correlated column references in the
+// subtree of the right child of an APPLY join which refer to the left child
+// of the join by what fn returns.
+func ReplaceApplyCorrColRefs(nodes []*plan.Node, rightID int32, fn func(expr *plan.Expr, corr *plan.CorrColRef) (*plan.Expr, error)) error {
+	return walkCorrColRefs(nodes, rightID, 0, func(expr *plan.Expr, corr *plan.CorrColRef, outer int32) (*plan.Expr, error) {
+		if outer != 1 {
+			return expr, nil
+		}
+		return fn(expr, corr)
+	})
+}
+
 func getJoinSide(expr *plan.Expr, leftTags, rightTags map[int32]*Binding, markTag int32) (side int8) {
 	switch exprImpl := expr.Expr.(type) {
 	case *plan.Expr_F:
@@ -200,9 +200,15 @@ func (rule *ResetParamRefRule) ApplyExpr(e *plan.Expr) (*plan.Expr, error) {
 		}
 		return e, nil
 	case *plan.Expr_P:
+		param := rule.params[int(exprImpl.P.Pos)]
+		typ := e.Typ
+		// the literals of a query parameterised automatically have their types
+		if _, ok := param.Expr.(*plan.Expr_C); ok {
+			typ = param.Typ
+		}
 		return &plan.Expr{
-			Typ:  e.Typ,
-			Expr: rule.params[int(exprImpl.P.Pos)].Expr,
+			Typ:  typ,
+			Expr: param.Expr,
 		}, nil
 	default:
 		return e, nil
@@ -442,3 +448,51 @@ func (r *RecomputeRealTimeRelatedFuncRule) ApplyExpr(e *plan.Expr) (*plan.Expr,
 		return e, nil
 	}
 }
+
+// PartitionPruneRule prunes the partitions of the table scan again after the
+// parameters of the prepared statement have been replaced by the values.
+type PartitionPruneRule struct {
+	compCtx CompilerContext
+}
+
+func NewPartitionPruneRule(compCtx CompilerContext) *PartitionPruneRule {
+	return &PartitionPruneRule{
+		compCtx: compCtx,
+	}
+}
+
+func (r *PartitionPruneRule) MatchNode(node *Node) bool {
+	return node.NodeType == plan.Node_TABLE_SCAN && node.ObjRef != nil &&
+		node.TableDef != nil && node.TableDef.Partition != nil && len(node.FilterList) != 0
+}
+
+func (r *PartitionPruneRule) IsApplyExpr() bool {
+	return false
+}
+
+func (r *PartitionPruneRule) ApplyNode(node *Node) error {
+	// the columns of the scan node have been pruned. the partition expression
+	// refers to the columns of the table definition.
+	_, tableDef := r.compCtx.Resolve(node.ObjRef.SchemaName, node.TableDef.Name)
+	if tableDef == nil {
+		return nil
+	}
+	colPosByName := make(map[string]int32, len(tableDef.Cols))
+	for i, col := range tableDef.Cols {
+		colPosByName[col.Name] = int32(i)
+	}
+	cols := node.TableDef.Cols
+	pruner := newPartitionPruner(r.compCtx.GetProcess(), node.TableDef.Partition, func(col *plan.ColRef) (int32, bool) {
+		if col.RelPos != 0 || int(col.ColPos) >= len(cols) {
+			return 0, false
+		}
+		pos, ok := colPosByName[cols[col.ColPos].Name]
+		return pos, ok
+	})
+	node.TableDef.Partition = pruner.prune(node.FilterList)
+	return nil
+}
+
+func (r *PartitionPruneRule) ApplyExpr(e *plan.Expr) (*plan.Expr, error) {
+	return e, nil
+}
@@ -0,0 +1,35 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import "github.com/matrixorigin/matrixone/pkg/container/types"
+
+// isZOrderType returns true if the columns of the type can be clustered in
+// Z-order: their values map into integers following their order. Byte strings
+// are ordered by their first 8 bytes, the types whose values are not stored
+// inline are not allowed.
+func isZOrderType(t types.T) bool {
+	switch t {
+	case types.T_bool,
+		types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64,
+		types.T_date, types.T_time, types.T_datetime, types.T_timestamp,
+		types.T_decimal64, types.T_decimal128, types.T_uuid,
+		types.T_char, types.T_varchar, types.T_binary, types.T_varbinary:
+		return true
+	}
+	return false
+}
@@ -23,6 +23,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -30,12 +32,31 @@ func JudgeIsCompositeClusterByColumn(s string) bool {
 	if len(s) < len(catalog.PrefixCBColName) {
 		return false
 	}
-	return s[0:len(catalog.PrefixCBColName)] == catalog.PrefixCBColName
+	return s[0:len(catalog.PrefixCBColName)] == catalog.PrefixCBColName ||
+		JudgeIsZOrderClusterByColumn(s)
+}
+
+// JudgeIsZOrderClusterByColumn returns true if s names the hidden sort key of
+// a table clustered by the Z-order of its columns
+func JudgeIsZOrderClusterByColumn(s string) bool {
+	if len(s) < len(catalog.PrefixZOrderColName) {
+		return false
+	}
+	return s[0:len(catalog.PrefixZOrderColName)] == catalog.PrefixZOrderColName
 }
 
 func BuildCompositeClusterByColumnName(s []string) string {
-	var name string
-	name = catalog.PrefixCBColName
+	return buildClusterByColumnName(catalog.PrefixCBColName, s)
+}
+
+// BuildZOrderClusterByColumnName builds the name of the hidden sort key of a
+// table clustered by the Z-order of the columns s
+func BuildZOrderClusterByColumnName(s []string) string {
+	return buildClusterByColumnName(catalog.PrefixZOrderColName, s)
+}
+
+func buildClusterByColumnName(prefix string, s []string) string {
+	name := prefix
 	for _, single := range s {
 		lenNum := format.Int64ToString(int64(len(single)))
 		for num := 0; num < 3-len(lenNum); num++ {
@@ -68,10 +89,16 @@ func GetClusterByColumnOrder(cbName, colName string) int {
 	if !JudgeIsCompositeClusterByColumn(cbName) {
 		return -1
 	}
+	zorder := JudgeIsZOrderClusterByColumn(cbName)
 	idx := 0
 	for next := len(catalog.PrefixCBColName); next < len(cbName); {
 		strLen, _ := strconv.Atoi(cbName[next : next+3])
 		if cbName[next+3:next+3+strLen] == colName {
+			// no column leads a Z-order, every one of them is
+			// clustered like the second column of a composite key
+			if zorder {
+				return 1
+			}
 			return idx
 		}
 		next += strLen + 3
@@ -84,9 +111,48 @@ func GetClusterByColumnOrder(cbName, colName string) int {
 // cbName: column name of composite column
 func FillCompositeClusterByBatch(bat *batch.Batch, cbName string, proc *process.Process) error {
 	names := SplitCompositeClusterByColumnName(cbName)
+	if JudgeIsZOrderClusterByColumn(cbName) {
+		return FillZOrderKeyBatch(bat, cbName, names, proc)
+	}
 	return FillCompositeKeyBatch(bat, cbName, names, proc)
 }
 
+// build the vector of the Z-order key of the parts, and append the result vector to batch.
+// the key is normalized over the rows of the batch only, DN recomputes it over
+// all the rows it merges
+// zkeyName: column name of the Z-order key
+// keyParts: parts of the Z-order key
+func FillZOrderKeyBatch(bat *batch.Batch, zkeyName string, keyParts []string, proc *process.Process) error {
+	cols := make([][]containers.Vector, len(keyParts))
+	for i, elem := range keyParts {
+		for num, attrName := range bat.Attrs {
+			if attrName != elem {
+				continue
+			}
+			v := bat.Vecs[num]
+			if v.IsConst() {
+				full := vector.NewVec(*v.GetType())
+				if err := full.UnionMulti(v, 0, v.Length(), proc.Mp()); err != nil {
+					full.Free(proc.Mp())
+					return err
+				}
+				defer full.Free(proc.Mp())
+				v = full
+			}
+			cols[i] = []containers.Vector{containers.ToDNVector(v)}
+		}
+	}
+	keys := mergesort.ZOrderKeys(cols)[0]
+	defer keys.Close()
+	vec, err := keys.GetDownstreamVector().Dup(proc.Mp())
+	if err != nil {
+		return err
+	}
+	bat.Attrs = append(bat.Attrs, zkeyName)
+	bat.Vecs = append(bat.Vecs, vec)
+	return nil
+}
+
 // build the vector of the composite key, and append the result vector to batch
 // ckeyName: column name of composite column
 // keyParts: parts of the composite column
@@ -15,6 +15,7 @@
 package util
 
 import (
+	"bytes"
 	"math"
 	"math/rand"
 	"reflect"
@@ -53,6 +54,11 @@ func TestJudgeIsCompositeClusterByColumn(t *testing.T) {
 			args: "__mo_cpkey_005ename003sal",
 			want: false,
 		},
+		{
+			name: "test04",
+			args: "__mo_zokey_005ename003sal",
+			want: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -150,6 +156,18 @@ func TestGetClusterByColumnOrder(t *testing.T) {
 			colName: "sal",
 			want:    0,
 		},
+		{
+			name:    "test04",
+			cbName:  "__mo_cbkey_003sal005empno",
+			colName: "empno",
+			want:    1,
+		},
+		{
+			name:    "test05",
+			cbName:  "__mo_zokey_003sal005empno",
+			colName: "sal",
+			want:    1,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -160,6 +178,32 @@ func TestGetClusterByColumnOrder(t *testing.T) {
 	}
 }
 
+func TestZOrderClusterByColumnName(t *testing.T) {
+	name := BuildZOrderClusterByColumnName([]string{"empno", "sal"})
+	require.Equal(t, "__mo_zokey_005empno003sal", name)
+	require.True(t, JudgeIsZOrderClusterByColumn(name))
+	require.False(t, JudgeIsZOrderClusterByColumn("__mo_cbkey_005empno003sal"))
+	require.Equal(t, []string{"empno", "sal"}, SplitCompositeClusterByColumnName(name))
+}
+
+func TestFillZOrderClusterByBatch(t *testing.T) {
+	proc := testutil.NewProc()
+	bat := batch.New(true, []string{"a", "b"})
+	bat.Vecs[0] = testutil.MakeInt64Vector([]int64{3, 0, 1, 2}, nil)
+	bat.Vecs[1] = vector.NewConstFixed(types.T_int32.ToType(), int32(7), 4, proc.Mp())
+	name := BuildZOrderClusterByColumnName([]string{"a", "b"})
+	require.NoError(t, FillCompositeClusterByBatch(bat, name, proc))
+	require.Equal(t, name, bat.Attrs[2])
+
+	// with b constant, the keys follow a
+	keys := vector.MustBytesCol(bat.Vecs[2])
+	require.Equal(t, 4, len(keys))
+	require.Equal(t, 16, len(keys[0]))
+	require.Negative(t, bytes.Compare(keys[1], keys[2]))
+	require.Negative(t, bytes.Compare(keys[2], keys[3]))
+	require.Negative(t, bytes.Compare(keys[3], keys[0]))
+}
+
 func TestFillCompositePKeyBatch(t *testing.T) {
 	var proc = testutil.NewProc()
 	columnSize := 10
@@ -169,8 +169,17 @@ func BuildMoColumnsFilter(curAccountId uint64) tree.Expr {
 	mo_indexes := tree.NewNumValWithType(constant.MakeString("mo_indexes"), "mo_indexes", false, tree.P_char)
 	mo_pubs := tree.NewNumValWithType(constant.MakeString("mo_pubs"), "mo_pubs", false, tree.P_char)
 	mo_stored_procedure := tree.NewNumValWithType(constant.MakeString("mo_stored_procedure"), "mo_stored_procedure", false, tree.P_char)
-
-	notInValues := tree.NewTuple(tree.Exprs{mo_databaseConst, mo_tablesConst, mo_columnsConst, mo_userConst, mo_roleConst, mo_user_grantConst, mo_role_grantConst, mo_role_privsConst, mo_user_defined_functionConst, mo_mysql_compatibility_modeConst, mo_indexes, mo_pubs, mo_stored_procedure})
+	mo_user_password_policy := tree.NewNumValWithType(constant.MakeString("mo_user_password_policy"), "mo_user_password_policy", false, tree.P_char)
+	mo_user_password_history := tree.NewNumValWithType(constant.MakeString("mo_user_password_history"), "mo_user_password_history", false, tree.P_char)
+	mo_role_column_privs := tree.NewNumValWithType(constant.MakeString("mo_role_column_privs"), "mo_role_column_privs", false, tree.P_char)
+	mo_row_policies := tree.NewNumValWithType(constant.MakeString("mo_row_policies"), "mo_row_policies", false, tree.P_char)
+	mo_audit_filters := tree.NewNumValWithType(constant.MakeString("mo_audit_filters"), "mo_audit_filters", false, tree.P_char)
+	mo_mviews := tree.NewNumValWithType(constant.MakeString("mo_mviews"), "mo_mviews", false, tree.P_char)
+	mo_column_stats := tree.NewNumValWithType(constant.MakeString("mo_column_stats"), "mo_column_stats", false, tree.P_char)
+	mo_account_variables := tree.NewNumValWithType(constant.MakeString("mo_account_variables"), "mo_account_variables", false, tree.P_char)
+	mo_slow_query_log := tree.NewNumValWithType(constant.MakeString("mo_slow_query_log"), "mo_slow_query_log", false, tree.P_char)
+
+	notInValues := tree.NewTuple(tree.Exprs{mo_databaseConst, mo_tablesConst, mo_columnsConst, mo_userConst, mo_roleConst, mo_user_grantConst, mo_role_grantConst, mo_role_privsConst, mo_user_defined_functionConst, mo_mysql_compatibility_modeConst, mo_indexes, mo_pubs, mo_stored_procedure, mo_user_password_policy, mo_user_password_history, mo_role_column_privs, mo_row_policies, mo_audit_filters, mo_mviews, mo_column_stats, mo_account_variables, mo_slow_query_log})
 	notInexpr := tree.NewComparisonExpr(tree.NOT_IN, att_relnameColName, notInValues)
 
 	dbNameEqualAst := makeStringEqualAst(catalog.SystemColAttr_DBName, "mo_catalog")
@@ -52,6 +52,7 @@ func NewTAEStorage(
 	logtailServerAddr string,
 	logtailServerCfg *options.LogtailServerCfg,
 	logStore options.LogstoreType,
+	walArchiveCfg *options.WalArchiveCfg,
 ) (*taeStorage, error) {
 	opt := &options.Options{
 		Clock:         rt.Clock(),
@@ -60,6 +61,7 @@ func NewTAEStorage(
 		Shard:         shard,
 		CheckpointCfg: ckpCfg,
 		LogStoreT:     logStore,
+		WalArchiveCfg: walArchiveCfg,
 	}
 
 	taeHandler := rpc.NewTAEHandle(dataDir, opt)
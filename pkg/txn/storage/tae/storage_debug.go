@@ -48,6 +48,20 @@ func (s *taeStorage) Debug(ctx context.Context,
 			ReturnStr: "OK",
 		})
 		return resp, err
+	case uint32(ctl.CmdMethod_Merge):
+		_, err := handleRead(
+			ctx, s, txnMeta, data, s.taeHandler.HandleMergeTable,
+		)
+		if err != nil {
+			resp := protoc.MustMarshal(&ctl.DNStringResponse{
+				ReturnStr: "Failed",
+			})
+			return resp, err
+		}
+		resp := protoc.MustMarshal(&ctl.DNStringResponse{
+			ReturnStr: "OK",
+		})
+		return resp, err
 	case uint32(ctl.CmdMethod_Checkpoint):
 		_, err := handleRead(
 			ctx, s, txnMeta, data, s.taeHandler.HandleForceCheckpoint,
@@ -73,6 +87,50 @@ func (s *taeStorage) Debug(ctx context.Context,
 			})
 		}
 		return resp.Read()
+	case uint32(ctl.CmdMethod_Backup):
+		resp, err := handleRead(
+			ctx, s, txnMeta, data, s.taeHandler.HandleBackup,
+		)
+		if err != nil {
+			payload, _ := types.Encode(&db.InspectResp{
+				Message: "Failed",
+			})
+			return payload, err
+		}
+		return resp.Read()
+	case uint32(ctl.CmdMethod_Restore):
+		resp, err := handleRead(
+			ctx, s, txnMeta, data, s.taeHandler.HandleRestore,
+		)
+		if err != nil {
+			payload, _ := types.Encode(&db.InspectResp{
+				Message: "Failed",
+			})
+			return payload, err
+		}
+		return resp.Read()
+	case uint32(ctl.CmdMethod_Changefeed):
+		resp, err := handleRead(
+			ctx, s, txnMeta, data, s.taeHandler.HandleChangefeed,
+		)
+		if err != nil {
+			payload, _ := types.Encode(&db.InspectResp{
+				Message: "Failed",
+			})
+			return payload, err
+		}
+		return resp.Read()
+	case uint32(ctl.CmdMethod_MView):
+		resp, err := handleRead(
+			ctx, s, txnMeta, data, s.taeHandler.HandleMView,
+		)
+		if err != nil {
+			payload, _ := types.Encode(&db.InspectResp{
+				Message: "Failed",
+			})
+			return payload, err
+		}
+		return resp.Read()
 	default:
 		return nil, moerr.NewNotSupportedNoCtx("TAEStorage not support ctl method %d", opCode)
 	}
@@ -27,6 +27,7 @@ var InitCollectors = []Collector{
 	StatementErrorsFactory,
 	TransactionCounterFactory,
 	TransactionErrorsFactory,
+	QueryResultCacheFactory,
 	// server metric
 	ConnFactory,
 	StorageUsageFactory,
@@ -54,6 +54,16 @@ var (
 		[]string{constTenantKey, "type"},
 		false,
 	)
+
+	QueryResultCacheFactory = NewCounterVec(
+		CounterOpts{
+			Subsystem: "sql",
+			Name:      "query_result_cache_total",
+			Help:      "Counter of the lookups of the query result cache",
+		},
+		[]string{constTenantKey, "type"},
+		false,
+	)
 )
 
 type SQLType string
@@ -72,6 +82,13 @@ var (
 	SQLTypeAutoRollback SQLType = "auto_rollback"
 )
 
+type QueryResultCacheType string
+
+var (
+	QueryResultCacheHit  QueryResultCacheType = "hit"
+	QueryResultCacheMiss QueryResultCacheType = "miss"
+)
+
 // StatementCounter accept t as tree.QueryType
 func StatementCounter(tenant string, t string) Counter {
 	return StatementCounterFactory.WithLabelValues(tenant, t)
@@ -89,3 +106,7 @@ func TransactionErrorsCounter(account string, t SQLType) Counter {
 func StatementErrorsCounter(account string, t string) Counter {
 	return StatementErrorsFactory.WithLabelValues(account, t)
 }
+
+func QueryResultCacheCounter(account string, t QueryResultCacheType) Counter {
+	return QueryResultCacheFactory.WithLabelValues(account, string(t))
+}
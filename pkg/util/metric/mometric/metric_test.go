@@ -229,7 +229,7 @@ func TestGetSchemaForAccount(t *testing.T) {
 				account: "user1",
 			},
 			wantPath: "/user1/*/*/*/*/metric/*",
-			wantSche: 7,
+			wantSche: 8,
 		},
 	}
 	ctx := context.Background()
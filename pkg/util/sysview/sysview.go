@@ -366,6 +366,25 @@ var (
 			"FROM mo_catalog.mo_tables tbl LEFT JOIN mo_catalog.mo_user usr ON tbl.creator = usr.user_id " +
 			"WHERE tbl.relkind = 'v' and tbl.reldatabase != 'information_schema'",
 
+		// the slow queries of the account aggregated by the digest,
+		// like performance_schema.events_statements_summary_by_digest in mysql
+		"CREATE VIEW IF NOT EXISTS STATEMENTS_SUMMARY_BY_DIGEST AS " +
+			"SELECT database_name AS `SCHEMA_NAME`," +
+			"digest AS `DIGEST`," +
+			"any_value(normalized_statement) AS `DIGEST_TEXT`," +
+			"count(*) AS `COUNT_STAR`," +
+			"sum(query_time) AS `SUM_QUERY_TIME`," +
+			"min(query_time) AS `MIN_QUERY_TIME`," +
+			"avg(query_time) AS `AVG_QUERY_TIME`," +
+			"max(query_time) AS `MAX_QUERY_TIME`," +
+			"sum(lock_time) AS `SUM_LOCK_TIME`," +
+			"sum(case when status = 'fail' then 1 else 0 end) AS `SUM_ERRORS`," +
+			"sum(rows_sent) AS `SUM_ROWS_SENT`," +
+			"sum(rows_examined) AS `SUM_ROWS_EXAMINED`," +
+			"min(start_time) AS `FIRST_SEEN`," +
+			"max(start_time) AS `LAST_SEEN` " +
+			"FROM mo_catalog.mo_slow_query_log GROUP BY database_name, digest",
+
 		"CREATE TABLE IF NOT EXISTS ENGINES (" +
 			"ENGINE varchar(64)," +
 			"SUPPORT varchar(8)," +
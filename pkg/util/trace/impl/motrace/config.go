@@ -16,6 +16,7 @@ package motrace
 
 import (
 	"encoding/binary"
+	"io"
 	"sync"
 	"time"
 
@@ -23,6 +24,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/util/export/table"
 	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
 	"github.com/matrixorigin/matrixone/pkg/util/trace"
+	"gopkg.in/natefinch/lumberjack.v2"
 )
 
 const (
@@ -36,6 +38,7 @@ const (
 	MOLogType       = "log"
 	MOErrorType     = "error"
 	MORawLogType    = "rawlog"
+	MOAuditType     = "audit"
 )
 
 // tracerProviderConfig.
@@ -74,6 +77,10 @@ type tracerProviderConfig struct {
 
 	bufferSizeThreshold int64 // WithBufferSizeThreshold
 
+	enableAudit bool // EnableAudit
+	// auditLogWriter writes the audit records into the local files in JSON
+	auditLogWriter io.Writer // WithAuditLogFile
+
 	mux sync.RWMutex
 }
 
@@ -99,6 +106,18 @@ func (cfg *tracerProviderConfig) SetEnable(enable bool) {
 	cfg.enable = enable
 }
 
+func (cfg *tracerProviderConfig) IsEnableAudit() bool {
+	cfg.mux.RLock()
+	defer cfg.mux.RUnlock()
+	return cfg.enableAudit
+}
+
+func (cfg *tracerProviderConfig) getAuditLogWriter() io.Writer {
+	cfg.mux.RLock()
+	defer cfg.mux.RUnlock()
+	return cfg.auditLogWriter
+}
+
 func (cfg *tracerProviderConfig) GetSqlExecutor() func() ie.InternalExecutor {
 	cfg.mux.RLock()
 	defer cfg.mux.RUnlock()
@@ -162,6 +181,32 @@ func WithBufferSizeThreshold(size int64) tracerProviderOption {
 	})
 }
 
+func EnableAudit(enable bool) tracerProviderOption {
+	return func(cfg *tracerProviderConfig) {
+		cfg.mux.Lock()
+		defer cfg.mux.Unlock()
+		cfg.enableAudit = enable
+	}
+}
+
+// WithAuditLogFile writes the audit records into the file, which is rotated when it reaches maxSize MB.
+// Empty filename means no local file.
+func WithAuditLogFile(filename string, maxSize, maxBackups int) tracerProviderOption {
+	return func(cfg *tracerProviderConfig) {
+		if filename == "" {
+			return
+		}
+		cfg.mux.Lock()
+		defer cfg.mux.Unlock()
+		cfg.auditLogWriter = &lumberjack.Logger{
+			Filename:   filename,
+			MaxSize:    maxSize,
+			MaxBackups: maxBackups,
+			LocalTime:  true,
+		}
+	}
+}
+
 func DebugMode(debug bool) tracerProviderOption {
 	return func(cfg *tracerProviderConfig) {
 		cfg.debugMode = debug
@@ -0,0 +1,188 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package motrace
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+	"unsafe"
+
+	"github.com/google/uuid"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/util/export/table"
+)
+
+// the classes of the audit events
+const (
+	AuditClassConnection = "connection"
+	AuditClassDDL        = "ddl"
+	AuditClassDCL        = "dcl"
+	AuditClassDML        = "dml"
+)
+
+// the events of the class connection
+const (
+	AuditEventLogin  = "Login"
+	AuditEventLogout = "Logout"
+)
+
+// AuditRecord implement IBuffer2SqlItem and table.RowField
+type AuditRecord struct {
+	AuditID   [16]byte
+	SessionID [16]byte
+	Account   string
+	User      string
+	Role      string
+	Host      string
+	Database  string
+	EventTime time.Time
+	Class     string
+	Event     string
+	Statement string
+	// Error is nil, if the event succeeded.
+	Error error
+}
+
+func NewAuditRecord(class, event string) *AuditRecord {
+	return &AuditRecord{
+		AuditID:   uuid.New(),
+		EventTime: time.Now(),
+		Class:     class,
+		Event:     event,
+	}
+}
+
+func (r *AuditRecord) GetName() string {
+	return AuditTable.GetName()
+}
+
+func (r *AuditRecord) Size() int64 {
+	return int64(unsafe.Sizeof(r)) + int64(
+		len(r.Account)+len(r.User)+len(r.Role)+len(r.Host)+
+			len(r.Database)+len(r.Class)+len(r.Event)+len(r.Statement),
+	)
+}
+
+func (r *AuditRecord) Free() {}
+
+func (r *AuditRecord) GetTable() *table.Table { return AuditTable }
+
+func (r *AuditRecord) status() string {
+	if r.Error != nil {
+		return StatementStatusFailed.String()
+	}
+	return StatementStatusSuccess.String()
+}
+
+func (r *AuditRecord) errCode() string {
+	var moError *moerr.Error
+	errCode := moerr.ErrInfo
+	if errors.As(r.Error, &moError) {
+		errCode = moError.ErrorCode()
+	}
+	return fmt.Sprintf("%d", errCode)
+}
+
+func (r *AuditRecord) FillRow(ctx context.Context, row *table.Row) {
+	row.Reset()
+	row.SetColumnVal(auditIDCol, uuid.UUID(r.AuditID).String())
+	row.SetColumnVal(sesIDCol, uuid.UUID(r.SessionID).String())
+	row.SetColumnVal(accountCol, r.Account)
+	row.SetColumnVal(userCol, r.User)
+	row.SetColumnVal(roleCol, r.Role)
+	row.SetColumnVal(hostCol, r.Host)
+	row.SetColumnVal(dbCol, r.Database)
+	row.SetColumnVal(eventTimeCol, r.EventTime)
+	row.SetColumnVal(eventClassCol, r.Class)
+	row.SetColumnVal(eventCol, r.Event)
+	row.SetColumnVal(stmtCol, r.Statement)
+	row.SetColumnVal(outcomeCol, r.status())
+	if r.Error != nil {
+		row.SetColumnVal(errCodeCol, r.errCode())
+		row.SetColumnVal(errorCol, r.Error.Error())
+	}
+	row.SetColumnVal(nodeUUIDCol, GetNodeResource().NodeUuid)
+	row.SetColumnVal(nodeTypeCol, GetNodeResource().NodeType)
+}
+
+// MarshalJSON is used by the audit log file
+func (r *AuditRecord) MarshalJSON() ([]byte, error) {
+	var errCode, errMsg string
+	if r.Error != nil {
+		errCode, errMsg = r.errCode(), r.Error.Error()
+	}
+	return json.Marshal(&struct {
+		AuditID    string `json:"audit_id"`
+		SessionID  string `json:"session_id"`
+		Account    string `json:"account"`
+		User       string `json:"user"`
+		Role       string `json:"role"`
+		Host       string `json:"host"`
+		Database   string `json:"database"`
+		EventTime  string `json:"event_time"`
+		EventClass string `json:"event_class"`
+		Event      string `json:"event"`
+		Statement  string `json:"statement"`
+		Status     string `json:"status"`
+		ErrCode    string `json:"err_code,omitempty"`
+		Error      string `json:"error,omitempty"`
+	}{
+		AuditID:    uuid.UUID(r.AuditID).String(),
+		SessionID:  uuid.UUID(r.SessionID).String(),
+		Account:    r.Account,
+		User:       r.User,
+		Role:       r.Role,
+		Host:       r.Host,
+		Database:   r.Database,
+		EventTime:  r.EventTime.Format(time.RFC3339Nano),
+		EventClass: r.Class,
+		Event:      r.Event,
+		Statement:  r.Statement,
+		Status:     r.status(),
+		ErrCode:    errCode,
+		Error:      errMsg,
+	})
+}
+
+// IsAuditEnable returns true, if the audit records should be reported.
+func IsAuditEnable() bool {
+	return GetTracerProvider().IsEnableAudit()
+}
+
+// ReportAudit writes the audit record into the audit log file, if configured,
+// and into the table system.mo_audit of the account.
+var ReportAudit = func(ctx context.Context, r *AuditRecord) error {
+	cfg := &GetTracerProvider().tracerProviderConfig
+	if !cfg.IsEnableAudit() {
+		return nil
+	}
+	if w := cfg.getAuditLogWriter(); w != nil {
+		line, err := json.Marshal(r)
+		if err != nil {
+			return err
+		}
+		if _, err = w.Write(append(line, '\n')); err != nil {
+			logutil.Errorf("[Audit] failed to write the audit log file. err: %v", err)
+		}
+	}
+	if !cfg.IsEnable() {
+		return nil
+	}
+	return GetGlobalBatchProcessor().Collect(ctx, r)
+}
@@ -0,0 +1,95 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package motrace
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/util/export/table"
+	"github.com/stretchr/testify/require"
+)
+
+func auditColumnVal(row *table.Row, name string) string {
+	vals := row.ToStrings()
+	for idx, col := range row.Table.Columns {
+		if col.Name == name {
+			return vals[idx]
+		}
+	}
+	return ""
+}
+
+func TestAuditRecord_FillRow(t *testing.T) {
+	ctx := context.Background()
+	r := NewAuditRecord(AuditClassDDL, "CreateTable")
+	r.Account = "acc1"
+	r.User = "u1"
+	r.Role = "r1"
+	r.Statement = "create table t(a int)"
+
+	row := AuditTable.GetRow(ctx)
+	r.FillRow(ctx, row)
+	require.Equal(t, StatementStatusSuccess.String(), auditColumnVal(row, outcomeCol.Name))
+	require.Equal(t, "acc1", row.GetAccount())
+
+	r.Error = moerr.NewInternalError(ctx, "failed")
+	r.FillRow(ctx, row)
+	require.Equal(t, StatementStatusFailed.String(), auditColumnVal(row, outcomeCol.Name))
+	require.Equal(t, "internal error: failed", auditColumnVal(row, errorCol.Name))
+}
+
+func TestReportAudit(t *testing.T) {
+	old := GetTracerProvider()
+	defer SetTracerProvider(old)
+
+	filename := filepath.Join(t.TempDir(), "audit.log")
+	SetTracerProvider(newMOTracerProvider(EnableTracer(false), EnableAudit(true), WithAuditLogFile(filename, 1, 1)))
+	require.True(t, IsAuditEnable())
+
+	ctx := context.Background()
+	r := NewAuditRecord(AuditClassConnection, AuditEventLogin)
+	r.Account = "acc1"
+	r.User = "u1"
+	r.Host = "127.0.0.1:6001"
+	r.Error = moerr.NewInternalError(ctx, "check password failed")
+	require.NoError(t, ReportAudit(ctx, r))
+
+	data, err := os.ReadFile(filename)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Equal(t, 1, len(lines))
+
+	var m map[string]string
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &m))
+	require.Equal(t, AuditClassConnection, m["event_class"])
+	require.Equal(t, AuditEventLogin, m["event"])
+	require.Equal(t, "acc1", m["account"])
+	require.Equal(t, StatementStatusFailed.String(), m["status"])
+	require.Equal(t, "20101", m["err_code"])
+
+	// nothing is written, if the audit is disabled
+	SetTracerProvider(newMOTracerProvider(EnableTracer(false), EnableAudit(false), WithAuditLogFile(filename, 1, 1)))
+	require.False(t, IsAuditEnable())
+	require.NoError(t, ReportAudit(ctx, r))
+	data2, err := os.ReadFile(filename)
+	require.NoError(t, err)
+	require.Equal(t, data, data2)
+}
@@ -33,6 +33,8 @@ const (
 	// statementInfoTbl is an EXTERNAL table
 	statementInfoTbl = "statement_info"
 	rawLogTbl        = "rawlog"
+	// auditTbl is an EXTERNAL table
+	auditTbl = "mo_audit"
 
 	// spanInfoTbl is a view
 	spanInfoTbl  = "span_info"
@@ -68,6 +70,44 @@ var (
 	sqlTypeCol   = table.TextColumn("sql_source_type", "sql statement source type")
 	resultCntCol = table.Int64Column("result_count", "the number of rows of sql execution results")
 
+	auditIDCol    = table.UuidStringColumn("audit_id", "audit record uniq id")
+	roleCol       = table.StringColumn("role", "role name")
+	eventTimeCol  = table.DatetimeColumn("event_time", "the datetime of the event")
+	eventClassCol = table.StringColumn("event_class", "event class, val in [connection, ddl, dcl, dml]")
+	eventCol      = table.StringColumn("event", "event name, val in [Login, Logout, Create Table, Grant Privilege, Insert, ...]")
+	outcomeCol    = table.StringColumn("status", "the outcome of the event, enum: Success, Failed")
+
+	AuditTable = &table.Table{
+		Account:  table.AccountAll,
+		Database: StatsDatabase,
+		Table:    auditTbl,
+		Columns: []table.Column{
+			auditIDCol,
+			sesIDCol,
+			accountCol,
+			userCol,
+			roleCol,
+			hostCol,
+			dbCol,
+			eventTimeCol,
+			eventClassCol,
+			eventCol,
+			stmtCol,
+			outcomeCol,
+			errCodeCol,
+			errorCol,
+			nodeUUIDCol,
+			nodeTypeCol,
+		},
+		PrimaryKeyColumn: []table.Column{auditIDCol},
+		Engine:           table.ExternalTableEngine,
+		Comment:          "record the logins, logouts and the privileged or data-changing statements",
+		PathBuilder:      table.NewAccountDatePathBuilder(),
+		AccountColumn:    &accountCol,
+		// SupportUserAccess
+		SupportUserAccess: true,
+	}
+
 	SingleStatementTable = &table.Table{
 		Account:  table.AccountAll,
 		Database: StatsDatabase,
@@ -228,7 +268,7 @@ const (
 	sqlCreateDBConst = `create database if not exists ` + StatsDatabase
 )
 
-var tables = []*table.Table{SingleStatementTable, SingleRowLogTable}
+var tables = []*table.Table{SingleStatementTable, SingleRowLogTable, AuditTable}
 var views = []*table.View{logView, errorView, spanView}
 
 // InitSchemaByInnerExecutor init schema, which can access db by io.InternalExecutor on any Node.
@@ -139,7 +139,14 @@ func TestGetSchemaForAccount(t *testing.T) {
 					found = true
 				}
 			}
-			require.Equal(t, 1, len(schemas))
+			require.Equal(t, 2, len(schemas))
+			require.Equal(t, true, found)
+			found = false
+			for _, sche := range schemas {
+				if strings.Contains(sche, "/"+tt.args.account+"/*/*/*/*/mo_audit/*") {
+					found = true
+				}
+			}
 			require.Equal(t, true, found)
 			found = false
 			if strings.Contains(SingleStatementTable.ToCreateSql(ctx, true), "/*/*/*/*/*/statement_info/*") {
@@ -59,6 +59,8 @@ func InitWithConfig(ctx context.Context, SV *config.ObservabilityParameters, opt
 		WithLongQueryTime(SV.LongQueryTime),
 		DebugMode(SV.EnableTraceDebug),
 		WithBufferSizeThreshold(SV.BufferSize),
+		EnableAudit(SV.EnableAudit),
+		WithAuditLogFile(SV.AuditLogFile, SV.AuditLogMaxSize, SV.AuditLogMaxBackups),
 	)
 	return Init(ctx, opts...)
 }
@@ -124,6 +126,7 @@ func initExporter(ctx context.Context, config *tracerProviderConfig) error {
 		p.Register(&MOZapLog{}, NewBufferPipe2CSVWorker(defaultOptions...))
 		p.Register(&StatementInfo{}, NewBufferPipe2CSVWorker(defaultOptions...))
 		p.Register(&MOErrorHolder{}, NewBufferPipe2CSVWorker(defaultOptions...))
+		p.Register(&AuditRecord{}, NewBufferPipe2CSVWorker(defaultOptions...))
 	default:
 		return moerr.NewInternalError(ctx, "unknown batchProcessMode: %s", config.batchProcessMode)
 	}
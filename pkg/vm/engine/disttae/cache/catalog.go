@@ -41,6 +41,29 @@ func NewCatalog() *CatalogCache {
 	}
 }
 
+// Version returns the version of the catalog cache and the max commit
+// timestamp of the catalog changes, the version changes whenever a
+// database, a table or the columns of a table are inserted or deleted.
+func (cc *CatalogCache) Version() (uint64, timestamp.Timestamp) {
+	cc.version.Lock()
+	defer cc.version.Unlock()
+	return cc.version.version, cc.version.ts
+}
+
+func (cc *CatalogCache) bumpVersion(timestamps []types.TS) {
+	if len(timestamps) == 0 {
+		return
+	}
+	cc.version.Lock()
+	defer cc.version.Unlock()
+	cc.version.version++
+	for i := range timestamps {
+		if ts := timestamps[i].ToTimestamp(); cc.version.ts.Less(ts) {
+			cc.version.ts = ts
+		}
+	}
+}
+
 func (cc *CatalogCache) GC(ts timestamp.Timestamp) {
 	{ // table cache gc
 		var items []*TableItem
@@ -56,7 +79,8 @@ func (cc *CatalogCache) GC(ts timestamp.Timestamp) {
 		})
 		for _, item := range items {
 			cc.tables.data.Delete(item)
-			if !item.deleted {
+			// the rowid index keeps the latest version of the table
+			if cur, ok := cc.tables.rowidIndex.Get(item); ok && cur == item {
 				cc.tables.rowidIndex.Delete(item)
 			}
 		}
@@ -91,7 +115,12 @@ func (cc *CatalogCache) Tables(accountId uint32, databaseId uint64,
 		AccountId:  accountId,
 		DatabaseId: databaseId,
 	}
-	mp := make(map[uint64]uint8)
+	// a renamed table has items of different names
+	type nameAndId struct {
+		name string
+		id   uint64
+	}
+	mp := make(map[nameAndId]uint8)
 	cc.tables.data.Ascend(key, func(item *TableItem) bool {
 		if item.AccountId != accountId {
 			return false
@@ -102,8 +131,8 @@ func (cc *CatalogCache) Tables(accountId uint32, databaseId uint64,
 		if item.Ts.Greater(ts) {
 			return true
 		}
-		if _, ok := mp[item.Id]; !ok {
-			mp[item.Id] = 0
+		if _, ok := mp[nameAndId{item.Name, item.Id}]; !ok {
+			mp[nameAndId{item.Name, item.Id}] = 0
 			if !item.deleted {
 				rs = append(rs, item.Name)
 				rids = append(rids, item.Id)
@@ -210,6 +239,7 @@ func (cc *CatalogCache) GetDatabase(db *DatabaseItem) bool {
 func (cc *CatalogCache) DeleteTable(bat *batch.Batch) {
 	rowids := vector.MustFixedCol[types.Rowid](bat.GetVector(MO_ROWID_IDX))
 	timestamps := vector.MustFixedCol[types.TS](bat.GetVector(MO_TIMESTAMP_IDX))
+	defer cc.bumpVersion(timestamps)
 	for i, rowid := range rowids {
 		if item, ok := cc.tables.rowidIndex.Get(&TableItem{Rowid: rowid}); ok {
 			newItem := &TableItem{
@@ -229,6 +259,7 @@ func (cc *CatalogCache) DeleteTable(bat *batch.Batch) {
 func (cc *CatalogCache) DeleteDatabase(bat *batch.Batch) {
 	rowids := vector.MustFixedCol[types.Rowid](bat.GetVector(MO_ROWID_IDX))
 	timestamps := vector.MustFixedCol[types.TS](bat.GetVector(MO_TIMESTAMP_IDX))
+	defer cc.bumpVersion(timestamps)
 	for i, rowid := range rowids {
 		if item, ok := cc.databases.rowidIndex.Get(&DatabaseItem{Rowid: rowid}); ok {
 			newItem := &DatabaseItem{
@@ -249,6 +280,7 @@ func (cc *CatalogCache) DeleteDatabase(bat *batch.Batch) {
 func (cc *CatalogCache) InsertTable(bat *batch.Batch) {
 	rowids := vector.MustFixedCol[types.Rowid](bat.GetVector(MO_ROWID_IDX))
 	timestamps := vector.MustFixedCol[types.TS](bat.GetVector(MO_TIMESTAMP_IDX))
+	defer cc.bumpVersion(timestamps)
 	accounts := vector.MustFixedCol[uint32](bat.GetVector(catalog.MO_TABLES_ACCOUNT_ID_IDX + MO_OFF))
 	names := vector.MustStrCol(bat.GetVector(catalog.MO_TABLES_REL_NAME_IDX + MO_OFF))
 	ids := vector.MustFixedCol[uint64](bat.GetVector(catalog.MO_TABLES_REL_ID_IDX + MO_OFF))
@@ -278,6 +310,18 @@ func (cc *CatalogCache) InsertTable(bat *batch.Batch) {
 		item.PrimaryIdx = -1
 		item.ClusterByIdx = -1
 		copy(item.Rowid[:], rowids[i][:])
+		// the table is renamed, the old name is deleted at the same time
+		if old, ok := cc.tables.rowidIndex.Get(item); ok && old.Name != item.Name {
+			cc.tables.data.Set(&TableItem{
+				deleted:    true,
+				Id:         old.Id,
+				Name:       old.Name,
+				Rowid:      old.Rowid,
+				AccountId:  old.AccountId,
+				DatabaseId: old.DatabaseId,
+				Ts:         item.Ts,
+			})
+		}
 		cc.tables.data.Set(item)
 		cc.tables.rowidIndex.Set(item)
 	}
@@ -290,6 +334,7 @@ func (cc *CatalogCache) InsertColumns(bat *batch.Batch) {
 	key := new(TableItem)
 	// get table key info
 	timestamps := vector.MustFixedCol[types.TS](bat.GetVector(MO_TIMESTAMP_IDX))
+	defer cc.bumpVersion(timestamps)
 	accounts := vector.MustFixedCol[uint32](bat.GetVector(catalog.MO_COLUMNS_ACCOUNT_ID_IDX + MO_OFF))
 	databaseIds := vector.MustFixedCol[uint64](bat.GetVector(catalog.MO_COLUMNS_ATT_DATABASE_ID_IDX + MO_OFF))
 	tableNames := vector.MustStrCol(bat.GetVector(catalog.MO_COLUMNS_ATT_RELNAME_IDX + MO_OFF))
@@ -365,6 +410,7 @@ func (cc *CatalogCache) InsertColumns(bat *batch.Batch) {
 func (cc *CatalogCache) InsertDatabase(bat *batch.Batch) {
 	rowids := vector.MustFixedCol[types.Rowid](bat.GetVector(MO_ROWID_IDX))
 	timestamps := vector.MustFixedCol[types.TS](bat.GetVector(MO_TIMESTAMP_IDX))
+	defer cc.bumpVersion(timestamps)
 	accounts := vector.MustFixedCol[uint32](bat.GetVector(catalog.MO_DATABASE_ACCOUNT_ID_IDX + MO_OFF))
 	names := vector.MustStrCol(bat.GetVector(catalog.MO_DATABASE_DAT_NAME_IDX + MO_OFF))
 	ids := vector.MustFixedCol[uint64](bat.GetVector(catalog.MO_DATABASE_DAT_ID_IDX + MO_OFF))
@@ -15,6 +15,7 @@
 package cache
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
@@ -106,6 +107,95 @@ func TestTables(t *testing.T) {
 	require.Equal(t, int64(0), mp.CurrNB())
 }
 
+func TestTableRename(t *testing.T) {
+	mp := mpool.MustNewZero()
+	cc := NewCatalog()
+	newBatch := func() *batch.Batch {
+		bat := newTestTableBatch(mp)
+		for i := range vector.MustFixedCol[uint32](bat.GetVector(catalog.MO_TABLES_ACCOUNT_ID_IDX + MO_OFF)) {
+			vector.MustFixedCol[uint32](bat.GetVector(catalog.MO_TABLES_ACCOUNT_ID_IDX + MO_OFF))[i] = 1
+			vector.MustFixedCol[uint64](bat.GetVector(catalog.MO_TABLES_RELDATABASE_ID_IDX + MO_OFF))[i] = 12
+		}
+		return bat
+	}
+	bat := newBatch()
+	cc.InsertTable(bat)
+	names := vector.MustStrCol(bat.GetVector(catalog.MO_TABLES_REL_NAME_IDX + MO_OFF))
+	timestamps := vector.MustFixedCol[types.TS](bat.GetVector(MO_TIMESTAMP_IDX))
+
+	// the rows of the same rowids with new names rename the tables
+	renamed := newBatch()
+	newNames := vector.NewVec(*renamed.GetVector(catalog.MO_TABLES_REL_NAME_IDX + MO_OFF).GetType())
+	for _, name := range names {
+		require.NoError(t, vector.AppendBytes(newNames, []byte("new_"+name), false, mp))
+	}
+	renamed.GetVector(catalog.MO_TABLES_REL_NAME_IDX + MO_OFF).Free(mp)
+	renamed.SetVector(catalog.MO_TABLES_REL_NAME_IDX+MO_OFF, newNames)
+	copy(vector.MustFixedCol[types.Rowid](renamed.GetVector(MO_ROWID_IDX)), vector.MustFixedCol[types.Rowid](bat.GetVector(MO_ROWID_IDX)))
+	renamedTimestamps := vector.MustFixedCol[types.TS](renamed.GetVector(MO_TIMESTAMP_IDX))
+	for i := range renamedTimestamps {
+		renamedTimestamps[i] = types.BuildTS(timestamps[i].Physical()+10, timestamps[i].Logical())
+	}
+	cc.InsertTable(renamed)
+
+	key := new(TableItem)
+	for i, name := range names {
+		key.Name = name
+		key.AccountId = 1
+		key.DatabaseId = 12
+		key.Ts = timestamps[i].ToTimestamp()
+		require.True(t, cc.GetTable(key))
+		key.Ts = types.BuildTS(renamedTimestamps[i].Physical()+1, 0).ToTimestamp()
+		require.False(t, cc.GetTable(key))
+		key.Name = "new_" + name
+		require.True(t, cc.GetTable(key))
+	}
+	tblList, tblIdList := cc.Tables(1, 12, timestamp.Timestamp{
+		PhysicalTime: 100,
+	})
+	require.Equal(t, 10, len(tblList))
+	require.Equal(t, 10, len(tblIdList))
+	for _, name := range tblList {
+		require.True(t, strings.HasPrefix(name, "new_"))
+	}
+	bat.Clean(mp)
+	renamed.Clean(mp)
+	require.Equal(t, int64(0), mp.CurrNB())
+}
+
+func TestVersion(t *testing.T) {
+	mp := mpool.MustNewZero()
+	cc := NewCatalog()
+	version, ts := cc.Version()
+	require.Equal(t, uint64(0), version)
+	require.True(t, ts.IsEmpty())
+
+	dbBat := newTestDatabaseBatch(mp)
+	cc.InsertDatabase(dbBat)
+	version, _ = cc.Version()
+	require.Equal(t, uint64(1), version)
+
+	tblBat := newTestTableBatch(mp)
+	colBat := newTestColumnBatch(t, tblBat, mp)
+	cc.InsertTable(tblBat)
+	cc.InsertColumns(colBat)
+	version, ts = cc.Version()
+	require.Equal(t, uint64(3), version)
+	for _, t0 := range vector.MustFixedCol[types.TS](tblBat.GetVector(MO_TIMESTAMP_IDX)) {
+		require.False(t, ts.Less(t0.ToTimestamp()))
+	}
+
+	// gc does not change the visible catalog, so the version stays
+	cc.GC(timestamp.Timestamp{PhysicalTime: 100})
+	version, _ = cc.Version()
+	require.Equal(t, uint64(3), version)
+
+	dbBat.Clean(mp)
+	tblBat.Clean(mp)
+	colBat.Clean(mp)
+	require.Equal(t, int64(0), mp.CurrNB())
+}
+
 func TestDatabases(t *testing.T) {
 	mp := mpool.MustNewZero()
 	cc := NewCatalog()
@@ -16,6 +16,7 @@ package cache
 
 import (
 	"bytes"
+	"sync"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
@@ -38,6 +39,17 @@ const (
 type CatalogCache struct {
 	tables    *tableCache
 	databases *databaseCache
+	version   catalogVersion
+}
+
+// catalogVersion is bumped whenever a database, a table or its columns
+// are changed, it allows the cn to find out cheaply whether anything
+// derived from the catalog (such as a cached plan) is still valid.
+type catalogVersion struct {
+	sync.Mutex
+	version uint64
+	// ts is the max commit timestamp of the changes seen so far
+	ts timestamp.Timestamp
 }
 
 // database cache:
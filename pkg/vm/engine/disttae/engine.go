@@ -273,6 +273,10 @@ func (e *Engine) AllocateIDByKey(ctx context.Context, key string) (uint64, error
 	return e.idGen.AllocateIDByKey(ctx, key)
 }
 
+func (e *Engine) CatalogVersion() (uint64, timestamp.Timestamp) {
+	return e.catalog.Version()
+}
+
 func (e *Engine) Delete(ctx context.Context, name string, op client.TxnOperator) error {
 	var db *txnDatabase
 
@@ -48,6 +48,9 @@ type PartitionState struct {
 	Blocks       *btree.BTreeG[BlockEntry]
 	PrimaryIndex *btree.BTreeG[*PrimaryIndexEntry]
 	Checkpoints  []string
+	// MaxCommitTS is the max commit timestamp of the entries applied to the state,
+	// which changes whenever the data of the table is changed
+	MaxCommitTS types.TS
 
 	// noData indicates whether to retain data batch
 	// for primary key dedup, reading data is not required
@@ -147,10 +150,17 @@ func (p *PartitionState) Copy() *PartitionState {
 		Blocks:       p.Blocks.Copy(),
 		PrimaryIndex: p.PrimaryIndex.Copy(),
 		Checkpoints:  checkpoints,
+		MaxCommitTS:  p.MaxCommitTS,
 		noData:       p.noData,
 	}
 }
 
+func (p *PartitionState) updateMaxCommitTS(ts types.TS) {
+	if ts.Greater(p.MaxCommitTS) {
+		p.MaxCommitTS = ts
+	}
+}
+
 func (p *PartitionState) RowExists(rowID types.Rowid, ts types.TS) bool {
 	iter := p.Rows.Iter()
 	defer iter.Release()
@@ -248,6 +258,7 @@ func (p *PartitionState) HandleRowsInsert(
 				entry.ID = atomic.AddInt64(&nextRowEntryID, 1)
 				numInserted++
 			}
+			p.updateMaxCommitTS(timeVector[i])
 
 			if !p.noData {
 				entry.Batch = batch
@@ -309,6 +320,7 @@ func (p *PartitionState) HandleRowsDelete(ctx context.Context, input *api.Batch)
 				entry.ID = atomic.AddInt64(&nextRowEntryID, 1)
 			}
 
+			p.updateMaxCommitTS(timeVector[i])
 			entry.Deleted = true
 			if !p.noData {
 				entry.Batch = batch
@@ -366,9 +378,11 @@ func (p *PartitionState) HandleMetadataInsert(ctx context.Context, input *api.Ba
 			entry.Sorted = sortedStateVector[i]
 			if t := createTimeVector[i]; !t.IsEmpty() {
 				entry.CreateTime = t
+				p.updateMaxCommitTS(t)
 			}
 			if t := commitTimeVector[i]; !t.IsEmpty() {
 				entry.CommitTs = t
+				p.updateMaxCommitTS(t)
 			}
 			entry.EntryState = entryStateVector[i]
 
@@ -432,6 +446,7 @@ func (p *PartitionState) HandleMetadataDelete(ctx context.Context, input *api.Ba
 			}
 
 			entry.DeleteTime = deleteTimeVector[i]
+			p.updateMaxCommitTS(entry.DeleteTime)
 
 			p.Blocks.Set(entry)
 		})
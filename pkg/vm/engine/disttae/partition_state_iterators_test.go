@@ -81,6 +81,7 @@ func TestPartitionStateRowsIter(t *testing.T) {
 			},
 		}, 0, packer)
 	}
+	require.Equal(t, types.BuildTS(num-1, 0), state.MaxCommitTS)
 
 	for i := 0; i < num; i++ {
 		ts := types.BuildTS(int64(i), 0)
@@ -165,6 +166,7 @@ func TestPartitionStateRowsIter(t *testing.T) {
 			},
 		})
 	}
+	require.Equal(t, types.BuildTS(int64(deleteAt+num-1), 1), state.MaxCommitTS)
 
 	for i := 0; i < num; i++ {
 		{
@@ -214,6 +216,7 @@ func TestPartitionStateRowsIter(t *testing.T) {
 			},
 		})
 	}
+	require.Equal(t, types.BuildTS(int64(deleteAt+num-1), 1), state.MaxCommitTS)
 
 	for i := 0; i < num; i++ {
 		{
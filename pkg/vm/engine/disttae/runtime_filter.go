@@ -0,0 +1,68 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disttae
+
+import (
+	"context"
+	"unsafe"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
+)
+
+var blockInfoSize = int(unsafe.Sizeof(catalog.BlockInfo{}))
+
+// PruneRangesByZonemap drops the blocks of ranges whose zonemap on the column
+// at col is rejected by keep. The ranges not of a block, like the mark of
+// the data in memory, and the blocks without a zonemap on the column are
+// always kept.
+func PruneRangesByZonemap(
+	ctx context.Context,
+	ranges [][]byte,
+	col uint16,
+	keep func(index.ZM) bool,
+	fs fileservice.FileService,
+	m *mpool.MPool) ([][]byte, error) {
+	return pruneRangesByZonemap(ranges, keep, func(info catalog.BlockInfo) (Zonemap, error) {
+		zms, _, err := fetchZonemapAndRowsFromBlockInfo(ctx, []uint16{col}, info, fs, m)
+		if err != nil {
+			return Zonemap{}, err
+		}
+		return zms[0], nil
+	})
+}
+
+func pruneRangesByZonemap(
+	ranges [][]byte,
+	keep func(index.ZM) bool,
+	load func(catalog.BlockInfo) (Zonemap, error)) ([][]byte, error) {
+	pruned := make([][]byte, 0, len(ranges))
+	for _, r := range ranges {
+		if len(r) != blockInfoSize {
+			pruned = append(pruned, r)
+			continue
+		}
+		zm, err := load(*BlockInfoUnmarshal(r))
+		if err != nil {
+			return nil, err
+		}
+		if z := index.ZM(zm[:]); !z.IsInited() || keep(z) {
+			pruned = append(pruned, r)
+		}
+	}
+	return pruned, nil
+}
@@ -0,0 +1,85 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disttae
+
+import (
+	"context"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
+)
+
+// SortRangesByZonemap orders the blocks of ranges by the minimums of their
+// zonemaps on the column at col, and returns the minimums along. The ranges
+// not of a block, like the mark of the data in memory, and the blocks
+// without a zonemap on the column come first, their minimums are nil.
+func SortRangesByZonemap(
+	ctx context.Context,
+	ranges [][]byte,
+	col uint16,
+	oid types.T,
+	fs fileservice.FileService,
+	m *mpool.MPool) ([][]byte, [][]byte, error) {
+	return sortRangesByZonemap(ranges, oid, func(info catalog.BlockInfo) (Zonemap, error) {
+		zms, _, err := fetchZonemapAndRowsFromBlockInfo(ctx, []uint16{col}, info, fs, m)
+		if err != nil {
+			return Zonemap{}, err
+		}
+		return zms[0], nil
+	})
+}
+
+func sortRangesByZonemap(
+	ranges [][]byte,
+	oid types.T,
+	load func(catalog.BlockInfo) (Zonemap, error)) ([][]byte, [][]byte, error) {
+	mins := make([][]byte, len(ranges))
+	for i, r := range ranges {
+		if len(r) != blockInfoSize {
+			continue
+		}
+		zm, err := load(*BlockInfoUnmarshal(r))
+		if err != nil {
+			return nil, nil, err
+		}
+		// the min of a zonemap of strings is a prefix no greater than the
+		// real min, it bounds the keys of the block as well
+		if z := index.ZM(zm[:]); z.IsInited() {
+			mins[i] = append([]byte{}, z.GetMinBuf()...)
+		}
+	}
+	sorted := make([]int, len(ranges))
+	for i := range sorted {
+		sorted[i] = i
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := mins[sorted[i]], mins[sorted[j]]
+		if a == nil || b == nil {
+			return a == nil && b != nil
+		}
+		return compute.Compare(a, b, oid) < 0
+	})
+	sortedRanges := make([][]byte, len(ranges))
+	sortedMins := make([][]byte, len(ranges))
+	for i, k := range sorted {
+		sortedRanges[i], sortedMins[i] = ranges[k], mins[k]
+	}
+	return sortedRanges, sortedMins, nil
+}
@@ -150,6 +150,120 @@ func genTableConstraintTuple(tblId, dbId uint64, tblName, dbName string, constra
 	return bat, nil
 }
 
+func genTablePartitionTuple(tblId, dbId uint64, tblName, dbName string, partition string,
+	m *mpool.MPool) (*batch.Batch, error) {
+	bat := batch.NewWithSize(5)
+	bat.Attrs = append(bat.Attrs, catalog.MoTablesSchema[:4]...)
+	bat.Attrs = append(bat.Attrs, catalog.SystemRelAttr_Partition)
+	bat.SetZs(1, m)
+
+	{
+		idx := catalog.MO_TABLES_REL_ID_IDX
+		bat.Vecs[idx] = vector.NewVec(catalog.MoTablesTypes[idx]) // rel_id
+		if err := vector.AppendFixed(bat.Vecs[idx], tblId, false, m); err != nil {
+			return nil, err
+		}
+		idx = catalog.MO_TABLES_REL_NAME_IDX
+		bat.Vecs[idx] = vector.NewVec(catalog.MoTablesTypes[idx]) // relname
+		if err := vector.AppendBytes(bat.Vecs[idx], []byte(tblName), false, m); err != nil {
+			return nil, err
+		}
+		idx = catalog.MO_TABLES_RELDATABASE_IDX
+		bat.Vecs[idx] = vector.NewVec(catalog.MoTablesTypes[idx]) // reldatabase
+		if err := vector.AppendBytes(bat.Vecs[idx], []byte(dbName), false, m); err != nil {
+			return nil, err
+		}
+		idx = catalog.MO_TABLES_RELDATABASE_ID_IDX
+		bat.Vecs[idx] = vector.NewVec(catalog.MoTablesTypes[idx]) // reldatabase_id
+		if err := vector.AppendFixed(bat.Vecs[idx], dbId, false, m); err != nil {
+			return nil, err
+		}
+		idx = catalog.MO_TABLES_UPDATE_PARTITION
+		bat.Vecs[idx] = vector.NewVec(catalog.MoTablesTypes[catalog.MO_TABLES_PARTITION_INFO_IDX]) // partition_info
+		if err := vector.AppendBytes(bat.Vecs[idx], []byte(partition), false, m); err != nil {
+			return nil, err
+		}
+	}
+
+	return bat, nil
+}
+
+func genTableMergePolicyTuple(tblId, dbId uint64, tblName, dbName string, mergePolicy string,
+	m *mpool.MPool) (*batch.Batch, error) {
+	bat := batch.NewWithSize(5)
+	bat.Attrs = append(bat.Attrs, catalog.MoTablesSchema[:4]...)
+	bat.Attrs = append(bat.Attrs, catalog.MergePolicyAttr)
+	bat.SetZs(1, m)
+
+	{
+		idx := catalog.MO_TABLES_REL_ID_IDX
+		bat.Vecs[idx] = vector.NewVec(catalog.MoTablesTypes[idx]) // rel_id
+		if err := vector.AppendFixed(bat.Vecs[idx], tblId, false, m); err != nil {
+			return nil, err
+		}
+		idx = catalog.MO_TABLES_REL_NAME_IDX
+		bat.Vecs[idx] = vector.NewVec(catalog.MoTablesTypes[idx]) // relname
+		if err := vector.AppendBytes(bat.Vecs[idx], []byte(tblName), false, m); err != nil {
+			return nil, err
+		}
+		idx = catalog.MO_TABLES_RELDATABASE_IDX
+		bat.Vecs[idx] = vector.NewVec(catalog.MoTablesTypes[idx]) // reldatabase
+		if err := vector.AppendBytes(bat.Vecs[idx], []byte(dbName), false, m); err != nil {
+			return nil, err
+		}
+		idx = catalog.MO_TABLES_RELDATABASE_ID_IDX
+		bat.Vecs[idx] = vector.NewVec(catalog.MoTablesTypes[idx]) // reldatabase_id
+		if err := vector.AppendFixed(bat.Vecs[idx], dbId, false, m); err != nil {
+			return nil, err
+		}
+		idx = catalog.MO_TABLES_UPDATE_MERGE
+		bat.Vecs[idx] = vector.NewVec(catalog.MoTablesTypes[catalog.MO_TABLES_PARTITION_INFO_IDX]) // merge_policy
+		if err := vector.AppendBytes(bat.Vecs[idx], []byte(mergePolicy), false, m); err != nil {
+			return nil, err
+		}
+	}
+
+	return bat, nil
+}
+
+func genTableNameTuple(tblId, dbId uint64, tblName, dbName string, newName string,
+	m *mpool.MPool) (*batch.Batch, error) {
+	bat := batch.NewWithSize(5)
+	bat.Attrs = append(bat.Attrs, catalog.MoTablesSchema[:4]...)
+	bat.Attrs = append(bat.Attrs, catalog.NewRelNameAttr)
+	bat.SetZs(1, m)
+
+	{
+		idx := catalog.MO_TABLES_REL_ID_IDX
+		bat.Vecs[idx] = vector.NewVec(catalog.MoTablesTypes[idx]) // rel_id
+		if err := vector.AppendFixed(bat.Vecs[idx], tblId, false, m); err != nil {
+			return nil, err
+		}
+		idx = catalog.MO_TABLES_REL_NAME_IDX
+		bat.Vecs[idx] = vector.NewVec(catalog.MoTablesTypes[idx]) // relname
+		if err := vector.AppendBytes(bat.Vecs[idx], []byte(tblName), false, m); err != nil {
+			return nil, err
+		}
+		idx = catalog.MO_TABLES_RELDATABASE_IDX
+		bat.Vecs[idx] = vector.NewVec(catalog.MoTablesTypes[idx]) // reldatabase
+		if err := vector.AppendBytes(bat.Vecs[idx], []byte(dbName), false, m); err != nil {
+			return nil, err
+		}
+		idx = catalog.MO_TABLES_RELDATABASE_ID_IDX
+		bat.Vecs[idx] = vector.NewVec(catalog.MoTablesTypes[idx]) // reldatabase_id
+		if err := vector.AppendFixed(bat.Vecs[idx], dbId, false, m); err != nil {
+			return nil, err
+		}
+		idx = catalog.MO_TABLES_UPDATE_NAME
+		bat.Vecs[idx] = vector.NewVec(catalog.MoTablesTypes[catalog.MO_TABLES_REL_NAME_IDX]) // new_relname
+		if err := vector.AppendBytes(bat.Vecs[idx], []byte(newName), false, m); err != nil {
+			return nil, err
+		}
+	}
+
+	return bat, nil
+}
+
 func genCreateTableTuple(tbl *txnTable, sql string, accountId, userId, roleId uint32, name string,
 	tableId uint64, databaseId uint64, databaseName string, m *mpool.MPool) (*batch.Batch, error) {
 	_ = sql //TODO delete this param if not required
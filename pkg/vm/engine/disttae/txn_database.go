@@ -235,6 +235,7 @@ func (db *txnDatabase) Create(ctx context.Context, name string, defs []engine.Ta
 	}
 	tbl := new(txnTable)
 	tbl.comment = getTableComment(defs)
+	mergeProps := new(catalog.MergeProperties)
 	{
 		for _, def := range defs { // copy from tae
 			switch defVal := def.(type) {
@@ -248,6 +249,11 @@ func (db *txnDatabase) Create(ctx context.Context, name string, defs []engine.Ta
 					case catalog.SystemRelAttr_CreateSQL:
 						tbl.createSql = property.Value // I don't trust this information.
 					default:
+						if catalog.IsMergeProperty(property.Key) {
+							if err := mergeProps.Set(ctx, property.Key, property.Value); err != nil {
+								return err
+							}
+						}
 					}
 				}
 			case *engine.ViewDef:
@@ -305,6 +311,11 @@ func (db *txnDatabase) Create(ctx context.Context, name string, defs []engine.Ta
 	tbl.defs = defs
 	tbl.tableName = name
 	tbl.tableId = tableId
+	if mergePolicy := mergeProps.Encode(); mergePolicy != "" {
+		if err := tbl.UpdateMergePolicy(ctx, mergePolicy); err != nil {
+			return err
+		}
+	}
 	tbl.getTableDef()
 	db.txn.createMap.Store(genTableKey(ctx, name, db.databaseId), tbl)
 	return nil
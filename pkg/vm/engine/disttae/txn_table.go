@@ -25,12 +25,15 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
 	"github.com/matrixorigin/matrixone/pkg/pb/api"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/perfcounter"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/deletion"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
 	"github.com/matrixorigin/matrixone/pkg/txn/storage/memorystorage/memorytable"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
@@ -177,6 +180,61 @@ func (tbl *txnTable) MaxAndMinValues(ctx context.Context) ([][2]any, []uint8, er
 	return tableVal, tableTypes, nil
 }
 
+// ClusteringDepth returns the clustering depth of the table on the columns of
+// its cluster by key, or of its primary key if it has none. The depth on a
+// column is the average number of blocks whose zonemaps overlap the zonemap of
+// a block, itself included, and the depth of the table is averaged over the
+// columns: 1 means the blocks are perfectly clustered.
+func (tbl *txnTable) ClusteringDepth(ctx context.Context) (float64, error) {
+	tableDef := tbl.getTableDef()
+	var cols []int
+	if cbName := tbl.getCbName(); util.JudgeIsCompositeClusterByColumn(cbName) {
+		for _, name := range util.SplitCompositeClusterByColumnName(cbName) {
+			if idx, ok := tableDef.Name2ColIndex[name]; ok {
+				cols = append(cols, int(idx))
+			}
+		}
+	} else if tbl.clusterByIdx >= 0 {
+		cols = append(cols, tbl.clusterByIdx)
+	} else if tbl.primaryIdx >= 0 {
+		cols = append(cols, tbl.primaryIdx)
+	}
+	if len(cols) == 0 {
+		return 0, moerr.NewInvalidInput(ctx, "table %s has no cluster by or primary key", tbl.tableName)
+	}
+
+	var metas []BlockMeta
+	for _, blks := range tbl.blockMetas {
+		metas = append(metas, blks...)
+	}
+	depth := float64(0)
+	for _, col := range cols {
+		d, err := getClusteringDepth(metas, col, tableDef)
+		if err != nil {
+			return 0, err
+		}
+		depth += d
+	}
+	return depth / float64(len(cols)), nil
+}
+
+func (tbl *txnTable) MaxCommitTS(ctx context.Context) (types.TS, error) {
+	if err := tbl.updateBlockMetas(ctx, nil); err != nil {
+		return types.TS{}, err
+	}
+	parts, err := tbl.getParts(ctx)
+	if err != nil {
+		return types.TS{}, err
+	}
+	var ts types.TS
+	for _, part := range parts {
+		if part.MaxCommitTS.Greater(ts) {
+			ts = part.MaxCommitTS
+		}
+	}
+	return ts, nil
+}
+
 func (tbl *txnTable) Size(ctx context.Context, name string) (int64, error) {
 	// TODO
 	return 0, nil
@@ -267,6 +325,8 @@ func (tbl *txnTable) Ranges(ctx context.Context, expr *plan.Expr) ([][]byte, err
 
 	exprMono := plan2.CheckExprIsMonotonic(tbl.db.txn.proc.Ctx, expr)
 	columnMap, columns, maxCol := plan2.GetColumnsByExpr(expr, tbl.getTableDef())
+	bfPreds := getBloomFilterPredicates(expr, tbl.getBloomFilterColumns(), tbl.getTableDef())
+	bfCache := make(map[string][]objectio.StaticFilter)
 	for _, i := range tbl.dnList {
 		blocks := tbl.blockMetas[i]
 		blks := make([]BlockMeta, 0, len(blocks))
@@ -315,18 +375,81 @@ func (tbl *txnTable) Ranges(ctx context.Context, expr *plan.Expr) ([][]byte, err
 				}
 			}
 		}
+		zmPruned, bfPruned := 0, 0
 		for _, blk := range blks {
 			tbl.skipBlocks[blk.Info.BlockID] = 0
 			if !exprMono || needRead(ctx, expr, blk, tbl.getTableDef(), columnMap, columns, maxCol, tbl.db.txn.proc) {
+				if len(bfPreds) > 0 && !tbl.mayContainBloomFilterKeys(ctx, blk, bfPreds, bfCache) {
+					bfPruned++
+					continue
+				}
 				ranges = append(ranges, blockInfoMarshal(blk))
+			} else {
+				zmPruned++
 			}
 		}
+		perfcounter.Update(ctx, func(c *perfcounter.CounterSet) {
+			c.DistTAE.Ranges.Blocks.Add(int64(len(blocks)))
+			c.DistTAE.Ranges.ZonemapPruned.Add(int64(zmPruned))
+			c.DistTAE.Ranges.BloomFilterPruned.Add(int64(bfPruned))
+		})
 		tbl.modifiedBlocks[i] = genModifedBlocks(ctx, deletes,
 			tbl.blockMetas[i], blks, expr, tbl.getTableDef(), tbl.db.txn.proc)
 	}
 	return ranges, nil
 }
 
+// getBloomFilterColumns returns the indexes of the columns declared with bloom
+// filters by their names.
+func (tbl *txnTable) getBloomFilterColumns() map[string]uint16 {
+	if len(tbl.constraint) == 0 {
+		return nil
+	}
+	c := new(engine.ConstraintDef)
+	if err := c.UnmarshalBinary(tbl.constraint); err != nil {
+		return nil
+	}
+	def := c.GetBloomFilterDef()
+	if def == nil || def.BloomFilter == nil {
+		return nil
+	}
+	tableDef := tbl.getTableDef()
+	cols := make(map[string]uint16, len(def.BloomFilter.Cols))
+	for _, name := range def.BloomFilter.Cols {
+		if idx, ok := tableDef.Name2ColIndex[name]; ok {
+			cols[name] = uint16(idx)
+		}
+	}
+	return cols
+}
+
+// mayContainBloomFilterKeys checks the predicates on the bloom filter columns
+// by the filters of the block, the filters of an object are loaded once.
+func (tbl *txnTable) mayContainBloomFilterKeys(
+	ctx context.Context,
+	blk BlockMeta,
+	preds []bloomFilterPredicate,
+	cache map[string][]objectio.StaticFilter) bool {
+	location := blk.Info.MetaLocation()
+	name := location.Name().String()
+	bfs, ok := cache[name]
+	if !ok {
+		reader, err := blockio.NewObjectReader(tbl.db.txn.engine.fs, location)
+		if err != nil {
+			return true
+		}
+		if bfs, _, err = reader.LoadAllBF(ctx); err != nil {
+			return true
+		}
+		cache[name] = bfs
+	}
+	id := int(location.ID())
+	if id >= len(bfs) || bfs[id] == nil {
+		return true
+	}
+	return mayContainBloomFilterKeys(bfs[id], preds)
+}
+
 // getTableDef only return all cols and their index.
 func (tbl *txnTable) getTableDef() *plan.TableDef {
 	if tbl.tableDef == nil {
@@ -433,6 +556,57 @@ func (tbl *txnTable) UpdateConstraint(ctx context.Context, c *engine.ConstraintD
 	return nil
 }
 
+func (tbl *txnTable) UpdatePartition(ctx context.Context, p *engine.PartitionDef) error {
+	bat, err := genTablePartitionTuple(tbl.tableId, tbl.db.databaseId, tbl.tableName, tbl.db.databaseName, p.Partition, tbl.db.txn.proc.Mp())
+	if err != nil {
+		return err
+	}
+	if err = tbl.db.txn.WriteBatch(UPDATE, catalog.MO_CATALOG_ID, catalog.MO_TABLES_ID,
+		catalog.MO_CATALOG, catalog.MO_TABLES, bat, tbl.db.txn.dnStores[0], -1); err != nil {
+		return err
+	}
+	tbl.partitioned = 1
+	tbl.partition = p.Partition
+	return nil
+}
+
+func (tbl *txnTable) UpdateMergePolicy(ctx context.Context, mergePolicy string) error {
+	bat, err := genTableMergePolicyTuple(tbl.tableId, tbl.db.databaseId, tbl.tableName, tbl.db.databaseName, mergePolicy, tbl.db.txn.proc.Mp())
+	if err != nil {
+		return err
+	}
+	return tbl.db.txn.WriteBatch(UPDATE, catalog.MO_CATALOG_ID, catalog.MO_TABLES_ID,
+		catalog.MO_CATALOG, catalog.MO_TABLES, bat, tbl.db.txn.dnStores[0], -1)
+}
+
+func (tbl *txnTable) Rename(ctx context.Context, name string) error {
+	bat, err := genTableNameTuple(tbl.tableId, tbl.db.databaseId, tbl.tableName, tbl.db.databaseName, name, tbl.db.txn.proc.Mp())
+	if err != nil {
+		return err
+	}
+	if err = tbl.db.txn.WriteBatch(UPDATE, catalog.MO_CATALOG_ID, catalog.MO_TABLES_ID,
+		catalog.MO_CATALOG, catalog.MO_TABLES, bat, tbl.db.txn.dnStores[0], -1); err != nil {
+		return err
+	}
+	// the later statements of the txn find the table by the new name
+	oldKey := genTableKey(ctx, tbl.tableName, tbl.db.databaseId)
+	newKey := genTableKey(ctx, name, tbl.db.databaseId)
+	if _, ok := tbl.db.txn.createMap.Load(oldKey); ok {
+		tbl.db.txn.createMap.Delete(oldKey)
+		tbl.db.txn.createMap.Store(newKey, tbl)
+	} else {
+		tbl.db.txn.tableMap.Delete(oldKey)
+		tbl.db.txn.tableMap.Store(newKey, tbl)
+	}
+	tbl.tableName = name
+	if tbl.tableDef != nil {
+		tableDef := *tbl.tableDef
+		tableDef.Name = name
+		tbl.tableDef = &tableDef
+	}
+	return nil
+}
+
 func (tbl *txnTable) TableColumns(ctx context.Context) ([]*engine.Attribute, error) {
 	var attrs []*engine.Attribute
 	for _, def := range tbl.defs {
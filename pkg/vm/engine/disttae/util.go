@@ -37,6 +37,7 @@ import (
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/compute"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -100,6 +101,52 @@ func getZonemapDataFromMeta(columns []int, meta BlockMeta, tableDef *plan.TableD
 	return datas, dataTypes, nil
 }
 
+// getClusteringDepth returns the average number of blocks whose zonemaps on
+// the column overlap the zonemap of a block, itself included. The blocks
+// without a zonemap on the column, all nulls, are left out.
+func getClusteringDepth(metas []BlockMeta, col int, tableDef *plan.TableDef) (float64, error) {
+	oid := types.T(tableDef.Cols[col].Typ.Id)
+	mins := make([]any, 0, len(metas))
+	maxs := make([]any, 0, len(metas))
+	for _, meta := range metas {
+		vals, _, err := getZonemapDataFromMeta([]int{col}, meta, tableDef)
+		if err != nil {
+			return 0, err
+		}
+		if vals == nil {
+			continue
+		}
+		mins = append(mins, vals[0][0])
+		maxs = append(maxs, vals[0][1])
+	}
+	n := len(mins)
+	if n == 0 {
+		return 0, nil
+	}
+
+	sortedMins := append([]any(nil), mins...)
+	sortedMaxs := append([]any(nil), maxs...)
+	sort.Slice(sortedMins, func(i, j int) bool {
+		return compute.CompareGeneric(sortedMins[i], sortedMins[j], oid) < 0
+	})
+	sort.Slice(sortedMaxs, func(i, j int) bool {
+		return compute.CompareGeneric(sortedMaxs[i], sortedMaxs[j], oid) < 0
+	})
+	overlaps := 0
+	for i := 0; i < n; i++ {
+		// the blocks starting after the end of the block and the blocks
+		// ending before its start are the ones not overlapping it
+		after := n - sort.Search(n, func(k int) bool {
+			return compute.CompareGeneric(sortedMins[k], maxs[i], oid) > 0
+		})
+		before := sort.Search(n, func(k int) bool {
+			return compute.CompareGeneric(sortedMaxs[k], mins[i], oid) >= 0
+		})
+		overlaps += n - after - before
+	}
+	return float64(overlaps) / float64(n), nil
+}
+
 func getConstantExprHashValue(ctx context.Context, constExpr *plan.Expr, proc *process.Process) (bool, uint64) {
 	args := []*plan.Expr{constExpr}
 	argTypes := []types.Type{types.T(constExpr.Typ.Id).ToType()}
@@ -271,6 +318,11 @@ func getPkValueByExpr(expr *plan.Expr, pkName string, oid types.T) (bool, any) {
 	if !canCompute {
 		return canCompute, nil
 	}
+	return getConstValue(valExpr, oid)
+}
+
+// getConstValue transfers the value of the constant expression to the type
+func getConstValue(valExpr *plan.Expr, oid types.T) (bool, any) {
 	switch val := valExpr.Expr.(*plan.Expr_C).C.Value.(type) {
 	case *plan.Const_I8Val:
 		return transferIval(val.I8Val, oid)
@@ -314,6 +366,139 @@ func getPkValueByExpr(expr *plan.Expr, pkName string, oid types.T) (bool, any) {
 	return false, nil
 }
 
+// bloomFilterPredicate is an equality or IN predicate on a column with bloom
+// filter, keys are the encoded values the column may equal.
+type bloomFilterPredicate struct {
+	col  uint16
+	keys [][]byte
+}
+
+// getBloomFilterPredicates returns the predicates on the bloom filter columns
+// in the conjunctions of the expression, a block is not needed if any of them
+// has no key in the filter of the block.
+// support eg: col="a", col in ("a", "b"), col="a" or col="b", col="a" and noCol > 200
+// unsupport eg: col>"a", col=otherFun("a"), col="a" or noCol > 200
+func getBloomFilterPredicates(expr *plan.Expr, bfCols map[string]uint16, tableDef *plan.TableDef) []bloomFilterPredicate {
+	if expr == nil || len(bfCols) == 0 {
+		return nil
+	}
+	if f, ok := expr.Expr.(*plan.Expr_F); ok && f.F.Func.ObjName == "and" {
+		return append(
+			getBloomFilterPredicates(f.F.Args[0], bfCols, tableDef),
+			getBloomFilterPredicates(f.F.Args[1], bfCols, tableDef)...)
+	}
+	if pred, ok := getBloomFilterPredicate(expr, bfCols, tableDef); ok {
+		return []bloomFilterPredicate{pred}
+	}
+	return nil
+}
+
+func getBloomFilterPredicate(expr *plan.Expr, bfCols map[string]uint16, tableDef *plan.TableDef) (pred bloomFilterPredicate, ok bool) {
+	f, ok := expr.Expr.(*plan.Expr_F)
+	if !ok {
+		return pred, false
+	}
+	// getColumn returns the index of the bloom filter column of the expression
+	getColumn := func(e *plan.Expr) (uint16, types.T, bool) {
+		col, ok := e.Expr.(*plan.Expr_Col)
+		if !ok {
+			return 0, 0, false
+		}
+		name := col.Col.Name[strings.Index(col.Col.Name, ".")+1:]
+		idx, ok := bfCols[name]
+		if !ok {
+			return 0, 0, false
+		}
+		return idx, types.T(tableDef.Cols[idx].Typ.Id), true
+	}
+	// getKey encodes the value of the constant expression as the column type
+	getKey := func(e *plan.Expr, oid types.T) ([]byte, bool) {
+		if _, ok := e.Expr.(*plan.Expr_C); !ok {
+			return nil, false
+		}
+		ok, v := getConstValue(e, oid)
+		if !ok || v == nil {
+			return nil, false
+		}
+		return types.EncodeValue(v, oid), true
+	}
+
+	switch f.F.Func.ObjName {
+	case "=":
+		colExpr, valExpr := f.F.Args[0], f.F.Args[1]
+		if _, ok := colExpr.Expr.(*plan.Expr_C); ok {
+			colExpr, valExpr = valExpr, colExpr
+		}
+		idx, oid, ok := getColumn(colExpr)
+		if !ok {
+			return pred, false
+		}
+		key, ok := getKey(valExpr, oid)
+		if !ok {
+			return pred, false
+		}
+		return bloomFilterPredicate{col: idx, keys: [][]byte{key}}, true
+
+	case "in":
+		idx, oid, ok := getColumn(f.F.Args[0])
+		if !ok {
+			return pred, false
+		}
+		list, ok := f.F.Args[1].Expr.(*plan.Expr_List)
+		if !ok {
+			return pred, false
+		}
+		pred.col = idx
+		for _, e := range list.List.List {
+			key, ok := getKey(e, oid)
+			if !ok {
+				return pred, false
+			}
+			pred.keys = append(pred.keys, key)
+		}
+		return pred, len(pred.keys) > 0
+
+	case "or":
+		left, ok := getBloomFilterPredicate(f.F.Args[0], bfCols, tableDef)
+		if !ok {
+			return pred, false
+		}
+		right, ok := getBloomFilterPredicate(f.F.Args[1], bfCols, tableDef)
+		if !ok || left.col != right.col {
+			return pred, false
+		}
+		left.keys = append(left.keys, right.keys...)
+		return left, true
+	}
+	return pred, false
+}
+
+// mayContainBloomFilterKeys checks the predicates by the bloom filters of the
+// block, it returns false only if the block surely has no row matching them.
+func mayContainBloomFilterKeys(filter objectio.StaticFilter, preds []bloomFilterPredicate) bool {
+	filters, ok := filter.(*index.ColumnFilters)
+	if !ok {
+		return true
+	}
+	for _, pred := range preds {
+		bf := filters.Column(pred.col)
+		if bf == nil {
+			continue
+		}
+		found := false
+		for _, key := range pred.keys {
+			if ok, err := bf.MayContainsKey(key); err != nil || ok {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
 // computeRangeByNonIntPk compute NonIntPk range Expr
 // only support function :["and", "="]
 // support eg: pk="a",  pk="a" and noPk > 200
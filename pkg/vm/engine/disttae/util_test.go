@@ -27,6 +27,7 @@ import (
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
 )
 
@@ -597,3 +598,193 @@ func TestComputeRangeByIntPk(t *testing.T) {
 // 		}
 // 	})
 // }
+
+func TestGetBloomFilterPredicates(t *testing.T) {
+	tableDef := makeTableDefForTest([]string{"a", "b", "c", "d"})
+	bfCols := map[string]uint16{"b": 1, "c": 2}
+	key := func(v int64) []byte { return types.EncodeInt64(&v) }
+	inList := &plan.Expr{
+		Expr: &plan.Expr_List{List: &plan.ExprList{List: []*plan.Expr{
+			plan2.MakePlan2Int64ConstExprWithType(10),
+			plan2.MakePlan2Int64ConstExprWithType(20),
+		}}},
+	}
+	testCases := []struct {
+		expr  *plan.Expr
+		preds []bloomFilterPredicate
+	}{
+		{makeFunctionExprForTest("=", []*plan.Expr{
+			makeColExprForTest(1, types.T_int64),
+			plan2.MakePlan2Int64ConstExprWithType(10),
+		}), []bloomFilterPredicate{{col: 1, keys: [][]byte{key(10)}}}},
+		{makeFunctionExprForTest("=", []*plan.Expr{
+			plan2.MakePlan2Int64ConstExprWithType(10),
+			makeColExprForTest(2, types.T_int64),
+		}), []bloomFilterPredicate{{col: 2, keys: [][]byte{key(10)}}}},
+		{&plan.Expr{Expr: &plan.Expr_F{F: &plan.Function{
+			Func: &plan.ObjectRef{ObjName: "in"},
+			Args: []*plan.Expr{makeColExprForTest(1, types.T_int64), inList},
+		}}}, []bloomFilterPredicate{{col: 1, keys: [][]byte{key(10), key(20)}}}},
+		{makeFunctionExprForTest("and", []*plan.Expr{
+			makeFunctionExprForTest("=", []*plan.Expr{
+				makeColExprForTest(1, types.T_int64),
+				plan2.MakePlan2Int64ConstExprWithType(10),
+			}),
+			makeFunctionExprForTest("and", []*plan.Expr{
+				makeFunctionExprForTest(">", []*plan.Expr{
+					makeColExprForTest(0, types.T_int64),
+					plan2.MakePlan2Int64ConstExprWithType(1),
+				}),
+				makeFunctionExprForTest("=", []*plan.Expr{
+					makeColExprForTest(2, types.T_int64),
+					plan2.MakePlan2Int64ConstExprWithType(20),
+				}),
+			}),
+		}), []bloomFilterPredicate{{col: 1, keys: [][]byte{key(10)}}, {col: 2, keys: [][]byte{key(20)}}}},
+		{makeFunctionExprForTest("or", []*plan.Expr{
+			makeFunctionExprForTest("=", []*plan.Expr{
+				makeColExprForTest(1, types.T_int64),
+				plan2.MakePlan2Int64ConstExprWithType(10),
+			}),
+			makeFunctionExprForTest("=", []*plan.Expr{
+				makeColExprForTest(1, types.T_int64),
+				plan2.MakePlan2Int64ConstExprWithType(20),
+			}),
+		}), []bloomFilterPredicate{{col: 1, keys: [][]byte{key(10), key(20)}}}},
+		{makeFunctionExprForTest("or", []*plan.Expr{
+			makeFunctionExprForTest("=", []*plan.Expr{
+				makeColExprForTest(1, types.T_int64),
+				plan2.MakePlan2Int64ConstExprWithType(10),
+			}),
+			makeFunctionExprForTest("=", []*plan.Expr{
+				makeColExprForTest(2, types.T_int64),
+				plan2.MakePlan2Int64ConstExprWithType(20),
+			}),
+		}), nil},
+		{makeFunctionExprForTest("=", []*plan.Expr{
+			makeColExprForTest(0, types.T_int64),
+			plan2.MakePlan2Int64ConstExprWithType(10),
+		}), nil},
+		{makeFunctionExprForTest(">", []*plan.Expr{
+			makeColExprForTest(1, types.T_int64),
+			plan2.MakePlan2Int64ConstExprWithType(10),
+		}), nil},
+	}
+	for i, testCase := range testCases {
+		preds := getBloomFilterPredicates(testCase.expr, bfCols, tableDef)
+		require.Equal(t, testCase.preds, preds, "test case %d", i)
+	}
+	require.Nil(t, getBloomFilterPredicates(testCases[0].expr, nil, tableDef))
+}
+
+func TestMayContainBloomFilterKeys(t *testing.T) {
+	data := containers.MockVector2(types.T_int64.ToType(), 1000, 0)
+	defer data.Close()
+	bf, err := index.NewBinaryFuseFilter(data)
+	require.NoError(t, err)
+	filters := index.NewColumnFilters()
+	filters.Add(1, bf, false)
+	key := func(v int64) []byte { return types.EncodeInt64(&v) }
+
+	require.True(t, mayContainBloomFilterKeys(filters, []bloomFilterPredicate{{col: 1, keys: [][]byte{key(10)}}}))
+	require.False(t, mayContainBloomFilterKeys(filters, []bloomFilterPredicate{{col: 1, keys: [][]byte{key(5000)}}}))
+	require.True(t, mayContainBloomFilterKeys(filters, []bloomFilterPredicate{{col: 1, keys: [][]byte{key(5000), key(999)}}}))
+	// the column without filter can not skip the block
+	require.True(t, mayContainBloomFilterKeys(filters, []bloomFilterPredicate{{col: 2, keys: [][]byte{key(5000)}}}))
+	require.False(t, mayContainBloomFilterKeys(filters, []bloomFilterPredicate{
+		{col: 2, keys: [][]byte{key(5000)}},
+		{col: 1, keys: [][]byte{key(5000)}},
+	}))
+	// the filter of the objects written before is only on the primary key
+	require.True(t, mayContainBloomFilterKeys(bf, []bloomFilterPredicate{{col: 1, keys: [][]byte{key(5000)}}}))
+}
+
+func TestGetClusteringDepth(t *testing.T) {
+	tableDef := makeTableDefForTest([]string{"a", "b"})
+	makeMeta := func(minA, maxA, minB, maxB int64) BlockMeta {
+		return BlockMeta{
+			Zonemap: []Zonemap{
+				makeZonemapForTest(types.T_int64, minA, maxA),
+				makeZonemapForTest(types.T_int64, minB, maxB),
+			},
+		}
+	}
+	// a is clustered, every block of b spans the whole range
+	metas := []BlockMeta{
+		makeMeta(0, 9, 0, 99),
+		makeMeta(10, 19, 0, 99),
+		makeMeta(20, 29, 1, 98),
+		makeMeta(25, 39, 0, 99),
+	}
+	depth, err := getClusteringDepth(metas, 0, tableDef)
+	require.NoError(t, err)
+	require.Equal(t, float64(1+1+2+2)/4, depth)
+	depth, err = getClusteringDepth(metas, 1, tableDef)
+	require.NoError(t, err)
+	require.Equal(t, float64(4), depth)
+
+	// the blocks touching at a bound overlap
+	metas = []BlockMeta{makeMeta(0, 10, 0, 0), makeMeta(10, 20, 0, 0), makeMeta(21, 30, 0, 0)}
+	depth, err = getClusteringDepth(metas, 0, tableDef)
+	require.NoError(t, err)
+	require.Equal(t, float64(2+2+1)/3, depth)
+
+	depth, err = getClusteringDepth(nil, 0, tableDef)
+	require.NoError(t, err)
+	require.Equal(t, float64(0), depth)
+}
+
+func TestPruneRangesByZonemap(t *testing.T) {
+	zms := make(map[types.Blockid]Zonemap)
+	ranges := [][]byte{{}}
+	for i, zm := range []Zonemap{
+		makeZonemapForTest(types.T_int64, int64(0), int64(9)),
+		makeZonemapForTest(types.T_int64, int64(10), int64(19)),
+		{}, // all nulls
+	} {
+		var meta BlockMeta
+		meta.Info.BlockID[0] = byte(i)
+		zms[meta.Info.BlockID] = zm
+		ranges = append(ranges, blockInfoMarshal(meta))
+	}
+	load := func(info catalog.BlockInfo) (Zonemap, error) {
+		return zms[info.BlockID], nil
+	}
+	key := types.EncodeInt64(new(int64))
+	pruned, err := pruneRangesByZonemap(ranges, func(zm index.ZM) bool {
+		return zm.ContainsKey(key)
+	}, load)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{ranges[0], ranges[1], ranges[3]}, pruned)
+}
+
+func TestSortRangesByZonemap(t *testing.T) {
+	zms := make(map[types.Blockid]Zonemap)
+	newRange := func(id byte, min, max int64) []byte {
+		var info catalog.BlockInfo
+		info.BlockID[0] = id
+		if min <= max {
+			zm := index.NewZM(types.T_int64)
+			index.UpdateZM(zm, types.EncodeInt64(&min))
+			index.UpdateZM(zm, types.EncodeInt64(&max))
+			var z Zonemap
+			copy(z[:], (*zm)[:])
+			zms[info.BlockID] = z
+		}
+		return append([]byte{}, blockInfoMarshal(BlockMeta{Info: info})...)
+	}
+	load := func(info catalog.BlockInfo) (Zonemap, error) {
+		return zms[info.BlockID], nil
+	}
+
+	ranges := [][]byte{{}, newRange(1, 30, 40), newRange(2, 10, 50), newRange(3, 1, 0), newRange(4, 20, 25)}
+	sorted, mins, err := sortRangesByZonemap(ranges, types.T_int64, load)
+	require.NoError(t, err)
+	// the data in memory and the block without zonemap come first
+	require.Equal(t, [][]byte{ranges[0], ranges[3], ranges[2], ranges[4], ranges[1]}, sorted)
+	require.Nil(t, mins[0])
+	require.Nil(t, mins[1])
+	require.Equal(t, int64(10), types.DecodeInt64(mins[2]))
+	require.Equal(t, int64(20), types.DecodeInt64(mins[3]))
+	require.Equal(t, int64(30), types.DecodeInt64(mins[4]))
+}
@@ -100,3 +100,7 @@ func (e *EntireEngine) GetRelationById(ctx context.Context, op client.TxnOperato
 func (e *EntireEngine) AllocateIDByKey(ctx context.Context, key string) (uint64, error) {
 	return e.Engine.AllocateIDByKey(ctx, key)
 }
+
+func (e *EntireEngine) CatalogVersion() (uint64, timestamp.Timestamp) {
+	return e.Engine.CatalogVersion()
+}
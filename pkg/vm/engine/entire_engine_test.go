@@ -313,6 +313,10 @@ func (e *testEngine) AllocateIDByKey(ctx context.Context, key string) (uint64, e
 	return 0, nil
 }
 
+func (e *testEngine) CatalogVersion() (uint64, timestamp.Timestamp) {
+	return 0, timestamp.Timestamp{}
+}
+
 func newtestOperator() *testOperator {
 	return &testOperator{}
 }
@@ -89,3 +89,7 @@ func (b *BindedEngine) GetRelationById(ctx context.Context, op client.TxnOperato
 func (b *BindedEngine) AllocateIDByKey(ctx context.Context, key string) (uint64, error) {
 	return b.engine.AllocateIDByKey(ctx, key)
 }
+
+func (b *BindedEngine) CatalogVersion() (uint64, timestamp.Timestamp) {
+	return b.engine.CatalogVersion()
+}
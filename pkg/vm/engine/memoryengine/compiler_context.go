@@ -21,6 +21,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
@@ -73,6 +74,14 @@ func (c *CompilerContext) ResolveUdf(name string, ast []*plan.Expr) (string, err
 	return "", nil
 }
 
+func (c *CompilerContext) ResolvePolicy(obj *plan.ObjectRef, tableDef *plan.TableDef, command string) (tree.Expr, error) {
+	return nil, nil
+}
+
+func (c *CompilerContext) ResolveMaterializedViews(obj *plan.ObjectRef, tableDef *plan.TableDef) ([]*plan.MaterializedView, error) {
+	return nil, nil
+}
+
 func (c *CompilerContext) ResolveAccountIds(accountNames []string) ([]uint32, error) {
 	return []uint32{catalog.System_Account}, nil
 }
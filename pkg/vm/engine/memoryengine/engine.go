@@ -199,6 +199,10 @@ func (e *Engine) AllocateIDByKey(ctx context.Context, key string) (uint64, error
 	return uint64(id), err
 }
 
+func (e *Engine) CatalogVersion() (uint64, timestamp.Timestamp) {
+	return 0, timestamp.Timestamp{}
+}
+
 func getDNServices(cluster clusterservice.MOCluster) []metadata.DNService {
 	var values []metadata.DNService
 	cluster.GetDNService(clusterservice.NewSelector(),
@@ -20,7 +20,9 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
@@ -273,6 +275,20 @@ func (t *Table) UpdateConstraint(context.Context, *engine.ConstraintDef) error {
 	return nil
 }
 
+func (t *Table) UpdatePartition(context.Context, *engine.PartitionDef) error {
+	// implement me
+	return nil
+}
+
+func (t *Table) UpdateMergePolicy(context.Context, string) error {
+	// implement me
+	return nil
+}
+
+func (t *Table) Rename(ctx context.Context, _ string) error {
+	return moerr.NewNYI(ctx, "rename table")
+}
+
 func (t *Table) Update(ctx context.Context, data *batch.Batch) error {
 	data.InitZsOne(data.Length())
 	shards, err := t.engine.shardPolicy.Batch(
@@ -376,3 +392,11 @@ func (t *Table) GetTableID(ctx context.Context) uint64 {
 func (t *Table) MaxAndMinValues(ctx context.Context) ([][2]any, []uint8, error) {
 	return nil, nil, nil
 }
+
+func (t *Table) ClusteringDepth(ctx context.Context) (float64, error) {
+	return 0, nil
+}
+
+func (t *Table) MaxCommitTS(ctx context.Context) (types.TS, error) {
+	return types.TS{}, moerr.NewNotSupported(ctx, "max commit ts of the memory engine table")
+}
@@ -34,6 +34,7 @@ type BlockWriter struct {
 	objMetaBuilder *ObjectColumnMetasBuilder
 	isSetPK        bool
 	pk             uint16
+	bfCols         []uint16
 	nameStr        string
 	name           objectio.ObjectName
 }
@@ -68,6 +69,21 @@ func (w *BlockWriter) SetPrimaryKey(idx uint16) {
 	w.pk = idx
 }
 
+// SetBloomFilterColumns sets the columns other than the primary key with a
+// bloom filter built for every block.
+func (w *BlockWriter) SetBloomFilterColumns(idxes ...uint16) {
+	w.bfCols = idxes
+}
+
+func (w *BlockWriter) isBloomFilterColumn(idx uint16) bool {
+	for _, col := range w.bfCols {
+		if col == idx {
+			return true
+		}
+	}
+	return false
+}
+
 func (w *BlockWriter) WriteBatch(batch *batch.Batch) (objectio.BlockObject, error) {
 	block, err := w.writer.Write(batch)
 	if err != nil {
@@ -76,6 +92,10 @@ func (w *BlockWriter) WriteBatch(batch *batch.Batch) (objectio.BlockObject, erro
 	if w.objMetaBuilder == nil {
 		w.objMetaBuilder = NewObjectColumnMetasBuilder(len(batch.Vecs))
 	}
+	var filters *index.ColumnFilters
+	if len(w.bfCols) > 0 {
+		filters = index.NewColumnFilters()
+	}
 	for i, vec := range batch.Vecs {
 		if i == 0 {
 			w.objMetaBuilder.AddRowCnt(vec.Length())
@@ -97,13 +117,18 @@ func (w *BlockWriter) WriteBatch(batch *batch.Batch) (objectio.BlockObject, erro
 		// update object zonemap
 		w.objMetaBuilder.UpdateZm(i, zm)
 
-		if !w.isSetPK || w.pk != uint16(i) {
+		isPK := w.isSetPK && w.pk == uint16(i)
+		if !isPK && !w.isBloomFilterColumn(uint16(i)) {
 			continue
 		}
 		bf, err := index.NewBinaryFuseFilter(columnData)
 		if err != nil {
 			return nil, err
 		}
+		if filters != nil {
+			filters.Add(uint16(i), bf, isPK)
+			continue
+		}
 		buf, err := bf.Marshal()
 		if err != nil {
 			return nil, err
@@ -113,6 +138,15 @@ func (w *BlockWriter) WriteBatch(batch *batch.Batch) (objectio.BlockObject, erro
 			return nil, err
 		}
 	}
+	if filters != nil {
+		buf, err := filters.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		if err = w.writer.WriteColumnsBF(int(block.GetID()), buf); err != nil {
+			return nil, err
+		}
+	}
 	return block, nil
 }
 
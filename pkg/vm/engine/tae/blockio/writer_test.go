@@ -22,6 +22,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/objectio"
 
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
@@ -96,6 +97,60 @@ func TestWriter_WriteBlockAndZoneMap(t *testing.T) {
 	require.False(t, zm.Contains(int32(80000)))
 }
 
+func TestWriter_WriteColumnBloomFilters(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	dir := testutils.InitTestEnv(ModuleName, t)
+	dir = path.Join(dir, "/local")
+	name := objectio.BuildObjectName(objectio.NewSegmentid(), 0)
+	c := fileservice.Config{
+		Name:    defines.LocalFileServiceName,
+		Backend: "DISK",
+		DataDir: dir,
+	}
+	service, err := fileservice.NewFileService(c, nil)
+	require.NoError(t, err)
+	writer, _ := NewBlockWriterNew(service, name)
+	writer.SetPrimaryKey(3)
+	writer.SetBloomFilterColumns(2)
+
+	schema := catalog.MockSchemaAll(13, 2)
+	bats := catalog.MockBatch(schema, 40000*2).Split(2)
+	_, err = writer.WriteBatch(containers.ToCNBatch(bats[0]))
+	require.NoError(t, err)
+	_, err = writer.WriteBatch(containers.ToCNBatch(bats[1]))
+	require.NoError(t, err)
+	blocks, _, err := writer.Sync(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, len(blocks))
+
+	metaloc := EncodeLocation(writer.GetName(), blocks[0].GetExtent(), 40000, blocks[0].GetID())
+	reader, err := NewObjectReader(service, metaloc)
+	require.NoError(t, err)
+	bfs, _, err := reader.LoadAllBF(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, len(bfs))
+
+	// the filter of a block is still the filter of the primary key
+	pk := bats[0].Vecs[3].Get(100).(int64)
+	ok, err := bfs[0].MayContainsKey(types.EncodeInt64(&pk))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	filters := bfs[1].(*index.ColumnFilters)
+	require.Equal(t, []uint16{2, 3}, filters.Columns())
+	require.Nil(t, filters.Column(4))
+	v := int32(40001)
+	ok, err = filters.Column(2).MayContainsKey(types.EncodeInt32(&v))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	keys := containers.MockVector2(types.T_int32.ToType(), 1000, 40000)
+	defer keys.Close()
+	_, positive, err := bfs[0].(*index.ColumnFilters).Column(2).MayContainsAnyKeys(keys)
+	require.NoError(t, err)
+	require.Less(t, positive.GetCardinality(), uint64(100))
+}
+
 func TestMergeDeleteRows(t *testing.T) {
 	require.Equal(t, mergeDeleteRows([]int64{1, 2, 3}, nil), []int64{1, 2, 3})
 	require.Equal(t, mergeDeleteRows(nil, []int64{1, 2, 3}), []int64{1, 2, 3})
@@ -0,0 +1,42 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// GetBloomFilterIdxes returns the indexes of the columns declared with a bloom
+// filter besides the primary key, nil if the table has none.
+func (s *Schema) GetBloomFilterIdxes() []uint16 {
+	if len(s.Constraint) == 0 {
+		return nil
+	}
+	c := new(engine.ConstraintDef)
+	if err := c.UnmarshalBinary(s.Constraint); err != nil {
+		return nil
+	}
+	def := c.GetBloomFilterDef()
+	if def == nil || def.BloomFilter == nil {
+		return nil
+	}
+	var idxes []uint16
+	for _, name := range def.BloomFilter.Cols {
+		if idx := s.GetColIdx(name); idx >= 0 {
+			idxes = append(idxes, uint16(idx))
+		}
+	}
+	return idxes
+}
@@ -0,0 +1,39 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemaGetBloomFilterIdxes(t *testing.T) {
+	schema := MockSchemaAll(15, 2)
+	assert.Nil(t, schema.GetBloomFilterIdxes())
+
+	c := &engine.ConstraintDef{Cts: []engine.Constraint{
+		&engine.BloomFilterDef{BloomFilter: &plan.BloomFilterDef{
+			Cols: []string{schema.ColDefs[5].Name, "missing", schema.ColDefs[3].Name},
+		}},
+	}}
+	var err error
+	schema.Constraint, err = c.MarshalBinary()
+	require.NoError(t, err)
+	assert.Equal(t, []uint16{5, 3}, schema.GetBloomFilterIdxes())
+}
@@ -52,6 +52,7 @@ const (
 	SegmentAttr_Sorted           = "sorted"
 	SnapshotAttr_BlockMaxRow     = "block_max_row"
 	SnapshotAttr_SegmentMaxBlock = "segment_max_block"
+	SnapshotAttr_MergePolicy     = "merge_policy"
 )
 
 type DataFactory interface {
@@ -373,8 +374,10 @@ func (catalog *Catalog) onReplayUpdateTable(cmd *EntryCommand[*TableMVCCNode, *T
 	}
 	tblun := tbl.SearchNode(un)
 	if tblun == nil {
+		oldName := tbl.TableNode.schema.Name
 		tbl.TableNode.schema = un.BaseNode.Schema
 		tbl.Insert(un) //TODO isvalid
+		db.onReplayRenameTable(tbl, oldName, un.BaseNode.Schema)
 	}
 
 }
@@ -402,6 +405,7 @@ func (catalog *Catalog) OnReplayTableBatch(ins, insTxn, insCol, del, delTxn *con
 		schema.AcInfo.TenantID = ins.GetVectorByName(pkgcatalog.SystemRelAttr_AccID).Get(i).(uint32)
 		schema.BlockMaxRows = insTxn.GetVectorByName(SnapshotAttr_BlockMaxRow).Get(i).(uint32)
 		schema.SegmentMaxBlocks = insTxn.GetVectorByName(SnapshotAttr_SegmentMaxBlock).Get(i).(uint16)
+		schema.MergePolicy = string(insTxn.GetVectorByName(SnapshotAttr_MergePolicy).Get(i).([]byte))
 		txnNode := txnbase.ReadTuple(insTxn, i)
 		catalog.onReplayCreateTable(dbid, tid, schema, txnNode, dataFactory)
 	}
@@ -427,7 +431,9 @@ func (catalog *Catalog) onReplayCreateTable(dbid, tid uint64, schema *Schema, tx
 			panic(moerr.NewInternalErrorNoCtx("logic err expect %s, get %s", txnNode.End.ToString(), tblCreatedAt.ToString()))
 		}
 		// alter table
+		oldName := tbl.TableNode.schema.Name
 		tbl.TableNode.schema = schema
+		db.onReplayRenameTable(tbl, oldName, schema)
 		un := &MVCCNode[*TableMVCCNode]{
 			EntryMVCCNode: &EntryMVCCNode{
 				CreatedAt: tblCreatedAt,
@@ -974,7 +980,7 @@ func (catalog *Catalog) txnGetNodeByName(
 	if node == nil {
 		return nil, moerr.NewBadDBNoCtx(name)
 	}
-	return node.TxnGetNodeLocked(txn)
+	return node.TxnGetNodeLocked(txn, "")
 }
 
 func (catalog *Catalog) GetDBEntryByName(
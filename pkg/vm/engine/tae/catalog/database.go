@@ -52,9 +52,10 @@ func (ai *accessInfo) ReadFrom(r io.Reader) (n int64, err error) {
 	return AccessInfoSize, nil
 }
 
-func dbVisibilityFn[T *DBEntry](n *common.GenericDLNode[*DBEntry], txn txnif.TxnReader) (visible, dropped bool) {
+func dbVisibilityFn[T *DBEntry](n *common.GenericDLNode[*DBEntry], txn txnif.TxnReader) (visible, dropped bool, name string) {
 	db := n.GetPayload()
 	visible, dropped = db.GetVisibility(txn)
+	name = db.GetName()
 	return
 }
 
@@ -297,7 +298,7 @@ func (e *DBEntry) txnGetNodeByName(
 	if node == nil {
 		return nil, moerr.GetOkExpectedEOB()
 	}
-	return node.TxnGetNodeLocked(txn)
+	return node.TxnGetNodeLocked(txn, name)
 }
 
 func (e *DBEntry) TxnGetTableEntryByName(name string, txn txnif.AsyncTxn) (entry *TableEntry, err error) {
@@ -409,12 +410,11 @@ func (e *DBEntry) RemoveEntry(table *TableEntry) (err error) {
 	if n, ok := e.entries[table.ID]; !ok {
 		return moerr.GetOkExpectedEOB()
 	} else {
-		nn := e.nameNodes[table.GetFullName()]
-		nn.DeleteNode(table.ID)
-		e.link.Delete(n)
-		if nn.Length() == 0 {
-			delete(e.nameNodes, table.GetFullName())
+		// a renamed table is indexed by all the names it has had
+		for fullName := range e.nameNodes {
+			e.removeNameIndexLocked(fullName, table.ID)
 		}
+		e.link.Delete(n)
 		delete(e.entries, table.ID)
 	}
 	return
@@ -439,32 +439,89 @@ func (e *DBEntry) AddEntryLocked(table *TableEntry, txn txnif.TxnReader, skipDed
 		}
 	}()
 	fullName := table.GetFullName()
-	nn := e.nameNodes[fullName]
+	if !skipDedup {
+		if err = e.checkAddNameConflictLocked(table.GetLastestSchema().Name, table.ID, e.nameNodes[fullName], txn); err != nil {
+			return
+		}
+	}
+	n := e.link.Insert(table)
+	e.entries[table.ID] = n
+	e.addNameIndexLocked(fullName, table.ID)
+	return
+}
+
+// RenameTableInTxn indexes the table by the new name. The old name is kept
+// in the index for the txns reading the old snapshot, unless it is a
+// temporary name given by a previous rename in the same txn.
+func (e *DBEntry) RenameTableInTxn(old, new string, tid uint64, tenantID uint32, txn txnif.TxnReader, temporary bool) error {
+	e.Lock()
+	defer e.Unlock()
+	newFullName := genTblFullName(tenantID, new)
+	if err := e.checkAddNameConflictLocked(new, tid, e.nameNodes[newFullName], txn); err != nil {
+		return err
+	}
+	if temporary {
+		e.removeNameIndexLocked(genTblFullName(tenantID, old), tid)
+	}
+	e.addNameIndexLocked(newFullName, tid)
+	return nil
+}
+
+// checkAddNameConflictLocked checks whether the name can be given to the table.
+// The list of the name also keeps the tables renamed to other names, they are
+// checked against the name they have in the latest version.
+func (e *DBEntry) checkAddNameConflictLocked(name string, tid uint64, nn *nodeList[*TableEntry], txn txnif.TxnReader) (err error) {
 	if nn == nil {
-		n := e.link.Insert(table)
-		e.entries[table.ID] = n
+		return
+	}
+	var records []*TableEntry
+	nn.ForEachNodes(func(node *nameNode[*TableEntry]) bool {
+		if dn := node.GetNode(); node.id != tid && dn != nil {
+			records = append(records, dn.GetPayload())
+		}
+		return true
+	})
+	for _, record := range records {
+		if err = record.PrepareAddName(name, txn); err != nil {
+			return
+		}
+	}
+	return
+}
 
-		nn := newNodeList(e.GetItemNodeByIDLocked,
+func (e *DBEntry) addNameIndexLocked(fullName string, tid uint64) {
+	nn := e.nameNodes[fullName]
+	if nn == nil {
+		nn = newNodeList(e.GetItemNodeByIDLocked,
 			tableVisibilityFn[*TableEntry],
 			&e.nodesMu,
 			fullName)
 		e.nameNodes[fullName] = nn
+	} else if nn.ContainsLocked(tid) {
+		return
+	}
+	nn.CreateNode(tid)
+}
 
-		nn.CreateNode(table.ID)
-	} else {
-		node := nn.GetNode()
-		if !skipDedup {
-			record := node.GetPayload()
-			err = record.PrepareAdd(txn)
-			if err != nil {
-				return
-			}
-		}
-		n := e.link.Insert(table)
-		e.entries[table.ID] = n
-		nn.CreateNode(table.ID)
+func (e *DBEntry) removeNameIndexLocked(fullName string, tid uint64) {
+	nn := e.nameNodes[fullName]
+	if nn == nil {
+		return
 	}
-	return
+	nn.DeleteNode(tid)
+	if nn.Length() == 0 {
+		delete(e.nameNodes, fullName)
+	}
+}
+
+// onReplayRenameTable indexes the replayed table by its new name
+func (e *DBEntry) onReplayRenameTable(table *TableEntry, oldName string, schema *Schema) {
+	if oldName == schema.Name {
+		return
+	}
+	e.Lock()
+	defer e.Unlock()
+	e.addNameIndexLocked(genTblFullName(schema.AcInfo.TenantID, schema.Name), table.ID)
 }
 
 func (e *DBEntry) MakeCommand(id uint32) (txnif.TxnCmd, error) {
@@ -26,13 +26,13 @@ import (
 type nodeList[T any] struct {
 	common.SSLLNode
 	getter       func(uint64) *common.GenericDLNode[T]
-	visibilityFn func(*common.GenericDLNode[T], txnif.TxnReader) (bool, bool)
+	visibilityFn func(*common.GenericDLNode[T], txnif.TxnReader) (bool, bool, string)
 	rwlocker     *sync.RWMutex
 	name         string
 }
 
 func newNodeList[T any](getter func(uint64) *common.GenericDLNode[T],
-	visibilityFn func(*common.GenericDLNode[T], txnif.TxnReader) (bool, bool),
+	visibilityFn func(*common.GenericDLNode[T], txnif.TxnReader) (bool, bool, string),
 	rwlocker *sync.RWMutex,
 	name string) *nodeList[T] {
 	return &nodeList[T]{
@@ -110,6 +110,16 @@ func (n *nodeList[T]) Length() int {
 	return n.LengthLocked()
 }
 
+// ContainsLocked returns true if the id is in the list
+func (n *nodeList[T]) ContainsLocked(id uint64) bool {
+	found := false
+	n.ForEachNodesLocked(func(nn *nameNode[T]) bool {
+		found = nn.id == id
+		return !found
+	})
+	return found
+}
+
 func (n *nodeList[T]) GetNode() *common.GenericDLNode[T] {
 	n.rwlocker.RLock()
 	defer n.rwlocker.RUnlock()
@@ -134,19 +144,26 @@ func (n *nodeList[T]) GetNode() *common.GenericDLNode[T] {
 // 7. Txn3 commit
 // 8. Txn4 can still find "tb1"
 // 9. Txn5 start and cannot find "tb1"
-func (n *nodeList[T]) TxnGetNodeLocked(txn txnif.TxnReader) (
+//
+// A renamed table stays in the list of its old name for the txns reading
+// the old snapshot, so the node is returned only if its visible name is
+// the wanted one. An empty name skips the check.
+func (n *nodeList[T]) TxnGetNodeLocked(txn txnif.TxnReader, name string) (
 	dn *common.GenericDLNode[T], err error) {
 	fn := func(nn *nameNode[T]) bool {
 		dlNode := nn.GetNode()
-		visible, dropped := n.visibilityFn(dlNode, txn)
-		if !visible {
+		if dlNode == nil {
 			return true
 		}
-		if dropped {
-			return false
+		visible, dropped, visibleName := n.visibilityFn(dlNode, txn)
+		if !visible || dropped {
+			return true
+		}
+		if name != "" && visibleName != name {
+			return true
 		}
 		dn = dlNode
-		return true
+		return false
 	}
 	n.ForEachNodes(fn)
 	if dn == nil && err == nil {
@@ -127,6 +127,8 @@ type Schema struct {
 	Createsql        string
 	View             string
 	Constraint       []byte
+	// MergePolicy is the encoded merge properties of the table, see pkgcatalog.MergeProperties
+	MergePolicy string
 
 	SortKey    *SortKey
 	PhyAddrKey *ColDef
@@ -161,6 +163,13 @@ func (s *Schema) ApplyAlterTable(req *apipb.AlterTableReq) {
 		s.Constraint = req.GetUpdateCstr().GetConstraints()
 	case apipb.AlterKind_UpdateComment:
 		s.Comment = req.GetUpdateComment().GetComment()
+	case apipb.AlterKind_UpdatePartition:
+		s.Partition = req.GetUpdatePartition().GetPartition()
+		s.Partitioned = 1
+	case apipb.AlterKind_UpdateMergePolicy:
+		s.MergePolicy = req.GetUpdateMerge().GetMergePolicy()
+	case apipb.AlterKind_RenameTable:
+		s.Name = req.GetRenameTable().GetNewName()
 	default:
 		panic("not support alter type")
 	}
@@ -250,6 +259,10 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 		return
 	}
 	n += sn
+	if s.MergePolicy, sn, err = objectio.ReadString(r); err != nil {
+		return
+	}
+	n += sn
 	colCnt := uint16(0)
 	if sn2, err = r.Read(types.EncodeUint16(&colCnt)); err != nil {
 		return
@@ -360,6 +373,9 @@ func (s *Schema) Marshal() (buf []byte, err error) {
 	if _, err = objectio.WriteBytes(s.Constraint, &w); err != nil {
 		return
 	}
+	if _, err = objectio.WriteString(s.MergePolicy, &w); err != nil {
+		return
+	}
 	length := uint16(len(s.ColDefs))
 	if _, err = w.Write(types.EncodeUint16(&length)); err != nil {
 		return
@@ -34,9 +34,9 @@ import (
 
 type TableDataFactory = func(meta *TableEntry) data.Table
 
-func tableVisibilityFn[T *TableEntry](n *common.GenericDLNode[*TableEntry], txn txnif.TxnReader) (visible, dropped bool) {
+func tableVisibilityFn[T *TableEntry](n *common.GenericDLNode[*TableEntry], txn txnif.TxnReader) (visible, dropped bool, name string) {
 	table := n.GetPayload()
-	visible, dropped = table.GetVisibility(txn)
+	visible, dropped, name = table.GetVisibilityAndName(txn)
 	return
 }
 
@@ -231,6 +231,18 @@ func (entry *TableEntry) GetLastestSchema() *Schema {
 	return entry.schema
 }
 
+// GetLatestCommittedSchema returns the schema of the latest committed mvcc node,
+// which includes the committed alter table changes
+func (entry *TableEntry) GetLatestCommittedSchema() *Schema {
+	entry.RLock()
+	defer entry.RUnlock()
+	node := entry.GetLatestCommittedNode()
+	if node == nil || node.BaseNode.Schema == nil {
+		return entry.schema
+	}
+	return node.BaseNode.Schema
+}
+
 // GetVisibleSchema returns committed schema visible at the given txn
 func (entry *TableEntry) GetVisibleSchema(txn txnif.TxnReader) *Schema {
 	entry.RLock()
@@ -242,6 +254,61 @@ func (entry *TableEntry) GetVisibleSchema(txn txnif.TxnReader) *Schema {
 	return nil
 }
 
+// GetVisibilityAndName returns the visibility of the table at the txn and
+// the name of the table in the visible version
+func (entry *TableEntry) GetVisibilityAndName(txn txnif.TxnReader) (visible, dropped bool, name string) {
+	entry.RLock()
+	defer entry.RUnlock()
+	needWait, txnToWait := entry.NeedWaitCommitting(txn.GetStartTS())
+	if needWait {
+		entry.RUnlock()
+		txnToWait.GetTxnState(true)
+		entry.RLock()
+	}
+	un := entry.GetVisibleNode(txn)
+	if un == nil {
+		return
+	}
+	visible = true
+	if un.IsSameTxn(txn) {
+		dropped = un.HasDropIntent()
+	} else {
+		dropped = un.HasDropCommitted()
+	}
+	name = un.BaseNode.Schema.Name
+	return
+}
+
+// PrepareAddName checks whether the table holds the name in the latest
+// version, in which case the name can not be given to another table.
+func (entry *TableEntry) PrepareAddName(name string, txn txnif.TxnReader) (err error) {
+	entry.RLock()
+	defer entry.RUnlock()
+	if txn != nil {
+		needWait, waitTxn := entry.NeedWaitCommitting(txn.GetStartTS())
+		if needWait {
+			entry.RUnlock()
+			waitTxn.GetTxnState(true)
+			entry.RLock()
+		}
+		if err = entry.CheckConflict(txn); err != nil {
+			return
+		}
+	}
+	un := entry.GetLatestNodeLocked()
+	if un == nil || un.BaseNode.Schema == nil || un.BaseNode.Schema.Name != name {
+		return
+	}
+	if txn == nil || entry.GetTxn() != txn {
+		if !entry.HasDropCommittedLocked() {
+			return moerr.GetOkExpectedDup()
+		}
+	} else if entry.ensureVisibleAndNotDropped(txn) {
+		return moerr.GetOkExpectedDup()
+	}
+	return
+}
+
 func (entry *TableEntry) GetColDefs() []*ColDef {
 	return entry.GetLastestSchema().ColDefs
 }
@@ -0,0 +1,68 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// TTL is the time to live of the rows of a table. A row expires when the value
+// of its TTL column is older than now minus the interval.
+type TTL struct {
+	Def      *ColDef
+	Interval time.Duration
+}
+
+// GetTTL returns the TTL of the table, nil if the table has no TTL.
+func (s *Schema) GetTTL() *TTL {
+	if len(s.Constraint) == 0 {
+		return nil
+	}
+	c := new(engine.ConstraintDef)
+	if err := c.UnmarshalBinary(s.Constraint); err != nil {
+		return nil
+	}
+	def := c.GetTTLDef()
+	if def == nil || def.Ttl == nil || def.Ttl.Interval <= 0 {
+		return nil
+	}
+	idx := s.GetColIdx(def.Ttl.Col)
+	if idx < 0 {
+		return nil
+	}
+	return &TTL{
+		Def:      s.ColDefs[idx],
+		Interval: time.Duration(def.Ttl.Interval) * time.Second,
+	}
+}
+
+// ExpiredAt returns the function checking whether a value of the TTL column
+// is expired at the time now. DATE and DATETIME values are taken as UTC.
+func (ttl *TTL) ExpiredAt(now time.Time) func(v any) bool {
+	cutoff := types.UnixMicroToTimestamp(now.Add(-ttl.Interval).UnixMicro())
+	cutoffDt := cutoff.ToDatetime(time.UTC)
+	switch ttl.Def.Type.Oid {
+	case types.T_timestamp:
+		return func(v any) bool { return v.(types.Timestamp) < cutoff }
+	case types.T_datetime:
+		return func(v any) bool { return v.(types.Datetime) < cutoffDt }
+	case types.T_date:
+		return func(v any) bool { return v.(types.Date).ToDatetime() < cutoffDt }
+	}
+	return func(any) bool { return false }
+}
@@ -0,0 +1,60 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTTLExpiredAt(t *testing.T) {
+	now := time.Date(2023, 5, 10, 12, 30, 0, 0, time.UTC)
+	ttl := &TTL{
+		Def:      &ColDef{Name: "ts", Type: types.T_timestamp.ToType()},
+		Interval: 24 * time.Hour,
+	}
+	expired := ttl.ExpiredAt(now)
+	ts := func(t time.Time) types.Timestamp { return types.UnixMicroToTimestamp(t.UnixMicro()) }
+	assert.True(t, expired(ts(now.Add(-25*time.Hour))))
+	assert.False(t, expired(ts(now.Add(-24*time.Hour))))
+	assert.False(t, expired(ts(now)))
+
+	ttl.Def.Type = types.T_datetime.ToType()
+	expired = ttl.ExpiredAt(now)
+	dt := func(t time.Time) types.Datetime { return ts(t).ToDatetime(time.UTC) }
+	assert.True(t, expired(dt(now.Add(-24*time.Hour-time.Second))))
+	assert.False(t, expired(dt(now.Add(-time.Hour))))
+
+	// the cutoff is 2023-05-09 12:30:00, a date expires if its midnight is before it
+	ttl.Def.Type = types.T_date.ToType()
+	expired = ttl.ExpiredAt(now)
+	assert.True(t, expired(types.DateFromCalendar(2023, 5, 8)))
+	assert.True(t, expired(types.DateFromCalendar(2023, 5, 9)))
+	assert.False(t, expired(types.DateFromCalendar(2023, 5, 10)))
+
+	ttl.Def.Type = types.T_int64.ToType()
+	expired = ttl.ExpiredAt(now)
+	assert.False(t, expired(int64(0)))
+}
+
+func TestSchemaGetTTL(t *testing.T) {
+	schema := MockSchemaAll(15, 2)
+	assert.Nil(t, schema.GetTTL())
+	schema.Constraint = nil
+	assert.Nil(t, schema.GetTTL())
+}
@@ -0,0 +1,50 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"strconv"
+	"strings"
+
+	pkgcatalog "github.com/matrixorigin/matrixone/pkg/catalog"
+)
+
+// GetZOrderIdxes returns the indexes of the columns the table is clustered by
+// in Z-order, nil if it is not. Their order is encoded in the name of the
+// hidden sort key, as the length of each column name on 3 digits followed by
+// the name.
+func (s *Schema) GetZOrderIdxes() []int {
+	if !s.HasSortKey() || s.SortKey.IsPrimary() {
+		return nil
+	}
+	name := s.GetSingleSortKey().Name
+	if !strings.HasPrefix(name, pkgcatalog.PrefixZOrderColName) {
+		return nil
+	}
+	var idxes []int
+	for next := len(pkgcatalog.PrefixZOrderColName); next+3 <= len(name); {
+		l, err := strconv.Atoi(name[next : next+3])
+		if err != nil || next+3+l > len(name) {
+			return nil
+		}
+		idx := s.GetColIdx(name[next+3 : next+3+l])
+		if idx < 0 {
+			return nil
+		}
+		idxes = append(idxes, idx)
+		next += 3 + l
+	}
+	return idxes
+}
@@ -0,0 +1,45 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"testing"
+
+	pkgcatalog "github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemaGetZOrderIdxes(t *testing.T) {
+	schema := MockSchemaAll(3, 2)
+	assert.Nil(t, schema.GetZOrderIdxes())
+
+	schema = NewEmptySchema("t")
+	require.NoError(t, schema.AppendCol("a", types.T_int32.ToType()))
+	require.NoError(t, schema.AppendCol("bb", types.T_int64.ToType()))
+	name := pkgcatalog.PrefixZOrderColName + "002bb" + "001a"
+	require.NoError(t, schema.AppendSortKey(name, types.T_varchar.ToType(), 0, false))
+	require.NoError(t, schema.Finalize(false))
+	assert.Equal(t, []int{1, 0}, schema.GetZOrderIdxes())
+
+	schema = NewEmptySchema("t")
+	require.NoError(t, schema.AppendCol("a", types.T_int32.ToType()))
+	require.NoError(t, schema.AppendCol("b", types.T_int64.ToType()))
+	name = pkgcatalog.PrefixCBColName + "001a" + "001b"
+	require.NoError(t, schema.AppendSortKey(name, types.T_varchar.ToType(), 0, false))
+	require.NoError(t, schema.Finalize(false))
+	assert.Nil(t, schema.GetZOrderIdxes())
+}
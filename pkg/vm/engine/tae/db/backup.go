@@ -0,0 +1,269 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/checkpoint"
+)
+
+// BackupFileServiceName is the name of the file service backed up to or restored from
+const BackupFileServiceName = "BACKUP"
+
+// BackupResult is the summary of a backup or a restore.
+type BackupResult struct {
+	// Metadata is the checkpoint metadata file of the backup
+	Metadata string
+	// End is the timestamp the backup is consistent at
+	End types.TS
+	// Incremental is true if the backup is based on a previous backup in the target
+	Incremental bool
+	// Entries is the count of the checkpoint entries copied
+	Entries int
+	// Files is the count of the files copied
+	Files int
+	// Size is the total size of the files copied
+	Size int64
+}
+
+func (r *BackupResult) String() string {
+	return fmt.Sprintf("end %s, incremental %v, %d checkpoints, %d files, %d bytes, metadata %s",
+		r.End.ToString(), r.Incremental, r.Entries, r.Files, r.Size, r.Metadata)
+}
+
+// Backup takes a consistent physical backup of the DB into the file service dst.
+//
+// It pins the checkpoints to stop GC, forces the incremental checkpoints flushing
+// all the data committed before now, and then copies the latest global checkpoint, the incremental checkpoints after it and
+// all the objects referenced by them into dst. At last, a checkpoint metadata file
+// recording the copied checkpoints is written into dst, which is exactly what the
+// DB replays at startup.
+//
+// The checkpoints and objects existing in dst are skipped, so backing up into the
+// target of a previous backup is incremental, only the new incremental checkpoints
+// and the objects referenced by them are copied. Every backup in the target can
+// still be restored by its own metadata file.
+func (db *DB) Backup(ctx context.Context, dst fileservice.FileService) (result *BackupResult, err error) {
+	unpin := db.BGCheckpointRunner.Pin()
+	defer unpin()
+
+	// all the data committed before now are in the backup
+	t0 := time.Now()
+	if err = db.CheckpointAll(ctx); err != nil {
+		return
+	}
+	entries := db.collectBackupEntries()
+	if len(entries) == 0 {
+		return nil, moerr.NewInternalError(ctx, "no checkpoint to backup")
+	}
+
+	prevs, err := checkpoint.ListMetadataFiles(ctx, dst)
+	if err != nil {
+		return
+	}
+	result = &BackupResult{
+		End:         entries[len(entries)-1].GetEnd(),
+		Incremental: len(prevs) > 0,
+	}
+	if err = copyCheckpoints(ctx, db.Fs.Service, dst, entries, result); err != nil {
+		return
+	}
+	if result.Metadata, err = checkpoint.SaveMetadata(ctx, dst, entries); err != nil {
+		return
+	}
+	logutil.Infof("[Backup] %s, takes %s", result.String(), time.Since(t0))
+	return
+}
+
+// collectBackupEntries returns the latest finished global checkpoint and the
+// finished incremental checkpoints after it, which are needed to replay the DB.
+func (db *DB) collectBackupEntries() []*checkpoint.CheckpointEntry {
+	var global *checkpoint.CheckpointEntry
+	globals := db.BGCheckpointRunner.GetAllGlobalCheckpoints()
+	for i := len(globals) - 1; i >= 0; i-- {
+		if globals[i].IsFinished() {
+			global = globals[i]
+			break
+		}
+	}
+	entries := make([]*checkpoint.CheckpointEntry, 0)
+	if global != nil {
+		entries = append(entries, global)
+	}
+	for _, entry := range db.BGCheckpointRunner.GetAllIncrementalCheckpoints() {
+		if !entry.IsFinished() {
+			break
+		}
+		if global != nil && entry.GetEnd().LessEq(global.GetEnd()) {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// Restore copies the backup ending at ts from the file service src into dst, the
+// latest backup is restored if ts is empty. dst must have no checkpoint, and the
+// DB opened on dst with an empty WAL rebuilds the catalog and the data by replaying
+// the checkpoints of the backup. It must be done offline.
+func Restore(ctx context.Context, src, dst fileservice.FileService, ts types.TS) (result *BackupResult, err error) {
+	files, err := checkpoint.ListMetadataFiles(ctx, src)
+	if err != nil {
+		return
+	}
+	var target *checkpoint.MetadataFile
+	for i := len(files) - 1; i >= 0; i-- {
+		if ts.IsEmpty() || files[i].End.Equal(ts) {
+			target = &files[i]
+			break
+		}
+	}
+	if target == nil {
+		if ts.IsEmpty() {
+			return nil, moerr.NewInternalError(ctx, "no backup found")
+		}
+		return nil, moerr.NewInternalError(ctx, "no backup found at %s", ts.ToString())
+	}
+	if existing, err := checkpoint.ListMetadataFiles(ctx, dst); err != nil {
+		return nil, err
+	} else if len(existing) > 0 {
+		return nil, moerr.NewInternalError(ctx, "restore target has checkpoints already")
+	}
+
+	t0 := time.Now()
+	entries, err := checkpoint.ReadMetadata(ctx, src, target.Name)
+	if err != nil {
+		return
+	}
+	result = &BackupResult{
+		Metadata: target.Name,
+		End:      target.End,
+	}
+	if err = copyCheckpoints(ctx, src, dst, entries, result); err != nil {
+		return
+	}
+	size, err := copyFile(ctx, src, dst, target.Name)
+	if err != nil {
+		return
+	}
+	result.Files++
+	result.Size += size
+	logutil.Infof("[Restore] %s, takes %s", result.String(), time.Since(t0))
+	return
+}
+
+// copyCheckpoints copies the checkpoint entries and the objects referenced by
+// them from src into dst, skipping the ones existing in dst. The object of a
+// checkpoint entry is copied after all the objects referenced by it, so an entry
+// existing in dst means it has been copied completely.
+func copyCheckpoints(
+	ctx context.Context,
+	src, dst fileservice.FileService,
+	entries []*checkpoint.CheckpointEntry,
+	result *BackupResult) error {
+	existing, err := listFiles(ctx, dst)
+	if err != nil {
+		return err
+	}
+	srcFS := objectio.NewObjectFS(src, "")
+	for _, entry := range entries {
+		name := entry.GetLocation().Name().String()
+		if _, ok := existing[name]; ok {
+			continue
+		}
+		data, err := entry.Read(ctx, srcFS)
+		if err != nil {
+			return err
+		}
+		objects := data.GetReferencedObjects()
+		data.Close()
+		for _, object := range append(objects, name) {
+			if _, ok := existing[object]; ok {
+				continue
+			}
+			size, err := copyFile(ctx, src, dst, object)
+			if err != nil {
+				return err
+			}
+			existing[object] = struct{}{}
+			result.Files++
+			result.Size += size
+		}
+		result.Entries++
+	}
+	return nil
+}
+
+func listFiles(ctx context.Context, fs fileservice.FileService) (map[string]struct{}, error) {
+	dirs, err := fs.List(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[string]struct{}, len(dirs))
+	for _, dir := range dirs {
+		if !dir.IsDir {
+			files[dir.Name] = struct{}{}
+		}
+	}
+	return files, nil
+}
+
+type countingReader struct {
+	io.Reader
+	n int64
+}
+
+func (r *countingReader) Read(p []byte) (n int, err error) {
+	n, err = r.Reader.Read(p)
+	r.n += int64(n)
+	return
+}
+
+func copyFile(ctx context.Context, src, dst fileservice.FileService, name string) (int64, error) {
+	var reader io.ReadCloser
+	if err := src.Read(ctx, &fileservice.IOVector{
+		FilePath: name,
+		Entries: []fileservice.IOEntry{{
+			Offset:            0,
+			Size:              -1,
+			ReadCloserForRead: &reader,
+		}},
+		NoCache: true,
+	}); err != nil {
+		return 0, err
+	}
+	defer reader.Close()
+	counter := &countingReader{Reader: reader}
+	if err := dst.Write(ctx, fileservice.IOVector{
+		FilePath: name,
+		Entries: []fileservice.IOEntry{{
+			Offset:         0,
+			Size:           -1,
+			ReaderForWrite: counter,
+		}},
+	}); err != nil {
+		return 0, err
+	}
+	return counter.n, nil
+}
@@ -0,0 +1,158 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupAndRestore(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	testutils.EnsureNoLeak(t)
+	ctx := context.Background()
+	tae := newTestEngine(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(13, 3)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	tae.bindSchema(schema)
+
+	bat := catalog.MockBatch(schema, 40)
+	defer bat.Close()
+	bats := bat.Split(2)
+	tae.createRelAndAppend(bats[0], true)
+
+	backup := objectio.TmpNewFileservice(path.Join(tae.Dir, "backup"))
+	full, err := tae.Backup(ctx, backup)
+	require.NoError(t, err)
+	assert.False(t, full.Incremental)
+	assert.Less(t, 0, full.Entries)
+	assert.Less(t, 0, full.Files)
+
+	// the second backup only copies the new checkpoint and objects
+	txn, rel := tae.getRelation()
+	require.NoError(t, rel.Append(bats[1]))
+	require.NoError(t, txn.Commit())
+	incr, err := tae.Backup(ctx, backup)
+	require.NoError(t, err)
+	assert.True(t, incr.Incremental)
+	assert.Equal(t, 2, incr.Entries)
+	assert.True(t, full.End.Less(incr.End))
+
+	restore := func(name string, ts types.TS, rows int) {
+		dir := path.Join(tae.Dir, name)
+		_, err := Restore(ctx, backup, objectio.TmpNewFileservice(path.Join(dir, "data")), ts)
+		require.NoError(t, err)
+		db, err := Open(dir, nil)
+		require.NoError(t, err)
+		defer db.Close()
+		txn, rel := getRelation(t, 0, db, defaultTestDB, schema.Name)
+		checkAllColRowsByScan(t, rel, rows, true)
+		require.NoError(t, txn.Commit())
+	}
+	restore("restore1", full.End, 20)
+	restore("restore2", types.TS{}, 40)
+
+	// restoring into a target with checkpoints is not allowed
+	_, err = Restore(ctx, backup, tae.Fs.Service, types.TS{})
+	assert.Error(t, err)
+}
+
+func TestPointInTimeRecovery(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	testutils.EnsureNoLeak(t)
+	ctx := context.Background()
+	archive, err := fileservice.NewMemoryFS("archive", fileservice.DisabledCacheConfig, nil)
+	require.NoError(t, err)
+	opts := config.WithLongScanAndCKPOpts(nil)
+	opts.WalArchiveCfg = &options.WalArchiveCfg{Fs: archive}
+	tae := newTestEngine(t, opts)
+	schema := catalog.MockSchemaAll(13, 3)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	tae.bindSchema(schema)
+
+	bat := catalog.MockBatch(schema, 40)
+	defer bat.Close()
+	bats := bat.Split(2)
+	tae.createRelAndAppend(bats[0], true)
+
+	backup := objectio.TmpNewFileservice(path.Join(tae.Dir, "backup"))
+	_, err = tae.Backup(ctx, backup)
+	require.NoError(t, err)
+
+	// the txns after the backup are only in the WAL
+	txn, rel := tae.getRelation()
+	require.NoError(t, rel.Append(bats[1]))
+	require.NoError(t, txn.Commit())
+	appended := txn.GetCommitTS()
+	txn, err = tae.StartTxn(nil)
+	require.NoError(t, err)
+	db, err := txn.GetDatabase(defaultTestDB)
+	require.NoError(t, err)
+	_, err = db.DropRelationByName(schema.Name)
+	require.NoError(t, err)
+	require.NoError(t, txn.Commit())
+	dir := tae.Dir
+	require.NoError(t, tae.Close())
+
+	recoverTo := func(name string, cfg *options.RecoveryCfg) *DB {
+		dir := path.Join(dir, name)
+		fs := objectio.TmpNewFileservice(path.Join(dir, "data"))
+		_, err := Restore(ctx, backup, fs, types.TS{})
+		require.NoError(t, err)
+		cfg.Archive = archive
+		db, err := Open(dir, &options.Options{Fs: fs, RecoveryCfg: cfg})
+		require.NoError(t, err)
+		return db
+	}
+
+	// recovered to the second before the table was dropped
+	db1 := recoverTo("recover1", &options.RecoveryCfg{TargetTS: appended})
+	txn, rel = getRelation(t, 0, db1, defaultTestDB, schema.Name)
+	checkAllColRowsByScan(t, rel, 40, true)
+	require.NoError(t, txn.Commit())
+	// the recovered data survives restarts without the archive
+	require.NoError(t, db1.Close())
+	db1, err = Open(db1.Dir, &options.Options{Fs: db1.Opts.Fs})
+	require.NoError(t, err)
+	txn, rel = getRelation(t, 0, db1, defaultTestDB, schema.Name)
+	checkAllColRowsByScan(t, rel, 40, true)
+	require.NoError(t, txn.Commit())
+	require.NoError(t, db1.Close())
+
+	// all the archived txns are replayed without a target
+	db2 := recoverTo("recover2", &options.RecoveryCfg{})
+	txn, err = db2.StartTxn(nil)
+	require.NoError(t, err)
+	db, err = txn.GetDatabase(defaultTestDB)
+	require.NoError(t, err)
+	_, err = db.GetRelationByName(schema.Name)
+	assert.Error(t, err)
+	require.NoError(t, txn.Commit())
+	require.NoError(t, db2.Close())
+}
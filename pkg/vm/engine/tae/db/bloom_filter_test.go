@@ -0,0 +1,112 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils/config"
+	"github.com/stretchr/testify/require"
+)
+
+// checkBloomFilters checks every value of the column is in the column filter
+// of the block holding it.
+func (e *testEngine) checkBloomFilters(blks []*catalog.BlockEntry, col uint16) {
+	pk := uint16(e.schema.GetSingleSortKeyIdx())
+	txn, err := e.StartTxn(nil)
+	require.NoError(e.t, err)
+	defer func() { require.NoError(e.t, txn.Commit()) }()
+	for _, blk := range blks {
+		loc := blk.GetMetaLoc()
+		reader, err := blockio.NewObjectReader(e.Fs.Service, loc)
+		require.NoError(e.t, err)
+		bfs, _, err := reader.LoadAllBF(context.Background())
+		require.NoError(e.t, err)
+		filters, ok := bfs[loc.ID()].(*index.ColumnFilters)
+		require.True(e.t, ok)
+		require.Equal(e.t, []uint16{pk, col}, filters.Columns())
+
+		view, err := blk.GetBlockData().GetColumnDataById(txn, int(col))
+		require.NoError(e.t, err)
+		vec := view.GetData()
+		for i := 0; i < vec.Length(); i++ {
+			ok, err := filters.Column(col).MayContainsKey(types.EncodeValue(vec.Get(i), vec.GetType().Oid))
+			require.NoError(e.t, err)
+			require.True(e.t, ok)
+		}
+		view.Close()
+	}
+}
+
+func TestBloomFilterColumns(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	testutils.EnsureNoLeak(t)
+	opts := config.WithQuickScanAndCKPOpts(nil)
+	tae := newTestEngine(t, opts)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(15, 2)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	c := new(engine.ConstraintDef)
+	require.NoError(t, c.UnmarshalBinary(schema.Constraint))
+	c.Cts = append(c.Cts, &engine.BloomFilterDef{
+		BloomFilter: &plan.BloomFilterDef{Cols: []string{"mock_3"}},
+	})
+	var err error
+	schema.Constraint, err = c.MarshalBinary()
+	require.NoError(t, err)
+	tae.bindSchema(schema)
+
+	bat := catalog.MockBatch(schema, 30)
+	defer bat.Close()
+	tae.createRelAndAppend(bat, true)
+	tae.compactBlocks(true)
+
+	// the filters are built by flush
+	var blks []*catalog.BlockEntry
+	testutils.WaitExpect(4000, func() bool {
+		var ok bool
+		blks, ok = tae.collectNonAppendableBlocks()
+		return ok
+	})
+	require.Equal(t, 3, len(blks))
+	tae.checkBloomFilters(blks, 3)
+
+	// and by merge
+	table := blks[0].GetSegment().GetTable()
+	require.NoError(t, tae.ForceMergeTable(context.Background(), table.GetDB().ID, table.ID))
+	testutils.WaitExpect(4000, func() bool {
+		for _, blk := range blks {
+			if !blk.HasDropCommitted() {
+				return false
+			}
+		}
+		return true
+	})
+	merged, ok := tae.collectNonAppendableBlocks()
+	require.True(t, ok)
+	require.Equal(t, 3, len(merged))
+	require.NotEqual(t, blks[0].ID, merged[0].ID)
+	tae.checkBloomFilters(merged, 3)
+	tae.checkRowsByScan(30, true)
+}
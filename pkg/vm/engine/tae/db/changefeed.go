@@ -0,0 +1,1050 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/cdc"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
+	"github.com/matrixorigin/matrixone/pkg/pb/logtail"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
+	"go.uber.org/zap"
+)
+
+// A changefeed captures the row changes of some tables, and sends them to a
+// sink in the commit order. The changes of the committed txns are taken from
+// the logtails pushed by the logtail manager, and the changes committed before
+// the changefeed starts, since the cursor or the last checkpoint, are scanned
+// from the blocks of the tables. The checkpoint is the commit timestamp, all
+// the changes committed at or before which have been sent, and is persisted
+// in the file service of DN, so a changefeed resumes from it after DN restarts.
+// The blocks keep the commit timestamps of the rows in memory only, they are
+// not in the objects flushed or the checkpoints. So the changes committed at
+// or before the checkpoint DN replayed from cannot be scanned, neither can the
+// changes of the blocks removed by the GC. The changefeed fails with an error
+// instead of skipping them silently, and must be recreated.
+
+const (
+	ChangefeedDir = "changefeed"
+
+	changefeedBatchSize          = 1000
+	changefeedCheckpointInterval = time.Second
+	changefeedRetryInterval      = 5 * time.Second
+)
+
+// ChangefeedTable is a table captured by a changefeed
+type ChangefeedTable struct {
+	Database   string `json:"database"`
+	Table      string `json:"table"`
+	DatabaseID uint64 `json:"database_id"`
+	TableID    uint64 `json:"table_id"`
+}
+
+func (t ChangefeedTable) String() string {
+	return t.Database + "." + t.Table
+}
+
+// changefeedSpec is persisted in ChangefeedDir/name/checkpoint
+type changefeedSpec struct {
+	Name   string            `json:"name"`
+	Sink   string            `json:"sink"`
+	Tables []ChangefeedTable `json:"tables"`
+	// MView is not nil if the changefeed maintains a materialized view, whose
+	// table is the only one in Tables
+	MView *mviewSpec `json:"mview,omitempty"`
+}
+
+// ChangefeedStatus is the row of a changefeed shown by SHOW CHANGEFEEDS
+type ChangefeedStatus struct {
+	Name       string   `json:"name"`
+	Tables     []string `json:"tables"`
+	Sink       string   `json:"sink"`
+	Checkpoint string   `json:"checkpoint"`
+	Error      string   `json:"error,omitempty"`
+}
+
+type ChangefeedManager struct {
+	db *DB
+	fs fileservice.FileService
+	// replayed is the checkpoint DN replayed from
+	replayed types.TS
+	mu       sync.Mutex
+	feeds    map[string]*changefeed
+}
+
+func newChangefeedManager(db *DB, replayed types.TS) *ChangefeedManager {
+	return &ChangefeedManager{
+		db:       db,
+		fs:       db.Opts.Fs,
+		replayed: replayed,
+		feeds:    make(map[string]*changefeed),
+	}
+}
+
+// open resumes the changefeeds persisted. The changefeeds failed to resume
+// are logged and skipped, so they do not prevent DN from starting.
+func (m *ChangefeedManager) open(ctx context.Context) error {
+	if m.fs == nil {
+		return nil
+	}
+	entries, err := m.fs.List(ctx, ChangefeedDir)
+	if err != nil {
+		if moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+			return nil
+		}
+		return err
+	}
+	for _, entry := range entries {
+		if !entry.IsDir {
+			continue
+		}
+		spec, checkpoint, err := m.loadSpec(ctx, entry.Name)
+		if err == nil {
+			err = m.start(ctx, spec, checkpoint, nil)
+		}
+		if err != nil {
+			logutil.Error("[Changefeed] resume failed",
+				zap.String("name", entry.Name), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+func (m *ChangefeedManager) close() {
+	m.mu.Lock()
+	feeds := m.feeds
+	m.feeds = make(map[string]*changefeed)
+	m.mu.Unlock()
+	for _, feed := range feeds {
+		feed.stop()
+	}
+}
+
+// Create creates a changefeed, and starts sending the changes committed after
+// the cursor.
+func (m *ChangefeedManager) Create(ctx context.Context, req *Changefeed) (err error) {
+	if m.fs == nil {
+		return moerr.NewNotSupported(ctx, "changefeed without file service")
+	}
+	if req.Name == "" || strings.ContainsAny(req.Name, "/\\") || strings.HasPrefix(req.Name, mviewFeedPrefix) {
+		return moerr.NewInvalidInput(ctx, "invalid changefeed name '%s'", req.Name)
+	}
+	if len(req.Tables) == 0 {
+		return moerr.NewInvalidInput(ctx, "no table to capture")
+	}
+	m.mu.Lock()
+	_, exists := m.feeds[req.Name]
+	m.mu.Unlock()
+	if exists {
+		if req.IfNotExists {
+			return nil
+		}
+		return moerr.NewInvalidInput(ctx, "changefeed %s already exists", req.Name)
+	}
+	spec := &changefeedSpec{
+		Name: req.Name,
+		Sink: req.Sink,
+	}
+	if spec.Tables, err = m.resolveTables(req.AccessInfo, req.Tables); err != nil {
+		return
+	}
+	var cursor types.TS
+	if req.Cursor != "" {
+		var ok bool
+		if cursor, ok = parseChangefeedTS(req.Cursor); !ok || cursor.IsEmpty() {
+			return moerr.NewInvalidInput(ctx, "invalid changefeed cursor '%s'", req.Cursor)
+		}
+		if cursor.Greater(m.db.TxnMgr.Now()) {
+			return moerr.NewInvalidInput(ctx, "changefeed cursor %s is in the future", req.Cursor)
+		}
+		if err = m.checkScannable(ctx, cursor.Next()); err != nil {
+			return err
+		}
+	}
+	sink, err := cdc.NewSink(ctx, req.Sink)
+	if err != nil {
+		return
+	}
+	return m.start(ctx, spec, cursor, sink)
+}
+
+// resolveTables resolves the names of the tables in the account
+func (m *ChangefeedManager) resolveTables(info AccessInfo, names []string) (tables []ChangefeedTable, err error) {
+	txn, err := m.db.StartTxn(nil)
+	if err != nil {
+		return
+	}
+	defer txn.Rollback()
+	txn.BindAccessInfo(info.AccountID, info.UserID, info.RoleID)
+	for _, name := range names {
+		dbName, tblName, ok := strings.Cut(name, ".")
+		if !ok {
+			return nil, moerr.NewInvalidInputNoCtx("table name '%s' without database", name)
+		}
+		database, err := txn.GetDatabase(dbName)
+		if err != nil {
+			return nil, err
+		}
+		rel, err := database.GetRelationByName(tblName)
+		if err != nil {
+			return nil, err
+		}
+		tables = append(tables, ChangefeedTable{
+			Database:   dbName,
+			Table:      tblName,
+			DatabaseID: database.GetID(),
+			TableID:    rel.ID(),
+		})
+	}
+	return
+}
+
+// start starts the changefeed from the checkpoint, which is empty for a new
+// changefeed starting from now. sink is nil if the changefeed resumes, and it
+// is created in the background.
+func (m *ChangefeedManager) start(
+	ctx context.Context,
+	spec *changefeedSpec,
+	checkpoint types.TS,
+	sink cdc.Sink) error {
+	feed := newChangefeed(m, spec, sink)
+	// the changes committed after now come from the logtails, the others are
+	// scanned from the blocks. now is the max committed timestamp, so all the
+	// txns committed at or before it have been applied to the blocks.
+	feed.removeListener = m.db.LogtailMgr.AddTxnListener(feed.onTxn)
+	txn, err := m.db.StartTxn(nil)
+	if err != nil {
+		feed.removeListener()
+		return err
+	}
+	now := txn.GetStartTS()
+	_ = txn.Rollback()
+	if checkpoint.IsEmpty() {
+		checkpoint = now
+	}
+	feed.mu.Lock()
+	pending := feed.pending[:0]
+	for _, tail := range feed.pending {
+		if tail.ts.Greater(now) {
+			pending = append(pending, tail)
+		}
+	}
+	feed.pending = pending
+	// the checkpoint of a resumed changefeed may be after the last txn replayed
+	if feed.seen.Less(now) {
+		feed.seen = now
+	}
+	if feed.seen.Less(checkpoint) {
+		feed.seen = checkpoint
+	}
+	feed.checkpoint = checkpoint
+	feed.scanTo = now
+	feed.mu.Unlock()
+
+	m.mu.Lock()
+	if _, ok := m.feeds[spec.Name]; ok {
+		m.mu.Unlock()
+		feed.removeListener()
+		return moerr.NewInvalidInput(ctx, "changefeed %s already exists", spec.Name)
+	}
+	m.feeds[spec.Name] = feed
+	m.mu.Unlock()
+	if err = m.saveCheckpoint(ctx, spec, types.TS{}, checkpoint); err != nil {
+		m.mu.Lock()
+		delete(m.feeds, spec.Name)
+		m.mu.Unlock()
+		feed.removeListener()
+		return err
+	}
+	feed.run()
+	logutil.Info("[Changefeed] start",
+		zap.String("name", spec.Name),
+		zap.String("checkpoint", checkpoint.ToString()),
+		zap.String("now", now.ToString()))
+	return nil
+}
+
+// Drop stops the changefeed and removes its checkpoints
+func (m *ChangefeedManager) Drop(ctx context.Context, req *Changefeed) error {
+	m.mu.Lock()
+	feed, ok := m.feeds[req.Name]
+	delete(m.feeds, req.Name)
+	m.mu.Unlock()
+	if !ok {
+		if req.IfExists {
+			return nil
+		}
+		return moerr.NewInvalidInput(ctx, "changefeed %s does not exist", req.Name)
+	}
+	feed.stop()
+	files, err := m.listCheckpoints(ctx, req.Name)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return nil
+	}
+	return m.fs.Delete(ctx, files...)
+}
+
+// Show returns the status of the changefeeds in the order of the names
+func (m *ChangefeedManager) Show() []ChangefeedStatus {
+	m.mu.Lock()
+	feeds := make([]*changefeed, 0, len(m.feeds))
+	for _, feed := range m.feeds {
+		// the changefeeds of the materialized views are internal
+		if feed.spec.MView == nil {
+			feeds = append(feeds, feed)
+		}
+	}
+	m.mu.Unlock()
+	sort.Slice(feeds, func(i, j int) bool {
+		return feeds[i].spec.Name < feeds[j].spec.Name
+	})
+	status := make([]ChangefeedStatus, 0, len(feeds))
+	for _, feed := range feeds {
+		status = append(status, feed.status())
+	}
+	return status
+}
+
+func (m *ChangefeedManager) listCheckpoints(ctx context.Context, name string) ([]string, error) {
+	dir := path.Join(ChangefeedDir, name)
+	entries, err := m.fs.List(ctx, dir)
+	if err != nil {
+		if moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	files := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir {
+			files = append(files, path.Join(dir, entry.Name))
+		}
+	}
+	return files, nil
+}
+
+// loadSpec loads the spec and the latest checkpoint of the changefeed
+func (m *ChangefeedManager) loadSpec(ctx context.Context, name string) (spec *changefeedSpec, checkpoint types.TS, err error) {
+	files, err := m.listCheckpoints(ctx, name)
+	if err != nil {
+		return
+	}
+	var latest string
+	for _, file := range files {
+		if ts, ok := parseChangefeedTS(path.Base(file)); ok && ts.Greater(checkpoint) {
+			checkpoint, latest = ts, file
+		}
+	}
+	if latest == "" {
+		err = moerr.NewInternalError(ctx, "no checkpoint of changefeed %s", name)
+		return
+	}
+	vec := &fileservice.IOVector{
+		FilePath: latest,
+		Entries:  []fileservice.IOEntry{{Size: -1}},
+	}
+	if err = m.fs.Read(ctx, vec); err != nil {
+		return
+	}
+	spec = new(changefeedSpec)
+	err = json.Unmarshal(vec.Entries[0].Data, spec)
+	return
+}
+
+// parseChangefeedTS parses the timestamp in the form of physical-logical, or
+// the physical time in nanoseconds
+func parseChangefeedTS(s string) (ts types.TS, ok bool) {
+	physical, logical, found := strings.Cut(s, "-")
+	p, err := strconv.ParseInt(physical, 10, 64)
+	if err != nil {
+		return
+	}
+	var l uint64
+	if found {
+		if l, err = strconv.ParseUint(logical, 10, 32); err != nil {
+			return
+		}
+	}
+	return types.BuildTS(p, uint32(l)), true
+}
+
+// saveCheckpoint writes a new checkpoint file and removes the previous one,
+// so there is always a checkpoint file to resume from.
+func (m *ChangefeedManager) saveCheckpoint(
+	ctx context.Context,
+	spec *changefeedSpec,
+	prev, checkpoint types.TS) error {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return err
+	}
+	dir := path.Join(ChangefeedDir, spec.Name)
+	err = m.fs.Write(ctx, fileservice.IOVector{
+		FilePath: path.Join(dir, checkpoint.ToString()),
+		Entries: []fileservice.IOEntry{{
+			Size: int64(len(data)),
+			Data: data,
+		}},
+	})
+	if err != nil && !moerr.IsMoErrCode(err, moerr.ErrFileAlreadyExists) {
+		return err
+	}
+	if prev.IsEmpty() || prev.Equal(checkpoint) {
+		return nil
+	}
+	return m.fs.Delete(ctx, path.Join(dir, prev.ToString()))
+}
+
+// txnTails are the logtails of a txn on the tables of a changefeed
+type txnTails struct {
+	ts    types.TS
+	tails []logtail.TableLogtail
+}
+
+type changefeed struct {
+	mgr    *ChangefeedManager
+	spec   *changefeedSpec
+	tables map[uint64]*ChangefeedTable
+	sink   cdc.Sink
+
+	mu      sync.Mutex
+	pending []txnTails
+	// seen is the commit timestamp of the last txn seen
+	seen types.TS
+	// the changes in (checkpoint, scanTo] are scanned from the blocks
+	scanTo     types.TS
+	checkpoint types.TS
+	lastErr    error
+
+	notify         chan struct{}
+	removeListener func()
+	cancel         context.CancelFunc
+	wg             sync.WaitGroup
+}
+
+func newChangefeed(mgr *ChangefeedManager, spec *changefeedSpec, sink cdc.Sink) *changefeed {
+	feed := &changefeed{
+		mgr:    mgr,
+		spec:   spec,
+		tables: make(map[uint64]*ChangefeedTable, len(spec.Tables)),
+		sink:   sink,
+		notify: make(chan struct{}, 1),
+	}
+	for i := range spec.Tables {
+		feed.tables[spec.Tables[i].TableID] = &spec.Tables[i]
+	}
+	return feed
+}
+
+// onTxn is the logtail listener, which queues the logtails on the tables of
+// the changefeed
+func (feed *changefeed) onTxn(ts types.TS, tails []logtail.TableLogtail) {
+	var captured []logtail.TableLogtail
+	for _, tail := range tails {
+		if _, ok := feed.tables[tail.Table.TbId]; ok {
+			captured = append(captured, tail)
+		}
+	}
+	feed.mu.Lock()
+	if !ts.Greater(feed.seen) {
+		feed.mu.Unlock()
+		return
+	}
+	feed.seen = ts
+	if len(captured) > 0 {
+		feed.pending = append(feed.pending, txnTails{ts: ts, tails: captured})
+	}
+	feed.mu.Unlock()
+	if len(captured) > 0 {
+		select {
+		case feed.notify <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (feed *changefeed) run() {
+	ctx, cancel := context.WithCancel(context.Background())
+	feed.cancel = cancel
+	feed.wg.Add(1)
+	go func() {
+		defer feed.wg.Done()
+		ticker := time.NewTicker(changefeedCheckpointInterval)
+		defer ticker.Stop()
+		for {
+			err := feed.step(ctx)
+			feed.mu.Lock()
+			feed.lastErr = err
+			feed.mu.Unlock()
+			wait := ticker.C
+			if err != nil {
+				logutil.Error("[Changefeed] send failed",
+					zap.String("name", feed.spec.Name), zap.Error(err))
+				wait = time.After(changefeedRetryInterval)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-feed.notify:
+			case <-wait:
+			}
+		}
+	}()
+}
+
+func (feed *changefeed) stop() {
+	feed.removeListener()
+	if feed.cancel != nil {
+		feed.cancel()
+		feed.wg.Wait()
+	}
+	if feed.sink != nil {
+		feed.sink.Close()
+	}
+}
+
+// step sends the changes not sent yet, and advances the checkpoint
+func (feed *changefeed) step(ctx context.Context) (err error) {
+	if feed.sink == nil {
+		if feed.sink, err = feed.newSink(ctx); err != nil {
+			return
+		}
+	}
+	feed.mu.Lock()
+	checkpoint, scanTo := feed.checkpoint, feed.scanTo
+	feed.mu.Unlock()
+	if checkpoint.Less(scanTo) {
+		changes, err := feed.scan(ctx, checkpoint.Next(), scanTo)
+		if err != nil {
+			return err
+		}
+		if err = feed.send(ctx, changes); err != nil {
+			return err
+		}
+		if err = feed.advance(ctx, checkpoint, scanTo); err != nil {
+			return err
+		}
+		checkpoint = scanTo
+	}
+
+	feed.mu.Lock()
+	pending, seen := feed.pending, feed.seen
+	feed.pending = nil
+	feed.mu.Unlock()
+	var changes []*cdc.Change
+	for _, txn := range pending {
+		var txnChanges []*cdc.Change
+		if txnChanges, err = feed.convertTxn(txn); err != nil {
+			break
+		}
+		changes = append(changes, txnChanges...)
+	}
+	if err == nil {
+		err = feed.send(ctx, changes)
+	}
+	if err != nil {
+		// retries later from the first txn not sent
+		feed.mu.Lock()
+		feed.pending = append(pending, feed.pending...)
+		feed.mu.Unlock()
+		return
+	}
+	if checkpoint.Less(seen) {
+		err = feed.advance(ctx, checkpoint, seen)
+	}
+	return
+}
+
+func (feed *changefeed) newSink(ctx context.Context) (cdc.Sink, error) {
+	if feed.spec.MView != nil {
+		view, err := newMView(feed.mgr.db, feed.spec)
+		if err != nil {
+			return nil, err
+		}
+		return &mviewSink{view: view}, nil
+	}
+	return cdc.NewSink(ctx, feed.spec.Sink)
+}
+
+// send sends the changes in batches, the changes of a txn are in one batch
+func (feed *changefeed) send(ctx context.Context, changes []*cdc.Change) error {
+	for len(changes) > 0 {
+		n := len(changes)
+		if n > changefeedBatchSize {
+			n = changefeedBatchSize
+			for n < len(changes) && changes[n].TS.Equal(changes[n-1].TS) {
+				n++
+			}
+		}
+		if err := feed.sink.Send(ctx, changes[:n]); err != nil {
+			return err
+		}
+		changes = changes[n:]
+	}
+	return nil
+}
+
+func (feed *changefeed) advance(ctx context.Context, prev, checkpoint types.TS) error {
+	if err := feed.mgr.saveCheckpoint(ctx, feed.spec, prev, checkpoint); err != nil {
+		return err
+	}
+	feed.mu.Lock()
+	feed.checkpoint = checkpoint
+	feed.mu.Unlock()
+	return nil
+}
+
+func (feed *changefeed) status() ChangefeedStatus {
+	status := ChangefeedStatus{
+		Name:   feed.spec.Name,
+		Tables: make([]string, 0, len(feed.spec.Tables)),
+		Sink:   feed.spec.Sink,
+	}
+	if u, err := url.Parse(feed.spec.Sink); err == nil {
+		status.Sink = u.Redacted()
+	}
+	for _, table := range feed.spec.Tables {
+		status.Tables = append(status.Tables, table.String())
+	}
+	feed.mu.Lock()
+	defer feed.mu.Unlock()
+	status.Checkpoint = feed.checkpoint.ToString()
+	if feed.lastErr != nil {
+		status.Error = feed.lastErr.Error()
+	}
+	return status
+}
+
+// convertTxn converts the logtails of a txn to the changes. The inserts and
+// the deletes of a table are in different logtails.
+func (feed *changefeed) convertTxn(txn txnTails) (changes []*cdc.Change, err error) {
+	var (
+		tables           []uint64
+		deletes, inserts = make(map[uint64][]rowOp), make(map[uint64][]rowOp)
+	)
+	for _, tail := range txn.tails {
+		id := tail.Table.TbId
+		if _, ok := deletes[id]; !ok {
+			tables = append(tables, id)
+			deletes[id] = nil
+		}
+		meta := fmt.Sprintf("_%d_meta", id)
+		for i := range tail.Commands {
+			entry := &tail.Commands[i]
+			if entry.TableName == meta {
+				continue
+			}
+			bat, err := protoBatchToDNBatch(entry.Bat)
+			if err != nil {
+				return nil, err
+			}
+			if entry.EntryType == api.Entry_Delete {
+				deletes[id] = appendRowOps(deletes[id], bat, true)
+			} else {
+				inserts[id] = appendRowOps(inserts[id], bat, false)
+			}
+		}
+	}
+	for _, id := range tables {
+		capture, err := newTableCapture(feed.mgr.db, feed.tables[id])
+		if err != nil {
+			return nil, err
+		}
+		tableChanges, err := capture.convert(append(deletes[id], inserts[id]...))
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, tableChanges...)
+	}
+	return
+}
+
+func protoBatchToDNBatch(bat *api.Batch) (*containers.Batch, error) {
+	moBat, err := batch.ProtoBatchToBatch(bat)
+	if err != nil {
+		return nil, err
+	}
+	return containers.ToDNBatch(moBat), nil
+}
+
+// scan scans the changes committed in [start, end] from the blocks of the
+// tables, in the commit order
+func (feed *changefeed) scan(ctx context.Context, start, end types.TS) (changes []*cdc.Change, err error) {
+	if err = feed.mgr.checkScannable(ctx, start); err != nil {
+		return
+	}
+	for i := range feed.spec.Tables {
+		table := &feed.spec.Tables[i]
+		capture, err := newTableCapture(feed.mgr.db, table)
+		if err != nil {
+			return nil, err
+		}
+		var ops []rowOp
+		segIt := capture.entry.MakeSegmentIt(false)
+		for ; segIt.Valid(); segIt.Next() {
+			blkIt := segIt.Get().GetPayload().MakeBlockIt(false)
+			for ; blkIt.Valid(); blkIt.Next() {
+				blkData := blkIt.Get().GetPayload().GetBlockData()
+				inserts, err := blkData.CollectAppendInRange(start, end, false)
+				if err != nil {
+					return nil, err
+				}
+				deletes, err := blkData.CollectDeleteInRange(start, end, false)
+				if err != nil {
+					return nil, err
+				}
+				ops = appendRowOps(ops, deletes, true)
+				ops = appendRowOps(ops, inserts, false)
+			}
+		}
+		sort.SliceStable(ops, func(i, j int) bool {
+			if !ops[i].ts.Equal(ops[j].ts) {
+				return ops[i].ts.Less(ops[j].ts)
+			}
+			return ops[i].delete && !ops[j].delete
+		})
+		tableChanges, err := capture.convert(ops)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, tableChanges...)
+	}
+	if len(feed.spec.Tables) > 1 {
+		sort.SliceStable(changes, func(i, j int) bool {
+			return changes[i].TS.Less(changes[j].TS)
+		})
+	}
+	// the blocks may be removed by the GC during the scan
+	err = feed.mgr.checkScannable(ctx, start)
+	return
+}
+
+// checkScannable returns an error if the changes committed since start cannot
+// be scanned from the blocks. They are not in memory if they are committed at
+// or before the checkpoint DN replayed from, or the blocks may have been removed
+// if they are dropped before the end of the last checkpoint the GC consumed.
+func (m *ChangefeedManager) checkScannable(ctx context.Context, start types.TS) error {
+	if !start.Greater(m.replayed) {
+		return moerr.NewInternalError(ctx,
+			"the changes since %s are checkpointed at %s, the changefeed must be recreated with a later cursor",
+			start.ToString(), m.replayed.ToString())
+	}
+	if m.db.DiskCleaner == nil {
+		return nil
+	}
+	consumed := m.db.DiskCleaner.GetMaxConsumed()
+	if consumed == nil || start.Greater(consumed.GetEnd()) {
+		return nil
+	}
+	return moerr.NewInternalError(ctx,
+		"the changes since %s have been garbage collected at %s, the changefeed must be recreated with a later cursor",
+		start.ToString(), consumed.GetEnd().ToString())
+}
+
+// rowOp is a row inserted or deleted
+type rowOp struct {
+	ts     types.TS
+	delete bool
+	bat    *containers.Batch
+	row    int
+}
+
+func appendRowOps(ops []rowOp, bat *containers.Batch, delete bool) []rowOp {
+	if bat == nil || bat.Length() == 0 {
+		return ops
+	}
+	commitTS := bat.GetVectorByName(catalog.AttrCommitTs)
+	for i := 0; i < bat.Length(); i++ {
+		ops = append(ops, rowOp{
+			ts:     commitTS.Get(i).(types.TS),
+			delete: delete,
+			bat:    bat,
+			row:    i,
+		})
+	}
+	return ops
+}
+
+// tableCapture converts the row operations of a table to the changes
+type tableCapture struct {
+	table  *ChangefeedTable
+	entry  *catalog.TableEntry
+	schema *catalog.Schema
+	// the visible columns, and the columns of the primary key
+	cols, pk []*catalog.ColDef
+	// the positions of cols in bat
+	bat      *containers.Batch
+	batIdxes []int
+}
+
+func newTableCapture(db *DB, table *ChangefeedTable) (*tableCapture, error) {
+	database, err := db.Catalog.GetDatabaseByID(table.DatabaseID)
+	if err != nil {
+		return nil, err
+	}
+	entry, err := database.GetTableEntryByID(table.TableID)
+	if err != nil {
+		return nil, err
+	}
+	capture := &tableCapture{
+		table:  table,
+		entry:  entry,
+		schema: entry.GetLastestSchema(),
+	}
+	for _, def := range capture.schema.ColDefs {
+		if !def.IsPhyAddr() && !def.IsHidden() {
+			capture.cols = append(capture.cols, def)
+		}
+	}
+	for _, name := range primaryKeyNames(capture.schema) {
+		if idx, ok := capture.schema.NameIndex[name]; ok {
+			capture.pk = append(capture.pk, capture.schema.ColDefs[idx])
+		}
+	}
+	return capture, nil
+}
+
+// primaryKeyNames returns the names of the columns of the primary key
+func primaryKeyNames(schema *catalog.Schema) []string {
+	if len(schema.Constraint) > 0 {
+		c := new(engine.ConstraintDef)
+		if err := c.UnmarshalBinary(schema.Constraint); err == nil {
+			for _, ct := range c.Cts {
+				if pk, ok := ct.(*engine.PrimaryKeyDef); ok && pk.Pkey != nil {
+					return pk.Pkey.Names
+				}
+			}
+		}
+	}
+	if schema.HasPK() && !schema.GetSingleSortKey().IsHidden() {
+		return []string{schema.GetSingleSortKey().Name}
+	}
+	return nil
+}
+
+// convert converts the row operations sorted by the commit timestamp. In a
+// txn, a row deleted and inserted with the same primary key is an update.
+func (c *tableCapture) convert(ops []rowOp) (changes []*cdc.Change, err error) {
+	views := make(map[types.Blockid]*model.BlockView)
+	defer func() {
+		for _, view := range views {
+			view.Close()
+		}
+	}()
+	for start := 0; start < len(ops); {
+		end := start + 1
+		for end < len(ops) && ops[end].ts.Equal(ops[start].ts) {
+			end++
+		}
+		var (
+			txnChanges []*cdc.Change
+			deleted    = make(map[string]int)
+		)
+		for _, op := range ops[start:end] {
+			change := &cdc.Change{
+				Database: c.table.Database,
+				Table:    c.table.Table,
+				TS:       op.ts,
+			}
+			if op.delete {
+				change.Op = cdc.OpDelete
+				if change.Before, err = c.beforeImage(views, op); err != nil {
+					return
+				}
+				change.PK = c.primaryKey(change.Before)
+			} else {
+				change.Op = cdc.OpInsert
+				change.After = c.image(op.bat, op.row)
+				change.PK = c.primaryKey(change.After)
+				if change.PK != nil {
+					key, _ := json.Marshal(change.PK)
+					if i, ok := deleted[string(key)]; ok {
+						delete(deleted, string(key))
+						txnChanges[i].Op = cdc.OpUpdate
+						txnChanges[i].After = change.After
+						continue
+					}
+				}
+			}
+			txnChanges = append(txnChanges, change)
+			if op.delete && change.PK != nil {
+				key, _ := json.Marshal(change.PK)
+				deleted[string(key)] = len(txnChanges) - 1
+			}
+		}
+		changes = append(changes, txnChanges...)
+		start = end
+	}
+	return
+}
+
+// image returns the row of the batch, whose columns are named after the
+// schema
+func (c *tableCapture) image(bat *containers.Batch, row int) cdc.Row {
+	if bat != c.bat {
+		c.bat = bat
+		c.batIdxes = c.batIdxes[:0]
+		for _, def := range c.cols {
+			idx := -1
+			for i, attr := range bat.Attrs {
+				if attr == def.Name {
+					idx = i
+					break
+				}
+			}
+			c.batIdxes = append(c.batIdxes, idx)
+		}
+	}
+	image := make(cdc.Row, 0, len(c.cols))
+	for i, def := range c.cols {
+		var value any
+		if idx := c.batIdxes[i]; idx >= 0 {
+			vec := bat.Vecs[idx]
+			if !vec.IsNull(row) {
+				value = cdc.FormatValue(*vec.GetType(), vec.Get(row))
+			}
+		}
+		image = append(image, cdc.Column{Name: def.Name, Value: value})
+	}
+	return image
+}
+
+// scanVisible calls fn with the rows of the table visible at ts
+func (c *tableCapture) scanVisible(ts types.TS, fn func(cdc.Row) error) error {
+	txn := txnbase.MockTxnReaderWithStartTS(ts)
+	idxes := make([]int, 0, len(c.cols))
+	for _, def := range c.cols {
+		idxes = append(idxes, def.Idx)
+	}
+	segIt := c.entry.MakeSegmentIt(false)
+	for ; segIt.Valid(); segIt.Next() {
+		seg := segIt.Get().GetPayload()
+		if visible, dropped := seg.GetVisibility(txn); !visible || dropped {
+			continue
+		}
+		blkIt := seg.MakeBlockIt(false)
+		for ; blkIt.Valid(); blkIt.Next() {
+			blk := blkIt.Get().GetPayload()
+			if visible, dropped := blk.GetVisibility(txn); !visible || dropped {
+				continue
+			}
+			view, err := blk.GetBlockData().GetColumnDataByIds(txn, idxes)
+			if err != nil {
+				return err
+			}
+			err = c.scanView(view, fn)
+			view.Close()
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (c *tableCapture) scanView(view *model.BlockView, fn func(cdc.Row) error) error {
+	if len(c.cols) == 0 {
+		return nil
+	}
+	rows := view.GetColumnData(c.cols[0].Idx).Length()
+	for row := 0; row < rows; row++ {
+		if view.DeleteMask != nil && view.DeleteMask.ContainsInt(row) {
+			continue
+		}
+		image := make(cdc.Row, 0, len(c.cols))
+		for _, def := range c.cols {
+			var value any
+			vec := view.GetColumnData(def.Idx)
+			if !vec.IsNull(row) {
+				value = cdc.FormatValue(*vec.GetType(), vec.Get(row))
+			}
+			image = append(image, cdc.Column{Name: def.Name, Value: value})
+		}
+		if err := fn(image); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// beforeImage reads the row deleted from its block just before the deletion
+func (c *tableCapture) beforeImage(views map[types.Blockid]*model.BlockView, op rowOp) (cdc.Row, error) {
+	rowID := op.bat.GetVectorByName(catalog.AttrRowID).Get(op.row).(types.Rowid)
+	segID, blkID, offset := model.DecodePhyAddrKey(rowID)
+	view, ok := views[blkID]
+	if !ok {
+		seg, err := c.entry.GetSegmentByID(segID)
+		if err != nil {
+			return nil, err
+		}
+		blk, err := seg.GetBlockEntryByID(blkID)
+		if err != nil {
+			return nil, err
+		}
+		idxes := make([]int, 0, len(c.cols))
+		for _, def := range c.cols {
+			idxes = append(idxes, def.Idx)
+		}
+		txn := txnbase.MockTxnReaderWithStartTS(op.ts.Prev())
+		if view, err = blk.GetBlockData().GetColumnDataByIds(txn, idxes); err != nil {
+			return nil, err
+		}
+		views[blkID] = view
+	}
+	image := make(cdc.Row, 0, len(c.cols))
+	for _, def := range c.cols {
+		var value any
+		vec := view.GetColumnData(def.Idx)
+		if int(offset) < vec.Length() && !vec.IsNull(int(offset)) {
+			value = cdc.FormatValue(*vec.GetType(), vec.Get(int(offset)))
+		}
+		image = append(image, cdc.Column{Name: def.Name, Value: value})
+	}
+	return image, nil
+}
+
+func (c *tableCapture) primaryKey(image cdc.Row) cdc.Row {
+	if len(c.pk) == 0 {
+		return nil
+	}
+	pk := make(cdc.Row, 0, len(c.pk))
+	for _, def := range c.pk {
+		value, _ := image.Get(def.Name)
+		pk = append(pk, cdc.Column{Name: def.Name, Value: value})
+	}
+	return pk
+}
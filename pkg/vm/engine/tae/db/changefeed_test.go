@@ -0,0 +1,220 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/cdc"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testChange struct {
+	Table  string         `json:"table"`
+	TS     string         `json:"ts"`
+	Op     cdc.Op         `json:"op"`
+	PK     map[string]any `json:"pk"`
+	Before map[string]any `json:"before"`
+	After  map[string]any `json:"after"`
+}
+
+func readChanges(t *testing.T, file string) (changes []testChange) {
+	data, err := os.ReadFile(file)
+	if os.IsNotExist(err) {
+		return
+	}
+	require.NoError(t, err)
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		if line == "" {
+			continue
+		}
+		var c testChange
+		require.NoError(t, json.Unmarshal([]byte(line), &c))
+		changes = append(changes, c)
+	}
+	return
+}
+
+func waitChanges(t *testing.T, file string, n int) []testChange {
+	testutils.WaitExpect(5000, func() bool {
+		return len(readChanges(t, file)) >= n
+	})
+	changes := readChanges(t, file)
+	require.Equal(t, n, len(changes))
+	return changes
+}
+
+func TestChangefeed(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	testutils.EnsureNoLeak(t)
+	ctx := context.Background()
+	tae := newTestEngine(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(3, 2)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	tae.bindSchema(schema)
+	bat := catalog.MockBatch(schema, 20)
+	defer bat.Close()
+	bats := bat.Split(2)
+
+	_, rel := tae.createRelAndAppend(bats[0], true)
+	start := rel.GetMeta().(*catalog.TableEntry).GetCreatedAt()
+	table := defaultTestDB + "." + schema.Name
+	pkName := schema.GetSingleSortKey().Name
+	dir := t.TempDir()
+	fromNow := filepath.Join(dir, "now.json")
+	fromCursor := filepath.Join(dir, "cursor.json")
+
+	require.NoError(t, tae.Changefeeds.Create(ctx, &Changefeed{
+		Op:     ChangefeedCreate,
+		Name:   "now",
+		Tables: []string{table},
+		Sink:   "file://" + fromNow,
+	}))
+	// the changefeed with a cursor scans the rows appended before it starts
+	require.NoError(t, tae.Changefeeds.Create(ctx, &Changefeed{
+		Op:     ChangefeedCreate,
+		Name:   "cursor",
+		Tables: []string{table},
+		Sink:   "file://" + fromCursor,
+		Cursor: start.Prev().ToString(),
+	}))
+	assert.Error(t, tae.Changefeeds.Create(ctx, &Changefeed{Name: "now", Tables: []string{table}, Sink: "file://" + fromNow}))
+	assert.NoError(t, tae.Changefeeds.Create(ctx, &Changefeed{Name: "now", Tables: []string{table}, Sink: "file://" + fromNow, IfNotExists: true}))
+	assert.Error(t, tae.Changefeeds.Create(ctx, &Changefeed{Name: "bad", Tables: []string{table}, Sink: "hdfs://x"}))
+	assert.Error(t, tae.Changefeeds.Create(ctx, &Changefeed{Name: "bad", Tables: []string{"db.missing"}, Sink: "file://" + fromNow}))
+
+	changes := waitChanges(t, fromCursor, 10)
+	for _, c := range changes {
+		assert.Equal(t, cdc.OpInsert, c.Op)
+		assert.Equal(t, schema.Name, c.Table)
+		assert.Equal(t, c.After[pkName], c.PK[pkName])
+	}
+
+	pk0 := getSingleSortKeyValue(bats[0], schema, 0)
+	pk1 := getSingleSortKeyValue(bats[0], schema, 1)
+	txn, rel := tae.getRelation()
+	require.NoError(t, rel.DeleteByFilter(handle.NewEQFilter(pk0)))
+	require.NoError(t, txn.Commit())
+	txn, rel = tae.getRelation()
+	require.NoError(t, rel.UpdateByFilter(handle.NewEQFilter(pk1), 0, int8(100), false))
+	require.NoError(t, txn.Commit())
+
+	changes = waitChanges(t, fromNow, 2)
+	assert.Equal(t, cdc.OpDelete, changes[0].Op)
+	assert.Nil(t, changes[0].After)
+	assert.Equal(t, float64(pk0.(int32)), changes[0].Before[pkName])
+	assert.Equal(t, float64(pk0.(int32)), changes[0].PK[pkName])
+	assert.Equal(t, cdc.OpUpdate, changes[1].Op)
+	assert.Equal(t, float64(pk1.(int32)), changes[1].PK[pkName])
+	assert.NotEqual(t, float64(100), changes[1].Before[schema.ColDefs[0].Name])
+	assert.Equal(t, float64(100), changes[1].After[schema.ColDefs[0].Name])
+	assert.Equal(t, changes[1].Before[pkName], changes[1].After[pkName])
+	waitChanges(t, fromCursor, 12)
+
+	status := tae.Changefeeds.Show()
+	require.Equal(t, 2, len(status))
+	assert.Equal(t, "cursor", status[0].Name)
+	assert.Equal(t, []string{table}, status[0].Tables)
+	assert.Empty(t, status[0].Error)
+
+	// resumes from the checkpoints after restart
+	testutils.WaitExpect(5000, func() bool {
+		return tae.Changefeeds.Show()[1].Checkpoint >= changes[1].TS
+	})
+	tae.restart()
+	require.Equal(t, 2, len(tae.Changefeeds.Show()))
+	txn, rel = tae.getRelation()
+	require.NoError(t, rel.Append(bats[1]))
+	require.NoError(t, txn.Commit())
+	changes = waitChanges(t, fromNow, 12)
+	for _, c := range changes[2:] {
+		assert.Equal(t, cdc.OpInsert, c.Op)
+	}
+
+	require.NoError(t, tae.Changefeeds.Drop(ctx, &Changefeed{Name: "now"}))
+	assert.Error(t, tae.Changefeeds.Drop(ctx, &Changefeed{Name: "now"}))
+	assert.NoError(t, tae.Changefeeds.Drop(ctx, &Changefeed{Name: "now", IfExists: true}))
+	tae.restart()
+	status = tae.Changefeeds.Show()
+	require.Equal(t, 1, len(status))
+	assert.Equal(t, "cursor", status[0].Name)
+}
+
+func TestParseChangefeedTS(t *testing.T) {
+	ts, ok := parseChangefeedTS("100-2")
+	assert.True(t, ok)
+	assert.Equal(t, types.BuildTS(100, 2), ts)
+	ts, ok = parseChangefeedTS("100")
+	assert.True(t, ok)
+	assert.Equal(t, types.BuildTS(100, 0), ts)
+	_, ok = parseChangefeedTS("abc")
+	assert.False(t, ok)
+	_, ok = parseChangefeedTS("1-x")
+	assert.False(t, ok)
+}
+
+func TestChangefeedCheckpointed(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	testutils.EnsureNoLeak(t)
+	ctx := context.Background()
+	tae := newTestEngine(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(3, 2)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	tae.bindSchema(schema)
+	bat := catalog.MockBatch(schema, 10)
+	defer bat.Close()
+
+	_, rel := tae.createRelAndAppend(bat, true)
+	start := rel.GetMeta().(*catalog.TableEntry).GetCreatedAt()
+	table := defaultTestDB + "." + schema.Name
+	dir := t.TempDir()
+	lagging := filepath.Join(dir, "lagging.json")
+	require.NoError(t, tae.Changefeeds.Create(ctx, &Changefeed{
+		Op:     ChangefeedCreate,
+		Name:   "lagging",
+		Tables: []string{table},
+		Sink:   "file://" + lagging,
+		Cursor: start.Prev().ToString(),
+	}))
+	waitChanges(t, lagging, 10)
+	require.NoError(t, tae.Changefeeds.Drop(ctx, &Changefeed{Name: "lagging"}))
+
+	// the commit timestamps of the rows are not kept after the checkpoint is
+	// replayed, so the changes before it cannot be scanned
+	tae.compactBlocks(false)
+	require.NoError(t, tae.incrementalCheckpoint(tae.TxnMgr.StatMaxCommitTS(), false, true, true))
+	tae.restart()
+	assert.Error(t, tae.Changefeeds.Create(ctx, &Changefeed{
+		Op:     ChangefeedCreate,
+		Name:   "lagging",
+		Tables: []string{table},
+		Sink:   "file://" + lagging,
+		Cursor: start.Prev().ToString(),
+	}))
+}
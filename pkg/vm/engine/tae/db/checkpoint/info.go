@@ -16,6 +16,7 @@ package checkpoint
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -157,6 +158,18 @@ func (r *runner) GCByTS(ctx context.Context, ts types.TS) error {
 	return nil
 }
 
+func (r *runner) Pin() (unpin func()) {
+	r.pinned.Add(1)
+	var once sync.Once
+	return func() {
+		once.Do(func() { r.pinned.Add(-1) })
+	}
+}
+
+func (r *runner) IsPinned() bool {
+	return r.pinned.Load() > 0
+}
+
 func (r *runner) getGCTS() types.TS {
 	prev := r.gcTS.Load()
 	if prev == nil {
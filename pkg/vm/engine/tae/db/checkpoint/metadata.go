@@ -0,0 +1,138 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checkpoint
+
+import (
+	"context"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+)
+
+// MetadataFile is a checkpoint metadata file under CheckpointDir, which records
+// all the checkpoint entries ending no later than its end.
+type MetadataFile struct {
+	Name  string
+	Start types.TS
+	End   types.TS
+}
+
+// ListMetadataFiles lists the checkpoint metadata files of the file service,
+// sorted by the end timestamp.
+func ListMetadataFiles(ctx context.Context, fs fileservice.FileService) ([]MetadataFile, error) {
+	dirs, err := fs.List(ctx, CheckpointDir)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]MetadataFile, 0, len(dirs))
+	for _, dir := range dirs {
+		if dir.IsDir {
+			continue
+		}
+		start, end := blockio.DecodeCheckpointMetadataFileName(dir.Name)
+		files = append(files, MetadataFile{
+			Name:  CheckpointDir + dir.Name,
+			Start: start,
+			End:   end,
+		})
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].End.Less(files[j].End)
+	})
+	return files, nil
+}
+
+// ReadMetadata reads the finished checkpoint entries recorded in the metadata file.
+func ReadMetadata(ctx context.Context, fs fileservice.FileService, name string) ([]*CheckpointEntry, error) {
+	reader, err := blockio.NewFileReader(fs, name)
+	if err != nil {
+		return nil, err
+	}
+	bats, err := reader.LoadAllColumns(ctx, nil, common.DefaultAllocator)
+	if err != nil {
+		return nil, err
+	}
+	if len(bats) == 0 || len(bats[0].Vecs) != len(CheckpointSchemaAttr) {
+		return nil, moerr.NewInternalError(ctx, "invalid checkpoint metadata file %s", name)
+	}
+	bat := containers.NewBatch()
+	defer bat.Close()
+	colTypes := CheckpointSchema.Types()
+	for i, colName := range CheckpointSchema.Attrs() {
+		var vec containers.Vector
+		if bats[0].Vecs[i].Length() == 0 {
+			vec = containers.MakeVector(colTypes[i])
+		} else {
+			vec = containers.ToDNVector(bats[0].Vecs[i])
+		}
+		bat.AddVector(colName, vec)
+	}
+	entries := make([]*CheckpointEntry, 0, bat.Length())
+	for i := 0; i < bat.Length(); i++ {
+		typ := ET_Global
+		if bat.GetVectorByName(CheckpointAttr_EntryType).Get(i).(bool) {
+			typ = ET_Incremental
+		}
+		metaloc := bat.GetVectorByName(CheckpointAttr_MetaLocation).Get(i).([]byte)
+		entries = append(entries, &CheckpointEntry{
+			start:     bat.GetVectorByName(CheckpointAttr_StartTS).Get(i).(types.TS),
+			end:       bat.GetVectorByName(CheckpointAttr_EndTS).Get(i).(types.TS),
+			location:  objectio.Location(append([]byte(nil), metaloc...)),
+			state:     ST_Finished,
+			entryType: typ,
+		})
+	}
+	return entries, nil
+}
+
+// SaveMetadata writes a metadata file recording the checkpoint entries into the
+// file service, named by the start and end of the last entry like the runner does.
+// It does nothing if the file exists already.
+func SaveMetadata(ctx context.Context, fs fileservice.FileService, entries []*CheckpointEntry) (name string, err error) {
+	if len(entries) == 0 {
+		return "", moerr.NewInternalError(ctx, "no checkpoint entry to save")
+	}
+	last := entries[len(entries)-1]
+	name = blockio.EncodeCheckpointMetadataFileName(CheckpointDir, PrefixMetadata, last.start, last.end)
+	if _, err = fs.StatFile(ctx, name); err == nil {
+		return
+	} else if !moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+		return
+	}
+
+	bat := makeRespBatchFromSchema(CheckpointSchema)
+	defer bat.Close()
+	for _, entry := range entries {
+		bat.GetVectorByName(CheckpointAttr_StartTS).Append(entry.start, false)
+		bat.GetVectorByName(CheckpointAttr_EndTS).Append(entry.end, false)
+		bat.GetVectorByName(CheckpointAttr_MetaLocation).Append([]byte(entry.GetLocation()), false)
+		bat.GetVectorByName(CheckpointAttr_EntryType).Append(entry.IsIncremental(), false)
+	}
+	writer, err := objectio.NewObjectWriterSpecial(objectio.WriterCheckpoint, name, fs)
+	if err != nil {
+		return
+	}
+	if _, err = writer.Write(containers.ToCNBatch(bat)); err != nil {
+		return
+	}
+	_, err = writer.WriteEnd(ctx)
+	return
+}
@@ -197,6 +197,8 @@ type runner struct {
 	}
 
 	gcTS atomic.Value
+	// count of the pins, no checkpoint entry is GCed if pinned
+	pinned atomic.Int32
 
 	// checkpoint policy
 	incrementalPolicy *timeBasedPolicy
@@ -300,6 +302,9 @@ func (r *runner) onGCCheckpointEntries(items ...any) {
 }
 
 func (r *runner) getTSTOGC() (ts types.TS, needGC bool) {
+	if r.IsPinned() {
+		return
+	}
 	ts = r.getGCTS()
 	if ts.IsEmpty() {
 		return
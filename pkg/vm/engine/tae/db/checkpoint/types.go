@@ -49,6 +49,11 @@ type Runner interface {
 	FlushTable(ctx context.Context, dbID, tableID uint64, ts types.TS) error
 	GCByTS(ctx context.Context, ts types.TS) error
 
+	// Pin keeps the checkpoint entries and the objects referenced by them from
+	// being GCed until the returned function is called, such as in a backup
+	Pin() (unpin func())
+	IsPinned() bool
+
 	// for test, delete in next phase
 	DebugUpdateOptions(opts ...Option)
 }
@@ -67,8 +67,10 @@ type DB struct {
 
 	BGScanner          wb.IHeartbeater
 	BGCheckpointRunner checkpoint.Runner
+	MergeHandle        *MergeTaskBuilder
 
 	DiskCleaner *gc2.DiskCleaner
+	Changefeeds *ChangefeedManager
 	Pipeline    *blockio.IoPipeline
 
 	Fs *objectio.ObjectFS
@@ -87,6 +89,23 @@ func (db *DB) FlushTable(
 	return
 }
 
+// ForceMergeTable merges the blocks of the table in the next scan round,
+// regardless of the merge policy of the table.
+func (db *DB) ForceMergeTable(
+	ctx context.Context,
+	dbId, tableId uint64) (err error) {
+	dbEntry, err := db.Catalog.GetDatabaseByID(dbId)
+	if err != nil {
+		return
+	}
+	if _, err = dbEntry.GetTableEntryByID(tableId); err != nil {
+		return
+	}
+	db.MergeHandle.ForceMerge(tableId)
+	logutil.Infof("[Force Merge] table %d-%d", dbId, tableId)
+	return
+}
+
 func (db *DB) ForceCheckpoint(
 	ctx context.Context,
 	ts types.TS,
@@ -110,6 +129,19 @@ func (db *DB) ForceCheckpoint(
 	return err
 }
 
+// CheckpointAll forces the checkpoints referencing all the data committed before
+// now. The blocks flushed by a checkpoint are committed after it, so the checkpoint
+// forced at now references none of the objects flushed, another checkpoint is
+// forced to record them.
+func (db *DB) CheckpointAll(ctx context.Context) (err error) {
+	now := types.BuildTS(time.Now().UTC().UnixNano(), 0)
+	if err = db.ForceCheckpoint(ctx, now, 0); err != nil {
+		return
+	}
+	end := types.BuildTS(time.Now().UTC().UnixNano(), 0)
+	return db.ForceCheckpoint(ctx, end, 0)
+}
+
 func (db *DB) StartTxn(info []byte) (txnif.AsyncTxn, error) {
 	return db.TxnMgr.StartTxn(info)
 }
@@ -158,6 +190,7 @@ func (db *DB) Close() error {
 		panic(err)
 	}
 	db.Closed.Store(ErrClosed)
+	db.Changefeeds.close()
 	db.GCManager.Stop()
 	db.BGScanner.Stop()
 	db.BGCheckpointRunner.Stop()
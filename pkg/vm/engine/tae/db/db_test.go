@@ -5132,6 +5132,140 @@ func TestAlterTableBasic(t *testing.T) {
 	require.Equal(t, api.Entry_Delete, resp.Commands[1].EntryType)
 }
 
+func TestAlterTableRename(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	opts := config.WithLongScanAndCKPOpts(nil)
+	tae := newTestEngine(t, opts)
+	defer tae.Close()
+
+	schema1 := catalog.MockSchemaAll(2, -1)
+	schema1.Name = "t1"
+	schema2 := catalog.MockSchemaAll(2, -1)
+	schema2.Name = "t2"
+
+	txn, _ := tae.StartTxn(nil)
+	db, _ := txn.CreateDatabase("db", "", "")
+	rel1, _ := db.CreateRelation(schema1)
+	rel2, _ := db.CreateRelation(schema2)
+	id1, id2 := rel1.ID(), rel2.ID()
+	require.NoError(t, txn.Commit())
+
+	// the snapshot before the swap
+	oldTxn, _ := tae.StartTxn(nil)
+
+	// swap the names of the two tables like exchanging a partition
+	txn, _ = tae.StartTxn(nil)
+	db, _ = txn.GetDatabase("db")
+	tbl1, _ := db.GetRelationByName("t1")
+	tbl2, _ := db.GetRelationByName("t2")
+	require.NoError(t, tbl1.AlterTable(context.Background(), api.NewRenameTableReq(0, 0, "t1", "tmp")))
+	err := tbl2.AlterTable(context.Background(), api.NewRenameTableReq(0, 0, "t2", "tmp"))
+	require.True(t, moerr.IsMoErrCode(err, moerr.OkExpectedDup))
+	require.NoError(t, tbl2.AlterTable(context.Background(), api.NewRenameTableReq(0, 0, "t2", "t1")))
+	require.NoError(t, tbl1.AlterTable(context.Background(), api.NewRenameTableReq(0, 0, "tmp", "t2")))
+	rel, err := db.GetRelationByName("t1")
+	require.NoError(t, err)
+	require.Equal(t, id2, rel.ID())
+	_, err = db.GetRelationByName("tmp")
+	require.Error(t, err)
+	require.NoError(t, txn.Commit())
+
+	checkNames := func() {
+		txn, _ := tae.StartTxn(nil)
+		db, _ := txn.GetDatabase("db")
+		rel, err := db.GetRelationByName("t1")
+		require.NoError(t, err)
+		require.Equal(t, id2, rel.ID())
+		rel, err = db.GetRelationByName("t2")
+		require.NoError(t, err)
+		require.Equal(t, id1, rel.ID())
+		_, err = db.GetRelationByName("tmp")
+		require.Error(t, err)
+		_, err = db.CreateRelation(schema1)
+		require.True(t, moerr.IsMoErrCode(err, moerr.OkExpectedDup))
+		require.NoError(t, txn.Rollback())
+	}
+	checkNames()
+
+	db, _ = oldTxn.GetDatabase("db")
+	rel, err = db.GetRelationByName("t1")
+	require.NoError(t, err)
+	require.Equal(t, id1, rel.ID())
+	rel, err = db.GetRelationByName("t2")
+	require.NoError(t, err)
+	require.Equal(t, id2, rel.ID())
+	require.NoError(t, oldTxn.Commit())
+
+	tae.restart()
+	checkNames()
+}
+
+func TestAlterTableRenameReplay(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	opts := config.WithLongScanAndCKPOpts(nil)
+	tae := newTestEngine(t, opts)
+	defer tae.Close()
+
+	schema1 := catalog.MockSchemaAll(2, -1)
+	schema1.Name = "t1"
+	schema2 := catalog.MockSchemaAll(2, -1)
+	schema2.Name = "t2"
+
+	txn, _ := tae.StartTxn(nil)
+	db, _ := txn.CreateDatabase("db", "", "")
+	rel1, _ := db.CreateRelation(schema1)
+	rel2, _ := db.CreateRelation(schema2)
+	id1, id2 := rel1.ID(), rel2.ID()
+	require.NoError(t, txn.Commit())
+
+	swap := func() {
+		txn, _ := tae.StartTxn(nil)
+		db, _ := txn.GetDatabase("db")
+		tbl1, err := db.GetRelationByName("t1")
+		require.NoError(t, err)
+		tbl2, err := db.GetRelationByName("t2")
+		require.NoError(t, err)
+		require.NoError(t, tbl1.AlterTable(context.Background(), api.NewRenameTableReq(0, 0, "t1", "tmp")))
+		require.NoError(t, tbl2.AlterTable(context.Background(), api.NewRenameTableReq(0, 0, "t2", "t1")))
+		require.NoError(t, tbl1.AlterTable(context.Background(), api.NewRenameTableReq(0, 0, "tmp", "t2")))
+		require.NoError(t, txn.Commit())
+	}
+	checkNames := func(idOfT1, idOfT2 uint64) {
+		txn, _ := tae.StartTxn(nil)
+		db, _ := txn.GetDatabase("db")
+		rel, err := db.GetRelationByName("t1")
+		require.NoError(t, err)
+		require.Equal(t, idOfT1, rel.ID())
+		rel, err = db.GetRelationByName("t2")
+		require.NoError(t, err)
+		require.Equal(t, idOfT2, rel.ID())
+		_, err = db.GetRelationByName("tmp")
+		require.Error(t, err)
+		require.NoError(t, txn.Rollback())
+	}
+
+	// the swap is replayed from the checkpoint
+	swap()
+	txn, _ = tae.StartTxn(nil)
+	require.NoError(t, tae.incrementalCheckpoint(txn.GetStartTS(), true, true, true))
+	require.NoError(t, txn.Commit())
+	tae.restart()
+	checkNames(id2, id1)
+
+	// the swap back is replayed from the wal after the checkpoint
+	swap()
+	tae.restart()
+	checkNames(id1, id2)
+
+	// both swaps are replayed from the global checkpoint
+	txn, _ = tae.StartTxn(nil)
+	require.NoError(t, tae.incrementalCheckpoint(txn.GetStartTS(), true, true, true))
+	require.NoError(t, tae.globalCheckpoint(txn.GetStartTS(), 0, true))
+	require.NoError(t, txn.Commit())
+	tae.restart()
+	checkNames(id1, id2)
+}
+
 func TestGlobalCheckpoint1(t *testing.T) {
 	defer testutils.AfterTest(t)()
 	testutils.EnsureNoLeak(t)
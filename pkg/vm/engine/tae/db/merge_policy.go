@@ -0,0 +1,325 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	pkgcatalog "github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
+)
+
+const constMergeTimeWindow = time.Hour
+
+// MergePolicy decides which non-appendable blocks of a table are merged together.
+// In every scan round, MergeTaskBuilder feeds the mergeable blocks of a table to
+// its policy and schedules a merge task for the revised blocks.
+type MergePolicy interface {
+	// OnBlock collects a mergeable block, the lock of the entry is not held.
+	OnBlock(entry *catalog.BlockEntry, rows int)
+	// Revise returns the blocks to be merged in this round, nil means no merge.
+	// If force is true, the policy skips waiting for more blocks or a quiet table.
+	Revise(force bool) []*catalog.BlockEntry
+	// ResetForTable clears the collected blocks before a new round of the table.
+	ResetForTable(entry *catalog.TableEntry)
+	String() string
+}
+
+// NewMergePolicy creates the merge policy of a table by its encoded merge properties.
+// The size-tiered policy is used if no policy is specified.
+func NewMergePolicy(mergePolicy string) (MergePolicy, error) {
+	props, err := pkgcatalog.DecodeMergeProperties(mergePolicy)
+	if err != nil {
+		return nil, err
+	}
+	switch props.Policy {
+	case pkgcatalog.MergePolicyTimeWindow:
+		return newTimeWindowPolicy(props), nil
+	default:
+		return newSizeTieredPolicy(props), nil
+	}
+}
+
+type stat struct {
+	ttl          time.Time
+	lastTotalRow int
+}
+
+func (st *stat) String() string {
+	return fmt.Sprintf("row%d[%s]", st.lastTotalRow, st.ttl)
+}
+
+// sizeTieredPolicy merges the smallest blocks of a table first. It considers
+// update rate and time to decide to merge or not:
+// merge immediately if it has enough rows, skip if:
+// 1. has only a few rows or blocks
+// 2. is actively updating, which means total rows changes obviously compared with last time
+// in other cases, wait some time to merge
+type sizeTieredPolicy struct {
+	maxRows   int
+	minBlks   int
+	wait      time.Duration
+	totalRows int
+	blocks    *mergedBlkBuilder
+	stat      *stat
+}
+
+func newSizeTieredPolicy(props *pkgcatalog.MergeProperties) *sizeTieredPolicy {
+	p := &sizeTieredPolicy{
+		maxRows: constMergeRightNow,
+		minBlks: constMergeMinBlks,
+		wait:    constMergeWaitDuration,
+		blocks: &mergedBlkBuilder{
+			blocks: make(itemSet, 0, constHeapCapacity),
+			cap:    constHeapCapacity,
+		},
+	}
+	if props.MaxRows > 0 {
+		p.maxRows = props.MaxRows
+	}
+	if props.MinBlocks > 0 {
+		p.minBlks = props.MinBlocks
+	}
+	if props.Wait > 0 {
+		p.wait = props.Wait
+	}
+	return p
+}
+
+func (p *sizeTieredPolicy) OnBlock(entry *catalog.BlockEntry, rows int) {
+	p.totalRows += rows
+	p.blocks.push(&mItem{row: rows, entry: entry})
+}
+
+func (p *sizeTieredPolicy) Revise(force bool) []*catalog.BlockEntry {
+	blks := p.blocks.finish()
+	if force {
+		p.stat = nil
+		if len(blks) < 2 {
+			return nil
+		}
+		return blks
+	}
+	if p.totalRows > p.maxRows {
+		logutil.Infof("Mergeblocks merge right now: %d rows %d blks", p.totalRows, len(blks))
+		p.stat = nil
+		return blks
+	}
+	if len(blks) < p.minBlks || p.totalRows < constMergeMinRows {
+		return nil
+	}
+
+	if p.stat == nil {
+		p.stat = &stat{
+			ttl:          p.ttl(p.totalRows),
+			lastTotalRow: p.totalRows,
+		}
+		return nil
+	} else if d := p.totalRows - p.stat.lastTotalRow; d > 5 || d < -5 {
+		// a lot of things happened in the past scan interval...
+		p.stat.ttl = p.ttl(p.totalRows)
+		p.stat.lastTotalRow = p.totalRows
+		logutil.Infof("Mergeblocks delta %d, resched to %v", d, p.stat.ttl)
+		return nil
+	} else if p.stat.ttl.Before(time.Now()) {
+		// this table is quiet finally
+		return blks
+	}
+	return nil
+}
+
+func (p *sizeTieredPolicy) ttl(totalRow int) time.Time {
+	return time.Now().Add(time.Duration(
+		(float32(p.wait) / float32(p.maxRows)) *
+			(float32(p.maxRows) - float32(totalRow))))
+}
+
+func (p *sizeTieredPolicy) ResetForTable(*catalog.TableEntry) {
+	p.totalRows = 0
+	p.blocks.reset()
+}
+
+func (p *sizeTieredPolicy) String() string {
+	if p.stat == nil {
+		return pkgcatalog.MergePolicySizeTiered
+	}
+	return fmt.Sprintf("%s%s", pkgcatalog.MergePolicySizeTiered, p.stat.String())
+}
+
+// timeWindowPolicy groups blocks into windows by the time of their data and
+// merges the blocks of one window together, so the data of different time is
+// never mixed. It suits append-only tables, such as logs. The time of a block is
+// the min value of the time column in its zonemap, the time column is the
+// merge_time_column property, or else the TTL column or the sort key if it is
+// a DATE, DATETIME or TIMESTAMP column. The create time of the block is used if
+// the table has no time column. Only the windows which have been closed are
+// merged, unless forced. The merged blocks are not merged again, so they stay
+// in their windows.
+type timeWindowPolicy struct {
+	window     time.Duration
+	minBlks    int
+	maxRows    int
+	timeColumn string
+	// the index of the time column of the current table, -1 if no time column
+	timeIdx int
+	windows map[int64][]*mItem
+}
+
+func newTimeWindowPolicy(props *pkgcatalog.MergeProperties) *timeWindowPolicy {
+	p := &timeWindowPolicy{
+		window:     constMergeTimeWindow,
+		minBlks:    constMergeMinBlks,
+		maxRows:    constMergeRightNow,
+		timeColumn: props.TimeColumn,
+		timeIdx:    -1,
+		windows:    make(map[int64][]*mItem),
+	}
+	if props.Window > 0 {
+		p.window = props.Window
+	}
+	if props.MinBlocks > 0 {
+		p.minBlks = props.MinBlocks
+	}
+	if props.MaxRows > 0 {
+		p.maxRows = props.MaxRows
+	}
+	return p
+}
+
+// windowOf returns the window of the unix microseconds
+func (p *timeWindowPolicy) windowOf(t int64) int64 {
+	w := p.window.Microseconds()
+	if w <= 0 {
+		w = 1
+	}
+	if t < 0 {
+		return (t+1)/w - 1
+	}
+	return t / w
+}
+
+func (p *timeWindowPolicy) OnBlock(entry *catalog.BlockEntry, rows int) {
+	t := entry.GetCreatedAt().Physical() / int64(time.Microsecond)
+	if p.timeIdx >= 0 {
+		zm, err := entry.GetBlockData().GetColumnZoneMap(p.timeIdx)
+		if err != nil {
+			logutil.Warnf("Mergeblocks load zonemap of %s: %v", entry.ID.String(), err)
+			return
+		}
+		var ok bool
+		if t, ok = zoneMapTime(zm); !ok {
+			// the block is not grouped into a wrong window
+			return
+		}
+	}
+	w := p.windowOf(t)
+	p.windows[w] = append(p.windows[w], &mItem{row: rows, entry: entry})
+}
+
+func (p *timeWindowPolicy) Revise(force bool) []*catalog.BlockEntry {
+	current := p.windowOf(time.Now().UnixMicro())
+	windows := make([]int64, 0, len(p.windows))
+	for w := range p.windows {
+		windows = append(windows, w)
+	}
+	sort.Slice(windows, func(i, j int) bool { return windows[i] < windows[j] })
+
+	// merge the oldest window first
+	for _, w := range windows {
+		items := p.windows[w]
+		if len(items) < 2 {
+			continue
+		}
+		if !force && (w >= current || len(items) < p.minBlks) {
+			continue
+		}
+		// merge the smallest blocks of the window up to max rows
+		sort.Slice(items, func(i, j int) bool { return items[i].row < items[j].row })
+		blks := make([]*catalog.BlockEntry, 0, len(items))
+		rows := 0
+		for _, item := range items {
+			if len(blks) >= constHeapCapacity || (len(blks) >= 2 && rows+item.row > p.maxRows) {
+				break
+			}
+			rows += item.row
+			blks = append(blks, item.entry)
+		}
+		return blks
+	}
+	return nil
+}
+
+func (p *timeWindowPolicy) ResetForTable(entry *catalog.TableEntry) {
+	for w := range p.windows {
+		delete(p.windows, w)
+	}
+	p.timeIdx = -1
+	if entry != nil {
+		p.timeIdx = getTimeColumnIdx(entry.GetLatestCommittedSchema(), p.timeColumn)
+	}
+}
+
+// getTimeColumnIdx returns the index of the time column of the table, -1 if none
+func getTimeColumnIdx(schema *catalog.Schema, timeColumn string) int {
+	isTime := func(def *catalog.ColDef) bool {
+		switch def.Type.Oid {
+		case types.T_date, types.T_datetime, types.T_timestamp:
+			return true
+		}
+		return false
+	}
+	if timeColumn != "" {
+		for _, def := range schema.ColDefs {
+			if strings.EqualFold(def.Name, timeColumn) && isTime(def) {
+				return def.Idx
+			}
+		}
+		return -1
+	}
+	if ttl := schema.GetTTL(); ttl != nil && isTime(ttl.Def) {
+		return ttl.Def.Idx
+	}
+	if schema.HasSortKey() && schema.SortKey.Size() == 1 && isTime(schema.GetSingleSortKey()) {
+		return schema.GetSingleSortKeyIdx()
+	}
+	return -1
+}
+
+// zoneMapTime returns the min value of a time column in the zonemap as unix
+// microseconds, DATE and DATETIME values are taken as UTC.
+func zoneMapTime(zm *index.ZM) (int64, bool) {
+	if zm == nil || !zm.IsInited() {
+		return 0, false
+	}
+	switch v := zm.GetMin().(type) {
+	case types.Timestamp:
+		return v.ToDatetime(time.UTC).ConvertToGoTime(time.UTC).UnixMicro(), true
+	case types.Datetime:
+		return v.ConvertToGoTime(time.UTC).UnixMicro(), true
+	case types.Date:
+		return v.ToDatetime().ConvertToGoTime(time.UTC).UnixMicro(), true
+	}
+	return 0, false
+}
+
+func (p *timeWindowPolicy) String() string {
+	return fmt.Sprintf("%s[%s]", pkgcatalog.MergePolicyTimeWindow, p.window)
+}
@@ -0,0 +1,251 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mockBlockAt(id int64, t time.Time) *catalog.BlockEntry {
+	return catalog.NewStandaloneBlock(nil, types.BuildTestBlockid(id, id), types.BuildTS(t.UnixNano(), 0))
+}
+
+func TestSizeTieredPolicy(t *testing.T) {
+	policy, err := NewMergePolicy("merge_min_blocks=2;merge_max_rows=10000")
+	require.NoError(t, err)
+	_, ok := policy.(*sizeTieredPolicy)
+	require.True(t, ok)
+
+	now := time.Now()
+	// too few rows, wait for more
+	policy.ResetForTable(nil)
+	policy.OnBlock(mockBlockAt(1, now), 100)
+	policy.OnBlock(mockBlockAt(2, now), 100)
+	require.Nil(t, policy.Revise(false))
+	// forced
+	require.Len(t, policy.Revise(true), 2)
+
+	// enough rows, merge right now
+	policy.ResetForTable(nil)
+	policy.OnBlock(mockBlockAt(1, now), 6000)
+	policy.OnBlock(mockBlockAt(2, now), 6000)
+	require.Len(t, policy.Revise(false), 2)
+
+	// a single block is never merged
+	policy.ResetForTable(nil)
+	policy.OnBlock(mockBlockAt(1, now), 100)
+	require.Nil(t, policy.Revise(true))
+
+	_, err = NewMergePolicy("merge_policy=leveled")
+	require.Error(t, err)
+}
+
+func TestTimeWindowPolicy(t *testing.T) {
+	policy, err := NewMergePolicy("merge_policy=time_window;merge_window=1h;merge_min_blocks=2")
+	require.NoError(t, err)
+	_, ok := policy.(*timeWindowPolicy)
+	require.True(t, ok)
+
+	now := time.Now()
+	closed := now.Add(-2 * time.Hour)
+	older := now.Add(-5 * time.Hour)
+
+	// only the current window has blocks
+	policy.ResetForTable(nil)
+	policy.OnBlock(mockBlockAt(1, now), 100)
+	policy.OnBlock(mockBlockAt(2, now), 100)
+	require.Nil(t, policy.Revise(false))
+	require.Len(t, policy.Revise(true), 2)
+
+	// blocks of different windows are never merged together
+	policy.ResetForTable(nil)
+	b1, b2 := mockBlockAt(1, closed), mockBlockAt(2, closed)
+	policy.OnBlock(b1, 100)
+	policy.OnBlock(mockBlockAt(3, older), 100)
+	policy.OnBlock(b2, 200)
+	policy.OnBlock(mockBlockAt(4, now), 100)
+	policy.OnBlock(mockBlockAt(5, now), 100)
+	blks := policy.Revise(false)
+	require.Equal(t, []*catalog.BlockEntry{b1, b2}, blks)
+
+	// the oldest window first
+	policy.ResetForTable(nil)
+	o1, o2 := mockBlockAt(6, older), mockBlockAt(7, older)
+	policy.OnBlock(b1, 100)
+	policy.OnBlock(b2, 100)
+	policy.OnBlock(o2, 300)
+	policy.OnBlock(o1, 100)
+	require.Equal(t, []*catalog.BlockEntry{o1, o2}, policy.Revise(false))
+}
+
+func TestForceMergeTable(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	testutils.EnsureNoLeak(t)
+	opts := config.WithQuickScanAndCKPOpts(nil)
+	tae := newTestEngine(t, opts)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(3, 2)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	schema.MergePolicy = "merge_policy=time_window"
+	tae.bindSchema(schema)
+	bat := catalog.MockBatch(schema, 30)
+	defer bat.Close()
+	tae.createRelAndAppend(bat, true)
+	// the background scanner may compact the blocks too
+	tae.compactBlocks(true)
+
+	var table *catalog.TableEntry
+	var blks []*catalog.BlockEntry
+	collect := func() bool {
+		blks = blks[:0]
+		txn, rel := tae.getRelation()
+		defer func() { assert.NoError(t, txn.Commit()) }()
+		table = rel.GetMeta().(*catalog.TableEntry)
+		it := rel.MakeBlockIt()
+		for it.Valid() {
+			blk := it.GetBlock().GetMeta().(*catalog.BlockEntry)
+			if blk.IsAppendable() {
+				return false
+			}
+			blks = append(blks, blk)
+			it.Next()
+		}
+		return true
+	}
+	testutils.WaitExpect(4000, collect)
+	assert.Equal(t, 3, len(blks))
+
+	// the blocks are created in the current window, they are merged only if forced
+	assert.NoError(t, tae.ForceMergeTable(context.Background(), table.GetDB().ID, table.ID))
+	testutils.WaitExpect(4000, func() bool {
+		for _, blk := range blks {
+			if !blk.HasDropCommitted() {
+				return false
+			}
+		}
+		return true
+	})
+	for _, blk := range blks {
+		assert.True(t, blk.HasDropCommitted())
+	}
+	tae.checkRowsByScan(30, true)
+
+	assert.Error(t, tae.ForceMergeTable(context.Background(), table.GetDB().ID, table.ID+1000))
+}
+
+func TestTimeWindowPolicyByDataTime(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	testutils.EnsureNoLeak(t)
+	opts := config.WithQuickScanAndCKPOpts(nil)
+	tae := newTestEngine(t, opts)
+	defer tae.Close()
+	// the sort key is a DATETIME column
+	schema := catalog.MockSchemaAll(13, 11)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	schema.MergePolicy = "merge_policy=time_window;merge_min_blocks=2"
+	tae.bindSchema(schema)
+	require.Equal(t, 11, getTimeColumnIdx(schema, ""))
+	require.Equal(t, 10, getTimeColumnIdx(schema, "MOCK_10"))
+	require.Equal(t, -1, getTimeColumnIdx(schema, "mock_3"))
+
+	bat := catalog.MockBatch(schema, 30)
+	defer bat.Close()
+	// all rows are in one closed window
+	base := types.DatetimeFromClock(2020, 1, 1, 0, 0, 0, 0)
+	for i := 0; i < bat.Length(); i++ {
+		bat.Vecs[11].Update(i, base+types.Datetime(i), false)
+	}
+	tae.createRelAndAppend(bat, true)
+	tae.compactBlocks(true)
+
+	// the blocks are created now, but their data is in a closed window, so
+	// they are merged without forcing. The scanner may merge the blocks before
+	// all of them are compacted, leaving a single block alone.
+	var merged, unmerged int
+	collect := func() bool {
+		merged, unmerged = 0, 0
+		txn, rel := tae.getRelation()
+		defer func() { assert.NoError(t, txn.Commit()) }()
+		it := rel.MakeBlockIt()
+		for it.Valid() {
+			blk := it.GetBlock().GetMeta().(*catalog.BlockEntry)
+			if blk.IsAppendable() {
+				return false
+			}
+			if isMergedSegment(blk.GetSegment()) {
+				merged++
+			} else {
+				unmerged++
+			}
+			it.Next()
+		}
+		return merged > 0 && unmerged <= 1
+	}
+	testutils.WaitExpect(4000, collect)
+	require.True(t, collect())
+	tae.checkRowsByScan(30, true)
+}
+
+func TestAlterTableMergePolicy(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	opts := config.WithLongScanAndCKPOpts(nil)
+	tae := newTestEngine(t, opts)
+	defer tae.Close()
+
+	schema := catalog.MockSchemaAll(2, -1)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	tae.bindSchema(schema)
+	txn, _ := tae.StartTxn(nil)
+	db, err := txn.CreateDatabase(defaultTestDB, "", "")
+	require.NoError(t, err)
+	_, err = db.CreateRelation(schema)
+	require.NoError(t, err)
+	require.NoError(t, txn.Commit())
+
+	mergePolicy := "merge_policy=time_window;merge_window=30m0s"
+	txn, rel := tae.getRelation()
+	err = rel.AlterTable(context.Background(), api.NewUpdateMergePolicyReq(0, 0, mergePolicy))
+	require.NoError(t, err)
+	require.NoError(t, txn.Commit())
+
+	getMergePolicy := func() string {
+		txn, rel := tae.getRelation()
+		defer func() { assert.NoError(t, txn.Commit()) }()
+		return rel.GetMeta().(*catalog.TableEntry).GetLatestCommittedSchema().MergePolicy
+	}
+	require.Equal(t, mergePolicy, getMergePolicy())
+
+	// replay from wal
+	tae.restart()
+	require.Equal(t, mergePolicy, getMergePolicy())
+
+	// replay from checkpoint
+	require.NoError(t, tae.incrementalCheckpoint(tae.TxnMgr.StatMaxCommitTS(), false, true, true))
+	tae.restart()
+	require.Equal(t, mergePolicy, getMergePolicy())
+}
@@ -0,0 +1,906 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/cdc"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"go.uber.org/zap"
+)
+
+// A materialized view is maintained by a changefeed on its table, whose sink
+// applies the changes to the state table of the view in the txns of DN. The
+// state table is built from the rows visible at a timestamp when the view is
+// created or refreshed, and the changefeed starts from the timestamp. A row of
+// the state table records the commit timestamp of the last change applied to
+// it, so the changes sent again after DN restarts are skipped.
+//
+// A min or max of a group cannot be maintained when the row with the value is
+// removed, the group is computed again from the rows visible at the commit
+// timestamp of the change then.
+
+const mviewFeedPrefix = "__mo_mv_"
+
+// mviewSpec is the cdc.MViewSpec with the state table resolved
+type mviewSpec struct {
+	cdc.MViewSpec
+	StateTable ChangefeedTable `json:"state_table"`
+}
+
+func mviewFeedName(state *ChangefeedTable) string {
+	return fmt.Sprintf("%s%d", mviewFeedPrefix, state.TableID)
+}
+
+// CreateMView builds the state table of a materialized view, and starts
+// maintaining it
+func (m *ChangefeedManager) CreateMView(ctx context.Context, req *MView) error {
+	if m.fs == nil {
+		return moerr.NewNotSupported(ctx, "materialized view without file service")
+	}
+	mv := new(mviewSpec)
+	if err := json.Unmarshal([]byte(req.Spec), &mv.MViewSpec); err != nil {
+		return moerr.NewInvalidInput(ctx, "invalid materialized view spec: %v", err)
+	}
+	tables, err := m.resolveTables(req.AccessInfo, []string{mv.Base, mv.State})
+	if err != nil {
+		return err
+	}
+	mv.StateTable = tables[1]
+	spec := &changefeedSpec{
+		Name:   mviewFeedName(&mv.StateTable),
+		Tables: tables[:1],
+		MView:  mv,
+	}
+	m.mu.Lock()
+	_, exists := m.feeds[spec.Name]
+	m.mu.Unlock()
+	if exists {
+		return moerr.NewInvalidInput(ctx, "materialized view %s is maintained already", mv.State)
+	}
+	return m.startMView(ctx, spec)
+}
+
+// RefreshMView builds the state table of a materialized view again, and
+// restarts maintaining it
+func (m *ChangefeedManager) RefreshMView(ctx context.Context, req *MView) error {
+	name, err := m.mviewName(req)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	feed, ok := m.feeds[name]
+	delete(m.feeds, name)
+	m.mu.Unlock()
+	if !ok {
+		return moerr.NewInvalidInput(ctx, "materialized view %s is not maintained", req.State)
+	}
+	feed.stop()
+	prev, err := m.listCheckpoints(ctx, name)
+	if err == nil {
+		err = m.startMView(ctx, feed.spec)
+	}
+	if err != nil {
+		// keeps maintaining the state table from where it stopped
+		feed.mu.Lock()
+		checkpoint := feed.checkpoint
+		feed.mu.Unlock()
+		if resumeErr := m.start(ctx, feed.spec, checkpoint, nil); resumeErr != nil {
+			logutil.Error("[Changefeed] resume materialized view failed",
+				zap.String("name", name), zap.Error(resumeErr))
+		}
+		return err
+	}
+	if len(prev) == 0 {
+		return nil
+	}
+	return m.fs.Delete(ctx, prev...)
+}
+
+// DropMView stops maintaining a materialized view
+func (m *ChangefeedManager) DropMView(ctx context.Context, req *MView) error {
+	name, err := m.mviewName(req)
+	if err != nil {
+		if req.IfExists {
+			return nil
+		}
+		return err
+	}
+	return m.Drop(ctx, &Changefeed{Name: name, IfExists: req.IfExists})
+}
+
+func (m *ChangefeedManager) mviewName(req *MView) (string, error) {
+	tables, err := m.resolveTables(req.AccessInfo, []string{req.State})
+	if err != nil {
+		return "", err
+	}
+	return mviewFeedName(&tables[0]), nil
+}
+
+func (m *ChangefeedManager) startMView(ctx context.Context, spec *changefeedSpec) error {
+	view, err := newMView(m.db, spec)
+	if err != nil {
+		return err
+	}
+	checkpoint, err := view.rebuild()
+	if err != nil {
+		return err
+	}
+	return m.start(ctx, spec, checkpoint, &mviewSink{view: view})
+}
+
+// mviewSink applies the changes of a txn to the state table in a txn
+type mviewSink struct {
+	view *mview
+}
+
+func (s *mviewSink) Send(_ context.Context, changes []*cdc.Change) (err error) {
+	view := s.view
+	txn, err := view.db.StartTxn(nil)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err != nil {
+			_ = txn.Rollback()
+		}
+	}()
+	rel, err := view.stateRelation(txn)
+	if err != nil {
+		return
+	}
+	var (
+		groups = make(map[string]*mviewGroup)
+		dirty  []*mviewGroup
+	)
+	apply := func(row cdc.Row, ts types.TS, sign int64) error {
+		if ok, err := view.match(row); err != nil || !ok {
+			return err
+		}
+		key, err := view.groupKey(row)
+		if err != nil {
+			return err
+		}
+		g, ok := groups[key]
+		if !ok {
+			if g, err = view.load(rel, key); err != nil {
+				return err
+			}
+			groups[key] = g
+		}
+		// the change has been applied before DN restarted
+		if !ts.Greater(g.applied) {
+			return nil
+		}
+		// the row has been removed with its group before DN restarted
+		if sign < 0 && g.count <= 0 {
+			return nil
+		}
+		if !g.dirty {
+			g.dirty = true
+			dirty = append(dirty, g)
+		}
+		g.ts = ts
+		return view.apply(g, row, sign)
+	}
+	for _, change := range changes {
+		if change.Before != nil {
+			if err = apply(change.Before, change.TS, -1); err != nil {
+				return
+			}
+		}
+		if change.After != nil {
+			if err = apply(change.After, change.TS, 1); err != nil {
+				return
+			}
+		}
+	}
+	for _, g := range dirty {
+		if g.recompute {
+			if err = view.recompute(g); err != nil {
+				return
+			}
+		}
+		if err = view.write(rel, g); err != nil {
+			return
+		}
+	}
+	if len(changes) > 0 {
+		if err = view.writeWatermark(rel, changes[len(changes)-1].TS); err != nil {
+			return
+		}
+	}
+	return txn.Commit()
+}
+
+func (s *mviewSink) Close() error {
+	return nil
+}
+
+// mview computes the rows of the state table of a materialized view
+type mview struct {
+	db   *DB
+	spec *mviewSpec
+	base *tableCapture
+	// the types of the columns of the table
+	baseTypes map[string]types.Type
+	filter    *mviewFilter
+	state     *catalog.Schema
+	// the types of spec.Columns in the state table, and the position of the
+	// count column on the argument of each sum column
+	colTypes  []types.Type
+	sumCounts []int
+}
+
+func newMView(db *DB, spec *changefeedSpec) (*mview, error) {
+	base, err := newTableCapture(db, &spec.Tables[0])
+	if err != nil {
+		return nil, err
+	}
+	database, err := db.Catalog.GetDatabaseByID(spec.MView.StateTable.DatabaseID)
+	if err != nil {
+		return nil, err
+	}
+	entry, err := database.GetTableEntryByID(spec.MView.StateTable.TableID)
+	if err != nil {
+		return nil, err
+	}
+	view := &mview{
+		db:        db,
+		spec:      spec.MView,
+		base:      base,
+		baseTypes: make(map[string]types.Type, len(base.cols)),
+		state:     entry.GetLastestSchema(),
+	}
+	for _, def := range base.cols {
+		view.baseTypes[def.Name] = def.Type
+	}
+	for _, key := range view.spec.Keys {
+		if _, ok := view.baseTypes[key]; !ok {
+			return nil, moerr.NewInternalErrorNoCtx("no column %s in table %s", key, view.spec.Base)
+		}
+	}
+	for _, name := range []string{cdc.MViewKeyCol, cdc.MViewTSCol, cdc.MViewCountCol} {
+		if _, ok := view.state.NameIndex[name]; !ok {
+			return nil, moerr.NewInternalErrorNoCtx("no column %s in state table %s", name, view.spec.State)
+		}
+	}
+	for _, col := range view.spec.Columns {
+		idx, ok := view.state.NameIndex[col.Name]
+		if !ok {
+			return nil, moerr.NewInternalErrorNoCtx("no column %s in state table %s", col.Name, view.spec.State)
+		}
+		if _, ok = view.baseTypes[col.Arg]; !ok {
+			return nil, moerr.NewInternalErrorNoCtx("no column %s in table %s", col.Arg, view.spec.Base)
+		}
+		view.colTypes = append(view.colTypes, view.state.ColDefs[idx].Type)
+	}
+	for _, col := range view.spec.Columns {
+		pos := -1
+		if col.Func == cdc.MViewSum {
+			for j, other := range view.spec.Columns {
+				if other.Func == cdc.MViewCount && other.Arg == col.Arg {
+					pos = j
+					break
+				}
+			}
+		}
+		view.sumCounts = append(view.sumCounts, pos)
+	}
+	if view.filter, err = compileMViewFilter(view.spec.Filter, view.baseTypes); err != nil {
+		return nil, err
+	}
+	return view, nil
+}
+
+// global is true if the view is an aggregation without GROUP BY, whose only
+// row is kept even if the table is empty
+func (v *mview) global() bool {
+	return v.spec.Aggregate && len(v.spec.Keys) == 0
+}
+
+func (v *mview) stateRelation(txn txnif.AsyncTxn) (handle.Relation, error) {
+	database, err := txn.GetDatabaseByID(v.spec.StateTable.DatabaseID)
+	if err != nil {
+		return nil, err
+	}
+	return database.GetRelationByID(v.spec.StateTable.TableID)
+}
+
+func (v *mview) match(row cdc.Row) (bool, error) {
+	if v.filter == nil {
+		return true, nil
+	}
+	result, null, err := v.filter.eval(row)
+	return result && !null, err
+}
+
+// groupKey returns the key of the group of the row, which is the JSON array
+// of the values of the keys
+func (v *mview) groupKey(row cdc.Row) (string, error) {
+	values := make([]any, 0, len(v.spec.Keys))
+	for _, key := range v.spec.Keys {
+		value, _ := row.Get(key)
+		values = append(values, value)
+	}
+	data, err := json.Marshal(values)
+	return string(data), err
+}
+
+// mviewGroup is a row of the state table. values are the values of the
+// columns of the spec, nil if null.
+type mviewGroup struct {
+	key string
+	// exists is true if the row is in the state table, and applied is its
+	// timestamp
+	exists  bool
+	applied types.TS
+	ts      types.TS
+	count   int64
+	values  []any
+	// dirty is true if the group is changed, and recompute is true if the
+	// group has to be computed from the rows of the table
+	dirty, recompute bool
+}
+
+func (v *mview) newGroup(key string) *mviewGroup {
+	g := &mviewGroup{
+		key:    key,
+		values: make([]any, len(v.spec.Columns)),
+	}
+	for i, col := range v.spec.Columns {
+		if col.Func == cdc.MViewCount {
+			g.values[i] = int64(0)
+		}
+	}
+	return g
+}
+
+// load reads the group from the state table
+func (v *mview) load(rel handle.Relation, key string) (*mviewGroup, error) {
+	g := v.newGroup(key)
+	id, row, err := rel.GetByFilter(handle.NewEQFilter([]byte(key)))
+	if err != nil {
+		if moerr.IsMoErrCode(err, moerr.ErrNotFound) {
+			return g, nil
+		}
+		return nil, err
+	}
+	get := func(name string) (any, bool, error) {
+		return rel.GetValue(id, row, uint16(v.state.NameIndex[name]))
+	}
+	value, _, err := get(cdc.MViewTSCol)
+	if err != nil {
+		return nil, err
+	}
+	ts, ok := parseChangefeedTS(string(value.([]byte)))
+	if !ok {
+		return nil, moerr.NewInternalErrorNoCtx("invalid timestamp %s of materialized view", value)
+	}
+	if value, _, err = get(cdc.MViewCountCol); err != nil {
+		return nil, err
+	}
+	g.count = value.(int64)
+	for i, col := range v.spec.Columns {
+		value, isNull, err := get(col.Name)
+		if err != nil {
+			return nil, err
+		}
+		if !isNull {
+			g.values[i] = value
+		}
+	}
+	g.exists, g.applied, g.ts = true, ts, ts
+	return g, nil
+}
+
+// apply adds the row to the group if sign is positive, or removes the row from
+// the group
+func (v *mview) apply(g *mviewGroup, row cdc.Row, sign int64) error {
+	g.count += sign
+	for i, col := range v.spec.Columns {
+		arg, _ := row.Get(col.Arg)
+		if arg == nil {
+			continue
+		}
+		if col.Func == cdc.MViewCount {
+			g.values[i] = g.values[i].(int64) + sign
+			continue
+		}
+		value, err := mviewValue(v.colTypes[i], arg)
+		if err != nil {
+			return err
+		}
+		switch col.Func {
+		case cdc.MViewValue:
+			if sign > 0 {
+				g.values[i] = value
+			}
+		case cdc.MViewSum:
+			if g.values[i], err = addMViewValue(g.values[i], value, sign); err != nil {
+				return err
+			}
+		case cdc.MViewMin, cdc.MViewMax:
+			cmp := 0
+			if cur := g.values[i]; cur != nil {
+				cmp = compareMViewValues(value, cur)
+			}
+			if sign < 0 {
+				// the value removed may be the min or the max
+				if g.values[i] == nil ||
+					(col.Func == cdc.MViewMin && cmp <= 0) ||
+					(col.Func == cdc.MViewMax && cmp >= 0) {
+					g.recompute = true
+				}
+				continue
+			}
+			if g.values[i] == nil ||
+				(col.Func == cdc.MViewMin && cmp < 0) ||
+				(col.Func == cdc.MViewMax && cmp > 0) {
+				g.values[i] = value
+			}
+		}
+	}
+	return nil
+}
+
+// scan computes the groups from the rows of the table visible at ts. Only the
+// group of key is computed if key is not empty.
+func (v *mview) scan(ts types.TS, key string) (map[string]*mviewGroup, error) {
+	groups := make(map[string]*mviewGroup)
+	if v.global() && (key == "" || key == "[]") {
+		groups["[]"] = v.newGroup("[]")
+	}
+	err := v.base.scanVisible(ts, func(row cdc.Row) error {
+		if ok, err := v.match(row); err != nil || !ok {
+			return err
+		}
+		k, err := v.groupKey(row)
+		if err != nil {
+			return err
+		}
+		if key != "" && k != key {
+			return nil
+		}
+		g, ok := groups[k]
+		if !ok {
+			g = v.newGroup(k)
+			groups[k] = g
+		}
+		return v.apply(g, row, 1)
+	})
+	return groups, err
+}
+
+// recompute computes the group from the rows of the table visible at the
+// timestamp of its last change
+func (v *mview) recompute(g *mviewGroup) error {
+	groups, err := v.scan(g.ts, g.key)
+	if err != nil {
+		return err
+	}
+	g.count, g.values = 0, v.newGroup(g.key).values
+	if fresh, ok := groups[g.key]; ok {
+		g.count, g.values = fresh.count, fresh.values
+	}
+	g.recompute = false
+	return nil
+}
+
+// write replaces the row of the group in the state table
+func (v *mview) write(rel handle.Relation, g *mviewGroup) error {
+	if g.exists {
+		if err := rel.DeleteByFilter(handle.NewEQFilter([]byte(g.key))); err != nil {
+			return err
+		}
+	}
+	if g.count <= 0 && !v.global() {
+		return nil
+	}
+	bat := v.makeBatch()
+	defer bat.Close()
+	v.appendGroup(bat, g)
+	return rel.Append(bat)
+}
+
+// writeWatermark moves the watermark of the state table to ts
+func (v *mview) writeWatermark(rel handle.Relation, ts types.TS) error {
+	g, err := v.load(rel, cdc.MViewWatermarkKey)
+	if err != nil {
+		return err
+	}
+	// the change has been applied before DN restarted
+	if !ts.Greater(g.applied) {
+		return nil
+	}
+	if g.exists {
+		if err = rel.DeleteByFilter(handle.NewEQFilter([]byte(g.key))); err != nil {
+			return err
+		}
+	}
+	g.ts = ts
+	bat := v.makeBatch()
+	defer bat.Close()
+	v.appendGroup(bat, g)
+	return rel.Append(bat)
+}
+
+// rebuild replaces the rows of the state table with the groups of the rows of
+// the table visible now, and returns the timestamp of the rows
+func (v *mview) rebuild() (ts types.TS, err error) {
+	txn, err := v.db.StartTxn(nil)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err != nil {
+			_ = txn.Rollback()
+		}
+	}()
+	ts = txn.GetStartTS()
+	groups, err := v.scan(ts, "")
+	if err != nil {
+		return
+	}
+	rel, err := v.stateRelation(txn)
+	if err != nil {
+		return
+	}
+	if err = deleteAllRows(rel); err != nil {
+		return
+	}
+	groups[cdc.MViewWatermarkKey] = v.newGroup(cdc.MViewWatermarkKey)
+	keys := make([]string, 0, len(groups))
+	for key := range groups {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	bat := v.makeBatch()
+	defer bat.Close()
+	for _, key := range keys {
+		g := groups[key]
+		g.ts = ts
+		v.appendGroup(bat, g)
+	}
+	if err = rel.Append(bat); err != nil {
+		return
+	}
+	err = txn.Commit()
+	return
+}
+
+func deleteAllRows(rel handle.Relation) error {
+	it := rel.MakeBlockIt()
+	for ; it.Valid(); it.Next() {
+		blk := it.GetBlock()
+		view, err := blk.GetColumnDataByName(cdc.MViewKeyCol)
+		if err != nil {
+			return err
+		}
+		for row := 0; row < view.Length(); row++ {
+			if view.IsDeleted(row) {
+				continue
+			}
+			if err = rel.RangeDelete(blk.Fingerprint(), uint32(row), uint32(row), handle.DT_Normal); err != nil {
+				view.Close()
+				return err
+			}
+		}
+		view.Close()
+	}
+	return nil
+}
+
+func (v *mview) makeBatch() *containers.Batch {
+	bat := containers.NewBatch()
+	for _, def := range v.state.ColDefs {
+		if !def.IsPhyAddr() {
+			bat.AddVector(def.Name, containers.MakeVector(def.Type))
+		}
+	}
+	return bat
+}
+
+func (v *mview) appendGroup(bat *containers.Batch, g *mviewGroup) {
+	values := make(map[string]any, len(v.spec.Columns)+3)
+	values[cdc.MViewKeyCol] = []byte(g.key)
+	values[cdc.MViewTSCol] = []byte(g.ts.ToString())
+	values[cdc.MViewCountCol] = g.count
+	for i, col := range v.spec.Columns {
+		value := g.values[i]
+		// the sum of no value is null
+		if pos := v.sumCounts[i]; pos >= 0 && g.values[pos].(int64) == 0 {
+			value = nil
+		}
+		values[col.Name] = value
+	}
+	for i, attr := range bat.Attrs {
+		value, ok := values[attr]
+		bat.Vecs[i].Append(value, !ok || value == nil)
+	}
+}
+
+// mviewFilter is a cdc.MViewExpr with the literals converted to the types of
+// the columns
+type mviewFilter struct {
+	op    string
+	col   string
+	typ   types.Type
+	value any
+	args  []*mviewFilter
+}
+
+func compileMViewFilter(expr *cdc.MViewExpr, typs map[string]types.Type) (f *mviewFilter, err error) {
+	if expr == nil {
+		return nil, nil
+	}
+	f = &mviewFilter{op: expr.Op}
+	switch expr.Op {
+	case cdc.MViewOpAnd, cdc.MViewOpOr, cdc.MViewOpNot:
+		if len(expr.Args) == 0 || (expr.Op == cdc.MViewOpNot && len(expr.Args) != 1) {
+			return nil, moerr.NewInternalErrorNoCtx("invalid filter %s of materialized view", expr.Op)
+		}
+		for _, arg := range expr.Args {
+			compiled, err := compileMViewFilter(arg, typs)
+			if err != nil {
+				return nil, err
+			}
+			f.args = append(f.args, compiled)
+		}
+	case cdc.MViewOpIsNull, cdc.MViewOpIsNotNull,
+		cdc.MViewOpEQ, cdc.MViewOpNE, cdc.MViewOpLT, cdc.MViewOpLE, cdc.MViewOpGT, cdc.MViewOpGE:
+		typ, ok := typs[expr.Col]
+		if !ok {
+			return nil, moerr.NewInternalErrorNoCtx("no column %s of filter of materialized view", expr.Col)
+		}
+		f.col, f.typ = expr.Col, typ
+		if expr.Op != cdc.MViewOpIsNull && expr.Op != cdc.MViewOpIsNotNull {
+			if f.value, err = mviewValue(typ, expr.Value); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, moerr.NewInternalErrorNoCtx("invalid filter %s of materialized view", expr.Op)
+	}
+	return f, nil
+}
+
+// eval evaluates the filter on the row, the result is unknown if null is true
+func (f *mviewFilter) eval(row cdc.Row) (result, null bool, err error) {
+	switch f.op {
+	case cdc.MViewOpAnd, cdc.MViewOpOr:
+		// AND is false if any argument is false, and OR is true if any
+		// argument is true, otherwise they are unknown if any argument is
+		result = f.op == cdc.MViewOpAnd
+		for _, arg := range f.args {
+			r, n, err := arg.eval(row)
+			if err != nil {
+				return false, false, err
+			}
+			if n {
+				null = true
+			} else if r != result {
+				return r, false, nil
+			}
+		}
+		return
+	case cdc.MViewOpNot:
+		result, null, err = f.args[0].eval(row)
+		return !result, null, err
+	}
+	arg, _ := row.Get(f.col)
+	switch f.op {
+	case cdc.MViewOpIsNull:
+		return arg == nil, false, nil
+	case cdc.MViewOpIsNotNull:
+		return arg != nil, false, nil
+	}
+	if arg == nil {
+		return false, true, nil
+	}
+	value, err := mviewValue(f.typ, arg)
+	if err != nil {
+		return
+	}
+	cmp := compareMViewValues(value, f.value)
+	switch f.op {
+	case cdc.MViewOpEQ:
+		result = cmp == 0
+	case cdc.MViewOpNE:
+		result = cmp != 0
+	case cdc.MViewOpLT:
+		result = cmp < 0
+	case cdc.MViewOpLE:
+		result = cmp <= 0
+	case cdc.MViewOpGT:
+		result = cmp > 0
+	case cdc.MViewOpGE:
+		result = cmp >= 0
+	}
+	return
+}
+
+// mviewValue converts a value of cdc.Column, or a literal of the filter, to
+// the value of the type typ in DN
+func mviewValue(typ types.Type, v any) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	s := fmt.Sprint(v)
+	switch typ.Oid {
+	case types.T_bool:
+		if b, ok := v.(bool); ok {
+			return b, nil
+		}
+		return strconv.ParseBool(s)
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64:
+		i, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, moerr.NewInvalidInputNoCtx("invalid %s value '%s'", typ.String(), s)
+		}
+		switch typ.Oid {
+		case types.T_int8:
+			return int8(i), nil
+		case types.T_int16:
+			return int16(i), nil
+		case types.T_int32:
+			return int32(i), nil
+		}
+		return i, nil
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		u, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return nil, moerr.NewInvalidInputNoCtx("invalid %s value '%s'", typ.String(), s)
+		}
+		switch typ.Oid {
+		case types.T_uint8:
+			return uint8(u), nil
+		case types.T_uint16:
+			return uint16(u), nil
+		case types.T_uint32:
+			return uint32(u), nil
+		}
+		return u, nil
+	case types.T_float32, types.T_float64:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, moerr.NewInvalidInputNoCtx("invalid %s value '%s'", typ.String(), s)
+		}
+		if typ.Oid == types.T_float32 {
+			return float32(f), nil
+		}
+		return f, nil
+	case types.T_decimal64:
+		return types.ParseDecimal64(s, typ.Width, typ.Scale)
+	case types.T_decimal128:
+		return types.ParseDecimal128(s, typ.Width, typ.Scale)
+	case types.T_date:
+		return types.ParseDateCast(s)
+	case types.T_datetime:
+		return types.ParseDatetime(s, typ.Scale)
+	case types.T_time:
+		return types.ParseTime(s, typ.Scale)
+	case types.T_timestamp:
+		return types.ParseTimestamp(time.UTC, s, typ.Scale)
+	case types.T_uuid:
+		return types.ParseUuid(s)
+	case types.T_char, types.T_varchar, types.T_text, types.T_blob:
+		return []byte(s), nil
+	}
+	return nil, moerr.NewNotSupportedNoCtx("type %s in materialized view", typ.String())
+}
+
+// compareMViewValues compares two values of the same type converted by
+// mviewValue
+func compareMViewValues(a, b any) int {
+	switch a := a.(type) {
+	case bool:
+		return compareOrdered(boolToInt(a), boolToInt(b.(bool)))
+	case int8:
+		return compareOrdered(a, b.(int8))
+	case int16:
+		return compareOrdered(a, b.(int16))
+	case int32:
+		return compareOrdered(a, b.(int32))
+	case int64:
+		return compareOrdered(a, b.(int64))
+	case uint8:
+		return compareOrdered(a, b.(uint8))
+	case uint16:
+		return compareOrdered(a, b.(uint16))
+	case uint32:
+		return compareOrdered(a, b.(uint32))
+	case uint64:
+		return compareOrdered(a, b.(uint64))
+	case float32:
+		return compareOrdered(a, b.(float32))
+	case float64:
+		return compareOrdered(a, b.(float64))
+	case types.Decimal64:
+		return a.Compare(b.(types.Decimal64))
+	case types.Decimal128:
+		return a.Compare(b.(types.Decimal128))
+	case types.Date:
+		return compareOrdered(a, b.(types.Date))
+	case types.Datetime:
+		return compareOrdered(a, b.(types.Datetime))
+	case types.Time:
+		return compareOrdered(a, b.(types.Time))
+	case types.Timestamp:
+		return compareOrdered(a, b.(types.Timestamp))
+	case types.Uuid:
+		u := b.(types.Uuid)
+		return bytes.Compare(a[:], u[:])
+	case []byte:
+		return bytes.Compare(a, b.([]byte))
+	}
+	panic(fmt.Sprintf("unexpected value %T of materialized view", a))
+}
+
+func compareOrdered[T types.OrderedT](a, b T) int {
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// addMViewValue adds the value to the sum if sign is positive, or subtracts
+// it from the sum
+func addMViewValue(sum, v any, sign int64) (any, error) {
+	switch v := v.(type) {
+	case int64:
+		s, _ := sum.(int64)
+		return s + sign*v, nil
+	case uint64:
+		s, _ := sum.(uint64)
+		if sign < 0 {
+			return s - v, nil
+		}
+		return s + v, nil
+	case float64:
+		s, _ := sum.(float64)
+		return s + float64(sign)*v, nil
+	case types.Decimal128:
+		s, _ := sum.(types.Decimal128)
+		if sign < 0 {
+			return s.Sub128(v)
+		}
+		return s.Add128(v)
+	}
+	return nil, moerr.NewNotSupportedNoCtx("sum of %T in materialized view", v)
+}
@@ -0,0 +1,313 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/cdc"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mviewTestRow returns the columns of the row of the state table, or nil if
+// the group does not exist
+func mviewTestRow(t *testing.T, tae *DB, state *catalog.Schema, key string) map[string]any {
+	txn, rel := getRelation(t, 0, tae, "db", state.Name)
+	defer txn.Rollback()
+	id, row, err := rel.GetByFilter(handle.NewEQFilter([]byte(key)))
+	if moerr.IsMoErrCode(err, moerr.ErrNotFound) {
+		return nil
+	}
+	require.NoError(t, err)
+	values := make(map[string]any)
+	for _, def := range state.ColDefs {
+		if def.IsPhyAddr() {
+			continue
+		}
+		v, isNull, err := rel.GetValue(id, row, uint16(def.Idx))
+		require.NoError(t, err)
+		if b, ok := v.([]byte); ok {
+			v = string(b)
+		}
+		if !isNull {
+			values[def.Name] = v
+		}
+	}
+	return values
+}
+
+func waitMViewRow(t *testing.T, tae *DB, state *catalog.Schema, key string, expect map[string]any) {
+	check := func(row map[string]any) bool {
+		if expect == nil || row == nil {
+			return expect == nil && row == nil
+		}
+		for name, v := range expect {
+			if row[name] != v {
+				return false
+			}
+		}
+		for _, name := range []string{"__mo_mv_sum_v", "__mo_mv_min_v", "__mo_mv_max_v"} {
+			if _, ok := expect[name]; !ok && row[name] != nil {
+				return false
+			}
+		}
+		return true
+	}
+	testutils.WaitExpect(5000, func() bool {
+		return check(mviewTestRow(t, tae, state, key))
+	})
+	row := mviewTestRow(t, tae, state, key)
+	require.True(t, check(row), "group %s: %v, expect %v", key, row, expect)
+}
+
+func TestMaterializedView(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	testutils.EnsureNoLeak(t)
+	ctx := context.Background()
+	tae := newTestEngine(t, nil)
+	defer tae.Close()
+
+	// select g, count(*), count(v), sum(v), min(v), max(v) from t where v > 0 or v is null group by g
+	base := catalog.NewEmptySchema("t")
+	require.NoError(t, base.AppendPKCol("id", types.T_int32.ToType(), 0))
+	require.NoError(t, base.AppendCol("g", types.T_varchar.ToType()))
+	require.NoError(t, base.AppendCol("v", types.T_int64.ToType()))
+	require.NoError(t, base.Finalize(false))
+	base.BlockMaxRows = 10
+	base.SegmentMaxBlocks = 2
+	state := catalog.NewEmptySchema("__mo_mv_mv")
+	require.NoError(t, state.AppendPKCol(cdc.MViewKeyCol, types.T_varchar.ToType(), 0))
+	require.NoError(t, state.AppendCol(cdc.MViewTSCol, types.T_varchar.ToType()))
+	require.NoError(t, state.AppendCol(cdc.MViewCountCol, types.T_int64.ToType()))
+	require.NoError(t, state.AppendCol("g", types.T_varchar.ToType()))
+	for _, name := range []string{"__mo_mv_n_v", "__mo_mv_sum_v", "__mo_mv_min_v", "__mo_mv_max_v"} {
+		require.NoError(t, state.AppendCol(name, types.T_int64.ToType()))
+	}
+	require.NoError(t, state.Finalize(false))
+	state.BlockMaxRows = 10
+	state.SegmentMaxBlocks = 2
+	createRelation(t, tae.DB, "db", base, true)
+	createRelation(t, tae.DB, "db", state, false)
+
+	write := func(deletes []int32, rows ...[]any) {
+		txn, rel := getRelation(t, 0, tae.DB, "db", base.Name)
+		for _, id := range deletes {
+			require.NoError(t, rel.DeleteByFilter(handle.NewEQFilter(id)))
+		}
+		if len(rows) > 0 {
+			bat := containers.NewBatch()
+			defer bat.Close()
+			for _, def := range base.ColDefs {
+				if !def.IsPhyAddr() {
+					bat.AddVector(def.Name, containers.MakeVector(def.Type))
+				}
+			}
+			for _, row := range rows {
+				bat.Vecs[0].Append(row[0], false)
+				bat.Vecs[1].Append([]byte(row[1].(string)), false)
+				bat.Vecs[2].Append(row[2], row[2] == nil)
+			}
+			require.NoError(t, rel.Append(bat))
+		}
+		require.NoError(t, txn.Commit())
+	}
+	write(nil,
+		[]any{int32(1), "a", int64(10)},
+		[]any{int32(2), "a", int64(20)},
+		[]any{int32(3), "b", int64(5)},
+		[]any{int32(4), "b", int64(-1)},
+		[]any{int32(5), "c", int64(7)},
+		[]any{int32(6), "c", nil})
+
+	spec := cdc.MViewSpec{
+		Base:  "db.t",
+		State: "db.__mo_mv_mv",
+		Filter: &cdc.MViewExpr{Op: cdc.MViewOpOr, Args: []*cdc.MViewExpr{
+			{Op: cdc.MViewOpGT, Col: "v", Value: "0"},
+			{Op: cdc.MViewOpIsNull, Col: "v"},
+		}},
+		Aggregate: true,
+		Keys:      []string{"g"},
+		Columns: []cdc.MViewColumn{
+			{Name: "g", Arg: "g"},
+			{Name: "__mo_mv_n_v", Func: cdc.MViewCount, Arg: "v"},
+			{Name: "__mo_mv_sum_v", Func: cdc.MViewSum, Arg: "v"},
+			{Name: "__mo_mv_min_v", Func: cdc.MViewMin, Arg: "v"},
+			{Name: "__mo_mv_max_v", Func: cdc.MViewMax, Arg: "v"},
+		},
+	}
+	data, err := json.Marshal(spec)
+	require.NoError(t, err)
+	require.NoError(t, tae.Changefeeds.CreateMView(ctx, &MView{Op: MViewCreate, Spec: string(data)}))
+	assert.Error(t, tae.Changefeeds.CreateMView(ctx, &MView{Op: MViewCreate, Spec: string(data)}))
+	assert.Error(t, tae.Changefeeds.CreateMView(ctx, &MView{Op: MViewCreate, Spec: "{"}))
+	// the changefeeds of the materialized views are not shown
+	assert.Empty(t, tae.Changefeeds.Show())
+
+	// built from the rows of the table
+	waitMViewRow(t, tae.DB, state, `["a"]`, map[string]any{
+		"g": "a", cdc.MViewCountCol: int64(2), "__mo_mv_n_v": int64(2),
+		"__mo_mv_sum_v": int64(30), "__mo_mv_min_v": int64(10), "__mo_mv_max_v": int64(20)})
+	waitMViewRow(t, tae.DB, state, `["b"]`, map[string]any{
+		"g": "b", cdc.MViewCountCol: int64(1), "__mo_mv_n_v": int64(1),
+		"__mo_mv_sum_v": int64(5), "__mo_mv_min_v": int64(5), "__mo_mv_max_v": int64(5)})
+	waitMViewRow(t, tae.DB, state, `["c"]`, map[string]any{
+		"g": "c", cdc.MViewCountCol: int64(2), "__mo_mv_n_v": int64(1),
+		"__mo_mv_sum_v": int64(7), "__mo_mv_min_v": int64(7), "__mo_mv_max_v": int64(7)})
+
+	// the min of a is removed, b is updated and gets the row filtered before
+	write([]int32{1, 3, 4}, []any{int32(3), "b", int64(50)}, []any{int32(4), "b", int64(8)})
+	waitMViewRow(t, tae.DB, state, `["a"]`, map[string]any{
+		"g": "a", cdc.MViewCountCol: int64(1), "__mo_mv_n_v": int64(1),
+		"__mo_mv_sum_v": int64(20), "__mo_mv_min_v": int64(20), "__mo_mv_max_v": int64(20)})
+	waitMViewRow(t, tae.DB, state, `["b"]`, map[string]any{
+		"g": "b", cdc.MViewCountCol: int64(2), "__mo_mv_n_v": int64(2),
+		"__mo_mv_sum_v": int64(58), "__mo_mv_min_v": int64(8), "__mo_mv_max_v": int64(50)})
+	// the only value of c is removed, and the group of d is created
+	write([]int32{5}, []any{int32(7), "d", int64(1)})
+	waitMViewRow(t, tae.DB, state, `["c"]`, map[string]any{
+		"g": "c", cdc.MViewCountCol: int64(1), "__mo_mv_n_v": int64(0)})
+	waitMViewRow(t, tae.DB, state, `["d"]`, map[string]any{
+		"g": "d", cdc.MViewCountCol: int64(1), "__mo_mv_n_v": int64(1),
+		"__mo_mv_sum_v": int64(1), "__mo_mv_min_v": int64(1), "__mo_mv_max_v": int64(1)})
+	write([]int32{6})
+	waitMViewRow(t, tae.DB, state, `["c"]`, nil)
+
+	// the watermark moves even if the changes are filtered out by the view
+	watermark := func() types.TS {
+		row := mviewTestRow(t, tae.DB, state, cdc.MViewWatermarkKey)
+		require.NotNil(t, row)
+		ts, ok := parseChangefeedTS(row[cdc.MViewTSCol].(string))
+		require.True(t, ok)
+		return ts
+	}
+	before := watermark()
+	write(nil, []any{int32(9), "e", int64(-1)})
+	testutils.WaitExpect(5000, func() bool {
+		return watermark().Greater(before)
+	})
+	assert.True(t, watermark().Greater(before))
+	assert.Nil(t, mviewTestRow(t, tae.DB, state, `["e"]`))
+
+	// the changes applied are skipped if they are sent again
+	tae.Changefeeds.mu.Lock()
+	var feed *changefeed
+	for _, f := range tae.Changefeeds.feeds {
+		feed = f
+	}
+	tae.Changefeeds.mu.Unlock()
+	view, err := newMView(tae.DB, feed.spec)
+	require.NoError(t, err)
+	applied, ok := parseChangefeedTS(mviewTestRow(t, tae.DB, state, `["d"]`)[cdc.MViewTSCol].(string))
+	require.True(t, ok)
+	sink := &mviewSink{view: view}
+	require.NoError(t, sink.Send(ctx, []*cdc.Change{{
+		TS:    applied,
+		Op:    cdc.OpInsert,
+		After: cdc.Row{{Name: "id", Value: int64(7)}, {Name: "g", Value: "d"}, {Name: "v", Value: int64(1)}},
+	}}))
+	assert.Equal(t, int64(1), mviewTestRow(t, tae.DB, state, `["d"]`)[cdc.MViewCountCol])
+
+	// resumes after restart
+	tae.restart()
+	write(nil, []any{int32(8), "a", int64(2)})
+	waitMViewRow(t, tae.DB, state, `["a"]`, map[string]any{
+		"g": "a", cdc.MViewCountCol: int64(2), "__mo_mv_n_v": int64(2),
+		"__mo_mv_sum_v": int64(22), "__mo_mv_min_v": int64(2), "__mo_mv_max_v": int64(20)})
+
+	// refresh builds the state table again
+	req := &MView{Op: MViewRefresh, State: spec.State}
+	require.NoError(t, tae.Changefeeds.RefreshMView(ctx, req))
+	waitMViewRow(t, tae.DB, state, `["b"]`, map[string]any{
+		"g": "b", cdc.MViewCountCol: int64(2), "__mo_mv_n_v": int64(2),
+		"__mo_mv_sum_v": int64(58), "__mo_mv_min_v": int64(8), "__mo_mv_max_v": int64(50)})
+	refreshed, ok := parseChangefeedTS(mviewTestRow(t, tae.DB, state, `["d"]`)[cdc.MViewTSCol].(string))
+	require.True(t, ok)
+	assert.True(t, refreshed.Greater(applied))
+	assert.Equal(t, refreshed, watermark())
+	write([]int32{7})
+	waitMViewRow(t, tae.DB, state, `["d"]`, nil)
+
+	require.NoError(t, tae.Changefeeds.DropMView(ctx, &MView{Op: MViewDrop, State: spec.State}))
+	assert.Error(t, tae.Changefeeds.RefreshMView(ctx, req))
+	assert.NoError(t, tae.Changefeeds.DropMView(ctx, &MView{Op: MViewDrop, State: spec.State, IfExists: true}))
+	tae.restart()
+	tae.Changefeeds.mu.Lock()
+	assert.Empty(t, tae.Changefeeds.feeds)
+	tae.Changefeeds.mu.Unlock()
+}
+
+func TestMViewFilter(t *testing.T) {
+	typs := map[string]types.Type{
+		"i": types.T_int32.ToType(),
+		"s": types.T_varchar.ToType(),
+		"d": types.New(types.T_decimal64, 10, 2),
+		"t": types.T_date.ToType(),
+	}
+	row := cdc.Row{
+		{Name: "i", Value: int64(5)},
+		{Name: "s", Value: "abc"},
+		{Name: "d", Value: "1.50"},
+		{Name: "t", Value: "2023-03-01"},
+	}
+	eval := func(expr *cdc.MViewExpr) (bool, bool) {
+		f, err := compileMViewFilter(expr, typs)
+		require.NoError(t, err)
+		result, null, err := f.eval(row)
+		require.NoError(t, err)
+		return result, null
+	}
+	cmp := func(op, col, value string) *cdc.MViewExpr {
+		return &cdc.MViewExpr{Op: op, Col: col, Value: value}
+	}
+	result, null := eval(cmp(cdc.MViewOpGE, "i", "5"))
+	assert.True(t, result && !null)
+	result, _ = eval(cmp(cdc.MViewOpLT, "d", "1.2"))
+	assert.False(t, result)
+	result, _ = eval(cmp(cdc.MViewOpGT, "t", "2023-02-28"))
+	assert.True(t, result)
+	result, _ = eval(&cdc.MViewExpr{Op: cdc.MViewOpNot, Args: []*cdc.MViewExpr{cmp(cdc.MViewOpEQ, "s", "abc")}})
+	assert.False(t, result)
+
+	// a comparison with null is unknown, which is not true even negated
+	row = append(row, cdc.Column{Name: "n"})
+	typs["n"] = types.T_int64.ToType()
+	_, null = eval(cmp(cdc.MViewOpEQ, "n", "1"))
+	assert.True(t, null)
+	_, null = eval(&cdc.MViewExpr{Op: cdc.MViewOpNot, Args: []*cdc.MViewExpr{cmp(cdc.MViewOpEQ, "n", "1")}})
+	assert.True(t, null)
+	result, null = eval(&cdc.MViewExpr{Op: cdc.MViewOpAnd, Args: []*cdc.MViewExpr{cmp(cdc.MViewOpEQ, "n", "1"), cmp(cdc.MViewOpEQ, "i", "4")}})
+	assert.False(t, result || null)
+	result, null = eval(&cdc.MViewExpr{Op: cdc.MViewOpOr, Args: []*cdc.MViewExpr{cmp(cdc.MViewOpEQ, "n", "1"), cmp(cdc.MViewOpEQ, "i", "5")}})
+	assert.True(t, result && !null)
+	result, null = eval(&cdc.MViewExpr{Op: cdc.MViewOpIsNull, Col: "n"})
+	assert.True(t, result && !null)
+
+	_, err := compileMViewFilter(cmp(cdc.MViewOpEQ, "x", "1"), typs)
+	assert.Error(t, err)
+	_, err = compileMViewFilter(cmp(cdc.MViewOpEQ, "i", "abc"), typs)
+	assert.Error(t, err)
+	_, err = compileMViewFilter(&cdc.MViewExpr{Op: "like", Col: "s"}, typs)
+	assert.Error(t, err)
+}
@@ -33,6 +33,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/checkpoint"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/store"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables"
 	w "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks/worker"
@@ -84,12 +85,26 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 		Closed:     new(atomic.Value),
 	}
 
-	switch opts.LogStoreT {
-	case options.LogstoreBatchStore:
-		db.Wal = wal.NewDriverWithBatchStore(dirname, WALDir, nil)
-	case options.LogstoreLogservice:
-		db.Wal = wal.NewDriverWithLogservice(opts.Lc)
+	if opts.RecoveryCfg != nil {
+		now := time.Now()
+		var restored int
+		if restored, err = restoreArchivedWal(context.Background(), dirname, opts); err != nil {
+			return
+		}
+		logutil.Info("open-tae", common.OperationField("restore"),
+			common.OperandField("archived wal"),
+			common.AnyField("cost", time.Since(now)),
+			common.AnyField("entries", restored))
+	}
+	var walCfg *wal.DriverConfig
+	if opts.WalArchiveCfg != nil {
+		var archiver *store.Archiver
+		if archiver, err = store.NewArchiver(opts.WalArchiveCfg.Fs, opts.WalArchiveCfg.FlushInterval); err != nil {
+			return
+		}
+		walCfg = &wal.DriverConfig{Archiver: archiver}
 	}
+	db.Wal = newWalDriver(dirname, opts, walCfg)
 	db.Scheduler = newTaskScheduler(db, db.Opts.SchedulerCfg.AsyncWorkers, db.Opts.SchedulerCfg.IOWorkers)
 	dataFactory := tables.NewDataFactory(
 		db.Fs, indexCache, db.Scheduler, db.Dir)
@@ -148,8 +163,8 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 
 	// Init timed scanner
 	scanner := NewDBScanner(db, nil)
-	mergeOp := newMergeTaskBuiler(db)
-	scanner.RegisterOp(mergeOp)
+	db.MergeHandle = newMergeTaskBuiler(db)
+	scanner.RegisterOp(db.MergeHandle)
 	db.Wal.Start()
 	db.BGCheckpointRunner.Start()
 
@@ -166,6 +181,11 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 			ts := types.BuildTS(time.Now().UTC().UnixNano()-int64(opts.GCCfg.GCTTL), 0)
 			return !checkpoint.GetEnd().GreaterEq(ts)
 		})
+	// no object is GCed while the checkpoints are pinned by a backup
+	db.DiskCleaner.AddChecker(
+		func(item any) bool {
+			return !db.BGCheckpointRunner.IsPinned()
+		})
 	// Init gc manager at last
 	// TODO: clean-try-gc requires configuration parameters
 	db.GCManager = gc.NewManager(
@@ -226,7 +246,22 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 
 	db.GCManager.Start()
 
+	db.Changefeeds = newChangefeedManager(db, checkpointed)
+	if err = db.Changefeeds.open(context.Background()); err != nil {
+		return
+	}
+
 	// For debug or test
 	// logutil.Info(db.Catalog.SimplePPString(common.PPL2))
 	return
 }
+
+func newWalDriver(dirname string, opts *options.Options, cfg *wal.DriverConfig) (driver wal.Driver) {
+	switch opts.LogStoreT {
+	case options.LogstoreBatchStore:
+		driver = wal.NewDriverWithBatchStore(dirname, WALDir, cfg)
+	case options.LogstoreLogservice:
+		driver = wal.NewDriverWithLogservice(opts.Lc, cfg)
+	}
+	return
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	apipb "github.com/matrixorigin/matrixone/pkg/pb/api"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
@@ -88,6 +89,20 @@ func (m *FlushTable) UnmarshalBinary(data []byte) error {
 	return m.Unmarshal(data)
 }
 
+type MergeTable struct {
+	AccessInfo AccessInfo
+	DatabaseID uint64
+	TableID    uint64
+}
+
+func (m *MergeTable) MarshalBinary() ([]byte, error) {
+	return m.Marshal()
+}
+
+func (m *MergeTable) UnmarshalBinary(data []byte) error {
+	return m.Unmarshal(data)
+}
+
 type Checkpoint struct {
 	FlushDuration time.Duration
 }
@@ -100,6 +115,108 @@ func (m *Checkpoint) UnmarshalBinary(data []byte) error {
 	return m.Unmarshal(data)
 }
 
+type Backup struct {
+	AccessInfo AccessInfo
+	Backend    string
+	Dir        string
+	Endpoint   string
+	Bucket     string
+	KeyPrefix  string
+}
+
+func (m *Backup) MarshalBinary() ([]byte, error) {
+	return m.Marshal()
+}
+
+func (m *Backup) UnmarshalBinary(data []byte) error {
+	return m.Unmarshal(data)
+}
+
+// FileServiceConfig returns the config of the file service backed up to
+func (m *Backup) FileServiceConfig() fileservice.Config {
+	return fileservice.Config{
+		Name:    BackupFileServiceName,
+		Backend: m.Backend,
+		DataDir: m.Dir,
+		S3: fileservice.S3Config{
+			Endpoint:  m.Endpoint,
+			Bucket:    m.Bucket,
+			KeyPrefix: m.KeyPrefix,
+		},
+	}
+}
+
+// RestoreReq copies the backup ending at Ts from the file service Src into the
+// file service Dst, the latest backup is restored if Ts is empty. DN opened on
+// Dst rebuilds the catalog and the data of the backup.
+type RestoreReq struct {
+	AccessInfo AccessInfo
+	Src        Backup
+	Dst        Backup
+	Ts         string
+}
+
+func (m *RestoreReq) MarshalBinary() ([]byte, error) {
+	return m.Marshal()
+}
+
+func (m *RestoreReq) UnmarshalBinary(data []byte) error {
+	return m.Unmarshal(data)
+}
+
+const (
+	ChangefeedCreate = "create"
+	ChangefeedDrop   = "drop"
+	ChangefeedShow   = "show"
+)
+
+// Changefeed creates, drops or shows the changefeeds. Tables are the names of
+// the tables captured in the form of database.table, and Cursor is the commit
+// timestamp to start from, default the time of creation.
+type Changefeed struct {
+	AccessInfo  AccessInfo
+	Op          string
+	Name        string
+	Tables      []string
+	Sink        string
+	Cursor      string
+	IfNotExists bool
+	IfExists    bool
+}
+
+func (m *Changefeed) MarshalBinary() ([]byte, error) {
+	return m.Marshal()
+}
+
+func (m *Changefeed) UnmarshalBinary(data []byte) error {
+	return m.Unmarshal(data)
+}
+
+const (
+	MViewCreate  = "create"
+	MViewDrop    = "drop"
+	MViewRefresh = "refresh"
+)
+
+// MView creates, drops or refreshes the maintenance of a materialized view.
+// State is the name of the state table of the view in the form of
+// database.table, and Spec is the cdc.MViewSpec in JSON for creation.
+type MView struct {
+	AccessInfo AccessInfo
+	Op         string
+	State      string
+	Spec       string
+	IfExists   bool
+}
+
+func (m *MView) MarshalBinary() ([]byte, error) {
+	return m.Marshal()
+}
+
+func (m *MView) UnmarshalBinary(data []byte) error {
+	return m.Unmarshal(data)
+}
+
 type InspectDN struct {
 	AccessInfo AccessInfo
 	Operation  string
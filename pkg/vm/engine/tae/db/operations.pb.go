@@ -195,11 +195,65 @@ func (m *FlushTable) GetTableID() uint64 {
 	return 0
 }
 
+func (m *MergeTable) Reset()         { *m = MergeTable{} }
+func (m *MergeTable) String() string { return proto.CompactTextString(m) }
+func (*MergeTable) ProtoMessage()    {}
+func (*MergeTable) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b4a5877375e491e, []int{3}
+}
+func (m *MergeTable) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MergeTable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MergeTable.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MergeTable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeTable.Merge(m, src)
+}
+func (m *MergeTable) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *MergeTable) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeTable.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MergeTable proto.InternalMessageInfo
+
+func (m *MergeTable) GetAccessInfo() AccessInfo {
+	if m != nil {
+		return m.AccessInfo
+	}
+	return AccessInfo{}
+}
+
+func (m *MergeTable) GetDatabaseID() uint64 {
+	if m != nil {
+		return m.DatabaseID
+	}
+	return 0
+}
+
+func (m *MergeTable) GetTableID() uint64 {
+	if m != nil {
+		return m.TableID
+	}
+	return 0
+}
+
 func (m *Checkpoint) Reset()         { *m = Checkpoint{} }
 func (m *Checkpoint) String() string { return proto.CompactTextString(m) }
 func (*Checkpoint) ProtoMessage()    {}
 func (*Checkpoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b4a5877375e491e, []int{3}
+	return fileDescriptor_1b4a5877375e491e, []int{4}
 }
 func (m *Checkpoint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -239,7 +293,7 @@ func (m *InspectDN) Reset()         { *m = InspectDN{} }
 func (m *InspectDN) String() string { return proto.CompactTextString(m) }
 func (*InspectDN) ProtoMessage()    {}
 func (*InspectDN) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b4a5877375e491e, []int{4}
+	return fileDescriptor_1b4a5877375e491e, []int{5}
 }
 func (m *InspectDN) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -282,11 +336,304 @@ func (m *InspectDN) GetOperation() string {
 	return ""
 }
 
+func (m *Backup) Reset()         { *m = Backup{} }
+func (m *Backup) String() string { return proto.CompactTextString(m) }
+func (*Backup) ProtoMessage()    {}
+func (*Backup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b4a5877375e491e, []int{6}
+}
+func (m *Backup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Backup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Backup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Backup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Backup.Merge(m, src)
+}
+func (m *Backup) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *Backup) XXX_DiscardUnknown() {
+	xxx_messageInfo_Backup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Backup proto.InternalMessageInfo
+
+func (m *Backup) GetAccessInfo() AccessInfo {
+	if m != nil {
+		return m.AccessInfo
+	}
+	return AccessInfo{}
+}
+
+func (m *Backup) GetBackend() string {
+	if m != nil {
+		return m.Backend
+	}
+	return ""
+}
+
+func (m *Backup) GetDir() string {
+	if m != nil {
+		return m.Dir
+	}
+	return ""
+}
+
+func (m *Backup) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func (m *Backup) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *Backup) GetKeyPrefix() string {
+	if m != nil {
+		return m.KeyPrefix
+	}
+	return ""
+}
+
+func (m *RestoreReq) Reset()         { *m = RestoreReq{} }
+func (m *RestoreReq) String() string { return proto.CompactTextString(m) }
+func (*RestoreReq) ProtoMessage()    {}
+func (*RestoreReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b4a5877375e491e, []int{7}
+}
+func (m *RestoreReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestoreReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestoreReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestoreReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreReq.Merge(m, src)
+}
+func (m *RestoreReq) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *RestoreReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreReq proto.InternalMessageInfo
+
+func (m *RestoreReq) GetAccessInfo() AccessInfo {
+	if m != nil {
+		return m.AccessInfo
+	}
+	return AccessInfo{}
+}
+
+func (m *RestoreReq) GetSrc() Backup {
+	if m != nil {
+		return m.Src
+	}
+	return Backup{}
+}
+
+func (m *RestoreReq) GetDst() Backup {
+	if m != nil {
+		return m.Dst
+	}
+	return Backup{}
+}
+
+func (m *RestoreReq) GetTs() string {
+	if m != nil {
+		return m.Ts
+	}
+	return ""
+}
+
+func (m *Changefeed) Reset()         { *m = Changefeed{} }
+func (m *Changefeed) String() string { return proto.CompactTextString(m) }
+func (*Changefeed) ProtoMessage()    {}
+func (*Changefeed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b4a5877375e491e, []int{8}
+}
+func (m *Changefeed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Changefeed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Changefeed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Changefeed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Changefeed.Merge(m, src)
+}
+func (m *Changefeed) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *Changefeed) XXX_DiscardUnknown() {
+	xxx_messageInfo_Changefeed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Changefeed proto.InternalMessageInfo
+
+func (m *Changefeed) GetAccessInfo() AccessInfo {
+	if m != nil {
+		return m.AccessInfo
+	}
+	return AccessInfo{}
+}
+
+func (m *Changefeed) GetOp() string {
+	if m != nil {
+		return m.Op
+	}
+	return ""
+}
+
+func (m *Changefeed) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Changefeed) GetTables() []string {
+	if m != nil {
+		return m.Tables
+	}
+	return nil
+}
+
+func (m *Changefeed) GetSink() string {
+	if m != nil {
+		return m.Sink
+	}
+	return ""
+}
+
+func (m *Changefeed) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *Changefeed) GetIfNotExists() bool {
+	if m != nil {
+		return m.IfNotExists
+	}
+	return false
+}
+
+func (m *Changefeed) GetIfExists() bool {
+	if m != nil {
+		return m.IfExists
+	}
+	return false
+}
+
+func (m *MView) Reset()         { *m = MView{} }
+func (m *MView) String() string { return proto.CompactTextString(m) }
+func (*MView) ProtoMessage()    {}
+func (*MView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b4a5877375e491e, []int{9}
+}
+func (m *MView) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MView.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MView.Merge(m, src)
+}
+func (m *MView) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *MView) XXX_DiscardUnknown() {
+	xxx_messageInfo_MView.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MView proto.InternalMessageInfo
+
+func (m *MView) GetAccessInfo() AccessInfo {
+	if m != nil {
+		return m.AccessInfo
+	}
+	return AccessInfo{}
+}
+
+func (m *MView) GetOp() string {
+	if m != nil {
+		return m.Op
+	}
+	return ""
+}
+
+func (m *MView) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *MView) GetSpec() string {
+	if m != nil {
+		return m.Spec
+	}
+	return ""
+}
+
+func (m *MView) GetIfExists() bool {
+	if m != nil {
+		return m.IfExists
+	}
+	return false
+}
+
 func (m *InspectResp) Reset()         { *m = InspectResp{} }
 func (m *InspectResp) String() string { return proto.CompactTextString(m) }
 func (*InspectResp) ProtoMessage()    {}
 func (*InspectResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b4a5877375e491e, []int{5}
+	return fileDescriptor_1b4a5877375e491e, []int{10}
 }
 func (m *InspectResp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -340,7 +687,7 @@ func (m *CatalogResp) Reset()         { *m = CatalogResp{} }
 func (m *CatalogResp) String() string { return proto.CompactTextString(m) }
 func (*CatalogResp) ProtoMessage()    {}
 func (*CatalogResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b4a5877375e491e, []int{6}
+	return fileDescriptor_1b4a5877375e491e, []int{11}
 }
 func (m *CatalogResp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -387,8 +734,13 @@ func init() {
 	proto.RegisterType((*AccessInfo)(nil), "db.AccessInfo")
 	proto.RegisterType((*CreateDatabaseReq)(nil), "db.CreateDatabaseReq")
 	proto.RegisterType((*FlushTable)(nil), "db.FlushTable")
+	proto.RegisterType((*MergeTable)(nil), "db.MergeTable")
 	proto.RegisterType((*Checkpoint)(nil), "db.Checkpoint")
 	proto.RegisterType((*InspectDN)(nil), "db.InspectDN")
+	proto.RegisterType((*Backup)(nil), "db.Backup")
+	proto.RegisterType((*RestoreReq)(nil), "db.RestoreReq")
+	proto.RegisterType((*Changefeed)(nil), "db.Changefeed")
+	proto.RegisterType((*MView)(nil), "db.MView")
 	proto.RegisterType((*InspectResp)(nil), "db.InspectResp")
 	proto.RegisterType((*CatalogResp)(nil), "db.CatalogResp")
 }
@@ -396,37 +748,53 @@ func init() {
 func init() { proto.RegisterFile("operations.proto", fileDescriptor_1b4a5877375e491e) }
 
 var fileDescriptor_1b4a5877375e491e = []byte{
-	// 475 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xed, 0xd8, 0xb4, 0xf2, 0x84, 0x00, 0xdd, 0x03, 0x32, 0xa8, 0x72, 0x42, 0x4f, 0xb9,
-	0xe0, 0x4a, 0x05, 0x09, 0x89, 0x5b, 0x13, 0x0b, 0xc9, 0x20, 0xfe, 0x68, 0x5b, 0xee, 0xec, 0x3a,
-	0x5b, 0xc7, 0xc2, 0xf1, 0xba, 0xd9, 0xf5, 0xa1, 0x57, 0x9e, 0x80, 0x47, 0xa0, 0x6f, 0xd3, 0x63,
-	0x8e, 0x9c, 0x22, 0x48, 0x5e, 0x80, 0x73, 0x4f, 0xc8, 0xe3, 0xb5, 0xe2, 0x5e, 0x7b, 0xdb, 0xef,
-	0x9b, 0xec, 0xfc, 0xbe, 0x99, 0x8d, 0xe1, 0x89, 0x2c, 0xc5, 0x92, 0xe9, 0x4c, 0x16, 0x2a, 0x2c,
-	0x97, 0x52, 0x4b, 0xd2, 0x9b, 0xf1, 0xe7, 0x2f, 0xd3, 0x4c, 0xcf, 0x2b, 0x1e, 0x26, 0x72, 0x71,
-	0x9c, 0xca, 0x54, 0x1e, 0x63, 0x89, 0x57, 0x17, 0xa8, 0x50, 0xe0, 0xa9, 0xb9, 0x72, 0xf4, 0x0d,
-	0xe0, 0x34, 0x49, 0x84, 0x52, 0x71, 0x71, 0x21, 0xc9, 0x21, 0x78, 0xa7, 0x49, 0x22, 0xab, 0x42,
-	0xc7, 0x91, 0x6f, 0x8f, 0xec, 0xf1, 0x80, 0xee, 0x0c, 0xf2, 0x14, 0xf6, 0xbe, 0x2a, 0xb1, 0x8c,
-	0x23, 0xbf, 0x87, 0x25, 0xa3, 0x6a, 0x9f, 0xca, 0x5c, 0xc4, 0x91, 0xef, 0x34, 0x7e, 0xa3, 0xde,
-	0xba, 0xff, 0xae, 0x87, 0xd6, 0xd1, 0xb5, 0x0d, 0x07, 0xd3, 0xa5, 0x60, 0x5a, 0x44, 0x4c, 0x33,
-	0xce, 0x94, 0xa0, 0xe2, 0x92, 0xbc, 0xee, 0x72, 0x11, 0xd5, 0x3f, 0x79, 0x14, 0xce, 0x78, 0xb8,
-	0x73, 0x27, 0xee, 0xcd, 0x7a, 0x68, 0xd1, 0x6e, 0x3e, 0x02, 0x6e, 0xc1, 0x16, 0x02, 0xf9, 0x1e,
-	0xc5, 0x73, 0x9d, 0xb9, 0x69, 0x7f, 0x76, 0x99, 0x63, 0x00, 0x8f, 0xee, 0x0c, 0x12, 0x00, 0xb4,
-	0xd8, 0x78, 0xe6, 0xbb, 0x23, 0x7b, 0xec, 0xd2, 0x8e, 0x63, 0x32, 0xfe, 0xb0, 0x01, 0xde, 0xe5,
-	0x95, 0x9a, 0x9f, 0x33, 0x9e, 0x8b, 0x7b, 0x86, 0xeb, 0xa2, 0x9a, 0x15, 0x75, 0x51, 0x11, 0xf1,
-	0x61, 0x1f, 0xdb, 0x9b, 0x3d, 0xb9, 0xb4, 0x95, 0x26, 0xc4, 0x07, 0x80, 0xe9, 0x5c, 0x24, 0xdf,
-	0x4b, 0x99, 0x15, 0x9a, 0xbc, 0x81, 0x01, 0x26, 0x8a, 0xaa, 0xe6, 0x8d, 0x31, 0x86, 0x33, 0x39,
-	0xb8, 0x5d, 0x0f, 0x07, 0x3a, 0x5b, 0x88, 0xb0, 0x2d, 0xd0, 0xbb, 0xbf, 0x33, 0xcd, 0x04, 0x78,
-	0x71, 0xa1, 0x4a, 0x91, 0xe8, 0xe8, 0xd3, 0x3d, 0xe7, 0x39, 0x04, 0xef, 0x73, 0xfb, 0x0f, 0x33,
-	0x1b, 0xdf, 0x19, 0x06, 0xc3, 0xa1, 0x6f, 0x30, 0x54, 0xa8, 0x92, 0x3c, 0x03, 0xe7, 0xfc, 0xaa,
-	0x44, 0xc2, 0x83, 0xc9, 0xfe, 0xed, 0x7a, 0xe8, 0x64, 0x85, 0xa6, 0xb5, 0x57, 0x4f, 0xff, 0x51,
-	0x28, 0xc5, 0xd2, 0xf6, 0xf5, 0x5a, 0x59, 0x57, 0xbe, 0xb0, 0xab, 0x5c, 0xb2, 0x19, 0xee, 0xe5,
-	0x21, 0x6d, 0xa5, 0x61, 0xbc, 0x87, 0xfe, 0x94, 0x69, 0x96, 0xcb, 0x14, 0x19, 0x04, 0xdc, 0x58,
-	0x8b, 0x05, 0x42, 0x3c, 0x8a, 0x67, 0xf2, 0x02, 0x9c, 0xb3, 0x8a, 0xfb, 0xbd, 0x91, 0x33, 0xee,
-	0x9f, 0x3c, 0xae, 0x27, 0xeb, 0xdc, 0xa0, 0x75, 0xad, 0xe9, 0x35, 0x19, 0xad, 0xfe, 0x06, 0xd6,
-	0xcd, 0x26, 0xb0, 0x57, 0x9b, 0xc0, 0xfe, 0xb3, 0x09, 0xac, 0x9f, 0xdb, 0xc0, 0xfa, 0xb5, 0x0d,
-	0xec, 0xd5, 0x36, 0xb0, 0x7e, 0x6f, 0x03, 0x8b, 0xef, 0xe1, 0x77, 0xf1, 0xea, 0x7f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xf3, 0x01, 0x85, 0x0a, 0x5e, 0x03, 0x00, 0x00,
+	// 726 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0x8e, 0xe3, 0xfc, 0x68, 0x5e, 0x68, 0x69, 0x4f, 0x08, 0x99, 0xaa, 0x72, 0x42, 0xa7, 0x2c,
+	0xa4, 0x52, 0x41, 0x42, 0x62, 0x6b, 0xe2, 0x22, 0x99, 0xaa, 0x3f, 0x74, 0x09, 0xcc, 0x9c, 0x9d,
+	0x4b, 0x6a, 0x25, 0xf1, 0xb9, 0xbe, 0xb3, 0x68, 0x57, 0xfe, 0x02, 0x56, 0xb6, 0xf6, 0x5f, 0x61,
+	0xea, 0xd8, 0x91, 0xa9, 0x82, 0x66, 0x47, 0xcc, 0x9d, 0xd0, 0x9d, 0xcf, 0x8d, 0x2b, 0xc1, 0x12,
+	0x90, 0xd8, 0xde, 0xf7, 0xde, 0xbb, 0xfb, 0xbe, 0xf7, 0xf9, 0x5d, 0x02, 0xab, 0x2c, 0xa2, 0x31,
+	0x11, 0x01, 0x0b, 0x79, 0x3b, 0x8a, 0x99, 0x60, 0xa8, 0x38, 0xf0, 0xd6, 0x9f, 0x8d, 0x02, 0x71,
+	0x9c, 0x78, 0x6d, 0x9f, 0x4d, 0xb7, 0x46, 0x6c, 0xc4, 0xb6, 0x54, 0xc9, 0x4b, 0x86, 0x0a, 0x29,
+	0xa0, 0xa2, 0xf4, 0xc8, 0xe6, 0x7b, 0x80, 0x1d, 0xdf, 0xa7, 0x9c, 0xbb, 0xe1, 0x90, 0xa1, 0x0d,
+	0xa8, 0xed, 0xf8, 0x3e, 0x4b, 0x42, 0xe1, 0x3a, 0x96, 0xd1, 0x34, 0x5a, 0xcb, 0x78, 0x9e, 0x40,
+	0x8f, 0xa1, 0xf2, 0x96, 0xd3, 0xd8, 0x75, 0xac, 0xa2, 0x2a, 0x69, 0x24, 0xf3, 0x98, 0x4d, 0xa8,
+	0xeb, 0x58, 0x66, 0x9a, 0x4f, 0xd1, 0xab, 0xd2, 0xcf, 0x8b, 0x46, 0x61, 0xf3, 0xc2, 0x80, 0xb5,
+	0x6e, 0x4c, 0x89, 0xa0, 0x0e, 0x11, 0xc4, 0x23, 0x9c, 0x62, 0x7a, 0x82, 0x5e, 0xe4, 0x79, 0x15,
+	0x55, 0x7d, 0x7b, 0xa5, 0x3d, 0xf0, 0xda, 0xf3, 0x6c, 0xa7, 0x74, 0x79, 0xdd, 0x28, 0xe0, 0xbc,
+	0x3e, 0x04, 0xa5, 0x90, 0x4c, 0xa9, 0xe2, 0xaf, 0x61, 0x15, 0x4b, 0xcd, 0xe9, 0xf5, 0xbd, 0x93,
+	0x89, 0x12, 0x50, 0xc3, 0xf3, 0x04, 0xb2, 0x01, 0x32, 0x5a, 0x77, 0x60, 0x95, 0x9a, 0x46, 0xab,
+	0x84, 0x73, 0x19, 0xad, 0xf1, 0xa3, 0x01, 0xf0, 0x7a, 0x92, 0xf0, 0xe3, 0x3e, 0xf1, 0x26, 0x74,
+	0x41, 0x71, 0x79, 0xaa, 0xd4, 0xa2, 0x3c, 0x95, 0x83, 0x2c, 0xa8, 0xaa, 0xeb, 0xb5, 0x4f, 0x25,
+	0x9c, 0xc1, 0x9c, 0x88, 0x7d, 0x1a, 0x8f, 0xe8, 0xff, 0x14, 0xb1, 0x07, 0xd0, 0x3d, 0xa6, 0xfe,
+	0x38, 0x62, 0x41, 0x28, 0xd0, 0x4b, 0x58, 0x56, 0xb6, 0x38, 0x49, 0xba, 0x68, 0x4a, 0x86, 0xd9,
+	0x59, 0xbb, 0xbd, 0x6e, 0x2c, 0x8b, 0x60, 0x4a, 0xdb, 0x59, 0x01, 0xdf, 0xef, 0xd3, 0x97, 0x51,
+	0xa8, 0xb9, 0x21, 0x8f, 0xa8, 0x2f, 0x9c, 0x83, 0x05, 0xe7, 0xd9, 0x80, 0xda, 0x61, 0xb6, 0xe6,
+	0xfa, 0xb3, 0xcf, 0x13, 0x9a, 0xe6, 0x8b, 0x01, 0x95, 0x0e, 0xf1, 0xc7, 0x49, 0xb4, 0x20, 0x89,
+	0x05, 0x55, 0x79, 0x9e, 0x86, 0x03, 0x4d, 0x91, 0x41, 0xb4, 0x0a, 0xa6, 0x13, 0xc4, 0x7a, 0xad,
+	0x64, 0x88, 0xd6, 0x61, 0x69, 0x37, 0x1c, 0x28, 0x7b, 0xd4, 0x3a, 0xd5, 0xf0, 0x1d, 0x96, 0x0f,
+	0xa1, 0x93, 0xf8, 0x63, 0x2a, 0xac, 0xb2, 0xaa, 0x68, 0x24, 0x87, 0xd8, 0xa3, 0x67, 0x47, 0x31,
+	0x1d, 0x06, 0xa7, 0x56, 0x25, 0x1d, 0xe2, 0x2e, 0xa1, 0x87, 0x38, 0x37, 0x00, 0x30, 0xe5, 0x82,
+	0xc5, 0x7f, 0xf1, 0x3e, 0x36, 0xc1, 0xec, 0xc5, 0xbe, 0x1a, 0xa2, 0xbe, 0x0d, 0xb2, 0x3d, 0xf5,
+	0x45, 0xb7, 0xca, 0xa2, 0xec, 0x71, 0xb8, 0xb0, 0xcc, 0x3f, 0xf5, 0x38, 0x5c, 0xa0, 0x15, 0x28,
+	0xf6, 0xb9, 0x1e, 0xaf, 0xd8, 0xe7, 0x5a, 0xe2, 0x0f, 0x43, 0x2e, 0x07, 0x09, 0x47, 0x74, 0x48,
+	0xe9, 0x60, 0x41, 0x89, 0x2b, 0x50, 0x3c, 0x8c, 0xb4, 0xcd, 0xc5, 0xc3, 0x48, 0x3e, 0xe9, 0x03,
+	0xf9, 0xa4, 0x53, 0x8b, 0x55, 0x2c, 0x7d, 0x54, 0x5b, 0x29, 0x25, 0x98, 0xd2, 0xc7, 0x14, 0xc9,
+	0xde, 0x5e, 0x10, 0x8e, 0xb5, 0xbb, 0x2a, 0x96, 0xbd, 0xdd, 0x24, 0xe6, 0x2c, 0xd6, 0xc6, 0x6a,
+	0x84, 0x9a, 0x50, 0x77, 0x87, 0x07, 0x4c, 0xec, 0x9e, 0x06, 0x5c, 0x70, 0xab, 0xda, 0x34, 0x5a,
+	0x4b, 0x38, 0x9f, 0x92, 0x5f, 0xd2, 0x1d, 0xea, 0xf2, 0x92, 0x2a, 0xdf, 0x61, 0x3d, 0xf0, 0x67,
+	0x03, 0xca, 0xfb, 0xef, 0x02, 0xfa, 0xe1, 0x1f, 0xcd, 0xfa, 0x08, 0xca, 0x3d, 0x41, 0x44, 0x36,
+	0x6c, 0x0a, 0xd4, 0x54, 0x11, 0xf5, 0xb5, 0xdd, 0x2a, 0xbe, 0xa7, 0xad, 0xfc, 0x5b, 0x6d, 0x1e,
+	0xd4, 0xf5, 0xdb, 0xc2, 0x94, 0x47, 0xe8, 0x09, 0x98, 0xfd, 0xb3, 0x48, 0x29, 0x2b, 0x77, 0xaa,
+	0xb7, 0xd7, 0x0d, 0x33, 0x08, 0x05, 0x96, 0x39, 0xb9, 0xdd, 0xfb, 0x94, 0x73, 0x32, 0xca, 0x7e,
+	0x37, 0x33, 0x28, 0x2b, 0x47, 0xe4, 0x6c, 0xc2, 0xc8, 0x40, 0x29, 0x7a, 0x80, 0x33, 0xa8, 0x39,
+	0xde, 0x40, 0xbd, 0x4b, 0x04, 0x99, 0xb0, 0x91, 0xe2, 0x40, 0x50, 0x72, 0x05, 0x9d, 0x2a, 0x92,
+	0x1a, 0x56, 0x31, 0x7a, 0x0a, 0x66, 0x2f, 0xf1, 0xac, 0x62, 0xd3, 0x6c, 0xd5, 0xb7, 0x1f, 0x4a,
+	0x47, 0x72, 0x27, 0xb0, 0xac, 0xa5, 0x77, 0x75, 0x9a, 0x57, 0xdf, 0xed, 0xc2, 0xe5, 0x8d, 0x6d,
+	0x5c, 0xdd, 0xd8, 0xc6, 0xb7, 0x1b, 0xbb, 0xf0, 0x69, 0x66, 0x17, 0xce, 0x67, 0xb6, 0x71, 0x35,
+	0xb3, 0x0b, 0x5f, 0x67, 0x76, 0xc1, 0xab, 0xa8, 0x7f, 0xa4, 0xe7, 0xbf, 0x06, 0x00, 0x80, 0xc6,
+	0xc6, 0xd9, 0xd8, 0x06, 0x00, 0x00,
 }
 
 func (m *AccessInfo) Marshal() (dAtA []byte, err error) {
@@ -562,7 +930,7 @@ func (m *FlushTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Checkpoint) Marshal() (dAtA []byte, err error) {
+func (m *MergeTable) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -572,32 +940,75 @@ func (m *Checkpoint) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Checkpoint) MarshalTo(dAtA []byte) (int, error) {
+func (m *MergeTable) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Checkpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MergeTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.FlushDuration != 0 {
-		i = encodeVarintOperations(dAtA, i, uint64(m.FlushDuration))
+	if m.TableID != 0 {
+		i = encodeVarintOperations(dAtA, i, uint64(m.TableID))
 		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *InspectDN) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0x18
 	}
-	return dAtA[:n], nil
+	if m.DatabaseID != 0 {
+		i = encodeVarintOperations(dAtA, i, uint64(m.DatabaseID))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.AccessInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOperations(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Checkpoint) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Checkpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Checkpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FlushDuration != 0 {
+		i = encodeVarintOperations(dAtA, i, uint64(m.FlushDuration))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InspectDN) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
 func (m *InspectDN) MarshalTo(dAtA []byte) (int, error) {
@@ -630,6 +1041,288 @@ func (m *InspectDN) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Backup) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Backup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Backup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyPrefix) > 0 {
+		i -= len(m.KeyPrefix)
+		copy(dAtA[i:], m.KeyPrefix)
+		i = encodeVarintOperations(dAtA, i, uint64(len(m.KeyPrefix)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Bucket) > 0 {
+		i -= len(m.Bucket)
+		copy(dAtA[i:], m.Bucket)
+		i = encodeVarintOperations(dAtA, i, uint64(len(m.Bucket)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Endpoint) > 0 {
+		i -= len(m.Endpoint)
+		copy(dAtA[i:], m.Endpoint)
+		i = encodeVarintOperations(dAtA, i, uint64(len(m.Endpoint)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Dir) > 0 {
+		i -= len(m.Dir)
+		copy(dAtA[i:], m.Dir)
+		i = encodeVarintOperations(dAtA, i, uint64(len(m.Dir)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Backend) > 0 {
+		i -= len(m.Backend)
+		copy(dAtA[i:], m.Backend)
+		i = encodeVarintOperations(dAtA, i, uint64(len(m.Backend)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.AccessInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOperations(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RestoreReq) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ts) > 0 {
+		i -= len(m.Ts)
+		copy(dAtA[i:], m.Ts)
+		i = encodeVarintOperations(dAtA, i, uint64(len(m.Ts)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Dst.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOperations(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Src.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOperations(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.AccessInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOperations(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Changefeed) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Changefeed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Changefeed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IfExists {
+		i--
+		if m.IfExists {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.IfNotExists {
+		i--
+		if m.IfNotExists {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintOperations(dAtA, i, uint64(len(m.Cursor)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Sink) > 0 {
+		i -= len(m.Sink)
+		copy(dAtA[i:], m.Sink)
+		i = encodeVarintOperations(dAtA, i, uint64(len(m.Sink)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Tables) > 0 {
+		for iNdEx := len(m.Tables) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tables[iNdEx])
+			copy(dAtA[i:], m.Tables[iNdEx])
+			i = encodeVarintOperations(dAtA, i, uint64(len(m.Tables[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintOperations(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Op) > 0 {
+		i -= len(m.Op)
+		copy(dAtA[i:], m.Op)
+		i = encodeVarintOperations(dAtA, i, uint64(len(m.Op)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.AccessInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOperations(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MView) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MView) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MView) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IfExists {
+		i--
+		if m.IfExists {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Spec) > 0 {
+		i -= len(m.Spec)
+		copy(dAtA[i:], m.Spec)
+		i = encodeVarintOperations(dAtA, i, uint64(len(m.Spec)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintOperations(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Op) > 0 {
+		i -= len(m.Op)
+		copy(dAtA[i:], m.Op)
+		i = encodeVarintOperations(dAtA, i, uint64(len(m.Op)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.AccessInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOperations(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *InspectResp) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -784,30 +1477,162 @@ func (m *FlushTable) ProtoSize() (n int) {
 	return n
 }
 
-func (m *Checkpoint) ProtoSize() (n int) {
+func (m *MergeTable) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.FlushDuration != 0 {
-		n += 1 + sovOperations(uint64(m.FlushDuration))
+	l = m.AccessInfo.ProtoSize()
+	n += 1 + l + sovOperations(uint64(l))
+	if m.DatabaseID != 0 {
+		n += 1 + sovOperations(uint64(m.DatabaseID))
+	}
+	if m.TableID != 0 {
+		n += 1 + sovOperations(uint64(m.TableID))
 	}
 	return n
 }
 
-func (m *InspectDN) ProtoSize() (n int) {
+func (m *Checkpoint) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.AccessInfo.ProtoSize()
-	n += 1 + l + sovOperations(uint64(l))
-	l = len(m.Operation)
+	if m.FlushDuration != 0 {
+		n += 1 + sovOperations(uint64(m.FlushDuration))
+	}
+	return n
+}
+
+func (m *InspectDN) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AccessInfo.ProtoSize()
+	n += 1 + l + sovOperations(uint64(l))
+	l = len(m.Operation)
+	if l > 0 {
+		n += 1 + l + sovOperations(uint64(l))
+	}
+	return n
+}
+
+func (m *Backup) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AccessInfo.ProtoSize()
+	n += 1 + l + sovOperations(uint64(l))
+	l = len(m.Backend)
+	if l > 0 {
+		n += 1 + l + sovOperations(uint64(l))
+	}
+	l = len(m.Dir)
+	if l > 0 {
+		n += 1 + l + sovOperations(uint64(l))
+	}
+	l = len(m.Endpoint)
+	if l > 0 {
+		n += 1 + l + sovOperations(uint64(l))
+	}
+	l = len(m.Bucket)
+	if l > 0 {
+		n += 1 + l + sovOperations(uint64(l))
+	}
+	l = len(m.KeyPrefix)
+	if l > 0 {
+		n += 1 + l + sovOperations(uint64(l))
+	}
+	return n
+}
+
+func (m *RestoreReq) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AccessInfo.ProtoSize()
+	n += 1 + l + sovOperations(uint64(l))
+	l = m.Src.ProtoSize()
+	n += 1 + l + sovOperations(uint64(l))
+	l = m.Dst.ProtoSize()
+	n += 1 + l + sovOperations(uint64(l))
+	l = len(m.Ts)
+	if l > 0 {
+		n += 1 + l + sovOperations(uint64(l))
+	}
+	return n
+}
+
+func (m *Changefeed) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AccessInfo.ProtoSize()
+	n += 1 + l + sovOperations(uint64(l))
+	l = len(m.Op)
+	if l > 0 {
+		n += 1 + l + sovOperations(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovOperations(uint64(l))
+	}
+	if len(m.Tables) > 0 {
+		for _, s := range m.Tables {
+			l = len(s)
+			n += 1 + l + sovOperations(uint64(l))
+		}
+	}
+	l = len(m.Sink)
+	if l > 0 {
+		n += 1 + l + sovOperations(uint64(l))
+	}
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovOperations(uint64(l))
+	}
+	if m.IfNotExists {
+		n += 2
+	}
+	if m.IfExists {
+		n += 2
+	}
+	return n
+}
+
+func (m *MView) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AccessInfo.ProtoSize()
+	n += 1 + l + sovOperations(uint64(l))
+	l = len(m.Op)
 	if l > 0 {
 		n += 1 + l + sovOperations(uint64(l))
 	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovOperations(uint64(l))
+	}
+	l = len(m.Spec)
+	if l > 0 {
+		n += 1 + l + sovOperations(uint64(l))
+	}
+	if m.IfExists {
+		n += 2
+	}
 	return n
 }
 
@@ -927,7 +1752,1010 @@ func (m *AccessInfo) Unmarshal(dAtA []byte) error {
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RoleID", wireType)
 			}
-			m.RoleID = 0
+			m.RoleID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoleID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOperations(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOperations
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateDatabaseReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOperations
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateDatabaseReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateDatabaseReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOperations
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOperations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccessInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOperations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOperations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateSql", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOperations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOperations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreateSql = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseId", wireType)
+			}
+			m.DatabaseId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DatabaseId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOperations(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOperations
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FlushTable) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOperations
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FlushTable: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FlushTable: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOperations
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOperations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccessInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseID", wireType)
+			}
+			m.DatabaseID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DatabaseID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableID", wireType)
+			}
+			m.TableID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TableID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOperations(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOperations
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MergeTable) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOperations
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergeTable: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergeTable: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOperations
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOperations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccessInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseID", wireType)
+			}
+			m.DatabaseID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DatabaseID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableID", wireType)
+			}
+			m.TableID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TableID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOperations(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOperations
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Checkpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOperations
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Checkpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Checkpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlushDuration", wireType)
+			}
+			m.FlushDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FlushDuration |= time.Duration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOperations(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOperations
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InspectDN) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOperations
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InspectDN: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InspectDN: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOperations
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOperations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccessInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOperations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOperations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOperations(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOperations
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Backup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOperations
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Backup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Backup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOperations
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOperations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccessInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOperations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOperations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Backend = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOperations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOperations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOperations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOperations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOperations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOperations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bucket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOperations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOperations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOperations(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOperations
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestoreReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOperations
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestoreReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestoreReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOperations
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOperations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccessInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Src", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOperations
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOperations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Src.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dst", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOperations
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOperations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Dst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOperations
@@ -937,11 +2765,24 @@ func (m *AccessInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RoleID |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOperations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOperations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ts = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOperations(dAtA[iNdEx:])
@@ -963,7 +2804,7 @@ func (m *AccessInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateDatabaseReq) Unmarshal(dAtA []byte) error {
+func (m *Changefeed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -986,10 +2827,10 @@ func (m *CreateDatabaseReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateDatabaseReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: Changefeed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateDatabaseReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Changefeed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1027,7 +2868,7 @@ func (m *CreateDatabaseReq) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1055,11 +2896,11 @@ func (m *CreateDatabaseReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Op = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateSql", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1087,13 +2928,13 @@ func (m *CreateDatabaseReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreateSql = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tables", wireType)
 			}
-			m.DatabaseId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOperations
@@ -1103,66 +2944,61 @@ func (m *CreateDatabaseReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DatabaseId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOperations(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOperations
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthOperations
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FlushTable) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOperations
+			m.Tables = append(m.Tables, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sink", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOperations
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FlushTable: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FlushTable: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOperations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sink = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOperations
@@ -1172,30 +3008,29 @@ func (m *FlushTable) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOperations
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOperations
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AccessInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Cursor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IfNotExists", wireType)
 			}
-			m.DatabaseID = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOperations
@@ -1205,16 +3040,17 @@ func (m *FlushTable) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DatabaseID |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			m.IfNotExists = bool(v != 0)
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IfExists", wireType)
 			}
-			m.TableID = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOperations
@@ -1224,11 +3060,12 @@ func (m *FlushTable) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TableID |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.IfExists = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOperations(dAtA[iNdEx:])
@@ -1250,7 +3087,7 @@ func (m *FlushTable) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Checkpoint) Unmarshal(dAtA []byte) error {
+func (m *MView) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1273,17 +3110,17 @@ func (m *Checkpoint) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Checkpoint: wiretype end group for non-group")
+			return fmt.Errorf("proto: MView: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Checkpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MView: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FlushDuration", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessInfo", wireType)
 			}
-			m.FlushDuration = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOperations
@@ -1293,66 +3130,62 @@ func (m *Checkpoint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FlushDuration |= time.Duration(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOperations(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthOperations
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthOperations
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InspectDN) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOperations
+			if err := m.AccessInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InspectDN: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectDN: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOperations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOperations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Op = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOperations
@@ -1362,28 +3195,27 @@ func (m *InspectDN) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOperations
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOperations
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AccessInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.State = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1411,8 +3243,28 @@ func (m *InspectDN) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operation = string(dAtA[iNdEx:postIndex])
+			m.Spec = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IfExists", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IfExists = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOperations(dAtA[iNdEx:])
@@ -16,6 +16,7 @@ package db
 
 import (
 	"bytes"
+	"context"
 
 	//"fmt"
 
@@ -26,7 +27,9 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/entry"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/store"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnimpl"
@@ -137,3 +140,97 @@ func (replayer *Replayer) OnReplayTxn(cmd txnif.TxnCmd, walIdx *wal.Index, lsn u
 		}
 	}
 }
+
+const restoreBatchSize = 1000
+
+// restoreArchivedWal appends the txn entries archived in the recovery archive into
+// the empty WAL of the DB, up to the target timestamp or LSN of the recovery. They
+// are replayed on top of the checkpoints later like the entries never archived,
+// and the ones committed before the checkpoints are skipped by the replayer.
+func restoreArchivedWal(ctx context.Context, dirname string, opts *options.Options) (restored int, err error) {
+	cfg := opts.RecoveryCfg
+	driver := newWalDriver(dirname, opts, nil)
+	defer func() {
+		if closeErr := driver.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+	replayed := 0
+	if err = driver.Replay(func(uint32, uint64, []byte, uint16, any) {
+		replayed++
+	}); err != nil {
+		return
+	}
+	if replayed > 0 {
+		return 0, moerr.NewInternalError(ctx, "point-in-time recovery needs an empty WAL")
+	}
+
+	pending := make([]entry.Entry, 0, restoreBatchSize)
+	waitEntries := func() error {
+		for _, e := range pending {
+			if err := e.WaitDone(); err != nil {
+				return err
+			}
+			e.Free()
+		}
+		pending = pending[:0]
+		return nil
+	}
+	var appendErr error
+	if err = store.ReplayArchive(ctx, cfg.Archive, func(group uint32, lsn uint64, typ uint16, payload []byte) bool {
+		if group != wal.GroupPrepare && group != wal.GroupC {
+			return true
+		}
+		// the LSNs of different groups are allocated separately
+		if group == wal.GroupPrepare && cfg.TargetLSN != 0 && lsn > cfg.TargetLSN {
+			return false
+		}
+		var ts types.TS
+		if ts, appendErr = archivedTxnTS(payload); appendErr != nil {
+			return false
+		}
+		if !cfg.TargetTS.IsEmpty() && ts.Greater(cfg.TargetTS) {
+			return true
+		}
+		e := entry.GetBase()
+		e.SetType(typ)
+		if appendErr = e.SetPayload(payload); appendErr != nil {
+			return false
+		}
+		e.SetInfo(&entry.Info{Group: group})
+		if _, appendErr = driver.AppendEntry(group, e); appendErr != nil {
+			return false
+		}
+		pending = append(pending, e)
+		restored++
+		if len(pending) >= restoreBatchSize {
+			appendErr = waitEntries()
+		}
+		return appendErr == nil
+	}); err != nil {
+		return
+	}
+	if err = appendErr; err != nil {
+		return
+	}
+	err = waitEntries()
+	return
+}
+
+// archivedTxnTS returns the commit timestamp of the txn in the archived entry
+func archivedTxnTS(payload []byte) (ts types.TS, err error) {
+	cmd, _, err := txnbase.BuildCommandFrom(bytes.NewBuffer(payload))
+	if err != nil {
+		return
+	}
+	defer cmd.Close()
+	switch txnCmd := cmd.(type) {
+	case *txnbase.TxnCmd:
+		ts = txnCmd.PrepareTS
+	case *txnbase.TxnStateCmd:
+		ts = txnCmd.CommitTs
+	default:
+		err = moerr.NewInternalErrorNoCtx("unexpected archived txn command %T", cmd)
+	}
+	return
+}
@@ -15,9 +15,11 @@
 package db
 
 import (
+	"bytes"
 	"container/heap"
 	"fmt"
 	"sort"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -174,18 +176,8 @@ func (d *deletableSegBuilder) finish() []*catalog.SegmentEntry {
 	return ret
 }
 
-type stat struct {
-	ttl          time.Time
-	lastTotalRow int
-}
-
-func (st *stat) String() string {
-	return fmt.Sprintf("row%d[%s]", st.lastTotalRow, st.ttl)
-}
-
-// mergeLimiter consider update rate and time to decide to merge or not.
+// mergeLimiter limits the concurrency of big merge tasks.
 type mergeLimiter struct {
-	stats                map[uint64]*stat
 	concurrentMergeLimit int32
 	activeMergeCount     int32
 }
@@ -198,92 +190,51 @@ func (ml *mergeLimiter) OnExecDone(_ any) {
 	atomic.AddInt32(&ml.activeMergeCount, -1)
 }
 
-// merge immediately if it has enough rows, skip if:
-// 1. has only a few rows or blocks
-// 2. is actively updating, which means total rows changes obviously compared with last time
-// in other cases, wait some time to merge
-func (ml *mergeLimiter) canMerge(tid uint64, totalRow int, blks int) bool {
-	if atomic.LoadInt32(&ml.activeMergeCount) >= ml.concurrentMergeLimit {
-		return false
-	}
-	if totalRow > constMergeRightNow {
-		logutil.Infof("Mergeblocks %d merge right now: %d rows %d blks", tid, totalRow, blks)
-		delete(ml.stats, tid)
-		return true
-	}
-	if blks < constMergeMinBlks || totalRow < constMergeMinRows {
-		return false
-	}
-
-	if st, ok := ml.stats[tid]; !ok {
-		ml.stats[tid] = &stat{
-			ttl:          ml.ttl(totalRow),
-			lastTotalRow: totalRow,
-		}
-		return false
-	} else if d := totalRow - st.lastTotalRow; d > 5 || d < -5 {
-		// a lot of things happened in the past scan interval...
-		st.ttl = ml.ttl(totalRow)
-		st.lastTotalRow = totalRow
-		logutil.Infof("Mergeblocks delta %d on table %d, resched to %v", d, tid, st.ttl)
-		return false
-	} else {
-		// this table is quiet finally, check ttl
-		return st.ttl.Before(time.Now())
-	}
-}
-
-func (ml *mergeLimiter) ttl(totalRow int) time.Time {
-	return time.Now().Add(time.Duration(
-		(float32(constMergeWaitDuration) / float32(constMergeRightNow)) *
-			(float32(constMergeRightNow) - float32(totalRow))))
+func (ml *mergeLimiter) canMerge() bool {
+	return atomic.LoadInt32(&ml.activeMergeCount) < ml.concurrentMergeLimit
 }
 
-// prune old stat entry
-func (ml *mergeLimiter) pruneStale() {
-	staleIds := make([]uint64, 0)
-	t := time.Now().Add(-10 * time.Minute)
-	for id, st := range ml.stats {
-		if st.ttl.Before(t) {
-			staleIds = append(staleIds, id)
-		}
-	}
-	for _, id := range staleIds {
-		delete(ml.stats, id)
-	}
-}
-
-func (ml *mergeLimiter) String() string {
-	return fmt.Sprintf("%v", ml.stats)
+// tablePolicy is the merge policy of a table, which is rebuilt if the merge
+// properties of the table are changed.
+type tablePolicy struct {
+	mergePolicy string
+	policy      MergePolicy
+	lastScan    time.Time
 }
 
 type MergeTaskBuilder struct {
 	db *DB
 	*catalog.LoopProcessor
-	runCnt      int
-	tableRowCnt int
-	tid         uint64
-	limiter     *mergeLimiter
-	segBuilder  *deletableSegBuilder
-	blkBuilder  *mergedBlkBuilder
+	runCnt     int
+	tid        uint64
+	force      bool
+	policy     MergePolicy
+	policies   map[uint64]*tablePolicy
+	limiter    *mergeLimiter
+	segBuilder *deletableSegBuilder
+
+	// ttl of the current table, the blocks whose rows are all expired are dropped
+	ttl         *catalog.TTL
+	expired     func(v any) bool
+	expiredBlks []*catalog.BlockEntry
+
+	forcedMu sync.Mutex
+	forced   map[uint64]struct{}
 }
 
 func newMergeTaskBuiler(db *DB) *MergeTaskBuilder {
 	op := &MergeTaskBuilder{
 		db:            db,
 		LoopProcessor: new(catalog.LoopProcessor),
+		policies:      make(map[uint64]*tablePolicy),
 		limiter: &mergeLimiter{
-			stats:                make(map[uint64]*stat),
 			concurrentMergeLimit: 1,
 		},
 		segBuilder: &deletableSegBuilder{
 			segCandids:  make([]*catalog.SegmentEntry, 0),
 			nsegCandids: make([]*catalog.SegmentEntry, 0),
 		},
-		blkBuilder: &mergedBlkBuilder{
-			blocks: make(itemSet, 0, constHeapCapacity),
-			cap:    constHeapCapacity,
-		},
+		forced: make(map[uint64]struct{}),
 	}
 
 	op.TableFn = op.onTable
@@ -293,16 +244,96 @@ func newMergeTaskBuiler(db *DB) *MergeTaskBuilder {
 	return op
 }
 
+// ForceMerge makes the table merge its blocks in the next scan round, regardless
+// of the conditions of its merge policy.
+func (s *MergeTaskBuilder) ForceMerge(tid uint64) {
+	s.forcedMu.Lock()
+	defer s.forcedMu.Unlock()
+	s.forced[tid] = struct{}{}
+}
+
+func (s *MergeTaskBuilder) takeForced(tid uint64) bool {
+	s.forcedMu.Lock()
+	defer s.forcedMu.Unlock()
+	_, ok := s.forced[tid]
+	delete(s.forced, tid)
+	return ok
+}
+
+func (s *MergeTaskBuilder) getPolicy(tableEntry *catalog.TableEntry) MergePolicy {
+	mergePolicy := tableEntry.GetLatestCommittedSchema().MergePolicy
+	tp, ok := s.policies[tableEntry.ID]
+	if !ok || tp.mergePolicy != mergePolicy {
+		policy, err := NewMergePolicy(mergePolicy)
+		if err != nil {
+			logutil.Warnf("Mergeblocks invalid merge policy %q of table %d: %v", mergePolicy, tableEntry.ID, err)
+			policy, _ = NewMergePolicy("")
+		}
+		tp = &tablePolicy{
+			mergePolicy: mergePolicy,
+			policy:      policy,
+		}
+		s.policies[tableEntry.ID] = tp
+	}
+	tp.lastScan = time.Now()
+	return tp.policy
+}
+
+// prune the policies of the tables not scanned for a while
+func (s *MergeTaskBuilder) pruneStale() {
+	t := time.Now().Add(-10 * time.Minute)
+	for id, tp := range s.policies {
+		if tp.lastScan.Before(t) {
+			delete(s.policies, id)
+		}
+	}
+}
+
+func (s *MergeTaskBuilder) policyString() string {
+	var w bytes.Buffer
+	for id, tp := range s.policies {
+		_, _ = w.WriteString(fmt.Sprintf("%d:%s ", id, tp.policy.String()))
+	}
+	return w.String()
+}
+
+func (s *MergeTaskBuilder) trySchedDelExpiredTask() {
+	if len(s.expiredBlks) == 0 {
+		return
+	}
+	delBlks := make([]*catalog.BlockEntry, len(s.expiredBlks))
+	copy(delBlks, s.expiredBlks)
+	scopes := make([]common.ID, len(delBlks))
+	for i, blk := range delBlks {
+		scopes[i] = *blk.AsCommonID()
+	}
+	factory := func(ctx *tasks.Context, txn txnif.AsyncTxn) (tasks.Task, error) {
+		return jobs.NewDelExpiredBlkTask(ctx, txn, delBlks), nil
+	}
+	_, err := s.db.Scheduler.ScheduleMultiScopedTxnTask(nil, tasks.DataCompactionTask, scopes, factory)
+	if err != nil {
+		if err != tasks.ErrScheduleScopeConflict {
+			logutil.Infof("[Mergeblocks] Schedule del expired blk errinfo=%v", err)
+		}
+		return
+	}
+	logutil.Infof("[Mergeblocks] Scheduled | del %d expired blk", len(delBlks))
+}
+
 func (s *MergeTaskBuilder) trySchedMergeTask() {
 	if s.tid == 0 {
 		return
 	}
+	s.trySchedDelExpiredTask()
 	// compactable blks
-	mergedBlks := s.blkBuilder.finish()
+	var mergedBlks []*catalog.BlockEntry
+	if s.force || s.limiter.canMerge() {
+		mergedBlks = s.policy.Revise(s.force)
+	}
 	// deletable segs
 	mergedSegs := s.segBuilder.finish()
 	hasDelSeg := len(mergedSegs) > 0
-	hasMergeBlk := s.limiter.canMerge(s.tid, s.tableRowCnt, len(mergedBlks))
+	hasMergeBlk := len(mergedBlks) > 0
 	if !hasDelSeg && !hasMergeBlk {
 		return
 	}
@@ -345,29 +376,45 @@ func (s *MergeTaskBuilder) trySchedMergeTask() {
 			s.limiter.IncActiveCount()
 			task.AddObserver(s.limiter)
 		}
+		n := len(scopes)
+		if n > constMergeMinBlks {
+			n = constMergeMinBlks
+		}
 		logutil.Infof("[Mergeblocks] Scheduled | Scopes=[%d],[%d]%s",
 			len(segScopes), len(scopes),
-			common.BlockIDArraryString(scopes[:constMergeMinBlks]))
+			common.BlockIDArraryString(scopes[:n]))
 	}
 }
 
-func (s *MergeTaskBuilder) resetForTable(tid uint64) {
-	s.tableRowCnt = 0
-	s.tid = tid
+func (s *MergeTaskBuilder) resetForTable(entry *catalog.TableEntry) {
+	s.tid = 0
+	s.force = false
+	s.policy = nil
+	s.ttl = nil
+	s.expired = nil
+	s.expiredBlks = s.expiredBlks[:0]
+	if entry != nil {
+		s.tid = entry.ID
+		s.force = s.takeForced(entry.ID)
+		s.policy = s.getPolicy(entry)
+		s.policy.ResetForTable(entry)
+		if s.ttl = entry.GetLatestCommittedSchema().GetTTL(); s.ttl != nil {
+			s.expired = s.ttl.ExpiredAt(time.Now())
+		}
+	}
 	s.segBuilder.reset()
-	s.blkBuilder.reset()
 }
 
 func (s *MergeTaskBuilder) PreExecute() error {
 	// clean stale stats for every 10min (default)
 	if s.runCnt++; s.runCnt >= 120 {
 		s.runCnt = 0
-		s.limiter.pruneStale()
+		s.pruneStale()
 	}
 
 	// print stats for every 50s (default)
 	if s.runCnt%10 == 0 {
-		logutil.Infof("Mergeblocks stats: %s", s.limiter.String())
+		logutil.Infof("Mergeblocks stats: %s", s.policyString())
 	}
 
 	if s.runCnt%5 == 0 {
@@ -384,7 +431,7 @@ func (s *MergeTaskBuilder) PreExecute() error {
 }
 func (s *MergeTaskBuilder) PostExecute() error {
 	s.trySchedMergeTask()
-	s.resetForTable(0)
+	s.resetForTable(nil)
 	if cnt := atomic.LoadInt32(&s.limiter.activeMergeCount); cnt > 0 {
 		logutil.Infof("Mergeblocks current big active task: %d", cnt)
 	}
@@ -393,15 +440,21 @@ func (s *MergeTaskBuilder) PostExecute() error {
 
 func (s *MergeTaskBuilder) onTable(tableEntry *catalog.TableEntry) (err error) {
 	s.trySchedMergeTask()
-	s.resetForTable(tableEntry.ID)
 	if !tableEntry.IsActive() {
+		s.resetForTable(nil)
 		err = moerr.GetOkStopCurrRecur()
+		return
 	}
+	s.resetForTable(tableEntry)
 	return
 }
 
 func (s *MergeTaskBuilder) onSegment(segmentEntry *catalog.SegmentEntry) (err error) {
-	if !segmentEntry.IsActive() || (!segmentEntry.IsAppendable() && segmentEntry.IsSorted()) {
+	if !segmentEntry.IsActive() {
+		return moerr.GetOkStopCurrRecur()
+	}
+	// the merged segments are visited only to find out the expired blocks
+	if isMergedSegment(segmentEntry) && s.ttl == nil {
 		return moerr.GetOkStopCurrRecur()
 	}
 	// handle appendable segs
@@ -431,10 +484,41 @@ func (s *MergeTaskBuilder) onBlock(entry *catalog.BlockEntry) (err error) {
 		return
 	}
 
+	// the block data is read without the lock of the entry
 	entry.RUnlock()
-	rows := entry.GetBlockData().Rows()
-	entry.RLock()
-	s.tableRowCnt += rows
-	s.blkBuilder.push(&mItem{row: rows, entry: entry})
+	defer entry.RLock()
+	if s.isExpiredBlock(entry) {
+		s.expiredBlks = append(s.expiredBlks, entry)
+		return nil
+	}
+	if isMergedSegment(entry.GetSegment()) {
+		return nil
+	}
+	s.policy.OnBlock(entry, entry.GetBlockData().Rows())
 	return nil
 }
+
+// isExpiredBlock checks whether all rows of the block are expired by the max
+// value of the TTL column in the zonemap.
+func (s *MergeTaskBuilder) isExpiredBlock(entry *catalog.BlockEntry) bool {
+	if s.ttl == nil {
+		return false
+	}
+	idx := entry.GetSchema().GetColIdx(s.ttl.Def.Name)
+	if idx < 0 {
+		return false
+	}
+	zm, err := entry.GetBlockData().GetColumnZoneMap(idx)
+	if err != nil {
+		logutil.Warnf("Mergeblocks load zonemap of %s: %v", entry.ID.String(), err)
+		return false
+	}
+	if zm == nil || !zm.IsInited() || zm.GetType() != s.ttl.Def.Type.Oid {
+		return false
+	}
+	return s.expired(zm.GetMax())
+}
+
+func isMergedSegment(entry *catalog.SegmentEntry) bool {
+	return !entry.IsAppendable() && entry.IsSorted()
+}
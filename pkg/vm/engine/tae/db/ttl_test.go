@@ -0,0 +1,148 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mockTTLSchema(t *testing.T, col string, interval time.Duration) *catalog.Schema {
+	schema := catalog.MockSchemaAll(15, 2)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	c := new(engine.ConstraintDef)
+	require.NoError(t, c.UnmarshalBinary(schema.Constraint))
+	c.Cts = append(c.Cts, &engine.TTLDef{
+		Ttl: &plan.TTLDef{Col: col, Interval: int64(interval / time.Second)},
+	})
+	var err error
+	schema.Constraint, err = c.MarshalBinary()
+	require.NoError(t, err)
+	ttl := schema.GetTTL()
+	require.NotNil(t, ttl)
+	require.Equal(t, col, ttl.Def.Name)
+	require.Equal(t, interval, ttl.Interval)
+	return schema
+}
+
+func (e *testEngine) collectNonAppendableBlocks() (blks []*catalog.BlockEntry, ok bool) {
+	txn, rel := e.getRelation()
+	defer func() { assert.NoError(e.t, txn.Commit()) }()
+	it := rel.MakeBlockIt()
+	for it.Valid() {
+		blk := it.GetBlock().GetMeta().(*catalog.BlockEntry)
+		if blk.IsAppendable() {
+			return nil, false
+		}
+		blks = append(blks, blk)
+		it.Next()
+	}
+	return blks, true
+}
+
+func TestTTLDropExpiredBlocks(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	testutils.EnsureNoLeak(t)
+	opts := config.WithQuickScanAndCKPOpts(nil)
+	tae := newTestEngine(t, opts)
+	defer tae.Close()
+	schema := mockTTLSchema(t, "mock_14", time.Hour)
+	tae.bindSchema(schema)
+
+	// the rows of the first two blocks are expired
+	bat := catalog.MockBatch(schema, 30)
+	defer bat.Close()
+	old := types.UnixMicroToTimestamp(time.Now().Add(-2 * time.Hour).UnixMicro())
+	fresh := types.UnixMicroToTimestamp(time.Now().UnixMicro())
+	for i := 0; i < 30; i++ {
+		v := old
+		if i >= 20 {
+			v = fresh
+		}
+		bat.Vecs[14].Update(i, v, false)
+	}
+	tae.createRelAndAppend(bat, true)
+	tae.compactBlocks(true)
+
+	var blks []*catalog.BlockEntry
+	testutils.WaitExpect(4000, func() bool {
+		var ok bool
+		blks, ok = tae.collectNonAppendableBlocks()
+		return ok && len(blks) == 1
+	})
+	require.Equal(t, 1, len(blks))
+	tae.checkRowsByScan(10, true)
+}
+
+func TestTTLMergeExpiredRows(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	testutils.EnsureNoLeak(t)
+	opts := config.WithQuickScanAndCKPOpts(nil)
+	tae := newTestEngine(t, opts)
+	defer tae.Close()
+	schema := mockTTLSchema(t, "mock_11", time.Hour)
+	tae.bindSchema(schema)
+
+	// every other row is expired
+	bat := catalog.MockBatch(schema, 30)
+	defer bat.Close()
+	now := time.Now()
+	old := types.UnixMicroToTimestamp(now.Add(-2 * time.Hour).UnixMicro()).ToDatetime(time.UTC)
+	fresh := types.UnixMicroToTimestamp(now.UnixMicro()).ToDatetime(time.UTC)
+	for i := 0; i < 30; i++ {
+		v := old
+		if i%2 == 0 {
+			v = fresh
+		}
+		bat.Vecs[11].Update(i, v, false)
+	}
+	tae.createRelAndAppend(bat, true)
+	tae.compactBlocks(true)
+
+	var blks []*catalog.BlockEntry
+	testutils.WaitExpect(4000, func() bool {
+		var ok bool
+		blks, ok = tae.collectNonAppendableBlocks()
+		return ok
+	})
+	require.Equal(t, 3, len(blks))
+	tae.checkRowsByScan(30, true)
+
+	table := blks[0].GetSegment().GetTable()
+	require.NoError(t, tae.ForceMergeTable(context.Background(), table.GetDB().ID, table.ID))
+	testutils.WaitExpect(4000, func() bool {
+		for _, blk := range blks {
+			if !blk.HasDropCommitted() {
+				return false
+			}
+		}
+		return true
+	})
+	for _, blk := range blks {
+		assert.True(t, blk.HasDropCommitted())
+	}
+	tae.checkRowsByScan(15, true)
+}
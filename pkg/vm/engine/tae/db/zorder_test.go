@@ -0,0 +1,117 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	pkgcatalog "github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils/config"
+	"github.com/stretchr/testify/require"
+)
+
+// countDistinct returns the number of distinct values of the column in
+// every block.
+func (e *testEngine) countDistinct(blks []*catalog.BlockEntry, col int) []int {
+	txn, err := e.StartTxn(nil)
+	require.NoError(e.t, err)
+	defer func() { require.NoError(e.t, txn.Commit()) }()
+	cnts := make([]int, 0, len(blks))
+	for _, blk := range blks {
+		view, err := blk.GetBlockData().GetColumnDataById(txn, col)
+		require.NoError(e.t, err)
+		vec := view.GetData()
+		distinct := make(map[any]bool)
+		for i := 0; i < vec.Length(); i++ {
+			distinct[vec.Get(i)] = true
+		}
+		cnts = append(cnts, len(distinct))
+		view.Close()
+	}
+	return cnts
+}
+
+func TestZOrderMerge(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	testutils.EnsureNoLeak(t)
+	opts := config.WithQuickScanAndCKPOpts(nil)
+	tae := newTestEngine(t, opts)
+	defer tae.Close()
+
+	const side = 16
+	schema := catalog.NewEmptySchema("zorder")
+	require.NoError(t, schema.AppendCol("a", types.T_int32.ToType()))
+	require.NoError(t, schema.AppendCol("b", types.T_int64.ToType()))
+	require.NoError(t, schema.AppendSortKey(
+		pkgcatalog.PrefixZOrderColName+"001a001b", types.T_varchar.ToType(), 0, false))
+	schema.BlockMaxRows = side
+	schema.SegmentMaxBlocks = side
+	require.NoError(t, schema.Finalize(false))
+	tae.bindSchema(schema)
+
+	// a 16x16 grid appended in the lexicographic order of (a, b)
+	bat := containers.BuildBatch(schema.Attrs(), schema.Types(), containers.Options{})
+	defer bat.Close()
+	for a := 0; a < side; a++ {
+		for b := 0; b < side; b++ {
+			key := make([]byte, 12)
+			binary.BigEndian.PutUint32(key, uint32(a))
+			binary.BigEndian.PutUint64(key[4:], uint64(b)<<30)
+			bat.Vecs[0].Append(int32(a), false)
+			bat.Vecs[1].Append(int64(b)<<30, false)
+			bat.Vecs[2].Append(key, false)
+		}
+	}
+	tae.createRelAndAppend(bat, true)
+	tae.compactBlocks(true)
+
+	var blks []*catalog.BlockEntry
+	testutils.WaitExpect(4000, func() bool {
+		var ok bool
+		blks, ok = tae.collectNonAppendableBlocks()
+		return ok
+	})
+	require.Equal(t, side, len(blks))
+	for _, cnt := range tae.countDistinct(blks, 0) {
+		require.Equal(t, 1, cnt)
+	}
+
+	// merge clusters every block on a 4x4 square of the grid
+	table := blks[0].GetSegment().GetTable()
+	require.NoError(t, tae.ForceMergeTable(context.Background(), table.GetDB().ID, table.ID))
+	testutils.WaitExpect(4000, func() bool {
+		for _, blk := range blks {
+			if !blk.HasDropCommitted() {
+				return false
+			}
+		}
+		return true
+	})
+	merged, ok := tae.collectNonAppendableBlocks()
+	require.True(t, ok)
+	require.Equal(t, side, len(merged))
+	for col := 0; col < 2; col++ {
+		for _, cnt := range tae.countDistinct(merged, col) {
+			require.Equal(t, 4, cnt)
+		}
+	}
+	tae.checkRowsByScan(side*side, true)
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
@@ -76,6 +77,7 @@ type Block interface {
 	GetColumnDataByName(txn txnif.AsyncTxn, attr string) (*model.ColumnView, error)
 	GetColumnDataById(txn txnif.AsyncTxn, colIdx int) (*model.ColumnView, error)
 	Prefetch(idxes []uint16) error
+	GetColumnZoneMap(colIdx int) (*index.ZM, error)
 	GetMeta() any
 
 	MakeAppender() (BlockAppender, error)
@@ -77,6 +77,13 @@ type Handler interface {
 		resp *apipb.SyncLogTailResp,
 	) error
 
+	HandleMergeTable(
+		ctx context.Context,
+		meta txn.TxnMeta,
+		req *db.MergeTable,
+		resp *apipb.SyncLogTailResp,
+	) error
+
 	HandleForceCheckpoint(
 		ctx context.Context,
 		meta txn.TxnMeta,
@@ -90,4 +97,32 @@ type Handler interface {
 		req *db.InspectDN,
 		resp *db.InspectResp,
 	) error
+
+	HandleBackup(
+		ctx context.Context,
+		meta txn.TxnMeta,
+		req *db.Backup,
+		resp *db.InspectResp,
+	) error
+
+	HandleRestore(
+		ctx context.Context,
+		meta txn.TxnMeta,
+		req *db.RestoreReq,
+		resp *db.InspectResp,
+	) error
+
+	HandleChangefeed(
+		ctx context.Context,
+		meta txn.TxnMeta,
+		req *db.Changefeed,
+		resp *db.InspectResp,
+	) error
+
+	HandleMView(
+		ctx context.Context,
+		meta txn.TxnMeta,
+		req *db.MView,
+		resp *db.InspectResp,
+	) error
 }
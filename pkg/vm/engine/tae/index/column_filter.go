@@ -0,0 +1,150 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package index
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+)
+
+const noPrimaryColumn = ^uint16(0)
+
+// ColumnFilters is the bloom filters of a block on several columns. It is a
+// StaticFilter of the primary key column, so the readers only looking for the
+// primary key filter of a block work with it as before. The filter of another
+// column is returned by Column.
+type ColumnFilters struct {
+	primary uint16
+	cols    []uint16
+	filters []StaticFilter
+}
+
+func NewColumnFilters() *ColumnFilters {
+	return &ColumnFilters{primary: noPrimaryColumn}
+}
+
+// Add adds the filter of the column, primary tells whether the column is the
+// primary key of the table.
+func (f *ColumnFilters) Add(col uint16, filter StaticFilter, primary bool) {
+	if primary {
+		f.primary = col
+	}
+	f.cols = append(f.cols, col)
+	f.filters = append(f.filters, filter)
+}
+
+// Column returns the filter of the column, nil if the column has no filter.
+func (f *ColumnFilters) Column(col uint16) StaticFilter {
+	for i, c := range f.cols {
+		if c == col {
+			return f.filters[i]
+		}
+	}
+	return nil
+}
+
+// Columns returns the columns with a filter.
+func (f *ColumnFilters) Columns() []uint16 {
+	return f.cols
+}
+
+func (f *ColumnFilters) MayContainsKey(key []byte) (bool, error) {
+	pk := f.Column(f.primary)
+	if pk == nil {
+		return true, nil
+	}
+	return pk.MayContainsKey(key)
+}
+
+func (f *ColumnFilters) MayContainsAnyKeys(keys containers.Vector) (bool, *roaring.Bitmap, error) {
+	pk := f.Column(f.primary)
+	if pk == nil {
+		positive := roaring.NewBitmap()
+		positive.AddRange(0, uint64(keys.Length()))
+		return keys.Length() > 0, positive, nil
+	}
+	return pk.MayContainsAnyKeys(keys)
+}
+
+// Marshal encodes the filters as the primary key column, the count of
+// filters and the column, length and content of every filter.
+func (f *ColumnFilters) Marshal() (buf []byte, err error) {
+	var w bytes.Buffer
+	count := uint16(len(f.cols))
+	if _, err = types.WriteValues(&w, f.primary, count); err != nil {
+		return
+	}
+	for i, col := range f.cols {
+		var data []byte
+		if data, err = f.filters[i].Marshal(); err != nil {
+			return
+		}
+		if _, err = types.WriteValues(&w, col, uint32(len(data))); err != nil {
+			return
+		}
+		if _, err = w.Write(data); err != nil {
+			return
+		}
+	}
+	buf = w.Bytes()
+	return
+}
+
+func (f *ColumnFilters) Unmarshal(buf []byte) error {
+	if len(buf) < 4 {
+		return moerr.NewInternalErrorNoCtx("bad column filters of %d bytes", len(buf))
+	}
+	f.primary = types.DecodeFixed[uint16](buf[:2])
+	count := types.DecodeFixed[uint16](buf[2:4])
+	buf = buf[4:]
+	f.cols = make([]uint16, 0, count)
+	f.filters = make([]StaticFilter, 0, count)
+	for i := uint16(0); i < count; i++ {
+		if len(buf) < 6 {
+			return moerr.NewInternalErrorNoCtx("bad column filters, %d filters missing", count-i)
+		}
+		col := types.DecodeFixed[uint16](buf[:2])
+		length := types.DecodeFixed[uint32](buf[2:6])
+		buf = buf[6:]
+		if uint32(len(buf)) < length {
+			return moerr.NewInternalErrorNoCtx("bad column filter of column %d", col)
+		}
+		filter, err := DecodeBloomFilter(buf[:length])
+		if err != nil {
+			return err
+		}
+		buf = buf[length:]
+		f.cols = append(f.cols, col)
+		f.filters = append(f.filters, filter)
+	}
+	return nil
+}
+
+func (f *ColumnFilters) String() string {
+	return fmt.Sprintf("<CF primary=%d cols=%v>", f.primary, f.cols)
+}
+
+func DecodeColumnFilters(data []byte) (*ColumnFilters, error) {
+	f := NewColumnFilters()
+	if err := f.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
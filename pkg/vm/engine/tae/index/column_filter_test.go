@@ -0,0 +1,70 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package index
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestColumnFilters(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	testutils.EnsureNoLeak(t)
+	typ := types.T_int32.ToType()
+	pkData := containers.MockVector2(typ, 1000, 0)
+	defer pkData.Close()
+	colData := containers.MockVector2(typ, 1000, 5000)
+	defer colData.Close()
+
+	pk, err := NewBinaryFuseFilter(pkData)
+	require.NoError(t, err)
+	col, err := NewBinaryFuseFilter(colData)
+	require.NoError(t, err)
+
+	filters := NewColumnFilters()
+	// a block without primary key filter may contain any key
+	ok, err := filters.MayContainsKey(types.EncodeValue(int32(1), typ.Oid))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	filters.Add(0, pk, true)
+	filters.Add(3, col, false)
+	buf, err := filters.Marshal()
+	require.NoError(t, err)
+
+	decoded, err := DecodeColumnFilters(buf)
+	require.NoError(t, err)
+	require.Equal(t, []uint16{0, 3}, decoded.Columns())
+	require.Nil(t, decoded.Column(1))
+
+	ok, err = decoded.MayContainsKey(types.EncodeValue(int32(10), typ.Oid))
+	require.NoError(t, err)
+	require.True(t, ok)
+	ok, err = decoded.Column(3).MayContainsKey(types.EncodeValue(int32(5010), typ.Oid))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	exist, positive, err := decoded.MayContainsAnyKeys(pkData)
+	require.NoError(t, err)
+	require.True(t, exist)
+	require.Equal(t, uint64(1000), positive.GetCardinality())
+
+	_, err = DecodeColumnFilters(buf[:len(buf)-10])
+	require.Error(t, err)
+}
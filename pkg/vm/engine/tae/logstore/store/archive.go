@@ -0,0 +1,251 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	driverEntry "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/driver/entry"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/entry"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/sm"
+)
+
+const (
+	// ArchiveDir is the dir of the archived log entries in the file service
+	ArchiveDir = "wal-archive/"
+
+	DefaultArchiveFlushInterval = time.Second * 5
+	DefaultArchiveMaxBufferSize = 64 * 1024 * 1024
+
+	// group(4) + lsn(8) + type(2) + payload size(4)
+	archiveRecordHeaderSize = 18
+)
+
+// ArchiveHandle is called on every archived log entry in the order they were
+// appended, replaying stops if it returns false.
+type ArchiveHandle = func(group uint32, lsn uint64, typ uint16, payload []byte) bool
+
+// Archiver archives the log entries of the customized groups into a file service
+// continuously. The entries are handed over to the queue of the archiver, which
+// buffers them and flushes them into a new archive file periodically, or when the
+// buffer is full, so the appending of the WAL never waits for the archive.
+//
+// The archive is not as durable as the WAL: the entries not flushed yet are lost
+// if the process crashes. The recovery point objective of the point-in-time
+// recovery is the flush interval, plus the time of a flush.
+type Archiver struct {
+	fs            fileservice.FileService
+	flushInterval time.Duration
+	maxBufferSize int
+
+	queue sm.Queue
+
+	mu  sync.Mutex
+	buf *bytes.Buffer
+
+	// flushMu serializes the flushes to keep the order of the archive files
+	flushMu sync.Mutex
+	// sequence number of the next archive file
+	seq uint64
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewArchiver(fs fileservice.FileService, flushInterval time.Duration) (*Archiver, error) {
+	if flushInterval <= 0 {
+		flushInterval = DefaultArchiveFlushInterval
+	}
+	names, err := listArchiveFiles(context.Background(), fs)
+	if err != nil {
+		return nil, err
+	}
+	a := &Archiver{
+		fs:            fs,
+		flushInterval: flushInterval,
+		maxBufferSize: DefaultArchiveMaxBufferSize,
+		buf:           new(bytes.Buffer),
+	}
+	a.queue = sm.NewSafeQueue(DefaultMaxBatchSize*10, DefaultMaxBatchSize, a.onRecords)
+	if len(names) > 0 {
+		var last uint64
+		if _, err = fmt.Sscanf(names[len(names)-1], ArchiveDir+"%d", &last); err != nil {
+			return nil, err
+		}
+		a.seq = last + 1
+	}
+	var ctx context.Context
+	ctx, a.cancel = context.WithCancel(context.Background())
+	a.queue.Start()
+	a.wg.Add(1)
+	go a.flushLoop(ctx)
+	return a, nil
+}
+
+func (a *Archiver) flushLoop(ctx context.Context) {
+	defer a.wg.Done()
+	ticker := time.NewTicker(a.flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := a.Flush(ctx); err != nil {
+				logutil.Errorf("[WalArchive] flush: %v", err)
+			}
+		}
+	}
+}
+
+// Archive enqueues the log entry appended successfully, the entries of the
+// internal groups of the store are skipped. The record is copied, for the payload
+// of the entry can be freed once it is done.
+func (a *Archiver) Archive(e *driverEntry.Entry) {
+	info, ok := e.Entry.GetInfo().(*entry.Info)
+	if !ok || info.Group < entry.GTCustomizedStart {
+		return
+	}
+	payload := e.Entry.GetPayload()
+	record := make([]byte, archiveRecordHeaderSize+len(payload))
+	binary.LittleEndian.PutUint32(record[0:], info.Group)
+	binary.LittleEndian.PutUint64(record[4:], info.GroupLSN)
+	binary.LittleEndian.PutUint16(record[12:], e.Entry.GetType())
+	binary.LittleEndian.PutUint32(record[14:], uint32(len(payload)))
+	copy(record[archiveRecordHeaderSize:], payload)
+	if _, err := a.queue.Enqueue(record); err != nil {
+		logutil.Errorf("[WalArchive] archive entry %d-%d: %v", info.Group, info.GroupLSN, err)
+	}
+}
+
+func (a *Archiver) onRecords(items ...any) {
+	a.mu.Lock()
+	for _, item := range items {
+		a.buf.Write(item.([]byte))
+	}
+	full := a.buf.Len() >= a.maxBufferSize
+	a.mu.Unlock()
+	if full {
+		if err := a.Flush(context.Background()); err != nil {
+			logutil.Errorf("[WalArchive] flush: %v", err)
+		}
+	}
+}
+
+// Flush writes the buffered entries into a new archive file. The entries are
+// put back to the buffer and retried by the next flush if the write fails.
+func (a *Archiver) Flush(ctx context.Context) error {
+	a.flushMu.Lock()
+	defer a.flushMu.Unlock()
+	a.mu.Lock()
+	buf := a.buf
+	a.buf = new(bytes.Buffer)
+	a.mu.Unlock()
+	if buf.Len() == 0 {
+		return nil
+	}
+	name := fmt.Sprintf("%s%020d", ArchiveDir, a.seq)
+	data := buf.Bytes()
+	if err := a.fs.Write(ctx, fileservice.IOVector{
+		FilePath: name,
+		Entries: []fileservice.IOEntry{{
+			Offset: 0,
+			Size:   int64(len(data)),
+			Data:   data,
+		}},
+	}); err != nil {
+		a.mu.Lock()
+		buf.Write(a.buf.Bytes())
+		a.buf = buf
+		a.mu.Unlock()
+		return err
+	}
+	a.seq++
+	return nil
+}
+
+// Close stops the background flushing and flushes the entries queued.
+func (a *Archiver) Close() error {
+	a.queue.Stop()
+	a.cancel()
+	a.wg.Wait()
+	return a.Flush(context.Background())
+}
+
+func listArchiveFiles(ctx context.Context, fs fileservice.FileService) ([]string, error) {
+	dirs, err := fs.List(ctx, ArchiveDir)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(dirs))
+	for _, dir := range dirs {
+		if !dir.IsDir {
+			names = append(names, ArchiveDir+dir.Name)
+		}
+	}
+	// the names are sequence numbers padded with zeros
+	sort.Strings(names)
+	return names, nil
+}
+
+// ReplayArchive calls h on every log entry archived in the file service, in the
+// order they were appended to the WAL.
+func ReplayArchive(ctx context.Context, fs fileservice.FileService, h ArchiveHandle) error {
+	names, err := listArchiveFiles(ctx, fs)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		vec := &fileservice.IOVector{
+			FilePath: name,
+			Entries: []fileservice.IOEntry{{
+				Offset: 0,
+				Size:   -1,
+			}},
+			NoCache: true,
+		}
+		if err = fs.Read(ctx, vec); err != nil {
+			return err
+		}
+		data := vec.Entries[0].Data
+		for len(data) > 0 {
+			if len(data) < archiveRecordHeaderSize {
+				return moerr.NewInternalError(ctx, "corrupted wal archive file %s", name)
+			}
+			group := binary.LittleEndian.Uint32(data[0:])
+			lsn := binary.LittleEndian.Uint64(data[4:])
+			typ := binary.LittleEndian.Uint16(data[12:])
+			size := int(binary.LittleEndian.Uint32(data[14:]))
+			data = data[archiveRecordHeaderSize:]
+			if len(data) < size {
+				return moerr.NewInternalError(ctx, "corrupted wal archive file %s", name)
+			}
+			if !h(group, lsn, typ, data[:size]) {
+				return nil
+			}
+			data = data[size:]
+		}
+	}
+	return nil
+}
@@ -44,6 +44,8 @@ type StoreImpl struct {
 
 	truncatingQueue sm.Queue
 	truncateQueue   sm.Queue
+
+	archiver *Archiver
 }
 
 func NewStoreWithLogserviceDriver(factory logservicedriver.LogServiceClientFactory) Store {
@@ -100,8 +102,17 @@ func (w *StoreImpl) Close() error {
 	if err != nil {
 		return err
 	}
+	if w.archiver != nil {
+		return w.archiver.Close()
+	}
 	return nil
 }
+
+// SetArchiver sets the archiver of the entries appended, it must be called
+// before any entry is appended.
+func (w *StoreImpl) SetArchiver(archiver *Archiver) {
+	w.archiver = archiver
+}
 func (w *StoreImpl) Append(gid uint32, e entry.Entry) (lsn uint64, err error) {
 	_, lsn, err = w.doAppend(gid, e)
 	return
@@ -162,6 +173,9 @@ func (w *StoreImpl) onDoneWithErrQueue(items ...any) {
 		if err != nil {
 			panic(err)
 		}
+		if w.archiver != nil {
+			w.archiver.Archive(e)
+		}
 		e.Entry.DoneWithErr(nil)
 		_, err = w.logInfoQueue.Enqueue(e)
 		if err != nil {
@@ -15,17 +15,20 @@
 package store
 
 import (
+	"context"
 	"math/rand"
 	"os"
 	"strconv"
 	"sync"
 	"testing"
+	"time"
 
 	// "net/http"
 	// _ "net/http/pprof"
 
 	// "github.com/lni/vfs"
 	// "github.com/matrixorigin/matrixone/pkg/logservice"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/driver"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/driver/batchstoredriver"
@@ -359,3 +362,54 @@ func TestTruncate(t *testing.T) {
 	t.Logf("truncated %d, current %d", truncated, drcurrLsn)
 	assert.GreaterOrEqual(t, truncated, drcurrLsn)
 }
+
+func TestArchive(t *testing.T) {
+	ctx := context.Background()
+	fs, err := fileservice.NewMemoryFS("archive", fileservice.DisabledCacheConfig, nil)
+	assert.NoError(t, err)
+	archiver, err := NewArchiver(fs, time.Millisecond*10)
+	assert.NoError(t, err)
+	driver := newTestDriver(t, int(common.M)*64)
+	wal := NewStore(driver)
+	wal.SetArchiver(archiver)
+
+	entryCnt := 100
+	for i := 0; i < entryCnt; i++ {
+		e := entry.GetBase()
+		assert.NoError(t, e.SetPayload([]byte(strconv.Itoa(i))))
+		_, err = wal.Append(10, e)
+		assert.NoError(t, err)
+		assert.NoError(t, e.WaitDone())
+		e.Free()
+	}
+	_, err = wal.RangeCheckpoint(10, 1, uint64(entryCnt))
+	assert.NoError(t, err)
+	assert.NoError(t, wal.Close())
+
+	// the checkpoint entries are not archived
+	var lsn uint64
+	assert.NoError(t, ReplayArchive(ctx, fs, func(group uint32, l uint64, _ uint16, payload []byte) bool {
+		assert.Equal(t, uint32(10), group)
+		assert.Equal(t, lsn+1, l)
+		assert.Equal(t, strconv.Itoa(int(lsn)), string(payload))
+		lsn = l
+		return true
+	}))
+	assert.Equal(t, uint64(entryCnt), lsn)
+
+	// replaying stops if the handle returns false
+	cnt := 0
+	assert.NoError(t, ReplayArchive(ctx, fs, func(uint32, uint64, uint16, []byte) bool {
+		cnt++
+		return cnt < 10
+	}))
+	assert.Equal(t, 10, cnt)
+
+	// the archiver continues the archive files
+	archiver, err = NewArchiver(fs, time.Millisecond*10)
+	assert.NoError(t, err)
+	names, err := listArchiveFiles(ctx, fs)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(len(names)), archiver.seq)
+	assert.NoError(t, archiver.Close())
+}
@@ -35,6 +35,7 @@ type Store interface {
 	GetCheckpointed(gid uint32) (lsn uint64)
 
 	Replay(h ApplyHandle) error
+	SetArchiver(archiver *Archiver)
 	Close() error
 }
 
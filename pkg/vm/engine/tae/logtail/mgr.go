@@ -56,6 +56,10 @@ func MockCallback(from, to timestamp.Timestamp, tails ...logtail.TableLogtail) e
 	return nil
 }
 
+// TxnListener is notified of the logtails of each committed txn in the commit
+// order. It runs in the logtail pipeline, so it should return quickly.
+type TxnListener = func(commitTS types.TS, tails []logtail.TableLogtail)
+
 type callback struct {
 	cb func(from, to timestamp.Timestamp, tails ...logtail.TableLogtail) error
 }
@@ -79,6 +83,9 @@ type Manager struct {
 
 	previousSaveTS      types.TS
 	logtailCallback     atomic.Pointer[callback]
+	listenerMu          sync.Mutex
+	listeners           atomic.Pointer[map[uint64]TxnListener]
+	listenerID          uint64
 	collectLogtailQueue sm.Queue
 	waitCommitQueue     sm.Queue
 	eventOnce           sync.Once
@@ -140,6 +147,11 @@ func (mgr *Manager) Start() {
 }
 
 func (mgr *Manager) generateLogtailWithTxn(txn *txnWithLogtails) {
+	if listeners := mgr.listeners.Load(); listeners != nil {
+		for _, listener := range *listeners {
+			listener(txn.txn.GetPrepareTS(), *txn.tails)
+		}
+	}
 	callback := mgr.logtailCallback.Load()
 	if callback != nil {
 		to := txn.txn.GetPrepareTS()
@@ -209,3 +221,36 @@ func (mgr *Manager) RegisterCallback(cb func(from, to timestamp.Timestamp, tails
 	mgr.logtailCallback.Store(callbackFn)
 	return nil
 }
+
+// AddTxnListener adds a listener of the logtails of the committed txns, and
+// returns the function to remove it. The listeners see the same logtails as
+// the callback, and must not modify them.
+func (mgr *Manager) AddTxnListener(listener TxnListener) (remove func()) {
+	mgr.listenerMu.Lock()
+	defer mgr.listenerMu.Unlock()
+	mgr.listenerID++
+	id := mgr.listenerID
+	mgr.updateListenersLocked(func(listeners map[uint64]TxnListener) {
+		listeners[id] = listener
+	})
+	return func() {
+		mgr.listenerMu.Lock()
+		defer mgr.listenerMu.Unlock()
+		mgr.updateListenersLocked(func(listeners map[uint64]TxnListener) {
+			delete(listeners, id)
+		})
+	}
+}
+
+// updateListenersLocked replaces the listeners by a modified copy, so
+// generateLogtailWithTxn iterates them without lock.
+func (mgr *Manager) updateListenersLocked(update func(map[uint64]TxnListener)) {
+	listeners := make(map[uint64]TxnListener)
+	if old := mgr.listeners.Load(); old != nil {
+		for id, listener := range *old {
+			listeners[id] = listener
+		}
+	}
+	update(listeners)
+	mgr.listeners.Store(&listeners)
+}
@@ -46,6 +46,7 @@ const (
 	// Low priority, because replay from ckp will keep the create sequence
 	SnapshotAttr_BlockMaxRow               = catalog.SnapshotAttr_BlockMaxRow
 	SnapshotAttr_SegmentMaxBlock           = catalog.SnapshotAttr_SegmentMaxBlock
+	SnapshotAttr_MergePolicy               = catalog.SnapshotAttr_MergePolicy
 	SnapshotMetaAttr_Tid                   = "table_id"
 	SnapshotMetaAttr_BlockInsertBatchStart = "block_insert_batch_start"
 	SnapshotMetaAttr_BlockInsertBatchEnd   = "block_insert_batch_end"
@@ -126,6 +127,7 @@ var (
 		SnapshotAttr_TID,
 		SnapshotAttr_BlockMaxRow,
 		SnapshotAttr_SegmentMaxBlock,
+		SnapshotAttr_MergePolicy,
 	}
 	TblDNSchemaType = []types.Type{
 		types.New(types.T_uint64, 0, 0),
@@ -138,6 +140,7 @@ var (
 		types.New(types.T_uint64, 0, 0),
 		types.New(types.T_uint32, 0, 0),
 		types.New(types.T_uint16, 0, 0),
+		types.New(types.T_varchar, types.MaxVarcharLen, 0),
 	}
 	SegmentDNSchemaAttr = []string{
 		txnbase.SnapshotAttr_LogIndex_LSN,
@@ -574,6 +574,40 @@ func (data *CheckpointData) GetDNBlkBatchs() (
 		data.bats[BLKDNMetaDeleteTxnIDX]
 }
 
+// GetReferencedObjects returns the names of the objects referenced by the meta
+// and delta locations of the blocks in the checkpoint, without duplicates.
+func (data *CheckpointData) GetReferencedObjects() []string {
+	names := make([]string, 0)
+	seen := make(map[string]struct{})
+	for _, bat := range data.bats {
+		if bat == nil {
+			continue
+		}
+		for i, attr := range bat.Attrs {
+			if attr != pkgcatalog.BlockMeta_MetaLoc && attr != pkgcatalog.BlockMeta_DeltaLoc {
+				continue
+			}
+			vec := bat.Vecs[i]
+			for row := 0; row < vec.Length(); row++ {
+				if vec.IsNull(row) {
+					continue
+				}
+				loc := objectio.Location(vec.Get(row).([]byte))
+				if loc.IsEmpty() {
+					continue
+				}
+				name := loc.Name().String()
+				if _, ok := seen[name]; ok {
+					continue
+				}
+				seen[name] = struct{}{}
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
 func (collector *BaseCollector) VisitDB(entry *catalog.DBEntry) error {
 	if shouldIgnoreDBInLogtail(entry.ID) {
 		return nil
@@ -655,6 +689,8 @@ func (collector *BaseCollector) VisitTable(entry *catalog.TableEntry) (err error
 				SnapshotAttr_BlockMaxRow).Append(entry.GetLastestSchema().BlockMaxRows, false)
 			collector.data.bats[TBLInsertTxnIDX].GetVectorByName(
 				SnapshotAttr_SegmentMaxBlock).Append(entry.GetLastestSchema().SegmentMaxBlocks, false)
+			collector.data.bats[TBLInsertTxnIDX].GetVectorByName(
+				SnapshotAttr_MergePolicy).Append([]byte(tblNode.BaseNode.Schema.MergePolicy), false)
 
 			catalogEntry2Batch(
 				collector.data.bats[TBLInsertIDX],
@@ -0,0 +1,241 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mergesort
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/bits"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+)
+
+const signBit = uint64(1) << 63
+
+// ZOrderKeys computes the Z-order (Morton) keys of the rows of the clustered
+// columns, cols[i][j] being the j-th block of the i-th column. All the columns
+// must be laid out alike and the keys are returned as varchar vectors, like
+// the hidden sort key of the table, laid out the same way.
+//
+// Every value is mapped into an order preserving uint64, which is normalized
+// over all the rows of its column: the minimum is subtracted and the range is
+// shifted up to the most significant bit. A column spanning a narrow range has
+// its varying bits interleaved as high as those of a wide one, so no column
+// dominates the order. The keys only compare with the keys of the same call.
+func ZOrderKeys(cols [][]containers.Vector) []containers.Vector {
+	values := make([][][]uint64, len(cols))
+	for i, col := range cols {
+		values[i] = zorderNormalize(col)
+	}
+
+	ret := make([]containers.Vector, len(cols[0]))
+	for j := range ret {
+		ret[j] = containers.MakeVector(types.T_varchar.ToType())
+		for row := range values[0][j] {
+			key := make([]byte, 8*len(cols))
+			zorderInterleave(key, values, j, row)
+			ret[j].Append(key, false)
+		}
+	}
+	return ret
+}
+
+// zorderNormalize maps the values of the blocks of one column into uint64s
+// normalized over all of them. Nulls are mapped to 0 like the minimum.
+func zorderNormalize(col []containers.Vector) [][]uint64 {
+	min, max := uint64(math.MaxUint64), uint64(0)
+	values := make([][]uint64, len(col))
+	for j, vec := range col {
+		values[j] = make([]uint64, vec.Length())
+		for row := range values[j] {
+			if vec.IsNull(row) {
+				continue
+			}
+			v := ZOrderValue(vec.ShallowGet(row))
+			if v < min {
+				min = v
+			}
+			if v > max {
+				max = v
+			}
+			values[j][row] = v
+		}
+	}
+	if min > max {
+		// all nulls
+		return values
+	}
+	shift := 64 - bits.Len64(max-min)
+	for j, vec := range col {
+		for row, v := range values[j] {
+			if vec.IsNull(row) {
+				continue
+			}
+			values[j][row] = (v - min) << shift
+		}
+	}
+	return values
+}
+
+// zorderInterleave writes into key the bits of the row of every column, from
+// the most significant one down, taking one bit of each column in turn.
+func zorderInterleave(key []byte, values [][][]uint64, blk, row int) {
+	n := len(values)
+	for b := 0; b < 64; b++ {
+		for i := 0; i < n; i++ {
+			if values[i][blk][row]&(signBit>>b) == 0 {
+				continue
+			}
+			pos := b*n + i
+			key[pos/8] |= 0x80 >> (pos % 8)
+		}
+	}
+}
+
+// ZOrderValue maps a value into an uint64 following the order of its type.
+// Byte strings are ordered by their first 8 bytes only.
+func ZOrderValue(v any) uint64 {
+	switch x := v.(type) {
+	case bool:
+		if x {
+			return 1
+		}
+		return 0
+	case int8:
+		return uint64(int64(x)) ^ signBit
+	case int16:
+		return uint64(int64(x)) ^ signBit
+	case int32:
+		return uint64(int64(x)) ^ signBit
+	case int64:
+		return uint64(x) ^ signBit
+	case uint8:
+		return uint64(x)
+	case uint16:
+		return uint64(x)
+	case uint32:
+		return uint64(x)
+	case uint64:
+		return x
+	case float32:
+		return zorderFloat(float64(x))
+	case float64:
+		return zorderFloat(x)
+	case types.Date:
+		return uint64(int64(x)) ^ signBit
+	case types.Time:
+		return uint64(x) ^ signBit
+	case types.Datetime:
+		return uint64(x) ^ signBit
+	case types.Timestamp:
+		return uint64(x) ^ signBit
+	case types.Decimal64:
+		return uint64(x) ^ signBit
+	case types.Decimal128:
+		return x.B64_127 ^ signBit
+	case types.Uuid:
+		return binary.BigEndian.Uint64(x[:8])
+	case []byte:
+		var buf [8]byte
+		copy(buf[:], x)
+		return binary.BigEndian.Uint64(buf[:])
+	default:
+		panic(fmt.Sprintf("%T not supported", v))
+	}
+}
+
+func zorderFloat(f float64) uint64 {
+	v := math.Float64bits(f)
+	if v&signBit != 0 {
+		return ^v
+	}
+	return v | signBit
+}
+
+// SortMergeVarlenaColumn merges the blocks of a varlena column into the blocks
+// of toLayout sorted by its values. Unlike MergeSortedColumn, the blocks need
+// not be sorted beforehand and sortedIdx receives, for every merged row, the
+// position of its source row within all the rows of the blocks laid out by
+// fromLayout, to be followed by GatherColumn.
+func SortMergeVarlenaColumn(
+	column []containers.Vector,
+	sortedIdx *[]uint32,
+	fromLayout,
+	toLayout []uint32) (ret []containers.Vector, mapping []uint32) {
+	dataWithIdx := NewSortSlice(len(*sortedIdx), bytesLess)
+	k := uint32(0)
+	for i, col := range column {
+		for row := 0; row < int(fromLayout[i]); row++ {
+			if col.IsNull(row) {
+				dataWithIdx.Append(SortElem[[]byte]{isNull: true, idx: k})
+			} else {
+				dataWithIdx.Append(SortElem[[]byte]{data: col.ShallowGet(row).([]byte), idx: k})
+			}
+			k++
+		}
+	}
+	sortUnstable(dataWithIdx)
+
+	ret = make([]containers.Vector, len(toLayout))
+	mapping = make([]uint32, len(*sortedIdx))
+	elems := dataWithIdx.AsSlice()
+	k = 0
+	for i := range toLayout {
+		ret[i] = containers.MakeVector(*column[0].GetType())
+		for j := 0; j < int(toLayout[i]); j++ {
+			elem := elems[k]
+			(*sortedIdx)[k] = elem.idx
+			mapping[elem.idx] = k
+			if elem.isNull {
+				ret[i].Append(nil, true)
+			} else {
+				ret[i].Append(elem.data, false)
+			}
+			k++
+		}
+	}
+	return
+}
+
+// GatherColumn merges the blocks of a column into the blocks of toLayout
+// following the source rows given by SortMergeVarlenaColumn.
+func GatherColumn(column []containers.Vector, sortedIdx []uint32, fromLayout, toLayout []uint32) (ret []containers.Vector) {
+	srcBlk := make([]uint32, 0, len(sortedIdx))
+	srcRow := make([]uint32, 0, len(sortedIdx))
+	for i, rows := range fromLayout {
+		for row := uint32(0); row < rows; row++ {
+			srcBlk = append(srcBlk, uint32(i))
+			srcRow = append(srcRow, row)
+		}
+	}
+
+	ret = make([]containers.Vector, len(toLayout))
+	k := 0
+	for i := range toLayout {
+		ret[i] = containers.MakeVector(*column[0].GetType())
+		for j := 0; j < int(toLayout[i]); j++ {
+			col, row := column[srcBlk[sortedIdx[k]]], int(srcRow[sortedIdx[k]])
+			ret[i].Append(col.Get(row), col.IsNull(row))
+			k++
+		}
+	}
+
+	for _, v := range column {
+		v.Close()
+	}
+	return
+}
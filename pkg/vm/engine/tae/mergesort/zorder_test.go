@@ -0,0 +1,159 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mergesort
+
+import (
+	"bytes"
+	"math"
+	gosort "sort"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestZOrderValue(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	ordered := [][]any{
+		{int8(math.MinInt8), int8(-1), int8(0), int8(1), int8(math.MaxInt8)},
+		{int64(math.MinInt64), int64(-1), int64(0), int64(1), int64(math.MaxInt64)},
+		{uint32(0), uint32(1), uint32(math.MaxUint32)},
+		{math.Inf(-1), -1.5, -0.5, 0.0, 0.5, 1.5, math.Inf(1)},
+		{float32(-2), float32(-1), float32(0), float32(3)},
+		{types.Date(-10), types.Date(0), types.Date(10)},
+		{types.Decimal64(^uint64(0)), types.Decimal64(0), types.Decimal64(1)},
+		{[]byte(""), []byte("a"), []byte("ab"), []byte("b"), []byte("ba")},
+	}
+	for _, values := range ordered {
+		for i := 1; i < len(values); i++ {
+			require.Less(t, ZOrderValue(values[i-1]), ZOrderValue(values[i]), values)
+		}
+	}
+}
+
+func TestZOrderKeys(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	// a 16x16 grid of a narrow and a wide column, split into two blocks
+	const side = 16
+	var cols [2][]containers.Vector
+	for i := range cols {
+		cols[i] = []containers.Vector{
+			containers.MakeVector(types.T_int64.ToType()),
+			containers.MakeVector(types.T_int64.ToType()),
+		}
+	}
+	for x := 0; x < side; x++ {
+		for y := 0; y < side; y++ {
+			blk := (x*side + y) % 2
+			cols[0][blk].Append(int64(x), false)
+			cols[1][blk].Append(int64(1<<40+y<<30), false)
+		}
+	}
+	keys := ZOrderKeys(cols[:])
+	require.Equal(t, 2, len(keys))
+
+	type point struct {
+		key  []byte
+		x, y int64
+	}
+	points := make([]point, 0, side*side)
+	for blk, vec := range keys {
+		require.Equal(t, cols[0][blk].Length(), vec.Length())
+		for row := 0; row < vec.Length(); row++ {
+			points = append(points, point{
+				key: vec.Get(row).([]byte),
+				x:   cols[0][blk].Get(row).(int64),
+				y:   cols[1][blk].Get(row).(int64),
+			})
+		}
+	}
+	gosort.Slice(points, func(i, j int) bool {
+		return bytes.Compare(points[i].key, points[j].key) < 0
+	})
+
+	// every run of 16 rows in the key order covers a 4x4 square of the grid,
+	// where the lexicographic order would cover a single x
+	for i := 0; i < len(points); i += side {
+		xs, ys := make(map[int64]bool), make(map[int64]bool)
+		for _, p := range points[i : i+side] {
+			xs[p.x], ys[p.y] = true, true
+		}
+		require.Equal(t, 4, len(xs))
+		require.Equal(t, 4, len(ys))
+	}
+
+	for _, vec := range keys {
+		vec.Close()
+	}
+	for i := range cols {
+		for _, vec := range cols[i] {
+			vec.Close()
+		}
+	}
+}
+
+func TestSortMergeVarlenaColumn(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	keys := []containers.Vector{
+		containers.MakeVector(types.T_varbinary.ToType()),
+		containers.MakeVector(types.T_varbinary.ToType()),
+	}
+	vals := []containers.Vector{
+		containers.MakeVector(types.T_int32.ToType()),
+		containers.MakeVector(types.T_int32.ToType()),
+	}
+	for i, k := range []string{"d", "b", "f", "a", "e", "c"} {
+		keys[i%2].Append([]byte(k), false)
+		vals[i%2].Append(int32(k[0]), false)
+	}
+	fromLayout := []uint32{3, 3}
+	toLayout := []uint32{4, 2}
+	sortedIdx := make([]uint32, 6)
+
+	ret, mapping := SortMergeVarlenaColumn(keys, &sortedIdx, fromLayout, toLayout)
+	require.Equal(t, 2, len(ret))
+	require.Equal(t, []uint32{3, 5, 4, 1, 0, 2}, mapping)
+	gathered := GatherColumn(vals, sortedIdx, fromLayout, toLayout)
+	expected := "abcdef"
+	for i, vec := range ret {
+		require.Equal(t, int(toLayout[i]), vec.Length())
+		for row := 0; row < vec.Length(); row++ {
+			require.Equal(t, []byte{expected[0]}, vec.Get(row))
+			require.Equal(t, int32(expected[0]), gathered[i].Get(row))
+			expected = expected[1:]
+		}
+		vec.Close()
+		gathered[i].Close()
+	}
+	for _, vec := range keys {
+		vec.Close()
+	}
+}
+
+func TestZOrderKeysNull(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	vec := containers.MakeVector(types.T_varchar.ToType())
+	vec.Append([]byte("b"), false)
+	vec.Append(nil, true)
+	vec.Append([]byte("a"), false)
+	keys := ZOrderKeys([][]containers.Vector{{vec}})
+	require.Equal(t, 1, len(keys))
+	require.Equal(t, keys[0].Get(1), keys[0].Get(2))
+	require.Negative(t, bytes.Compare(keys[0].Get(2).([]byte), keys[0].Get(0).([]byte)))
+	keys[0].Close()
+	vec.Close()
+}
@@ -18,6 +18,8 @@ import (
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
 )
 
 const (
@@ -78,6 +80,27 @@ type LogtailServerCfg struct {
 	MaxLogtailFetchFailure   int
 }
 
+// WalArchiveCfg is the config of archiving the WAL entries continuously
+type WalArchiveCfg struct {
+	// Fs is the file service the WAL entries archived into
+	Fs fileservice.FileService
+	// FlushInterval is the interval of flushing the entries archived, which is
+	// the recovery point objective of the point-in-time recovery
+	FlushInterval time.Duration
+}
+
+// RecoveryCfg is the config of the point-in-time recovery. The archived WAL
+// entries are replayed on top of the checkpoints of the DB, up to TargetTS or
+// TargetLSN. The WAL of the DB must be empty, e.g. a DB restored from a backup.
+type RecoveryCfg struct {
+	// Archive is the file service the WAL entries archived into
+	Archive fileservice.FileService
+	// TargetTS is the max commit timestamp of the txns replayed, no limit if empty
+	TargetTS types.TS
+	// TargetLSN is the max LSN of the txns replayed, no limit if 0
+	TargetLSN uint64
+}
+
 func NewDefaultLogtailServerCfg() *LogtailServerCfg {
 	return &LogtailServerCfg{
 		RpcMaxMessageSize:        defaultRpcMaxMessageSize,
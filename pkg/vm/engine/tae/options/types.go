@@ -69,6 +69,8 @@ type Options struct {
 	GCCfg         *GCCfg
 	LogtailCfg    *LogtailCfg
 	CatalogCfg    *CatalogCfg
+	WalArchiveCfg *WalArchiveCfg
+	RecoveryCfg   *RecoveryCfg
 	Catalog       *catalog.Catalog
 
 	TransferTableTTL time.Duration
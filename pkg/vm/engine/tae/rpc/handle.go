@@ -17,7 +17,10 @@ package rpc
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"os"
+	"strconv"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
@@ -32,6 +35,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	apipb "github.com/matrixorigin/matrixone/pkg/pb/api"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
@@ -354,6 +358,14 @@ func (h *Handle) HandleFlushTable(
 	return err
 }
 
+func (h *Handle) HandleMergeTable(
+	ctx context.Context,
+	meta txn.TxnMeta,
+	req *db.MergeTable,
+	resp *apipb.SyncLogTailResp) (err error) {
+	return h.db.ForceMergeTable(ctx, req.DatabaseID, req.TableID)
+}
+
 func (h *Handle) HandleForceCheckpoint(
 	ctx context.Context,
 	meta txn.TxnMeta,
@@ -368,6 +380,129 @@ func (h *Handle) HandleForceCheckpoint(
 	return err
 }
 
+// HandleBackup backs up the data of DN to the file service of the request,
+// only the sys account is allowed.
+func (h *Handle) HandleBackup(
+	ctx context.Context,
+	meta txn.TxnMeta,
+	req *db.Backup,
+	resp *db.InspectResp) (err error) {
+	if req.AccessInfo.AccountID != catalog.System_Account {
+		return moerr.NewInternalError(ctx, "only the sys account can backup")
+	}
+	fs, err := newBackupFileService(ctx, req)
+	if err != nil {
+		return
+	}
+	result, err := h.db.Backup(ctx, fs)
+	if err != nil {
+		return
+	}
+	resp.Message = result.String()
+	return
+}
+
+// HandleRestore restores a backup into another file service, which is not used
+// by DN. The restored file service can be used by a new DN to start with the data
+// of the backup. Only the sys account is allowed.
+func (h *Handle) HandleRestore(
+	ctx context.Context,
+	meta txn.TxnMeta,
+	req *db.RestoreReq,
+	resp *db.InspectResp) (err error) {
+	if req.AccessInfo.AccountID != catalog.System_Account {
+		return moerr.NewInternalError(ctx, "only the sys account can restore")
+	}
+	src, err := newBackupFileService(ctx, &req.Src)
+	if err != nil {
+		return
+	}
+	dst, err := newBackupFileService(ctx, &req.Dst)
+	if err != nil {
+		return
+	}
+	var ts types.TS
+	if req.Ts != "" {
+		physical, logical, ok := strings.Cut(req.Ts, "-")
+		p, err1 := strconv.ParseInt(physical, 10, 64)
+		l, err2 := strconv.ParseUint(logical, 10, 32)
+		if !ok || err1 != nil || err2 != nil {
+			return moerr.NewInvalidInput(ctx, "invalid restore timestamp '%s', it must be physical-logical", req.Ts)
+		}
+		ts = types.BuildTS(p, uint32(l))
+	}
+	result, err := db.Restore(ctx, src, dst, ts)
+	if err != nil {
+		return
+	}
+	resp.Message = result.String()
+	return
+}
+
+// newBackupFileService creates the file service of a backup target
+func newBackupFileService(ctx context.Context, req *db.Backup) (fileservice.FileService, error) {
+	cfg := req.FileServiceConfig()
+	switch strings.ToUpper(cfg.Backend) {
+	case "DISK":
+		if cfg.DataDir == "" {
+			return nil, moerr.NewInvalidInput(ctx, "empty backup dir")
+		}
+	case "S3", "MINIO":
+		if cfg.S3.Bucket == "" {
+			return nil, moerr.NewInvalidInput(ctx, "empty backup bucket")
+		}
+	default:
+		return nil, moerr.NewNotSupported(ctx, "backup to %s", cfg.Backend)
+	}
+	return fileservice.NewFileService(cfg, nil)
+}
+
+// HandleChangefeed creates, drops or shows the changefeeds, only the sys
+// account is allowed.
+func (h *Handle) HandleChangefeed(
+	ctx context.Context,
+	meta txn.TxnMeta,
+	req *db.Changefeed,
+	resp *db.InspectResp) (err error) {
+	if req.AccessInfo.AccountID != catalog.System_Account {
+		return moerr.NewInternalError(ctx, "only the sys account can manage changefeeds")
+	}
+	switch req.Op {
+	case db.ChangefeedCreate:
+		err = h.db.Changefeeds.Create(ctx, req)
+	case db.ChangefeedDrop:
+		err = h.db.Changefeeds.Drop(ctx, req)
+	case db.ChangefeedShow:
+		var data []byte
+		if data, err = json.Marshal(h.db.Changefeeds.Show()); err == nil {
+			resp.Message = string(data)
+		}
+	default:
+		err = moerr.NewNotSupported(ctx, "changefeed operation %s", req.Op)
+	}
+	return
+}
+
+// HandleMView creates, drops or refreshes the maintenance of a materialized
+// view in the account of the request.
+func (h *Handle) HandleMView(
+	ctx context.Context,
+	meta txn.TxnMeta,
+	req *db.MView,
+	resp *db.InspectResp) (err error) {
+	switch req.Op {
+	case db.MViewCreate:
+		err = h.db.Changefeeds.CreateMView(ctx, req)
+	case db.MViewDrop:
+		err = h.db.Changefeeds.DropMView(ctx, req)
+	case db.MViewRefresh:
+		err = h.db.Changefeeds.RefreshMView(ctx, req)
+	default:
+		err = moerr.NewNotSupported(ctx, "materialized view operation %s", req.Op)
+	}
+	return
+}
+
 func (h *Handle) HandleInspectDN(
 	ctx context.Context,
 	meta txn.TxnMeta,
@@ -530,6 +665,37 @@ func (h *Handle) HandlePreCommitWrite(
 					return err
 				}
 			}
+		case []catalog.UpdatePartition:
+			for _, cmd := range cmds {
+				req := apipb.NewUpdatePartitionReq(
+					cmd.DatabaseId,
+					cmd.TableId,
+					cmd.Partition)
+				if err = h.CacheTxnRequest(ctx, meta, req, nil); err != nil {
+					return err
+				}
+			}
+		case []catalog.UpdateMergePolicy:
+			for _, cmd := range cmds {
+				req := apipb.NewUpdateMergePolicyReq(
+					cmd.DatabaseId,
+					cmd.TableId,
+					cmd.MergePolicy)
+				if err = h.CacheTxnRequest(ctx, meta, req, nil); err != nil {
+					return err
+				}
+			}
+		case []catalog.UpdateTableName:
+			for _, cmd := range cmds {
+				req := apipb.NewRenameTableReq(
+					cmd.DatabaseId,
+					cmd.TableId,
+					cmd.TableName,
+					cmd.NewName)
+				if err = h.CacheTxnRequest(ctx, meta, req, nil); err != nil {
+					return err
+				}
+			}
 		case []catalog.DropDatabase:
 			for _, cmd := range cmds {
 				req := &db.DropDatabaseReq{
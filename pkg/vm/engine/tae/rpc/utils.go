@@ -15,6 +15,7 @@
 package rpc
 
 import (
+	"context"
 	"strings"
 
 	pkgcatalog "github.com/matrixorigin/matrixone/pkg/catalog"
@@ -26,6 +27,7 @@ import (
 
 func DefsToSchema(name string, defs []engine.TableDef) (schema *catalog.Schema, err error) {
 	schema = catalog.NewEmptySchema(name)
+	mergeProps := new(pkgcatalog.MergeProperties)
 	var pkeyColName string
 	for _, def := range defs {
 		switch defVal := def.(type) {
@@ -64,6 +66,11 @@ func DefsToSchema(name string, defs []engine.TableDef) (schema *catalog.Schema,
 				case pkgcatalog.SystemRelAttr_CreateSQL:
 					schema.Createsql = property.Value
 				default:
+					if pkgcatalog.IsMergeProperty(property.Key) {
+						if err = mergeProps.Set(context.TODO(), property.Key, property.Value); err != nil {
+							return
+						}
+					}
 				}
 			}
 
@@ -83,6 +90,7 @@ func DefsToSchema(name string, defs []engine.TableDef) (schema *catalog.Schema,
 			// We will not deal with other cases for the time being
 		}
 	}
+	schema.MergePolicy = mergeProps.Encode()
 	if err = schema.Finalize(false); err != nil {
 		return
 	}
@@ -32,6 +32,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/jobs"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/updates"
@@ -262,6 +263,21 @@ func (blk *baseBlock) Prefetch(idxes []uint16) error {
 	}
 }
 
+// GetColumnZoneMap returns the zonemap of the column of a persisted block,
+// nil if the block is not persisted yet.
+func (blk *baseBlock) GetColumnZoneMap(colIdx int) (*index.ZM, error) {
+	node := blk.PinNode()
+	defer node.Unref()
+	if !node.IsPersisted() {
+		return nil, nil
+	}
+	idx, ok := node.MustPNode().indexes[colIdx]
+	if !ok {
+		return nil, moerr.NewInternalErrorNoCtx("no index of column %d in block %s", colIdx, blk.meta.ID.String())
+	}
+	return idx.GetZoneMap()
+}
+
 func (blk *baseBlock) ResolvePersistedColumnDatas(
 	pnode *persistedNode,
 	txn txnif.TxnReader,
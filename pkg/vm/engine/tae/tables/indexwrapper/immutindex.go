@@ -39,6 +39,7 @@ func (index *immutableIndex) BatchUpsert(keysCtx *index.KeysCtx, offset int) (er
 }
 func (index *immutableIndex) GetActiveRow(key any) ([]uint32, error) { panic("not support") }
 func (index *immutableIndex) String() string                         { return "immutable" }
+func (index *immutableIndex) GetZoneMap() (*index.ZM, error)         { return index.zmReader.getZoneMap() }
 func (index *immutableIndex) Dedup(key any, _ func(row uint32) error) (err error) {
 	exist := index.zmReader.Contains(key)
 	// 1. if not in [min, max], key is definitely not found
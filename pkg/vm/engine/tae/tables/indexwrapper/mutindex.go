@@ -137,6 +137,8 @@ func (idx *mutableIndex) Destroy() error {
 	return idx.Close()
 }
 
+func (idx *mutableIndex) GetZoneMap() (*index.ZM, error) { return idx.zonemap, nil }
+
 func (idx *mutableIndex) Close() error {
 	idx.art = nil
 	idx.zonemap = nil
@@ -166,6 +168,7 @@ func (idx *nonPkMutIndex) Close() error {
 }
 func (idx *nonPkMutIndex) GetActiveRow(any) ([]uint32, error) { panic("not support") }
 func (idx *nonPkMutIndex) String() string                     { return "nonpk" }
+func (idx *nonPkMutIndex) GetZoneMap() (*index.ZM, error)     { return idx.zonemap, nil }
 func (idx *nonPkMutIndex) BatchUpsert(keysCtx *index.KeysCtx, offset int) (err error) {
 	return TranslateError(index.BatchUpdateZM(idx.zonemap, keysCtx.Keys))
 }
@@ -57,5 +57,8 @@ type Index interface {
 
 	GetActiveRow(key any) (row []uint32, err error)
 
+	// GetZoneMap returns the zonemap of the indexed column
+	GetZoneMap() (*index.ZM, error)
+
 	String() string
 }
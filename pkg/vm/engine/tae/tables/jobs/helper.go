@@ -68,3 +68,53 @@ func (t *delSegTask) Execute() (err error) {
 	logutil.Info("Mergeblocks delete merged segments [Done]", zap.String("task", tdesc))
 	return
 }
+
+type delExpiredBlkTask struct {
+	*tasks.BaseTask
+	delBlks []*catalog.BlockEntry
+	txn     txnif.AsyncTxn
+}
+
+// NewDelExpiredBlkTask drops the blocks whose rows are all expired by the TTL of the table
+func NewDelExpiredBlkTask(ctx *tasks.Context, txn txnif.AsyncTxn, delBlks []*catalog.BlockEntry) *delExpiredBlkTask {
+	task := &delExpiredBlkTask{
+		delBlks: delBlks,
+		txn:     txn,
+	}
+	task.BaseTask = tasks.NewBaseTask(task, tasks.DataCompactionTask, ctx)
+	return task
+}
+
+func (t *delExpiredBlkTask) String() string {
+	blks := "DelExpiredBlk:"
+	for _, blk := range t.delBlks {
+		blks = fmt.Sprintf("%s%s,", blks, blk.ID.String())
+	}
+	return blks
+}
+
+func (t *delExpiredBlkTask) Execute() (err error) {
+	tdesc := t.String()
+	logutil.Info("Mergeblocks delete expired blocks [Start]", zap.String("task", tdesc))
+	dbId := t.delBlks[0].GetSegment().GetTable().GetDB().ID
+	database, err := t.txn.GetDatabaseByID(dbId)
+	if err != nil {
+		return
+	}
+	relId := t.delBlks[0].GetSegment().GetTable().ID
+	rel, err := database.GetRelationByID(relId)
+	if err != nil {
+		return
+	}
+	for _, entry := range t.delBlks {
+		seg, err := rel.GetSegment(entry.GetSegment().ID)
+		if err != nil {
+			return err
+		}
+		if err = seg.SoftDeleteBlock(entry.ID); err != nil {
+			return err
+		}
+	}
+	logutil.Info("Mergeblocks delete expired blocks [Done]", zap.String("task", tdesc))
+	return
+}
@@ -66,6 +66,11 @@ type mergeBlocksTask struct {
 	scheduler   tasks.TaskScheduler
 	scopes      []common.ID
 	deletes     []*roaring.Bitmap
+	// expired rows of the compacted blocks, which are removed by the merge
+	expired []*roaring.Bitmap
+	// zorder is set if the table is clustered in Z-order, whose sort key is
+	// recomputed over the merged rows which are sorted rather than merged
+	zorder bool
 }
 
 func NewMergeBlocksTask(ctx *tasks.Context, txn txnif.AsyncTxn, mergedBlks []*catalog.BlockEntry, mergedSegs []*catalog.SegmentEntry, toSegEntry *catalog.SegmentEntry, scheduler tasks.TaskScheduler) (task *mergeBlocksTask, err error) {
@@ -116,7 +121,13 @@ func (task *mergeBlocksTask) mergeColumn(
 	if len(vecs) == 0 {
 		return
 	}
-	if sort {
+	if sort && task.zorder {
+		if isPrimary {
+			column, mapping = mergesort.SortMergeVarlenaColumn(vecs, sortedIdx, fromLayout, toLayout)
+		} else {
+			column = mergesort.GatherColumn(vecs, *sortedIdx, fromLayout, toLayout)
+		}
+	} else if sort {
 		if isPrimary {
 			column, mapping = mergesort.MergeSortedColumn(vecs, sortedIdx, fromLayout, toLayout)
 		} else {
@@ -144,6 +155,69 @@ func (task *mergeBlocksTask) mergeColumnWithOutSort(column []containers.Vector,
 	return
 }
 
+// collectExpired collects the rows of the compacted blocks expired by the TTL
+// of the table, which are filtered out as if they were deleted.
+func (task *mergeBlocksTask) collectExpired(schema *catalog.Schema) (err error) {
+	ttl := task.mergedBlks[0].GetSegment().GetTable().GetLatestCommittedSchema().GetTTL()
+	if ttl == nil {
+		return
+	}
+	colIdx := schema.GetColIdx(ttl.Def.Name)
+	if colIdx < 0 {
+		return
+	}
+	expired := ttl.ExpiredAt(time.Now())
+	task.expired = make([]*roaring.Bitmap, len(task.compacted))
+	cnt := uint64(0)
+	for i, block := range task.compacted {
+		view, err := block.GetColumnDataById(colIdx)
+		if err != nil {
+			return err
+		}
+		mask := roaring.NewBitmap()
+		vec := view.GetData()
+		for row := 0; row < vec.Length(); row++ {
+			if !vec.IsNull(row) && expired(vec.Get(row)) {
+				mask.Add(uint32(row))
+			}
+		}
+		view.Close()
+		if !mask.IsEmpty() {
+			task.expired[i] = mask
+			cnt += mask.GetCardinality()
+		}
+	}
+	if cnt > 0 {
+		logutil.Infof("Mergeblocks remove %d expired rows", cnt)
+	}
+	return
+}
+
+// appendColumnData appends to vecs the column of the i-th compacted block,
+// without its deleted and expired rows
+func (task *mergeBlocksTask) appendColumnData(
+	vecs []containers.Vector, i int, block handle.Block, colIdx int) ([]containers.Vector, error) {
+	view, err := block.GetColumnDataById(colIdx)
+	if err != nil {
+		return vecs, err
+	}
+	defer view.Close()
+	task.applyExpired(i, view)
+	view.ApplyDeletes()
+	return append(vecs, view.Orphan()), nil
+}
+
+// applyExpired adds the expired rows of the i-th compacted block into the deletes of the view
+func (task *mergeBlocksTask) applyExpired(i int, view *model.ColumnView) {
+	if task.expired == nil || task.expired[i] == nil {
+		return
+	}
+	if view.DeleteMask == nil {
+		view.DeleteMask = roaring.NewBitmap()
+	}
+	view.DeleteMask.Or(task.expired[i])
+}
+
 func (task *mergeBlocksTask) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	blks := ""
 	for _, blk := range task.mergedBlks {
@@ -212,6 +286,16 @@ func (task *mergeBlocksTask) Execute() (err error) {
 		sortColDef = schema.PhyAddrKey
 	}
 	logutil.Infof("Mergeblocks on sort column %s\n", sortColDef.Name)
+	zorderIdxes := schema.GetZOrderIdxes()
+	task.zorder = len(zorderIdxes) > 0
+	zorderCols := make([][]containers.Vector, len(zorderIdxes))
+	defer func() {
+		for _, col := range zorderCols {
+			for _, vec := range col {
+				vec.Close()
+			}
+		}
+	}()
 
 	idxes := make([]uint16, 0)
 	for _, def := range schema.ColDefs {
@@ -227,11 +311,16 @@ func (task *mergeBlocksTask) Execute() (err error) {
 		}
 	}
 
+	if err = task.collectExpired(schema); err != nil {
+		return
+	}
+
 	for i, block := range task.compacted {
 		if view, err = block.GetColumnDataById(sortColDef.Idx); err != nil {
 			return
 		}
 		defer view.Close()
+		task.applyExpired(i, view)
 		task.deletes[i] = view.DeleteMask
 		view.ApplyDeletes()
 		vec := view.Orphan()
@@ -241,6 +330,11 @@ func (task *mergeBlocksTask) Execute() (err error) {
 			continue
 		}
 		sortVecs = append(sortVecs, vec)
+		for j, idx := range zorderIdxes {
+			if zorderCols[j], err = task.appendColumnData(zorderCols[j], i, block, idx); err != nil {
+				return
+			}
+		}
 		rows = append(rows, uint32(vec.Length()))
 		fromAddr = append(fromAddr, uint32(length))
 		length += vec.Length()
@@ -260,6 +354,13 @@ func (task *mergeBlocksTask) Execute() (err error) {
 		}
 	}
 
+	if task.zorder {
+		sortVecs = mergesort.ZOrderKeys(zorderCols)
+		for _, vec := range sortVecs {
+			defer vec.Close()
+		}
+	}
+
 	// merge sort the sort key
 	node, err := common.DefaultAllocator.Alloc(length * 4)
 	if err != nil {
@@ -292,7 +393,14 @@ func (task *mergeBlocksTask) Execute() (err error) {
 		blockHandles = append(blockHandles, blk)
 		batch := containers.NewBatch()
 		batchs = append(batchs, batch)
-		vec.Close()
+		if !task.zorder {
+			vec.Close()
+		}
+	}
+	// the recomputed Z-order keys are written as they are sorted
+	var sortedKeys []containers.Vector
+	if task.zorder {
+		sortedKeys, vecs = vecs, nil
 	}
 
 	// Build and flush block index if sort key is defined
@@ -305,12 +413,20 @@ func (task *mergeBlocksTask) Execute() (err error) {
 		// Skip
 		// PhyAddr column was processed before
 		// If only one single sort key, it was processed before
+		if task.zorder && def.Idx == sortColDef.Idx {
+			for i, vec := range sortedKeys {
+				defer vec.Close()
+				batchs[i].AddVector(def.Name, vec)
+			}
+			continue
+		}
 		vecs = vecs[:0]
-		for _, block := range task.compacted {
+		for i, block := range task.compacted {
 			if view, err = block.GetColumnDataById(def.Idx); err != nil {
 				return
 			}
 			defer view.Close()
+			task.applyExpired(i, view)
 			view.ApplyDeletes()
 			vec := view.Orphan()
 			if vec.Length() == 0 {
@@ -337,6 +453,7 @@ func (task *mergeBlocksTask) Execute() (err error) {
 		pkIdx := schema.GetSingleSortKeyIdx()
 		writer.SetPrimaryKey(uint16(pkIdx))
 	}
+	writer.SetBloomFilterColumns(schema.GetBloomFilterIdxes()...)
 	for _, bat := range batchs {
 		_, err = writer.WriteBatch(containers.ToCNBatch(bat))
 		if err != nil {
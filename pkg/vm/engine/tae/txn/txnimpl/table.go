@@ -811,10 +811,29 @@ func (tbl *txnTable) UpdateDeltaLoc(id *common.ID, deltaloc objectio.Location) (
 
 func (tbl *txnTable) AlterTable(ctx context.Context, req *apipb.AlterTableReq) error {
 	switch req.Kind {
-	case apipb.AlterKind_UpdateConstraint, apipb.AlterKind_UpdateComment:
+	case apipb.AlterKind_UpdateConstraint, apipb.AlterKind_UpdateComment, apipb.AlterKind_UpdatePartition,
+		apipb.AlterKind_UpdateMergePolicy, apipb.AlterKind_RenameTable:
 	default:
 		return moerr.NewNYI(ctx, "alter table %s", req.Kind.String())
 	}
+	if req.Kind == apipb.AlterKind_RenameTable {
+		rename := req.GetRenameTable()
+		if schema := tbl.entry.GetVisibleSchema(tbl.store.txn); schema == nil || schema.Name != rename.OldName {
+			return moerr.NewInternalError(ctx, "rename table %s, the table is not found", rename.OldName)
+		}
+		// the name is taken before the schema is changed, the rollback of the txn
+		// leaves the index of the new name, which is skipped by the name check
+		err := tbl.entry.GetDB().RenameTableInTxn(
+			rename.OldName,
+			rename.NewName,
+			tbl.entry.ID,
+			tbl.entry.GetLatestCommittedSchema().AcInfo.TenantID,
+			tbl.store.txn,
+			rename.OldName != tbl.entry.GetLatestCommittedSchema().Name)
+		if err != nil {
+			return err
+		}
+	}
 	tbl.store.IncreateWriteCnt()
 	tbl.store.txn.GetMemo().AddCatalogChange()
 	isNewNode, err := tbl.entry.AlterTable(ctx, tbl.store.txn, req)
@@ -34,6 +34,8 @@ type entryWithInfo struct {
 type DriverConfig struct {
 	BatchStoreConfig   *batchstoredriver.StoreCfg
 	CheckpointDuration time.Duration
+	// Archiver archives the entries appended if not nil
+	Archiver *store.Archiver
 }
 
 type walDriver struct {
@@ -50,9 +52,12 @@ type walDriver struct {
 	wg            sync.WaitGroup
 }
 
-func NewDriverWithLogservice(factory logservicedriver.LogServiceClientFactory) Driver {
+func NewDriverWithLogservice(factory logservicedriver.LogServiceClientFactory, cfg *DriverConfig) Driver {
 	ckpDuration := time.Second * 5
 	impl := store.NewStoreWithLogserviceDriver(factory)
+	if cfg != nil && cfg.Archiver != nil {
+		impl.SetArchiver(cfg.Archiver)
+	}
 	driver := NewDriverWithStore(impl, true, ckpDuration)
 	return driver
 }
@@ -62,9 +67,14 @@ func NewDriverWithBatchStore(dir, name string, cfg *DriverConfig) Driver {
 	ckpDuration := time.Second * 5
 	if cfg != nil {
 		batchStoreCfg = cfg.BatchStoreConfig
-		ckpDuration = cfg.CheckpointDuration
+		if cfg.CheckpointDuration != 0 {
+			ckpDuration = cfg.CheckpointDuration
+		}
 	}
 	impl := store.NewStoreWithBatchStoreDriver(dir, name, batchStoreCfg)
+	if cfg != nil && cfg.Archiver != nil {
+		impl.SetArchiver(cfg.Archiver)
+	}
 	driver := NewDriverWithStore(impl, true, ckpDuration)
 	return driver
 }
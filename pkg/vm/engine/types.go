@@ -145,6 +145,14 @@ type RefChildTableDef struct {
 	Tables []uint64
 }
 
+type TTLDef struct {
+	Ttl *plan.TTLDef
+}
+
+type BloomFilterDef struct {
+	BloomFilter *plan.BloomFilterDef
+}
+
 type TableDef interface {
 	tableDef()
 
@@ -271,6 +279,8 @@ const (
 	RefChildTable
 	ForeignKey
 	PrimaryKey
+	TTL
+	BloomFilter
 )
 
 type EngineType int8
@@ -346,6 +356,30 @@ func (def *ConstraintDef) MarshalBinary() (data []byte, err error) {
 				return nil, err
 			}
 			buf.Write(bytes)
+		case *TTLDef:
+			if err := binary.Write(buf, binary.BigEndian, TTL); err != nil {
+				return nil, err
+			}
+			bytes, err := def.Ttl.Marshal()
+			if err != nil {
+				return nil, err
+			}
+			if err := binary.Write(buf, binary.BigEndian, uint64((len(bytes)))); err != nil {
+				return nil, err
+			}
+			buf.Write(bytes)
+		case *BloomFilterDef:
+			if err := binary.Write(buf, binary.BigEndian, BloomFilter); err != nil {
+				return nil, err
+			}
+			bytes, err := def.BloomFilter.Marshal()
+			if err != nil {
+				return nil, err
+			}
+			if err := binary.Write(buf, binary.BigEndian, uint64((len(bytes)))); err != nil {
+				return nil, err
+			}
+			buf.Write(bytes)
 		}
 	}
 	return buf.Bytes(), nil
@@ -414,6 +448,28 @@ func (def *ConstraintDef) UnmarshalBinary(data []byte) error {
 			}
 			l += int(length)
 			def.Cts = append(def.Cts, &PrimaryKeyDef{pkey})
+
+		case TTL:
+			length = binary.BigEndian.Uint64(data[l : l+8])
+			l += 8
+			ttl := &plan.TTLDef{}
+			err := ttl.Unmarshal(data[l : l+int(length)])
+			if err != nil {
+				return err
+			}
+			l += int(length)
+			def.Cts = append(def.Cts, &TTLDef{ttl})
+
+		case BloomFilter:
+			length = binary.BigEndian.Uint64(data[l : l+8])
+			l += 8
+			bf := &plan.BloomFilterDef{}
+			err := bf.Unmarshal(data[l : l+int(length)])
+			if err != nil {
+				return err
+			}
+			l += int(length)
+			def.Cts = append(def.Cts, &BloomFilterDef{bf})
 		}
 	}
 	return nil
@@ -442,6 +498,12 @@ func (def *ConstraintPB) FromPBVersion() Constraint {
 	if r := def.GetIndexDef(); r != nil {
 		return r
 	}
+	if r := def.GetTTLDef(); r != nil {
+		return r
+	}
+	if r := def.GetBloomFilterDef(); r != nil {
+		return r
+	}
 	panic("no corresponding type")
 }
 
@@ -455,6 +517,26 @@ func (def *ConstraintDef) GetPrimaryKeyDef() *PrimaryKeyDef {
 	return nil
 }
 
+// get the ttl definition in the constraint, and return null if the table has no ttl
+func (def *ConstraintDef) GetTTLDef() *TTLDef {
+	for _, ct := range def.Cts {
+		if ctVal, ok := ct.(*TTLDef); ok {
+			return ctVal
+		}
+	}
+	return nil
+}
+
+// get the bloom filter definition in the constraint, and return null if the table has no bloom filter columns
+func (def *ConstraintDef) GetBloomFilterDef() *BloomFilterDef {
+	for _, ct := range def.Cts {
+		if ctVal, ok := ct.(*BloomFilterDef); ok {
+			return ctVal
+		}
+	}
+	return nil
+}
+
 type Constraint interface {
 	constraint()
 
@@ -467,6 +549,8 @@ func (*ForeignKeyDef) constraint()    {}
 func (*PrimaryKeyDef) constraint()    {}
 func (*RefChildTableDef) constraint() {}
 func (*IndexDef) constraint()         {}
+func (*TTLDef) constraint()           {}
+func (*BloomFilterDef) constraint()   {}
 
 func (def *ForeignKeyDef) ToPBVersion() ConstraintPB {
 	return ConstraintPB{
@@ -496,6 +580,20 @@ func (def *IndexDef) ToPBVersion() ConstraintPB {
 		},
 	}
 }
+func (def *TTLDef) ToPBVersion() ConstraintPB {
+	return ConstraintPB{
+		Ct: &ConstraintPB_TTLDef{
+			TTLDef: def,
+		},
+	}
+}
+func (def *BloomFilterDef) ToPBVersion() ConstraintPB {
+	return ConstraintPB{
+		Ct: &ConstraintPB_BloomFilterDef{
+			BloomFilterDef: def,
+		},
+	}
+}
 
 type Relation interface {
 	Statistics
@@ -521,6 +619,13 @@ type Relation interface {
 	// only ConstraintDef can be modified
 	UpdateConstraint(context.Context, *ConstraintDef) error
 
+	// UpdatePartition replaces the partition info of a partitioned table
+	UpdatePartition(context.Context, *PartitionDef) error
+	// UpdateMergePolicy replaces the encoded merge properties of the table kept in DN
+	UpdateMergePolicy(context.Context, string) error
+	// Rename changes the name of the table, the table keeps its id and data
+	Rename(context.Context, string) error
+
 	GetTableID(context.Context) uint64
 
 	// second argument is the number of reader, third argument is the filter extend, foruth parameter is the payload required by the engine
@@ -531,6 +636,14 @@ type Relation interface {
 	//max and min values
 	MaxAndMinValues(ctx context.Context) ([][2]any, []uint8, error)
 
+	// ClusteringDepth returns the average number of blocks whose zonemaps on
+	// the clustering columns overlap those of a block
+	ClusteringDepth(ctx context.Context) (float64, error)
+
+	// MaxCommitTS returns the max commit timestamp of the data of the table
+	// known by the CN, which changes whenever the data of the table is changed
+	MaxCommitTS(ctx context.Context) (types.TS, error)
+
 	GetEngineType() EngineType
 }
 
@@ -588,6 +701,12 @@ type Engine interface {
 
 	// AllocateIDByKey allocate a globally unique ID by key.
 	AllocateIDByKey(ctx context.Context, key string) (uint64, error)
+
+	// CatalogVersion returns the version of the catalog cached by the engine
+	// and the max commit timestamp of the catalog changes, the version changes
+	// whenever a ddl is applied. A zero version means that the engine does not
+	// track the catalog, and nothing derived from the catalog can be cached.
+	CatalogVersion() (uint64, timestamp.Timestamp)
 }
 
 type VectorPool interface {
@@ -480,6 +480,86 @@ func (m *IndexDef) GetIndexes() []*plan.IndexDef {
 	return nil
 }
 
+func (m *TTLDef) Reset()         { *m = TTLDef{} }
+func (m *TTLDef) String() string { return proto.CompactTextString(m) }
+func (*TTLDef) ProtoMessage()    {}
+func (*TTLDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{11}
+}
+func (m *TTLDef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TTLDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TTLDef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TTLDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TTLDef.Merge(m, src)
+}
+func (m *TTLDef) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *TTLDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_TTLDef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TTLDef proto.InternalMessageInfo
+
+func (m *TTLDef) GetTtl() *plan.TTLDef {
+	if m != nil {
+		return m.Ttl
+	}
+	return nil
+}
+
+func (m *BloomFilterDef) Reset()         { *m = BloomFilterDef{} }
+func (m *BloomFilterDef) String() string { return proto.CompactTextString(m) }
+func (*BloomFilterDef) ProtoMessage()    {}
+func (*BloomFilterDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{12}
+}
+func (m *BloomFilterDef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BloomFilterDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BloomFilterDef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BloomFilterDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BloomFilterDef.Merge(m, src)
+}
+func (m *BloomFilterDef) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *BloomFilterDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_BloomFilterDef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BloomFilterDef proto.InternalMessageInfo
+
+func (m *BloomFilterDef) GetBloomFilter() *plan.BloomFilterDef {
+	if m != nil {
+		return m.BloomFilter
+	}
+	return nil
+}
+
 // PB version of ConstraintDef
 type ConstraintDefPB struct {
 	Cts []ConstraintPB `protobuf:"bytes,1,rep,name=Cts,proto3" json:"Cts"`
@@ -489,7 +569,7 @@ func (m *ConstraintDefPB) Reset()         { *m = ConstraintDefPB{} }
 func (m *ConstraintDefPB) String() string { return proto.CompactTextString(m) }
 func (*ConstraintDefPB) ProtoMessage()    {}
 func (*ConstraintDefPB) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{11}
+	return fileDescriptor_d938547f84707355, []int{13}
 }
 func (m *ConstraintDefPB) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -532,6 +612,8 @@ type ConstraintPB struct {
 	//	*ConstraintPB_PrimaryKeyDef
 	//	*ConstraintPB_RefChildTableDef
 	//	*ConstraintPB_IndexDef
+	//	*ConstraintPB_TTLDef
+	//	*ConstraintPB_BloomFilterDef
 	Ct isConstraintPB_Ct `protobuf_oneof:"ct"`
 }
 
@@ -539,7 +621,7 @@ func (m *ConstraintPB) Reset()         { *m = ConstraintPB{} }
 func (m *ConstraintPB) String() string { return proto.CompactTextString(m) }
 func (*ConstraintPB) ProtoMessage()    {}
 func (*ConstraintPB) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{12}
+	return fileDescriptor_d938547f84707355, []int{14}
 }
 func (m *ConstraintPB) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -586,11 +668,19 @@ type ConstraintPB_RefChildTableDef struct {
 type ConstraintPB_IndexDef struct {
 	IndexDef *IndexDef `protobuf:"bytes,4,opt,name=IndexDef,proto3,oneof" json:"IndexDef,omitempty"`
 }
+type ConstraintPB_TTLDef struct {
+	TTLDef *TTLDef `protobuf:"bytes,5,opt,name=TTLDef,proto3,oneof" json:"TTLDef,omitempty"`
+}
+type ConstraintPB_BloomFilterDef struct {
+	BloomFilterDef *BloomFilterDef `protobuf:"bytes,6,opt,name=BloomFilterDef,proto3,oneof" json:"BloomFilterDef,omitempty"`
+}
 
 func (*ConstraintPB_ForeignKeyDef) isConstraintPB_Ct()    {}
 func (*ConstraintPB_PrimaryKeyDef) isConstraintPB_Ct()    {}
 func (*ConstraintPB_RefChildTableDef) isConstraintPB_Ct() {}
 func (*ConstraintPB_IndexDef) isConstraintPB_Ct()         {}
+func (*ConstraintPB_TTLDef) isConstraintPB_Ct()           {}
+func (*ConstraintPB_BloomFilterDef) isConstraintPB_Ct()   {}
 
 func (m *ConstraintPB) GetCt() isConstraintPB_Ct {
 	if m != nil {
@@ -627,6 +717,20 @@ func (m *ConstraintPB) GetIndexDef() *IndexDef {
 	return nil
 }
 
+func (m *ConstraintPB) GetTTLDef() *TTLDef {
+	if x, ok := m.GetCt().(*ConstraintPB_TTLDef); ok {
+		return x.TTLDef
+	}
+	return nil
+}
+
+func (m *ConstraintPB) GetBloomFilterDef() *BloomFilterDef {
+	if x, ok := m.GetCt().(*ConstraintPB_BloomFilterDef); ok {
+		return x.BloomFilterDef
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ConstraintPB) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -634,6 +738,8 @@ func (*ConstraintPB) XXX_OneofWrappers() []interface{} {
 		(*ConstraintPB_PrimaryKeyDef)(nil),
 		(*ConstraintPB_RefChildTableDef)(nil),
 		(*ConstraintPB_IndexDef)(nil),
+		(*ConstraintPB_TTLDef)(nil),
+		(*ConstraintPB_BloomFilterDef)(nil),
 	}
 }
 
@@ -655,7 +761,7 @@ func (m *TableDefPB) Reset()         { *m = TableDefPB{} }
 func (m *TableDefPB) String() string { return proto.CompactTextString(m) }
 func (*TableDefPB) ProtoMessage()    {}
 func (*TableDefPB) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{13}
+	return fileDescriptor_d938547f84707355, []int{15}
 }
 func (m *TableDefPB) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -805,7 +911,7 @@ func (m *Property) Reset()         { *m = Property{} }
 func (m *Property) String() string { return proto.CompactTextString(m) }
 func (*Property) ProtoMessage()    {}
 func (*Property) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{14}
+	return fileDescriptor_d938547f84707355, []int{16}
 }
 func (m *Property) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -852,7 +958,7 @@ func (m *Attribute) Reset()         { *m = Attribute{} }
 func (m *Attribute) String() string { return proto.CompactTextString(m) }
 func (*Attribute) ProtoMessage()    {}
 func (*Attribute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{15}
+	return fileDescriptor_d938547f84707355, []int{17}
 }
 func (m *Attribute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -970,6 +1076,8 @@ func init() {
 	proto.RegisterType((*PrimaryKeyDef)(nil), "engine.PrimaryKeyDef")
 	proto.RegisterType((*RefChildTableDef)(nil), "engine.RefChildTableDef")
 	proto.RegisterType((*IndexDef)(nil), "engine.IndexDef")
+	proto.RegisterType((*TTLDef)(nil), "engine.TTLDef")
+	proto.RegisterType((*BloomFilterDef)(nil), "engine.BloomFilterDef")
 	proto.RegisterType((*ConstraintDefPB)(nil), "engine.ConstraintDefPB")
 	proto.RegisterType((*ConstraintPB)(nil), "engine.ConstraintPB")
 	proto.RegisterType((*TableDefPB)(nil), "engine.TableDefPB")
@@ -980,66 +1088,71 @@ func init() {
 func init() { proto.RegisterFile("types.proto", fileDescriptor_d938547f84707355) }
 
 var fileDescriptor_d938547f84707355 = []byte{
-	// 939 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xdd, 0x8e, 0xda, 0x46,
-	0x14, 0x36, 0xd8, 0xfc, 0x1d, 0x20, 0xd9, 0xba, 0xdb, 0xd6, 0x5a, 0x55, 0xb0, 0xa2, 0x95, 0x42,
-	0x9b, 0x14, 0xa2, 0x6d, 0x54, 0x55, 0x48, 0x5b, 0x75, 0xcd, 0x76, 0x05, 0x8a, 0xda, 0xa2, 0xd1,
-	0x76, 0xef, 0x0d, 0x0c, 0xc4, 0x5a, 0xb0, 0x2d, 0x7b, 0x50, 0xc2, 0x5b, 0xe4, 0x11, 0xda, 0xb7,
-	0xc9, 0xe5, 0x5e, 0x46, 0xbd, 0x58, 0xb5, 0xcb, 0x0b, 0x54, 0xea, 0x5d, 0xae, 0xaa, 0x99, 0x39,
-	0x63, 0x3c, 0xe6, 0xa6, 0x77, 0xe7, 0xe7, 0x3b, 0x67, 0x0e, 0xdf, 0x19, 0x7f, 0x03, 0xd4, 0xd9,
-	0x36, 0xa2, 0x49, 0x2f, 0x8a, 0x43, 0x16, 0xda, 0x65, 0x1a, 0x2c, 0xfd, 0x80, 0x9e, 0x7c, 0xb3,
-	0xf4, 0xd9, 0xab, 0xcd, 0xb4, 0x37, 0x0b, 0xd7, 0xfd, 0x65, 0xb8, 0x0c, 0xfb, 0x22, 0x3d, 0xdd,
-	0x2c, 0x84, 0x27, 0x1c, 0x61, 0xc9, 0xb2, 0x13, 0x88, 0x56, 0x5e, 0x20, 0xed, 0xce, 0x33, 0x80,
-	0x61, 0xb8, 0x5e, 0xd3, 0x80, 0x5d, 0xd2, 0x85, 0xed, 0x40, 0x05, 0x3d, 0xa7, 0x70, 0x5a, 0xe8,
-	0xd6, 0x88, 0x72, 0x07, 0xd6, 0x3f, 0x7f, 0xb4, 0x8d, 0xce, 0x19, 0x34, 0x26, 0x5e, 0xcc, 0x7c,
-	0xe6, 0x87, 0x01, 0xc7, 0x7f, 0x0e, 0xb5, 0xd4, 0xc7, 0x8a, 0x7d, 0x00, 0x6b, 0xbe, 0x80, 0xca,
-	0x8d, 0x4f, 0x5f, 0x73, 0xb8, 0x0d, 0x16, 0x37, 0x11, 0x29, 0x6c, 0x04, 0x5d, 0x40, 0xe3, 0x82,
-	0xb1, 0xd8, 0x9f, 0x6e, 0x18, 0xe5, 0xc8, 0xa7, 0x60, 0x71, 0x5f, 0x20, 0xeb, 0x67, 0x1f, 0xf5,
-	0xe4, 0x0f, 0xed, 0xa5, 0x18, 0xd7, 0x7a, 0x77, 0xdf, 0x36, 0x88, 0x00, 0x61, 0x8b, 0x19, 0x34,
-	0xc7, 0xc1, 0x9c, 0xbe, 0xb9, 0xf6, 0xa6, 0x2b, 0x2a, 0x87, 0x33, 0xaf, 0xb7, 0x91, 0x68, 0x51,
-	0x72, 0xe1, 0xc3, 0x7d, 0xbb, 0x2c, 0xf3, 0x84, 0x87, 0xed, 0x13, 0xa8, 0x0e, 0xc3, 0xd5, 0x2f,
-	0xde, 0x9a, 0x26, 0x4e, 0xf1, 0xd4, 0xec, 0xd6, 0x48, 0xea, 0xf3, 0x39, 0xb9, 0xe1, 0x98, 0x72,
-	0x4e, 0x6e, 0xe3, 0x21, 0x3f, 0x43, 0x73, 0x12, 0x87, 0x11, 0x8d, 0x99, 0x4f, 0x13, 0x7e, 0xc8,
-	0x77, 0x00, 0xfb, 0x80, 0x53, 0x38, 0x35, 0xbb, 0xf5, 0xb3, 0x23, 0x35, 0x2e, 0x66, 0xb6, 0x38,
-	0x6d, 0x06, 0x89, 0xed, 0xba, 0xd0, 0x18, 0xae, 0x36, 0x09, 0xa3, 0xb1, 0xbb, 0x45, 0x82, 0xc4,
-	0xc1, 0x85, 0x83, 0x83, 0x7f, 0x84, 0xe6, 0x55, 0x18, 0x53, 0x7f, 0x19, 0xbc, 0xa4, 0x02, 0xfa,
-	0x15, 0x94, 0xae, 0x6e, 0xe9, 0x56, 0x9d, 0xf9, 0x71, 0x4f, 0x2c, 0x55, 0xc3, 0x10, 0x89, 0xc0,
-	0x0e, 0x3f, 0xf0, 0xd1, 0xfd, 0xb5, 0x17, 0x6f, 0xb1, 0xc3, 0x13, 0xb0, 0x26, 0xb7, 0x74, 0x8b,
-	0x1c, 0x63, 0x03, 0x0d, 0x42, 0x04, 0x00, 0xeb, 0x9f, 0xc3, 0x11, 0xa1, 0x8b, 0xe1, 0x2b, 0x7f,
-	0x35, 0x4f, 0x29, 0xfe, 0x14, 0xca, 0xc2, 0x96, 0x53, 0x58, 0x04, 0x3d, 0xac, 0x18, 0x40, 0x55,
-	0x30, 0xce, 0x91, 0x5d, 0xa8, 0x08, 0x3b, 0x25, 0xe9, 0x91, 0x3c, 0x4f, 0x01, 0x88, 0x4a, 0x63,
-	0xed, 0x4f, 0xf0, 0x78, 0x18, 0x06, 0x09, 0x8b, 0x3d, 0x5f, 0x5c, 0xcd, 0x89, 0x6b, 0x3f, 0x03,
-	0x73, 0xc8, 0x54, 0xf9, 0xb1, 0xe2, 0x78, 0x8f, 0x9a, 0xb8, 0xc8, 0x33, 0x87, 0x89, 0x36, 0x85,
-	0xce, 0xdb, 0x22, 0x34, 0xb2, 0x08, 0xfb, 0x3c, 0xc7, 0x23, 0xfe, 0xfa, 0x4f, 0x54, 0x3b, 0x2d,
-	0x39, 0x32, 0x48, 0x8e, 0xf5, 0xf3, 0x1c, 0x89, 0x4e, 0x51, 0x2f, 0xd7, 0x92, 0xbc, 0x5c, 0xa7,
-	0xfc, 0xea, 0x90, 0x43, 0x71, 0xc9, 0xea, 0x67, 0x8e, 0xea, 0x90, 0xcf, 0x8f, 0x0c, 0x72, 0xc8,
-	0x7b, 0x6f, 0xcf, 0xac, 0x63, 0x89, 0xfa, 0xf4, 0xce, 0xa9, 0xf8, 0xc8, 0x20, 0x29, 0x46, 0x92,
-	0xe1, 0x5a, 0x50, 0x9c, 0xb1, 0xce, 0xbf, 0x26, 0x80, 0x6a, 0x34, 0x71, 0xed, 0x17, 0x59, 0x01,
-	0x40, 0x36, 0xec, 0x3d, 0xb9, 0x2a, 0x33, 0x32, 0x48, 0x56, 0x28, 0x06, 0xba, 0x10, 0x20, 0x0d,
-	0xe9, 0x52, 0xb2, 0xb9, 0x91, 0x41, 0x74, 0xd1, 0x78, 0x9a, 0x0a, 0x02, 0xfe, 0xf6, 0xc7, 0xaa,
-	0x0c, 0xc3, 0x23, 0x83, 0xa4, 0x92, 0x31, 0xd0, 0x85, 0x01, 0x7f, 0xed, 0xf1, 0x81, 0x20, 0xe0,
-	0x41, 0x9a, 0x88, 0x9c, 0xe7, 0x14, 0xc1, 0x29, 0xe9, 0xcb, 0xd2, 0x92, 0x7c, 0x59, 0xba, 0x7e,
-	0x9c, 0xe7, 0xbe, 0x75, 0xa7, 0x9c, 0xdf, 0x75, 0x26, 0x29, 0x77, 0x9d, 0x55, 0x86, 0x81, 0xfe,
-	0x6d, 0x3b, 0x15, 0x7d, 0xf2, 0x6c, 0x8e, 0x4f, 0xae, 0xe9, 0xc0, 0xf0, 0xe0, 0xf6, 0x3b, 0x55,
-	0x51, 0xfe, 0xd9, 0xe1, 0xb5, 0x17, 0xe9, 0x91, 0x41, 0xf2, 0x15, 0xb8, 0xf4, 0x12, 0x98, 0x73,
-	0xba, 0xe8, 0x7c, 0x0f, 0x55, 0xa5, 0x46, 0xf6, 0x11, 0x98, 0x2f, 0xf1, 0xbb, 0xaf, 0x11, 0x6e,
-	0xda, 0xc7, 0x50, 0xba, 0xf1, 0x56, 0x1b, 0x2a, 0xf6, 0x58, 0x23, 0xd2, 0xc1, 0x2f, 0xf1, 0xbd,
-	0x09, 0xb5, 0x94, 0x56, 0x2e, 0x9b, 0xe3, 0x64, 0xe4, 0xcf, 0xe7, 0x54, 0x0a, 0x7e, 0x95, 0xa4,
-	0x3e, 0x7f, 0x3d, 0xc6, 0x09, 0x09, 0x5f, 0x8f, 0xe7, 0xa2, 0x4f, 0x95, 0x28, 0xd7, 0x7e, 0x04,
-	0xc5, 0xf1, 0xa5, 0xd8, 0xb6, 0x45, 0x8a, 0xe3, 0xcb, 0x54, 0xe7, 0xac, 0xbd, 0xce, 0xd9, 0x57,
-	0x60, 0x5e, 0xac, 0x96, 0x62, 0x47, 0x4d, 0xf7, 0xc5, 0x87, 0xfb, 0xf6, 0xf3, 0xcc, 0xab, 0xb6,
-	0xf6, 0x58, 0xec, 0xbf, 0x09, 0x63, 0x7f, 0xe9, 0x07, 0xca, 0x09, 0x68, 0x3f, 0xba, 0x5d, 0xf6,
-	0x67, 0xe1, 0x3a, 0x8a, 0x69, 0x92, 0xf4, 0xae, 0x09, 0x6f, 0x60, 0xdf, 0x80, 0x75, 0xbd, 0x8d,
-	0xa8, 0xd8, 0x56, 0xc3, 0x75, 0xb9, 0x22, 0xfc, 0x79, 0xdf, 0x1e, 0xfc, 0xdf, 0x66, 0x01, 0xf3,
-	0xfc, 0x80, 0xc6, 0x7d, 0xf9, 0xce, 0xf2, 0x4e, 0x44, 0xf4, 0xb3, 0x9f, 0x40, 0xe5, 0x92, 0x2e,
-	0xbc, 0xcd, 0x8a, 0xe1, 0x2a, 0x9b, 0x52, 0xc1, 0x30, 0x48, 0x54, 0xd6, 0xfe, 0x1a, 0xaa, 0xbf,
-	0x06, 0xbf, 0x45, 0x73, 0x8f, 0x51, 0xdc, 0x1a, 0x6a, 0x9d, 0x8a, 0x92, 0x34, 0xcf, 0x29, 0x43,
-	0x85, 0x70, 0x6a, 0x92, 0x32, 0x74, 0xf9, 0xd3, 0x9a, 0x5e, 0x09, 0x07, 0x44, 0x6e, 0x1f, 0xc8,
-	0x3e, 0xd4, 0x75, 0xed, 0xa1, 0xb6, 0xbf, 0x84, 0xe6, 0xc5, 0x86, 0x85, 0xe3, 0x60, 0x16, 0x53,
-	0x91, 0x6f, 0x88, 0x5a, 0x3d, 0x28, 0x57, 0xeb, 0x9e, 0xde, 0xfd, 0xdd, 0x32, 0xde, 0x3d, 0xb4,
-	0x0a, 0x77, 0x0f, 0xad, 0xc2, 0x5f, 0x0f, 0x2d, 0xe3, 0xed, 0xae, 0x65, 0xfc, 0xbe, 0x6b, 0x15,
-	0xee, 0x76, 0x2d, 0xe3, 0xfd, 0xae, 0x65, 0x4c, 0xcb, 0xe2, 0x5f, 0xc2, 0xb7, 0xff, 0x05, 0x00,
-	0x00, 0xff, 0xff, 0x23, 0x55, 0x63, 0xf2, 0x77, 0x08, 0x00, 0x00,
+	// 1014 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x51, 0x8f, 0xda, 0x46,
+	0x10, 0x36, 0xd8, 0x70, 0x30, 0xc0, 0xe5, 0xea, 0x5e, 0x53, 0xeb, 0x54, 0x01, 0xa2, 0x95, 0x42,
+	0x9b, 0x14, 0xa2, 0x6b, 0x54, 0x55, 0x48, 0xa9, 0x72, 0xe6, 0x8a, 0x8c, 0xd2, 0xa6, 0x68, 0x45,
+	0xef, 0xdd, 0xc0, 0x42, 0xac, 0x33, 0x36, 0x32, 0x8b, 0x12, 0xfe, 0x45, 0x7f, 0x42, 0xfb, 0x6f,
+	0xf2, 0x78, 0x8f, 0x51, 0x1f, 0x4e, 0xed, 0xdd, 0x1f, 0xa8, 0xd4, 0xb7, 0xa8, 0x0f, 0xd5, 0xee,
+	0xce, 0x1a, 0x2f, 0xbc, 0xf4, 0x6d, 0x67, 0xbe, 0x6f, 0x66, 0x87, 0xf9, 0x86, 0xf1, 0x42, 0x85,
+	0x6d, 0x57, 0x74, 0xdd, 0x59, 0x25, 0x31, 0x8b, 0xed, 0x22, 0x8d, 0x16, 0x41, 0x44, 0xcf, 0xbe,
+	0x5e, 0x04, 0xec, 0xf5, 0x66, 0xd2, 0x99, 0xc6, 0xcb, 0xee, 0x22, 0x5e, 0xc4, 0x5d, 0x01, 0x4f,
+	0x36, 0x73, 0x61, 0x09, 0x43, 0x9c, 0x64, 0xd8, 0x19, 0xac, 0x42, 0x3f, 0x92, 0xe7, 0xd6, 0x13,
+	0x80, 0x7e, 0xbc, 0x5c, 0xd2, 0x88, 0x5d, 0xd2, 0xb9, 0xed, 0xc0, 0x11, 0x5a, 0x4e, 0xae, 0x99,
+	0x6b, 0x97, 0x89, 0x32, 0x7b, 0xd6, 0xdf, 0xbf, 0x37, 0x8c, 0xd6, 0x39, 0x54, 0x47, 0x7e, 0xc2,
+	0x02, 0x16, 0xc4, 0x11, 0xe7, 0x7f, 0x06, 0xe5, 0xd4, 0xc6, 0x88, 0x9d, 0x03, 0x63, 0x3e, 0x87,
+	0xa3, 0xab, 0x80, 0xbe, 0xe1, 0x74, 0x1b, 0x2c, 0x7e, 0x44, 0xa6, 0x38, 0x23, 0xe9, 0x02, 0xaa,
+	0x17, 0x8c, 0x25, 0xc1, 0x64, 0xc3, 0x28, 0x67, 0x3e, 0x06, 0x8b, 0xdb, 0x82, 0x59, 0x39, 0xff,
+	0xa8, 0x23, 0x7f, 0x68, 0x27, 0xe5, 0xb8, 0xd6, 0xbb, 0xdb, 0x86, 0x41, 0x04, 0x09, 0x53, 0x4c,
+	0xa1, 0x36, 0x8c, 0x66, 0xf4, 0xed, 0xd8, 0x9f, 0x84, 0x54, 0x16, 0x67, 0x8e, 0xb7, 0x2b, 0x91,
+	0xa2, 0xe0, 0xc2, 0x87, 0xdb, 0x46, 0x51, 0xe2, 0x84, 0xbb, 0xed, 0x33, 0x28, 0xf5, 0xe3, 0xf0,
+	0x95, 0xbf, 0xa4, 0x6b, 0x27, 0xdf, 0x34, 0xdb, 0x65, 0x92, 0xda, 0xbc, 0x4e, 0x7e, 0x70, 0x4c,
+	0x59, 0x27, 0x3f, 0xe3, 0x25, 0x3f, 0x41, 0x6d, 0x94, 0xc4, 0x2b, 0x9a, 0xb0, 0x80, 0xae, 0xf9,
+	0x25, 0xdf, 0x02, 0xec, 0x1c, 0x4e, 0xae, 0x69, 0xb6, 0x2b, 0xe7, 0x27, 0xaa, 0x5c, 0x44, 0xb6,
+	0x58, 0x6d, 0x86, 0x89, 0xe9, 0xda, 0x50, 0xed, 0x87, 0x9b, 0x35, 0xa3, 0x89, 0xbb, 0xc5, 0x06,
+	0x89, 0x8b, 0x73, 0x07, 0x17, 0xbf, 0x80, 0xda, 0x20, 0x4e, 0x68, 0xb0, 0x88, 0x5e, 0x52, 0x41,
+	0xfd, 0x12, 0x0a, 0x83, 0x6b, 0xba, 0x55, 0x77, 0x7e, 0xdc, 0x11, 0xa2, 0x6a, 0x1c, 0x22, 0x19,
+	0x98, 0xe1, 0x7b, 0x5e, 0x7a, 0xb0, 0xf4, 0x93, 0x2d, 0x66, 0x78, 0x04, 0xd6, 0xe8, 0x9a, 0x6e,
+	0xb1, 0xc7, 0x98, 0x40, 0xa3, 0x10, 0x41, 0xc0, 0xf8, 0xa7, 0x70, 0x42, 0xe8, 0xbc, 0xff, 0x3a,
+	0x08, 0x67, 0x69, 0x8b, 0x1f, 0x42, 0x51, 0x9c, 0x65, 0x15, 0x16, 0x41, 0x0b, 0x23, 0x7a, 0x50,
+	0x12, 0x1d, 0xe7, 0xcc, 0x36, 0x1c, 0x89, 0x73, 0xda, 0xa4, 0x63, 0x79, 0x9f, 0x22, 0x10, 0x05,
+	0x63, 0x6c, 0x07, 0x8a, 0xe3, 0xf1, 0x8f, 0x3c, 0xb2, 0x0e, 0xe6, 0x98, 0x85, 0x58, 0x65, 0x55,
+	0x46, 0x49, 0x88, 0x70, 0x00, 0xf9, 0xaf, 0xe0, 0xd8, 0x0d, 0xe3, 0x78, 0x39, 0x08, 0x42, 0x46,
+	0x13, 0xa9, 0x4c, 0x25, 0xe3, 0xc1, 0xf8, 0x53, 0x19, 0xaf, 0x53, 0x49, 0x96, 0x88, 0xf9, 0x7e,
+	0x80, 0x07, 0xfd, 0x38, 0x5a, 0xb3, 0xc4, 0x0f, 0xc4, 0x5f, 0x63, 0xe4, 0xda, 0x4f, 0xc0, 0xec,
+	0x33, 0x55, 0xfe, 0xa9, 0xd2, 0x78, 0xc7, 0x1a, 0xb9, 0xa8, 0x33, 0xa7, 0x89, 0x34, 0xb9, 0xd6,
+	0xbf, 0x79, 0xa8, 0x66, 0x19, 0xf6, 0xf3, 0x3d, 0x1d, 0xb1, 0xae, 0x4f, 0x54, 0x3a, 0x0d, 0xf4,
+	0x0c, 0xb2, 0xa7, 0xfa, 0xf3, 0x3d, 0x11, 0x9d, 0xbc, 0x1e, 0xae, 0x81, 0x3c, 0x5c, 0x97, 0x7c,
+	0x70, 0xa8, 0xa1, 0x18, 0xf2, 0xca, 0xb9, 0xa3, 0x32, 0xec, 0xe3, 0x9e, 0x41, 0x0e, 0x75, 0xef,
+	0xec, 0x94, 0x75, 0xac, 0x66, 0x2e, 0x3b, 0xf3, 0xca, 0xef, 0x19, 0x24, 0xab, 0x3e, 0xaa, 0xe9,
+	0x14, 0x04, 0xfb, 0x58, 0xb1, 0xa5, 0xd7, 0x33, 0x88, 0x52, 0xfb, 0xc5, 0xbe, 0x8e, 0x4e, 0x51,
+	0x44, 0x3c, 0x54, 0x11, 0x3a, 0xea, 0x19, 0x64, 0x8f, 0x2f, 0x1b, 0xef, 0x5a, 0x90, 0x9f, 0xb2,
+	0xd6, 0x3f, 0x26, 0x80, 0x2a, 0x7a, 0xe4, 0xda, 0xcf, 0xb2, 0xcb, 0x0e, 0x3b, 0x6f, 0xef, 0x84,
+	0x54, 0x88, 0x67, 0x90, 0xec, 0x52, 0xec, 0xe9, 0x4b, 0x0f, 0x5b, 0x9e, 0x0e, 0x40, 0x16, 0xf3,
+	0x0c, 0xa2, 0x2f, 0xc8, 0xc7, 0xe9, 0xf2, 0xc3, 0x3e, 0x3f, 0x50, 0x61, 0xe8, 0xf6, 0x0c, 0x92,
+	0xae, 0xc7, 0x9e, 0xbe, 0x04, 0x1d, 0x4b, 0xbf, 0x28, 0x8b, 0xf1, 0x8b, 0xb2, 0x36, 0x1f, 0x0c,
+	0x6d, 0xfb, 0x39, 0x05, 0x7d, 0x30, 0x34, 0x90, 0x0f, 0x86, 0xe6, 0x90, 0x73, 0x95, 0xd9, 0x6b,
+	0x4e, 0x51, 0x0f, 0xd7, 0x40, 0x39, 0x57, 0x19, 0x87, 0xdd, 0xd3, 0xf7, 0x98, 0x73, 0xa4, 0x57,
+	0x9e, 0xc5, 0x78, 0xe5, 0x59, 0xdb, 0xee, 0x1f, 0xfc, 0xd3, 0x9c, 0x92, 0x08, 0xff, 0xf4, 0xf0,
+	0x2f, 0x26, 0x60, 0xcf, 0x20, 0xfb, 0x11, 0x28, 0x7a, 0x01, 0xcc, 0x19, 0x9d, 0xb7, 0xbe, 0x83,
+	0x92, 0xda, 0xbc, 0xf6, 0x09, 0x98, 0x2f, 0x71, 0xc7, 0x95, 0x09, 0x3f, 0xda, 0xa7, 0x50, 0xb8,
+	0xf2, 0xc3, 0x0d, 0x15, 0x3a, 0x96, 0x89, 0x34, 0xf0, 0x5f, 0xff, 0xde, 0x84, 0x72, 0xda, 0x56,
+	0xfe, 0x89, 0x18, 0xae, 0xbd, 0x60, 0x36, 0xa3, 0xf2, 0xe3, 0x56, 0x22, 0xa9, 0xcd, 0xbf, 0x94,
+	0xc3, 0x35, 0x89, 0xdf, 0x0c, 0x67, 0x22, 0x4f, 0x89, 0x28, 0xd3, 0x3e, 0x86, 0xfc, 0xf0, 0x52,
+	0xa8, 0x6d, 0x91, 0xfc, 0xf0, 0x32, 0xdd, 0xe9, 0xd6, 0x6e, 0xa7, 0xdb, 0x03, 0x30, 0x2f, 0xc2,
+	0x85, 0xd0, 0xa8, 0xe6, 0x3e, 0xfb, 0x70, 0xdb, 0x78, 0x9a, 0xf9, 0x82, 0x2f, 0x7d, 0x96, 0x04,
+	0x6f, 0xe3, 0x24, 0x58, 0x04, 0x91, 0x32, 0x22, 0xda, 0x5d, 0x5d, 0x2f, 0xba, 0xd3, 0x78, 0xb9,
+	0x4a, 0xe8, 0x7a, 0xdd, 0x19, 0x13, 0x9e, 0xc0, 0xbe, 0x02, 0x6b, 0xbc, 0x5d, 0x51, 0xa1, 0x56,
+	0xd5, 0x75, 0xf9, 0xf6, 0xf9, 0xe3, 0xb6, 0xd1, 0xfb, 0xbf, 0xc9, 0x22, 0xe6, 0x07, 0x11, 0x4d,
+	0xba, 0xf2, 0x4d, 0xc1, 0x33, 0x11, 0x91, 0xcf, 0x7e, 0x04, 0x47, 0x97, 0x74, 0xee, 0x6f, 0x42,
+	0x86, 0x52, 0xd6, 0xe4, 0xde, 0x44, 0x27, 0x51, 0xa8, 0xfd, 0x15, 0x94, 0x7e, 0x8e, 0x7e, 0x59,
+	0xcd, 0x7c, 0x46, 0x51, 0x35, 0xdc, 0xeb, 0xca, 0x4b, 0x52, 0x9c, 0xb7, 0x0c, 0xb7, 0x91, 0x53,
+	0x96, 0x2d, 0x43, 0x93, 0x3f, 0x23, 0xd2, 0x91, 0x70, 0x40, 0x60, 0x3b, 0x47, 0xf6, 0x51, 0x52,
+	0xd1, 0x1e, 0x25, 0xf6, 0x17, 0x50, 0xbb, 0xd8, 0xb0, 0x78, 0x18, 0x4d, 0x13, 0x2a, 0xf0, 0xaa,
+	0x88, 0xd5, 0x9d, 0x52, 0x5a, 0xb7, 0x79, 0xf3, 0x57, 0xdd, 0x78, 0x77, 0x57, 0xcf, 0xdd, 0xdc,
+	0xd5, 0x73, 0x7f, 0xde, 0xd5, 0x8d, 0x5f, 0xef, 0xeb, 0xc6, 0x6f, 0xf7, 0xf5, 0xdc, 0xcd, 0x7d,
+	0xdd, 0x78, 0x7f, 0x5f, 0x37, 0x26, 0x45, 0xf1, 0x22, 0xfa, 0xe6, 0xbf, 0x01, 0x00, 0x76, 0x4e,
+	0x3f, 0x34, 0x63, 0x09, 0x00, 0x00,
 }
 
 func (m *CommentDef) Marshal() (dAtA []byte, err error) {
@@ -1426,6 +1539,76 @@ func (m *IndexDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TTLDef) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TTLDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TTLDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Ttl != nil {
+		{
+			size, err := m.Ttl.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BloomFilterDef) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BloomFilterDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BloomFilterDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BloomFilter != nil {
+		{
+			size, err := m.BloomFilter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ConstraintDefPB) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -1579,6 +1762,48 @@ func (m *ConstraintPB_IndexDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *ConstraintPB_TTLDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConstraintPB_TTLDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TTLDef != nil {
+		{
+			size, err := m.TTLDef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ConstraintPB_BloomFilterDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConstraintPB_BloomFilterDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BloomFilterDef != nil {
+		{
+			size, err := m.BloomFilterDef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
 func (m *TableDefPB) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -2117,6 +2342,32 @@ func (m *IndexDef) ProtoSize() (n int) {
 	return n
 }
 
+func (m *TTLDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ttl != nil {
+		l = m.Ttl.ProtoSize()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *BloomFilterDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BloomFilter != nil {
+		l = m.BloomFilter.ProtoSize()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *ConstraintDefPB) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -2192,6 +2443,30 @@ func (m *ConstraintPB_IndexDef) ProtoSize() (n int) {
 	}
 	return n
 }
+func (m *ConstraintPB_TTLDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TTLDef != nil {
+		l = m.TTLDef.ProtoSize()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *ConstraintPB_BloomFilterDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BloomFilterDef != nil {
+		l = m.BloomFilterDef.ProtoSize()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
 func (m *TableDefPB) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -3379,6 +3654,178 @@ func (m *IndexDef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TTLDef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TTLDef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TTLDef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ttl == nil {
+				m.Ttl = &plan.TTLDef{}
+			}
+			if err := m.Ttl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BloomFilterDef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BloomFilterDef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BloomFilterDef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BloomFilter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BloomFilter == nil {
+				m.BloomFilter = &plan.BloomFilterDef{}
+			}
+			if err := m.BloomFilter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ConstraintDefPB) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3632,6 +4079,76 @@ func (m *ConstraintPB) Unmarshal(dAtA []byte) error {
 			}
 			m.Ct = &ConstraintPB_IndexDef{v}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TTLDef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TTLDef{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Ct = &ConstraintPB_TTLDef{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BloomFilterDef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BloomFilterDef{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Ct = &ConstraintPB_BloomFilterDef{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
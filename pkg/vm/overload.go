@@ -18,6 +18,7 @@ import (
 	"bytes"
 
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/anti"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/apply"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/connector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/deletion"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/dispatch"
@@ -42,6 +43,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergeblock"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergedelete"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergegroup"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergejoin"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergelimit"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergeoffset"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergeorder"
@@ -97,6 +99,7 @@ var stringFunc = [...]func(any, *bytes.Buffer){
 	LoopSemi:    loopsemi.String,
 	LoopAnti:    loopanti.String,
 	LoopMark:    loopmark.String,
+	Apply:       apply.String,
 
 	MergeTop:    mergetop.String,
 	MergeLimit:  mergelimit.String,
@@ -109,6 +112,7 @@ var stringFunc = [...]func(any, *bytes.Buffer){
 	OnDuplicateKey:  onduplicatekey.String,
 	PreInsert:       preinsert.String,
 	PreInsertUnique: preinsertunique.String,
+	MergeJoin:       mergejoin.String,
 	Update:          update.String,
 	External:        external.String,
 
@@ -153,6 +157,7 @@ var prepareFunc = [...]func(*process.Process, any) error{
 	LoopSemi:    loopsemi.Prepare,
 	LoopAnti:    loopanti.Prepare,
 	LoopMark:    loopmark.Prepare,
+	Apply:       apply.Prepare,
 
 	MergeTop:    mergetop.Prepare,
 	MergeLimit:  mergelimit.Prepare,
@@ -165,6 +170,7 @@ var prepareFunc = [...]func(*process.Process, any) error{
 	OnDuplicateKey:  onduplicatekey.Prepare,
 	PreInsert:       preinsert.Prepare,
 	PreInsertUnique: preinsertunique.Prepare,
+	MergeJoin:       mergejoin.Prepare,
 	Update:          update.Prepare,
 	External:        external.Prepare,
 
@@ -209,6 +215,7 @@ var execFunc = [...]func(int, *process.Process, any, bool, bool) (bool, error){
 	LoopSemi:    loopsemi.Call,
 	LoopAnti:    loopanti.Call,
 	LoopMark:    loopmark.Call,
+	Apply:       apply.Call,
 
 	MergeTop:    mergetop.Call,
 	MergeLimit:  mergelimit.Call,
@@ -224,6 +231,7 @@ var execFunc = [...]func(int, *process.Process, any, bool, bool) (bool, error){
 	OnDuplicateKey:  onduplicatekey.Call,
 	PreInsert:       preinsert.Call,
 	PreInsertUnique: preinsertunique.Call,
+	MergeJoin:       mergejoin.Call,
 
 	Minus:        minus.Call,
 	Intersect:    intersect.Call,
@@ -51,7 +51,10 @@ func (p *Pipeline) Run(r engine.Reader, proc *process.Process) (end bool, err er
 	perfCounterSet := new(perfcounter.CounterSet)
 	proc.Ctx = perfcounter.WithCounterSet(proc.Ctx, perfCounterSet)
 	defer func() {
-		_ = perfCounterSet //TODO
+		a := proc.GetAnalyze(p.instructions[0].Idx)
+		a.S3ReadCount(int(perfCounterSet.FileService.S3.Get.Load()))
+		a.CacheReadCount(int(perfCounterSet.FileService.Cache.Read.Load()),
+			int(perfCounterSet.FileService.Cache.Hit.Load()))
 	}()
 
 	var bat *batch.Batch
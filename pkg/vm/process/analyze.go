@@ -15,9 +15,12 @@
 package process
 
 import (
+	"fmt"
+	"runtime"
 	"sync/atomic"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 )
 
@@ -41,8 +44,23 @@ func NewAnalyzeInfo(nodeId int32) *AnalyzeInfo {
 	}
 }
 
+// Start begins a call of the operator. Until Stop, the goroutine is locked to
+// its thread so the on-CPU time of the call can be read from the thread clock,
+// and the process allocates from the pool of the node to track its peak.
 func (a *analyze) Start() {
 	a.start = time.Now()
+	if a.analInfo == nil || a.started {
+		return
+	}
+	a.started = true
+	runtime.LockOSThread()
+	a.cpuStart = threadCPUTime()
+	if a.proc != nil {
+		if mp := a.proc.rootMp(); mp != nil {
+			a.mp = a.analInfo.pool(mp)
+			a.proc.mp.Store(a.mp)
+		}
+	}
 }
 
 func (a *analyze) Stop() {
@@ -50,6 +68,19 @@ func (a *analyze) Stop() {
 		atomic.AddInt64(&a.analInfo.WaitTimeConsumed, int64(a.wait/time.Nanosecond))
 		atomic.AddInt64(&a.analInfo.TimeConsumed, int64((time.Since(a.start)-a.wait)/time.Nanosecond))
 	}
+	if !a.started {
+		return
+	}
+	a.started = false
+	if cpu := threadCPUTime() - a.cpuStart; cpu > 0 {
+		atomic.AddInt64(&a.analInfo.CpuTime, cpu)
+	}
+	runtime.UnlockOSThread()
+	if a.mp != nil {
+		a.analInfo.SetMemoryPeak(a.mp.Stats().HighWaterMark.Load())
+		a.proc.mp.Store(a.mp.Parent())
+		a.mp = nil
+	}
 }
 
 func (a *analyze) Alloc(size int64) {
@@ -117,3 +148,64 @@ func (a *analyze) AddInsertTime(t time.Time) {
 		atomic.AddInt64(&a.analInfo.InsertTime, int64(time.Since(t)))
 	}
 }
+
+func (a *analyze) AddLockWaitTime(t time.Time) {
+	if a.analInfo != nil {
+		atomic.AddInt64(&a.analInfo.LockWaitTime, int64(time.Since(t)))
+	}
+}
+
+func (a *analyze) S3ReadCount(count int) {
+	if a.analInfo != nil {
+		atomic.AddInt64(&a.analInfo.S3ReadCount, int64(count))
+	}
+}
+
+func (a *analyze) CacheReadCount(read int, hit int) {
+	if a.analInfo != nil {
+		atomic.AddInt64(&a.analInfo.CacheReadCount, int64(read))
+		atomic.AddInt64(&a.analInfo.CacheHitCount, int64(hit))
+	}
+}
+
+// AddCnAddr records that the node ran on the CN with the address.
+func (a *AnalyzeInfo) AddCnAddr(addr string) {
+	if len(addr) == 0 {
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for _, v := range a.cnAddrs {
+		if v == addr {
+			return
+		}
+	}
+	a.cnAddrs = append(a.cnAddrs, addr)
+}
+
+// CnAddrs returns the addresses of the CNs the node ran on.
+func (a *AnalyzeInfo) CnAddrs() []string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return append([]string(nil), a.cnAddrs...)
+}
+
+// SetMemoryPeak raises the memory peak of the node to peak if it is higher.
+func (a *AnalyzeInfo) SetMemoryPeak(peak int64) {
+	for {
+		old := atomic.LoadInt64(&a.MemoryPeak)
+		if peak <= old || atomic.CompareAndSwapInt64(&a.MemoryPeak, old, peak) {
+			return
+		}
+	}
+}
+
+// pool returns the child of mp the node allocates from, created on first use.
+func (a *AnalyzeInfo) pool(mp *mpool.MPool) *mpool.MPool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.mp == nil {
+		a.mp = mp.NewChild(fmt.Sprintf("node-%d", a.NodeId))
+	}
+	return a.mp
+}
@@ -0,0 +1,58 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package process
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyzeMemoryPeakAndCpuTime(t *testing.T) {
+	mp := mpool.MustNewZero()
+	proc := NewWithAnalyze(New(context.TODO(), mp, nil, nil, nil, nil, nil), context.TODO(), 0,
+		[]*AnalyzeInfo{NewAnalyzeInfo(0), NewAnalyzeInfo(1)})
+
+	// node 0 holds 3000 bytes at most, and node 1 frees them.
+	anal := proc.GetAnalyze(0)
+	anal.Start()
+	bs1, err := proc.Mp().Alloc(1000)
+	require.NoError(t, err)
+	bs2, err := proc.Mp().Alloc(2000)
+	require.NoError(t, err)
+	sum := 0
+	for i := 0; i < 1000000; i++ {
+		sum += i
+	}
+	require.NotZero(t, sum)
+	anal.Stop()
+	require.Equal(t, mp, proc.Mp())
+
+	anal = proc.GetAnalyze(1)
+	anal.Start()
+	proc.Mp().Free(bs1)
+	proc.Mp().Free(bs2)
+	bs3, err := proc.Mp().Alloc(500)
+	require.NoError(t, err)
+	proc.Mp().Free(bs3)
+	anal.Stop()
+
+	require.Equal(t, int64(3000), proc.AnalInfos[0].MemoryPeak)
+	require.Equal(t, int64(0), proc.AnalInfos[1].MemoryPeak)
+	require.Greater(t, proc.AnalInfos[0].CpuTime, int64(0))
+	require.Equal(t, int64(0), mp.CurrNB())
+}
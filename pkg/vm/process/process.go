@@ -41,8 +41,7 @@ func New(
 	fileService fileservice.FileService,
 	lockService lockservice.LockService,
 	aicm *defines.AutoIncrCacheManager) *Process {
-	return &Process{
-		mp:           m,
+	proc := &Process{
 		Ctx:          ctx,
 		TxnClient:    txnClient,
 		TxnOperator:  txnOperator,
@@ -54,7 +53,10 @@ func New(
 		vp: &vectorPool{
 			vecs: make(map[uint8][]*vector.Vector),
 		},
+		rfBox: newRuntimeFilterBox(),
 	}
+	proc.mp.Store(m)
+	return proc
 }
 
 func NewWithAnalyze(p *Process, ctx context.Context, regNumber int, anals []*AnalyzeInfo) *Process {
@@ -70,7 +72,8 @@ func NewFromProc(p *Process, ctx context.Context, regNumber int) *Process {
 	newctx, cancel := context.WithCancel(ctx)
 	proc.Id = p.Id
 	proc.vp = p.vp
-	proc.mp = p.Mp()
+	proc.rfBox = p.rfBox
+	proc.mp.Store(p.rootMp())
 	proc.Lim = p.Lim
 	proc.TxnClient = p.TxnClient
 	proc.TxnOperator = p.TxnOperator
@@ -135,13 +138,22 @@ func (proc *Process) GetMPool() *mpool.MPool {
 	if proc == nil {
 		return xxxProcMp
 	}
-	return proc.mp
+	return proc.mp.Load()
 }
 
 func (proc *Process) Mp() *mpool.MPool {
 	return proc.GetMPool()
 }
 
+// rootMp returns the pool of the query, not the one of the operator running.
+func (proc *Process) rootMp() *mpool.MPool {
+	mp := proc.Mp()
+	if mp != nil && mp.Parent() != nil {
+		return mp.Parent()
+	}
+	return mp
+}
+
 func (proc *Process) OperatorOutofMemory(size int64) bool {
 	return proc.Mp().Cap() < size
 }
@@ -158,7 +170,7 @@ func (proc *Process) GetAnalyze(idx int) Analyze {
 	if idx >= len(proc.AnalInfos) {
 		return &analyze{analInfo: nil}
 	}
-	return &analyze{analInfo: proc.AnalInfos[idx], wait: 0}
+	return &analyze{analInfo: proc.AnalInfos[idx], wait: 0, proc: proc}
 }
 
 func (proc *Process) AllocVectorOfRows(typ types.Type, nele int, nsp *nulls.Nulls) (*vector.Vector, error) {
@@ -0,0 +1,156 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package process
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+const (
+	// RuntimeFilter_PASS means no filter is built, the scan reads everything.
+	RuntimeFilter_PASS = iota
+	// RuntimeFilter_DROP means the build side is empty, nothing is needed.
+	RuntimeFilter_DROP
+	// RuntimeFilter_IN keeps the rows whose key is one of Keys.
+	RuntimeFilter_IN
+	// RuntimeFilter_BLOOM keeps the rows whose key may be in Bloom.
+	RuntimeFilter_BLOOM
+	// RuntimeFilter_MIN_MAX keeps the rows whose key is within [Min, Max].
+	RuntimeFilter_MIN_MAX
+)
+
+// RuntimeKeyFilter tells whether an encoded key may be one of the build keys.
+type RuntimeKeyFilter interface {
+	MayContainsKey(key []byte) (bool, error)
+}
+
+// RuntimeFilterMessage is the filter of join keys sent by a hash build to the
+// table scans on the probe side of the join. The keys are encoded as the
+// bytes of vector elements, the fixed-size value or the varlena content.
+type RuntimeFilterMessage struct {
+	Tag int32
+	Typ int
+	// Oid is the type of keys.
+	Oid types.T
+	// Min and Max bound the keys of IN, BLOOM and MIN_MAX filters.
+	Min []byte
+	Max []byte
+	// Keys are the distinct keys of an IN filter.
+	Keys [][]byte
+	// Bloom is the filter of a BLOOM filter.
+	Bloom RuntimeKeyFilter
+}
+
+// runtimeFilterBox keeps the runtime filters of a query, it is shared by all
+// processes derived from the same root.
+type runtimeFilterBox struct {
+	sync.Mutex
+	msgs map[int32]*RuntimeFilterMessage
+	// arrived is closed and renewed when a new filter arrives.
+	arrived chan struct{}
+}
+
+func newRuntimeFilterBox() *runtimeFilterBox {
+	return &runtimeFilterBox{
+		msgs:    make(map[int32]*RuntimeFilterMessage),
+		arrived: make(chan struct{}),
+	}
+}
+
+// ResetRuntimeFilters drops the runtime filters of the last statement run by
+// the process, the tags of filters are only unique within a statement.
+func (proc *Process) ResetRuntimeFilters() {
+	proc.rfBox = newRuntimeFilterBox()
+}
+
+// SendRuntimeFilter publishes a runtime filter, the first filter of a tag wins
+// because all hash builds of a broadcast join build the same keys.
+func (proc *Process) SendRuntimeFilter(msg *RuntimeFilterMessage) {
+	box := proc.rfBox
+	if box == nil {
+		return
+	}
+	box.Lock()
+	defer box.Unlock()
+	if _, ok := box.msgs[msg.Tag]; ok {
+		return
+	}
+	box.msgs[msg.Tag] = msg
+	close(box.arrived)
+	box.arrived = make(chan struct{})
+}
+
+// RuntimeFilters returns the runtime filters of tags arrived, and the channel
+// closed when another filter arrives. It never waits.
+func (proc *Process) RuntimeFilters(tags []int32) (map[int32]*RuntimeFilterMessage, <-chan struct{}) {
+	box := proc.rfBox
+	if box == nil || len(tags) == 0 {
+		return nil, nil
+	}
+	return box.get(tags)
+}
+
+// WaitRuntimeFilters waits at most timeout for the runtime filters of tags,
+// and returns the ones arrived.
+func (proc *Process) WaitRuntimeFilters(ctx context.Context, tags []int32, timeout time.Duration) map[int32]*RuntimeFilterMessage {
+	box := proc.rfBox
+	if box == nil || len(tags) == 0 {
+		return nil
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		msgs, arrived := box.get(tags)
+		if len(msgs) == len(tags) {
+			return msgs
+		}
+		select {
+		case <-arrived:
+		case <-timer.C:
+			return msgs
+		case <-ctx.Done():
+			return msgs
+		}
+	}
+}
+
+// get returns the filters of tags arrived, and the channel closed when a new
+// filter arrives.
+func (box *runtimeFilterBox) get(tags []int32) (map[int32]*RuntimeFilterMessage, chan struct{}) {
+	box.Lock()
+	defer box.Unlock()
+	msgs := make(map[int32]*RuntimeFilterMessage, len(tags))
+	for _, tag := range tags {
+		if msg, ok := box.msgs[tag]; ok {
+			msgs[tag] = msg
+		}
+	}
+	return msgs, box.arrived
+}
+
+// RuntimeFilterKey returns the key of a runtime filter at row i of vec, the
+// content of a varlena or the bytes of a fixed-size value.
+func RuntimeFilterKey(vec *vector.Vector, i int) []byte {
+	if vec.GetType().IsVarlen() {
+		return vec.GetBytesAt(i)
+	}
+	size := vec.GetType().TypeSize()
+	return vec.UnsafeGetRawData()[i*size : (i+1)*size]
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"io"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
@@ -48,6 +49,9 @@ type Analyze interface {
 	Network(*batch.Batch)
 	AddScanTime(t time.Time)
 	AddInsertTime(t time.Time)
+	S3ReadCount(int)
+	CacheReadCount(read int, hit int)
+	AddLockWaitTime(t time.Time)
 }
 
 // WaitRegister channel
@@ -120,6 +124,8 @@ type AnalyzeInfo struct {
 	TimeConsumed int64
 	// WaitTimeConsumed, time taken by the node waiting for channel in milliseconds
 	WaitTimeConsumed int64
+	// CpuTime, on-CPU time of the goroutines running the node in nanoseconds
+	CpuTime int64
 	// InputSize, data size accepted by node
 	InputSize int64
 	// OutputSize, data size output by node
@@ -140,6 +146,26 @@ type AnalyzeInfo struct {
 	ScanTime int64
 	// InsertTime, insert cost time in load flow
 	InsertTime int64
+	// MemoryPeak, peak of the memory held by the node in the query's pool
+	MemoryPeak int64
+	// TotalBlocks, number of blocks of the scanned table
+	TotalBlocks int64
+	// PrunedBlocks, number of blocks skipped by zonemaps or bloom filters
+	PrunedBlocks int64
+	// S3ReadCount, number of s3 reads issued by the node's reader
+	S3ReadCount int64
+	// CacheReadCount, number of file service cache lookups issued by the node's reader
+	CacheReadCount int64
+	// CacheHitCount, number of file service cache hits of the node's reader
+	CacheHitCount int64
+	// LockWaitTime, time spent waiting for the locks of the node
+	LockWaitTime int64
+
+	mu sync.Mutex
+	// mp, the child of the query's pool the node allocates from
+	mp *mpool.MPool
+	// cnAddrs, addresses of the CNs the node ran on
+	cnAddrs []string
 }
 
 // Process contains context used in query execution
@@ -152,7 +178,8 @@ type Process struct {
 	Lim Limitation
 
 	vp *vectorPool
-	mp *mpool.MPool
+	// mp is switched to the pool of the operator running, see analyze.Start
+	mp atomic.Pointer[mpool.MPool]
 
 	// unix timestamp
 	UnixTime int64
@@ -181,6 +208,8 @@ type Process struct {
 	DispatchNotifyCh chan WrapCs
 
 	Aicm *defines.AutoIncrCacheManager
+
+	rfBox *runtimeFilterBox
 }
 
 type vectorPool struct {
@@ -223,6 +252,10 @@ func (proc *Process) GetLastInsertID() uint64 {
 type analyze struct {
 	start    time.Time
 	wait     time.Duration
+	cpuStart int64
+	started  bool
+	proc     *Process
+	mp       *mpool.MPool
 	analInfo *AnalyzeInfo
 }
 
@@ -45,6 +45,7 @@ const (
 	LoopAnti
 	Mark
 	LoopMark
+	Apply
 
 	Merge
 	MergeTop
@@ -74,6 +75,7 @@ const (
 	OnDuplicateKey
 	PreInsert
 	PreInsertUnique
+	MergeJoin
 	// LastInstructionOp is not a true operator and must set at last.
 	// It was used by unit testing to ensure that
 	// all functions related to instructions can reach 100% coverage.